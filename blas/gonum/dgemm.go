@@ -5,7 +5,6 @@
 package gonum
 
 import (
-	"runtime"
 	"sync"
 
 	"gonum.org/v1/gonum/blas"
@@ -160,9 +159,56 @@ func dgemmParallel(aTrans, bTrans bool, m, n, k int, a []float64, lda int, b []f
 		return
 	}
 
+	// block computes the {i, j} block of C. The blocks and the order of
+	// the updates along k within each block depend only on the dimensions
+	// of the matrices, so the result does not depend on the number of
+	// workers.
+	block := func(i, j int) {
+		leni := blockSize
+		if i+leni > m {
+			leni = m - i
+		}
+		lenj := blockSize
+		if j+lenj > n {
+			lenj = n - j
+		}
+
+		cSub := sliceView64(c, ldc, i, j, leni, lenj)
+
+		// Compute A_ik B_kj for all k
+		for k := 0; k < maxKLen; k += blockSize {
+			lenk := blockSize
+			if k+lenk > maxKLen {
+				lenk = maxKLen - k
+			}
+			var aSub, bSub []float64
+			if aTrans {
+				aSub = sliceView64(a, lda, k, i, lenk, leni)
+			} else {
+				aSub = sliceView64(a, lda, i, k, leni, lenk)
+			}
+			if bTrans {
+				bSub = sliceView64(b, ldb, j, k, lenj, lenk)
+			} else {
+				bSub = sliceView64(b, ldb, k, j, lenk, lenj)
+			}
+			dgemmSerial(aTrans, bTrans, leni, lenj, lenk, aSub, lda, bSub, ldb, cSub, ldc, alpha)
+		}
+	}
+
+	workers := maxWorkers()
+	if workers == 1 {
+		for i := 0; i < m; i += blockSize {
+			for j := 0; j < n; j += blockSize {
+				block(i, j)
+			}
+		}
+		return
+	}
+
 	// workerLimit acts a number of maximum concurrent workers,
 	// with the limit set to the number of procs available.
-	workerLimit := make(chan struct{}, runtime.GOMAXPROCS(0))
+	workerLimit := make(chan struct{}, workers)
 
 	// wg is used to wait for all
 	var wg sync.WaitGroup
@@ -177,37 +223,7 @@ func dgemmParallel(aTrans, bTrans bool, m, n, k int, a []float64, lda int, b []f
 					wg.Done()
 					<-workerLimit
 				}()
-
-				leni := blockSize
-				if i+leni > m {
-					leni = m - i
-				}
-				lenj := blockSize
-				if j+lenj > n {
-					lenj = n - j
-				}
-
-				cSub := sliceView64(c, ldc, i, j, leni, lenj)
-
-				// Compute A_ik B_kj for all k
-				for k := 0; k < maxKLen; k += blockSize {
-					lenk := blockSize
-					if k+lenk > maxKLen {
-						lenk = maxKLen - k
-					}
-					var aSub, bSub []float64
-					if aTrans {
-						aSub = sliceView64(a, lda, k, i, lenk, leni)
-					} else {
-						aSub = sliceView64(a, lda, i, k, leni, lenk)
-					}
-					if bTrans {
-						bSub = sliceView64(b, ldb, j, k, lenj, lenk)
-					} else {
-						bSub = sliceView64(b, ldb, k, j, lenk, lenj)
-					}
-					dgemmSerial(aTrans, bTrans, leni, lenj, lenk, aSub, lda, bSub, ldb, cSub, ldc, alpha)
-				}
+				block(i, j)
 			}(i, j)
 		}
 	}
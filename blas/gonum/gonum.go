@@ -8,6 +8,8 @@ package gonum
 
 import (
 	"math"
+	"runtime"
+	"sync/atomic"
 
 	"gonum.org/v1/gonum/internal/math32"
 )
@@ -21,6 +23,39 @@ const (
 	minParBlock = 4  // minimum number of blocks needed to go parallel
 )
 
+// maxProcs is the maximum number of goroutines used by
+// parallel routines. Zero means runtime.GOMAXPROCS(0).
+var maxProcs atomic.Int64
+
+// SetMaxProcs sets the maximum number of goroutines used concurrently by
+// the parallel routines of the package, Dgemm and Sgemm, and returns the
+// previous setting. If n is less than one, the limit is runtime.GOMAXPROCS(0)
+// at the time of each call, which is the default.
+//
+// The partitioning of the work and the order of the floating point
+// operations in each part depend only on the dimensions of the operands,
+// so results are identical bit-for-bit regardless of the limit and of
+// GOMAXPROCS.
+func SetMaxProcs(n int) (prev int) {
+	return int(maxProcs.Swap(int64(max(n, 0))))
+}
+
+// MaxProcs returns the current setting of the maximum number of goroutines
+// used concurrently by the parallel routines of the package. A return value
+// of zero means runtime.GOMAXPROCS(0) is used.
+func MaxProcs() int {
+	return int(maxProcs.Load())
+}
+
+// maxWorkers returns the maximum number of goroutines to use in a
+// parallel routine.
+func maxWorkers() int {
+	if n := maxProcs.Load(); n > 0 {
+		return int(n)
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
 // blocks returns the number of divisions of the dimension length with the given
 // block size.
 func blocks(dim, bsize int) int {
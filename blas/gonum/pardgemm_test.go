@@ -175,6 +175,46 @@ func testMatchParallelSerial(t *testing.T, rnd *rand.Rand, i int, tA, tB blas.Tr
 	}
 }
 
+func TestDgemmMaxProcs(t *testing.T) {
+	defer SetMaxProcs(SetMaxProcs(0))
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const m, n, k = 3*blockSize + 7, 2*blockSize + 3, 5*blockSize + 11
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, tB := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			lda, ldb := k, n
+			if tA == blas.Trans {
+				lda = m
+			}
+			if tB == blas.Trans {
+				ldb = k
+			}
+			a := randmat(max(m, k), lda, lda, rnd)
+			b := randmat(max(n, k), ldb, ldb, rnd)
+			c0 := randmat(m, n, n, rnd)
+
+			var want []float64
+			for _, procs := range []int{1, 2, 3, 8, 0} {
+				SetMaxProcs(procs)
+				if got := MaxProcs(); got != procs {
+					t.Errorf("unexpected MaxProcs: got:%d want:%d", got, procs)
+				}
+				c := make([]float64, len(c0))
+				copy(c, c0)
+				Implementation{}.Dgemm(tA, tB, m, n, k, 1.5, a, lda, b, ldb, 0.5, c, n)
+				if want == nil {
+					want = c
+					continue
+				}
+				// Results must be identical bit-for-bit.
+				if !floats.Same(c, want) {
+					t.Errorf("tA=%c tB=%c: result with %d procs differs from serial result", tA, tB, procs)
+				}
+			}
+		}
+	}
+}
+
 func randmat(r, c, stride int, rnd *rand.Rand) []float64 {
 	data := make([]float64, r*stride+c)
 	for i := range data {
@@ -7,7 +7,6 @@
 package gonum
 
 import (
-	"runtime"
 	"sync"
 
 	"gonum.org/v1/gonum/blas"
@@ -164,9 +163,56 @@ func sgemmParallel(aTrans, bTrans bool, m, n, k int, a []float32, lda int, b []f
 		return
 	}
 
+	// block computes the {i, j} block of C. The blocks and the order of
+	// the updates along k within each block depend only on the dimensions
+	// of the matrices, so the result does not depend on the number of
+	// workers.
+	block := func(i, j int) {
+		leni := blockSize
+		if i+leni > m {
+			leni = m - i
+		}
+		lenj := blockSize
+		if j+lenj > n {
+			lenj = n - j
+		}
+
+		cSub := sliceView32(c, ldc, i, j, leni, lenj)
+
+		// Compute A_ik B_kj for all k
+		for k := 0; k < maxKLen; k += blockSize {
+			lenk := blockSize
+			if k+lenk > maxKLen {
+				lenk = maxKLen - k
+			}
+			var aSub, bSub []float32
+			if aTrans {
+				aSub = sliceView32(a, lda, k, i, lenk, leni)
+			} else {
+				aSub = sliceView32(a, lda, i, k, leni, lenk)
+			}
+			if bTrans {
+				bSub = sliceView32(b, ldb, j, k, lenj, lenk)
+			} else {
+				bSub = sliceView32(b, ldb, k, j, lenk, lenj)
+			}
+			sgemmSerial(aTrans, bTrans, leni, lenj, lenk, aSub, lda, bSub, ldb, cSub, ldc, alpha)
+		}
+	}
+
+	workers := maxWorkers()
+	if workers == 1 {
+		for i := 0; i < m; i += blockSize {
+			for j := 0; j < n; j += blockSize {
+				block(i, j)
+			}
+		}
+		return
+	}
+
 	// workerLimit acts a number of maximum concurrent workers,
 	// with the limit set to the number of procs available.
-	workerLimit := make(chan struct{}, runtime.GOMAXPROCS(0))
+	workerLimit := make(chan struct{}, workers)
 
 	// wg is used to wait for all
 	var wg sync.WaitGroup
@@ -181,37 +227,7 @@ func sgemmParallel(aTrans, bTrans bool, m, n, k int, a []float32, lda int, b []f
 					wg.Done()
 					<-workerLimit
 				}()
-
-				leni := blockSize
-				if i+leni > m {
-					leni = m - i
-				}
-				lenj := blockSize
-				if j+lenj > n {
-					lenj = n - j
-				}
-
-				cSub := sliceView32(c, ldc, i, j, leni, lenj)
-
-				// Compute A_ik B_kj for all k
-				for k := 0; k < maxKLen; k += blockSize {
-					lenk := blockSize
-					if k+lenk > maxKLen {
-						lenk = maxKLen - k
-					}
-					var aSub, bSub []float32
-					if aTrans {
-						aSub = sliceView32(a, lda, k, i, lenk, leni)
-					} else {
-						aSub = sliceView32(a, lda, i, k, leni, lenk)
-					}
-					if bTrans {
-						bSub = sliceView32(b, ldb, j, k, lenj, lenk)
-					} else {
-						bSub = sliceView32(b, ldb, k, j, lenk, lenj)
-					}
-					sgemmSerial(aTrans, bTrans, leni, lenj, lenk, aSub, lda, bSub, ldb, cSub, ldc, alpha)
-				}
+				block(i, j)
 			}(i, j)
 		}
 	}
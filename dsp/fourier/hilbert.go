@@ -0,0 +1,187 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fourier
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// Hilbert implements the discrete Hilbert transform of real sequences and
+// the computation of their analytic signals using the Fast Fourier Transform.
+//
+// The analytic signal of a real sequence x is the complex sequence
+// z = x + i·H(x), where H(x) is the Hilbert transform of x, whose spectrum
+// has no negative frequency components. The modulus and argument of the
+// analytic signal are the instantaneous amplitude, or envelope, and the
+// instantaneous phase of x. The transform treats the sequence as periodic,
+// so values near the ends of a sequence that is not periodic are affected by
+// the discontinuity between its last and first samples.
+type Hilbert struct {
+	fft  *FFT
+	cfft *CmplxFFT
+
+	coeff []complex128
+	work  []complex128
+}
+
+// NewHilbert returns a Hilbert initialized for work on sequences of length n.
+func NewHilbert(n int) *Hilbert {
+	return &Hilbert{
+		fft:   NewFFT(n),
+		cfft:  NewCmplxFFT(n),
+		coeff: make([]complex128, n/2+1),
+		work:  make([]complex128, n),
+	}
+}
+
+// Len returns the length of the acceptable input.
+func (h *Hilbert) Len() int { return h.fft.Len() }
+
+// Reset reinitializes the Hilbert for work on sequences of length n.
+func (h *Hilbert) Reset(n int) {
+	h.fft.Reset(n)
+	h.cfft.Reset(n)
+	if n <= cap(h.work) {
+		h.coeff = h.coeff[:n/2+1]
+		h.work = h.work[:n]
+	} else {
+		h.coeff = make([]complex128, n/2+1)
+		h.work = make([]complex128, n)
+	}
+}
+
+// AnalyticSignal computes the analytic signal of the real sequence in seq,
+// placing the result in dst and returning it. The real part of the analytic
+// signal is seq and the imaginary part is the Hilbert transform of seq.
+//
+// If the length of seq is not h.Len(), AnalyticSignal will panic.
+// If dst is nil, a new slice is allocated and returned. If dst is not nil and
+// the length of dst does not equal the length of seq, AnalyticSignal will
+// panic.
+func (h *Hilbert) AnalyticSignal(dst []complex128, seq []float64) []complex128 {
+	n := h.Len()
+	if len(seq) != n {
+		panic("fourier: sequence length mismatch")
+	}
+	if dst == nil {
+		dst = make([]complex128, n)
+	} else if len(dst) != n {
+		panic("fourier: destination length mismatch")
+	}
+	h.fft.Coefficients(h.coeff, seq)
+
+	// Double the positive frequency components and
+	// zero the negative frequency components. The
+	// zero frequency component and, for even n, the
+	// Nyquist frequency component are unchanged.
+	clear(dst)
+	f := 1 / float64(n)
+	dst[0] = h.coeff[0] * complex(f, 0)
+	for k := 1; k < (n+1)/2; k++ {
+		dst[k] = h.coeff[k] * complex(2*f, 0)
+	}
+	if n%2 == 0 {
+		dst[n/2] = h.coeff[n/2] * complex(f, 0)
+	}
+	h.cfft.Sequence(dst, dst)
+
+	// Restore the real part, which is the
+	// input sequence, to avoid rounding error.
+	for i, v := range seq {
+		dst[i] = complex(v, imag(dst[i]))
+	}
+	return dst
+}
+
+// Transform computes the Hilbert transform of the real sequence in seq,
+// placing the result in dst and returning it.
+//
+// If the length of seq is not h.Len(), Transform will panic.
+// If dst is nil, a new slice is allocated and returned. If dst is not nil and
+// the length of dst does not equal the length of seq, Transform will panic.
+// It is safe to use the same slice for dst and seq.
+func (h *Hilbert) Transform(dst, seq []float64) []float64 {
+	if len(seq) != h.Len() {
+		panic("fourier: sequence length mismatch")
+	}
+	if dst == nil {
+		dst = make([]float64, len(seq))
+	} else if len(dst) != len(seq) {
+		panic("fourier: destination length mismatch")
+	}
+	h.AnalyticSignal(h.work, seq)
+	for i, v := range h.work {
+		dst[i] = imag(v)
+	}
+	return dst
+}
+
+// Envelope computes the instantaneous amplitude of the signal with the
+// analytic signal z, the modulus of each element of z, placing the result
+// in dst and returning it.
+//
+// If dst is nil, a new slice is allocated and returned. If dst is not nil and
+// the length of dst does not equal the length of z, Envelope will panic.
+func Envelope(dst []float64, z []complex128) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(z))
+	} else if len(dst) != len(z) {
+		panic("fourier: destination length mismatch")
+	}
+	for i, v := range z {
+		dst[i] = cmplx.Abs(v)
+	}
+	return dst
+}
+
+// InstantaneousPhase computes the instantaneous phase in radians of the
+// signal with the analytic signal z, placing the result in dst and returning
+// it. The phase is unwrapped so that consecutive values differ by no more
+// than π, and the first value is in [-π, π].
+//
+// If dst is nil, a new slice is allocated and returned. If dst is not nil and
+// the length of dst does not equal the length of z, InstantaneousPhase will
+// panic.
+func InstantaneousPhase(dst []float64, z []complex128) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(z))
+	} else if len(dst) != len(z) {
+		panic("fourier: destination length mismatch")
+	}
+	for i, v := range z {
+		if i == 0 {
+			dst[i] = cmplx.Phase(v)
+			continue
+		}
+		dst[i] = dst[i-1] + cmplx.Phase(v*cmplx.Conj(z[i-1]))
+	}
+	return dst
+}
+
+// InstantaneousFrequency computes the instantaneous frequency in cycles per
+// sample of the signal with the analytic signal z, placing the result in dst
+// and returning it. The value in dst[i] is the rate of change of the phase
+// between samples i and i+1, so the result has one element fewer than z,
+// and each value is in [-0.5, 0.5]. The frequency in cycles per unit time is
+// obtained by multiplying by the sample rate.
+//
+// If dst is nil, a new slice is allocated and returned. If dst is not nil and
+// the length of dst does not equal len(z)-1, InstantaneousFrequency will
+// panic. If z is empty, InstantaneousFrequency will panic.
+func InstantaneousFrequency(dst []float64, z []complex128) []float64 {
+	if len(z) == 0 {
+		panic("fourier: empty analytic signal")
+	}
+	if dst == nil {
+		dst = make([]float64, len(z)-1)
+	} else if len(dst) != len(z)-1 {
+		panic("fourier: destination length mismatch")
+	}
+	for i := range dst {
+		dst[i] = cmplx.Phase(z[i+1]*cmplx.Conj(z[i])) / (2 * math.Pi)
+	}
+	return dst
+}
@@ -0,0 +1,151 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fourier
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+// naiveAnalytic returns the analytic signal of seq calculated
+// by direct evaluation of the discrete Fourier transforms.
+func naiveAnalytic(seq []float64) []complex128 {
+	n := len(seq)
+	z := make([]complex128, n)
+	for j := range z {
+		for k := range n {
+			var w float64
+			switch {
+			case k == 0, 2*k == n:
+				w = 1
+			case 2*k < n:
+				w = 2
+			default:
+				continue
+			}
+			var c complex128
+			for i, v := range seq {
+				c += complex(v, 0) * cmplx.Rect(1, -2*math.Pi*float64(i*k)/float64(n))
+			}
+			z[j] += complex(w/float64(n), 0) * c * cmplx.Rect(1, 2*math.Pi*float64(j*k)/float64(n))
+		}
+	}
+	return z
+}
+
+func TestHilbert(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewPCG(1, 1))
+	h := NewHilbert(1)
+	for _, n := range []int{1, 2, 3, 8, 15, 16, 31, 64} {
+		h.Reset(n)
+		if h.Len() != n {
+			t.Errorf("unexpected length: got %d, want %d", h.Len(), n)
+		}
+		seq := make([]float64, n)
+		for i := range seq {
+			seq[i] = rnd.NormFloat64()
+		}
+		got := h.AnalyticSignal(nil, seq)
+		want := naiveAnalytic(seq)
+		for i := range got {
+			if cmplx.Abs(got[i]-want[i]) > tol {
+				t.Errorf("unexpected analytic signal for n=%d at %d: got %v, want %v", n, i, got[i], want[i])
+			}
+			if real(got[i]) != seq[i] {
+				t.Errorf("unexpected real part for n=%d at %d: got %v, want %v", n, i, real(got[i]), seq[i])
+			}
+		}
+
+		hx := h.Transform(nil, seq)
+		for i, v := range hx {
+			if !scalar.EqualWithinAbs(v, imag(got[i]), tol) {
+				t.Errorf("unexpected Hilbert transform for n=%d at %d: got %v, want %v", n, i, v, imag(got[i]))
+			}
+		}
+		// The transform can be performed in place.
+		h.Transform(seq, seq)
+		if !floats.EqualApprox(seq, hx, tol) {
+			t.Errorf("unexpected in place Hilbert transform for n=%d", n)
+		}
+	}
+}
+
+func TestHilbertTone(t *testing.T) {
+	t.Parallel()
+	const (
+		n     = 256
+		cycle = 10
+		tol   = 1e-10
+	)
+	// An amplitude modulated tone with periodic
+	// modulation whose spectrum lies wholly
+	// within the positive frequencies.
+	seq := make([]float64, n)
+	amp := make([]float64, n)
+	for i := range seq {
+		phase := 2 * math.Pi * float64(i) / n
+		amp[i] = 1 + 0.5*math.Cos(2*phase)
+		seq[i] = amp[i] * math.Cos(cycle*phase+0.3)
+	}
+	z := NewHilbert(n).AnalyticSignal(nil, seq)
+
+	env := Envelope(nil, z)
+	if !floats.EqualApprox(env, amp, tol) {
+		t.Errorf("unexpected envelope:\ngot: %v\nwant:%v", env, amp)
+	}
+
+	phase := InstantaneousPhase(nil, z)
+	for i, got := range phase {
+		want := 2*math.Pi*cycle*float64(i)/n + 0.3
+		if !scalar.EqualWithinAbs(got, want, tol) {
+			t.Errorf("unexpected phase at %d: got %v, want %v", i, got, want)
+			break
+		}
+	}
+
+	freq := InstantaneousFrequency(nil, z)
+	if len(freq) != n-1 {
+		t.Errorf("unexpected frequency length: got %d, want %d", len(freq), n-1)
+	}
+	for i, got := range freq {
+		if !scalar.EqualWithinAbs(got, cycle/float64(n), tol) {
+			t.Errorf("unexpected frequency at %d: got %v, want %v", i, got, cycle/float64(n))
+			break
+		}
+	}
+}
+
+func TestHilbertPanics(t *testing.T) {
+	t.Parallel()
+	h := NewHilbert(8)
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "sequence length", fn: func() { h.AnalyticSignal(nil, make([]float64, 7)) }},
+		{name: "destination length", fn: func() { h.AnalyticSignal(make([]complex128, 7), make([]float64, 8)) }},
+		{name: "transform destination length", fn: func() { h.Transform(make([]float64, 7), make([]float64, 8)) }},
+		{name: "envelope destination length", fn: func() { Envelope(make([]float64, 7), make([]complex128, 8)) }},
+		{name: "phase destination length", fn: func() { InstantaneousPhase(make([]float64, 7), make([]complex128, 8)) }},
+		{name: "frequency destination length", fn: func() { InstantaneousFrequency(make([]float64, 8), make([]complex128, 8)) }},
+		{name: "empty frequency", fn: func() { InstantaneousFrequency(nil, nil) }},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic for %s", test.name)
+				}
+			}()
+			test.fn()
+		}()
+	}
+}
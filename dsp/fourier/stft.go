@@ -0,0 +1,268 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fourier
+
+import "gonum.org/v1/gonum/dsp/window"
+
+// STFT implements a streaming short-time Fourier transform of a real
+// sequence. The sequence is divided into frames of a fixed length that
+// start at multiples of the hop size, and the Fourier coefficients of each
+// frame are computed after multiplying the frame by a window function.
+//
+// Samples are provided to an STFT in blocks of any length by calls to Write,
+// and the coefficients of each frame are retrieved by calls to Next once
+// all the samples of the frame have been written.
+type STFT struct {
+	fft    *FFT
+	hop    int
+	window window.Values
+
+	// buf holds the samples that have been
+	// written from the start of the next frame.
+	// The first covered samples of buf are in
+	// a frame that has already been returned.
+	buf     []float64
+	covered int
+
+	// skip is the number of samples to be
+	// discarded from the gap between frames
+	// when the hop is longer than the frame.
+	skip int
+
+	frame []float64
+}
+
+// NewSTFT returns an STFT for frames of length n separated by hop samples,
+// weighting each frame by the window values in win. If win is nil, the
+// rectangular window is used. NewSTFT will panic if n or hop is not
+// positive, or win is not nil and does not have length n.
+func NewSTFT(n, hop int, win window.Values) *STFT {
+	checkSTFT(n, hop, win)
+	return &STFT{
+		fft:    NewFFT(n),
+		hop:    hop,
+		window: win,
+		frame:  make([]float64, n),
+	}
+}
+
+// checkSTFT panics if the parameters of a short-time Fourier transform are
+// not valid.
+func checkSTFT(n, hop int, win window.Values) {
+	if n <= 0 {
+		panic("fourier: non-positive frame length")
+	}
+	if hop <= 0 {
+		panic("fourier: non-positive hop size")
+	}
+	if win != nil && len(win) != n {
+		panic("fourier: window length mismatch")
+	}
+}
+
+// Len returns the length of the frames.
+func (s *STFT) Len() int { return s.fft.Len() }
+
+// Hop returns the number of samples between the starts of
+// consecutive frames.
+func (s *STFT) Hop() int { return s.hop }
+
+// Reset discards all the samples that have been written to the receiver.
+func (s *STFT) Reset() {
+	s.buf = s.buf[:0]
+	s.covered = 0
+	s.skip = 0
+}
+
+// Write appends the samples in seq to the sequence being transformed.
+func (s *STFT) Write(seq []float64) {
+	k := min(s.skip, len(seq))
+	s.skip -= k
+	s.buf = append(s.buf, seq[k:]...)
+}
+
+// Flush appends zeros to the sequence being transformed so that every
+// sample that has been written and is not in the gap between frames is in a
+// complete frame.
+func (s *STFT) Flush() {
+	if len(s.buf) <= s.covered {
+		return
+	}
+	// Complete the last frame that starts
+	// at or before the last written sample.
+	last := ((len(s.buf) - 1) / s.hop) * s.hop
+	for len(s.buf) < last+s.Len() {
+		s.buf = append(s.buf, 0)
+	}
+}
+
+// Next computes the Fourier coefficients of the next frame of the sequence
+// if all its samples have been written, placing the result in dst and
+// returning it and true. If the frame is not complete, Next returns dst
+// and false. The coefficients are those returned by FFT.Coefficients
+// for the windowed frame.
+//
+// If dst is nil, a new slice is allocated and returned. If dst is not nil
+// and the length of dst does not equal s.Len()/2+1, Next will panic.
+func (s *STFT) Next(dst []complex128) ([]complex128, bool) {
+	n := s.Len()
+	if len(s.buf) < n {
+		return dst, false
+	}
+	if s.window == nil {
+		copy(s.frame, s.buf[:n])
+	} else {
+		s.window.TransformTo(s.frame, s.buf[:n])
+	}
+	dst = s.fft.Coefficients(dst, s.frame)
+
+	// Discard the samples before the next frame.
+	if s.hop <= len(s.buf) {
+		s.buf = s.buf[:copy(s.buf, s.buf[s.hop:])]
+	} else {
+		// The next frame starts after the
+		// written samples, so discard the
+		// samples before it when they are
+		// written.
+		s.skip = s.hop - len(s.buf)
+		s.buf = s.buf[:0]
+	}
+	s.covered = max(n-s.hop, 0)
+	return dst, true
+}
+
+// ISTFT implements a streaming inverse short-time Fourier transform,
+// reconstructing a real sequence from the Fourier coefficients of its
+// windowed frames by weighted overlap-add.
+//
+// Each frame is transformed back to the time domain, multiplied by the
+// window function, and added to the output. The output is normalized by the
+// sum of the squares of the window functions of the frames that overlap
+// each sample, so the sequence is reconstructed exactly from unmodified
+// frames produced by an STFT with the same parameters for any window and hop
+// for which that sum is not zero. This includes every window and hop that
+// satisfy the constant overlap-add (COLA) condition for the squared window,
+// as well as those that do not, such as the symmetric windows of the window
+// package. Samples for which the sum is negligible, less than 1e-12 times
+// the largest squared window value, such as samples that are not in any frame
+// or only at the zero ends of windows, are reconstructed as zero.
+type ISTFT struct {
+	fft    *FFT
+	hop    int
+	window window.Values
+
+	// sum and norm hold the weighted overlap-added
+	// frames and the sum of the squared window
+	// functions for the samples from the start of
+	// the last frame.
+	sum  []float64
+	norm []float64
+
+	// tiny is the largest negligible
+	// value of an element of norm.
+	tiny float64
+
+	frame []float64
+}
+
+// NewISTFT returns an ISTFT for frames of length n separated by hop
+// samples, with frames weighted by the window values in win. If win is nil,
+// the rectangular window is used. NewISTFT will panic if n or hop is not
+// positive, or win is not nil and does not have length n.
+func NewISTFT(n, hop int, win window.Values) *ISTFT {
+	checkSTFT(n, hop, win)
+	maxW2 := 1.0
+	if win != nil {
+		maxW2 = 0
+		for _, w := range win {
+			maxW2 = max(maxW2, w*w)
+		}
+	}
+	return &ISTFT{
+		fft:    NewFFT(n),
+		hop:    hop,
+		window: win,
+		tiny:   1e-12 * maxW2,
+		frame:  make([]float64, n),
+	}
+}
+
+// Len returns the length of the frames.
+func (s *ISTFT) Len() int { return s.fft.Len() }
+
+// Hop returns the number of samples between the starts of
+// consecutive frames.
+func (s *ISTFT) Hop() int { return s.hop }
+
+// Reset discards all the frames that have been written to the receiver.
+func (s *ISTFT) Reset() {
+	s.sum = s.sum[:0]
+	s.norm = s.norm[:0]
+}
+
+// Write adds the frame with the Fourier coefficients in coeff to the
+// reconstructed sequence, and appends to dst the samples that precede the
+// start of the frame and so will not be changed by later frames, returning
+// the result. The first call to Write after creation or a call to Reset or
+// Flush appends no samples.
+//
+// If the length of coeff is not s.Len()/2+1, Write will panic.
+func (s *ISTFT) Write(dst []float64, coeff []complex128) []float64 {
+	n := s.Len()
+	if len(coeff) != n/2+1 {
+		panic("fourier: coefficients length mismatch")
+	}
+	if len(s.sum) != 0 {
+		// Emit the samples before the start of
+		// the new frame, which is hop samples
+		// after the start of the previous frame.
+		dst = s.emit(dst, s.hop)
+	}
+	for len(s.sum) < n {
+		s.sum = append(s.sum, 0)
+		s.norm = append(s.norm, 0)
+	}
+
+	s.fft.Sequence(s.frame, coeff)
+	f := 1 / float64(n)
+	for i, v := range s.frame {
+		w := 1.0
+		if s.window != nil {
+			w = s.window[i]
+		}
+		s.sum[i] += w * v * f
+		s.norm[i] += w * w
+	}
+	return dst
+}
+
+// Flush appends to dst all the samples of the reconstructed sequence that
+// have not yet been returned, returning the result, and resets the receiver.
+func (s *ISTFT) Flush(dst []float64) []float64 {
+	dst = s.emit(dst, len(s.sum))
+	s.Reset()
+	return dst
+}
+
+// emit appends the first k samples to dst and discards them, returning the
+// result. If k is greater than the number of held samples, the samples of
+// the gap before the next frame are appended as zeros.
+func (s *ISTFT) emit(dst []float64, k int) []float64 {
+	m := min(k, len(s.sum))
+	for i, v := range s.sum[:m] {
+		if s.norm[i] > s.tiny {
+			v /= s.norm[i]
+		} else {
+			v = 0
+		}
+		dst = append(dst, v)
+	}
+	for range k - m {
+		dst = append(dst, 0)
+	}
+	s.sum = s.sum[:copy(s.sum, s.sum[m:])]
+	s.norm = s.norm[:copy(s.norm, s.norm[m:])]
+	return dst
+}
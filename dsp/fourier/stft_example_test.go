@@ -0,0 +1,87 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fourier_test
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/dsp/fourier"
+	"gonum.org/v1/gonum/dsp/window"
+)
+
+func ExampleSTFT() {
+	// A signal with a tone of 2 cycles per 16 samples
+	// that changes to 4 cycles per 16 samples.
+	signal := make([]float64, 64)
+	for i := range signal {
+		f := 2.0
+		if i >= 32 {
+			f = 4
+		}
+		signal[i] = math.Sin(2 * math.Pi * f * float64(i) / 16)
+	}
+
+	// Analyze frames of 16 samples every 8 samples using
+	// a Hann window, and reconstruct the signal.
+	const n, hop = 16, 8
+	win := window.NewValues(window.Hann, n)
+	stft := fourier.NewSTFT(n, hop, win)
+	istft := fourier.NewISTFT(n, hop, win)
+
+	var (
+		out    []float64
+		frames int
+	)
+	coeff := make([]complex128, n/2+1)
+	process := func() {
+		for {
+			var ok bool
+			coeff, ok = stft.Next(coeff)
+			if !ok {
+				return
+			}
+			// Find the dominant frequency of the frame.
+			var peak int
+			for i, c := range coeff {
+				if cmplx.Abs(c) > cmplx.Abs(coeff[peak]) {
+					peak = i
+				}
+			}
+			fmt.Printf("frame starting at %2d: peak at %v cycles/frame\n", frames*hop, peak)
+			frames++
+			out = istft.Write(out, coeff)
+		}
+	}
+
+	// Stream the signal in blocks of 10 samples.
+	for i := 0; i < len(signal); i += 10 {
+		stft.Write(signal[i:min(i+10, len(signal))])
+		process()
+	}
+	stft.Flush()
+	process()
+	out = istft.Flush(out)
+
+	// The first and last samples are only at the zero
+	// ends of Hann windows, so cannot be reconstructed.
+	var maxErr float64
+	for i := 1; i < len(signal)-1; i++ {
+		maxErr = math.Max(maxErr, math.Abs(out[i]-signal[i]))
+	}
+	fmt.Printf("maximum reconstruction error: %.0f\n", maxErr)
+
+	// Output:
+	//
+	// frame starting at  0: peak at 2 cycles/frame
+	// frame starting at  8: peak at 2 cycles/frame
+	// frame starting at 16: peak at 2 cycles/frame
+	// frame starting at 24: peak at 3 cycles/frame
+	// frame starting at 32: peak at 4 cycles/frame
+	// frame starting at 40: peak at 4 cycles/frame
+	// frame starting at 48: peak at 4 cycles/frame
+	// maximum reconstruction error: 0
+}
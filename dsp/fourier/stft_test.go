@@ -0,0 +1,163 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fourier
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/dsp/window"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestSTFT(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		name   string
+		n, hop int
+		window func([]float64) []float64
+	}{
+		{name: "rectangular", n: 16, hop: 16},
+		{name: "rectangular overlap", n: 16, hop: 5},
+		{name: "rectangular gap", n: 8, hop: 11},
+		{name: "hann half", n: 32, hop: 16, window: window.Hann},
+		{name: "hann quarter", n: 33, hop: 8, window: window.Hann},
+		{name: "hamming", n: 20, hop: 7, window: window.Hamming},
+		{name: "blackman gap", n: 10, hop: 12, window: window.Blackman},
+		{name: "rectangular single", n: 1, hop: 1},
+	} {
+		var win window.Values
+		if test.window != nil {
+			win = window.NewValues(test.window, test.n)
+		}
+		for _, length := range []int{0, 1, test.n - 1, test.n, 10 * test.n, 10*test.n + 3} {
+			seq := make([]float64, length)
+			for i := range seq {
+				seq[i] = rnd.NormFloat64()
+			}
+
+			// Stream the sequence through the
+			// transforms in blocks of random length.
+			stft := NewSTFT(test.n, test.hop, win)
+			istft := NewISTFT(test.n, test.hop, win)
+			var (
+				frames [][]complex128
+				got    []float64
+			)
+			next := func() {
+				for {
+					coeff, ok := stft.Next(nil)
+					if !ok {
+						return
+					}
+					frames = append(frames, coeff)
+					got = istft.Write(got, coeff)
+				}
+			}
+			for rest := seq; len(rest) != 0; {
+				k := min(rnd.IntN(2*test.n)+1, len(rest))
+				stft.Write(rest[:k])
+				rest = rest[k:]
+				next()
+			}
+			stft.Flush()
+			next()
+			got = istft.Flush(got)
+
+			// Check the frames against the transforms
+			// of the windowed, zero padded sequence.
+			fft := NewFFT(test.n)
+			padded := append(seq, make([]float64, test.n)...)
+			var norm []float64
+			for k, coeff := range frames {
+				start := k * test.hop
+				frame := make([]float64, test.n)
+				copy(frame, padded[start:start+test.n])
+				if win != nil {
+					win.Transform(frame)
+				}
+				want := fft.Coefficients(nil, frame)
+				for i := range want {
+					if !scalar.EqualWithinAbsOrRel(real(coeff[i]), real(want[i]), tol, tol) ||
+						!scalar.EqualWithinAbsOrRel(imag(coeff[i]), imag(want[i]), tol, tol) {
+						t.Errorf("%s length %d: unexpected coefficients for frame %d:\ngot: %v\nwant:%v",
+							test.name, length, k, coeff, want)
+						break
+					}
+				}
+				for len(norm) < start+test.n {
+					norm = append(norm, 0)
+				}
+				for i := range test.n {
+					w := 1.0
+					if win != nil {
+						w = win[i]
+					}
+					norm[start+i] += w * w
+				}
+			}
+
+			// Every sample not in a gap between
+			// frames must be in a frame.
+			for i := range seq {
+				inGap := i%test.hop >= test.n
+				if !inGap && i >= len(norm) {
+					t.Errorf("%s length %d: sample %d not in a frame", test.name, length, i)
+				}
+			}
+
+			// Check the reconstruction.
+			if len(frames) == 0 {
+				if len(got) != 0 {
+					t.Errorf("%s length %d: unexpected reconstruction without frames: %v", test.name, length, got)
+				}
+				continue
+			}
+			if want := (len(frames)-1)*test.hop + test.n; len(got) != want {
+				t.Errorf("%s length %d: unexpected reconstruction length: got %d, want %d", test.name, length, len(got), want)
+				continue
+			}
+			want := make([]float64, len(got))
+			for i := range want {
+				if i < len(norm) && norm[i] > istft.tiny {
+					want[i] = padded[i]
+				}
+			}
+			if !floats.EqualApprox(got, want, tol) {
+				t.Errorf("%s length %d: unexpected reconstruction:\ngot: %v\nwant:%v", test.name, length, got, want)
+			}
+		}
+	}
+}
+
+func TestSTFTPanics(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "zero length", fn: func() { NewSTFT(0, 1, nil) }},
+		{name: "zero hop", fn: func() { NewSTFT(4, 0, nil) }},
+		{name: "window length", fn: func() { NewISTFT(4, 2, make(window.Values, 3)) }},
+		{name: "coefficients length", fn: func() { NewISTFT(4, 2, nil).Write(nil, make([]complex128, 2)) }},
+		{name: "destination length", fn: func() {
+			s := NewSTFT(4, 2, nil)
+			s.Write(make([]float64, 4))
+			s.Next(make([]complex128, 2))
+		}},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic for %s", test.name)
+				}
+			}()
+			test.fn()
+		}()
+	}
+}
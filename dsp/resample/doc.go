@@ -0,0 +1,8 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package resample provides sample rate conversion of signals by rational
+// factors using polyphase FIR filters, and band-limited interpolation of
+// signals at arbitrary positions.
+package resample // import "gonum.org/v1/gonum/dsp/resample"
@@ -0,0 +1,41 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package resample_test
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/gonum/dsp/resample"
+)
+
+func ExampleResample() {
+	// Convert a 10 Hz tone sampled at 200 Hz
+	// to a sample rate of 300 Hz.
+	const (
+		in   = 200
+		out  = 300
+		tone = 10
+	)
+	x := make([]float64, in)
+	for i := range x {
+		x[i] = math.Sin(2 * math.Pi * tone * float64(i) / in)
+	}
+	y := resample.Resample(nil, x, out, in, nil)
+	fmt.Printf("%d samples\n", len(y))
+
+	// Compare with the tone away from the
+	// edges of the signal.
+	var maxErr float64
+	for i := out / 4; i < 3*out/4; i++ {
+		want := math.Sin(2 * math.Pi * tone * float64(i) / out)
+		maxErr = max(maxErr, math.Abs(y[i]-want))
+	}
+	fmt.Printf("max error < 1e-4: %t\n", maxErr < 1e-4)
+
+	// Output:
+	// 300 samples
+	// max error < 1e-4: true
+}
@@ -0,0 +1,86 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package resample
+
+import "math"
+
+// Lowpass returns the n coefficients of a windowed sinc lowpass FIR filter
+// with the given cutoff frequency, in cycles per sample, weighted by the
+// window function win and normalized to have unit gain at zero frequency.
+// The window functions of the dsp/window package may be used for win. If
+// win is nil, the rectangular window is used.
+//
+// Lowpass will panic if n is not positive or cutoff is not in (0, 0.5].
+func Lowpass(n int, cutoff float64, win func([]float64) []float64) []float64 {
+	if n <= 0 {
+		panic("resample: non-positive filter length")
+	}
+	if cutoff <= 0 || 0.5 < cutoff {
+		panic("resample: cutoff frequency out of range")
+	}
+	if n == 1 {
+		// The window functions are degenerate
+		// for a single coefficient.
+		return []float64{1}
+	}
+	h := make([]float64, n)
+	mid := float64(n-1) / 2
+	for i := range h {
+		h[i] = 2 * cutoff * sinc(2*cutoff*(float64(i)-mid))
+	}
+	if win != nil {
+		win(h)
+	}
+	var sum float64
+	for _, v := range h {
+		sum += v
+	}
+	for i := range h {
+		h[i] /= sum
+	}
+	return h
+}
+
+// Filter returns an anti-aliasing lowpass filter for resampling by the
+// rational factor p/q. The filter is a windowed sinc filter with the cutoff
+// frequency at the lower of the input and output Nyquist frequencies, and
+// extends over halfLen zero crossings of the sinc function on each side of
+// its center. The filter is weighted by the window function win, which may be
+// one of the window functions of the dsp/window package. If win is nil, the
+// rectangular window is used.
+//
+// Larger values of halfLen give a sharper transition between the passband and
+// the stopband at the cost of more computation and a longer delay.
+//
+// Filter will panic if p, q or halfLen is not positive.
+func Filter(p, q, halfLen int, win func([]float64) []float64) []float64 {
+	if p <= 0 || q <= 0 {
+		panic("resample: non-positive resampling factor")
+	}
+	if halfLen <= 0 {
+		panic("resample: non-positive filter half length")
+	}
+	g := gcd(p, q)
+	p, q = p/g, q/g
+	m := max(p, q)
+	return Lowpass(2*halfLen*m+1, 0.5/float64(m), win)
+}
+
+// sinc returns the normalized sinc function, sin(πx)/(πx).
+func sinc(x float64) float64 {
+	if x == 0 {
+		return 1
+	}
+	x *= math.Pi
+	return math.Sin(x) / x
+}
+
+// gcd returns the greatest common divisor of the positive integers a and b.
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
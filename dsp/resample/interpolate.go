@@ -0,0 +1,40 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package resample
+
+import "math"
+
+// Lanczos returns the value of the band-limited interpolation of the signal
+// sampled in seq at the fractional sample position x, using the Lanczos
+// kernel with a lobes,
+//
+//	L(x) = sinc(x) sinc(x/a) for |x| < a, and 0 otherwise,
+//
+// which is the sinc function weighted by the central lobe of a wider sinc
+// function. The samples outside seq are taken to be zero. At integer values
+// of x within seq, Lanczos returns the corresponding sample.
+//
+// Lanczos will panic if a is not positive.
+func Lanczos(seq []float64, x float64, a int) float64 {
+	if a <= 0 {
+		panic("resample: non-positive number of lobes")
+	}
+	i := math.Floor(x)
+	if i == x {
+		if 0 <= i && int(i) < len(seq) {
+			return seq[int(i)]
+		}
+		return 0
+	}
+	lo := max(int(i)-a+1, 0)
+	hi := min(int(i)+a, len(seq)-1)
+	fa := float64(a)
+	var sum float64
+	for k := lo; k <= hi; k++ {
+		d := x - float64(k)
+		sum += seq[k] * sinc(d) * sinc(d/fa)
+	}
+	return sum
+}
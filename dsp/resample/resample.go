@@ -0,0 +1,162 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package resample
+
+import "gonum.org/v1/gonum/dsp/window"
+
+// defaultHalfLen is the number of sinc zero crossings on each side of the
+// center of the default anti-aliasing filter.
+const defaultHalfLen = 10
+
+// Resampler implements streaming sample rate conversion by a rational
+// factor p/q using a polyphase FIR filter. Conceptually the input signal is
+// upsampled by p by inserting p-1 zeros between samples, filtered by a lowpass
+// FIR filter, and downsampled by q by keeping every q-th sample. The polyphase
+// implementation only computes the products of filter coefficients with
+// input samples that contribute to the retained output samples.
+//
+// Samples are provided to a Resampler in blocks of any length, and the
+// output samples are returned as soon as all the input samples they depend
+// on have been provided. The output is delayed relative to the input by the
+// delay of the filter, returned by Delay.
+type Resampler struct {
+	p, q int
+
+	// taps holds the polyphase decomposition of the
+	// filter scaled by p, with taps[k][j] = p*h[k+j*p].
+	taps [][]float64
+	// delay is the delay of the filter in
+	// upsampled samples.
+	delay float64
+
+	// buf holds the input samples from which the
+	// next output is calculated, preceded by the
+	// len(taps[0])-1 samples before them.
+	buf []float64
+	// t is the position of the next output sample
+	// in the upsampled signal, relative to the
+	// position of buf[len(taps[0])-1].
+	t int
+}
+
+// NewResampler returns a Resampler that resamples signals by the rational
+// factor p/q using the lowpass FIR filter coefficients in h, which are
+// applied at the upsampled rate. The filter should have unit gain at zero
+// frequency and a cutoff frequency no higher than 0.5/max(p, q) cycles per
+// upsampled sample. If h is nil, the filter returned by Filter with p, q,
+// a half length of 10 and the Blackman window is used. The factor p/q is
+// reduced to its lowest terms.
+//
+// NewResampler will panic if p or q is not positive or h is not nil and
+// empty.
+func NewResampler(p, q int, h []float64) *Resampler {
+	if p <= 0 || q <= 0 {
+		panic("resample: non-positive resampling factor")
+	}
+	if h == nil {
+		h = Filter(p, q, defaultHalfLen, window.Blackman)
+	} else if len(h) == 0 {
+		panic("resample: empty filter")
+	}
+	g := gcd(p, q)
+	p, q = p/g, q/g
+
+	n := (len(h) + p - 1) / p
+	taps := make([][]float64, p)
+	for k := range taps {
+		taps[k] = make([]float64, n)
+		for j := range taps[k] {
+			if i := k + j*p; i < len(h) {
+				taps[k][j] = float64(p) * h[i]
+			}
+		}
+	}
+	r := &Resampler{
+		p:     p,
+		q:     q,
+		taps:  taps,
+		delay: float64(len(h)-1) / 2,
+	}
+	r.Reset()
+	return r
+}
+
+// Factors returns the resampling factor p/q of the receiver in its lowest
+// terms.
+func (r *Resampler) Factors() (p, q int) { return r.p, r.q }
+
+// Delay returns the delay of the output relative to the input, in output
+// samples, due to the filter. The delay is exact for filters that are
+// symmetric about their center, such as those returned by Filter.
+func (r *Resampler) Delay() float64 { return r.delay / float64(r.q) }
+
+// Reset discards all the samples that have been provided to the receiver,
+// returning it to its initial state.
+func (r *Resampler) Reset() {
+	n := len(r.taps[0])
+	r.buf = append(r.buf[:0], make([]float64, n-1)...)
+	r.t = 0
+}
+
+// Process resamples the input samples in src, appending to dst the output
+// samples that can be calculated from all the samples that have been
+// provided, and returning the result. The samples before the first sample
+// provided after creation or a call to Reset are taken to be zero.
+func (r *Resampler) Process(dst, src []float64) []float64 {
+	r.buf = append(r.buf, src...)
+	n := len(r.taps[0])
+	for {
+		// The output depends on the input samples
+		// up to x[t/p] at buf[t/p+n-1].
+		i := r.t/r.p + n - 1
+		if i >= len(r.buf) {
+			break
+		}
+		var y float64
+		for j, h := range r.taps[r.t%r.p] {
+			y += h * r.buf[i-j]
+		}
+		dst = append(dst, y)
+		r.t += r.q
+	}
+
+	// Discard the samples that will not
+	// contribute to later outputs.
+	if d := min(r.t/r.p, len(r.buf)-(n-1)); d > 0 {
+		r.buf = r.buf[:copy(r.buf, r.buf[d:])]
+		r.t -= d * r.p
+	}
+	return dst
+}
+
+// Resample returns the signal in src resampled by the rational factor p/q
+// using the filter h as described for NewResampler, appending the result to
+// dst. Unlike the output of a Resampler, the output of Resample is aligned
+// with the input by compensating for the delay of the filter, so the output
+// sample i corresponds to the input position i*q/p. The number of output
+// samples is ⌈len(src)*p/q⌉.
+//
+// Resample will panic if p or q is not positive or h is not nil and empty.
+func Resample(dst, src []float64, p, q int, h []float64) []float64 {
+	r := NewResampler(p, q, h)
+	p, q = r.p, r.q
+	// Compensate for the delay, rounded to the
+	// nearest upsampled sample, by starting the
+	// output that many upsampled samples late.
+	delay := int(r.delay + 0.5)
+	want := (len(src)*p + q - 1) / q
+	for m := range want {
+		t := m*q + delay
+		i := t / p
+		var y float64
+		for j, h := range r.taps[t%p] {
+			if k := i - j; 0 <= k && k < len(src) {
+				y += h * src[k]
+			}
+		}
+		dst = append(dst, y)
+	}
+	return dst
+}
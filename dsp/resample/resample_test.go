@@ -0,0 +1,230 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package resample
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/dsp/window"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+// upfirdn returns the first n samples of the signal x upsampled by p,
+// filtered by h scaled by p and downsampled by q, calculated directly.
+func upfirdn(x []float64, p, q int, h []float64, n int) []float64 {
+	up := make([]float64, len(x)*p)
+	for i, v := range x {
+		up[i*p] = v
+	}
+	y := make([]float64, n)
+	for m := range y {
+		t := m * q
+		for k, c := range h {
+			if i := t - k; 0 <= i && i < len(up) {
+				y[m] += float64(p) * c * up[i]
+			}
+		}
+	}
+	return y
+}
+
+func TestResampler(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		p, q int
+		h    []float64
+	}{
+		{p: 1, q: 1},
+		{p: 3, q: 2},
+		{p: 2, q: 3},
+		{p: 4, q: 6},
+		{p: 1, q: 5},
+		{p: 7, q: 1},
+		{p: 160, q: 147},
+		{p: 3, q: 2, h: []float64{0.25, 0.5, 0.25}},
+		{p: 2, q: 1, h: []float64{1}},
+	} {
+		x := make([]float64, 200)
+		for i := range x {
+			x[i] = rnd.NormFloat64()
+		}
+		r := NewResampler(test.p, test.q, test.h)
+		p, q := r.Factors()
+		if g := gcd(p, q); g != 1 || p*test.q != q*test.p {
+			t.Errorf("unexpected factors for %d/%d: got %d/%d", test.p, test.q, p, q)
+		}
+
+		var got []float64
+		for rest := x; len(rest) != 0; {
+			k := min(rnd.IntN(20), len(rest))
+			got = r.Process(got, rest[:k])
+			rest = rest[k:]
+		}
+		h := test.h
+		if h == nil {
+			h = Filter(p, q, defaultHalfLen, window.Blackman)
+		}
+		// All outputs at positions up to the last
+		// provided input have been returned.
+		wantLen := (len(x)*p + q - 1) / q
+		if len(got) != wantLen {
+			t.Errorf("unexpected number of outputs for %d/%d: got %d, want %d", p, q, len(got), wantLen)
+			continue
+		}
+		want := upfirdn(x, p, q, h, wantLen)
+		if !floats.EqualApprox(got, want, 1e-12) {
+			t.Errorf("unexpected output for %d/%d:\ngot: %v\nwant:%v", p, q, got, want)
+		}
+
+		// Resetting restarts the stream.
+		r.Reset()
+		again := r.Process(nil, x)
+		if !floats.EqualApprox(again, want, 1e-12) {
+			t.Errorf("unexpected output after reset for %d/%d", p, q)
+		}
+	}
+}
+
+func TestResampleTone(t *testing.T) {
+	t.Parallel()
+	const freq = 0.05 // Cycles per input sample.
+	x := make([]float64, 400)
+	for i := range x {
+		x[i] = math.Sin(2 * math.Pi * freq * float64(i))
+	}
+	for _, test := range []struct {
+		p, q int
+	}{
+		{p: 1, q: 1},
+		{p: 3, q: 2},
+		{p: 2, q: 3},
+		{p: 5, q: 1},
+		{p: 160, q: 147},
+	} {
+		got := Resample(nil, x, test.p, test.q, nil)
+		if want := (len(x)*test.p + test.q - 1) / test.q; len(got) != want {
+			t.Errorf("unexpected length for %d/%d: got %d, want %d", test.p, test.q, len(got), want)
+		}
+		// Check away from the edges of the signal.
+		ratio := float64(test.q) / float64(test.p)
+		for m, v := range got {
+			pos := float64(m) * ratio
+			if pos < 50 || pos > float64(len(x))-50 {
+				continue
+			}
+			want := math.Sin(2 * math.Pi * freq * pos)
+			if math.Abs(v-want) > 1e-3 {
+				t.Errorf("unexpected value for %d/%d at %d: got %v, want %v", test.p, test.q, m, v, want)
+				break
+			}
+		}
+
+		// The streaming output is delayed by Delay.
+		r := NewResampler(test.p, test.q, nil)
+		stream := r.Process(nil, x)
+		for m, v := range stream {
+			pos := (float64(m) - r.Delay()) * ratio
+			if pos < 50 || pos > float64(len(x))-50 {
+				continue
+			}
+			want := math.Sin(2 * math.Pi * freq * pos)
+			if math.Abs(v-want) > 1e-3 {
+				t.Errorf("unexpected streaming value for %d/%d at %d: got %v, want %v", test.p, test.q, m, v, want)
+				break
+			}
+		}
+	}
+
+	// Resampling by one with the default
+	// filter is the identity.
+	if got := Resample(nil, x, 3, 3, nil); !floats.EqualApprox(got, x, 1e-14) {
+		t.Errorf("unexpected result for resampling by one")
+	}
+}
+
+func TestLowpass(t *testing.T) {
+	t.Parallel()
+	for _, n := range []int{1, 2, 21, 64} {
+		for _, cutoff := range []float64{0.05, 0.25, 0.5} {
+			h := Lowpass(n, cutoff, window.Hamming)
+			if sum := floats.Sum(h); !scalar.EqualWithinAbsOrRel(sum, 1, 1e-14, 1e-14) {
+				t.Errorf("unexpected DC gain for n=%d cutoff=%v: got %v, want 1", n, cutoff, sum)
+			}
+			for i := range h {
+				if !scalar.EqualWithinAbsOrRel(h[i], h[n-1-i], 1e-14, 1e-14) {
+					t.Errorf("filter not symmetric for n=%d cutoff=%v", n, cutoff)
+					break
+				}
+			}
+		}
+	}
+
+	// The gain of a long filter at frequencies well
+	// within the stopband is small.
+	h := Lowpass(101, 0.1, window.Blackman)
+	for _, f := range []float64{0.2, 0.3, 0.4, 0.5} {
+		var re, im float64
+		for k, v := range h {
+			re += v * math.Cos(2*math.Pi*f*float64(k))
+			im += v * math.Sin(2*math.Pi*f*float64(k))
+		}
+		if gain := math.Hypot(re, im); gain > 1e-4 {
+			t.Errorf("unexpected stopband gain at %v: %v", f, gain)
+		}
+	}
+}
+
+func TestLanczos(t *testing.T) {
+	t.Parallel()
+	const freq = 0.05
+	x := make([]float64, 200)
+	for i := range x {
+		x[i] = math.Sin(2 * math.Pi * freq * float64(i))
+	}
+	for i, v := range x {
+		if got := Lanczos(x, float64(i), 3); got != v {
+			t.Errorf("unexpected value at sample %d: got %v, want %v", i, got, v)
+		}
+	}
+	for pos := 20.0; pos < 180; pos += 0.37 {
+		got := Lanczos(x, pos, 8)
+		want := math.Sin(2 * math.Pi * freq * pos)
+		if math.Abs(got-want) > 1e-3 {
+			t.Errorf("unexpected value at %v: got %v, want %v", pos, got, want)
+		}
+	}
+	if got := Lanczos(x, -10, 3); got != 0 {
+		t.Errorf("unexpected value outside signal: got %v, want 0", got)
+	}
+}
+
+func TestPanics(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "zero p", fn: func() { NewResampler(0, 1, nil) }},
+		{name: "negative q", fn: func() { NewResampler(1, -1, nil) }},
+		{name: "empty filter", fn: func() { NewResampler(1, 2, []float64{}) }},
+		{name: "zero filter length", fn: func() { Lowpass(0, 0.25, nil) }},
+		{name: "cutoff too high", fn: func() { Lowpass(10, 0.6, nil) }},
+		{name: "zero half length", fn: func() { Filter(1, 2, 0, nil) }},
+		{name: "zero lobes", fn: func() { Lanczos([]float64{1}, 0.5, 0) }},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic for %s", test.name)
+				}
+			}()
+			test.fn()
+		}()
+	}
+}
@@ -240,6 +240,46 @@ func Dot(s1, s2 []float64) float64 {
 	return f64.DotUnitary(s1, s2)
 }
 
+// DotCompensated computes the dot product of s1 and s2 with greater accuracy
+// than Dot at the expense of additional computation. The result is as accurate
+// as if it were computed in twice the working precision and then rounded.
+// It panics if the argument lengths do not match.
+func DotCompensated(s1, s2 []float64) float64 {
+	if len(s1) != len(s2) {
+		panic(badLength)
+	}
+	// DotCompensated uses the Dot2 algorithm of Ogita, Rump and Oishi,
+	// with the error of each product obtained exactly by a fused
+	// multiply-add and the error of each addition by Knuth's TwoSum.
+	// See https://doi.org/10.1137/030601818 for details.
+	var sum, c float64
+	for i, x := range s1 {
+		p := x * s2[i]
+		ep := math.FMA(x, s2[i], -p)
+		t := float64(sum + p)
+		z := float64(t - sum)
+		c += ((sum - (t - z)) + (p - z)) + ep
+		sum = t
+	}
+	return sum + c
+}
+
+// DotPairwise computes the dot product of s1 and s2 using pairwise summation
+// of the products. The rounding error of DotPairwise grows logarithmically
+// with the length of the slices rather than linearly as for Dot, at a small
+// additional cost.
+// It panics if the argument lengths do not match.
+func DotPairwise(s1, s2 []float64) float64 {
+	if len(s1) != len(s2) {
+		panic(badLength)
+	}
+	if len(s1) <= pairwiseBlock {
+		return f64.DotUnitary(s1, s2)
+	}
+	h := len(s1) / 2
+	return DotPairwise(s1[:h], s2[:h]) + DotPairwise(s1[h:], s2[h:])
+}
+
 // Equal returns true when the slices have equal lengths and
 // all elements are numerically identical.
 func Equal(s1, s2 []float64) bool {
@@ -761,10 +801,30 @@ func SubTo(dst, s, t []float64) []float64 {
 }
 
 // Sum returns the sum of the elements of the slice.
+//
+// The rounding error of Sum grows linearly with the length of s. For long
+// slices, SumPairwise or SumCompensated may be used to obtain a more accurate
+// result.
 func Sum(s []float64) float64 {
 	return f64.Sum(s)
 }
 
+// pairwiseBlock is the length of the blocks that are summed
+// directly by pairwise summation.
+const pairwiseBlock = 128
+
+// SumPairwise returns the sum of the elements of the slice calculated using
+// pairwise summation. The rounding error of SumPairwise grows logarithmically
+// with the length of s rather than linearly as for Sum, at a small additional
+// cost. SumCompensated is more accurate but slower.
+func SumPairwise(s []float64) float64 {
+	if len(s) <= pairwiseBlock {
+		return f64.Sum(s)
+	}
+	h := len(s) / 2
+	return SumPairwise(s[:h]) + SumPairwise(s[h:])
+}
+
 // Within returns the first index i where s[i] <= v < s[i+1]. Within panics if:
 //   - len(s) < 2
 //   - s is not sorted
@@ -7,6 +7,7 @@ package floats
 import (
 	"fmt"
 	"math"
+	"math/big"
 	"math/rand/v2"
 	"sort"
 	"strconv"
@@ -1599,6 +1600,99 @@ func TestSumCompensated(t *testing.T) {
 	}
 }
 
+// exactSum returns the correctly rounded sum of the elements of s.
+func exactSum(s []float64) float64 {
+	sum := new(big.Float).SetPrec(2048)
+	for _, v := range s {
+		sum.Add(sum, big.NewFloat(v))
+	}
+	f, _ := sum.Float64()
+	return f
+}
+
+func TestSumPairwise(t *testing.T) {
+	t.Parallel()
+	src := rand.NewPCG(1, 1)
+	for _, n := range []int{0, 1, 5, pairwiseBlock, pairwiseBlock + 1, 1000, 1 << 20} {
+		s := randomSlice(n, src)
+		want := exactSum(s)
+		got := SumPairwise(s)
+		if !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+			t.Errorf("unexpected sum for n = %d: got:%v want:%v", n, got, want)
+		}
+	}
+
+	// Pairwise summation is more accurate than naive
+	// summation for long slices of equal elements.
+	s := make([]float64, 1<<22)
+	for i := range s {
+		s[i] = 0.1
+	}
+	want := exactSum(s)
+	errPairwise := math.Abs(SumPairwise(s) - want)
+	errNaive := math.Abs(Sum(s) - want)
+	if errPairwise > 1e-14*want || errPairwise > errNaive {
+		t.Errorf("unexpected pairwise summation error: got:%v naive:%v", errPairwise, errNaive)
+	}
+}
+
+func TestDotCompensated(t *testing.T) {
+	t.Parallel()
+	for i, test := range []struct {
+		s1, s2 []float64
+		want   float64
+	}{
+		{
+			s1:   []float64{1, 2, 3},
+			s2:   []float64{4, 5, 6},
+			want: 32,
+		},
+		{
+			// Fails if we use simple Dot.
+			s1:   []float64{1e100, 1, -1e100},
+			s2:   []float64{1, 1, 1},
+			want: 1,
+		},
+		{
+			// The products are not exactly representable.
+			s1:   []float64{1 + 0x1p-30, 1 - 0x1p-30, -1},
+			s2:   []float64{1 - 0x1p-30, 1 + 0x1p-30, 2},
+			want: -0x1p-59,
+		},
+		{
+			s1:   nil,
+			s2:   nil,
+			want: 0,
+		},
+	} {
+		got := DotCompensated(test.s1, test.s2)
+		if got != test.want {
+			t.Errorf("Wrong dot product returned in test case %d. Want: %g, got: %g", i, test.want, got)
+		}
+	}
+	if !Panics(func() { DotCompensated(make([]float64, 2), make([]float64, 3)) }) {
+		t.Errorf("Did not panic with length mismatch")
+	}
+}
+
+func TestDotPairwise(t *testing.T) {
+	t.Parallel()
+	src := rand.NewPCG(1, 1)
+	for _, n := range []int{0, 1, 5, pairwiseBlock, pairwiseBlock + 1, 1000, 1 << 18} {
+		s1 := randomSlice(n, src)
+		s2 := randomSlice(n, src)
+		want := DotCompensated(s1, s2)
+		for _, got := range []float64{DotPairwise(s1, s2), Dot(s1, s2)} {
+			if !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+				t.Errorf("unexpected dot product for n = %d: got:%v want:%v", n, got, want)
+			}
+		}
+	}
+	if !Panics(func() { DotPairwise(make([]float64, 2), make([]float64, 3)) }) {
+		t.Errorf("Did not panic with length mismatch")
+	}
+}
+
 func randomSlice(l int, src rand.Source) []float64 {
 	rnd := rand.New(src)
 	s := make([]float64, l)
@@ -1819,6 +1913,20 @@ func BenchmarkSumCompensatedMedium(b *testing.B) { benchmarkSumCompensated(b, Me
 func BenchmarkSumCompensatedLarge(b *testing.B)  { benchmarkSumCompensated(b, Large) }
 func BenchmarkSumCompensatedHuge(b *testing.B)   { benchmarkSumCompensated(b, Huge) }
 
+func benchmarkSumPairwise(b *testing.B, size int) {
+	src := rand.NewPCG(1, 1)
+	s := randomSlice(size, src)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		SumPairwise(s)
+	}
+}
+
+func BenchmarkSumPairwiseSmall(b *testing.B)  { benchmarkSumPairwise(b, Small) }
+func BenchmarkSumPairwiseMedium(b *testing.B) { benchmarkSumPairwise(b, Medium) }
+func BenchmarkSumPairwiseLarge(b *testing.B)  { benchmarkSumPairwise(b, Large) }
+func BenchmarkSumPairwiseHuge(b *testing.B)   { benchmarkSumPairwise(b, Huge) }
+
 func benchmarkSum(b *testing.B, size int) {
 	src := rand.NewPCG(1, 1)
 	s := randomSlice(size, src)
@@ -0,0 +1,221 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package floats
+
+import "math"
+
+// The functions below apply elementary functions over slices. Arguments in
+// the common range are evaluated by branch-free kernels that perform the
+// argument reduction and scaling with integer operations on the bits of the
+// floating point values rather than calls to math.Frexp and math.Ldexp, so
+// that the evaluation of consecutive elements can overlap. Special values and
+// arguments with subnormal results are passed to the math package. Results
+// agree with the math package to within a few ULP.
+
+const (
+	ln2Hi = 6.93147180369123816490e-01 // Low 32 bits are zero.
+	ln2Lo = 1.90821492927058770002e-10
+	log2e = 1.44269504088896338700e+00
+
+	// roundMagic is 1.5×2⁵². Adding it to a float64 of magnitude less
+	// than 2⁵¹ rounds the value to an integer held in the low bits
+	// of the mantissa of the sum.
+	roundMagic = 0x1.8p52
+
+	// expMin and expMax bound the arguments for which expKernel
+	// returns a finite normal result.
+	expMin = -708
+	expMax = 709
+
+	// minNormalBits and infBits are the bit patterns of the smallest
+	// positive normal float64 and of +Inf.
+	minNormalBits = 0x0010000000000000
+	infBits       = 0x7ff0000000000000
+)
+
+// expKernel returns e**x for expMin ≤ x ≤ expMax.
+//
+// The reduction and polynomial are those of the math package,
+// x = k×ln2 + r with |r| ≤ ln2/2, with k found by rounding
+// against roundMagic and 2**k constructed from its bits.
+func expKernel(x float64) float64 {
+	const (
+		p1 = 1.66666666666666657415e-01
+		p2 = -2.77777777770155933842e-03
+		p3 = 6.61375632143793436117e-05
+		p4 = -1.65339022054652515390e-06
+		p5 = 4.13813679705723846039e-08
+	)
+	t := x*log2e + roundMagic
+	k := t - roundMagic
+	hi := x - k*ln2Hi
+	lo := k * ln2Lo
+	r := hi - lo
+	r2 := r * r
+	c := r - r2*(p1+r2*(p2+r2*(p3+r2*(p4+r2*p5))))
+	y := 1 - ((lo - (r*c)/(2-c)) - hi)
+	scale := math.Float64frombits((math.Float64bits(t) - math.Float64bits(roundMagic) + 1023) << 52)
+	return y * scale
+}
+
+// logKernel returns the natural logarithm of x for positive, finite
+// and normal x.
+//
+// The reduction and polynomial are those of the math package,
+// x = 2**k × (1+f) with √2/2 ≤ 1+f < √2, with k and f taken from
+// the bits of x offset by the bits of √2/2.
+func logKernel(x float64) float64 {
+	const (
+		l1 = 6.666666666666735130e-01
+		l2 = 3.999999999940941908e-01
+		l3 = 2.857142874366239149e-01
+		l4 = 2.222219843214978396e-01
+		l5 = 1.818357216161805012e-01
+		l6 = 1.531383769920937332e-01
+		l7 = 1.479819860511658591e-01
+
+		sqrtHalfBits = 0x3fe6a09e667f3bcd
+		fracMask     = 1<<52 - 1
+	)
+	ix := math.Float64bits(x) - sqrtHalfBits
+	k := float64(int64(ix) >> 52)
+	f := math.Float64frombits(ix&fracMask+sqrtHalfBits) - 1
+	s := f / (2 + f)
+	s2 := s * s
+	s4 := s2 * s2
+	t1 := s2 * (l1 + s4*(l3+s4*(l5+s4*l7)))
+	t2 := s4 * (l2 + s4*(l4+s4*l6))
+	r := t1 + t2
+	hfsq := 0.5 * f * f
+	return k*ln2Hi - ((hfsq - (s*(hfsq+r) + k*ln2Lo)) - f)
+}
+
+// isPosNormal returns whether x is positive, finite and normal.
+func isPosNormal(x float64) bool {
+	return math.Float64bits(x)-minNormalBits < infBits-minNormalBits
+}
+
+// ExpTo stores e**s[i] in dst[i] for each element of s and returns dst.
+// dst and s may be the same slice.
+// It panics if the argument lengths do not match.
+func ExpTo(dst, s []float64) []float64 {
+	if len(dst) != len(s) {
+		panic(badDstLength)
+	}
+	for i, x := range s {
+		if expMin <= x && x <= expMax {
+			dst[i] = expKernel(x)
+		} else {
+			dst[i] = math.Exp(x)
+		}
+	}
+	return dst
+}
+
+// Expm1To stores e**s[i] - 1 in dst[i] for each element of s and returns dst.
+// dst and s may be the same slice. The result is accurate even when s[i] is
+// near zero.
+// It panics if the argument lengths do not match.
+func Expm1To(dst, s []float64) []float64 {
+	if len(dst) != len(s) {
+		panic(badDstLength)
+	}
+	for i, x := range s {
+		switch {
+		case math.Abs(x) < 0x1p-54:
+			// Retain the sign of zero.
+			dst[i] = x
+		case math.Abs(x) < 0.5*math.Ln2:
+			// Taylor series to the x¹³ term, truncated
+			// below the precision of the result.
+			p := 1.0 / 6227020800
+			p = p*x + 1.0/479001600
+			p = p*x + 1.0/39916800
+			p = p*x + 1.0/3628800
+			p = p*x + 1.0/362880
+			p = p*x + 1.0/40320
+			p = p*x + 1.0/5040
+			p = p*x + 1.0/720
+			p = p*x + 1.0/120
+			p = p*x + 1.0/24
+			p = p*x + 1.0/6
+			p = p*x + 1.0/2
+			dst[i] = x + x*x*p
+		case expMin <= x && x <= expMax:
+			dst[i] = expKernel(x) - 1
+		default:
+			dst[i] = math.Expm1(x)
+		}
+	}
+	return dst
+}
+
+// LogTo stores the natural logarithm of s[i] in dst[i] for each element of s
+// and returns dst. dst and s may be the same slice.
+// It panics if the argument lengths do not match.
+func LogTo(dst, s []float64) []float64 {
+	if len(dst) != len(s) {
+		panic(badDstLength)
+	}
+	for i, x := range s {
+		if isPosNormal(x) {
+			dst[i] = logKernel(x)
+		} else {
+			dst[i] = math.Log(x)
+		}
+	}
+	return dst
+}
+
+// Log1pTo stores the natural logarithm of 1 plus s[i] in dst[i] for each
+// element of s and returns dst. dst and s may be the same slice. The result
+// is accurate even when s[i] is near zero.
+// It panics if the argument lengths do not match.
+func Log1pTo(dst, s []float64) []float64 {
+	if len(dst) != len(s) {
+		panic(badDstLength)
+	}
+	for i, x := range s {
+		u := 1 + x
+		switch {
+		case u == 1:
+			// Retain the sign of zero.
+			dst[i] = x
+		case x > -1 && isPosNormal(u):
+			// The rounding error in u is corrected by
+			// scaling by x/(u-1) where u-1 is exact.
+			dst[i] = logKernel(u) * (x / (u - 1))
+		default:
+			dst[i] = math.Log1p(x)
+		}
+	}
+	return dst
+}
+
+// SigmoidTo stores the logistic sigmoid 1/(1+e**-s[i]) in dst[i] for each
+// element of s and returns dst. dst and s may be the same slice.
+// It panics if the argument lengths do not match.
+func SigmoidTo(dst, s []float64) []float64 {
+	if len(dst) != len(s) {
+		panic(badDstLength)
+	}
+	for i, x := range s {
+		// Evaluate the exponential of a non-positive argument
+		// so that the result cannot overflow and retains its
+		// relative accuracy for large negative x.
+		var e float64
+		if a := -math.Abs(x); a >= expMin {
+			e = expKernel(a)
+		} else {
+			e = math.Exp(a)
+		}
+		q := 1 / (1 + e)
+		if x < 0 {
+			q *= e
+		}
+		dst[i] = q
+	}
+	return dst
+}
@@ -0,0 +1,123 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package floats
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func sigmoid(x float64) float64 {
+	if x >= 0 {
+		return 1 / (1 + math.Exp(-x))
+	}
+	e := math.Exp(x)
+	return e / (1 + e)
+}
+
+// ulpDiff returns the distance between a and b in units
+// of the last place of b.
+func ulpDiff(a, b float64) float64 {
+	if a == b || math.IsNaN(a) && math.IsNaN(b) {
+		return 0
+	}
+	if math.IsInf(b, 0) || math.IsNaN(a) || math.IsNaN(b) {
+		return math.Inf(1)
+	}
+	ulp := math.Nextafter(math.Abs(b), math.Inf(1)) - math.Abs(b)
+	return math.Abs(a-b) / ulp
+}
+
+func TestTranscendentalTo(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	inputs := func(lo, hi float64, special ...float64) []float64 {
+		s := make([]float64, 10000)
+		for i := range s {
+			s[i] = lo + (hi-lo)*rnd.Float64()
+		}
+		return append(s, special...)
+	}
+	inf := math.Inf(1)
+	nan := math.NaN()
+	negZero := math.Copysign(0, -1)
+	const maxULP = 4
+	for _, test := range []struct {
+		name  string
+		fn    func(dst, s []float64) []float64
+		want  func(float64) float64
+		input []float64
+	}{
+		{name: "Exp", fn: ExpTo, want: math.Exp, input: inputs(-750, 710, 0, negZero, -708, 709, -708.5, 709.5, -745, inf, -inf, nan)},
+		{name: "Exp", fn: ExpTo, want: math.Exp, input: inputs(-1, 1, 1e-20, -1e-300)},
+		{name: "Expm1", fn: Expm1To, want: math.Expm1, input: inputs(-1, 1, 0, negZero, 1e-20, -1e-300, inf, -inf, nan)},
+		{name: "Expm1", fn: Expm1To, want: math.Expm1, input: inputs(-800, 800, 0.5*math.Ln2, -0.5*math.Ln2)},
+		{name: "Log", fn: LogTo, want: math.Log, input: inputs(0, 1e3, 0, negZero, -1, 5e-324, 1e-310, math.MaxFloat64, inf, -inf, nan)},
+		{name: "Log", fn: LogTo, want: math.Log, input: inputs(0.5, 1.5, 1, math.Sqrt2, math.Sqrt2/2)},
+		{name: "Log1p", fn: Log1pTo, want: math.Log1p, input: inputs(-1, 1, 0, negZero, 1e-20, -1, -2, math.MaxFloat64, inf, -inf, nan)},
+		{name: "Log1p", fn: Log1pTo, want: math.Log1p, input: inputs(-1e-3, 1e-3)},
+		{name: "Log1p", fn: Log1pTo, want: math.Log1p, input: inputs(1, 1e10)},
+		{name: "Sigmoid", fn: SigmoidTo, want: sigmoid, input: inputs(-40, 40, 0, negZero, -700, -740, -800, inf, -inf, nan)},
+	} {
+		dst := make([]float64, len(test.input))
+		got := test.fn(dst, test.input)
+		if &got[0] != &dst[0] {
+			t.Errorf("%s: result not stored in dst", test.name)
+		}
+		for i, x := range test.input {
+			want := test.want(x)
+			if d := ulpDiff(got[i], want); d > maxULP || !math.IsNaN(want) && math.Signbit(got[i]) != math.Signbit(want) {
+				t.Errorf("%s: unexpected result for %v: got:%v want:%v (%v ULP)", test.name, x, got[i], want, d)
+			}
+		}
+
+		// The operation may be performed in place.
+		s := append([]float64(nil), test.input...)
+		test.fn(s, s)
+		if !Same(s, got) {
+			t.Errorf("%s: in-place result does not match", test.name)
+		}
+
+		if !Panics(func() { test.fn(make([]float64, 2), make([]float64, 3)) }) {
+			t.Errorf("%s: did not panic with length mismatch", test.name)
+		}
+	}
+}
+
+func benchmarkTranscendental(b *testing.B, fn func(dst, s []float64) []float64, lo, hi float64) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	s := make([]float64, Medium)
+	for i := range s {
+		s[i] = lo + (hi-lo)*rnd.Float64()
+	}
+	dst := make([]float64, len(s))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		fn(dst, s)
+	}
+}
+
+// loop returns a function that applies fn to each element of a slice,
+// for comparison of the kernels with the math package.
+func loop(fn func(float64) float64) func(dst, s []float64) []float64 {
+	return func(dst, s []float64) []float64 {
+		for i, x := range s {
+			dst[i] = fn(x)
+		}
+		return dst
+	}
+}
+
+func BenchmarkExpTo(b *testing.B)       { benchmarkTranscendental(b, ExpTo, -10, 10) }
+func BenchmarkExpLoop(b *testing.B)     { benchmarkTranscendental(b, loop(math.Exp), -10, 10) }
+func BenchmarkExpm1To(b *testing.B)     { benchmarkTranscendental(b, Expm1To, -2, 2) }
+func BenchmarkExpm1Loop(b *testing.B)   { benchmarkTranscendental(b, loop(math.Expm1), -2, 2) }
+func BenchmarkLogTo(b *testing.B)       { benchmarkTranscendental(b, LogTo, 0, 10) }
+func BenchmarkLogLoop(b *testing.B)     { benchmarkTranscendental(b, loop(math.Log), 0, 10) }
+func BenchmarkLog1pTo(b *testing.B)     { benchmarkTranscendental(b, Log1pTo, -0.5, 2) }
+func BenchmarkLog1pLoop(b *testing.B)   { benchmarkTranscendental(b, loop(math.Log1p), -0.5, 2) }
+func BenchmarkSigmoidTo(b *testing.B)   { benchmarkTranscendental(b, SigmoidTo, -10, 10) }
+func BenchmarkSigmoidLoop(b *testing.B) { benchmarkTranscendental(b, loop(sigmoid), -10, 10) }
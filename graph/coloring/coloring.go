@@ -80,7 +80,10 @@ type Terminator interface {
 // algorithm of Brélaz. If the provided terminator is cancelled or times out
 // before completion, the terminator's reason for termination will be returned
 // along with a potentially sub-optimal chromatic number and coloring. If
-// term is nil, DsaturExact will run to completion.
+// term is nil, DsaturExact will run to completion. A time budget may be set
+// by providing a context.Context with a deadline as the terminator, and
+// the optimality gap of a terminated search may be assessed using the
+// bound returned by LowerBound.
 // See Brélaz doi:10.1145/359094.359101 for details of the algorithm.
 func DsaturExact(term Terminator, g graph.Undirected) (k int, colors map[int64]int, err error) {
 	// This is implemented essentially as described in algorithm 1 of
@@ -253,10 +256,19 @@ func dSaturExact(term Terminator, selector *saturationDegree, cand dSaturColorin
 	return ub, best, nil
 }
 
+// LowerBound returns a lower bound on the chromatic number of g, the order
+// of a maximum clique of g, and a maximum clique establishing the bound. A
+// coloring of g with k colors is a minimal coloring. Finding a maximum clique
+// is NP-hard, so LowerBound may be slow for large dense graphs.
+func LowerBound(g graph.Undirected) (k int, clique []graph.Node) {
+	k, clique, _ = maximumClique(g)
+	return k, clique
+}
+
 // maximumClique returns a maximum clique in g and its order.
 func maximumClique(g graph.Undirected) (k int, maxClique []graph.Node, cliques [][]graph.Node) {
 	cliques = topo.BronKerbosch(g)
-	for _, c := range topo.BronKerbosch(g) {
+	for _, c := range cliques {
 		if len(c) > len(maxClique) {
 			maxClique = c
 		}
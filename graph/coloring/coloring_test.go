@@ -507,6 +507,25 @@ func TestDsaturExact(t *testing.T) {
 	}
 }
 
+func TestLowerBound(t *testing.T) {
+	for _, test := range coloringTests {
+		k, clique := LowerBound(test.g)
+		if k > test.colors {
+			t.Errorf("lower bound for %q greater than chromatic number: got:%d want<=%d", test.name, k, test.colors)
+		}
+		if len(clique) != k {
+			t.Errorf("mismatch between clique order and k for %q: |clique|=%d k=%d", test.name, len(clique), k)
+		}
+		for i, u := range clique {
+			for _, v := range clique[:i] {
+				if !test.g.HasEdgeBetween(u.ID(), v.ID()) {
+					t.Errorf("lower bound clique for %q is not a clique: %d and %d not adjacent", test.name, u.ID(), v.ID())
+				}
+			}
+		}
+	}
+}
+
 func TestRandomized(t *testing.T) {
 	for seed := uint64(1); seed <= 1000; seed++ {
 		for _, test := range coloringTests {
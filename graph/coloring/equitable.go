@@ -0,0 +1,79 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package coloring
+
+import (
+	"math/rand/v2"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// Equitable returns an approximate minimal number of colors, k, and a
+// corresponding equitable vertex coloring of g. In an equitable coloring
+// the numbers of nodes with any two colors differ by at most one.
+//
+// Equitable searches for an equitable coloring with k colors using a tabu
+// search that only makes moves that retain the sizes of the color classes,
+// making at most iterations moves for each k. The search starts with k set
+// to the number of colors of a Dsatur coloring, increasing k until an
+// equitable coloring is found and then decreasing k while equitable colorings
+// with fewer colors are found. Since a coloring of n nodes with n colors is
+// always equitable, Equitable always returns a valid equitable coloring. If
+// src is non-nil it will be used as the random source, otherwise the global
+// random source will be used.
+// See Díaz, Rudová and Zabala doi:10.1016/j.endm.2014.08.057 for a
+// discussion of tabu search for equitable coloring.
+func Equitable(g graph.Undirected, iterations int, src rand.Source) (k int, colors map[int64]int) {
+	k, colors, _ = Dsatur(g, nil)
+	if k == 0 {
+		return k, colors
+	}
+
+	s := newTabuSearch(g, src)
+	n := len(s.nodes)
+
+	// Order the nodes by their Dsatur color so
+	// that filling the color classes of the initial
+	// colorings in this order places mostly
+	// non-adjacent nodes in the same class.
+	dsatur := s.colorsFrom(colors)
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int { return dsatur[a] - dsatur[b] })
+
+	cand := make([]int, n)
+	try := func(k int) bool {
+		// The first n%k classes have one
+		// more node than the remainder.
+		q, r := n/k, n%k
+		var c, size int
+		for _, v := range order {
+			cand[v] = c
+			size++
+			if size == q+1 || (size == q && c >= r) {
+				c++
+				size = 0
+			}
+		}
+		s.init(k, cand)
+		return s.search(iterations, true)
+	}
+
+	start := k
+	for !try(k) {
+		k++
+	}
+	best := slices.Clone(s.color)
+	if k == start {
+		for k > 1 && try(k-1) {
+			copy(best, s.color)
+			k--
+		}
+	}
+	return k, s.colorMap(best)
+}
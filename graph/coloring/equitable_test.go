@@ -0,0 +1,91 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package coloring
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// equitableColors holds the equitable chromatic numbers
+// of test graphs that differ from their chromatic numbers.
+var equitableColors = map[string]int{
+	"wheel-7": 4, // The hub must be alone in its class.
+}
+
+func TestEquitable(t *testing.T) {
+	t.Parallel()
+	for _, test := range coloringTests {
+		if test.long && !*runLong {
+			continue
+		}
+		k, colors := Equitable(test.g, 1000, rand.NewPCG(1, 1))
+		if want, ok := equitableColors[test.name]; ok && k != want {
+			t.Errorf("unexpected equitable chromatic number for %q: got:%d want:%d", test.name, k, want)
+		}
+		checkEquitable(t, test.name, test.g, k, colors)
+		if k < test.colors {
+			t.Errorf("equitable chromatic number for %q less than chromatic number: got:%d want>=%d",
+				test.name, k, test.colors)
+		}
+		// By the Hajnal–Szemerédi theorem every graph has an
+		// equitable coloring with one more color than its
+		// maximum degree.
+		var maxDegree int
+		for _, u := range graph.NodesOf(test.g.Nodes()) {
+			maxDegree = max(maxDegree, test.g.From(u.ID()).Len())
+		}
+		if test.g.Nodes().Len() != 0 && k > maxDegree+1 {
+			t.Errorf("equitable chromatic number for %q greater than maximum degree plus one: got:%d max degree:%d",
+				test.name, k, maxDegree)
+		}
+	}
+}
+
+func TestEquitableStar(t *testing.T) {
+	t.Parallel()
+	for n := 1; n <= 12; n++ {
+		g := simple.NewUndirectedGraph()
+		for i := 1; i <= n; i++ {
+			g.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(i)})
+		}
+		k, colors := Equitable(g, 1000, rand.NewPCG(1, 1))
+		// The equitable chromatic number of the star
+		// K_{1,n} is 1+⌈n/2⌉.
+		want := 1 + (n+1)/2
+		if k != want {
+			t.Errorf("unexpected equitable chromatic number for K_{1,%d}: got:%d want:%d", n, k, want)
+		}
+		checkEquitable(t, "star", g, k, colors)
+	}
+}
+
+func checkEquitable(t *testing.T, name string, g graph.Undirected, k int, colors map[int64]int) {
+	t.Helper()
+	sets := Sets(colors)
+	if len(sets) != k {
+		t.Errorf("mismatch between number of color sets and k for %q: |sets|=%d k=%d", name, len(sets), k)
+	}
+	if missing, ok := isCompleteColoring(colors, g); !ok {
+		t.Errorf("incomplete coloring for %q: missing %d\ngot:%v", name, missing, colors)
+	}
+	if xid, yid, ok := isValidColoring(colors, g); !ok {
+		t.Errorf("invalid coloring for %q: %d--%d match color\ncolors:%v", name, xid, yid, colors)
+	}
+	if len(sets) == 0 {
+		return
+	}
+	lo, hi := len(colors), 0
+	for _, s := range sets {
+		lo = min(lo, len(s))
+		hi = max(hi, len(s))
+	}
+	if hi-lo > 1 {
+		t.Errorf("coloring for %q is not equitable: class sizes range from %d to %d\ncolors:%v", name, lo, hi, colors)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package coloring
+
+import (
+	"math/rand/v2"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// IteratedGreedy returns an approximate minimal chromatic number of g and a
+// corresponding vertex coloring using the iterated greedy algorithm of
+// Culberson and Luo. Starting from a Dsatur coloring, each of iterations
+// passes reorders the color classes of the current coloring, by reversing
+// them, by descending size or randomly, and greedily recolors the nodes in
+// the order of their classes. A pass never increases the number of colors
+// used. If src is non-nil it will be used as the random source, otherwise the
+// global random source will be used.
+// See Culberson and Luo, "Exploring the k-colorable landscape with iterated
+// greedy", DIMACS Series in Discrete Mathematics and Theoretical Computer
+// Science 26, 1996, for details of the algorithm.
+func IteratedGreedy(g graph.Undirected, iterations int, src rand.Source) (k int, colors map[int64]int) {
+	k, colors, _ = Dsatur(g, nil)
+	if k <= 1 {
+		return k, colors
+	}
+
+	var rnd *rand.Rand
+	if src == nil {
+		rnd = rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	} else {
+		rnd = rand.New(src)
+	}
+
+	classes := make([][]int64, k)
+	for c, s := range Sets(colors) {
+		classes[c] = s
+	}
+	order := make([]int64, 0, len(colors))
+	for range iterations {
+		switch p := rnd.Float64(); {
+		case p < 0.5:
+			slices.Reverse(classes)
+		case p < 0.8:
+			slices.SortStableFunc(classes, func(a, b []int64) int { return len(b) - len(a) })
+		default:
+			rnd.Shuffle(len(classes), func(i, j int) {
+				classes[i], classes[j] = classes[j], classes[i]
+			})
+		}
+
+		order = order[:0]
+		for _, s := range classes {
+			order = append(order, s...)
+		}
+		clear(colors)
+		k = 0
+		for _, uid := range order {
+			used := colorsOf(g.From(uid), colors)
+			c := 0
+			for used.Has(c) {
+				c++
+			}
+			colors[uid] = c
+			k = max(k, c+1)
+		}
+
+		classes = classes[:k]
+		for c := range classes {
+			classes[c] = classes[c][:0]
+		}
+		for _, uid := range order {
+			c := colors[uid]
+			classes[c] = append(classes[c], uid)
+		}
+	}
+	return k, colors
+}
@@ -0,0 +1,39 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package coloring
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func TestIteratedGreedy(t *testing.T) {
+	t.Parallel()
+	for _, test := range coloringTests {
+		if test.long && !*runLong {
+			continue
+		}
+		k, colors := IteratedGreedy(test.g, 100, rand.NewPCG(1, 1))
+		// The coloring is never worse than the
+		// initial Dsatur coloring.
+		ub := test.colors
+		for c := range test.dsatur {
+			ub = max(ub, c)
+		}
+		if k < test.colors || ub < k {
+			t.Errorf("unexpected chromatic number for %q: got:%d want in [%d,%d]", test.name, k, test.colors, ub)
+		}
+		if s := Sets(colors); len(s) != k {
+			t.Errorf("mismatch between number of color sets and k: |sets|=%d k=%d", len(s), k)
+		}
+		if missing, ok := isCompleteColoring(colors, test.g); !ok {
+			t.Errorf("incomplete coloring for %q: missing %d\ngot:%v", test.name, missing, colors)
+		}
+		if xid, yid, ok := isValidColoring(colors, test.g); !ok {
+			t.Errorf("invalid coloring for %q: %d--%d match color\ncolors:%v",
+				test.name, xid, yid, colors)
+		}
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package coloring
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// Tabu returns an approximate minimal chromatic number of g and a
+// corresponding vertex coloring using the TabuCol local search of Hertz and
+// de Werra with the dynamic tabu tenure of Galinier and Hao. Starting from a
+// Dsatur coloring with k colors, Tabu repeatedly searches for a coloring with
+// k-1 colors, making at most iterations moves in each search, and returns the
+// coloring with the fewest colors that was found. If src is non-nil it will
+// be used as the random source, otherwise the global random source will be
+// used.
+// See Hertz and de Werra doi:10.1007/BF02239976 and Galinier and Hao
+// doi:10.1023/A:1009823419804 for details of the algorithm.
+func Tabu(g graph.Undirected, iterations int, src rand.Source) (k int, colors map[int64]int) {
+	k, colors, _ = Dsatur(g, nil)
+	if k <= 1 {
+		return k, colors
+	}
+
+	s := newTabuSearch(g, src)
+	best := s.colorsFrom(colors)
+	cand := make([]int, len(best))
+	for k > 1 {
+		// Reassign the nodes with the highest color to
+		// random colors to obtain a candidate coloring
+		// with conflicts for the search to resolve.
+		for i, c := range best {
+			if c == k-1 {
+				c = s.rnd.IntN(k - 1)
+			}
+			cand[i] = c
+		}
+		s.init(k-1, cand)
+		if !s.search(iterations, false) {
+			break
+		}
+		copy(best, s.color)
+		k--
+	}
+	return k, s.colorMap(best)
+}
+
+// tabuSearch is the state of a tabu search for a vertex coloring with k
+// colors. Nodes are referred to by their index into nodes.
+type tabuSearch struct {
+	nodes []graph.Node
+	adj   [][]int
+
+	k     int
+	color []int
+	size  []int
+
+	// gamma[v*k+c] is the number of neighbors
+	// of node v that have the color c.
+	gamma []int
+	// tabu[v*k+c] is the iteration until which
+	// moving the node v to the color c is tabu.
+	tabu []int
+
+	// conflicts is the number of edges with
+	// both ends having the same color.
+	conflicts int
+
+	// isAdj is work space for marking the
+	// neighbors of a node.
+	isAdj []bool
+
+	rnd *rand.Rand
+}
+
+// newTabuSearch returns a tabu search state for g using the random source src.
+// If src is nil, the global random source is used.
+func newTabuSearch(g graph.Undirected, src rand.Source) *tabuSearch {
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	indexOf := make(map[int64]int, len(nodes))
+	for i, u := range nodes {
+		indexOf[u.ID()] = i
+	}
+	adj := make([][]int, len(nodes))
+	for i, u := range nodes {
+		to := g.From(u.ID())
+		for to.Next() {
+			if j := indexOf[to.Node().ID()]; j != i {
+				adj[i] = append(adj[i], j)
+			}
+		}
+	}
+	if src == nil {
+		src = rand.NewPCG(rand.Uint64(), rand.Uint64())
+	}
+	return &tabuSearch{
+		nodes: nodes,
+		adj:   adj,
+		color: make([]int, len(nodes)),
+		isAdj: make([]bool, len(nodes)),
+		rnd:   rand.New(src),
+	}
+}
+
+// colorsFrom returns the coloring held in colors indexed by node index.
+func (s *tabuSearch) colorsFrom(colors map[int64]int) []int {
+	c := make([]int, len(s.nodes))
+	for i, u := range s.nodes {
+		c[i] = colors[u.ID()]
+	}
+	return c
+}
+
+// colorMap returns the coloring held in color keyed by node ID.
+func (s *tabuSearch) colorMap(color []int) map[int64]int {
+	colors := make(map[int64]int, len(color))
+	for i, c := range color {
+		colors[s.nodes[i].ID()] = c
+	}
+	return colors
+}
+
+// init sets the search state to the coloring color with k colors.
+func (s *tabuSearch) init(k int, color []int) {
+	n := len(s.nodes)
+	s.k = k
+	copy(s.color, color)
+	s.size = resize(s.size, k)
+	s.gamma = resize(s.gamma, n*k)
+	s.tabu = resize(s.tabu, n*k)
+	s.conflicts = 0
+	for v, c := range s.color {
+		s.size[c]++
+		for _, u := range s.adj[v] {
+			s.gamma[u*k+c]++
+			if s.color[u] == c && u < v {
+				s.conflicts++
+			}
+		}
+	}
+}
+
+// resize returns a zeroed slice of length n, reusing the storage of s if
+// possible.
+func resize(s []int, n int) []int {
+	if cap(s) < n {
+		return make([]int, n)
+	}
+	s = s[:n]
+	for i := range s {
+		s[i] = 0
+	}
+	return s
+}
+
+// delta returns the change in the number of conflicts that would result from
+// moving the node v to the color c.
+func (s *tabuSearch) delta(v, c int) int {
+	return s.gamma[v*s.k+c] - s.gamma[v*s.k+s.color[v]]
+}
+
+// move moves the node v to the color c.
+func (s *tabuSearch) move(v, c int) {
+	k := s.k
+	old := s.color[v]
+	s.conflicts += s.delta(v, c)
+	for _, u := range s.adj[v] {
+		s.gamma[u*k+old]--
+		s.gamma[u*k+c]++
+	}
+	s.size[old]--
+	s.size[c]++
+	s.color[v] = c
+}
+
+// tabuMove is a candidate move of the tabu search. The node v is moved
+// to the color c and, if w is not negative, the node w is moved to the
+// original color of v.
+type tabuMove struct {
+	v, c, w int
+}
+
+// search performs at most iterations moves of a tabu search for a coloring
+// of the nodes without conflicts, returning whether such a coloring was
+// found. If equitable is true, only moves that retain the sizes of the color
+// classes are made, so the search retains the equitability of the initial
+// coloring.
+func (s *tabuSearch) search(iterations int, equitable bool) bool {
+	k := s.k
+	best := s.conflicts
+	var moves []tabuMove
+	for it := 0; it < iterations && s.conflicts > 0; it++ {
+		bestDelta := math.MaxInt
+		moves = moves[:0]
+		consider := func(m tabuMove, d int, isTabu bool) {
+			// A tabu move is only allowed if it
+			// improves on the best coloring found.
+			if isTabu && s.conflicts+d >= best {
+				return
+			}
+			if d < bestDelta {
+				bestDelta = d
+				moves = moves[:0]
+			}
+			if d == bestDelta {
+				moves = append(moves, m)
+			}
+		}
+
+		var conflicting int
+		for v, a := range s.color {
+			if s.gamma[v*k+a] == 0 {
+				continue
+			}
+			conflicting++
+			if !equitable {
+				for c := range k {
+					if c != a {
+						consider(tabuMove{v: v, c: c, w: -1}, s.delta(v, c), s.tabu[v*k+c] > it)
+					}
+				}
+				continue
+			}
+
+			// Moving a node from a larger class to a
+			// smaller class retains the class sizes.
+			for c := range k {
+				if c != a && s.size[c] < s.size[a] {
+					consider(tabuMove{v: v, c: c, w: -1}, s.delta(v, c), s.tabu[v*k+c] > it)
+				}
+			}
+			// Swapping the colors of a pair of nodes
+			// always retains the class sizes.
+			for _, u := range s.adj[v] {
+				s.isAdj[u] = true
+			}
+			for w, b := range s.color {
+				if b == a {
+					continue
+				}
+				d := s.delta(v, b) + s.delta(w, a)
+				if s.isAdj[w] {
+					d -= 2
+				}
+				consider(tabuMove{v: v, c: b, w: w}, d, s.tabu[v*k+b] > it || s.tabu[w*k+a] > it)
+			}
+			for _, u := range s.adj[v] {
+				s.isAdj[u] = false
+			}
+		}
+		if len(moves) == 0 {
+			continue
+		}
+
+		m := moves[s.rnd.IntN(len(moves))]
+		a := s.color[m.v]
+		tenure := s.rnd.IntN(10) + int(0.6*float64(conflicting))
+		s.move(m.v, m.c)
+		s.tabu[m.v*k+a] = it + tenure
+		if m.w >= 0 {
+			s.move(m.w, a)
+			s.tabu[m.w*k+m.c] = it + tenure
+		}
+		best = min(best, s.conflicts)
+	}
+	return s.conflicts == 0
+}
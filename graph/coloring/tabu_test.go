@@ -0,0 +1,43 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package coloring
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+// tabuColors holds the number of colors found by Tabu for test
+// graphs where it does not find the chromatic number.
+var tabuColors = map[string]int{
+	"sudoku problem": 10,
+}
+
+func TestTabu(t *testing.T) {
+	t.Parallel()
+	for _, test := range coloringTests {
+		if test.long && !*runLong {
+			continue
+		}
+		k, colors := Tabu(test.g, 1000, rand.NewPCG(1, 1))
+		want, ok := tabuColors[test.name]
+		if !ok {
+			want = test.colors
+		}
+		if k != want {
+			t.Errorf("unexpected chromatic number for %q: got:%d want:%d", test.name, k, want)
+		}
+		if s := Sets(colors); len(s) != k {
+			t.Errorf("mismatch between number of color sets and k: |sets|=%d k=%d", len(s), k)
+		}
+		if missing, ok := isCompleteColoring(colors, test.g); !ok {
+			t.Errorf("incomplete coloring for %q: missing %d\ngot:%v", test.name, missing, colors)
+		}
+		if xid, yid, ok := isValidColoring(colors, test.g); !ok {
+			t.Errorf("invalid coloring for %q: %d--%d match color\ncolors:%v",
+				test.name, xid, yid, colors)
+		}
+	}
+}
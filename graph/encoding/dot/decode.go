@@ -40,6 +40,33 @@ type PortSetter interface {
 	SetToPort(port, compass string) error
 }
 
+// SubgraphBuilder is implemented by encoding.Builder values that can hold
+// the subgraphs of a DOT graph, including cluster subgraphs.
+//
+// The nodes and edges declared within a subgraph are added to the subgraph
+// returned by NewSubgraph as well as to the graph being unmarshaled, and
+// the attributes declared within the subgraph are set using the attribute
+// setters of the subgraph if it is an AttributeSetters. A subgraph that is
+// a SubgraphBuilder may itself hold subgraphs.
+type SubgraphBuilder interface {
+	// NewSubgraph returns a new subgraph with the given
+	// DOT ID, which is empty for anonymous subgraphs.
+	// NewSubgraph is called once for each distinct
+	// non-empty ID.
+	NewSubgraph(id string) encoding.Builder
+}
+
+// MultiSubgraphBuilder is implemented by encoding.MultiBuilder values that
+// can hold the subgraphs of a DOT graph, including cluster subgraphs. It is
+// used in the same way as a SubgraphBuilder.
+type MultiSubgraphBuilder interface {
+	// NewSubgraph returns a new subgraph with the given
+	// DOT ID, which is empty for anonymous subgraphs.
+	// NewSubgraph is called once for each distinct
+	// non-empty ID.
+	NewSubgraph(id string) encoding.MultiBuilder
+}
+
 // Unmarshal parses the Graphviz DOT-encoded data and stores the result in dst.
 // If the number of graphs encoded in data is not one, an error is returned and
 // dst will hold the first graph in data.
@@ -75,6 +102,93 @@ func UnmarshalMulti(data []byte, dst encoding.MultiBuilder) error {
 	return err
 }
 
+// UnmarshalWeightedMulti parses the Graphviz DOT-encoded data as a weighted
+// multigraph and stores the result in dst. The weight of each line is read
+// from its weight attribute, and lines without a weight attribute are given
+// the Graphviz default weight of 1. Other line attributes are ignored.
+// If the number of graphs encoded in data is not one, an error is returned and
+// dst will hold the first graph in data.
+//
+// Attributes and IDs are unquoted during unmarshalling if appropriate.
+func UnmarshalWeightedMulti(data []byte, dst encoding.WeightedMultiBuilder) error {
+	file, err := dot.ParseBytes(data)
+	if err != nil {
+		return err
+	}
+	b := &weightedMultiBuilder{WeightedMultiBuilder: dst}
+	err = copyMultigraph(b, file.Graphs[0])
+	b.flush()
+	if err == nil && len(file.Graphs) != 1 {
+		err = fmt.Errorf("invalid number of graphs; expected 1, got %d", len(file.Graphs))
+	}
+	return err
+}
+
+// weightedMultiBuilder adapts an encoding.WeightedMultiBuilder to the
+// encoding.MultiBuilder interface used by the multigraph decoder. Lines
+// are held until their attributes have been read and are added to the
+// destination graph by flush.
+type weightedMultiBuilder struct {
+	encoding.WeightedMultiBuilder
+	lines []*weightedLine
+}
+
+// NewLine returns a line with the default weight that is
+// able to read its weight from a DOT attribute.
+func (b *weightedMultiBuilder) NewLine(from, to graph.Node) graph.Line {
+	return &weightedLine{F: from, T: to, W: 1}
+}
+
+// SetLine records the line l to be added to the destination graph.
+func (b *weightedMultiBuilder) SetLine(l graph.Line) {
+	b.lines = append(b.lines, l.(*weightedLine))
+}
+
+// flush adds the recorded lines to the destination graph.
+func (b *weightedMultiBuilder) flush() {
+	for _, l := range b.lines {
+		b.SetWeightedLine(b.NewWeightedLine(l.F, l.T, l.W))
+	}
+	b.lines = nil
+}
+
+// SetDOTID sets the DOT ID of the destination graph if it is a DOTIDSetter.
+func (b *weightedMultiBuilder) SetDOTID(id string) {
+	if dst, ok := b.WeightedMultiBuilder.(DOTIDSetter); ok {
+		dst.SetDOTID(id)
+	}
+}
+
+// DOTAttributeSetters returns the global attribute setters of the
+// destination graph if it is an AttributeSetters.
+func (b *weightedMultiBuilder) DOTAttributeSetters() (graph, node, edge encoding.AttributeSetter) {
+	if dst, ok := b.WeightedMultiBuilder.(AttributeSetters); ok {
+		return dst.DOTAttributeSetters()
+	}
+	return nil, nil, nil
+}
+
+// weightedLine is a line being read by UnmarshalWeightedMulti.
+type weightedLine struct {
+	F, T graph.Node
+	W    float64
+}
+
+func (l *weightedLine) From() graph.Node         { return l.F }
+func (l *weightedLine) To() graph.Node           { return l.T }
+func (l *weightedLine) ReversedLine() graph.Line { return &weightedLine{F: l.T, T: l.F, W: l.W} }
+func (l *weightedLine) ID() int64                { return -1 }
+
+// SetAttribute sets the weight of the line if attr is a weight attribute.
+func (l *weightedLine) SetAttribute(attr encoding.Attribute) error {
+	if attr.Key != "weight" {
+		return nil
+	}
+	var err error
+	l.W, err = strconv.ParseFloat(attr.Value, 64)
+	return err
+}
+
 // copyGraph copies the nodes and edges from the Graphviz AST source graph to
 // the destination graph. Edge direction is maintained if present.
 func copyGraph(dst encoding.Builder, src *ast.Graph) (err error) {
@@ -87,18 +201,7 @@ func copyGraph(dst encoding.Builder, src *ast.Graph) (err error) {
 			panic(e)
 		}
 	}()
-	gen := &simpleGraph{
-		generator: generator{
-			directed: src.Directed,
-			ids:      make(map[string]graph.Node),
-		},
-	}
-	if dst, ok := dst.(DOTIDSetter); ok {
-		dst.SetDOTID(unquoteID(src.ID))
-	}
-	if a, ok := dst.(AttributeSetters); ok {
-		gen.graphAttr, gen.nodeAttr, gen.edgeAttr = a.DOTAttributeSetters()
-	}
+	gen := newSimpleGraph(dst, src.Directed, src.ID)
 	for _, stmt := range src.Stmts {
 		gen.addStmt(dst, stmt)
 	}
@@ -117,18 +220,7 @@ func copyMultigraph(dst encoding.MultiBuilder, src *ast.Graph) (err error) {
 			panic(e)
 		}
 	}()
-	gen := &multiGraph{
-		generator: generator{
-			directed: src.Directed,
-			ids:      make(map[string]graph.Node),
-		},
-	}
-	if dst, ok := dst.(DOTIDSetter); ok {
-		dst.SetDOTID(unquoteID(src.ID))
-	}
-	if a, ok := dst.(AttributeSetters); ok {
-		gen.graphAttr, gen.nodeAttr, gen.edgeAttr = a.DOTAttributeSetters()
-	}
+	gen := newMultiGraph(dst, src.Directed, src.ID)
 	for _, stmt := range src.Stmts {
 		gen.addStmt(dst, stmt)
 	}
@@ -148,22 +240,80 @@ type generator struct {
 	// Stack of start indices into the subgraph node slice. The top element
 	// corresponds to the start index of the active (or inner-most) subgraph.
 	subStart []int
-	// graphAttr, nodeAttr and edgeAttr are global graph attributes.
+
+	// Stack of the scopes of the statements being processed. The bottom
+	// element is the scope of the root graph.
+	scopes []scope
+	// Map from dot AST subgraph ID to the scope of the subgraph held by the
+	// destination graph.
+	subgraphs map[string]scope
+	// newSubgraph returns a new subgraph of the given graph with the given
+	// ID, or nil if the graph can not hold subgraphs.
+	newSubgraph func(parent subgraph, id string) subgraph
+}
+
+// subgraph is the part of encoding.Builder and encoding.MultiBuilder
+// used to hold the nodes of a subgraph.
+type subgraph interface {
+	graph.NodeAdder
+	Node(id int64) graph.Node
+}
+
+// scope is the destination of the nodes, edges and attributes of the
+// statements of a graph or subgraph.
+type scope struct {
+	// dst is the graph or subgraph holding the nodes and
+	// edges of the scope. It is nil for subgraphs that are
+	// not held by the destination graph.
+	dst subgraph
+	// graphAttr, nodeAttr and edgeAttr are the global
+	// attributes of the scope.
 	graphAttr, nodeAttr, edgeAttr encoding.AttributeSetter
+	// attr holds graph attributes given as statements.
+	// It is nil if these attributes are ignored.
+	attr encoding.AttributeSetter
+}
+
+// newScope returns the scope of the subgraph dst.
+func newScope(dst subgraph) scope {
+	s := scope{dst: dst}
+	if a, ok := dst.(AttributeSetters); ok {
+		s.graphAttr, s.nodeAttr, s.edgeAttr = a.DOTAttributeSetters()
+		s.attr = s.graphAttr
+	}
+	return s
+}
+
+// init initializes the generator to add nodes and edges to dst and sets the
+// DOT ID of dst if possible.
+func (gen *generator) init(dst subgraph, directed bool, id string) {
+	gen.directed = directed
+	gen.ids = make(map[string]graph.Node)
+	gen.subgraphs = make(map[string]scope)
+	if dst, ok := dst.(DOTIDSetter); ok {
+		dst.SetDOTID(unquoteID(id))
+	}
+	gen.scopes = []scope{newScope(dst)}
 }
 
 // node returns the Gonum node corresponding to the given dot AST node ID,
-// generating a new such node if none exist.
+// generating a new such node if none exist, and adds it to the active
+// subgraphs.
 func (gen *generator) node(dst graph.NodeAdder, id string) graph.Node {
-	if n, ok := gen.ids[id]; ok {
-		return n
+	n, ok := gen.ids[id]
+	if !ok {
+		n = dst.NewNode()
+		if n, ok := n.(DOTIDSetter); ok {
+			n.SetDOTID(unquoteID(id))
+		}
+		dst.AddNode(n)
+		gen.ids[id] = n
 	}
-	n := dst.NewNode()
-	if n, ok := n.(DOTIDSetter); ok {
-		n.SetDOTID(unquoteID(id))
+	for _, s := range gen.scopes[1:] {
+		if s.dst != nil && s.dst.Node(n.ID()) == nil {
+			s.dst.AddNode(n)
+		}
 	}
-	dst.AddNode(n)
-	gen.ids[id] = n
 	// Check if within the context of a subgraph, that is to be used as a vertex
 	// of an edge.
 	if gen.isInSubgraph() {
@@ -174,67 +324,138 @@ func (gen *generator) node(dst graph.NodeAdder, id string) graph.Node {
 	return n
 }
 
+// scope returns the active scope.
+func (gen *generator) scope() scope {
+	return gen.scopes[len(gen.scopes)-1]
+}
+
+// enterSubgraph makes the subgraph with the given dot AST ID the active
+// scope. Anonymous subgraphs used as a vertex of an edge are not held by
+// the destination graph. Subgraphs that are not held by the destination
+// graph share the global attributes of the enclosing scope.
+func (gen *generator) enterSubgraph(id string, isVertex bool) {
+	parent := gen.scope()
+	s := parent
+	s.dst = nil
+	s.attr = nil
+	if sub, ok := gen.subgraphs[id]; ok && id != "" {
+		s = sub
+	} else if id != "" || !isVertex {
+		if parent.dst != nil {
+			if dst := gen.newSubgraph(parent.dst, unquoteID(id)); dst != nil {
+				s = newScope(dst)
+				if id != "" {
+					gen.subgraphs[id] = s
+				}
+			}
+		}
+	}
+	gen.scopes = append(gen.scopes, s)
+}
+
+// leaveSubgraph makes the enclosing scope of the active subgraph the active
+// scope.
+func (gen *generator) leaveSubgraph() {
+	gen.scopes = gen.scopes[:len(gen.scopes)-1]
+}
+
+// addNodeStmt adds the given node statement to the graph.
+func (gen *generator) addNodeStmt(dst graph.NodeAdder, stmt *ast.NodeStmt) {
+	n, ok := gen.node(dst, stmt.Node.ID).(encoding.AttributeSetter)
+	if !ok {
+		return
+	}
+	for _, attr := range stmt.Attrs {
+		a := encoding.Attribute{
+			Key:   unquoteID(attr.Key),
+			Value: unquoteID(attr.Val),
+		}
+		if err := n.SetAttribute(a); err != nil {
+			panic(fmt.Errorf("unable to unmarshal node DOT attribute (%s=%s): %v", a.Key, a.Value, err))
+		}
+	}
+}
+
+// addAttrStmt sets the global attributes of the active scope.
+func (gen *generator) addAttrStmt(stmt *ast.AttrStmt) {
+	s := gen.scope()
+	var n encoding.AttributeSetter
+	var dst string
+	switch stmt.Kind {
+	case ast.GraphKind:
+		n = s.graphAttr
+		dst = "graph"
+	case ast.NodeKind:
+		n = s.nodeAttr
+		dst = "node"
+	case ast.EdgeKind:
+		n = s.edgeAttr
+		dst = "edge"
+	default:
+		panic("unreachable")
+	}
+	if n == nil {
+		return
+	}
+	for _, attr := range stmt.Attrs {
+		a := encoding.Attribute{
+			Key:   unquoteID(attr.Key),
+			Value: unquoteID(attr.Val),
+		}
+		if err := n.SetAttribute(a); err != nil {
+			panic(fmt.Errorf("unable to unmarshal global %s DOT attribute (%s=%s): %v", dst, a.Key, a.Value, err))
+		}
+	}
+}
+
+// addAttr sets a graph attribute of the active scope.
+func (gen *generator) addAttr(attr *ast.Attr) {
+	n := gen.scope().attr
+	if n == nil {
+		return
+	}
+	a := encoding.Attribute{
+		Key:   unquoteID(attr.Key),
+		Value: unquoteID(attr.Val),
+	}
+	if err := n.SetAttribute(a); err != nil {
+		panic(fmt.Errorf("unable to unmarshal graph DOT attribute (%s=%s): %v", a.Key, a.Value, err))
+	}
+}
+
 type simpleGraph struct{ generator }
 
+// newSimpleGraph returns a generator for adding the nodes and edges of a
+// DOT graph to dst.
+func newSimpleGraph(dst encoding.Builder, directed bool, id string) *simpleGraph {
+	gen := &simpleGraph{}
+	gen.init(dst, directed, id)
+	gen.newSubgraph = func(parent subgraph, id string) subgraph {
+		if p, ok := parent.(SubgraphBuilder); ok {
+			return p.NewSubgraph(id)
+		}
+		return nil
+	}
+	return gen
+}
+
 // addStmt adds the given statement to the graph.
 func (gen *simpleGraph) addStmt(dst encoding.Builder, stmt ast.Stmt) {
 	switch stmt := stmt.(type) {
 	case *ast.NodeStmt:
-		n, ok := gen.node(dst, stmt.Node.ID).(encoding.AttributeSetter)
-		if !ok {
-			return
-		}
-		for _, attr := range stmt.Attrs {
-			a := encoding.Attribute{
-				Key:   unquoteID(attr.Key),
-				Value: unquoteID(attr.Val),
-			}
-			if err := n.SetAttribute(a); err != nil {
-				panic(fmt.Errorf("unable to unmarshal node DOT attribute (%s=%s): %v", a.Key, a.Value, err))
-			}
-		}
+		gen.addNodeStmt(dst, stmt)
 	case *ast.EdgeStmt:
 		gen.addEdgeStmt(dst, stmt)
 	case *ast.AttrStmt:
-		var n encoding.AttributeSetter
-		var dst string
-		switch stmt.Kind {
-		case ast.GraphKind:
-			if gen.graphAttr == nil {
-				return
-			}
-			n = gen.graphAttr
-			dst = "graph"
-		case ast.NodeKind:
-			if gen.nodeAttr == nil {
-				return
-			}
-			n = gen.nodeAttr
-			dst = "node"
-		case ast.EdgeKind:
-			if gen.edgeAttr == nil {
-				return
-			}
-			n = gen.edgeAttr
-			dst = "edge"
-		default:
-			panic("unreachable")
-		}
-		for _, attr := range stmt.Attrs {
-			a := encoding.Attribute{
-				Key:   unquoteID(attr.Key),
-				Value: unquoteID(attr.Val),
-			}
-			if err := n.SetAttribute(a); err != nil {
-				panic(fmt.Errorf("unable to unmarshal global %s DOT attribute (%s=%s): %v", dst, a.Key, a.Value, err))
-			}
-		}
+		gen.addAttrStmt(stmt)
 	case *ast.Attr:
-		// ignore.
+		gen.addAttr(stmt)
 	case *ast.Subgraph:
+		gen.enterSubgraph(stmt.ID, false)
 		for _, stmt := range stmt.Stmts {
 			gen.addStmt(dst, stmt)
 		}
+		gen.leaveSubgraph()
 	default:
 		panic(fmt.Sprintf("unknown statement type %T", stmt))
 	}
@@ -288,13 +509,23 @@ func (gen *simpleGraph) addEdgeStmt(dst encoding.Builder, stmt *ast.EdgeStmt) {
 	for _, f := range fs {
 		for _, t := range ts {
 			edge := dst.NewEdge(f, t)
-			dst.SetEdge(edge)
+			gen.setEdge(dst, edge)
 			applyPortsToEdge(stmt.From, stmt.To, edge)
 			addEdgeAttrs(edge, stmt.Attrs)
 		}
 	}
 }
 
+// setEdge adds the edge to the graph and the active subgraphs.
+func (gen *simpleGraph) setEdge(dst encoding.Builder, e graph.Edge) {
+	dst.SetEdge(e)
+	for _, s := range gen.scopes[1:] {
+		if s.dst != nil {
+			s.dst.(encoding.Builder).SetEdge(e)
+		}
+	}
+}
+
 // addVertex adds the given vertex to the graph, and returns its set of nodes.
 func (gen *simpleGraph) addVertex(dst encoding.Builder, v ast.Vertex) []graph.Node {
 	switch v := v.(type) {
@@ -303,9 +534,11 @@ func (gen *simpleGraph) addVertex(dst encoding.Builder, v ast.Vertex) []graph.No
 		return []graph.Node{n}
 	case *ast.Subgraph:
 		gen.pushSubgraph()
+		gen.enterSubgraph(v.ID, true)
 		for _, stmt := range v.Stmts {
 			gen.addStmt(dst, stmt)
 		}
+		gen.leaveSubgraph()
 		return gen.popSubgraph()
 	default:
 		panic(fmt.Sprintf("unknown vertex type %T", v))
@@ -323,7 +556,7 @@ func (gen *simpleGraph) addEdge(dst encoding.Builder, to *ast.Edge, attrs []*ast
 		for _, f := range fs {
 			for _, t := range ts {
 				edge := dst.NewEdge(f, t)
-				dst.SetEdge(edge)
+				gen.setEdge(dst, edge)
 				applyPortsToEdge(to.Vertex, to.To, edge)
 				addEdgeAttrs(edge, attrs)
 			}
@@ -385,65 +618,37 @@ func (gen *generator) appendSubgraphNode(n graph.Node) {
 
 type multiGraph struct{ generator }
 
+// newMultiGraph returns a generator for adding the nodes and lines of a
+// DOT graph to dst.
+func newMultiGraph(dst encoding.MultiBuilder, directed bool, id string) *multiGraph {
+	gen := &multiGraph{}
+	gen.init(dst, directed, id)
+	gen.newSubgraph = func(parent subgraph, id string) subgraph {
+		if p, ok := parent.(MultiSubgraphBuilder); ok {
+			return p.NewSubgraph(id)
+		}
+		return nil
+	}
+	return gen
+}
+
 // addStmt adds the given statement to the multigraph.
 func (gen *multiGraph) addStmt(dst encoding.MultiBuilder, stmt ast.Stmt) {
 	switch stmt := stmt.(type) {
 	case *ast.NodeStmt:
-		n, ok := gen.node(dst, stmt.Node.ID).(encoding.AttributeSetter)
-		if !ok {
-			return
-		}
-		for _, attr := range stmt.Attrs {
-			a := encoding.Attribute{
-				Key:   unquoteID(attr.Key),
-				Value: unquoteID(attr.Val),
-			}
-			if err := n.SetAttribute(a); err != nil {
-				panic(fmt.Errorf("unable to unmarshal node DOT attribute (%s=%s): %v", a.Key, a.Value, err))
-			}
-		}
+		gen.addNodeStmt(dst, stmt)
 	case *ast.EdgeStmt:
 		gen.addEdgeStmt(dst, stmt)
 	case *ast.AttrStmt:
-		var n encoding.AttributeSetter
-		var dst string
-		switch stmt.Kind {
-		case ast.GraphKind:
-			if gen.graphAttr == nil {
-				return
-			}
-			n = gen.graphAttr
-			dst = "graph"
-		case ast.NodeKind:
-			if gen.nodeAttr == nil {
-				return
-			}
-			n = gen.nodeAttr
-			dst = "node"
-		case ast.EdgeKind:
-			if gen.edgeAttr == nil {
-				return
-			}
-			n = gen.edgeAttr
-			dst = "edge"
-		default:
-			panic("unreachable")
-		}
-		for _, attr := range stmt.Attrs {
-			a := encoding.Attribute{
-				Key:   unquoteID(attr.Key),
-				Value: unquoteID(attr.Val),
-			}
-			if err := n.SetAttribute(a); err != nil {
-				panic(fmt.Errorf("unable to unmarshal global %s DOT attribute (%s=%s): %v", dst, a.Key, a.Value, err))
-			}
-		}
+		gen.addAttrStmt(stmt)
 	case *ast.Attr:
-		// ignore.
+		gen.addAttr(stmt)
 	case *ast.Subgraph:
+		gen.enterSubgraph(stmt.ID, false)
 		for _, stmt := range stmt.Stmts {
 			gen.addStmt(dst, stmt)
 		}
+		gen.leaveSubgraph()
 	default:
 		panic(fmt.Sprintf("unknown statement type %T", stmt))
 	}
@@ -456,13 +661,23 @@ func (gen *multiGraph) addEdgeStmt(dst encoding.MultiBuilder, stmt *ast.EdgeStmt
 	for _, f := range fs {
 		for _, t := range ts {
 			edge := dst.NewLine(f, t)
-			dst.SetLine(edge)
+			gen.setLine(dst, edge)
 			applyPortsToEdge(stmt.From, stmt.To, edge)
 			addEdgeAttrs(edge, stmt.Attrs)
 		}
 	}
 }
 
+// setLine adds the line to the multigraph and the active subgraphs.
+func (gen *multiGraph) setLine(dst encoding.MultiBuilder, l graph.Line) {
+	dst.SetLine(l)
+	for _, s := range gen.scopes[1:] {
+		if s.dst != nil {
+			s.dst.(encoding.MultiBuilder).SetLine(l)
+		}
+	}
+}
+
 // addVertex adds the given vertex to the multigraph, and returns its set of nodes.
 func (gen *multiGraph) addVertex(dst encoding.MultiBuilder, v ast.Vertex) []graph.Node {
 	switch v := v.(type) {
@@ -471,9 +686,11 @@ func (gen *multiGraph) addVertex(dst encoding.MultiBuilder, v ast.Vertex) []grap
 		return []graph.Node{n}
 	case *ast.Subgraph:
 		gen.pushSubgraph()
+		gen.enterSubgraph(v.ID, true)
 		for _, stmt := range v.Stmts {
 			gen.addStmt(dst, stmt)
 		}
+		gen.leaveSubgraph()
 		return gen.popSubgraph()
 	default:
 		panic(fmt.Sprintf("unknown vertex type %T", v))
@@ -491,7 +708,7 @@ func (gen *multiGraph) addLine(dst encoding.MultiBuilder, to *ast.Edge, attrs []
 		for _, f := range fs {
 			for _, t := range ts {
 				edge := dst.NewLine(f, t)
-				dst.SetLine(edge)
+				gen.setLine(dst, edge)
 				applyPortsToEdge(to.Vertex, to.To, edge)
 				addEdgeAttrs(edge, attrs)
 			}
@@ -518,20 +735,34 @@ func addEdgeAttrs(edge basicEdge, attrs []*ast.Attr) {
 }
 
 // unquoteID unquotes the given string if needed in the context of an ID. If s
-// is not already quoted the original string is returned.
+// is not quoted the original string is returned.
+//
+// As in the DOT language, the only escape sequence in quoted strings is \"
+// for a double quote, so other backslashes are kept for interpretation of
+// the string as a Graphviz escString.
 func unquoteID(s string) string {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return s
+	}
+	t := s[1 : len(s)-1]
 	// To make round-trips idempotent, don't unquote quoted HTML-like strings
 	//
 	//    /^"<.*>"$/
-	if len(s) >= 4 && strings.HasPrefix(s, `"<`) && strings.HasSuffix(s, `>"`) {
+	if isHTMLID(t) {
 		return s
 	}
-	// Unquote quoted string if possible.
-	if t, err := strconv.Unquote(s); err == nil {
+	if !strings.Contains(t, `\"`) {
 		return t
 	}
-	// On error, either s is not quoted or s is quoted but contains invalid
-	// characters, in both cases we return the original string rather than
-	// panicking.
-	return s
+	var buf strings.Builder
+	for i := 0; i < len(t); i++ {
+		if t[i] == '\\' && i+1 < len(t) {
+			i++
+			if t[i] != '"' {
+				buf.WriteByte('\\')
+			}
+		}
+		buf.WriteByte(t[i])
+	}
+	return buf.String()
 }
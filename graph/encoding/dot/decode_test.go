@@ -5,7 +5,9 @@
 package dot
 
 import (
+	"bytes"
 	"fmt"
+	"slices"
 	"testing"
 
 	"gonum.org/v1/gonum/graph"
@@ -464,6 +466,136 @@ func TestMultigraphLineIDsharing(t *testing.T) {
 	}
 }
 
+func TestWeightedMultigraphRoundTrip(t *testing.T) {
+	for i, test := range []struct {
+		directed bool
+		lines    []multi.WeightedLine
+		expected string
+	}{
+		{
+			directed: true,
+			lines: []multi.WeightedLine{
+				{F: multi.Node(0), T: multi.Node(1), W: 1.5, UID: 0},
+				{F: multi.Node(0), T: multi.Node(1), W: -2, UID: 1},
+				{F: multi.Node(0), T: multi.Node(2), W: 1e-20, UID: 0},
+				{F: multi.Node(2), T: multi.Node(0), W: 3, UID: 0},
+			},
+			expected: directedWeightedMultigraph,
+		},
+		{
+			directed: false,
+			lines: []multi.WeightedLine{
+				{F: multi.Node(0), T: multi.Node(1), W: 1.5, UID: 0},
+				{F: multi.Node(0), T: multi.Node(1), W: -2, UID: 1},
+				{F: multi.Node(0), T: multi.Node(2), W: 1e-20, UID: 0},
+				{F: multi.Node(0), T: multi.Node(2), W: 3, UID: 1},
+			},
+			expected: undirectedWeightedMultigraph,
+		},
+	} {
+		newGraph := func() encoding.WeightedMultiBuilder {
+			if test.directed {
+				return multi.NewWeightedDirectedGraph()
+			}
+			return multi.NewWeightedUndirectedGraph()
+		}
+
+		src := newGraph()
+		for _, l := range test.lines {
+			src.SetWeightedLine(l)
+		}
+		buf, err := MarshalMulti(src, "", "", "\t")
+		if err != nil {
+			t.Errorf("i=%d: unable to marshal graph; %v", i, err)
+			continue
+		}
+		if got := string(buf); got != test.expected {
+			t.Errorf("i=%d: graph content mismatch; want:\n%s\n\nactual:\n%s", i, test.expected, got)
+			continue
+		}
+
+		dst := newGraph()
+		if err := UnmarshalWeightedMulti(buf, dst); err != nil {
+			t.Errorf("i=%d: unable to unmarshal DOT graph; %v", i, err)
+			continue
+		}
+		for _, l := range test.lines {
+			var got []float64
+			for _, dl := range graph.WeightedLinesOf(dst.(graph.WeightedMultigraph).WeightedLines(l.F.ID(), l.T.ID())) {
+				got = append(got, dl.Weight())
+			}
+			var want []float64
+			for _, sl := range graph.WeightedLinesOf(src.(graph.WeightedMultigraph).WeightedLines(l.F.ID(), l.T.ID())) {
+				want = append(want, sl.Weight())
+			}
+			slices.Sort(got)
+			slices.Sort(want)
+			if !slices.Equal(got, want) {
+				t.Errorf("i=%d: unexpected line weights between %d and %d: got %v want %v", i, l.F.ID(), l.T.ID(), got, want)
+			}
+		}
+		buf, err = MarshalMulti(dst, "", "", "\t")
+		if err != nil {
+			t.Errorf("i=%d: unable to marshal graph; %v", i, err)
+			continue
+		}
+		if got := string(buf); got != test.expected {
+			t.Errorf("i=%d: round trip graph content mismatch; want:\n%s\n\nactual:\n%s", i, test.expected, got)
+		}
+	}
+}
+
+func TestUnmarshalWeightedMultiDefaultWeight(t *testing.T) {
+	dst := multi.NewWeightedDirectedGraph()
+	err := UnmarshalWeightedMulti([]byte(`digraph {
+	a -> b [color=red];
+	a -> b [weight=2.5 color=blue];
+}`), dst)
+	if err != nil {
+		t.Fatalf("unable to unmarshal DOT graph; %v", err)
+	}
+	var got []float64
+	for _, l := range graph.WeightedLinesOf(dst.WeightedLines(0, 1)) {
+		got = append(got, l.Weight())
+	}
+	slices.Sort(got)
+	want := []float64{1, 2.5}
+	if !slices.Equal(got, want) {
+		t.Errorf("unexpected line weights: got %v want %v", got, want)
+	}
+
+	err = UnmarshalWeightedMulti([]byte(`digraph { a -> b [weight=heavy]; }`), multi.NewWeightedDirectedGraph())
+	if err == nil {
+		t.Error("expected error for invalid weight")
+	}
+}
+
+const directedWeightedMultigraph = `digraph {
+	// Node definitions.
+	0;
+	1;
+	2;
+
+	// Edge definitions.
+	0 -> 1 [weight=1.5];
+	0 -> 1 [weight=-2];
+	0 -> 2 [weight="1e-20"];
+	2 -> 0 [weight=3];
+}`
+
+const undirectedWeightedMultigraph = `graph {
+	// Node definitions.
+	0;
+	1;
+	2;
+
+	// Edge definitions.
+	0 -- 1 [weight=1.5];
+	0 -- 1 [weight=-2];
+	0 -- 2 [weight="1e-20"];
+	0 -- 2 [weight=3];
+}`
+
 const directedMultigraph = `digraph {
 	// Node definitions.
 	0;
@@ -514,6 +646,180 @@ const undirectedSelfLoopMultigraph = `graph {
 	1 -- 1;
 }`
 
+func TestSubgraphRoundTrip(t *testing.T) {
+	const src = `graph G {
+	rankdir=LR;
+	subgraph cluster_a {
+		label="A \"x\"";
+		a -- b;
+		subgraph cluster_inner { c [label=<<b>bold</b> text>] }
+	}
+	subgraph cluster_b { label="left\lright\l"; d -- e }
+	b -- d;
+	{ rank=same; a; e }
+}`
+	dst := newDotStructuredGraph("")
+	err := Unmarshal([]byte(src), dst)
+	if err != nil {
+		t.Fatalf("unable to unmarshal DOT graph: %v", err)
+	}
+	if got, want := dst.Nodes().Len(), 5; got != want {
+		t.Errorf("unexpected number of nodes: got:%d want:%d", got, want)
+	}
+	for _, test := range []struct {
+		path  []int
+		id    string
+		nodes int
+		edges int
+		attr  encoding.Attribute
+	}{
+		{path: []int{0}, id: "cluster_a", nodes: 3, edges: 1, attr: encoding.Attribute{Key: "label", Value: `A "x"`}},
+		{path: []int{0, 0}, id: "cluster_inner", nodes: 1, edges: 0},
+		{path: []int{1}, id: "cluster_b", nodes: 2, edges: 1, attr: encoding.Attribute{Key: "label", Value: `left\lright\l`}},
+		{path: []int{2}, id: "", nodes: 2, edges: 0, attr: encoding.Attribute{Key: "rank", Value: "same"}},
+	} {
+		g := dst
+		for _, i := range test.path {
+			g = g.subgraphs[i].(*dotStructuredGraph)
+		}
+		if g.DOTID() != test.id {
+			t.Errorf("unexpected subgraph ID at %v: got:%q want:%q", test.path, g.DOTID(), test.id)
+		}
+		if got := g.Nodes().Len(); got != test.nodes {
+			t.Errorf("unexpected number of nodes in subgraph %q: got:%d want:%d", test.id, got, test.nodes)
+		}
+		if got := g.Edges().Len(); got != test.edges {
+			t.Errorf("unexpected number of edges in subgraph %q: got:%d want:%d", test.id, got, test.edges)
+		}
+		if test.attr != (encoding.Attribute{}) {
+			attrs := g.graph.Attributes()
+			if !slices.Contains(attrs, test.attr) {
+				t.Errorf("missing attribute in subgraph %q: got:%v want:%v", test.id, attrs, test.attr)
+			}
+		}
+	}
+
+	buf, err := Marshal(dst, "", "", "\t")
+	if err != nil {
+		t.Fatalf("unable to marshal graph: %v", err)
+	}
+	if got := string(buf); got != clusteredGraph {
+		t.Errorf("unexpected marshaled graph; want:\n%s\n\ngot:\n%s", clusteredGraph, got)
+	}
+	// Nodes are given IDs in the order in which they are declared,
+	// so the marshaled graph is a fixed point of a round trip after
+	// a further round trip.
+	want := roundTripStructured(t, buf)
+	if got := roundTripStructured(t, want); !bytes.Equal(got, want) {
+		t.Errorf("unexpected round-trip of marshaled graph; want:\n%s\n\ngot:\n%s", want, got)
+	}
+}
+
+func roundTripStructured(t *testing.T, data []byte) []byte {
+	t.Helper()
+	dst := newDotStructuredGraph("")
+	err := Unmarshal(data, dst)
+	if err != nil {
+		t.Fatalf("unable to unmarshal DOT graph: %v", err)
+	}
+	buf, err := Marshal(dst, "", "", "\t")
+	if err != nil {
+		t.Fatalf("unable to marshal graph: %v", err)
+	}
+	return buf
+}
+
+const clusteredGraph = `strict graph G {
+	graph [
+		rankdir=LR
+	];
+
+	subgraph cluster_a {
+		graph [
+			label="A \"x\""
+		];
+
+		subgraph cluster_inner {
+			// Node definitions.
+			c [label=<<b>bold</b> text>];
+		}
+		// Node definitions.
+		a;
+		b;
+		c [label=<<b>bold</b> text>];
+
+		// Edge definitions.
+		a -- b;
+	}
+	subgraph cluster_b {
+		graph [
+			label="left\lright\l"
+		];
+
+		// Node definitions.
+		d;
+		e;
+
+		// Edge definitions.
+		d -- e;
+	}
+	subgraph {
+		graph [
+			rank=same
+		];
+
+		// Node definitions.
+		a;
+		e;
+	}
+	// Node definitions.
+	a;
+	b;
+	c [label=<<b>bold</b> text>];
+	d;
+	e;
+
+	// Edge definitions.
+	b -- d;
+}`
+
+var quoteIDTests = []struct {
+	raw, quoted string
+}{
+	{raw: "a", quoted: "a"},
+	{raw: "-1.5", quoted: "-1.5"},
+	{raw: "node", quoted: `"node"`},
+	{raw: "a b", quoted: `"a b"`},
+	{raw: `say "hi"`, quoted: `"say \"hi\""`},
+	{raw: `left\lright\l`, quoted: `"left\lright\l"`},
+	{raw: "<<b>bold</b>>", quoted: "<<b>bold</b>>"},
+	{raw: "<a> <b>", quoted: `"<a> <b>"`},
+	{raw: `"<a>"`, quoted: `"<a>"`},
+}
+
+func TestQuoteID(t *testing.T) {
+	for _, test := range quoteIDTests {
+		got := quoteID(test.raw)
+		if got != test.quoted {
+			t.Errorf("unexpected quoted ID for %q: got:%s want:%s", test.raw, got, test.quoted)
+		}
+		if !isID(got) {
+			t.Errorf("quoted ID for %q is not an ID: %s", test.raw, got)
+		}
+		if got := unquoteID(got); got != test.raw {
+			t.Errorf("unexpected unquoted ID for %s: got:%q want:%q", test.quoted, got, test.raw)
+		}
+	}
+
+	// Backslashes that can not be paired with the
+	// following character are escaped to give an ID.
+	for _, raw := range []string{`C:\`, `\"`, "a\\\nb"} {
+		if got := quoteID(raw); !isID(got) {
+			t.Errorf("quoted ID for %q is not an ID: %s", raw, got)
+		}
+	}
+}
+
 // Below follows a minimal implementation of a graph capable of validating the
 // round-trip encoding and decoding of DOT graphs with nodes and edges
 // containing DOT attributes.
@@ -778,3 +1084,32 @@ func panics(fn func()) (message string, ok bool) {
 	fn()
 	return
 }
+
+// dotStructuredGraph extends dotUndirectedGraph to hold subgraphs.
+//
+// dotStructuredGraph implements the dot.SubgraphBuilder and dot.Structurer
+// interfaces.
+type dotStructuredGraph struct {
+	*dotUndirectedGraph
+	subgraphs []Graph
+}
+
+// newDotStructuredGraph returns a new undirected graph capable of holding
+// subgraphs with the given DOT ID.
+func newDotStructuredGraph(id string) *dotStructuredGraph {
+	g := &dotStructuredGraph{dotUndirectedGraph: newDotUndirectedGraph()}
+	g.id = id
+	return g
+}
+
+// NewSubgraph returns a new subgraph of the graph.
+func (g *dotStructuredGraph) NewSubgraph(id string) encoding.Builder {
+	s := newDotStructuredGraph(id)
+	g.subgraphs = append(g.subgraphs, s)
+	return s
+}
+
+// Structure returns the subgraphs of the graph.
+func (g *dotStructuredGraph) Structure() []Graph {
+	return g.subgraphs
+}
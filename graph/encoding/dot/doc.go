@@ -15,7 +15,26 @@
 //
 // Attributes and IDs are quoted if needed during marshalling, to conform with
 // valid DOT syntax. Quoted IDs and attributes are unquoted during unmarshaling,
-// so the data is kept in raw form. As an exception, quoted text with a leading
-// `"<` and a trailing `>"` is not unquoted to ensure preservation of the string
-// during a round-trip.
+// so the data is kept in raw form. As in the DOT language, the only escape
+// sequence in a quoted string is \" for a double quote, so other backslash
+// sequences, such as the \l and \n line justifications of Graphviz escString
+// labels, are kept and round-trip unchanged. As an exception, quoted text with
+// a leading `"<` and a trailing `>"` and balanced angle brackets is not
+// unquoted to ensure preservation of the string during a round-trip. Unquoted
+// text of that form is an HTML-like label and is written without quotes.
+//
+// # Subgraphs
+//
+// Subgraphs of graphs implementing Structurer or MultiStructurer are
+// marshaled as DOT subgraphs, so clusters may be written by giving subgraphs
+// IDs with a "cluster" prefix. When unmarshaling, the subgraphs of a DOT
+// graph are held by destination graphs implementing SubgraphBuilder or
+// MultiSubgraphBuilder.
+//
+// # Streaming
+//
+// Unmarshal and its variants parse the complete DOT input before
+// constructing the graph. A Decoder reads graphs from an io.Reader, parsing
+// their statements as they are read, and may be used to decode very large
+// DOT files or streams holding more than one graph.
 package dot // import "gonum.org/v1/gonum/graph/encoding/dot"
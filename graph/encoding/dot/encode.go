@@ -113,7 +113,10 @@ func Marshal(g graph.Graph, name, prefix, indent string) ([]byte, error) {
 // Graph serialization will work for a graph.Multigraph without modification,
 // however, advanced GraphViz DOT features provided by Marshal depend on
 // implementation of the Node, Attributer, Porter, Attributers, Structurer,
-// MultiSubgrapher and Multigraph interfaces.
+// MultiSubgrapher and Multigraph interfaces. Lines that implement
+// graph.WeightedLine but not encoding.Attributer are written with a weight
+// attribute holding the line weight, allowing the graph to be read back with
+// UnmarshalWeightedMulti.
 //
 // Attributes and IDs are quoted if needed during marshalling.
 func MarshalMulti(g graph.Multigraph, name, prefix, indent string) ([]byte, error) {
@@ -154,6 +157,7 @@ func (p *simpleGraphPrinter) print(g graph.Graph, name string, needsIndent, isSu
 	if a, ok := g.(Attributers); ok {
 		p.writeAttributeComplex(a)
 	}
+	var structure []string
 	if s, ok := g.(Structurer); ok {
 		for _, g := range s.Structure() {
 			_, subIsDirected := g.(graph.Directed)
@@ -162,6 +166,7 @@ func (p *simpleGraphPrinter) print(g graph.Graph, name string, needsIndent, isSu
 			}
 			p.buf.WriteByte('\n')
 			p.print(g, g.DOTID(), true, true)
+			structure = append(structure, g.DOTID())
 		}
 	}
 
@@ -222,6 +227,11 @@ func (p *simpleGraphPrinter) print(g graph.Graph, name string, needsIndent, isSu
 				p.visited[f] = true
 				p.visited[edge{inGraph: name, from: tid, to: nid}] = true
 			}
+			if p.inStructure(structure, nid, tid) {
+				// Edges written in a subgraph belong to
+				// the graph and need not be written again.
+				continue
+			}
 
 			if !havePrintedEdgeHeader {
 				p.buf.WriteByte('\n')
@@ -428,11 +438,33 @@ type simpleGraphPrinter struct {
 	visited map[edge]bool
 }
 
+// inStructure reports whether the edge from u to v has been written in one
+// of the subgraphs with the given names.
+func (p *simpleGraphPrinter) inStructure(names []string, u, v int64) bool {
+	for _, name := range names {
+		if p.visited[edge{inGraph: name, from: u, to: v}] {
+			return true
+		}
+	}
+	return false
+}
+
 type multiGraphPrinter struct {
 	printer
 	visited map[line]bool
 }
 
+// inStructure reports whether the line from u to v with the given ID has
+// been written in one of the subgraphs with the given names.
+func (p *multiGraphPrinter) inStructure(names []string, u, v, id int64) bool {
+	for _, name := range names {
+		if p.visited[line{inGraph: name, from: u, to: v, id: id}] {
+			return true
+		}
+	}
+	return false
+}
+
 type line struct {
 	inGraph string
 	from    int64
@@ -453,6 +485,7 @@ func (p *multiGraphPrinter) print(g graph.Multigraph, name string, needsIndent,
 	if a, ok := g.(Attributers); ok {
 		p.writeAttributeComplex(a)
 	}
+	var structure []string
 	if s, ok := g.(MultiStructurer); ok {
 		for _, g := range s.Structure() {
 			_, subIsDirected := g.(graph.Directed)
@@ -461,6 +494,7 @@ func (p *multiGraphPrinter) print(g graph.Multigraph, name string, needsIndent,
 			}
 			p.buf.WriteByte('\n')
 			p.print(g, g.DOTID(), true, true)
+			structure = append(structure, g.DOTID())
 		}
 	}
 
@@ -528,6 +562,11 @@ func (p *multiGraphPrinter) print(g graph.Multigraph, name string, needsIndent,
 					p.visited[f] = true
 					p.visited[line{inGraph: name, from: tid, to: nid, id: lid}] = true
 				}
+				if p.inStructure(structure, nid, tid, lid) {
+					// Lines written in a subgraph belong to
+					// the graph and must not be written again.
+					continue
+				}
 
 				if !havePrintedEdgeHeader {
 					p.buf.WriteByte('\n')
@@ -582,8 +621,14 @@ func (p *multiGraphPrinter) print(g graph.Multigraph, name string, needsIndent,
 					}
 				}
 
-				if a, ok := l.(encoding.Attributer); ok {
-					p.writeAttributeList(a)
+				switch l := l.(type) {
+				case encoding.Attributer:
+					p.writeAttributeList(l)
+				case graph.WeightedLine:
+					p.writeAttributeList(&encoding.Attributes{{
+						Key:   "weight",
+						Value: strconv.FormatFloat(l.Weight(), 'g', -1, 64),
+					}})
 				}
 
 				p.buf.WriteByte(';')
@@ -599,17 +644,42 @@ func (p *multiGraphPrinter) print(g graph.Multigraph, name string, needsIndent,
 // quoteID quotes the given string if needed in the context of an ID. If s is
 // already quoted, or if s does not contain any spaces or special characters
 // that need escaping, the original string is returned.
+//
+// As in the DOT language, the only escape sequence in quoted strings is \"
+// for a double quote, so other backslashes are kept for interpretation of
+// the string as a Graphviz escString.
 func quoteID(s string) string {
 	// To use a keyword as an ID, it must be quoted.
-	if isKeyword(s) {
-		return strconv.Quote(s)
-	}
 	// Quote if s is not an ID. This includes strings containing spaces, except
 	// if those spaces are used within HTML string IDs (e.g. <foo >).
-	if !isID(s) {
-		return strconv.Quote(s)
+	if !isKeyword(s) && isID(s) {
+		return s
 	}
-	return s
+	var buf strings.Builder
+	buf.Grow(len(s) + 2)
+	buf.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteByte(c)
+			// A backslash pairs with the following character,
+			// so a final backslash or a backslash before a
+			// double quote must be escaped, and a backslash
+			// before a newline would be a line continuation.
+			if i+1 == len(s) || s[i+1] == '"' || s[i+1] == '\n' {
+				buf.WriteByte('\\')
+			} else {
+				i++
+				buf.WriteByte(s[i])
+			}
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	buf.WriteByte('"')
+	return buf.String()
 }
 
 // isKeyword reports whether the given string is a keyword in the DOT language.
@@ -651,18 +721,52 @@ func isID(s string) bool {
 		return true
 	}
 	// 3. double-quote string ID.
-	if len(s) >= 2 && strings.HasPrefix(s, `"`) && strings.HasSuffix(s, `"`) {
-		// Check that escape sequences within the double-quotes are valid.
-		if _, err := strconv.Unquote(s); err == nil {
-			return true
-		}
+	if isQuotedID(s) {
+		return true
 	}
 	// 4. HTML ID.
 	return isHTMLID(s)
 }
 
+// isQuotedID reports whether the given string is a double-quoted string ID.
+func isQuotedID(s string) bool {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return false
+	}
+	for i := 1; i < len(s)-1; i++ {
+		switch s[i] {
+		case '\\':
+			// Skip the escaped character, which
+			// must not be the final quote.
+			i++
+			if i == len(s)-1 {
+				return false
+			}
+		case '"':
+			return false
+		}
+	}
+	return true
+}
+
 // isHTMLID reports whether the given string an HTML ID.
 func isHTMLID(s string) bool {
-	// HTML IDs have the format /^<.*>$/
-	return len(s) >= 2 && strings.HasPrefix(s, "<") && strings.HasSuffix(s, ">")
+	// HTML IDs have the format /^<.*>$/ with balanced
+	// angle brackets.
+	if len(s) < 2 || s[0] != '<' || s[len(s)-1] != '>' {
+		return false
+	}
+	var depth int
+	for i, c := range s {
+		switch c {
+		case '<':
+			depth++
+		case '>':
+			depth--
+			if depth == 0 && i != len(s)-1 {
+				return false
+			}
+		}
+	}
+	return depth == 0
 }
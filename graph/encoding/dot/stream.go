@@ -0,0 +1,493 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dot
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"gonum.org/v1/gonum/graph/encoding"
+	"gonum.org/v1/gonum/graph/formats/dot"
+	"gonum.org/v1/gonum/graph/formats/dot/ast"
+)
+
+// Decoder reads and decodes Graphviz DOT-encoded graphs from an input
+// stream.
+//
+// Unlike Unmarshal, a Decoder does not hold the complete input or its
+// syntax tree in memory. The statements of each graph are read and parsed
+// in small batches as they are needed, so the memory required to decode a
+// graph is largely that of the destination graph. This allows very large
+// DOT files to be decoded.
+//
+// Attributes and IDs are unquoted during decoding if appropriate.
+type Decoder struct {
+	s scanner
+
+	// batchSize is the approximate size in
+	// bytes of the batches of statements that
+	// are parsed together.
+	batchSize int
+}
+
+// NewDecoder returns a new decoder that reads from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{s: scanner{r: bufio.NewReader(r), line: 1}, batchSize: 1 << 16}
+}
+
+// Decode reads the next graph from the input and stores it in dst. Decode
+// returns io.EOF if there are no more graphs in the input.
+func (d *Decoder) Decode(dst encoding.Builder) (err error) {
+	defer func() {
+		switch e := recover().(type) {
+		case nil:
+		case error:
+			err = e
+		default:
+			panic(e)
+		}
+	}()
+	header, directed, id, err := d.header()
+	if err != nil {
+		return err
+	}
+	gen := newSimpleGraph(dst, directed, id)
+	return d.statements(header, func(stmt ast.Stmt) { gen.addStmt(dst, stmt) })
+}
+
+// DecodeMulti reads the next graph from the input as a multigraph and stores
+// it in dst. DecodeMulti returns io.EOF if there are no more graphs in the
+// input.
+func (d *Decoder) DecodeMulti(dst encoding.MultiBuilder) (err error) {
+	defer func() {
+		switch e := recover().(type) {
+		case nil:
+		case error:
+			err = e
+		default:
+			panic(e)
+		}
+	}()
+	header, directed, id, err := d.header()
+	if err != nil {
+		return err
+	}
+	gen := newMultiGraph(dst, directed, id)
+	return d.statements(header, func(stmt ast.Stmt) { gen.addStmt(dst, stmt) })
+}
+
+// header reads the header of the next graph up to and including its opening
+// brace, returning the graph header without its ID, whether the graph is
+// directed and the graph ID.
+func (d *Decoder) header() (header string, directed bool, id string, err error) {
+	tok, err := d.s.next()
+	if err != nil {
+		return "", false, "", err
+	}
+	var strict bool
+	if tok.is("strict") {
+		strict = true
+		tok, err = d.s.next()
+		if err != nil {
+			return "", false, "", d.s.unexpected(err)
+		}
+	}
+	switch {
+	case tok.is("graph"):
+	case tok.is("digraph"):
+		directed = true
+	default:
+		return "", false, "", d.s.errorf("unexpected %q at start of graph", tok.text)
+	}
+	header = tok.text
+	if strict {
+		header = "strict " + header
+	}
+	tok, err = d.s.next()
+	if err != nil {
+		return "", false, "", d.s.unexpected(err)
+	}
+	if tok.kind == idToken {
+		id = tok.text
+		tok, err = d.s.next()
+		if err != nil {
+			return "", false, "", d.s.unexpected(err)
+		}
+	}
+	if tok.text != "{" {
+		return "", false, "", d.s.errorf("unexpected %q in graph header", tok.text)
+	}
+	if id != "" {
+		// Parse the ID to interpret any
+		// line continuations it holds.
+		file, err := dot.ParseString(header + " " + id + " {}")
+		if err != nil {
+			return "", false, "", err
+		}
+		id = file.Graphs[0].ID
+	}
+	return header, directed, id, nil
+}
+
+// statements reads the statements of a graph up to and including its
+// closing brace, parsing them in batches and passing them to add.
+func (d *Decoder) statements(header string, add func(ast.Stmt)) error {
+	var (
+		batch strings.Builder
+		line  int
+
+		// depth is the bracket depth within the
+		// statement being read. last and penult
+		// are the kinds of the last two tokens
+		// of the statement.
+		depth        int
+		last, penult tokenKind
+		empty        = true
+	)
+	flush := func() error {
+		if batch.Len() == 0 {
+			return nil
+		}
+		file, err := dot.ParseString(header + " {\n" + batch.String() + "\n}")
+		if err != nil {
+			return fmt.Errorf("dot: statements from line %d: %w", line, err)
+		}
+		for _, stmt := range file.Graphs[0].Stmts {
+			add(stmt)
+		}
+		batch.Reset()
+		return nil
+	}
+	endStmt := func() error {
+		if !empty {
+			batch.WriteString(";\n")
+			empty = true
+		}
+		if batch.Len() >= d.batchSize {
+			return flush()
+		}
+		return nil
+	}
+	for {
+		tok, err := d.s.next()
+		if err != nil {
+			return d.s.unexpected(err)
+		}
+		if depth == 0 {
+			switch {
+			case tok.text == ";":
+				if err := endStmt(); err != nil {
+					return err
+				}
+				continue
+			case tok.text == "}":
+				if err := endStmt(); err != nil {
+					return err
+				}
+				return flush()
+			case !empty && startsStmt(penult, last, tok.kind):
+				if err := endStmt(); err != nil {
+					return err
+				}
+			}
+		}
+		switch tok.text {
+		case "{", "[":
+			depth++
+		case "}", "]":
+			depth--
+			if depth < 0 {
+				return d.s.errorf("unexpected %q", tok.text)
+			}
+		}
+		if batch.Len() == 0 {
+			line = tok.line
+		}
+		if !empty {
+			batch.WriteByte(' ')
+		}
+		batch.WriteString(tok.text)
+		empty = false
+		penult, last = last, tok.kind
+	}
+}
+
+// startsStmt reports whether a token of kind next following tokens of kind
+// penult and last at the top level of a graph starts a new statement.
+func startsStmt(penult, last, next tokenKind) bool {
+	switch last {
+	case idToken:
+		// The ID of a subgraph may be
+		// followed by its opening brace.
+		if penult == subgraphToken {
+			return false
+		}
+	case closeToken:
+	default:
+		return false
+	}
+	switch next {
+	case idToken, keywordToken, subgraphToken, openBraceToken:
+		return true
+	}
+	return false
+}
+
+// tokenKind is the kind of a DOT token.
+type tokenKind int
+
+const (
+	// idToken is an identifier, numeral,
+	// quoted string or HTML string.
+	idToken tokenKind = iota
+	// keywordToken is a node, edge, graph,
+	// digraph or strict keyword.
+	keywordToken
+	// subgraphToken is a subgraph keyword.
+	subgraphToken
+	// openBraceToken is an opening brace.
+	openBraceToken
+	// closeToken is a closing brace or
+	// bracket.
+	closeToken
+	// otherToken is any other punctuation
+	// or an edge operator.
+	otherToken
+)
+
+// token is a DOT token.
+type token struct {
+	kind tokenKind
+	text string
+	line int
+}
+
+// is reports whether the token is the given keyword.
+func (t token) is(keyword string) bool {
+	return t.kind != idToken && strings.EqualFold(t.text, keyword)
+}
+
+// scanner splits DOT input into tokens.
+type scanner struct {
+	r    *bufio.Reader
+	line int
+	buf  strings.Builder
+}
+
+// errorf returns an error at the current line.
+func (s *scanner) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("dot: line %d: %s", s.line, fmt.Sprintf(format, args...))
+}
+
+// unexpected returns err, or an unexpected end of input error if err is
+// io.EOF.
+func (s *scanner) unexpected(err error) error {
+	if err == io.EOF {
+		return s.errorf("unexpected end of input")
+	}
+	return err
+}
+
+// read returns the next rune of the input.
+func (s *scanner) read() (rune, error) {
+	r, _, err := s.r.ReadRune()
+	if r == '\n' {
+		s.line++
+	}
+	return r, err
+}
+
+// unread unreads the last rune read.
+func (s *scanner) unread(r rune) {
+	s.r.UnreadRune()
+	if r == '\n' {
+		s.line--
+	}
+}
+
+// peek returns the next rune of the input without consuming it.
+func (s *scanner) peek() rune {
+	r, _, err := s.r.ReadRune()
+	if err != nil {
+		return utf8.RuneError
+	}
+	s.r.UnreadRune()
+	return r
+}
+
+// next returns the next token of the input, skipping white space and
+// comments. next returns io.EOF at the end of the input.
+func (s *scanner) next() (token, error) {
+	r, err := s.skip()
+	if err != nil {
+		return token{}, err
+	}
+	line := s.line
+	s.buf.Reset()
+	s.buf.WriteRune(r)
+	kind := otherToken
+	switch {
+	case r == '{':
+		kind = openBraceToken
+	case r == '}' || r == ']':
+		kind = closeToken
+	case strings.ContainsRune("[;,=:+", r):
+	case r == '-' && (s.peek() == '-' || s.peek() == '>'):
+		r, _ = s.read()
+		s.buf.WriteRune(r)
+	case r == '"':
+		kind = idToken
+		err = s.quoted()
+	case r == '<':
+		kind = idToken
+		err = s.html()
+	case r == '-' || r == '.' || isDigit(r):
+		kind = idToken
+		s.numeral()
+	case isLetter(r):
+		kind = idToken
+		s.ident()
+		text := s.buf.String()
+		switch strings.ToLower(text) {
+		case "subgraph":
+			kind = subgraphToken
+		case "node", "edge", "graph", "digraph", "strict":
+			kind = keywordToken
+		}
+	default:
+		return token{}, s.errorf("unexpected character %q", r)
+	}
+	if err != nil {
+		return token{}, s.unexpected(err)
+	}
+	return token{kind: kind, text: s.buf.String(), line: line}, nil
+}
+
+// skip skips white space and comments and returns the following rune.
+func (s *scanner) skip() (rune, error) {
+	for {
+		r, err := s.read()
+		if err != nil {
+			return 0, err
+		}
+		switch {
+		case r == ' ' || r == '\t' || r == '\r' || r == '\n':
+		case r == '#':
+			if err := s.skipLine(); err != nil {
+				return 0, err
+			}
+		case r == '/' && s.peek() == '/':
+			if err := s.skipLine(); err != nil {
+				return 0, err
+			}
+		case r == '/' && s.peek() == '*':
+			s.read()
+			var star bool
+			for {
+				r, err := s.read()
+				if err != nil {
+					return 0, s.unexpected(err)
+				}
+				if star && r == '/' {
+					break
+				}
+				star = r == '*'
+			}
+		default:
+			return r, nil
+		}
+	}
+}
+
+// skipLine skips the remainder of the current line.
+func (s *scanner) skipLine() error {
+	for {
+		r, err := s.read()
+		if err != nil || r == '\n' {
+			return err
+		}
+	}
+}
+
+// quoted reads the remainder of a double-quoted string.
+func (s *scanner) quoted() error {
+	for {
+		r, err := s.read()
+		if err != nil {
+			return err
+		}
+		s.buf.WriteRune(r)
+		switch r {
+		case '\\':
+			r, err = s.read()
+			if err != nil {
+				return err
+			}
+			s.buf.WriteRune(r)
+		case '"':
+			return nil
+		}
+	}
+}
+
+// html reads the remainder of an HTML string.
+func (s *scanner) html() error {
+	depth := 1
+	for depth > 0 {
+		r, err := s.read()
+		if err != nil {
+			return err
+		}
+		s.buf.WriteRune(r)
+		switch r {
+		case '<':
+			depth++
+		case '>':
+			depth--
+		}
+	}
+	return nil
+}
+
+// numeral reads the remainder of a numeral.
+func (s *scanner) numeral() {
+	for {
+		r, err := s.read()
+		if err != nil {
+			return
+		}
+		if !isDigit(r) && r != '.' {
+			s.unread(r)
+			return
+		}
+		s.buf.WriteRune(r)
+	}
+}
+
+// ident reads the remainder of an identifier.
+func (s *scanner) ident() {
+	for {
+		r, err := s.read()
+		if err != nil {
+			return
+		}
+		if !isLetter(r) && !isDigit(r) {
+			s.unread(r)
+			return
+		}
+		s.buf.WriteRune(r)
+	}
+}
+
+func isDigit(r rune) bool {
+	return '0' <= r && r <= '9'
+}
+
+func isLetter(r rune) bool {
+	return 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || r == '_' || r >= utf8.RuneSelf
+}
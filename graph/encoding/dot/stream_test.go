@@ -0,0 +1,146 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dot
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"gonum.org/v1/gonum/graph/encoding"
+	"gonum.org/v1/gonum/graph/multi"
+)
+
+var decoderTests = []string{
+	directed,
+	undirected,
+	directedID,
+	undirectedWithPorts,
+	undirectedAttrs,
+	clusteredGraph,
+
+	// Statements without semicolons.
+	`digraph "my graph" {
+	node [shape=box]
+	A B
+	A -> B -> C
+	[label=chain]
+	C
+	-> D
+	rankdir=LR
+	subgraph cluster_0 { label="zero"
+		E -> F } G -> H
+}`,
+
+	// Comments and strings holding delimiters.
+	`graph {
+	# a line comment
+	A [label="}{;"] // a comment
+	/* a block
+	   comment } */
+	B [label="x\"];"]; A -- B
+	C [label=<<i>}</i>>]
+}`,
+
+	// Vertex subgraphs.
+	`digraph {
+	{A B} -> {C D}
+	subgraph S {E} -> F
+	subgraph T {G} subgraph U {H}
+	-1 -> .5 -> -2.5
+}`,
+}
+
+func TestDecoder(t *testing.T) {
+	for _, batchSize := range []int{1, 1 << 16} {
+		dec := NewDecoder(strings.NewReader(strings.Join(decoderTests, "\n")))
+		dec.batchSize = batchSize
+		for i, src := range decoderTests {
+			want := newDotStructuredGraph("")
+			err := Unmarshal([]byte(src), want)
+			if err != nil {
+				t.Fatalf("i=%d: unable to unmarshal DOT graph: %v", i, err)
+			}
+			got := newDotStructuredGraph("")
+			err = dec.Decode(got)
+			if err != nil {
+				t.Errorf("i=%d batch=%d: unable to decode DOT graph: %v", i, batchSize, err)
+				continue
+			}
+			wantBuf, err := Marshal(want, "", "", "\t")
+			if err != nil {
+				t.Fatalf("i=%d: unable to marshal graph: %v", i, err)
+			}
+			gotBuf, err := Marshal(got, "", "", "\t")
+			if err != nil {
+				t.Fatalf("i=%d: unable to marshal graph: %v", i, err)
+			}
+			if string(gotBuf) != string(wantBuf) {
+				t.Errorf("i=%d batch=%d: unexpected decoded graph; want:\n%s\n\ngot:\n%s", i, batchSize, wantBuf, gotBuf)
+			}
+		}
+		err := dec.Decode(newDotStructuredGraph(""))
+		if err != io.EOF {
+			t.Errorf("batch=%d: unexpected error at end of input: got:%v want:%v", batchSize, err, io.EOF)
+		}
+	}
+}
+
+func TestDecoderMulti(t *testing.T) {
+	srcs := []string{
+		`graph { 0 -- 1 0 -- 1; 0 -- 2 }`,
+		`digraph { 0 -> 0 0 -> 0 }`,
+		directedMultigraph,
+	}
+	dec := NewDecoder(strings.NewReader(strings.Join(srcs, "\n")))
+	for i, src := range srcs {
+		var want, got encoding.MultiBuilder
+		if strings.HasPrefix(src, "digraph") {
+			want, got = multi.NewDirectedGraph(), multi.NewDirectedGraph()
+		} else {
+			want, got = multi.NewUndirectedGraph(), multi.NewUndirectedGraph()
+		}
+		err := UnmarshalMulti([]byte(src), want)
+		if err != nil {
+			t.Fatalf("i=%d: unable to unmarshal DOT multigraph: %v", i, err)
+		}
+		err = dec.DecodeMulti(got)
+		if err != nil {
+			t.Errorf("i=%d: unable to decode DOT multigraph: %v", i, err)
+			continue
+		}
+		wantBuf, err := MarshalMulti(want, "", "", "\t")
+		if err != nil {
+			t.Fatalf("i=%d: unable to marshal multigraph: %v", i, err)
+		}
+		gotBuf, err := MarshalMulti(got, "", "", "\t")
+		if err != nil {
+			t.Fatalf("i=%d: unable to marshal multigraph: %v", i, err)
+		}
+		if string(gotBuf) != string(wantBuf) {
+			t.Errorf("i=%d: unexpected decoded multigraph; want:\n%s\n\ngot:\n%s", i, wantBuf, gotBuf)
+		}
+	}
+	if err := dec.DecodeMulti(multi.NewUndirectedGraph()); err != io.EOF {
+		t.Errorf("unexpected error at end of input: got:%v want:%v", err, io.EOF)
+	}
+}
+
+func TestDecoderErrors(t *testing.T) {
+	for _, src := range []string{
+		`graph {`,
+		`graph { A -- }`,
+		`graph { A [label="x }`,
+		`graph { A ] }`,
+		`node { A }`,
+		`graph { A @ B }`,
+		`graph { /* A }`,
+	} {
+		err := NewDecoder(strings.NewReader(src)).Decode(newDotUndirectedGraph())
+		if err == nil || err == io.EOF {
+			t.Errorf("expected error decoding %q: got:%v", src, err)
+		}
+	}
+}
@@ -18,6 +18,13 @@ type MultiBuilder interface {
 	graph.MultigraphBuilder
 }
 
+// WeightedMultiBuilder is a graph that can have user-defined nodes and
+// weighted lines added.
+type WeightedMultiBuilder interface {
+	graph.Multigraph
+	graph.WeightedMultigraphBuilder
+}
+
 // AttributeSetter is implemented by types that can set an encoded graph
 // attribute.
 type AttributeSetter interface {
@@ -0,0 +1,317 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+)
+
+// IDSetter is implemented by graph.Node values that can set their
+// node-link JSON ID.
+type IDSetter interface {
+	// SetJSONID sets the ID of the node. JSON
+	// string IDs are unquoted and other IDs are
+	// given as their compact JSON text.
+	SetJSONID(id string)
+}
+
+// Unmarshal parses the node-link JSON-encoded data and stores the result in
+// dst. Nodes are created using dst.NewNode and have their ID set if they
+// implement IDSetter. The attributes of the graph and its nodes and edges
+// are set if they implement encoding.AttributeSetter.
+//
+// Unmarshal returns an error if the directed member of data does not agree
+// with the directedness of dst. Parallel links are merged into a single
+// edge.
+func Unmarshal(data []byte, dst encoding.Builder) error {
+	doc, err := parse(data, dst)
+	if err != nil {
+		return err
+	}
+	gen := generator{ids: make(map[string]graph.Node)}
+	err = gen.addNodes(dst, doc.Nodes)
+	if err != nil {
+		return err
+	}
+	for _, raw := range doc.links() {
+		link, err := gen.link(dst, raw, "source", "target")
+		if err != nil {
+			return err
+		}
+		e := dst.NewEdge(link.from, link.to)
+		err = setEdge(dst, e)
+		if err != nil {
+			return err
+		}
+		err = setAttributes("edge", e, link.attrs)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnmarshalMulti parses the node-link JSON-encoded data as a multigraph and
+// stores the result in dst. Nodes and attributes are handled as for
+// Unmarshal. Lines are created using dst.NewLine and the key member of
+// links is ignored.
+//
+// UnmarshalMulti returns an error if the directed member of data does not
+// agree with the directedness of dst.
+func UnmarshalMulti(data []byte, dst encoding.MultiBuilder) error {
+	doc, err := parse(data, dst)
+	if err != nil {
+		return err
+	}
+	gen := generator{ids: make(map[string]graph.Node)}
+	err = gen.addNodes(dst, doc.Nodes)
+	if err != nil {
+		return err
+	}
+	for _, raw := range doc.links() {
+		link, err := gen.link(dst, raw, "source", "target", "key")
+		if err != nil {
+			return err
+		}
+		l := dst.NewLine(link.from, link.to)
+		dst.SetLine(l)
+		err = setAttributes("line", l, link.attrs)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setEdge adds e to dst, returning an error if dst panics, for example if
+// it does not allow self loops.
+func setEdge(dst graph.Builder, e graph.Edge) (err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err = fmt.Errorf("graphjson: panic setting edge: %v", e)
+		}
+	}()
+	dst.SetEdge(e)
+	return nil
+}
+
+// document is a node-link JSON document.
+type document struct {
+	Directed *bool             `json:"directed"`
+	Graph    json.RawMessage   `json:"graph"`
+	Nodes    []json.RawMessage `json:"nodes"`
+	Links    []json.RawMessage `json:"links"`
+	Edges    []json.RawMessage `json:"edges"`
+}
+
+// links returns the links of the document, which may
+// be held in either its links or its edges member.
+func (d *document) links() []json.RawMessage {
+	if d.Links != nil {
+		return d.Links
+	}
+	return d.Edges
+}
+
+// parse parses the node-link document in data, checking that it agrees
+// with the directedness of dst and setting the graph attributes of dst.
+func parse(data []byte, dst interface{}) (*document, error) {
+	var doc document
+	err := json.Unmarshal(data, &doc)
+	if err != nil {
+		return nil, err
+	}
+	if doc.Directed != nil {
+		_, directed := dst.(graph.Directed)
+		if *doc.Directed != directed {
+			return nil, errors.New("graphjson: mismatched graph type")
+		}
+	}
+	if doc.Graph != nil && !bytes.Equal(doc.Graph, []byte("null")) {
+		attrs, err := members(doc.Graph)
+		if err != nil {
+			return nil, err
+		}
+		err = setAttributes("graph", dst, attrs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &doc, nil
+}
+
+// generator holds the state of a node-link decoding.
+type generator struct {
+	// ids maps from the compact JSON text
+	// of node IDs to graph nodes.
+	ids map[string]graph.Node
+}
+
+// addNodes adds the nodes described by the given node objects to dst.
+// Nodes without an id member are identified by their index.
+func (gen *generator) addNodes(dst graph.NodeAdder, nodes []json.RawMessage) error {
+	for i, raw := range nodes {
+		m, err := members(raw)
+		if err != nil {
+			return err
+		}
+		id := json.RawMessage(strconv.Itoa(i))
+		attrs := m[:0:0]
+		for _, v := range m {
+			if v.key == "id" {
+				id = v.value
+				continue
+			}
+			attrs = append(attrs, v)
+		}
+		n, err := gen.node(dst, id)
+		if err != nil {
+			return err
+		}
+		err = setAttributes("node", n, attrs)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// node returns the node with the given JSON ID, adding a new node to dst
+// if none exists.
+func (gen *generator) node(dst graph.NodeAdder, id json.RawMessage) (graph.Node, error) {
+	var buf bytes.Buffer
+	err := json.Compact(&buf, id)
+	if err != nil {
+		return nil, err
+	}
+	key := buf.String()
+	if n, ok := gen.ids[key]; ok {
+		return n, nil
+	}
+	n := dst.NewNode()
+	if s, ok := n.(IDSetter); ok {
+		s.SetJSONID(attributeValue(id))
+	}
+	dst.AddNode(n)
+	gen.ids[key] = n
+	return n, nil
+}
+
+// link is a decoded link object.
+type link struct {
+	from, to graph.Node
+	attrs    []member
+}
+
+// link returns the end points and attributes of the link object raw. The
+// first two reserved member names are the names of the source and target
+// members, and all the reserved members are excluded from the attributes.
+func (gen *generator) link(dst graph.NodeAdder, raw json.RawMessage, reserved ...string) (link, error) {
+	m, err := members(raw)
+	if err != nil {
+		return link{}, err
+	}
+	var (
+		l        link
+		from, to json.RawMessage
+	)
+outer:
+	for _, v := range m {
+		for i, k := range reserved {
+			if v.key != k {
+				continue
+			}
+			switch i {
+			case 0:
+				from = v.value
+			case 1:
+				to = v.value
+			}
+			continue outer
+		}
+		l.attrs = append(l.attrs, v)
+	}
+	if from == nil || to == nil {
+		return link{}, errors.New("graphjson: link missing source or target")
+	}
+	l.from, err = gen.node(dst, from)
+	if err != nil {
+		return link{}, err
+	}
+	l.to, err = gen.node(dst, to)
+	if err != nil {
+		return link{}, err
+	}
+	return l, nil
+}
+
+// setAttributes sets the given attributes of dst if it is an
+// encoding.AttributeSetter.
+func setAttributes(kind string, dst interface{}, attrs []member) error {
+	s, ok := dst.(encoding.AttributeSetter)
+	if !ok {
+		return nil
+	}
+	for _, m := range attrs {
+		a := encoding.Attribute{Key: m.key, Value: attributeValue(m.value)}
+		if err := s.SetAttribute(a); err != nil {
+			return fmt.Errorf("graphjson: unable to unmarshal %s attribute (%s=%s): %w", kind, a.Key, a.Value, err)
+		}
+	}
+	return nil
+}
+
+// attributeValue returns the attribute value of the JSON value v. Strings
+// are unquoted and other values are returned as compact JSON text.
+func attributeValue(v json.RawMessage) string {
+	v = bytes.TrimSpace(v)
+	if len(v) != 0 && v[0] == '"' {
+		var s string
+		err := json.Unmarshal(v, &s)
+		if err != nil {
+			panic(err)
+		}
+		return s
+	}
+	var buf bytes.Buffer
+	err := json.Compact(&buf, v)
+	if err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
+// members returns the members of the JSON object data in order.
+func members(data json.RawMessage) ([]member, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	if tok != json.Delim('{') {
+		return nil, fmt.Errorf("graphjson: expected object, got %s", data)
+	}
+	var m []member
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		var v json.RawMessage
+		err = dec.Decode(&v)
+		if err != nil {
+			return nil, err
+		}
+		m = append(m, member{key: tok.(string), value: v})
+	}
+	return m, nil
+}
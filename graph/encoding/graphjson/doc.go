@@ -0,0 +1,35 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package graphjson implements JSON marshaling and unmarshaling of graphs in
+// the node-link format used by NetworkX and D3.
+//
+// A node-link document is a JSON object holding the graph attributes, a list
+// of node objects and a list of link objects:
+//
+//	{
+//		"directed": true,
+//		"multigraph": false,
+//		"graph": {"name": "example"},
+//		"nodes": [{"id": "a", "color": "red"}, {"id": "b"}],
+//		"links": [{"source": "a", "target": "b", "weight": 2}]
+//	}
+//
+// Node objects hold the node ID in their "id" member and link objects hold
+// the IDs of their end points in their "source" and "target" members, and
+// the links of multigraphs hold a "key" member distinguishing parallel
+// links. The remaining members of the graph, node and link objects are
+// attributes. When unmarshaling, the list of links may be named "edges" as
+// written by recent versions of NetworkX, and nodes without an "id" member
+// are identified by their index in the list of nodes as in D3.
+//
+// # Attributes
+//
+// Attributes are mapped to encoding.Attribute values. JSON string attribute
+// values are unquoted during unmarshaling, and other values, such as
+// numbers, booleans, arrays and objects, are kept as their compact JSON
+// text. During marshaling, attribute values that are compact JSON text other
+// than a string are written as JSON values and all other attribute values
+// are written as JSON strings.
+package graphjson // import "gonum.org/v1/gonum/graph/encoding/graphjson"
@@ -0,0 +1,268 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// Node is a graph.Node with a node-link JSON ID.
+type Node interface {
+	// JSONID returns the ID of the node in the
+	// node-link encoding. It is written as a
+	// JSON string.
+	JSONID() string
+}
+
+// Marshal returns the node-link JSON encoding for the graph g, applying the
+// prefix and indent to the encoding as for json.MarshalIndent.
+//
+// Nodes are identified by their ID, or by the string returned by JSONID if
+// they implement Node. The attributes of g and its nodes and edges are
+// written if they implement encoding.Attributer, and edges that implement
+// graph.WeightedEdge but not encoding.Attributer are written with a weight
+// attribute holding the edge weight. Marshal returns an error if a node has
+// an "id" attribute or an edge has a "source" or "target" attribute.
+func Marshal(g graph.Graph, prefix, indent string) ([]byte, error) {
+	_, directed := g.(graph.Directed)
+	p := newPrinter(g.Nodes())
+	var links []object
+	for _, u := range p.nodes {
+		uid := u.ID()
+		to := graph.NodesOf(g.From(uid))
+		order.ByID(to)
+		for _, v := range to {
+			vid := v.ID()
+			if !directed && vid < uid {
+				// Undirected edges are written
+				// from their lower ID end.
+				continue
+			}
+			link := object{{"source", p.ids[uid]}, {"target", p.ids[vid]}}
+			link, err := appendEdgeAttributes(link, g.Edge(uid, vid), "source", "target")
+			if err != nil {
+				return nil, err
+			}
+			links = append(links, link)
+		}
+	}
+	return p.marshal(g, directed, false, links, prefix, indent)
+}
+
+// MarshalMulti returns the node-link JSON encoding for the multigraph g,
+// applying the prefix and indent to the encoding as for json.MarshalIndent.
+//
+// Lines are written as for the edges of Marshal, holding the line ID in
+// their "key" member. MarshalMulti returns an error if a node has an "id"
+// attribute or a line has a "source", "target" or "key" attribute.
+func MarshalMulti(g graph.Multigraph, prefix, indent string) ([]byte, error) {
+	_, directed := g.(graph.Directed)
+	p := newPrinter(g.Nodes())
+	var links []object
+	for _, u := range p.nodes {
+		uid := u.ID()
+		to := graph.NodesOf(g.From(uid))
+		order.ByID(to)
+		for _, v := range to {
+			vid := v.ID()
+			if !directed && vid < uid {
+				continue
+			}
+			lines := graph.LinesOf(g.Lines(uid, vid))
+			order.LinesByIDs(lines)
+			for _, l := range lines {
+				link := object{
+					{"source", p.ids[uid]},
+					{"target", p.ids[vid]},
+					{"key", json.RawMessage(strconv.FormatInt(l.ID(), 10))},
+				}
+				link, err := appendEdgeAttributes(link, l, "source", "target", "key")
+				if err != nil {
+					return nil, err
+				}
+				links = append(links, link)
+			}
+		}
+	}
+	return p.marshal(g, directed, true, links, prefix, indent)
+}
+
+// printer holds the state of a node-link encoding.
+type printer struct {
+	// nodes is the nodes of the graph
+	// ordered by ID.
+	nodes []graph.Node
+	// ids maps from graph node IDs
+	// to encoded node IDs.
+	ids map[int64]json.RawMessage
+}
+
+// newPrinter returns a printer for a graph with the given nodes.
+func newPrinter(it graph.Nodes) *printer {
+	nodes := graph.NodesOf(it)
+	order.ByID(nodes)
+	ids := make(map[int64]json.RawMessage, len(nodes))
+	for _, n := range nodes {
+		if jn, ok := n.(Node); ok {
+			ids[n.ID()] = marshalString(jn.JSONID())
+		} else {
+			ids[n.ID()] = json.RawMessage(strconv.FormatInt(n.ID(), 10))
+		}
+	}
+	return &printer{nodes: nodes, ids: ids}
+}
+
+// marshal returns the encoding of the graph g with the given links.
+func (p *printer) marshal(g interface{}, directed, multigraph bool, links []object, prefix, indent string) ([]byte, error) {
+	var attrs object
+	if a, ok := g.(encoding.Attributer); ok {
+		attrs = appendAttributes(attrs, a.Attributes())
+	}
+	nodes := make([]object, len(p.nodes))
+	for i, n := range p.nodes {
+		node := object{{"id", p.ids[n.ID()]}}
+		if a, ok := n.(encoding.Attributer); ok {
+			attributes := a.Attributes()
+			if err := checkReserved("node", attributes, "id"); err != nil {
+				return nil, err
+			}
+			node = appendAttributes(node, attributes)
+		}
+		nodes[i] = node
+	}
+	if links == nil {
+		links = []object{}
+	}
+	doc := object{
+		{"directed", json.RawMessage(strconv.FormatBool(directed))},
+		{"multigraph", json.RawMessage(strconv.FormatBool(multigraph))},
+		{"graph", marshalValue(attrs)},
+		{"nodes", marshalValue(nodes)},
+		{"links", marshalValue(links)},
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent(prefix, indent)
+	err := enc.Encode(doc)
+	if err != nil {
+		return nil, err
+	}
+	// Remove the newline added by Encode.
+	return bytes.TrimSuffix(buf.Bytes(), []byte{'\n'}), nil
+}
+
+// appendEdgeAttributes appends the attributes of the edge or line e to dst,
+// returning an error if any of the attribute keys is reserved.
+func appendEdgeAttributes(dst object, e interface{}, reserved ...string) (object, error) {
+	switch e := e.(type) {
+	case encoding.Attributer:
+		attributes := e.Attributes()
+		if err := checkReserved("edge", attributes, reserved...); err != nil {
+			return nil, err
+		}
+		return appendAttributes(dst, attributes), nil
+	case graph.WeightedEdge:
+		return append(dst, member{"weight", marshalWeight(e.Weight())}), nil
+	case graph.WeightedLine:
+		return append(dst, member{"weight", marshalWeight(e.Weight())}), nil
+	}
+	return dst, nil
+}
+
+// checkReserved returns an error if any of the attributes has a reserved key.
+func checkReserved(kind string, attributes []encoding.Attribute, reserved ...string) error {
+	for _, a := range attributes {
+		for _, k := range reserved {
+			if a.Key == k {
+				return fmt.Errorf("graphjson: reserved %s attribute key %q", kind, k)
+			}
+		}
+	}
+	return nil
+}
+
+// appendAttributes appends the attributes to dst.
+func appendAttributes(dst object, attributes []encoding.Attribute) object {
+	for _, a := range attributes {
+		dst = append(dst, member{a.Key, marshalAttribute(a.Value)})
+	}
+	return dst
+}
+
+// marshalAttribute returns the encoding of an attribute value. Values that
+// are compact JSON text other than a string are returned unaltered, and
+// other values are encoded as JSON strings.
+func marshalAttribute(v string) json.RawMessage {
+	if v != "" && v[0] != '"' && json.Valid([]byte(v)) {
+		var buf bytes.Buffer
+		if json.Compact(&buf, []byte(v)) == nil && buf.String() == v {
+			return json.RawMessage(v)
+		}
+	}
+	return marshalString(v)
+}
+
+// marshalWeight returns the encoding of an edge weight. Infinite and NaN
+// weights are encoded as strings since they are not valid JSON numbers.
+func marshalWeight(w float64) json.RawMessage {
+	s := strconv.FormatFloat(w, 'g', -1, 64)
+	if !json.Valid([]byte(s)) {
+		return marshalString(s)
+	}
+	return json.RawMessage(s)
+}
+
+// marshalString returns the JSON encoding of s without escaping HTML
+// characters.
+func marshalString(s string) json.RawMessage {
+	return marshalValue(s)
+}
+
+// marshalValue returns the JSON encoding of v without escaping HTML
+// characters.
+func marshalValue(v interface{}) json.RawMessage {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	err := enc.Encode(v)
+	if err != nil {
+		panic(err)
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte{'\n'})
+}
+
+// member is a member of a JSON object.
+type member struct {
+	key   string
+	value json.RawMessage
+}
+
+// object is a JSON object with ordered members.
+type object []member
+
+// MarshalJSON implements the json.Marshaler interface.
+func (o object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, m := range o {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(marshalString(m.key))
+		buf.WriteByte(':')
+		buf.Write(m.value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,46 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphjson_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/graph/encoding/graphjson"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func ExampleMarshal() {
+	g := simple.NewWeightedDirectedGraph(0, 0)
+	g.SetWeightedEdge(g.NewWeightedEdge(simple.Node(0), simple.Node(1), 0.5))
+	g.SetWeightedEdge(g.NewWeightedEdge(simple.Node(1), simple.Node(2), 2))
+
+	b, err := graphjson.Marshal(g, "", "")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(string(b))
+
+	// Output:
+	// {"directed":true,"multigraph":false,"graph":{},"nodes":[{"id":0},{"id":1},{"id":2}],"links":[{"source":0,"target":1,"weight":0.5},{"source":1,"target":2,"weight":2}]}
+}
+
+func ExampleUnmarshal() {
+	const data = `{
+	"directed": false,
+	"nodes": [{"id": "a"}, {"id": "b"}, {"id": "c"}],
+	"links": [{"source": "a", "target": "b"}, {"source": "b", "target": "c"}]
+}`
+
+	g := simple.NewUndirectedGraph()
+	err := graphjson.Unmarshal([]byte(data), g)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("nodes: %d edges: %d\n", g.Nodes().Len(), g.Edges().Len())
+
+	// Output:
+	// nodes: 3 edges: 2
+}
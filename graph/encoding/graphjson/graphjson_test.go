@@ -0,0 +1,351 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphjson
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+	"gonum.org/v1/gonum/graph/multi"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var roundTripTests = []struct {
+	name     string
+	directed bool
+	want     string
+}{
+	{
+		name:     "undirected",
+		directed: false,
+		want: `{
+	"directed": false,
+	"multigraph": false,
+	"graph": {
+		"name": "example",
+		"layout": {
+			"rankdir": "LR"
+		}
+	},
+	"nodes": [
+		{
+			"id": "a",
+			"color": "red",
+			"label": "<b>a</b> & b"
+		},
+		{
+			"id": "b",
+			"size": 1.5,
+			"visible": true
+		},
+		{
+			"id": "c"
+		}
+	],
+	"links": [
+		{
+			"source": "a",
+			"target": "b",
+			"weight": 2
+		},
+		{
+			"source": "a",
+			"target": "c",
+			"label": "1 2"
+		},
+		{
+			"source": "b",
+			"target": "c"
+		}
+	]
+}`,
+	},
+	{
+		name:     "directed",
+		directed: true,
+		want: `{
+	"directed": true,
+	"multigraph": false,
+	"graph": {},
+	"nodes": [
+		{
+			"id": "a"
+		},
+		{
+			"id": "b",
+			"tags": [
+				"x",
+				"y"
+			]
+		}
+	],
+	"links": [
+		{
+			"source": "a",
+			"target": "b"
+		},
+		{
+			"source": "b",
+			"target": "a",
+			"quote": "say \"hi\""
+		}
+	]
+}`,
+	},
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, test := range roundTripTests {
+		var dst encoding.Builder
+		if test.directed {
+			dst = newAttrDirectedGraph()
+		} else {
+			dst = newAttrUndirectedGraph()
+		}
+		err := Unmarshal([]byte(test.want), dst)
+		if err != nil {
+			t.Errorf("%s: unable to unmarshal graph: %v", test.name, err)
+			continue
+		}
+		buf, err := Marshal(dst, "", "\t")
+		if err != nil {
+			t.Errorf("%s: unable to marshal graph: %v", test.name, err)
+			continue
+		}
+		if got := string(buf); got != test.want {
+			t.Errorf("%s: unexpected round trip:\ngot:\n%s\nwant:\n%s", test.name, got, test.want)
+		}
+	}
+}
+
+func TestUnmarshalNetworkX(t *testing.T) {
+	// Output of networkx.node_link_data with edges="edges".
+	const src = `{"directed": false, "multigraph": false, "graph": {"name": "path"},
+"nodes": [{"club": "A", "id": 0}, {"club": "B", "id": 1}, {"id": 2}],
+"edges": [{"weight": 0.5, "source": 0, "target": 1}, {"source": 1, "target": 2}]}`
+
+	dst := newAttrUndirectedGraph()
+	err := Unmarshal([]byte(src), dst)
+	if err != nil {
+		t.Fatalf("unable to unmarshal graph: %v", err)
+	}
+	if got := dst.Attributes(); !slices.Equal(got, []encoding.Attribute{{Key: "name", Value: "path"}}) {
+		t.Errorf("unexpected graph attributes: %v", got)
+	}
+	if got := dst.Nodes().Len(); got != 3 {
+		t.Errorf("unexpected number of nodes: got:%d want:3", got)
+	}
+	if got := dst.Edges().Len(); got != 2 {
+		t.Errorf("unexpected number of edges: got:%d want:2", got)
+	}
+	n := dst.byID("1")
+	if n == nil {
+		t.Fatal("missing node with JSON ID 1")
+	}
+	if got := n.Attributes(); !slices.Equal(got, []encoding.Attribute{{Key: "club", Value: "B"}}) {
+		t.Errorf("unexpected node attributes: %v", got)
+	}
+	e := dst.Edge(dst.byID("0").ID(), n.ID()).(*attrEdge)
+	if got := e.Attributes(); !slices.Equal(got, []encoding.Attribute{{Key: "weight", Value: "0.5"}}) {
+		t.Errorf("unexpected edge attributes: %v", got)
+	}
+}
+
+func TestUnmarshalD3(t *testing.T) {
+	// D3 force layout data with links referring
+	// to nodes by their index.
+	const src = `{
+	"nodes": [{"name": "a"}, {"name": "b"}, {"name": "c"}],
+	"links": [{"source": 0, "target": 1}, {"source": 2, "target": 0}]
+}`
+
+	dst := simple.NewDirectedGraph()
+	err := Unmarshal([]byte(src), dst)
+	if err != nil {
+		t.Fatalf("unable to unmarshal graph: %v", err)
+	}
+	if got := dst.Nodes().Len(); got != 3 {
+		t.Errorf("unexpected number of nodes: got:%d want:3", got)
+	}
+	for _, e := range [][2]int64{{0, 1}, {2, 0}} {
+		if !dst.HasEdgeFromTo(e[0], e[1]) {
+			t.Errorf("missing edge %d->%d", e[0], e[1])
+		}
+	}
+	if got := dst.Edges().Len(); got != 2 {
+		t.Errorf("unexpected number of edges: got:%d want:2", got)
+	}
+}
+
+func TestMarshalWeighted(t *testing.T) {
+	g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	g.SetWeightedEdge(g.NewWeightedEdge(simple.Node(0), simple.Node(1), 0.25))
+	g.SetWeightedEdge(g.NewWeightedEdge(simple.Node(2), simple.Node(1), math.Inf(1)))
+	buf, err := Marshal(g, "", "")
+	if err != nil {
+		t.Fatalf("unable to marshal graph: %v", err)
+	}
+	const want = `{"directed":false,"multigraph":false,"graph":{},"nodes":[{"id":0},{"id":1},{"id":2}],` +
+		`"links":[{"source":0,"target":1,"weight":0.25},{"source":1,"target":2,"weight":"+Inf"}]}`
+	if got := string(buf); got != want {
+		t.Errorf("unexpected encoding:\ngot: %s\nwant:%s", got, want)
+	}
+}
+
+func TestMultiRoundTrip(t *testing.T) {
+	const want = `{
+	"directed": true,
+	"multigraph": true,
+	"graph": {},
+	"nodes": [
+		{
+			"id": 0
+		},
+		{
+			"id": 1
+		}
+	],
+	"links": [
+		{
+			"source": 0,
+			"target": 0,
+			"key": 0
+		},
+		{
+			"source": 0,
+			"target": 1,
+			"key": 0
+		},
+		{
+			"source": 0,
+			"target": 1,
+			"key": 1
+		},
+		{
+			"source": 1,
+			"target": 0,
+			"key": 0
+		}
+	]
+}`
+
+	dst := multi.NewDirectedGraph()
+	err := UnmarshalMulti([]byte(want), dst)
+	if err != nil {
+		t.Fatalf("unable to unmarshal multigraph: %v", err)
+	}
+	if got := dst.Lines(0, 1).Len(); got != 2 {
+		t.Errorf("unexpected number of parallel lines: got:%d want:2", got)
+	}
+	buf, err := MarshalMulti(dst, "", "\t")
+	if err != nil {
+		t.Fatalf("unable to marshal multigraph: %v", err)
+	}
+	if got := string(buf); got != want {
+		t.Errorf("unexpected round trip:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		src  string
+		dst  encoding.Builder
+	}{
+		{name: "invalid JSON", src: `{"nodes": [`, dst: simple.NewUndirectedGraph()},
+		{name: "mismatched type", src: `{"directed": true}`, dst: simple.NewUndirectedGraph()},
+		{name: "node not object", src: `{"nodes": [1]}`, dst: simple.NewUndirectedGraph()},
+		{name: "missing target", src: `{"links": [{"source": 1}]}`, dst: simple.NewUndirectedGraph()},
+		{name: "self loop", src: `{"links": [{"source": 1, "target": 1}]}`, dst: simple.NewUndirectedGraph()},
+	} {
+		err := Unmarshal([]byte(test.src), test.dst)
+		if err == nil {
+			t.Errorf("%s: expected error", test.name)
+		}
+	}
+
+	g := newAttrUndirectedGraph()
+	n := g.NewNode().(*attrNode)
+	n.SetAttribute(encoding.Attribute{Key: "id", Value: "x"})
+	g.AddNode(n)
+	_, err := Marshal(g, "", "")
+	if err == nil || !strings.Contains(err.Error(), "reserved") {
+		t.Errorf("unexpected error for reserved node attribute: %v", err)
+	}
+}
+
+// attrUndirectedGraph is an undirected graph with attributes
+// and attributed nodes and edges.
+type attrUndirectedGraph struct {
+	*simple.UndirectedGraph
+	attrs
+}
+
+func newAttrUndirectedGraph() *attrUndirectedGraph {
+	return &attrUndirectedGraph{UndirectedGraph: simple.NewUndirectedGraph()}
+}
+
+func (g *attrUndirectedGraph) NewNode() graph.Node {
+	return &attrNode{Node: g.UndirectedGraph.NewNode()}
+}
+
+func (g *attrUndirectedGraph) NewEdge(from, to graph.Node) graph.Edge {
+	return &attrEdge{Edge: g.UndirectedGraph.NewEdge(from, to)}
+}
+
+// byID returns the node with the given JSON ID.
+func (g *attrUndirectedGraph) byID(id string) *attrNode {
+	for _, n := range graph.NodesOf(g.Nodes()) {
+		if n := n.(*attrNode); n.id == id {
+			return n
+		}
+	}
+	return nil
+}
+
+// attrDirectedGraph is a directed graph with attributes
+// and attributed nodes and edges.
+type attrDirectedGraph struct {
+	*simple.DirectedGraph
+	attrs
+}
+
+func newAttrDirectedGraph() *attrDirectedGraph {
+	return &attrDirectedGraph{DirectedGraph: simple.NewDirectedGraph()}
+}
+
+func (g *attrDirectedGraph) NewNode() graph.Node {
+	return &attrNode{Node: g.DirectedGraph.NewNode()}
+}
+
+func (g *attrDirectedGraph) NewEdge(from, to graph.Node) graph.Edge {
+	return &attrEdge{Edge: g.DirectedGraph.NewEdge(from, to)}
+}
+
+type attrNode struct {
+	graph.Node
+	id string
+	attrs
+}
+
+func (n *attrNode) JSONID() string      { return n.id }
+func (n *attrNode) SetJSONID(id string) { n.id = id }
+
+type attrEdge struct {
+	graph.Edge
+	attrs
+}
+
+// attrs is a collection of attributes.
+type attrs struct {
+	attrs encoding.Attributes
+}
+
+func (a *attrs) Attributes() []encoding.Attribute           { return a.attrs }
+func (a *attrs) SetAttribute(attr encoding.Attribute) error { return a.attrs.SetAttribute(attr) }
@@ -0,0 +1,324 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphlet
+
+import (
+	"cmp"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// Count returns the number of induced subgraphs of g that are isomorphic to
+// each graphlet with at most n nodes, indexed by Graphlet. The length of the
+// returned slice is NumGraphletsOf(n).
+//
+// Graphlets with up to four nodes are counted using the combinatorial
+// method described for Orbits. Graphlets with five nodes are counted by
+// enumerating the connected induced subgraphs of g with five nodes using
+// Enumerate.
+//
+// Count will panic if n is not between two and five.
+func Count(g graph.Undirected, n int) []int64 {
+	checkSize(n)
+	counts := make([]int64, NumGraphletsOf(n))
+	a := newAdjacency(g)
+	orbits := a.orbits(min(n, 4))
+	for _, o := range orbits {
+		for i, c := range o {
+			counts[orbitGraphlet[i]] += c
+		}
+	}
+	// Each occurrence of a graphlet is counted
+	// once for each of its nodes.
+	for i := range NumGraphletsOf(min(n, 4)) {
+		counts[i] /= int64(graphlets[i].nodes)
+	}
+	if n == 5 {
+		a.enumerate(5, func(sub []int, mask uint16) bool {
+			counts[graphletOf[5][mask]]++
+			return true
+		})
+	}
+	return counts
+}
+
+// Orbits returns the graphlet degree vector of each node of g for the
+// graphlets with at most n nodes, keyed on node ID. Element o of the vector
+// of a node is the number of induced subgraphs of g isomorphic to the
+// graphlet of orbit o in which the node is in orbit o. The length of each
+// vector is NumOrbitsOf(n).
+//
+// The orbits of graphlets with up to four nodes are counted with a
+// combinatorial method similar to that described in Hočevar, T. and
+// Demšar, J. (2014) A combinatorial approach to graphlet counting.
+// Bioinformatics 30(4) 559-565. doi:10.1093/bioinformatics/btt717 and
+// Pinar, A., Seshadhri, C. and Vishal, V. (2017) ESCAPE: Efficiently
+// counting all 5-vertex subgraphs. WWW'17 1431-1440.
+// doi:10.1145/3038912.3052597.
+// The number of non-induced occurrences of each orbit at each node is
+// computed from the degrees of the nodes, the common neighbors of pairs of
+// nodes and the triangles and four-cliques of g, and the induced counts
+// are obtained by removing the contributions of the denser graphlets that
+// contain each graphlet. The time taken is dominated by the listing of the
+// four-cliques and the counting of common neighbors of nodes at distance
+// two, which is O(Σ d²) for node degrees d.
+//
+// The orbits of graphlets with five nodes are counted by enumerating the
+// connected induced subgraphs of g with five nodes using Enumerate, so the
+// time taken is proportional to their number.
+//
+// Orbits will panic if n is not between two and five.
+func Orbits(g graph.Undirected, n int) map[int64][]int64 {
+	checkSize(n)
+	a := newAdjacency(g)
+	orbits := a.orbits(min(n, 4))
+	if n == 5 {
+		for i, o := range orbits {
+			orbits[i] = append(o, make([]int64, NumOrbitsOf(5)-len(o))...)
+		}
+		a.enumerate(5, func(sub []int, mask uint16) bool {
+			for i, u := range sub {
+				orbits[u][orbitOf[5][mask][i]]++
+			}
+			return true
+		})
+	}
+	gdv := make(map[int64][]int64, len(orbits))
+	for i, o := range orbits {
+		gdv[a.nodes[i].ID()] = o
+	}
+	return gdv
+}
+
+// adjacency is an indexed adjacency list representation of a simple
+// undirected graph.
+type adjacency struct {
+	nodes []graph.Node
+	adj   [][]int
+}
+
+// newAdjacency returns the adjacency list representation of g with nodes
+// ordered by ID and sorted neighbor lists. Self loops are ignored.
+func newAdjacency(g graph.Undirected) adjacency {
+	nodes := graph.NodesOf(g.Nodes())
+	slices.SortFunc(nodes, func(a, b graph.Node) int {
+		return cmp.Compare(a.ID(), b.ID())
+	})
+	indexOf := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+	}
+	adj := make([][]int, len(nodes))
+	for i, u := range nodes {
+		uid := u.ID()
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if vid == uid {
+				continue
+			}
+			adj[i] = append(adj[i], indexOf[vid])
+		}
+		slices.Sort(adj[i])
+		adj[i] = slices.Compact(adj[i])
+	}
+	return adjacency{nodes: nodes, adj: adj}
+}
+
+// isEdge returns whether there is an edge between the nodes u and v.
+func (a adjacency) isEdge(u, v int) bool {
+	_, ok := slices.BinarySearch(a.adj[u], v)
+	return ok
+}
+
+// orbits returns the induced orbit counts of each node for the graphlets
+// with at most n nodes, where n is at most four.
+func (a adjacency) orbits(n int) [][]int64 {
+	orbits := make([][]int64, len(a.nodes))
+	for i := range orbits {
+		orbits[i] = make([]int64, NumOrbitsOf(n))
+	}
+	if len(a.nodes) == 0 {
+		return orbits
+	}
+
+	deg := make([]int64, len(a.adj))
+	for v, nv := range a.adj {
+		deg[v] = int64(len(nv))
+	}
+
+	// common[v][j] is the number of common neighbors of
+	// v and its j'th neighbor, the number of triangles
+	// holding the edge between them, and tri[v] is the
+	// number of triangles holding v.
+	common := make([][]int64, len(a.adj))
+	tri := make([]int64, len(a.adj))
+	mark := make([]int, len(a.adj))
+	for i := range mark {
+		mark[i] = -1
+	}
+	for v, nv := range a.adj {
+		for _, u := range nv {
+			mark[u] = v
+		}
+		common[v] = make([]int64, len(nv))
+		for j, u := range nv {
+			for _, w := range a.adj[u] {
+				if mark[w] == v {
+					common[v][j]++
+				}
+			}
+			tri[v] += common[v][j]
+		}
+		tri[v] /= 2
+	}
+	// commonOf returns the number of common neighbors
+	// of the adjacent nodes u and v.
+	commonOf := func(u, v int) int64 {
+		j, _ := slices.BinarySearch(a.adj[u], v)
+		return common[u][j]
+	}
+
+	// The non-induced orbit counts are computed in place
+	// and then converted to induced counts.
+	for v, nv := range a.adj {
+		o := orbits[v]
+		d := deg[v]
+		o[0] = d
+		if n < 3 {
+			continue
+		}
+		for _, u := range nv {
+			o[1] += deg[u] - 1
+		}
+		o[2] = d * (d - 1) / 2
+		o[3] = tri[v]
+	}
+	if n == 4 {
+		a.orbits4(orbits, deg, tri, common, commonOf)
+	}
+
+	// Remove the contributions of the graphlets containing
+	// each graphlet, starting with the densest graphlets,
+	// whose non-induced and induced counts are equal.
+	order := make([]int, NumOrbitsOf(n))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(graphlets[orbitGraphlet[b]].edges, graphlets[orbitGraphlet[a]].edges)
+	})
+	for _, o := range orbits {
+		for _, i := range order {
+			for _, c := range containment[i] {
+				o[c.orbit] -= c.count * o[i]
+			}
+		}
+	}
+	return orbits
+}
+
+// orbits4 computes the non-induced counts of the orbits of the graphlets
+// with four nodes.
+func (a adjacency) orbits4(orbits [][]int64, deg, tri []int64, common [][]int64, commonOf func(u, v int) int64) {
+	// sum[u] is the number of paths of length two
+	// starting at u, counted with their end nodes.
+	sum := make([]int64, len(a.adj))
+	for u, nu := range a.adj {
+		for _, w := range nu {
+			sum[u] += deg[w] - 1
+		}
+	}
+
+	twoHop := make([]int64, len(a.adj))
+	inN := make([]int, len(a.adj))
+	inC := make([]int, len(a.adj))
+	for i := range inN {
+		inN[i] = -1
+		inC[i] = -1
+	}
+	var reached []int
+	for v, nv := range a.adj {
+		o := orbits[v]
+		d := deg[v]
+		for j, u := range nv {
+			t := common[v][j]
+			// Paths v-u-w-x with v at an end.
+			o[4] += sum[u] - (d - 1) - t
+			// Paths a-v-u-b with v in the middle.
+			o[5] += (d-1)*(deg[u]-1) - t
+			// Stars centered on u with v a leaf.
+			o[6] += (deg[u] - 1) * (deg[u] - 2) / 2
+			// Paws with v the pendant node attached to u.
+			o[9] += tri[u] - t
+			// Paws with v in the triangle and u the
+			// node with the pendant.
+			o[10] += t * (deg[u] - 2)
+			// Diamonds with v and u the nodes of
+			// degree three.
+			o[13] += t * (t - 1) / 2
+		}
+		o[7] = d * (d - 1) * (d - 2) / 6
+		o[11] = tri[v] * (d - 2)
+
+		// Four-cycles through v, counted by the common
+		// neighbors of v and the nodes at distance two.
+		reached = reached[:0]
+		for _, u := range nv {
+			for _, w := range a.adj[u] {
+				if w == v {
+					continue
+				}
+				if twoHop[w] == 0 {
+					reached = append(reached, w)
+				}
+				twoHop[w]++
+			}
+		}
+		for _, w := range reached {
+			o[8] += twoHop[w] * (twoHop[w] - 1) / 2
+			twoHop[w] = 0
+		}
+
+		// Diamonds with v a node of degree two are counted
+		// over the triangles holding v, and four-cliques
+		// holding v over the edges of the subgraphs induced
+		// by the common neighbors of v and each neighbor.
+		for _, u := range nv {
+			inN[u] = v
+		}
+		var diamonds, cliques int64
+		for _, u := range nv {
+			for _, w := range a.adj[u] {
+				if inN[w] == v {
+					diamonds += commonOf(u, w) - 1
+					inC[w] = u
+				}
+			}
+			for _, w := range a.adj[u] {
+				if inC[w] != u || inN[w] != v {
+					continue
+				}
+				for _, x := range a.adj[w] {
+					if inC[x] == u && inN[x] == v {
+						cliques++
+					}
+				}
+			}
+			for _, w := range a.adj[u] {
+				if inN[w] == v {
+					inC[w] = -1
+				}
+			}
+		}
+		// Each triangle holding v is seen from both of
+		// its other nodes, and each four-clique from each
+		// of its other three nodes with each edge between
+		// the remaining two seen in both directions.
+		o[12] = diamonds / 2
+		o[14] = cliques / 6
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphlet
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/graphs/gen"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// bruteForce returns the graphlet counts and the orbit counts of each node
+// of g for graphlets with up to n nodes by examining every subset of the
+// nodes of g.
+func bruteForce(g graph.Undirected, n int) (counts []int64, orbits map[int64][]int64) {
+	a := newAdjacency(g)
+	counts = make([]int64, NumGraphletsOf(n))
+	orbits = make(map[int64][]int64)
+	for _, u := range a.nodes {
+		orbits[u.ID()] = make([]int64, NumOrbitsOf(n))
+	}
+	sub := make([]int, 0, n)
+	var choose func(next int)
+	choose = func(next int) {
+		if k := len(sub); k >= 2 {
+			var mask uint16
+			for i := 1; i < k; i++ {
+				for j := range i {
+					if a.isEdge(sub[i], sub[j]) {
+						mask |= pairBit(i, j)
+					}
+				}
+			}
+			if g := graphletOf[k][mask]; g >= 0 {
+				counts[g]++
+				for i, u := range sub {
+					orbits[a.nodes[u].ID()][orbitOf[k][mask][i]]++
+				}
+			}
+		}
+		if len(sub) == n {
+			return
+		}
+		for u := next; u < len(a.nodes); u++ {
+			sub = append(sub, u)
+			choose(u + 1)
+			sub = sub[:len(sub)-1]
+		}
+	}
+	choose(0)
+	return counts, orbits
+}
+
+func graphletTestGraphs() map[string]graph.Undirected {
+	graphs := make(map[string]graph.Undirected)
+
+	complete := simple.NewUndirectedGraph()
+	for i := range 6 {
+		for j := range i {
+			complete.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(j)})
+		}
+	}
+	graphs["K6"] = complete
+
+	petersen := simple.NewUndirectedGraph()
+	for i := range 5 {
+		petersen.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node((i + 1) % 5)})
+		petersen.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(i + 5)})
+		petersen.SetEdge(simple.Edge{F: simple.Node(i + 5), T: simple.Node((i+2)%5 + 5)})
+	}
+	graphs["Petersen"] = petersen
+
+	isolated := simple.NewUndirectedGraph()
+	isolated.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(1)})
+	isolated.AddNode(simple.Node(2))
+	graphs["isolated"] = isolated
+
+	graphs["empty"] = simple.NewUndirectedGraph()
+
+	for i, p := range []float64{0.2, 0.4, 0.7} {
+		g := simple.NewUndirectedGraph()
+		err := gen.Gnp(g, 12, p, rand.NewPCG(uint64(i), 1))
+		if err != nil {
+			panic(err)
+		}
+		graphs[fmt.Sprintf("Gnp(12, %v)", p)] = g
+	}
+	return graphs
+}
+
+func TestCountAndOrbits(t *testing.T) {
+	t.Parallel()
+	for name, g := range graphletTestGraphs() {
+		for n := 2; n <= 5; n++ {
+			wantCounts, wantOrbits := bruteForce(g, n)
+			if got := Count(g, n); !slices.Equal(got, wantCounts) {
+				t.Errorf("unexpected counts for %s with n=%d:\ngot: %v\nwant:%v", name, n, got, wantCounts)
+			}
+			gotOrbits := Orbits(g, n)
+			if len(gotOrbits) != len(wantOrbits) {
+				t.Errorf("unexpected number of nodes for %s with n=%d: got:%d want:%d", name, n, len(gotOrbits), len(wantOrbits))
+			}
+			for id, want := range wantOrbits {
+				if got := gotOrbits[id]; !slices.Equal(got, want) {
+					t.Errorf("unexpected orbit counts for node %d of %s with n=%d:\ngot: %v\nwant:%v", id, name, n, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestCountKnown(t *testing.T) {
+	t.Parallel()
+	g := graphletTestGraphs()["Petersen"]
+	// The Petersen graph has girth five, so its only
+	// graphlets with up to four nodes are paths and stars.
+	got := Count(g, 5)
+	want := map[Graphlet]int64{0: 15, 1: 30, 3: 60, 4: 10}
+	for i, c := range got[:NumGraphletsOf(4)] {
+		if c != want[Graphlet(i)] {
+			t.Errorf("unexpected count of graphlet %d: got:%d want:%d", i, c, want[Graphlet(i)])
+		}
+	}
+	// The twelve five-cycles of the Petersen graph are induced.
+	if c := got[12]; c != 12 {
+		t.Errorf("unexpected number of five-cycles: got:%d want:12", c)
+	}
+
+	if !panics(func() { Count(g, 1) }) {
+		t.Error("expected panic for too few nodes")
+	}
+	if !panics(func() { Orbits(g, 6) }) {
+		t.Error("expected panic for too many nodes")
+	}
+}
@@ -0,0 +1,20 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package graphlet provides graphlet counting and motif enumeration for
+// undirected graphs.
+//
+// Graphlets are the small connected non-isomorphic graphs that occur as
+// induced subgraphs of a larger graph. The nodes of each graphlet are
+// partitioned into automorphism orbits, and the number of times each node
+// of a graph touches each orbit, its graphlet degree vector, describes the
+// topology of the node's neighborhood. Graphlet counts and graphlet degree
+// vectors are used for network comparison and as node features.
+//
+// The package handles the 30 graphlets with two to five nodes and their 73
+// orbits. The numbering of the graphlets and orbits with up to four nodes
+// follows Pržulj, N. (2007) Biological network comparison using graphlet
+// degree distribution. Bioinformatics 23(2) e177-e183.
+// doi:10.1093/bioinformatics/btl301
+package graphlet // import "gonum.org/v1/gonum/graph/graphlet"
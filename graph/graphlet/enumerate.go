@@ -0,0 +1,97 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphlet
+
+import (
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// Enumerate calls fn for each connected induced subgraph of g with n nodes,
+// passing the nodes of the subgraph, the graphlet it is isomorphic to and
+// the orbit of each node in the graphlet. The nodes and orbits slices are
+// reused between calls and must not be retained by fn. Enumeration stops
+// if fn returns false.
+//
+// Each subgraph is visited exactly once using the ESU algorithm described
+// in Wernicke, S. (2006) Efficient detection of network motifs. IEEE/ACM
+// Transactions on Computational Biology and Bioinformatics 3(4) 347-359.
+// doi:10.1109/TCBB.2006.51
+// The time taken is proportional to the number of connected induced
+// subgraphs with n nodes.
+//
+// Enumerate will panic if n is not between two and five.
+func Enumerate(g graph.Undirected, n int, fn func(nodes []graph.Node, which Graphlet, orbits []int) bool) {
+	checkSize(n)
+	a := newAdjacency(g)
+	nodes := make([]graph.Node, n)
+	orbits := make([]int, n)
+	a.enumerate(n, func(sub []int, mask uint16) bool {
+		for i, u := range sub {
+			nodes[i] = a.nodes[u]
+			orbits[i] = int(orbitOf[n][mask][i])
+		}
+		return fn(nodes, graphletOf[n][mask], orbits)
+	})
+}
+
+// enumerate calls fn for each connected induced subgraph with n nodes,
+// passing the node indices of the subgraph and its adjacency mask.
+// Enumeration stops if fn returns false.
+func (a adjacency) enumerate(n int, fn func(sub []int, mask uint16) bool) {
+	sub := make([]int, 0, n)
+	for v := range a.adj {
+		i, _ := slices.BinarySearch(a.adj[v], v+1)
+		ext := slices.Clone(a.adj[v][i:])
+		if !a.extend(n, append(sub, v), ext, v, fn) {
+			return
+		}
+	}
+}
+
+// extend extends the subgraph sub with the nodes of the extension set ext,
+// all greater than the root node v, and calls fn for each subgraph with n
+// nodes. It returns false if enumeration should stop.
+func (a adjacency) extend(n int, sub, ext []int, v int, fn func(sub []int, mask uint16) bool) bool {
+	if len(sub) == n {
+		var mask uint16
+		for i := 1; i < n; i++ {
+			for j := range i {
+				if a.isEdge(sub[i], sub[j]) {
+					mask |= pairBit(i, j)
+				}
+			}
+		}
+		return fn(sub, mask)
+	}
+	for len(ext) > 0 {
+		w := ext[len(ext)-1]
+		ext = ext[:len(ext)-1]
+		// Add the exclusive neighbors of w, those not in
+		// sub or adjacent to a node in sub, to the extension.
+		next := slices.Clone(ext)
+		for _, u := range a.adj[w] {
+			if u <= v || a.inNeighborhood(u, sub) {
+				continue
+			}
+			next = append(next, u)
+		}
+		if !a.extend(n, append(sub, w), next, v, fn) {
+			return false
+		}
+	}
+	return true
+}
+
+// inNeighborhood returns whether u is in sub or adjacent to a node in sub.
+func (a adjacency) inNeighborhood(u int, sub []int) bool {
+	for _, s := range sub {
+		if s == u || a.isEdge(s, u) {
+			return true
+		}
+	}
+	return false
+}
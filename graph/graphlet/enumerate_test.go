@@ -0,0 +1,79 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphlet
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+func TestEnumerate(t *testing.T) {
+	t.Parallel()
+	for name, g := range graphletTestGraphs() {
+		for n := 2; n <= 5; n++ {
+			wantCounts, wantOrbits := bruteForce(g, n)
+			counts := make([]int64, NumGraphletsOf(n))
+			orbits := make(map[int64][]int64)
+			seen := make(map[string]bool)
+			Enumerate(g, n, func(nodes []graph.Node, which Graphlet, orb []int) bool {
+				if which.Nodes() != n {
+					t.Errorf("unexpected graphlet size for %s: got:%d want:%d", name, which.Nodes(), n)
+				}
+				ids := make([]int64, len(nodes))
+				for i, u := range nodes {
+					ids[i] = u.ID()
+					if OrbitGraphlet(orb[i]) != which {
+						t.Errorf("orbit %d not in graphlet %d for %s", orb[i], which, name)
+					}
+					if orbits[u.ID()] == nil {
+						orbits[u.ID()] = make([]int64, NumOrbitsOf(n))
+					}
+					orbits[u.ID()][orb[i]]++
+				}
+				slices.Sort(ids)
+				key := fmt.Sprint(ids)
+				if seen[key] {
+					t.Errorf("subgraph %v visited more than once for %s", ids, name)
+				}
+				seen[key] = true
+				counts[which]++
+				return true
+			})
+			for i, c := range counts {
+				want := wantCounts[i]
+				if graphlets[i].nodes != n {
+					want = 0
+				}
+				if c != want {
+					t.Errorf("unexpected count of graphlet %d for %s with n=%d: got:%d want:%d", i, name, n, c, want)
+				}
+			}
+			for id, want := range wantOrbits {
+				got := orbits[id]
+				if got == nil {
+					got = make([]int64, NumOrbitsOf(n))
+				}
+				for o, c := range want {
+					if OrbitGraphlet(o).Nodes() == n && got[o] != c {
+						t.Errorf("unexpected count of orbit %d of node %d for %s: got:%d want:%d", o, id, name, got[o], c)
+					}
+				}
+			}
+		}
+	}
+
+	g := graphletTestGraphs()["K6"]
+	var visits int
+	Enumerate(g, 3, func(_ []graph.Node, _ Graphlet, _ []int) bool {
+		visits++
+		return visits < 4
+	})
+	if visits != 4 {
+		t.Errorf("unexpected number of visits after stopping: got:%d want:4", visits)
+	}
+}
@@ -0,0 +1,393 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphlet
+
+import (
+	"cmp"
+	"maps"
+	"math/bits"
+	"slices"
+)
+
+const (
+	// NumGraphlets is the number of graphlets with two
+	// to five nodes.
+	NumGraphlets = 30
+	// NumOrbits is the number of orbits of the graphlets
+	// with two to five nodes.
+	NumOrbits = 73
+
+	// maxNodes is the largest number of nodes in a graphlet.
+	maxNodes = 5
+)
+
+// Graphlet is a connected graph with two to five nodes. Graphlets are
+// numbered from 0 to NumGraphlets-1 in order of increasing number of
+// nodes, then increasing number of edges, then lexicographically increasing
+// degree sequence, with the degrees in decreasing order. Ties, which only
+// occur between graphlets with five nodes, are broken by an arbitrary but
+// fixed order.
+//
+// The orbits of the graphlets are numbered from 0 to NumOrbits-1 in order
+// of the graphlets, and within a graphlet in order of increasing node
+// degree, then lexicographically increasing sorted degrees of the node's
+// neighbors. Ties are broken by an arbitrary but fixed order.
+//
+// With this ordering, graphlets 0 to 8 and orbits 0 to 14 follow the
+// numbering of Pržulj (2007).
+type Graphlet int
+
+// Nodes returns the number of nodes in the graphlet.
+func (g Graphlet) Nodes() int {
+	return graphlets[g].nodes
+}
+
+// Edges returns the edges of the graphlet between the nodes numbered from
+// zero to g.Nodes()-1.
+func (g Graphlet) Edges() [][2]int {
+	info := graphlets[g]
+	var edges [][2]int
+	for i := range info.nodes {
+		for j := i + 1; j < info.nodes; j++ {
+			if info.mask&pairBit(i, j) != 0 {
+				edges = append(edges, [2]int{i, j})
+			}
+		}
+	}
+	return edges
+}
+
+// Orbits returns the orbit of each node of the graphlet, with nodes
+// numbered as for Edges.
+func (g Graphlet) Orbits() []int {
+	return slices.Clone(graphlets[g].orbits)
+}
+
+// OrbitGraphlet returns the graphlet holding the orbit o.
+func OrbitGraphlet(o int) Graphlet {
+	return orbitGraphlet[o]
+}
+
+// NumGraphletsOf returns the number of graphlets with at most n nodes. It
+// returns 1, 3, 9 and 30 for n from two to five.
+func NumGraphletsOf(n int) int {
+	checkSize(n)
+	return graphletsUpTo[n]
+}
+
+// NumOrbitsOf returns the number of orbits of the graphlets with at most
+// n nodes. It returns 1, 4, 15 and 73 for n from two to five.
+func NumOrbitsOf(n int) int {
+	checkSize(n)
+	return orbitsUpTo[n]
+}
+
+// checkSize panics if n is not a valid number of graphlet nodes.
+func checkSize(n int) {
+	if n < 2 || maxNodes < n {
+		panic("graphlet: invalid number of nodes")
+	}
+}
+
+// graphletInfo describes a graphlet in a canonical labeling.
+type graphletInfo struct {
+	nodes  int
+	edges  int
+	mask   uint16
+	orbits []int
+}
+
+var (
+	// graphlets holds the description of each graphlet.
+	graphlets []graphletInfo
+
+	// orbitGraphlet holds the graphlet of each orbit.
+	orbitGraphlet []Graphlet
+
+	// graphletsUpTo and orbitsUpTo hold the number of graphlets
+	// and orbits with at most the index number of nodes.
+	graphletsUpTo [maxNodes + 1]int
+	orbitsUpTo    [maxNodes + 1]int
+
+	// graphletOf holds the graphlet of the graph with each
+	// adjacency mask for each number of nodes, or -1 if the
+	// graph is not connected. orbitOf holds the orbit of
+	// each node of the graph.
+	graphletOf [maxNodes + 1][]Graphlet
+	orbitOf    [maxNodes + 1][][maxNodes]int8
+
+	// containment holds for each orbit o the orbits o' of
+	// other graphlets with the same number of nodes and the
+	// number of spanning subgraphs of the graphlet of o that
+	// are isomorphic to the graphlet of o' with the node in
+	// orbit o mapped to orbit o'.
+	containment [][]orbitCount
+)
+
+// orbitCount is a count associated with an orbit.
+type orbitCount struct {
+	orbit int
+	count int64
+}
+
+// pairBit returns the bit of an adjacency mask for the edge between the
+// nodes i and j.
+func pairBit(i, j int) uint16 {
+	if i > j {
+		i, j = j, i
+	}
+	// The pairs are numbered in colexicographic order,
+	// so the numbering is independent of the number
+	// of nodes.
+	return 1 << (j*(j-1)/2 + i)
+}
+
+func init() {
+	for n := 2; n <= maxNodes; n++ {
+		addGraphlets(n)
+		graphletsUpTo[n] = len(graphlets)
+		orbitsUpTo[n] = len(orbitGraphlet)
+	}
+	addContainment()
+}
+
+// addGraphlets adds the graphlets with n nodes to the tables.
+func addGraphlets(n int) {
+	perms := permutations(n)
+	masks := 1 << (n * (n - 1) / 2)
+
+	// Find the canonical labeling of each connected graph,
+	// the labeling with the smallest adjacency mask, and the
+	// permutation of the nodes that gives it.
+	canon := make([]uint16, masks)
+	perm := make([][]int, masks)
+	isCanon := make(map[uint16]bool)
+	for m := range masks {
+		mask := uint16(m)
+		if !connected(mask, n) {
+			continue
+		}
+		canon[m] = mask
+		perm[m] = perms[0]
+		for _, p := range perms[1:] {
+			if c := permute(mask, p); c < canon[m] {
+				canon[m] = c
+				perm[m] = p
+			}
+		}
+		isCanon[canon[m]] = true
+	}
+
+	type candidate struct {
+		mask    uint16
+		edges   int
+		degrees []int
+	}
+	var cands []candidate
+	for mask := range isCanon {
+		deg := degrees(mask, n)
+		slices.SortFunc(deg, func(a, b int) int { return cmp.Compare(b, a) })
+		cands = append(cands, candidate{mask: mask, edges: bits.OnesCount16(mask), degrees: deg})
+	}
+	slices.SortFunc(cands, func(a, b candidate) int {
+		if c := cmp.Compare(a.edges, b.edges); c != 0 {
+			return c
+		}
+		if c := slices.Compare(a.degrees, b.degrees); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.mask, b.mask)
+	})
+
+	index := make(map[uint16]Graphlet)
+	for _, c := range cands {
+		g := Graphlet(len(graphlets))
+		index[c.mask] = g
+		graphlets = append(graphlets, graphletInfo{
+			nodes:  n,
+			edges:  c.edges,
+			mask:   c.mask,
+			orbits: nodeOrbits(c.mask, n, perms, g),
+		})
+	}
+
+	graphletOf[n] = make([]Graphlet, masks)
+	orbitOf[n] = make([][maxNodes]int8, masks)
+	for m := range masks {
+		if perm[m] == nil {
+			graphletOf[n][m] = -1
+			continue
+		}
+		g := index[canon[m]]
+		graphletOf[n][m] = g
+		for i, pi := range perm[m] {
+			orbitOf[n][m][i] = int8(graphlets[g].orbits[pi])
+		}
+	}
+}
+
+// nodeOrbits returns the orbits of the nodes of the graph with the given
+// adjacency mask and n nodes that is graphlet g, and adds the orbits to
+// orbitGraphlet.
+func nodeOrbits(mask uint16, n int, perms [][]int, g Graphlet) []int {
+	// The automorphisms form a group, so the orbit of
+	// a node is its set of images under the automorphisms,
+	// and the smallest image represents the orbit.
+	group := make([]int, n)
+	for i := range group {
+		group[i] = i
+	}
+	for _, p := range perms {
+		if permute(mask, p) != mask {
+			continue
+		}
+		for i, pi := range p {
+			group[i] = min(group[i], pi)
+		}
+	}
+
+	deg := degrees(mask, n)
+	type key struct {
+		rep       int
+		degree    int
+		neighbors []int
+	}
+	var keys []key
+	for i := range n {
+		if group[i] != i {
+			continue
+		}
+		var nd []int
+		for j := range n {
+			if j != i && mask&pairBit(i, j) != 0 {
+				nd = append(nd, deg[j])
+			}
+		}
+		slices.Sort(nd)
+		keys = append(keys, key{rep: i, degree: deg[i], neighbors: nd})
+	}
+	slices.SortFunc(keys, func(a, b key) int {
+		if c := cmp.Compare(a.degree, b.degree); c != 0 {
+			return c
+		}
+		if c := slices.Compare(a.neighbors, b.neighbors); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.rep, b.rep)
+	})
+
+	orbitOfRep := make(map[int]int)
+	for _, k := range keys {
+		orbitOfRep[k.rep] = len(orbitGraphlet)
+		orbitGraphlet = append(orbitGraphlet, g)
+	}
+	orbits := make([]int, n)
+	for i := range orbits {
+		orbits[i] = orbitOfRep[group[i]]
+	}
+	return orbits
+}
+
+// addContainment fills the containment table.
+func addContainment() {
+	containment = make([][]orbitCount, len(orbitGraphlet))
+	for _, info := range graphlets {
+		seen := make(map[int]bool)
+		for r, o := range info.orbits {
+			if seen[o] {
+				continue
+			}
+			seen[o] = true
+			counts := make(map[int]int64)
+			// Iterate over the proper subsets of the edges.
+			for sub := (info.mask - 1) & info.mask; sub != 0; sub = (sub - 1) & info.mask {
+				h := graphletOf[info.nodes][sub]
+				if h < 0 {
+					continue
+				}
+				counts[int(orbitOf[info.nodes][sub][r])]++
+			}
+			for _, c := range slices.Sorted(maps.Keys(counts)) {
+				containment[o] = append(containment[o], orbitCount{orbit: c, count: counts[c]})
+			}
+		}
+	}
+}
+
+// permutations returns all the permutations of 0, ..., n-1 with the
+// identity first.
+func permutations(n int) [][]int {
+	var perms [][]int
+	p := make([]int, n)
+	used := make([]bool, n)
+	var build func(i int)
+	build = func(i int) {
+		if i == n {
+			perms = append(perms, slices.Clone(p))
+			return
+		}
+		for v := range n {
+			if used[v] {
+				continue
+			}
+			used[v] = true
+			p[i] = v
+			build(i + 1)
+			used[v] = false
+		}
+	}
+	build(0)
+	return perms
+}
+
+// permute returns the adjacency mask of the graph with adjacency mask
+// mask with node i relabeled as p[i].
+func permute(mask uint16, p []int) uint16 {
+	var m uint16
+	for i := range p {
+		for j := i + 1; j < len(p); j++ {
+			if mask&pairBit(i, j) != 0 {
+				m |= pairBit(p[i], p[j])
+			}
+		}
+	}
+	return m
+}
+
+// degrees returns the degrees of the nodes of the graph with adjacency
+// mask mask and n nodes.
+func degrees(mask uint16, n int) []int {
+	deg := make([]int, n)
+	for i := range n {
+		for j := i + 1; j < n; j++ {
+			if mask&pairBit(i, j) != 0 {
+				deg[i]++
+				deg[j]++
+			}
+		}
+	}
+	return deg
+}
+
+// connected returns whether the graph with adjacency mask mask and n
+// nodes is connected.
+func connected(mask uint16, n int) bool {
+	reached := 1
+	for changed := true; changed; {
+		changed = false
+		for i := range n {
+			if reached&(1<<i) == 0 {
+				continue
+			}
+			for j := range n {
+				if j != i && reached&(1<<j) == 0 && mask&pairBit(i, j) != 0 {
+					reached |= 1 << j
+					changed = true
+				}
+			}
+		}
+	}
+	return reached == 1<<n-1
+}
@@ -0,0 +1,89 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphlet
+
+import (
+	"slices"
+	"testing"
+)
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return false
+}
+
+func TestGraphletTables(t *testing.T) {
+	t.Parallel()
+	for n, want := range []struct{ graphlets, orbits int }{
+		2: {1, 1},
+		3: {3, 4},
+		4: {9, 15},
+		5: {NumGraphlets, NumOrbits},
+	} {
+		if n < 2 {
+			continue
+		}
+		if got := NumGraphletsOf(n); got != want.graphlets {
+			t.Errorf("unexpected number of graphlets with at most %d nodes: got:%d want:%d", n, got, want.graphlets)
+		}
+		if got := NumOrbitsOf(n); got != want.orbits {
+			t.Errorf("unexpected number of orbits with at most %d nodes: got:%d want:%d", n, got, want.orbits)
+		}
+	}
+	if !panics(func() { NumGraphletsOf(6) }) {
+		t.Error("expected panic for too many nodes")
+	}
+
+	// The orbits of graphlets with up to four nodes follow
+	// the numbering of Pržulj (2007), which is determined by
+	// the graphlet of each orbit and the degree of its nodes.
+	wantGraphlet := []Graphlet{0, 1, 1, 2, 3, 3, 4, 4, 5, 6, 6, 6, 7, 7, 8}
+	wantDegree := []int{1, 1, 2, 2, 1, 2, 1, 3, 2, 1, 2, 3, 2, 3, 3}
+	wantEdges := []int{1, 2, 3, 3, 3, 4, 4, 5, 6}
+	for o := range wantGraphlet {
+		if got := OrbitGraphlet(o); got != wantGraphlet[o] {
+			t.Errorf("unexpected graphlet of orbit %d: got:%d want:%d", o, got, wantGraphlet[o])
+		}
+	}
+	for g, edges := range wantEdges {
+		if got := len(Graphlet(g).Edges()); got != edges {
+			t.Errorf("unexpected number of edges of graphlet %d: got:%d want:%d", g, got, edges)
+		}
+	}
+
+	seen := make(map[int]bool)
+	for g := range Graphlet(NumGraphlets) {
+		n := g.Nodes()
+		deg := make([]int, n)
+		var mask uint16
+		for _, e := range g.Edges() {
+			deg[e[0]]++
+			deg[e[1]]++
+			mask |= pairBit(e[0], e[1])
+		}
+		if got := graphletOf[n][mask]; got != g {
+			t.Errorf("graphlet %d not classified as itself: got:%d", g, got)
+		}
+		orbits := g.Orbits()
+		for i, o := range orbits {
+			seen[o] = true
+			if OrbitGraphlet(o) != g {
+				t.Errorf("orbit %d of graphlet %d attributed to graphlet %d", o, g, OrbitGraphlet(o))
+			}
+			if o < len(wantDegree) && deg[i] != wantDegree[o] {
+				t.Errorf("unexpected degree of node in orbit %d: got:%d want:%d", o, deg[i], wantDegree[o])
+			}
+		}
+		if g > 0 && slices.Min(orbits) <= slices.Max(Graphlet(g-1).Orbits()) {
+			t.Errorf("orbits of graphlet %d not after orbits of graphlet %d", g, g-1)
+		}
+	}
+	if len(seen) != NumOrbits {
+		t.Errorf("unexpected number of distinct orbits: got:%d want:%d", len(seen), NumOrbits)
+	}
+}
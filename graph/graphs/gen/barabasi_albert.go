@@ -0,0 +1,86 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/stat/sampleuv"
+)
+
+// BarabasiAlbert constructs a graph in the destination, dst, of order n by
+// generalized preferential attachment. The graph is constructed successively
+// starting from m unconnected nodes. At each iteration of graph addition, one
+// node is added with m edges joining distinct existing nodes with probability
+// proportional to k^alpha + a where k is the degree of the existing node.
+// The first generation of edges is formed with equal probability for all
+// initial nodes.
+//
+// When alpha is 1 and a is 0 the attachment is the linear preferential
+// attachment of the Barabási–Albert model. Values of alpha less than 1 give
+// sub-linear attachment and values greater than 1 give super-linear attachment.
+// The a parameter is the initial attractiveness of a node and must not be
+// negative.
+//
+// If src is not nil it is used as the random source, otherwise rand.Float64 is
+// used for the random number generator.
+//
+// The attachment kernel is described in doi:10.1103/PhysRevLett.85.4629.
+func BarabasiAlbert(dst graph.UndirectedBuilder, n, m int, alpha, a float64, src rand.Source) error {
+	if m < 1 {
+		return fmt.Errorf("gen: bad attachment count: m=%d", m)
+	}
+	if n <= m {
+		return fmt.Errorf("gen: n <= m: n=%v m=%d", n, m)
+	}
+	if alpha < 0 || math.IsInf(alpha, 1) {
+		return fmt.Errorf("gen: bad attachment exponent: alpha=%v", alpha)
+	}
+	if a < 0 || math.IsInf(a, 1) {
+		return fmt.Errorf("gen: bad initial attractiveness: a=%v", a)
+	}
+
+	// Initial condition.
+	nodes := make([]graph.Node, n)
+	deg := make([]int, n)
+	wt := make([]float64, n)
+	for u := 0; u < m; u++ {
+		un := dst.NewNode()
+		dst.AddNode(un)
+		nodes[u] = un
+		// We need to give equal probability for
+		// adding the first generation of edges.
+		wt[u] = 1
+	}
+	ws := sampleuv.NewWeighted(wt, src)
+
+	// Growth.
+	for v := m; v < n; v++ {
+		vn := dst.NewNode()
+		dst.AddNode(vn)
+		nodes[v] = vn
+		for i := 0; i < m; i++ {
+			// Weighted.Take samples without replacement,
+			// so each new edge joins a distinct node.
+			u, ok := ws.Take()
+			if !ok {
+				return errors.New("gen: depleted distribution")
+			}
+			dst.SetEdge(dst.NewEdge(nodes[u], vn))
+			deg[u]++
+			deg[v]++
+		}
+		for u, k := range deg[:v+1] {
+			wt[u] = math.Pow(float64(k), alpha) + a
+		}
+		ws.ReweightAll(wt)
+	}
+
+	return nil
+}
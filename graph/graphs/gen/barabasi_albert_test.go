@@ -0,0 +1,41 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestBarabasiAlbert(t *testing.T) {
+	t.Parallel()
+	for n := 2; n <= 20; n++ {
+		for m := 1; m < n; m++ {
+			for _, alpha := range []float64{0, 0.5, 1, 2} {
+				for _, a := range []float64{0, 1} {
+					g := &gnUndirected{UndirectedBuilder: simple.NewUndirectedGraph()}
+					err := BarabasiAlbert(g, n, m, alpha, a, rand.NewPCG(uint64(n), uint64(m)))
+					if err != nil {
+						t.Fatalf("unexpected error: n=%d, m=%d, alpha=%v, a=%v: %v", n, m, alpha, a, err)
+					}
+					if g.addBackwards {
+						t.Errorf("edge added with From.ID > To.ID: n=%d, m=%d, alpha=%v, a=%v", n, m, alpha, a)
+					}
+					if g.addSelfLoop {
+						t.Errorf("unexpected self edge: n=%d, m=%d, alpha=%v, a=%v", n, m, alpha, a)
+					}
+					if g.addMultipleEdge {
+						t.Errorf("unexpected multiple edge: n=%d, m=%d, alpha=%v, a=%v", n, m, alpha, a)
+					}
+					if got, want := g.UndirectedBuilder.(*simple.UndirectedGraph).Edges().Len(), (n-m)*m; got != want {
+						t.Errorf("unexpected number of edges: n=%d, m=%d, alpha=%v, a=%v: got:%d want:%d", n, m, alpha, a, got, want)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/mat"
+)
+
+// StochasticBlockModel constructs a stochastic block model graph in the
+// destination, dst. The number of blocks and the number of nodes in each
+// block is given by sizes. Edges between a node in block i and a node in
+// block j are formed with probability p.At(i, j). If dst is undirected, p
+// must be symmetric. The nodes of each block are returned in blocks.
+// If src is not nil it is used as the random source, otherwise rand.Float64
+// is used. The graph is constructed in O(n^2) time where n is the sum of
+// sizes.
+func StochasticBlockModel(dst GraphBuilder, sizes []int, p mat.Matrix, src rand.Source) (blocks [][]graph.Node, err error) {
+	r, c := p.Dims()
+	if r != c || r != len(sizes) {
+		return nil, fmt.Errorf("gen: probability matrix dimension mismatch: %d×%d for %d blocks", r, c, len(sizes))
+	}
+	_, isDirected := dst.(graph.Directed)
+	for i := 0; i < r; i++ {
+		if sizes[i] < 0 {
+			return nil, fmt.Errorf("gen: bad block size: sizes[%d]=%d", i, sizes[i])
+		}
+		for j := 0; j < c; j++ {
+			pij := p.At(i, j)
+			if pij < 0 || pij > 1 {
+				return nil, fmt.Errorf("gen: bad probability: p[%d,%d]=%v", i, j, pij)
+			}
+			if !isDirected && pij != p.At(j, i) {
+				return nil, fmt.Errorf("gen: asymmetric probability matrix for undirected graph: p[%d,%d]=%v p[%d,%d]=%v", i, j, pij, j, i, p.At(j, i))
+			}
+		}
+	}
+
+	var rnd func() float64
+	if src == nil {
+		rnd = rand.Float64
+	} else {
+		rnd = rand.New(src).Float64
+	}
+
+	var (
+		nodes []graph.Node
+		blk   []int
+	)
+	blocks = make([][]graph.Node, len(sizes))
+	for b, n := range sizes {
+		blocks[b] = make([]graph.Node, n)
+		for i := range blocks[b] {
+			u := dst.NewNode()
+			dst.AddNode(u)
+			blocks[b][i] = u
+			nodes = append(nodes, u)
+			blk = append(blk, b)
+		}
+	}
+
+	// Add forward edges for all graphs.
+	for i, u := range nodes {
+		for j := i + 1; j < len(nodes); j++ {
+			if rnd() < p.At(blk[i], blk[j]) {
+				dst.SetEdge(dst.NewEdge(u, nodes[j]))
+			}
+		}
+	}
+
+	// Add backward edges for directed graphs.
+	if !isDirected {
+		return blocks, nil
+	}
+	for i, u := range nodes {
+		for j := i + 1; j < len(nodes); j++ {
+			if rnd() < p.At(blk[j], blk[i]) {
+				dst.SetEdge(dst.NewEdge(nodes[j], u))
+			}
+		}
+	}
+
+	return blocks, nil
+}
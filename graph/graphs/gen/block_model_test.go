@@ -0,0 +1,132 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestStochasticBlockModelUndirected(t *testing.T) {
+	t.Parallel()
+	sizes := []int{5, 10, 15}
+	for _, p := range []*mat.SymDense{
+		mat.NewSymDense(3, []float64{
+			0, 0, 0,
+			0, 0, 0,
+			0, 0, 0,
+		}),
+		mat.NewSymDense(3, []float64{
+			1, 0, 0,
+			0, 1, 0,
+			0, 0, 1,
+		}),
+		mat.NewSymDense(3, []float64{
+			0.9, 0.1, 0.05,
+			0.1, 0.8, 0.2,
+			0.05, 0.2, 0.7,
+		}),
+	} {
+		g := &gnUndirected{UndirectedBuilder: simple.NewUndirectedGraph()}
+		orig := g.NewNode()
+		g.AddNode(orig)
+		blocks, err := StochasticBlockModel(g, sizes, p, rand.NewPCG(1, 1))
+		if err != nil {
+			t.Fatalf("unexpected error: p=%v: %v", mat.Formatted(p), err)
+		}
+		if len(blocks) != len(sizes) {
+			t.Fatalf("unexpected number of blocks: got:%d want:%d", len(blocks), len(sizes))
+		}
+		for i, b := range blocks {
+			if len(b) != sizes[i] {
+				t.Errorf("unexpected block size for block %d: got:%d want:%d", i, len(b), sizes[i])
+			}
+		}
+		if g.From(orig.ID()).Len() != 0 {
+			t.Errorf("edge added from already existing node: p=%v", mat.Formatted(p))
+		}
+		if g.addBackwards {
+			t.Errorf("edge added with From.ID > To.ID: p=%v", mat.Formatted(p))
+		}
+		if g.addSelfLoop {
+			t.Errorf("unexpected self edge: p=%v", mat.Formatted(p))
+		}
+		if g.addMultipleEdge {
+			t.Errorf("unexpected multiple edge: p=%v", mat.Formatted(p))
+		}
+
+		for i, bi := range blocks {
+			for j, bj := range blocks {
+				pij := p.At(i, j)
+				if pij != 0 && pij != 1 {
+					continue
+				}
+				for _, u := range bi {
+					for _, v := range bj {
+						if u.ID() == v.ID() {
+							continue
+						}
+						if got := g.HasEdgeBetween(u.ID(), v.ID()); got != (pij == 1) {
+							t.Errorf("unexpected edge state between %d in block %d and %d in block %d: got:%t want:%t",
+								u.ID(), i, v.ID(), j, got, pij == 1)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestStochasticBlockModelDirected(t *testing.T) {
+	t.Parallel()
+	sizes := []int{5, 10}
+	p := mat.NewDense(2, 2, []float64{
+		1, 1,
+		0, 1,
+	})
+	g := &gnDirected{DirectedBuilder: simple.NewDirectedGraph()}
+	blocks, err := StochasticBlockModel(g, sizes, p, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if g.addSelfLoop {
+		t.Error("unexpected self edge")
+	}
+	if g.addMultipleEdge {
+		t.Error("unexpected multiple edge")
+	}
+	for _, u := range blocks[0] {
+		for _, v := range blocks[1] {
+			if !g.HasEdgeFromTo(u.ID(), v.ID()) {
+				t.Errorf("missing edge from %d to %d", u.ID(), v.ID())
+			}
+			if g.HasEdgeFromTo(v.ID(), u.ID()) {
+				t.Errorf("unexpected edge from %d to %d", v.ID(), u.ID())
+			}
+		}
+	}
+}
+
+func TestStochasticBlockModelBadParameters(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name  string
+		sizes []int
+		p     mat.Matrix
+	}{
+		{name: "dimension", sizes: []int{1, 2, 3}, p: mat.NewDense(2, 2, nil)},
+		{name: "negative size", sizes: []int{1, -2}, p: mat.NewDense(2, 2, nil)},
+		{name: "probability", sizes: []int{1, 2}, p: mat.NewDense(2, 2, []float64{1, 2, 2, 1})},
+		{name: "asymmetric", sizes: []int{1, 2}, p: mat.NewDense(2, 2, []float64{1, 0, 0.5, 1})},
+	} {
+		_, err := StochasticBlockModel(simple.NewUndirectedGraph(), test.sizes, test.p, nil)
+		if err == nil {
+			t.Errorf("expected error for %s test", test.name)
+		}
+	}
+}
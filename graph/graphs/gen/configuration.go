@@ -0,0 +1,121 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sort"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// ConfigurationModel constructs a simple undirected graph in the destination,
+// dst, with the degree sequence given by deg. The returned nodes are in the
+// order of deg. Stubs are paired uniformly at random and any pairing that
+// would produce a self edge or a multiple edge is rejected and the pairing is
+// restarted, up to tries attempts. Nodes are only added to dst when a valid
+// pairing is found. If src is not nil it is used as the random source,
+// otherwise rand.IntN is used.
+//
+// ConfigurationModel returns an error if deg is not graphical or if no simple
+// graph is found within tries attempts. Rejection sampling gives a uniform
+// sample over the simple graphs with the given degree sequence, but the
+// expected number of attempts grows rapidly with the maximum degree.
+func ConfigurationModel(dst graph.UndirectedBuilder, deg []int, tries int, src rand.Source) ([]graph.Node, error) {
+	if tries < 1 {
+		return nil, fmt.Errorf("gen: bad number of tries: tries=%d", tries)
+	}
+	if !IsGraphical(deg) {
+		return nil, errors.New("gen: degree sequence is not graphical")
+	}
+
+	var rndN func(int) int
+	if src == nil {
+		rndN = rand.IntN
+	} else {
+		rndN = rand.New(src).IntN
+	}
+
+	var stubs []int
+	for u, d := range deg {
+		for i := 0; i < d; i++ {
+			stubs = append(stubs, u)
+		}
+	}
+
+	type pair struct{ u, v int }
+	seen := make(map[pair]bool, len(stubs)/2)
+	for range tries {
+		for i := len(stubs) - 1; i > 0; i-- {
+			j := rndN(i + 1)
+			stubs[i], stubs[j] = stubs[j], stubs[i]
+		}
+		clear(seen)
+		ok := true
+		for i := 0; i < len(stubs); i += 2 {
+			u, v := stubs[i], stubs[i+1]
+			if u > v {
+				u, v = v, u
+			}
+			if u == v || seen[pair{u, v}] {
+				ok = false
+				break
+			}
+			seen[pair{u, v}] = true
+		}
+		if !ok {
+			continue
+		}
+
+		nodes := make([]graph.Node, len(deg))
+		for i := range nodes {
+			u := dst.NewNode()
+			dst.AddNode(u)
+			nodes[i] = u
+		}
+		for i := 0; i < len(stubs); i += 2 {
+			u, v := nodes[stubs[i]], nodes[stubs[i+1]]
+			if u.ID() > v.ID() {
+				u, v = v, u
+			}
+			dst.SetEdge(dst.NewEdge(u, v))
+		}
+		return nodes, nil
+	}
+	return nil, fmt.Errorf("gen: no simple graph found after %d tries", tries)
+}
+
+// IsGraphical returns whether the degree sequence deg can be realized
+// by a simple undirected graph. The test used is the Erdős–Gallai theorem.
+func IsGraphical(deg []int) bool {
+	d := make([]int, len(deg))
+	var sum int
+	for i, v := range deg {
+		if v < 0 || v >= len(deg) {
+			return false
+		}
+		d[i] = v
+		sum += v
+	}
+	if sum%2 != 0 {
+		return false
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(d)))
+
+	var lhs int
+	for k := 1; k <= len(d); k++ {
+		lhs += d[k-1]
+		rhs := k * (k - 1)
+		for _, v := range d[k:] {
+			rhs += min(v, k)
+		}
+		if lhs > rhs {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,76 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var isGraphicalTests = []struct {
+	deg  []int
+	want bool
+}{
+	{deg: nil, want: true},
+	{deg: []int{0}, want: true},
+	{deg: []int{1, 1}, want: true},
+	{deg: []int{2, 2, 2}, want: true},
+	{deg: []int{3, 3, 3, 3}, want: true},
+	{deg: []int{3, 2, 2, 2, 1}, want: true},
+	{deg: []int{1}, want: false},
+	{deg: []int{1, 1, 1}, want: false},
+	{deg: []int{2, 2}, want: false},
+	{deg: []int{3, 3, 1, 1}, want: false},
+	{deg: []int{4, 4, 4, 1, 1}, want: false},
+	{deg: []int{-1, 1}, want: false},
+}
+
+func TestIsGraphical(t *testing.T) {
+	t.Parallel()
+	for _, test := range isGraphicalTests {
+		got := IsGraphical(test.deg)
+		if got != test.want {
+			t.Errorf("unexpected result for %v: got:%t want:%t", test.deg, got, test.want)
+		}
+	}
+}
+
+func TestConfigurationModel(t *testing.T) {
+	t.Parallel()
+	rnd := rand.NewPCG(1, 1)
+	for _, test := range isGraphicalTests {
+		g := &gnUndirected{UndirectedBuilder: simple.NewUndirectedGraph()}
+		nodes, err := ConfigurationModel(g, test.deg, 1000, rnd)
+		if err != nil {
+			if test.want {
+				t.Errorf("unexpected error for %v: %v", test.deg, err)
+			}
+			if g.Nodes().Len() != 0 {
+				t.Errorf("unexpected nodes added for failed generation of %v", test.deg)
+			}
+			continue
+		}
+		if !test.want {
+			t.Errorf("expected error for %v", test.deg)
+			continue
+		}
+		if g.addBackwards {
+			t.Errorf("edge added with From.ID > To.ID: deg=%v", test.deg)
+		}
+		if g.addSelfLoop {
+			t.Errorf("unexpected self edge: deg=%v", test.deg)
+		}
+		if g.addMultipleEdge {
+			t.Errorf("unexpected multiple edge: deg=%v", test.deg)
+		}
+		for i, u := range nodes {
+			if got := g.From(u.ID()).Len(); got != test.deg[i] {
+				t.Errorf("unexpected degree for node %d of %v: got:%d want:%d", i, test.deg, got, test.deg[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sort"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/spatial/r2"
+	"gonum.org/v1/gonum/spatial/r3"
+)
+
+// GeometricR2 constructs a random geometric graph of order n in the destination,
+// dst. Nodes are placed uniformly at random in the unit square and edges are formed
+// between all pairs of nodes separated by a Euclidean distance of at most r. If dst
+// is directed, edges are added in both directions. The positions of the nodes are
+// returned keyed by node ID. If src is not nil it is used as the random source,
+// otherwise rand.Float64 is used.
+func GeometricR2(dst GraphBuilder, n int, r float64, src rand.Source) (map[int64]r2.Vec, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("gen: bad order: n=%d", n)
+	}
+	if r < 0 {
+		return nil, fmt.Errorf("gen: bad radius: r=%v", r)
+	}
+	var rnd func() float64
+	if src == nil {
+		rnd = rand.Float64
+	} else {
+		rnd = rand.New(src).Float64
+	}
+
+	nodes := make([]graph.Node, n)
+	pos := make([]r2.Vec, n)
+	for i := range nodes {
+		u := dst.NewNode()
+		dst.AddNode(u)
+		nodes[i] = u
+		pos[i] = r2.Vec{X: rnd(), Y: rnd()}
+	}
+
+	setGeometricEdges(dst, nodes, r, func(i int) float64 { return pos[i].X }, func(i, j int) float64 {
+		return r2.Norm2(r2.Sub(pos[i], pos[j]))
+	})
+
+	positions := make(map[int64]r2.Vec, n)
+	for i, u := range nodes {
+		positions[u.ID()] = pos[i]
+	}
+	return positions, nil
+}
+
+// GeometricR3 constructs a random geometric graph of order n in the destination,
+// dst. Nodes are placed uniformly at random in the unit cube and edges are formed
+// between all pairs of nodes separated by a Euclidean distance of at most r. If dst
+// is directed, edges are added in both directions. The positions of the nodes are
+// returned keyed by node ID. If src is not nil it is used as the random source,
+// otherwise rand.Float64 is used.
+func GeometricR3(dst GraphBuilder, n int, r float64, src rand.Source) (map[int64]r3.Vec, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("gen: bad order: n=%d", n)
+	}
+	if r < 0 {
+		return nil, fmt.Errorf("gen: bad radius: r=%v", r)
+	}
+	var rnd func() float64
+	if src == nil {
+		rnd = rand.Float64
+	} else {
+		rnd = rand.New(src).Float64
+	}
+
+	nodes := make([]graph.Node, n)
+	pos := make([]r3.Vec, n)
+	for i := range nodes {
+		u := dst.NewNode()
+		dst.AddNode(u)
+		nodes[i] = u
+		pos[i] = r3.Vec{X: rnd(), Y: rnd(), Z: rnd()}
+	}
+
+	setGeometricEdges(dst, nodes, r, func(i int) float64 { return pos[i].X }, func(i, j int) float64 {
+		return r3.Norm2(r3.Sub(pos[i], pos[j]))
+	})
+
+	positions := make(map[int64]r3.Vec, n)
+	for i, u := range nodes {
+		positions[u.ID()] = pos[i]
+	}
+	return positions, nil
+}
+
+// setGeometricEdges adds edges to dst between all pairs of nodes separated
+// by a distance of at most r. The x function returns the first coordinate
+// of the indexed node and is used to restrict the search to a sweep along
+// that axis. The dist2 function returns the squared distance between the
+// indexed nodes.
+func setGeometricEdges(dst GraphBuilder, nodes []graph.Node, r float64, x func(int) float64, dist2 func(i, j int) float64) {
+	_, isDirected := dst.(graph.Directed)
+
+	idx := make([]int, len(nodes))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(i, j int) bool { return x(idx[i]) < x(idx[j]) })
+
+	for a, i := range idx {
+		for _, j := range idx[a+1:] {
+			if x(j)-x(i) > r {
+				break
+			}
+			if dist2(i, j) > r*r {
+				continue
+			}
+			u, v := nodes[i], nodes[j]
+			if u.ID() > v.ID() {
+				u, v = v, u
+			}
+			dst.SetEdge(dst.NewEdge(u, v))
+			if isDirected {
+				dst.SetEdge(dst.NewEdge(v, u))
+			}
+		}
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/spatial/r2"
+	"gonum.org/v1/gonum/spatial/r3"
+)
+
+func TestGeometricR2(t *testing.T) {
+	t.Parallel()
+	for _, n := range []int{0, 1, 2, 10, 100} {
+		for _, r := range []float64{0, 0.1, 0.5, 2} {
+			g := &gnUndirected{UndirectedBuilder: simple.NewUndirectedGraph()}
+			pos, err := GeometricR2(g, n, r, rand.NewPCG(uint64(n), 1))
+			if err != nil {
+				t.Fatalf("unexpected error: n=%d, r=%v: %v", n, r, err)
+			}
+			if len(pos) != n {
+				t.Errorf("unexpected number of positions: n=%d, r=%v: got:%d", n, r, len(pos))
+			}
+			if g.addBackwards {
+				t.Errorf("edge added with From.ID > To.ID: n=%d, r=%v", n, r)
+			}
+			if g.addSelfLoop {
+				t.Errorf("unexpected self edge: n=%d, r=%v", n, r)
+			}
+			if g.addMultipleEdge {
+				t.Errorf("unexpected multiple edge: n=%d, r=%v", n, r)
+			}
+			nodes := graph.NodesOf(g.Nodes())
+			for i, u := range nodes {
+				for _, v := range nodes[i+1:] {
+					want := r2.Norm(r2.Sub(pos[u.ID()], pos[v.ID()])) <= r
+					if got := g.HasEdgeBetween(u.ID(), v.ID()); got != want {
+						t.Errorf("unexpected edge state between %d and %d: n=%d, r=%v: got:%t want:%t", u.ID(), v.ID(), n, r, got, want)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestGeometricR3(t *testing.T) {
+	t.Parallel()
+	for _, n := range []int{0, 1, 2, 10, 100} {
+		for _, r := range []float64{0, 0.1, 0.5, 2} {
+			g := &gnDirected{DirectedBuilder: simple.NewDirectedGraph()}
+			pos, err := GeometricR3(g, n, r, rand.NewPCG(uint64(n), 1))
+			if err != nil {
+				t.Fatalf("unexpected error: n=%d, r=%v: %v", n, r, err)
+			}
+			if len(pos) != n {
+				t.Errorf("unexpected number of positions: n=%d, r=%v: got:%d", n, r, len(pos))
+			}
+			if g.addSelfLoop {
+				t.Errorf("unexpected self edge: n=%d, r=%v", n, r)
+			}
+			if g.addMultipleEdge {
+				t.Errorf("unexpected multiple edge: n=%d, r=%v", n, r)
+			}
+			nodes := graph.NodesOf(g.Nodes())
+			for i, u := range nodes {
+				for _, v := range nodes[i+1:] {
+					want := r3.Norm(r3.Sub(pos[u.ID()], pos[v.ID()])) <= r
+					if got := g.HasEdgeFromTo(u.ID(), v.ID()); got != want {
+						t.Errorf("unexpected edge state from %d to %d: n=%d, r=%v: got:%t want:%t", u.ID(), v.ID(), n, r, got, want)
+					}
+					if got := g.HasEdgeFromTo(v.ID(), u.ID()); got != want {
+						t.Errorf("unexpected edge state from %d to %d: n=%d, r=%v: got:%t want:%t", v.ID(), u.ID(), n, r, got, want)
+					}
+				}
+			}
+		}
+	}
+}
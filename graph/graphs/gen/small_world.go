@@ -201,3 +201,77 @@ func idxFromDelta(base, delta, dims []int, translate int) int {
 	}
 	return id
 }
+
+// WattsStrogatz constructs a Watts–Strogatz small world graph of order n in the
+// destination, dst. The graph is initially constructed as a ring lattice with each
+// node joined to its k nearest neighbors, k/2 on each side, and then each lattice
+// edge is rewired to a uniformly chosen node with probability beta, avoiding self
+// edges and multiple edges. The parameter k must be even and less than n.
+// If src is not nil it is used as the random source, otherwise rand.Float64 and
+// rand.IntN are used for the random number generators.
+//
+// The algorithm is essentially as described in doi:10.1038/30918.
+func WattsStrogatz(dst UndirectedMutator, n, k int, beta float64, src rand.Source) error {
+	if k < 0 || k%2 != 0 || k >= n {
+		return fmt.Errorf("gen: bad degree: k=%d", k)
+	}
+	if beta < 0 || beta > 1 {
+		return fmt.Errorf("gen: bad probability: beta=%v", beta)
+	}
+
+	var (
+		rnd  func() float64
+		rndN func(int) int
+	)
+	if src == nil {
+		rnd = rand.Float64
+		rndN = rand.IntN
+	} else {
+		r := rand.New(src)
+		rnd = r.Float64
+		rndN = r.IntN
+	}
+
+	nodes := make([]graph.Node, n)
+	for i := range nodes {
+		u := dst.NewNode()
+		dst.AddNode(u)
+		nodes[i] = u
+	}
+	setEdge := func(u, v graph.Node) {
+		if u.ID() > v.ID() {
+			u, v = v, u
+		}
+		dst.SetEdge(dst.NewEdge(u, v))
+	}
+
+	// Construct the ring lattice.
+	for j := 1; j <= k/2; j++ {
+		for i, u := range nodes {
+			setEdge(u, nodes[(i+j)%n])
+		}
+	}
+
+	// Rewire the lattice edges.
+	for j := 1; j <= k/2; j++ {
+		for i, u := range nodes {
+			if rnd() >= beta {
+				continue
+			}
+			uid := u.ID()
+			if dst.From(uid).Len() >= n-1 {
+				// u is connected to all other nodes
+				// so no rewiring is possible.
+				continue
+			}
+			w := nodes[rndN(n)]
+			for w.ID() == uid || dst.HasEdgeBetween(uid, w.ID()) {
+				w = nodes[rndN(n)]
+			}
+			dst.RemoveEdge(uid, nodes[(i+j)%n].ID())
+			setEdge(u, w)
+		}
+	}
+
+	return nil
+}
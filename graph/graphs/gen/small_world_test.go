@@ -5,8 +5,10 @@
 package gen
 
 import (
+	"math/rand/v2"
 	"testing"
 
+	"gonum.org/v1/gonum/graph"
 	"gonum.org/v1/gonum/graph/simple"
 )
 
@@ -77,3 +79,53 @@ func TestNavigableSmallWorldDirected(t *testing.T) {
 		}
 	}
 }
+
+func TestWattsStrogatz(t *testing.T) {
+	t.Parallel()
+	for n := 3; n <= 20; n++ {
+		for k := 0; k < n; k += 2 {
+			for beta := 0.; beta <= 1; beta += 0.25 {
+				g := &duplication{UndirectedMutator: simple.NewUndirectedGraph()}
+				err := WattsStrogatz(g, n, k, beta, rand.NewPCG(uint64(n), uint64(k)))
+				if err != nil {
+					t.Fatalf("unexpected error: n=%d, k=%d, beta=%v: %v", n, k, beta, err)
+				}
+				if g.addBackwards {
+					t.Errorf("edge added with From.ID > To.ID: n=%d, k=%d, beta=%v", n, k, beta)
+				}
+				if g.addSelfLoop {
+					t.Errorf("unexpected self edge: n=%d, k=%d, beta=%v", n, k, beta)
+				}
+				if g.addMultipleEdge {
+					t.Errorf("unexpected multiple edge: n=%d, k=%d, beta=%v", n, k, beta)
+				}
+				if got, want := g.UndirectedMutator.(*simple.UndirectedGraph).Edges().Len(), n*k/2; got != want {
+					t.Errorf("unexpected number of edges: n=%d, k=%d, beta=%v: got:%d want:%d", n, k, beta, got, want)
+				}
+				if beta == 0 {
+					for _, u := range graph.NodesOf(g.Nodes()) {
+						if d := g.From(u.ID()).Len(); d != k {
+							t.Errorf("unexpected lattice degree for node %d: n=%d, k=%d: got:%d want:%d", u.ID(), n, k, d, k)
+						}
+					}
+				}
+			}
+		}
+	}
+	for _, test := range []struct {
+		n, k int
+		beta float64
+	}{
+		{n: 10, k: 3, beta: 0.5},
+		{n: 10, k: 10, beta: 0.5},
+		{n: 10, k: -2, beta: 0.5},
+		{n: 10, k: 4, beta: -0.5},
+		{n: 10, k: 4, beta: 1.5},
+	} {
+		g := simple.NewUndirectedGraph()
+		err := WattsStrogatz(g, test.n, test.k, test.beta, nil)
+		if err == nil {
+			t.Errorf("expected error for n=%d, k=%d, beta=%v", test.n, test.k, test.beta)
+		}
+	}
+}
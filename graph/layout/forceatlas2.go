@@ -0,0 +1,180 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package layout
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/spatial/barneshut"
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+// ForceAtlas2R2 implements the graph layout algorithm essentially as
+// described in "ForceAtlas2, a continuous graph layout algorithm for
+// handy network visualization designed for the Gephi software",
+// PLoS ONE 9(6):e98679. doi:10.1371/journal.pone.0098679
+// The implementation here uses the Barnes-Hut approximation for
+// global repulsion calculation, and edge weights are considered
+// when calculating adjacent node attraction.
+type ForceAtlas2R2 struct {
+	// Updates is the number of updates to perform.
+	Updates int
+
+	// Scaling is the strength of the degree-scaled
+	// global repulsive force between nodes. It
+	// corresponds to k_r in the paper. If Scaling
+	// is zero, a value of 2 is used.
+	Scaling float64
+
+	// Gravity is the strength of the attraction of
+	// nodes towards the origin. It corresponds to
+	// k_g in the paper.
+	Gravity float64
+
+	// StrongGravity specifies that the gravity force
+	// is proportional to the distance from the origin
+	// rather than constant.
+	StrongGravity bool
+
+	// Tolerance is the swinging tolerance used to
+	// adapt the global speed of the layout. It
+	// corresponds to τ in the paper. If Tolerance
+	// is zero, a value of 1 is used.
+	Tolerance float64
+
+	// Theta is the Barnes-Hut theta constant.
+	Theta float64
+
+	// Src is the source of randomness used
+	// to initialize the nodes' locations. If
+	// Src is nil, the global random number
+	// generator is used.
+	Src rand.Source
+
+	nodes   []graph.Node
+	indexOf map[int64]int
+
+	particles []barneshut.Particle2
+	forces    []r2.Vec
+	prev      []r2.Vec
+	speed     float64
+}
+
+const (
+	// fa2NodeSpeed and fa2MaxNodeSpeed correspond
+	// to k_s and k_smax in the ForceAtlas2 paper.
+	fa2NodeSpeed    = 0.1
+	fa2MaxNodeSpeed = 10
+)
+
+// Update is the ForceAtlas2R2 spatial graph update function.
+func (u *ForceAtlas2R2) Update(g graph.Graph, layout LayoutR2) bool {
+	if u.Updates <= 0 {
+		return false
+	}
+	u.Updates--
+
+	if !layout.IsInitialized() {
+		u.nodes, u.indexOf, u.particles = initParticlesR2(g, 1, u.Src)
+		forEachEdge(g, u.nodes, u.indexOf, func(_, _ int64, xidx, yidx int) bool {
+			x := u.particles[xidx].(particleR2)
+			x.mass++
+			u.particles[xidx] = x
+			y := u.particles[yidx].(particleR2)
+			y.mass++
+			u.particles[yidx] = y
+			return true
+		})
+		u.forces = make([]r2.Vec, len(u.particles))
+		u.prev = make([]r2.Vec, len(u.particles))
+		u.speed = 1
+	}
+
+	scaling := u.Scaling
+	if scaling == 0 {
+		scaling = 2
+	}
+	tolerance := u.Tolerance
+	if tolerance == 0 {
+		tolerance = 1
+	}
+
+	// Apply global repulsion and gravity.
+	plane, err := barneshut.NewPlane(u.particles)
+	if err != nil {
+		return false
+	}
+	repulsion := inverseForce2(scaling)
+	for i, p := range u.particles {
+		f := plane.ForceOn(p, u.Theta, repulsion)
+		if u.Gravity != 0 {
+			pos := p.Coord2()
+			d := r2.Norm(pos)
+			if d != 0 {
+				g := u.Gravity * p.Mass()
+				if !u.StrongGravity {
+					g /= d
+				}
+				f = r2.Sub(f, r2.Scale(g, pos))
+			}
+		}
+		u.forces[i] = f
+	}
+
+	// Apply adjacent node attraction.
+	weight := edgeWeightFunc(g)
+	ok := forEachEdge(g, u.nodes, u.indexOf, func(xid, yid int64, xidx, yidx int) bool {
+		v := r2.Sub(u.particles[yidx].Coord2(), u.particles[xidx].Coord2())
+		f := r2.Scale(weight(xid, yid), v)
+		if math.IsInf(f.X, 0) || math.IsInf(f.Y, 0) {
+			return false
+		}
+		u.forces[xidx] = r2.Add(u.forces[xidx], f)
+		u.forces[yidx] = r2.Sub(u.forces[yidx], f)
+		return true
+	})
+	if !ok {
+		return false
+	}
+
+	// Adapt the global speed from the swinging and
+	// effective traction of the nodes.
+	var swing, traction float64
+	for i, f := range u.forces {
+		m := u.particles[i].Mass()
+		swing += m * r2.Norm(r2.Sub(f, u.prev[i]))
+		traction += m * r2.Norm(r2.Add(f, u.prev[i])) / 2
+	}
+	if swing != 0 {
+		u.speed = math.Min(tolerance*traction/swing, 1.5*u.speed)
+	}
+
+	var updated bool
+	for i, f := range u.forces {
+		d := r2.Norm(f)
+		if d == 0 {
+			u.prev[i] = f
+			continue
+		}
+		swing := r2.Norm(r2.Sub(f, u.prev[i]))
+		speed := fa2NodeSpeed * u.speed / (1 + u.speed*math.Sqrt(swing))
+		speed = math.Min(speed, fa2MaxNodeSpeed/d)
+		step := r2.Scale(speed, f)
+		// Prevent marginal updates that can be caused by
+		// floating point error when nodes are very far apart.
+		if r2.Norm(step) > 1e-12 {
+			updated = true
+		}
+		n := u.particles[i].(particleR2)
+		n.pos = r2.Add(n.pos, step)
+		u.particles[i] = n
+		layout.SetCoord2(n.id, n.pos)
+		u.prev[i] = f
+	}
+
+	return updated
+}
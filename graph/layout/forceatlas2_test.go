@@ -0,0 +1,36 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package layout_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph/simple"
+
+	. "gonum.org/v1/gonum/graph/layout"
+)
+
+func TestForceAtlas2R2(t *testing.T) {
+	t.Parallel()
+	for _, test := range forceDirectedTests {
+		for _, strong := range []bool{false, true} {
+			g := simple.NewUndirectedGraph()
+			for _, e := range test.edges {
+				g.SetEdge(e)
+			}
+			fa2 := ForceAtlas2R2{Updates: 200, Gravity: 1, StrongGravity: strong, Theta: 0.1, Src: rand.NewPCG(1, 1)}
+			o := NewOptimizerR2(g, fa2.Update)
+			var n int
+			for o.Update() {
+				n++
+			}
+			if n == 0 || n > 200 {
+				t.Errorf("unexpected number of updates for %q: %d", test.name, n)
+			}
+			checkClusteredLayout(t, test.name, o, g, test.clusters)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package layout
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+	"gonum.org/v1/gonum/spatial/barneshut"
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+// FruchtermanReingoldR2 implements the graph layout algorithm essentially
+// as described in "Graph drawing by force-directed placement", Software:
+// Practice and Experience 21(11):1129-1164.
+// The implementation here uses the Barnes-Hut approximation for
+// global repulsion calculation, and edge weights are considered
+// when calculating adjacent node attraction.
+type FruchtermanReingoldR2 struct {
+	// Updates is the number of updates to perform.
+	// The layout temperature is cooled linearly over
+	// the number of updates.
+	Updates int
+
+	// K is the optimal distance between nodes. It
+	// corresponds to k in the paper. If K is zero,
+	// a value of 1 is used.
+	K float64
+
+	// Temperature is the initial maximum
+	// displacement of a node in a single update.
+	// If Temperature is zero, a value of one tenth
+	// of the initial layout width is used.
+	Temperature float64
+
+	// Theta is the Barnes-Hut theta constant.
+	Theta float64
+
+	// Src is the source of randomness used
+	// to initialize the nodes' locations. If
+	// Src is nil, the global random number
+	// generator is used.
+	Src rand.Source
+
+	nodes   []graph.Node
+	indexOf map[int64]int
+
+	particles []barneshut.Particle2
+	forces    []r2.Vec
+
+	temp, cool float64
+}
+
+// Update is the FruchtermanReingoldR2 spatial graph update function.
+func (u *FruchtermanReingoldR2) Update(g graph.Graph, layout LayoutR2) bool {
+	if u.Updates <= 0 {
+		return false
+	}
+
+	k := u.K
+	if k == 0 {
+		k = 1
+	}
+
+	if !layout.IsInitialized() {
+		u.nodes, u.indexOf, u.particles = initParticlesR2(g, k, u.Src)
+		u.forces = make([]r2.Vec, len(u.particles))
+
+		u.temp = u.Temperature
+		if u.temp == 0 {
+			u.temp = k * math.Sqrt(float64(len(u.particles))) / 10
+		}
+		u.cool = u.temp / float64(u.Updates)
+	}
+	u.Updates--
+
+	// Apply global repulsion.
+	plane, err := barneshut.NewPlane(u.particles)
+	if err != nil {
+		return false
+	}
+	repulsion := inverseForce2(k * k)
+	for i, p := range u.particles {
+		u.forces[i] = plane.ForceOn(p, u.Theta, repulsion)
+	}
+
+	// Apply adjacent node attraction.
+	weight := edgeWeightFunc(g)
+	ok := forEachEdge(g, u.nodes, u.indexOf, func(xid, yid int64, xidx, yidx int) bool {
+		v := r2.Sub(u.particles[yidx].Coord2(), u.particles[xidx].Coord2())
+		f := r2.Scale(weight(xid, yid)*r2.Norm(v)/k, v)
+		if math.IsInf(f.X, 0) || math.IsInf(f.Y, 0) {
+			return false
+		}
+		u.forces[xidx] = r2.Add(u.forces[xidx], f)
+		u.forces[yidx] = r2.Sub(u.forces[yidx], f)
+		return true
+	})
+	if !ok {
+		return false
+	}
+
+	// Limit displacement by the current temperature.
+	var updated bool
+	for i, f := range u.forces {
+		d := r2.Norm(f)
+		if d == 0 {
+			continue
+		}
+		step := r2.Scale(math.Min(d, u.temp)/d, f)
+		// Prevent marginal updates that can be caused by
+		// floating point error when nodes are very far apart.
+		if r2.Norm(step) > 1e-12 {
+			updated = true
+		}
+		n := u.particles[i].(particleR2)
+		n.pos = r2.Add(n.pos, step)
+		u.particles[i] = n
+		layout.SetCoord2(n.id, n.pos)
+	}
+	u.temp = math.Max(u.temp-u.cool, 0)
+
+	return updated
+}
+
+// initParticlesR2 returns the nodes of g sorted by ID, an index into the
+// nodes and a set of unit mass particles for the nodes placed uniformly at
+// random in a square with side length scale×sqrt(n).
+func initParticlesR2(g graph.Graph, scale float64, src rand.Source) ([]graph.Node, map[int64]int, []barneshut.Particle2) {
+	var rnd func() float64
+	if src == nil {
+		rnd = rand.Float64
+	} else {
+		rnd = rand.New(src).Float64
+	}
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	indexOf := make(map[int64]int, len(nodes))
+	particles := make([]barneshut.Particle2, len(nodes))
+	width := scale * math.Sqrt(float64(len(nodes)))
+	for i, n := range nodes {
+		id := n.ID()
+		indexOf[id] = i
+		particles[i] = particleR2{id: id, pos: r2.Vec{X: width * rnd(), Y: width * rnd()}, mass: 1}
+	}
+	return nodes, indexOf, particles
+}
+
+// inverseForce2 returns a repulsive Barnes-Hut force function with a
+// magnitude of c⋅m1⋅m2/‖v‖.
+func inverseForce2(c float64) barneshut.Force2 {
+	return func(_, _ barneshut.Particle2, m1, m2 float64, v r2.Vec) r2.Vec {
+		d2 := r2.Norm2(v)
+		if d2 == 0 {
+			return r2.Vec{}
+		}
+		return r2.Scale(-c*m1*m2/d2, v)
+	}
+}
+
+// edgeWeightFunc returns a function that returns the weight of the
+// edge between nodes in g for use in attraction calculations. For
+// directed graphs the weight is the sum of the weights in both
+// directions. If g is not a graph.Weighted, unit weights are returned.
+func edgeWeightFunc(g graph.Graph) func(xid, yid int64) float64 {
+	wg, ok := g.(graph.Weighted)
+	if !ok {
+		// This is only called when the adjacency is known so just return unit.
+		return func(_, _ int64) float64 { return 1 }
+	}
+	if _, ok := g.(graph.Directed); ok {
+		return func(xid, yid int64) float64 {
+			var w float64
+			f, ok := wg.Weight(xid, yid)
+			if ok {
+				w += f
+			}
+			r, ok := wg.Weight(yid, xid)
+			if ok {
+				w += r
+			}
+			return w
+		}
+	}
+	return func(xid, yid int64) float64 {
+		w, ok := wg.Weight(xid, yid)
+		if ok {
+			return w
+		}
+		return 0
+	}
+}
+
+// forEachEdge calls fn for each pair of adjacent nodes in g once,
+// ignoring self edges. If fn returns false, the iteration is terminated
+// and forEachEdge returns false.
+func forEachEdge(g graph.Graph, nodes []graph.Node, indexOf map[int64]int, fn func(xid, yid int64, xidx, yidx int) bool) bool {
+	seen := make(map[[2]int64]bool)
+	for _, n := range nodes {
+		xid := n.ID()
+		// Visit neighbours in ID order so that floating point
+		// accumulation is reproducible for a given Src.
+		to := graph.NodesOf(g.From(xid))
+		order.ByID(to)
+		for _, y := range to {
+			yid := y.ID()
+			if xid == yid || seen[[2]int64{xid, yid}] {
+				continue
+			}
+			seen[[2]int64{yid, xid}] = true
+			if !fn(xid, yid, indexOf[xid], indexOf[yid]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+type particleR2 struct {
+	id   int64
+	pos  r2.Vec
+	mass float64
+}
+
+func (p particleR2) Coord2() r2.Vec { return p.pos }
+func (p particleR2) Mass() float64  { return p.mass }
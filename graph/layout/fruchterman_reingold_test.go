@@ -0,0 +1,102 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package layout_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/spatial/r2"
+
+	. "gonum.org/v1/gonum/graph/layout"
+)
+
+// forceDirectedTests are graphs with two tightly connected clusters
+// joined by a single edge.
+var forceDirectedTests = []struct {
+	name     string
+	edges    []simple.Edge
+	clusters [][]int64
+}{
+	{
+		name: "barbell",
+		edges: []simple.Edge{
+			{F: simple.Node(0), T: simple.Node(1)},
+			{F: simple.Node(0), T: simple.Node(2)},
+			{F: simple.Node(0), T: simple.Node(3)},
+			{F: simple.Node(1), T: simple.Node(2)},
+			{F: simple.Node(1), T: simple.Node(3)},
+			{F: simple.Node(2), T: simple.Node(3)},
+
+			{F: simple.Node(3), T: simple.Node(4)},
+
+			{F: simple.Node(4), T: simple.Node(5)},
+			{F: simple.Node(4), T: simple.Node(6)},
+			{F: simple.Node(4), T: simple.Node(7)},
+			{F: simple.Node(5), T: simple.Node(6)},
+			{F: simple.Node(5), T: simple.Node(7)},
+			{F: simple.Node(6), T: simple.Node(7)},
+		},
+		clusters: [][]int64{{0, 1, 2, 3}, {4, 5, 6, 7}},
+	},
+}
+
+// checkClusteredLayout checks that the mean distance between
+// nodes in the same cluster is less than the mean distance
+// between nodes in different clusters.
+func checkClusteredLayout(t *testing.T, name string, o OptimizerR2, g graph.Graph, clusters [][]int64) {
+	t.Helper()
+	clusterOf := make(map[int64]int)
+	for c, ids := range clusters {
+		for _, id := range ids {
+			clusterOf[id] = c
+		}
+	}
+	var within, between float64
+	var nWithin, nBetween int
+	nodes := graph.NodesOf(g.Nodes())
+	for i, u := range nodes {
+		for _, v := range nodes[i+1:] {
+			d := r2.Norm(r2.Sub(o.Coord2(u.ID()), o.Coord2(v.ID())))
+			if d == 0 {
+				t.Errorf("unexpected coincident nodes %d and %d in %q", u.ID(), v.ID(), name)
+			}
+			if clusterOf[u.ID()] == clusterOf[v.ID()] {
+				within += d
+				nWithin++
+			} else {
+				between += d
+				nBetween++
+			}
+		}
+	}
+	within /= float64(nWithin)
+	between /= float64(nBetween)
+	if within >= between {
+		t.Errorf("unexpected layout for %q: mean within cluster distance %v >= mean between cluster distance %v", name, within, between)
+	}
+}
+
+func TestFruchtermanReingoldR2(t *testing.T) {
+	t.Parallel()
+	for _, test := range forceDirectedTests {
+		g := simple.NewUndirectedGraph()
+		for _, e := range test.edges {
+			g.SetEdge(e)
+		}
+		fr := FruchtermanReingoldR2{Updates: 200, Theta: 0.1, Src: rand.NewPCG(1, 1)}
+		o := NewOptimizerR2(g, fr.Update)
+		var n int
+		for o.Update() {
+			n++
+		}
+		if n == 0 || n > 200 {
+			t.Errorf("unexpected number of updates for %q: %d", test.name, n)
+		}
+		checkClusteredLayout(t, test.name, o, g, test.clusters)
+	}
+}
@@ -0,0 +1,291 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package layout
+
+import (
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+// LayeredR2 implements a layered graph layout algorithm for directed
+// graphs essentially as described in "Methods for visual understanding of
+// hierarchical system structures", IEEE Transactions on Systems, Man, and
+// Cybernetics 11(2):109-125. doi:10.1109/TSMC.1981.4308636
+//
+// Cycles in the graph are broken by reversing the back edges of a depth
+// first search, nodes are assigned to layers by longest path layering,
+// edges spanning more than one layer are split with virtual nodes and
+// crossings between adjacent layers are reduced using the barycenter
+// heuristic. Source nodes are placed in the top layer, with successive
+// layers placed at decreasing Y coordinates. The layout is calculated in
+// a single call to Update.
+type LayeredR2 struct {
+	// LayerSeparation is the distance between
+	// adjacent layers. If LayerSeparation is
+	// zero, a value of 1 is used.
+	LayerSeparation float64
+
+	// NodeSeparation is the distance between
+	// adjacent nodes in a layer. If NodeSeparation
+	// is zero, a value of 1 is used.
+	NodeSeparation float64
+
+	// Sweeps is the number of down and up
+	// crossing reduction sweeps to perform. If
+	// Sweeps is zero, a value of 4 is used.
+	Sweeps int
+}
+
+// Update is the LayeredR2 spatial graph update function.
+func (l LayeredR2) Update(g graph.Graph, layout LayoutR2) bool {
+	nodes := graph.NodesOf(g.Nodes())
+	if len(nodes) == 0 {
+		return false
+	}
+	order.ByID(nodes)
+
+	layers := newLayering(g, nodes)
+	sweeps := l.Sweeps
+	if sweeps == 0 {
+		sweeps = 4
+	}
+	layers.reduceCrossings(sweeps)
+
+	layerSep := l.LayerSeparation
+	if layerSep == 0 {
+		layerSep = 1
+	}
+	nodeSep := l.NodeSeparation
+	if nodeSep == 0 {
+		nodeSep = 1
+	}
+	for i, layer := range layers.order {
+		off := float64(len(layer)-1) / 2
+		for j, v := range layer {
+			if v >= len(nodes) {
+				// Virtual node.
+				continue
+			}
+			layout.SetCoord2(nodes[v].ID(), r2.Vec{
+				X: (float64(j) - off) * nodeSep,
+				Y: -float64(i) * layerSep,
+			})
+		}
+	}
+	return false
+}
+
+// layering is a proper layering of an acyclic graph. Vertices
+// are indexed with real nodes preceding virtual nodes.
+type layering struct {
+	// order holds the ordered vertices of each layer.
+	order [][]int
+	// up and down hold the adjacent vertices in the
+	// preceding and following layers.
+	up, down [][]int
+}
+
+// newLayering returns a proper layering of g with the nodes indexed in
+// the order of nodes.
+func newLayering(g graph.Graph, nodes []graph.Node) *layering {
+	indexOf := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+	}
+	succ := make([][]int, len(nodes))
+	for i, n := range nodes {
+		to := graph.NodesOf(g.From(n.ID()))
+		order.ByID(to)
+		for _, v := range to {
+			if j := indexOf[v.ID()]; j != i {
+				succ[i] = append(succ[i], j)
+			}
+		}
+	}
+
+	// Remove cycles by reversing DFS back edges.
+	const (
+		unvisited = iota
+		onStack
+		done
+	)
+	state := make([]int, len(nodes))
+	type edge struct{ u, v int }
+	seen := make(map[edge]bool)
+	var edges []edge
+	addEdge := func(u, v int) {
+		if !seen[edge{u, v}] {
+			seen[edge{u, v}] = true
+			edges = append(edges, edge{u, v})
+		}
+	}
+	var dfs func(u int)
+	dfs = func(u int) {
+		state[u] = onStack
+		for _, v := range succ[u] {
+			switch state[v] {
+			case unvisited:
+				addEdge(u, v)
+				dfs(v)
+			case onStack:
+				addEdge(v, u)
+			default:
+				addEdge(u, v)
+			}
+		}
+		state[u] = done
+	}
+	for u := range nodes {
+		if state[u] == unvisited {
+			dfs(u)
+		}
+	}
+
+	// Assign layers by longest path from the sources.
+	out := make([][]int, len(nodes))
+	indeg := make([]int, len(nodes))
+	for _, e := range edges {
+		out[e.u] = append(out[e.u], e.v)
+		indeg[e.v]++
+	}
+	rank := make([]int, len(nodes))
+	var queue []int
+	for u, d := range indeg {
+		if d == 0 {
+			queue = append(queue, u)
+		}
+	}
+	for len(queue) != 0 {
+		u := queue[0]
+		queue = queue[1:]
+		for _, v := range out[u] {
+			rank[v] = max(rank[v], rank[u]+1)
+			indeg[v]--
+			if indeg[v] == 0 {
+				queue = append(queue, v)
+			}
+		}
+	}
+
+	// Construct the proper layering, adding virtual
+	// nodes for edges that span more than one layer.
+	l := &layering{
+		order: make([][]int, slices.Max(rank)+1),
+		up:    make([][]int, len(nodes)),
+		down:  make([][]int, len(nodes)),
+	}
+	for u, r := range rank {
+		l.order[r] = append(l.order[r], u)
+	}
+	for _, e := range edges {
+		u := e.u
+		for r := rank[e.u] + 1; r < rank[e.v]; r++ {
+			w := len(l.up)
+			l.up = append(l.up, nil)
+			l.down = append(l.down, nil)
+			l.order[r] = append(l.order[r], w)
+			l.link(u, w)
+			u = w
+		}
+		l.link(u, e.v)
+	}
+	return l
+}
+
+func (l *layering) link(u, v int) {
+	l.down[u] = append(l.down[u], v)
+	l.up[v] = append(l.up[v], u)
+}
+
+// reduceCrossings reorders the vertices in each layer using the
+// barycenter heuristic, retaining the ordering with the fewest
+// crossings found.
+func (l *layering) reduceCrossings(sweeps int) {
+	pos := make([]int, len(l.up))
+	for _, layer := range l.order {
+		for i, v := range layer {
+			pos[v] = i
+		}
+	}
+	best := l.crossings(pos)
+	bestOrder := cloneOrder(l.order)
+	for i := 0; i < sweeps && best != 0; i++ {
+		for r := 1; r < len(l.order); r++ {
+			l.sortByBarycenter(l.order[r], l.up, pos)
+		}
+		for r := len(l.order) - 2; r >= 0; r-- {
+			l.sortByBarycenter(l.order[r], l.down, pos)
+		}
+		if c := l.crossings(pos); c < best {
+			best = c
+			bestOrder = cloneOrder(l.order)
+		}
+	}
+	l.order = bestOrder
+}
+
+// sortByBarycenter sorts the vertices in layer by the mean position
+// of their adjacent vertices, and updates pos to reflect the new order.
+// Vertices without adjacent vertices retain their current position.
+func (l *layering) sortByBarycenter(layer []int, adj [][]int, pos []int) {
+	bary := make(map[int]float64, len(layer))
+	for _, v := range layer {
+		if len(adj[v]) == 0 {
+			bary[v] = float64(pos[v])
+			continue
+		}
+		var sum float64
+		for _, u := range adj[v] {
+			sum += float64(pos[u])
+		}
+		bary[v] = sum / float64(len(adj[v]))
+	}
+	slices.SortStableFunc(layer, func(a, b int) int {
+		switch {
+		case bary[a] < bary[b]:
+			return -1
+		case bary[a] > bary[b]:
+			return 1
+		}
+		return 0
+	})
+	for i, v := range layer {
+		pos[v] = i
+	}
+}
+
+// crossings returns the number of edge crossings between
+// adjacent layers for the vertex positions in pos.
+func (l *layering) crossings(pos []int) int {
+	var n int
+	for _, layer := range l.order {
+		type edge struct{ u, v int }
+		var edges []edge
+		for _, u := range layer {
+			for _, v := range l.down[u] {
+				edges = append(edges, edge{pos[u], pos[v]})
+			}
+		}
+		for i, e := range edges {
+			for _, f := range edges[i+1:] {
+				if (e.u < f.u && e.v > f.v) || (e.u > f.u && e.v < f.v) {
+					n++
+				}
+			}
+		}
+	}
+	return n
+}
+
+func cloneOrder(order [][]int) [][]int {
+	c := make([][]int, len(order))
+	for i, layer := range order {
+		c[i] = slices.Clone(layer)
+	}
+	return c
+}
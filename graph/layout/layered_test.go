@@ -0,0 +1,124 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package layout_test
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+
+	. "gonum.org/v1/gonum/graph/layout"
+)
+
+var layeredR2Tests = []struct {
+	name  string
+	edges []simple.Edge
+
+	// wantLayer is the expected layer of each
+	// node for acyclic graphs.
+	wantLayer map[int64]int
+}{
+	{
+		name: "chain",
+		edges: []simple.Edge{
+			{F: simple.Node(0), T: simple.Node(1)},
+			{F: simple.Node(1), T: simple.Node(2)},
+			{F: simple.Node(2), T: simple.Node(3)},
+		},
+		wantLayer: map[int64]int{0: 0, 1: 1, 2: 2, 3: 3},
+	},
+	{
+		name: "diamond",
+		edges: []simple.Edge{
+			{F: simple.Node(0), T: simple.Node(1)},
+			{F: simple.Node(0), T: simple.Node(2)},
+			{F: simple.Node(1), T: simple.Node(3)},
+			{F: simple.Node(2), T: simple.Node(3)},
+			{F: simple.Node(0), T: simple.Node(3)},
+		},
+		wantLayer: map[int64]int{0: 0, 1: 1, 2: 1, 3: 2},
+	},
+	{
+		name: "crossed",
+		edges: []simple.Edge{
+			{F: simple.Node(0), T: simple.Node(3)},
+			{F: simple.Node(1), T: simple.Node(2)},
+			{F: simple.Node(0), T: simple.Node(4)},
+			{F: simple.Node(1), T: simple.Node(5)},
+		},
+		wantLayer: map[int64]int{0: 0, 1: 0, 2: 1, 3: 1, 4: 1, 5: 1},
+	},
+	{
+		name: "cycle",
+		edges: []simple.Edge{
+			{F: simple.Node(0), T: simple.Node(1)},
+			{F: simple.Node(1), T: simple.Node(2)},
+			{F: simple.Node(2), T: simple.Node(0)},
+		},
+	},
+}
+
+func TestLayeredR2(t *testing.T) {
+	t.Parallel()
+	for _, test := range layeredR2Tests {
+		g := simple.NewDirectedGraph()
+		for _, e := range test.edges {
+			g.SetEdge(e)
+		}
+		o := NewOptimizerR2(g, LayeredR2{LayerSeparation: 2}.Update)
+		if o.Update() {
+			t.Errorf("unexpected continuation for %q", test.name)
+		}
+
+		nodes := graph.NodesOf(g.Nodes())
+		seen := make(map[float64]map[float64]bool)
+		for _, n := range nodes {
+			p := o.Coord2(n.ID())
+			if seen[p.Y] == nil {
+				seen[p.Y] = make(map[float64]bool)
+			}
+			if seen[p.Y][p.X] {
+				t.Errorf("unexpected coincident nodes for %q at %v", test.name, p)
+			}
+			seen[p.Y][p.X] = true
+		}
+
+		if test.wantLayer == nil {
+			continue
+		}
+		for id, want := range test.wantLayer {
+			if got := -o.Coord2(id).Y / 2; got != float64(want) {
+				t.Errorf("unexpected layer for node %d in %q: got:%v want:%d", id, test.name, got, want)
+			}
+		}
+		for _, e := range test.edges {
+			from := o.Coord2(e.F.ID())
+			to := o.Coord2(e.T.ID())
+			if from.Y <= to.Y {
+				t.Errorf("unexpected upward edge in %q: %d at %v to %d at %v", test.name, e.F.ID(), from, e.T.ID(), to)
+			}
+		}
+	}
+}
+
+func TestLayeredR2Crossings(t *testing.T) {
+	t.Parallel()
+	g := simple.NewDirectedGraph()
+	for _, e := range layeredR2Tests[2].edges {
+		g.SetEdge(e)
+	}
+	o := NewOptimizerR2(g, LayeredR2{}.Update)
+	o.Update()
+	for _, e := range layeredR2Tests[2].edges {
+		for _, f := range layeredR2Tests[2].edges {
+			ef, et := o.Coord2(e.F.ID()), o.Coord2(e.T.ID())
+			ff, ft := o.Coord2(f.F.ID()), o.Coord2(f.T.ID())
+			if (ef.X < ff.X && et.X > ft.X) || (ef.X > ff.X && et.X < ft.X) {
+				t.Errorf("unexpected crossing between %v and %v", e, f)
+			}
+		}
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package layout
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/path"
+	"gonum.org/v1/gonum/internal/order"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/spatial/r2"
+	"gonum.org/v1/gonum/stat/mds"
+)
+
+// StressR2 implements a graph layout algorithm based on stress
+// majorization essentially as described in "Graph drawing by stress
+// majorization", Graph Drawing, LNCS 3383:239-250.
+// doi:10.1007/978-3-540-31843-9_25
+//
+// The layout minimizes the weighted stress
+//
+//	Σ_{i<j} d_ij^-2 (‖x_i - x_j‖ - d_ij)²
+//
+// where d_ij is the shortest path distance between nodes i and j. Node
+// positions are initialized by a Torgerson multidimensional scaling of
+// the shortest path distances and then refined by localized majorization
+// updates. The all pair shortest path distances are calculated using
+// Dijkstra's algorithm and so StressR2 will not scale to large graphs.
+// Graphs with more than one connected component cannot be laid out by
+// StressR2.
+type StressR2 struct {
+	// Updates is the maximum number of
+	// majorization updates to perform.
+	Updates int
+
+	// Tolerance is the relative change in
+	// stress below which the layout is
+	// considered to have converged. If
+	// Tolerance is zero, a value of 1e-4
+	// is used.
+	Tolerance float64
+
+	// Src is the source of randomness used
+	// to initialize the nodes' locations when
+	// the multidimensional scaling does not
+	// have a solution in two dimensions. If
+	// Src is nil, the global random number
+	// generator is used.
+	Src rand.Source
+
+	nodes  []graph.Node
+	dist   *mat.SymDense
+	pos    []r2.Vec
+	stress float64
+}
+
+// Update is the StressR2 spatial graph update function.
+func (u *StressR2) Update(g graph.Graph, layout LayoutR2) bool {
+	if u.Updates <= 0 {
+		return false
+	}
+	u.Updates--
+
+	if !layout.IsInitialized() {
+		if !u.init(g) {
+			return false
+		}
+		for i, n := range u.nodes {
+			layout.SetCoord2(n.ID(), u.pos[i])
+		}
+	}
+	if len(u.nodes) < 2 {
+		return false
+	}
+
+	// Perform one sweep of localized majorization updates.
+	for i := range u.pos {
+		var num r2.Vec
+		var den float64
+		for j, p := range u.pos {
+			if i == j {
+				continue
+			}
+			d := u.dist.At(i, j)
+			if d == 0 {
+				continue
+			}
+			w := 1 / (d * d)
+			v := r2.Sub(u.pos[i], p)
+			num = r2.Add(num, r2.Scale(w, p))
+			if n := r2.Norm(v); n != 0 {
+				num = r2.Add(num, r2.Scale(w*d/n, v))
+			}
+			den += w
+		}
+		if den != 0 {
+			u.pos[i] = r2.Scale(1/den, num)
+		}
+	}
+	for i, n := range u.nodes {
+		layout.SetCoord2(n.ID(), u.pos[i])
+	}
+
+	tol := u.Tolerance
+	if tol == 0 {
+		tol = 1e-4
+	}
+	stress := u.currentStress()
+	converged := u.stress-stress <= tol*u.stress
+	u.stress = stress
+	return !converged
+}
+
+// init initializes the shortest path distances and positions
+// of the nodes of g. It returns false if g cannot be laid out.
+func (u *StressR2) init(g graph.Graph) bool {
+	u.nodes = graph.NodesOf(g.Nodes())
+	order.ByID(u.nodes)
+	paths := path.DijkstraAllPaths(g)
+	u.dist = mat.NewSymDense(len(u.nodes), nil)
+	for i, x := range u.nodes {
+		for j := i + 1; j < len(u.nodes); j++ {
+			d := paths.Weight(x.ID(), u.nodes[j].ID())
+			if math.IsInf(d, 1) {
+				return false
+			}
+			u.dist.SetSym(i, j, d)
+		}
+	}
+
+	u.pos = make([]r2.Vec, len(u.nodes))
+	var v mat.Dense
+	k, _ := mds.TorgersonScaling(&v, nil, u.dist)
+	if k >= 2 {
+		for i := range u.pos {
+			u.pos[i] = r2.Vec{X: v.At(i, 0), Y: v.At(i, 1)}
+		}
+	} else {
+		var rnd func() float64
+		if u.Src == nil {
+			rnd = rand.Float64
+		} else {
+			rnd = rand.New(u.Src).Float64
+		}
+		for i := range u.pos {
+			u.pos[i] = r2.Vec{X: rnd(), Y: rnd()}
+		}
+	}
+	u.stress = u.currentStress()
+	return true
+}
+
+// currentStress returns the weighted stress of the current layout.
+func (u *StressR2) currentStress() float64 {
+	var stress float64
+	for i, p := range u.pos {
+		for j := i + 1; j < len(u.pos); j++ {
+			d := u.dist.At(i, j)
+			if d == 0 {
+				continue
+			}
+			r := r2.Norm(r2.Sub(p, u.pos[j])) - d
+			stress += r * r / (d * d)
+		}
+	}
+	return stress
+}
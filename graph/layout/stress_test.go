@@ -0,0 +1,103 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package layout_test
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/path"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/spatial/r2"
+
+	. "gonum.org/v1/gonum/graph/layout"
+)
+
+func TestStressR2(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name  string
+		edges []simple.Edge
+		tol   float64
+	}{
+		{
+			name: "line",
+			edges: []simple.Edge{
+				{F: simple.Node(0), T: simple.Node(1)},
+				{F: simple.Node(1), T: simple.Node(2)},
+				{F: simple.Node(2), T: simple.Node(3)},
+			},
+			tol: 1e-3,
+		},
+		{
+			name: "square",
+			edges: []simple.Edge{
+				{F: simple.Node(0), T: simple.Node(1)},
+				{F: simple.Node(1), T: simple.Node(2)},
+				{F: simple.Node(2), T: simple.Node(3)},
+				{F: simple.Node(3), T: simple.Node(0)},
+			},
+			tol: 0.3,
+		},
+		{
+			name: "sheet",
+			edges: []simple.Edge{
+				{F: simple.Node(0), T: simple.Node(1)},
+				{F: simple.Node(0), T: simple.Node(3)},
+				{F: simple.Node(1), T: simple.Node(2)},
+				{F: simple.Node(1), T: simple.Node(4)},
+				{F: simple.Node(2), T: simple.Node(5)},
+				{F: simple.Node(3), T: simple.Node(4)},
+				{F: simple.Node(3), T: simple.Node(6)},
+				{F: simple.Node(4), T: simple.Node(5)},
+				{F: simple.Node(4), T: simple.Node(7)},
+				{F: simple.Node(5), T: simple.Node(8)},
+				{F: simple.Node(6), T: simple.Node(7)},
+				{F: simple.Node(7), T: simple.Node(8)},
+			},
+			tol: 0.6,
+		},
+	} {
+		g := simple.NewUndirectedGraph()
+		for _, e := range test.edges {
+			g.SetEdge(e)
+		}
+		stress := StressR2{Updates: 500, Tolerance: 1e-10, Src: rand.NewPCG(1, 1)}
+		o := NewOptimizerR2(g, stress.Update)
+		var n int
+		for o.Update() {
+			n++
+		}
+		if n == 0 {
+			t.Errorf("unexpected zero updates for %q", test.name)
+		}
+
+		paths := path.DijkstraAllPaths(g)
+		nodes := graph.NodesOf(g.Nodes())
+		for i, u := range nodes {
+			for _, v := range nodes[i+1:] {
+				want := paths.Weight(u.ID(), v.ID())
+				got := r2.Norm(r2.Sub(o.Coord2(u.ID()), o.Coord2(v.ID())))
+				if math.Abs(got-want) > test.tol*want {
+					t.Errorf("unexpected distance between %d and %d in %q: got:%v want:%v", u.ID(), v.ID(), test.name, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestStressR2Disconnected(t *testing.T) {
+	t.Parallel()
+	g := simple.NewUndirectedGraph()
+	g.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(1)})
+	g.SetEdge(simple.Edge{F: simple.Node(2), T: simple.Node(3)})
+	stress := StressR2{Updates: 10}
+	o := NewOptimizerR2(g, stress.Update)
+	if o.Update() {
+		t.Error("unexpected continuation for disconnected graph")
+	}
+}
@@ -0,0 +1,11 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package matrix provides conversions between graphs and their adjacency,
+// incidence and Laplacian matrices.
+//
+// The rows and columns of the matrices correspond to the nodes of a graph
+// through an Index, so that matrices exported from a graph and graphs
+// constructed from a matrix use the same node order.
+package matrix // import "gonum.org/v1/gonum/graph/matrix"
@@ -0,0 +1,225 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrix
+
+import (
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/internal/order"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Index is a mapping between graph nodes and the row and column indices of
+// matrices.
+type Index struct {
+	// Nodes holds the node corresponding
+	// to each row and column index.
+	Nodes []graph.Node
+
+	// IDs is a mapping from the graph
+	// node IDs to row and column indices.
+	IDs map[int64]int
+}
+
+// NewIndex returns an Index holding the nodes of g ordered by ID.
+func NewIndex(g graph.Graph) Index {
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	return IndexOf(nodes)
+}
+
+// IndexOf returns an Index mapping the nodes to their positions in the
+// slice. The nodes are not copied. IndexOf panics if a node ID is repeated.
+func IndexOf(nodes []graph.Node) Index {
+	ids := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		id := n.ID()
+		if _, exists := ids[id]; exists {
+			panic("matrix: repeated node ID")
+		}
+		ids[id] = i
+	}
+	return Index{Nodes: nodes, IDs: ids}
+}
+
+// Len returns the number of nodes in the index.
+func (x Index) Len() int { return len(x.Nodes) }
+
+// indexOf returns the index of the node with the given ID, and panics if
+// the node is not in x.
+func (x Index) indexOf(id int64) int {
+	i, ok := x.IDs[id]
+	if !ok {
+		panic("matrix: node not in index")
+	}
+	return i
+}
+
+// weight returns the weight of the edge from uid to vid in g. The weight is
+// one if g is not weighted.
+func weight(g graph.Graph, uid, vid int64) float64 {
+	if wg, ok := g.(graph.Weighted); ok {
+		return wg.WeightedEdge(uid, vid).Weight()
+	}
+	return 1
+}
+
+// Adjacency returns the weighted adjacency matrix of g with rows and columns
+// ordered by idx. The element in row i and column j holds the weight of the
+// edge from idx.Nodes[i] to idx.Nodes[j], or one if g is not a graph.Weighted,
+// and is zero if there is no such edge. The matrix of an undirected graph is
+// symmetric. Adjacency panics if a node of g is not in idx.
+func Adjacency(g graph.Graph, idx Index) *mat.Dense {
+	n := idx.Len()
+	a := mat.NewDense(n, n, nil)
+	nodes := g.Nodes()
+	for nodes.Next() {
+		uid := nodes.Node().ID()
+		i := idx.indexOf(uid)
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			a.Set(i, idx.indexOf(vid), weight(g, uid, vid))
+		}
+	}
+	return a
+}
+
+// Laplacian returns the weighted Laplacian matrix D - A of g with rows and
+// columns ordered by idx, where A is the adjacency matrix of g as returned
+// by Adjacency and D is the diagonal matrix of the row sums of A, the
+// weighted out-degrees of the nodes. Self edges do not contribute to the
+// Laplacian. The Laplacian of an undirected graph is symmetric. Laplacian
+// panics if a node of g is not in idx.
+func Laplacian(g graph.Graph, idx Index) *mat.Dense {
+	l := Adjacency(g, idx)
+	n := idx.Len()
+	for i := 0; i < n; i++ {
+		row := l.RawRowView(i)
+		var deg float64
+		for j, w := range row {
+			if w != 0 {
+				deg += w
+				row[j] = -w
+			}
+		}
+		row[i] += deg
+	}
+	return l
+}
+
+// Incidence returns the oriented incidence matrix of g with rows ordered
+// by idx, and the edges corresponding to its columns. The column of the
+// edge from u to v holds -1 in the row of u and 1 in the row of v. Each
+// edge of an undirected graph appears once, oriented from the node with
+// the lower index to the node with the higher index, so that B Bᵀ is the
+// Laplacian of an unweighted undirected graph and B W Bᵀ is the Laplacian
+// of a weighted undirected graph, where B is the incidence matrix and W is
+// the diagonal matrix of the edge weights. Self edges are omitted. The
+// edges are ordered by the indices of their from and then to nodes.
+// Incidence panics if a node of g is not in idx.
+func Incidence(g graph.Graph, idx Index) (b *mat.Dense, edges []graph.Edge) {
+	_, undirected := g.(graph.Undirected)
+	n := idx.Len()
+	for i := 0; i < n; i++ {
+		uid := idx.Nodes[i].ID()
+		var js []int
+		to := g.From(uid)
+		for to.Next() {
+			j := idx.indexOf(to.Node().ID())
+			if j == i || (undirected && j < i) {
+				continue
+			}
+			js = append(js, j)
+		}
+		slices.Sort(js)
+		for _, j := range js {
+			edges = append(edges, g.Edge(uid, idx.Nodes[j].ID()))
+		}
+	}
+	if len(edges) == 0 {
+		return &mat.Dense{}, nil
+	}
+	b = mat.NewDense(n, len(edges), nil)
+	for k, e := range edges {
+		b.Set(idx.indexOf(e.From().ID()), k, -1)
+		b.Set(idx.indexOf(e.To().ID()), k, 1)
+	}
+	return b, edges
+}
+
+// NewWeightedDirectedGraph returns a weighted directed graph with the
+// weighted adjacency matrix a. The nodes of the graph are held in idx, or
+// have IDs 0 to n-1 for an n×n matrix if idx is nil. An edge is added from
+// the node of row i to the node of column j for each non-zero off-diagonal
+// element of a. The diagonal of a is ignored. The self and absent
+// parameters are the weights returned by the graph for self connection and
+// absent edges. NewWeightedDirectedGraph panics if a is not square or if
+// the length of idx does not match its size.
+func NewWeightedDirectedGraph(a mat.Matrix, idx *Index, self, absent float64) *simple.WeightedDirectedGraph {
+	nodes := matrixNodes(a, idx)
+	g := simple.NewWeightedDirectedGraph(self, absent)
+	for _, n := range nodes {
+		g.AddNode(n)
+	}
+	for i, u := range nodes {
+		for j, v := range nodes {
+			if w := a.At(i, j); i != j && w != 0 {
+				g.SetWeightedEdge(g.NewWeightedEdge(u, v, w))
+			}
+		}
+	}
+	return g
+}
+
+// NewWeightedUndirectedGraph returns a weighted undirected graph with the
+// symmetric weighted adjacency matrix a. The nodes of the graph are held in
+// idx, or have IDs 0 to n-1 for an n×n matrix if idx is nil. An edge is
+// added between the nodes of row i and column j for each non-zero
+// off-diagonal element of a. The diagonal of a is ignored. The self and
+// absent parameters are the weights returned by the graph for self
+// connection and absent edges. NewWeightedUndirectedGraph panics if a is
+// not symmetric or if the length of idx does not match its size.
+func NewWeightedUndirectedGraph(a mat.Matrix, idx *Index, self, absent float64) *simple.WeightedUndirectedGraph {
+	nodes := matrixNodes(a, idx)
+	g := simple.NewWeightedUndirectedGraph(self, absent)
+	for _, n := range nodes {
+		g.AddNode(n)
+	}
+	for i, u := range nodes {
+		for j := i + 1; j < len(nodes); j++ {
+			w := a.At(i, j)
+			if w != a.At(j, i) {
+				panic("matrix: adjacency matrix not symmetric")
+			}
+			if w != 0 {
+				g.SetWeightedEdge(g.NewWeightedEdge(u, nodes[j], w))
+			}
+		}
+	}
+	return g
+}
+
+// matrixNodes returns the nodes corresponding to the rows and columns of
+// the square matrix a.
+func matrixNodes(a mat.Matrix, idx *Index) []graph.Node {
+	r, c := a.Dims()
+	if r != c {
+		panic(mat.ErrSquare)
+	}
+	if idx != nil {
+		if idx.Len() != r {
+			panic("matrix: index length mismatch")
+		}
+		return idx.Nodes
+	}
+	nodes := make([]graph.Node, r)
+	for i := range nodes {
+		nodes[i] = simple.Node(i)
+	}
+	return nodes
+}
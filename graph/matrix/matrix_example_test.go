@@ -0,0 +1,35 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrix_test
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/graph/matrix"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/mat"
+)
+
+func ExampleLaplacian() {
+	g := simple.NewWeightedUndirectedGraph(0, 0)
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(100), T: simple.Node(20), W: 2})
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(20), T: simple.Node(7), W: 1})
+
+	// The index maps the node IDs 7, 20 and 100
+	// to the rows and columns 0, 1 and 2.
+	idx := matrix.NewIndex(g)
+	for i, n := range idx.Nodes {
+		fmt.Printf("row %d: node %d\n", i, n.ID())
+	}
+	fmt.Printf("L = %v\n", mat.Formatted(matrix.Laplacian(g, idx), mat.Prefix("    ")))
+
+	// Output:
+	// row 0: node 7
+	// row 1: node 20
+	// row 2: node 100
+	// L = ⎡ 1  -1   0⎤
+	//     ⎢-1   3  -2⎥
+	//     ⎣ 0  -2   2⎦
+}
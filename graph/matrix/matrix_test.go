@@ -0,0 +1,207 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrix
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/spectral"
+	"gonum.org/v1/gonum/mat"
+)
+
+func panics(fun func()) (b bool) {
+	defer func() {
+		err := recover()
+		if err != nil {
+			b = true
+		}
+	}()
+	fun()
+	return
+}
+
+// testEdges holds the edges of a graph with non-contiguous node IDs.
+var testEdges = []struct {
+	from, to int64
+	weight   float64
+}{
+	{from: 10, to: 3, weight: 2},
+	{from: 3, to: 7, weight: 0.5},
+	{from: 7, to: 10, weight: 1},
+	{from: 7, to: 42, weight: 3},
+}
+
+func TestAdjacencyRoundTrip(t *testing.T) {
+	dg := simple.NewWeightedDirectedGraph(0, 0)
+	ug := simple.NewWeightedUndirectedGraph(0, 0)
+	for _, e := range testEdges {
+		dg.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(e.from), T: simple.Node(e.to), W: e.weight})
+		ug.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(e.from), T: simple.Node(e.to), W: e.weight})
+	}
+	// Add an isolated node.
+	dg.AddNode(simple.Node(5))
+	ug.AddNode(simple.Node(5))
+
+	// Nodes are ordered by ID: 3, 5, 7, 10, 42.
+	idx := NewIndex(dg)
+	for i, id := range []int64{3, 5, 7, 10, 42} {
+		if got := idx.Nodes[i].ID(); got != id {
+			t.Errorf("unexpected node at index %d: got %d, want %d", i, got, id)
+		}
+		if got := idx.IDs[id]; got != i {
+			t.Errorf("unexpected index of node %d: got %d, want %d", id, got, i)
+		}
+	}
+
+	wantDirected := mat.NewDense(5, 5, []float64{
+		0, 0, 0.5, 0, 0,
+		0, 0, 0, 0, 0,
+		0, 0, 0, 1, 3,
+		2, 0, 0, 0, 0,
+		0, 0, 0, 0, 0,
+	})
+	a := Adjacency(dg, idx)
+	if !mat.Equal(a, wantDirected) {
+		t.Errorf("unexpected directed adjacency:\ngot:\n%v\nwant:\n%v", mat.Formatted(a), mat.Formatted(wantDirected))
+	}
+	var wantUndirected mat.Dense
+	wantUndirected.Add(wantDirected, wantDirected.T())
+	au := Adjacency(ug, idx)
+	if !mat.Equal(au, &wantUndirected) {
+		t.Errorf("unexpected undirected adjacency:\ngot:\n%v\nwant:\n%v", mat.Formatted(au), mat.Formatted(&wantUndirected))
+	}
+
+	// Construct the graphs back from the matrices
+	// and check that the edges are preserved.
+	dback := NewWeightedDirectedGraph(a, &idx, 0, 0)
+	uback := NewWeightedUndirectedGraph(au, &idx, 0, 0)
+	checkSameEdges(t, "directed", dg, dback)
+	checkSameEdges(t, "undirected", ug, uback)
+	if got := uback.Nodes().Len(); got != 5 {
+		t.Errorf("unexpected number of nodes: got %d, want 5", got)
+	}
+	if !mat.Equal(Adjacency(dback, NewIndex(dback)), a) {
+		t.Errorf("directed adjacency not preserved by round trip")
+	}
+
+	// Without an index the nodes have IDs from zero.
+	g := NewWeightedDirectedGraph(a, nil, 0, 0)
+	if w, ok := g.Weight(2, 4); !ok || w != 3 {
+		t.Errorf("unexpected weight of edge 2→4: got %v, want 3", w)
+	}
+
+	if !panics(func() { Adjacency(dg, IndexOf([]graph.Node{simple.Node(3)})) }) {
+		t.Errorf("expected panic for node missing from index")
+	}
+	if !panics(func() { IndexOf([]graph.Node{simple.Node(1), simple.Node(1)}) }) {
+		t.Errorf("expected panic for repeated node ID")
+	}
+	if !panics(func() { NewWeightedUndirectedGraph(a, nil, 0, 0) }) {
+		t.Errorf("expected panic for asymmetric matrix")
+	}
+	if !panics(func() { NewWeightedDirectedGraph(mat.NewDense(2, 3, nil), nil, 0, 0) }) {
+		t.Errorf("expected panic for non-square matrix")
+	}
+}
+
+type weightedGraph interface {
+	graph.Weighted
+	WeightedEdges() graph.WeightedEdges
+}
+
+func checkSameEdges(t *testing.T, name string, orig, back weightedGraph) {
+	t.Helper()
+	edges := orig.WeightedEdges()
+	for edges.Next() {
+		e := edges.WeightedEdge()
+		w, ok := back.Weight(e.From().ID(), e.To().ID())
+		if !ok || w != e.Weight() {
+			t.Errorf("%s: unexpected weight of edge %d-%d: got %v, want %v", name, e.From().ID(), e.To().ID(), w, e.Weight())
+		}
+	}
+	if got, want := back.WeightedEdges().Len(), orig.WeightedEdges().Len(); got != want {
+		t.Errorf("%s: unexpected number of edges: got %d, want %d", name, got, want)
+	}
+}
+
+func TestLaplacianIncidence(t *testing.T) {
+	ug := simple.NewUndirectedGraph()
+	wg := simple.NewWeightedUndirectedGraph(0, 0)
+	for _, e := range testEdges {
+		ug.SetEdge(simple.Edge{F: simple.Node(e.from), T: simple.Node(e.to)})
+		wg.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(e.from), T: simple.Node(e.to), W: e.weight})
+	}
+
+	// Compare with the unweighted Laplacian of the spectral package,
+	// which orders the nodes by the graph's Nodes method.
+	want := spectral.NewLaplacian(ug)
+	idx := IndexOf(want.Nodes)
+	l := Laplacian(ug, idx)
+	if !mat.Equal(l, want) {
+		t.Errorf("unexpected Laplacian:\ngot:\n%v\nwant:\n%v", mat.Formatted(l), mat.Formatted(want))
+	}
+
+	b, edges := Incidence(ug, idx)
+	if len(edges) != len(testEdges) {
+		t.Fatalf("unexpected number of edges: got %d, want %d", len(edges), len(testEdges))
+	}
+	var bbt mat.Dense
+	bbt.Mul(b, b.T())
+	if !mat.Equal(&bbt, l) {
+		t.Errorf("B Bᵀ does not match Laplacian:\ngot:\n%v\nwant:\n%v", mat.Formatted(&bbt), mat.Formatted(l))
+	}
+
+	// Weighted undirected graph: L = B W Bᵀ.
+	idx = NewIndex(wg)
+	l = Laplacian(wg, idx)
+	b, edges = Incidence(wg, idx)
+	w := make([]float64, len(edges))
+	for k, e := range edges {
+		w[k] = e.(graph.WeightedEdge).Weight()
+	}
+	var bw, bwbt mat.Dense
+	bw.Mul(b, mat.NewDiagDense(len(w), w))
+	bwbt.Mul(&bw, b.T())
+	if !mat.EqualApprox(&bwbt, l, 1e-14) {
+		t.Errorf("B W Bᵀ does not match Laplacian:\ngot:\n%v\nwant:\n%v", mat.Formatted(&bwbt), mat.Formatted(l))
+	}
+	r, _ := l.Dims()
+	for i := 0; i < r; i++ {
+		if s := mat.Sum(l.RowView(i)); s != 0 {
+			t.Errorf("row %d of Laplacian does not sum to zero: %v", i, s)
+		}
+	}
+
+	// Directed graph: each edge has its own column,
+	// oriented from its from node to its to node.
+	dg := simple.NewDirectedGraph()
+	for _, e := range testEdges {
+		dg.SetEdge(simple.Edge{F: simple.Node(e.from), T: simple.Node(e.to)})
+	}
+	dg.SetEdge(simple.Edge{F: simple.Node(3), T: simple.Node(10)})
+	idx = NewIndex(dg)
+	b, edges = Incidence(dg, idx)
+	if len(edges) != len(testEdges)+1 {
+		t.Fatalf("unexpected number of directed edges: got %d, want %d", len(edges), len(testEdges)+1)
+	}
+	for k, e := range edges {
+		if b.At(idx.IDs[e.From().ID()], k) != -1 || b.At(idx.IDs[e.To().ID()], k) != 1 {
+			t.Errorf("unexpected orientation of column %d for edge %d→%d", k, e.From().ID(), e.To().ID())
+		}
+		if k > 0 {
+			prev := edges[k-1]
+			pi, ci := idx.IDs[prev.From().ID()], idx.IDs[e.From().ID()]
+			if pi > ci || (pi == ci && idx.IDs[prev.To().ID()] > idx.IDs[e.To().ID()]) {
+				t.Errorf("edges not ordered by index at column %d", k)
+			}
+		}
+	}
+	ld := Laplacian(dg, idx)
+	if got := ld.At(idx.IDs[3], idx.IDs[3]); got != 2 {
+		t.Errorf("unexpected out-degree of node 3: got %v, want 2", got)
+	}
+}
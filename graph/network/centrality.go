@@ -0,0 +1,163 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Katz returns the Katz centrality for nodes of the graph g, the solution of
+//
+//	x_v = alpha \sum_{u→v} w_{uv} x_u + beta
+//
+// found by iterating the equation until the 2-norm of the vector difference
+// between iterations is below tol, or iters iterations have been made. The
+// returned map is keyed on the graph node IDs, and ok indicates whether the
+// iteration converged to within tol. The iteration only converges when alpha
+// is less than the reciprocal of the largest eigenvalue of the adjacency
+// matrix of g.
+//
+// For directed graphs the incoming edges are used. If g is a graph.Weighted,
+// the edge weights are used, otherwise all edges have unit weight. Katz uses
+// a sparse representation of the adjacency matrix of g.
+func Katz(g graph.Graph, alpha, beta, tol float64, iters int) (c map[int64]float64, ok bool) {
+	nodes := graph.NodesOf(g.Nodes())
+	indexOf := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+	}
+	m := adjacencyMatrix(g, nodes, indexOf, alpha)
+
+	last := make([]float64, len(nodes))
+	lastV := mat.NewVecDense(len(nodes), last)
+	vec := make([]float64, len(nodes))
+	for i := range vec {
+		vec[i] = beta
+	}
+	v := mat.NewVecDense(len(nodes), vec)
+	for {
+		iters--
+		if iters < 0 {
+			break
+		}
+		lastV, v = v, lastV
+		m.mulVecUnitary(v, lastV)
+		floats.AddConst(beta, v.RawVector().Data)
+		d := normDiff(vec, last)
+		if d < tol {
+			ok = true
+			break
+		}
+		if math.IsInf(d, 0) || math.IsNaN(d) {
+			// The iteration has diverged.
+			break
+		}
+	}
+
+	c = make(map[int64]float64, len(nodes))
+	for i, r := range v.RawVector().Data {
+		c[nodes[i].ID()] = r
+	}
+	return c, ok
+}
+
+// Eigenvector returns the eigenvector centrality for nodes of the graph g,
+// the elements of the principal eigenvector of the transposed adjacency
+// matrix of g, A^T, normalized to have a 2-norm of one. The eigenvector
+// is found by power iteration on the matrix
+//
+//	damp A^T/λ + (1-damp) I
+//
+// where λ is the current estimate of the principal eigenvalue, terminating
+// when the 2-norm of the vector difference between iterations is below tol,
+// or iters iterations have been made. A damping factor, damp, less than one
+// ensures that the iteration converges for graphs with periodic structure,
+// such as bipartite graphs, for which undamped power iteration oscillates.
+// The returned map is keyed on the graph node IDs, and ok indicates whether
+// the iteration converged to within tol.
+//
+// For directed graphs the incoming edges are used. If g is a graph.Weighted,
+// the edge weights are used, otherwise all edges have unit weight. Eigenvector
+// uses a sparse representation of the adjacency matrix of g.
+//
+// Eigenvector will panic if damp is not in (0, 1] or g has a negative edge
+// weight.
+func Eigenvector(g graph.Graph, damp, tol float64, iters int) (c map[int64]float64, ok bool) {
+	if damp <= 0 || 1 < damp {
+		panic("network: damping factor out of range")
+	}
+	nodes := graph.NodesOf(g.Nodes())
+	indexOf := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+	}
+	m := adjacencyMatrix(g, nodes, indexOf, 1)
+	for _, r := range m {
+		for _, e := range r {
+			if e.value < 0 {
+				panic("network: negative edge weight")
+			}
+		}
+	}
+
+	last := make([]float64, len(nodes))
+	lastV := mat.NewVecDense(len(nodes), last)
+	vec := make([]float64, len(nodes))
+	for i := range vec {
+		vec[i] = 1 / math.Sqrt(float64(len(nodes)))
+	}
+	v := mat.NewVecDense(len(nodes), vec)
+	for {
+		iters--
+		if iters < 0 {
+			break
+		}
+		lastV, v = v, lastV
+		m.mulVecUnitary(v, lastV)
+		x, y := v.RawVector().Data, lastV.RawVector().Data
+		norm := floats.Norm(x, 2)
+		if norm == 0 {
+			// A^T.x is zero, so the principal eigenvalue
+			// is zero and the centralities are undefined.
+			copy(x, y)
+			break
+		}
+		floats.Scale(damp/norm, x)
+		floats.AddScaled(x, 1-damp, y)
+		floats.Scale(1/floats.Norm(x, 2), x)
+		if normDiff(x, y) < tol {
+			ok = true
+			break
+		}
+	}
+
+	c = make(map[int64]float64, len(nodes))
+	for i, r := range v.RawVector().Data {
+		c[nodes[i].ID()] = r
+	}
+	return c, ok
+}
+
+// adjacencyMatrix returns the transpose of the adjacency matrix of g scaled
+// by f, such that row v holds the weights of the edges leading to v.
+func adjacencyMatrix(g graph.Graph, nodes []graph.Node, indexOf map[int64]int, f float64) rowCompressedMatrix {
+	weight := outWeightFunc(g)
+	m := make(rowCompressedMatrix, len(nodes))
+	for j, u := range nodes {
+		uid := u.ID()
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if w := weight(uid, vid); w != 0 {
+				m.addTo(indexOf[vid], j, f*w)
+			}
+		}
+	}
+	return m
+}
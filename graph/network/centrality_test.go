@@ -0,0 +1,234 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/path"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/mat"
+)
+
+// randomWeightedDigraph returns a strongly connected weighted directed
+// graph with n nodes formed by a directed cycle through all the nodes
+// and m additional random edges.
+func randomWeightedDigraph(rnd *rand.Rand, n, m int) *simple.WeightedDirectedGraph {
+	g := simple.NewWeightedDirectedGraph(0, 0)
+	for i := range n {
+		g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node((i + 1) % n), W: 0.5 + rnd.Float64()})
+	}
+	for range m {
+		u, v := rnd.IntN(n), rnd.IntN(n)
+		if u == v {
+			continue
+		}
+		g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: 0.5 + rnd.Float64()})
+	}
+	return g
+}
+
+// denseAdjacency returns the dense adjacency matrix of g with rows and
+// columns indexed by node ID.
+func denseAdjacency(g graph.Weighted) *mat.Dense {
+	nodes := graph.NodesOf(g.Nodes())
+	a := mat.NewDense(len(nodes), len(nodes), nil)
+	for _, u := range nodes {
+		for _, v := range graph.NodesOf(g.From(u.ID())) {
+			w, _ := g.Weight(u.ID(), v.ID())
+			a.Set(int(u.ID()), int(v.ID()), w)
+		}
+	}
+	return a
+}
+
+func TestKatz(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := range 20 {
+		n := 2 + rnd.IntN(10)
+		g := randomWeightedDigraph(rnd, n, 2*n)
+		a := denseAdjacency(g)
+
+		var eig mat.Eigen
+		if !eig.Factorize(a, mat.EigenNone) {
+			t.Fatalf("trial %d: eigendecomposition failed", trial)
+		}
+		var rho float64
+		for _, l := range eig.Values(nil) {
+			rho = math.Max(rho, math.Hypot(real(l), imag(l)))
+		}
+		alpha := 0.5 / rho
+		const beta = 2
+
+		// Solve (I - alpha A^T) x = beta 1 directly.
+		var m mat.Dense
+		m.Scale(-alpha, a.T())
+		for i := range n {
+			m.Set(i, i, m.At(i, i)+1)
+		}
+		b := mat.NewVecDense(n, nil)
+		for i := range n {
+			b.SetVec(i, beta)
+		}
+		var want mat.VecDense
+		err := want.SolveVec(&m, b)
+		if err != nil {
+			t.Fatalf("trial %d: unexpected error: %v", trial, err)
+		}
+
+		got, ok := Katz(g, alpha, beta, 1e-12, 1000)
+		if !ok {
+			t.Errorf("trial %d: Katz centrality did not converge", trial)
+		}
+		for i := range n {
+			if !scalar.EqualWithinAbsOrRel(got[int64(i)], want.AtVec(i), 1e-10, 1e-10) {
+				t.Errorf("trial %d: unexpected Katz centrality for node %d: got:%v want:%v", trial, i, got[int64(i)], want.AtVec(i))
+			}
+		}
+
+		_, ok = Katz(g, 2/rho, beta, 1e-12, 1000)
+		if ok {
+			t.Errorf("trial %d: Katz centrality unexpectedly converged for alpha > 1/ρ", trial)
+		}
+	}
+}
+
+func TestEigenvector(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := range 20 {
+		n := 2 + rnd.IntN(10)
+		g := randomWeightedDigraph(rnd, n, 2*n)
+		a := denseAdjacency(g)
+
+		var eig mat.Eigen
+		if !eig.Factorize(a.T(), mat.EigenRight) {
+			t.Fatalf("trial %d: eigendecomposition failed", trial)
+		}
+		values := eig.Values(nil)
+		var vecs mat.CDense
+		eig.VectorsTo(&vecs)
+		k := 0
+		for i, l := range values {
+			if real(l) > real(values[k]) {
+				k = i
+			}
+		}
+		want := make([]float64, n)
+		var norm float64
+		for i := range want {
+			want[i] = real(vecs.At(i, k))
+			norm += want[i] * want[i]
+		}
+		norm = math.Sqrt(norm)
+		if want[0] < 0 {
+			norm = -norm
+		}
+		for i := range want {
+			want[i] /= norm
+		}
+
+		// The random graphs may be periodic, so undamped
+		// power iteration is not guaranteed to converge.
+		for _, damp := range []float64{0.5, 0.85} {
+			got, ok := Eigenvector(g, damp, 1e-12, 10000)
+			if !ok {
+				t.Errorf("trial %d: eigenvector centrality did not converge with damp=%v", trial, damp)
+				continue
+			}
+			for i := range n {
+				if !scalar.EqualWithinAbsOrRel(got[int64(i)], want[i], 1e-8, 1e-8) {
+					t.Errorf("trial %d: unexpected eigenvector centrality for node %d with damp=%v: got:%v want:%v",
+						trial, i, damp, got[int64(i)], want[i])
+				}
+			}
+		}
+	}
+}
+
+func TestEigenvectorBipartite(t *testing.T) {
+	t.Parallel()
+	// A star graph is bipartite, so undamped power iteration
+	// oscillates between the center and the leaves.
+	g := simple.NewUndirectedGraph()
+	for i := int64(1); i <= 4; i++ {
+		g.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(i)})
+	}
+
+	_, ok := Eigenvector(g, 1, 1e-12, 1000)
+	if ok {
+		t.Error("undamped eigenvector centrality unexpectedly converged for bipartite graph")
+	}
+
+	got, ok := Eigenvector(g, 0.85, 1e-12, 1000)
+	if !ok {
+		t.Fatal("damped eigenvector centrality did not converge for bipartite graph")
+	}
+	// The principal eigenvector of the star K_{1,4} is
+	// proportional to [2 1 1 1 1].
+	want := map[int64]float64{0: 2 / math.Sqrt(8), 1: 1 / math.Sqrt(8), 2: 1 / math.Sqrt(8), 3: 1 / math.Sqrt(8), 4: 1 / math.Sqrt(8)}
+	for id, w := range want {
+		if !scalar.EqualWithinAbsOrRel(got[id], w, 1e-10, 1e-10) {
+			t.Errorf("unexpected eigenvector centrality for node %d: got:%v want:%v", id, got[id], w)
+		}
+	}
+}
+
+func TestEigenvectorPanics(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedDirectedGraph(0, 0)
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(0), T: simple.Node(1), W: 1})
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(1), T: simple.Node(0), W: -1})
+	for _, test := range []struct {
+		name string
+		damp float64
+	}{
+		{name: "negative weight", damp: 1},
+		{name: "zero damping", damp: 0},
+		{name: "large damping", damp: 1.5},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic for %s", test.name)
+				}
+			}()
+			Eigenvector(g, test.damp, 1e-12, 10)
+		}()
+	}
+}
+
+func TestHarmonicWeightedDisconnected(t *testing.T) {
+	t.Parallel()
+	// Two weighted directed components, A→B→C and D⇄E,
+	// with no paths between them.
+	g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+	for _, e := range []simple.WeightedEdge{
+		{F: simple.Node(A), T: simple.Node(B), W: 2},
+		{F: simple.Node(B), T: simple.Node(C), W: 2},
+		{F: simple.Node(D), T: simple.Node(E), W: 4},
+		{F: simple.Node(E), T: simple.Node(D), W: 0.5},
+	} {
+		g.SetWeightedEdge(e)
+	}
+	got := Harmonic(g, path.DijkstraAllPaths(g))
+	want := map[int64]float64{
+		A: 0,
+		B: 1.0 / 2,
+		C: 1.0/2 + 1.0/4,
+		D: 2,
+		E: 1.0 / 4,
+	}
+	for id, w := range want {
+		if !scalar.EqualWithinAbsOrRel(got[id], w, 1e-12, 1e-12) {
+			t.Errorf("unexpected harmonic centrality for node %d: got:%v want:%v", id, got[id], w)
+		}
+	}
+}
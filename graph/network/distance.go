@@ -104,7 +104,8 @@ func Farness(g graph.Graph, p path.AllShortest) map[int64]float64 {
 //	H(v)= \sum_{u ≠ v} 1 / d(u,v)
 //
 // For directed graphs the incoming paths are used. Infinite distances are
-// not considered.
+// not considered, so unlike Closeness, Harmonic is meaningful for graphs
+// that are not connected.
 func Harmonic(g graph.Graph, p path.AllShortest) map[int64]float64 {
 	nodes := graph.NodesOf(g.Nodes())
 	h := make(map[int64]float64, len(nodes))
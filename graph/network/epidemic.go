@@ -0,0 +1,229 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"math/rand/v2"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// Compartment is the state of a node in an epidemic simulation.
+type Compartment int
+
+const (
+	// Susceptible nodes may be infected by their infected neighbors.
+	Susceptible Compartment = iota
+	// Infected nodes may infect their susceptible neighbors.
+	Infected
+	// Recovered nodes can neither infect nor be infected.
+	Recovered
+)
+
+// EpidemicModel specifies the transitions between compartments in an
+// epidemic simulation.
+type EpidemicModel int
+
+const (
+	// SI is the susceptible-infected model. Infected nodes
+	// remain infected.
+	SI EpidemicModel = iota
+	// SIS is the susceptible-infected-susceptible model.
+	// Infected nodes become susceptible on recovery.
+	SIS
+	// SIR is the susceptible-infected-recovered model.
+	// Infected nodes become recovered on recovery.
+	SIR
+)
+
+// Census is the number of nodes in each compartment of an epidemic
+// simulation.
+type Census struct {
+	Susceptible int
+	Infected    int
+	Recovered   int
+}
+
+// Epidemic is a discrete-time stochastic compartmental epidemic on a graph.
+// In each step each node infected at the start of the step independently
+// infects each of its susceptible neighbors with probability Beta and then
+// recovers with probability Gamma. Nodes infected during a step become
+// infectious in the following step. In directed graphs infection spreads
+// from a node to the nodes it has edges to.
+//
+// If the graph is a graph.Weighted, the probability of infection along an
+// edge is Beta multiplied by the edge weight.
+type Epidemic struct {
+	// Model is the compartmental model of the epidemic.
+	Model EpidemicModel
+
+	// Beta is the probability of infection along an edge
+	// in each step.
+	Beta float64
+	// Gamma is the probability of recovery of an infected
+	// node in each step. Gamma is not used by the SI model.
+	Gamma float64
+
+	// Steps is the maximum number of steps simulated. If Steps
+	// is zero, the simulation continues until no further change
+	// of the compartments of the nodes is possible. For the SIS
+	// model this may take a very large number of steps.
+	Steps int
+}
+
+// Simulate runs the epidemic on g starting with the nodes with IDs in seeds
+// infected and all other nodes susceptible. Random numbers are drawn from
+// src, or from the global source if src is nil. Simulate returns the number
+// of nodes in each compartment at the start and after each step of the
+// simulation, and the final compartment of each node of g keyed on node ID.
+//
+// Simulate will panic if a seed is not a node of g, Beta or Gamma is not in
+// [0, 1], or the probability of infection along an edge is greater than one.
+func (e Epidemic) Simulate(g graph.Graph, seeds []int64, src rand.Source) (history []Census, state map[int64]Compartment) {
+	history, state, _ = e.simulate(g, seeds, src)
+	return history, state
+}
+
+// Spread returns the IDs of the nodes of g infected at any time during a
+// simulation of the epidemic starting from the nodes with IDs in seeds,
+// including the seeds. Random numbers are drawn from src, or from the global
+// source if src is nil. Spread allows an Epidemic to be used as a Cascade.
+//
+// Spread will panic under the same conditions as Simulate.
+func (e Epidemic) Spread(g graph.Graph, seeds []int64, src rand.Source) []int64 {
+	_, _, ever := e.simulate(g, seeds, src)
+	return ever
+}
+
+func (e Epidemic) simulate(g graph.Graph, seeds []int64, src rand.Source) (history []Census, state map[int64]Compartment, ever []int64) {
+	if e.Beta < 0 || 1 < e.Beta {
+		panic("network: infection probability out of range")
+	}
+	if e.Model != SI && (e.Gamma < 0 || 1 < e.Gamma) {
+		panic("network: recovery probability out of range")
+	}
+	if e.Steps < 0 {
+		panic("network: negative number of steps")
+	}
+	var rnd func() float64
+	if src == nil {
+		rnd = rand.Float64
+	} else {
+		rnd = rand.New(src).Float64
+	}
+
+	nodes := graph.NodesOf(g.Nodes())
+	state = make(map[int64]Compartment, len(nodes))
+	for _, n := range nodes {
+		state[n.ID()] = Susceptible
+	}
+	var infected []int64
+	for _, id := range seeds {
+		if g.Node(id) == nil {
+			panic("network: seed node not in graph")
+		}
+		if state[id] == Infected {
+			continue
+		}
+		state[id] = Infected
+		infected = append(infected, id)
+	}
+	ever = slices.Clone(infected)
+	var wasInfected map[int64]bool
+	if e.Model == SIS {
+		wasInfected = make(map[int64]bool)
+		for _, id := range infected {
+			wasInfected[id] = true
+		}
+	}
+	c := Census{Susceptible: len(nodes) - len(infected), Infected: len(infected)}
+	history = append(history, c)
+
+	weight := outWeightFunc(g)
+	prob := func(uid, vid int64) float64 {
+		p := e.Beta * weight(uid, vid)
+		if p < 0 || 1 < p {
+			panic("network: infection probability out of range")
+		}
+		return p
+	}
+	recovers := e.Model != SI && e.Gamma > 0
+	for step := 0; e.Steps == 0 || step < e.Steps; step++ {
+		if len(infected) == 0 {
+			break
+		}
+		if e.Steps == 0 && !recovers && !canInfect(g, state, infected, prob) {
+			break
+		}
+
+		var newly []int64
+		for _, uid := range infected {
+			to := g.From(uid)
+			for to.Next() {
+				vid := to.Node().ID()
+				if state[vid] != Susceptible {
+					continue
+				}
+				if rnd() < prob(uid, vid) {
+					state[vid] = Infected
+					newly = append(newly, vid)
+				}
+			}
+		}
+		if recovers {
+			n := 0
+			for _, uid := range infected {
+				if rnd() >= e.Gamma {
+					infected[n] = uid
+					n++
+					continue
+				}
+				c.Infected--
+				if e.Model == SIS {
+					state[uid] = Susceptible
+					c.Susceptible++
+				} else {
+					state[uid] = Recovered
+					c.Recovered++
+				}
+			}
+			infected = infected[:n]
+		}
+		c.Susceptible -= len(newly)
+		c.Infected += len(newly)
+		infected = append(infected, newly...)
+		history = append(history, c)
+
+		// Nodes can only be reinfected in the SIS model,
+		// and only nodes that were never infected are
+		// susceptible in the other models.
+		for _, id := range newly {
+			if e.Model == SIS {
+				if wasInfected[id] {
+					continue
+				}
+				wasInfected[id] = true
+			}
+			ever = append(ever, id)
+		}
+	}
+	return history, state, ever
+}
+
+// canInfect returns whether any of the infected nodes has a susceptible
+// neighbor in g that it may infect.
+func canInfect(g graph.Graph, state map[int64]Compartment, infected []int64, prob func(uid, vid int64) float64) bool {
+	for _, uid := range infected {
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if state[vid] == Susceptible && prob(uid, vid) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
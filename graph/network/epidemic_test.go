@@ -0,0 +1,173 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// pathGraph returns an undirected path graph with n nodes.
+func pathGraph(n int) *simple.UndirectedGraph {
+	g := simple.NewUndirectedGraph()
+	g.AddNode(simple.Node(0))
+	for i := 1; i < n; i++ {
+		g.SetEdge(simple.Edge{F: simple.Node(i - 1), T: simple.Node(i)})
+	}
+	return g
+}
+
+var epidemicTests = []struct {
+	name     string
+	epidemic Epidemic
+	n        int
+	seeds    []int64
+
+	wantHistory []Census
+	wantState   Compartment
+	wantSpread  int
+}{
+	{
+		name:     "SI",
+		epidemic: Epidemic{Model: SI, Beta: 1},
+		n:        4,
+		seeds:    []int64{0},
+		wantHistory: []Census{
+			{Susceptible: 3, Infected: 1},
+			{Susceptible: 2, Infected: 2},
+			{Susceptible: 1, Infected: 3},
+			{Susceptible: 0, Infected: 4},
+		},
+		wantState:  Infected,
+		wantSpread: 4,
+	},
+	{
+		name:     "SI limited steps",
+		epidemic: Epidemic{Model: SI, Beta: 1, Steps: 1},
+		n:        4,
+		seeds:    []int64{1},
+		wantHistory: []Census{
+			{Susceptible: 3, Infected: 1},
+			{Susceptible: 1, Infected: 3},
+		},
+		wantState:  -1,
+		wantSpread: 3,
+	},
+	{
+		name:     "SIR",
+		epidemic: Epidemic{Model: SIR, Beta: 1, Gamma: 1},
+		n:        3,
+		seeds:    []int64{0},
+		wantHistory: []Census{
+			{Susceptible: 2, Infected: 1},
+			{Susceptible: 1, Infected: 1, Recovered: 1},
+			{Susceptible: 0, Infected: 1, Recovered: 2},
+			{Susceptible: 0, Infected: 0, Recovered: 3},
+		},
+		wantState:  Recovered,
+		wantSpread: 3,
+	},
+	{
+		name:     "SIS",
+		epidemic: Epidemic{Model: SIS, Beta: 0, Gamma: 1},
+		n:        3,
+		seeds:    []int64{0, 2, 0},
+		wantHistory: []Census{
+			{Susceptible: 1, Infected: 2},
+			{Susceptible: 3, Infected: 0},
+		},
+		wantState:  Susceptible,
+		wantSpread: 2,
+	},
+}
+
+func TestEpidemicSimulate(t *testing.T) {
+	t.Parallel()
+	for _, test := range epidemicTests {
+		g := pathGraph(test.n)
+		history, state := test.epidemic.Simulate(g, test.seeds, nil)
+		if !reflect.DeepEqual(history, test.wantHistory) {
+			t.Errorf("unexpected history for %s:\ngot: %v\nwant:%v", test.name, history, test.wantHistory)
+		}
+		if len(state) != test.n {
+			t.Errorf("unexpected number of node states for %s: got:%d want:%d", test.name, len(state), test.n)
+		}
+		if test.wantState >= 0 {
+			for id, c := range state {
+				if c != test.wantState {
+					t.Errorf("unexpected final state of node %d for %s: got:%d want:%d", id, test.name, c, test.wantState)
+				}
+			}
+		}
+		if got := len(test.epidemic.Spread(g, test.seeds, nil)); got != test.wantSpread {
+			t.Errorf("unexpected spread for %s: got:%d want:%d", test.name, got, test.wantSpread)
+		}
+	}
+}
+
+func TestEpidemicSIR(t *testing.T) {
+	t.Parallel()
+	// On a star with the hub infected and recovery
+	// after a single step, each leaf is infected
+	// independently with probability Beta.
+	g := simple.NewUndirectedGraph()
+	const leaves = 50
+	for i := 1; i <= leaves; i++ {
+		g.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(i)})
+	}
+	e := Epidemic{Model: SIR, Beta: 0.3, Gamma: 1}
+	mean, stderr := ExpectedSpread(g, e, []int64{0}, 2000, rand.NewPCG(1, 1))
+	want := 1 + e.Beta*leaves
+	if math.Abs(mean-want) > 4*stderr {
+		t.Errorf("unexpected expected spread: got:%v±%v want:%v", mean, stderr, want)
+	}
+	history, _ := e.Simulate(g, []int64{0}, rand.NewPCG(2, 2))
+	last := history[len(history)-1]
+	if last.Infected != 0 || last.Susceptible+last.Recovered != leaves+1 {
+		t.Errorf("unexpected final census: %+v", last)
+	}
+}
+
+func TestEpidemicWeighted(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(0), T: simple.Node(1), W: 0})
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(0), T: simple.Node(2), W: 1})
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(2), T: simple.Node(0), W: 1})
+	got := Epidemic{Model: SI, Beta: 1}.Spread(g, []int64{0}, nil)
+	slices.Sort(got)
+	if want := []int64{0, 2}; !slices.Equal(got, want) {
+		t.Errorf("unexpected spread: got:%v want:%v", got, want)
+	}
+
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(0), T: simple.Node(1), W: 2})
+	if !panics(func() { Epidemic{Model: SI, Beta: 1}.Spread(g, []int64{0}, nil) }) {
+		t.Error("expected panic for infection probability greater than one")
+	}
+}
+
+func TestEpidemicPanics(t *testing.T) {
+	t.Parallel()
+	g := pathGraph(3)
+	for _, test := range []struct {
+		name  string
+		e     Epidemic
+		seeds []int64
+	}{
+		{name: "missing seed", e: Epidemic{Beta: 0.5}, seeds: []int64{5}},
+		{name: "bad beta", e: Epidemic{Beta: 1.5}, seeds: []int64{0}},
+		{name: "bad gamma", e: Epidemic{Model: SIR, Beta: 0.5, Gamma: -1}, seeds: []int64{0}},
+		{name: "negative steps", e: Epidemic{Beta: 0.5, Steps: -1}, seeds: []int64{0}},
+	} {
+		if !panics(func() { test.e.Simulate(g, test.seeds, nil) }) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
@@ -0,0 +1,294 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"cmp"
+	"container/heap"
+	"math"
+	"math/rand/v2"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// Cascade is a stochastic model of the spread of influence through a
+// graph.
+type Cascade interface {
+	// Spread returns the IDs of the nodes of g activated in a
+	// single realization of the model started from the nodes
+	// with IDs in seeds, including the seeds. Random numbers
+	// are drawn from src, or from the global source if src is
+	// nil.
+	Spread(g graph.Graph, seeds []int64, src rand.Source) []int64
+}
+
+var (
+	_ Cascade = IndependentCascade{}
+	_ Cascade = LinearThreshold{}
+	_ Cascade = Epidemic{}
+)
+
+// IndependentCascade is the independent cascade model of influence spread.
+// When a node is activated it has a single chance to activate each of its
+// inactive neighbors, each independently with probability Prob. In directed
+// graphs influence spreads from a node to the nodes it has edges to.
+//
+// If the graph is a graph.Weighted, the probability of activation along an
+// edge is Prob multiplied by the edge weight.
+//
+// See Kempe, D., Kleinberg, J. and Tardos, É. (2003) Maximizing the spread
+// of influence through a social network. KDD'03 137-146.
+// doi:10.1145/956750.956769
+type IndependentCascade struct {
+	// Prob is the probability of activation along an edge.
+	Prob float64
+}
+
+// Spread returns the IDs of the nodes of g activated in a single realization
+// of the independent cascade started from the nodes with IDs in seeds,
+// including the seeds. Random numbers are drawn from src, or from the global
+// source if src is nil.
+//
+// Spread will panic if a seed is not a node of g or the probability of
+// activation along an edge is not in [0, 1].
+func (c IndependentCascade) Spread(g graph.Graph, seeds []int64, src rand.Source) []int64 {
+	var rnd func() float64
+	if src == nil {
+		rnd = rand.Float64
+	} else {
+		rnd = rand.New(src).Float64
+	}
+	weight := outWeightFunc(g)
+
+	active, seen := activateSeeds(g, seeds)
+	for i := 0; i < len(active); i++ {
+		uid := active[i]
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if seen[vid] {
+				continue
+			}
+			p := c.Prob * weight(uid, vid)
+			if p < 0 || 1 < p {
+				panic("network: activation probability out of range")
+			}
+			if rnd() < p {
+				seen[vid] = true
+				active = append(active, vid)
+			}
+		}
+	}
+	return active
+}
+
+// LinearThreshold is the linear threshold model of influence spread. Each
+// node draws a threshold uniformly from [0, 1) and is activated when the
+// total influence of its active neighbors reaches the threshold. The
+// influence of a neighbor u on a node v is the weight of the edge from u to
+// v divided by the total weight of the edges to v, or the reciprocal of the
+// number of neighbors of v if the graph is not a graph.Weighted. In
+// directed graphs influence spreads from a node to the nodes it has edges
+// to.
+//
+// See Kempe, D., Kleinberg, J. and Tardos, É. (2003) Maximizing the spread
+// of influence through a social network. KDD'03 137-146.
+// doi:10.1145/956750.956769
+type LinearThreshold struct{}
+
+// Spread returns the IDs of the nodes of g activated in a single realization
+// of the linear threshold model started from the nodes with IDs in seeds,
+// including the seeds. Random numbers are drawn from src, or from the global
+// source if src is nil.
+//
+// Spread will panic if a seed is not a node of g or g has a negative edge
+// weight.
+func (LinearThreshold) Spread(g graph.Graph, seeds []int64, src rand.Source) []int64 {
+	var rnd func() float64
+	if src == nil {
+		rnd = rand.Float64
+	} else {
+		rnd = rand.New(src).Float64
+	}
+	weight := outWeightFunc(g)
+	dg, isDirected := g.(graph.Directed)
+	inWeight := func(vid int64) float64 {
+		var from graph.Nodes
+		if isDirected {
+			from = dg.To(vid)
+		} else {
+			from = g.From(vid)
+		}
+		var sum float64
+		for from.Next() {
+			w := weight(from.Node().ID(), vid)
+			if w < 0 {
+				panic("network: negative edge weight")
+			}
+			sum += w
+		}
+		return sum
+	}
+
+	// Thresholds and accumulated influence are only
+	// held for inactive nodes reached by the spread.
+	type reached struct {
+		threshold, influence, total float64
+	}
+	state := make(map[int64]*reached)
+	active, seen := activateSeeds(g, seeds)
+	for i := 0; i < len(active); i++ {
+		uid := active[i]
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if seen[vid] {
+				continue
+			}
+			r, ok := state[vid]
+			if !ok {
+				r = &reached{threshold: rnd(), total: inWeight(vid)}
+				state[vid] = r
+			}
+			w := weight(uid, vid)
+			if w < 0 {
+				panic("network: negative edge weight")
+			}
+			r.influence += w
+			if r.total > 0 && r.influence >= r.threshold*r.total {
+				seen[vid] = true
+				active = append(active, vid)
+				delete(state, vid)
+			}
+		}
+	}
+	return active
+}
+
+// activateSeeds returns the unique seeds in order and the set of seeds.
+// It panics if a seed is not a node of g.
+func activateSeeds(g graph.Graph, seeds []int64) (active []int64, seen map[int64]bool) {
+	seen = make(map[int64]bool, len(seeds))
+	for _, id := range seeds {
+		if g.Node(id) == nil {
+			panic("network: seed node not in graph")
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		active = append(active, id)
+	}
+	return active, seen
+}
+
+// ExpectedSpread returns a Monte Carlo estimate of the expected number of
+// nodes of g activated by the cascade model started from the nodes with IDs
+// in seeds, and the standard error of the estimate, using the given number
+// of realizations. Random numbers are drawn from src, or from the global
+// source if src is nil.
+//
+// ExpectedSpread will panic if runs is not positive.
+func ExpectedSpread(g graph.Graph, model Cascade, seeds []int64, runs int, src rand.Source) (mean, stderr float64) {
+	if runs <= 0 {
+		panic("network: non-positive number of runs")
+	}
+	// Welford's algorithm for the mean and variance.
+	var m2 float64
+	for i := range runs {
+		n := float64(len(model.Spread(g, seeds, src)))
+		d := n - mean
+		mean += d / float64(i+1)
+		m2 += d * (n - mean)
+	}
+	if runs == 1 {
+		return mean, 0
+	}
+	return mean, math.Sqrt(m2 / float64(runs-1) / float64(runs))
+}
+
+// MaximizeInfluence returns k seed nodes of g chosen to maximize the
+// expected spread of the cascade model, and the estimated expected spread
+// of the seeds. The seeds are chosen greedily, each adding the largest
+// estimated increase in the expected spread, with expected spreads
+// estimated by ExpectedSpread using the given number of realizations. The
+// seeds are returned in the order they were chosen.
+//
+// The expected spread of the independent cascade and linear threshold
+// models is a monotone submodular function of the seeds, so the greedy
+// seeds achieve at least 1-1/e of the optimal expected spread, up to the
+// error of the estimates. The lazy evaluation of increases described in
+// Leskovec, J. et al. (2007) Cost-effective outbreak detection in networks.
+// KDD'07 420-429. doi:10.1145/1281192.1281239 is used to avoid estimating
+// increases that cannot be the largest.
+//
+// MaximizeInfluence will panic if k is negative or greater than the number
+// of nodes in g, or runs is not positive.
+func MaximizeInfluence(g graph.Graph, model Cascade, k, runs int, src rand.Source) (seeds []int64, spread float64) {
+	nodes := graph.NodesOf(g.Nodes())
+	if k < 0 || len(nodes) < k {
+		panic("network: invalid number of seeds")
+	}
+	if runs <= 0 {
+		panic("network: non-positive number of runs")
+	}
+	// Sort the candidates so that the result is
+	// reproducible for a given source.
+	slices.SortFunc(nodes, func(a, b graph.Node) int {
+		return cmp.Compare(a.ID(), b.ID())
+	})
+
+	seeds = make([]int64, 0, k)
+	candidates := make(gainQueue, 0, len(nodes))
+	for _, n := range nodes {
+		gain, _ := ExpectedSpread(g, model, []int64{n.ID()}, runs, src)
+		candidates = append(candidates, influenceGain{id: n.ID(), gain: gain})
+	}
+	heap.Init(&candidates)
+	for len(seeds) < k {
+		best := heap.Pop(&candidates).(influenceGain)
+		if best.round == len(seeds) {
+			seeds = append(seeds, best.id)
+			spread += best.gain
+			continue
+		}
+		// The gain of best was estimated for a smaller set of
+		// seeds and is an upper bound on its current gain, so
+		// re-estimate it and return it to the queue.
+		with, _ := ExpectedSpread(g, model, append(seeds, best.id), runs, src)
+		best.gain = with - spread
+		best.round = len(seeds)
+		heap.Push(&candidates, best)
+	}
+	return seeds, spread
+}
+
+// influenceGain is the estimated increase in the expected spread of a
+// cascade from adding a node to the seeds chosen in the given round.
+type influenceGain struct {
+	id    int64
+	gain  float64
+	round int
+}
+
+// gainQueue implements a max-priority queue of influence gains.
+type gainQueue []influenceGain
+
+func (q gainQueue) Len() int { return len(q) }
+func (q gainQueue) Less(i, j int) bool {
+	if q[i].gain == q[j].gain {
+		return q[i].id < q[j].id
+	}
+	return q[i].gain > q[j].gain
+}
+func (q gainQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *gainQueue) Push(n any)   { *q = append(*q, n.(influenceGain)) }
+func (q *gainQueue) Pop() any {
+	t := *q
+	var n any
+	n, *q = t[len(t)-1], t[:len(t)-1]
+	return n
+}
@@ -0,0 +1,188 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return false
+}
+
+func TestIndependentCascade(t *testing.T) {
+	t.Parallel()
+	g := pathGraph(5)
+	for _, test := range []struct {
+		prob  float64
+		seeds []int64
+		want  []int64
+	}{
+		{prob: 1, seeds: []int64{2}, want: []int64{0, 1, 2, 3, 4}},
+		{prob: 0, seeds: []int64{2, 4, 2}, want: []int64{2, 4}},
+	} {
+		got := IndependentCascade{Prob: test.prob}.Spread(g, test.seeds, nil)
+		slices.Sort(got)
+		if !slices.Equal(got, test.want) {
+			t.Errorf("unexpected spread for prob=%v: got:%v want:%v", test.prob, got, test.want)
+		}
+	}
+
+	// On a directed star each leaf is activated
+	// independently with probability Prob.
+	star := simple.NewDirectedGraph()
+	const leaves = 50
+	for i := 1; i <= leaves; i++ {
+		star.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(i)})
+	}
+	ic := IndependentCascade{Prob: 0.2}
+	mean, stderr := ExpectedSpread(star, ic, []int64{0}, 2000, rand.NewPCG(1, 1))
+	want := 1 + ic.Prob*leaves
+	if math.Abs(mean-want) > 4*stderr {
+		t.Errorf("unexpected expected spread: got:%v±%v want:%v", mean, stderr, want)
+	}
+	if mean, _ := ExpectedSpread(star, ic, []int64{1}, 10, nil); mean != 1 {
+		t.Errorf("unexpected spread against edge direction: got:%v want:1", mean)
+	}
+
+	if !panics(func() { IndependentCascade{Prob: 2}.Spread(g, []int64{0}, nil) }) {
+		t.Error("expected panic for activation probability greater than one")
+	}
+	if !panics(func() { ic.Spread(g, []int64{10}, nil) }) {
+		t.Error("expected panic for missing seed")
+	}
+	if !panics(func() { ExpectedSpread(g, ic, []int64{0}, 0, nil) }) {
+		t.Error("expected panic for non-positive number of runs")
+	}
+}
+
+func TestLinearThreshold(t *testing.T) {
+	t.Parallel()
+	// Each node of a directed chain has a single
+	// neighbor, so activation always propagates.
+	chain := simple.NewDirectedGraph()
+	for i := 1; i < 5; i++ {
+		chain.SetEdge(simple.Edge{F: simple.Node(i - 1), T: simple.Node(i)})
+	}
+	got := LinearThreshold{}.Spread(chain, []int64{1}, nil)
+	slices.Sort(got)
+	if want := []int64{1, 2, 3, 4}; !slices.Equal(got, want) {
+		t.Errorf("unexpected spread: got:%v want:%v", got, want)
+	}
+
+	for _, test := range []struct {
+		name string
+		g    graph.Graph
+		want float64
+	}{
+		{
+			// Node 2 is influenced equally by nodes 0 and 1,
+			// and is activated by node 0 alone with
+			// probability one half.
+			name: "unweighted",
+			g: func() graph.Graph {
+				g := simple.NewDirectedGraph()
+				g.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(2)})
+				g.SetEdge(simple.Edge{F: simple.Node(1), T: simple.Node(2)})
+				return g
+			}(),
+			want: 1.5,
+		},
+		{
+			// Node 0 has three quarters of the influence
+			// on node 2.
+			name: "weighted",
+			g: func() graph.Graph {
+				g := simple.NewWeightedUndirectedGraph(0, 0)
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(0), T: simple.Node(2), W: 3})
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(1), T: simple.Node(2), W: 1})
+				return g
+			}(),
+			// Node 1 is activated by node 2 with
+			// probability one.
+			want: 1 + 2*0.75,
+		},
+	} {
+		mean, stderr := ExpectedSpread(test.g, LinearThreshold{}, []int64{0}, 4000, rand.NewPCG(1, 1))
+		if math.Abs(mean-test.want) > 4*stderr {
+			t.Errorf("unexpected expected spread for %s: got:%v±%v want:%v", test.name, mean, stderr, test.want)
+		}
+	}
+}
+
+func TestMaximizeInfluence(t *testing.T) {
+	t.Parallel()
+	// Two directed stars with 6 and 3 leaves, a
+	// chain of 4 nodes and an isolated node.
+	g := simple.NewDirectedGraph()
+	for i := 1; i <= 6; i++ {
+		g.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(i)})
+	}
+	for i := 11; i <= 13; i++ {
+		g.SetEdge(simple.Edge{F: simple.Node(10), T: simple.Node(i)})
+	}
+	for i := 21; i < 24; i++ {
+		g.SetEdge(simple.Edge{F: simple.Node(i - 1), T: simple.Node(i)})
+	}
+	g.AddNode(simple.Node(30))
+
+	for _, test := range []struct {
+		model      Cascade
+		k          int
+		runs       int
+		wantSeeds  []int64
+		wantSpread float64
+		tol        float64
+	}{
+		{
+			model:      IndependentCascade{Prob: 1},
+			k:          3,
+			runs:       1,
+			wantSeeds:  []int64{0, 10, 20},
+			wantSpread: 15,
+		},
+		{
+			model:      LinearThreshold{},
+			k:          2,
+			runs:       1,
+			wantSeeds:  []int64{0, 10},
+			wantSpread: 11,
+		},
+		{
+			model:      IndependentCascade{Prob: 0.5},
+			k:          2,
+			runs:       2000,
+			wantSeeds:  []int64{0, 10},
+			wantSpread: 1 + 3 + 1 + 1.5,
+			tol:        0.3,
+		},
+	} {
+		seeds, spread := MaximizeInfluence(g, test.model, test.k, test.runs, rand.NewPCG(1, 1))
+		if !slices.Equal(seeds, test.wantSeeds) {
+			t.Errorf("unexpected seeds for %T: got:%v want:%v", test.model, seeds, test.wantSeeds)
+		}
+		if math.Abs(spread-test.wantSpread) > test.tol {
+			t.Errorf("unexpected spread for %T: got:%v want:%v", test.model, spread, test.wantSpread)
+		}
+	}
+
+	seeds, spread := MaximizeInfluence(g, IndependentCascade{Prob: 1}, 0, 1, nil)
+	if len(seeds) != 0 || spread != 0 {
+		t.Errorf("unexpected result for no seeds: got:%v %v", seeds, spread)
+	}
+	if !panics(func() { MaximizeInfluence(g, LinearThreshold{}, 20, 1, nil) }) {
+		t.Error("expected panic for too many seeds")
+	}
+}
@@ -17,8 +17,12 @@ import (
 // weighted graph using [Dinic's algorithm]. It repeatedly builds level graphs
 // and augments blocking flows until no more augmenting paths exist.
 //
+// If g is a graph.WeightedMultigraph, the capacity of the edge from u to v is
+// the sum of the weights of the parallel lines from u to v, independent of how
+// the graph aggregates line weights in its Weight method.
+//
 // MaxFlowDinic will panic if s and t are the same node or g has any
-// reachable negative edge weight.
+// reachable negative edge or line weight.
 //
 // The eps parameter specifies an absolute tolerance for treating tiny flow
 // updates as zero. If eps is negative a default of 1e-12 is used.
@@ -64,13 +68,7 @@ func initializeResidualGraph(g graph.WeightedDirected) *simple.WeightedDirectedG
 		for it.Next() {
 			v := it.Node()
 
-			capacity, ok := g.Weight(u.ID(), v.ID())
-			if !ok {
-				panic("expected a weight for existing edge")
-			}
-			if capacity < 0 {
-				panic("negative edge weight")
-			}
+			capacity := capacityOf(g, u.ID(), v.ID())
 
 			// Add forward edge with full capacity.
 			forward := r.NewWeightedEdge(u, v, capacity)
@@ -84,6 +82,32 @@ func initializeResidualGraph(g graph.WeightedDirected) *simple.WeightedDirectedG
 	return r
 }
 
+// capacityOf returns the capacity of the edge from u to v in g. If g is a
+// graph.WeightedMultigraph the capacity is the sum of the weights of the
+// lines from u to v.
+func capacityOf(g graph.WeightedDirected, uid, vid int64) float64 {
+	if mg, ok := g.(graph.WeightedMultigraph); ok {
+		var capacity float64
+		lines := mg.WeightedLines(uid, vid)
+		for lines.Next() {
+			w := lines.WeightedLine().Weight()
+			if w < 0 {
+				panic("negative edge weight")
+			}
+			capacity += w
+		}
+		return capacity
+	}
+	capacity, ok := g.Weight(uid, vid)
+	if !ok {
+		panic("expected a weight for existing edge")
+	}
+	if capacity < 0 {
+		panic("negative edge weight")
+	}
+	return capacity
+}
+
 // canReachTargetInLevelGraph builds a level graph using BFS on residualGraph.
 // It records, for each reachable node, the list of parents at the previous level.
 // It returns whether target is reachable from source via positive-capacity edges.
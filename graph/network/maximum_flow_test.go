@@ -11,6 +11,7 @@ import (
 
 	"gonum.org/v1/gonum/floats/scalar"
 	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/multi"
 	"gonum.org/v1/gonum/graph/simple"
 )
 
@@ -199,6 +200,46 @@ var dinicTests = []struct {
 			{s: simple.Node(0), t: simple.Node(5), want: 0},
 		},
 	},
+	{
+		name: "multigraph_parallel_lines",
+		g: func() graph.WeightedDirected {
+			g := multi.NewWeightedDirectedGraph()
+			// The aggregate edge weight is not used for capacities.
+			g.EdgeWeightFunc = func(graph.WeightedLines) float64 { return 0 }
+			for _, l := range []struct {
+				u, v int64
+				w    float64
+			}{
+				{0, 1, 1},
+				{0, 1, 2},
+				{0, 1, 0.5},
+				{1, 2, 2},
+				{1, 2, 2},
+				{0, 2, 1},
+				{2, 0, 4},
+			} {
+				g.SetWeightedLine(g.NewWeightedLine(multi.Node(l.u), multi.Node(l.v), l.w))
+			}
+			return g
+		}(),
+		testFlows: []testFlow{
+			{s: multi.Node(0), t: multi.Node(2), want: 4.5},
+			{s: multi.Node(0), t: multi.Node(1), want: 3.5},
+			{s: multi.Node(2), t: multi.Node(1), want: 3.5},
+		},
+	},
+	{
+		name: "multigraph_negative_line",
+		g: func() graph.WeightedDirected {
+			g := multi.NewWeightedDirectedGraph()
+			g.SetWeightedLine(g.NewWeightedLine(multi.Node(0), multi.Node(1), 2))
+			g.SetWeightedLine(g.NewWeightedLine(multi.Node(0), multi.Node(1), -1))
+			return g
+		}(),
+		testFlows: []testFlow{
+			{s: multi.Node(0), t: multi.Node(1), wantPanic: "negative edge weight"},
+		},
+	},
 }
 
 type testFlow struct {
@@ -0,0 +1,269 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/mat"
+)
+
+// PersonalizedPageRank returns the personalized PageRank weights for nodes
+// of the graph g using the given damping factor and restart vector, and
+// terminating when the 2-norm of the vector difference between iterations
+// is below tol. The restart vector is keyed on graph node IDs and is
+// normalized to sum to one; nodes not in restart have a zero restart
+// probability. The random walk restarts from the restart distribution with
+// probability 1-damp at each step and from dangling nodes. The returned map
+// is keyed on the graph node IDs.
+//
+// If g is a graph.Weighted, an edge-weighted personalized PageRank is
+// calculated. PersonalizedPageRank uses a sparse representation of the
+// transition matrix of g.
+//
+// PersonalizedPageRank will panic if restart is empty, has a negative
+// value, has no positive values or refers to a node not in g.
+func PersonalizedPageRank(g graph.Graph, damp float64, restart map[int64]float64, tol float64) map[int64]float64 {
+	nodes := graph.NodesOf(g.Nodes())
+	indexOf := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+	}
+	rst := restartVector(restart, indexOf)
+
+	m, dangling := transitionMatrix(g, nodes, indexOf, damp)
+
+	last := make([]float64, len(nodes))
+	lastV := mat.NewVecDense(len(nodes), last)
+	vec := make([]float64, len(nodes))
+	copy(vec, rst)
+	v := mat.NewVecDense(len(nodes), vec)
+	for {
+		lastV, v = v, lastV
+
+		m.mulVecUnitary(v, lastV)                 // Walk along edges;
+		with := damp * dangling.dotUnitary(lastV) // walk from dangling nodes;
+		away := 1 - damp                          // and restart.
+
+		floats.AddScaled(v.RawVector().Data, with+away, rst)
+		if normDiff(vec, last) < tol {
+			break
+		}
+	}
+
+	ranks := make(map[int64]float64, len(nodes))
+	for i, r := range v.RawVector().Data {
+		ranks[nodes[i].ID()] = r
+	}
+
+	return ranks
+}
+
+// RandomWalkWithRestart returns the random walk with restart proximity
+// scores of nodes of the graph g with respect to the node with ID source.
+// At each step the random walk returns to the source with probability
+// restart. Iteration terminates when the 2-norm of the vector difference
+// between iterations is below tol. The returned map is keyed on the graph
+// node IDs.
+//
+// RandomWalkWithRestart is equivalent to PersonalizedPageRank with a damping
+// factor of 1-restart and a restart vector concentrated on the source node.
+func RandomWalkWithRestart(g graph.Graph, source int64, restart, tol float64) map[int64]float64 {
+	return PersonalizedPageRank(g, 1-restart, map[int64]float64{source: 1}, tol)
+}
+
+// PersonalizedPageRankPush returns an approximation of the personalized
+// PageRank weights for nodes of the graph g using the given damping factor
+// and restart vector. The restart vector is interpreted as described for
+// PersonalizedPageRank.
+//
+// The approximation is calculated using the local push algorithm described
+// in "Local graph partitioning using PageRank vectors", FOCS'06 475-486.
+// doi:10.1109/FOCS.2006.44
+// Residual probability mass is pushed from a node while it exceeds eps
+// times the out-degree of the node, so the work done is independent of the
+// size of g and depends only on eps and 1-damp. The returned map is keyed on
+// graph node IDs and only holds nodes with non-zero weight. Each weight
+// underestimates the personalized PageRank weight by at most eps times the
+// node's out-degree.
+//
+// If g is a graph.Weighted, edge weights are used to calculate the
+// transition probabilities.
+//
+// PersonalizedPageRankPush will panic if restart is empty, has a negative
+// value, has no positive values or refers to a node not in g, or if eps is
+// not positive.
+func PersonalizedPageRankPush(g graph.Graph, damp float64, restart map[int64]float64, eps float64) map[int64]float64 {
+	if eps <= 0 {
+		panic("network: non-positive push threshold")
+	}
+	var sum float64
+	for id, w := range restart {
+		if g.Node(id) == nil {
+			panic("network: restart node not in graph")
+		}
+		if w < 0 {
+			panic("network: negative restart weight")
+		}
+		sum += w
+	}
+	if sum == 0 {
+		panic("network: no positive restart weight")
+	}
+
+	weight := outWeightFunc(g)
+
+	type neighbor struct {
+		id int64
+		w  float64
+	}
+	adj := make(map[int64][]neighbor)
+	neighbors := func(id int64) []neighbor {
+		if n, ok := adj[id]; ok {
+			return n
+		}
+		var n []neighbor
+		var z float64
+		to := g.From(id)
+		for to.Next() {
+			vid := to.Node().ID()
+			w := weight(id, vid)
+			n = append(n, neighbor{id: vid, w: w})
+			z += w
+		}
+		if z == 0 {
+			n = nil
+		} else {
+			for i := range n {
+				n[i].w /= z
+			}
+		}
+		adj[id] = n
+		return n
+	}
+
+	p := make(map[int64]float64)
+	r := make(map[int64]float64, len(restart))
+	var queue []int64
+	queued := make(map[int64]bool)
+	enqueue := func(id int64) {
+		if queued[id] {
+			return
+		}
+		if r[id] >= eps*math.Max(1, float64(len(neighbors(id)))) {
+			queue = append(queue, id)
+			queued[id] = true
+		}
+	}
+	for id, w := range restart {
+		if w != 0 {
+			r[id] = w / sum
+		}
+	}
+	for id := range r {
+		enqueue(id)
+	}
+	for len(queue) != 0 {
+		u := queue[0]
+		queue = queue[1:]
+		queued[u] = false
+
+		ru := r[u]
+		p[u] += (1 - damp) * ru
+		r[u] = 0
+		push := damp * ru
+		nu := neighbors(u)
+		if len(nu) == 0 {
+			// Mass at dangling nodes returns to
+			// the restart distribution.
+			for id, w := range restart {
+				if w != 0 {
+					r[id] += push * w / sum
+					enqueue(id)
+				}
+			}
+			continue
+		}
+		for _, v := range nu {
+			r[v.id] += push * v.w
+			enqueue(v.id)
+		}
+	}
+
+	return p
+}
+
+// restartVector returns a dense normalized restart vector from
+// the restart weights keyed on the node IDs in indexOf.
+func restartVector(restart map[int64]float64, indexOf map[int64]int) []float64 {
+	if len(restart) == 0 {
+		panic("network: empty restart vector")
+	}
+	rst := make([]float64, len(indexOf))
+	var sum float64
+	for id, w := range restart {
+		i, ok := indexOf[id]
+		if !ok {
+			panic("network: restart node not in graph")
+		}
+		if w < 0 {
+			panic("network: negative restart weight")
+		}
+		rst[i] = w
+		sum += w
+	}
+	if sum == 0 {
+		panic("network: no positive restart weight")
+	}
+	floats.Scale(1/sum, rst)
+	return rst
+}
+
+// transitionMatrix returns the sparse transition matrix of g scaled
+// by damp and the dangling node indicator row for the given nodes.
+// If g is a graph.Weighted, edge weights are used to calculate the
+// transition probabilities.
+func transitionMatrix(g graph.Graph, nodes []graph.Node, indexOf map[int64]int, damp float64) (rowCompressedMatrix, compressedRow) {
+	weight := outWeightFunc(g)
+	m := make(rowCompressedMatrix, len(nodes))
+	var dangling compressedRow
+	for j, u := range nodes {
+		to := graph.NodesOf(g.From(u.ID()))
+		var z float64
+		for _, v := range to {
+			z += weight(u.ID(), v.ID())
+		}
+		if z == 0 {
+			dangling.addTo(j, 1)
+			continue
+		}
+		for _, v := range to {
+			if w := weight(u.ID(), v.ID()); w != 0 {
+				m.addTo(indexOf[v.ID()], j, (w*damp)/z)
+			}
+		}
+	}
+	return m, dangling
+}
+
+// outWeightFunc returns a function that returns the weight of the edge
+// from u to v in g. If g is not a graph.Weighted, unit weights are
+// returned. It is only valid to call the returned function for edges
+// that exist in g.
+func outWeightFunc(g graph.Graph) func(uid, vid int64) float64 {
+	wg, ok := g.(graph.Weighted)
+	if !ok {
+		return func(_, _ int64) float64 { return 1 }
+	}
+	return func(uid, vid int64) float64 {
+		w, ok := wg.Weight(uid, vid)
+		if !ok {
+			return 0
+		}
+		return w
+	}
+}
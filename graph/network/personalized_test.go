@@ -0,0 +1,239 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestPersonalizedPageRankUniform(t *testing.T) {
+	t.Parallel()
+	for i, test := range pageRankTests {
+		g := simple.NewDirectedGraph()
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+		restart := make(map[int64]float64)
+		for u := range test.g {
+			restart[int64(u)] = 1
+		}
+		got := PersonalizedPageRank(g, test.damp, restart, test.tol)
+		prec := 1 - int(math.Log10(test.wantTol))
+		for n := range test.g {
+			if !scalar.EqualWithinAbsOrRel(got[int64(n)], test.want[int64(n)], test.wantTol, test.wantTol) {
+				t.Errorf("unexpected personalized PageRank result for test %d:\ngot: %v\nwant:%v",
+					i, orderedFloats(got, prec), orderedFloats(test.want, prec))
+				break
+			}
+		}
+	}
+}
+
+var personalizedPageRankTests = []struct {
+	g        []set
+	weights  map[[2]int64]float64
+	directed bool
+	damp     float64
+	restart  map[int64]float64
+}{
+	{
+		g: []set{
+			A: linksTo(B, C),
+			B: linksTo(D),
+			C: linksTo(D, E),
+			D: linksTo(E),
+			E: linksTo(A),
+		},
+		directed: true,
+		damp:     0.85,
+		restart:  map[int64]float64{A: 1},
+	},
+	{
+		g: []set{
+			A: nil,
+			B: linksTo(C),
+			C: linksTo(B),
+			D: linksTo(A, B),
+			E: linksTo(D, B, F),
+			F: linksTo(B, E),
+			G: linksTo(B, E),
+		},
+		directed: true,
+		damp:     0.85,
+		restart:  map[int64]float64{E: 2, G: 1},
+	},
+	{
+		g: []set{
+			A: linksTo(B, C),
+			B: linksTo(C),
+			C: linksTo(D),
+			D: linksTo(E, F),
+			E: linksTo(F),
+		},
+		weights: map[[2]int64]float64{
+			{A, B}: 1, {A, C}: 3, {B, C}: 1,
+			{C, D}: 0.5, {D, E}: 2, {D, F}: 1, {E, F}: 4,
+		},
+		damp:    0.7,
+		restart: map[int64]float64{B: 1},
+	},
+}
+
+func personalizedPageRankGraph(t *testing.T, g []set, weights map[[2]int64]float64, directed bool) graph.Graph {
+	var dst interface {
+		graph.Graph
+		graph.NodeAdder
+	}
+	var setEdge func(u, v int64, w float64)
+	switch {
+	case directed && weights == nil:
+		d := simple.NewDirectedGraph()
+		setEdge = func(u, v int64, _ float64) { d.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)}) }
+		dst = d
+	case directed:
+		d := simple.NewWeightedDirectedGraph(0, 0)
+		setEdge = func(u, v int64, w float64) {
+			d.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: w})
+		}
+		dst = d
+	case weights == nil:
+		d := simple.NewUndirectedGraph()
+		setEdge = func(u, v int64, _ float64) { d.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)}) }
+		dst = d
+	default:
+		d := simple.NewWeightedUndirectedGraph(0, 0)
+		setEdge = func(u, v int64, w float64) {
+			d.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: w})
+		}
+		dst = d
+	}
+	for u, e := range g {
+		// Add nodes that are not defined by an edge.
+		if dst.Node(int64(u)) == nil {
+			dst.AddNode(simple.Node(u))
+		}
+		for v := range e {
+			w := 1.0
+			if weights != nil {
+				var ok bool
+				w, ok = weights[[2]int64{int64(u), v}]
+				if !ok {
+					t.Fatalf("missing weight for edge %d-%d", u, v)
+				}
+			}
+			setEdge(int64(u), v, w)
+		}
+	}
+	return dst
+}
+
+// personalizedPageRankResidual returns the maximum absolute residual
+// of the personalized PageRank fixed point equation for p.
+func personalizedPageRankResidual(g graph.Graph, damp float64, restart, p map[int64]float64) float64 {
+	var sum float64
+	for _, w := range restart {
+		sum += w
+	}
+	weight := outWeightFunc(g)
+	want := make(map[int64]float64)
+	nodes := graph.NodesOf(g.Nodes())
+	for _, u := range nodes {
+		uid := u.ID()
+		to := graph.NodesOf(g.From(uid))
+		var z float64
+		for _, v := range to {
+			z += weight(uid, v.ID())
+		}
+		if z == 0 {
+			for id, w := range restart {
+				want[id] += damp * p[uid] * w / sum
+			}
+			continue
+		}
+		for _, v := range to {
+			want[v.ID()] += damp * p[uid] * weight(uid, v.ID()) / z
+		}
+	}
+	for id, w := range restart {
+		want[id] += (1 - damp) * w / sum
+	}
+	var max float64
+	for _, u := range nodes {
+		max = math.Max(max, math.Abs(want[u.ID()]-p[u.ID()]))
+	}
+	return max
+}
+
+func TestPersonalizedPageRank(t *testing.T) {
+	t.Parallel()
+	for i, test := range personalizedPageRankTests {
+		for _, directed := range []bool{test.directed, false} {
+			g := personalizedPageRankGraph(t, test.g, test.weights, directed)
+			got := PersonalizedPageRank(g, test.damp, test.restart, 1e-12)
+			var sum float64
+			for _, v := range got {
+				sum += v
+			}
+			if !scalar.EqualWithinAbsOrRel(sum, 1, 1e-10, 1e-10) {
+				t.Errorf("unexpected total weight for test %d directed=%t: got:%v want:1", i, directed, sum)
+			}
+			if r := personalizedPageRankResidual(g, test.damp, test.restart, got); r > 1e-10 {
+				t.Errorf("unexpected residual for test %d directed=%t: %v", i, directed, r)
+			}
+		}
+	}
+}
+
+func TestRandomWalkWithRestart(t *testing.T) {
+	t.Parallel()
+	// A path graph A-B-C-D: proximity to A must decrease along the path.
+	g := simple.NewUndirectedGraph()
+	g.SetEdge(simple.Edge{F: simple.Node(A), T: simple.Node(B)})
+	g.SetEdge(simple.Edge{F: simple.Node(B), T: simple.Node(C)})
+	g.SetEdge(simple.Edge{F: simple.Node(C), T: simple.Node(D)})
+	got := RandomWalkWithRestart(g, A, 0.5, 1e-12)
+	for _, p := range [][2]int64{{A, B}, {B, C}, {C, D}} {
+		if got[p[0]] <= got[p[1]] {
+			t.Errorf("unexpected proximity ordering: %c:%v <= %c:%v", p[0]+'A', got[p[0]], p[1]+'A', got[p[1]])
+		}
+	}
+	want := PersonalizedPageRank(g, 0.5, map[int64]float64{A: 1}, 1e-12)
+	for id, w := range want {
+		if !scalar.EqualWithinAbsOrRel(got[id], w, 1e-12, 1e-12) {
+			t.Errorf("unexpected proximity for %c: got:%v want:%v", id+'A', got[id], w)
+		}
+	}
+}
+
+func TestPersonalizedPageRankPush(t *testing.T) {
+	t.Parallel()
+	for i, test := range personalizedPageRankTests {
+		for _, directed := range []bool{test.directed, false} {
+			g := personalizedPageRankGraph(t, test.g, test.weights, directed)
+			want := PersonalizedPageRank(g, test.damp, test.restart, 1e-12)
+			for _, eps := range []float64{1e-3, 1e-6, 1e-9} {
+				got := PersonalizedPageRankPush(g, test.damp, test.restart, eps)
+				for id, w := range want {
+					deg := math.Max(1, float64(g.From(id).Len()))
+					if got[id] > w+1e-12 || w-got[id] > eps*deg*float64(len(want)) {
+						t.Errorf("unexpected approximation for %c in test %d directed=%t eps=%v: got:%v want:%v",
+							id+'A', i, directed, eps, got[id], w)
+					}
+				}
+			}
+		}
+	}
+}
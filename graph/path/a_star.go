@@ -30,12 +30,7 @@ func AStar(s, t graph.Node, g traverse.Graph, h Heuristic) (path Shortest, expan
 			return Shortest{from: s}, 0
 		}
 	}
-	var weight Weighting
-	if wg, ok := g.(Weighted); ok {
-		weight = wg.Weight
-	} else {
-		weight = UniformCost(g)
-	}
+	weight := weightOf(g)
 	if h == nil {
 		if g, ok := g.(HeuristicCoster); ok {
 			h = g.HeuristicCost
@@ -0,0 +1,197 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/traverse"
+)
+
+// Arborescence generates a minimum spanning arborescence of g rooted at root
+// using the algorithm of Chu and Liu and of Edmonds, placing the result in
+// the destination, dst. An arborescence is a directed tree in which every
+// node is reachable from the root by a unique path, and a minimum spanning
+// arborescence is one with the smallest sum of edge weights that spans all
+// the nodes of g that are reachable from root. All the nodes of g are added to
+// dst, so nodes not reachable from root are isolated in dst. The destination
+// is not cleared first. The weight of the minimum spanning arborescence is
+// returned.
+//
+// Nodes and Edges from g are used to construct dst, so if the Node and Edge
+// types used in g are pointer or reference-like, then the values will be shared
+// between the graphs. If g is a graph.WeightedMultigraph, only the lightest
+// line joining each pair of nodes is considered, and the edges placed in dst
+// are simple.WeightedEdge values holding the weights of those lines.
+//
+// If dst has nodes that exist in g or root is not in g, Arborescence will
+// panic.
+//
+// The time complexity of Arborescence is O(|V|.|E|).
+func Arborescence(dst WeightedBuilder, g graph.WeightedDirected, root graph.Node) float64 {
+	if g.Node(root.ID()) == nil {
+		panic("arborescence: root not in graph")
+	}
+	for _, u := range graph.NodesOf(g.Nodes()) {
+		dst.AddNode(u)
+	}
+
+	// Index the nodes reachable from root,
+	// with the root having index zero.
+	var nodes []graph.Node
+	indexOf := make(map[int64]int)
+	var bf traverse.BreadthFirst
+	bf.Walk(g, root, func(n graph.Node, _ int) bool {
+		indexOf[n.ID()] = len(nodes)
+		nodes = append(nodes, n)
+		return false
+	})
+
+	weight := weightOf(g)
+	var edges []arborescenceEdge
+	for i, u := range nodes {
+		uid := u.ID()
+		to := g.From(uid)
+		for to.Next() {
+			j := indexOf[to.Node().ID()]
+			if j == i || j == 0 {
+				// Self edges and edges into the
+				// root are never in the arborescence.
+				continue
+			}
+			w, ok := weight(uid, to.Node().ID())
+			if !ok {
+				panic("arborescence: unexpected invalid weight")
+			}
+			edges = append(edges, arborescenceEdge{from: i, to: j, weight: w, parent: -1})
+		}
+	}
+
+	var w float64
+	for _, i := range minArborescence(len(nodes), edges) {
+		e := edges[i]
+		dst.SetWeightedEdge(edgeOf(g, nodes[e.from].ID(), nodes[e.to].ID()))
+		w += e.weight
+	}
+	return w
+}
+
+// arborescenceEdge is an edge in a possibly contracted graph used in the
+// Chu–Liu/Edmonds algorithm. The parent field holds the index of the edge
+// in the graph before contraction that corresponds to the edge.
+type arborescenceEdge struct {
+	from, to int
+	weight   float64
+	parent   int
+}
+
+// minArborescence returns the indices into edges of the edges of a minimum
+// spanning arborescence rooted at node 0 of the graph with n nodes and the
+// given edges. Every node other than the root must have an incoming edge.
+func minArborescence(n int, edges []arborescenceEdge) []int {
+	// Find the lightest incoming edge of each node.
+	in := make([]int, n)
+	for i := range in {
+		in[i] = -1
+	}
+	for i, e := range edges {
+		if in[e.to] < 0 || e.weight < edges[in[e.to]].weight {
+			in[e.to] = i
+		}
+	}
+
+	// Find the cycles formed by the lightest incoming edges,
+	// labeling each cycle with a new node index and the nodes
+	// not on a cycle with their own new node index.
+	const (
+		unvisited = -1
+		onCycle   = -2
+	)
+	comp := make([]int, n)
+	for i := range comp {
+		comp[i] = unvisited
+	}
+	visitedBy := make([]int, n)
+	for i := range visitedBy {
+		visitedBy[i] = -1
+	}
+	var cycles int
+	for v := 1; v < n; v++ {
+		u := v
+		for u != 0 && visitedBy[u] < 0 && comp[u] == unvisited {
+			visitedBy[u] = v
+			u = edges[in[u]].from
+		}
+		if u != 0 && visitedBy[u] == v && comp[u] == unvisited {
+			// The walk from v has returned to a node
+			// it visited, so the nodes from u form a cycle.
+			for x := u; comp[x] == unvisited; x = edges[in[x]].from {
+				comp[x] = onCycle
+			}
+			cycles++
+		}
+	}
+	if cycles == 0 {
+		return in[1:]
+	}
+
+	m := 1
+	comp[0] = 0
+	for v := 1; v < n; v++ {
+		switch comp[v] {
+		case unvisited:
+			comp[v] = m
+		case onCycle:
+			// Label the complete cycle through v.
+			for x := v; comp[x] == onCycle; x = edges[in[x]].from {
+				comp[x] = m
+			}
+		default:
+			// Already labeled as part of a cycle.
+			continue
+		}
+		m++
+	}
+	isCycle := make([]bool, m)
+	for v := 1; v < n; v++ {
+		if edges[in[v]].from != v && comp[edges[in[v]].from] == comp[v] {
+			isCycle[comp[v]] = true
+		}
+	}
+
+	// Contract the cycles, reducing the weight of each edge
+	// by the weight of the lightest edge into its head.
+	var contracted []arborescenceEdge
+	for i, e := range edges {
+		if comp[e.from] == comp[e.to] {
+			continue
+		}
+		contracted = append(contracted, arborescenceEdge{
+			from:   comp[e.from],
+			to:     comp[e.to],
+			weight: e.weight - edges[in[e.to]].weight,
+			parent: i,
+		})
+	}
+
+	// Expand the arborescence of the contracted graph. Each
+	// cycle is entered by a single edge, which replaces the
+	// lightest incoming edge of the node it enters.
+	var chosen []int
+	enters := make([]int, m)
+	for i := range enters {
+		enters[i] = -1
+	}
+	for _, i := range minArborescence(m, contracted) {
+		p := contracted[i].parent
+		chosen = append(chosen, p)
+		enters[comp[edges[p].to]] = edges[p].to
+	}
+	for v := 1; v < n; v++ {
+		if isCycle[comp[v]] && enters[comp[v]] != v {
+			chosen = append(chosen, in[v])
+		}
+	}
+	return chosen
+}
@@ -0,0 +1,123 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/topo"
+)
+
+// bruteArborescence returns the weight of a minimum spanning arborescence
+// of the nodes of g reachable from root by exhaustive search over the
+// choices of incoming edge of each reachable node.
+func bruteArborescence(g graph.WeightedDirected, root graph.Node) float64 {
+	var reach []graph.Node
+	for _, u := range graph.NodesOf(g.Nodes()) {
+		if u.ID() != root.ID() && topo.PathExistsIn(g, root, u) {
+			reach = append(reach, u)
+		}
+	}
+	parent := make(map[int64]int64)
+	best := math.Inf(1)
+	var choose func(i int, w float64)
+	choose = func(i int, w float64) {
+		if i == len(reach) {
+			// Check that every node reaches
+			// the root through its parents.
+			for _, u := range reach {
+				v := u.ID()
+				for range len(reach) {
+					p, ok := parent[v]
+					if !ok {
+						break
+					}
+					v = p
+				}
+				if v != root.ID() {
+					return
+				}
+			}
+			best = math.Min(best, w)
+			return
+		}
+		vid := reach[i].ID()
+		for _, u := range graph.NodesOf(g.To(vid)) {
+			if u.ID() == vid {
+				continue
+			}
+			parent[vid] = u.ID()
+			ew, _ := g.Weight(u.ID(), vid)
+			choose(i+1, w+ew)
+		}
+	}
+	choose(0, 0)
+	if len(reach) == 0 {
+		return 0
+	}
+	return best
+}
+
+func TestArborescence(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := range 200 {
+		const n = 7
+		g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		for i := range int64(n) {
+			g.AddNode(simple.Node(i))
+		}
+		for range 6 + rnd.IntN(14) {
+			u, v := rnd.Int64N(n), rnd.Int64N(n)
+			if u == v {
+				continue
+			}
+			g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: float64(rnd.IntN(10) - 2)})
+		}
+		root := simple.Node(rnd.Int64N(n))
+
+		dst := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		got := Arborescence(dst, g, root)
+		want := bruteArborescence(g, root)
+		if got != want {
+			t.Errorf("trial %d: unexpected arborescence weight: got:%v want:%v", trial, got, want)
+		}
+
+		if dst.Nodes().Len() != n {
+			t.Errorf("trial %d: unexpected number of nodes in arborescence: got:%d want:%d", trial, dst.Nodes().Len(), n)
+		}
+		if dst.To(root.ID()).Len() != 0 {
+			t.Errorf("trial %d: root has incoming edge", trial)
+		}
+		var sum float64
+		for _, e := range graph.WeightedEdgesOf(dst.WeightedEdges()) {
+			sum += e.Weight()
+		}
+		if sum != got {
+			t.Errorf("trial %d: arborescence edge weights do not match returned weight: got:%v want:%v", trial, sum, got)
+		}
+		for _, u := range graph.NodesOf(g.Nodes()) {
+			uid := u.ID()
+			if uid == root.ID() {
+				continue
+			}
+			reachable := topo.PathExistsIn(g, root, u)
+			wantIn := 0
+			if reachable {
+				wantIn = 1
+			}
+			if in := dst.To(uid).Len(); in != wantIn {
+				t.Errorf("trial %d: unexpected in-degree of %d: got:%d want:%d", trial, uid, in, wantIn)
+			}
+			if reachable && !topo.PathExistsIn(dst, root, u) {
+				t.Errorf("trial %d: node %d not reachable from root in arborescence", trial, uid)
+			}
+		}
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// BottleneckSpanningTree generates a minimum bottleneck spanning tree of g,
+// a spanning tree whose heaviest edge is as light as possible, placing the
+// result in the destination, dst. The destination is not cleared first. The
+// weight of the heaviest edge of the tree, the bottleneck, is returned. If g
+// is not connected, a minimum bottleneck spanning forest will be constructed
+// in dst. If g has no edges other than self edges, the returned bottleneck is
+// -Inf.
+//
+// The bottleneck is found using the algorithm of Camerini, which repeatedly
+// halves the set of candidate edges, contracting the components formed by the
+// lighter half when it is not sufficient to connect the graph. Any minimum
+// spanning tree is also a minimum bottleneck spanning tree, but the expected
+// time complexity of BottleneckSpanningTree is O(|E|) rather than the
+// O(|E|.log|E|) of Kruskal.
+//
+// Nodes and Edges from g are used to construct dst, so if the Node and Edge
+// types used in g are pointer or reference-like, then the values will be shared
+// between the graphs. If g is a graph.WeightedMultigraph, only the lightest
+// line joining each pair of nodes is considered, and the edges placed in dst
+// are simple.WeightedEdge values holding the weights of those lines.
+//
+// If dst has nodes that exist in g, BottleneckSpanningTree will panic.
+//
+// See Camerini doi:10.1016/0020-0190(78)90036-6 for details of the algorithm.
+func BottleneckSpanningTree(dst WeightedBuilder, g UndirectedWeightLister) (bottleneck float64) {
+	nodes := graph.NodesOf(g.Nodes())
+	indexOf := make(map[int64]int, len(nodes))
+	for i, u := range nodes {
+		dst.AddNode(u)
+		indexOf[u.ID()] = i
+	}
+
+	edges := graph.WeightedEdgesOf(g.WeightedEdges())
+	if _, ok := g.(graph.WeightedMultigraph); ok {
+		for i, e := range edges {
+			edges[i] = edgeOf(g, e.From().ID(), e.To().ID())
+		}
+	}
+	work := make([]bottleneckEdge, 0, len(edges))
+	for _, e := range edges {
+		u, v := indexOf[e.From().ID()], indexOf[e.To().ID()]
+		if u != v {
+			work = append(work, bottleneckEdge{u: u, v: v, weight: e.Weight()})
+		}
+	}
+	uf := newUnionFind(len(nodes))
+	bottleneck = minBottleneck(len(nodes), work, uf)
+
+	uf.reset(len(nodes))
+	for _, e := range edges {
+		if e.Weight() > bottleneck {
+			continue
+		}
+		if uf.union(indexOf[e.From().ID()], indexOf[e.To().ID()]) {
+			dst.SetWeightedEdge(edgeOf(g, e.From().ID(), e.To().ID()))
+		}
+	}
+	return bottleneck
+}
+
+// bottleneckEdge is an edge used in Camerini's algorithm.
+type bottleneckEdge struct {
+	u, v   int
+	weight float64
+}
+
+// minBottleneck returns the weight of the heaviest edge of a minimum
+// bottleneck spanning forest of the graph with n nodes and the given
+// edges, none of which may be self edges. The edges are reordered and
+// overwritten, and uf is used as work space.
+func minBottleneck(n int, edges []bottleneckEdge, uf unionFind) float64 {
+	if len(edges) == 0 {
+		return math.Inf(-1)
+	}
+	label := make([]int, n)
+	for len(edges) > 1 {
+		// Split the edges into the lighter half, a,
+		// and the heavier half, b.
+		k := (len(edges) + 1) / 2
+		selectEdge(edges, k-1)
+		a, b := edges[:k], edges[k:]
+
+		uf.reset(n)
+		for _, e := range a {
+			uf.union(e.u, e.v)
+		}
+		joins := false
+		for _, e := range b {
+			if uf.find(e.u) != uf.find(e.v) {
+				joins = true
+				break
+			}
+		}
+		if !joins {
+			// The lighter half connects the graph as
+			// well as all the edges, so the bottleneck
+			// is in the lighter half.
+			edges = a
+			continue
+		}
+
+		// The bottleneck is in the heavier half. Contract
+		// the components formed by the lighter half and
+		// keep the heavier edges that join components.
+		for i := range label[:n] {
+			label[i] = -1
+		}
+		m := 0
+		for v := range n {
+			r := uf.find(v)
+			if label[r] < 0 {
+				label[r] = m
+				m++
+			}
+		}
+		next := edges[:0]
+		for _, e := range b {
+			u, v := label[uf.find(e.u)], label[uf.find(e.v)]
+			if u != v {
+				next = append(next, bottleneckEdge{u: u, v: v, weight: e.weight})
+			}
+		}
+		edges = next
+		n = m
+	}
+	return edges[0].weight
+}
+
+// selectEdge partially orders edges by weight so that edges[k] is the edge
+// that would be at index k if edges were sorted by weight, with no lighter
+// edge after it and no heavier edge before it.
+func selectEdge(edges []bottleneckEdge, k int) {
+	lo, hi := 0, len(edges)-1
+	for lo < hi {
+		p := edges[lo+(hi-lo)/2].weight
+		i, j := lo, hi
+		for i <= j {
+			for edges[i].weight < p {
+				i++
+			}
+			for edges[j].weight > p {
+				j--
+			}
+			if i <= j {
+				edges[i], edges[j] = edges[j], edges[i]
+				i++
+				j--
+			}
+		}
+		switch {
+		case k <= j:
+			hi = j
+		case k >= i:
+			lo = i
+		default:
+			return
+		}
+	}
+}
+
+// unionFind is a disjoint set of the integers in [0, n).
+type unionFind []int
+
+// newUnionFind returns a unionFind of n singleton sets.
+func newUnionFind(n int) unionFind {
+	uf := make(unionFind, n)
+	uf.reset(n)
+	return uf
+}
+
+// reset resets the first n elements of uf to singleton sets.
+func (uf unionFind) reset(n int) {
+	for i := range uf[:n] {
+		uf[i] = i
+	}
+}
+
+// find returns the representative of the set containing x.
+func (uf unionFind) find(x int) int {
+	for uf[x] != x {
+		uf[x] = uf[uf[x]]
+		x = uf[x]
+	}
+	return x
+}
+
+// union joins the sets containing x and y, returning whether they
+// were different sets.
+func (uf unionFind) union(x, y int) bool {
+	rx, ry := uf.find(x), uf.find(y)
+	if rx == ry {
+		return false
+	}
+	uf[rx] = ry
+	return true
+}
@@ -0,0 +1,79 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/topo"
+)
+
+func TestBottleneckSpanningTree(t *testing.T) {
+	t.Parallel()
+	for _, test := range spanningTreeTests {
+		g := test.graph()
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+		want := math.Inf(-1)
+		for _, e := range test.treeEdges {
+			want = math.Max(want, e.W)
+		}
+		checkBottleneck(t, test.name, g, want)
+	}
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for range 200 {
+		n := 1 + rnd.IntN(20)
+		g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		for i := range int64(n) {
+			g.AddNode(simple.Node(i))
+		}
+		for range rnd.IntN(3 * n) {
+			u, v := rnd.Int64N(int64(n)), rnd.Int64N(int64(n))
+			if u == v {
+				continue
+			}
+			g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: float64(rnd.IntN(10))})
+		}
+		mst := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		Kruskal(mst, g)
+		want := math.Inf(-1)
+		for _, e := range graph.WeightedEdgesOf(mst.WeightedEdges()) {
+			want = math.Max(want, e.Weight())
+		}
+		checkBottleneck(t, "random", g, want)
+	}
+}
+
+func checkBottleneck(t *testing.T, name string, g spanningGraph, want float64) {
+	t.Helper()
+	dst := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	got := BottleneckSpanningTree(dst, g)
+	if got != want {
+		t.Errorf("unexpected bottleneck for %q: got:%v want:%v", name, got, want)
+	}
+	if dst.Nodes().Len() != g.Nodes().Len() {
+		t.Errorf("unexpected number of nodes for %q: got:%d want:%d", name, dst.Nodes().Len(), g.Nodes().Len())
+	}
+	components := len(topo.ConnectedComponents(g.(graph.Undirected)))
+	if n := dst.Edges().Len(); n != g.Nodes().Len()-components {
+		t.Errorf("unexpected number of edges for %q: got:%d want:%d", name, n, g.Nodes().Len()-components)
+	}
+	if n := len(topo.ConnectedComponents(dst)); n != components {
+		t.Errorf("unexpected number of components for %q: got:%d want:%d", name, n, components)
+	}
+	heaviest := math.Inf(-1)
+	for _, e := range graph.WeightedEdgesOf(dst.WeightedEdges()) {
+		heaviest = math.Max(heaviest, e.Weight())
+	}
+	if heaviest != got {
+		t.Errorf("unexpected heaviest edge for %q: got:%v want:%v", name, heaviest, got)
+	}
+}
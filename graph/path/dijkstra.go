@@ -6,6 +6,8 @@ package path
 
 import (
 	"container/heap"
+	"sync"
+	"sync/atomic"
 
 	"gonum.org/v1/gonum/graph"
 	"gonum.org/v1/gonum/graph/traverse"
@@ -55,12 +57,7 @@ func dijkstraFrom(u, t graph.Node, g traverse.Graph) Shortest {
 		path = newShortestFrom(u, []graph.Node{u})
 	}
 
-	var weight Weighting
-	if wg, ok := g.(Weighted); ok {
-		weight = wg.Weight
-	} else {
-		weight = UniformCost(g)
-	}
+	weight := weightOf(g)
 
 	// Dijkstra's algorithm here is implemented essentially as
 	// described in Function B.2 in figure 6 of UTCS Technical
@@ -131,12 +128,7 @@ func DijkstraAllFrom(u graph.Node, g traverse.Graph) ShortestAlts {
 		path = newShortestAltsFrom(u, []graph.Node{u})
 	}
 
-	var weight Weighting
-	if wg, ok := g.(Weighted); ok {
-		weight = wg.Weight
-	} else {
-		weight = UniformCost(g)
-	}
+	weight := weightOf(g)
 
 	// Dijkstra's algorithm here is implemented essentially as
 	// described in Function B.2 in figure 6 of UTCS Technical
@@ -189,59 +181,92 @@ func DijkstraAllFrom(u graph.Node, g traverse.Graph) ShortestAlts {
 // The time complexity of DijkstraAllPaths is O(|V|.|E|+|V|^2.log|V|).
 func DijkstraAllPaths(g graph.Graph) (paths AllShortest) {
 	paths = newAllShortest(graph.NodesOf(g.Nodes()), false)
-	dijkstraAllPaths(g, paths)
+	dijkstraAllPaths(g, paths, 1)
 	return paths
 }
 
 // dijkstraAllPaths is the all-paths implementation of Dijkstra. It is shared
-// between DijkstraAllPaths and JohnsonAllPaths to avoid repeated allocation
-// of the nodes slice and the indexOf map. It returns nothing, but stores the
-// result of the work in the paths parameter which is a reference type.
-func dijkstraAllPaths(g graph.Graph, paths AllShortest) {
-	var weight Weighting
-	if wg, ok := g.(graph.Weighted); ok {
-		weight = wg.Weight
-	} else {
-		weight = UniformCost(g)
+// between DijkstraAllPaths and the Johnson all-pairs functions to avoid
+// repeated allocation of the nodes slice and the indexOf map. It returns
+// nothing, but stores the result of the work in the paths parameter which
+// is a reference type. If paths.next is nil, only the distances are stored.
+// The sources are processed by up to workers goroutines.
+func dijkstraAllPaths(g graph.Graph, paths AllShortest, workers int) {
+	weight := weightOf(g)
+
+	if workers <= 1 || len(paths.nodes) < 2 {
+		var Q priorityQueue
+		for i := range paths.nodes {
+			dijkstraAllPathsFrom(i, g, weight, paths, &Q)
+		}
+		return
+	}
+
+	// Each source writes only to its own row of dist
+	// and its own elements of next, so the sources can
+	// be processed concurrently.
+	var (
+		wg     sync.WaitGroup
+		source atomic.Int64
+	)
+	for w := 0; w < min(workers, len(paths.nodes)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var Q priorityQueue
+			for {
+				i := int(source.Add(1) - 1)
+				if i >= len(paths.nodes) {
+					return
+				}
+				dijkstraAllPathsFrom(i, g, weight, paths, &Q)
+			}
+		}()
 	}
+	wg.Wait()
+}
 
-	var Q priorityQueue
-	for i, u := range paths.nodes {
-		// Dijkstra's algorithm here is implemented essentially as
-		// described in Function B.2 in figure 6 of UTCS Technical
-		// Report TR-07-54 with the addition of handling multiple
-		// co-equal paths.
-		//
-		// http://www.cs.utexas.edu/ftp/techreports/tr07-54.pdf
+// dijkstraAllPathsFrom stores the shortest paths from the node indexed by i
+// in paths, using Q as the priority queue.
+func dijkstraAllPathsFrom(i int, g graph.Graph, weight Weighting, paths AllShortest, Q *priorityQueue) {
+	// Dijkstra's algorithm here is implemented essentially as
+	// described in Function B.2 in figure 6 of UTCS Technical
+	// Report TR-07-54 with the addition of handling multiple
+	// co-equal paths.
+	//
+	// http://www.cs.utexas.edu/ftp/techreports/tr07-54.pdf
 
-		// Q must be empty at this point.
-		heap.Push(&Q, distanceNode{node: u, dist: 0})
-		for Q.Len() != 0 {
-			mid := heap.Pop(&Q).(distanceNode)
-			k := paths.indexOf[mid.node.ID()]
-			if mid.dist < paths.dist.At(i, k) {
-				paths.dist.Set(i, k, mid.dist)
+	// Q must be empty at this point.
+	heap.Push(Q, distanceNode{node: paths.nodes[i], dist: 0})
+	for Q.Len() != 0 {
+		mid := heap.Pop(Q).(distanceNode)
+		k := paths.indexOf[mid.node.ID()]
+		if mid.dist < paths.dist.At(i, k) {
+			paths.dist.Set(i, k, mid.dist)
+		}
+		mnid := mid.node.ID()
+		to := g.From(mnid)
+		for to.Next() {
+			v := to.Node()
+			vid := v.ID()
+			j := paths.indexOf[vid]
+			w, ok := weight(mnid, vid)
+			if !ok {
+				panic("dijkstra: unexpected invalid weight")
 			}
-			mnid := mid.node.ID()
-			to := g.From(mnid)
-			for to.Next() {
-				v := to.Node()
-				vid := v.ID()
-				j := paths.indexOf[vid]
-				w, ok := weight(mnid, vid)
-				if !ok {
-					panic("dijkstra: unexpected invalid weight")
-				}
-				if w < 0 {
-					panic("dijkstra: negative edge weight")
-				}
-				joint := paths.dist.At(i, k) + w
-				if joint < paths.dist.At(i, j) {
-					heap.Push(&Q, distanceNode{node: v, dist: joint})
+			if w < 0 {
+				panic("dijkstra: negative edge weight")
+			}
+			joint := paths.dist.At(i, k) + w
+			if joint < paths.dist.At(i, j) {
+				heap.Push(Q, distanceNode{node: v, dist: joint})
+				if paths.next == nil {
+					paths.dist.Set(i, j, joint)
+				} else {
 					paths.set(i, j, joint, k)
-				} else if joint == paths.dist.At(i, j) {
-					paths.add(i, j, k)
 				}
+			} else if joint == paths.dist.At(i, j) && paths.next != nil {
+				paths.add(i, j, k)
 			}
 		}
 	}
@@ -3,4 +3,10 @@
 // license that can be found in the LICENSE file.
 
 // Package path provides graph path finding functions.
+//
+// Functions that use edge weights obtain them from the Weight method of the
+// graph if it implements Weighted and use UniformCost otherwise. If the graph
+// is a graph.WeightedMultigraph, the weight between two nodes is instead the
+// weight of the lightest of the parallel lines joining them, independent of
+// how the graph aggregates line weights in its Weight method.
 package path // import "gonum.org/v1/gonum/graph/path"
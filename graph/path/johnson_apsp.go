@@ -7,6 +7,7 @@ package path
 import (
 	"math"
 	"math/rand/v2"
+	"runtime"
 
 	"gonum.org/v1/gonum/graph"
 	"gonum.org/v1/gonum/graph/simple"
@@ -18,14 +19,48 @@ import (
 //
 // The time complexity of JohnsonAllPaths is O(|V|.|E|+|V|^2.log|V|).
 func JohnsonAllPaths(g graph.Graph) (paths AllShortest, ok bool) {
-	adjusted := johnsonWeightAdjuster{Graph: g}
-	if wg, ok := g.(Weighted); ok {
-		adjusted.weight = wg.Weight
-	} else {
-		adjusted.weight = UniformCost(g)
-	}
+	paths = newAllShortest(graph.NodesOf(g.Nodes()), false)
+	ok = johnsonAllPaths(g, paths, 1)
+	return paths, ok
+}
 
+// ParallelJohnsonAllPaths returns a shortest-path tree for shortest paths in the
+// graph g, computed as for JohnsonAllPaths with the single-source searches from
+// each node performed by up to workers goroutines. If workers is less than one,
+// runtime.GOMAXPROCS(0) is used. The From and Weight methods of g must be safe
+// for concurrent use by multiple goroutines.
+func ParallelJohnsonAllPaths(g graph.Graph, workers int) (paths AllShortest, ok bool) {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
 	paths = newAllShortest(graph.NodesOf(g.Nodes()), false)
+	ok = johnsonAllPaths(g, paths, workers)
+	return paths, ok
+}
+
+// JohnsonAllDistances returns the weights of the shortest paths between all pairs
+// of nodes in the graph g, computed as for ParallelJohnsonAllPaths but without
+// storing the paths. This avoids the memory required for path reconstruction,
+// which dominates that of the distances when many shortest paths share the same
+// weight. If workers is less than one, runtime.GOMAXPROCS(0) is used. The From and
+// Weight methods of g must be safe for concurrent use by multiple goroutines.
+// If a negative cycle exists in g, ok will be returned false and dist will not
+// contain valid data.
+func JohnsonAllDistances(g graph.Graph, workers int) (dist AllDistances, ok bool) {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	paths := newAllDistances(graph.NodesOf(g.Nodes()))
+	ok = johnsonAllPaths(g, paths, workers)
+	return AllDistances{paths: paths}, ok
+}
+
+// johnsonAllPaths stores the shortest paths in g in paths using up to workers
+// goroutines for the single-source searches, and reports whether g has no
+// negative cycles.
+func johnsonAllPaths(g graph.Graph, paths AllShortest, workers int) (ok bool) {
+	adjusted := johnsonWeightAdjuster{Graph: g}
+	adjusted.weight = weightOf(g)
 
 	var q int64
 	sign := int64(-1)
@@ -41,10 +76,10 @@ func JohnsonAllPaths(g graph.Graph) (paths AllShortest, ok bool) {
 
 	adjusted.adjustBy, ok = BellmanFordFrom(johnsonGraphNode(q), johnsonReWeight{adjusted, q})
 	if !ok {
-		return paths, false
+		return false
 	}
 
-	dijkstraAllPaths(adjusted, paths)
+	dijkstraAllPaths(adjusted, paths, workers)
 
 	for i, u := range paths.nodes {
 		hu := adjusted.adjustBy.WeightTo(u.ID())
@@ -57,7 +92,7 @@ func JohnsonAllPaths(g graph.Graph) (paths AllShortest, ok bool) {
 		}
 	}
 
-	return paths, ok
+	return true
 }
 
 // johnsonWeightAdjuster is an edge re-weighted graph constructed
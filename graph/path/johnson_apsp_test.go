@@ -6,11 +6,14 @@ package path
 
 import (
 	"math"
+	"math/rand/v2"
 	"reflect"
 	"testing"
 
+	"gonum.org/v1/gonum/floats/scalar"
 	"gonum.org/v1/gonum/graph"
 	"gonum.org/v1/gonum/graph/path/internal/testgraphs"
+	"gonum.org/v1/gonum/graph/simple"
 	"gonum.org/v1/gonum/internal/order"
 )
 
@@ -119,3 +122,113 @@ func TestJohnsonAllPaths(t *testing.T) {
 		}
 	}
 }
+
+func TestParallelJohnsonAllPaths(t *testing.T) {
+	t.Parallel()
+	for _, test := range testgraphs.ShortestPathTests {
+		g := test.Graph()
+		for _, e := range test.Edges {
+			g.SetWeightedEdge(e)
+		}
+
+		nodes := graph.NodesOf(g.(graph.Graph).Nodes())
+		want, wantOK := JohnsonAllPaths(g.(graph.Graph))
+		for _, workers := range []int{0, 1, 3} {
+			got, ok := ParallelJohnsonAllPaths(g.(graph.Graph), workers)
+			dist, distOK := JohnsonAllDistances(g.(graph.Graph), workers)
+			if ok != wantOK || distOK != wantOK {
+				t.Errorf("%q workers=%d: unexpected negative cycle result: got paths:%t distances:%t want:%t",
+					test.Name, workers, ok, distOK, wantOK)
+				continue
+			}
+			if !ok {
+				continue
+			}
+			for _, u := range nodes {
+				for _, v := range nodes {
+					uid, vid := u.ID(), v.ID()
+					w := want.Weight(uid, vid)
+					if gw := got.Weight(uid, vid); gw != w {
+						t.Errorf("%q workers=%d: unexpected path weight %d→%d: got:%f want:%f",
+							test.Name, workers, uid, vid, gw, w)
+					}
+					if dw := dist.Weight(uid, vid); dw != w {
+						t.Errorf("%q workers=%d: unexpected distance %d→%d: got:%f want:%f",
+							test.Name, workers, uid, vid, dw, w)
+					}
+					if i, j := indexOfNode(dist.Nodes(), uid), indexOfNode(dist.Nodes(), vid); dist.Matrix().At(i, j) != w {
+						t.Errorf("%q workers=%d: unexpected distance matrix element %d→%d: got:%f want:%f",
+							test.Name, workers, uid, vid, dist.Matrix().At(i, j), w)
+					}
+
+					gotPaths, _ := got.AllBetween(uid, vid)
+					wantPaths, _ := want.AllBetween(uid, vid)
+					gotIDs, wantIDs := pathIDs(gotPaths), pathIDs(wantPaths)
+					order.BySliceValues(gotIDs)
+					order.BySliceValues(wantIDs)
+					if !reflect.DeepEqual(gotIDs, wantIDs) {
+						t.Errorf("%q workers=%d: unexpected shortest paths %d→%d:\ngot: %v\nwant:%v",
+							test.Name, workers, uid, vid, gotPaths, wantPaths)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestJohnsonAllDistancesRandom(t *testing.T) {
+	t.Parallel()
+	// Construct a random graph with negative edge weights
+	// but no negative cycles by adjusting non-negative
+	// weights with a node potential.
+	const n = 200
+	rnd := rand.New(rand.NewPCG(1, 1))
+	potential := make([]float64, n)
+	for i := range potential {
+		potential[i] = 10 * rnd.Float64()
+	}
+	g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+	for i := 0; i < n; i++ {
+		g.AddNode(simple.Node(i))
+	}
+	for i := 0; i < 5*n; i++ {
+		u, v := rnd.IntN(n), rnd.IntN(n)
+		if u == v {
+			continue
+		}
+		w := rnd.Float64() + potential[u] - potential[v]
+		g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: w})
+	}
+
+	want, ok := FloydWarshall(g)
+	if !ok {
+		t.Fatal("unexpected negative cycle")
+	}
+	dist, ok := JohnsonAllDistances(g, 4)
+	if !ok {
+		t.Fatal("unexpected negative cycle")
+	}
+	for u := int64(0); u < n; u++ {
+		for v := int64(0); v < n; v++ {
+			got, w := dist.Weight(u, v), want.Weight(u, v)
+			if math.IsInf(w, 1) {
+				if !math.IsInf(got, 1) {
+					t.Errorf("unexpected path %d→%d: got weight %f", u, v, got)
+				}
+				continue
+			}
+			if !scalar.EqualWithinAbsOrRel(got, w, 1e-10, 1e-10) {
+				t.Errorf("unexpected distance %d→%d: got:%f want:%f", u, v, got, w)
+			}
+		}
+	}
+}
+
+func indexOfNode(nodes []graph.Node, id int64) int {
+	for i, n := range nodes {
+		if n.ID() == id {
+			return i
+		}
+	}
+	return -1
+}
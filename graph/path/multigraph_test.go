@@ -0,0 +1,177 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/multi"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// lightestSimple returns a simple graph with an edge of the weight of the
+// lightest line of g between each pair of nodes joined by a line in g.
+func lightestSimple(g graph.WeightedMultigraph, dst interface {
+	graph.Weighted
+	AddNode(graph.Node)
+	SetWeightedEdge(graph.WeightedEdge)
+}) {
+	for _, u := range graph.NodesOf(g.Nodes()) {
+		if dst.Node(u.ID()) == nil {
+			dst.AddNode(u)
+		}
+	}
+	for _, u := range graph.NodesOf(g.Nodes()) {
+		for _, v := range graph.NodesOf(g.From(u.ID())) {
+			l := lightestLine(g, u.ID(), v.ID())
+			dst.SetWeightedEdge(simple.WeightedEdge{F: u, T: v, W: l.Weight()})
+		}
+	}
+}
+
+func randomMultigraph(dst graph.WeightedMultigraphBuilder, n, lines int, negative bool, rnd *rand.Rand) {
+	for i := range n {
+		dst.AddNode(multi.Node(i))
+	}
+	for range lines {
+		u := rnd.IntN(n)
+		v := rnd.IntN(n - 1)
+		if v >= u {
+			v++
+		}
+		w := float64(1 + rnd.IntN(20))
+		if negative && rnd.Float64() < 0.1 {
+			w = -w / 4
+		}
+		dst.SetWeightedLine(dst.NewWeightedLine(multi.Node(u), multi.Node(v), w))
+	}
+}
+
+func TestShortestMultigraph(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := range 20 {
+		const n = 12
+		mg := multi.NewWeightedDirectedGraph()
+		randomMultigraph(mg, n, 60, trial%2 == 1, rnd)
+		sg := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		lightestSimple(mg, sg)
+
+		var (
+			mAll, sAll AllShortest
+			mOK, sOK   bool
+			name       string
+		)
+		if trial%2 == 0 {
+			mAll, sAll = DijkstraAllPaths(mg), DijkstraAllPaths(sg)
+			mOK, sOK = true, true
+			name = "DijkstraAllPaths"
+		} else {
+			mAll, mOK = JohnsonAllPaths(mg)
+			sAll, sOK = JohnsonAllPaths(sg)
+			name = "JohnsonAllPaths"
+		}
+		fw, fwOK := FloydWarshall(mg)
+		if mOK != sOK || fwOK != sOK {
+			t.Errorf("trial %d: mismatched negative cycle detection: %s=%t FloydWarshall=%t simple=%t",
+				trial, name, mOK, fwOK, sOK)
+			continue
+		}
+		if !sOK {
+			continue
+		}
+
+		for u := range int64(n) {
+			var (
+				mFrom Shortest
+				sFrom Shortest
+				ok    bool
+			)
+			if trial%2 == 0 {
+				mFrom = DijkstraFrom(multi.Node(u), mg)
+				sFrom = DijkstraFrom(multi.Node(u), sg)
+			} else {
+				mFrom, ok = BellmanFordFrom(multi.Node(u), mg)
+				if !ok {
+					t.Errorf("trial %d: unexpected negative cycle from %d", trial, u)
+				}
+				sFrom, _ = BellmanFordFrom(multi.Node(u), sg)
+			}
+			for v := range int64(n) {
+				want := sAll.Weight(u, v)
+				if got := mAll.Weight(u, v); got != want {
+					t.Errorf("trial %d: unexpected %s weight from %d to %d: got %v want %v", trial, name, u, v, got, want)
+				}
+				if got := fw.Weight(u, v); got != want {
+					t.Errorf("trial %d: unexpected FloydWarshall weight from %d to %d: got %v want %v", trial, u, v, got, want)
+				}
+				if got := mFrom.WeightTo(v); got != sFrom.WeightTo(v) || got != want {
+					t.Errorf("trial %d: unexpected single source weight from %d to %d: got %v want %v", trial, u, v, got, want)
+				}
+				if trial%2 == 0 {
+					p, _ := AStar(multi.Node(u), multi.Node(v), mg, nil)
+					if got := p.WeightTo(v); got != want {
+						t.Errorf("trial %d: unexpected AStar weight from %d to %d: got %v want %v", trial, u, v, got, want)
+					}
+					if u != v && !math.IsInf(want, 1) {
+						paths := YenKShortestPaths(mg, 1, math.Inf(1), multi.Node(u), multi.Node(v))
+						if len(paths) != 1 {
+							t.Errorf("trial %d: unexpected number of Yen paths from %d to %d: got %d want 1", trial, u, v, len(paths))
+							continue
+						}
+						var got float64
+						for i := 1; i < len(paths[0]); i++ {
+							got += lightestLine(mg, paths[0][i-1].ID(), paths[0][i].ID()).Weight()
+						}
+						if got != want {
+							t.Errorf("trial %d: unexpected Yen path weight from %d to %d: got %v want %v", trial, u, v, got, want)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSpanningTreeMultigraph(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := range 20 {
+		mg := multi.NewWeightedUndirectedGraph()
+		randomMultigraph(mg, 10, 40, false, rnd)
+		sg := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		lightestSimple(mg, sg)
+
+		want := Kruskal(simple.NewWeightedUndirectedGraph(0, math.Inf(1)), sg)
+		for _, test := range []struct {
+			name string
+			fn   func(WeightedBuilder, *multi.WeightedUndirectedGraph) float64
+		}{
+			{name: "Prim", fn: func(dst WeightedBuilder, g *multi.WeightedUndirectedGraph) float64 { return Prim(dst, g) }},
+			{name: "Kruskal", fn: func(dst WeightedBuilder, g *multi.WeightedUndirectedGraph) float64 { return Kruskal(dst, g) }},
+		} {
+			dst := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+			got := test.fn(dst, mg)
+			if math.Abs(got-want) > 1e-12 {
+				t.Errorf("trial %d: unexpected %s weight: got %v want %v", trial, test.name, got, want)
+			}
+			var sum float64
+			for _, e := range graph.WeightedEdgesOf(dst.WeightedEdges()) {
+				w := e.Weight()
+				if lw := lightestLine(mg, e.From().ID(), e.To().ID()).Weight(); w != lw {
+					t.Errorf("trial %d: %s edge %d--%d does not have lightest line weight: got %v want %v",
+						trial, test.name, e.From().ID(), e.To().ID(), w, lw)
+				}
+				sum += w
+			}
+			if math.Abs(sum-got) > 1e-12 {
+				t.Errorf("trial %d: %s tree weight does not match returned weight: got %v want %v", trial, test.name, sum, got)
+			}
+		}
+	}
+}
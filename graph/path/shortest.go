@@ -553,6 +553,18 @@ var (
 // given nodes. The forward flag indicates whether the path reconstruction is
 // performed in the forward (Floyd-Warshall) or reverse (Dijkstra/Johnson's) order.
 func newAllShortest(nodes []graph.Node, forward bool) AllShortest {
+	p := newAllDistances(nodes)
+	if len(nodes) == 0 {
+		return p
+	}
+	p.next = make([][]int, len(nodes)*len(nodes))
+	p.forward = forward
+	return p
+}
+
+// newAllDistances returns an all-pairs shortest path forest for the given
+// nodes that holds only the path weights.
+func newAllDistances(nodes []graph.Node) AllShortest {
 	if len(nodes) == 0 {
 		return AllShortest{}
 	}
@@ -568,9 +580,7 @@ func newAllShortest(nodes []graph.Node, forward bool) AllShortest {
 		nodes:   nodes,
 		indexOf: indexOf,
 
-		dist:    mat.NewDense(len(nodes), len(nodes), dist),
-		next:    make([][]int, len(nodes)*len(nodes)),
-		forward: forward,
+		dist: mat.NewDense(len(nodes), len(nodes), dist),
 	}
 }
 
@@ -795,6 +805,33 @@ func (p AllShortest) allBetween(from, to int, seen []bool, path []graph.Node, fn
 	}
 }
 
+// AllDistances holds the weights of the shortest paths between all pairs of
+// nodes of a graph, without the paths themselves. It is created by
+// JohnsonAllDistances.
+type AllDistances struct {
+	paths AllShortest
+}
+
+// Weight returns the weight of the minimum path between u and v.
+func (d AllDistances) Weight(uid, vid int64) float64 {
+	return d.paths.Weight(uid, vid)
+}
+
+// Nodes returns the nodes of the analysed graph in the order of the rows and
+// columns of the matrix returned by Matrix. The returned slice must not be
+// modified.
+func (d AllDistances) Nodes() []graph.Node {
+	return d.paths.nodes
+}
+
+// Matrix returns the matrix of minimum path weights, with the weight of the
+// minimum path from Nodes()[i] to Nodes()[j] in row i and column j, and +Inf
+// where there is no path. Matrix returns nil if the graph has no nodes. The
+// returned matrix must not be modified.
+func (d AllDistances) Matrix() *mat.Dense {
+	return d.paths.dist
+}
+
 type node int64
 
 func (n node) ID() int64 { return int64(n) }
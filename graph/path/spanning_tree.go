@@ -31,6 +31,10 @@ type WeightedBuilder interface {
 // types used in g are pointer or reference-like, then the values will be shared
 // between the graphs.
 //
+// If g is a graph.WeightedMultigraph, only the lightest line joining each pair
+// of nodes is considered, and the edges placed in dst are simple.WeightedEdge
+// values holding the weights of those lines.
+//
 // If dst has nodes that exist in g, Prim will panic.
 func Prim(dst WeightedBuilder, g graph.WeightedUndirected) float64 {
 	nodes := graph.NodesOf(g.Nodes())
@@ -48,10 +52,11 @@ func Prim(dst WeightedBuilder, g graph.WeightedUndirected) float64 {
 		heap.Push(q, simple.WeightedEdge{F: u, W: math.Inf(1)})
 	}
 
+	weight := weightOf(g)
 	u := nodes[0]
 	uid := u.ID()
 	for _, v := range graph.NodesOf(g.From(uid)) {
-		w, ok := g.Weight(uid, v.ID())
+		w, ok := weight(uid, v.ID())
 		if !ok {
 			panic("prim: unexpected invalid weight")
 		}
@@ -62,7 +67,7 @@ func Prim(dst WeightedBuilder, g graph.WeightedUndirected) float64 {
 	for q.Len() > 0 {
 		e := heap.Pop(q).(simple.WeightedEdge)
 		if e.To() != nil && g.HasEdgeBetween(e.From().ID(), e.To().ID()) {
-			dst.SetWeightedEdge(g.WeightedEdge(e.From().ID(), e.To().ID()))
+			dst.SetWeightedEdge(edgeOf(g, e.From().ID(), e.To().ID()))
 			w += e.Weight()
 		}
 
@@ -70,7 +75,7 @@ func Prim(dst WeightedBuilder, g graph.WeightedUndirected) float64 {
 		uid := u.ID()
 		for _, n := range graph.NodesOf(g.From(uid)) {
 			if key, ok := q.key(n); ok {
-				w, ok := g.Weight(uid, n.ID())
+				w, ok := weight(uid, n.ID())
 				if !ok {
 					panic("prim: unexpected invalid weight")
 				}
@@ -161,9 +166,18 @@ type UndirectedWeightLister interface {
 // types used in g are pointer or reference-like, then the values will be shared
 // between the graphs.
 //
+// If g is a graph.WeightedMultigraph, only the lightest line joining each pair
+// of nodes is considered, and the edges placed in dst are simple.WeightedEdge
+// values holding the weights of those lines.
+//
 // If dst has nodes that exist in g, Kruskal will panic.
 func Kruskal(dst WeightedBuilder, g UndirectedWeightLister) float64 {
 	edges := graph.WeightedEdgesOf(g.WeightedEdges())
+	if _, ok := g.(graph.WeightedMultigraph); ok {
+		for i, e := range edges {
+			edges[i] = edgeOf(g, e.From().ID(), e.To().ID())
+		}
+	}
 	slices.SortFunc(edges, func(a, b graph.WeightedEdge) int {
 		return cmp.Compare(a.Weight(), b.Weight())
 	})
@@ -180,9 +194,21 @@ func Kruskal(dst WeightedBuilder, g UndirectedWeightLister) float64 {
 	for _, e := range edges {
 		if s1, s2 := ds.find(e.From().ID()), ds.find(e.To().ID()); s1 != s2 {
 			ds.union(s1, s2)
-			dst.SetWeightedEdge(g.WeightedEdge(e.From().ID(), e.To().ID()))
+			dst.SetWeightedEdge(edgeOf(g, e.From().ID(), e.To().ID()))
 			w += e.Weight()
 		}
 	}
 	return w
 }
+
+// edgeOf returns the edge joining the nodes with IDs xid and yid in g that
+// is used by the spanning tree functions. If g is a graph.WeightedMultigraph
+// this is a simple.WeightedEdge with the weight of the lightest line joining
+// the nodes.
+func edgeOf(g graph.Weighted, xid, yid int64) graph.WeightedEdge {
+	if mg, ok := g.(graph.WeightedMultigraph); ok {
+		l := lightestLine(mg, xid, yid)
+		return simple.WeightedEdge{F: l.From(), T: l.To(), W: l.Weight()}
+	}
+	return g.WeightedEdge(xid, yid)
+}
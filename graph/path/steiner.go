@@ -0,0 +1,132 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/internal/set"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// SteinerTree generates an approximate minimum Steiner tree of g connecting
+// the given terminal nodes using the algorithm of Kou, Markowsky and Berman,
+// placing the result in the destination, dst. A Steiner tree is a tree in g
+// that contains all the terminals, and may contain other nodes of g. The
+// weight of the tree is at most twice the weight of a minimum Steiner tree.
+// Only the nodes of the tree are added to dst. The destination is not cleared
+// first. The weight of the tree is returned. If the terminals are not all
+// connected in g, a forest of trees connecting the connected groups of
+// terminals is constructed in dst and the sum of their weights is returned.
+//
+// Nodes and Edges from g are used to construct dst, so if the Node and Edge
+// types used in g are pointer or reference-like, then the values will be shared
+// between the graphs. If g is a graph.WeightedMultigraph, only the lightest
+// line joining each pair of nodes is considered, and the edges placed in dst
+// are simple.WeightedEdge values holding the weights of those lines.
+//
+// If dst has nodes that exist in g, a terminal is not in g or g has a
+// reachable negative edge weight, SteinerTree will panic.
+//
+// See Kou, Markowsky and Berman doi:10.1007/BF00288961 for details of the
+// algorithm.
+func SteinerTree(dst WeightedBuilder, g graph.WeightedUndirected, terminals []graph.Node) float64 {
+	isTerminal := make(set.Ints[int64])
+	var term []graph.Node
+	for _, t := range terminals {
+		tid := t.ID()
+		if g.Node(tid) == nil {
+			panic("steiner: terminal not in graph")
+		}
+		if !isTerminal.Has(tid) {
+			isTerminal.Add(tid)
+			term = append(term, g.Node(tid))
+		}
+	}
+
+	// Find a minimum spanning forest of the metric
+	// closure of g restricted to the terminals.
+	type closureEdge struct {
+		from, to int
+		weight   float64
+	}
+	paths := make([]Shortest, len(term))
+	var closure []closureEdge
+	for i, t := range term {
+		paths[i] = DijkstraFrom(t, g)
+		for j, u := range term[:i] {
+			if w := paths[i].WeightTo(u.ID()); !math.IsInf(w, 1) {
+				closure = append(closure, closureEdge{from: i, to: j, weight: w})
+			}
+		}
+	}
+	slices.SortFunc(closure, func(a, b closureEdge) int {
+		return cmp.Compare(a.weight, b.weight)
+	})
+	ds := make(djSet)
+	for i := range term {
+		ds.add(int64(i))
+	}
+
+	// Construct the subgraph of g formed by the shortest
+	// paths corresponding to the closure forest edges.
+	weight := weightOf(g)
+	sub := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	for _, t := range term {
+		sub.AddNode(t)
+	}
+	for _, e := range closure {
+		s1, s2 := ds.find(int64(e.from)), ds.find(int64(e.to))
+		if s1 == s2 {
+			continue
+		}
+		ds.union(s1, s2)
+		p, _ := paths[e.from].To(term[e.to].ID())
+		for k, v := range p[1:] {
+			u := p[k]
+			if sub.Node(v.ID()) == nil {
+				sub.AddNode(v)
+			}
+			w, _ := weight(u.ID(), v.ID())
+			sub.SetWeightedEdge(simple.WeightedEdge{F: u, T: v, W: w})
+		}
+	}
+
+	// Find a minimum spanning forest of the subgraph
+	// and remove non-terminal leaves until none remain.
+	tree := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	Kruskal(tree, sub)
+	var leaves []graph.Node
+	for _, u := range graph.NodesOf(tree.Nodes()) {
+		if !isTerminal.Has(u.ID()) && tree.From(u.ID()).Len() <= 1 {
+			leaves = append(leaves, u)
+		}
+	}
+	for len(leaves) != 0 {
+		u := leaves[len(leaves)-1]
+		leaves = leaves[:len(leaves)-1]
+		var v graph.Node
+		if to := graph.NodesOf(tree.From(u.ID())); len(to) != 0 {
+			v = to[0]
+		}
+		tree.RemoveNode(u.ID())
+		if v != nil && !isTerminal.Has(v.ID()) && tree.From(v.ID()).Len() == 1 {
+			leaves = append(leaves, v)
+		}
+	}
+
+	for _, u := range graph.NodesOf(tree.Nodes()) {
+		dst.AddNode(u)
+	}
+	var w float64
+	for _, e := range graph.WeightedEdgesOf(tree.WeightedEdges()) {
+		dst.SetWeightedEdge(edgeOf(g, e.From().ID(), e.To().ID()))
+		w += e.Weight()
+	}
+	return w
+}
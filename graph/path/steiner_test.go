@@ -0,0 +1,143 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/topo"
+)
+
+// bruteSteiner returns the weight of a minimum Steiner tree of the
+// connected graph g for the given terminals by finding the minimum
+// spanning tree of the subgraph induced by each superset of the
+// terminals.
+func bruteSteiner(g *simple.WeightedUndirectedGraph, terminals []graph.Node) float64 {
+	isTerminal := make(map[int64]bool)
+	for _, t := range terminals {
+		isTerminal[t.ID()] = true
+	}
+	var others []graph.Node
+	for _, u := range graph.NodesOf(g.Nodes()) {
+		if !isTerminal[u.ID()] {
+			others = append(others, u)
+		}
+	}
+	best := math.Inf(1)
+	for mask := range 1 << len(others) {
+		sub := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		for _, t := range terminals {
+			sub.AddNode(t)
+		}
+		for i, u := range others {
+			if mask&(1<<i) != 0 {
+				sub.AddNode(u)
+			}
+		}
+		for _, e := range graph.WeightedEdgesOf(g.WeightedEdges()) {
+			if sub.Node(e.From().ID()) != nil && sub.Node(e.To().ID()) != nil {
+				sub.SetWeightedEdge(e)
+			}
+		}
+		if len(topo.ConnectedComponents(sub)) != 1 {
+			continue
+		}
+		dst := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		best = math.Min(best, Kruskal(dst, sub))
+	}
+	return best
+}
+
+func TestSteinerTree(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := range 200 {
+		const n = 8
+		g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		for i := range int64(n) {
+			g.AddNode(simple.Node(i))
+		}
+		// Join the nodes in a random spanning
+		// tree so that g is connected.
+		for i := int64(1); i < n; i++ {
+			g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(rnd.Int64N(i)), T: simple.Node(i), W: float64(1 + rnd.IntN(10))})
+		}
+		for range rnd.IntN(10) {
+			u, v := rnd.Int64N(n), rnd.Int64N(n)
+			if u == v {
+				continue
+			}
+			g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: float64(1 + rnd.IntN(10))})
+		}
+		var terminals []graph.Node
+		for _, i := range rnd.Perm(n)[:2+rnd.IntN(n-2)] {
+			terminals = append(terminals, simple.Node(i))
+		}
+
+		dst := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		got := SteinerTree(dst, g, terminals)
+		opt := bruteSteiner(g, terminals)
+		if got < opt || 2*opt < got {
+			t.Errorf("trial %d: Steiner tree weight out of bounds: got:%v want in [%v, %v]", trial, got, opt, 2*opt)
+		}
+
+		var sum float64
+		for _, e := range graph.WeightedEdgesOf(dst.WeightedEdges()) {
+			w, ok := g.Weight(e.From().ID(), e.To().ID())
+			if !ok || w != e.Weight() {
+				t.Errorf("trial %d: Steiner tree edge %d--%d not in graph", trial, e.From().ID(), e.To().ID())
+			}
+			sum += e.Weight()
+		}
+		if sum != got {
+			t.Errorf("trial %d: Steiner tree edge weights do not match returned weight: got:%v want:%v", trial, sum, got)
+		}
+		if len(topo.ConnectedComponents(dst)) != 1 || dst.Edges().Len() != dst.Nodes().Len()-1 {
+			t.Errorf("trial %d: Steiner tree is not a tree", trial)
+		}
+		isTerminal := make(map[int64]bool)
+		for _, u := range terminals {
+			isTerminal[u.ID()] = true
+			if dst.Node(u.ID()) == nil {
+				t.Errorf("trial %d: terminal %d not in Steiner tree", trial, u.ID())
+			}
+		}
+		for _, u := range graph.NodesOf(dst.Nodes()) {
+			if !isTerminal[u.ID()] && dst.From(u.ID()).Len() < 2 {
+				t.Errorf("trial %d: non-terminal %d is a leaf of the Steiner tree", trial, u.ID())
+			}
+		}
+	}
+}
+
+func TestSteinerTreeDisconnected(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	for _, e := range []simple.WeightedEdge{
+		{F: simple.Node(0), T: simple.Node(1), W: 1},
+		{F: simple.Node(1), T: simple.Node(2), W: 2},
+		{F: simple.Node(3), T: simple.Node(4), W: 3},
+		{F: simple.Node(4), T: simple.Node(5), W: 4},
+	} {
+		g.SetWeightedEdge(e)
+	}
+	terminals := []graph.Node{simple.Node(0), simple.Node(2), simple.Node(3)}
+
+	dst := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	got := SteinerTree(dst, g, terminals)
+	if want := 3.0; got != want {
+		t.Errorf("unexpected Steiner forest weight: got:%v want:%v", got, want)
+	}
+	if n := dst.Nodes().Len(); n != 4 {
+		t.Errorf("unexpected number of nodes in Steiner forest: got:%d want:4", n)
+	}
+	if n := len(topo.ConnectedComponents(dst)); n != 2 {
+		t.Errorf("unexpected number of trees in Steiner forest: got:%d want:2", n)
+	}
+}
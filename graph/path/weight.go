@@ -43,6 +43,44 @@ func UniformCost(g traverse.Graph) Weighting {
 	}
 }
 
+// weightOf returns the Weighting used by the shortest path functions for g.
+// If g is a graph.WeightedMultigraph, the weight between two nodes is the
+// weight of the lightest line joining them, since a shortest path will
+// only ever use that line. Otherwise the Weight method of g is used if g
+// implements Weighted, and UniformCost is used if it does not.
+func weightOf(g traverse.Graph) Weighting {
+	switch g := g.(type) {
+	case graph.WeightedMultigraph:
+		return func(xid, yid int64) (w float64, ok bool) {
+			if l := lightestLine(g, xid, yid); l != nil {
+				return l.Weight(), true
+			}
+			if xid == yid {
+				return 0, true
+			}
+			return math.Inf(1), false
+		}
+	case Weighted:
+		return g.Weight
+	default:
+		return UniformCost(g)
+	}
+}
+
+// lightestLine returns the line of least weight joining the nodes with IDs
+// xid and yid in g, or nil if no line joins them.
+func lightestLine(g graph.WeightedMultigraph, xid, yid int64) graph.WeightedLine {
+	var lightest graph.WeightedLine
+	lines := g.WeightedLines(xid, yid)
+	for lines.Next() {
+		l := lines.WeightedLine()
+		if lightest == nil || l.Weight() < lightest.Weight() {
+			lightest = l
+		}
+	}
+	return lightest
+}
+
 // Heuristic returns an estimate of the cost of travelling between two nodes.
 type Heuristic func(x, y graph.Node) float64
 
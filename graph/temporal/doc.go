@@ -0,0 +1,19 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package temporal provides temporal graphs and time-respecting path
+// algorithms.
+//
+// A temporal graph is a graph whose edges can only be traversed at given
+// times, such as the contacts of a contact network or the connections of a
+// transit timetable. A path through a temporal graph is time-respecting if
+// each edge departs no earlier than the time the previous edge arrives.
+// Reachability through time-respecting paths is not transitive and not
+// symmetric, even for undirected graphs, so temporal graphs cannot be
+// analysed with the static graph algorithms.
+//
+// The path algorithms make a single pass over the edges in time order, as
+// described in Wu et al., "Path problems in temporal graphs", Proceedings of
+// the VLDB Endowment 7(9), 2014.
+package temporal // import "gonum.org/v1/gonum/graph/temporal"
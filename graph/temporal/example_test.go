@@ -0,0 +1,39 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package temporal_test
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/temporal"
+)
+
+func ExampleEarliestArrival() {
+	// A timetable of direct connections between
+	// four stations, with times in minutes.
+	g := temporal.NewDirected()
+	for _, e := range []temporal.Edge{
+		{F: simple.Node(0), T: simple.Node(1), Start: 0, End: 30},
+		{F: simple.Node(1), T: simple.Node(3), Start: 35, End: 55},
+		{F: simple.Node(0), T: simple.Node(2), Start: 10, End: 15},
+		{F: simple.Node(2), T: simple.Node(3), Start: 40, End: 58},
+		{F: simple.Node(2), T: simple.Node(1), Start: 20, End: 25},
+	} {
+		g.SetTemporalEdge(e)
+	}
+
+	ea := temporal.EarliestArrival(g, simple.Node(0), 0, 120)
+	p, arrival := ea.To(3)
+	fmt.Printf("earliest arrival at 3 is %v via %v\n", arrival, p.Nodes())
+
+	sp := temporal.Shortest(g, simple.Node(0), 0, 120)
+	p, duration := sp.To(3)
+	fmt.Printf("shortest journey to 3 takes %v via %v\n", duration, p.Nodes())
+
+	// Output:
+	// earliest arrival at 3 is 55 via [0 2 1 3]
+	// shortest journey to 3 takes 23 via [0 2 3]
+}
@@ -0,0 +1,338 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package temporal
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// EarliestArrivals holds the earliest arrival times of time-respecting
+// paths from a source node and the paths achieving them.
+type EarliestArrivals struct {
+	from    graph.Node
+	arrival map[int64]float64
+	pred    map[int64]Edge
+}
+
+// EarliestArrival returns the earliest arrival times at the nodes of g of
+// time-respecting paths starting from the node u at or after time start
+// and arriving no later than time end.
+//
+// The time complexity of EarliestArrival is O(|E| log |E|).
+func EarliestArrival(g *Graph, u graph.Node, start, end float64) EarliestArrivals {
+	a := EarliestArrivals{
+		from:    u,
+		arrival: map[int64]float64{u.ID(): start},
+		pred:    make(map[int64]Edge),
+	}
+	if g.Node(u.ID()) == nil {
+		return a
+	}
+	arrival := func(id int64) float64 {
+		t, ok := a.arrival[id]
+		if !ok {
+			return math.Inf(1)
+		}
+		return t
+	}
+	forEachGroup(g.traversals(start, end), sameStart, func(e Edge) bool {
+		fid, tid := e.F.ID(), e.T.ID()
+		if arrival(fid) <= e.Start && e.End < arrival(tid) {
+			a.arrival[tid] = e.End
+			a.pred[tid] = e
+			return true
+		}
+		return false
+	})
+	return a
+}
+
+// From returns the source node of the paths.
+func (a EarliestArrivals) From() graph.Node { return a.from }
+
+// ArrivalAt returns the earliest arrival time at the node with the given
+// ID. ArrivalAt returns +Inf if the node cannot be reached.
+func (a EarliestArrivals) ArrivalAt(id int64) float64 {
+	t, ok := a.arrival[id]
+	if !ok {
+		return math.Inf(1)
+	}
+	return t
+}
+
+// To returns a time-respecting path from the source node to the node with
+// the given ID arriving at the earliest time, and the arrival time. If the
+// node cannot be reached, To returns a nil path and +Inf. The path to the
+// source node is empty.
+func (a EarliestArrivals) To(id int64) (path Path, arrival float64) {
+	t, ok := a.arrival[id]
+	if !ok {
+		return nil, math.Inf(1)
+	}
+	path = Path{}
+	for id != a.from.ID() {
+		e := a.pred[id]
+		path = append(path, e)
+		id = e.F.ID()
+	}
+	slices.Reverse(path)
+	return path, t
+}
+
+// LatestDepartures holds the latest departure times of time-respecting
+// paths to a target node and the paths achieving them.
+type LatestDepartures struct {
+	to        graph.Node
+	departure map[int64]float64
+	succ      map[int64]Edge
+}
+
+// LatestDeparture returns the latest departure times from the nodes of g
+// of time-respecting paths to the node v departing at or after time start
+// and arriving no later than time end.
+//
+// The time complexity of LatestDeparture is O(|E| log |E|).
+func LatestDeparture(g *Graph, v graph.Node, start, end float64) LatestDepartures {
+	d := LatestDepartures{
+		to:        v,
+		departure: map[int64]float64{v.ID(): end},
+		succ:      make(map[int64]Edge),
+	}
+	if g.Node(v.ID()) == nil {
+		return d
+	}
+	departure := func(id int64) float64 {
+		t, ok := d.departure[id]
+		if !ok {
+			return math.Inf(-1)
+		}
+		return t
+	}
+	edges := g.traversals(start, end)
+	slices.SortStableFunc(edges, func(a, b Edge) int {
+		if c := cmp.Compare(b.End, a.End); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.Start, a.Start)
+	})
+	forEachGroup(edges, sameEnd, func(e Edge) bool {
+		fid, tid := e.F.ID(), e.T.ID()
+		if e.End <= departure(tid) && departure(fid) < e.Start {
+			d.departure[fid] = e.Start
+			d.succ[fid] = e
+			return true
+		}
+		return false
+	})
+	return d
+}
+
+// To returns the target node of the paths.
+func (d LatestDepartures) To() graph.Node { return d.to }
+
+// DepartureFrom returns the latest departure time from the node with the
+// given ID. DepartureFrom returns -Inf if the target cannot be reached from
+// the node.
+func (d LatestDepartures) DepartureFrom(id int64) float64 {
+	t, ok := d.departure[id]
+	if !ok {
+		return math.Inf(-1)
+	}
+	return t
+}
+
+// From returns a time-respecting path from the node with the given ID to
+// the target node departing at the latest time, and the departure time. If
+// the target cannot be reached, From returns a nil path and -Inf. The path
+// from the target node is empty.
+func (d LatestDepartures) From(id int64) (path Path, departure float64) {
+	t, ok := d.departure[id]
+	if !ok {
+		return nil, math.Inf(-1)
+	}
+	path = Path{}
+	for id != d.to.ID() {
+		e := d.succ[id]
+		path = append(path, e)
+		id = e.T.ID()
+	}
+	return path, t
+}
+
+// ShortestPaths holds the time-respecting paths with the least total
+// traversal time from a source node.
+type ShortestPaths struct {
+	from graph.Node
+	best map[int64]*label
+}
+
+// label is a non-dominated arrival at a node.
+type label struct {
+	arrival, dist float64
+
+	edge Edge
+	prev *label
+}
+
+// Shortest returns the time-respecting paths from the node u with the least
+// total traversal time of their edges, departing at or after time start and
+// arriving no later than time end. Waiting times at nodes are not included
+// in the traversal time. If all edges have unit duration, the shortest
+// paths are the time-respecting paths with the fewest edges.
+//
+// The time complexity of Shortest is O(|E| log |E| + |E| L) where L is the
+// largest number of non-dominated arrivals at a node.
+func Shortest(g *Graph, u graph.Node, start, end float64) ShortestPaths {
+	src := &label{arrival: start}
+	s := ShortestPaths{
+		from: u,
+		best: map[int64]*label{u.ID(): src},
+	}
+	if g.Node(u.ID()) == nil {
+		return s
+	}
+
+	// Each node holds the arrivals that are not dominated by an
+	// arrival that is both earlier and shorter. The arrivals are
+	// ordered by increasing arrival time and so decreasing distance.
+	labels := map[int64][]*label{u.ID(): {src}}
+	forEachGroup(g.traversals(start, end), sameStart, func(e Edge) bool {
+		fid, tid := e.F.ID(), e.T.ID()
+		lu := labels[fid]
+		// Find the shortest arrival at e.F in time to depart.
+		i, _ := slices.BinarySearchFunc(lu, e.Start, func(l *label, t float64) int {
+			if l.arrival <= t {
+				return -1
+			}
+			return 1
+		})
+		if i == 0 {
+			return false
+		}
+		prev := lu[i-1]
+		cand := &label{arrival: e.End, dist: prev.dist + e.Duration(), edge: e, prev: prev}
+
+		lv := labels[tid]
+		j, _ := slices.BinarySearchFunc(lv, cand.arrival, func(l *label, t float64) int {
+			if l.arrival <= t {
+				return -1
+			}
+			return 1
+		})
+		if j > 0 && lv[j-1].dist <= cand.dist {
+			// Dominated by an earlier or simultaneous arrival.
+			return false
+		}
+		// Remove the simultaneous and later arrivals dominated
+		// by the new arrival.
+		i, _ = slices.BinarySearchFunc(lv[:j], cand.arrival, func(l *label, t float64) int {
+			return cmp.Compare(l.arrival, t)
+		})
+		k := j
+		for k < len(lv) && lv[k].dist >= cand.dist {
+			k++
+		}
+		labels[tid] = slices.Insert(slices.Delete(lv, i, k), i, cand)
+		if b, ok := s.best[tid]; !ok || cand.dist < b.dist || (cand.dist == b.dist && cand.arrival < b.arrival) {
+			s.best[tid] = cand
+		}
+		return true
+	})
+	return s
+}
+
+// From returns the source node of the paths.
+func (s ShortestPaths) From() graph.Node { return s.from }
+
+// To returns a time-respecting path from the source node to the node with
+// the given ID with the least total traversal time, and the traversal time.
+// Among the shortest paths, the path arriving earliest is returned. If the
+// node cannot be reached, To returns a nil path and +Inf. The path to the
+// source node is empty.
+func (s ShortestPaths) To(id int64) (path Path, duration float64) {
+	l, ok := s.best[id]
+	if !ok {
+		return nil, math.Inf(1)
+	}
+	path = Path{}
+	for ; l.prev != nil; l = l.prev {
+		path = append(path, l.edge)
+	}
+	slices.Reverse(path)
+	return path, s.best[id].dist
+}
+
+// Reachable returns the nodes of g that can be reached from the node u by
+// a time-respecting path departing at or after time start and arriving no
+// later than time end, ordered by ID. The node u is included if it is in g.
+func Reachable(g *Graph, u graph.Node, start, end float64) []graph.Node {
+	if g.Node(u.ID()) == nil {
+		return nil
+	}
+	a := EarliestArrival(g, u, start, end)
+	nodes := make([]graph.Node, 0, len(a.arrival))
+	for id := range a.arrival {
+		nodes = append(nodes, g.Node(id))
+	}
+	slices.SortFunc(nodes, func(a, b graph.Node) int {
+		return cmp.Compare(a.ID(), b.ID())
+	})
+	return nodes
+}
+
+// ReachabilityGraph returns the directed static graph with an edge from u
+// to v for each pair of distinct nodes of g where v can be reached from u by
+// a time-respecting path departing at or after time start and arriving no
+// later than time end.
+//
+// The time complexity of ReachabilityGraph is O(|V| |E| log |E|).
+func ReachabilityGraph(g *Graph, start, end float64) *simple.DirectedGraph {
+	dst := simple.NewDirectedGraph()
+	for _, u := range g.nodes {
+		dst.AddNode(u)
+	}
+	for _, u := range g.nodes {
+		for _, v := range Reachable(g, u, start, end) {
+			if v.ID() != u.ID() {
+				dst.SetEdge(dst.NewEdge(u, v))
+			}
+		}
+	}
+	return dst
+}
+
+// forEachGroup calls fn on the edges in order. Edges in runs of edges
+// for which same returns true for consecutive pairs are repeatedly passed
+// to fn until fn returns false for all edges in the run, so that paths
+// through edges with zero duration are found regardless of the order of
+// the edges.
+func forEachGroup(edges []Edge, same func(a, b Edge) bool, fn func(Edge) bool) {
+	for i := 0; i < len(edges); {
+		j := i + 1
+		for j < len(edges) && same(edges[i], edges[j]) {
+			j++
+		}
+		for changed := true; changed; {
+			changed = false
+			for _, e := range edges[i:j] {
+				if fn(e) {
+					changed = true
+				}
+			}
+			if j-i == 1 {
+				break
+			}
+		}
+		i = j
+	}
+}
+
+func sameStart(a, b Edge) bool { return a.Start == b.Start }
+func sameEnd(a, b Edge) bool   { return a.End == b.End }
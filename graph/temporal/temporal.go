@@ -0,0 +1,214 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package temporal
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/iterator"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// Edge is a temporal graph edge. The edge can be traversed by departing
+// from the F node at time Start and arriving at the T node at time End.
+type Edge struct {
+	F, T graph.Node
+
+	Start, End float64
+}
+
+// From returns the from-node of the edge.
+func (e Edge) From() graph.Node { return e.F }
+
+// To returns the to-node of the edge.
+func (e Edge) To() graph.Node { return e.T }
+
+// ReversedEdge returns a new Edge with the F and T fields
+// swapped. The times of the new Edge are the same as
+// the times of the receiver.
+func (e Edge) ReversedEdge() graph.Edge { return Edge{F: e.T, T: e.F, Start: e.Start, End: e.End} }
+
+// Duration returns the traversal time of the edge, End-Start.
+func (e Edge) Duration() float64 { return e.End - e.Start }
+
+// Path is a time-respecting path. Each edge of a Path departs from the node
+// at which the previous edge arrives, no earlier than its arrival time.
+type Path []Edge
+
+// Nodes returns the nodes visited by the path. Nodes returns nil for an
+// empty path.
+func (p Path) Nodes() []graph.Node {
+	if len(p) == 0 {
+		return nil
+	}
+	nodes := make([]graph.Node, 0, len(p)+1)
+	nodes = append(nodes, p[0].F)
+	for _, e := range p {
+		nodes = append(nodes, e.T)
+	}
+	return nodes
+}
+
+// Departure returns the departure time of the path. Departure will panic
+// if the path is empty.
+func (p Path) Departure() float64 { return p[0].Start }
+
+// Arrival returns the arrival time of the path. Arrival will panic if the
+// path is empty.
+func (p Path) Arrival() float64 { return p[len(p)-1].End }
+
+// Duration returns the sum of the traversal times of the edges of the path.
+func (p Path) Duration() float64 {
+	var d float64
+	for _, e := range p {
+		d += e.Duration()
+	}
+	return d
+}
+
+// Graph is a temporal graph. The edges of a directed Graph can only be
+// traversed from their F to their T node, while the edges of an undirected
+// Graph can be traversed in either direction. A Graph may hold any number of
+// edges between a pair of nodes.
+type Graph struct {
+	directed bool
+
+	nodes map[int64]graph.Node
+	edges []Edge
+
+	// sorted indicates whether edges
+	// are sorted by start time.
+	sorted bool
+}
+
+// NewDirected returns an empty directed temporal graph.
+func NewDirected() *Graph {
+	return &Graph{directed: true, nodes: make(map[int64]graph.Node)}
+}
+
+// NewUndirected returns an empty undirected temporal graph.
+func NewUndirected() *Graph {
+	return &Graph{nodes: make(map[int64]graph.Node)}
+}
+
+// IsDirected returns whether the graph is directed.
+func (g *Graph) IsDirected() bool {
+	return g.directed
+}
+
+// AddNode adds n to the graph. It panics if the added node ID matches an
+// existing node ID.
+func (g *Graph) AddNode(n graph.Node) {
+	if _, exists := g.nodes[n.ID()]; exists {
+		panic(fmt.Sprintf("temporal: node ID collision: %d", n.ID()))
+	}
+	g.nodes[n.ID()] = n
+}
+
+// Node returns the node with the given ID if it exists in the graph,
+// and nil otherwise.
+func (g *Graph) Node(id int64) graph.Node {
+	return g.nodes[id]
+}
+
+// Nodes returns all the nodes in the graph.
+func (g *Graph) Nodes() graph.Nodes {
+	if len(g.nodes) == 0 {
+		return graph.Empty
+	}
+	return iterator.NewNodes(g.nodes)
+}
+
+// SetTemporalEdge adds the temporal edge e to the graph. If the nodes of e
+// do not exist in the graph they are added. SetTemporalEdge will panic if
+// the IDs of the e.F and e.T are equal or if e arrives before it departs.
+func (g *Graph) SetTemporalEdge(e Edge) {
+	fid, tid := e.F.ID(), e.T.ID()
+	if fid == tid {
+		panic(fmt.Sprintf("temporal: adding self edge: %d", fid))
+	}
+	if !(e.Start <= e.End) {
+		panic("temporal: edge arrives before it departs")
+	}
+	if _, ok := g.nodes[fid]; !ok {
+		g.nodes[fid] = e.F
+	}
+	if _, ok := g.nodes[tid]; !ok {
+		g.nodes[tid] = e.T
+	}
+	g.edges = append(g.edges, e)
+	g.sorted = false
+}
+
+// TemporalEdges returns all the temporal edges in the graph in order of
+// increasing start time, with ties broken by increasing end time.
+func (g *Graph) TemporalEdges() []Edge {
+	g.sort()
+	return slices.Clone(g.edges)
+}
+
+func (g *Graph) sort() {
+	if g.sorted {
+		return
+	}
+	slices.SortStableFunc(g.edges, byStart)
+	g.sorted = true
+}
+
+func byStart(a, b Edge) int {
+	if c := cmp.Compare(a.Start, b.Start); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.End, b.End)
+}
+
+// traversals returns the edges of g within the time window [start, end]
+// oriented in each direction they can be traversed, in order of increasing
+// start time.
+func (g *Graph) traversals(start, end float64) []Edge {
+	g.sort()
+	var edges []Edge
+	for _, e := range g.edges {
+		if e.Start < start || end < e.End {
+			continue
+		}
+		edges = append(edges, e)
+		if !g.directed {
+			edges = append(edges, e.ReversedEdge().(Edge))
+		}
+	}
+	return edges
+}
+
+// Snapshot returns the static graph of the edges of g that are in transit
+// at some time in the interval [start, end], that is the edges with
+// Start ≤ end and start ≤ End. All the nodes of g are included in the
+// returned graph. The returned graph is a *simple.DirectedGraph if g is
+// directed and a *simple.UndirectedGraph otherwise.
+func (g *Graph) Snapshot(start, end float64) graph.Graph {
+	type builder interface {
+		graph.NodeAdder
+		graph.EdgeAdder
+		graph.Graph
+	}
+	var dst builder
+	if g.directed {
+		dst = simple.NewDirectedGraph()
+	} else {
+		dst = simple.NewUndirectedGraph()
+	}
+	for _, n := range g.nodes {
+		dst.AddNode(n)
+	}
+	for _, e := range g.edges {
+		if e.Start <= end && start <= e.End {
+			dst.SetEdge(dst.NewEdge(e.F, e.T))
+		}
+	}
+	return dst
+}
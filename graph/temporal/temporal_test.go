@@ -0,0 +1,260 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package temporal
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func randomGraph(directed bool, nodes, edges int, rnd *rand.Rand) *Graph {
+	var g *Graph
+	if directed {
+		g = NewDirected()
+	} else {
+		g = NewUndirected()
+	}
+	for i := range nodes {
+		g.AddNode(simple.Node(i))
+	}
+	for range edges {
+		f := rnd.IntN(nodes)
+		t := rnd.IntN(nodes - 1)
+		if t >= f {
+			t++
+		}
+		start := float64(rnd.IntN(10))
+		g.SetTemporalEdge(Edge{
+			F:     simple.Node(f),
+			T:     simple.Node(t),
+			Start: start,
+			End:   start + float64(rnd.IntN(3)),
+		})
+	}
+	return g
+}
+
+// allPaths returns all time-respecting paths in g within the time window
+// that use each edge at most once, including the empty path at each node.
+func allPaths(g *Graph, start, end float64) map[int64][]Path {
+	edges := g.traversals(start, end)
+	paths := make(map[int64][]Path)
+	// Each undirected edge appears twice in edges, once in each
+	// orientation, so it is marked as used by its first index.
+	id := func(i int) int {
+		if g.directed {
+			return i
+		}
+		return i / 2 * 2
+	}
+	var extend func(p Path, used []bool)
+	extend = func(p Path, used []bool) {
+		last := p[len(p)-1]
+		paths[p[0].F.ID()] = append(paths[p[0].F.ID()], slices.Clone(p))
+		for i, e := range edges {
+			if used[id(i)] {
+				continue
+			}
+			if e.F.ID() == last.T.ID() && last.End <= e.Start {
+				used[id(i)] = true
+				extend(append(p, e), used)
+				used[id(i)] = false
+			}
+		}
+	}
+	for i, e := range edges {
+		used := make([]bool, len(edges))
+		used[id(i)] = true
+		extend(Path{e}, used)
+	}
+	return paths
+}
+
+func isTimeRespecting(p Path, start, end float64) bool {
+	for i, e := range p {
+		if e.Start < start || end < e.End {
+			return false
+		}
+		if i > 0 && (p[i-1].T.ID() != e.F.ID() || e.Start < p[i-1].End) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestPaths(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := range 100 {
+		directed := trial%2 == 0
+		g := randomGraph(directed, 5, 12, rnd)
+		start, end := float64(rnd.IntN(3)), float64(8+rnd.IntN(5))
+		paths := allPaths(g, start, end)
+
+		for _, u := range graph.NodesOf(g.Nodes()) {
+			uid := u.ID()
+			ea := EarliestArrival(g, u, start, end)
+			sp := Shortest(g, u, start, end)
+			ld := LatestDeparture(g, u, start, end)
+			reach := Reachable(g, u, start, end)
+
+			wantArrival := map[int64]float64{uid: start}
+			wantDist := map[int64]float64{uid: 0}
+			for _, p := range paths[uid] {
+				vid := p[len(p)-1].T.ID()
+				if a, ok := wantArrival[vid]; !ok || p.Arrival() < a {
+					wantArrival[vid] = p.Arrival()
+				}
+				if d, ok := wantDist[vid]; !ok || p.Duration() < d {
+					wantDist[vid] = p.Duration()
+				}
+			}
+			wantDeparture := map[int64]float64{uid: end}
+			for _, from := range paths {
+				for _, p := range from {
+					if p[len(p)-1].T.ID() != uid {
+						continue
+					}
+					fid := p[0].F.ID()
+					if d, ok := wantDeparture[fid]; !ok || p.Departure() > d {
+						wantDeparture[fid] = p.Departure()
+					}
+				}
+			}
+
+			var wantReach []int64
+			for _, v := range graph.NodesOf(g.Nodes()) {
+				vid := v.ID()
+				want, ok := wantArrival[vid]
+				if !ok {
+					want = math.Inf(1)
+				} else {
+					wantReach = append(wantReach, vid)
+				}
+				if got := ea.ArrivalAt(vid); got != want {
+					t.Errorf("trial %d: unexpected earliest arrival from %d at %d: got %v want %v", trial, uid, vid, got, want)
+				}
+				p, arrival := ea.To(vid)
+				if arrival != want {
+					t.Errorf("trial %d: unexpected arrival of earliest path from %d to %d: got %v want %v", trial, uid, vid, arrival, want)
+				}
+				if ok && vid != uid {
+					if !isTimeRespecting(p, start, end) || p[0].F.ID() != uid || p[len(p)-1].T.ID() != vid || p.Arrival() != want {
+						t.Errorf("trial %d: invalid earliest arrival path from %d to %d: %v", trial, uid, vid, p)
+					}
+				}
+
+				want, ok = wantDist[vid]
+				if !ok {
+					want = math.Inf(1)
+				}
+				p, dist := sp.To(vid)
+				if dist != want {
+					t.Errorf("trial %d: unexpected shortest distance from %d to %d: got %v want %v", trial, uid, vid, dist, want)
+				}
+				if ok && vid != uid {
+					if !isTimeRespecting(p, start, end) || p[0].F.ID() != uid || p[len(p)-1].T.ID() != vid || p.Duration() != want {
+						t.Errorf("trial %d: invalid shortest path from %d to %d: %v", trial, uid, vid, p)
+					}
+				}
+
+				want, ok = wantDeparture[vid]
+				if !ok {
+					want = math.Inf(-1)
+				}
+				if got := ld.DepartureFrom(vid); got != want {
+					t.Errorf("trial %d: unexpected latest departure from %d to %d: got %v want %v", trial, vid, uid, got, want)
+				}
+				p, departure := ld.From(vid)
+				if departure != want {
+					t.Errorf("trial %d: unexpected departure of latest path from %d to %d: got %v want %v", trial, vid, uid, departure, want)
+				}
+				if ok && vid != uid {
+					if !isTimeRespecting(p, start, end) || p[0].F.ID() != vid || p[len(p)-1].T.ID() != uid || p.Departure() != want {
+						t.Errorf("trial %d: invalid latest departure path from %d to %d: %v", trial, vid, uid, p)
+					}
+				}
+			}
+
+			slices.Sort(wantReach)
+			var gotReach []int64
+			for _, v := range reach {
+				gotReach = append(gotReach, v.ID())
+			}
+			if !slices.Equal(gotReach, wantReach) {
+				t.Errorf("trial %d: unexpected reachable set from %d: got %v want %v", trial, uid, gotReach, wantReach)
+			}
+		}
+
+		rg := ReachabilityGraph(g, start, end)
+		for _, u := range graph.NodesOf(g.Nodes()) {
+			a := EarliestArrival(g, u, start, end)
+			for _, v := range graph.NodesOf(g.Nodes()) {
+				want := u.ID() != v.ID() && !math.IsInf(a.ArrivalAt(v.ID()), 1)
+				if got := rg.HasEdgeFromTo(u.ID(), v.ID()); got != want {
+					t.Errorf("trial %d: unexpected reachability from %d to %d: got %t want %t", trial, u.ID(), v.ID(), got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestGraph(t *testing.T) {
+	t.Parallel()
+	g := NewUndirected()
+	if g.IsDirected() {
+		t.Error("undirected graph reports directed")
+	}
+	g.SetTemporalEdge(Edge{F: simple.Node(0), T: simple.Node(1), Start: 3, End: 4})
+	g.SetTemporalEdge(Edge{F: simple.Node(1), T: simple.Node(2), Start: 1, End: 2})
+	g.SetTemporalEdge(Edge{F: simple.Node(2), T: simple.Node(3), Start: 1, End: 1})
+	g.AddNode(simple.Node(4))
+	if n := g.Nodes().Len(); n != 5 {
+		t.Errorf("unexpected number of nodes: got %d want 5", n)
+	}
+	edges := g.TemporalEdges()
+	for i := 1; i < len(edges); i++ {
+		if byStart(edges[i-1], edges[i]) > 0 {
+			t.Errorf("edges not sorted: %v", edges)
+		}
+	}
+
+	snap := g.Snapshot(1.5, 2.5)
+	if _, ok := snap.(*simple.UndirectedGraph); !ok {
+		t.Errorf("unexpected snapshot type %T", snap)
+	}
+	if snap.Nodes().Len() != 5 {
+		t.Errorf("unexpected number of nodes in snapshot: got %d want 5", snap.Nodes().Len())
+	}
+	if !snap.HasEdgeBetween(1, 2) || snap.HasEdgeBetween(0, 1) || snap.HasEdgeBetween(2, 3) {
+		t.Error("unexpected snapshot edges")
+	}
+
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "self edge", fn: func() { g.SetTemporalEdge(Edge{F: simple.Node(0), T: simple.Node(0)}) }},
+		{name: "negative duration", fn: func() { g.SetTemporalEdge(Edge{F: simple.Node(0), T: simple.Node(1), Start: 2, End: 1}) }},
+		{name: "node collision", fn: func() { g.AddNode(simple.Node(1)) }},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("%s: expected panic", test.name)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
@@ -0,0 +1,223 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"cmp"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// Coreness returns the core number of each node of the undirected graph g,
+// keyed on node ID. The core number of a node is the largest k such that
+// the node belongs to the k-core of g, the maximal subgraph of g in which
+// every node has degree at least k.
+func Coreness(g graph.Undirected) map[int64]int {
+	order, offsets := degeneracyOrdering(g)
+	core := make(map[int64]int, len(order))
+	var i int
+	for k, n := range offsets {
+		for _, v := range order[i : i+n] {
+			core[v.ID()] = k
+		}
+		i += n
+	}
+	return core
+}
+
+// Trussness returns the truss number of each edge of the undirected graph
+// g, keyed on the IDs of the nodes of the edge with the lower ID first. The
+// truss number of an edge is the largest k such that the edge belongs to
+// the k-truss of g, the maximal subgraph of g in which every edge is in at
+// least k-2 triangles. Every edge has a truss number of at least two.
+// Self loops are ignored.
+//
+// The truss decomposition is computed using the algorithm described in
+// Wang, J. and Cheng, J. (2012) Truss decomposition in massive networks.
+// PVLDB 5(9) 812-823. doi:10.14778/2311906.2311909
+func Trussness(g graph.Undirected) map[[2]int64]int {
+	a := newIndexedGraph(g)
+	m := len(a.edges)
+
+	// Count the triangles holding each edge.
+	support := make([]int, m)
+	for e, uv := range a.edges {
+		a.commonNeighbors(uv[0], uv[1], func(_, _, _ int) {
+			support[e]++
+		})
+	}
+
+	// Sort the edges by support with a bin sort as for
+	// core decomposition in Batagelj, V. and Zaversnik, M.
+	// (2003) An O(m) algorithm for cores decomposition of
+	// networks. arXiv:cs/0310049
+	var maxSupport int
+	for _, s := range support {
+		maxSupport = max(maxSupport, s)
+	}
+	bin := make([]int, maxSupport+1)
+	for _, s := range support {
+		bin[s]++
+	}
+	var start int
+	for s, n := range bin {
+		bin[s] = start
+		start += n
+	}
+	pos := make([]int, m)
+	sorted := make([]int, m)
+	for e, s := range support {
+		pos[e] = bin[s]
+		sorted[pos[e]] = e
+		bin[s]++
+	}
+	for s := maxSupport; s > 0; s-- {
+		bin[s] = bin[s-1]
+	}
+	if len(bin) != 0 {
+		bin[0] = 0
+	}
+
+	// decrement moves the edge f to the bin
+	// of the next smaller support.
+	decrement := func(f int) {
+		s := support[f]
+		pf := pos[f]
+		pw := bin[s]
+		w := sorted[pw]
+		if f != w {
+			pos[f], pos[w] = pw, pf
+			sorted[pf], sorted[pw] = w, f
+		}
+		bin[s]++
+		support[f]--
+	}
+
+	removed := make([]bool, m)
+	truss := make(map[[2]int64]int, m)
+	for _, e := range sorted {
+		u, v := a.edges[e][0], a.edges[e][1]
+		a.commonNeighbors(u, v, func(_, uw, vw int) {
+			if removed[uw] || removed[vw] {
+				return
+			}
+			for _, f := range [2]int{uw, vw} {
+				if support[f] > support[e] {
+					decrement(f)
+				}
+			}
+		})
+		removed[e] = true
+		truss[a.edgeKey(e)] = support[e] + 2
+	}
+	return truss
+}
+
+// KTruss returns the edges of the k-truss of the undirected graph g, the
+// maximal subgraph of g in which every edge is in at least k-2 triangles.
+// The edges are ordered by the IDs of their nodes.
+func KTruss(k int, g graph.Undirected) []graph.Edge {
+	truss := Trussness(g)
+	var edges []graph.Edge
+	for uv, t := range truss {
+		if t >= k {
+			edges = append(edges, g.Edge(uv[0], uv[1]))
+		}
+	}
+	slices.SortFunc(edges, func(a, b graph.Edge) int {
+		if c := cmp.Compare(min(a.From().ID(), a.To().ID()), min(b.From().ID(), b.To().ID())); c != 0 {
+			return c
+		}
+		return cmp.Compare(max(a.From().ID(), a.To().ID()), max(b.From().ID(), b.To().ID()))
+	})
+	return edges
+}
+
+// indexedGraph is an indexed adjacency list representation of a simple
+// undirected graph with nodes ordered by ID.
+type indexedGraph struct {
+	nodes []graph.Node
+
+	// adj holds the sorted neighbors of each node
+	// and edge holds the index of the edge to each
+	// of them in edges.
+	adj  [][]int
+	edge [][]int
+
+	// edges holds the nodes of each edge, with
+	// the lower index first.
+	edges [][2]int
+}
+
+// newIndexedGraph returns the indexed representation of g. Self loops are
+// ignored.
+func newIndexedGraph(g graph.Undirected) indexedGraph {
+	nodes := graph.NodesOf(g.Nodes())
+	slices.SortFunc(nodes, func(a, b graph.Node) int {
+		return cmp.Compare(a.ID(), b.ID())
+	})
+	indexOf := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+	}
+	a := indexedGraph{
+		nodes: nodes,
+		adj:   make([][]int, len(nodes)),
+		edge:  make([][]int, len(nodes)),
+	}
+	for i, u := range nodes {
+		uid := u.ID()
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if vid != uid {
+				a.adj[i] = append(a.adj[i], indexOf[vid])
+			}
+		}
+		slices.Sort(a.adj[i])
+		a.adj[i] = slices.Compact(a.adj[i])
+	}
+	for u, nu := range a.adj {
+		a.edge[u] = make([]int, len(nu))
+	}
+	for u, nu := range a.adj {
+		for j, v := range nu {
+			if v < u {
+				continue
+			}
+			e := len(a.edges)
+			a.edges = append(a.edges, [2]int{u, v})
+			a.edge[u][j] = e
+			k, _ := slices.BinarySearch(a.adj[v], u)
+			a.edge[v][k] = e
+		}
+	}
+	return a
+}
+
+// commonNeighbors calls fn for each common neighbor w of the nodes u and
+// v, passing w and the indices of the edges between u and w and between v
+// and w.
+func (a indexedGraph) commonNeighbors(u, v int, fn func(w, uw, vw int)) {
+	nu, nv := a.adj[u], a.adj[v]
+	for i, j := 0, 0; i < len(nu) && j < len(nv); {
+		switch {
+		case nu[i] < nv[j]:
+			i++
+		case nu[i] > nv[j]:
+			j++
+		default:
+			fn(nu[i], a.edge[u][i], a.edge[v][j])
+			i++
+			j++
+		}
+	}
+}
+
+// edgeKey returns the node IDs of the edge e with the lower ID first.
+func (a indexedGraph) edgeKey(e int) [2]int64 {
+	return [2]int64{a.nodes[a.edges[e][0]].ID(), a.nodes[a.edges[e][1]].ID()}
+}
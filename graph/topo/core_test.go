@@ -0,0 +1,140 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/graphs/gen"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestCoreness(t *testing.T) {
+	t.Parallel()
+	for i, test := range vOrderTests {
+		g := simple.NewUndirectedGraph()
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+		got := Coreness(g)
+		if len(got) != len(test.g) {
+			t.Errorf("unexpected number of core numbers for test %d: got:%d want:%d", i, len(got), len(test.g))
+		}
+		for k, core := range test.wantCore {
+			for _, id := range core {
+				if got[id] != k {
+					t.Errorf("unexpected core number of node %d for test %d: got:%d want:%d", id, i, got[id], k)
+				}
+			}
+		}
+	}
+}
+
+// bruteTruss returns the truss number of each edge of g by repeatedly
+// removing the edges in too few triangles for each k.
+func bruteTruss(g graph.Undirected) map[[2]int64]int {
+	a := newIndexedGraph(g)
+	truss := make(map[[2]int64]int)
+	for e := range a.edges {
+		truss[a.edgeKey(e)] = 2
+	}
+	alive := make([]bool, len(a.edges))
+	for e := range alive {
+		alive[e] = true
+	}
+	for k := 3; ; k++ {
+		for changed := true; changed; {
+			changed = false
+			for e, uv := range a.edges {
+				if !alive[e] {
+					continue
+				}
+				var triangles int
+				a.commonNeighbors(uv[0], uv[1], func(_, uw, vw int) {
+					if alive[uw] && alive[vw] {
+						triangles++
+					}
+				})
+				if triangles < k-2 {
+					alive[e] = false
+					changed = true
+				}
+			}
+		}
+		var any bool
+		for e, ok := range alive {
+			if ok {
+				truss[a.edgeKey(e)] = k
+				any = true
+			}
+		}
+		if !any {
+			return truss
+		}
+	}
+}
+
+func TestTrussness(t *testing.T) {
+	t.Parallel()
+	complete := simple.NewUndirectedGraph()
+	for i := range 5 {
+		for j := range i {
+			complete.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(j)})
+		}
+	}
+	// A triangle attached by an edge to a K5.
+	complete.SetEdge(simple.Edge{F: simple.Node(4), T: simple.Node(5)})
+	complete.SetEdge(simple.Edge{F: simple.Node(5), T: simple.Node(6)})
+	complete.SetEdge(simple.Edge{F: simple.Node(6), T: simple.Node(7)})
+	complete.SetEdge(simple.Edge{F: simple.Node(5), T: simple.Node(7)})
+	got := Trussness(complete)
+	for uv, k := range got {
+		want := 5
+		switch {
+		case uv == [2]int64{4, 5}:
+			want = 2
+		case uv[0] >= 5:
+			want = 3
+		}
+		if k != want {
+			t.Errorf("unexpected truss number of edge %v: got:%d want:%d", uv, k, want)
+		}
+	}
+	if len(got) != 14 {
+		t.Errorf("unexpected number of edges: got:%d want:14", len(got))
+	}
+	if edges := KTruss(4, complete); len(edges) != 10 {
+		t.Errorf("unexpected number of edges in 4-truss: got:%d want:10", len(edges))
+	}
+	if edges := KTruss(2, complete); len(edges) != 14 {
+		t.Errorf("unexpected number of edges in 2-truss: got:%d want:14", len(edges))
+	}
+
+	for i, p := range []float64{0.1, 0.3, 0.6} {
+		g := simple.NewUndirectedGraph()
+		err := gen.Gnp(g, 30, p, rand.NewPCG(uint64(i), 1))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := Trussness(g)
+		want := bruteTruss(g)
+		if len(got) != len(want) {
+			t.Errorf("unexpected number of edges for p=%v: got:%d want:%d", p, len(got), len(want))
+		}
+		for uv, k := range want {
+			if got[uv] != k {
+				t.Errorf("unexpected truss number of edge %v for p=%v: got:%d want:%d", uv, p, got[uv], k)
+			}
+		}
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/graphs/gen"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// subgraphDensity returns the density of the subgraph of g induced by
+// nodes.
+func subgraphDensity(g graph.Undirected, nodes []graph.Node) float64 {
+	var edges int
+	for i, u := range nodes {
+		for _, v := range nodes[:i] {
+			if g.HasEdgeBetween(u.ID(), v.ID()) {
+				edges++
+			}
+		}
+	}
+	return float64(edges) / float64(len(nodes))
+}
+
+// bruteDensest returns the largest density of the subgraphs of g.
+func bruteDensest(g graph.Undirected) float64 {
+	nodes := graph.NodesOf(g.Nodes())
+	var best float64
+	for set := 1; set < 1<<len(nodes); set++ {
+		var sub []graph.Node
+		for i, n := range nodes {
+			if set&(1<<i) != 0 {
+				sub = append(sub, n)
+			}
+		}
+		best = max(best, subgraphDensity(g, sub))
+	}
+	return best
+}
+
+func TestDensestSubgraph(t *testing.T) {
+	t.Parallel()
+	// A K5 with a path of pendant nodes has its
+	// densest subgraph at the K5.
+	g := simple.NewUndirectedGraph()
+	for i := range 5 {
+		for j := range i {
+			g.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(j)})
+		}
+	}
+	for i := 5; i < 10; i++ {
+		g.SetEdge(simple.Edge{F: simple.Node(i - 1), T: simple.Node(i)})
+	}
+	for _, fn := range []func(graph.Undirected) ([]graph.Node, float64){DensestSubgraph, GreedyDensestSubgraph} {
+		nodes, density := fn(g)
+		if len(nodes) != 5 || density != 2 {
+			t.Errorf("unexpected densest subgraph: got:%v with density %v want:K5 with density 2", nodes, density)
+		}
+		for i, n := range nodes {
+			if n.ID() != int64(i) {
+				t.Errorf("unexpected node in densest subgraph: got:%d want:%d", n.ID(), i)
+			}
+		}
+	}
+
+	empty := simple.NewUndirectedGraph()
+	empty.AddNode(simple.Node(0))
+	if nodes, density := DensestSubgraph(empty); nodes != nil || density != 0 {
+		t.Errorf("unexpected densest subgraph of graph without edges: got:%v with density %v", nodes, density)
+	}
+	if nodes, density := GreedyDensestSubgraph(simple.NewUndirectedGraph()); nodes != nil || density != 0 {
+		t.Errorf("unexpected densest subgraph of empty graph: got:%v with density %v", nodes, density)
+	}
+
+	for i, p := range []float64{0.15, 0.3, 0.5, 0.8} {
+		g := simple.NewUndirectedGraph()
+		err := gen.Gnp(g, 13, p, rand.NewPCG(uint64(i), 2))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := bruteDensest(g)
+
+		nodes, density := DensestSubgraph(g)
+		if density != want {
+			t.Errorf("unexpected density for p=%v: got:%v want:%v", p, density, want)
+		}
+		if d := subgraphDensity(g, nodes); d != density {
+			t.Errorf("returned density does not match nodes for p=%v: got:%v want:%v", p, density, d)
+		}
+
+		nodes, density = GreedyDensestSubgraph(g)
+		if density > want || density < want/2 {
+			t.Errorf("greedy density out of bounds for p=%v: got:%v optimum:%v", p, density, want)
+		}
+		if d := subgraphDensity(g, nodes); d != density {
+			t.Errorf("returned greedy density does not match nodes for p=%v: got:%v want:%v", p, density, d)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/multi"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestComponentsMultigraph(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := range 20 {
+		const n = 30
+		md := multi.NewWeightedDirectedGraph()
+		mu := multi.NewWeightedUndirectedGraph()
+		sd := simple.NewDirectedGraph()
+		su := simple.NewUndirectedGraph()
+		for i := range int64(n) {
+			md.AddNode(multi.Node(i))
+			mu.AddNode(multi.Node(i))
+			sd.AddNode(simple.Node(i))
+			su.AddNode(simple.Node(i))
+		}
+		for range 40 {
+			u, v := rnd.Int64N(n), rnd.Int64N(n)
+			// Parallel lines, including parallel self loops,
+			// must not change the components.
+			for range 1 + rnd.IntN(3) {
+				md.SetWeightedLine(md.NewWeightedLine(multi.Node(u), multi.Node(v), rnd.Float64()))
+				mu.SetWeightedLine(mu.NewWeightedLine(multi.Node(u), multi.Node(v), rnd.Float64()))
+			}
+			if u != v {
+				sd.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+				su.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+
+		for _, test := range []struct {
+			name      string
+			got, want [][]graph.Node
+		}{
+			{name: "ConnectedComponents", got: ConnectedComponents(mu), want: ConnectedComponents(su)},
+			{name: "WeaklyConnectedComponents", got: WeaklyConnectedComponents(md, 2), want: WeaklyConnectedComponents(sd, 2)},
+			{name: "StronglyConnectedComponents", got: StronglyConnectedComponents(md, 2), want: StronglyConnectedComponents(sd, 2)},
+			{name: "TarjanSCC", got: TarjanSCC(md), want: TarjanSCC(sd)},
+		} {
+			got, want := canonicalComponents(test.got), canonicalComponents(test.want)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("trial %d: unexpected %s:\ngot: %v\nwant:%v", trial, test.name, got, want)
+			}
+		}
+
+		for u := range int64(n) {
+			for v := range int64(n) {
+				got := PathExistsIn(md, multi.Node(u), multi.Node(v))
+				want := PathExistsIn(sd, simple.Node(u), simple.Node(v))
+				if got != want {
+					t.Errorf("trial %d: unexpected path existence from %d to %d: got %t want %t", trial, u, v, got, want)
+				}
+			}
+		}
+	}
+}
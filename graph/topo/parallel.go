@@ -0,0 +1,420 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"runtime"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// WeaklyConnectedComponents returns the weakly connected components of g,
+// ignoring edge direction. Nodes within each component are sorted by ID and
+// components are sorted by their lowest node ID, so the returned value does
+// not depend on the number of workers or on the iteration order of g.
+//
+// The components are calculated with a concurrent union-find using up to
+// workers goroutines. If workers is less than one, runtime.GOMAXPROCS(0) is
+// used. The From method of g must be safe for concurrent use by multiple
+// goroutines.
+func WeaklyConnectedComponents(g graph.Graph, workers int) [][]graph.Node {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	indexOf := make(map[int64]int32, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = int32(i)
+	}
+
+	uf := make(concurrentUnionFind, len(nodes))
+	for i := range uf {
+		uf[i] = int32(i)
+	}
+	parallelFor(len(nodes), workers, func(lo, hi int) {
+		for i := lo; i < hi; i++ {
+			to := g.From(nodes[i].ID())
+			for to.Next() {
+				uf.union(int32(i), indexOf[to.Node().ID()])
+			}
+		}
+	})
+
+	label := make([]int32, len(nodes))
+	for i := range label {
+		label[i] = uf.find(int32(i))
+	}
+	return componentsFrom(nodes, label)
+}
+
+// concurrentUnionFind is a lock-free disjoint set forest over dense
+// indices. Roots are always the lowest index in their set.
+type concurrentUnionFind []int32
+
+func (uf concurrentUnionFind) find(x int32) int32 {
+	for {
+		p := atomic.LoadInt32(&uf[x])
+		if p == x {
+			return x
+		}
+		gp := atomic.LoadInt32(&uf[p])
+		if gp != p {
+			// Path halving.
+			atomic.CompareAndSwapInt32(&uf[x], p, gp)
+		}
+		x = gp
+	}
+}
+
+func (uf concurrentUnionFind) union(x, y int32) {
+	for {
+		x = uf.find(x)
+		y = uf.find(y)
+		if x == y {
+			return
+		}
+		if x < y {
+			x, y = y, x
+		}
+		if atomic.CompareAndSwapInt32(&uf[x], x, y) {
+			return
+		}
+	}
+}
+
+// StronglyConnectedComponents returns the strongly connected components of
+// the directed graph g. Nodes within each component are sorted by ID and
+// components are sorted by their lowest node ID, so the returned value does
+// not depend on the number of workers or on the iteration order of g.
+//
+// The components are calculated with the forward-backward algorithm with
+// trimming described in "On identifying strongly connected components in
+// parallel", IPDPS 2000 Workshops, LNCS 1800:505-511, with independent
+// partitions processed concurrently using up to workers goroutines. If
+// workers is less than one, runtime.GOMAXPROCS(0) is used. The From and To
+// methods of g must be safe for concurrent use by multiple goroutines.
+func StronglyConnectedComponents(g graph.Directed, workers int) [][]graph.Node {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	indexOf := make(map[int64]int32, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = int32(i)
+	}
+
+	s := fwbw{
+		succ:  make([][]int32, len(nodes)),
+		pred:  make([][]int32, len(nodes)),
+		label: make([]int32, len(nodes)),
+		comp:  make([]int32, len(nodes)),
+		sem:   make(chan struct{}, workers-1),
+	}
+	parallelFor(len(nodes), workers, func(lo, hi int) {
+		for i := lo; i < hi; i++ {
+			id := nodes[i].ID()
+			to := g.From(id)
+			for to.Next() {
+				s.succ[i] = append(s.succ[i], indexOf[to.Node().ID()])
+			}
+			from := g.To(id)
+			for from.Next() {
+				s.pred[i] = append(s.pred[i], indexOf[from.Node().ID()])
+			}
+		}
+	})
+
+	all := make([]int32, len(nodes))
+	for i := range all {
+		all[i] = int32(i)
+	}
+	s.wg.Add(1)
+	s.partition(s.newLabel(all), all)
+	s.wg.Wait()
+
+	return componentsFrom(nodes, s.comp)
+}
+
+// fwbw holds the state for a concurrent forward-backward
+// strongly connected component search.
+type fwbw struct {
+	succ, pred [][]int32
+
+	// label holds the partition label of each
+	// vertex. Labels are read and written atomically
+	// since partitions are processed concurrently.
+	label     []int32
+	lastLabel atomic.Int32
+
+	// comp holds the lowest vertex index of the
+	// strongly connected component of each vertex.
+	comp []int32
+
+	sem chan struct{}
+	wg  sync.WaitGroup
+}
+
+// done is the label of vertices that have been assigned
+// to a strongly connected component.
+const done = -1
+
+// minParallelPartition is the smallest partition that will be
+// processed by the forward-backward algorithm. Smaller partitions
+// are processed using Tarjan's algorithm.
+const minParallelPartition = 64
+
+// newLabel labels the vertices in part with a new unique label
+// and returns the label.
+func (s *fwbw) newLabel(part []int32) int32 {
+	l := s.lastLabel.Add(1)
+	for _, v := range part {
+		atomic.StoreInt32(&s.label[v], l)
+	}
+	return l
+}
+
+// spawn processes the partition part with label l concurrently if
+// a worker is available, and otherwise in the calling goroutine.
+func (s *fwbw) spawn(l int32, part []int32) {
+	if len(part) == 0 {
+		return
+	}
+	s.wg.Add(1)
+	select {
+	case s.sem <- struct{}{}:
+		go func() {
+			s.partition(l, part)
+			<-s.sem
+		}()
+	default:
+		s.partition(l, part)
+	}
+}
+
+// partition finds the strongly connected components of the subgraph
+// induced by part, all of which are labeled l.
+func (s *fwbw) partition(l int32, part []int32) {
+	defer s.wg.Done()
+
+	part = s.trim(l, part)
+	if len(part) == 0 {
+		return
+	}
+	if len(part) < minParallelPartition {
+		s.tarjan(l, part)
+		return
+	}
+
+	// Find the vertices reachable forwards from the pivot, and
+	// then those reachable backwards. Vertices in both sets form
+	// the pivot's strongly connected component.
+	pivot := part[0]
+	fw := s.lastLabel.Add(1)
+	fwSet := s.search(pivot, s.succ, func(v int32) bool {
+		return atomic.LoadInt32(&s.label[v]) == l
+	}, fw)
+	bw := s.lastLabel.Add(1)
+	s.search(pivot, s.pred, func(v int32) bool {
+		lv := atomic.LoadInt32(&s.label[v])
+		return lv == l || lv == fw
+	}, bw)
+
+	var scc, fwOnly, bwOnly, rest []int32
+	for _, v := range fwSet {
+		if atomic.LoadInt32(&s.label[v]) == bw {
+			scc = append(scc, v)
+		} else {
+			fwOnly = append(fwOnly, v)
+		}
+	}
+	minV := slices.Min(scc)
+	for _, v := range scc {
+		s.comp[v] = minV
+		atomic.StoreInt32(&s.label[v], done)
+	}
+	for _, v := range part {
+		switch atomic.LoadInt32(&s.label[v]) {
+		case l:
+			rest = append(rest, v)
+		case bw:
+			bwOnly = append(bwOnly, v)
+		}
+	}
+
+	s.spawn(s.newLabel(fwOnly), fwOnly)
+	s.spawn(s.newLabel(bwOnly), bwOnly)
+	s.spawn(l, rest)
+}
+
+// search performs a breadth-first search from the vertex from along
+// the edges in adj, visiting vertices for which ok returns true, and
+// relabels visited vertices with the label to. It returns the visited
+// vertices.
+func (s *fwbw) search(from int32, adj [][]int32, ok func(int32) bool, to int32) []int32 {
+	atomic.StoreInt32(&s.label[from], to)
+	visited := []int32{from}
+	for i := 0; i < len(visited); i++ {
+		for _, v := range adj[visited[i]] {
+			if ok(v) {
+				atomic.StoreInt32(&s.label[v], to)
+				visited = append(visited, v)
+			}
+		}
+	}
+	return visited
+}
+
+// trim removes vertices from part that have no incoming or no outgoing
+// edges within the partition labeled l, assigning them to singleton
+// strongly connected components. It returns the remaining vertices.
+func (s *fwbw) trim(l int32, part []int32) []int32 {
+	in := make(map[int32]int, len(part))
+	out := make(map[int32]int, len(part))
+	for _, u := range part {
+		for _, v := range s.succ[u] {
+			if u != v && atomic.LoadInt32(&s.label[v]) == l {
+				out[u]++
+				in[v]++
+			}
+		}
+	}
+	var queue []int32
+	for _, v := range part {
+		if in[v] == 0 || out[v] == 0 {
+			queue = append(queue, v)
+			atomic.StoreInt32(&s.label[v], done)
+		}
+	}
+	for len(queue) != 0 {
+		u := queue[0]
+		queue = queue[1:]
+		s.comp[u] = u
+		for _, v := range s.succ[u] {
+			if atomic.LoadInt32(&s.label[v]) != l {
+				continue
+			}
+			if in[v]--; in[v] == 0 {
+				queue = append(queue, v)
+				atomic.StoreInt32(&s.label[v], done)
+			}
+		}
+		for _, v := range s.pred[u] {
+			if atomic.LoadInt32(&s.label[v]) != l {
+				continue
+			}
+			if out[v]--; out[v] == 0 {
+				queue = append(queue, v)
+				atomic.StoreInt32(&s.label[v], done)
+			}
+		}
+	}
+	remaining := part[:0:0]
+	for _, v := range part {
+		if atomic.LoadInt32(&s.label[v]) == l {
+			remaining = append(remaining, v)
+		}
+	}
+	return remaining
+}
+
+// tarjan finds the strongly connected components of the subgraph
+// induced by part, all of which are labeled l, using Tarjan's
+// algorithm.
+func (s *fwbw) tarjan(l int32, part []int32) {
+	index := make(map[int32]int, len(part))
+	lowLink := make(map[int32]int, len(part))
+	onStack := make(map[int32]bool)
+	var stack []int32
+	var strongconnect func(v int32)
+	strongconnect = func(v int32) {
+		index[v] = len(index)
+		lowLink[v] = index[v]
+		stack = append(stack, v)
+		onStack[v] = true
+		for _, w := range s.succ[v] {
+			if atomic.LoadInt32(&s.label[w]) != l {
+				continue
+			}
+			if _, ok := index[w]; !ok {
+				strongconnect(w)
+				lowLink[v] = min(lowLink[v], lowLink[w])
+			} else if onStack[w] {
+				lowLink[v] = min(lowLink[v], index[w])
+			}
+		}
+		if lowLink[v] == index[v] {
+			var c []int32
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				c = append(c, w)
+				if w == v {
+					break
+				}
+			}
+			minV := slices.Min(c)
+			for _, w := range c {
+				s.comp[w] = minV
+			}
+		}
+	}
+	for _, v := range part {
+		if _, ok := index[v]; !ok {
+			strongconnect(v)
+		}
+	}
+	for _, v := range part {
+		atomic.StoreInt32(&s.label[v], done)
+	}
+}
+
+// componentsFrom returns the nodes grouped by the component labels in
+// label. Since nodes must be sorted by ID, the returned components are
+// ordered by their lowest node ID.
+func componentsFrom(nodes []graph.Node, label []int32) [][]graph.Node {
+	byLabel := make(map[int32][]graph.Node)
+	var labels []int32
+	for i, c := range label {
+		if _, ok := byLabel[c]; !ok {
+			labels = append(labels, c)
+		}
+		byLabel[c] = append(byLabel[c], nodes[i])
+	}
+	cc := make([][]graph.Node, 0, len(labels))
+	for _, c := range labels {
+		cc = append(cc, byLabel[c])
+	}
+	return cc
+}
+
+// parallelFor calls fn on contiguous sub-ranges of [0, n) using
+// up to workers goroutines and waits for all calls to complete.
+func parallelFor(n, workers int, fn func(lo, hi int)) {
+	if n == 0 {
+		return
+	}
+	workers = min(workers, n)
+	if workers == 1 {
+		fn(0, n)
+		return
+	}
+	var wg sync.WaitGroup
+	chunk := (n + workers - 1) / workers
+	for lo := 0; lo < n; lo += chunk {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fn(lo, min(lo+chunk, n))
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,156 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/graphs/gen"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// canonicalComponents returns the IDs of the nodes in c with
+// each component sorted by ID and components sorted by their
+// lowest ID.
+func canonicalComponents(c [][]graph.Node) [][]int64 {
+	ids := make([][]int64, len(c))
+	for i, comp := range c {
+		comp = slices.Clone(comp)
+		order.ByID(comp)
+		for _, n := range comp {
+			ids[i] = append(ids[i], n.ID())
+		}
+	}
+	slices.SortFunc(ids, func(a, b []int64) int { return cmp.Compare(a[0], b[0]) })
+	return ids
+}
+
+var parallelComponentTests = []struct {
+	n int
+	p float64
+}{
+	{n: 0, p: 0},
+	{n: 1, p: 0},
+	{n: 10, p: 0.1},
+	{n: 100, p: 0.01},
+	{n: 100, p: 0.02},
+	{n: 1000, p: 0.001},
+	{n: 1000, p: 0.002},
+	{n: 2000, p: 0.0005},
+}
+
+func TestWeaklyConnectedComponents(t *testing.T) {
+	t.Parallel()
+	for _, test := range parallelComponentTests {
+		g := simple.NewDirectedGraph()
+		err := gen.Gnp(g, test.n, test.p, rand.NewPCG(uint64(test.n), 1))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		u := simple.NewUndirectedGraph()
+		graph.Copy(u, g)
+		want := canonicalComponents(ConnectedComponents(u))
+		if len(want) == 0 {
+			want = nil
+		}
+
+		for _, workers := range []int{0, 1, 2, 8} {
+			got := WeaklyConnectedComponents(g, workers)
+			gotIDs := canonicalComponents(got)
+			if len(gotIDs) == 0 {
+				gotIDs = nil
+			}
+			if !reflect.DeepEqual(gotIDs, want) {
+				t.Errorf("unexpected components: n=%d p=%v workers=%d:\ngot: %v\nwant:%v", test.n, test.p, workers, gotIDs, want)
+			}
+			for i, c := range got {
+				if i != 0 && got[i-1][0].ID() >= c[0].ID() {
+					t.Errorf("components not sorted by lowest ID: n=%d p=%v workers=%d", test.n, test.p, workers)
+				}
+			}
+		}
+	}
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	t.Parallel()
+	for _, test := range tarjanTests {
+		g := simple.NewDirectedGraph()
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+		want := canonicalComponents(TarjanSCC(g))
+		got := StronglyConnectedComponents(g, 0)
+		if gotIDs := canonicalComponents(got); !reflect.DeepEqual(gotIDs, want) {
+			t.Errorf("unexpected components:\ngot: %v\nwant:%v", gotIDs, want)
+		}
+	}
+
+	for _, test := range parallelComponentTests {
+		for _, p := range []float64{test.p, 2 * test.p, 10 * test.p} {
+			g := simple.NewDirectedGraph()
+			err := gen.Gnp(g, test.n, min(p, 1), rand.NewPCG(uint64(test.n), 2))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			want := canonicalComponents(TarjanSCC(g))
+			if len(want) == 0 {
+				want = nil
+			}
+
+			for _, workers := range []int{0, 1, 2, 8} {
+				got := StronglyConnectedComponents(g, workers)
+				gotIDs := canonicalComponents(got)
+				if len(gotIDs) == 0 {
+					gotIDs = nil
+				}
+				if !reflect.DeepEqual(gotIDs, want) {
+					t.Errorf("unexpected components: n=%d p=%v workers=%d:\ngot: %v\nwant:%v", test.n, p, workers, gotIDs, want)
+				}
+				for i, c := range got {
+					if !slices.IsSortedFunc(c, func(a, b graph.Node) int { return cmp.Compare(a.ID(), b.ID()) }) {
+						t.Errorf("component %d not sorted by ID: n=%d p=%v workers=%d", i, test.n, p, workers)
+					}
+					if i != 0 && got[i-1][0].ID() >= c[0].ID() {
+						t.Errorf("components not sorted by lowest ID: n=%d p=%v workers=%d", test.n, p, workers)
+					}
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkStronglyConnectedComponents(b *testing.B) {
+	g := simple.NewDirectedGraph()
+	err := gen.Gnp(g, 10000, 2e-4, rand.NewPCG(1, 1))
+	if err != nil {
+		b.Fatalf("unexpected error: %v", err)
+	}
+	b.Run("TarjanSCC", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			TarjanSCC(g)
+		}
+	})
+	for _, workers := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				StronglyConnectedComponents(g, workers)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package traverse
+
+import (
+	"runtime"
+	"sync"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/internal/set"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// minParallelFrontier is the smallest frontier that will
+// be expanded using more than one goroutine.
+const minParallelFrontier = 256
+
+// ParallelBreadthFirst performs a level-synchronous breadth-first traversal
+// of the graph g starting from the given node, and returns the nodes reachable
+// from the start node grouped by their depth in the traversal. The first level
+// holds only the start node. Nodes within each level are sorted by ID so the
+// returned value does not depend on the number of workers or on the iteration
+// order of g.
+//
+// Each frontier is expanded using up to workers goroutines. If workers is less
+// than one, runtime.GOMAXPROCS(0) is used. The From method of g must be safe for
+// concurrent use by multiple goroutines.
+func ParallelBreadthFirst(g Graph, from graph.Node, workers int) [][]graph.Node {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	visited := make(set.Ints[int64])
+	visited.Add(from.ID())
+	frontier := []graph.Node{from}
+	var levels [][]graph.Node
+	for len(frontier) != 0 {
+		levels = append(levels, frontier)
+
+		// Collect the candidates for the next frontier. The visited
+		// set is only read during this phase so it may be shared
+		// between the workers.
+		n := min(workers, (len(frontier)+minParallelFrontier-1)/minParallelFrontier)
+		next := make([][]graph.Node, n)
+		if n == 1 {
+			next[0] = expandFrontier(g, frontier, visited, nil)
+		} else {
+			var wg sync.WaitGroup
+			chunk := (len(frontier) + n - 1) / n
+			for w := range next {
+				lo := w * chunk
+				hi := min(lo+chunk, len(frontier))
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					next[w] = expandFrontier(g, frontier[lo:hi], visited, nil)
+				}()
+			}
+			wg.Wait()
+		}
+
+		frontier = nil
+		for _, part := range next {
+			for _, v := range part {
+				vid := v.ID()
+				if visited.Has(vid) {
+					continue
+				}
+				visited.Add(vid)
+				frontier = append(frontier, v)
+			}
+		}
+		order.ByID(frontier)
+	}
+	return levels
+}
+
+// expandFrontier appends the unvisited neighbors of the nodes in frontier
+// to dst and returns it. The returned nodes may contain duplicates.
+func expandFrontier(g Graph, frontier []graph.Node, visited set.Ints[int64], dst []graph.Node) []graph.Node {
+	for _, u := range frontier {
+		to := g.From(u.ID())
+		for to.Next() {
+			v := to.Node()
+			if !visited.Has(v.ID()) {
+				dst = append(dst, v)
+			}
+		}
+	}
+	return dst
+}
@@ -0,0 +1,95 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package traverse
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/graphs/gen"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestParallelBreadthFirst(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		n int
+		p float64
+	}{
+		{n: 1, p: 0},
+		{n: 10, p: 0.1},
+		{n: 100, p: 0.02},
+		{n: 2000, p: 0.002},
+		{n: 2000, p: 0.01},
+	} {
+		g := simple.NewDirectedGraph()
+		err := gen.Gnp(g, test.n, test.p, rand.NewPCG(uint64(test.n), 1))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		from := g.Node(0)
+
+		// Calculate the expected depths using the serial traversal.
+		want := make(map[int64]int)
+		var bft BreadthFirst
+		bft.Walk(g, from, func(n graph.Node, d int) bool {
+			want[n.ID()] = d
+			return false
+		})
+
+		var ref [][]graph.Node
+		for _, workers := range []int{0, 1, 2, 8} {
+			levels := ParallelBreadthFirst(g, from, workers)
+			var n int
+			for d, level := range levels {
+				for i, u := range level {
+					if i != 0 && level[i-1].ID() >= u.ID() {
+						t.Errorf("level %d not sorted by ID: n=%d p=%v workers=%d", d, test.n, test.p, workers)
+					}
+					if want[u.ID()] != d {
+						t.Errorf("unexpected depth for node %d: n=%d p=%v workers=%d: got:%d want:%d",
+							u.ID(), test.n, test.p, workers, d, want[u.ID()])
+					}
+					n++
+				}
+			}
+			if n != len(want) {
+				t.Errorf("unexpected number of visited nodes: n=%d p=%v workers=%d: got:%d want:%d",
+					test.n, test.p, workers, n, len(want))
+			}
+			if ref == nil {
+				ref = levels
+			} else if !reflect.DeepEqual(levels, ref) {
+				t.Errorf("result depends on number of workers: n=%d p=%v workers=%d", test.n, test.p, workers)
+			}
+		}
+	}
+}
+
+var gnpDirected_10000_thousandth = gnpDirected(10000, 1e-3)
+
+func gnpDirected(n int, p float64) graph.Directed {
+	g := simple.NewDirectedGraph()
+	err := gen.Gnp(g, n, p, nil)
+	if err != nil {
+		panic(fmt.Sprintf("traverse: bad test: %v", err))
+	}
+	return g
+}
+
+func BenchmarkParallelBreadthFirst(b *testing.B) {
+	g := gnpDirected_10000_thousandth
+	from := g.Node(0)
+	for _, workers := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				ParallelBreadthFirst(g, from, workers)
+			}
+		})
+	}
+}
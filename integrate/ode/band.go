@@ -0,0 +1,114 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import "math"
+
+// band is a square band matrix with kl subdiagonals and ku superdiagonals
+// that can be factorized in place by Gaussian elimination with partial
+// pivoting. Each row holds room for the kl additional superdiagonals
+// filled in by row interchanges during the factorization.
+type band struct {
+	n, kl, ku int
+
+	// a holds the elements of the matrix with the
+	// elements of row r in columns r-kl to r+kl+ku
+	// held in a[r*w:(r+1)*w], where w = 2*kl+ku+1.
+	a []float64
+
+	piv []int
+}
+
+// newBand returns a zero n×n band matrix with kl subdiagonals and ku
+// superdiagonals.
+func newBand(n, kl, ku int) *band {
+	return &band{
+		n:   n,
+		kl:  kl,
+		ku:  ku,
+		a:   make([]float64, n*(2*kl+ku+1)),
+		piv: make([]int, n),
+	}
+}
+
+// index returns the index of the element in row r and column c in b.a.
+func (b *band) index(r, c int) int {
+	return r*(2*b.kl+b.ku+1) + c - r + b.kl
+}
+
+// zero sets all the elements of the matrix to zero.
+func (b *band) zero() {
+	clear(b.a)
+}
+
+// set sets the element in row r and column c to v.
+func (b *band) set(r, c int, v float64) {
+	b.a[b.index(r, c)] = v
+}
+
+// at returns the element in row r and column c.
+func (b *band) at(r, c int) float64 {
+	return b.a[b.index(r, c)]
+}
+
+// factorize computes the LU factorization of the matrix in place. It
+// returns false if the matrix is exactly singular.
+func (b *band) factorize() bool {
+	n := b.n
+	for k := range n {
+		last := min(n-1, k+b.kl)
+		end := min(n-1, k+b.kl+b.ku)
+
+		p := k
+		for r := k + 1; r <= last; r++ {
+			if math.Abs(b.at(r, k)) > math.Abs(b.at(p, k)) {
+				p = r
+			}
+		}
+		if b.at(p, k) == 0 {
+			return false
+		}
+		b.piv[k] = p
+		if p != k {
+			for c := k; c <= end; c++ {
+				i, j := b.index(k, c), b.index(p, c)
+				b.a[i], b.a[j] = b.a[j], b.a[i]
+			}
+		}
+
+		d := b.at(k, k)
+		for r := k + 1; r <= last; r++ {
+			l := b.at(r, k) / d
+			b.set(r, k, l)
+			if l == 0 {
+				continue
+			}
+			for c := k + 1; c <= end; c++ {
+				b.a[b.index(r, c)] -= l * b.at(k, c)
+			}
+		}
+	}
+	return true
+}
+
+// solve solves the system of equations with the factorized matrix and the
+// right-hand side in x, placing the result in x.
+func (b *band) solve(x []float64) {
+	n := b.n
+	for k := range n {
+		if p := b.piv[k]; p != k {
+			x[k], x[p] = x[p], x[k]
+		}
+		for r := k + 1; r <= min(n-1, k+b.kl); r++ {
+			x[r] -= b.at(r, k) * x[k]
+		}
+	}
+	for k := n - 1; k >= 0; k-- {
+		for c := k + 1; c <= min(n-1, k+b.kl+b.ku); c++ {
+			x[k] -= b.at(k, c) * x[c]
+		}
+		x[k] /= b.at(k, k)
+	}
+}
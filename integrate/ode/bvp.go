@@ -0,0 +1,521 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"errors"
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	// ErrMaxNodes is returned by SolveBVP when the number of mesh nodes
+	// required to meet the tolerance exceeds the maximum.
+	ErrMaxNodes = errors.New("ode: maximum number of mesh nodes exceeded")
+
+	// ErrNoConvergence is returned by SolveBVP when the Newton iteration
+	// for the collocation equations fails to converge.
+	ErrNoConvergence = errors.New("ode: Newton iteration did not converge")
+
+	// ErrSingular is returned by SolveBVP when the Jacobian matrix of the
+	// collocation equations is singular.
+	ErrSingular = errors.New("ode: singular collocation Jacobian")
+)
+
+// BVP is a two-point boundary value problem for a system of n first order
+// ordinary differential equations
+//
+//	dy/dx = f(x, y),  a ≤ x ≤ b,
+//
+// with separated boundary conditions
+//
+//	gₐ(y(a)) = 0,  g_b(y(b)) = 0,
+//
+// where gₐ has NumLeft components and g_b has n-NumLeft components.
+type BVP struct {
+	// Func stores f(x, y) in dst. Func must not be nil.
+	Func func(dst []float64, x float64, y []float64)
+
+	// Jacobian stores the n×n Jacobian matrix of f
+	// with respect to y, ∂f/∂y, in dst. If Jacobian
+	// is nil, it is approximated by finite differences.
+	Jacobian func(dst *mat.Dense, x float64, y []float64)
+
+	// Left stores the NumLeft residuals of the
+	// boundary conditions at a, gₐ(ya), in dst.
+	// Left may only be nil if NumLeft is zero.
+	Left    func(dst, ya []float64)
+	NumLeft int
+
+	// Right stores the n-NumLeft residuals of the
+	// boundary conditions at b, g_b(yb), in dst.
+	// Right may only be nil if NumLeft is n.
+	Right func(dst, yb []float64)
+}
+
+// BVPSettings holds the settings for solving a boundary value problem.
+type BVPSettings struct {
+	// Tolerance is the tolerance for the relative
+	// residual of the solution. If Tolerance is
+	// zero, a value of 1e-6 is used.
+	Tolerance float64
+
+	// MaxNodes is the maximum number of mesh nodes.
+	// If MaxNodes is zero, a value of 1000 is used.
+	MaxNodes int
+
+	// MaxIterations is the maximum number of Newton
+	// iterations for the collocation equations on
+	// each mesh. If MaxIterations is zero, a value
+	// of 20 is used.
+	MaxIterations int
+}
+
+// BVPSolution is the solution of a boundary value problem.
+type BVPSolution struct {
+	// X holds the nodes of the final mesh.
+	X []float64
+
+	// Y and DY hold the values of the solution and
+	// its derivative at the nodes, with one row for
+	// each node and one column for each component.
+	Y, DY *mat.Dense
+
+	// Residuals holds the root mean square relative
+	// residual of the differential equations over
+	// each mesh interval.
+	Residuals []float64
+}
+
+// Predict returns the value of the solution at x, calculated by cubic
+// Hermite interpolation of the values and derivatives at the nodes. The
+// solution is extrapolated outside the mesh using the cubic of the nearest
+// interval. If dst is not nil, the result is stored in dst, which must have
+// length equal to the number of components of the solution.
+func (s *BVPSolution) Predict(dst []float64, x float64) []float64 {
+	return s.predict(dst, x, false)
+}
+
+// PredictDerivative returns the derivative of the solution at x, calculated
+// as the derivative of the interpolation used by Predict. If dst is not nil,
+// the result is stored in dst, which must have length equal to the number of
+// components of the solution.
+func (s *BVPSolution) PredictDerivative(dst []float64, x float64) []float64 {
+	return s.predict(dst, x, true)
+}
+
+func (s *BVPSolution) predict(dst []float64, x float64, deriv bool) []float64 {
+	_, n := s.Y.Dims()
+	if dst == nil {
+		dst = make([]float64, n)
+	}
+	if len(dst) != n {
+		panic("ode: destination length mismatch")
+	}
+	i, _ := slices.BinarySearch(s.X, x)
+	i = min(max(i-1, 0), len(s.X)-2)
+	hermite(dst, x, s.X[i], s.X[i+1], s.Y.RawRowView(i), s.Y.RawRowView(i+1), s.DY.RawRowView(i), s.DY.RawRowView(i+1), deriv)
+	return dst
+}
+
+// hermite stores in dst the value, or the derivative if deriv is true, at x
+// of the cubic Hermite interpolant with values y0 and y1 and derivatives f0
+// and f1 at x0 and x1.
+func hermite(dst []float64, x, x0, x1 float64, y0, y1, f0, f1 []float64, deriv bool) {
+	h := x1 - x0
+	t := (x - x0) / h
+	if deriv {
+		a := 6 * t * (t - 1) / h
+		b := (3*t - 1) * (t - 1)
+		c := t * (3*t - 2)
+		for j := range dst {
+			dst[j] = a*(y0[j]-y1[j]) + b*f0[j] + c*f1[j]
+		}
+		return
+	}
+	t2 := t * t
+	t3 := t2 * t
+	h00 := 2*t3 - 3*t2 + 1
+	h10 := (t3 - 2*t2 + t) * h
+	h01 := 1 - h00
+	h11 := (t3 - t2) * h
+	for j := range dst {
+		dst[j] = h00*y0[j] + h10*f0[j] + h01*y1[j] + h11*f1[j]
+	}
+}
+
+// SolveBVP solves the boundary value problem p starting from the mesh x,
+// which spans the interval [a, b], and the initial guess of the solution y,
+// which has one row for each node in x and one column for each component of
+// the solution. If settings is nil, the default settings are used.
+//
+// The differential equations are discretized by the fourth order
+// mono-implicit Runge-Kutta (MIRK) collocation scheme
+//
+//	y_{i+1} - y_i - h/6 (f_i + 4 f(x_i + h/2, y_{i+½}) + f_{i+1}) = 0,
+//	y_{i+½} = (y_i + y_{i+1})/2 - h/8 (f_{i+1} - f_i),
+//
+// where h = x_{i+1} - x_i and f_i = f(x_i, y_i), which is equivalent to
+// collocation by C¹ piecewise cubic polynomials. The resulting system of
+// nonlinear equations, together with the boundary conditions, is solved by a
+// damped Newton method exploiting its banded structure. The mesh is then
+// refined by inserting nodes into each interval in which the root mean square
+// of the relative residual of the differential equations,
+//
+//	(s′(x) - f(x, s(x))) / (1 + |f(x, s(x))|),
+//
+// where s is the piecewise cubic solution, exceeds the tolerance, and the
+// process is repeated until the residual is within tolerance on every
+// interval.
+//
+// SolveBVP returns ErrMaxNodes along with the last solution if the refined
+// mesh would have more than the maximum number of nodes, ErrNoConvergence if
+// the Newton iteration fails to converge and ErrSingular if the Jacobian
+// matrix of the collocation equations is singular.
+//
+// SolveBVP will panic if p.Func is nil, p.NumLeft is not in [0, n], a
+// required boundary condition function is nil, x has fewer than two
+// elements or is not strictly increasing, or y does not have len(x) rows.
+func SolveBVP(p BVP, x []float64, y mat.Matrix, settings *BVPSettings) (*BVPSolution, error) {
+	m, n := y.Dims()
+	if p.Func == nil {
+		panic("ode: nil function")
+	}
+	if p.NumLeft < 0 || n < p.NumLeft {
+		panic("ode: number of left boundary conditions out of range")
+	}
+	if (p.NumLeft != 0 && p.Left == nil) || (p.NumLeft != n && p.Right == nil) {
+		panic("ode: nil boundary condition function")
+	}
+	if len(x) < 2 {
+		panic("ode: too few mesh nodes")
+	}
+	if m != len(x) {
+		panic("ode: initial guess size mismatch")
+	}
+	for i := 1; i < len(x); i++ {
+		if x[i] <= x[i-1] {
+			panic("ode: mesh not strictly increasing")
+		}
+	}
+
+	s := bvpSolver{
+		p:        p,
+		n:        n,
+		tol:      1e-6,
+		maxNodes: 1000,
+		maxIter:  20,
+	}
+	if settings != nil {
+		if settings.Tolerance != 0 {
+			s.tol = settings.Tolerance
+		}
+		if settings.MaxNodes != 0 {
+			s.maxNodes = settings.MaxNodes
+		}
+		if settings.MaxIterations != 0 {
+			s.maxIter = settings.MaxIterations
+		}
+	}
+	s.x = append([]float64(nil), x...)
+	s.y = make([]float64, m*n)
+	for i := range m {
+		mat.Row(s.y[i*n:(i+1)*n], i, y)
+	}
+
+	for {
+		err := s.newton()
+		if err != nil {
+			return nil, err
+		}
+		res := s.residuals()
+		sol := s.solution(res)
+
+		var nx []float64
+		var ny []float64
+		work := make([]float64, n)
+		for i, r := range res {
+			x0, x1 := s.x[i], s.x[i+1]
+			y0, y1 := s.row(s.y, i), s.row(s.y, i+1)
+			nx = append(nx, x0)
+			ny = append(ny, y0...)
+			if r <= s.tol {
+				continue
+			}
+			// Insert one node into intervals with
+			// small residuals and two otherwise.
+			k := 2
+			if r > 100*s.tol {
+				k = 3
+			}
+			f0, f1 := s.row(s.f, i), s.row(s.f, i+1)
+			for j := 1; j < k; j++ {
+				xj := x0 + (x1-x0)*float64(j)/float64(k)
+				hermite(work, xj, x0, x1, y0, y1, f0, f1, false)
+				nx = append(nx, xj)
+				ny = append(ny, work...)
+			}
+		}
+		if len(nx) == len(res) {
+			return sol, nil
+		}
+		if len(nx)+1 > s.maxNodes {
+			return sol, ErrMaxNodes
+		}
+		s.x = append(nx, s.x[len(s.x)-1])
+		s.y = append(ny, s.row(s.y, len(res))...)
+	}
+}
+
+// bvpSolver holds the state of the solution of a boundary value problem on
+// a mesh.
+type bvpSolver struct {
+	p BVP
+	n int
+
+	tol      float64
+	maxNodes int
+	maxIter  int
+
+	// x holds the mesh nodes and y the values of
+	// the solution at the nodes, with the values
+	// at node i in y[i*n:(i+1)*n]. f holds the
+	// values of the differential equation function
+	// at the nodes and ymid and fmid the values of
+	// the solution and function at the midpoints of
+	// the intervals.
+	x, y       []float64
+	f          []float64
+	ymid, fmid []float64
+}
+
+// row returns the values for node i held in v.
+func (s *bvpSolver) row(v []float64, i int) []float64 {
+	return v[i*s.n : (i+1)*s.n]
+}
+
+// collocation stores in dst the residuals of the boundary conditions and the
+// collocation equations for the solution values y, updating s.f, s.ymid and
+// s.fmid.
+func (s *bvpSolver) collocation(dst, y []float64) {
+	n, na := s.n, s.p.NumLeft
+	m := len(s.x)
+	s.f = resize(s.f, m*n)
+	s.ymid = resize(s.ymid, (m-1)*n)
+	s.fmid = resize(s.fmid, (m-1)*n)
+	for i, x := range s.x {
+		s.p.Func(s.row(s.f, i), x, s.row(y, i))
+	}
+	if na != 0 {
+		s.p.Left(dst[:na], s.row(y, 0))
+	}
+	for i := range m - 1 {
+		h := s.x[i+1] - s.x[i]
+		y0, y1 := s.row(y, i), s.row(y, i+1)
+		f0, f1 := s.row(s.f, i), s.row(s.f, i+1)
+		ym, fm := s.row(s.ymid, i), s.row(s.fmid, i)
+		for j := range ym {
+			ym[j] = (y0[j]+y1[j])/2 - h/8*(f1[j]-f0[j])
+		}
+		s.p.Func(fm, s.x[i]+h/2, ym)
+		r := dst[na+i*n : na+(i+1)*n]
+		for j := range r {
+			r[j] = y1[j] - y0[j] - h/6*(f0[j]+4*fm[j]+f1[j])
+		}
+	}
+	if na != n {
+		s.p.Right(dst[na+(m-1)*n:], s.row(y, m-1))
+	}
+}
+
+// jacobian stores the Jacobian of the differential equation function at x
+// and y, where the function value is fy, in dst.
+func (s *bvpSolver) jacobian(dst *mat.Dense, x float64, y, fy []float64) {
+	if s.p.Jacobian != nil {
+		s.p.Jacobian(dst, x, y)
+		return
+	}
+	fd.Jacobian(dst, func(f, y []float64) { s.p.Func(f, x, y) }, y, &fd.JacobianSettings{
+		OriginValue: fy,
+	})
+}
+
+// newton solves the collocation equations on the current mesh, updating
+// s.y, s.f, s.ymid and s.fmid.
+func (s *bvpSolver) newton() error {
+	n, na := s.n, s.p.NumLeft
+	nb := n - na
+	m := len(s.x)
+	size := m * n
+
+	// The equations are ordered as the left boundary
+	// conditions, the collocation equations for each
+	// interval and the right boundary conditions, so
+	// the Jacobian is a band matrix.
+	a := newBand(size, na+n-1, max(2*n-1-na, n-1))
+	res := make([]float64, size)
+	trial := make([]float64, size)
+	step := make([]float64, size)
+	yTrial := make([]float64, size)
+
+	ji := mat.NewDense(n, n, nil)
+	ji1 := mat.NewDense(n, n, nil)
+	jm := mat.NewDense(n, n, nil)
+	var prod mat.Dense
+
+	s.collocation(res, s.y)
+	norm := floats.Norm(res, 2)
+	for range s.maxIter {
+		a.zero()
+		if na != 0 {
+			jb := mat.NewDense(na, n, nil)
+			fd.Jacobian(jb, s.p.Left, s.row(s.y, 0), &fd.JacobianSettings{OriginValue: res[:na]})
+			for r := range na {
+				for c := range n {
+					a.set(r, c, jb.At(r, c))
+				}
+			}
+		}
+		s.jacobian(ji, s.x[0], s.row(s.y, 0), s.row(s.f, 0))
+		for i := range m - 1 {
+			h := s.x[i+1] - s.x[i]
+			s.jacobian(ji1, s.x[i+1], s.row(s.y, i+1), s.row(s.f, i+1))
+			s.jacobian(jm, s.x[i]+h/2, s.row(s.ymid, i), s.row(s.fmid, i))
+
+			// The derivatives of the residual with
+			// respect to y_i and y_{i+1} are
+			//  -I - h/6 J_i - h/3 J_m - h²/12 J_m J_i,
+			//   I - h/6 J_{i+1} - h/3 J_m + h²/12 J_m J_{i+1}.
+			r0 := na + i*n
+			for k, jac := range []*mat.Dense{ji, ji1} {
+				sign := -1.0
+				if k == 1 {
+					sign = 1
+				}
+				prod.Mul(jm, jac)
+				c0 := (i + k) * n
+				for r := range n {
+					for c := range n {
+						v := -h/6*jac.At(r, c) - h/3*jm.At(r, c) + sign*h*h/12*prod.At(r, c)
+						if r == c {
+							v += sign
+						}
+						a.set(r0+r, c0+c, v)
+					}
+				}
+			}
+			ji, ji1 = ji1, ji
+		}
+		if nb != 0 {
+			jb := mat.NewDense(nb, n, nil)
+			fd.Jacobian(jb, s.p.Right, s.row(s.y, m-1), &fd.JacobianSettings{OriginValue: res[size-nb:]})
+			for r := range nb {
+				for c := range n {
+					a.set(size-nb+r, (m-1)*n+c, jb.At(r, c))
+				}
+			}
+		}
+		if !a.factorize() {
+			return ErrSingular
+		}
+		for i, v := range res {
+			step[i] = -v
+		}
+		a.solve(step)
+
+		// Damp the Newton step until the norm
+		// of the residuals decreases.
+		alpha := 1.0
+		var trialNorm float64
+		for range 10 {
+			for i, v := range s.y {
+				yTrial[i] = v + alpha*step[i]
+			}
+			s.collocation(trial, yTrial)
+			trialNorm = floats.Norm(trial, 2)
+			if trialNorm <= (1-alpha/4)*norm || trialNorm == 0 {
+				break
+			}
+			alpha /= 2
+		}
+		if !(trialNorm <= norm) {
+			return ErrNoConvergence
+		}
+
+		var change float64
+		for i, v := range s.y {
+			change = max(change, math.Abs(alpha*step[i])/(1+math.Abs(v)))
+		}
+		s.y, yTrial = yTrial, s.y
+		res, trial = trial, res
+		norm = trialNorm
+		if change <= 1e-3*s.tol {
+			return nil
+		}
+	}
+	return ErrNoConvergence
+}
+
+// residuals returns the root mean square relative residual of the
+// differential equations of the piecewise cubic solution over each interval,
+// calculated using five point Lobatto quadrature. The residual is zero at the
+// nodes.
+func (s *bvpSolver) residuals() []float64 {
+	n := s.n
+	m := len(s.x)
+	res := make([]float64, m-1)
+	v := make([]float64, n)
+	d := make([]float64, n)
+	f := make([]float64, n)
+	for i := range res {
+		x0, x1 := s.x[i], s.x[i+1]
+		y0, y1 := s.row(s.y, i), s.row(s.y, i+1)
+		f0, f1 := s.row(s.f, i), s.row(s.f, i+1)
+		h := x1 - x0
+		mid := x0 + h/2
+		off := h / 2 * math.Sqrt(3.0/7)
+		var sum float64
+		for k, x := range []float64{mid, mid - off, mid + off} {
+			hermite(v, x, x0, x1, y0, y1, f0, f1, false)
+			hermite(d, x, x0, x1, y0, y1, f0, f1, true)
+			s.p.Func(f, x, v)
+			var r2 float64
+			for j := range f {
+				r := (d[j] - f[j]) / (1 + math.Abs(f[j]))
+				r2 += r * r
+			}
+			if k == 0 {
+				sum += 32.0 / 45 * r2
+			} else {
+				sum += 49.0 / 90 * r2
+			}
+		}
+		res[i] = math.Sqrt(sum / 2)
+	}
+	return res
+}
+
+// solution returns the solution on the current mesh.
+func (s *bvpSolver) solution(res []float64) *BVPSolution {
+	m := len(s.x)
+	return &BVPSolution{
+		X:         append([]float64(nil), s.x...),
+		Y:         mat.NewDense(m, s.n, append([]float64(nil), s.y...)),
+		DY:        mat.NewDense(m, s.n, append([]float64(nil), s.f...)),
+		Residuals: res,
+	}
+}
+
+// resize returns a slice of length n, reusing the storage of v if possible.
+func resize(v []float64, n int) []float64 {
+	if cap(v) < n {
+		return make([]float64, n)
+	}
+	return v[:n]
+}
@@ -0,0 +1,310 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// linspace returns n evenly spaced values from a to b.
+func linspace(a, b float64, n int) []float64 {
+	x := make([]float64, n)
+	floats.Span(x, a, b)
+	return x
+}
+
+// bratuTheta returns the θ of the lower branch solution of Bratu's problem
+//
+//	y'' + λ exp(y) = 0, y(0) = y(1) = 0,
+//
+// given by θ = sqrt(2λ) cosh(θ/4).
+func bratuTheta(lambda float64) float64 {
+	theta := 1.0
+	for range 100 {
+		g := theta - math.Sqrt(2*lambda)*math.Cosh(theta/4)
+		dg := 1 - math.Sqrt(2*lambda)*math.Sinh(theta/4)/4
+		theta -= g / dg
+	}
+	return theta
+}
+
+var bvpTests = []struct {
+	name    string
+	p       BVP
+	a, b    float64
+	nodes   int
+	guess   func(x float64) []float64
+	want    func(x float64) float64
+	refined bool
+}{
+	{
+		name: "sine",
+		p: BVP{
+			Func: func(dst []float64, _ float64, y []float64) {
+				dst[0] = y[1]
+				dst[1] = -y[0]
+			},
+			Left:    func(dst, ya []float64) { dst[0] = ya[0] },
+			NumLeft: 1,
+			Right:   func(dst, yb []float64) { dst[0] = yb[0] - 1 },
+		},
+		a:     0,
+		b:     math.Pi / 2,
+		nodes: 5,
+		guess: func(float64) []float64 { return []float64{0, 0} },
+		want:  math.Sin,
+	},
+	{
+		name: "bratu",
+		p: BVP{
+			Func: func(dst []float64, _ float64, y []float64) {
+				dst[0] = y[1]
+				dst[1] = -math.Exp(y[0])
+			},
+			Left:    func(dst, ya []float64) { dst[0] = ya[0] },
+			NumLeft: 1,
+			Right:   func(dst, yb []float64) { dst[0] = yb[0] },
+		},
+		a:     0,
+		b:     1,
+		nodes: 5,
+		guess: func(float64) []float64 { return []float64{0, 0} },
+		want: func(x float64) float64 {
+			theta := bratuTheta(1)
+			return -2 * math.Log(math.Cosh((x-0.5)*theta/2)/math.Cosh(theta/4))
+		},
+	},
+	{
+		// A boundary layer at x = 0 of width 0.01
+		// that is not resolved by the initial mesh.
+		name: "boundary layer",
+		p: BVP{
+			Func: func(dst []float64, _ float64, y []float64) {
+				dst[0] = y[1]
+				dst[1] = 1e4 * y[0]
+			},
+			Left:    func(dst, ya []float64) { dst[0] = ya[0] - 1 },
+			NumLeft: 1,
+			Right:   func(dst, yb []float64) { dst[0] = yb[0] },
+		},
+		a:     0,
+		b:     1,
+		nodes: 11,
+		guess: func(x float64) []float64 { return []float64{1 - x, -1} },
+		want: func(x float64) float64 {
+			return math.Sinh(100*(1-x)) / math.Sinh(100)
+		},
+		refined: true,
+	},
+	{
+		name: "both conditions left",
+		p: BVP{
+			Func: func(dst []float64, _ float64, y []float64) {
+				dst[0] = y[1]
+				dst[1] = -y[0]
+			},
+			Left: func(dst, ya []float64) {
+				dst[0] = ya[0]
+				dst[1] = ya[1] - 1
+			},
+			NumLeft: 2,
+		},
+		a:     0,
+		b:     3,
+		nodes: 4,
+		guess: func(float64) []float64 { return []float64{0, 0} },
+		want:  math.Sin,
+	},
+	{
+		name: "both conditions right",
+		p: BVP{
+			Func: func(dst []float64, _ float64, y []float64) {
+				dst[0] = y[1]
+				dst[1] = -y[0]
+			},
+			Right: func(dst, yb []float64) {
+				dst[0] = yb[0] - math.Sin(2)
+				dst[1] = yb[1] - math.Cos(2)
+			},
+		},
+		a:     0,
+		b:     2,
+		nodes: 4,
+		guess: func(float64) []float64 { return []float64{0, 0} },
+		want:  math.Sin,
+	},
+}
+
+func TestSolveBVP(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-6
+	for _, test := range bvpTests {
+		for _, analytic := range []bool{false, true} {
+			p := test.p
+			if analytic {
+				// Supply the Jacobian calculated
+				// by central differences.
+				f := p.Func
+				p.Jacobian = func(dst *mat.Dense, x float64, y []float64) {
+					const h = 1e-6
+					n := len(y)
+					yy := make([]float64, n)
+					fp := make([]float64, n)
+					fm := make([]float64, n)
+					for j := range n {
+						copy(yy, y)
+						yy[j] += h
+						f(fp, x, yy)
+						yy[j] -= 2 * h
+						f(fm, x, yy)
+						for i := range n {
+							dst.Set(i, j, (fp[i]-fm[i])/(2*h))
+						}
+					}
+				}
+			}
+			x := linspace(test.a, test.b, test.nodes)
+			y := mat.NewDense(len(x), 2, nil)
+			for i, v := range x {
+				y.SetRow(i, test.guess(v))
+			}
+			sol, err := SolveBVP(p, x, y, &BVPSettings{Tolerance: tol})
+			if err != nil {
+				t.Errorf("unexpected error for %s: %v", test.name, err)
+				continue
+			}
+			if len(sol.Residuals) != len(sol.X)-1 {
+				t.Errorf("unexpected number of residuals for %s: got %d, want %d", test.name, len(sol.Residuals), len(sol.X)-1)
+			}
+			for i, r := range sol.Residuals {
+				if r > tol {
+					t.Errorf("residual out of tolerance for %s in interval %d: %v", test.name, i, r)
+				}
+			}
+			if test.refined && len(sol.X) <= test.nodes {
+				t.Errorf("expected mesh refinement for %s", test.name)
+			}
+
+			var maxErr float64
+			for _, v := range linspace(test.a, test.b, 101) {
+				got := sol.Predict(nil, v)[0]
+				maxErr = max(maxErr, math.Abs(got-test.want(v)))
+			}
+			if maxErr > 1e-5 {
+				t.Errorf("unexpected solution error for %s: %v", test.name, maxErr)
+			}
+
+			// The interpolant is consistent with
+			// the values at the nodes.
+			for i, v := range sol.X {
+				if !floats.EqualApprox(sol.Predict(nil, v), sol.Y.RawRowView(i), 1e-14) {
+					t.Errorf("unexpected value at node %d for %s", i, test.name)
+				}
+				if !floats.EqualApprox(sol.PredictDerivative(nil, v), sol.DY.RawRowView(i), 1e-12) {
+					t.Errorf("unexpected derivative at node %d for %s", i, test.name)
+				}
+			}
+		}
+	}
+}
+
+func TestSolveBVPMaxNodes(t *testing.T) {
+	t.Parallel()
+	test := bvpTests[2]
+	x := linspace(test.a, test.b, test.nodes)
+	y := mat.NewDense(len(x), 2, nil)
+	for i, v := range x {
+		y.SetRow(i, test.guess(v))
+	}
+	sol, err := SolveBVP(test.p, x, y, &BVPSettings{Tolerance: 1e-6, MaxNodes: 20})
+	if err != ErrMaxNodes {
+		t.Fatalf("unexpected error: got %v, want %v", err, ErrMaxNodes)
+	}
+	if sol == nil || len(sol.X) > 20 {
+		t.Errorf("unexpected solution returned with ErrMaxNodes")
+	}
+}
+
+func TestBand(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		n, kl, ku int
+	}{
+		{n: 1, kl: 0, ku: 0},
+		{n: 5, kl: 1, ku: 1},
+		{n: 10, kl: 3, ku: 2},
+		{n: 10, kl: 0, ku: 4},
+		{n: 12, kl: 4, ku: 0},
+		{n: 20, kl: 5, ku: 7},
+	} {
+		a := newBand(test.n, test.kl, test.ku)
+		dense := mat.NewDense(test.n, test.n, nil)
+		for r := range test.n {
+			for c := max(0, r-test.kl); c <= min(test.n-1, r+test.ku); c++ {
+				v := rnd.NormFloat64()
+				a.set(r, c, v)
+				dense.Set(r, c, v)
+			}
+		}
+		b := make([]float64, test.n)
+		for i := range b {
+			b[i] = rnd.NormFloat64()
+		}
+		var want mat.VecDense
+		err := want.SolveVec(dense, mat.NewVecDense(test.n, b))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !a.factorize() {
+			t.Errorf("unexpected singular matrix for n=%d kl=%d ku=%d", test.n, test.kl, test.ku)
+			continue
+		}
+		a.solve(b)
+		if !floats.EqualApprox(b, want.RawVector().Data, 1e-10) {
+			t.Errorf("unexpected solution for n=%d kl=%d ku=%d:\ngot: %v\nwant:%v", test.n, test.kl, test.ku, b, want.RawVector().Data)
+		}
+	}
+}
+
+func TestSolveBVPPanics(t *testing.T) {
+	t.Parallel()
+	p := bvpTests[0].p
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "nil function", fn: func() {
+			SolveBVP(BVP{NumLeft: 2, Left: p.Left}, []float64{0, 1}, mat.NewDense(2, 2, nil), nil)
+		}},
+		{name: "negative left count", fn: func() {
+			q := p
+			q.NumLeft = -1
+			SolveBVP(q, []float64{0, 1}, mat.NewDense(2, 2, nil), nil)
+		}},
+		{name: "nil right", fn: func() {
+			q := p
+			q.Right = nil
+			SolveBVP(q, []float64{0, 1}, mat.NewDense(2, 2, nil), nil)
+		}},
+		{name: "single node", fn: func() { SolveBVP(p, []float64{0}, mat.NewDense(1, 2, nil), nil) }},
+		{name: "guess size", fn: func() { SolveBVP(p, []float64{0, 1, 2}, mat.NewDense(2, 2, nil), nil) }},
+		{name: "decreasing mesh", fn: func() { SolveBVP(p, []float64{0, 2, 1}, mat.NewDense(3, 2, nil), nil) }},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic for %s", test.name)
+				}
+			}()
+			test.fn()
+		}()
+	}
+}
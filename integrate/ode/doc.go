@@ -0,0 +1,7 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package ode provides numerical solution of systems of ordinary differential
+// equations.
+package ode // import "gonum.org/v1/gonum/integrate/ode"
@@ -0,0 +1,228 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+// SeparableHamiltonian is a Hamiltonian system with generalized coordinates
+// q and conjugate momenta p whose Hamiltonian is the sum of a kinetic energy
+// depending only on the momenta and a potential energy depending only on the
+// coordinates,
+//
+//	H(q, p) = T(p) + V(q).
+//
+// The equations of motion of the system are
+//
+//	dq/dt = ∂T/∂p,  dp/dt = -∂V/∂q.
+type SeparableHamiltonian struct {
+	// Velocity stores ∂T/∂p, the rate of change of
+	// the coordinates, for the momenta p in dst.
+	// If Velocity is nil, the kinetic energy is
+	// taken to be T(p) = |p|²/2, so dq/dt = p.
+	Velocity func(dst, p []float64)
+
+	// Force stores -∂V/∂q, the rate of change of
+	// the momenta, for the coordinates q in dst.
+	// Force must not be nil.
+	Force func(dst, q []float64)
+
+	// Kinetic and Potential return the kinetic
+	// and potential energies T(p) and V(q). They
+	// are only used by Energy. If Kinetic is nil,
+	// the kinetic energy is taken to be |p|²/2.
+	Kinetic   func(p []float64) float64
+	Potential func(q []float64) float64
+}
+
+// Energy returns the value of the Hamiltonian, the total energy of the
+// system, at the coordinates q and momenta p. Energy will panic if Potential
+// is nil.
+func (h *SeparableHamiltonian) Energy(q, p []float64) float64 {
+	if h.Potential == nil {
+		panic("ode: nil potential energy function")
+	}
+	var t float64
+	if h.Kinetic == nil {
+		t = floats.Dot(p, p) / 2
+	} else {
+		t = h.Kinetic(p)
+	}
+	return t + h.Potential(q)
+}
+
+// Method is a symplectic splitting method for the integration of separable
+// Hamiltonian systems. A step of a splitting method is a sequence of
+// alternating drift substeps, which advance the coordinates using the
+// velocity at fixed momenta, and kick substeps, which advance the momenta
+// using the force at fixed coordinates. Each substep is an exact flow of part
+// of the Hamiltonian, so the method conserves the symplectic structure of
+// the system, and the energy error of the numerical solution remains bounded
+// over long times instead of drifting as it does for general purpose
+// methods such as explicit Runge-Kutta methods.
+type Method struct {
+	// drift and kick hold the coefficients of
+	// the substeps of a step, in the order
+	//  drift[0], kick[0], drift[1], ..., kick[s-1], drift[s].
+	drift, kick []float64
+
+	order int
+}
+
+var (
+	// StormerVerlet is the second order Störmer-Verlet method in its
+	// drift-kick-drift form, also known as position Verlet or leapfrog,
+	// requiring one force evaluation per step.
+	StormerVerlet = Method{
+		drift: []float64{0.5, 0.5},
+		kick:  []float64{1},
+		order: 2,
+	}
+
+	// VelocityVerlet is the second order velocity Verlet method, the
+	// kick-drift-kick form of the Störmer-Verlet method, requiring two
+	// force evaluations per step.
+	VelocityVerlet = Method{
+		drift: []float64{0, 1, 0},
+		kick:  []float64{0.5, 0.5},
+		order: 2,
+	}
+)
+
+// Order returns the order of accuracy of the method.
+func (m Method) Order() int { return m.order }
+
+// Evaluations returns the number of evaluations of the force performed by
+// a step of the method.
+func (m Method) Evaluations() int {
+	var n int
+	for _, k := range m.kick {
+		if k != 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// Yoshida returns the symplectic method of the given order constructed from
+// the second order method base by Yoshida's recursive triple jump
+// composition. A method of order k+2 is obtained from a method S of order k
+// as the composition
+//
+//	S(w₁·dt) S(w₀·dt) S(w₁·dt),
+//
+// with w₁ = 1/(2 - 2^(1/(k+1))) and w₀ = 1 - 2w₁, so a method of order k
+// requires 3^(k/2-1) steps of base. Consecutive drift substeps are merged.
+// The constructed methods have negative substeps, which are of no concern
+// for Hamiltonian systems.
+//
+// Yoshida will panic if order is not an even number greater than or equal
+// to the order of base.
+func Yoshida(base Method, order int) Method {
+	if order < base.order || order%2 != 0 {
+		panic("ode: invalid method order")
+	}
+	m := base
+	for m.order < order {
+		w1 := 1 / (2 - math.Pow(2, 1/float64(m.order+1)))
+		w0 := 1 - 2*w1
+		k := m.order
+		m = compose([]Method{m, m, m}, []float64{w1, w0, w1})
+		m.order = k + 2
+	}
+	return m
+}
+
+// compose returns the method formed by the sequence of steps of the methods
+// in ms with the step sizes scaled by the weights in w. The order of the
+// returned method is not set.
+func compose(ms []Method, w []float64) Method {
+	c := Method{drift: []float64{0}}
+	for i, m := range ms {
+		c.drift[len(c.drift)-1] += w[i] * m.drift[0]
+		for j, k := range m.kick {
+			if n := len(c.kick); n != 0 && c.drift[n] == 0 {
+				// Merge kicks that are not separated
+				// by a drift.
+				c.kick[n-1] += w[i] * k
+				c.drift[n] = w[i] * m.drift[j+1]
+				continue
+			}
+			c.kick = append(c.kick, w[i]*k)
+			c.drift = append(c.drift, w[i]*m.drift[j+1])
+		}
+	}
+	return c
+}
+
+// Symplectic integrates a separable Hamiltonian system using a symplectic
+// splitting method with fixed step size.
+type Symplectic struct {
+	system SeparableHamiltonian
+	method Method
+
+	work []float64
+}
+
+// NewSymplectic returns a Symplectic that integrates the system sys using
+// the method m. NewSymplectic will panic if sys.Force is nil.
+func NewSymplectic(sys SeparableHamiltonian, m Method) *Symplectic {
+	if sys.Force == nil {
+		panic("ode: nil force function")
+	}
+	return &Symplectic{system: sys, method: m}
+}
+
+// Step advances the state of the system with coordinates q and momenta p by
+// one step of size dt, updating q and p in place. A negative dt integrates
+// backward in time. The methods are time-reversible, so a step of size dt
+// followed by a step of size -dt returns to the initial state up to
+// rounding error.
+//
+// Step will panic if the lengths of q and p are not equal.
+func (s *Symplectic) Step(q, p []float64, dt float64) {
+	if len(q) != len(p) {
+		panic("ode: dimension mismatch")
+	}
+	if cap(s.work) < len(q) {
+		s.work = make([]float64, len(q))
+	}
+	work := s.work[:len(q)]
+	m := s.method
+	for i, k := range m.kick {
+		s.drift(q, p, m.drift[i]*dt, work)
+		if k != 0 {
+			s.system.Force(work, q)
+			floats.AddScaled(p, k*dt, work)
+		}
+	}
+	s.drift(q, p, m.drift[len(m.kick)]*dt, work)
+}
+
+// Steps advances the state of the system with coordinates q and momenta p by
+// n steps of size dt, updating q and p in place.
+//
+// Steps will panic if the lengths of q and p are not equal.
+func (s *Symplectic) Steps(q, p []float64, dt float64, n int) {
+	for range n {
+		s.Step(q, p, dt)
+	}
+}
+
+// drift advances the coordinates q by h using the velocity at the momenta p.
+func (s *Symplectic) drift(q, p []float64, h float64, work []float64) {
+	if h == 0 {
+		return
+	}
+	if s.system.Velocity == nil {
+		floats.AddScaled(q, h, p)
+		return
+	}
+	s.system.Velocity(work, p)
+	floats.AddScaled(q, h, work)
+}
@@ -0,0 +1,41 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode_test
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/gonum/integrate/ode"
+)
+
+func ExampleSymplectic() {
+	// A pendulum with unit length and mass in unit
+	// gravity, with the angle from the vertical as
+	// its coordinate.
+	pendulum := ode.SeparableHamiltonian{
+		Force: func(dst, q []float64) {
+			dst[0] = -math.Sin(q[0])
+		},
+		Potential: func(q []float64) float64 {
+			return 1 - math.Cos(q[0])
+		},
+	}
+
+	q := []float64{2}
+	p := []float64{0}
+	e0 := pendulum.Energy(q, p)
+
+	s := ode.NewSymplectic(pendulum, ode.Yoshida(ode.VelocityVerlet, 4))
+	var maxErr float64
+	for range 10000 {
+		s.Step(q, p, 0.05)
+		maxErr = max(maxErr, math.Abs(pendulum.Energy(q, p)-e0))
+	}
+	fmt.Printf("relative energy error after 10000 steps < 1e-5: %t\n", maxErr/e0 < 1e-5)
+
+	// Output:
+	// relative energy error after 10000 steps < 1e-5: true
+}
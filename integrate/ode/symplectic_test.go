@@ -0,0 +1,219 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+var harmonic = SeparableHamiltonian{
+	Force: func(dst, q []float64) {
+		for i, v := range q {
+			dst[i] = -v
+		}
+	},
+	Potential: func(q []float64) float64 { return floats.Dot(q, q) / 2 },
+}
+
+// kepler returns the Kepler problem of a body with unit mass in the
+// gravitational field of a body of unit mass fixed at the origin.
+func kepler() SeparableHamiltonian {
+	return SeparableHamiltonian{
+		Velocity: func(dst, p []float64) { copy(dst, p) },
+		Force: func(dst, q []float64) {
+			r := math.Hypot(q[0], q[1])
+			r3 := r * r * r
+			dst[0] = -q[0] / r3
+			dst[1] = -q[1] / r3
+		},
+		Kinetic:   func(p []float64) float64 { return floats.Dot(p, p) / 2 },
+		Potential: func(q []float64) float64 { return -1 / math.Hypot(q[0], q[1]) },
+	}
+}
+
+var symplecticMethods = []struct {
+	name        string
+	method      Method
+	order       int
+	evaluations int
+}{
+	{name: "StormerVerlet", method: StormerVerlet, order: 2, evaluations: 1},
+	{name: "VelocityVerlet", method: VelocityVerlet, order: 2, evaluations: 2},
+	{name: "Yoshida4 StormerVerlet", method: Yoshida(StormerVerlet, 4), order: 4, evaluations: 3},
+	{name: "Yoshida4 VelocityVerlet", method: Yoshida(VelocityVerlet, 4), order: 4, evaluations: 4},
+	{name: "Yoshida6 StormerVerlet", method: Yoshida(StormerVerlet, 6), order: 6, evaluations: 9},
+	{name: "Yoshida8 VelocityVerlet", method: Yoshida(VelocityVerlet, 8), order: 8, evaluations: 28},
+}
+
+func TestSymplecticOrder(t *testing.T) {
+	t.Parallel()
+	for _, test := range symplecticMethods {
+		m := test.method
+		if m.Order() != test.order {
+			t.Errorf("unexpected order for %s: got %d, want %d", test.name, m.Order(), test.order)
+		}
+		if m.Evaluations() != test.evaluations {
+			t.Errorf("unexpected number of evaluations for %s: got %d, want %d", test.name, m.Evaluations(), test.evaluations)
+		}
+		var sum float64
+		for _, d := range m.drift {
+			sum += d
+		}
+		if !scalar.EqualWithinAbs(sum, 1, 1e-14) {
+			t.Errorf("unexpected sum of drift coefficients for %s: got %v, want 1", test.name, sum)
+		}
+		sum = 0
+		for _, k := range m.kick {
+			sum += k
+		}
+		if !scalar.EqualWithinAbs(sum, 1, 1e-14) {
+			t.Errorf("unexpected sum of kick coefficients for %s: got %v, want 1", test.name, sum)
+		}
+
+		// The global error of a method of order k for
+		// the harmonic oscillator at a fixed time falls
+		// by 2^k when the step size is halved.
+		const end = 1.0
+		s := NewSymplectic(harmonic, m)
+		errs := make([]float64, 2)
+		for i, n := range []int{8, 16} {
+			q := []float64{1}
+			p := []float64{0}
+			s.Steps(q, p, end/float64(n), n)
+			errs[i] = math.Hypot(q[0]-math.Cos(end), p[0]+math.Sin(end))
+		}
+		got := math.Log2(errs[0] / errs[1])
+		if math.Abs(got-float64(test.order)) > 0.2 {
+			t.Errorf("unexpected convergence order for %s: got %v, want %d", test.name, got, test.order)
+		}
+	}
+}
+
+func TestSymplecticComposition(t *testing.T) {
+	t.Parallel()
+	// A step of the fourth order method is three
+	// steps of the base method of the triple jump.
+	const dt = 0.1
+	w1 := 1 / (2 - math.Cbrt(2))
+	w0 := 1 - 2*w1
+	for _, base := range []Method{StormerVerlet, VelocityVerlet} {
+		sys := kepler()
+		q := []float64{0.5, 0}
+		p := []float64{0, math.Sqrt(3)}
+		s := NewSymplectic(sys, Yoshida(base, 4))
+		s.Step(q, p, dt)
+
+		wantQ := []float64{0.5, 0}
+		wantP := []float64{0, math.Sqrt(3)}
+		b := NewSymplectic(sys, base)
+		for _, w := range []float64{w1, w0, w1} {
+			b.Step(wantQ, wantP, w*dt)
+		}
+		if !floats.EqualApprox(q, wantQ, 1e-14) || !floats.EqualApprox(p, wantP, 1e-14) {
+			t.Errorf("unexpected composed step: got q=%v p=%v, want q=%v p=%v", q, p, wantQ, wantP)
+		}
+	}
+}
+
+func TestSymplecticEnergy(t *testing.T) {
+	t.Parallel()
+	// An orbit of the Kepler problem with
+	// eccentricity 0.5 and period 2π.
+	const (
+		dt    = 0.01
+		steps = 20000
+	)
+	sys := kepler()
+	for _, test := range symplecticMethods {
+		q := []float64{0.5, 0}
+		p := []float64{0, math.Sqrt(3)}
+		e0 := sys.Energy(q, p)
+		s := NewSymplectic(sys, test.method)
+
+		// The energy error of a symplectic method
+		// oscillates without drifting, so the error
+		// in the second half of the integration is
+		// no larger than in the first half.
+		var first, second float64
+		for i := range steps {
+			s.Step(q, p, dt)
+			err := math.Abs(sys.Energy(q, p) - e0)
+			if i < steps/2 {
+				first = max(first, err)
+			} else {
+				second = max(second, err)
+			}
+		}
+		// The error of the higher order methods
+		// is limited by rounding.
+		tol := max(math.Pow(dt, float64(test.order))*1e3, 1e-10)
+		if first > tol {
+			t.Errorf("unexpected energy error for %s: got %v, want less than %v", test.name, first, tol)
+		}
+		if second > 1.5*first+1e-12 {
+			t.Errorf("energy drift for %s: error %v in first half, %v in second half", test.name, first, second)
+		}
+	}
+}
+
+func TestSymplecticReversible(t *testing.T) {
+	t.Parallel()
+	const (
+		dt    = 0.05
+		steps = 100
+	)
+	sys := kepler()
+	for _, test := range symplecticMethods {
+		q := []float64{0.5, 0}
+		p := []float64{0, math.Sqrt(3)}
+		s := NewSymplectic(sys, test.method)
+		s.Steps(q, p, dt, steps)
+		s.Steps(q, p, -dt, steps)
+		if !floats.EqualApprox(q, []float64{0.5, 0}, 1e-9) || !floats.EqualApprox(p, []float64{0, math.Sqrt(3)}, 1e-9) {
+			t.Errorf("unexpected state after reversal for %s: got q=%v p=%v", test.name, q, p)
+		}
+	}
+}
+
+func TestSymplecticPanics(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "odd order", fn: func() { Yoshida(StormerVerlet, 5) }},
+		{name: "low order", fn: func() { Yoshida(Yoshida(StormerVerlet, 4), 2) }},
+		{name: "nil force", fn: func() { NewSymplectic(SeparableHamiltonian{}, StormerVerlet) }},
+		{name: "nil potential", fn: func() { (&SeparableHamiltonian{}).Energy(nil, nil) }},
+		{name: "dimension mismatch", fn: func() { NewSymplectic(harmonic, StormerVerlet).Step([]float64{1}, []float64{1, 2}, 1) }},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic for %s", test.name)
+				}
+			}()
+			test.fn()
+		}()
+	}
+}
+
+func BenchmarkSymplectic(b *testing.B) {
+	sys := kepler()
+	for _, test := range symplecticMethods {
+		b.Run(test.name, func(b *testing.B) {
+			q := []float64{0.5, 0}
+			p := []float64{0, math.Sqrt(3)}
+			s := NewSymplectic(sys, test.method)
+			for b.Loop() {
+				s.Step(q, p, 1e-3)
+			}
+		})
+	}
+}
@@ -45,3 +45,17 @@ func Example() {
 	// Estimate using parallel evaluations of f.
 	// EV = 4.19064
 }
+
+func ExampleLaguerre() {
+	fmt.Println("Evaluate the expected value of x^2 + 3 under a Gamma(2.5, 1) distribution")
+	// The Gamma density is proportional to the generalized Laguerre
+	// weight x^1.5 e^(-x), so the rule integrates the polynomial exactly.
+	f := func(x float64) float64 {
+		return (x*x + 3) / math.Gamma(2.5)
+	}
+	ev := quad.Fixed(f, 0, math.Inf(1), 2, quad.Laguerre{Alpha: 1.5}, 0)
+	fmt.Printf("EV with 2 points = %0.6v\n", ev)
+	// Output:
+	// Evaluate the expected value of x^2 + 3 under a Gamma(2.5, 1) distribution
+	// EV with 2 points = 11.75
+}
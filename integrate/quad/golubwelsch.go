@@ -0,0 +1,101 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import (
+	"sync"
+
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/gonum"
+)
+
+// GolubWelsch computes the locations and weights of the Gaussian quadrature
+// rule for a weight function w and stores them in-place into x and weight,
+// so that
+//
+//	int w(x) f(x) dx ≈ \sum_i weight_i f(x_i)
+//
+// is exact for polynomials f of degree up to 2n-1, where n is len(x).
+// The weight function is specified by the three-term recurrence of its
+// monic orthogonal polynomials
+//
+//	p_{k+1}(x) = (x - a_k) p_k(x) - b_k^2 p_{k-1}(x),
+//
+// with a holding a_0, ..., a_{n-1}, b holding the positive values b_1, ...,
+// b_{n-1}, and by the integral of the weight function mu0.
+// The locations are returned in increasing order.
+//
+// GolubWelsch panics if len(weight) or len(a) is not len(x), or if len(b)
+// is not len(x)-1.
+//
+// References:
+//
+//	G. H. Golub and J. H. Welsch, "Calculation of Gauss quadrature rules",
+//	Math. Comp. 23:221-230, 1969.
+func GolubWelsch(x, weight, a, b []float64, mu0 float64) {
+	n := len(x)
+	switch {
+	case len(weight) != n, len(a) != n:
+		panic("quad: slice length mismatch")
+	case n == 0:
+		return
+	case len(b) != n-1:
+		panic("quad: slice length mismatch")
+	}
+
+	// The locations are the eigenvalues of the symmetric
+	// tridiagonal Jacobi matrix of the recurrence, and the
+	// weights are mu0 times the squares of the first
+	// components of the normalized eigenvectors.
+	copy(x, a)
+	e := make([]float64, n-1)
+	copy(e, b)
+	z := make([]float64, n*n)
+	work := make([]float64, max(1, 2*n-2))
+	ok := gonum.Implementation{}.Dsteqr(lapack.EVTridiag, n, x, e, z, n, work)
+	if !ok {
+		panic("quad: eigendecomposition failed")
+	}
+	for i := range weight {
+		weight[i] = mu0 * z[i] * z[i]
+	}
+}
+
+// ruleKey identifies a cached quadrature rule on the standard interval of
+// a family of weight functions.
+type ruleKey struct {
+	family      string
+	alpha, beta float64
+	n           int
+}
+
+// rule is a cached quadrature rule.
+type rule struct {
+	x, weight []float64
+}
+
+var ruleCache = struct {
+	sync.Mutex
+	rules map[ruleKey]rule
+}{rules: make(map[ruleKey]rule)}
+
+// cachedRule returns the quadrature rule for key, computing it with gen and
+// storing it in the cache if it has not been computed before. The returned
+// slices must not be modified.
+func cachedRule(key ruleKey, gen func(x, weight []float64)) (x, weight []float64) {
+	ruleCache.Lock()
+	r, ok := ruleCache.rules[key]
+	ruleCache.Unlock()
+	if ok {
+		return r.x, r.weight
+	}
+	x = make([]float64, key.n)
+	weight = make([]float64, key.n)
+	gen(x, weight)
+	ruleCache.Lock()
+	ruleCache.rules[key] = rule{x: x, weight: weight}
+	ruleCache.Unlock()
+	return x, weight
+}
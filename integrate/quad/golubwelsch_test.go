@@ -0,0 +1,163 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import (
+	"math"
+	"slices"
+	"sync"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestGolubWelsch(t *testing.T) {
+	t.Parallel()
+	// Compare with the Legendre and Hermite rules, whose
+	// monic recurrences have a_k = 0 and b_k^2 = k^2/(4k^2-1)
+	// and b_k^2 = k/2 respectively.
+	for _, n := range []int{1, 2, 5, 20, 50} {
+		a := make([]float64, n)
+		bLeg := make([]float64, n-1)
+		bHerm := make([]float64, n-1)
+		for k := 1; k < n; k++ {
+			fk := float64(k)
+			bLeg[k-1] = fk / math.Sqrt(4*fk*fk-1)
+			bHerm[k-1] = math.Sqrt(fk / 2)
+		}
+
+		x := make([]float64, n)
+		w := make([]float64, n)
+		wantX := make([]float64, n)
+		wantW := make([]float64, n)
+		GolubWelsch(x, w, a, bLeg, 2)
+		Legendre{}.FixedLocations(wantX, wantW, -1, 1)
+		// The Legendre locations are in decreasing order.
+		slices.Reverse(wantX)
+		slices.Reverse(wantW)
+		if !floats.EqualApprox(x, wantX, 1e-13) || !floats.EqualApprox(w, wantW, 1e-13) {
+			t.Errorf("n=%d: Legendre mismatch:\ngot x=%v w=%v\nwant x=%v w=%v", n, x, w, wantX, wantW)
+		}
+
+		if n > 20 {
+			// The Hermite rule uses an asymptotic
+			// expansion for larger n.
+			continue
+		}
+		GolubWelsch(x, w, a, bHerm, math.SqrtPi)
+		Hermite{}.FixedLocations(wantX, wantW, math.Inf(-1), math.Inf(1))
+		if !floats.EqualApprox(x, wantX, 1e-12) || !floats.EqualApprox(w, wantW, 1e-12) {
+			t.Errorf("n=%d: Hermite mismatch:\ngot x=%v w=%v\nwant x=%v w=%v", n, x, w, wantX, wantW)
+		}
+	}
+}
+
+func TestLaguerre(t *testing.T) {
+	t.Parallel()
+	for _, alpha := range []float64{0, 0.5, -0.5, 2.3} {
+		for _, n := range []int{1, 3, 8, 15} {
+			x := make([]float64, n)
+			w := make([]float64, n)
+			const min = 1.5
+			Laguerre{Alpha: alpha}.FixedLocations(x, w, min, math.Inf(1))
+			for i, v := range x {
+				if v <= min || (i > 0 && v <= x[i-1]) {
+					t.Errorf("alpha=%v n=%d: locations not increasing above min: %v", alpha, n, x)
+					break
+				}
+			}
+			// The rule is exact for polynomials of degree up to 2n-1:
+			//  int_0^inf x^(alpha+k) e^(-x) dx = Γ(alpha+k+1).
+			for k := 0; k < 2*n; k++ {
+				var got float64
+				for i, v := range x {
+					got += w[i] * math.Pow(v-min, float64(k))
+				}
+				want := math.Gamma(alpha + float64(k) + 1)
+				if !scalar.EqualWithinRel(got, want, 1e-11) {
+					t.Errorf("alpha=%v n=%d: moment %d mismatch: got %v, want %v", alpha, n, k, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestJacobi(t *testing.T) {
+	t.Parallel()
+	// Chebyshev rule of the first kind has locations
+	// cos((2i-1)π/(2n)) and weights π/n.
+	for _, n := range []int{1, 4, 17} {
+		x := make([]float64, n)
+		w := make([]float64, n)
+		Jacobi{Alpha: -0.5, Beta: -0.5}.FixedLocations(x, w, -1, 1)
+		for i := range x {
+			want := math.Cos(float64(2*(n-i)-1) * math.Pi / float64(2*n))
+			if !scalar.EqualWithinAbs(x[i], want, 1e-14) {
+				t.Errorf("n=%d: unexpected Chebyshev location %d: got %v, want %v", n, i, x[i], want)
+			}
+			if !scalar.EqualWithinRel(w[i], math.Pi/float64(n), 1e-13) {
+				t.Errorf("n=%d: unexpected Chebyshev weight %d: got %v, want %v", n, i, w[i], math.Pi/float64(n))
+			}
+		}
+	}
+
+	// Check exactness on the moments over a general interval:
+	//  int_min^max (max-x)^alpha (x-min)^(beta+k) dx
+	//   = L^(alpha+beta+k+1) B(alpha+1, beta+k+1)
+	// where L = max-min.
+	beta := func(a, b float64) float64 {
+		la, _ := math.Lgamma(a)
+		lb, _ := math.Lgamma(b)
+		lab, _ := math.Lgamma(a + b)
+		return math.Exp(la + lb - lab)
+	}
+	const min, max = -0.5, 2
+	for _, test := range []struct{ alpha, beta float64 }{
+		{0, 0}, {1, 2}, {-0.5, 0.5}, {0.3, -0.7}, {-0.9, 3},
+	} {
+		for _, n := range []int{1, 2, 6, 12} {
+			x := make([]float64, n)
+			w := make([]float64, n)
+			Jacobi{Alpha: test.alpha, Beta: test.beta}.FixedLocations(x, w, min, max)
+			for k := 0; k < 2*n; k++ {
+				var got float64
+				for i, v := range x {
+					got += w[i] * math.Pow(v-min, float64(k))
+				}
+				l := max - min
+				want := math.Pow(l, test.alpha+test.beta+float64(k)+1) * beta(test.alpha+1, test.beta+float64(k)+1)
+				if !scalar.EqualWithinRel(got, want, 1e-11) {
+					t.Errorf("alpha=%v beta=%v n=%d: moment %d mismatch: got %v, want %v",
+						test.alpha, test.beta, n, k, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestCachedRuleConcurrent(t *testing.T) {
+	t.Parallel()
+	want := make([]float64, 30)
+	wantW := make([]float64, 30)
+	Laguerre{Alpha: 1.25}.locations(want, wantW)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			x := make([]float64, 30)
+			w := make([]float64, 30)
+			Laguerre{Alpha: 1.25}.FixedLocations(x, w, 0, math.Inf(1))
+			if !floats.Equal(x, want) || !floats.Equal(w, wantW) {
+				t.Errorf("cached rule mismatch")
+			}
+			// The cached rule must not be modified by callers.
+			x[0] = -1
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,78 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import "math"
+
+// Jacobi generates sample locations and weights for performing quadrature
+// with the Jacobi weight over finite bounds
+//
+//	int_min^max (max-x)^Alpha (x-min)^Beta f(x) dx .
+//
+// Alpha and Beta must be greater than -1. Alpha = Beta = 0 gives the
+// Gauss–Legendre rule and Alpha = Beta = -1/2 the Gauss–Chebyshev rule. The
+// rules are computed with the Golub–Welsch algorithm and cached for reuse.
+type Jacobi struct {
+	Alpha, Beta float64
+}
+
+func (j Jacobi) FixedLocations(x, weight []float64, min, max float64) {
+	if len(x) != len(weight) {
+		panic("jacobi: slice length mismatch")
+	}
+	if min >= max {
+		panic("jacobi: min >= max")
+	}
+	if math.IsInf(min, 0) || math.IsInf(max, 0) {
+		panic("jacobi: infinite bound")
+	}
+	if !(j.Alpha > -1) || !(j.Beta > -1) {
+		panic("jacobi: parameter not greater than -1")
+	}
+	n := len(x)
+	if n == 0 {
+		return
+	}
+	xs, ws := cachedRule(ruleKey{family: "jacobi", alpha: j.Alpha, beta: j.Beta, n: n}, j.locations)
+
+	// Map the rule from [-1, 1] to [min, max]. The weight
+	// function scales by half the interval length to the
+	// power of Alpha+Beta, and dx by half the length.
+	half := (max - min) / 2
+	scale := math.Pow(half, j.Alpha+j.Beta+1)
+	for i := range x {
+		x[i] = min + half*(xs[i]+1)
+		weight[i] = scale * ws[i]
+	}
+}
+
+// locations computes the locations and weights for the weight function
+// (1-x)^Alpha (1+x)^Beta on [-1, 1].
+func (j Jacobi) locations(x, weight []float64) {
+	n := len(x)
+	alpha, beta := j.Alpha, j.Beta
+	ab := alpha + beta
+	a := make([]float64, n)
+	b := make([]float64, n-1)
+	a[0] = (beta - alpha) / (ab + 2)
+	for k := 1; k < n; k++ {
+		fk := float64(k)
+		s := 2*fk + ab
+		a[k] = (beta*beta - alpha*alpha) / (s * (s + 2))
+		if k == 1 {
+			// The general expression has a removable
+			// singularity at k = 1 when Alpha+Beta = -1.
+			b[0] = math.Sqrt(4 * (1 + alpha) * (1 + beta) / ((ab + 2) * (ab + 2) * (ab + 3)))
+			continue
+		}
+		b[k-1] = math.Sqrt(4 * fk * (fk + alpha) * (fk + beta) * (fk + ab) / (s * s * (s + 1) * (s - 1)))
+	}
+	lg := func(v float64) float64 {
+		l, _ := math.Lgamma(v)
+		return l
+	}
+	mu0 := math.Exp((ab+1)*math.Ln2 + lg(alpha+1) + lg(beta+1) - lg(ab+2))
+	GolubWelsch(x, weight, a, b, mu0)
+}
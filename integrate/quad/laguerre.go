@@ -0,0 +1,57 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import "math"
+
+// Laguerre generates sample locations and weights for performing quadrature
+// with the generalized Laguerre weight over a semi-infinite interval
+//
+//	int_min^inf (x-min)^Alpha e^(-(x-min)) f(x) dx .
+//
+// Alpha must be greater than -1. The rules are computed with the Golub–Welsch
+// algorithm and cached for reuse.
+type Laguerre struct {
+	Alpha float64
+}
+
+func (l Laguerre) FixedLocations(x, weight []float64, min, max float64) {
+	if len(x) != len(weight) {
+		panic("laguerre: slice length mismatch")
+	}
+	if min >= max {
+		panic("laguerre: min >= max")
+	}
+	if math.IsInf(min, 0) || !math.IsInf(max, 1) {
+		panic("laguerre: bounds not semi-infinite")
+	}
+	if !(l.Alpha > -1) {
+		panic("laguerre: alpha not greater than -1")
+	}
+	n := len(x)
+	if n == 0 {
+		return
+	}
+	xs, ws := cachedRule(ruleKey{family: "laguerre", alpha: l.Alpha, n: n}, l.locations)
+	for i := range x {
+		x[i] = min + xs[i]
+	}
+	copy(weight, ws)
+}
+
+// locations computes the locations and weights for the weight function
+// x^Alpha e^(-x) on [0, inf).
+func (l Laguerre) locations(x, weight []float64) {
+	n := len(x)
+	a := make([]float64, n)
+	b := make([]float64, n-1)
+	for k := range a {
+		a[k] = 2*float64(k) + l.Alpha + 1
+	}
+	for k := 1; k < n; k++ {
+		b[k-1] = math.Sqrt(float64(k) * (float64(k) + l.Alpha))
+	}
+	GolubWelsch(x, weight, a, b, math.Gamma(l.Alpha+1))
+}
@@ -0,0 +1,7 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package knn provides the retention of nearest neighbor search results
+// shared by the spatial tree packages.
+package knn // import "gonum.org/v1/gonum/internal/knn"
@@ -0,0 +1,105 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package knn
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// PointDist holds a point and its distance to a specific query.
+type PointDist[P any] struct {
+	Point P
+	Dist  float64
+}
+
+// Keeper retains the points found during a search of a tree. If n is
+// positive the n nearest points are retained in a max heap on distance and
+// max is the distance of the furthest of them once n have been found,
+// otherwise all the points within max of the query are retained.
+type Keeper[P any] struct {
+	n    int
+	heap []PointDist[P]
+	max  float64
+}
+
+// NewKNearest returns a Keeper that retains the k nearest points offered
+// to it. The heap is allocated with capacity min(k, count) where count is
+// the number of points that may be offered. k must be positive.
+func NewKNearest[P any](k, count int) Keeper[P] {
+	return Keeper[P]{n: k, heap: make([]PointDist[P], 0, min(k, count)), max: math.Inf(1)}
+}
+
+// NewWithin returns a Keeper that retains all the points offered
+// to it within the distance d of the query.
+func NewWithin[P any](d float64) Keeper[P] {
+	return Keeper[P]{max: d}
+}
+
+// Max returns the distance beyond which offered points are not retained.
+func (s *Keeper[P]) Max() float64 { return s.max }
+
+// Keep offers the point p at distance dist from the query for retention.
+func (s *Keeper[P]) Keep(p P, dist float64) {
+	switch {
+	case s.n < 1:
+		if dist <= s.max {
+			s.heap = append(s.heap, PointDist[P]{Point: p, Dist: dist})
+		}
+		return
+	case len(s.heap) < s.n:
+		s.heap = append(s.heap, PointDist[P]{Point: p, Dist: dist})
+		s.up(len(s.heap) - 1)
+	case dist < s.heap[0].Dist:
+		s.heap[0] = PointDist[P]{Point: p, Dist: dist}
+		s.down(0)
+	default:
+		return
+	}
+	if len(s.heap) == s.n {
+		s.max = s.heap[0].Dist
+	}
+}
+
+func (s *Keeper[P]) up(i int) {
+	h := s.heap
+	for i > 0 {
+		p := (i - 1) / 2
+		if h[p].Dist >= h[i].Dist {
+			break
+		}
+		h[p], h[i] = h[i], h[p]
+		i = p
+	}
+}
+
+func (s *Keeper[P]) down(i int) {
+	h := s.heap
+	for {
+		l := 2*i + 1
+		if l >= len(h) {
+			break
+		}
+		j := l
+		if r := l + 1; r < len(h) && h[r].Dist > h[l].Dist {
+			j = r
+		}
+		if h[i].Dist >= h[j].Dist {
+			break
+		}
+		h[i], h[j] = h[j], h[i]
+		i = j
+	}
+}
+
+// Sorted returns the retained points sorted by increasing distance.
+func (s *Keeper[P]) Sorted() []PointDist[P] {
+	if len(s.heap) == 0 {
+		return nil
+	}
+	slices.SortStableFunc(s.heap, func(a, b PointDist[P]) int { return cmp.Compare(a.Dist, b.Dist) })
+	return slices.Clip(s.heap)
+}
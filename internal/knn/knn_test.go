@@ -0,0 +1,62 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package knn
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestKeeper(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 10, 100} {
+		dists := make([]float64, n)
+		for i := range dists {
+			// Use a coarse range so that ties are present.
+			dists[i] = float64(rnd.IntN(20))
+		}
+		sorted := slices.Clone(dists)
+		slices.Sort(sorted)
+
+		for _, k := range []int{1, 5, 200} {
+			s := NewKNearest[int](k, n)
+			for i, d := range dists {
+				s.Keep(i, d)
+			}
+			got := s.Sorted()
+			want := sorted[:min(k, n)]
+			if len(got) != len(want) {
+				t.Errorf("unexpected number of retained points for n=%d k=%d: got:%d want:%d", n, k, len(got), len(want))
+				continue
+			}
+			for i, p := range got {
+				if p.Dist != want[i] || dists[p.Point] != p.Dist {
+					t.Errorf("unexpected retained point %d for n=%d k=%d: got:%v want distance:%v", i, n, k, p, want[i])
+				}
+			}
+			if k <= n && s.Max() != want[k-1] {
+				t.Errorf("unexpected maximum distance for n=%d k=%d: got:%v want:%v", n, k, s.Max(), want[k-1])
+			}
+		}
+
+		const r = 7
+		s := NewWithin[int](r)
+		for i, d := range dists {
+			s.Keep(i, d)
+		}
+		got := s.Sorted()
+		want, _ := slices.BinarySearch(sorted, r+1)
+		if len(got) != want {
+			t.Errorf("unexpected number of points within %v for n=%d: got:%d want:%d", float64(r), n, len(got), want)
+		}
+		for i, p := range got {
+			if p.Dist != sorted[i] || dists[p.Point] != p.Dist {
+				t.Errorf("unexpected point %d within %v for n=%d: got:%v want distance:%v", i, float64(r), n, p, sorted[i])
+			}
+		}
+	}
+}
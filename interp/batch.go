@@ -0,0 +1,173 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interp
+
+import "gonum.org/v1/gonum/mat"
+
+// FritschButlandBatch is a set of FritschButland interpolators fitted to a
+// collection of series sharing the same X values, such as the columns of a
+// panel of time series or the members of an ensemble. Fitting and prediction
+// are performed for all the series at once, so that the work that depends
+// only on the X values, including locating the segment containing each
+// predicted X value, is shared between the series. The predictions for each
+// series are identical to those of a FritschButland interpolator fitted to
+// that series alone. FritschButland interpolation is the method often
+// described as PCHIP, piecewise cubic Hermite interpolating polynomial.
+type FritschButlandBatch struct {
+	// Interpolated X values.
+	xs []float64
+
+	// Coefficients of interpolating cubic polynomials, with
+	// len(xs) - 1 rows and 4 columns for each series. The
+	// interpolated value of series j for xs[i] <= x < xs[i + 1]
+	// is defined as
+	//   sum_{k = 0}^3 coeffs.At(i, 4*j + k) * (x - xs[i])^k
+	coeffs mat.Dense
+
+	// Last interpolated Y and dY/dX values of each
+	// series, corresponding to xs[len(xs) - 1].
+	lastY    []float64
+	lastDyDx []float64
+}
+
+// Fit fits a FritschButland interpolator to each column of ys, using the
+// X values in xs for all the columns. It panics if len(xs) < 2, elements
+// of xs are not strictly increasing or the number of rows of ys is not
+// len(xs). Always returns nil.
+func (fb *FritschButlandBatch) Fit(xs []float64, ys mat.Matrix) error {
+	n := len(xs)
+	r, c := ys.Dims()
+	if r != n {
+		panic(differentLengths)
+	}
+	if n < 2 {
+		panic(tooFewPoints)
+	}
+	m := n - 1
+	dxs := make([]float64, m)
+	for i := range dxs {
+		dxs[i] = xs[i+1] - xs[i]
+		if dxs[i] <= 0 {
+			panic(xsNotStrictlyIncreasing)
+		}
+	}
+
+	fb.coeffs.Reset()
+	fb.coeffs.ReuseAs(m, 4*c)
+	fb.lastY = resize(fb.lastY, c)
+	fb.lastDyDx = resize(fb.lastDyDx, c)
+	y := make([]float64, n)
+	slopes := make([]float64, m)
+	dydxs := make([]float64, n)
+	for j := range c {
+		mat.Col(y, j, ys)
+		for i, dx := range dxs {
+			slopes[i] = (y[i+1] - y[i]) / dx
+		}
+		fritschButlandDerivatives(dydxs, xs, y, slopes)
+		for i, dx := range dxs {
+			// The coefficients are calculated as
+			// in PiecewiseCubic.FitWithDerivatives.
+			dy := y[i+1] - y[i]
+			a := fb.coeffs.RawRowView(i)[4*j : 4*j+4]
+			a[0] = y[i]
+			a[1] = dydxs[i]
+			a[2] = (3*dy - (2*dydxs[i]+dydxs[i+1])*dx) / dx / dx
+			a[3] = (-2*dy + (dydxs[i]+dydxs[i+1])*dx) / dx / dx / dx
+		}
+		fb.lastY[j] = y[m]
+		fb.lastDyDx[j] = dydxs[m]
+	}
+	fb.xs = append(fb.xs[:0], xs...)
+	return nil
+}
+
+// resize returns a slice of length n, reusing the storage of s if possible.
+func resize(s []float64, n int) []float64 {
+	if cap(s) < n {
+		return make([]float64, n)
+	}
+	return s[:n]
+}
+
+// Len returns the number of fitted series.
+func (fb *FritschButlandBatch) Len() int {
+	return len(fb.lastY)
+}
+
+// Predict stores the interpolation values of all the series at each of the
+// values in xs in dst, with one row for each value in xs and one column for
+// each series. If dst is empty, it is resized to the correct dimensions,
+// otherwise Predict will panic if dst does not have len(xs) rows and Len
+// columns.
+func (fb *FritschButlandBatch) Predict(dst *mat.Dense, xs []float64) {
+	fb.predict(dst, xs, false)
+}
+
+// PredictDerivative stores the predicted derivatives of all the series at
+// each of the values in xs in dst, with one row for each value in xs and one
+// column for each series. If dst is empty, it is resized to the correct
+// dimensions, otherwise PredictDerivative will panic if dst does not have
+// len(xs) rows and Len columns.
+func (fb *FritschButlandBatch) PredictDerivative(dst *mat.Dense, xs []float64) {
+	fb.predict(dst, xs, true)
+}
+
+// predict stores the predicted values, or derivatives if deriv is true, of
+// all the series at each of the values in xs in dst, following the semantics
+// of PiecewiseCubic.Predict and PiecewiseCubic.PredictDerivative.
+func (fb *FritschButlandBatch) predict(dst *mat.Dense, xs []float64, deriv bool) {
+	c := fb.Len()
+	if dst.IsEmpty() {
+		dst.ReuseAs(len(xs), c)
+	} else if r, cc := dst.Dims(); r != len(xs) || cc != c {
+		panic(mat.ErrShape)
+	}
+	last := fb.lastY
+	if deriv {
+		last = fb.lastDyDx
+	}
+	m := len(fb.xs) - 1
+	for r, x := range xs {
+		row := dst.RawRowView(r)
+		i := findSegment(fb.xs, x)
+		var dx float64
+		switch {
+		case i < 0:
+			// Before the first node, the values and derivatives
+			// are those of the first segment at its left end.
+			i = 0
+		case i == m:
+			copy(row, last)
+			continue
+		default:
+			dx = x - fb.xs[i]
+		}
+		a := fb.coeffs.RawRowView(i)
+		for j := range row {
+			a := a[4*j : 4*j+4]
+			if deriv {
+				row[j] = (3*a[3]*dx+2*a[2])*dx + a[1]
+			} else {
+				row[j] = ((a[3]*dx+a[2])*dx+a[1])*dx + a[0]
+			}
+		}
+	}
+}
+
+// Series returns a FritschButland interpolator for the series with index j.
+// It panics if j is out of range.
+func (fb *FritschButlandBatch) Series(j int) *FritschButland {
+	if j < 0 || fb.Len() <= j {
+		panic("interp: series index out of range")
+	}
+	m := len(fb.xs) - 1
+	var s FritschButland
+	s.cubic.xs = append([]float64(nil), fb.xs...)
+	s.cubic.coeffs.CloneFrom(fb.coeffs.Slice(0, m, 4*j, 4*j+4))
+	s.cubic.lastY = fb.lastY[j]
+	s.cubic.lastDyDx = fb.lastDyDx[j]
+	return &s
+}
@@ -0,0 +1,160 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interp
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestFritschButlandBatch(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{2, 3, 4, 10} {
+		const c = 5
+		xs := make([]float64, n)
+		for i := 1; i < n; i++ {
+			xs[i] = xs[i-1] + 0.1 + rnd.Float64()
+		}
+		ys := mat.NewDense(n, c, nil)
+		for i := range n {
+			for j := range c {
+				// Include flat and monotone
+				// sections in some series.
+				switch j {
+				case 0:
+					ys.Set(i, j, 1)
+				case 1:
+					ys.Set(i, j, float64(i*i))
+				default:
+					ys.Set(i, j, rnd.NormFloat64())
+				}
+			}
+		}
+
+		var batch FritschButlandBatch
+		err := batch.Fit(xs, ys)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if batch.Len() != c {
+			t.Errorf("unexpected number of series: got %d, want %d", batch.Len(), c)
+		}
+
+		// Predict at the knots, within segments
+		// and outside the interpolated range.
+		at := append([]float64{xs[0] - 1}, xs...)
+		for i := 1; i < n; i++ {
+			at = append(at, xs[i-1]+rnd.Float64()*(xs[i]-xs[i-1]))
+		}
+		at = append(at, xs[n-1]+1)
+
+		var values, derivs mat.Dense
+		batch.Predict(&values, at)
+		batch.PredictDerivative(&derivs, at)
+		for j := range c {
+			var fb FritschButland
+			err := fb.Fit(xs, mat.Col(nil, j, ys))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			series := batch.Series(j)
+			for r, x := range at {
+				if got, want := values.At(r, j), fb.Predict(x); got != want {
+					t.Errorf("n=%d: unexpected value for series %d at %v: got %v, want %v", n, j, x, got, want)
+				}
+				if got, want := derivs.At(r, j), fb.PredictDerivative(x); got != want {
+					t.Errorf("n=%d: unexpected derivative for series %d at %v: got %v, want %v", n, j, x, got, want)
+				}
+				if got, want := series.Predict(x), fb.Predict(x); got != want {
+					t.Errorf("n=%d: unexpected value for extracted series %d at %v: got %v, want %v", n, j, x, got, want)
+				}
+				if got, want := series.Integrate(xs[0], x), fb.Integrate(xs[0], x); got != want {
+					t.Errorf("n=%d: unexpected integral for extracted series %d to %v: got %v, want %v", n, j, x, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestFritschButlandBatchErrors(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{
+			name: "too few points",
+			fn: func() {
+				var fb FritschButlandBatch
+				_ = fb.Fit([]float64{0}, mat.NewDense(1, 2, nil))
+			},
+		},
+		{
+			name: "different lengths",
+			fn: func() {
+				var fb FritschButlandBatch
+				_ = fb.Fit([]float64{0, 1, 2}, mat.NewDense(2, 2, nil))
+			},
+		},
+		{
+			name: "xs not increasing",
+			fn: func() {
+				var fb FritschButlandBatch
+				_ = fb.Fit([]float64{0, 2, 1}, mat.NewDense(3, 2, nil))
+			},
+		},
+		{
+			name: "destination shape",
+			fn: func() {
+				var fb FritschButlandBatch
+				_ = fb.Fit([]float64{0, 1, 2}, mat.NewDense(3, 2, nil))
+				fb.Predict(mat.NewDense(2, 3, nil), []float64{0.5, 1.5})
+			},
+		},
+		{
+			name: "series index",
+			fn: func() {
+				var fb FritschButlandBatch
+				_ = fb.Fit([]float64{0, 1, 2}, mat.NewDense(3, 2, nil))
+				fb.Series(2)
+			},
+		},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
+
+func BenchmarkFritschButlandBatch(b *testing.B) {
+	const (
+		n = 100
+		c = 100
+	)
+	rnd := rand.New(rand.NewPCG(1, 1))
+	xs := make([]float64, n)
+	for i := range xs {
+		xs[i] = float64(i)
+	}
+	ys := mat.NewDense(n, c, nil)
+	for i := range n {
+		for j := range c {
+			ys.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	at := make([]float64, 1000)
+	for i := range at {
+		at[i] = rnd.Float64() * (n - 1)
+	}
+	var dst mat.Dense
+	var fb FritschButlandBatch
+	for b.Loop() {
+		_ = fb.Fit(xs, ys)
+		fb.Predict(&dst, at)
+	}
+}
@@ -72,6 +72,79 @@ func (pc *PiecewiseCubic) PredictDerivative(x float64) float64 {
 	return (3*a[3]*dx+2*a[2])*dx + a[1]
 }
 
+// PredictNthDerivative returns the predicted derivative of order n at x,
+// computed from the coefficients of the cubic polynomial of the segment
+// containing x. Derivatives of order greater than three are zero. At the
+// knots the derivatives of order two and three are those of the segment
+// to the right, except at the last knot. For x outside of the interpolated
+// range the derivatives of order one or more are those at the nearest end
+// of the range, as for PredictDerivative. PredictNthDerivative panics if n
+// is negative.
+func (pc *PiecewiseCubic) PredictNthDerivative(x float64, n int) float64 {
+	switch {
+	case n < 0:
+		panic("interp: negative derivative order")
+	case n == 0:
+		return pc.Predict(x)
+	case n == 1:
+		return pc.PredictDerivative(x)
+	case n > 3:
+		return 0
+	}
+	m := len(pc.xs) - 1
+	i := findSegment(pc.xs, x)
+	var dx float64
+	switch {
+	case i < 0:
+		i = 0
+	case i == m:
+		i = m - 1
+		dx = pc.xs[m] - pc.xs[m-1]
+	default:
+		dx = x - pc.xs[i]
+	}
+	a := pc.coeffs.RawRowView(i)
+	if n == 2 {
+		return 2*a[2] + 6*a[3]*dx
+	}
+	return 6 * a[3]
+}
+
+// Integrate returns the definite integral of the interpolated values from a
+// to b, computed from the coefficients of the cubic polynomials. Outside of
+// the interpolated range the integrand is the constant extrapolated value
+// returned by Predict.
+func (pc *PiecewiseCubic) Integrate(a, b float64) float64 {
+	if a > b {
+		return -pc.Integrate(b, a)
+	}
+	return pc.antiderivative(b) - pc.antiderivative(a)
+}
+
+// antiderivative returns the integral of the interpolated values from the
+// first knot to x.
+func (pc *PiecewiseCubic) antiderivative(x float64) float64 {
+	if x <= pc.xs[0] {
+		return (x - pc.xs[0]) * pc.coeffs.At(0, 0)
+	}
+	m := len(pc.xs) - 1
+	i := findSegment(pc.xs, x)
+	var sum float64
+	for k := 0; k < i && k < m; k++ {
+		sum += segmentIntegral(pc.coeffs.RawRowView(k), pc.xs[k+1]-pc.xs[k])
+	}
+	if i == m {
+		return sum + (x-pc.xs[m])*pc.lastY
+	}
+	return sum + segmentIntegral(pc.coeffs.RawRowView(i), x-pc.xs[i])
+}
+
+// segmentIntegral returns the integral of the cubic polynomial with the
+// coefficients a from zero to dx.
+func segmentIntegral(a []float64, dx float64) float64 {
+	return (((a[3]/4*dx+a[2]/3)*dx+a[1]/2)*dx + a[0]) * dx
+}
+
 // FitWithDerivatives fits a piecewise cubic predictor to (X, Y, dY/dX) value
 // triples provided as three slices.
 // It panics if len(xs) < 2, elements of xs are not strictly increasing,
@@ -127,6 +200,18 @@ func (as *AkimaSpline) PredictDerivative(x float64) float64 {
 	return as.cubic.PredictDerivative(x)
 }
 
+// PredictNthDerivative returns the predicted derivative of order n at x.
+// It panics if n is negative.
+func (as *AkimaSpline) PredictNthDerivative(x float64, n int) float64 {
+	return as.cubic.PredictNthDerivative(x, n)
+}
+
+// Integrate returns the definite integral of the interpolated values from
+// a to b.
+func (as *AkimaSpline) Integrate(a, b float64) float64 {
+	return as.cubic.Integrate(a, b)
+}
+
 // Fit fits a predictor to (X, Y) value pairs provided as two slices.
 // It panics if len(xs) < 2, elements of xs are not strictly increasing
 // or len(xs) != len(ys). Always returns nil.
@@ -223,6 +308,18 @@ func (fb *FritschButland) PredictDerivative(x float64) float64 {
 	return fb.cubic.PredictDerivative(x)
 }
 
+// PredictNthDerivative returns the predicted derivative of order n at x.
+// It panics if n is negative.
+func (fb *FritschButland) PredictNthDerivative(x float64, n int) float64 {
+	return fb.cubic.PredictNthDerivative(x, n)
+}
+
+// Integrate returns the definite integral of the interpolated values from
+// a to b.
+func (fb *FritschButland) Integrate(a, b float64) float64 {
+	return fb.cubic.Integrate(a, b)
+}
+
 // Fit fits a predictor to (X, Y) value pairs provided as two slices.
 // It panics if len(xs) < 2, elements of xs are not strictly increasing
 // or len(xs) != len(ys). Always returns nil.
@@ -235,17 +332,21 @@ func (fb *FritschButland) Fit(xs, ys []float64) error {
 		panic(differentLengths)
 	}
 	dydxs := make([]float64, n)
+	fritschButlandDerivatives(dydxs, xs, ys, calculateSlopes(xs, ys))
+	fb.cubic.FitWithDerivatives(xs, ys, dydxs)
+	return nil
+}
 
-	if n == 2 {
-		dx := xs[1] - xs[0]
-		slope := (ys[1] - ys[0]) / dx
-		dydxs[0] = slope
-		dydxs[1] = slope
-		fb.cubic.FitWithDerivatives(xs, ys, dydxs)
-		return nil
-	}
-	slopes := calculateSlopes(xs, ys)
+// fritschButlandDerivatives calculates the dy/dx approximations for the
+// Fritsch-Butland method at each node, placing them in dydxs, given the
+// slopes between consecutive nodes.
+func fritschButlandDerivatives(dydxs, xs, ys, slopes []float64) {
 	m := len(slopes)
+	if m == 1 {
+		dydxs[0] = slopes[0]
+		dydxs[1] = slopes[0]
+		return
+	}
 	prevSlope := slopes[0]
 	for i := 1; i < m; i++ {
 		slope := slopes[i]
@@ -259,8 +360,6 @@ func (fb *FritschButland) Fit(xs, ys []float64) error {
 	}
 	dydxs[0] = fritschButlandEdgeDerivative(xs, ys, slopes, true)
 	dydxs[m] = fritschButlandEdgeDerivative(xs, ys, slopes, false)
-	fb.cubic.FitWithDerivatives(xs, ys, dydxs)
-	return nil
 }
 
 // fritschButlandEdgeDerivative calculates dy/dx approximation for the
@@ -387,6 +486,18 @@ func (nc *NaturalCubic) PredictDerivative(x float64) float64 {
 	return nc.cubic.PredictDerivative(x)
 }
 
+// PredictNthDerivative returns the predicted derivative of order n at x.
+// It panics if n is negative.
+func (nc *NaturalCubic) PredictNthDerivative(x float64, n int) float64 {
+	return nc.cubic.PredictNthDerivative(x, n)
+}
+
+// Integrate returns the definite integral of the interpolated values from
+// a to b.
+func (nc *NaturalCubic) Integrate(a, b float64) float64 {
+	return nc.cubic.Integrate(a, b)
+}
+
 // Fit fits a predictor to (X, Y) value pairs provided as two slices.
 // It panics if len(xs) < 2, elements of xs are not strictly increasing
 // or len(xs) != len(ys). It returns an error if solving the required system
@@ -427,6 +538,18 @@ func (cc *ClampedCubic) PredictDerivative(x float64) float64 {
 	return cc.cubic.PredictDerivative(x)
 }
 
+// PredictNthDerivative returns the predicted derivative of order n at x.
+// It panics if n is negative.
+func (cc *ClampedCubic) PredictNthDerivative(x float64, n int) float64 {
+	return cc.cubic.PredictNthDerivative(x, n)
+}
+
+// Integrate returns the definite integral of the interpolated values from
+// a to b.
+func (cc *ClampedCubic) Integrate(a, b float64) float64 {
+	return cc.cubic.Integrate(a, b)
+}
+
 // Fit fits a predictor to (X, Y) value pairs provided as two slices.
 // It panics if len(xs) < 2, elements of xs are not strictly increasing
 // or len(xs) != len(ys). It returns an error if solving the required system
@@ -476,6 +599,18 @@ func (nak *NotAKnotCubic) PredictDerivative(x float64) float64 {
 	return nak.cubic.PredictDerivative(x)
 }
 
+// PredictNthDerivative returns the predicted derivative of order n at x.
+// It panics if n is negative.
+func (nak *NotAKnotCubic) PredictNthDerivative(x float64, n int) float64 {
+	return nak.cubic.PredictNthDerivative(x, n)
+}
+
+// Integrate returns the definite integral of the interpolated values from
+// a to b.
+func (nak *NotAKnotCubic) Integrate(a, b float64) float64 {
+	return nak.cubic.Integrate(a, b)
+}
+
 // Fit fits a predictor to (X, Y) value pairs provided as two slices.
 // It panics if len(xs) < 3 (because at least one interior node is required),
 // elements of xs are not strictly increasing or len(xs) != len(ys).
@@ -884,3 +884,113 @@ func TestNotAKnotCubicFit(t *testing.T) {
 		}
 	}
 }
+
+func TestPiecewiseCubicNthDerivativeIntegrate(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	// A cubic polynomial is reproduced exactly when fitted with its
+	// derivatives, so its derivatives and integrals must be recovered
+	// within the interpolated range.
+	f := func(x float64) float64 { return 4*math.Pow(x, 3) - 2*x*x + 10*x - 7 }
+	df := func(x float64) float64 { return 12*x*x - 4*x + 10 }
+	d2f := func(x float64) float64 { return 24*x - 4 }
+	intf := func(x float64) float64 { return math.Pow(x, 4) - 2*math.Pow(x, 3)/3 + 5*x*x - 7*x }
+	xs := []float64{-1.2, -1.001, 0, 0.2, 2.01, 2.1}
+	var pc PiecewiseCubic
+	pc.FitWithDerivatives(xs, applyFunc(xs, f), applyFunc(xs, df))
+
+	for _, x := range []float64{-1.2, -1.1, -0.5, 0, 0.1, 1, 2.01, 2.05, 2.1} {
+		for n, want := range []float64{f(x), df(x), d2f(x), 24, 0, 0} {
+			got := pc.PredictNthDerivative(x, n)
+			// Higher derivatives lose precision on short segments.
+			if !scalar.EqualWithinAbsOrRel(got, want, 1e-10, 1e-10) {
+				t.Errorf("unexpected derivative of order %d at %v: got %v, want %v", n, x, got, want)
+			}
+		}
+	}
+	for _, test := range []struct{ a, b float64 }{
+		{a: -1.2, b: 2.1},
+		{a: -0.5, b: 1},
+		{a: 0.05, b: 0.15},
+		{a: 2.1, b: -1.2},
+		{a: 0.2, b: 0.2},
+	} {
+		got := pc.Integrate(test.a, test.b)
+		want := intf(test.b) - intf(test.a)
+		if !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+			t.Errorf("unexpected integral from %v to %v: got %v, want %v", test.a, test.b, got, want)
+		}
+	}
+
+	// Outside of the range the values are extrapolated as constants.
+	got := pc.Integrate(-2.2, 3.1)
+	want := intf(2.1) - intf(-1.2) + f(-1.2) + f(2.1)
+	if !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+		t.Errorf("unexpected integral with extrapolation: got %v, want %v", got, want)
+	}
+	if !panics(func() { pc.PredictNthDerivative(0, -1) }) {
+		t.Errorf("expected panic for negative derivative order")
+	}
+}
+
+func TestCubicNthDerivativeIntegrate(t *testing.T) {
+	t.Parallel()
+	xs := []float64{0, 0.5, 1.5, 2, 3, 4.5, 5}
+	ys := []float64{1, 2, 1.5, -0.5, 0, 3, 2.5}
+	for _, test := range []struct {
+		name   string
+		interp interface {
+			Fitter
+			DifferentiableInterpolator
+			IntegrableInterpolator
+		}
+	}{
+		{name: "AkimaSpline", interp: &AkimaSpline{}},
+		{name: "FritschButland", interp: &FritschButland{}},
+		{name: "NaturalCubic", interp: &NaturalCubic{}},
+		{name: "ClampedCubic", interp: &ClampedCubic{}},
+		{name: "NotAKnotCubic", interp: &NotAKnotCubic{}},
+	} {
+		err := test.interp.Fit(xs, ys)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		p := test.interp
+
+		// Simpson's rule is exact for cubic polynomials, so it
+		// gives the integral over each segment exactly.
+		var total float64
+		for i := 0; i < len(xs)-1; i++ {
+			a, b := xs[i], xs[i+1]
+			mid := p.Predict((a + b) / 2)
+			// Use the limits from within the segment.
+			fa := p.Predict(a)
+			fb := p.Predict(math.Nextafter(b, a))
+			want := (b - a) / 6 * (fa + 4*mid + fb)
+			got := p.Integrate(a, b)
+			if !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+				t.Errorf("%s: unexpected integral over segment %d: got %v, want %v", test.name, i, got, want)
+			}
+			total += want
+		}
+		if got := p.Integrate(xs[0], xs[len(xs)-1]); !scalar.EqualWithinAbsOrRel(got, total, 1e-12, 1e-12) {
+			t.Errorf("%s: unexpected integral over range: got %v, want %v", test.name, got, total)
+		}
+
+		// Check the derivatives against finite differences
+		// of the lower order derivatives.
+		const h = 1e-6
+		for _, x := range []float64{0.2, 0.9, 1.7, 2.6, 4} {
+			for n := 1; n <= 3; n++ {
+				want := (p.PredictNthDerivative(x+h, n-1) - p.PredictNthDerivative(x-h, n-1)) / (2 * h)
+				got := p.PredictNthDerivative(x, n)
+				if !scalar.EqualWithinAbsOrRel(got, want, 1e-6, 1e-6) {
+					t.Errorf("%s: unexpected derivative of order %d at %v: got %v, want %v", test.name, n, x, got, want)
+				}
+			}
+			if got := p.PredictNthDerivative(x, 1); got != p.PredictDerivative(x) {
+				t.Errorf("%s: first derivative mismatch at %v", test.name, x)
+			}
+		}
+	}
+}
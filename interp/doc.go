@@ -2,7 +2,8 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// Package interp implements 1-dimensional algorithms for interpolating values.
+// Package interp implements 1-dimensional algorithms for interpolating values,
+// and parametric curves through points in spaces of any dimension.
 // Outside of the interpolation interval determined by the interpolated data,
 // the returned value is undefined (but we do our best to return something
 // reasonable).
@@ -10,6 +10,8 @@ const (
 	differentLengths        = "interp: input slices have different lengths"
 	tooFewPoints            = "interp: too few points for interpolation"
 	xsNotStrictlyIncreasing = "interp: xs values not strictly increasing"
+	notPeriodic             = "interp: first and last ys values differ"
+	repeatedPoint           = "interp: consecutive points are equal"
 )
 
 // Predictor predicts the value of a function. It handles both
@@ -42,6 +44,29 @@ type DerivativePredictor interface {
 	PredictDerivative(x float64) float64
 }
 
+// DifferentiableInterpolator predicts the value and the derivatives of any
+// order of a function. It handles both interpolation and extrapolation.
+type DifferentiableInterpolator interface {
+	DerivativePredictor
+
+	// PredictNthDerivative returns the predicted derivative
+	// of order n at x. The derivative of order zero is the
+	// predicted value. PredictNthDerivative panics if n is
+	// negative.
+	PredictNthDerivative(x float64, n int) float64
+}
+
+// IntegrableInterpolator predicts the value and the definite integrals of
+// a function. It handles both interpolation and extrapolation.
+type IntegrableInterpolator interface {
+	Predictor
+
+	// Integrate returns the definite integral of the
+	// predicted values from a to b. If a > b the integral
+	// is negative.
+	Integrate(a, b float64) float64
+}
+
 // Constant predicts a constant value.
 type Constant float64
 
@@ -0,0 +1,151 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interp
+
+import (
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// ParametricCubic is a cubic spline curve through a sequence of points in
+// a space of any dimension. Each coordinate of the curve is a cubic spline
+// function of a common parameter t that approximates the arc length along
+// the curve. The parameter value of each point is the cumulative length of
+// the polygonal chain through the points up to that point, so t ranges from
+// zero at the first point to the total chord length, Length, at the last
+// point.
+//
+// If Closed is false, each coordinate is a NaturalCubic spline of t and the
+// curve is open. If Closed is true, each coordinate is a PeriodicCubic spline
+// of t and the curve returns smoothly to the first point, with continuous
+// first and second derivatives there. Predictions for t outside [0, Length]
+// are made by periodic extension for a closed curve and by the extrapolation
+// of NaturalCubic for an open curve.
+type ParametricCubic struct {
+	// Closed specifies whether the fitted
+	// curve is closed. It must be set
+	// before calling Fit.
+	Closed bool
+
+	ts     []float64
+	coords []DifferentiableInterpolator
+}
+
+// Fit fits a curve to the points held in the rows of pts. If Closed is true,
+// the curve returns from the last point to the first, and the last point
+// need not be a repeat of the first point; if it is, it is not duplicated.
+// Consecutive points must be distinct.
+//
+// Fit panics if pts has fewer than two rows for an open curve or no rows for
+// a closed curve, or if consecutive points are equal. It returns an error if
+// solving the required systems of linear equations fails.
+func (pc *ParametricCubic) Fit(pts mat.Matrix) error {
+	r, c := pts.Dims()
+	if r == 0 {
+		panic(tooFewPoints)
+	}
+	rows := make([][]float64, r)
+	for i := range rows {
+		rows[i] = mat.Row(nil, i, pts)
+	}
+	if pc.Closed && floats.Equal(rows[0], rows[r-1]) {
+		rows = rows[:r-1]
+	}
+	if pc.Closed {
+		rows = append(rows, rows[0])
+	}
+	n := len(rows)
+	if n < 2 {
+		panic(tooFewPoints)
+	}
+
+	// Parameterize the points by the
+	// cumulative chord length.
+	ts := make([]float64, n)
+	for i := 1; i < n; i++ {
+		d := floats.Distance(rows[i], rows[i-1], 2)
+		if d == 0 {
+			panic(repeatedPoint)
+		}
+		ts[i] = ts[i-1] + d
+	}
+
+	coords := make([]DifferentiableInterpolator, c)
+	ys := make([]float64, n)
+	for j := range c {
+		for i, p := range rows {
+			ys[i] = p[j]
+		}
+		var err error
+		if pc.Closed {
+			var s PeriodicCubic
+			err = s.Fit(ts, ys)
+			coords[j] = &s
+		} else {
+			var s NaturalCubic
+			err = s.Fit(ts, ys)
+			coords[j] = &s
+		}
+		if err != nil {
+			return err
+		}
+	}
+	pc.ts = ts
+	pc.coords = coords
+	return nil
+}
+
+// Length returns the length of the range of the parameter of the fitted
+// curve, the total length of the polygonal chain through the fitted points.
+func (pc *ParametricCubic) Length() float64 {
+	return pc.ts[len(pc.ts)-1]
+}
+
+// Knots returns the parameter values of the fitted points. If dst is not
+// nil, the values are stored in dst, which must have length equal to the
+// number of fitted points, including the repeated first point of a closed
+// curve.
+func (pc *ParametricCubic) Knots(dst []float64) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(pc.ts))
+	}
+	if len(dst) != len(pc.ts) {
+		panic(differentLengths)
+	}
+	copy(dst, pc.ts)
+	return dst
+}
+
+// Predict returns the point on the curve at the parameter value t. If dst
+// is not nil, the point is stored in dst, which must have length equal to
+// the dimension of the fitted points.
+func (pc *ParametricCubic) Predict(dst []float64, t float64) []float64 {
+	return pc.PredictNthDerivative(dst, t, 0)
+}
+
+// PredictDerivative returns the derivative of the curve with respect to the
+// parameter at the parameter value t, the tangent vector of the curve. If
+// dst is not nil, the derivative is stored in dst, which must have length
+// equal to the dimension of the fitted points.
+func (pc *ParametricCubic) PredictDerivative(dst []float64, t float64) []float64 {
+	return pc.PredictNthDerivative(dst, t, 1)
+}
+
+// PredictNthDerivative returns the derivative of order n of the curve with
+// respect to the parameter at the parameter value t. If dst is not nil, the
+// derivative is stored in dst, which must have length equal to the
+// dimension of the fitted points. It panics if n is negative.
+func (pc *ParametricCubic) PredictNthDerivative(dst []float64, t float64, n int) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(pc.coords))
+	}
+	if len(dst) != len(pc.coords) {
+		panic(differentLengths)
+	}
+	for j, s := range pc.coords {
+		dst[j] = s.PredictNthDerivative(t, n)
+	}
+	return dst
+}
@@ -0,0 +1,137 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interp
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestParametricCubicClosed(t *testing.T) {
+	t.Parallel()
+	// Points on a unit circle in the z=1 plane,
+	// with the first point repeated at the end.
+	const n = 24
+	pts := mat.NewDense(n+1, 3, nil)
+	for i := range n + 1 {
+		theta := 2 * math.Pi * float64(i) / n
+		pts.SetRow(i, []float64{math.Cos(theta), math.Sin(theta), 1})
+	}
+	pts.SetRow(n, pts.RawRowView(0))
+	pc := ParametricCubic{Closed: true}
+	err := pc.Fit(pts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The repeated first point is not duplicated.
+	knots := pc.Knots(nil)
+	if len(knots) != n+1 {
+		t.Fatalf("unexpected number of knots: got %d, want %d", len(knots), n+1)
+	}
+	wantLength := 2 * n * math.Sin(math.Pi/n)
+	if !scalar.EqualWithinAbsOrRel(pc.Length(), wantLength, 1e-12, 1e-12) {
+		t.Errorf("unexpected length: got %v, want %v", pc.Length(), wantLength)
+	}
+	for i, k := range knots {
+		got := pc.Predict(nil, k)
+		want := pts.RawRowView(i)
+		if !floats.EqualApprox(got, want, 1e-12) {
+			t.Errorf("unexpected point at knot %d: got %v, want %v", i, got, want)
+		}
+	}
+
+	// The curve is close to the circle, and
+	// the parameter is close to arc length.
+	dst := make([]float64, 3)
+	for s := -pc.Length(); s < 2*pc.Length(); s += 0.01 {
+		p := pc.Predict(dst, s)
+		if r := math.Hypot(p[0], p[1]); math.Abs(r-1) > 1e-4 || p[2] != 1 {
+			t.Errorf("point not on circle at %v: %v", s, p)
+		}
+		d := pc.PredictDerivative(dst, s)
+		if speed := floats.Norm(d, 2); math.Abs(speed-1) > 1e-2 {
+			t.Errorf("unexpected speed at %v: got %v, want 1", s, speed)
+		}
+	}
+
+	// The curve is smooth at the closing point.
+	for order := 1; order <= 2; order++ {
+		end := pc.Length()
+		got := pc.PredictNthDerivative(nil, 0, order)
+		want := pc.PredictNthDerivative(nil, math.Nextafter(end, 0), order)
+		if !floats.EqualApprox(got, want, 1e-8) {
+			t.Errorf("discontinuous derivative of order %d at closing point: got %v, want %v", order, got, want)
+		}
+	}
+
+	// Fitting without the repeated first
+	// point gives the same curve.
+	open := ParametricCubic{Closed: true}
+	err = open.Fit(pts.Slice(0, n, 0, 3))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !floats.Equal(open.Knots(nil), knots) {
+		t.Errorf("unexpected knots without repeated point: got %v, want %v", open.Knots(nil), knots)
+	}
+}
+
+func TestParametricCubicOpen(t *testing.T) {
+	t.Parallel()
+	// Points along a straight line are interpolated by the
+	// line itself, parameterized by exact arc length.
+	pts := mat.NewDense(4, 2, []float64{
+		1, 1,
+		4, 5,
+		5.5, 7,
+		7, 9,
+	})
+	var pc ParametricCubic
+	err := pc.Fit(pts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := 10.0; !scalar.EqualWithinAbsOrRel(pc.Length(), want, 1e-14, 1e-14) {
+		t.Errorf("unexpected length: got %v, want %v", pc.Length(), want)
+	}
+	for s := 0.0; s <= 10; s += 0.25 {
+		got := pc.Predict(nil, s)
+		want := []float64{1 + 0.6*s, 1 + 0.8*s}
+		if !floats.EqualApprox(got, want, 1e-12) {
+			t.Errorf("unexpected point at %v: got %v, want %v", s, got, want)
+		}
+		got = pc.PredictDerivative(nil, s)
+		want = []float64{0.6, 0.8}
+		if !floats.EqualApprox(got, want, 1e-12) {
+			t.Errorf("unexpected derivative at %v: got %v, want %v", s, got, want)
+		}
+	}
+}
+
+func TestParametricCubicFitErrors(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name   string
+		closed bool
+		pts    *mat.Dense
+	}{
+		{name: "one point", pts: mat.NewDense(1, 2, []float64{0, 0})},
+		{name: "closed one point", closed: true, pts: mat.NewDense(1, 2, []float64{0, 0})},
+		{name: "closed repeated point", closed: true, pts: mat.NewDense(3, 2, []float64{0, 0, 1, 1, 1, 1})},
+		{name: "repeated point", pts: mat.NewDense(3, 2, []float64{0, 0, 1, 1, 1, 1})},
+	} {
+		if !panics(func() {
+			pc := ParametricCubic{Closed: test.closed}
+			_ = pc.Fit(test.pts)
+		}) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
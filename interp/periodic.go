@@ -0,0 +1,166 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interp
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// PeriodicCubic is a piecewise cubic 1-dimensional interpolator with
+// continuous value, first and second derivatives, which can be fitted to
+// (X, Y) value pairs sampled over one period of a periodic function without
+// providing derivatives. It uses the periodic boundary conditions
+//
+//	Y′(left end) = Y′(right end), Y′′(left end) = Y′′(right end),
+//
+// and predicts values outside of the fitted range by periodic extension with
+// period equal to the length of the range.
+type PeriodicCubic struct {
+	cubic PiecewiseCubic
+}
+
+// wrap returns x translated by a whole number of periods into the fitted
+// range, and the number of periods by which it was translated.
+func (pc *PeriodicCubic) wrap(x float64) (float64, float64) {
+	xs := pc.cubic.xs
+	x0 := xs[0]
+	period := xs[len(xs)-1] - x0
+	k := math.Floor((x - x0) / period)
+	x -= k * period
+	// Guard against rounding placing x
+	// at or beyond the end of the range.
+	if x >= xs[len(xs)-1] {
+		x = x0
+		k++
+	}
+	return x, k
+}
+
+// Predict returns the interpolation value at x.
+func (pc *PeriodicCubic) Predict(x float64) float64 {
+	x, _ = pc.wrap(x)
+	return pc.cubic.Predict(x)
+}
+
+// PredictDerivative returns the predicted derivative at x.
+func (pc *PeriodicCubic) PredictDerivative(x float64) float64 {
+	x, _ = pc.wrap(x)
+	return pc.cubic.PredictDerivative(x)
+}
+
+// PredictNthDerivative returns the predicted derivative of order n at x.
+// It panics if n is negative.
+func (pc *PeriodicCubic) PredictNthDerivative(x float64, n int) float64 {
+	x, _ = pc.wrap(x)
+	return pc.cubic.PredictNthDerivative(x, n)
+}
+
+// Integrate returns the definite integral of the interpolated values from
+// a to b.
+func (pc *PeriodicCubic) Integrate(a, b float64) float64 {
+	return pc.antiderivative(b) - pc.antiderivative(a)
+}
+
+// antiderivative returns the integral of the interpolated values from the
+// first knot to x.
+func (pc *PeriodicCubic) antiderivative(x float64) float64 {
+	xs := pc.cubic.xs
+	x, k := pc.wrap(x)
+	period := pc.cubic.antiderivative(xs[len(xs)-1])
+	return k*period + pc.cubic.antiderivative(x)
+}
+
+// Fit fits a predictor to (X, Y) value pairs provided as two slices.
+// The first and last values of ys must be equal, since they are the values
+// at the two ends of a period. It panics if len(xs) < 2, elements of xs are
+// not strictly increasing, len(xs) != len(ys) or ys[0] != ys[len(ys)-1].
+// It returns an error if solving the required system of linear equations
+// fails.
+func (pc *PeriodicCubic) Fit(xs, ys []float64) error {
+	slopes := calculateSlopes(xs, ys)
+	n := len(xs)
+	if ys[0] != ys[n-1] {
+		panic(notPeriodic)
+	}
+
+	// The second derivatives at the m distinct knots of a
+	// period satisfy a cyclic tridiagonal system of equations.
+	m := n - 1
+	dl := make([]float64, m)
+	d := make([]float64, m)
+	du := make([]float64, m)
+	b := make([]float64, m)
+	for i := range m {
+		prev := (i + m - 1) % m
+		dxPrev := xs[prev+1] - xs[prev]
+		dx := xs[i+1] - xs[i]
+		dl[i] = dxPrev / 6
+		d[i] = (dxPrev + dx) / 3
+		du[i] = dx / 6
+		b[i] = slopes[i] - slopes[prev]
+	}
+	d2ydx2s := make([]float64, n)
+	err := solveCyclicTridiag(d2ydx2s[:m], dl, d, du, b)
+	if err != nil {
+		return err
+	}
+	d2ydx2s[m] = d2ydx2s[0]
+	pc.cubic.fitWithSecondDerivatives(xs, ys, d2ydx2s)
+	return nil
+}
+
+// solveCyclicTridiag solves the cyclic tridiagonal system of equations
+//
+//	dl[i] x[i-1] + d[i] x[i] + du[i] x[i+1] = b[i]
+//
+// where indices are taken modulo len(d), placing the result in dst.
+func solveCyclicTridiag(dst, dl, d, du, b []float64) error {
+	m := len(d)
+	if m < 3 {
+		// The corner elements overlap the
+		// tridiagonal band, so solve directly.
+		a := mat.NewDense(m, m, nil)
+		for i := range m {
+			a.Set(i, (i+m-1)%m, a.At(i, (i+m-1)%m)+dl[i])
+			a.Set(i, i, a.At(i, i)+d[i])
+			a.Set(i, (i+1)%m, a.At(i, (i+1)%m)+du[i])
+		}
+		return mat.NewVecDense(m, dst).SolveVec(a, mat.NewVecDense(m, b))
+	}
+
+	// Use the Sherman-Morrison formula to write the system
+	// as a tridiagonal system with a rank one correction,
+	//  A = T + u.v^T,
+	// where u = [gamma 0 ... 0 beta] and v = [1 0 ... 0 alpha/gamma].
+	alpha := dl[0]  // A[0][m-1]
+	beta := du[m-1] // A[m-1][0]
+	gamma := -d[0]
+	diag := make([]float64, m)
+	copy(diag, d)
+	diag[0] -= gamma
+	diag[m-1] -= alpha * beta / gamma
+	t := mat.NewTridiag(m, dl[1:], diag, du[:m-1])
+
+	x := mat.NewVecDense(m, dst)
+	err := t.SolveVecTo(x, false, mat.NewVecDense(m, b))
+	if err != nil {
+		return err
+	}
+	u := make([]float64, m)
+	u[0] = gamma
+	u[m-1] = beta
+	z := mat.NewVecDense(m, nil)
+	err = t.SolveVecTo(z, false, mat.NewVecDense(m, u))
+	if err != nil {
+		return err
+	}
+	zs := z.RawVector().Data
+	f := (dst[0] + alpha/gamma*dst[m-1]) / (1 + zs[0] + alpha/gamma*zs[m-1])
+	floats.AddScaled(dst, -f, zs)
+	return nil
+}
@@ -0,0 +1,159 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interp
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestPeriodicCubicFit(t *testing.T) {
+	t.Parallel()
+	xs := []float64{0, 0.5, 1.25, 2, 3, 3.5, 4.5, 5.25, 2 * math.Pi}
+	ys := make([]float64, len(xs))
+	for i, x := range xs {
+		ys[i] = math.Sin(x)
+	}
+	ys[len(ys)-1] = ys[0]
+	var pc PeriodicCubic
+	err := pc.Fit(xs, ys)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, x := range xs {
+		if got := pc.Predict(x); !scalar.EqualWithinAbsOrRel(got, ys[i], 1e-14, 1e-14) {
+			t.Errorf("unexpected value at knot %v: got %v, want %v", x, got, ys[i])
+		}
+	}
+
+	// The first and second derivatives are continuous
+	// across the ends of the period.
+	m := len(xs) - 1
+	end := xs[m] - xs[m-1]
+	a := pc.cubic.coeffs.RawRowView(m - 1)
+	endDeriv := []float64{
+		(3*a[3]*end+2*a[2])*end + a[1],
+		6*a[3]*end + 2*a[2],
+	}
+	for n := 1; n <= 2; n++ {
+		got := pc.PredictNthDerivative(xs[0], n)
+		want := endDeriv[n-1]
+		if !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+			t.Errorf("discontinuous derivative of order %d at end of period: got %v, want %v", n, got, want)
+		}
+	}
+
+	// The interpolant approximates the periodic function.
+	for x := -10.0; x < 10; x += 0.1 {
+		if got := pc.Predict(x); math.Abs(got-math.Sin(x)) > 0.05 {
+			t.Errorf("poor approximation at %v: got %v, want %v", x, got, math.Sin(x))
+		}
+		if got, want := pc.Predict(x+2*math.Pi), pc.Predict(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+			t.Errorf("prediction not periodic at %v: got %v, want %v", x, got, want)
+		}
+		if got, want := pc.PredictDerivative(x-2*math.Pi), pc.PredictDerivative(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+			t.Errorf("derivative not periodic at %v: got %v, want %v", x, got, want)
+		}
+	}
+
+	period := pc.Integrate(xs[0], xs[m])
+	for _, test := range []struct {
+		a, b float64
+	}{
+		{a: 1, b: 1 + 2*math.Pi},
+		{a: -7, b: -7 + 2*math.Pi},
+		{a: 2 + 6*math.Pi, b: 2},
+	} {
+		k := math.Round((test.b - test.a) / (2 * math.Pi))
+		got := pc.Integrate(test.a, test.b)
+		want := k * period
+		if !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+			t.Errorf("unexpected integral from %v to %v: got %v, want %v", test.a, test.b, got, want)
+		}
+	}
+	if got, want := pc.Integrate(-1, 4), pc.Integrate(-1+2*math.Pi, 4+2*math.Pi); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+		t.Errorf("integral not periodic: got %v, want %v", got, want)
+	}
+}
+
+func TestPeriodicCubicConstant(t *testing.T) {
+	t.Parallel()
+	for _, xs := range [][]float64{{0, 1}, {0, 1, 3}} {
+		ys := make([]float64, len(xs))
+		for i := range ys {
+			ys[i] = 2
+		}
+		var pc PeriodicCubic
+		err := pc.Fit(xs, ys)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, x := range []float64{-0.5, 0, 0.25, 1.5, 4} {
+			if got := pc.Predict(x); got != 2 {
+				t.Errorf("unexpected value for %d knots at %v: got %v, want 2", len(xs), x, got)
+			}
+		}
+	}
+}
+
+func TestPeriodicCubicFitErrors(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		xs, ys []float64
+	}{
+		{xs: []float64{0}, ys: []float64{0}},
+		{xs: []float64{0, 1, 2}, ys: []float64{0, 1}},
+		{xs: []float64{0, 2, 1}, ys: []float64{0, 1, 0}},
+		{xs: []float64{0, 1, 2}, ys: []float64{0, 1, 2}},
+	} {
+		if !panics(func() {
+			var pc PeriodicCubic
+			_ = pc.Fit(test.xs, test.ys)
+		}) {
+			t.Errorf("expected panic for xs: %v and ys: %v", test.xs, test.ys)
+		}
+	}
+}
+
+func TestSolveCyclicTridiag(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for m := 1; m <= 10; m++ {
+		dl := make([]float64, m)
+		d := make([]float64, m)
+		du := make([]float64, m)
+		b := make([]float64, m)
+		a := mat.NewDense(m, m, nil)
+		for i := range m {
+			dl[i] = rnd.Float64()
+			du[i] = rnd.Float64()
+			d[i] = 2 + rnd.Float64()
+			b[i] = rnd.NormFloat64()
+			a.Set(i, (i+m-1)%m, a.At(i, (i+m-1)%m)+dl[i])
+			a.Set(i, i, a.At(i, i)+d[i])
+			a.Set(i, (i+1)%m, a.At(i, (i+1)%m)+du[i])
+		}
+		var want mat.VecDense
+		err := want.SolveVec(a, mat.NewVecDense(m, b))
+		if err != nil {
+			t.Fatalf("unexpected error for m=%d: %v", m, err)
+		}
+		got := make([]float64, m)
+		err = solveCyclicTridiag(got, dl, d, du, b)
+		if err != nil {
+			t.Fatalf("unexpected error for m=%d: %v", m, err)
+		}
+		for i := range m {
+			if !scalar.EqualWithinAbsOrRel(got[i], want.AtVec(i), 1e-12, 1e-12) {
+				t.Errorf("unexpected solution for m=%d: got %v, want %v", m, got, want.RawVector().Data)
+				break
+			}
+		}
+	}
+}
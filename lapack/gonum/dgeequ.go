@@ -0,0 +1,110 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dgeequ computes row and column scalings intended to equilibrate an m×n
+// matrix A and reduce its condition number. The scaled matrix
+//
+//	diag(R) * A * diag(C)
+//
+// has entries of largest absolute value equal to 1 in each row and column.
+//
+// r and c must have length m and n respectively, otherwise Dgeequ will panic.
+// On return, they contain the row and column scale factors. The scale factors
+// are not powers of the radix, so the scaling may introduce rounding errors.
+//
+// rowcnd is the ratio of the smallest to the largest row scale factor. If
+// rowcnd >= 0.1 and amax is neither too large nor too small, it is not
+// worth scaling by R. colcnd is the ratio of the smallest to the largest
+// column scale factor. If colcnd >= 0.1, it is not worth scaling by C. amax is
+// the absolute value of the largest element of A.
+//
+// Dgeequ returns whether the computation was successful. If a row or a column
+// of A is exactly zero, ok is false and r, c, rowcnd and colcnd must not be
+// used.
+//
+// Dgeequ is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dgeequ(m, n int, a []float64, lda int, r, c []float64) (rowcnd, colcnd, amax float64, ok bool) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return 1, 1, 0, true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(r) != m:
+		panic(shortR)
+	case len(c) != n:
+		panic(shortC)
+	}
+
+	const (
+		smlnum = dlamchS
+		bignum = 1 / smlnum
+	)
+
+	// Compute the row scale factors.
+	for i := range r {
+		var rmax float64
+		for _, v := range a[i*lda : i*lda+n] {
+			rmax = math.Max(rmax, math.Abs(v))
+		}
+		r[i] = rmax
+	}
+	rcmin := bignum
+	var rcmax float64
+	for _, v := range r {
+		rcmax = math.Max(rcmax, v)
+		rcmin = math.Min(rcmin, v)
+	}
+	amax = rcmax
+	if rcmin == 0 {
+		// A has a zero row.
+		return 0, 0, amax, false
+	}
+	for i, v := range r {
+		r[i] = 1 / math.Min(math.Max(v, smlnum), bignum)
+	}
+	rowcnd = math.Max(rcmin, smlnum) / math.Min(rcmax, bignum)
+
+	// Compute the column scale factors assuming the row scaling
+	// has been applied.
+	for j := range c {
+		c[j] = 0
+	}
+	for i, ri := range r {
+		for j, v := range a[i*lda : i*lda+n] {
+			c[j] = math.Max(c[j], math.Abs(v)*ri)
+		}
+	}
+	rcmin = bignum
+	rcmax = 0
+	for _, v := range c {
+		rcmin = math.Min(rcmin, v)
+		rcmax = math.Max(rcmax, v)
+	}
+	if rcmin == 0 {
+		// A has a zero column.
+		return 0, 0, amax, false
+	}
+	for j, v := range c {
+		c[j] = 1 / math.Min(math.Max(v, smlnum), bignum)
+	}
+	colcnd = math.Max(rcmin, smlnum) / math.Min(rcmax, bignum)
+
+	return rowcnd, colcnd, amax, true
+}
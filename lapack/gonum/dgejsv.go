@@ -0,0 +1,233 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dgejsv computes the singular value decomposition of an m×n matrix A with
+// m >= n to high relative accuracy,
+//
+//	A = U * Σ * Vᵀ,
+//
+// where Σ is an n×n diagonal matrix containing the singular values of A in
+// decreasing order, U is an m×m orthogonal matrix and V is an n×n orthogonal
+// matrix.
+//
+// Dgejsv first computes a QR factorization with column pivoting of A,
+//
+//	A * P = Q * R,
+//
+// and then computes the singular value decomposition of Rᵀ by the one-sided
+// Jacobi method implemented in Dgesvj. The preconditioning by the pivoted QR
+// factorization makes the Jacobi iteration converge quickly, and the singular
+// values of a matrix of the form A = B * D, where D is diagonal and B has
+// well-conditioned columns, are computed to high relative accuracy regardless
+// of the scaling D. Dgejsv is slower than Dgesvd.
+//
+// jobU specifies how U is computed. If jobU is lapack.SVDAll, all m columns
+// of U are stored in u, which must have at least m columns. If jobU is
+// lapack.SVDStore, the first n columns of U are stored in u, which must have
+// at least n columns. If jobU is lapack.SVDNone, u is not referenced.
+// Otherwise Dgejsv will panic.
+//
+// jobV specifies how V is computed. If jobV is lapack.SVDAll, V is stored in
+// v. If jobV is lapack.SVDNone, v is not referenced. Otherwise Dgejsv will
+// panic.
+//
+// On return, a is overwritten and s contains the singular values of A in
+// decreasing order. s must have length n.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// n + n*n + max(3*n+1, m) if jobU is lapack.SVDNone and n + 2*n*n + max(3*n+1, m)
+// otherwise, or Dgejsv will panic. If lwork is -1, instead of computing the
+// decomposition, Dgejsv only calculates the optimal workspace size and stores
+// it into work[0].
+//
+// iwork must have length at least n, otherwise Dgejsv will panic.
+//
+// Dgejsv returns whether the Jacobi iteration converged.
+func (impl Implementation) Dgejsv(jobU, jobV lapack.SVDJob, m, n int, a []float64, lda int, s, u []float64, ldu int, v []float64, ldv int, work []float64, lwork int, iwork []int) (ok bool) {
+	wantUA := jobU == lapack.SVDAll
+	wantU := wantUA || jobU == lapack.SVDStore
+	wantV := jobV == lapack.SVDAll
+	switch {
+	case !wantU && jobU != lapack.SVDNone:
+		panic(badSVDJob)
+	case !wantV && jobV != lapack.SVDNone:
+		panic(badSVDJob)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case n > m:
+		panic(nGTM)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldu < 1, wantUA && ldu < m, wantU && ldu < n:
+		panic(badLdU)
+	case ldv < 1, wantV && ldv < n:
+		panic(badLdV)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return true
+	}
+
+	// Workspace layout: tau (n), the n×n matrix X = Rᵀ that is
+	// overwritten by U_1, the n×n matrix V_1 if U is wanted, and
+	// workspace for the subroutines.
+	nv1 := 0
+	if wantU {
+		nv1 = n * n
+	}
+	minwork := n + n*n + nv1 + max(3*n+1, m)
+	switch {
+	case lwork < minwork && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Compute the optimal workspace size.
+	impl.Dgeqp3(m, n, a, lda, nil, nil, work, -1)
+	lwrk := max(3*n+1, int(work[0]))
+	if wantU {
+		ncu := n
+		if wantUA {
+			ncu = m
+		}
+		impl.Dormqr(blas.Left, blas.NoTrans, m, ncu, n, a, lda, nil, u, ldu, work, -1)
+		lwrk = max(lwrk, int(work[0]))
+	}
+	lwrk = max(lwrk, m)
+	if lwork == -1 {
+		work[0] = float64(n + n*n + nv1 + lwrk)
+		return true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(s) != n:
+		panic(shortS)
+	case wantUA && len(u) < (m-1)*ldu+m:
+		panic(shortU)
+	case wantU && len(u) < (m-1)*ldu+n:
+		panic(shortU)
+	case wantV && len(v) < (n-1)*ldv+n:
+		panic(shortV)
+	case len(iwork) < n:
+		panic(shortIWork)
+	}
+
+	tau := work[:n]
+	x := work[n : n+n*n]
+	ldx := n
+	v1 := work[n+n*n : n+n*n+nv1]
+	wrk := work[n+n*n+nv1:]
+
+	// Compute A * P = Q * R.
+	jpvt := iwork[:n]
+	for i := range jpvt {
+		jpvt[i] = -1
+	}
+	impl.Dgeqp3(m, n, a, lda, jpvt, tau, wrk, len(wrk))
+
+	// Form X = Rᵀ.
+	impl.Dlaset(blas.All, n, n, 0, 0, x, ldx)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			x[j*ldx+i] = a[i*lda+j]
+		}
+	}
+
+	// Compute X = U_1 * Σ * V_1ᵀ, so that A * P = Q * [V_1; 0] * Σ * U_1ᵀ.
+	jobX := lapack.SVDNone
+	if wantV {
+		jobX = lapack.SVDOverwrite
+	}
+	jobV1 := lapack.SVDNone
+	ldv1 := 1
+	if wantU {
+		jobV1 = lapack.SVDAll
+		ldv1 = n
+	}
+	ok = impl.Dgesvj(jobX, jobV1, n, n, x, ldx, s, v1, ldv1, wrk, len(wrk))
+
+	if wantV {
+		// Complete the columns of U_1 corresponding to zero
+		// singular values to an orthonormal basis.
+		completeOrthonormal(n, s, x, ldx, wrk[:n])
+
+		// V = P * U_1.
+		for i, p := range jpvt {
+			copy(v[p*ldv:p*ldv+n], x[i*ldx:i*ldx+n])
+		}
+	}
+
+	if wantU {
+		ncu := n
+		if wantUA {
+			ncu = m
+		}
+		// U = Q * [V_1 0; 0 I].
+		impl.Dlaset(blas.All, m, ncu, 0, 1, u, ldu)
+		impl.Dlacpy(blas.All, n, n, v1, ldv1, u, ldu)
+		impl.Dormqr(blas.Left, blas.NoTrans, m, ncu, n, a, lda, tau, u, ldu, wrk, len(wrk))
+	}
+
+	work[0] = float64(n + n*n + nv1 + lwrk)
+	return ok
+}
+
+// completeOrthonormal replaces the columns of the n×n matrix Q that correspond
+// to zero values in s by unit vectors orthogonal to all other columns, so that
+// on return Q is orthogonal. The columns of Q corresponding to non-zero values
+// in s must be orthonormal on entry. work must have length n.
+func completeOrthonormal(n int, s, q []float64, ldq int, work []float64) {
+	bi := blas64.Implementation()
+	done := make([]bool, n)
+	for j, sv := range s {
+		done[j] = sv != 0
+	}
+	k := 0
+	for j := range s {
+		if done[j] {
+			continue
+		}
+		// Try the unit vectors in turn until one has a substantial
+		// component orthogonal to the current columns.
+		for ; k < n; k++ {
+			for i := range work {
+				work[i] = 0
+			}
+			work[k] = 1
+			// Classical Gram-Schmidt with reorthogonalization.
+			for range 2 {
+				for l := 0; l < n; l++ {
+					if !done[l] {
+						continue
+					}
+					d := bi.Ddot(n, q[l:], ldq, work, 1)
+					bi.Daxpy(n, -d, q[l:], ldq, work, 1)
+				}
+			}
+			nrm := bi.Dnrm2(n, work, 1)
+			if nrm > 0.5 {
+				bi.Dscal(n, 1/nrm, work, 1)
+				bi.Dcopy(n, work, 1, q[j:], ldq)
+				done[j] = true
+				k++
+				break
+			}
+		}
+	}
+}
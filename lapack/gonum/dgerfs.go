@@ -0,0 +1,209 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dgerfs improves the computed solution to a system of linear equations
+//
+//	A * X = B   if trans == blas.NoTrans,
+//	Aᵀ * X = B  if trans == blas.Trans or blas.ConjTrans,
+//
+// where A is an n×n matrix and X and B are n×nrhs matrices, and provides
+// error bounds for the solution.
+//
+// a contains the original matrix A and af contains the LU factorization of A
+// as computed by Dgetrf, with the pivot indices in ipiv. b contains the right
+// hand side matrix B. On entry, x contains the solution matrix X as computed
+// by Dgetrs. On return, x contains the improved solution.
+//
+// On return, ferr[j] is an estimated forward error bound for the jth solution
+// vector x_j,
+//
+//	‖x_j - xtrue_j‖_∞ / ‖x_j‖_∞ ≤ ferr[j],
+//
+// where xtrue_j is the true solution. The estimate is almost always a slight
+// overestimate of the true error. berr[j] is the componentwise relative
+// backward error of x_j, that is, the smallest relative change in any element
+// of A or B that makes x_j an exact solution. ferr and berr must have length
+// nrhs, otherwise Dgerfs will panic.
+//
+// work must have length at least 3*n and iwork must have length at least n,
+// otherwise Dgerfs will panic.
+//
+// Dgerfs is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dgerfs(trans blas.Transpose, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int) {
+	notran := trans == blas.NoTrans
+	switch {
+	case !notran && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldaf < max(1, n):
+		panic(badLdAF)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	case ldx < max(1, nrhs):
+		panic(badLdX)
+	case len(ferr) != nrhs:
+		panic(shortFerr)
+	case len(berr) != nrhs:
+		panic(shortBerr)
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		for j := range ferr {
+			ferr[j] = 0
+			berr[j] = 0
+		}
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(af) < (n-1)*ldaf+n:
+		panic(shortAF)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case len(x) < (n-1)*ldx+nrhs:
+		panic(shortX)
+	case len(work) < 3*n:
+		panic(shortWork)
+	case len(iwork) < n:
+		panic(shortIWork)
+	}
+
+	const itmax = 5
+
+	transt := blas.Trans
+	if !notran {
+		transt = blas.NoTrans
+	}
+
+	// nz is the maximum number of non-zero entries in each row of A,
+	// plus 1.
+	nz := n + 1
+	eps := dlamchE
+	safmin := dlamchS
+	safe1 := float64(nz) * safmin
+	safe2 := safe1 / eps
+
+	bi := blas64.Implementation()
+	// wabs holds |B| + |op(A)|*|X|, res holds the residual and v is
+	// workspace for Dlacn2.
+	wabs := work[:n]
+	res := work[n : 2*n]
+	v := work[2*n : 3*n]
+	isave := new([3]int)
+	for j := 0; j < nrhs; j++ {
+		count := 1
+		lstres := 3.0
+		for {
+			// Compute the residual R = B - op(A) * X.
+			bi.Dcopy(n, b[j:], ldb, res, 1)
+			bi.Dgemv(trans, n, n, -1, a, lda, x[j:], ldx, 1, res, 1)
+
+			// Compute the componentwise relative backward error
+			//  max_i |R_i| / (|op(A)|*|X| + |B|)_i.
+			for i := range wabs {
+				wabs[i] = math.Abs(b[i*ldb+j])
+			}
+			if notran {
+				for i := range wabs {
+					row := a[i*lda : i*lda+n]
+					var s float64
+					for k, aik := range row {
+						s += math.Abs(aik) * math.Abs(x[k*ldx+j])
+					}
+					wabs[i] += s
+				}
+			} else {
+				for k := 0; k < n; k++ {
+					xk := math.Abs(x[k*ldx+j])
+					row := a[k*lda : k*lda+n]
+					for i, aki := range row {
+						wabs[i] += math.Abs(aki) * xk
+					}
+				}
+			}
+			var s float64
+			for i, wi := range wabs {
+				if wi > safe2 {
+					s = math.Max(s, math.Abs(res[i])/wi)
+				} else {
+					s = math.Max(s, (math.Abs(res[i])+safe1)/(wi+safe1))
+				}
+			}
+			berr[j] = s
+
+			// Test stopping criterion. Continue iterating if the
+			// backward error is larger than machine epsilon, it
+			// has decreased by at least a factor of 2 and the
+			// number of iterations is at most itmax.
+			if berr[j] <= eps || 2*berr[j] > lstres || count > itmax {
+				break
+			}
+			// Update the solution and try again.
+			impl.Dgetrs(trans, n, 1, af, ldaf, ipiv, res, 1)
+			bi.Daxpy(n, 1, res, 1, x[j:], ldx)
+			lstres = berr[j]
+			count++
+		}
+
+		// Bound the error in the solution using
+		//  ‖X - XTRUE‖_∞ / ‖X‖_∞ ≤ ‖|inv(op(A))|*(|R| + nz*eps*(|op(A)|*|X|+|B|))‖_∞ / ‖X‖_∞
+		// where the norm of the matrix is estimated by Dlacn2.
+		for i, wi := range wabs {
+			if wi > safe2 {
+				wabs[i] = math.Abs(res[i]) + float64(nz)*eps*wi
+			} else {
+				wabs[i] = math.Abs(res[i]) + float64(nz)*eps*wi + safe1
+			}
+		}
+		var kase int
+		ferr[j] = 0
+		for {
+			ferr[j], kase = impl.Dlacn2(n, v, res, iwork, ferr[j], kase, isave)
+			if kase == 0 {
+				break
+			}
+			if kase == 1 {
+				// Multiply by diag(W)*inv(op(A))ᵀ.
+				impl.Dgetrs(transt, n, 1, af, ldaf, ipiv, res, 1)
+				for i, wi := range wabs {
+					res[i] *= wi
+				}
+			} else {
+				// Multiply by inv(op(A))*diag(W).
+				for i, wi := range wabs {
+					res[i] *= wi
+				}
+				impl.Dgetrs(trans, n, 1, af, ldaf, ipiv, res, 1)
+			}
+		}
+
+		// Normalize the error.
+		var xnorm float64
+		for i := 0; i < n; i++ {
+			xnorm = math.Max(xnorm, math.Abs(x[i*ldx+j]))
+		}
+		if xnorm != 0 {
+			ferr[j] /= xnorm
+		}
+	}
+}
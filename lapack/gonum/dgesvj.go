@@ -0,0 +1,190 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dgesvj computes the singular value decomposition of an m×n matrix A with
+// m >= n using the one-sided Jacobi method,
+//
+//	A = U * Σ * Vᵀ,
+//
+// where U is an m×n matrix with orthonormal columns, Σ is an n×n diagonal
+// matrix and V is an n×n orthogonal matrix.
+//
+// The one-sided Jacobi method applies plane rotations from the right to
+// orthogonalize the columns of A. It computes the singular values of matrices
+// of the form A = B * D, where D is diagonal and B has well-conditioned
+// columns, to high relative accuracy regardless of the scaling D. In
+// particular, the small singular values of a matrix with badly scaled columns
+// are computed much more accurately than by Dgesvd.
+//
+// jobU specifies whether U is computed. If jobU is lapack.SVDOverwrite, the
+// columns of U are stored in a on return; columns corresponding to zero
+// singular values are zero. If jobU is lapack.SVDNone, U is not computed and
+// a is destroyed on return. Otherwise Dgesvj will panic.
+//
+// jobV specifies whether V is computed. If jobV is lapack.SVDAll, V is stored
+// in v on return. If jobV is lapack.SVDNone, v is not referenced. Otherwise
+// Dgesvj will panic.
+//
+// On return, s contains the singular values of A in decreasing order. s must
+// have length n.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// max(1,n), otherwise Dgesvj will panic. If lwork is -1, instead of computing
+// the decomposition, Dgesvj only calculates the optimal workspace size and
+// stores it into work[0].
+//
+// Dgesvj returns whether the iteration converged within the maximum number of
+// sweeps. If it did not, the contents of a, s and v are an approximation of
+// the decomposition.
+func (impl Implementation) Dgesvj(jobU, jobV lapack.SVDJob, m, n int, a []float64, lda int, s, v []float64, ldv int, work []float64, lwork int) (ok bool) {
+	wantU := jobU == lapack.SVDOverwrite
+	wantV := jobV == lapack.SVDAll
+	switch {
+	case !wantU && jobU != lapack.SVDNone:
+		panic(badSVDJob)
+	case !wantV && jobV != lapack.SVDNone:
+		panic(badSVDJob)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case n > m:
+		panic(nGTM)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldv < 1, wantV && ldv < n:
+		panic(badLdV)
+	case lwork < max(1, n) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	work[0] = float64(max(1, n))
+	if lwork == -1 {
+		return true
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(s) != n:
+		panic(shortS)
+	case wantV && len(v) < (n-1)*ldv+n:
+		panic(shortV)
+	}
+
+	const maxSweeps = 30
+
+	bi := blas64.Implementation()
+	if wantV {
+		impl.Dlaset('A', n, n, 0, 1, v, ldv)
+	}
+
+	// norms holds the Euclidean norms of the columns of A.
+	norms := work[:n]
+	for j := range norms {
+		norms[j] = bi.Dnrm2(m, a[j:], lda)
+	}
+
+	// Columns are treated as orthogonal if the cosine
+	// of the angle between them is at most tol.
+	tol := math.Sqrt(float64(m)) * dlamchE
+
+	ok = false
+	for sweep := 0; sweep < maxSweeps; sweep++ {
+		rotated := false
+		for p := 0; p < n-1; p++ {
+			// Move the column with the largest norm among the
+			// remaining columns into position p (de Rijk's
+			// pivoting) to improve convergence.
+			q := p + bi.Idamax(n-p, norms[p:], 1)
+			if q != p {
+				bi.Dswap(m, a[p:], lda, a[q:], lda)
+				if wantV {
+					bi.Dswap(n, v[p:], ldv, v[q:], ldv)
+				}
+				norms[p], norms[q] = norms[q], norms[p]
+			}
+			for q := p + 1; q < n; q++ {
+				ap := norms[p]
+				aq := norms[q]
+				if ap == 0 || aq == 0 {
+					continue
+				}
+				// Compute the cosine of the angle between columns
+				// p and q without overflow or underflow of the
+				// intermediate products.
+				var cos float64
+				for i := 0; i < m; i++ {
+					cos += (a[i*lda+p] / ap) * (a[i*lda+q] / aq)
+				}
+				if math.Abs(cos) <= tol {
+					continue
+				}
+				rotated = true
+
+				// Compute the rotation that makes columns p and q
+				// orthogonal.
+				zeta := (aq/ap - ap/aq) / (2 * cos)
+				var t float64
+				if math.Abs(zeta) > 1/dlamchE {
+					t = 1 / (2 * zeta)
+				} else {
+					t = math.Copysign(1/(math.Abs(zeta)+math.Sqrt(1+zeta*zeta)), zeta)
+				}
+				c := 1 / math.Sqrt(1+t*t)
+				sn := c * t
+
+				bi.Drot(m, a[p:], lda, a[q:], lda, c, -sn)
+				if wantV {
+					bi.Drot(n, v[p:], ldv, v[q:], ldv, c, -sn)
+				}
+				norms[p] = bi.Dnrm2(m, a[p:], lda)
+				norms[q] = bi.Dnrm2(m, a[q:], lda)
+			}
+		}
+		if !rotated {
+			ok = true
+			break
+		}
+	}
+
+	// Sort the singular values into decreasing order.
+	for p := 0; p < n-1; p++ {
+		q := p + bi.Idamax(n-p, norms[p:], 1)
+		if q != p {
+			bi.Dswap(m, a[p:], lda, a[q:], lda)
+			if wantV {
+				bi.Dswap(n, v[p:], ldv, v[q:], ldv)
+			}
+			norms[p], norms[q] = norms[q], norms[p]
+		}
+	}
+	copy(s, norms)
+
+	if wantU {
+		for j, sv := range s {
+			if sv != 0 {
+				impl.Dlascl(lapack.General, 0, 0, sv, 1, m, 1, a[j:], lda)
+			}
+		}
+	}
+	work[0] = float64(max(1, n))
+	return ok
+}
@@ -0,0 +1,190 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dgesvx computes the solution to a real system of linear equations
+//
+//	A * X = B   if trans == blas.NoTrans,
+//	Aᵀ * X = B  if trans == blas.Trans or blas.ConjTrans,
+//
+// where A is an n×n matrix and X and B are n×nrhs matrices, using the LU
+// factorization of A. Error bounds on the solution and a condition estimate
+// are also provided.
+//
+// Dgesvx performs the following steps:
+//
+//  1. If equilibrate is true, real scaling factors are computed by Dgeequ to
+//     equilibrate the system and, if the scaling is worthwhile, A is
+//     overwritten by diag(R)*A*diag(C) and B by diag(R)*B (if trans is
+//     blas.NoTrans) or diag(C)*B (otherwise). The form of equilibration
+//     that was applied is returned in equed.
+//  2. The LU decomposition with partial pivoting is used to factor the
+//     (equilibrated) matrix A as A = P * L * U and the factors are stored
+//     in af with the pivot indices in ipiv.
+//  3. If ok is true, the reciprocal of the condition number of A in the
+//     1-norm (if trans is blas.NoTrans) or the ∞-norm (otherwise) is
+//     estimated and returned in rcond.
+//  4. The system of equations is solved for X using the factored form of A
+//     and iterative refinement is applied to improve the computed solution
+//     and to calculate error bounds and backward error estimates for it
+//     using Dgerfs.
+//  5. If equilibration was used, X is premultiplied by diag(C) (if trans is
+//     blas.NoTrans) or diag(R) (otherwise) so that it solves the original
+//     system before equilibration.
+//
+// r and c must have length n if equilibrate is true, otherwise they are not
+// referenced. On return, they contain the row and column scale factors of A.
+//
+// On return, x contains the solution X. ferr[j] is an estimated forward
+// error bound and berr[j] the componentwise relative backward error for the
+// jth solution vector. ferr and berr must have length nrhs.
+//
+// work must have length at least 4*n and iwork must have length at least n,
+// otherwise Dgesvx will panic.
+//
+// Dgesvx returns whether the factorization completed successfully. If ok is
+// false, A is exactly singular, rcond is zero and X has not been computed. If
+// ok is true but rcond is less than the machine precision, A is singular to
+// working precision and the solution may be inaccurate.
+func (impl Implementation) Dgesvx(equilibrate bool, trans blas.Transpose, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []int, r, c []float64, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int) (equed lapack.EquilibrationType, rcond float64, ok bool) {
+	notran := trans == blas.NoTrans
+	switch {
+	case !notran && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldaf < max(1, n):
+		panic(badLdAF)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	case ldx < max(1, nrhs):
+		panic(badLdX)
+	case len(ferr) != nrhs:
+		panic(shortFerr)
+	case len(berr) != nrhs:
+		panic(shortBerr)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		for j := range ferr {
+			ferr[j] = 0
+			berr[j] = 0
+		}
+		return lapack.EquilibrateNone, 1, true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(af) < (n-1)*ldaf+n:
+		panic(shortAF)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	case equilibrate && len(r) != n:
+		panic(shortR)
+	case equilibrate && len(c) != n:
+		panic(shortC)
+	case nrhs > 0 && len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case nrhs > 0 && len(x) < (n-1)*ldx+nrhs:
+		panic(shortX)
+	case len(work) < 4*n:
+		panic(shortWork)
+	case len(iwork) < n:
+		panic(shortIWork)
+	}
+
+	// Compute row and column scalings to equilibrate A and apply them if
+	// worthwhile.
+	equed = lapack.EquilibrateNone
+	var rowcnd, colcnd float64
+	if equilibrate {
+		var amax float64
+		var eqok bool
+		rowcnd, colcnd, amax, eqok = impl.Dgeequ(n, n, a, lda, r, c)
+		if eqok {
+			equed = impl.Dlaqge(n, n, a, lda, r, c, rowcnd, colcnd, amax)
+		}
+	}
+	rowequ := equed == lapack.EquilibrateRows || equed == lapack.EquilibrateBoth
+	colequ := equed == lapack.EquilibrateCols || equed == lapack.EquilibrateBoth
+
+	// Scale the right hand side.
+	var scale []float64
+	switch {
+	case notran && rowequ:
+		scale = r
+	case !notran && colequ:
+		scale = c
+	}
+	if scale != nil && nrhs > 0 {
+		for i, si := range scale {
+			row := b[i*ldb : i*ldb+nrhs]
+			for j := range row {
+				row[j] *= si
+			}
+		}
+	}
+
+	// Compute the LU factorization of A.
+	impl.Dlacpy(blas.All, n, n, a, lda, af, ldaf)
+	ok = impl.Dgetrf(n, n, af, ldaf, ipiv)
+	if !ok {
+		return equed, 0, false
+	}
+
+	// Estimate the reciprocal of the condition number of A.
+	norm := lapack.MaxColumnSum
+	if !notran {
+		norm = lapack.MaxRowSum
+	}
+	anorm := impl.Dlange(norm, n, n, a, lda, work)
+	rcond = impl.Dgecon(norm, n, af, ldaf, anorm, work, iwork)
+
+	if nrhs == 0 {
+		return equed, rcond, true
+	}
+
+	// Compute the solution matrix X and use iterative refinement to
+	// improve it and compute error bounds.
+	impl.Dlacpy(blas.All, n, nrhs, b, ldb, x, ldx)
+	impl.Dgetrs(trans, n, nrhs, af, ldaf, ipiv, x, ldx)
+	impl.Dgerfs(trans, n, nrhs, a, lda, af, ldaf, ipiv, b, ldb, x, ldx, ferr, berr, work, iwork)
+
+	// Transform the solution X to the solution of the original system.
+	scale = nil
+	cnd := 1.0
+	switch {
+	case notran && colequ:
+		scale = c
+		cnd = colcnd
+	case !notran && rowequ:
+		scale = r
+		cnd = rowcnd
+	}
+	if scale != nil {
+		for i, si := range scale {
+			row := x[i*ldx : i*ldx+nrhs]
+			for j := range row {
+				row[j] *= si
+			}
+		}
+		for j := range ferr {
+			ferr[j] /= cnd
+		}
+	}
+
+	return equed, rcond, true
+}
@@ -0,0 +1,86 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/lapack"
+
+// Dlaqge equilibrates an m×n matrix A using the row and column scale factors
+// in r and c computed by Dgeequ. Row scaling is applied only if rowcnd is
+// less than 0.1 or amax is close to underflow or overflow, and column scaling
+// is applied only if colcnd is less than 0.1. On return, a contains the
+// equilibrated matrix and equed specifies the form of equilibration that was
+// done.
+//
+// r must have length m and c must have length n, otherwise Dlaqge will panic.
+//
+// Dlaqge is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlaqge(m, n int, a []float64, lda int, r, c []float64, rowcnd, colcnd, amax float64) (equed lapack.EquilibrationType) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return lapack.EquilibrateNone
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(r) != m:
+		panic(shortR)
+	case len(c) != n:
+		panic(shortC)
+	}
+
+	const (
+		// thresh is the threshold value used to decide whether
+		// scaling should be done based on the ratio of the scaling
+		// factors.
+		thresh = 0.1
+		// small and large are the threshold values used to decide
+		// whether scaling should be done based on the absolute size
+		// of the largest element.
+		small = dlamchS / dlamchP
+		large = 1 / small
+	)
+
+	if rowcnd >= thresh && amax >= small && amax <= large {
+		if colcnd >= thresh {
+			return lapack.EquilibrateNone
+		}
+		// Column scaling.
+		for i := 0; i < m; i++ {
+			row := a[i*lda : i*lda+n]
+			for j, cj := range c {
+				row[j] *= cj
+			}
+		}
+		return lapack.EquilibrateCols
+	}
+	if colcnd >= thresh {
+		// Row scaling.
+		for i, ri := range r {
+			row := a[i*lda : i*lda+n]
+			for j := range row {
+				row[j] *= ri
+			}
+		}
+		return lapack.EquilibrateRows
+	}
+	// Row and column scaling.
+	for i, ri := range r {
+		row := a[i*lda : i*lda+n]
+		for j, cj := range c {
+			row[j] *= ri * cj
+		}
+	}
+	return lapack.EquilibrateBoth
+}
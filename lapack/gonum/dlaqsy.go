@@ -0,0 +1,72 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// Dlaqsy equilibrates a symmetric n×n matrix A using the scale factors in s
+// computed by Dpoequ. Scaling is applied only if scond is less than 0.1 or
+// amax is close to underflow or overflow. If scaling is applied, the
+// triangle of a specified by uplo is overwritten by the corresponding
+// triangle of diag(S)*A*diag(S) and equed is true.
+//
+// s must have length n, otherwise Dlaqsy will panic.
+//
+// Dlaqsy is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlaqsy(uplo blas.Uplo, n int, a []float64, lda int, s []float64, scond, amax float64) (equed bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return false
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(s) != n:
+		panic(shortS)
+	}
+
+	const (
+		// thresh is the threshold value used to decide whether
+		// scaling should be done based on the ratio of the scaling
+		// factors.
+		thresh = 0.1
+		// small and large are the threshold values used to decide
+		// whether scaling should be done based on the absolute size
+		// of the largest element.
+		small = dlamchS / dlamchP
+		large = 1 / small
+	)
+
+	if scond >= thresh && amax >= small && amax <= large {
+		return false
+	}
+
+	if uplo == blas.Upper {
+		for i, si := range s {
+			row := a[i*lda+i : i*lda+n]
+			for j, sj := range s[i:] {
+				row[j] *= si * sj
+			}
+		}
+	} else {
+		for i, si := range s {
+			row := a[i*lda : i*lda+i+1]
+			for j, sj := range s[:i+1] {
+				row[j] *= si * sj
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,69 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dpoequ computes row and column scalings intended to equilibrate a symmetric
+// positive definite n×n matrix A and reduce its condition number in the
+// 2-norm. The scale factors are chosen so that the scaled matrix
+//
+//	diag(S) * A * diag(S)
+//
+// has ones on the diagonal. This choice of S puts the condition number of the
+// scaled matrix within a factor n of the smallest possible condition number
+// over all possible diagonal scalings.
+//
+// s must have length n, otherwise Dpoequ will panic. On return, it contains
+// the scale factors s[i] = 1/sqrt(A[i,i]).
+//
+// scond is the ratio of the smallest to the largest scale factor. If
+// scond >= 0.1 and amax is neither too large nor too small, it is not worth
+// scaling by S. amax is the absolute value of the largest diagonal element of
+// A.
+//
+// Dpoequ returns whether the computation was successful. If a diagonal
+// element of A is not positive, ok is false and s and scond must not be used.
+//
+// Dpoequ is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dpoequ(n int, a []float64, lda int, s []float64) (scond, amax float64, ok bool) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 1, 0, true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(s) != n:
+		panic(shortS)
+	}
+
+	// Find the minimum and maximum diagonal elements.
+	smin := a[0]
+	amax = a[0]
+	for i := range s {
+		s[i] = a[i*lda+i]
+		smin = math.Min(smin, s[i])
+		amax = math.Max(amax, s[i])
+	}
+	if smin <= 0 {
+		// A has a non-positive diagonal element.
+		return 0, amax, false
+	}
+
+	for i, v := range s {
+		s[i] = 1 / math.Sqrt(v)
+	}
+	scond = math.Sqrt(smin) / math.Sqrt(amax)
+	return scond, amax, true
+}
@@ -0,0 +1,157 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dposvx computes the solution to a real system of linear equations
+//
+//	A * X = B,
+//
+// where A is an n×n symmetric positive definite matrix and X and B are
+// n×nrhs matrices, using the Cholesky factorization of A. Error bounds on the
+// solution and a condition estimate are also provided.
+//
+// Dposvx performs the following steps:
+//
+//  1. If equilibrate is true, real scaling factors are computed by Dpoequ to
+//     equilibrate the system and, if the scaling is worthwhile, the triangle
+//     of A specified by uplo is overwritten by the corresponding triangle of
+//     diag(S)*A*diag(S), B is overwritten by diag(S)*B and equed is true.
+//  2. The Cholesky decomposition is used to factor the (equilibrated) matrix
+//     A as A = Uᵀ * U if uplo is blas.Upper or A = L * Lᵀ if uplo is
+//     blas.Lower and the factor is stored in af.
+//  3. If ok is true, the reciprocal of the condition number of A in the
+//     1-norm is estimated and returned in rcond.
+//  4. The system of equations is solved for X using the factored form of A
+//     and iterative refinement is applied to improve the computed solution
+//     and to calculate error bounds and backward error estimates for it
+//     using Dporfs.
+//  5. If equilibration was used, X is premultiplied by diag(S) so that it
+//     solves the original system before equilibration.
+//
+// s must have length n if equilibrate is true, otherwise it is not
+// referenced. On return, it contains the scale factors of A.
+//
+// On return, x contains the solution X. ferr[j] is an estimated forward
+// error bound and berr[j] the componentwise relative backward error for the
+// jth solution vector. ferr and berr must have length nrhs.
+//
+// work must have length at least 3*n and iwork must have length at least n,
+// otherwise Dposvx will panic.
+//
+// Dposvx returns whether the factorization completed successfully. If ok is
+// false, A is not positive definite, rcond is zero and X has not been
+// computed. If ok is true but rcond is less than the machine precision, A is
+// singular to working precision and the solution may be inaccurate.
+func (impl Implementation) Dposvx(equilibrate bool, uplo blas.Uplo, n, nrhs int, a []float64, lda int, af []float64, ldaf int, s []float64, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int) (equed bool, rcond float64, ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldaf < max(1, n):
+		panic(badLdAF)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	case ldx < max(1, nrhs):
+		panic(badLdX)
+	case len(ferr) != nrhs:
+		panic(shortFerr)
+	case len(berr) != nrhs:
+		panic(shortBerr)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		for j := range ferr {
+			ferr[j] = 0
+			berr[j] = 0
+		}
+		return false, 1, true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(af) < (n-1)*ldaf+n:
+		panic(shortAF)
+	case equilibrate && len(s) != n:
+		panic(shortS)
+	case nrhs > 0 && len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case nrhs > 0 && len(x) < (n-1)*ldx+nrhs:
+		panic(shortX)
+	case len(work) < 3*n:
+		panic(shortWork)
+	case len(iwork) < n:
+		panic(shortIWork)
+	}
+
+	// Compute scaling factors to equilibrate A and apply them if
+	// worthwhile.
+	var scond float64
+	if equilibrate {
+		var amax float64
+		var eqok bool
+		scond, amax, eqok = impl.Dpoequ(n, a, lda, s)
+		if eqok {
+			equed = impl.Dlaqsy(uplo, n, a, lda, s, scond, amax)
+		}
+	}
+
+	// Scale the right hand side.
+	if equed && nrhs > 0 {
+		for i, si := range s {
+			row := b[i*ldb : i*ldb+nrhs]
+			for j := range row {
+				row[j] *= si
+			}
+		}
+	}
+
+	// Compute the Cholesky factorization of A.
+	impl.Dlacpy(uplo, n, n, a, lda, af, ldaf)
+	ok = impl.Dpotrf(uplo, n, af, ldaf)
+	if !ok {
+		return equed, 0, false
+	}
+
+	// Estimate the reciprocal of the condition number of A.
+	anorm := impl.Dlansy(lapack.MaxColumnSum, uplo, n, a, lda, work)
+	rcond = impl.Dpocon(uplo, n, af, ldaf, anorm, work, iwork)
+
+	if nrhs == 0 {
+		return equed, rcond, true
+	}
+
+	// Compute the solution matrix X and use iterative refinement to
+	// improve it and compute error bounds.
+	impl.Dlacpy(blas.All, n, nrhs, b, ldb, x, ldx)
+	impl.Dpotrs(uplo, n, nrhs, af, ldaf, x, ldx)
+	impl.Dporfs(uplo, n, nrhs, a, lda, af, ldaf, b, ldb, x, ldx, ferr, berr, work, iwork)
+
+	// Transform the solution X to the solution of the original system.
+	if equed {
+		for i, si := range s {
+			row := x[i*ldx : i*ldx+nrhs]
+			for j := range row {
+				row[j] *= si
+			}
+		}
+		for j := range ferr {
+			ferr[j] /= scond
+		}
+	}
+
+	return equed, rcond, true
+}
@@ -117,8 +117,10 @@ const (
 	// Panic strings for insufficient slice lengths.
 	shortA     = "lapack: insufficient length of a"
 	shortAB    = "lapack: insufficient length of ab"
+	shortAF    = "lapack: insufficient length of af"
 	shortAuxv  = "lapack: insufficient length of auxv"
 	shortB     = "lapack: insufficient length of b"
+	shortBerr  = "lapack: insufficient length of berr"
 	shortC     = "lapack: insufficient length of c"
 	shortCNorm = "lapack: insufficient length of cnorm"
 	shortD     = "lapack: insufficient length of d"
@@ -126,10 +128,12 @@ const (
 	shortDU    = "lapack: insufficient length of du"
 	shortE     = "lapack: insufficient length of e"
 	shortF     = "lapack: insufficient length of f"
+	shortFerr  = "lapack: insufficient length of ferr"
 	shortH     = "lapack: insufficient length of h"
 	shortIWork = "lapack: insufficient length of iwork"
 	shortIsgn  = "lapack: insufficient length of isgn"
 	shortQ     = "lapack: insufficient length of q"
+	shortR     = "lapack: insufficient length of r"
 	shortRHS   = "lapack: insufficient length of rhs"
 	shortS     = "lapack: insufficient length of s"
 	shortScale = "lapack: insufficient length of scale"
@@ -156,6 +160,7 @@ const (
 
 	// Panic strings for bad leading dimensions of matrices.
 	badLdA    = "lapack: bad leading dimension of A"
+	badLdAF   = "lapack: bad leading dimension of AF"
 	badLdB    = "lapack: bad leading dimension of B"
 	badLdC    = "lapack: bad leading dimension of C"
 	badLdF    = "lapack: bad leading dimension of F"
@@ -47,6 +47,11 @@ func TestDgecon(t *testing.T) {
 	testlapack.DgeconTest(t, impl)
 }
 
+func TestDgeequ(t *testing.T) {
+	t.Parallel()
+	testlapack.DgeequTest(t, impl)
+}
+
 func TestDgeev(t *testing.T) {
 	t.Parallel()
 	testlapack.DgeevTest(t, impl)
@@ -62,6 +67,12 @@ func TestDgehrd(t *testing.T) {
 	testlapack.DgehrdTest(t, impl)
 }
 
+func TestDgejsv(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	testlapack.DgejsvTest(t, impl, tol)
+}
+
 func TestDgelqf(t *testing.T) {
 	t.Parallel()
 	testlapack.DgelqfTest(t, impl)
@@ -123,6 +134,17 @@ func TestDgesvd(t *testing.T) {
 	testlapack.DgesvdTest(t, impl, tol)
 }
 
+func TestDgesvx(t *testing.T) {
+	t.Parallel()
+	testlapack.DgesvxTest(t, impl)
+}
+
+func TestDgesvj(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	testlapack.DgesvjTest(t, impl, tol)
+}
+
 func TestDgetc2(t *testing.T) {
 	t.Parallel()
 	testlapack.Dgetc2Test(t, impl)
@@ -548,6 +570,16 @@ func TestDpocon(t *testing.T) {
 	testlapack.DpoconTest(t, impl)
 }
 
+func TestDpoequ(t *testing.T) {
+	t.Parallel()
+	testlapack.DpoequTest(t, impl)
+}
+
+func TestDposvx(t *testing.T) {
+	t.Parallel()
+	testlapack.DposvxTest(t, impl)
+}
+
 func TestDpotf2(t *testing.T) {
 	t.Parallel()
 	testlapack.Dpotf2Test(t, impl)
@@ -178,6 +178,17 @@ const (
 	BalanceNone  BalanceJob = 'N'
 )
 
+// EquilibrationType specifies the form of equilibration applied to a matrix
+// by Dlaqge and Dgesvx.
+type EquilibrationType byte
+
+const (
+	EquilibrateNone EquilibrationType = 'N' // No equilibration.
+	EquilibrateRows EquilibrationType = 'R' // Row equilibration, A is replaced by diag(R)*A.
+	EquilibrateCols EquilibrationType = 'C' // Column equilibration, A is replaced by A*diag(C).
+	EquilibrateBoth EquilibrationType = 'B' // Row and column equilibration, A is replaced by diag(R)*A*diag(C).
+)
+
 // SchurJob specifies whether the Schur form is computed in Dhseqr.
 type SchurJob byte
 
@@ -354,6 +354,104 @@ func Gesvd(jobU, jobVT lapack.SVDJob, a, u, vt blas64.General, s, work []float64
 	return lapack64.Dgesvd(jobU, jobVT, a.Rows, a.Cols, a.Data, max(1, a.Stride), s, u.Data, max(1, u.Stride), vt.Data, max(1, vt.Stride), work, lwork)
 }
 
+// Gejsv computes the singular value decomposition of an m×n matrix A with
+// m >= n to high relative accuracy,
+//
+//	A = U * Sigma * Vᵀ
+//
+// where Sigma is an n×n diagonal matrix containing the singular values of A in
+// decreasing order, U is an m×m orthogonal matrix and V is an n×n orthogonal
+// matrix. Gejsv uses a QR factorization with column pivoting followed by the
+// one-sided Jacobi method and is slower than Gesvd, but computes the singular
+// values of matrices with badly scaled columns to high relative accuracy.
+//
+// jobU and jobV are options for computing the singular vectors. The behavior
+// is as follows
+//
+//	jobU == lapack.SVDAll    All m columns of U are returned in u
+//	jobU == lapack.SVDStore  The first n columns of U are returned in u
+//	jobU == lapack.SVDNone   The columns of U are not computed
+//	jobV == lapack.SVDAll    V is returned in v
+//	jobV == lapack.SVDNone   V is not computed.
+//
+// On entry, a contains the data for the matrix A. During the call to Gejsv
+// the data is overwritten.
+//
+// s must have length n. iwork must have length at least n.
+//
+// work is a slice for storing temporary memory, and lwork is the usable size of
+// the slice. lwork must be at least n + 2*n*n + max(3*n+1, m). If lwork == -1,
+// instead of performing Gejsv, the optimal work length will be stored into
+// work[0].
+//
+// Gejsv returns whether the decomposition successfully completed.
+//
+// Dgejsv is not part of the lapack.Float64 interface and so calls to Gejsv are
+// always executed by the Gonum implementation.
+func Gejsv(jobU, jobV lapack.SVDJob, a, u, v blas64.General, s, work []float64, lwork int, iwork []int) (ok bool) {
+	return gonum.Implementation{}.Dgejsv(jobU, jobV, a.Rows, a.Cols, a.Data, max(1, a.Stride), s, u.Data, max(1, u.Stride), v.Data, max(1, v.Stride), work, lwork, iwork)
+}
+
+// Gesvj computes the singular value decomposition of an m×n matrix A with
+// m >= n using the one-sided Jacobi method,
+//
+//	A = U * Sigma * Vᵀ
+//
+// where U is an m×n matrix with orthonormal columns, Sigma is an n×n diagonal
+// matrix containing the singular values of A in decreasing order and V is an
+// n×n orthogonal matrix.
+//
+// If jobU == lapack.SVDOverwrite, U is written into a. If jobU ==
+// lapack.SVDNone, U is not computed and a is overwritten. If jobV ==
+// lapack.SVDAll, V is returned in v. If jobV == lapack.SVDNone, V is not
+// computed.
+//
+// s must have length n.
+//
+// work is a slice for storing temporary memory, and lwork is the usable size of
+// the slice. lwork must be at least max(1,n). If lwork == -1, instead of
+// performing Gesvj, the optimal work length will be stored into work[0].
+//
+// Gesvj returns whether the Jacobi iteration converged.
+//
+// Dgesvj is not part of the lapack.Float64 interface and so calls to Gesvj are
+// always executed by the Gonum implementation.
+func Gesvj(jobU, jobV lapack.SVDJob, a, v blas64.General, s, work []float64, lwork int) (ok bool) {
+	return gonum.Implementation{}.Dgesvj(jobU, jobV, a.Rows, a.Cols, a.Data, max(1, a.Stride), s, v.Data, max(1, v.Stride), work, lwork)
+}
+
+// Gesvx computes the solution to a real system of linear equations
+//
+//	A * X = B   if trans == blas.NoTrans,
+//	Aᵀ * X = B  if trans == blas.Trans or blas.ConjTrans,
+//
+// where A is an n×n matrix and X and B are n×nrhs matrices, and provides an
+// estimate of the reciprocal condition number of A together with forward and
+// backward error bounds for the solution computed with iterative refinement.
+//
+// If equilibrate is true, the rows and columns of A are scaled to improve the
+// conditioning of the system when that is worthwhile. In that case a and b
+// are overwritten by the equilibrated system and r and c, which must have
+// length n, contain the row and column scale factors. The equilibration that
+// was applied is returned in equed.
+//
+// On return, af contains the LU factorization of the (equilibrated) matrix
+// A with pivots in ipiv, and x contains the solution of the original system.
+// ferr and berr must have length nrhs and contain the forward error bound and
+// the componentwise relative backward error of each solution vector.
+//
+// work must have length at least 4*n and iwork must have length at least n.
+//
+// Gesvx returns whether A is non-singular. If rcond is less than the machine
+// precision, A is singular to working precision and the solution may be
+// inaccurate.
+//
+// Dgesvx is not part of the lapack.Float64 interface and so calls to Gesvx are
+// always executed by the Gonum implementation.
+func Gesvx(equilibrate bool, trans blas.Transpose, a, af blas64.General, ipiv []int, r, c []float64, b, x blas64.General, ferr, berr, work []float64, iwork []int) (equed lapack.EquilibrationType, rcond float64, ok bool) {
+	return gonum.Implementation{}.Dgesvx(equilibrate, trans, a.Rows, b.Cols, a.Data, max(1, a.Stride), af.Data, max(1, af.Stride), ipiv, r, c, b.Data, max(1, b.Stride), x.Data, max(1, x.Stride), ferr, berr, work, iwork)
+}
+
 // Getrf computes the LU decomposition of an m×n matrix A using partial
 // pivoting with row interchanges.
 //
@@ -780,6 +878,38 @@ func Pocon(a blas64.Symmetric, anorm float64, work []float64, iwork []int) float
 	return lapack64.Dpocon(a.Uplo, a.N, a.Data, max(1, a.Stride), anorm, work, iwork)
 }
 
+// Posvx computes the solution to a real system of linear equations
+//
+//	A * X = B,
+//
+// where A is an n×n symmetric positive definite matrix and X and B are
+// n×nrhs matrices, and provides an estimate of the reciprocal condition number
+// of A together with forward and backward error bounds for the solution
+// computed with iterative refinement.
+//
+// If equilibrate is true, A is scaled symmetrically to improve the
+// conditioning of the system when that is worthwhile. In that case a and b
+// are overwritten by the equilibrated system, s, which must have length n,
+// contains the scale factors and equed is true.
+//
+// On return, af contains the Cholesky factor of the (equilibrated) matrix A
+// in the triangle given by a.Uplo, and x contains the solution of the
+// original system. ferr and berr must have length nrhs and contain the
+// forward error bound and the componentwise relative backward error of each
+// solution vector.
+//
+// work must have length at least 3*n and iwork must have length at least n.
+//
+// Posvx returns whether A is positive definite. If rcond is less than the
+// machine precision, A is singular to working precision and the solution may
+// be inaccurate.
+//
+// Dposvx is not part of the lapack.Float64 interface and so calls to Posvx are
+// always executed by the Gonum implementation.
+func Posvx(equilibrate bool, a blas64.Symmetric, af blas64.General, s []float64, b, x blas64.General, ferr, berr, work []float64, iwork []int) (equed bool, rcond float64, ok bool) {
+	return gonum.Implementation{}.Dposvx(equilibrate, a.Uplo, a.N, b.Cols, a.Data, max(1, a.Stride), af.Data, max(1, af.Stride), s, b.Data, max(1, b.Stride), x.Data, max(1, x.Stride), ferr, berr, work, iwork)
+}
+
 // Syev computes all eigenvalues and, optionally, the eigenvectors of a real
 // symmetric matrix A.
 //
@@ -0,0 +1,174 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgeequer interface {
+	Dgeequ(m, n int, a []float64, lda int, r, c []float64) (rowcnd, colcnd, amax float64, ok bool)
+	Dlaqge(m, n int, a []float64, lda int, r, c []float64, rowcnd, colcnd, amax float64) lapack.EquilibrationType
+}
+
+func DgeequTest(t *testing.T, impl Dgeequer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, m := range []int{0, 1, 2, 3, 5, 10} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10} {
+			for _, lda := range []int{max(1, n), n + 3} {
+				for _, scale := range []float64{1, 1e-200, 1e200} {
+					for _, spread := range []float64{0, 1, 8} {
+						dgeequTest(t, impl, rnd, m, n, lda, scale, spread)
+					}
+				}
+			}
+		}
+	}
+
+	// Check that zero rows and columns are detected.
+	for _, test := range []struct {
+		m, n    int
+		zeroRow int
+		zeroCol int
+	}{
+		{m: 3, n: 4, zeroRow: 1, zeroCol: -1},
+		{m: 3, n: 4, zeroRow: -1, zeroCol: 2},
+		{m: 1, n: 1, zeroRow: 0, zeroCol: 0},
+	} {
+		a := randomGeneral(test.m, test.n, test.n, rnd)
+		if test.zeroRow >= 0 {
+			for j := 0; j < test.n; j++ {
+				a.Data[test.zeroRow*a.Stride+j] = 0
+			}
+		}
+		if test.zeroCol >= 0 {
+			for i := 0; i < test.m; i++ {
+				a.Data[i*a.Stride+test.zeroCol] = 0
+			}
+		}
+		_, _, _, ok := impl.Dgeequ(test.m, test.n, a.Data, a.Stride, make([]float64, test.m), make([]float64, test.n))
+		if ok {
+			t.Errorf("m=%v,n=%v,zeroRow=%v,zeroCol=%v: unexpected success", test.m, test.n, test.zeroRow, test.zeroCol)
+		}
+	}
+}
+
+func dgeequTest(t *testing.T, impl Dgeequer, rnd *rand.Rand, m, n, lda int, scale, spread float64) {
+	const tol = 1e-14
+
+	name := fmt.Sprintf("m=%v,n=%v,lda=%v,scale=%v,spread=%v", m, n, lda, scale, spread)
+
+	// Generate a matrix with rows and columns scaled by factors
+	// in [scale*10^-spread, scale*10^spread].
+	a := randomGeneral(m, n, lda, rnd)
+	for i := 0; i < m; i++ {
+		ri := scale * math.Pow(10, spread*(2*rnd.Float64()-1))
+		for j := 0; j < n; j++ {
+			a.Data[i*lda+j] *= ri
+		}
+	}
+	for j := 0; j < n; j++ {
+		cj := math.Pow(10, spread*(2*rnd.Float64()-1))
+		for i := 0; i < m; i++ {
+			a.Data[i*lda+j] *= cj
+		}
+	}
+	aCopy := cloneGeneral(a)
+
+	r := nanSlice(m)
+	c := nanSlice(n)
+	rowcnd, colcnd, amax, ok := impl.Dgeequ(m, n, a.Data, lda, r, c)
+	if !ok {
+		t.Errorf("%v: unexpected failure", name)
+		return
+	}
+	if !equalGeneral(a, aCopy) {
+		t.Errorf("%v: unexpected modification of A", name)
+	}
+	if m == 0 || n == 0 {
+		return
+	}
+
+	wantAmax := dlange(lapack.MaxAbs, m, n, aCopy.Data, lda)
+	if amax != wantAmax {
+		t.Errorf("%v: unexpected amax; got %v, want %v", name, amax, wantAmax)
+	}
+
+	// Check that the largest element in each row and each column of the
+	// scaled matrix diag(R)*A*diag(C) has absolute value at most 1, and
+	// that each column attains it.
+	rowMax := make([]float64, m)
+	colMax := make([]float64, n)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			v := math.Abs(r[i] * a.Data[i*lda+j] * c[j])
+			rowMax[i] = math.Max(rowMax[i], v)
+			colMax[j] = math.Max(colMax[j], v)
+		}
+	}
+	for i, v := range rowMax {
+		if v > 1+tol {
+			t.Errorf("%v: row %v of scaled matrix has element %v greater than 1", name, i, v)
+		}
+	}
+	for j, v := range colMax {
+		if math.Abs(v-1) > tol {
+			t.Errorf("%v: column %v of scaled matrix has largest element %v, want 1", name, j, v)
+		}
+	}
+
+	// Check the ratios of the scale factors.
+	if got, want := rowcnd, ratioMinMax(r); math.Abs(got-want) > tol*want {
+		t.Errorf("%v: unexpected rowcnd; got %v, want %v", name, got, want)
+	}
+	if got, want := colcnd, ratioMinMax(c); math.Abs(got-want) > tol*want {
+		t.Errorf("%v: unexpected colcnd; got %v, want %v", name, got, want)
+	}
+
+	// Check that Dlaqge applies the scaling it reports.
+	equed := impl.Dlaqge(m, n, a.Data, lda, r, c, rowcnd, colcnd, amax)
+	rowequ := equed == lapack.EquilibrateRows || equed == lapack.EquilibrateBoth
+	colequ := equed == lapack.EquilibrateCols || equed == lapack.EquilibrateBoth
+	const thresh = 0.1
+	small := dlamchS / dlamchP
+	if wantRow := rowcnd < thresh || amax < small || amax > 1/small; rowequ != wantRow {
+		t.Errorf("%v: unexpected row equilibration; got %v, want %v", name, rowequ, wantRow)
+	}
+	if wantCol := colcnd < thresh; colequ != wantCol {
+		t.Errorf("%v: unexpected column equilibration; got %v, want %v", name, colequ, wantCol)
+	}
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			want := aCopy.Data[i*lda+j]
+			switch {
+			case rowequ && colequ:
+				want *= r[i] * c[j]
+			case rowequ:
+				want *= r[i]
+			case colequ:
+				want *= c[j]
+			}
+			if got := a.Data[i*lda+j]; got != want {
+				t.Errorf("%v: unexpected element (%v,%v) of equilibrated matrix; got %v, want %v", name, i, j, got, want)
+				return
+			}
+		}
+	}
+}
+
+// ratioMinMax returns the ratio of the smallest to the largest element of s.
+func ratioMinMax(s []float64) float64 {
+	lo, hi := s[0], s[0]
+	for _, v := range s {
+		lo = math.Min(lo, v)
+		hi = math.Max(hi, v)
+	}
+	return lo / hi
+}
@@ -0,0 +1,139 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgejsver interface {
+	Dgejsv(jobU, jobV lapack.SVDJob, m, n int, a []float64, lda int, s, u []float64, ldu int, v []float64, ldv int, work []float64, lwork int, iwork []int) (ok bool)
+}
+
+func DgejsvTest(t *testing.T, impl Dgejsver, tol float64) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, m := range []int{0, 1, 2, 3, 4, 5, 10, 50} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 50} {
+			if n > m {
+				continue
+			}
+			for _, mtype := range []int{1, 2, 3, 4, 5, 6} {
+				for _, wl := range []worklen{minimumWork, optimumWork} {
+					dgejsvTest(t, impl, m, n, mtype, wl, tol, rnd)
+				}
+			}
+		}
+	}
+}
+
+func dgejsvTest(t *testing.T, impl Dgejsver, m, n, mtype int, wl worklen, tol float64, rnd *rand.Rand) {
+	const tolOrtho = 1e-15
+
+	lda := n + 3
+	ldu := m + 5
+	ldv := n + 7
+
+	prefix := fmt.Sprintf("m=%v,n=%v,mtype=%v,work=%v", m, n, mtype, wl)
+
+	a, want, aNorm := svdTestMatrix(m, n, lda, mtype, rnd)
+	aCopy := make([]float64, len(a))
+	copy(aCopy, a)
+
+	var lwork int
+	switch wl {
+	case minimumWork:
+		lwork = max(1, n+2*n*n+max(3*n+1, m))
+		if n == 0 {
+			lwork = 1
+		}
+	case optimumWork:
+		work := make([]float64, 1)
+		impl.Dgejsv(lapack.SVDAll, lapack.SVDAll, m, n, a, lda, nil, nil, ldu, nil, ldv, work, -1, nil)
+		lwork = int(work[0])
+	}
+	work := nanSlice(lwork)
+	iwork := make([]int, n)
+
+	// Compute the full SVD.
+	s := nanSlice(n)
+	u := nanSlice(m * ldu)
+	v := nanSlice(n * ldv)
+	ok := impl.Dgejsv(lapack.SVDAll, lapack.SVDAll, m, n, a, lda, s, u, ldu, v, ldv, work, lwork, iwork)
+	if !ok {
+		t.Errorf("%v: Dgejsv did not converge", prefix)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	if !sort.IsSorted(sort.Reverse(sort.Float64Slice(s))) {
+		t.Errorf("%v: singular values are not decreasing", prefix)
+	}
+	if floats.Min(s) < 0 {
+		t.Errorf("%v: some singular values are negative", prefix)
+	}
+	if want != nil {
+		if dist := svdRelDist(s, want); dist > tol {
+			t.Errorf("%v: singular values not computed to high relative accuracy; dist=%v, want<=%v", prefix, dist, tol)
+		}
+	}
+
+	vt := transposeGeneral(blas64.General{Rows: n, Cols: n, Data: v, Stride: ldv})
+	if resid := svdFullResidual(m, n, aNorm, aCopy, lda, u, ldu, s, vt.Data, vt.Stride); resid > tol {
+		t.Errorf("%v: original matrix not recovered, |A - U*S*Vᵀ|=%v", prefix, resid)
+	}
+	uGen := blas64.General{Rows: m, Cols: m, Data: u, Stride: ldu}
+	if resid := residualOrthogonal(uGen, false); resid > tolOrtho*float64(m) {
+		t.Errorf("%v: U is not orthogonal; resid=%v, want<=%v", prefix, resid, tolOrtho*float64(m))
+	}
+	if resid := residualOrthogonal(vt, false); resid > tolOrtho*float64(n) {
+		t.Errorf("%v: V is not orthogonal; resid=%v, want<=%v", prefix, resid, tolOrtho*float64(n))
+	}
+
+	// Check that partial decompositions match the full decomposition.
+	for _, jobU := range []lapack.SVDJob{lapack.SVDAll, lapack.SVDStore, lapack.SVDNone} {
+		for _, jobV := range []lapack.SVDJob{lapack.SVDAll, lapack.SVDNone} {
+			if jobU == lapack.SVDAll && jobV == lapack.SVDAll {
+				continue
+			}
+			copy(a, aCopy)
+			sp := nanSlice(n)
+			up := nanSlice(m * ldu)
+			vp := nanSlice(n * ldv)
+			ok := impl.Dgejsv(jobU, jobV, m, n, a, lda, sp, up, ldu, vp, ldv, work, lwork, iwork)
+			if !ok {
+				t.Errorf("%v,jobU=%c,jobV=%c: Dgejsv did not converge", prefix, jobU, jobV)
+				continue
+			}
+			if !floats.Equal(sp, s) {
+				t.Errorf("%v,jobU=%c,jobV=%c: singular values differ from full SVD", prefix, jobU, jobV)
+			}
+			if jobU != lapack.SVDNone {
+				ncu := m
+				if jobU == lapack.SVDStore {
+					ncu = n
+				}
+				if !equalGeneral(blas64.General{Rows: m, Cols: ncu, Data: up, Stride: ldu},
+					blas64.General{Rows: m, Cols: ncu, Data: u, Stride: ldu}) {
+					t.Errorf("%v,jobU=%c,jobV=%c: U differs from full SVD", prefix, jobU, jobV)
+				}
+			}
+			if jobV != lapack.SVDNone {
+				if !equalGeneral(blas64.General{Rows: n, Cols: n, Data: vp, Stride: ldv},
+					blas64.General{Rows: n, Cols: n, Data: v, Stride: ldv}) {
+					t.Errorf("%v,jobU=%c,jobV=%c: V differs from full SVD", prefix, jobU, jobV)
+				}
+			}
+		}
+	}
+}
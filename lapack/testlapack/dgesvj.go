@@ -0,0 +1,202 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgesvjer interface {
+	Dgesvj(jobU, jobV lapack.SVDJob, m, n int, a []float64, lda int, s, v []float64, ldv int, work []float64, lwork int) (ok bool)
+}
+
+func DgesvjTest(t *testing.T, impl Dgesvjer, tol float64) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, m := range []int{0, 1, 2, 3, 4, 5, 10, 50} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 50} {
+			if n > m {
+				continue
+			}
+			for _, mtype := range []int{1, 2, 3, 4, 5, 6} {
+				dgesvjTest(t, impl, m, n, mtype, tol, rnd)
+			}
+		}
+	}
+}
+
+func dgesvjTest(t *testing.T, impl Dgesvjer, m, n, mtype int, tol float64, rnd *rand.Rand) {
+	const tolOrtho = 1e-15
+
+	lda := n + 3
+	ldv := n + 5
+
+	prefix := fmt.Sprintf("m=%v,n=%v,mtype=%v", m, n, mtype)
+
+	a, want, aNorm := svdTestMatrix(m, n, lda, mtype, rnd)
+	aCopy := make([]float64, len(a))
+	copy(aCopy, a)
+
+	work := make([]float64, 1)
+	impl.Dgesvj(lapack.SVDOverwrite, lapack.SVDAll, m, n, a, lda, nil, nil, ldv, work, -1)
+	lwork := int(work[0])
+	if lwork < max(1, n) {
+		t.Errorf("%v: unexpected optimal lwork %v", prefix, lwork)
+		return
+	}
+	work = nanSlice(lwork)
+
+	s := nanSlice(n)
+	v := nanSlice(n * ldv)
+	ok := impl.Dgesvj(lapack.SVDOverwrite, lapack.SVDAll, m, n, a, lda, s, v, ldv, work, lwork)
+	if !ok {
+		t.Errorf("%v: Dgesvj did not converge", prefix)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	if !sort.IsSorted(sort.Reverse(sort.Float64Slice(s))) {
+		t.Errorf("%v: singular values are not decreasing", prefix)
+	}
+	if floats.Min(s) < 0 {
+		t.Errorf("%v: some singular values are negative", prefix)
+	}
+	if want != nil {
+		if dist := svdRelDist(s, want); dist > tol {
+			t.Errorf("%v: singular values not computed to high relative accuracy; dist=%v, want<=%v", prefix, dist, tol)
+		}
+	}
+
+	vt := transposeGeneral(blas64.General{Rows: n, Cols: n, Data: v, Stride: ldv})
+	if resid := svdFullResidual(m, n, aNorm, aCopy, lda, a, lda, s, vt.Data, vt.Stride); resid > tol {
+		t.Errorf("%v: original matrix not recovered, |A - U*S*Vᵀ|=%v", prefix, resid)
+	}
+	if mtype != 1 {
+		// The columns of U are only orthonormal if A has full rank.
+		u := blas64.General{Rows: m, Cols: n, Data: a, Stride: lda}
+		if resid := residualOrthogonal(u, false); resid > tolOrtho*float64(m) {
+			t.Errorf("%v: U does not have orthonormal columns; resid=%v, want<=%v", prefix, resid, tolOrtho*float64(m))
+		}
+	}
+	if resid := residualOrthogonal(vt, false); resid > tolOrtho*float64(n) {
+		t.Errorf("%v: V is not orthogonal; resid=%v, want<=%v", prefix, resid, tolOrtho*float64(n))
+	}
+
+	// Check that computing only the singular values gives the same result.
+	copy(a, aCopy)
+	sNone := nanSlice(n)
+	ok = impl.Dgesvj(lapack.SVDNone, lapack.SVDNone, m, n, a, lda, sNone, nil, 1, work, lwork)
+	if !ok {
+		t.Errorf("%v: Dgesvj did not converge for singular values only", prefix)
+		return
+	}
+	if dist := svdRelDist(sNone, s); dist > tol {
+		t.Errorf("%v: singular values differ when U and V are not computed; dist=%v", prefix, dist)
+	}
+}
+
+// svdTestMatrix returns an m×n matrix A generated according to mtype as
+//   - the zero matrix if mtype == 1,
+//   - the identity matrix if mtype == 2,
+//   - a random matrix with singular values spread linearly between 1/n and 1,
+//     scaled by 1, smlnum or bignum if mtype == 3, 4 or 5,
+//   - a graded matrix Q*D where Q has orthonormal columns and the diagonal
+//     of D spans thirty orders of magnitude in random order if mtype == 6.
+//
+// The singular values of A in decreasing order are returned in sv when they
+// are known exactly, and aNorm is the largest singular value of A.
+func svdTestMatrix(m, n, lda, mtype int, rnd *rand.Rand) (a, sv []float64, aNorm float64) {
+	a = make([]float64, m*lda)
+	for i := range a {
+		a[i] = rnd.NormFloat64()
+	}
+	minmn := min(m, n)
+	switch mtype {
+	default:
+		panic("unknown test matrix type")
+	case 1:
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a[i*lda+j] = 0
+			}
+		}
+		return a, make([]float64, minmn), 0
+	case 2:
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a[i*lda+j] = 0
+			}
+			if i < n {
+				a[i*lda+i] = 1
+			}
+		}
+		sv = make([]float64, minmn)
+		for i := range sv {
+			sv[i] = 1
+		}
+		return a, sv, 1
+	case 3, 4, 5:
+		sv = make([]float64, minmn)
+		Dlatm1(sv, 4, float64(max(1, minmn)), false, 1, rnd)
+		aNorm = 1
+		if mtype == 4 {
+			aNorm = smlnum
+		}
+		if mtype == 5 {
+			aNorm = bignum
+		}
+		floats.Scale(aNorm, sv)
+		Dlagge(m, n, max(0, m-1), max(0, n-1), sv, a, lda, rnd, make([]float64, m+n))
+		// Dlagge loses relative accuracy in the smallest singular
+		// values so they are not known exactly.
+		return a, nil, aNorm
+	case 6:
+		if minmn == 0 {
+			return a, nil, 0
+		}
+		d := make([]float64, n)
+		for j := range d {
+			e := 0.0
+			if n > 1 {
+				e = -15 + 30*float64(j)/float64(n-1)
+			}
+			d[j] = math.Pow(10, e)
+		}
+		rnd.Shuffle(n, func(i, j int) { d[i], d[j] = d[j], d[i] })
+		q := randomOrthogonal(m, rnd)
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a[i*lda+j] = q.Data[i*q.Stride+j] * d[j]
+			}
+		}
+		sv = make([]float64, n)
+		copy(sv, d)
+		sort.Sort(sort.Reverse(sort.Float64Slice(sv)))
+		return a, sv, sv[0]
+	}
+}
+
+// svdRelDist returns the maximum relative distance between corresponding
+// singular values in s and want. Zero values are compared absolutely.
+func svdRelDist(s, want []float64) float64 {
+	var dist float64
+	for i, w := range want {
+		d := math.Abs(s[i] - w)
+		if w != 0 {
+			d /= w
+		}
+		dist = math.Max(dist, d)
+	}
+	return dist
+}
@@ -0,0 +1,184 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgesvxer interface {
+	Dgesvx(equilibrate bool, trans blas.Transpose, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []int, r, c []float64, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int) (equed lapack.EquilibrationType, rcond float64, ok bool)
+
+	Dgetrier
+}
+
+func DgesvxTest(t *testing.T, impl Dgesvxer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 3, 5, 10, 50} {
+		for _, nrhs := range []int{0, 1, 3} {
+			for _, ld := range []int{0, 4} {
+				for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+					for _, equilibrate := range []bool{false, true} {
+						for _, spread := range []float64{0, 6} {
+							dgesvxTest(t, impl, rnd, equilibrate, trans, n, nrhs, ld, spread)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	// Check that a singular matrix is reported.
+	for _, equilibrate := range []bool{false, true} {
+		const n = 4
+		a := randomGeneral(n, n, n, rnd)
+		for j := 0; j < n; j++ {
+			a.Data[2*n+j] = 0
+		}
+		b := randomGeneral(n, 1, 1, rnd)
+		_, rcond, ok := impl.Dgesvx(equilibrate, blas.NoTrans, n, 1, a.Data, n, make([]float64, n*n), n, make([]int, n),
+			make([]float64, n), make([]float64, n), b.Data, 1, make([]float64, n), 1,
+			make([]float64, 1), make([]float64, 1), make([]float64, 4*n), make([]int, n))
+		if ok {
+			t.Errorf("equilibrate=%v: unexpected success for singular matrix", equilibrate)
+		}
+		if rcond != 0 {
+			t.Errorf("equilibrate=%v: unexpected rcond for singular matrix; got %v, want 0", equilibrate, rcond)
+		}
+	}
+}
+
+func dgesvxTest(t *testing.T, impl Dgesvxer, rnd *rand.Rand, equilibrate bool, trans blas.Transpose, n, nrhs, ld int, spread float64) {
+	name := fmt.Sprintf("equilibrate=%v,trans=%v,n=%v,nrhs=%v,ld=%v,spread=%v", equilibrate, transToString(trans), n, nrhs, ld, spread)
+
+	lda := n + ld
+	ldb := nrhs + ld
+
+	// Generate a matrix with rows and columns scaled by factors in
+	// [10^-spread, 10^spread].
+	a := randomGeneral(n, n, max(1, lda), rnd)
+	for i := 0; i < n; i++ {
+		a.Data[i*a.Stride+i] += float64(n)
+		ri := math.Pow(10, spread*(2*rnd.Float64()-1))
+		for j := 0; j < n; j++ {
+			a.Data[i*a.Stride+j] *= ri
+		}
+	}
+	for j := 0; j < n; j++ {
+		cj := math.Pow(10, spread*(2*rnd.Float64()-1))
+		for i := 0; i < n; i++ {
+			a.Data[i*a.Stride+j] *= cj
+		}
+	}
+	aCopy := cloneGeneral(a)
+
+	// Generate the solution and compute the right hand side.
+	xWant := randomGeneral(n, nrhs, max(1, ldb), rnd)
+	b := zeros(n, nrhs, max(1, ldb))
+	if n > 0 && nrhs > 0 {
+		blas64.Gemm(trans, blas.NoTrans, 1, a, xWant, 0, b)
+	}
+	bCopy := cloneGeneral(b)
+
+	af := nanGeneral(n, n, max(1, lda))
+	ipiv := make([]int, n)
+	r := nanSlice(n)
+	c := nanSlice(n)
+	x := nanGeneral(n, nrhs, max(1, ldb))
+	ferr := nanSlice(nrhs)
+	berr := nanSlice(nrhs)
+	work := nanSlice(4 * n)
+	iwork := make([]int, n)
+
+	equed, rcond, ok := impl.Dgesvx(equilibrate, trans, n, nrhs, a.Data, a.Stride, af.Data, af.Stride, ipiv, r, c,
+		b.Data, b.Stride, x.Data, x.Stride, ferr, berr, work, iwork)
+	if !ok {
+		t.Errorf("%v: unexpected failure", name)
+		return
+	}
+	if !equilibrate && equed != lapack.EquilibrateNone {
+		t.Errorf("%v: unexpected equilibration %c", name, equed)
+	}
+	if n == 0 {
+		return
+	}
+	if spread == 0 && equed != lapack.EquilibrateNone {
+		t.Errorf("%v: unexpected equilibration %c of well scaled matrix", name, equed)
+	}
+	if equilibrate && spread > 0 && n > 1 && equed == lapack.EquilibrateNone {
+		t.Errorf("%v: badly scaled matrix not equilibrated", name)
+	}
+
+	// Check that A and B have been overwritten by their equilibrated
+	// forms.
+	rowequ := equed == lapack.EquilibrateRows || equed == lapack.EquilibrateBoth
+	colequ := equed == lapack.EquilibrateCols || equed == lapack.EquilibrateBoth
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			want := aCopy.Data[i*aCopy.Stride+j]
+			if rowequ {
+				want *= r[i]
+			}
+			if colequ {
+				want *= c[j]
+			}
+			if got := a.Data[i*a.Stride+j]; math.Abs(got-want) > 1e-15*math.Abs(want) {
+				t.Errorf("%v: unexpected element (%v,%v) of equilibrated A; got %v, want %v", name, i, j, got, want)
+				return
+			}
+		}
+		scale := 1.0
+		if trans == blas.NoTrans && rowequ {
+			scale = r[i]
+		}
+		if trans != blas.NoTrans && colequ {
+			scale = c[i]
+		}
+		for j := 0; j < nrhs; j++ {
+			want := scale * bCopy.Data[i*bCopy.Stride+j]
+			if got := b.Data[i*b.Stride+j]; got != want {
+				t.Errorf("%v: unexpected element (%v,%v) of equilibrated B; got %v, want %v", name, i, j, got, want)
+				return
+			}
+		}
+	}
+
+	// Compare rcond with the reciprocal condition number of the
+	// equilibrated A computed from its inverse.
+	norm := lapack.MaxColumnSum
+	if trans != blas.NoTrans {
+		norm = lapack.MaxRowSum
+	}
+	aInv := cloneGeneral(a)
+	impl.Dgetrf(n, n, aInv.Data, aInv.Stride, ipiv)
+	impl.Dgetri(n, aInv.Data, aInv.Stride, ipiv, make([]float64, n), n)
+	rcondWant := 1 / dlange(norm, n, n, a.Data, a.Stride) / dlange(norm, n, n, aInv.Data, aInv.Stride)
+	if rcond < 0.5*rcondWant || 10*rcondWant < rcond {
+		t.Errorf("%v: unexpected rcond; got %v, want %v", name, rcond, rcondWant)
+	}
+
+	// Check the solution and the error bounds.
+	eps := dlamchE
+	for j := 0; j < nrhs; j++ {
+		var diff, xnorm float64
+		for i := 0; i < n; i++ {
+			diff = math.Max(diff, math.Abs(x.Data[i*x.Stride+j]-xWant.Data[i*xWant.Stride+j]))
+			xnorm = math.Max(xnorm, math.Abs(x.Data[i*x.Stride+j]))
+		}
+		if err := diff / xnorm; err > ferr[j] {
+			t.Errorf("%v: forward error of solution %v larger than bound; got %v, want <= %v", name, j, err, ferr[j])
+		}
+		if berr[j] > float64(n+1)*eps {
+			t.Errorf("%v: backward error of solution %v too large; got %v, want <= %v", name, j, berr[j], float64(n+1)*eps)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+type Dpoequer interface {
+	Dpoequ(n int, a []float64, lda int, s []float64) (scond, amax float64, ok bool)
+	Dlaqsy(uplo blas.Uplo, n int, a []float64, lda int, s []float64, scond, amax float64) (equed bool)
+}
+
+func DpoequTest(t *testing.T, impl Dpoequer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10} {
+			for _, lda := range []int{max(1, n), n + 3} {
+				for _, scale := range []float64{1, 1e-200, 1e200} {
+					for _, spread := range []float64{0, 1, 8} {
+						dpoequTest(t, impl, rnd, uplo, n, lda, scale, spread)
+					}
+				}
+			}
+		}
+	}
+
+	// Check that non-positive diagonal elements are detected.
+	for _, d := range []float64{0, -1} {
+		const n = 4
+		a := randomSPD(n, rnd)
+		a.Data[2*a.Stride+2] = d
+		_, _, ok := impl.Dpoequ(n, a.Data, a.Stride, make([]float64, n))
+		if ok {
+			t.Errorf("diagonal element %v: unexpected success", d)
+		}
+	}
+}
+
+func dpoequTest(t *testing.T, impl Dpoequer, rnd *rand.Rand, uplo blas.Uplo, n, lda int, scale, spread float64) {
+	const tol = 1e-14
+
+	name := fmt.Sprintf("uplo=%v,n=%v,lda=%v,scale=%v,spread=%v", uploToString(uplo), n, lda, scale, spread)
+
+	// Generate a symmetric positive definite matrix scaled symmetrically
+	// by factors in [sqrt(scale)*10^-spread, sqrt(scale)*10^spread].
+	a := randomSPD(n, rnd)
+	aScaled := nanGeneral(n, n, max(1, lda))
+	d := make([]float64, n)
+	for i := range d {
+		d[i] = math.Sqrt(scale) * math.Pow(10, spread*(2*rnd.Float64()-1))
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			aScaled.Data[i*aScaled.Stride+j] = d[i] * a.Data[i*a.Stride+j] * d[j]
+		}
+	}
+	a = aScaled
+	aCopy := cloneGeneral(a)
+
+	s := nanSlice(n)
+	scond, amax, ok := impl.Dpoequ(n, a.Data, a.Stride, s)
+	if !ok {
+		t.Errorf("%v: unexpected failure", name)
+		return
+	}
+	if !equalGeneral(a, aCopy) {
+		t.Errorf("%v: unexpected modification of A", name)
+	}
+	if n == 0 {
+		return
+	}
+
+	// Check that the scaled matrix has a unit diagonal.
+	var wantAmax float64
+	for i := 0; i < n; i++ {
+		aii := aCopy.Data[i*aCopy.Stride+i]
+		wantAmax = math.Max(wantAmax, aii)
+		if v := s[i] * aii * s[i]; math.Abs(v-1) > tol {
+			t.Errorf("%v: diagonal element %v of scaled matrix is %v, want 1", name, i, v)
+		}
+	}
+	if amax != wantAmax {
+		t.Errorf("%v: unexpected amax; got %v, want %v", name, amax, wantAmax)
+	}
+	if got, want := scond, ratioMinMax(s); math.Abs(got-want) > tol*want {
+		t.Errorf("%v: unexpected scond; got %v, want %v", name, got, want)
+	}
+
+	// Check that Dlaqsy applies the scaling it reports to the uplo
+	// triangle only.
+	equed := impl.Dlaqsy(uplo, n, a.Data, a.Stride, s, scond, amax)
+	const thresh = 0.1
+	small := dlamchS / dlamchP
+	if want := scond < thresh || amax < small || amax > 1/small; equed != want {
+		t.Errorf("%v: unexpected equilibration; got %v, want %v", name, equed, want)
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			want := aCopy.Data[i*aCopy.Stride+j]
+			inTri := (uplo == blas.Upper && j >= i) || (uplo == blas.Lower && j <= i)
+			if equed && inTri {
+				want *= s[i] * s[j]
+			}
+			if got := a.Data[i*a.Stride+j]; got != want {
+				t.Errorf("%v: unexpected element (%v,%v) of equilibrated matrix; got %v, want %v", name, i, j, got, want)
+				return
+			}
+		}
+	}
+}
+
+// randomSPD returns a random n×n symmetric positive definite matrix.
+func randomSPD(n int, rnd *rand.Rand) blas64.General {
+	g := randomGeneral(n, n, max(1, n), rnd)
+	a := zeros(n, n, max(1, n))
+	if n == 0 {
+		return a
+	}
+	blas64.Gemm(blas.Trans, blas.NoTrans, 1, g, g, 0, a)
+	for i := 0; i < n; i++ {
+		a.Data[i*a.Stride+i] += float64(n)
+	}
+	return a
+}
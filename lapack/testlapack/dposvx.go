@@ -0,0 +1,176 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dposvxer interface {
+	Dposvx(equilibrate bool, uplo blas.Uplo, n, nrhs int, a []float64, lda int, af []float64, ldaf int, s []float64, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int) (equed bool, rcond float64, ok bool)
+
+	Dpotrier
+}
+
+func DposvxTest(t *testing.T, impl Dposvxer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10, 50} {
+			for _, nrhs := range []int{0, 1, 3} {
+				for _, ld := range []int{0, 4} {
+					for _, equilibrate := range []bool{false, true} {
+						for _, spread := range []float64{0, 6} {
+							dposvxTest(t, impl, rnd, equilibrate, uplo, n, nrhs, ld, spread)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	// Check that a matrix that is not positive definite is reported.
+	for _, equilibrate := range []bool{false, true} {
+		const n = 4
+		a := randomSPD(n, rnd)
+		for i := 0; i < n; i++ {
+			a.Data[i*a.Stride+i] = -a.Data[i*a.Stride+i]
+		}
+		b := randomGeneral(n, 1, 1, rnd)
+		_, rcond, ok := impl.Dposvx(equilibrate, blas.Upper, n, 1, a.Data, a.Stride, make([]float64, n*n), n,
+			make([]float64, n), b.Data, 1, make([]float64, n), 1,
+			make([]float64, 1), make([]float64, 1), make([]float64, 3*n), make([]int, n))
+		if ok {
+			t.Errorf("equilibrate=%v: unexpected success for indefinite matrix", equilibrate)
+		}
+		if rcond != 0 {
+			t.Errorf("equilibrate=%v: unexpected rcond for indefinite matrix; got %v, want 0", equilibrate, rcond)
+		}
+	}
+}
+
+func dposvxTest(t *testing.T, impl Dposvxer, rnd *rand.Rand, equilibrate bool, uplo blas.Uplo, n, nrhs, ld int, spread float64) {
+	name := fmt.Sprintf("equilibrate=%v,uplo=%v,n=%v,nrhs=%v,ld=%v,spread=%v", equilibrate, uploToString(uplo), n, nrhs, ld, spread)
+
+	lda := n + ld
+	ldb := nrhs + ld
+
+	// Generate a symmetric positive definite matrix scaled symmetrically
+	// by factors in [10^-spread, 10^spread].
+	spd := randomSPD(n, rnd)
+	d := make([]float64, n)
+	for i := range d {
+		d[i] = math.Pow(10, spread*(2*rnd.Float64()-1))
+	}
+	if n > 1 {
+		// Make sure that the scale factors span the whole range.
+		d[0] = math.Pow(10, spread)
+		d[n-1] = math.Pow(10, -spread)
+	}
+	a := nanGeneral(n, n, max(1, lda))
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			v := d[i] * spd.Data[i*spd.Stride+j] * d[j]
+			a.Data[i*a.Stride+j] = v
+			a.Data[j*a.Stride+i] = v
+		}
+	}
+	aCopy := cloneGeneral(a)
+
+	// Generate the solution and compute the right hand side.
+	xWant := randomGeneral(n, nrhs, max(1, ldb), rnd)
+	b := zeros(n, nrhs, max(1, ldb))
+	if n > 0 && nrhs > 0 {
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, a, xWant, 0, b)
+	}
+	bCopy := cloneGeneral(b)
+
+	af := nanGeneral(n, n, max(1, lda))
+	s := nanSlice(n)
+	x := nanGeneral(n, nrhs, max(1, ldb))
+	ferr := nanSlice(nrhs)
+	berr := nanSlice(nrhs)
+	work := nanSlice(3 * n)
+	iwork := make([]int, n)
+
+	equed, rcond, ok := impl.Dposvx(equilibrate, uplo, n, nrhs, a.Data, a.Stride, af.Data, af.Stride, s,
+		b.Data, b.Stride, x.Data, x.Stride, ferr, berr, work, iwork)
+	if !ok {
+		t.Errorf("%v: unexpected failure", name)
+		return
+	}
+	if !equilibrate && equed {
+		t.Errorf("%v: unexpected equilibration", name)
+	}
+	if n == 0 {
+		return
+	}
+	if spread == 0 && equed {
+		t.Errorf("%v: unexpected equilibration of well scaled matrix", name)
+	}
+	if equilibrate && spread > 0 && n > 1 && !equed {
+		t.Errorf("%v: badly scaled matrix not equilibrated", name)
+	}
+
+	// Check that the uplo triangle of A and B have been overwritten by
+	// their equilibrated forms and that the other triangle of A is not
+	// modified.
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			want := aCopy.Data[i*aCopy.Stride+j]
+			inTri := (uplo == blas.Upper && j >= i) || (uplo == blas.Lower && j <= i)
+			if equed && inTri {
+				want *= s[i] * s[j]
+			}
+			if got := a.Data[i*a.Stride+j]; got != want {
+				t.Errorf("%v: unexpected element (%v,%v) of A; got %v, want %v", name, i, j, got, want)
+				return
+			}
+		}
+		scale := 1.0
+		if equed {
+			scale = s[i]
+		}
+		for j := 0; j < nrhs; j++ {
+			want := scale * bCopy.Data[i*bCopy.Stride+j]
+			if got := b.Data[i*b.Stride+j]; got != want {
+				t.Errorf("%v: unexpected element (%v,%v) of equilibrated B; got %v, want %v", name, i, j, got, want)
+				return
+			}
+		}
+	}
+
+	// Compare rcond with the reciprocal condition number of the
+	// equilibrated A computed from its inverse.
+	aInv := cloneGeneral(a)
+	impl.Dpotrf(uplo, n, aInv.Data, aInv.Stride)
+	impl.Dpotri(uplo, n, aInv.Data, aInv.Stride)
+	rcondWant := 1 / dlansy(lapack.MaxColumnSum, uplo, n, a.Data, a.Stride) / dlansy(lapack.MaxColumnSum, uplo, n, aInv.Data, aInv.Stride)
+	if rcond < 0.5*rcondWant || 10*rcondWant < rcond {
+		t.Errorf("%v: unexpected rcond; got %v, want %v", name, rcond, rcondWant)
+	}
+
+	// Check the solution and the error bounds.
+	eps := dlamchE
+	for j := 0; j < nrhs; j++ {
+		var diff, xnorm float64
+		for i := 0; i < n; i++ {
+			diff = math.Max(diff, math.Abs(x.Data[i*x.Stride+j]-xWant.Data[i*xWant.Stride+j]))
+			xnorm = math.Max(xnorm, math.Abs(x.Data[i*x.Stride+j]))
+		}
+		if err := diff / xnorm; err > ferr[j] {
+			t.Errorf("%v: forward error of solution %v larger than bound; got %v, want <= %v", name, j, err, ferr[j])
+		}
+		if berr[j] > float64(n+1)*eps {
+			t.Errorf("%v: backward error of solution %v too large; got %v, want <= %v", name, j, berr[j], float64(n+1)*eps)
+		}
+	}
+}
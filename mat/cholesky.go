@@ -723,6 +723,134 @@ func (c *Cholesky) SymRankOne(orig *Cholesky, alpha float64, x Vector) (ok bool)
 	return ok
 }
 
+// Downdate performs a rank-1 downdate of the original matrix A and
+// refactorizes its Cholesky factorization, storing the result into the
+// receiver. That is, if in the original Cholesky factorization
+//
+//	Uᵀ * U = A,
+//
+// in the updated factorization
+//
+//	U'ᵀ * U' = A - x * xᵀ = A'.
+//
+// Downdate computes the factorization with hyperbolic rotations, and is
+// equivalent to SymRankK with alpha = -1. It returns whether the updated
+// matrix A' is positive definite. If the downdate fails the receiver is left
+// unchanged.
+//
+// Downdate updates a Cholesky factorization in O(n²) time.
+func (c *Cholesky) Downdate(orig *Cholesky, x Vector) (ok bool) {
+	return c.SymRankK(orig, -1, x)
+}
+
+// SymRankK performs a rank-k update of the original matrix A and refactorizes
+// its Cholesky factorization, storing the result into the receiver. That is,
+// if in the original Cholesky factorization
+//
+//	Uᵀ * U = A,
+//
+// in the updated factorization
+//
+//	U'ᵀ * U' = A + alpha * X * Xᵀ = A',
+//
+// where X is an n×k matrix. The k columns of X are applied together in a
+// single pass over the rows of U, using Givens rotations when alpha is
+// positive and hyperbolic rotations when alpha is negative.
+//
+// When alpha is negative, the updated matrix A' may not be positive definite
+// and not have a Cholesky factorization. SymRankK returns whether the updated
+// matrix A' is positive definite. If the update fails the receiver is left
+// unchanged. SymRankK panics if the number of rows of X is not n, or if the
+// receiver is not empty and is of a different size from orig.
+//
+// SymRankK updates a Cholesky factorization in O(k n²) time. The Cholesky
+// factorization computation from scratch is O(n³).
+func (c *Cholesky) SymRankK(orig *Cholesky, alpha float64, x Matrix) (ok bool) {
+	if !orig.valid() {
+		panic(badCholesky)
+	}
+	n := orig.SymmetricDim()
+	r, k := x.Dims()
+	if r != n {
+		panic(ErrShape)
+	}
+	if orig != c {
+		if c.chol == nil {
+			c.chol = NewTriDense(n, Upper, nil)
+		} else if c.chol.mat.N != n {
+			panic(ErrShape)
+		}
+	}
+	if alpha == 0 || k == 0 {
+		if orig != c {
+			c.chol.Copy(orig.chol)
+			c.cond = orig.cond
+		}
+		return true
+	}
+
+	// The rows of xt hold the columns of sqrt(|alpha|) * X.
+	xt := getDenseWorkspace(k, n, false)
+	defer putDenseWorkspace(xt)
+	xt.Copy(x.T())
+	xt.Scale(math.Sqrt(math.Abs(alpha)), xt)
+	u := getTriDenseWorkspace(n, Upper, false)
+	defer putTriWorkspace(u)
+	u.Copy(orig.chol)
+
+	// Each row i of U is combined with the rows of xt by rotations
+	// that zero the i-th element of each row of xt, so that
+	//  Uᵀ * U ± xtᵀ * xt
+	// is invariant.
+	umat := u.mat
+	xmat := xt.mat
+	for i := 0; i < n; i++ {
+		ui := umat.Data[i*umat.Stride+i : i*umat.Stride+n]
+		for l := 0; l < k; l++ {
+			xl := xmat.Data[l*xmat.Stride+i : l*xmat.Stride+n]
+			if xl[0] == 0 {
+				continue
+			}
+			if alpha > 0 {
+				// Apply a Givens rotation.
+				cos, sin, r, _ := blas64.Rotg(ui[0], xl[0])
+				if r < 0 {
+					// Keep the diagonal positive.
+					r, cos, sin = -r, -cos, -sin
+				}
+				ui[0] = r
+				if len(ui) > 1 {
+					blas64.Rot(
+						blas64.Vector{N: len(ui) - 1, Data: ui[1:], Inc: 1},
+						blas64.Vector{N: len(xl) - 1, Data: xl[1:], Inc: 1},
+						cos, sin)
+				}
+			} else {
+				// Apply a hyperbolic rotation in the mixed
+				// form, which is numerically stable.
+				a, b := ui[0], xl[0]
+				d := (a - b) * (a + b)
+				if !(d > 0) {
+					// The updated matrix is not positive definite.
+					return false
+				}
+				r := math.Sqrt(d)
+				cos := r / a
+				sin := b / a
+				ui[0] = r
+				for j := 1; j < len(ui); j++ {
+					ui[j] = (ui[j] - sin*xl[j]) / cos
+					xl[j] = cos*xl[j] - sin*ui[j]
+				}
+			}
+			xl[0] = 0
+		}
+	}
+	c.chol.Copy(u)
+	c.updateCond(-1)
+	return true
+}
+
 func (c *Cholesky) valid() bool {
 	return c.chol != nil && !c.chol.IsEmpty()
 }
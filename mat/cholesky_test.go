@@ -362,6 +362,138 @@ func TestCholeskyInverseTo(t *testing.T) {
 	}
 }
 
+func TestCholeskySymRankK(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10, 30} {
+		for _, k := range []int{0, 1, 2, 5} {
+			for trial := 0; trial < 10; trial++ {
+				// Construct a random positive definite matrix.
+				data := make([]float64, n*n)
+				for i := range data {
+					data[i] = rnd.NormFloat64()
+				}
+				var a SymDense
+				a.SymOuterK(1, NewDense(n, n, data))
+
+				x := NewDense(n, max(k, 1), nil)
+				for i := 0; i < n; i++ {
+					for j := 0; j < k; j++ {
+						x.Set(i, j, rnd.NormFloat64())
+					}
+				}
+				xk := x.Slice(0, n, 0, k)
+				alpha := rnd.NormFloat64()
+
+				// Compute the updated matrix directly. If alpha < 0, the
+				// final matrix may not be positive definite, so instead
+				// switch the two matrices.
+				aUpdate := NewSymDense(n, nil)
+				if alpha > 0 {
+					aUpdate.SymRankK(&a, alpha, xk)
+				} else {
+					aUpdate.CopySym(&a)
+					a.Reset()
+					a.SymRankK(aUpdate, -alpha, xk)
+				}
+
+				var chol Cholesky
+				if !chol.Factorize(&a) {
+					t.Errorf("Bad random test, Cholesky factorization failed")
+					continue
+				}
+				for _, inPlace := range []bool{false, true} {
+					var cholUpdate Cholesky
+					dst := &cholUpdate
+					if inPlace {
+						dst.Clone(&chol)
+						if !dst.SymRankK(dst, alpha, xk) {
+							t.Errorf("n=%v, k=%v, alpha=%v: unexpected failure in place", n, k, alpha)
+							continue
+						}
+					} else if !dst.SymRankK(&chol, alpha, xk) {
+						t.Errorf("n=%v, k=%v, alpha=%v: unexpected failure", n, k, alpha)
+						continue
+					}
+					var aCompare SymDense
+					dst.ToSym(&aCompare)
+					if !EqualApprox(&aCompare, aUpdate, 1e-12) {
+						t.Errorf("n=%v, k=%v, alpha=%v, in place=%t: mismatch between updated matrix and from Cholesky:\nupdated:\n%v\nfrom Cholesky:\n%v",
+							n, k, alpha, inPlace, Formatted(aUpdate), Formatted(&aCompare))
+					}
+					u := dst.RawU()
+					for i := 0; i < n; i++ {
+						if u.At(i, i) <= 0 {
+							t.Errorf("n=%v, k=%v, alpha=%v: non-positive diagonal of factor", n, k, alpha)
+							break
+						}
+					}
+				}
+			}
+		}
+	}
+
+	// A downdate that loses positive definiteness
+	// must fail and leave the receiver unchanged.
+	a := NewSymDense(3, []float64{
+		4, 1, 0,
+		0, 3, 1,
+		0, 0, 2,
+	})
+	var chol Cholesky
+	if !chol.Factorize(a) {
+		t.Fatal("Bad test, Cholesky factorization failed")
+	}
+	var want Cholesky
+	want.Clone(&chol)
+	if chol.Downdate(&chol, NewVecDense(3, []float64{0, 0, 2})) {
+		t.Errorf("expected failure downdating to a singular matrix")
+	}
+	if !Equal(chol.RawU(), want.RawU()) {
+		t.Errorf("receiver modified by failed downdate")
+	}
+	x := NewVecDense(3, []float64{1, 0.5, 0.25})
+	if !chol.Downdate(&chol, x) {
+		t.Fatalf("unexpected failure downdating")
+	}
+	var got SymDense
+	chol.ToSym(&got)
+	a.SymRankOne(a, -1, x)
+	if !EqualApprox(&got, a, 1e-14) {
+		t.Errorf("mismatch between downdated matrix and from Cholesky:\nupdated:\n%v\nfrom Cholesky:\n%v",
+			Formatted(a), Formatted(&got))
+	}
+
+	// Sliding a window of observations keeps the factorization
+	// of the scatter matrix of the observations in the window.
+	const window, dim = 10, 4
+	obs := NewDense(100, dim, nil)
+	for i := 0; i < 100; i++ {
+		for j := 0; j < dim; j++ {
+			obs.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	var scatter SymDense
+	scatter.SymOuterK(1, obs.Slice(0, window, 0, dim).T())
+	if !chol.Factorize(&scatter) {
+		t.Fatal("Bad test, Cholesky factorization failed")
+	}
+	for i := window; i < 100; i++ {
+		if !chol.SymRankK(&chol, 1, obs.Slice(i, i+1, 0, dim).T()) {
+			t.Fatalf("unexpected failure updating at %d", i)
+		}
+		if !chol.Downdate(&chol, obs.RowView(i-window)) {
+			t.Fatalf("unexpected failure downdating at %d", i)
+		}
+	}
+	scatter.SymOuterK(1, obs.Slice(100-window, 100, 0, dim).T())
+	got.Reset()
+	chol.ToSym(&got)
+	if !EqualApprox(&got, &scatter, 1e-10) {
+		t.Errorf("mismatch in sliding window factorization:\ngot:\n%v\nwant:\n%v", Formatted(&got), Formatted(&scatter))
+	}
+}
+
 func TestCholeskySymRankOne(t *testing.T) {
 	t.Parallel()
 	rnd := rand.New(rand.NewPCG(1, 1))
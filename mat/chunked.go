@@ -0,0 +1,132 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+// badChunk is the panic string used for a non-positive chunk size.
+const badChunk = "mat: non-positive chunk size"
+
+// MulChunked sets dst to the matrix product a * b, computing the product
+// for at most rows rows of a at a time. If dst is empty, it is resized to
+// the dimensions of the product, otherwise MulChunked panics if the
+// dimensions of dst do not match.
+//
+// MulChunked is intended for use when a and dst are out-of-core matrices,
+// such as the matrices of MappedDense values, and b fits in memory. The
+// rows of a and dst are accessed in order and each chunk is accessed only
+// once, so the working set of the computation is bounded by the size of
+// b and of the chunks. If a is not a *Dense, each chunk of a is copied to
+// a temporary matrix. dst must not share data with a or b.
+func MulChunked(dst *Dense, a, b Matrix, rows int) {
+	if rows <= 0 {
+		panic(badChunk)
+	}
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ac != br {
+		panic(ErrShape)
+	}
+	dst.reuseAsNonZeroed(ar, bc)
+	dst.checkChunkOverlap(a)
+	dst.checkChunkOverlap(b)
+
+	var w *Dense
+	if _, ok := a.(*Dense); !ok {
+		w = getDenseWorkspace(min(rows, ar), ac, false)
+		defer putDenseWorkspace(w)
+	}
+	for i := 0; i < ar; i += rows {
+		k := min(i+rows, ar)
+		dst.slice(i, k, 0, bc).Mul(rowChunk(a, i, k, w), b)
+	}
+}
+
+// MulTransChunked sets dst to the matrix product aᵀ * b, accumulating the
+// product over at most rows rows of a and b at a time. If dst is empty, it
+// is resized to the dimensions of the product, otherwise MulTransChunked
+// panics if the dimensions of dst do not match.
+//
+// MulTransChunked is intended for use when a and b are tall out-of-core
+// matrices, such as the matrices of MappedDense values, with a product
+// that fits in memory. For example, MulTransChunked(dst, x, x, rows)
+// computes the Gram matrix xᵀ * x of a data matrix x with observations
+// held in its rows. The rows of a and b are accessed in order and each
+// chunk is accessed only once. If a or b is not a *Dense, each chunk is
+// copied to a temporary matrix. dst must not share data with a or b.
+func MulTransChunked(dst *Dense, a, b Matrix, rows int) {
+	if rows <= 0 {
+		panic(badChunk)
+	}
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br {
+		panic(ErrShape)
+	}
+	dst.reuseAsNonZeroed(ac, bc)
+	dst.checkChunkOverlap(a)
+	dst.checkChunkOverlap(b)
+
+	var wa, wb *Dense
+	if _, ok := a.(*Dense); !ok {
+		wa = getDenseWorkspace(min(rows, ar), ac, false)
+		defer putDenseWorkspace(wa)
+	}
+	if _, ok := b.(*Dense); !ok && b != a {
+		wb = getDenseWorkspace(min(rows, br), bc, false)
+		defer putDenseWorkspace(wb)
+	}
+	var tmp *Dense
+	for i := 0; i < ar; i += rows {
+		k := min(i+rows, ar)
+		ak := rowChunk(a, i, k, wa)
+		bk := ak
+		if b != a {
+			bk = rowChunk(b, i, k, wb)
+		}
+		if i == 0 {
+			dst.Mul(ak.T(), bk)
+			continue
+		}
+		if tmp == nil {
+			tmp = getDenseWorkspace(ac, bc, false)
+			defer putDenseWorkspace(tmp)
+		}
+		tmp.Mul(ak.T(), bk)
+		dst.Add(dst, tmp)
+	}
+}
+
+// checkChunkOverlap panics if the receiver shares data with a. The
+// receiver and a must not be identical since chunks of the receiver
+// are distinct from chunks of a.
+func (m *Dense) checkChunkOverlap(a Matrix) {
+	u, _ := untransposeExtract(a)
+	if m == u {
+		panic(regionIdentity)
+	}
+	m.checkOverlapMatrix(u)
+}
+
+// rowChunk returns rows i to k-1 of a. If a is a *Dense, the returned
+// matrix is a view of a, otherwise the rows are copied into w.
+func rowChunk(a Matrix, i, k int, w *Dense) *Dense {
+	_, c := a.Dims()
+	if a, ok := a.(*Dense); ok {
+		return a.slice(i, k, 0, c)
+	}
+	w = w.slice(0, k-i, 0, c)
+	if r, ok := a.(RawRowViewer); ok {
+		for ii := i; ii < k; ii++ {
+			copy(w.rawRowView(ii-i), r.RawRowView(ii))
+		}
+		return w
+	}
+	for ii := i; ii < k; ii++ {
+		row := w.rawRowView(ii - i)
+		for j := range row {
+			row[j] = a.At(ii, j)
+		}
+	}
+	return w
+}
@@ -0,0 +1,139 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+)
+
+// Difference describes how two matrices of the same size differ
+// element-wise. For each measure of difference, the largest value over the
+// elements and the row and column of the first element at which it occurs
+// are recorded. The indices are -1 if no elements differ.
+//
+// An element that is NaN in exactly one of the matrices has infinite
+// absolute and relative difference and a ULP distance of math.MaxUint64.
+// Elements that are NaN in both matrices are not considered to differ.
+type Difference struct {
+	// Rows and Cols are the dimensions of the compared matrices.
+	Rows, Cols int
+
+	// Count is the number of elements that are not equal.
+	Count int
+
+	// MaxAbs is the largest absolute difference |a_ij - b_ij|.
+	MaxAbs               float64
+	MaxAbsRow, MaxAbsCol int
+
+	// MaxRel is the largest relative difference
+	// |a_ij - b_ij| / max(|a_ij|, |b_ij|).
+	MaxRel               float64
+	MaxRelRow, MaxRelCol int
+
+	// MaxULP is the largest distance between a_ij and b_ij
+	// in units in the last place.
+	MaxULP               uint64
+	MaxULPRow, MaxULPCol int
+}
+
+// Diff returns a description of the element-wise differences between the
+// matrices a and b. Diff will panic if a and b do not have the same
+// dimensions.
+func Diff(a, b Matrix) Difference {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br || ac != bc {
+		panic(ErrShape)
+	}
+	d := Difference{
+		Rows: ar, Cols: ac,
+		MaxAbsRow: -1, MaxAbsCol: -1,
+		MaxRelRow: -1, MaxRelCol: -1,
+		MaxULPRow: -1, MaxULPCol: -1,
+	}
+	for i := 0; i < ar; i++ {
+		for j := 0; j < ac; j++ {
+			va := a.At(i, j)
+			vb := b.At(i, j)
+			if va == vb || (math.IsNaN(va) && math.IsNaN(vb)) {
+				continue
+			}
+			d.Count++
+
+			abs := math.Abs(va - vb)
+			rel := abs / math.Max(math.Abs(va), math.Abs(vb))
+			if math.IsNaN(abs) {
+				// One of the elements is NaN, or
+				// both are the same infinity.
+				abs = math.Inf(1)
+				rel = math.Inf(1)
+			}
+			if abs > d.MaxAbs || d.MaxAbsRow < 0 {
+				d.MaxAbs, d.MaxAbsRow, d.MaxAbsCol = abs, i, j
+			}
+			if rel > d.MaxRel || d.MaxRelRow < 0 {
+				d.MaxRel, d.MaxRelRow, d.MaxRelCol = rel, i, j
+			}
+			if ulp := ulpDistance(va, vb); ulp > d.MaxULP || d.MaxULPRow < 0 {
+				d.MaxULP, d.MaxULPRow, d.MaxULPCol = ulp, i, j
+			}
+		}
+	}
+	return d
+}
+
+// String returns a summary of the differences.
+func (d Difference) String() string {
+	if d.Count == 0 {
+		return fmt.Sprintf("all %d×%d elements equal", d.Rows, d.Cols)
+	}
+	return fmt.Sprintf("%d of %d×%d elements differ: max abs %g at (%d, %d), max rel %g at (%d, %d), max ulp %d at (%d, %d)",
+		d.Count, d.Rows, d.Cols,
+		d.MaxAbs, d.MaxAbsRow, d.MaxAbsCol,
+		d.MaxRel, d.MaxRelRow, d.MaxRelCol,
+		d.MaxULP, d.MaxULPRow, d.MaxULPCol,
+	)
+}
+
+// EqualULP returns whether the matrices a and b have the same size and are
+// element-wise equal to within the given number of units in the last place.
+// Elements that are NaN are not equal to any value.
+func EqualULP(a, b Matrix, ulp uint64) bool {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br || ac != bc {
+		return false
+	}
+	for i := 0; i < ar; i++ {
+		for j := 0; j < ac; j++ {
+			if ulpDistance(a.At(i, j), b.At(i, j)) > ulp {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// ulpDistance returns the number of representable float64 values between
+// a and b, or math.MaxUint64 if either is NaN. Zeros of either sign are
+// treated as equal.
+func ulpDistance(a, b float64) uint64 {
+	if a == b {
+		return 0
+	}
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return math.MaxUint64
+	}
+	ua := math.Float64bits(math.Abs(a))
+	ub := math.Float64bits(math.Abs(b))
+	if math.Signbit(a) != math.Signbit(b) {
+		return ua + ub
+	}
+	if ua > ub {
+		return ua - ub
+	}
+	return ub - ua
+}
@@ -0,0 +1,97 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+	nan := math.NaN()
+	inf := math.Inf(1)
+	one := math.Nextafter(1, 2)
+	for i, test := range []struct {
+		a, b Matrix
+		want Difference
+		str  string
+	}{
+		{
+			a:    NewDense(2, 2, []float64{1, 2, 3, nan}),
+			b:    NewDense(2, 2, []float64{1, 2, 3, nan}),
+			want: Difference{Rows: 2, Cols: 2, MaxAbsRow: -1, MaxAbsCol: -1, MaxRelRow: -1, MaxRelCol: -1, MaxULPRow: -1, MaxULPCol: -1},
+			str:  "all 2×2 elements equal",
+		},
+		{
+			a: NewDense(2, 3, []float64{1, 2, 100, 4, 5, 0}),
+			b: NewDense(2, 3, []float64{one, 2, 101, 4, 5.5, 0}),
+			want: Difference{
+				Rows: 2, Cols: 3, Count: 3,
+				MaxAbs: 1, MaxAbsRow: 0, MaxAbsCol: 2,
+				MaxRel: 0.5 / 5.5, MaxRelRow: 1, MaxRelCol: 1,
+				MaxULP: math.Float64bits(5.5) - math.Float64bits(5), MaxULPRow: 1, MaxULPCol: 1,
+			},
+			str: "3 of 2×3 elements differ: max abs 1 at (0, 2), max rel 0.09090909090909091 at (1, 1), max ulp 562949953421312 at (1, 1)",
+		},
+		{
+			a: NewDense(1, 3, []float64{1, inf, 0}),
+			b: NewDense(3, 1, []float64{nan, inf, math.Copysign(0, -1)}).T(),
+			want: Difference{
+				Rows: 1, Cols: 3, Count: 1,
+				MaxAbs: inf, MaxAbsRow: 0, MaxAbsCol: 0,
+				MaxRel: inf, MaxRelRow: 0, MaxRelCol: 0,
+				MaxULP: math.MaxUint64, MaxULPRow: 0, MaxULPCol: 0,
+			},
+		},
+		{
+			a: NewVecDense(2, []float64{1e-300, -1}),
+			b: NewVecDense(2, []float64{-1e-300, -one}),
+			want: Difference{
+				Rows: 2, Cols: 1, Count: 2,
+				MaxAbs: math.Nextafter(1, 2) - 1, MaxAbsRow: 1, MaxAbsCol: 0,
+				MaxRel: 2, MaxRelRow: 0, MaxRelCol: 0,
+				MaxULP: 2 * math.Float64bits(1e-300), MaxULPRow: 0, MaxULPCol: 0,
+			},
+		},
+	} {
+		got := Diff(test.a, test.b)
+		if got != test.want {
+			t.Errorf("unexpected difference for test %d:\ngot: %+v\nwant:%+v", i, got, test.want)
+		}
+		if test.str != "" && got.String() != test.str {
+			t.Errorf("unexpected string for test %d:\ngot: %s\nwant:%s", i, got, test.str)
+		}
+	}
+	if p, _ := panics(func() { Diff(NewDense(2, 2, nil), NewDense(2, 3, nil)) }); !p {
+		t.Error("expected panic for mismatched dimensions")
+	}
+}
+
+func TestEqualULP(t *testing.T) {
+	t.Parallel()
+	a := NewDense(2, 2, []float64{1, -2, 0, 1e300})
+	b := NewDense(2, 2, []float64{
+		math.Nextafter(1, 2), math.Nextafter(math.Nextafter(-2, -3), -3),
+		math.Copysign(0, -1), 1e300,
+	})
+	for _, test := range []struct {
+		a, b Matrix
+		ulp  uint64
+		want bool
+	}{
+		{a: a, b: b, ulp: 0, want: false},
+		{a: a, b: b, ulp: 1, want: false},
+		{a: a, b: b, ulp: 2, want: true},
+		{a: a.T(), b: b.T(), ulp: 2, want: true},
+		{a: a, b: a.T(), ulp: 1 << 40, want: false},
+		{a: a, b: NewDense(2, 3, nil), ulp: 1 << 62, want: false},
+		{a: NewDense(1, 1, []float64{math.NaN()}), b: NewDense(1, 1, []float64{math.NaN()}), ulp: math.MaxUint64 - 1, want: false},
+	} {
+		if got := EqualULP(test.a, test.b, test.ulp); got != test.want {
+			t.Errorf("unexpected result for EqualULP(%v, %v, %d): got:%t want:%t", Formatted(test.a), Formatted(test.b), test.ulp, got, test.want)
+		}
+	}
+}
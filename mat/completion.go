@@ -0,0 +1,287 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+)
+
+// Masked is a matrix with missing elements.
+type Masked interface {
+	Matrix
+
+	// Observed returns whether the element at row i, column j
+	// is observed. The values returned by At for elements that
+	// are not observed are ignored.
+	Observed(i, j int) bool
+}
+
+// CompletionSettings holds the parameters of a low-rank matrix completion.
+type CompletionSettings struct {
+	// Lambda is the non-negative weight of the Tikhonov regularization
+	// of the factors. A positive Lambda is required when a row or
+	// column of the matrix has fewer than k observed elements.
+	Lambda float64
+
+	// MaxIter is the maximum number of iterations. If MaxIter
+	// is zero, a default of 100 is used.
+	MaxIter int
+
+	// Tol is the convergence tolerance. The iteration stops when
+	// the relative decrease in the objective in an iteration is
+	// less than Tol, or when the objective is less than Tol times
+	// the sum of squares of the observed elements. If Tol is zero,
+	// a default of 1e-8 is used.
+	Tol float64
+}
+
+// Completion is a type for computing and using a regularized low-rank
+// factorization
+//
+//	A ≈ U * Vᵀ,
+//
+// of an m×n matrix A with missing elements, where U is m×k and V is n×k.
+// The factors minimize
+//
+//	Σ_{(i,j) observed} (A_ij - (U*Vᵀ)_ij)² + λ (‖U‖²_F + ‖V‖²_F),
+//
+// and the product U*Vᵀ provides estimates of the missing elements of A.
+type Completion struct {
+	u, v *Dense
+
+	obj  float64
+	iter int
+}
+
+// succFact returns whether the receiver contains a factorization.
+func (c *Completion) succFact() bool {
+	return c.u != nil
+}
+
+// Factorize computes a rank-k completion of the m×n matrix A by alternating
+// least squares. If A implements Masked, its Observed method determines the
+// observed elements, otherwise elements of A with NaN values are missing.
+// The factors are initialized from the truncated singular value
+// decomposition of A with missing elements set to zero. If settings is nil,
+// the default settings with no regularization are used.
+//
+// Factorize returns whether the iteration converged. The factors from the
+// last iteration are retained when the iteration did not converge. If a
+// least squares subproblem is singular, the factorization fails and
+// routines that require a factorization will panic. Factorize will panic
+// if k is not in [1, min(m, n)] or if the regularization weight is
+// negative.
+func (c *Completion) Factorize(a Matrix, k int, settings *CompletionSettings) (ok bool) {
+	m, n := a.Dims()
+	if k < 1 || min(m, n) < k {
+		panic("completion: rank out of range")
+	}
+	var s CompletionSettings
+	if settings != nil {
+		s = *settings
+	}
+	if s.Lambda < 0 {
+		panic("completion: negative regularization")
+	}
+	if s.MaxIter == 0 {
+		s.MaxIter = 100
+	}
+	if s.Tol == 0 {
+		s.Tol = 1e-8
+	}
+	c.u = nil
+	c.v = nil
+	c.iter = 0
+
+	// Copy the observed elements and their mask.
+	observed := func(i, j int) bool { return !math.IsNaN(a.At(i, j)) }
+	if ma, ok := a.(Masked); ok {
+		observed = ma.Observed
+	}
+	ad := NewDense(m, n, nil)
+	mask := make([]bool, m*n)
+	var ss float64
+	for i := range m {
+		for j := range n {
+			if observed(i, j) {
+				v := a.At(i, j)
+				mask[i*n+j] = true
+				ad.set(i, j, v)
+				ss += v * v
+			}
+		}
+	}
+
+	var svd SVD
+	if !svd.Factorize(ad, SVDThin) {
+		return false
+	}
+	sv := svd.Values(nil)
+	var su, svv Dense
+	svd.UTo(&su)
+	svd.VTo(&svv)
+	u := DenseCopyOf(su.Slice(0, m, 0, k))
+	v := DenseCopyOf(svv.Slice(0, n, 0, k))
+	for j := range k {
+		f := math.Sqrt(sv[j])
+		for i := range m {
+			u.set(i, j, f*u.at(i, j))
+		}
+		for i := range n {
+			v.set(i, j, f*v.at(i, j))
+		}
+	}
+
+	var (
+		sys  = NewSymDense(k, nil)
+		chol Cholesky
+		rhs  = NewVecDense(k, nil)
+		sol  VecDense
+	)
+	// update replaces the rows of x by the minimizers of the objective
+	// with the factor y fixed. If trans is true, the rows of x correspond
+	// to the columns of A.
+	update := func(x, y *Dense, trans bool) bool {
+		r, _ := x.Dims()
+		ry, _ := y.Dims()
+		for i := range r {
+			sys.Zero()
+			rhs.Zero()
+			for l := range ry {
+				idx := i*n + l
+				if trans {
+					idx = l*n + i
+				}
+				if !mask[idx] {
+					continue
+				}
+				yl := y.RawRowView(l)
+				sys.SymRankOne(sys, 1, NewVecDense(k, yl))
+				rhs.AddScaledVec(rhs, ad.mat.Data[idx], NewVecDense(k, yl))
+			}
+			for p := range k {
+				sys.SetSym(p, p, sys.at(p, p)+s.Lambda)
+			}
+			if !chol.Factorize(sys) {
+				return false
+			}
+			sol.Reset()
+			if err := chol.SolveVecTo(&sol, rhs); err != nil {
+				return false
+			}
+			copy(x.RawRowView(i), sol.RawVector().Data)
+		}
+		return true
+	}
+	objective := func() float64 {
+		var f float64
+		for i := range m {
+			ui := u.RawRowView(i)
+			for j := range n {
+				if !mask[i*n+j] {
+					continue
+				}
+				var p float64
+				for l, v := range v.RawRowView(j) {
+					p += ui[l] * v
+				}
+				r := ad.at(i, j) - p
+				f += r * r
+			}
+		}
+		nu := Norm(u, 2)
+		nv := Norm(v, 2)
+		return f + s.Lambda*(nu*nu+nv*nv)
+	}
+
+	prev := objective()
+	for c.iter = 1; c.iter <= s.MaxIter; c.iter++ {
+		if !update(u, v, false) || !update(v, u, true) {
+			return false
+		}
+		obj := objective()
+		if prev-obj <= s.Tol*prev || obj <= s.Tol*ss {
+			c.u, c.v, c.obj = u, v, obj
+			return true
+		}
+		prev = obj
+	}
+	c.u, c.v, c.obj = u, v, prev
+	c.iter = s.MaxIter
+	return false
+}
+
+// Objective returns the value of the regularized objective at the
+// factors.
+//
+// Objective will panic if the receiver does not contain a factorization.
+func (c *Completion) Objective() float64 {
+	if !c.succFact() {
+		panic(badFact)
+	}
+	return c.obj
+}
+
+// Iterations returns the number of iterations performed by the
+// factorization.
+func (c *Completion) Iterations() int {
+	return c.iter
+}
+
+// UTo extracts the m×k factor U into dst.
+//
+// If dst is empty, UTo will resize dst to be m×k. When dst is non-empty,
+// UTo will panic if dst is not m×k. UTo will also panic if the receiver
+// does not contain a factorization.
+func (c *Completion) UTo(dst *Dense) {
+	if !c.succFact() {
+		panic(badFact)
+	}
+	m, k := c.u.Dims()
+	dst.reuseAsNonZeroed(m, k)
+	dst.Copy(c.u)
+}
+
+// VTo extracts the n×k factor V into dst.
+//
+// If dst is empty, VTo will resize dst to be n×k. When dst is non-empty,
+// VTo will panic if dst is not n×k. VTo will also panic if the receiver
+// does not contain a factorization.
+func (c *Completion) VTo(dst *Dense) {
+	if !c.succFact() {
+		panic(badFact)
+	}
+	n, k := c.v.Dims()
+	dst.reuseAsNonZeroed(n, k)
+	dst.Copy(c.v)
+}
+
+// At returns the estimate of the element of A at row i, column j.
+//
+// At will panic if the receiver does not contain a factorization.
+func (c *Completion) At(i, j int) float64 {
+	if !c.succFact() {
+		panic(badFact)
+	}
+	var p float64
+	vj := c.v.RawRowView(j)
+	for l, v := range c.u.RawRowView(i) {
+		p += v * vj[l]
+	}
+	return p
+}
+
+// CompletedTo stores the m×n matrix U*Vᵀ of estimates of the elements of A
+// into dst.
+//
+// If dst is empty, CompletedTo will resize dst to be m×n. When dst is
+// non-empty, CompletedTo will panic if dst is not m×n. CompletedTo will
+// also panic if the receiver does not contain a factorization.
+func (c *Completion) CompletedTo(dst *Dense) {
+	if !c.succFact() {
+		panic(badFact)
+	}
+	dst.Mul(c.u, c.v.T())
+}
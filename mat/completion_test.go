@@ -0,0 +1,110 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// maskedDense is a Dense with an explicit mask of observed elements.
+type maskedDense struct {
+	*Dense
+	obs [][]bool
+}
+
+func (m maskedDense) Observed(i, j int) bool { return m.obs[i][j] }
+
+func TestCompletion(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n, k int
+		frac    float64
+		lambda  float64
+		masked  bool
+		stop    float64
+		tol     float64
+	}{
+		{m: 30, n: 20, k: 2, frac: 0.6, stop: 1e-14, tol: 1e-6},
+		{m: 20, n: 30, k: 3, frac: 0.7, stop: 1e-14, tol: 1e-6},
+		{m: 30, n: 20, k: 2, frac: 0.6, masked: true, stop: 1e-14, tol: 1e-6},
+		{m: 30, n: 20, k: 2, frac: 0.6, lambda: 1e-3, stop: 1e-5, tol: 1e-2},
+		{m: 10, n: 10, k: 1, frac: 1, stop: 1e-14, tol: 1e-10},
+	} {
+		var u0, v0, a Dense
+		u0.CloneFrom(NewDense(test.m, test.k, randSlice(test.m*test.k, rnd)))
+		v0.CloneFrom(NewDense(test.n, test.k, randSlice(test.n*test.k, rnd)))
+		a.Mul(&u0, v0.T())
+
+		// Remove elements keeping at least k observations in each
+		// row and column.
+		obs := make([][]bool, test.m)
+		in := DenseCopyOf(&a)
+		for i := range obs {
+			obs[i] = make([]bool, test.n)
+			for j := range obs[i] {
+				obs[i][j] = rnd.Float64() < test.frac || (i-j+test.n)%test.n < test.k
+				if !obs[i][j] {
+					if test.masked {
+						in.Set(i, j, 1e10)
+					} else {
+						in.Set(i, j, math.NaN())
+					}
+				}
+			}
+		}
+		var input Matrix = in
+		if test.masked {
+			input = maskedDense{Dense: in, obs: obs}
+		}
+
+		var c Completion
+		if !c.Factorize(input, test.k, &CompletionSettings{Lambda: test.lambda, MaxIter: 5000, Tol: test.stop}) {
+			t.Errorf("m=%d n=%d k=%d lambda=%v masked=%t: no convergence after %d iterations, obj=%v", test.m, test.n, test.k, test.lambda, test.masked, c.Iterations(), c.Objective())
+		}
+		var got Dense
+		c.CompletedTo(&got)
+		var diff Dense
+		diff.Sub(&got, &a)
+		if rel := Norm(&diff, 2) / Norm(&a, 2); rel > test.tol {
+			t.Errorf("m=%d n=%d k=%d lambda=%v: completion error too large: %v", test.m, test.n, test.k, test.lambda, rel)
+		}
+		for i := range test.m {
+			for j := range test.n {
+				if c.At(i, j) != got.At(i, j) {
+					t.Fatalf("m=%d n=%d k=%d: At does not match CompletedTo", test.m, test.n, test.k)
+				}
+			}
+		}
+		var u, v Dense
+		c.UTo(&u)
+		c.VTo(&v)
+		var uvt Dense
+		uvt.Mul(&u, v.T())
+		if !EqualApprox(&uvt, &got, 1e-14) {
+			t.Errorf("m=%d n=%d k=%d: U*Vᵀ does not match CompletedTo", test.m, test.n, test.k)
+		}
+	}
+
+	var c Completion
+	if p, _ := panics(func() { c.At(0, 0) }); !p {
+		t.Error("no panic for use without factorization")
+	}
+	if p, _ := panics(func() { c.Factorize(NewDense(2, 2, nil), 1, &CompletionSettings{Lambda: -1}) }); !p {
+		t.Error("no panic for negative regularization")
+	}
+	// An empty column cannot be determined without regularization.
+	a := NewDense(3, 3, []float64{1, 2, math.NaN(), 2, 4, math.NaN(), 3, 6, math.NaN()})
+	if c.Factorize(a, 1, nil) {
+		t.Error("unexpected success for empty column without regularization")
+	}
+	if !c.Factorize(a, 1, &CompletionSettings{Lambda: 1e-6}) {
+		t.Error("unexpected failure for empty column with regularization")
+	} else if v := c.At(0, 2); v != 0 {
+		t.Errorf("unexpected estimate for empty column: got %v want 0", v)
+	}
+}
@@ -36,6 +36,13 @@ type Dense struct {
 	mat blas64.General
 
 	capRows, capCols int
+
+	// sealed indicates that the matrix
+	// must not be mutated. shared indicates
+	// that the backing data is shared
+	// with copy-on-write branches and
+	// must be copied before mutation.
+	sealed, shared bool
 }
 
 // NewDense creates a new Dense matrix with r rows and c columns. If data == nil,
@@ -96,6 +103,7 @@ func (m *Dense) ReuseAs(r, c int) {
 // or checks that a non-empty matrix is r×c. It does not zero
 // the data in the receiver.
 func (m *Dense) reuseAsNonZeroed(r, c int) {
+	m.mutate()
 	// reuseAs must be kept in sync with reuseAsZeroed.
 	if m.mat.Rows > m.capRows || m.mat.Cols > m.capCols {
 		// Panic as a string, not a mat.Error.
@@ -124,6 +132,7 @@ func (m *Dense) reuseAsNonZeroed(r, c int) {
 // or checks that a non-empty matrix is r×c. It zeroes
 // all the elements of the matrix.
 func (m *Dense) reuseAsZeroed(r, c int) {
+	m.mutate()
 	// reuseAsZeroed must be kept in sync with reuseAsNonZeroed.
 	if m.mat.Rows > m.capRows || m.mat.Cols > m.capCols {
 		// Panic as a string, not a mat.Error.
@@ -151,6 +160,7 @@ func (m *Dense) reuseAsZeroed(r, c int) {
 
 // Zero sets all of the matrix elements to zero.
 func (m *Dense) Zero() {
+	m.mutate()
 	r := m.mat.Rows
 	c := m.mat.Cols
 	for i := 0; i < r; i++ {
@@ -179,6 +189,14 @@ func (m *Dense) isolatedWorkspace(a Matrix) (w *Dense, restore func()) {
 // Reset should not be used when the matrix shares backing data.
 // See the Reseter interface for more information.
 func (m *Dense) Reset() {
+	if m.sealed {
+		panic(ErrSealed)
+	}
+	if m.shared {
+		// Do not reuse shared data.
+		m.mat.Data = nil
+		m.shared = false
+	}
 	// Row, Cols and Stride must be zeroed in unison.
 	m.mat.Rows, m.mat.Cols, m.mat.Stride = 0, 0, 0
 	m.capRows, m.capCols = 0, 0
@@ -220,6 +238,10 @@ func DenseCopyOf(a Matrix) *Dense {
 // Changes to elements in the receiver following the call will be reflected
 // in b.
 func (m *Dense) SetRawMatrix(b blas64.General) {
+	if m.sealed {
+		panic(ErrSealed)
+	}
+	m.shared = false
 	m.capRows, m.capCols = b.Rows, b.Cols
 	m.mat = b
 }
@@ -244,6 +266,7 @@ func (m *Dense) T() Matrix {
 //
 // See ColViewer for more information.
 func (m *Dense) ColView(j int) Vector {
+	m.unshare()
 	var v VecDense
 	v.ColViewOf(m, j)
 	return &v
@@ -252,6 +275,7 @@ func (m *Dense) ColView(j int) Vector {
 // SetCol sets the values in the specified column of the matrix to the values
 // in src. len(src) must equal the number of rows in the receiver.
 func (m *Dense) SetCol(j int, src []float64) {
+	m.mutate()
 	if j >= m.mat.Cols || j < 0 {
 		panic(ErrColAccess)
 	}
@@ -268,6 +292,7 @@ func (m *Dense) SetCol(j int, src []float64) {
 // SetRow sets the values in the specified rows of the matrix to the values
 // in src. len(src) must equal the number of columns in the receiver.
 func (m *Dense) SetRow(i int, src []float64) {
+	m.mutate()
 	if i >= m.mat.Rows || i < 0 {
 		panic(ErrRowAccess)
 	}
@@ -283,6 +308,7 @@ func (m *Dense) SetRow(i int, src []float64) {
 //
 // See RowViewer for more information.
 func (m *Dense) RowView(i int) Vector {
+	m.unshare()
 	var v VecDense
 	v.RowViewOf(m, i)
 	return &v
@@ -294,6 +320,7 @@ func (m *Dense) RawRowView(i int) []float64 {
 	if i >= m.mat.Rows || i < 0 {
 		panic(ErrRowAccess)
 	}
+	m.unshare()
 	return m.rawRowView(i)
 }
 
@@ -303,6 +330,7 @@ func (m *Dense) rawRowView(i int) []float64 {
 
 // DiagView returns the diagonal as a matrix backed by the original data.
 func (m *Dense) DiagView() Diagonal {
+	m.unshare()
 	n := min(m.mat.Rows, m.mat.Cols)
 	return &DiagDense{
 		mat: blas64.Vector{
@@ -320,6 +348,7 @@ func (m *Dense) DiagView() Diagonal {
 // Slice panics with ErrIndexOutOfRange if the slice is outside the capacity
 // of the receiver.
 func (m *Dense) Slice(i, k, j, l int) Matrix {
+	m.unshare()
 	return m.slice(i, k, j, l)
 }
 
@@ -351,6 +380,7 @@ func (m *Dense) Grow(r, c int) Matrix {
 	if r == 0 && c == 0 {
 		return m
 	}
+	m.unshare()
 
 	r += m.mat.Rows
 	c += m.mat.Cols
@@ -399,6 +429,7 @@ func (m *Dense) Grow(r, c int) Matrix {
 			Cols:   c,
 			Stride: m.mat.Stride,
 		}
+		t.sealed = m.sealed
 	}
 	t.capRows = r
 	t.capCols = c
@@ -411,6 +442,9 @@ func (m *Dense) Grow(r, c int) Matrix {
 //
 // See the ClonerFrom interface for more information.
 func (m *Dense) CloneFrom(a Matrix) {
+	if m.sealed {
+		panic(ErrSealed)
+	}
 	r, c := a.Dims()
 	mat := blas64.General{
 		Rows:   r,
@@ -449,9 +483,11 @@ func (m *Dense) CloneFrom(a Matrix) {
 			}
 		}
 		*m = w
+		m.shared = false
 		return
 	}
 	m.mat = mat
+	m.shared = false
 }
 
 // Copy makes a copy of elements of a into the receiver. It is similar to the
@@ -462,6 +498,7 @@ func (m *Dense) CloneFrom(a Matrix) {
 //
 // See the Copier interface for more information.
 func (m *Dense) Copy(a Matrix) (r, c int) {
+	m.mutate()
 	r, c = a.Dims()
 	if a == m {
 		return r, c
@@ -642,6 +679,7 @@ func (m *Dense) Permutation(n int, p []int) {
 //
 // p must have length m, otherwise PermuteRows will panic.
 func (m *Dense) PermuteRows(p []int, inverse bool) {
+	m.mutate()
 	r, _ := m.Dims()
 	if len(p) != r {
 		panic(badSliceLength)
@@ -662,6 +700,7 @@ func (m *Dense) PermuteRows(p []int, inverse bool) {
 //
 // p must have length n, otherwise PermuteCols will panic.
 func (m *Dense) PermuteCols(p []int, inverse bool) {
+	m.mutate()
 	_, c := m.Dims()
 	if len(p) != c {
 		panic(badSliceLength)
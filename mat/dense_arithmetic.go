@@ -9,6 +9,7 @@ import (
 
 	"gonum.org/v1/gonum/blas"
 	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/internal/asm/f64"
 	"gonum.org/v1/gonum/lapack/lapack64"
 )
 
@@ -210,6 +211,167 @@ func (m *Dense) DivElem(a, b Matrix) {
 	}
 }
 
+// AddScaledElem performs element-wise a + alpha * b, placing the result in
+// the receiver without allocating an intermediate matrix. AddScaledElem will
+// panic if the two matrices do not have the same shape.
+func (m *Dense) AddScaledElem(a Matrix, alpha float64, b Matrix) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br || ac != bc {
+		panic(ErrShape)
+	}
+
+	aU, aTrans := untransposeExtract(a)
+	bU, bTrans := untransposeExtract(b)
+	m.reuseAsNonZeroed(ar, ac)
+
+	if arm, ok := a.(*Dense); ok {
+		if brm, ok := b.(*Dense); ok {
+			amat, bmat := arm.mat, brm.mat
+			if m != aU {
+				m.checkOverlap(amat)
+			}
+			if m != bU {
+				m.checkOverlap(bmat)
+			}
+			for ja, jb, jm := 0, 0, 0; ja < ar*amat.Stride; ja, jb, jm = ja+amat.Stride, jb+bmat.Stride, jm+m.mat.Stride {
+				f64.AxpyUnitaryTo(m.mat.Data[jm:jm+ac], alpha, bmat.Data[jb:jb+ac], amat.Data[ja:ja+ac])
+			}
+			return
+		}
+	}
+
+	m.checkOverlapMatrix(aU)
+	m.checkOverlapMatrix(bU)
+	var restore func()
+	if aTrans && m == aU {
+		m, restore = m.isolatedWorkspace(aU)
+		defer restore()
+	} else if bTrans && m == bU {
+		m, restore = m.isolatedWorkspace(bU)
+		defer restore()
+	}
+
+	for r := 0; r < ar; r++ {
+		for c := 0; c < ac; c++ {
+			m.set(r, c, a.At(r, c)+alpha*b.At(r, c))
+		}
+	}
+}
+
+// MulElemAdd performs element-wise multiplication of a and b and adds c,
+// placing the result in the receiver without allocating an intermediate
+// matrix. MulElemAdd will panic if the three matrices do not have the same
+// shape.
+func (m *Dense) MulElemAdd(a, b, c Matrix) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	cr, cc := c.Dims()
+	if ar != br || ac != bc || ar != cr || ac != cc {
+		panic(ErrShape)
+	}
+
+	aU, aTrans := untransposeExtract(a)
+	bU, bTrans := untransposeExtract(b)
+	cU, cTrans := untransposeExtract(c)
+	m.reuseAsNonZeroed(ar, ac)
+
+	if arm, ok := a.(*Dense); ok {
+		if brm, ok := b.(*Dense); ok {
+			if crm, ok := c.(*Dense); ok {
+				amat, bmat, cmat := arm.mat, brm.mat, crm.mat
+				if m != aU {
+					m.checkOverlap(amat)
+				}
+				if m != bU {
+					m.checkOverlap(bmat)
+				}
+				if m != cU {
+					m.checkOverlap(cmat)
+				}
+				for ja, jb, jc, jm := 0, 0, 0, 0; ja < ar*amat.Stride; ja, jb, jc, jm = ja+amat.Stride, jb+bmat.Stride, jc+cmat.Stride, jm+m.mat.Stride {
+					for i, v := range amat.Data[ja : ja+ac] {
+						m.mat.Data[i+jm] = v*bmat.Data[i+jb] + cmat.Data[i+jc]
+					}
+				}
+				return
+			}
+		}
+	}
+
+	m.checkOverlapMatrix(aU)
+	m.checkOverlapMatrix(bU)
+	m.checkOverlapMatrix(cU)
+	var restore func()
+	if aTrans && m == aU {
+		m, restore = m.isolatedWorkspace(aU)
+		defer restore()
+	} else if bTrans && m == bU {
+		m, restore = m.isolatedWorkspace(bU)
+		defer restore()
+	} else if cTrans && m == cU {
+		m, restore = m.isolatedWorkspace(cU)
+		defer restore()
+	}
+
+	for r := 0; r < ar; r++ {
+		for j := 0; j < ac; j++ {
+			m.set(r, j, a.At(r, j)*b.At(r, j)+c.At(r, j))
+		}
+	}
+}
+
+// Clip places the elements of a clamped to the interval [lo, hi] in the
+// receiver. NaN elements are retained. Clip will panic if lo is greater
+// than hi.
+func (m *Dense) Clip(lo, hi float64, a Matrix) {
+	if lo > hi {
+		panic("mat: lower bound greater than upper bound")
+	}
+	ar, ac := a.Dims()
+
+	aU, aTrans := untransposeExtract(a)
+	m.reuseAsNonZeroed(ar, ac)
+
+	if arm, ok := a.(*Dense); ok {
+		amat := arm.mat
+		if m != aU {
+			m.checkOverlap(amat)
+		}
+		for ja, jm := 0, 0; ja < ar*amat.Stride; ja, jm = ja+amat.Stride, jm+m.mat.Stride {
+			for i, v := range amat.Data[ja : ja+ac] {
+				m.mat.Data[i+jm] = clip(v, lo, hi)
+			}
+		}
+		return
+	}
+
+	m.checkOverlapMatrix(aU)
+	if aTrans && m == aU {
+		var restore func()
+		m, restore = m.isolatedWorkspace(aU)
+		defer restore()
+	}
+
+	for r := 0; r < ar; r++ {
+		for c := 0; c < ac; c++ {
+			m.set(r, c, clip(a.At(r, c), lo, hi))
+		}
+	}
+}
+
+// clip returns v clamped to the interval [lo, hi]. NaN values are returned
+// unaltered.
+func clip(v, lo, hi float64) float64 {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
+
 // Inverse computes the inverse of the matrix a, storing the result into the
 // receiver. If a is ill-conditioned, a Condition error will be returned.
 // Note that matrix inversion is numerically unstable, and should generally
@@ -1534,6 +1534,92 @@ func TestDenseApply(t *testing.T) {
 	}
 }
 
+func TestDenseAddScaledElem(t *testing.T) {
+	t.Parallel()
+	for _, alpha := range []float64{0, 1, -2.5} {
+		method := func(receiver, a, b Matrix) {
+			type AddScaledElemer interface {
+				AddScaledElem(a Matrix, alpha float64, b Matrix)
+			}
+			rd := receiver.(AddScaledElemer)
+			rd.AddScaledElem(a, alpha, b)
+		}
+		denseComparison := func(receiver, a, b *Dense) {
+			var scaled Dense
+			scaled.Scale(alpha, b)
+			receiver.Add(a, &scaled)
+		}
+		testTwoInput(t, "AddScaledElem", &Dense{}, method, denseComparison, legalTypesAll, legalSizeSameRectangular, 1e-14)
+	}
+}
+
+func TestDenseMulElemAdd(t *testing.T) {
+	t.Parallel()
+	a := NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6})
+	b := NewDense(2, 3, []float64{-1, 0, 1, 2, 0.5, -2})
+	c := NewDense(2, 3, []float64{10, 20, 30, 40, 50, 60})
+	want := NewDense(2, 3, []float64{9, 20, 33, 48, 52.5, 48})
+	var got Dense
+	got.MulElemAdd(a, b, c)
+	if !Equal(&got, want) {
+		t.Errorf("unexpected result from MulElemAdd: got: %v want: %v", got.mat.Data, want.mat.Data)
+	}
+	got.Reset()
+	got.MulElemAdd(a.T(), b.T(), c.T())
+	if !Equal(&got, want.T()) {
+		t.Errorf("unexpected result from MulElemAdd of transposes: got: %v want: %v", got.mat.Data, want.T())
+	}
+	c.MulElemAdd(a, b, c)
+	if !Equal(c, want) {
+		t.Errorf("unexpected result from in-place MulElemAdd: got: %v want: %v", c.mat.Data, want.mat.Data)
+	}
+	if p, _ := panics(func() { got.MulElemAdd(a, b, NewDense(3, 2, nil)) }); !p {
+		t.Error("expected panic for mismatched shapes")
+	}
+
+	method := func(receiver, a, b Matrix) {
+		type MulElemAdder interface {
+			MulElemAdd(a, b, c Matrix)
+		}
+		rd := receiver.(MulElemAdder)
+		rd.MulElemAdd(a, b, a)
+	}
+	denseComparison := func(receiver, a, b *Dense) {
+		var prod Dense
+		prod.MulElem(a, b)
+		receiver.Add(&prod, a)
+	}
+	testTwoInput(t, "MulElemAdd", &Dense{}, method, denseComparison, legalTypesAll, legalSizeSameRectangular, 1e-14)
+}
+
+func TestDenseClip(t *testing.T) {
+	t.Parallel()
+	a := NewDense(2, 3, []float64{-2, -0.5, 0, 0.5, 2, math.NaN()})
+	var got Dense
+	got.Clip(-1, 1, a)
+	want := []float64{-1, -0.5, 0, 0.5, 1}
+	if !floats.Equal(got.mat.Data[:5], want) || !math.IsNaN(got.mat.Data[5]) {
+		t.Errorf("unexpected result from Clip: got: %v want: %v", got.mat.Data, append(want, math.NaN()))
+	}
+	if p, _ := panics(func() { got.Clip(1, -1, a) }); !p {
+		t.Error("expected panic for lower bound greater than upper bound")
+	}
+
+	method := func(receiver, x Matrix) {
+		type Clipper interface {
+			Clip(lo, hi float64, a Matrix)
+		}
+		rd := receiver.(Clipper)
+		rd.Clip(-0.25, 0.5, x)
+	}
+	denseComparison := func(receiver, x *Dense) {
+		receiver.Apply(func(_, _ int, v float64) float64 {
+			return math.Max(-0.25, math.Min(v, 0.5))
+		}, x)
+	}
+	testOneInput(t, "Clip", &Dense{}, method, denseComparison, isAnyType, isAnySize, 0)
+}
+
 func TestDenseClone(t *testing.T) {
 	t.Parallel()
 	for i, test := range []struct {
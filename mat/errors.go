@@ -137,6 +137,7 @@ var (
 	ErrSliceLengthMismatch = Error{"mat: input slice length mismatch"}
 	ErrNotPSD              = Error{"mat: input not positive symmetric definite"}
 	ErrFailedEigen         = Error{"mat: eigendecomposition not successful"}
+	ErrSealed              = Error{"mat: mutation of sealed matrix"}
 )
 
 // ErrorStack represents matrix handling errors that have been recovered by Maybe wrappers.
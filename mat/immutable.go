@@ -0,0 +1,99 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+// Seal makes the receiver immutable. Following the call to Seal, methods
+// that would modify the elements or the shape of the receiver, including
+// its use as the receiver of an operation, panic with ErrSealed. Views of
+// the receiver returned by Slice and Grow are also sealed. Seal does not
+// prevent modification of the receiver through views created before the
+// call to Seal, through the vectors returned by RowView, ColView and
+// DiagView or through the data returned by RawMatrix and RawRowView.
+//
+// A sealed matrix may be shared between goroutines and read concurrently
+// without synchronization. A matrix can not be unsealed.
+func (m *Dense) Seal() {
+	m.sealed = true
+	// Sealed data is never modified through the receiver, so there
+	// is no need to copy it on write. Resolving sharing here means
+	// that reads of the sealed receiver never write to it.
+	m.shared = false
+}
+
+// IsSealed returns whether the receiver has been sealed.
+func (m *Dense) IsSealed() bool {
+	return m.sealed
+}
+
+// Freeze returns a sealed matrix holding the elements of a. If a is a sealed
+// *Dense, it is returned, otherwise a copy of a is made.
+func Freeze(a Matrix) *Dense {
+	if d, ok := a.(*Dense); ok && d.sealed {
+		return d
+	}
+	d := DenseCopyOf(a)
+	d.Seal()
+	return d
+}
+
+// Branch returns a copy-on-write copy of the receiver. The returned matrix
+// initially shares its backing data with the receiver, and the data is
+// copied the first time the returned matrix is modified, so the returned
+// matrix and the receiver may be modified independently. Branch allows
+// cheap copies of a matrix to be made in algorithms that explore
+// alternative modifications of a matrix, such as branch-and-bound.
+//
+// The returned matrix is not sealed. If the receiver is sealed, Branch does
+// not modify the receiver and may be called concurrently. Otherwise the
+// receiver is also made copy-on-write, so its data is copied the first time
+// it is modified following the call. Views of the receiver created before
+// the call to Branch continue to share data with the returned matrix.
+//
+// The data returned by RawMatrix of a matrix that shares its backing data
+// with a copy-on-write matrix must not be modified.
+func (m *Dense) Branch() *Dense {
+	if m.IsEmpty() {
+		return &Dense{}
+	}
+	if !m.sealed {
+		m.shared = true
+	}
+	return &Dense{
+		mat:     m.mat,
+		capRows: m.capRows,
+		capCols: m.capCols,
+		shared:  true,
+	}
+}
+
+// mutate panics if the receiver is sealed and ensures that the receiver
+// does not share its backing data with a copy-on-write matrix. It must be
+// called before the receiver is modified. mutate is small enough to be
+// inlined into element setters.
+func (m *Dense) mutate() {
+	if m.sealed {
+		panic(ErrSealed)
+	}
+	if m.shared {
+		m.cloneData()
+	}
+}
+
+// unshare copies the backing data of the receiver, including elements
+// within its capacity, if it is shared with a copy-on-write matrix.
+// unshare does not modify a sealed receiver, so it may be called by
+// methods that read the receiver.
+func (m *Dense) unshare() {
+	if m.shared && !m.sealed {
+		m.cloneData()
+	}
+}
+
+// cloneData replaces the backing data of the receiver, including elements
+// within its capacity, with a copy.
+func (m *Dense) cloneData() {
+	m.mat.Data = append([]float64(nil), m.mat.Data[:cap(m.mat.Data)]...)[:len(m.mat.Data)]
+	m.shared = false
+}
@@ -0,0 +1,204 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"sync"
+	"testing"
+
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+func TestDenseSeal(t *testing.T) {
+	t.Parallel()
+	a := NewDense(3, 3, []float64{
+		1, 2, 3,
+		4, 5, 6,
+		7, 8, 10,
+	})
+	want := DenseCopyOf(a)
+	a.Seal()
+	if !a.IsSealed() {
+		t.Fatal("matrix not sealed after call to Seal")
+	}
+	view := a.Slice(0, 2, 0, 2).(*Dense)
+	grown := view.Grow(1, 1).(*Dense)
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "Set", fn: func() { a.Set(0, 0, 1) }},
+		{name: "SetRow", fn: func() { a.SetRow(0, []float64{1, 2, 3}) }},
+		{name: "SetCol", fn: func() { a.SetCol(0, []float64{1, 2, 3}) }},
+		{name: "Zero", fn: func() { a.Zero() }},
+		{name: "Reset", fn: func() { a.Reset() }},
+		{name: "SetRawMatrix", fn: func() { a.SetRawMatrix(blas64.General{}) }},
+		{name: "CloneFrom", fn: func() { a.CloneFrom(want) }},
+		{name: "Copy", fn: func() { a.Copy(want) }},
+		{name: "Scale", fn: func() { a.Scale(2, want) }},
+		{name: "Mul", fn: func() { a.Mul(want, want) }},
+		{name: "Inverse", fn: func() { a.Inverse(want) }},
+		{name: "PermuteRows", fn: func() { a.PermuteRows([]int{2, 1, 0}, false) }},
+		{name: "slice Set", fn: func() { view.Set(0, 0, 1) }},
+		{name: "grown Set", fn: func() { grown.Set(0, 0, 1) }},
+	} {
+		panicked, message := panics(test.fn)
+		if !panicked || message != ErrSealed.Error() {
+			t.Errorf("unexpected panic for %s on sealed matrix: got:%q want:%q", test.name, message, ErrSealed)
+		}
+	}
+	if !Equal(a, want) {
+		t.Errorf("sealed matrix modified:\ngot:\n%v\nwant:\n%v", Formatted(a), Formatted(want))
+	}
+
+	// Sealed matrices can be used as operands.
+	var dst Dense
+	dst.Mul(a, a.T())
+	dst.Add(a, view.Grow(1, 1))
+
+	if got := Freeze(a); got != a {
+		t.Error("Freeze copied a sealed matrix")
+	}
+	frozen := Freeze(want)
+	if frozen == want || !frozen.IsSealed() || !Equal(frozen, want) {
+		t.Error("Freeze did not return a sealed copy of an unsealed matrix")
+	}
+}
+
+func TestDenseBranch(t *testing.T) {
+	t.Parallel()
+	for _, sealed := range []bool{false, true} {
+		orig := NewDense(3, 4, []float64{
+			1, 2, 3, 4,
+			5, 6, 7, 8,
+			9, 10, 11, 12,
+		})
+		// Use a view with spare capacity.
+		a := orig.Slice(0, 2, 0, 3).(*Dense)
+		want := DenseCopyOf(a)
+		if sealed {
+			a.Seal()
+		}
+
+		b := a.Branch()
+		if b.IsSealed() {
+			t.Errorf("sealed=%t: branch is sealed", sealed)
+		}
+		if !Equal(a, b) {
+			t.Errorf("sealed=%t: branch does not match receiver", sealed)
+		}
+		b.Set(0, 0, -1)
+		if !Equal(a, want) {
+			t.Errorf("sealed=%t: receiver modified by Set on branch", sealed)
+		}
+		if b.At(0, 0) != -1 || b.At(1, 2) != 7 {
+			t.Errorf("sealed=%t: unexpected branch after Set:\n%v", sealed, Formatted(b))
+		}
+		if r, c := b.Caps(); r != 3 || c != 4 {
+			t.Errorf("sealed=%t: unexpected branch capacity: got:%d×%d want:3×4", sealed, r, c)
+		}
+		// Capacity is preserved through the copy.
+		if got := b.Grow(1, 1).At(2, 3); got != 12 {
+			t.Errorf("sealed=%t: unexpected element of grown branch: got:%v want:12", sealed, got)
+		}
+
+		// Views and operations with the branch as the receiver
+		// do not modify the receiver.
+		c := a.Branch()
+		c.Slice(1, 2, 1, 3).(*Dense).Set(0, 0, -2)
+		d := a.Branch()
+		d.Mul(a, NewDiagDense(3, []float64{2, 2, 2}))
+		e := a.Branch()
+		e.RawRowView(0)[0] = -3
+		f := a.Branch()
+		f.Reset()
+		f.ReuseAs(2, 3)
+		if !Equal(a, want) {
+			t.Errorf("sealed=%t: receiver modified by branch:\n%v", sealed, Formatted(a))
+		}
+		if c.At(1, 1) != -2 || d.At(1, 2) != 14 || e.At(0, 0) != -3 {
+			t.Errorf("sealed=%t: branch not modified", sealed)
+		}
+
+		if !sealed {
+			// The receiver may be modified
+			// independently of its branches.
+			g := a.Branch()
+			a.Set(1, 1, 100)
+			if g.At(1, 1) != 6 {
+				t.Errorf("branch modified by Set on receiver")
+			}
+		}
+	}
+}
+
+func TestDenseBranchConcurrent(t *testing.T) {
+	t.Parallel()
+	const n = 8
+	a := NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		a.Set(i, i, 1)
+	}
+	a.Seal()
+
+	var wg sync.WaitGroup
+	got := make([]*Dense, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			b := a.Branch()
+			b.Set(i, i, float64(i+2))
+			got[i] = b
+		}(i)
+	}
+	wg.Wait()
+	for i, b := range got {
+		for j := 0; j < n; j++ {
+			want := 1.0
+			if j == i {
+				want = float64(i + 2)
+			}
+			if b.At(j, j) != want {
+				t.Errorf("unexpected diagonal element %d of branch %d: got:%v want:%v", j, i, b.At(j, j), want)
+			}
+		}
+	}
+	if a.Trace() != n {
+		t.Errorf("sealed matrix modified by branches")
+	}
+}
+
+func TestDenseSealedBranchConcurrentRead(t *testing.T) {
+	t.Parallel()
+	const n = 8
+	a := NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		a.Set(i, i, float64(i+1))
+	}
+	b := a.Branch()
+	b.Seal()
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			s := b.Slice(i, n, i, n)
+			if got := s.At(0, 0); got != float64(i+1) {
+				t.Errorf("unexpected slice element %d: got:%v want:%v", i, got, i+1)
+			}
+			if got := b.RowView(i).AtVec(i); got != float64(i+1) {
+				t.Errorf("unexpected row view element %d: got:%v want:%v", i, got, i+1)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	a.Set(0, 0, -1)
+	if b.At(0, 0) != 1 {
+		t.Errorf("sealed branch modified by its source")
+	}
+}
@@ -26,6 +26,7 @@ func (m *Dense) at(i, j int) float64 {
 
 // Set sets the element at row i, column j to the value v.
 func (m *Dense) Set(i, j int, v float64) {
+	m.mutate()
 	if uint(i) >= uint(m.mat.Rows) {
 		panic(ErrRowAccess)
 	}
@@ -41,3 +41,26 @@ func ExampleRow() {
 	//
 	// row = []float64{1.2, 3, 6}
 }
+
+func ExampleDiff() {
+	// This example reports where and by how much
+	// a matrix differs from an expected result.
+	got := mat.NewDense(2, 3, []float64{
+		1, 2, 3.000001,
+		4, 5, 6,
+	})
+	want := mat.NewDense(2, 3, []float64{
+		1, 2, 3,
+		4, 5.0001, 6,
+	})
+
+	d := mat.Diff(got, want)
+	fmt.Println(d)
+	fmt.Printf("worst absolute error at (%d, %d)\n", d.MaxAbsRow, d.MaxAbsCol)
+	fmt.Println("equal within 1e12 ulp:", mat.EqualULP(got, want, 1e12))
+
+	// Output:
+	// 2 of 2×3 elements differ: max abs 9.999999999976694e-05 at (1, 1), max rel 1.999960000795323e-05 at (1, 1), max ulp 112589990684 at (1, 1)
+	// worst absolute error at (1, 1)
+	// equal within 1e12 ulp: true
+}
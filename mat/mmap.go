@@ -0,0 +1,209 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+)
+
+// errMapUnsupported is returned when memory-mapped matrices
+// are not supported by the platform or build.
+var errMapUnsupported = errors.New("mat: memory mapping not supported")
+
+// MapMode specifies the access mode of a memory-mapped matrix.
+type MapMode int
+
+const (
+	// MapReadOnly maps a file for reading. The
+	// matrix of a read-only mapping is sealed.
+	MapReadOnly MapMode = iota
+
+	// MapReadWrite maps a file for reading and
+	// writing. Modifications of the matrix are
+	// written to the file.
+	MapReadWrite
+)
+
+// MappedDense is a Dense matrix backed by a memory-mapped file. The file
+// holds the matrix in the binary form written by Dense.MarshalBinary, so
+// matrices may be written with MarshalBinaryTo and mapped later, and
+// mapped matrices may be read with UnmarshalBinaryFrom. Pages of the file
+// are read by the operating system as the elements of the matrix are
+// accessed, allowing matrices larger than the available memory to be used.
+//
+// Memory mapping is only supported on Unix systems with little-endian
+// byte order, and is not available when built with the safe build tag.
+type MappedDense struct {
+	dense *Dense
+	data  []byte
+	file  *os.File
+	mode  MapMode
+}
+
+// MapDense maps the matrix held in the file at path using the given mode.
+// The file must hold a matrix in the binary form written by
+// Dense.MarshalBinary. Close must be called to release the mapping.
+func MapDense(path string, mode MapMode) (*MappedDense, error) {
+	if mode != MapReadOnly && mode != MapReadWrite {
+		return nil, errors.New("mat: invalid map mode")
+	}
+	flag := os.O_RDONLY
+	if mode == MapReadWrite {
+		flag = os.O_RDWR
+	}
+	f, err := os.OpenFile(path, flag, 0)
+	if err != nil {
+		return nil, err
+	}
+	m, err := mapDense(f, mode)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return m, nil
+}
+
+// CreateMappedDense creates a file at path holding an r×c matrix of zeros
+// in the binary form written by Dense.MarshalBinary, and maps it for
+// reading and writing. If the file already exists, it is truncated. Close
+// must be called to release the mapping.
+func CreateMappedDense(path string, r, c int) (*MappedDense, error) {
+	if r <= 0 || c <= 0 {
+		if r == 0 || c == 0 {
+			return nil, ErrZeroLength
+		}
+		return nil, errBadSize
+	}
+	size, ok := mappedSize(int64(r), int64(c))
+	if !ok {
+		return nil, errTooBig
+	}
+	if !nativeLittleEndian {
+		return nil, errMapUnsupported
+	}
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o666)
+	if err != nil {
+		return nil, err
+	}
+	header := storage{
+		Form: 'G', Packing: 'F', Uplo: 'A',
+		Rows: int64(r), Cols: int64(c),
+		Version: version,
+	}
+	_, err = header.marshalBinaryTo(f)
+	if err == nil {
+		err = f.Truncate(int64(size))
+	}
+	var m *MappedDense
+	if err == nil {
+		m, err = mapDense(f, MapReadWrite)
+	}
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return m, nil
+}
+
+// mapDense maps the matrix held in f using the given mode.
+func mapDense(f *os.File, mode MapMode) (*MappedDense, error) {
+	if !nativeLittleEndian {
+		return nil, errMapUnsupported
+	}
+	var header storage
+	_, err := header.unmarshalBinaryFrom(io.NewSectionReader(f, 0, int64(headerSize)))
+	if err != nil {
+		return nil, err
+	}
+	rows := header.Rows
+	cols := header.Cols
+	header.Version = 0
+	header.Rows = 0
+	header.Cols = 0
+	if (header != storage{Form: 'G', Packing: 'F', Uplo: 'A'}) {
+		return nil, errWrongType
+	}
+	if rows < 0 || cols < 0 {
+		return nil, errBadSize
+	}
+	if rows == 0 || cols == 0 {
+		return nil, ErrZeroLength
+	}
+	size, ok := mappedSize(rows, cols)
+	if !ok {
+		return nil, errTooBig
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if fi.Size() != int64(size) {
+		return nil, errBadBuffer
+	}
+
+	data, err := mmap(f, size, mode == MapReadWrite)
+	if err != nil {
+		return nil, err
+	}
+	d := NewDense(int(rows), int(cols), float64s(data[headerSize:]))
+	if mode == MapReadOnly {
+		d.Seal()
+	}
+	return &MappedDense{dense: d, data: data, file: f, mode: mode}, nil
+}
+
+// mappedSize returns the size of the binary form of an r×c matrix
+// and whether the size can be represented.
+func mappedSize(r, c int64) (int, bool) {
+	n := r * c
+	if n/c != r || n > (maxLen-int64(headerSize))/int64(sizeFloat64) {
+		return 0, false
+	}
+	return headerSize + int(n)*sizeFloat64, true
+}
+
+// nativeLittleEndian is whether the platform is little-endian, allowing
+// the data of a matrix in its binary form to be used without conversion.
+var nativeLittleEndian = binary.NativeEndian.Uint16([]byte{1, 0}) == 1
+
+// Dense returns the mapped matrix. The matrix is sealed if the mapping is
+// read-only. The matrix and any views of it must not be used after the
+// call to Close.
+func (m *MappedDense) Dense() *Dense {
+	return m.dense
+}
+
+// Sync writes modifications of a read-write mapped matrix to stable
+// storage.
+func (m *MappedDense) Sync() error {
+	if m.data == nil {
+		return errors.New("mat: sync of closed mapping")
+	}
+	if m.mode == MapReadOnly {
+		return nil
+	}
+	return m.file.Sync()
+}
+
+// Close releases the mapping and closes the underlying file. Modifications
+// of a read-write mapped matrix are visible in the file following the call
+// to Close, but are not guaranteed to be written to stable storage unless
+// Sync has been called. Following the call to Close the matrix returned by
+// Dense is empty.
+func (m *MappedDense) Close() error {
+	if m.data == nil {
+		return nil
+	}
+	*m.dense = Dense{}
+	err := munmap(m.data)
+	m.data = nil
+	if cerr := m.file.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
@@ -0,0 +1,22 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !unix || safe
+// +build !unix safe
+
+package mat
+
+import "os"
+
+func mmap(f *os.File, size int, writable bool) ([]byte, error) {
+	return nil, errMapUnsupported
+}
+
+func munmap(data []byte) error {
+	return errMapUnsupported
+}
+
+func float64s(b []byte) []float64 {
+	panic("mat: memory mapping not supported")
+}
@@ -0,0 +1,206 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMappedDense(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "a.mat")
+
+	m, err := CreateMappedDense(path, 3, 4)
+	if err == errMapUnsupported {
+		t.Skip("memory mapping not supported")
+	}
+	if err != nil {
+		t.Fatalf("unexpected error creating mapped matrix: %v", err)
+	}
+	a := m.Dense()
+	if r, c := a.Dims(); r != 3 || c != 4 {
+		t.Errorf("unexpected dimensions: got:%d×%d want:3×4", r, c)
+	}
+	if !Equal(a, NewDense(3, 4, nil)) {
+		t.Errorf("created matrix not zeroed:\n%v", Formatted(a))
+	}
+	want := NewDense(3, 4, []float64{
+		1, 2, 3, 4,
+		5, 6, 7, 8,
+		9, 10, 11, 12,
+	})
+	a.Copy(want)
+	err = m.Sync()
+	if err != nil {
+		t.Errorf("unexpected error syncing mapping: %v", err)
+	}
+	err = m.Close()
+	if err != nil {
+		t.Errorf("unexpected error closing mapping: %v", err)
+	}
+	if !a.IsEmpty() {
+		t.Error("matrix not empty after close")
+	}
+
+	// The file holds the binary form of the matrix.
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Dense
+	_, err = got.UnmarshalBinaryFrom(f)
+	f.Close()
+	if err != nil {
+		t.Fatalf("unexpected error unmarshaling mapped matrix: %v", err)
+	}
+	if !Equal(&got, want) {
+		t.Errorf("unexpected file contents:\ngot:\n%v\nwant:\n%v", Formatted(&got), Formatted(want))
+	}
+
+	m, err = MapDense(path, MapReadWrite)
+	if err != nil {
+		t.Fatalf("unexpected error mapping matrix: %v", err)
+	}
+	if !Equal(m.Dense(), want) {
+		t.Errorf("unexpected mapped matrix:\ngot:\n%v\nwant:\n%v", Formatted(m.Dense()), Formatted(want))
+	}
+	m.Dense().Set(2, 3, -1)
+	want.Set(2, 3, -1)
+	m.Close()
+
+	m, err = MapDense(path, MapReadOnly)
+	if err != nil {
+		t.Fatalf("unexpected error mapping matrix: %v", err)
+	}
+	defer m.Close()
+	r := m.Dense()
+	if !r.IsSealed() {
+		t.Error("read-only mapped matrix not sealed")
+	}
+	if !Equal(r, want) {
+		t.Errorf("unexpected mapped matrix:\ngot:\n%v\nwant:\n%v", Formatted(r), Formatted(want))
+	}
+	panicked, message := panics(func() { r.Set(0, 0, 1) })
+	if !panicked || message != ErrSealed.Error() {
+		t.Errorf("unexpected panic for Set on read-only mapping: got:%q want:%q", message, ErrSealed)
+	}
+}
+
+func TestMapDenseErrors(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	if _, err := CreateMappedDense(filepath.Join(dir, "probe"), 1, 1); err == errMapUnsupported {
+		t.Skip("memory mapping not supported")
+	}
+
+	a := NewDense(2, 2, []float64{1, 2, 3, 4})
+	buf, err := a.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sym := append([]byte(nil), buf...)
+	sym[4] = 'S'
+	for _, test := range []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{name: "short header", data: buf[:headerSize-1]},
+		{name: "short data", data: buf[:len(buf)-1], want: errBadBuffer},
+		{name: "long data", data: append(buf[:len(buf):len(buf)], 0), want: errBadBuffer},
+		{name: "symmetric", data: sym, want: errWrongType},
+	} {
+		path := filepath.Join(dir, test.name)
+		err := os.WriteFile(path, test.data, 0o666)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m, err := MapDense(path, MapReadOnly)
+		if err == nil {
+			m.Close()
+			t.Errorf("%s: expected error", test.name)
+			continue
+		}
+		if test.want != nil && err != test.want {
+			t.Errorf("%s: unexpected error: got:%v want:%v", test.name, err, test.want)
+		}
+	}
+
+	if _, err := CreateMappedDense(filepath.Join(dir, "zero"), 0, 2); err != ErrZeroLength {
+		t.Errorf("unexpected error for zero dimension: got:%v want:%v", err, ErrZeroLength)
+	}
+}
+
+func TestMulChunked(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		r, c, k int
+	}{
+		{r: 1, c: 1, k: 1},
+		{r: 7, c: 3, k: 4},
+		{r: 20, c: 5, k: 2},
+	} {
+		a := NewDense(test.r, test.c, nil)
+		b := NewDense(test.c, test.k, nil)
+		x := NewDense(test.r, test.k, nil)
+		for _, m := range []*Dense{a, b, x} {
+			r, c := m.Dims()
+			for i := 0; i < r; i++ {
+				for j := 0; j < c; j++ {
+					m.Set(i, j, rnd.NormFloat64())
+				}
+			}
+		}
+		var want, wantT Dense
+		want.Mul(a, b)
+		wantT.Mul(a.T(), x)
+		for _, rows := range []int{1, 3, test.r, test.r + 1} {
+			// Exercise the view and copying paths.
+			for _, src := range []Matrix{a, DenseCopyOf(a.T()).T(), asBasicMatrix(a)} {
+				var got Dense
+				MulChunked(&got, src, b, rows)
+				if !EqualApprox(&got, &want, 1e-12) {
+					t.Errorf("unexpected MulChunked result for %d×%d chunk %d with %T:\ngot:\n%v\nwant:\n%v",
+						test.r, test.c, rows, src, Formatted(&got), Formatted(&want))
+				}
+
+				got.Reset()
+				MulTransChunked(&got, src, x, rows)
+				if !EqualApprox(&got, &wantT, 1e-12) {
+					t.Errorf("unexpected MulTransChunked result for %d×%d chunk %d with %T:\ngot:\n%v\nwant:\n%v",
+						test.r, test.c, rows, src, Formatted(&got), Formatted(&wantT))
+				}
+
+				var gram, wantGram Dense
+				wantGram.Mul(a.T(), a)
+				MulTransChunked(&gram, src, src, rows)
+				if !EqualApprox(&gram, &wantGram, 1e-12) {
+					t.Errorf("unexpected Gram matrix for %d×%d chunk %d with %T", test.r, test.c, rows, src)
+				}
+			}
+		}
+	}
+
+	a := NewDense(3, 3, nil)
+	for _, test := range []struct {
+		name string
+		fn   func()
+		want string
+	}{
+		{name: "chunk", fn: func() { MulChunked(&Dense{}, a, a, 0) }, want: badChunk},
+		{name: "identity", fn: func() { MulChunked(a, a, NewDense(3, 3, nil), 1) }, want: regionIdentity},
+		{name: "overlap", fn: func() { MulTransChunked(a.Slice(0, 2, 0, 2).(*Dense), a.Slice(0, 3, 0, 2), a.Slice(0, 3, 1, 3), 1) }, want: regionOverlap},
+		{name: "shape", fn: func() { MulChunked(&Dense{}, a, NewDense(2, 2, nil), 1) }, want: ErrShape.Error()},
+	} {
+		panicked, message := panics(test.fn)
+		if !panicked || message != test.want {
+			t.Errorf("unexpected panic for %s: got:%q want:%q", test.name, message, test.want)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build unix && !safe
+// +build unix,!safe
+
+package mat
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// mmap maps the first size bytes of f into memory.
+func mmap(f *os.File, size int, writable bool) ([]byte, error) {
+	prot := syscall.PROT_READ
+	if writable {
+		prot |= syscall.PROT_WRITE
+	}
+	return syscall.Mmap(int(f.Fd()), 0, size, prot, syscall.MAP_SHARED)
+}
+
+// munmap releases the mapping of data.
+func munmap(data []byte) error {
+	return syscall.Munmap(data)
+}
+
+// float64s returns the float64 values held in b. The start of b must be
+// aligned to 8 bytes, which holds for the data of a mapped matrix since
+// mappings are page aligned and the header size is a multiple of 8.
+func float64s(b []byte) []float64 {
+	return unsafe.Slice((*float64)(unsafe.Pointer(unsafe.SliceData(b))), len(b)/sizeFloat64)
+}
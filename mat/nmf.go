@@ -0,0 +1,339 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+)
+
+// NMFMethod specifies the update rule used to compute a nonnegative matrix
+// factorization.
+type NMFMethod int
+
+const (
+	// NMFHALS uses hierarchical alternating least squares, updating each
+	// column of W and row of H in turn by an exact nonnegative least
+	// squares step as described in Cichocki and Phan, "Fast local
+	// algorithms for large scale nonnegative matrix and tensor
+	// factorizations", IEICE Trans. Fundam. E92-A(3), 2009.
+	NMFHALS NMFMethod = iota
+	// NMFMultiplicative uses the multiplicative update rule of Lee and
+	// Seung, "Algorithms for non-negative matrix factorization", NIPS
+	// 2001. The multiplicative update is simple but converges more slowly
+	// than HALS.
+	NMFMultiplicative
+)
+
+// NMFSettings holds the parameters of a nonnegative matrix factorization.
+type NMFSettings struct {
+	// Method is the update rule used by the factorization.
+	Method NMFMethod
+
+	// MaxIter is the maximum number of iterations. If MaxIter
+	// is zero, a default of 200 is used.
+	MaxIter int
+
+	// Tol is the convergence tolerance. The iteration stops when
+	// the relative decrease in the residual norm in an iteration
+	// is less than Tol, or when the residual norm is less than Tol
+	// times the norm of the matrix. If Tol is zero, a default of
+	// 1e-6 is used.
+	Tol float64
+}
+
+// NMF is a type for computing and using a nonnegative matrix factorization
+//
+//	A ≈ W * H,
+//
+// of an m×n nonnegative matrix A into an m×k nonnegative matrix W and a
+// k×n nonnegative matrix H minimizing the Frobenius norm of the residual
+// ‖A - W*H‖_F.
+type NMF struct {
+	w, h *Dense
+
+	resid float64
+	iter  int
+}
+
+// succFact returns whether the receiver contains a factorization.
+func (nmf *NMF) succFact() bool {
+	return nmf.w != nil
+}
+
+// Factorize computes a rank-k nonnegative matrix factorization of the
+// m×n matrix A. The factors are initialized deterministically from the
+// truncated singular value decomposition of A as described in Boutsidis
+// and Gallopoulos, "SVD based initialization: A head start for
+// nonnegative matrix factorization", Pattern Recognition 41(4), 2008, with
+// zero elements replaced by the mean of A. If settings is nil, the default
+// settings are used.
+//
+// Factorize returns whether the iteration converged. The factors from the
+// last iteration are retained even when the iteration did not converge.
+// Factorize will panic if A has a negative element or if k is not in
+// [1, min(m, n)].
+func (nmf *NMF) Factorize(a Matrix, k int, settings *NMFSettings) (ok bool) {
+	m, n := a.Dims()
+	if k < 1 || min(m, n) < k {
+		panic("nmf: rank out of range")
+	}
+	var s NMFSettings
+	if settings != nil {
+		s = *settings
+	}
+	if s.MaxIter == 0 {
+		s.MaxIter = 200
+	}
+	if s.Tol == 0 {
+		s.Tol = 1e-6
+	}
+
+	ad := DenseCopyOf(a)
+	var sum float64
+	for i := range m {
+		for _, v := range ad.RawRowView(i) {
+			if v < 0 {
+				panic("nmf: negative element")
+			}
+			sum += v
+		}
+	}
+	nmf.w = NewDense(m, k, nil)
+	nmf.h = NewDense(k, n, nil)
+	nmf.iter = 0
+	if !nndsvd(nmf.w, nmf.h, ad, sum/float64(m*n)) {
+		nmf.w = nil
+		nmf.h = nil
+		return false
+	}
+
+	var (
+		wh       Dense
+		wta, wtw Dense
+		aht, hht Dense
+		denH     Dense
+		denW     Dense
+	)
+	resid := func() float64 {
+		wh.Mul(nmf.w, nmf.h)
+		wh.Sub(ad, &wh)
+		return Norm(&wh, 2)
+	}
+	norm := Norm(ad, 2)
+	prev := resid()
+	for nmf.iter = 1; nmf.iter <= s.MaxIter; nmf.iter++ {
+		// Update H with W fixed.
+		wta.Mul(nmf.w.T(), ad)
+		wtw.Mul(nmf.w.T(), nmf.w)
+		switch s.Method {
+		case NMFHALS:
+			halsUpdate(nmf.h, &wta, &wtw)
+		case NMFMultiplicative:
+			denH.Mul(&wtw, nmf.h)
+			multiplicativeUpdate(nmf.h, &wta, &denH)
+		default:
+			panic("nmf: unknown method")
+		}
+
+		// Update W with H fixed, working on Wᵀ so that
+		// the updates are the same as for H.
+		aht.Mul(nmf.h, ad.T())
+		hht.Mul(nmf.h, nmf.h.T())
+		wt := DenseCopyOf(nmf.w.T())
+		switch s.Method {
+		case NMFHALS:
+			halsUpdate(wt, &aht, &hht)
+		case NMFMultiplicative:
+			denW.Mul(&hht, wt)
+			multiplicativeUpdate(wt, &aht, &denW)
+		}
+		nmf.w.Copy(wt.T())
+
+		r := resid()
+		if prev-r <= s.Tol*prev || r <= s.Tol*norm {
+			nmf.resid = r
+			return true
+		}
+		prev = r
+	}
+	nmf.iter = s.MaxIter
+	nmf.resid = prev
+	return false
+}
+
+// halsUpdate performs one sweep of hierarchical alternating least squares
+// updates of the rows of the k×n matrix H given GᵀA and GᵀG for the fixed
+// factor G.
+func halsUpdate(h, gta, gtg *Dense) {
+	k, n := h.Dims()
+	for l := range k {
+		d := gtg.At(l, l)
+		if d == 0 {
+			continue
+		}
+		hl := h.RawRowView(l)
+		for j := range n {
+			var gh float64
+			for p := range k {
+				gh += gtg.At(l, p) * h.At(p, j)
+			}
+			// A small positive floor keeps components from
+			// being permanently zeroed.
+			hl[j] = math.Max(hl[j]+(gta.At(l, j)-gh)/d, nmfEps)
+		}
+	}
+}
+
+// multiplicativeUpdate performs the multiplicative update H ∘= num/den.
+func multiplicativeUpdate(h, num, den *Dense) {
+	k, _ := h.Dims()
+	for l := range k {
+		hl := h.RawRowView(l)
+		nl := num.RawRowView(l)
+		dl := den.RawRowView(l)
+		for j := range hl {
+			hl[j] *= nl[j] / (dl[j] + nmfEps)
+		}
+	}
+}
+
+// nmfEps is a small value used to prevent division by zero and
+// to keep factor elements strictly positive.
+const nmfEps = 1e-16
+
+// nndsvd fills w and h with the nonnegative double singular value
+// decomposition initialization of a, replacing zero elements by fill.
+func nndsvd(w, h, a *Dense, fill float64) bool {
+	var svd SVD
+	if !svd.Factorize(a, SVDThin) {
+		return false
+	}
+	m, n := a.Dims()
+	_, k := w.Dims()
+	s := svd.Values(nil)
+	var u, v Dense
+	svd.UTo(&u)
+	svd.VTo(&v)
+
+	x := make([]float64, m)
+	y := make([]float64, n)
+	for j := range k {
+		Col(x, j, &u)
+		Col(y, j, &v)
+		if j == 0 {
+			// The leading singular vectors of a nonnegative matrix
+			// can be chosen to be nonnegative.
+			for i := range x {
+				x[i] = math.Abs(x[i])
+			}
+			for i := range y {
+				y[i] = math.Abs(y[i])
+			}
+		} else {
+			xpn, xnn := posNegNorms(x)
+			ypn, ynn := posNegNorms(y)
+			sign := 1.0
+			mp, mn := xpn*ypn, xnn*ynn
+			if mn > mp {
+				sign = -1
+			}
+			var xn, yn float64
+			for i, vi := range x {
+				x[i] = math.Max(sign*vi, 0)
+				xn += x[i] * x[i]
+			}
+			for i, vi := range y {
+				y[i] = math.Max(sign*vi, 0)
+				yn += y[i] * y[i]
+			}
+			xn, yn = math.Sqrt(xn), math.Sqrt(yn)
+			f := math.Sqrt(max(mp, mn))
+			for i := range x {
+				if xn > 0 {
+					x[i] *= f / xn
+				}
+			}
+			for i := range y {
+				if yn > 0 {
+					y[i] *= f / yn
+				}
+			}
+		}
+		f := math.Sqrt(s[j])
+		for i, vi := range x {
+			vi *= f
+			if vi == 0 {
+				vi = fill
+			}
+			w.set(i, j, vi)
+		}
+		for i, vi := range y {
+			vi *= f
+			if vi == 0 {
+				vi = fill
+			}
+			h.set(j, i, vi)
+		}
+	}
+	return true
+}
+
+// posNegNorms returns the Euclidean norms of the positive and negative
+// parts of x.
+func posNegNorms(x []float64) (pos, neg float64) {
+	for _, v := range x {
+		if v > 0 {
+			pos += v * v
+		} else {
+			neg += v * v
+		}
+	}
+	return math.Sqrt(pos), math.Sqrt(neg)
+}
+
+// Residual returns the Frobenius norm of the residual ‖A - W*H‖_F of the
+// factorization.
+//
+// Residual will panic if the receiver does not contain a factorization.
+func (nmf *NMF) Residual() float64 {
+	if !nmf.succFact() {
+		panic(badFact)
+	}
+	return nmf.resid
+}
+
+// Iterations returns the number of iterations performed by the
+// factorization.
+func (nmf *NMF) Iterations() int {
+	return nmf.iter
+}
+
+// WTo extracts the m×k factor W into dst.
+//
+// If dst is empty, WTo will resize dst to be m×k. When dst is non-empty,
+// WTo will panic if dst is not m×k. WTo will also panic if the receiver
+// does not contain a factorization.
+func (nmf *NMF) WTo(dst *Dense) {
+	if !nmf.succFact() {
+		panic(badFact)
+	}
+	m, k := nmf.w.Dims()
+	dst.reuseAsNonZeroed(m, k)
+	dst.Copy(nmf.w)
+}
+
+// HTo extracts the k×n factor H into dst.
+//
+// If dst is empty, HTo will resize dst to be k×n. When dst is non-empty,
+// HTo will panic if dst is not k×n. HTo will also panic if the receiver
+// does not contain a factorization.
+func (nmf *NMF) HTo(dst *Dense) {
+	if !nmf.succFact() {
+		panic(badFact)
+	}
+	k, n := nmf.h.Dims()
+	dst.reuseAsNonZeroed(k, n)
+	dst.Copy(nmf.h)
+}
@@ -0,0 +1,110 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestNMF(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n, k int
+		method  NMFMethod
+		maxIter int
+		tol     float64
+	}{
+		{m: 20, n: 15, k: 3, method: NMFHALS, maxIter: 2000, tol: 1e-3},
+		{m: 15, n: 20, k: 4, method: NMFHALS, maxIter: 2000, tol: 1e-3},
+		{m: 30, n: 30, k: 1, method: NMFHALS, maxIter: 2000, tol: 1e-8},
+		{m: 20, n: 15, k: 3, method: NMFMultiplicative, maxIter: 2000, tol: 2e-2},
+		{m: 15, n: 20, k: 4, method: NMFMultiplicative, maxIter: 2000, tol: 2e-2},
+	} {
+		// Construct an exactly factorizable nonnegative matrix.
+		w0 := NewDense(test.m, test.k, nil)
+		h0 := NewDense(test.k, test.n, nil)
+		w0.Apply(func(_, _ int, _ float64) float64 { return rnd.Float64() }, w0)
+		h0.Apply(func(_, _ int, _ float64) float64 { return rnd.Float64() }, h0)
+		var a Dense
+		a.Mul(w0, h0)
+
+		var nmf NMF
+		nmf.Factorize(&a, test.k, &NMFSettings{
+			Method:  test.method,
+			MaxIter: test.maxIter,
+			Tol:     1e-12,
+		})
+		if nmf.Iterations() < 1 {
+			t.Errorf("m=%d n=%d k=%d method=%d: no iterations", test.m, test.n, test.k, test.method)
+		}
+		var w, h Dense
+		nmf.WTo(&w)
+		nmf.HTo(&h)
+		for _, f := range []*Dense{&w, &h} {
+			r, c := f.Dims()
+			for i := range r {
+				for j := range c {
+					if v := f.At(i, j); v < 0 || math.IsNaN(v) {
+						t.Errorf("m=%d n=%d k=%d method=%d: invalid factor element %v", test.m, test.n, test.k, test.method, v)
+					}
+				}
+			}
+		}
+		var resid Dense
+		resid.Mul(&w, &h)
+		resid.Sub(&a, &resid)
+		got := Norm(&resid, 2)
+		if math.Abs(got-nmf.Residual()) > 1e-12*Norm(&a, 2) {
+			t.Errorf("m=%d n=%d k=%d method=%d: mismatched residual: got %v want %v", test.m, test.n, test.k, test.method, nmf.Residual(), got)
+		}
+		if got > test.tol*Norm(&a, 2) {
+			t.Errorf("m=%d n=%d k=%d method=%d: residual too large: %v", test.m, test.n, test.k, test.method, got/Norm(&a, 2))
+		}
+	}
+
+	var nmf NMF
+	if p, _ := panics(func() { nmf.WTo(&Dense{}) }); !p {
+		t.Error("no panic for use without factorization")
+	}
+	if p, _ := panics(func() { nmf.Factorize(NewDense(2, 2, []float64{1, -1, 1, 1}), 1, nil) }); !p {
+		t.Error("no panic for negative element")
+	}
+	if p, _ := panics(func() { nmf.Factorize(NewDense(2, 3, nil), 3, nil) }); !p {
+		t.Error("no panic for rank out of range")
+	}
+}
+
+func TestNMFConvergence(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	a := NewDense(25, 20, nil)
+	a.Apply(func(_, _ int, _ float64) float64 { return rnd.Float64() }, a)
+	for _, method := range []NMFMethod{NMFHALS, NMFMultiplicative} {
+		// The residual is non-increasing in the number of iterations.
+		prev := math.Inf(1)
+		for _, maxIter := range []int{1, 5, 20, 100} {
+			var nmf NMF
+			ok := nmf.Factorize(a, 5, &NMFSettings{Method: method, MaxIter: maxIter, Tol: 1e-300})
+			if ok {
+				t.Errorf("method=%d: unexpected convergence with maxIter=%d", method, maxIter)
+			}
+			if nmf.Iterations() != maxIter {
+				t.Errorf("method=%d: unexpected iteration count: got %d want %d", method, nmf.Iterations(), maxIter)
+			}
+			if r := nmf.Residual(); r > prev*(1+1e-12) {
+				t.Errorf("method=%d: residual increased from %v to %v at maxIter=%d", method, prev, r, maxIter)
+			}
+			prev = nmf.Residual()
+		}
+
+		var nmf NMF
+		if !nmf.Factorize(a, 5, &NMFSettings{Method: method, MaxIter: 10000, Tol: 1e-4}) {
+			t.Errorf("method=%d: no convergence", method)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"runtime"
+	"sync"
+
+	"gonum.org/v1/gonum/blas/gonum"
+)
+
+// SetMaxProcs sets the maximum number of goroutines used concurrently by
+// the operations of the package and returns the previous setting. If n is
+// less than one, the limit is runtime.GOMAXPROCS(0) at the time of each
+// operation, which is the default.
+//
+// The internal parallelism of the package is in matrix multiplication
+// performed by the Gonum BLAS implementation, the default implementation
+// of blas64, and in Dense.ApplyParallel, so SetMaxProcs is equivalent to
+// gonum.SetMaxProcs. The work in a multiplication is partitioned and reduced
+// in an order that depends only on the dimensions of the operands, so results
+// are reproducible bit-for-bit regardless of the limit and of GOMAXPROCS.
+// This guarantee does not hold if another BLAS implementation has been
+// registered with blas64.Use.
+func SetMaxProcs(n int) (prev int) {
+	return gonum.SetMaxProcs(n)
+}
+
+// maxWorkers returns the maximum number of goroutines to use
+// in a parallel operation.
+func maxWorkers() int {
+	if n := gonum.MaxProcs(); n > 0 {
+		return n
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
+// minParallelElems is the minimum number of matrix elements
+// processed by each goroutine in a parallel element-wise
+// operation.
+const minParallelElems = 1 << 12
+
+// ApplyParallel applies the function fn to each of the elements of a, placing
+// the resulting matrix in the receiver, as for Apply. The work is distributed
+// across goroutines: the rows of the receiver are divided into contiguous
+// blocks of equal size, each of which is processed by a single goroutine. The
+// number of blocks depends only on the dimensions of a and the limit set by
+// SetMaxProcs.
+//
+// fn must be safe for concurrent use, and if a is not a *Dense or the
+// transpose of a *Dense, its At method must be safe for concurrent use.
+func (m *Dense) ApplyParallel(fn func(i, j int, v float64) float64, a Matrix) {
+	ar, ac := a.Dims()
+
+	m.reuseAsNonZeroed(ar, ac)
+
+	aU, aTrans := untransposeExtract(a)
+	if m != aU {
+		m.checkOverlapMatrix(aU)
+	} else if aTrans {
+		// Elements of the receiver would be overwritten
+		// before being read by other goroutines.
+		var restore func()
+		m, restore = m.isolatedWorkspace(a)
+		defer restore()
+	}
+
+	rm, fast := aU.(*Dense)
+	apply := func(r0, r1 int) {
+		for i := r0; i < r1; i++ {
+			row := m.mat.Data[i*m.mat.Stride : i*m.mat.Stride+ac]
+			switch {
+			case fast && !aTrans:
+				for j, v := range rm.mat.Data[i*rm.mat.Stride : i*rm.mat.Stride+ac] {
+					row[j] = fn(i, j, v)
+				}
+			case fast:
+				for j := range row {
+					row[j] = fn(i, j, rm.mat.Data[j*rm.mat.Stride+i])
+				}
+			default:
+				for j := range row {
+					row[j] = fn(i, j, a.At(i, j))
+				}
+			}
+		}
+	}
+
+	blocks := min(maxWorkers(), ar, max(1, ar*ac/minParallelElems))
+	if blocks <= 1 {
+		apply(0, ar)
+		return
+	}
+	var wg sync.WaitGroup
+	for b := range blocks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			apply(b*ar/blocks, (b+1)*ar/blocks)
+		}()
+	}
+	wg.Wait()
+}
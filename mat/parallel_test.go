@@ -0,0 +1,84 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func TestSetMaxProcs(t *testing.T) {
+	defer SetMaxProcs(SetMaxProcs(0))
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	a := NewDense(300, 200, randSlice(300*200, rnd))
+	b := NewDense(200, 250, randSlice(200*250, rnd))
+	var want *Dense
+	for _, procs := range []int{1, 2, 7, 0} {
+		SetMaxProcs(procs)
+		var got Dense
+		got.Mul(a, b)
+		if want == nil {
+			want = &got
+			continue
+		}
+		if !Equal(&got, want) {
+			t.Errorf("result of Mul with %d procs differs from serial result", procs)
+		}
+	}
+}
+
+func TestDenseApplyParallel(t *testing.T) {
+	defer SetMaxProcs(SetMaxProcs(0))
+
+	fn := func(i, j int, v float64) float64 {
+		return float64(i) - 2*float64(j) + v*v
+	}
+	method := func(receiver, x Matrix) {
+		type ApplyParalleler interface {
+			ApplyParallel(func(r, c int, v float64) float64, Matrix)
+		}
+		rd := receiver.(ApplyParalleler)
+		rd.ApplyParallel(fn, x)
+	}
+	denseComparison := func(receiver, x *Dense) {
+		receiver.Apply(fn, x)
+	}
+	testOneInput(t, "ApplyParallel", &Dense{}, method, denseComparison, isAnyType, isAnySize, 0)
+
+	// Test matrices large enough to be processed by multiple goroutines.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	a := NewDense(301, 200, randSlice(301*200, rnd))
+	var want Dense
+	want.Apply(fn, a)
+	var wantT Dense
+	wantT.Apply(fn, a.T())
+	for _, procs := range []int{1, 2, 7, 0} {
+		SetMaxProcs(procs)
+		var got Dense
+		got.ApplyParallel(fn, a)
+		if !Equal(&got, &want) {
+			t.Errorf("unexpected result of ApplyParallel with %d procs", procs)
+		}
+		got.Reset()
+		got.ApplyParallel(fn, a.T())
+		if !Equal(&got, &wantT) {
+			t.Errorf("unexpected result of ApplyParallel of transpose with %d procs", procs)
+		}
+
+		in := DenseCopyOf(a)
+		in.ApplyParallel(fn, in)
+		if !Equal(in, &want) {
+			t.Errorf("unexpected result of in-place ApplyParallel with %d procs", procs)
+		}
+		sq := DenseCopyOf(a.Slice(0, 200, 0, 200))
+		var sqWant Dense
+		sqWant.Apply(fn, sq.T())
+		sq.ApplyParallel(fn, sq.T())
+		if !Equal(sq, &sqWant) {
+			t.Errorf("unexpected result of in-place ApplyParallel of transpose with %d procs", procs)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package sketch provides randomized sketching algorithms for dense linear
+// algebra.
+//
+// A sketch of an m×n matrix A is the product S*A with a random k×m matrix S,
+// k ≪ m, that approximately preserves the geometry of the column space of A.
+// Sketches are used to compute low-rank approximations such as the randomized
+// singular value decomposition and principal components analysis, and to
+// solve overdetermined least squares problems, at a fraction of the cost of
+// the corresponding deterministic factorizations when A is large and
+// approximately low-rank.
+//
+// The algorithms follow Halko, Martinsson and Tropp, "Finding structure with
+// randomness: Probabilistic algorithms for constructing approximate matrix
+// decompositions", SIAM Review 53(2), 2011, and Woodruff, "Sketching as a
+// tool for numerical linear algebra", Foundations and Trends in Theoretical
+// Computer Science 10(1-2), 2014.
+package sketch // import "gonum.org/v1/gonum/mat/sketch"
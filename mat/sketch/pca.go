@@ -0,0 +1,90 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sketch
+
+import (
+	"gonum.org/v1/gonum/mat"
+)
+
+// PC is a type for computing and extracting the leading principal
+// components of a matrix using a randomized singular value decomposition.
+// The results of the principal components analysis are only valid if the
+// call to PrincipalComponents was successful.
+type PC struct {
+	n, d int
+	svd  SVD
+	ok   bool
+}
+
+// PrincipalComponents performs an approximate principal components analysis
+// of the n×d matrix a, where each row is an observation and each column is
+// a variable, computing the leading k components. The number of components
+// k is reduced to min(n, d) if it is larger. If settings is nil,
+// DefaultSettings is used.
+//
+// PrincipalComponents centers the variables but does not scale the variance.
+//
+// PrincipalComponents returns whether the analysis was successful.
+// PrincipalComponents will panic if k is not positive.
+func (c *PC) PrincipalComponents(a mat.Matrix, k int, settings *Settings) (ok bool) {
+	c.n, c.d = a.Dims()
+
+	centered := mat.DenseCopyOf(a)
+	raw := centered.RawMatrix()
+	mean := make([]float64, c.d)
+	for i := range c.n {
+		for j, v := range raw.Data[i*raw.Stride : i*raw.Stride+c.d] {
+			mean[j] += v
+		}
+	}
+	for j := range mean {
+		mean[j] /= float64(c.n)
+	}
+	for i := range c.n {
+		row := raw.Data[i*raw.Stride : i*raw.Stride+c.d]
+		for j := range row {
+			row[j] -= mean[j]
+		}
+	}
+
+	c.ok = c.svd.Factorize(centered, k, settings)
+	return c.ok
+}
+
+// VectorsTo returns the component direction vectors of a principal
+// components analysis. The vectors are returned in the columns of a d×k
+// matrix.
+//
+// If dst is empty, VectorsTo will resize dst to be d×k. When dst is
+// non-empty, VectorsTo will panic if dst is not d×k. VectorsTo will also
+// panic if the receiver does not contain a successful PC.
+func (c *PC) VectorsTo(dst *mat.Dense) {
+	if !c.ok {
+		panic("sketch: use of unsuccessful principal components analysis")
+	}
+	c.svd.VTo(dst)
+}
+
+// VarsTo returns the column variances of the principal component scores,
+// b * vecs, where b is a matrix with centered columns. Variances are
+// returned in descending order.
+// If dst is not nil it is used to store the variances and returned.
+// VarsTo will panic if the receiver has not successfully performed a
+// principal components analysis or dst is not nil and the length of dst
+// is not k.
+func (c *PC) VarsTo(dst []float64) []float64 {
+	if !c.ok {
+		panic("sketch: use of unsuccessful principal components analysis")
+	}
+	if dst != nil && len(dst) != c.svd.Rank() {
+		panic("sketch: length of slice does not match analysis")
+	}
+	dst = c.svd.Values(dst)
+	f := 1 / float64(c.n-1)
+	for i, v := range dst {
+		dst[i] = f * v * v
+	}
+	return dst
+}
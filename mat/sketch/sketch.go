@@ -0,0 +1,276 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sketch
+
+import (
+	"math"
+	"math/bits"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	badDims = "sketch: bad sketch dimensions"
+	badFact = "sketch: use of unsuccessful factorization"
+)
+
+// Sketcher is a random k×m sketching matrix S.
+type Sketcher interface {
+	// Dims returns the dimensions of S.
+	Dims() (k, m int)
+
+	// SketchTo stores the k×n product S*A of S and the m×n matrix A
+	// into dst. If dst is empty, SketchTo will resize dst to be k×n.
+	// When dst is non-empty, SketchTo will panic if dst is not k×n.
+	// SketchTo will panic if A does not have m rows.
+	SketchTo(dst *mat.Dense, a mat.Matrix)
+}
+
+// Gaussian is a sketching matrix with independent normally distributed
+// entries with mean zero and variance 1/k.
+type Gaussian struct {
+	s *mat.Dense
+}
+
+// NewGaussian returns a new k×m Gaussian sketching matrix using the
+// provided source of randomness. If src is nil, the global source is used.
+// NewGaussian will panic if k or m is not positive.
+func NewGaussian(k, m int, src rand.Source) *Gaussian {
+	if k <= 0 || m <= 0 {
+		panic(badDims)
+	}
+	rnd := newRand(src)
+	data := make([]float64, k*m)
+	f := 1 / math.Sqrt(float64(k))
+	for i := range data {
+		data[i] = f * normFloat64(rnd)
+	}
+	return &Gaussian{s: mat.NewDense(k, m, data)}
+}
+
+// Dims returns the dimensions of the sketching matrix.
+func (g *Gaussian) Dims() (k, m int) {
+	return g.s.Dims()
+}
+
+// SketchTo stores the product S*A into dst.
+func (g *Gaussian) SketchTo(dst *mat.Dense, a mat.Matrix) {
+	k, m := g.s.Dims()
+	r, c := a.Dims()
+	if r != m {
+		panic(mat.ErrShape)
+	}
+	reuseAs(dst, k, c)
+	dst.Mul(g.s, a)
+}
+
+// CountSketch is a sparse sketching matrix with a single non-zero entry of
+// ±1 in each column. The row and the sign of the entry are chosen uniformly
+// at random. Applying a CountSketch takes time proportional to the number of
+// elements of A.
+//
+// CountSketch is described in Clarkson and Woodruff, "Low rank approximation
+// and regression in input sparsity time", STOC 2013.
+type CountSketch struct {
+	k    int
+	row  []int
+	sign []float64
+}
+
+// NewCountSketch returns a new k×m CountSketch sketching matrix using the
+// provided source of randomness. If src is nil, the global source is used.
+// NewCountSketch will panic if k or m is not positive.
+func NewCountSketch(k, m int, src rand.Source) *CountSketch {
+	if k <= 0 || m <= 0 {
+		panic(badDims)
+	}
+	rnd := newRand(src)
+	c := &CountSketch{
+		k:    k,
+		row:  make([]int, m),
+		sign: make([]float64, m),
+	}
+	for i := range c.row {
+		c.row[i] = intN(rnd, k)
+		c.sign[i] = 1
+		if intN(rnd, 2) == 0 {
+			c.sign[i] = -1
+		}
+	}
+	return c
+}
+
+// Dims returns the dimensions of the sketching matrix.
+func (c *CountSketch) Dims() (k, m int) {
+	return c.k, len(c.row)
+}
+
+// SketchTo stores the product S*A into dst.
+func (c *CountSketch) SketchTo(dst *mat.Dense, a mat.Matrix) {
+	r, n := a.Dims()
+	if r != len(c.row) {
+		panic(mat.ErrShape)
+	}
+	reuseAs(dst, c.k, n)
+	dst.Zero()
+	raw := dst.RawMatrix()
+	buf := make([]float64, n)
+	for i, ri := range c.row {
+		src := rowOf(buf, a, i)
+		dstRow := raw.Data[ri*raw.Stride : ri*raw.Stride+n]
+		s := c.sign[i]
+		for j, v := range src {
+			dstRow[j] += s * v
+		}
+	}
+}
+
+// SRHT is a subsampled randomized Hadamard transform sketching matrix
+//
+//	S = sqrt(p/k) * P * H * D,
+//
+// where D is a p×m matrix with random signs on its diagonal that pads its
+// input with zeros to p rows, p is the smallest power of two not less than
+// m, H is the p×p normalized Walsh-Hadamard matrix and P selects k rows
+// uniformly at random without replacement. Applying an SRHT takes
+// O(p*n*log(p)) time.
+//
+// The SRHT is described in Tropp, "Improved analysis of the subsampled
+// randomized Hadamard transform", Adv. Adapt. Data Anal. 3(1-2), 2011.
+type SRHT struct {
+	p    int
+	rows []int
+	sign []float64
+}
+
+// NewSRHT returns a new k×m SRHT sketching matrix using the provided source
+// of randomness. If src is nil, the global source is used. NewSRHT will panic
+// if k or m is not positive or if k is larger than the smallest power of two
+// not less than m.
+func NewSRHT(k, m int, src rand.Source) *SRHT {
+	if k <= 0 || m <= 0 {
+		panic(badDims)
+	}
+	p := 1 << bits.Len(uint(m-1))
+	if k > p {
+		panic(badDims)
+	}
+	rnd := newRand(src)
+	s := &SRHT{
+		p:    p,
+		rows: perm(rnd, p)[:k],
+		sign: make([]float64, m),
+	}
+	for i := range s.sign {
+		s.sign[i] = 1
+		if intN(rnd, 2) == 0 {
+			s.sign[i] = -1
+		}
+	}
+	return s
+}
+
+// Dims returns the dimensions of the sketching matrix.
+func (s *SRHT) Dims() (k, m int) {
+	return len(s.rows), len(s.sign)
+}
+
+// SketchTo stores the product S*A into dst.
+func (s *SRHT) SketchTo(dst *mat.Dense, a mat.Matrix) {
+	m, n := a.Dims()
+	if m != len(s.sign) {
+		panic(mat.ErrShape)
+	}
+	k := len(s.rows)
+	reuseAs(dst, k, n)
+
+	// Form W = D*A padded with zeros to p rows and apply the
+	// unnormalized Walsh-Hadamard transform to its columns.
+	w := make([]float64, s.p*n)
+	for i, si := range s.sign {
+		row := rowOf(w[i*n:(i+1)*n], a, i)
+		wi := w[i*n : (i+1)*n]
+		for j, v := range row {
+			wi[j] = si * v
+		}
+	}
+	for h := 1; h < s.p; h *= 2 {
+		for i := 0; i < s.p; i += 2 * h {
+			for l := i; l < i+h; l++ {
+				x := w[l*n : (l+1)*n]
+				y := w[(l+h)*n : (l+h+1)*n]
+				for j, xj := range x {
+					yj := y[j]
+					x[j] = xj + yj
+					y[j] = xj - yj
+				}
+			}
+		}
+	}
+
+	// The normalization of H by 1/sqrt(p) and the scaling by
+	// sqrt(p/k) combine to 1/sqrt(k).
+	f := 1 / math.Sqrt(float64(k))
+	raw := dst.RawMatrix()
+	for r, i := range s.rows {
+		dstRow := raw.Data[r*raw.Stride : r*raw.Stride+n]
+		for j, v := range w[i*n : (i+1)*n] {
+			dstRow[j] = f * v
+		}
+	}
+}
+
+// reuseAs resizes an empty dst to r×c or checks that a non-empty dst is r×c.
+func reuseAs(dst *mat.Dense, r, c int) {
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, c)
+		return
+	}
+	if r2, c2 := dst.Dims(); r2 != r || c2 != c {
+		panic(mat.ErrShape)
+	}
+}
+
+// rowOf returns the ith row of a. If a does not provide direct access to
+// its rows, the row is copied into buf, which must have length equal to the
+// number of columns of a.
+func rowOf(buf []float64, a mat.Matrix, i int) []float64 {
+	if rv, ok := a.(mat.RawRowViewer); ok {
+		return rv.RawRowView(i)
+	}
+	for j := range buf {
+		buf[j] = a.At(i, j)
+	}
+	return buf
+}
+
+func newRand(src rand.Source) *rand.Rand {
+	if src == nil {
+		return nil
+	}
+	return rand.New(src)
+}
+
+func normFloat64(rnd *rand.Rand) float64 {
+	if rnd == nil {
+		return rand.NormFloat64()
+	}
+	return rnd.NormFloat64()
+}
+
+func intN(rnd *rand.Rand, n int) int {
+	if rnd == nil {
+		return rand.IntN(n)
+	}
+	return rnd.IntN(n)
+}
+
+func perm(rnd *rand.Rand, n int) []int {
+	if rnd == nil {
+		return rand.Perm(n)
+	}
+	return rnd.Perm(n)
+}
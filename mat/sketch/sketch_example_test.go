@@ -0,0 +1,57 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sketch_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/mat/sketch"
+)
+
+func ExampleSVD() {
+	// Construct a 100×50 matrix of rank 2 as the sum of two outer products.
+	const (
+		m = 100
+		n = 50
+	)
+	x := make([]float64, m)
+	y := make([]float64, m)
+	u := make([]float64, n)
+	v := make([]float64, n)
+	for i := range x {
+		x[i] = float64(i%7) - 3
+		y[i] = float64(i%3) - 1
+	}
+	for j := range u {
+		u[j] = float64(j % 5)
+		v[j] = float64(j%2) - 0.5
+	}
+	var a, b mat.Dense
+	a.Outer(1, mat.NewVecDense(m, x), mat.NewVecDense(n, u))
+	b.Outer(1, mat.NewVecDense(m, y), mat.NewVecDense(n, v))
+	a.Add(&a, &b)
+
+	var svd sketch.SVD
+	ok := svd.Factorize(&a, 3, &sketch.Settings{
+		Oversample: 5,
+		PowerIter:  1,
+		Src:        rand.NewPCG(1, 1),
+	})
+	if !ok {
+		fmt.Println("factorization failed")
+		return
+	}
+	fmt.Printf("singular values = %.4f\n", svd.Values(nil))
+
+	var exact mat.SVD
+	exact.Factorize(&a, mat.SVDNone)
+	fmt.Printf("exact values = %.4f\n", exact.Values(nil)[:3])
+
+	// Output:
+	// singular values = [348.5687 28.9374 0.0000]
+	// exact values = [348.5687 28.9374 0.0000]
+}
@@ -0,0 +1,390 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sketch
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+)
+
+func randDense(r, c int, rnd *rand.Rand) *mat.Dense {
+	data := make([]float64, r*c)
+	for i := range data {
+		data[i] = rnd.NormFloat64()
+	}
+	return mat.NewDense(r, c, data)
+}
+
+// lowRank returns an m×n matrix of rank k with singular values 1, 2, ..., k.
+func lowRank(m, n, k int, rnd *rand.Rand) *mat.Dense {
+	var u, v mat.Dense
+	u.CloneFrom(randDense(m, k, rnd))
+	orthonormalize(&u)
+	v.CloneFrom(randDense(n, k, rnd))
+	orthonormalize(&v)
+	for j := range k {
+		for i := range m {
+			u.Set(i, j, float64(j+1)*u.At(i, j))
+		}
+	}
+	var a mat.Dense
+	a.Mul(&u, v.T())
+	return &a
+}
+
+func newSketchers(k, m int, src rand.Source) map[string]Sketcher {
+	return map[string]Sketcher{
+		"Gaussian":    NewGaussian(k, m, src),
+		"CountSketch": NewCountSketch(k, m, src),
+		"SRHT":        NewSRHT(k, m, src),
+	}
+}
+
+func TestSketchTo(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct{ k, m, n int }{
+		{1, 1, 1},
+		{3, 5, 2},
+		{4, 8, 3},
+		{7, 9, 4},
+		{20, 100, 5},
+	} {
+		for name, s := range newSketchers(test.k, test.m, rand.NewPCG(2, uint64(test.m))) {
+			k, m := s.Dims()
+			if k != test.k || m != test.m {
+				t.Errorf("%s: unexpected dimensions: got %d×%d want %d×%d", name, k, m, test.k, test.m)
+			}
+
+			// Form S explicitly and compare S*A with the product
+			// computed by SketchTo for raw and non-raw matrices.
+			var explicit mat.Dense
+			s.SketchTo(&explicit, eye(test.m))
+			a := randDense(test.m, test.n, rnd)
+			var want mat.Dense
+			want.Mul(&explicit, a)
+			for _, in := range []mat.Matrix{a, mat.DenseCopyOf(a.T()).T()} {
+				var got mat.Dense
+				s.SketchTo(&got, in)
+				if !mat.EqualApprox(&got, &want, 1e-12) {
+					t.Errorf("%s: unexpected result for %T, k=%d m=%d n=%d", name, in, test.k, test.m, test.n)
+				}
+			}
+
+			// Check that a correctly sized destination is reused.
+			dst := mat.NewDense(test.k, test.n, nil)
+			s.SketchTo(dst, a)
+			if !mat.EqualApprox(dst, &want, 1e-12) {
+				t.Errorf("%s: unexpected result for non-empty dst", name)
+			}
+			if !panics(func() { s.SketchTo(mat.NewDense(test.k+1, test.n, nil), a) }) {
+				t.Errorf("%s: no panic for mismatched dst", name)
+			}
+			if !panics(func() { s.SketchTo(&mat.Dense{}, randDense(test.m+1, test.n, rnd)) }) {
+				t.Errorf("%s: no panic for mismatched input", name)
+			}
+		}
+	}
+}
+
+func TestSketchNorm(t *testing.T) {
+	t.Parallel()
+	const (
+		m      = 1000
+		k      = 200
+		trials = 50
+		tol    = 0.3
+	)
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for name, s := range newSketchers(k, m, rand.NewPCG(3, 3)) {
+		// The sketches are unbiased, E[‖S*x‖²] = ‖x‖², so averaging
+		// over many vectors the squared norm ratio should be near one.
+		var mean float64
+		for range trials {
+			x := randDense(m, 1, rnd)
+			var sx mat.Dense
+			s.SketchTo(&sx, x)
+			r := mat.Norm(&sx, 2) / mat.Norm(x, 2)
+			if r < 1-tol || 1+tol < r {
+				t.Errorf("%s: norm not preserved: ratio=%v", name, r)
+			}
+			mean += r * r / trials
+		}
+		if math.Abs(mean-1) > 0.05 {
+			t.Errorf("%s: unexpected mean squared norm ratio: got %v want 1", name, mean)
+		}
+	}
+}
+
+func TestRangeFinder(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct{ m, n, k, l, q int }{
+		{50, 30, 5, 5, 0},
+		{50, 30, 5, 10, 1},
+		{30, 50, 5, 8, 2},
+		{10, 4, 4, 20, 0},
+	} {
+		a := lowRank(test.m, test.n, test.k, rnd)
+		var q mat.Dense
+		RangeFinder(&q, a, test.l, test.q, rand.NewPCG(2, 2))
+		m, l := q.Dims()
+		if wantL := min(test.l, test.m, test.n); m != test.m || l != wantL {
+			t.Errorf("unexpected dimensions: got %d×%d want %d×%d", m, l, test.m, wantL)
+			continue
+		}
+		var qtq mat.Dense
+		qtq.Mul(q.T(), &q)
+		if !mat.EqualApprox(&qtq, eye(l), 1e-12) {
+			t.Errorf("Q not orthonormal for m=%d n=%d l=%d q=%d", test.m, test.n, test.l, test.q)
+		}
+		var qta, proj mat.Dense
+		qta.Mul(q.T(), a)
+		proj.Mul(&q, &qta)
+		if !mat.EqualApprox(&proj, a, 1e-10) {
+			t.Errorf("range of A not captured for m=%d n=%d l=%d q=%d", test.m, test.n, test.l, test.q)
+		}
+	}
+}
+
+func TestSVD(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n, k  int
+		settings *Settings
+	}{
+		{m: 60, n: 40, k: 5},
+		{m: 40, n: 60, k: 5},
+		{m: 60, n: 40, k: 5, settings: &Settings{Src: rand.NewPCG(1, 2)}},
+		{m: 100, n: 20, k: 3, settings: &Settings{Oversample: 2, PowerIter: 1, Src: rand.NewPCG(1, 3)}},
+		{m: 8, n: 6, k: 6, settings: &Settings{Oversample: 10, Src: rand.NewPCG(1, 4)}},
+	} {
+		name := fmt.Sprintf("m=%d n=%d k=%d", test.m, test.n, test.k)
+		a := lowRank(test.m, test.n, test.k, rnd)
+		var svd SVD
+		if !svd.Factorize(a, test.k, test.settings) {
+			t.Errorf("%s: factorization failed", name)
+			continue
+		}
+		if svd.Rank() != test.k {
+			t.Errorf("%s: unexpected rank: got %d want %d", name, svd.Rank(), test.k)
+		}
+		s := svd.Values(nil)
+		want := make([]float64, test.k)
+		for i := range want {
+			want[i] = float64(test.k - i)
+		}
+		if !floats.EqualApprox(s, want, 1e-10) {
+			t.Errorf("%s: unexpected singular values: got %v want %v", name, s, want)
+		}
+		var u, v mat.Dense
+		svd.UTo(&u)
+		svd.VTo(&v)
+		var us, usvt mat.Dense
+		us.Mul(&u, mat.NewDiagDense(test.k, s))
+		usvt.Mul(&us, v.T())
+		if !mat.EqualApprox(&usvt, a, 1e-10) {
+			t.Errorf("%s: U*Σ*Vᵀ does not reconstruct A", name)
+		}
+		for _, x := range []*mat.Dense{&u, &v} {
+			var xtx mat.Dense
+			xtx.Mul(x.T(), x)
+			if !mat.EqualApprox(&xtx, eye(test.k), 1e-12) {
+				t.Errorf("%s: singular vectors not orthonormal", name)
+			}
+		}
+	}
+
+	var svd SVD
+	if !panics(func() { svd.Values(nil) }) {
+		t.Error("no panic for use of unsuccessful factorization")
+	}
+}
+
+func TestSVDApprox(t *testing.T) {
+	t.Parallel()
+	// A full rank matrix with rapidly decaying singular values is well
+	// approximated by the randomized SVD, and power iterations improve
+	// the accuracy of the approximation.
+	const (
+		m = 200
+		n = 100
+		k = 10
+	)
+	rnd := rand.New(rand.NewPCG(1, 1))
+	var u, v mat.Dense
+	u.CloneFrom(randDense(m, n, rnd))
+	orthonormalize(&u)
+	v.CloneFrom(randDense(n, n, rnd))
+	orthonormalize(&v)
+	sigma := make([]float64, n)
+	for i := range sigma {
+		sigma[i] = math.Pow(0.7, float64(i))
+	}
+	var a mat.Dense
+	a.Mul(&u, mat.NewDiagDense(n, sigma))
+	a.Mul(&a, v.T())
+
+	prev := math.Inf(1)
+	for q := range 3 {
+		var svd SVD
+		if !svd.Factorize(&a, k, &Settings{Oversample: 5, PowerIter: q, Src: rand.NewPCG(2, 2)}) {
+			t.Fatalf("factorization failed for q=%d", q)
+		}
+		s := svd.Values(nil)
+		var us, vs mat.Dense
+		svd.UTo(&us)
+		svd.VTo(&vs)
+		var usk, approx mat.Dense
+		usk.Mul(&us, mat.NewDiagDense(k, s))
+		approx.Mul(&usk, vs.T())
+		approx.Sub(&a, &approx)
+		err := mat.Norm(&approx, 2)
+
+		// The best rank-k approximation has spectral norm error
+		// sigma[k].
+		if err > 10*sigma[k] {
+			t.Errorf("approximation error too large for q=%d: got %v, optimal %v", q, err, sigma[k])
+		}
+		if err > 1.01*prev {
+			t.Errorf("power iteration did not improve approximation for q=%d: %v > %v", q, err, prev)
+		}
+		prev = err
+		for i, v := range s {
+			if math.Abs(v-sigma[i]) > 10*sigma[k] {
+				t.Errorf("singular value %d inaccurate for q=%d: got %v want %v", i, q, v, sigma[i])
+			}
+		}
+	}
+}
+
+func TestPC(t *testing.T) {
+	t.Parallel()
+	const (
+		n = 100
+		d = 20
+		k = 3
+	)
+	rnd := rand.New(rand.NewPCG(1, 1))
+	a := lowRank(n, d, k, rnd)
+	// Add a mean that the analysis must remove.
+	for j := range d {
+		for i := range n {
+			a.Set(i, j, a.At(i, j)+float64(j))
+		}
+	}
+
+	var want stat.PC
+	if !want.PrincipalComponents(a, nil) {
+		t.Fatal("stat.PC failed")
+	}
+	wantVars := want.VarsTo(nil)[:k]
+	var wantVecs mat.Dense
+	want.VectorsTo(&wantVecs)
+
+	var pc PC
+	if !pc.PrincipalComponents(a, k, &Settings{Oversample: 5, PowerIter: 1, Src: rand.NewPCG(2, 2)}) {
+		t.Fatal("PrincipalComponents failed")
+	}
+	vars := pc.VarsTo(nil)
+	if !floats.EqualApprox(vars, wantVars, 1e-10) {
+		t.Errorf("unexpected variances: got %v want %v", vars, wantVars)
+	}
+	var vecs mat.Dense
+	pc.VectorsTo(&vecs)
+	for j := range k {
+		// Component directions are determined up to sign.
+		got := mat.Col(nil, j, &vecs)
+		w := mat.Col(nil, j, &wantVecs)
+		if floats.Dot(got, w) < 0 {
+			floats.Scale(-1, got)
+		}
+		if !floats.EqualApprox(got, w, 1e-8) {
+			t.Errorf("unexpected component %d", j)
+		}
+	}
+
+	if !panics(func() { pc.VarsTo(make([]float64, k+1)) }) {
+		t.Error("no panic for mismatched slice length")
+	}
+}
+
+func TestSolve(t *testing.T) {
+	t.Parallel()
+	const (
+		m = 500
+		n = 10
+		p = 2
+		k = 100
+	)
+	rnd := rand.New(rand.NewPCG(1, 1))
+	a := randDense(m, n, rnd)
+	x := randDense(n, p, rnd)
+	var b mat.Dense
+	b.Mul(a, x)
+
+	// A consistent system is solved exactly.
+	for name, s := range newSketchers(k, m, rand.NewPCG(2, 2)) {
+		var got mat.Dense
+		if err := Solve(&got, s, a, &b); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if !mat.EqualApprox(&got, x, 1e-10) {
+			t.Errorf("%s: unexpected solution for consistent system", name)
+		}
+	}
+
+	// For an inconsistent system the residual is close to optimal.
+	noise := randDense(m, p, rnd)
+	b.Add(&b, noise)
+	var opt, r mat.Dense
+	if err := opt.Solve(a, &b); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	r.Mul(a, &opt)
+	r.Sub(&b, &r)
+	optRes := mat.Norm(&r, 2)
+	for name, s := range newSketchers(k, m, rand.NewPCG(3, 3)) {
+		var got mat.Dense
+		if err := Solve(&got, s, a, &b); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		r.Mul(a, &got)
+		r.Sub(&b, &r)
+		res := mat.Norm(&r, 2)
+		if res > 1.5*optRes {
+			t.Errorf("%s: residual too large: got %v, optimal %v", name, res, optRes)
+		}
+	}
+
+	if !panics(func() { Solve(&mat.Dense{}, NewGaussian(k, m+1, nil), a, &b) }) {
+		t.Error("no panic for mismatched sketch")
+	}
+}
+
+func eye(n int) *mat.Dense {
+	d := mat.NewDense(n, n, nil)
+	for i := range n {
+		d.Set(i, i, 1)
+	}
+	return d
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
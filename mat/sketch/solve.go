@@ -0,0 +1,40 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sketch
+
+import (
+	"gonum.org/v1/gonum/mat"
+)
+
+// Solve finds an approximate least squares solution of the overdetermined
+// system A * X = B, where A is m×n and B is m×p, by solving the smaller
+// sketched problem
+//
+//	minimize ‖S*A*X - S*B‖_F
+//
+// with the k×m sketching matrix S, storing the n×p result into dst. For a
+// sketch with k = O(n/ε²) rows, the residual of the solution is within a
+// factor 1+ε of the optimal residual with high probability. The solution is
+// exact when the system is consistent and S*A has full column rank.
+//
+// If dst is empty, Solve will resize dst to be n×p. When dst is non-empty,
+// Solve will panic if dst is not n×p. Solve will panic if the number of rows
+// of A and B do not match the number of columns of S.
+//
+// Solve returns the error from the solution of the sketched problem; see
+// the Solve method of mat.Dense.
+func Solve(dst *mat.Dense, s Sketcher, a, b mat.Matrix) error {
+	m, n := a.Dims()
+	mb, p := b.Dims()
+	_, ms := s.Dims()
+	if m != ms || mb != ms {
+		panic(mat.ErrShape)
+	}
+	reuseAs(dst, n, p)
+	var sa, sb mat.Dense
+	s.SketchTo(&sa, a)
+	s.SketchTo(&sb, b)
+	return dst.Solve(&sa, &sb)
+}
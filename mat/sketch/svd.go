@@ -0,0 +1,205 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sketch
+
+import (
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/lapack/lapack64"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Settings holds the parameters of the randomized low-rank
+// approximation algorithms.
+type Settings struct {
+	// Oversample is the number of columns sampled in addition to
+	// the requested rank. Oversampling by 5 to 10 columns is usually
+	// sufficient for an accurate approximation.
+	Oversample int
+
+	// PowerIter is the number of power iterations used to improve
+	// the approximation of matrices with slowly decaying singular
+	// values. Each iteration requires two additional passes over
+	// the input matrix.
+	PowerIter int
+
+	// Src is the source of randomness. If Src is nil,
+	// the global source is used.
+	Src rand.Source
+}
+
+// DefaultSettings returns the default settings for the randomized
+// low-rank approximation algorithms.
+func DefaultSettings() *Settings {
+	return &Settings{
+		Oversample: 10,
+		PowerIter:  2,
+	}
+}
+
+// RangeFinder stores in dst an m×l matrix Q with orthonormal columns whose
+// range approximates the range of the m×n matrix A, so that A ≈ Q*Qᵀ*A. The
+// number of columns l is reduced to min(m, n) if it is larger. The range is
+// found by sampling A at l random Gaussian vectors followed by q power
+// iterations, as described in Algorithm 4.4 of Halko, Martinsson and Tropp
+// (2011). If src is nil, the global source of randomness is used.
+//
+// If dst is empty, RangeFinder will resize dst to be m×l. When dst is
+// non-empty, RangeFinder will panic if dst is not m×l. RangeFinder will
+// panic if l is not positive or q is negative.
+func RangeFinder(dst *mat.Dense, a mat.Matrix, l, q int, src rand.Source) {
+	if l <= 0 {
+		panic(badDims)
+	}
+	if q < 0 {
+		panic("sketch: negative number of power iterations")
+	}
+	m, n := a.Dims()
+	l = min(l, m, n)
+	reuseAs(dst, m, l)
+
+	// Y = A * Ω where Ω is an n×l Gaussian matrix. The scaling of
+	// the sketching matrix does not affect the range.
+	g := NewGaussian(l, n, src)
+	dst.Mul(a, g.s.T())
+	orthonormalize(dst)
+
+	var z mat.Dense
+	for range q {
+		z.Mul(a.T(), dst)
+		orthonormalize(&z)
+		dst.Mul(a, &z)
+		orthonormalize(dst)
+	}
+}
+
+// orthonormalize replaces the columns of the tall matrix a with an
+// orthonormal basis for their span computed by a QR factorization.
+func orthonormalize(a *mat.Dense) {
+	raw := a.RawMatrix()
+	tau := make([]float64, raw.Cols)
+	work := []float64{0}
+	lapack64.Geqrf(raw, tau, work, -1)
+	lwork := int(work[0])
+	lapack64.Orgqr(raw, tau, work, -1)
+	lwork = max(lwork, int(work[0]))
+	work = make([]float64, lwork)
+	lapack64.Geqrf(raw, tau, work, lwork)
+	lapack64.Orgqr(raw, tau, work, lwork)
+}
+
+// SVD is a randomized approximate singular value decomposition of a
+// matrix. The results are only valid if the call to Factorize was
+// successful.
+type SVD struct {
+	m, n int
+	u, v mat.Dense
+	s    []float64
+	ok   bool
+}
+
+// Factorize computes an approximate rank-k singular value decomposition
+// of the m×n matrix A,
+//
+//	A ≈ U * Σ * Vᵀ,
+//
+// where U is m×k, Σ is k×k and V is n×k, using the randomized algorithm
+// of Halko, Martinsson and Tropp (2011). The rank k is reduced to min(m, n)
+// if it is larger. If settings is nil, DefaultSettings is used.
+//
+// Factorize returns whether the decomposition succeeded. Factorize will
+// panic if k is not positive.
+func (svd *SVD) Factorize(a mat.Matrix, k int, settings *Settings) (ok bool) {
+	if k <= 0 {
+		panic(badDims)
+	}
+	if settings == nil {
+		settings = DefaultSettings()
+	}
+	svd.ok = false
+	svd.m, svd.n = a.Dims()
+	k = min(k, svd.m, svd.n)
+
+	var q mat.Dense
+	RangeFinder(&q, a, k+settings.Oversample, settings.PowerIter, settings.Src)
+
+	// B = Qᵀ * A is a small l×n matrix whose singular values
+	// approximate those of A, and A ≈ Q * U_B * Σ * Vᵀ.
+	var b mat.Dense
+	b.Mul(q.T(), a)
+	var bsvd mat.SVD
+	if !bsvd.Factorize(&b, mat.SVDThin) {
+		return false
+	}
+	var ub, vb mat.Dense
+	bsvd.UTo(&ub)
+	bsvd.VTo(&vb)
+	_, l := q.Dims()
+	svd.u.Reset()
+	svd.u.Mul(&q, ub.Slice(0, l, 0, k))
+	svd.v.Reset()
+	svd.v.CloneFrom(vb.Slice(0, svd.n, 0, k))
+	svd.s = bsvd.Values(nil)[:k]
+	svd.ok = true
+	return true
+}
+
+// Rank returns the rank of the approximation.
+func (svd *SVD) Rank() int {
+	return len(svd.s)
+}
+
+// Values returns the approximate singular values of the factorized matrix
+// in descending order.
+//
+// If the input slice is non-nil, the values will be stored in-place into
+// the slice. In this case, the slice must have length Rank(), and Values
+// will panic with ErrSliceLengthMismatch otherwise. If the input slice is
+// nil, a new slice of the appropriate length will be allocated and
+// returned.
+//
+// Values will panic if the receiver does not contain a successful
+// factorization.
+func (svd *SVD) Values(s []float64) []float64 {
+	if !svd.ok {
+		panic(badFact)
+	}
+	if s == nil {
+		s = make([]float64, len(svd.s))
+	}
+	if len(s) != len(svd.s) {
+		panic(mat.ErrSliceLengthMismatch)
+	}
+	copy(s, svd.s)
+	return s
+}
+
+// UTo extracts the approximate left singular vectors into the columns of
+// dst.
+//
+// If dst is empty, UTo will resize dst to be m×k. When dst is non-empty,
+// UTo will panic if dst is not m×k. UTo will also panic if the receiver
+// does not contain a successful factorization.
+func (svd *SVD) UTo(dst *mat.Dense) {
+	if !svd.ok {
+		panic(badFact)
+	}
+	reuseAs(dst, svd.m, len(svd.s))
+	dst.Copy(&svd.u)
+}
+
+// VTo extracts the approximate right singular vectors into the columns of
+// dst.
+//
+// If dst is empty, VTo will resize dst to be n×k. When dst is non-empty,
+// VTo will panic if dst is not n×k. VTo will also panic if the receiver
+// does not contain a successful factorization.
+func (svd *SVD) VTo(dst *mat.Dense) {
+	if !svd.ok {
+		panic(badFact)
+	}
+	reuseAs(dst, svd.n, len(svd.s))
+	dst.Copy(&svd.v)
+}
@@ -4,6 +4,14 @@
 
 package mat
 
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
 // Solve solves the linear least squares problem
 //
 //	minimize over x |b - A*x|_2
@@ -122,3 +130,115 @@ func (v *VecDense) SolveVec(a Matrix, b Vector) error {
 	m := v.asDense()
 	return m.Solve(a, b)
 }
+
+// SolveExpert holds the options for and the diagnostics of a linear solve
+// performed by Dense.SolveExpert.
+type SolveExpert struct {
+	// Equilibrate specifies whether the rows and columns of A are
+	// scaled before the system is solved when that improves the
+	// conditioning of A.
+	Equilibrate bool
+
+	// The following fields are set by Dense.SolveExpert.
+
+	// Equilibrated reports whether the system was equilibrated.
+	Equilibrated bool
+	// RCond is an estimate of the reciprocal of the condition number
+	// of the (equilibrated) matrix A in the 1-norm. RCond is zero if A
+	// is exactly singular.
+	RCond float64
+	// ForwardErr holds, for each column x_j of the solution, an
+	// estimated bound on the relative error ‖x_j - xtrue_j‖_∞ / ‖x_j‖_∞.
+	ForwardErr []float64
+	// BackwardErr holds, for each column x_j of the solution, the
+	// componentwise relative backward error, that is the smallest
+	// relative change in any element of A or b_j that makes x_j an
+	// exact solution.
+	BackwardErr []float64
+}
+
+// SolveExpert solves the system of linear equations
+//
+//	A * X = B
+//
+// where A is an n×n matrix and B is an n×k matrix, storing the result in the
+// receiver. SolveExpert uses iterative refinement to improve the computed
+// solution and reports an estimate of the condition number of A and bounds on
+// the forward and backward error of each column of the solution in opt. If
+// opt.Equilibrate is true, the rows and columns of A are scaled to improve the
+// conditioning of the system before it is factorized.
+//
+// If a implements Symmetric and is positive definite, the Cholesky
+// factorization of A is used, otherwise the LU factorization with partial
+// pivoting is used. a and b are not modified.
+//
+// opt may be nil, in which case no equilibration is performed and the
+// diagnostics are discarded.
+//
+// If A is singular or near-singular a Condition error is returned. See the
+// documentation for Condition for more information. SolveExpert will panic if
+// A is not square or if the number of rows of A and B differ.
+func (m *Dense) SolveExpert(a, b Matrix, opt *SolveExpert) error {
+	n, c := a.Dims()
+	if n != c {
+		panic(ErrSquare)
+	}
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+	if opt == nil {
+		opt = &SolveExpert{}
+	}
+	opt.ForwardErr = use(opt.ForwardErr, bc)
+	opt.BackwardErr = use(opt.BackwardErr, bc)
+
+	// The expert drivers overwrite A and B with their equilibrated
+	// forms, so work on copies.
+	bw := getDenseWorkspace(n, bc, false)
+	defer putDenseWorkspace(bw)
+	af := getDenseWorkspace(n, n, false)
+	defer putDenseWorkspace(af)
+	ints := getInts(2*n, false)
+	defer putInts(ints)
+	ipiv, iwork := ints[:n], ints[n:]
+	work := getFloat64s(4*n, false)
+	defer putFloat64s(work)
+	scale := getFloat64s(2*n, false)
+	defer putFloat64s(scale)
+
+	// The solution is only written into the receiver after A and B
+	// have been copied, so m may alias a or b.
+	m.reuseAsNonZeroed(n, bc)
+
+	var (
+		rcond float64
+		ok    bool
+	)
+	if s, isSym := a.(Symmetric); isSym {
+		aw := getSymDenseWorkspace(n, false)
+		defer putSymDenseWorkspace(aw)
+		aw.CopySym(s)
+		bw.Copy(b)
+		opt.Equilibrated, rcond, ok = lapack64.Posvx(opt.Equilibrate, aw.mat, af.mat, scale[:n], bw.mat, m.mat, opt.ForwardErr, opt.BackwardErr, work, iwork)
+	}
+	if !ok {
+		// A is not symmetric positive definite, so use the LU
+		// factorization.
+		aw := getDenseWorkspace(n, n, false)
+		defer putDenseWorkspace(aw)
+		aw.Copy(a)
+		bw.Copy(b)
+		var equed lapack.EquilibrationType
+		equed, rcond, ok = lapack64.Gesvx(opt.Equilibrate, blas.NoTrans, aw.mat, af.mat, ipiv, scale[:n], scale[n:], bw.mat, m.mat, opt.ForwardErr, opt.BackwardErr, work, iwork)
+		opt.Equilibrated = equed != lapack.EquilibrateNone
+	}
+	opt.RCond = rcond
+	if !ok {
+		return Condition(math.Inf(1))
+	}
+	if cond := 1 / rcond; cond > ConditionTolerance {
+		return Condition(cond)
+	}
+	return nil
+}
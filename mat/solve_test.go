@@ -5,6 +5,7 @@
 package mat
 
 import (
+	"math"
 	"math/rand/v2"
 	"testing"
 )
@@ -304,3 +305,157 @@ func TestSolveVec(t *testing.T) {
 	}
 	testTwoInput(t, "SolveVec", &VecDense{}, method, denseComparison, legalTypesMatrixVector, legalSizeSolve, 1e-12)
 }
+
+func TestDenseSolveExpert(t *testing.T) {
+	t.Parallel()
+	const eps = 0x1p-53
+	rnd := rand.New(rand.NewPCG(1, 1))
+
+	// randScaled returns a random n×n matrix with a dominant diagonal
+	// whose rows and columns are scaled by factors in [10^-spread, 10^spread].
+	randScaled := func(n int, spread float64) *Dense {
+		a := NewDense(n, n, randSlice(n*n, rnd))
+		for i := 0; i < n; i++ {
+			a.Set(i, i, a.At(i, i)+float64(n))
+		}
+		for i := 0; i < n; i++ {
+			r := math.Pow(10, spread*(2*rnd.Float64()-1))
+			c := math.Pow(10, spread*(2*rnd.Float64()-1))
+			for j := 0; j < n; j++ {
+				a.Set(i, j, a.At(i, j)*r)
+				a.Set(j, i, a.At(j, i)*c)
+			}
+		}
+		return a
+	}
+	randSPD := func(n int) *SymDense {
+		g := NewDense(n, n, randSlice(n*n, rnd))
+		var a SymDense
+		a.SymOuterK(1, g)
+		for i := 0; i < n; i++ {
+			a.SetSym(i, i, a.At(i, i)+float64(n))
+		}
+		return &a
+	}
+	indefinite := func(n int) *SymDense {
+		a := randSPD(n)
+		a.SetSym(0, 0, -a.At(0, 0))
+		return a
+	}
+
+	for _, test := range []struct {
+		name   string
+		a      Matrix
+		k      int
+		spread float64
+	}{
+		{name: "general", a: randScaled(10, 0), k: 3},
+		{name: "scaled", a: randScaled(10, 3), k: 2, spread: 3},
+		{name: "transpose", a: randScaled(10, 3).T(), k: 1, spread: 3},
+		{name: "spd", a: randSPD(10), k: 3},
+		{name: "indefinite", a: indefinite(10), k: 2},
+		{name: "single", a: randScaled(1, 0), k: 1},
+	} {
+		n, _ := test.a.Dims()
+		aCopy := DenseCopyOf(test.a)
+		want := NewDense(n, test.k, randSlice(n*test.k, rnd))
+		var b Dense
+		b.Mul(test.a, want)
+		bCopy := DenseCopyOf(&b)
+
+		for _, equilibrate := range []bool{false, true} {
+			opt := SolveExpert{Equilibrate: equilibrate}
+			var x Dense
+			err := x.SolveExpert(test.a, &b, &opt)
+			if err != nil {
+				t.Errorf("%s,equilibrate=%t: unexpected error: %v", test.name, equilibrate, err)
+				continue
+			}
+			if !Equal(test.a, aCopy) || !Equal(&b, bCopy) {
+				t.Errorf("%s,equilibrate=%t: input modified", test.name, equilibrate)
+			}
+			if test.spread > 0 && n > 1 && opt.Equilibrated != equilibrate {
+				t.Errorf("%s,equilibrate=%t: unexpected equilibration", test.name, equilibrate)
+			}
+			if !equilibrate && opt.Equilibrated {
+				t.Errorf("%s,equilibrate=%t: unexpected equilibration", test.name, equilibrate)
+			}
+			if opt.RCond <= 0 || opt.RCond > 1 {
+				t.Errorf("%s,equilibrate=%t: unexpected rcond %v", test.name, equilibrate, opt.RCond)
+			}
+			if len(opt.ForwardErr) != test.k || len(opt.BackwardErr) != test.k {
+				t.Errorf("%s,equilibrate=%t: unexpected length of error bounds", test.name, equilibrate)
+				continue
+			}
+			for j := 0; j < test.k; j++ {
+				var diff, xnorm float64
+				for i := 0; i < n; i++ {
+					diff = math.Max(diff, math.Abs(x.At(i, j)-want.At(i, j)))
+					xnorm = math.Max(xnorm, math.Abs(x.At(i, j)))
+				}
+				if err := diff / xnorm; err > opt.ForwardErr[j] {
+					t.Errorf("%s,equilibrate=%t: forward error %v of column %d larger than bound %v",
+						test.name, equilibrate, err, j, opt.ForwardErr[j])
+				}
+				if opt.BackwardErr[j] > float64(n+1)*eps {
+					t.Errorf("%s,equilibrate=%t: backward error %v of column %d too large",
+						test.name, equilibrate, opt.BackwardErr[j], j)
+				}
+			}
+		}
+
+		// Check that the receiver may alias b and that opt may be nil.
+		x := DenseCopyOf(&b)
+		err := x.SolveExpert(test.a, x, nil)
+		if err != nil {
+			t.Errorf("%s: unexpected error for aliased receiver: %v", test.name, err)
+		}
+		if !EqualApprox(x, want, 1e-10) {
+			t.Errorf("%s: unexpected result for aliased receiver", test.name)
+		}
+	}
+
+	// Check that equilibration removes the ill-conditioning caused by bad
+	// scaling.
+	a := randScaled(10, 8)
+	b := NewDense(10, 1, randSlice(10, rnd))
+	var x Dense
+	err := x.SolveExpert(a, b, &SolveExpert{})
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("expected Condition error for badly scaled matrix, got %v", err)
+	}
+	x.Reset()
+	err = x.SolveExpert(a, b, &SolveExpert{Equilibrate: true})
+	if err != nil {
+		t.Errorf("unexpected error for equilibrated badly scaled matrix: %v", err)
+	}
+
+	// Check that singular and ill-conditioned systems are reported.
+	singular := NewDense(3, 3, []float64{
+		1, 2, 3,
+		4, 5, 6,
+		0, 0, 0,
+	})
+	x.Reset()
+	var opt SolveExpert
+	err = x.SolveExpert(singular, NewDense(3, 1, []float64{1, 2, 3}), &opt)
+	if cond, ok := err.(Condition); !ok || !math.IsInf(float64(cond), 1) {
+		t.Errorf("unexpected error for singular matrix: %v", err)
+	}
+	if opt.RCond != 0 {
+		t.Errorf("unexpected rcond for singular matrix: got %v, want 0", opt.RCond)
+	}
+
+	const n = 14
+	hilbert := NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			hilbert.SetSym(i, j, 1/float64(i+j+1))
+		}
+	}
+	x.Reset()
+	err = x.SolveExpert(hilbert, NewDense(n, 1, randSlice(n, rnd)), &opt)
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("expected Condition error for Hilbert matrix, got %v", err)
+	}
+}
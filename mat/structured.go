@@ -0,0 +1,429 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/bits"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/dsp/fourier"
+)
+
+var (
+	toeplitz *Toeplitz
+	_        Matrix = toeplitz
+
+	circulant *Circulant
+	_         Matrix = circulant
+
+	hankel *Hankel
+	_      Matrix = hankel
+)
+
+// Toeplitz represents an m×n Toeplitz matrix, a matrix that is constant
+// along each of its diagonals, by its first column and first row. The
+// element in row i and column j is col[i-j] if i ≥ j and row[j-i] otherwise.
+//
+// Products with a Toeplitz matrix are computed in O((m+n) log(m+n)) time
+// using the fast Fourier transform, and square Toeplitz systems are solved
+// in O(n²) time using the Levinson recursion. A Toeplitz matrix can be
+// converted to a Dense using Dense.Copy.
+type Toeplitz struct {
+	col, row []float64
+}
+
+// NewToeplitz creates a new Toeplitz matrix with the first column col and
+// the first row row. If row is nil, the matrix is the symmetric Toeplitz
+// matrix with first row equal to col. The elements of col and row are used
+// as the backing data of the returned matrix. NewToeplitz panics if col or
+// row has zero length, or if col[0] and row[0] differ.
+func NewToeplitz(col, row []float64) *Toeplitz {
+	if row == nil {
+		row = col
+	}
+	if len(col) == 0 || len(row) == 0 {
+		panic(ErrZeroLength)
+	}
+	if col[0] != row[0] {
+		panic("mat: Toeplitz column and row differ in first element")
+	}
+	return &Toeplitz{col: col, row: row}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (t *Toeplitz) Dims() (r, c int) {
+	return len(t.col), len(t.row)
+}
+
+// At returns the element at row i, column j.
+func (t *Toeplitz) At(i, j int) float64 {
+	if uint(i) >= uint(len(t.col)) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(len(t.row)) {
+		panic(ErrColAccess)
+	}
+	if i >= j {
+		return t.col[i-j]
+	}
+	return t.row[j-i]
+}
+
+// T performs an implicit transpose by returning the receiver inside a Transpose.
+func (t *Toeplitz) T() Matrix {
+	return Transpose{t}
+}
+
+// diag returns the element on the d-th diagonal, counted
+// from the main diagonal to the lower left.
+func (t *Toeplitz) diag(d int) float64 {
+	if d >= 0 {
+		return t.col[d]
+	}
+	return t.row[-d]
+}
+
+// MulVecTo computes A⋅x or Aᵀ⋅x storing the result into dst.
+func (t *Toeplitz) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	col, row := t.col, t.row
+	if trans {
+		col, row = row, col
+	}
+	m, n := len(col), len(row)
+	if x.Len() != n {
+		panic(ErrShape)
+	}
+
+	// The product is a part of the linear convolution
+	// of x with the sequence of the diagonals
+	//  g = [row[n-1], ..., row[1], col[0], ..., col[m-1]].
+	g := make([]float64, m+n-1)
+	for k := 1; k < n; k++ {
+		g[n-1-k] = row[k]
+	}
+	copy(g[n-1:], col)
+	y := make([]float64, m)
+	convolve(y, g, vecData(x), n-1)
+
+	dst.reuseAsNonZeroed(m)
+	for i, v := range y {
+		dst.SetVec(i, v)
+	}
+}
+
+// SolveVecTo solves the square Toeplitz system A⋅x = b or Aᵀ⋅x = b using
+// the Levinson recursion, storing the result into dst. The recursion
+// requires all leading principal submatrices of A to be non-singular,
+// which holds if A is positive definite. If the recursion breaks down,
+// the contents of dst will be undefined and a Condition error will be
+// returned. The recursion is not backward stable in general, but is
+// weakly stable for symmetric positive definite matrices. SolveVecTo
+// panics if A is not square.
+func (t *Toeplitz) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
+	n := len(t.col)
+	if len(t.row) != n {
+		panic(ErrSquare)
+	}
+	if b.Len() != n {
+		panic(ErrShape)
+	}
+	a := t
+	if trans {
+		a = &Toeplitz{col: t.row, row: t.col}
+	}
+	y := vecData(b)
+
+	// The forward and backward vectors f and b of the leading
+	// k×k submatrix T_k satisfy T_k f = e_1 and T_k b = e_k.
+	t0 := a.col[0]
+	if t0 == 0 {
+		return Condition(math.Inf(1))
+	}
+	f := make([]float64, 1, n)
+	bw := make([]float64, 1, n)
+	x := make([]float64, 1, n)
+	f[0] = 1 / t0
+	bw[0] = 1 / t0
+	x[0] = y[0] / t0
+	for k := 1; k < n; k++ {
+		var ef, eb, ex float64
+		for j := 0; j < k; j++ {
+			ef += a.diag(k-j) * f[j]
+			eb += a.diag(-(j + 1)) * bw[j]
+			ex += a.diag(k-j) * x[j]
+		}
+		denom := 1 - ef*eb
+		if denom == 0 {
+			return Condition(math.Inf(1))
+		}
+		f = append(f, 0)
+		bw = append(bw, 0)
+		for j := k; j >= 0; j-- {
+			fj := f[j]
+			var bj1 float64
+			if j > 0 {
+				bj1 = bw[j-1]
+			}
+			f[j] = (fj - ef*bj1) / denom
+			bw[j] = (bj1 - eb*fj) / denom
+		}
+		x = append(x, 0)
+		d := y[k] - ex
+		for j, v := range bw {
+			x[j] += d * v
+		}
+	}
+
+	dst.reuseAsNonZeroed(n)
+	for i, v := range x {
+		dst.SetVec(i, v)
+	}
+	return nil
+}
+
+// Circulant represents an n×n circulant matrix, a Toeplitz matrix in which
+// each column is the previous column rotated down by one element, by its
+// first column. The element in row i and column j is col[(i-j) mod n].
+//
+// Products with a circulant matrix and solutions of circulant systems are
+// computed in O(n log n) time using the fast Fourier transform, which
+// diagonalizes circulant matrices. A circulant matrix can be converted to a
+// Dense using Dense.Copy.
+type Circulant struct {
+	col []float64
+}
+
+// NewCirculant creates a new circulant matrix with the first column col.
+// The elements of col are used as the backing data of the returned matrix.
+// NewCirculant panics if col has zero length.
+func NewCirculant(col []float64) *Circulant {
+	if len(col) == 0 {
+		panic(ErrZeroLength)
+	}
+	return &Circulant{col: col}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (c *Circulant) Dims() (r, cols int) {
+	return len(c.col), len(c.col)
+}
+
+// At returns the element at row i, column j.
+func (c *Circulant) At(i, j int) float64 {
+	n := len(c.col)
+	if uint(i) >= uint(n) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(n) {
+		panic(ErrColAccess)
+	}
+	k := i - j
+	if k < 0 {
+		k += n
+	}
+	return c.col[k]
+}
+
+// T performs an implicit transpose by returning the receiver inside a Transpose.
+func (c *Circulant) T() Matrix {
+	return Transpose{c}
+}
+
+// eigenvalues returns the eigenvalues of the matrix or its transpose, the
+// discrete Fourier transform of its first column, computed by fft.
+func (c *Circulant) eigenvalues(fft *fourier.FFT, trans bool) []complex128 {
+	lambda := fft.Coefficients(nil, c.col)
+	if trans {
+		// The transpose has the reversed column, whose
+		// transform is the complex conjugate.
+		for i, v := range lambda {
+			lambda[i] = cmplx.Conj(v)
+		}
+	}
+	return lambda
+}
+
+// MulVecTo computes A⋅x or Aᵀ⋅x storing the result into dst.
+func (c *Circulant) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	n := len(c.col)
+	if x.Len() != n {
+		panic(ErrShape)
+	}
+	fft := fourier.NewFFT(n)
+	lambda := c.eigenvalues(fft, trans)
+	coeff := fft.Coefficients(nil, vecData(x))
+	for i := range coeff {
+		coeff[i] *= lambda[i]
+	}
+	y := fft.Sequence(nil, coeff)
+
+	dst.reuseAsNonZeroed(n)
+	for i, v := range y {
+		dst.SetVec(i, v/float64(n))
+	}
+}
+
+// SolveVecTo solves the circulant system A⋅x = b or Aᵀ⋅x = b, storing the
+// result into dst. If A is singular, the contents of dst will be undefined
+// and a Condition error will be returned. If A is near singular, a
+// Condition error with the condition number of A will be returned along
+// with the result.
+func (c *Circulant) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
+	n := len(c.col)
+	if b.Len() != n {
+		panic(ErrShape)
+	}
+	fft := fourier.NewFFT(n)
+	lambda := c.eigenvalues(fft, trans)
+
+	// The eigenvalues of the transposed and untransposed
+	// matrices are those in lambda and their conjugates.
+	lmin, lmax := math.Inf(1), 0.0
+	for _, v := range lambda {
+		a := cmplx.Abs(v)
+		lmin = math.Min(lmin, a)
+		lmax = math.Max(lmax, a)
+	}
+	if lmin == 0 {
+		return Condition(math.Inf(1))
+	}
+	coeff := fft.Coefficients(nil, vecData(b))
+	for i := range coeff {
+		coeff[i] /= lambda[i]
+	}
+	x := fft.Sequence(nil, coeff)
+
+	dst.reuseAsNonZeroed(n)
+	for i, v := range x {
+		dst.SetVec(i, v/float64(n))
+	}
+	// The singular values of a circulant matrix are
+	// the magnitudes of its eigenvalues.
+	if cond := lmax / lmin; cond > ConditionTolerance {
+		return Condition(cond)
+	}
+	return nil
+}
+
+// Hankel represents an m×n Hankel matrix, a matrix that is constant along
+// each of its anti-diagonals, by its first column and last row. The element
+// in row i and column j is col[i+j] if i+j < m and row[i+j-m+1] otherwise.
+//
+// Products with a Hankel matrix are computed in O((m+n) log(m+n)) time
+// using the fast Fourier transform. A Hankel matrix can be converted to a
+// Dense using Dense.Copy.
+type Hankel struct {
+	col, row []float64
+}
+
+// NewHankel creates a new Hankel matrix with the first column col and the
+// last row row. The elements of col and row are used as the backing data of
+// the returned matrix. NewHankel panics if col or row has zero length, or if
+// the last element of col and the first element of row differ.
+func NewHankel(col, row []float64) *Hankel {
+	if len(col) == 0 || len(row) == 0 {
+		panic(ErrZeroLength)
+	}
+	if col[len(col)-1] != row[0] {
+		panic("mat: Hankel column and row differ in shared element")
+	}
+	return &Hankel{col: col, row: row}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (h *Hankel) Dims() (r, c int) {
+	return len(h.col), len(h.row)
+}
+
+// At returns the element at row i, column j.
+func (h *Hankel) At(i, j int) float64 {
+	m := len(h.col)
+	if uint(i) >= uint(m) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(len(h.row)) {
+		panic(ErrColAccess)
+	}
+	return h.antidiag(i + j)
+}
+
+// T performs an implicit transpose by returning the receiver inside a Transpose.
+func (h *Hankel) T() Matrix {
+	return Transpose{h}
+}
+
+// antidiag returns the element on the k-th anti-diagonal.
+func (h *Hankel) antidiag(k int) float64 {
+	m := len(h.col)
+	if k < m {
+		return h.col[k]
+	}
+	return h.row[k-m+1]
+}
+
+// MulVecTo computes A⋅x or Aᵀ⋅x storing the result into dst.
+func (h *Hankel) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	m, n := h.Dims()
+	if trans {
+		// The transpose has the same anti-diagonals.
+		m, n = n, m
+	}
+	if x.Len() != n {
+		panic(ErrShape)
+	}
+
+	// The product is a part of the linear convolution of
+	// the reversed x with the sequence of anti-diagonals.
+	g := make([]float64, m+n-1)
+	for k := range g {
+		g[k] = h.antidiag(k)
+	}
+	xr := vecData(x)
+	for i, j := 0, len(xr)-1; i < j; i, j = i+1, j-1 {
+		xr[i], xr[j] = xr[j], xr[i]
+	}
+	y := make([]float64, m)
+	convolve(y, g, xr, n-1)
+
+	dst.reuseAsNonZeroed(m)
+	for i, v := range y {
+		dst.SetVec(i, v)
+	}
+}
+
+// vecData returns a newly allocated slice holding the elements of v.
+func vecData(v Vector) []float64 {
+	data := make([]float64, v.Len())
+	for i := range data {
+		data[i] = v.AtVec(i)
+	}
+	return data
+}
+
+// convolve stores the elements of the linear convolution of g and x
+// starting at offset into dst, computing the convolution with the fast
+// Fourier transform.
+func convolve(dst, g, x []float64, offset int) {
+	// Pad to a power of two at least the length of the
+	// convolution so the circular convolution computed by
+	// the transform does not wrap around.
+	l := len(g) + len(x) - 1
+	size := 1 << bits.Len(uint(l-1))
+	fft := fourier.NewFFT(size)
+	seq := make([]float64, size)
+	copy(seq, g)
+	cg := fft.Coefficients(nil, seq)
+	zero(seq)
+	copy(seq, x)
+	cx := fft.Coefficients(nil, seq)
+	for i := range cg {
+		cg[i] *= cx[i]
+	}
+	fft.Sequence(seq, cg)
+	for i := range dst {
+		dst[i] = seq[offset+i] / float64(size)
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func randSlice(n int, rnd *rand.Rand) []float64 {
+	s := make([]float64, n)
+	for i := range s {
+		s[i] = rnd.NormFloat64()
+	}
+	return s
+}
+
+func TestToeplitz(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct{ m, n int }{
+		{1, 1}, {1, 4}, {4, 1}, {3, 3}, {5, 8}, {8, 5}, {17, 17}, {30, 7},
+	} {
+		col := randSlice(test.m, rnd)
+		row := randSlice(test.n, rnd)
+		row[0] = col[0]
+		a := NewToeplitz(col, row)
+		for i := 0; i < test.m; i++ {
+			for j := 0; j < test.n; j++ {
+				var want float64
+				if i >= j {
+					want = col[i-j]
+				} else {
+					want = row[j-i]
+				}
+				if got := a.At(i, j); got != want {
+					t.Errorf("unexpected value at (%d,%d) for %d×%d: got:%v want:%v", i, j, test.m, test.n, got, want)
+				}
+			}
+		}
+		testStructuredMulVec(t, "Toeplitz", a, rnd)
+	}
+
+	if p, _ := panics(func() { NewToeplitz([]float64{1, 2}, []float64{3, 4}) }); !p {
+		t.Error("expected panic for mismatched first element")
+	}
+	if p, _ := panics(func() { NewToeplitz(nil, nil) }); !p {
+		t.Error("expected panic for zero length")
+	}
+	sym := NewToeplitz([]float64{4, 1, 2}, nil)
+	if !Equal(sym, sym.T()) {
+		t.Error("Toeplitz matrix with nil row is not symmetric")
+	}
+}
+
+func TestToeplitzSolveVecTo(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10, 25} {
+		// Symmetric positive definite from an exponentially
+		// decaying autocorrelation sequence.
+		spd := make([]float64, n)
+		for k := range spd {
+			spd[k] = math.Pow(0.7, float64(k))
+		}
+		// Nonsymmetric and diagonally dominant.
+		col := randSlice(n, rnd)
+		row := randSlice(n, rnd)
+		col[0] = 2 * float64(n)
+		row[0] = col[0]
+		for _, a := range []*Toeplitz{
+			NewToeplitz(spd, nil),
+			NewToeplitz(col, row),
+		} {
+			b := NewVecDense(n, randSlice(n, rnd))
+			for _, trans := range []bool{false, true} {
+				var got VecDense
+				err := a.SolveVecTo(&got, trans, b)
+				if err != nil {
+					t.Errorf("unexpected error for n=%d trans=%t: %v", n, trans, err)
+					continue
+				}
+				var m Matrix = a
+				if trans {
+					m = a.T()
+				}
+				var want VecDense
+				err = want.SolveVec(m, b)
+				if err != nil {
+					t.Fatalf("unexpected error from dense solve: %v", err)
+				}
+				if !EqualApprox(&got, &want, 1e-10) {
+					t.Errorf("unexpected solution for n=%d trans=%t:\ngot: %v\nwant:%v",
+						n, trans, Formatted(&got), Formatted(&want))
+				}
+			}
+		}
+	}
+
+	// The leading 1×1 submatrix is singular.
+	a := NewToeplitz([]float64{0, 1}, nil)
+	var x VecDense
+	err := a.SolveVecTo(&x, false, NewVecDense(2, []float64{1, 1}))
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("expected Condition error for breakdown, got:%v", err)
+	}
+	if p, _ := panics(func() { NewToeplitz([]float64{1, 2}, []float64{1, 2, 3}).SolveVecTo(&x, false, NewVecDense(2, nil)) }); !p {
+		t.Error("expected panic for non-square solve")
+	}
+}
+
+func TestCirculant(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 7, 8, 16, 31} {
+		col := randSlice(n, rnd)
+		col[0] += 2 * float64(n)
+		a := NewCirculant(col)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				want := col[((i-j)%n+n)%n]
+				if got := a.At(i, j); got != want {
+					t.Errorf("unexpected value at (%d,%d) for n=%d: got:%v want:%v", i, j, n, got, want)
+				}
+			}
+		}
+		testStructuredMulVec(t, "Circulant", a, rnd)
+
+		b := NewVecDense(n, randSlice(n, rnd))
+		for _, trans := range []bool{false, true} {
+			var got VecDense
+			err := a.SolveVecTo(&got, trans, b)
+			if err != nil {
+				t.Errorf("unexpected error for n=%d trans=%t: %v", n, trans, err)
+				continue
+			}
+			var m Matrix = a
+			if trans {
+				m = a.T()
+			}
+			var want VecDense
+			err = want.SolveVec(m, b)
+			if err != nil {
+				t.Fatalf("unexpected error from dense solve: %v", err)
+			}
+			if !EqualApprox(&got, &want, 1e-10) {
+				t.Errorf("unexpected solution for n=%d trans=%t:\ngot: %v\nwant:%v",
+					n, trans, Formatted(&got), Formatted(&want))
+			}
+		}
+	}
+
+	// The all-ones vector is in the null space.
+	a := NewCirculant([]float64{1, -1, 0, 0})
+	var x VecDense
+	err := a.SolveVecTo(&x, false, NewVecDense(4, []float64{1, 0, 0, 0}))
+	if c, ok := err.(Condition); !ok || !math.IsInf(float64(c), 1) {
+		t.Errorf("expected infinite Condition error for singular matrix, got:%v", err)
+	}
+}
+
+func TestHankel(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct{ m, n int }{
+		{1, 1}, {1, 4}, {4, 1}, {3, 3}, {5, 8}, {8, 5}, {17, 17}, {30, 7},
+	} {
+		col := randSlice(test.m, rnd)
+		row := randSlice(test.n, rnd)
+		row[0] = col[test.m-1]
+		a := NewHankel(col, row)
+		for i := 0; i < test.m; i++ {
+			for j := 0; j < test.n; j++ {
+				var want float64
+				if i+j < test.m {
+					want = col[i+j]
+				} else {
+					want = row[i+j-test.m+1]
+				}
+				if got := a.At(i, j); got != want {
+					t.Errorf("unexpected value at (%d,%d) for %d×%d: got:%v want:%v", i, j, test.m, test.n, got, want)
+				}
+			}
+		}
+		testStructuredMulVec(t, "Hankel", a, rnd)
+	}
+
+	if p, _ := panics(func() { NewHankel([]float64{1, 2}, []float64{3, 4}) }); !p {
+		t.Error("expected panic for mismatched shared element")
+	}
+}
+
+// testStructuredMulVec checks MulVecTo of a against a product
+// computed with a Dense copy of a.
+func testStructuredMulVec(t *testing.T, name string, a interface {
+	Matrix
+	MulVecTo(*VecDense, bool, Vector)
+}, rnd *rand.Rand) {
+	t.Helper()
+	m, n := a.Dims()
+	d := DenseCopyOf(a)
+	for _, trans := range []bool{false, true} {
+		r, c := m, n
+		var dm Matrix = d
+		if trans {
+			r, c = n, m
+			dm = d.T()
+		}
+		x := NewVecDense(c, randSlice(c, rnd))
+		var want VecDense
+		want.MulVec(dm, x)
+
+		var got VecDense
+		a.MulVecTo(&got, trans, x)
+		if !EqualApprox(&got, &want, 1e-12) {
+			t.Errorf("%s %d×%d trans=%t: unexpected MulVecTo result:\ngot: %v\nwant:%v",
+				name, m, n, trans, Formatted(&got), Formatted(&want))
+		}
+
+		// The receiver may be the argument when the
+		// result has the same length.
+		if r == c {
+			y := VecDenseCopyOf(x)
+			a.MulVecTo(y, trans, y)
+			if !EqualApprox(y, &want, 1e-12) {
+				t.Errorf("%s %d×%d trans=%t: unexpected in-place MulVecTo result", name, m, n, trans)
+			}
+		}
+	}
+}
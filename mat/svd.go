@@ -39,6 +39,12 @@ const (
 	SVDThinV
 	// SVDFullV specifies the full decomposition for V should be computed.
 	SVDFullV
+	// SVDAccurate specifies that the decomposition should be computed to
+	// high relative accuracy using the one-sided Jacobi method. It may be
+	// combined with any of the other kinds. The accurate decomposition is
+	// slower, but the small singular values of matrices with badly scaled
+	// rows or columns are computed much more accurately.
+	SVDAccurate
 
 	// SVDThin is a convenience value for computing both thin vectors.
 	SVDThin SVDKind = SVDThinU | SVDThinV
@@ -74,6 +80,13 @@ func (svd *SVD) succFact() bool {
 // where U~ is of size m×min(m,n), Σ is a diagonal matrix of size min(m,n)×min(m,n)
 // and V~ is of size n×min(m,n).
 //
+// If kind includes SVDAccurate, for example SVDThin|SVDAccurate, the
+// decomposition is computed using a QR factorization with column pivoting
+// followed by the one-sided Jacobi method. The singular values of a matrix of
+// the form A = D_1 * B * D_2, where D_1 and D_2 are diagonal and B is well
+// conditioned, are then computed to high relative accuracy regardless of the
+// scaling D_1 and D_2.
+//
 // Factorize returns whether the decomposition succeeded. If the decomposition
 // failed, routines that require a successful factorization will panic.
 func (svd *SVD) Factorize(a Matrix, kind SVDKind) (ok bool) {
@@ -128,11 +141,20 @@ func (svd *SVD) Factorize(a Matrix, kind SVDKind) (ok bool) {
 		jobVT = lapack.SVDNone
 	}
 
-	// A is destroyed on call, so copy the matrix.
-	aCopy := DenseCopyOf(a)
 	svd.kind = kind
 	svd.s = use(svd.s, min(m, n))
 
+	if kind&SVDAccurate != 0 {
+		ok = svd.factorizeAccurate(a, jobU, jobVT)
+		if !ok {
+			svd.kind = 0
+		}
+		return ok
+	}
+
+	// A is destroyed on call, so copy the matrix.
+	aCopy := DenseCopyOf(a)
+
 	work := []float64{0}
 	lapack64.Gesvd(jobU, jobVT, aCopy.mat, svd.u, svd.vt, svd.s, work, -1)
 	work = getFloat64s(int(work[0]), false)
@@ -144,6 +166,77 @@ func (svd *SVD) Factorize(a Matrix, kind SVDKind) (ok bool) {
 	return ok
 }
 
+// factorizeAccurate computes the singular value decomposition of a using the
+// one-sided Jacobi method. The receiver's singular vector storage must have
+// been allocated according to jobU and jobVT.
+func (svd *SVD) factorizeAccurate(a Matrix, jobU, jobVT lapack.SVDJob) (ok bool) {
+	m, n := a.Dims()
+
+	// Gejsv requires A to have at least as many rows as columns, so
+	// the transpose of a wide matrix is factorized instead and the
+	// roles of U and V are swapped.
+	trans := m < n
+	var b *Dense
+	if trans {
+		b = DenseCopyOf(a.T())
+		m, n = n, m
+		jobU, jobVT = jobVT, jobU
+	} else {
+		b = DenseCopyOf(a)
+	}
+	jobV := lapack.SVDNone
+	if jobVT != lapack.SVDNone {
+		jobV = lapack.SVDAll
+	}
+
+	// u and v hold the singular vectors of B. The singular vectors
+	// that are not stored directly into the receiver are written
+	// into w and transposed below.
+	var (
+		u, v blas64.General
+		w    *Dense
+	)
+	if trans {
+		if jobU != lapack.SVDNone {
+			c := m
+			if jobU == lapack.SVDStore {
+				c = n
+			}
+			w = getDenseWorkspace(m, c, false)
+			defer putDenseWorkspace(w)
+			u = w.mat
+		}
+		if jobV != lapack.SVDNone {
+			v = svd.u
+		}
+	} else {
+		u = svd.u
+		if jobV != lapack.SVDNone {
+			w = getDenseWorkspace(n, n, false)
+			defer putDenseWorkspace(w)
+			v = w.mat
+		}
+	}
+
+	iwork := getInts(n, false)
+	defer putInts(iwork)
+	work := []float64{0}
+	lapack64.Gejsv(jobU, jobV, b.mat, u, v, svd.s, work, -1, iwork)
+	work = getFloat64s(int(work[0]), false)
+	defer putFloat64s(work)
+	ok = lapack64.Gejsv(jobU, jobV, b.mat, u, v, svd.s, work, len(work), iwork)
+
+	if w != nil {
+		vt := Dense{
+			mat:     svd.vt,
+			capRows: svd.vt.Rows,
+			capCols: svd.vt.Cols,
+		}
+		vt.Copy(w.T())
+	}
+	return ok
+}
+
 // Kind returns the SVDKind of the decomposition. If no decomposition has been
 // computed, Kind returns -1.
 func (svd *SVD) Kind() SVDKind {
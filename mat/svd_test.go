@@ -5,7 +5,9 @@
 package mat
 
 import (
+	"math"
 	"math/rand/v2"
+	"sort"
 	"testing"
 
 	"gonum.org/v1/gonum/floats"
@@ -204,6 +206,138 @@ func TestSVD(t *testing.T) {
 	}
 }
 
+func TestSVDAccurate(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{1, 1},
+		{5, 5},
+		{5, 3},
+		{3, 5},
+		{50, 30},
+		{30, 50},
+	} {
+		m := test.m
+		n := test.n
+		a := NewDense(m, n, randSlice(m*n, rnd))
+		aCopy := DenseCopyOf(a)
+
+		var want SVD
+		ok := want.Factorize(a, SVDNone)
+		if !ok {
+			t.Fatalf("SVD factorization failed")
+		}
+		sWant := want.Values(nil)
+
+		for _, kind := range []SVDKind{
+			SVDAccurate,
+			SVDThin | SVDAccurate,
+			SVDFull | SVDAccurate,
+			SVDThinU | SVDAccurate,
+			SVDFullU | SVDAccurate,
+			SVDThinV | SVDAccurate,
+			SVDFullV | SVDAccurate,
+			SVDThinU | SVDFullV | SVDAccurate,
+		} {
+			var svd SVD
+			ok := svd.Factorize(a, kind)
+			if !ok {
+				t.Errorf("m=%d,n=%d,kind=%d: SVD factorization failed", m, n, kind)
+				continue
+			}
+			if svd.Kind() != kind {
+				t.Errorf("m=%d,n=%d,kind=%d: unexpected kind %d", m, n, kind, svd.Kind())
+			}
+			if !Equal(a, aCopy) {
+				t.Errorf("m=%d,n=%d,kind=%d: A changed during call to SVD", m, n, kind)
+			}
+			s := svd.Values(nil)
+			if !floats.EqualApprox(s, sWant, 1e-12) {
+				t.Errorf("m=%d,n=%d,kind=%d: singular value mismatch", m, n, kind)
+			}
+
+			var u, v Dense
+			if kind&(SVDThinU|SVDFullU) != 0 {
+				svd.UTo(&u)
+				r, c := u.Dims()
+				wantC := min(m, n)
+				if kind&SVDFullU != 0 {
+					wantC = m
+				}
+				if r != m || c != wantC {
+					t.Errorf("m=%d,n=%d,kind=%d: unexpected U shape %d×%d", m, n, kind, r, c)
+					continue
+				}
+				var utu Dense
+				utu.Mul(u.T(), &u)
+				if !EqualApprox(&utu, eye(c), 1e-13) {
+					t.Errorf("m=%d,n=%d,kind=%d: U columns not orthonormal", m, n, kind)
+				}
+			}
+			if kind&(SVDThinV|SVDFullV) != 0 {
+				svd.VTo(&v)
+				r, c := v.Dims()
+				wantC := min(m, n)
+				if kind&SVDFullV != 0 {
+					wantC = n
+				}
+				if r != n || c != wantC {
+					t.Errorf("m=%d,n=%d,kind=%d: unexpected V shape %d×%d", m, n, kind, r, c)
+					continue
+				}
+				var vtv Dense
+				vtv.Mul(v.T(), &v)
+				if !EqualApprox(&vtv, eye(c), 1e-13) {
+					t.Errorf("m=%d,n=%d,kind=%d: V columns not orthonormal", m, n, kind)
+				}
+			}
+			if kind&(SVDThinU|SVDFullU) == 0 || kind&(SVDThinV|SVDFullV) == 0 {
+				continue
+			}
+			k := min(m, n)
+			var ans Dense
+			ans.Product(u.Slice(0, m, 0, k), NewDiagDense(k, s), v.Slice(0, n, 0, k).T())
+			if !EqualApprox(&ans, a, 1e-12) {
+				t.Errorf("m=%d,n=%d,kind=%d: A reconstruction mismatch", m, n, kind)
+			}
+		}
+	}
+
+	// Test that the singular values of a matrix with badly scaled columns
+	// are computed to high relative accuracy. The singular values of
+	// A = Q*D where Q has orthonormal columns are the absolute values
+	// of the diagonal of D.
+	const m, n = 20, 10
+	d := make([]float64, n)
+	for j := range d {
+		d[j] = math.Pow(10, -15+30*float64(j)/(n-1))
+	}
+	rnd.Shuffle(n, func(i, j int) { d[i], d[j] = d[j], d[i] })
+	var qr QR
+	qr.Factorize(NewDense(m, n, randSlice(m*n, rnd)))
+	var q Dense
+	qr.QTo(&q)
+	var a Dense
+	a.Mul(q.Slice(0, m, 0, n), NewDiagDense(n, d))
+	sWant := make([]float64, n)
+	copy(sWant, d)
+	sort.Sort(sort.Reverse(sort.Float64Slice(sWant)))
+	for _, x := range []Matrix{&a, a.T()} {
+		var svd SVD
+		ok := svd.Factorize(x, SVDThin|SVDAccurate)
+		if !ok {
+			t.Fatalf("SVD factorization failed for graded matrix")
+		}
+		for i, v := range svd.Values(nil) {
+			if math.Abs(v-sWant[i]) > 1e-13*sWant[i] {
+				t.Errorf("singular value %d of graded matrix not accurate: got %v, want %v", i, v, sWant[i])
+			}
+		}
+	}
+}
+
 func extractSVD(svd *SVD) (s []float64, u, v *Dense) {
 	u = &Dense{}
 	svd.UTo(u)
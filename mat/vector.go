@@ -577,6 +577,94 @@ func (v *VecDense) DivElemVec(a, b Vector) {
 	}
 }
 
+// MulElemAddVec performs element-wise multiplication of a and b and adds c,
+// placing the result in the receiver without allocating an intermediate
+// vector.
+func (v *VecDense) MulElemAddVec(a, b, c Vector) {
+	ar := a.Len()
+	br := b.Len()
+	cr := c.Len()
+
+	if ar != br || ar != cr {
+		panic(ErrShape)
+	}
+
+	v.reuseAsNonZeroed(ar)
+
+	aU, _ := untransposeExtract(a)
+	bU, _ := untransposeExtract(b)
+	cU, _ := untransposeExtract(c)
+
+	if arv, ok := aU.(*VecDense); ok {
+		if brv, ok := bU.(*VecDense); ok {
+			if crv, ok := cU.(*VecDense); ok {
+				amat := arv.mat
+				bmat := brv.mat
+				cmat := crv.mat
+
+				if v != a {
+					v.checkOverlap(amat)
+				}
+				if v != b {
+					v.checkOverlap(bmat)
+				}
+				if v != c {
+					v.checkOverlap(cmat)
+				}
+
+				if v.mat.Inc == 1 && amat.Inc == 1 && bmat.Inc == 1 && cmat.Inc == 1 {
+					// Fast path for a common case.
+					for i, a := range amat.Data {
+						v.mat.Data[i] = a*bmat.Data[i] + cmat.Data[i]
+					}
+					return
+				}
+				var ia, ib, ic int
+				for i := 0; i < ar; i++ {
+					v.setVec(i, amat.Data[ia]*bmat.Data[ib]+cmat.Data[ic])
+					ia += amat.Inc
+					ib += bmat.Inc
+					ic += cmat.Inc
+				}
+				return
+			}
+		}
+	}
+
+	for i := 0; i < ar; i++ {
+		v.setVec(i, a.AtVec(i)*b.AtVec(i)+c.AtVec(i))
+	}
+}
+
+// ClipVec places the elements of a clamped to the interval [lo, hi] in the
+// receiver. NaN elements are retained. ClipVec will panic if lo is greater
+// than hi.
+func (v *VecDense) ClipVec(lo, hi float64, a Vector) {
+	if lo > hi {
+		panic("mat: lower bound greater than upper bound")
+	}
+	ar := a.Len()
+	v.reuseAsNonZeroed(ar)
+
+	aU, _ := untransposeExtract(a)
+	if arv, ok := aU.(*VecDense); ok {
+		amat := arv.mat
+		if v != a {
+			v.checkOverlap(amat)
+		}
+		var ia int
+		for i := 0; i < ar; i++ {
+			v.setVec(i, clip(amat.Data[ia], lo, hi))
+			ia += amat.Inc
+		}
+		return
+	}
+
+	for i := 0; i < ar; i++ {
+		v.setVec(i, clip(a.AtVec(i), lo, hi))
+	}
+}
+
 // MulVec computes a * b. The result is stored into the receiver.
 // MulVec panics if the number of columns in a does not equal the number of rows in b
 // or if the number of columns in b does not equal 1.
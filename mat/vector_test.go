@@ -452,6 +452,73 @@ func TestVecDenseMulElem(t *testing.T) {
 	}
 }
 
+func TestVecDenseMulElemAdd(t *testing.T) {
+	t.Parallel()
+	for i, test := range []struct {
+		a, b, c Vector
+		want    *VecDense
+	}{
+		{
+			a:    NewVecDense(3, []float64{0, 1, 2}),
+			b:    NewVecDense(3, []float64{0, 2, 3}),
+			c:    NewVecDense(3, []float64{1, 1, -1}),
+			want: NewVecDense(3, []float64{1, 3, 5}),
+		},
+		{
+			a:    NewVecDense(3, []float64{0, 1, 2}),
+			b:    NewDense(3, 1, []float64{0, 2, 3}).ColView(0),
+			c:    NewDense(3, 1, []float64{1, 1, -1}).ColView(0),
+			want: NewVecDense(3, []float64{1, 3, 5}),
+		},
+		{
+			a:    NewDense(3, 2, []float64{0, 9, 1, 9, 2, 9}).ColView(0),
+			b:    NewDense(3, 1, []float64{0, 2, 3}).ColView(0),
+			c:    NewDense(1, 3, []float64{1, 1, -1}).RowView(0),
+			want: NewVecDense(3, []float64{1, 3, 5}),
+		},
+		{
+			a:    NewVecDense(3, []float64{0, 1, 2}),
+			b:    (*basicVector)(NewVecDense(3, []float64{0, 2, 3})),
+			c:    NewVecDense(3, []float64{1, 1, -1}),
+			want: NewVecDense(3, []float64{1, 3, 5}),
+		},
+	} {
+		var v VecDense
+		v.MulElemAddVec(test.a, test.b, test.c)
+		if !Equal(&v, test.want) {
+			t.Errorf("unexpected result for test %d: got: %v want: %v", i, v.RawVector(), test.want.RawVector())
+		}
+	}
+
+	a := NewVecDense(3, []float64{0, 1, 2})
+	a.MulElemAddVec(a, a, a)
+	if want := NewVecDense(3, []float64{0, 2, 6}); !Equal(a, want) {
+		t.Errorf("unexpected result for in-place MulElemAddVec: got: %v want: %v", a.RawVector(), want.RawVector())
+	}
+	if p, _ := panics(func() { a.MulElemAddVec(a, a, NewVecDense(2, nil)) }); !p {
+		t.Error("expected panic for mismatched lengths")
+	}
+}
+
+func TestVecDenseClip(t *testing.T) {
+	t.Parallel()
+	for i, a := range []Vector{
+		NewVecDense(5, []float64{-2, -0.5, 0, 0.5, 2}),
+		NewDense(5, 2, []float64{-2, 9, -0.5, 9, 0, 9, 0.5, 9, 2, 9}).ColView(0),
+		(*basicVector)(NewVecDense(5, []float64{-2, -0.5, 0, 0.5, 2})),
+	} {
+		var v VecDense
+		v.ClipVec(-1, 1, a)
+		want := NewVecDense(5, []float64{-1, -0.5, 0, 0.5, 1})
+		if !Equal(&v, want) {
+			t.Errorf("unexpected result for test %d: got: %v want: %v", i, v.RawVector(), want.RawVector())
+		}
+	}
+	if p, _ := panics(func() { new(VecDense).ClipVec(1, -1, NewVecDense(1, nil)) }); !p {
+		t.Error("expected panic for lower bound greater than upper bound")
+	}
+}
+
 func TestVecDenseDivElem(t *testing.T) {
 	t.Parallel()
 	for i, test := range []struct {
@@ -0,0 +1,234 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+var _ Matrix = (*IndexView)(nil)
+
+// IndexView is a view of a selection of the rows and columns of a matrix.
+// The selected rows and columns need not be contiguous, ordered or distinct.
+// The elements of an IndexView are obtained from the viewed matrix when they
+// are accessed, so no elements are copied and changes to the viewed matrix
+// are reflected in the view.
+//
+// An IndexView may be materialized into a Dense using GatherTo or DenseCopyOf.
+type IndexView struct {
+	m Matrix
+
+	// rows and cols hold the selected rows and
+	// columns. A nil slice selects all of them.
+	rows, cols []int
+}
+
+// NewIndexView returns a view of the elements of a in the given rows and
+// columns, so that element (i, j) of the view is a.At(rows[i], cols[j]). If
+// rows or cols is nil, all rows or all columns of a are selected in order.
+// The index slices are retained by the view and must not be modified while
+// it is in use.
+//
+// NewIndexView will panic if rows or cols is non-nil and empty or if any
+// index is out of range for a.
+func NewIndexView(a Matrix, rows, cols []int) *IndexView {
+	r, c := a.Dims()
+	checkIndices(rows, r, ErrRowAccess)
+	checkIndices(cols, c, ErrColAccess)
+	return &IndexView{m: a, rows: rows, cols: cols}
+}
+
+// RowsView returns a view of the given rows of a. It is equivalent to
+// NewIndexView(a, rows, nil).
+func RowsView(a Matrix, rows []int) *IndexView {
+	return NewIndexView(a, rows, nil)
+}
+
+// ColsView returns a view of the given columns of a. It is equivalent to
+// NewIndexView(a, nil, cols).
+func ColsView(a Matrix, cols []int) *IndexView {
+	return NewIndexView(a, nil, cols)
+}
+
+// StridedView returns a view of every rstep-th row of a starting from row r0
+// and every cstep-th column of a starting from column c0. A contiguous block
+// of a may be selected before striding using the Slice method of a.
+//
+// StridedView will panic if r0 or c0 is out of range for a or if rstep or
+// cstep is not positive.
+func StridedView(a Matrix, r0, rstep, c0, cstep int) *IndexView {
+	if rstep < 1 || cstep < 1 {
+		panic(ErrIllegalStride)
+	}
+	r, c := a.Dims()
+	if r0 < 0 || r <= r0 {
+		panic(ErrRowAccess)
+	}
+	if c0 < 0 || c <= c0 {
+		panic(ErrColAccess)
+	}
+	return &IndexView{
+		m:    a,
+		rows: stridedIndices(r0, r, rstep),
+		cols: stridedIndices(c0, c, cstep),
+	}
+}
+
+// stridedIndices returns the indices from start to less than
+// end in steps of step. It returns nil if the indices are all
+// of those in [0, end).
+func stridedIndices(start, end, step int) []int {
+	if start == 0 && step == 1 {
+		return nil
+	}
+	idx := make([]int, 0, (end-start+step-1)/step)
+	for i := start; i < end; i += step {
+		idx = append(idx, i)
+	}
+	return idx
+}
+
+// checkIndices panics if idx is non-nil and empty or if any
+// element of idx is outside [0, n).
+func checkIndices(idx []int, n int, access Error) {
+	if idx == nil {
+		return
+	}
+	if len(idx) == 0 {
+		panic(ErrZeroLength)
+	}
+	for _, v := range idx {
+		if v < 0 || n <= v {
+			panic(access)
+		}
+	}
+}
+
+// Dims returns the number of selected rows and columns.
+func (v *IndexView) Dims() (r, c int) {
+	r, c = v.m.Dims()
+	if v.rows != nil {
+		r = len(v.rows)
+	}
+	if v.cols != nil {
+		c = len(v.cols)
+	}
+	return r, c
+}
+
+// At returns the element at row i and column j of the view.
+func (v *IndexView) At(i, j int) float64 {
+	r, c := v.Dims()
+	if i < 0 || r <= i {
+		panic(ErrRowAccess)
+	}
+	if j < 0 || c <= j {
+		panic(ErrColAccess)
+	}
+	return v.m.At(index(v.rows, i), index(v.cols, j))
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose.
+func (v *IndexView) T() Matrix {
+	return Transpose{v}
+}
+
+// index returns the i-th selected index of idx.
+func index(idx []int, i int) int {
+	if idx == nil {
+		return i
+	}
+	return idx[i]
+}
+
+// GatherTo places the elements of a in the given rows and columns into dst,
+// so that dst is a copy of NewIndexView(a, rows, cols). If rows or cols is
+// nil, all rows or all columns of a are gathered in order. The rows and
+// columns need not be ordered or distinct.
+//
+// GatherTo will panic if rows or cols is non-nil and empty, if any index is
+// out of range for a, or if dst is not empty and does not have the dimensions
+// of the gathered matrix.
+func GatherTo(dst *Dense, a Matrix, rows, cols []int) {
+	v := NewIndexView(a, rows, cols)
+	r, c := v.Dims()
+	dst.reuseAsNonZeroed(r, c)
+
+	aU, aTrans := untransposeExtract(a)
+	if dst == aU {
+		var restore func()
+		dst, restore = dst.isolatedWorkspace(v)
+		defer restore()
+	} else {
+		dst.checkOverlapMatrix(aU)
+	}
+
+	if ad, ok := a.(*Dense); ok && !aTrans {
+		amat := ad.mat
+		for i := 0; i < r; i++ {
+			src := amat.Data[index(rows, i)*amat.Stride:]
+			row := dst.rawRowView(i)
+			if cols == nil {
+				copy(row, src[:c])
+				continue
+			}
+			for j, k := range cols {
+				row[j] = src[k]
+			}
+		}
+		return
+	}
+	for i := 0; i < r; i++ {
+		row := dst.rawRowView(i)
+		for j := range row {
+			row[j] = a.At(index(rows, i), index(cols, j))
+		}
+	}
+}
+
+// ScatterTo places the elements of a into the given rows and columns of dst,
+// so that element (rows[i], cols[j]) of dst is set to a.At(i, j). ScatterTo
+// is the inverse of GatherTo. If rows or cols is nil, all rows or all columns
+// of dst are written in order. If an index is repeated, the value written
+// last, in row-major order of a, is retained.
+//
+// ScatterTo will panic if rows or cols is non-nil and empty, if any index is
+// out of range for dst, or if the dimensions of a do not match the number of
+// selected rows and columns.
+func ScatterTo(dst Mutable, a Matrix, rows, cols []int) {
+	v := NewIndexView(dst, rows, cols)
+	r, c := v.Dims()
+	ar, ac := a.Dims()
+	if ar != r || ac != c {
+		panic(ErrShape)
+	}
+
+	if dd, ok := dst.(*Dense); ok {
+		aU, _ := untransposeExtract(a)
+		if dd == aU {
+			// Scattering may overwrite elements of a
+			// before they are read.
+			a = DenseCopyOf(a)
+		} else {
+			dd.checkOverlapMatrix(aU)
+		}
+		if ad, ok := a.(*Dense); ok {
+			for i := 0; i < r; i++ {
+				src := ad.rawRowView(i)
+				row := dd.mat.Data[index(rows, i)*dd.mat.Stride:]
+				if cols == nil {
+					copy(row[:c], src)
+					continue
+				}
+				for j, k := range cols {
+					row[k] = src[j]
+				}
+			}
+			return
+		}
+	}
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			dst.Set(index(rows, i), index(cols, j), a.At(i, j))
+		}
+	}
+}
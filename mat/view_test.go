@@ -0,0 +1,163 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func TestIndexView(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	a := NewDense(5, 4, randSlice(20, rnd))
+	for _, test := range []struct {
+		name       string
+		view       *IndexView
+		src        Matrix
+		rows, cols []int
+	}{
+		{name: "all", view: NewIndexView(a, nil, nil), src: a, rows: []int{0, 1, 2, 3, 4}, cols: []int{0, 1, 2, 3}},
+		{name: "rows", view: RowsView(a, []int{4, 0, 0, 2}), src: a, rows: []int{4, 0, 0, 2}, cols: []int{0, 1, 2, 3}},
+		{name: "cols", view: ColsView(a, []int{3, 1}), src: a, rows: []int{0, 1, 2, 3, 4}, cols: []int{3, 1}},
+		{name: "both", view: NewIndexView(a.T(), []int{1, 2}, []int{4, 3, 4}), src: a.T(), rows: []int{1, 2}, cols: []int{4, 3, 4}},
+		{name: "strided", view: StridedView(a, 1, 2, 0, 3), src: a, rows: []int{1, 3}, cols: []int{0, 3}},
+		{name: "strided unit", view: StridedView(a, 0, 1, 2, 1), src: a, rows: []int{0, 1, 2, 3, 4}, cols: []int{2, 3}},
+		{name: "strided slice", view: StridedView(a.Slice(1, 5, 1, 4), 0, 3, 1, 5), src: a, rows: []int{1, 4}, cols: []int{2}},
+	} {
+		r, c := test.view.Dims()
+		if r != len(test.rows) || c != len(test.cols) {
+			t.Errorf("%s: unexpected dimensions: got:%d×%d want:%d×%d", test.name, r, c, len(test.rows), len(test.cols))
+			continue
+		}
+		want := NewDense(r, c, nil)
+		for i, ri := range test.rows {
+			for j, cj := range test.cols {
+				want.Set(i, j, test.src.At(ri, cj))
+			}
+		}
+		if !Equal(test.view, want) {
+			t.Errorf("%s: unexpected view:\ngot: %v\nwant:%v", test.name, Formatted(test.view), Formatted(want))
+		}
+		if !Equal(test.view.T(), want.T()) {
+			t.Errorf("%s: unexpected transpose of view", test.name)
+		}
+
+		got := DenseCopyOf(test.view)
+		if !Equal(got, want) {
+			t.Errorf("%s: unexpected copy of view", test.name)
+		}
+	}
+
+	// Views reflect changes to the viewed matrix.
+	b := DenseCopyOf(a)
+	v := RowsView(b, []int{2})
+	b.Set(2, 1, 42)
+	if v.At(0, 1) != 42 {
+		t.Errorf("view does not reflect change to viewed matrix")
+	}
+
+	for _, test := range []struct {
+		name string
+		fn   func()
+		want error
+	}{
+		{name: "empty rows", fn: func() { RowsView(a, []int{}) }, want: ErrZeroLength},
+		{name: "bad row", fn: func() { RowsView(a, []int{5}) }, want: ErrRowAccess},
+		{name: "bad col", fn: func() { ColsView(a, []int{-1}) }, want: ErrColAccess},
+		{name: "bad stride", fn: func() { StridedView(a, 0, 0, 0, 1) }, want: ErrIllegalStride},
+		{name: "bad start", fn: func() { StridedView(a, 0, 1, 4, 1) }, want: ErrColAccess},
+		{name: "bad access", fn: func() { RowsView(a, []int{1}).At(1, 0) }, want: ErrRowAccess},
+	} {
+		panicked, message := panics(test.fn)
+		if !panicked || message != test.want.Error() {
+			t.Errorf("%s: unexpected panic: got:%q want:%q", test.name, message, test.want)
+		}
+	}
+}
+
+func TestGatherScatter(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	a := NewDense(6, 5, randSlice(30, rnd))
+	for _, test := range []struct {
+		rows, cols []int
+	}{
+		{rows: nil, cols: nil},
+		{rows: []int{5, 1, 3}, cols: nil},
+		{rows: nil, cols: []int{4, 0}},
+		{rows: []int{0, 2, 4}, cols: []int{1, 3, 2}},
+		{rows: []int{1, 1}, cols: []int{2}},
+	} {
+		view := NewIndexView(a, test.rows, test.cols)
+		for _, src := range []Matrix{a, asBasicMatrix(a)} {
+			var got Dense
+			GatherTo(&got, src, test.rows, test.cols)
+			if !Equal(&got, view) {
+				t.Errorf("unexpected gather for rows %v cols %v from %T", test.rows, test.cols, src)
+			}
+		}
+		var gotT Dense
+		GatherTo(&gotT, a.T(), test.cols, test.rows)
+		if !Equal(&gotT, view.T()) {
+			t.Errorf("unexpected gather of transpose for rows %v cols %v", test.rows, test.cols)
+		}
+
+		// Scattering a gathered matrix into a zero matrix
+		// places the gathered elements at their origin.
+		var g Dense
+		GatherTo(&g, a, test.rows, test.cols)
+		for _, dst := range []Mutable{NewDense(6, 5, nil), struct{ Mutable }{NewDense(6, 5, nil)}} {
+			ScatterTo(dst, &g, test.rows, test.cols)
+			r, c := a.Dims()
+			for i := 0; i < r; i++ {
+				for j := 0; j < c; j++ {
+					want := 0.0
+					if contains(test.rows, i) && contains(test.cols, j) {
+						want = a.At(i, j)
+					}
+					if got := dst.At(i, j); got != want {
+						t.Errorf("unexpected scatter into %T at (%d, %d) for rows %v cols %v: got:%v want:%v",
+							dst, i, j, test.rows, test.cols, got, want)
+					}
+				}
+			}
+		}
+	}
+
+	// Gather and scatter within a single matrix.
+	b := DenseCopyOf(a)
+	GatherTo(b, b, []int{5, 4, 3, 2, 1, 0}, nil)
+	var want Dense
+	GatherTo(&want, a, []int{5, 4, 3, 2, 1, 0}, nil)
+	if !Equal(b, &want) {
+		t.Errorf("unexpected in-place gather")
+	}
+	sq := a.Slice(0, 5, 0, 5)
+	b = DenseCopyOf(sq)
+	ScatterTo(b, b.T(), nil, nil)
+	if !Equal(b, sq.T()) {
+		t.Errorf("unexpected in-place scatter of transpose")
+	}
+
+	if p, _ := panics(func() { ScatterTo(NewDense(3, 3, nil), a, []int{0, 1}, nil) }); !p {
+		t.Error("expected panic for mismatched dimensions")
+	}
+	if p, _ := panics(func() { GatherTo(NewDense(2, 2, nil), a, []int{0, 1}, nil) }); !p {
+		t.Error("expected panic for mismatched destination")
+	}
+}
+
+func contains(idx []int, v int) bool {
+	if idx == nil {
+		return true
+	}
+	for _, i := range idx {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,548 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// BesselJ returns the Bessel function of the first kind of real order ν,
+// J_ν(x). J_ν is a solution of Bessel's equation
+//
+//	x² y′′ + x y′ + (x² - ν²) y = 0
+//
+// that is regular at the origin.
+//
+// For x < 0, BesselJ returns (-1)^ν J_ν(-x) if ν is an integer and NaN
+// otherwise. The time taken to compute J_ν(x) grows linearly with x, and
+// the absolute error grows slowly with x.
+//
+// See http://mathworld.wolfram.com/BesselFunctionoftheFirstKind.html
+// for more detailed information.
+func BesselJ(nu, x float64) float64 {
+	switch {
+	case math.IsNaN(nu) || math.IsNaN(x):
+		return math.NaN()
+	case x < 0:
+		if nu != math.Trunc(nu) {
+			return math.NaN()
+		}
+		return intParity(nu) * BesselJ(nu, -x)
+	case nu < 0:
+		if nu == math.Trunc(nu) {
+			// J_{-n}(x) = (-1)^n J_n(x).
+			return intParity(nu) * BesselJ(-nu, x)
+		}
+		if x == 0 {
+			return math.NaN()
+		}
+		j, y := besselJY(-nu, x)
+		s, c := math.Sincos(-nu * math.Pi)
+		return c*j - s*y
+	case x == 0:
+		if nu == 0 {
+			return 1
+		}
+		return 0
+	case math.IsInf(x, 1):
+		return 0
+	}
+	j, _ := besselJY(nu, x)
+	return j
+}
+
+// BesselY returns the Bessel function of the second kind of real order ν,
+// Y_ν(x), also known as the Neumann function. Y_ν is a solution of Bessel's
+// equation that is singular at the origin.
+//
+// BesselY returns NaN for x < 0 and -Inf for x == 0. The time taken to
+// compute Y_ν(x) grows linearly with x, and the absolute error grows
+// slowly with x.
+//
+// See http://mathworld.wolfram.com/BesselFunctionoftheSecondKind.html
+// for more detailed information.
+func BesselY(nu, x float64) float64 {
+	switch {
+	case math.IsNaN(nu) || math.IsNaN(x) || x < 0:
+		return math.NaN()
+	case x == 0:
+		return math.Inf(-1)
+	case math.IsInf(x, 1):
+		return 0
+	case nu < 0:
+		if nu == math.Trunc(nu) {
+			// Y_{-n}(x) = (-1)^n Y_n(x).
+			return intParity(nu) * BesselY(-nu, x)
+		}
+		j, y := besselJY(-nu, x)
+		s, c := math.Sincos(-nu * math.Pi)
+		return s*j + c*y
+	}
+	_, y := besselJY(nu, x)
+	return y
+}
+
+// BesselI returns the modified Bessel function of the first kind of real
+// order ν, I_ν(x). I_ν is a solution of the modified Bessel equation
+//
+//	x² y′′ + x y′ - (x² + ν²) y = 0
+//
+// that is regular at the origin.
+//
+// For x < 0, BesselI returns (-1)^ν I_ν(-x) if ν is an integer and NaN
+// otherwise.
+//
+// See http://mathworld.wolfram.com/ModifiedBesselFunctionoftheFirstKind.html
+// for more detailed information.
+func BesselI(nu, x float64) float64 {
+	switch {
+	case math.IsNaN(nu) || math.IsNaN(x):
+		return math.NaN()
+	case x < 0:
+		if nu != math.Trunc(nu) {
+			return math.NaN()
+		}
+		return intParity(nu) * BesselI(nu, -x)
+	case nu < 0:
+		if nu == math.Trunc(nu) {
+			// I_{-n}(x) = I_n(x).
+			return BesselI(-nu, x)
+		}
+		if x == 0 {
+			return math.NaN()
+		}
+		i, k := besselIK(-nu, x)
+		return i + 2/math.Pi*math.Sin(-nu*math.Pi)*k
+	case x == 0:
+		if nu == 0 {
+			return 1
+		}
+		return 0
+	case math.IsInf(x, 1):
+		return math.Inf(1)
+	}
+	i, _ := besselIK(nu, x)
+	return i
+}
+
+// BesselK returns the modified Bessel function of the second kind of real
+// order ν, K_ν(x). K_ν is the solution of the modified Bessel equation that
+// decays exponentially for large x.
+//
+// BesselK returns NaN for x < 0 and +Inf for x == 0.
+//
+// See http://mathworld.wolfram.com/ModifiedBesselFunctionoftheSecondKind.html
+// for more detailed information.
+func BesselK(nu, x float64) float64 {
+	switch {
+	case math.IsNaN(nu) || math.IsNaN(x) || x < 0:
+		return math.NaN()
+	case x == 0:
+		return math.Inf(1)
+	case math.IsInf(x, 1):
+		return 0
+	}
+	// K_{-ν}(x) = K_ν(x).
+	_, k := besselIK(math.Abs(nu), x)
+	return k
+}
+
+// intParity returns (-1)^n for the integer-valued n.
+func intParity(n float64) float64 {
+	if math.Mod(n, 2) == 0 {
+		return 1
+	}
+	return -1
+}
+
+const (
+	besselEps     = 1e-16
+	besselTiny    = 1e-300
+	besselBig     = 1e250
+	besselMaxIter = 1 << 20
+)
+
+// besselJY returns J_ν(x) and Y_ν(x) for ν >= 0 and x > 0 using Steed's
+// method. The ratio J_ν′/J_ν is found by a continued fraction and used to
+// recur J downward to an order μ with |μ| <= 1/2 where Y_μ and Y_μ₊₁ are
+// found by Temme's series for small x or by a complex continued fraction
+// for large x. J is normalized using the Wronskian and Y is recurred upward.
+//
+// See Barnett, Feng, Steed and Goldfarb, "Coulomb wave functions for all
+// real η and ρ", Computer Physics Communications 8:377-395.
+// doi:10.1016/0010-4655(74)90013-7 and Temme, "On the numerical evaluation
+// of the ordinary Bessel function of the second kind", Journal of
+// Computational Physics 21:343-350. doi:10.1016/0021-9991(76)90032-2.
+func besselJY(nu, x float64) (j, y float64) {
+	var nl int
+	if x < 2 {
+		nl = int(nu + 0.5)
+	} else {
+		nl = max(0, int(nu-x+1.5))
+	}
+	mu := nu - float64(nl)
+
+	// Find f = J_ν′/J_ν by the continued fraction
+	//  J_ν/J_{ν-1} = 1/(2ν/x - 1/(2(ν+1)/x - ...)),
+	// tracking the sign of J_ν.
+	sign := 1.0
+	f, sgn := besselCF1(nu, x, -1)
+	if sgn < 0 {
+		sign = -1
+	}
+
+	// Recur downward from ν to μ starting from
+	// an arbitrary value with the correct sign.
+	jl := sign * besselTiny
+	jpl := f * jl
+	jnu := jl
+	for l := nl; l >= 1; l-- {
+		k := mu + float64(l)
+		jm1 := k/x*jl + jpl
+		jpl = (k-1)/x*jm1 - jl
+		jl = jm1
+		if math.Abs(jl) > besselBig {
+			jl /= besselBig
+			jpl /= besselBig
+			jnu /= besselBig
+		}
+	}
+	if jl == 0 {
+		jl = besselEps
+	}
+	fmu := jpl / jl
+
+	w := 2 / (math.Pi * x)
+	var jmu, ymu, ymu1 float64
+	if x < 2 {
+		ymu, ymu1 = temmeY(mu, x)
+		ympmu := mu/x*ymu - ymu1
+		jmu = w / (ympmu - fmu*ymu)
+	} else {
+		pq := besselCF2(mu, x)
+		p, q := real(pq), imag(pq)
+		gam := (p - fmu) / q
+		jmu = math.Sqrt(w / ((p-fmu)*gam + q))
+		jmu = math.Copysign(jmu, jl)
+		ymu = gam * jmu
+		ympmu := ymu * (p + q/gam)
+		ymu1 = mu/x*ymu - ympmu
+	}
+	j = jmu * (jnu / jl)
+
+	// Recur Y upward from μ to ν.
+	for l := 1; l <= nl; l++ {
+		ymu, ymu1 = ymu1, 2*(mu+float64(l))/x*ymu1-ymu
+	}
+	return j, ymu
+}
+
+// besselCF1 returns ν/x + s K where K is the continued fraction
+//
+//	K = 1/(2(ν+1)/x + s/(2(ν+2)/x + s/(2(ν+3)/x + ...)))
+//
+// evaluated by the modified Lentz method, together with the sign of the
+// product of the denominators. For s = -1 the result is J_ν′/J_ν and the
+// sign is the sign of J_ν relative to the starting value, and for s = 1 the
+// result is I_ν′/I_ν.
+func besselCF1(nu, x, s float64) (f, sign float64) {
+	xi := 1 / x
+	f = nu * xi
+	if f == 0 {
+		f = besselTiny
+	}
+	b := 2 * nu * xi
+	c := f
+	d := 0.0
+	sign = 1
+	for i := 0; i < besselMaxIter; i++ {
+		b += 2 * xi
+		d = b + s*d
+		if d == 0 {
+			d = besselTiny
+		}
+		c = b + s/c
+		if c == 0 {
+			c = besselTiny
+		}
+		d = 1 / d
+		del := c * d
+		f *= del
+		if d < 0 {
+			sign = -sign
+		}
+		if math.Abs(del-1) < besselEps {
+			return f, sign
+		}
+	}
+	return math.NaN(), sign
+}
+
+// besselCF2 returns p + iq = (J_μ′ + iY_μ′)/(J_μ + iY_μ) for x >= 2
+// using the continued fraction
+//
+//	p + iq = -1/(2x) + i + (i/x) a_1/(b_1 + a_2/(b_2 + ...))
+//
+// with a_k = (k-1/2)² - μ² and b_k = 2(x + ki).
+func besselCF2(mu, x float64) complex128 {
+	f := complex(besselTiny, 0)
+	c := f
+	var d complex128
+	for k := 1; k < besselMaxIter; k++ {
+		h := float64(k) - 0.5
+		a := complex(h*h-mu*mu, 0)
+		b := complex(2*x, 2*float64(k))
+		d = b + a*d
+		if d == 0 {
+			d = complex(besselTiny, 0)
+		}
+		c = b + a/c
+		if c == 0 {
+			c = complex(besselTiny, 0)
+		}
+		d = 1 / d
+		del := c * d
+		f *= del
+		if cmplx.Abs(del-1) < besselEps {
+			break
+		}
+	}
+	return complex(-0.5/x, 1) + complex(0, 1/x)*f
+}
+
+// temmeY returns Y_μ(x) and Y_μ₊₁(x) for |μ| <= 1/2 and x < 2 using
+// Temme's series.
+func temmeY(mu, x float64) (ymu, ymu1 float64) {
+	g1, g2, gampl, gammi := temmeGamma(mu)
+	d := -math.Log(x / 2)
+	e := mu * d
+	pimu := math.Pi * mu
+	fact := 1.0
+	if math.Abs(pimu) >= besselEps {
+		fact = pimu / math.Sin(pimu)
+	}
+	fact2 := 1.0
+	if math.Abs(e) >= besselEps {
+		fact2 = math.Sinh(e) / e
+	}
+	ff := 2 / math.Pi * fact * (g1*math.Cosh(e) + g2*fact2*d)
+	e = math.Exp(e)
+	p := e / (gampl * math.Pi)
+	q := 1 / (e * math.Pi * gammi)
+
+	// r = (2/μ) sin²(πμ/2).
+	pimu2 := pimu / 2
+	fact3 := 1.0
+	if math.Abs(pimu2) >= besselEps {
+		fact3 = math.Sin(pimu2) / pimu2
+	}
+	r := math.Pi * pimu2 * fact3 * fact3
+
+	c := 1.0
+	dd := -x * x / 4
+	sum := ff + r*q
+	sum1 := p
+	for i := 1; i < besselMaxIter; i++ {
+		fi := float64(i)
+		ff = (fi*ff + p + q) / (fi*fi - mu*mu)
+		c *= dd / fi
+		p /= fi - mu
+		q /= fi + mu
+		del := c * (ff + r*q)
+		sum += del
+		sum1 += c*p - fi*del
+		if math.Abs(del) < (1+math.Abs(sum))*besselEps {
+			break
+		}
+	}
+	return -sum, -sum1 * 2 / x
+}
+
+// besselIK returns I_ν(x) and K_ν(x) for ν >= 0 and x > 0. The ratio
+// I_ν′/I_ν is found by a continued fraction and used to recur I downward
+// to an order μ with |μ| <= 1/2 where K_μ and K_μ₊₁ are found by Temme's
+// series for small x or Steed's continued fraction for large x. I is
+// normalized using the Wronskian and K is recurred upward.
+//
+// See Temme, "On the numerical evaluation of the modified Bessel function
+// of the third kind", Journal of Computational Physics 19:324-337.
+// doi:10.1016/0021-9991(75)90082-0.
+func besselIK(nu, x float64) (i, k float64) {
+	nl := int(nu + 0.5)
+	mu := nu - float64(nl)
+
+	f, _ := besselCF1(nu, x, 1)
+	il := besselTiny
+	ipl := f * il
+	inu := il
+	for l := nl; l >= 1; l-- {
+		m := mu + float64(l)
+		im1 := m/x*il + ipl
+		ipl = (m-1)/x*im1 + il
+		il = im1
+		if il > besselBig {
+			il /= besselBig
+			ipl /= besselBig
+			inu /= besselBig
+		}
+	}
+	fmu := ipl / il
+
+	var kmu, kmu1 float64
+	if x < 2 {
+		kmu, kmu1 = temmeK(mu, x)
+	} else {
+		kmu, kmu1 = steedK(mu, x)
+	}
+	kpmu := mu/x*kmu - kmu1
+	imu := 1 / x / (fmu*kmu - kpmu)
+	i = imu * (inu / il)
+
+	// Recur K upward from μ to ν.
+	for l := 1; l <= nl; l++ {
+		kmu, kmu1 = kmu1, 2*(mu+float64(l))/x*kmu1+kmu
+	}
+	return i, kmu
+}
+
+// temmeK returns K_μ(x) and K_μ₊₁(x) for |μ| <= 1/2 and x < 2 using
+// Temme's series.
+func temmeK(mu, x float64) (kmu, kmu1 float64) {
+	g1, g2, gampl, gammi := temmeGamma(mu)
+	d := -math.Log(x / 2)
+	e := mu * d
+	pimu := math.Pi * mu
+	fact := 1.0
+	if math.Abs(pimu) >= besselEps {
+		fact = pimu / math.Sin(pimu)
+	}
+	fact2 := 1.0
+	if math.Abs(e) >= besselEps {
+		fact2 = math.Sinh(e) / e
+	}
+	ff := fact * (g1*math.Cosh(e) + g2*fact2*d)
+	e = math.Exp(e)
+	p := 0.5 * e / gampl
+	q := 0.5 / (e * gammi)
+
+	c := 1.0
+	dd := x * x / 4
+	sum := ff
+	sum1 := p
+	for i := 1; i < besselMaxIter; i++ {
+		fi := float64(i)
+		ff = (fi*ff + p + q) / (fi*fi - mu*mu)
+		c *= dd / fi
+		p /= fi - mu
+		q /= fi + mu
+		del := c * ff
+		sum += del
+		sum1 += c * (p - fi*ff)
+		if math.Abs(del) < math.Abs(sum)*besselEps {
+			break
+		}
+	}
+	return sum, sum1 * 2 / x
+}
+
+// steedK returns K_μ(x) and K_μ₊₁(x) for |μ| <= 1/2 and x >= 2 using
+// Steed's continued fraction as formulated by Temme, summing the series
+// for the normalization of K alongside the continued fraction.
+//
+// See Thompson and Barnett, "Modified Bessel functions I_ν(z) and K_ν(z)
+// of real order and complex argument, to selected accuracy", Computer
+// Physics Communications 47:245-257. doi:10.1016/0010-4655(87)90111-1.
+func steedK(mu, x float64) (kmu, kmu1 float64) {
+	b := 2 * (1 + x)
+	d := 1 / b
+	delh := d
+	h := d
+	q1, q2 := 0.0, 1.0
+	a1 := 0.25 - mu*mu
+	c := a1
+	q := a1
+	a := -a1
+	s := 1 + q*delh
+	for i := 2; i < besselMaxIter; i++ {
+		fi := float64(i)
+		a -= 2 * (fi - 1)
+		c = -a * c / fi
+		qnew := (q1 - b*q2) / a
+		q1, q2 = q2, qnew
+		q += c * qnew
+		b += 2
+		d = 1 / (b + a*d)
+		delh *= b*d - 1
+		h += delh
+		dels := q * delh
+		s += dels
+		if math.Abs(dels/s) < besselEps {
+			break
+		}
+	}
+	h *= a1
+	kmu = math.Sqrt(math.Pi/(2*x)) * math.Exp(-x) / s
+	kmu1 = kmu * (mu + x + 0.5 - h) / x
+	return kmu, kmu1
+}
+
+// temmeGamma returns, for |μ| <= 1/2,
+//
+//	g1 = (1/Γ(1-μ) - 1/Γ(1+μ))/(2μ),
+//	g2 = (1/Γ(1-μ) + 1/Γ(1+μ))/2,
+//
+// and 1/Γ(1+μ) and 1/Γ(1-μ), using the Taylor series of 1/Γ(1+z) to
+// avoid cancellation in g1.
+func temmeGamma(mu float64) (g1, g2, gampl, gammi float64) {
+	// Coefficients of the Taylor series of 1/Γ(z) from
+	// Abramowitz and Stegun 6.1.34, so that
+	//  1/Γ(1+z) = \sum_{k=0}^∞ recipGammaCoef[k+1] z^k.
+	// The series for odd and even powers give g1 and g2.
+	mu2 := mu * mu
+	var odd, even float64
+	for k := len(recipGammaCoef) - 1; k >= 1; k-- {
+		if k%2 == 1 {
+			even = even*mu2 + recipGammaCoef[k]
+		} else {
+			odd = odd*mu2 + recipGammaCoef[k]
+		}
+	}
+	g1 = -odd
+	g2 = even
+	return g1, g2, g2 - mu*g1, g2 + mu*g1
+}
+
+// recipGammaCoef holds the coefficients of the Taylor series of 1/Γ(z)
+// about zero, with recipGammaCoef[k] the coefficient of z^k.
+var recipGammaCoef = [...]float64{
+	0,
+	1.0000000000000000,
+	0.5772156649015329,
+	-0.6558780715202538,
+	-0.0420026350340952,
+	0.1665386113822915,
+	-0.0421977345555443,
+	-0.0096219715278770,
+	0.0072189432466630,
+	-0.0011651675918591,
+	-0.0002152416741149,
+	0.0001280502823882,
+	-0.0000201348547807,
+	-0.0000012504934821,
+	0.0000011330272320,
+	-0.0000002056338417,
+	0.0000000061160950,
+	0.0000000050020075,
+	-0.0000000011812746,
+	0.0000000001043427,
+	0.0000000000077823,
+	-0.0000000000036968,
+	0.0000000000005100,
+	-0.0000000000000206,
+	-0.0000000000000054,
+	0.0000000000000014,
+	0.0000000000000001,
+}
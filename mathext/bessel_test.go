@@ -0,0 +1,155 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+var besselX = []float64{1e-3, 0.1, 0.5, 1, 1.9, 2, 2.5, 5, 10, 25.5, 60, 150}
+
+func TestBesselJYInteger(t *testing.T) {
+	t.Parallel()
+	for n := 0; n <= 20; n++ {
+		for _, x := range besselX {
+			got := BesselJ(float64(n), x)
+			want := math.Jn(n, x)
+			if !scalar.EqualWithinAbsOrRel(got, want, 1e-13, 1e-11) {
+				t.Errorf("unexpected BesselJ(%d, %v): got:%v want:%v", n, x, got, want)
+			}
+			got = BesselY(float64(n), x)
+			want = math.Yn(n, x)
+			if !scalar.EqualWithinAbsOrRel(got, want, 1e-13, 1e-11) {
+				t.Errorf("unexpected BesselY(%d, %v): got:%v want:%v", n, x, got, want)
+			}
+			if n > 0 {
+				got = BesselJ(float64(-n), x)
+				want = math.Jn(-n, x)
+				if !scalar.EqualWithinAbsOrRel(got, want, 1e-13, 1e-11) {
+					t.Errorf("unexpected BesselJ(%d, %v): got:%v want:%v", -n, x, got, want)
+				}
+				got = BesselY(float64(-n), x)
+				want = math.Yn(-n, x)
+				if !scalar.EqualWithinAbsOrRel(got, want, 1e-13, 1e-11) {
+					t.Errorf("unexpected BesselY(%d, %v): got:%v want:%v", -n, x, got, want)
+				}
+			}
+			got = BesselJ(float64(n), -x)
+			want = math.Jn(n, -x)
+			if !scalar.EqualWithinAbsOrRel(got, want, 1e-13, 1e-11) {
+				t.Errorf("unexpected BesselJ(%d, %v): got:%v want:%v", n, -x, got, want)
+			}
+		}
+	}
+}
+
+func TestBesselHalfInteger(t *testing.T) {
+	t.Parallel()
+	for _, x := range besselX {
+		s, c := math.Sincos(x)
+		r := math.Sqrt(2 / (math.Pi * x))
+		for _, test := range []struct {
+			name string
+			fn   func(nu, x float64) float64
+			nu   float64
+			want float64
+		}{
+			{"J", BesselJ, 0.5, r * s},
+			{"J", BesselJ, -0.5, r * c},
+			{"J", BesselJ, 1.5, r * (s/x - c)},
+			{"J", BesselJ, -1.5, r * (-c/x - s)},
+			{"Y", BesselY, 0.5, -r * c},
+			{"Y", BesselY, -0.5, r * s},
+			{"Y", BesselY, 1.5, -r * (c/x + s)},
+			{"I", BesselI, 0.5, r * math.Sinh(x)},
+			{"I", BesselI, -0.5, r * math.Cosh(x)},
+			{"I", BesselI, 1.5, r * (math.Cosh(x) - math.Sinh(x)/x)},
+			{"K", BesselK, 0.5, math.Sqrt(math.Pi/(2*x)) * math.Exp(-x)},
+			{"K", BesselK, -0.5, math.Sqrt(math.Pi/(2*x)) * math.Exp(-x)},
+			{"K", BesselK, 1.5, math.Sqrt(math.Pi/(2*x)) * math.Exp(-x) * (1 + 1/x)},
+		} {
+			got := test.fn(test.nu, x)
+			if !scalar.EqualWithinAbsOrRel(got, test.want, 1e-13, 1e-11) {
+				t.Errorf("unexpected Bessel%s(%v, %v): got:%v want:%v", test.name, test.nu, x, got, test.want)
+			}
+		}
+	}
+}
+
+func TestBesselAiry(t *testing.T) {
+	t.Parallel()
+	// The Airy function is related to Bessel functions of order 1/3:
+	//  Ai(x) = 1/π sqrt(x/3) K_{1/3}(ζ),
+	//  Ai(-x) = sqrt(x)/3 (J_{1/3}(ζ) + J_{-1/3}(ζ)),
+	// where ζ = 2/3 x^{3/2}.
+	for _, x := range []float64{0.1, 0.5, 1, 2, 3.5, 6} {
+		zeta := 2.0 / 3 * math.Pow(x, 1.5)
+		got := 1 / math.Pi * math.Sqrt(x/3) * BesselK(1.0/3, zeta)
+		want := real(AiryAi(complex(x, 0)))
+		if !scalar.EqualWithinAbsOrRel(got, want, 1e-13, 1e-11) {
+			t.Errorf("unexpected Ai(%v) from BesselK: got:%v want:%v", x, got, want)
+		}
+		got = math.Sqrt(x) / 3 * (BesselJ(1.0/3, zeta) + BesselJ(-1.0/3, zeta))
+		want = real(AiryAi(complex(-x, 0)))
+		if !scalar.EqualWithinAbsOrRel(got, want, 1e-13, 1e-10) {
+			t.Errorf("unexpected Ai(%v) from BesselJ: got:%v want:%v", -x, got, want)
+		}
+	}
+}
+
+func TestBesselWronskian(t *testing.T) {
+	t.Parallel()
+	for _, nu := range []float64{0, 0.2, 0.7, 1.3, 4.9, 12.25, 30.6, -0.3, -2.7} {
+		for _, x := range besselX {
+			if nu < 0 && x < 0.1 {
+				// The Wronskians suffer from cancellation
+				// for negative orders at small x.
+				continue
+			}
+			// J_{ν+1}Y_ν - J_νY_{ν+1} = 2/(πx).
+			got := BesselJ(nu+1, x)*BesselY(nu, x) - BesselJ(nu, x)*BesselY(nu+1, x)
+			want := 2 / (math.Pi * x)
+			if !scalar.EqualWithinAbsOrRel(got, want, 1e-300, 1e-10) {
+				t.Errorf("unexpected J/Y Wronskian for ν=%v x=%v: got:%v want:%v", nu, x, got, want)
+			}
+			if x > 500 {
+				continue
+			}
+			// I_νK_{ν+1} + I_{ν+1}K_ν = 1/x.
+			got = BesselI(nu, x)*BesselK(nu+1, x) + BesselI(nu+1, x)*BesselK(nu, x)
+			want = 1 / x
+			if !scalar.EqualWithinAbsOrRel(got, want, 1e-300, 1e-10) {
+				t.Errorf("unexpected I/K Wronskian for ν=%v x=%v: got:%v want:%v", nu, x, got, want)
+			}
+		}
+	}
+}
+
+func TestBesselValues(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name        string
+		fn          func(nu, x float64) float64
+		nu, x, want float64
+	}{
+		// Values from Abramowitz and Stegun tables 9.8 and 9.11.
+		{"I", BesselI, 0, 1, 1.2660658777520082},
+		{"I", BesselI, 1, 1, 0.5651591039924851},
+		{"K", BesselK, 0, 1, 0.42102443824070834},
+		{"K", BesselK, 1, 1, 0.6019072301972346},
+		{"I", BesselI, 0, 10, 2815.716628466254},
+		{"K", BesselK, 0, 10, 1.778006231616917e-05},
+		{"I", BesselI, 2, 0.5, 0.031906149177738},
+		{"K", BesselK, 2, 0.5, 7.550183551240869},
+	} {
+		got := test.fn(test.nu, test.x)
+		if !scalar.EqualWithinAbsOrRel(got, test.want, 1e-300, 1e-12) {
+			t.Errorf("unexpected Bessel%s(%v, %v): got:%v want:%v", test.name, test.nu, test.x, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bigmath
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mathext"
+)
+
+const testPrec = 190
+
+func parse(t *testing.T, s string) *big.Float {
+	t.Helper()
+	f, _, err := big.ParseFloat(s, 10, testPrec+64, big.ToNearestEven)
+	if err != nil {
+		t.Fatalf("bad test value %q: %v", s, err)
+	}
+	return f
+}
+
+// closeRel reports whether got and want agree to within 2^-tol relative.
+func closeRel(got, want *big.Float, tol int) bool {
+	d := new(big.Float).SetPrec(testPrec+64).Sub(got, want)
+	if d.Sign() == 0 {
+		return true
+	}
+	return d.MantExp(nil) < want.MantExp(nil)-tol
+}
+
+func TestHighPrecision(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		f    func(z, x *big.Float) *big.Float
+		x    string
+		want string
+	}{
+		{name: "Exp", f: Exp, x: "1", want: "2.71828182845904523536028747135266249775724709369995957496696762772407663035354759"},
+		{name: "Exp", f: Exp, x: "-1e-30", want: "0.9999999999999999999999999999990000000000000000000000000000005"},
+		{name: "Log", f: Log, x: "2", want: "0.693147180559945309417232121458176568075500134360255254120680009493393621969694715605863"},
+		{name: "Log", f: Log, x: "1e-20", want: "-46.05170185988091368035982909368728415202202977257545952066655801935145219354704960471994"},
+		{name: "Erf", f: Erf, x: "1", want: "0.842700792949714869341220635082609259296066997966302908459937897834"},
+		{name: "Erf", f: Erf, x: "-1", want: "-0.842700792949714869341220635082609259296066997966302908459937897834"},
+		{name: "Erfc", f: Erfc, x: "-1", want: "1.842700792949714869341220635082609259296066997966302908459937897834"},
+		{name: "Gamma", f: Gamma, x: "0.5", want: "1.772453850905516027298167483341145182797549456122387128213807789852911284591"},
+		{name: "Gamma", f: Gamma, x: "5", want: "24"},
+		{name: "Lgamma", f: Lgamma, x: "100", want: "359.134205369575398776044010460"},
+	} {
+		x := parse(t, test.x)
+		want := parse(t, test.want)
+		tol := testPrec - 5
+		if len(test.want) < 40 {
+			tol = 90
+		}
+		got := test.f(new(big.Float).SetPrec(testPrec), x)
+		if !closeRel(got, want, tol) {
+			t.Errorf("unexpected %s(%s): got:%s want:%s", test.name, test.x, got.Text('g', 60), test.want)
+		}
+	}
+
+	got := pi(testPrec)
+	want := parse(t, "3.14159265358979323846264338327950288419716939937510582097494459230781640628620899")
+	if !closeRel(got, want, testPrec-5) {
+		t.Errorf("unexpected value of π: got:%s want:%s", got.Text('g', 60), want.Text('g', 60))
+	}
+}
+
+func TestLgammaNearOne(t *testing.T) {
+	t.Parallel()
+	// log Γ(1+ε) = -γε + π²ε²/12 + O(ε³).
+	eps := new(big.Float).SetMantExp(big.NewFloat(1), -100)
+	x := new(big.Float).SetPrec(128).Add(big.NewFloat(1), eps)
+	got := Lgamma(new(big.Float).SetPrec(128), x)
+	got.Quo(got, eps)
+	want := parse(t, "-0.57721566490153286060651209008240243104215933593992")
+	if !closeRel(got, want, 80) {
+		t.Errorf("unexpected Lgamma(1+2^-100)/2^-100: got:%s want:%s", got.Text('g', 30), want.Text('g', 30))
+	}
+}
+
+func TestComplement(t *testing.T) {
+	t.Parallel()
+	sum := new(big.Float).SetPrec(testPrec)
+	for _, test := range []struct{ a, b, x float64 }{
+		{a: 0.5, b: 2, x: 0.1},
+		{a: 3, b: 0.5, x: 0.9},
+		{a: 20, b: 30, x: 0.4},
+		{a: 1e-3, b: 1e3, x: 1e-4},
+	} {
+		a, b, x := big.NewFloat(test.a), big.NewFloat(test.b), big.NewFloat(test.x)
+		p := GammaIncReg(new(big.Float).SetPrec(testPrec), a, x)
+		q := GammaIncRegComp(new(big.Float).SetPrec(testPrec), a, x)
+		sum.Add(p, q)
+		if !closeRel(sum, big.NewFloat(1), testPrec-8) {
+			t.Errorf("GammaIncReg(%v,%v) + GammaIncRegComp(%v,%v) != 1: got:%s", test.a, test.x, test.a, test.x, sum.Text('g', 60))
+		}
+		p = RegIncBeta(new(big.Float).SetPrec(testPrec), a, b, x)
+		q = RegIncBetaComp(new(big.Float).SetPrec(testPrec), a, b, x)
+		sum.Add(p, q)
+		if !closeRel(sum, big.NewFloat(1), testPrec-8) {
+			t.Errorf("RegIncBeta(%v,%v,%v) + RegIncBetaComp(%v,%v,%v) != 1: got:%s", test.a, test.b, test.x, test.a, test.b, test.x, sum.Text('g', 60))
+		}
+	}
+}
+
+func TestFloat64Agreement(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	bf := func(f func(z, x *big.Float) *big.Float, x float64) float64 {
+		v, _ := f(new(big.Float), big.NewFloat(x)).Float64()
+		return v
+	}
+	for _, x := range []float64{-4, -0.5, 0, 1e-8, 0.3, 1, 2.5, 6, 12} {
+		if got, want := bf(Erf, x), math.Erf(x); !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+			t.Errorf("unexpected Erf(%v): got:%v want:%v", x, got, want)
+		}
+		if got, want := bf(Erfc, x), math.Erfc(x); !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+			t.Errorf("unexpected Erfc(%v): got:%v want:%v", x, got, want)
+		}
+		if got, want := LogErfc(x), math.Log(math.Erfc(x)); !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+			t.Errorf("unexpected LogErfc(%v): got:%v want:%v", x, got, want)
+		}
+	}
+	for _, x := range []float64{1e-3, 0.5, 1, 7.5, 40, 170.5} {
+		if got, want := bf(Gamma, x), math.Gamma(x); !scalar.EqualWithinRel(got, want, tol) {
+			t.Errorf("unexpected Gamma(%v): got:%v want:%v", x, got, want)
+		}
+		lg, _ := math.Lgamma(x)
+		if got := bf(Lgamma, x); !scalar.EqualWithinAbsOrRel(got, lg, tol, tol) {
+			t.Errorf("unexpected Lgamma(%v): got:%v want:%v", x, got, lg)
+		}
+	}
+	for _, test := range []struct{ a, x float64 }{
+		{a: 0.5, x: 0.2},
+		{a: 1, x: 1},
+		{a: 2.5, x: 4},
+		{a: 10, x: 3},
+		{a: 50, x: 60},
+	} {
+		p := mathext.GammaIncReg(test.a, test.x)
+		q := mathext.GammaIncRegComp(test.a, test.x)
+		if got := LogGammaIncReg(test.a, test.x); !scalar.EqualWithinAbsOrRel(got, math.Log(p), tol, tol) {
+			t.Errorf("unexpected LogGammaIncReg(%v,%v): got:%v want:%v", test.a, test.x, got, math.Log(p))
+		}
+		if got := LogGammaIncRegComp(test.a, test.x); !scalar.EqualWithinAbsOrRel(got, math.Log(q), tol, tol) {
+			t.Errorf("unexpected LogGammaIncRegComp(%v,%v): got:%v want:%v", test.a, test.x, got, math.Log(q))
+		}
+	}
+	for _, test := range []struct{ a, b, x float64 }{
+		{a: 0.5, b: 0.5, x: 0.25},
+		{a: 2, b: 3, x: 0.4},
+		{a: 10, b: 2, x: 0.9},
+		{a: 30, b: 40, x: 0.5},
+	} {
+		p := mathext.RegIncBeta(test.a, test.b, test.x)
+		q := mathext.RegIncBeta(test.b, test.a, 1-test.x)
+		if got := LogRegIncBeta(test.a, test.b, test.x); !scalar.EqualWithinAbsOrRel(got, math.Log(p), tol, tol) {
+			t.Errorf("unexpected LogRegIncBeta(%v,%v,%v): got:%v want:%v", test.a, test.b, test.x, got, math.Log(p))
+		}
+		if got := LogRegIncBetaComp(test.a, test.b, test.x); !scalar.EqualWithinAbsOrRel(got, math.Log(q), tol, tol) {
+			t.Errorf("unexpected LogRegIncBetaComp(%v,%v,%v): got:%v want:%v", test.a, test.b, test.x, got, math.Log(q))
+		}
+	}
+}
+
+func TestLogTails(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	for _, test := range []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		// erfc(x) ~ e^{-x²}/(x√π) (1 - 1/(2x²) + 3/(4x⁴) - 15/(8x⁶)).
+		{
+			name: "LogErfc(40)",
+			got:  LogErfc(40),
+			want: -1600 - math.Log(40*math.Sqrt(math.Pi)) + math.Log1p(-1.0/3200+3.0/(4*math.Pow(40, 4))-15.0/(8*math.Pow(40, 6))),
+		},
+		{
+			name: "LogErfc(1000)",
+			got:  LogErfc(1000),
+			want: -1e6 - math.Log(1000*math.Sqrt(math.Pi)) + math.Log1p(-1.0/2e6+3.0/4e12),
+		},
+		// GammaIncRegComp(1,x) = e^{-x}.
+		{name: "LogGammaIncRegComp(1,1000)", got: LogGammaIncRegComp(1, 1000), want: -1000},
+		{name: "LogGammaIncReg(1,1e-300)", got: LogGammaIncReg(1, 1e-300), want: math.Log(1e-300)},
+		// GammaIncReg(a,x) is close to one, and is not resolved by the
+		// float64 implementation.
+		{name: "LogGammaIncReg(1e-10,1e-5)", got: LogGammaIncReg(1e-10, 1e-5), want: -1.0935719800125941e-09},
+		{name: "LogErfc(-1e-300)", got: LogErfc(-1e-300), want: 2 / math.SqrtPi * 1e-300},
+		// I_x(a,1) = x^a and 1 - I_x(1,b) = (1-x)^b.
+		{name: "LogRegIncBeta(100,1,1e-5)", got: LogRegIncBeta(100, 1, 1e-5), want: 100 * math.Log(1e-5)},
+		{name: "LogRegIncBetaComp(1,50,0.999999)", got: LogRegIncBetaComp(1, 50, 0.999999), want: 50 * math.Log1p(-0.999999)},
+		{name: "LogRegIncBetaComp(1,2,1e-300)", got: LogRegIncBetaComp(1, 2, 1e-300), want: 2 * math.Log1p(-1e-300)},
+	} {
+		if !scalar.EqualWithinRel(test.got, test.want, tol) {
+			t.Errorf("unexpected %s: got:%v want:%v", test.name, test.got, test.want)
+		}
+	}
+}
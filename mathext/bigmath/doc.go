@@ -0,0 +1,18 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package bigmath provides arbitrary precision implementations of special
+// functions using math/big.
+//
+// The functions operating on *big.Float follow the conventions of the
+// math/big package: the result is stored in the receiver argument z, which
+// is also returned, and if z's precision is zero it is set to the precision
+// of the input before the operation. Values are computed internally with
+// additional guard bits and rounded to z's precision.
+//
+// The float64 functions return logarithms of probabilities computed in
+// arbitrary precision, and are intended for use in the far tails of
+// distributions where the float64 implementations in the mathext package
+// underflow or suffer catastrophic cancellation.
+package bigmath // import "gonum.org/v1/gonum/mathext/bigmath"
@@ -0,0 +1,379 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bigmath
+
+import (
+	"math"
+	"math/big"
+	"math/bits"
+	"sync"
+)
+
+// defaultPrec is the precision used for results when neither the receiver
+// nor the arguments specify a precision.
+const defaultPrec = 64
+
+// guard is the number of guard bits added to the working precision.
+const guard = 32
+
+var one = big.NewFloat(1)
+
+// Exp sets z to the rounded value of e^x and returns it.
+func Exp(z, x *big.Float) *big.Float {
+	prec := resultPrec(z, x)
+	return z.Set(exp(prec+guard, x))
+}
+
+// Log sets z to the rounded value of the natural logarithm of x and returns
+// it. Log panics if x is negative.
+func Log(z, x *big.Float) *big.Float {
+	if x.Sign() < 0 {
+		panic("bigmath: logarithm of negative number")
+	}
+	prec := resultPrec(z, x)
+	return z.Set(log(prec+guard, x))
+}
+
+// resultPrec sets the precision of z to the largest precision of args if
+// it is zero, and returns the precision of z.
+func resultPrec(z *big.Float, args ...*big.Float) uint {
+	if z.Prec() == 0 {
+		var prec uint
+		for _, x := range args {
+			prec = max(prec, x.Prec())
+		}
+		if prec == 0 {
+			prec = defaultPrec
+		}
+		z.SetPrec(prec)
+	}
+	return z.Prec()
+}
+
+// guardBits returns the number of additional bits needed to compute terms
+// of the form u log v for u and v in args to full absolute precision.
+func guardBits(args ...*big.Float) uint {
+	var emax, lmax int
+	for _, x := range args {
+		if x.Sign() == 0 || x.IsInf() {
+			continue
+		}
+		e := x.MantExp(nil)
+		emax = max(emax, e)
+		if e < 0 {
+			e = -e
+		}
+		lmax = max(lmax, bits.Len(uint(e)))
+	}
+	return uint(emax+lmax) + guard
+}
+
+// newFloat returns a new zero big.Float with precision prec.
+func newFloat(prec uint) *big.Float {
+	return new(big.Float).SetPrec(prec)
+}
+
+// newInt returns a new big.Float with value n and precision prec.
+func newInt(prec uint, n int) *big.Float {
+	return new(big.Float).SetPrec(prec).SetInt64(int64(n))
+}
+
+// negligible returns whether |term| is less than 2^-prec |sum|.
+func negligible(term, sum *big.Float, prec uint) bool {
+	if term.Sign() == 0 {
+		return true
+	}
+	if sum.Sign() == 0 {
+		return false
+	}
+	return term.MantExp(nil) < sum.MantExp(nil)-int(prec)
+}
+
+// exp returns e^x computed with precision prec.
+func exp(prec uint, x *big.Float) *big.Float {
+	z := newFloat(prec)
+	switch {
+	case x.Sign() == 0:
+		return z.SetInt64(1)
+	case x.IsInf():
+		if x.Sign() > 0 {
+			return z.SetInf(false)
+		}
+		return z
+	}
+
+	// Values outside this range overflow or underflow the exponent of
+	// a big.Float.
+	const maxArg = (math.MaxInt32 + 1) * math.Ln2
+	xf, _ := x.Float64()
+	switch {
+	case xf > maxArg:
+		return z.SetInf(false)
+	case xf < -maxArg:
+		return z
+	}
+
+	// Reduce x = k ln2 + r with |r| <= ln2/2, and then scale r by 2^-s
+	// so that the Taylor series converges quickly. The result is
+	// recovered by squaring s times and scaling by 2^k.
+	k := int(math.Round(xf / math.Ln2))
+	s := int(math.Sqrt(float64(prec))/2) + 1
+	kabs := k
+	if kabs < 0 {
+		kabs = -kabs
+	}
+	wp := prec + uint(s+bits.Len(uint(kabs))) + 16
+
+	r := newInt(wp, k)
+	r.Mul(r, ln2(wp))
+	r.Sub(x, r)
+	r.SetMantExp(r, -s)
+
+	sum := newInt(wp, 1)
+	term := newInt(wp, 1)
+	for n := 1; ; n++ {
+		term.Mul(term, r)
+		term.Quo(term, newInt(wp, n))
+		if negligible(term, sum, wp) {
+			break
+		}
+		sum.Add(sum, term)
+	}
+	for i := 0; i < s; i++ {
+		sum.Mul(sum, sum)
+	}
+	sum.SetMantExp(sum, k)
+	return z.Set(sum)
+}
+
+// log returns the natural logarithm of x computed with precision prec.
+// The argument x must not be negative.
+func log(prec uint, x *big.Float) *big.Float {
+	z := newFloat(prec)
+	switch {
+	case x.Sign() == 0:
+		return z.SetInf(true)
+	case x.IsInf():
+		return z.SetInf(false)
+	}
+	wp := prec + 16
+
+	// Write x = m 2^e with 1/√2 <= m < √2 so that log(x) = e ln2 + log(m)
+	// where log(m) = 2 atanh((m-1)/(m+1)) and |(m-1)/(m+1)| < 0.18.
+	m := new(big.Float)
+	e := x.MantExp(m)
+	if m.Cmp(big.NewFloat(math.Sqrt2/2)) < 0 {
+		m.SetMantExp(m, 1)
+		e--
+	}
+	t := newFloat(wp).Sub(m, one)
+	t.Quo(t, newFloat(wp).Add(m, one))
+	r := atanh(wp, t)
+	r.SetMantExp(r, 1)
+	if e != 0 {
+		eabs := e
+		if eabs < 0 {
+			eabs = -eabs
+		}
+		wpe := wp + uint(bits.Len(uint(eabs)))
+		l := newInt(wpe, e)
+		l.Mul(l, ln2(wpe))
+		r = l.Add(l, r)
+	}
+	return z.Set(r)
+}
+
+// atanh returns the inverse hyperbolic tangent of t computed with precision
+// prec by its Taylor series. The argument t should be small.
+func atanh(prec uint, t *big.Float) *big.Float {
+	t2 := newFloat(prec).Mul(t, t)
+	sum := newFloat(prec).Set(t)
+	pow := newFloat(prec).Set(t)
+	term := newFloat(prec)
+	for k := 3; ; k += 2 {
+		pow.Mul(pow, t2)
+		term.Quo(pow, newInt(prec, k))
+		if negligible(term, sum, prec) {
+			return sum
+		}
+		sum.Add(sum, term)
+	}
+}
+
+// atanInv returns arctan(1/n) computed with precision prec by its Taylor
+// series.
+func atanInv(prec uint, n int) *big.Float {
+	t := newInt(prec, 1)
+	t.Quo(t, newInt(prec, n))
+	t2 := newFloat(prec).Mul(t, t)
+	sum := newFloat(prec).Set(t)
+	pow := newFloat(prec).Set(t)
+	term := newFloat(prec)
+	for k := 3; ; k += 2 {
+		pow.Mul(pow, t2)
+		term.Quo(pow, newInt(prec, k))
+		if negligible(term, sum, prec) {
+			return sum
+		}
+		if k%4 == 3 {
+			sum.Sub(sum, term)
+		} else {
+			sum.Add(sum, term)
+		}
+	}
+}
+
+// constant is a mathematical constant cached at the highest precision
+// that has been requested.
+type constant struct {
+	mu      sync.Mutex
+	val     *big.Float
+	compute func(prec uint) *big.Float
+}
+
+// get returns the constant rounded to precision prec.
+func (c *constant) get(prec uint) *big.Float {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.val == nil || c.val.Prec() < prec {
+		c.val = c.compute(prec + 16)
+	}
+	return newFloat(prec).Set(c.val)
+}
+
+var (
+	ln2Const = constant{compute: func(prec uint) *big.Float {
+		// ln2 = 2 atanh(1/3).
+		t := newInt(prec, 1)
+		t.Quo(t, newInt(prec, 3))
+		r := atanh(prec, t)
+		return r.SetMantExp(r, 1)
+	}}
+	piConst = constant{compute: func(prec uint) *big.Float {
+		// Machin's formula π = 16 arctan(1/5) - 4 arctan(1/239).
+		a := atanInv(prec, 5)
+		a.SetMantExp(a, 4)
+		b := atanInv(prec, 239)
+		b.SetMantExp(b, 2)
+		return a.Sub(a, b)
+	}}
+)
+
+// ln2 returns the natural logarithm of 2 with precision prec.
+func ln2(prec uint) *big.Float { return ln2Const.get(prec) }
+
+// pi returns π with precision prec.
+func pi(prec uint) *big.Float { return piConst.get(prec) }
+
+// maxIter is the maximum number of continued fraction terms evaluated.
+const maxIter = 1 << 20
+
+// lentz returns the value of the continued fraction
+//
+//	b_0 + a_1/(b_1 + a_2/(b_2 + ...))
+//
+// computed with precision prec using the modified Lentz algorithm. The
+// function next sets a and b to the coefficients a_n and b_n for n >= 1.
+func lentz(prec uint, b0 *big.Float, next func(n int, a, b *big.Float)) *big.Float {
+	tiny := new(big.Float).SetMantExp(one, -4*int(prec)-64)
+	f := newFloat(prec).Set(b0)
+	if f.Sign() == 0 {
+		f.Set(tiny)
+	}
+	c := newFloat(prec).Set(f)
+	d := newFloat(prec)
+	a := newFloat(prec)
+	b := newFloat(prec)
+	delta := newFloat(prec)
+	for n := 1; n <= maxIter; n++ {
+		next(n, a, b)
+		d.Mul(a, d)
+		d.Add(b, d)
+		if d.Sign() == 0 {
+			d.Set(tiny)
+		}
+		d.Quo(one, d)
+		c.Quo(a, c)
+		c.Add(b, c)
+		if c.Sign() == 0 {
+			c.Set(tiny)
+		}
+		delta.Mul(c, d)
+		f.Mul(f, delta)
+		delta.Sub(delta, one)
+		if delta.Sign() == 0 || delta.MantExp(nil) < -int(prec) {
+			return f
+		}
+	}
+	panic("bigmath: continued fraction failed to converge")
+}
+
+// scaled is the value m e^l, used to represent values whose magnitude is
+// outside the exponent range of a big.Float. If c is not nil the value is
+// 1 + c, retaining precision for values close to one.
+type scaled struct {
+	m, l *big.Float
+	c    *big.Float
+}
+
+// value returns the value of s computed with precision prec.
+func (s scaled) value(prec uint) *big.Float {
+	if s.c != nil {
+		return newFloat(prec).Add(one, s.c)
+	}
+	v := newFloat(prec).Set(s.m)
+	if s.l == nil || s.l.Sign() == 0 || v.Sign() == 0 {
+		return v
+	}
+	return v.Mul(v, exp(prec, s.l))
+}
+
+// log returns the natural logarithm of the value of s computed with
+// precision prec.
+func (s scaled) log(prec uint) *big.Float {
+	if s.c != nil {
+		// log(1+c) = 2 atanh(c/(2+c)).
+		t := newFloat(prec+16).Add(s.c, big.NewFloat(2))
+		t.Quo(s.c, t)
+		r := atanh(prec+16, t)
+		return r.SetMantExp(r, 1)
+	}
+	r := log(prec, s.m)
+	if s.l == nil || r.IsInf() {
+		return r
+	}
+	return r.Add(r, s.l)
+}
+
+// maxGuard is the largest number of guard bits used to resolve
+// cancellation in complement.
+const maxGuard = 1 << 16
+
+// complement returns 1 - v where v in [0, 1] is computed by f with working
+// precision wp. The working precision is increased until the subtraction
+// retains prec bits.
+func complement(prec uint, f func(wp uint) scaled) scaled {
+	g := uint(guard)
+	for {
+		wp := prec + g
+		v := f(wp).value(wp)
+		if v.Cmp(big.NewFloat(0.5)) < 0 {
+			return scaled{c: v.Neg(v)}
+		}
+		d := newFloat(wp).Sub(one, v)
+		if d.Sign() > 0 {
+			loss := 1 - d.MantExp(nil)
+			if loss+guard/2 <= int(g) || g >= maxGuard {
+				return scaled{m: d}
+			}
+			g = uint(loss) + guard
+		} else {
+			if g >= maxGuard {
+				return scaled{m: newFloat(prec)}
+			}
+			g *= 2
+		}
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bigmath
+
+import (
+	"math"
+	"math/big"
+)
+
+// Erf sets z to the rounded value of the error function of x,
+//
+//	erf(x) = 2/√π \int_0^x e^{-t²} dt,
+//
+// and returns it.
+func Erf(z, x *big.Float) *big.Float {
+	prec := resultPrec(z, x)
+	if x.IsInf() {
+		return z.SetInt64(int64(x.Sign()))
+	}
+	xabs := new(big.Float).Abs(x)
+	r := erf(prec, xabs).value(prec + guard)
+	if x.Sign() < 0 {
+		r.Neg(r)
+	}
+	return z.Set(r)
+}
+
+// Erfc sets z to the rounded value of the complementary error function of x,
+//
+//	erfc(x) = 1 - erf(x),
+//
+// and returns it. The result is computed without cancellation for large
+// positive x.
+func Erfc(z, x *big.Float) *big.Float {
+	prec := resultPrec(z, x)
+	if x.IsInf() {
+		return z.SetInt64(int64(1 - x.Sign()))
+	}
+	return z.Set(erfc(prec, x).value(prec + guard))
+}
+
+// LogErfc returns the natural logarithm of the complementary error function
+// of x, computed in arbitrary precision so that the result is accurate when
+// erfc(x) underflows a float64.
+func LogErfc(x float64) float64 {
+	switch {
+	case math.IsNaN(x):
+		return math.NaN()
+	case math.IsInf(x, 1):
+		return math.Inf(-1)
+	case math.IsInf(x, -1):
+		return math.Ln2
+	}
+	r, _ := erfc(defaultPrec, big.NewFloat(x)).log(defaultPrec).Float64()
+	return r
+}
+
+// erfSeries reports whether the power series should be used to compute
+// erf(x) and erfc(x) for x >= 0 at precision prec. Above this the cost of
+// cancellation in 1 - erf(x) exceeds that of the continued fraction for
+// erfc(x).
+func erfSeries(prec uint, x *big.Float) bool {
+	xf, _ := x.Float64()
+	return xf*xf < float64(prec)/16+4
+}
+
+// erfWorkingPrec returns the working precision needed to compute e^{-x²}
+// to relative precision prec.
+func erfWorkingPrec(prec uint, x *big.Float) uint {
+	return prec + 2*guardBits(x)
+}
+
+// erf returns erf(x) for x >= 0 computed with precision prec.
+func erf(prec uint, x *big.Float) scaled {
+	if !erfSeries(prec, x) {
+		return complement(prec, func(wp uint) scaled {
+			return erfcFrac(erfWorkingPrec(wp, x), x)
+		})
+	}
+	return erfPowerSeries(erfWorkingPrec(prec, x), x)
+}
+
+// erfc returns erfc(x) computed with precision prec.
+func erfc(prec uint, x *big.Float) scaled {
+	switch {
+	case x.Sign() < 0:
+		// erfc(x) = 1 + erf(-x).
+		xabs := new(big.Float).Abs(x)
+		v := erf(prec, xabs).value(prec + guard)
+		return scaled{c: v}
+	case erfSeries(prec, x):
+		return complement(prec, func(wp uint) scaled {
+			return erfPowerSeries(erfWorkingPrec(wp, x), x)
+		})
+	}
+	return erfcFrac(erfWorkingPrec(prec, x), x)
+}
+
+// erfPowerSeries returns erf(x) for x >= 0 computed with precision prec
+// using the series
+//
+//	erf(x) = 2x/√π e^{-x²} \sum_{n=0}^∞ (2x²)^n / (1·3·5···(2n+1)),
+//
+// which has only positive terms.
+func erfPowerSeries(prec uint, x *big.Float) scaled {
+	x2 := newFloat(prec).Mul(x, x)
+	r := newFloat(prec).Mul(x2, big.NewFloat(2))
+	sum := newInt(prec, 1)
+	term := newInt(prec, 1)
+	for n := 0; ; n++ {
+		term.Mul(term, r)
+		term.Quo(term, newInt(prec, 2*n+3))
+		if negligible(term, sum, prec) {
+			break
+		}
+		sum.Add(sum, term)
+	}
+	sum.Mul(sum, x)
+	sum.SetMantExp(sum, 1)
+	sum.Quo(sum, newFloat(prec).Sqrt(pi(prec)))
+	return scaled{m: sum, l: x2.Neg(x2)}
+}
+
+// erfcFrac returns erfc(x) for x > 0 computed with precision prec using
+// the continued fraction
+//
+//	erfc(x) = e^{-x²}/√π 1/(x + (1/2)/(x + 1/(x + (3/2)/(x + ...)))).
+func erfcFrac(prec uint, x *big.Float) scaled {
+	f := lentz(prec, x, func(n int, a, b *big.Float) {
+		a.SetInt64(int64(n))
+		a.SetMantExp(a, -1)
+		b.Set(x)
+	})
+	f.Mul(f, newFloat(prec).Sqrt(pi(prec)))
+	m := newFloat(prec).Quo(one, f)
+	l := newFloat(prec).Mul(x, x)
+	return scaled{m: m, l: l.Neg(l)}
+}
@@ -19,6 +19,9 @@ import (
 // Hypergeo returns the value of the Gaussian Hypergeometric function at z.
 // For |z| < 1, this implementation follows the Cephes library.
 // For |z| ≥ 1, this implementation performs analytic continuation via relevant Hypergeometric identities.
+// For z > 1, Hypergeo returns NaN unless a or b is a non-positive integer,
+// and for z = 1 it returns NaN unless c-a-b > 0 or a or b is a non-positive
+// integer.
 //
 // See https://en.wikipedia.org/wiki/Hypergeometric_function for more details.
 func Hypergeo(a, b, c, z float64) float64 {
@@ -44,8 +47,22 @@ func Hypergeo(a, b, c, z float64) float64 {
 		return cephes.Hyp2f1(a, b, c, z)
 	}
 
-	// Function undefined between the 1 and Inf branch points.
 	if z >= 1 {
+		// When a or b is a non-positive integer, Hypergeo reduces
+		// to the finite polynomial described in equation 15.4.1,
+		// which is defined for all z.
+		if isNonPosInt(a) {
+			return eqn15_4_1(int(-a), b, c, z)
+		}
+		if isNonPosInt(b) {
+			return eqn15_4_1(int(-b), a, c, z)
+		}
+		// The series converges at z=1 when c-a-b > 0, with the
+		// value given by equation 15.1.20.
+		if z == 1 && c-a-b > 0 {
+			return eqn15_1_20(a, b, c)
+		}
+		// Function undefined between the 1 and Inf branch points.
 		return math.NaN()
 	}
 
@@ -63,12 +80,13 @@ func Hypergeo(a, b, c, z float64) float64 {
 		return eqn15_4_1(int(-b), a, c, z)
 	}
 
-	// Analytic continuation formula contains NaNs from Gamma(a-b) when a-b is a non-positive integer.
-	// Fix this by making a and b different via equations 15.3.3 and 15.3.4.
+	// Analytic continuation formula contains NaNs from Gamma(a-b) or Gamma(b-a)
+	// when a-b is an integer of either sign. Fix this by making a and b different
+	// via equations 15.3.3 and 15.3.4.
 	if isNonPosInt(c-a) || isNonPosInt(c-b) {
 		return eqn15_3_3(a, b, c, z)
 	}
-	if isNonPosInt(a - b) {
+	if isNonPosInt(a-b) || isNonPosInt(b-a) {
 		return eqn15_3_4(a, b, c, z)
 	}
 
@@ -137,6 +155,22 @@ func eqn15_2_12(a, b, c, x float64) float64 {
 	return f0
 }
 
+// eqn15_1_20 returns the value of the Gaussian Hypergeometric function at
+// z=1 for c-a-b > 0.
+func eqn15_1_20(a, b, c float64) float64 {
+	if isNonPosInt(c) {
+		return math.NaN()
+	}
+	if isNonPosInt(c-a) || isNonPosInt(c-b) {
+		return 0
+	}
+	lc, sc := math.Lgamma(c)
+	lcab, scab := math.Lgamma(c - a - b)
+	lca, sca := math.Lgamma(c - a)
+	lcb, scb := math.Lgamma(c - b)
+	return float64(sc*scab*sca*scb) * math.Exp(lc+lcab-lca-lcb)
+}
+
 func eqn15_3_3(a, b, c, z float64) float64 {
 	y := Hypergeo(c-a, c-b, c, z)
 	return math.Pow(1-z, c-a-b) * y
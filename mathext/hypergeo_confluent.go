@@ -0,0 +1,174 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import "math"
+
+// The confluent hypergeometric relations used here are from chapter 13 of:
+//
+// M. Abramowitz and I. A. Stegun 1965. Handbook of Mathematical Functions, New York: Dover
+//
+// It is available online via https://personal.math.ubc.ca/~cbm/aands/frameindex.htm
+
+// ConfluentHypergeo returns the value of Kummer's confluent hypergeometric
+// function ₁F₁(a; b; z), also written M(a, b, z), defined by the series
+//
+//	₁F₁(a; b; z) = ∑_{n=0}^∞ (a)_n/(b)_n zⁿ/n!
+//
+// where (x)_n is the rising factorial.
+//
+// The function is evaluated using the series for moderate z, the
+// asymptotic expansion in equation 13.5.1 for large z, and Kummer's
+// transformation in equation 13.1.27 to evaluate the function at negative
+// z from its value at positive z where the terms of the series and
+// expansion do not cancel for positive a and b. When a is a non-positive
+// integer the series is a polynomial and is evaluated directly.
+//
+// ConfluentHypergeo returns NaN if b is a non-positive integer unless a is
+// a non-positive integer greater than b.
+//
+// See https://en.wikipedia.org/wiki/Confluent_hypergeometric_function for
+// more details.
+func ConfluentHypergeo(a, b, z float64) float64 {
+	switch {
+	case math.IsNaN(a) || math.IsNaN(b) || math.IsNaN(z):
+		return math.NaN()
+	case isNonPosInt(b):
+		// The series has a pole at non-positive integer
+		// b unless it terminates before the pole.
+		if isNonPosInt(a) && a > b {
+			return confluentPoly(a, b, z)
+		}
+		return math.NaN()
+	case a == 0 || z == 0:
+		return 1
+	case a == b:
+		return math.Exp(z)
+	case isNonPosInt(a):
+		return confluentPoly(a, b, z)
+	case z < 0:
+		// Use Kummer's transformation, equation 13.1.27.
+		m, s := confluentPos(b-a, b, -z)
+		return m * math.Exp(s+z)
+	}
+	m, s := confluentPos(a, b, z)
+	return m * math.Exp(s)
+}
+
+// confluentPos returns the value of ₁F₁(a; b; z) for z > 0 and b not a
+// non-positive integer as m×exp(s) to avoid overflow.
+func confluentPos(a, b, z float64) (m, s float64) {
+	switch {
+	case a == 0:
+		return 1, 0
+	case a == b:
+		return 1, z
+	case isNonPosInt(a):
+		return confluentPoly(a, b, z), 0
+	}
+	// The asymptotic expansion has a smallest term of order
+	// exp(-z) for moderate a and b, so it is accurate to
+	// machine precision for z beyond about 36.
+	if z > 36 {
+		m, s, ok := eqn13_5_1(a, b, z)
+		if ok {
+			return m, s
+		}
+	}
+	return confluentSeries(a, b, z), 0
+}
+
+// confluentPoly returns the value of ₁F₁(a; b; z) for non-positive integer
+// a, where the series is a polynomial of degree -a.
+func confluentPoly(a, b, z float64) float64 {
+	sum, term := 1.0, 1.0
+	for n := 0; n < int(-a); n++ {
+		fn := float64(n)
+		term *= (a + fn) / (b + fn) * z / (fn + 1)
+		sum += term
+	}
+	return sum
+}
+
+// confluentSeries returns the value of ₁F₁(a; b; z) by summing its series.
+func confluentSeries(a, b, z float64) float64 {
+	const (
+		eps     = 1e-17
+		maxIter = 100000
+	)
+	// The terms of the series may be transiently small
+	// while n+a or n+b are near zero, so the summation
+	// only stops once the terms are decreasing in
+	// magnitude and have their final sign.
+	start := math.Max(0, math.Max(-a, -b))
+	sum, term := 1.0, 1.0
+	for n := 0; n < maxIter; n++ {
+		fn := float64(n)
+		r := (a + fn) / (b + fn) * z / (fn + 1)
+		term *= r
+		sum += term
+		if fn > start && math.Abs(r) < 1 && math.Abs(term) <= eps*math.Abs(sum) {
+			break
+		}
+		if math.IsInf(sum, 0) {
+			break
+		}
+	}
+	return sum
+}
+
+// eqn13_5_1 returns the value of ₁F₁(a; b; z) for large positive z as
+// m×exp(s) using the asymptotic expansion in equation 13.5.1 and whether
+// the expansion converged to machine precision.
+func eqn13_5_1(a, b, z float64) (m, s float64, ok bool) {
+	const (
+		eps     = 1e-16
+		maxIter = 1000
+	)
+	// asymptoticSum returns the sum of the asymptotic
+	// series ∑ (p)_n (q)_n / n! xⁿ and whether its terms
+	// became negligible before they started to grow.
+	asymptoticSum := func(p, q, x float64) (float64, bool) {
+		sum, term := 1.0, 1.0
+		prev := math.Inf(1)
+		for n := 0; n < maxIter; n++ {
+			fn := float64(n)
+			term *= (p + fn) * (q + fn) / (fn + 1) * x
+			if term == 0 || math.Abs(term) <= eps*math.Abs(sum) {
+				return sum, true
+			}
+			if math.Abs(term) > prev {
+				return sum, false
+			}
+			prev = math.Abs(term)
+			sum += term
+		}
+		return sum, false
+	}
+
+	// The dominant term is
+	//  Γ(b)/Γ(a) exp(z) z^(a-b) ∑ (b-a)_n (1-a)_n / n! z^-n
+	// and the subdominant term for real z is
+	//  Γ(b)/Γ(b-a) cos(πa) z^-a ∑ (a)_n (1+a-b)_n / n! (-z)^-n.
+	lb, sb := math.Lgamma(b)
+	la, sa := math.Lgamma(a)
+	s1, ok := asymptoticSum(b-a, 1-a, 1/z)
+	if !ok {
+		return 0, 0, false
+	}
+	s = lb - la + z + (a-b)*math.Log(z)
+	m = float64(sb*sa) * s1
+
+	if !isNonPosInt(b - a) {
+		s2, ok := asymptoticSum(a, 1+a-b, -1/z)
+		if !ok {
+			return 0, 0, false
+		}
+		lba, sba := math.Lgamma(b - a)
+		t := lb - lba - a*math.Log(z)
+		m += float64(sb*sba) * math.Cos(math.Pi*a) * s2 * math.Exp(t-s)
+	}
+	return m, s, true
+}
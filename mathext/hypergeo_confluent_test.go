@@ -0,0 +1,131 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestConfluentHypergeo(t *testing.T) {
+	t.Parallel()
+
+	// Values were calculated by summing the series using 400 digit
+	// decimal arithmetic.
+	tests := []struct {
+		a, b, z float64
+		want    float64
+	}{
+		{a: 0.5, b: 1.5, z: 0.1, want: 1.0343576135040385},
+		{a: 0.5, b: 1.5, z: -2, want: 0.59814400666130410},
+		{a: 1, b: 2, z: 3, want: 6.3618456410625559},
+		{a: 2.5, b: 3.5, z: 10, want: 4724.4326237806562},
+		{a: -0.5, b: 2, z: 5, want: -1.5367314657435152},
+		{a: 0.3, b: -2.7, z: 1.5, want: -1.1047300789628847},
+		{a: 1.5, b: 2.5, z: -20, want: 0.014862477207661502},
+		{a: 3, b: 1.2, z: -50, want: 0.0000027613548523327116},
+		{a: 0.25, b: 0.75, z: 40, want: 12701211841040722},
+		{a: 2, b: 3, z: 60, want: 3.7432464443958540e+24},
+		{a: -2.5, b: 1.5, z: 30, want: -21619585.930391471},
+		{a: 5, b: 10, z: 100, want: 3.3213604495293771e+37},
+		{a: 0.1, b: 0.2, z: 200, want: 2.0537219036123893e+86},
+		{a: 1.5, b: 0.5, z: -100, want: -7.4029511922814636e-42},
+		{a: 10, b: 20, z: -30, want: 0.000028298117364976489},
+		{a: -3, b: 2, z: 4, want: 0.33333333333333333},
+		{a: -3, b: 2, z: -4, want: 17.666666666666667},
+		{a: -10.5, b: 3, z: -15, want: 701844.13563910425},
+		{a: 7.5, b: 2.25, z: 25, want: 2987071553276116.0},
+		{a: 0.5, b: 1.5, z: -700, want: 0.033496229284533939},
+		{a: 1, b: 1.5, z: 700, want: 3.3972949453127687e+302},
+		{a: 2, b: 1, z: 37, want: 4.4532741016649923e+17},
+		{a: -0.5, b: 0.5, z: 36.5, want: -101675833771285.56},
+		{a: 20, b: 5, z: 10, want: 3639417243.5150654},
+		{a: 0.001, b: 1, z: 50, want: 1.0632813010380530e+17},
+		{a: -4.2, b: -3.7, z: 2.3, want: 12.775874076190878},
+
+		// Special cases.
+		{a: 0, b: 2.5, z: 10, want: 1},
+		{a: 1.5, b: 2.5, z: 0, want: 1},
+		{a: 1.5, b: 1.5, z: -3, want: math.Exp(-3)},
+		{a: -2, b: -4, z: 2, want: 7.0 / 3},
+		{a: 1, b: -2, z: 1, want: math.NaN()},
+		{a: -3, b: -2, z: 1, want: math.NaN()},
+		{a: math.NaN(), b: 1, z: 1, want: math.NaN()},
+	}
+	const tol = 1e-13
+	for _, test := range tests {
+		got := ConfluentHypergeo(test.a, test.b, test.z)
+		if math.IsNaN(test.want) {
+			if !math.IsNaN(got) {
+				t.Errorf("unexpected result for ConfluentHypergeo(%v, %v, %v): got:%v want:NaN", test.a, test.b, test.z, got)
+			}
+			continue
+		}
+		if !scalar.EqualWithinAbsOrRel(got, test.want, 0, tol) {
+			t.Errorf("unexpected result for ConfluentHypergeo(%v, %v, %v): got:%v want:%v rel error:%.2g",
+				test.a, test.b, test.z, got, test.want, math.Abs(got-test.want)/math.Abs(test.want))
+		}
+	}
+}
+
+func TestConfluentHypergeoIdentities(t *testing.T) {
+	t.Parallel()
+
+	const tol = 1e-12
+	for _, z := range []float64{-50, -12.5, -3, -0.5, 0.25, 1, 4.5, 20, 45, 80} {
+		t.Run(fmt.Sprint(z), func(t *testing.T) {
+			// Equation 13.6.14: M(1, 2, 2z) = exp(z) sinh(z)/z.
+			got := ConfluentHypergeo(1, 2, 2*z)
+			want := math.Exp(z) * math.Sinh(z) / z
+			if !scalar.EqualWithinAbsOrRel(got, want, 0, tol) {
+				t.Errorf("unexpected value of M(1, 2, %v): got:%v want:%v", 2*z, got, want)
+			}
+
+			// Equation 13.6.19: M(1/2, 3/2, -z²) = √π/2 erf(z)/z.
+			if z > 0 && z < 20 {
+				got = ConfluentHypergeo(0.5, 1.5, -z*z)
+				want = math.Sqrt(math.Pi) / 2 * math.Erf(z) / z
+				if !scalar.EqualWithinAbsOrRel(got, want, 0, tol) {
+					t.Errorf("unexpected value of M(1/2, 3/2, %v): got:%v want:%v", -z*z, got, want)
+				}
+			}
+
+			// Equation 13.1.27, Kummer's transformation.
+			for _, p := range [][2]float64{{0.3, 1.7}, {2.5, 4}, {1.25, 0.5}} {
+				a, b := p[0], p[1]
+				got = ConfluentHypergeo(a, b, z)
+				want = math.Exp(z) * ConfluentHypergeo(b-a, b, -z)
+				if !scalar.EqualWithinAbsOrRel(got, want, 0, tol) {
+					t.Errorf("Kummer's transformation does not hold for a=%v b=%v z=%v: got:%v want:%v", a, b, z, got, want)
+				}
+			}
+
+			// Equation 13.4.1, a recurrence relation in a.
+			if math.Abs(z) < 40 {
+				const a, b = 0.7, 2.3
+				lhs := (b - a) * ConfluentHypergeo(a-1, b, z)
+				lhs += (2*a - b + z) * ConfluentHypergeo(a, b, z)
+				lhs -= a * ConfluentHypergeo(a+1, b, z)
+				scale := math.Abs(a * ConfluentHypergeo(a+1, b, z))
+				if math.Abs(lhs) > tol*scale {
+					t.Errorf("recurrence relation does not hold for z=%v: got:%v want:0", z, lhs)
+				}
+			}
+		})
+	}
+
+	// Equation 13.6.9: M(-n, α+1, z) = n!/(α+1)_n L_n^(α)(z),
+	// with L_3^(α)(z) for α=0 being (-z³+9z²-18z+6)/6.
+	for _, z := range []float64{-5, -1, 0.5, 2, 7} {
+		got := ConfluentHypergeo(-3, 1, z)
+		want := (-z*z*z + 9*z*z - 18*z + 6) / 6
+		if !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+			t.Errorf("unexpected value of M(-3, 1, %v): got:%v want:%v", z, got, want)
+		}
+	}
+}
@@ -306,3 +306,48 @@ func Test_John_Ormerod(t *testing.T) {
 		t.Errorf("unexpected result from Hypergeo(5.25, 1, 6.5, 0.501): got %f want %f", y, want)
 	}
 }
+
+func TestHypergeoOutsideUnitDisk(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a, b, c, z float64
+		want       float64
+	}{
+		// Equation 15.1.20, Gauss's summation theorem.
+		{a: 0.5, b: 0.5, c: 2, z: 1, want: 4 / math.Pi},
+		{a: 1, b: 2, c: 4.5, z: 1, want: 3.5 / 1.5},
+		{a: 0.5, b: -0.25, c: 0.5, z: 1, want: 0},
+		{a: 0.5, b: 0.5, c: 1, z: 1, want: math.NaN()},
+		{a: 0.5, b: 1.5, c: 1, z: 1, want: math.NaN()},
+
+		// Terminating series, equation 15.4.1.
+		{a: -2, b: 1, c: 3, z: 2, want: 1.0 / 3},
+		{a: 1, b: -2, c: 3, z: 2, want: 1.0 / 3},
+		{a: -1, b: 0.5, c: 0.5, z: 1, want: 0},
+		{a: -3, b: 2.5, c: 1.5, z: 5, want: 1 - 3*2.5*5/1.5 + 3*2.5*3.5*25/(1.5*2.5) - 2.5*3.5*4.5*125/(1.5*2.5*3.5)},
+
+		{a: 0.5, b: 0.5, c: 2, z: 1.5, want: math.NaN()},
+
+		// Integer a-b of either sign with z < -1.
+		{a: 1, b: 2, c: 3, z: -20, want: (20 - math.Log(21)) / 200},
+		{a: 2, b: 1, c: 3, z: -20, want: (20 - math.Log(21)) / 200},
+		{a: 4, b: 1, c: 3, z: -5, want: 13.0 / 108},
+		{a: 0.5, b: 2.5, c: 4, z: -3, want: 0.60328965654809},
+		{a: 1.5, b: 3.5, c: 4.5, z: -10, want: 0.0433714292538636},
+		{a: 0.75, b: 2.75, c: 4.25, z: -50, want: 0.0796242162667797},
+	}
+	const tol = 1e-14
+	for _, test := range tests {
+		got := Hypergeo(test.a, test.b, test.c, test.z)
+		if math.IsNaN(test.want) {
+			if !math.IsNaN(got) {
+				t.Errorf("unexpected result from Hypergeo(%v, %v, %v, %v): got %v want NaN", test.a, test.b, test.c, test.z, got)
+			}
+			continue
+		}
+		if !scalar.EqualWithinAbsOrRel(got, test.want, tol, tol) {
+			t.Errorf("unexpected result from Hypergeo(%v, %v, %v, %v): got %v want %v", test.a, test.b, test.c, test.z, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import "math"
+
+// LambertW0 returns the principal branch of the Lambert W function, the
+// solution w >= -1 of
+//
+//	w e^w = x.
+//
+// LambertW0 is defined for x >= -1/e and returns NaN for x < -1/e, with
+// arguments within rounding error of -1/e returning -1.
+//
+// See Corless, Gonnet, Hare, Jeffrey and Knuth, "On the Lambert W function",
+// Advances in Computational Mathematics 5:329-359. doi:10.1007/BF02124750
+// for more detailed information.
+func LambertW0(x float64) float64 {
+	switch {
+	case math.IsNaN(x):
+		return math.NaN()
+	case x == 0:
+		return x
+	case math.IsInf(x, 1):
+		return x
+	}
+	p2 := branchPointDist(x)
+	switch {
+	case p2 < -branchPointTol:
+		return math.NaN()
+	case p2 <= 0:
+		// x is within rounding error of -1/e.
+		return -1
+	}
+
+	var w float64
+	switch {
+	case p2 < 0.5:
+		// Series about the branch point.
+		p := math.Sqrt(p2)
+		w = branchPointSeries(p)
+		if math.Abs(p) < 1e-3 {
+			// The series is accurate to
+			// working precision.
+			return w
+		}
+	case x < 3:
+		w = 0.5 * math.Log1p(x)
+	default:
+		l1 := math.Log(x)
+		l2 := math.Log(l1)
+		w = l1 - l2 + l2/l1
+	}
+	return lambertWHalley(x, w)
+}
+
+// LambertWm1 returns the lower branch of the Lambert W function, the
+// solution w <= -1 of
+//
+//	w e^w = x.
+//
+// LambertWm1 is defined for -1/e <= x < 0 and returns NaN outside that
+// interval, except that LambertWm1(0) returns -Inf and arguments within
+// rounding error of -1/e return -1.
+//
+// See Corless, Gonnet, Hare, Jeffrey and Knuth, "On the Lambert W function",
+// Advances in Computational Mathematics 5:329-359. doi:10.1007/BF02124750
+// for more detailed information.
+func LambertWm1(x float64) float64 {
+	switch {
+	case math.IsNaN(x) || x > 0:
+		return math.NaN()
+	case x == 0:
+		return math.Inf(-1)
+	}
+	p2 := branchPointDist(x)
+	switch {
+	case p2 < -branchPointTol:
+		return math.NaN()
+	case p2 <= 0:
+		// x is within rounding error of -1/e.
+		return -1
+	}
+
+	var w float64
+	if p2 < 0.5 {
+		// Series about the branch point.
+		p := -math.Sqrt(p2)
+		w = branchPointSeries(p)
+		if math.Abs(p) < 1e-3 {
+			// The series is accurate to
+			// working precision.
+			return w
+		}
+	} else {
+		l1 := math.Log(-x)
+		l2 := math.Log(-l1)
+		w = l1 - l2 + l2/l1
+	}
+	return lambertWHalley(x, w)
+}
+
+// branchPointTol is the tolerance on 2(ex + 1) for arguments rounded
+// from -1/e, which lies between two floating point values.
+const branchPointTol = 1e-15
+
+// branchPointDist returns 2(ex + 1), computed with the constant 1/e
+// represented in double-double precision to retain accuracy near the
+// branch point at x = -1/e.
+func branchPointDist(x float64) float64 {
+	const (
+		invEHi = 0.36787944117144233
+		invELo = -1.2428753672788363e-17
+		eHi    = 2.718281828459045
+		eLo    = 1.4456468917292502e-16
+	)
+	// ex + 1 = e(x + 1/e).
+	d := (x + invEHi) + invELo
+	return 2 * (eHi + eLo) * d
+}
+
+// branchPointSeries returns the series expansion of W about the branch
+// point in p = ±sqrt(2(ex + 1)), truncated after the p⁶ term.
+func branchPointSeries(p float64) float64 {
+	return -1 + p*(1+p*(-1.0/3+p*(11.0/72+p*(-43.0/540+p*(769.0/17280+p*(-221.0/8505))))))
+}
+
+// lambertWHalley refines the estimate w of W(x) using Halley's method.
+func lambertWHalley(x, w float64) float64 {
+	for i := 0; i < 100; i++ {
+		ew := math.Exp(w)
+		f := w*ew - x
+		if f == 0 {
+			return w
+		}
+		wp1 := w + 1
+		if wp1 == 0 {
+			return w
+		}
+		dw := f / (ew*wp1 - (w+2)*f/(2*wp1))
+		w -= dw
+		if math.Abs(dw) <= 4*dlamchE*math.Abs(w) {
+			break
+		}
+	}
+	return w
+}
+
+// dlamchE is the machine epsilon for float64.
+const dlamchE = 1.0 / (1 << 53)
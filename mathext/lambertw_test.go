@@ -0,0 +1,65 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestLambertW(t *testing.T) {
+	t.Parallel()
+	for _, w := range []float64{-1 + 1e-7, -1 + 1e-4, -0.999, -0.9, -0.5, -0.1, -1e-10, 1e-300, 1e-10, 0.1, 0.5, 1, 2, 5, 10, 100, 700} {
+		x := w * math.Exp(w)
+		got := LambertW0(x)
+		if !scalar.EqualWithinAbsOrRel(got, w, 1e-14, 1e-14) {
+			// Near the branch point W is ill-conditioned.
+			if p := math.Sqrt(2 * (math.E*x + 1)); math.Abs(got-w) > 1e-15/p {
+				t.Errorf("unexpected LambertW0(%v): got:%v want:%v", x, got, w)
+			}
+		}
+	}
+	for _, w := range []float64{-1 - 1e-7, -1 - 1e-4, -1.001, -1.1, -1.5, -2, -5, -10, -100, -700} {
+		x := w * math.Exp(w)
+		got := LambertWm1(x)
+		if !scalar.EqualWithinAbsOrRel(got, w, 1e-14, 1e-14) {
+			if p := math.Sqrt(2 * (math.E*x + 1)); math.Abs(got-w) > 1e-15/p {
+				t.Errorf("unexpected LambertWm1(%v): got:%v want:%v", x, got, w)
+			}
+		}
+	}
+
+	for _, test := range []struct {
+		name string
+		fn   func(float64) float64
+		x    float64
+		want float64
+	}{
+		{"W0", LambertW0, 1, 0.5671432904097838},
+		{"W0", LambertW0, math.E, 1},
+		{"W0", LambertW0, 0, 0},
+		{"W0", LambertW0, -1 / math.E, -1},
+		{"W0", LambertW0, math.Inf(1), math.Inf(1)},
+		{"W0", LambertW0, -0.5, math.NaN()},
+		{"Wm1", LambertWm1, -math.Ln2 / 2, -2 * math.Ln2},
+		{"Wm1", LambertWm1, -1 / math.E, -1},
+		{"Wm1", LambertWm1, 0, math.Inf(-1)},
+		{"Wm1", LambertWm1, 0.5, math.NaN()},
+		{"Wm1", LambertWm1, -0.5, math.NaN()},
+	} {
+		got := test.fn(test.x)
+		if math.IsNaN(test.want) {
+			if !math.IsNaN(got) {
+				t.Errorf("unexpected Lambert%s(%v): got:%v want:NaN", test.name, test.x, got)
+			}
+			continue
+		}
+		if !scalar.EqualWithinAbsOrRel(got, test.want, 1e-15, 1e-15) {
+			t.Errorf("unexpected Lambert%s(%v): got:%v want:%v", test.name, test.x, got, test.want)
+		}
+	}
+}
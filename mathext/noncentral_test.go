@@ -0,0 +1,149 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestGammaIncRegDeriv(t *testing.T) {
+	t.Parallel()
+	for _, a := range []float64{0.5, 1, 2.5, 10, 40} {
+		for _, x := range []float64{0.1, 1, 3, 12, 50} {
+			got := GammaIncRegDeriv(a, x)
+
+			// Check the recurrence in a.
+			want := (GammaIncReg(a, x) - GammaIncReg(a+1, x)) * a / x
+			if !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-8) {
+				t.Errorf("unexpected GammaIncRegDeriv(%v, %v): got:%v want:%v", a, x, got, want)
+			}
+		}
+	}
+	if got := GammaIncRegDeriv(1, 0); got != 1 {
+		t.Errorf("unexpected GammaIncRegDeriv(1, 0): got:%v want:1", got)
+	}
+	if got := GammaIncRegDeriv(0.5, 0); !math.IsInf(got, 1) {
+		t.Errorf("unexpected GammaIncRegDeriv(0.5, 0): got:%v want:+Inf", got)
+	}
+}
+
+func TestRegIncBetaDeriv(t *testing.T) {
+	t.Parallel()
+	const h = 1e-6
+	for _, a := range []float64{0.5, 1, 2.5, 10} {
+		for _, b := range []float64{0.5, 1, 3, 7} {
+			for _, x := range []float64{0.05, 0.3, 0.5, 0.9} {
+				got := RegIncBetaDeriv(a, b, x)
+				want := (RegIncBeta(a, b, x+h) - RegIncBeta(a, b, x-h)) / (2 * h)
+				if !scalar.EqualWithinAbsOrRel(got, want, 1e-7, 1e-7) {
+					t.Errorf("unexpected RegIncBetaDeriv(%v, %v, %v): got:%v want:%v", a, b, x, got, want)
+				}
+			}
+		}
+	}
+	for _, test := range []struct {
+		a, b, x, want float64
+	}{
+		{a: 1, b: 3, x: 0, want: 3},
+		{a: 2, b: 3, x: 0, want: 0},
+		{a: 0.5, b: 3, x: 0, want: math.Inf(1)},
+		{a: 4, b: 1, x: 1, want: 4},
+		{a: 4, b: 2, x: 1, want: 0},
+	} {
+		if got := RegIncBetaDeriv(test.a, test.b, test.x); got != test.want {
+			t.Errorf("unexpected RegIncBetaDeriv(%v, %v, %v): got:%v want:%v", test.a, test.b, test.x, got, test.want)
+		}
+	}
+}
+
+func TestMarcumQ(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		m, a, b, want float64
+	}{
+		// Results computed using the Poisson mixture series
+		// in arbitrary precision arithmetic.
+		{1, 1, 1, 0.73287980379682027},
+		{1, 0.5, 2, 0.16914063850946717},
+		{2, 3, 1, 0.99804974984265937},
+		{3, 2, 4, 0.12993458054619234},
+		{5, 10, 12, 0.055991130144650292},
+		{1, 20, 15, 0.99999975320094703},
+		{1, 3, 8, 4.7596497368338938e-07},
+		{4, 0, 2, 0.85712346049854704},
+		{2, 7, 2, 0.99999996319958551},
+	} {
+		got := MarcumQ(test.m, test.a, test.b)
+		if !scalar.EqualWithinAbsOrRel(got, test.want, 1e-300, 1e-11) {
+			t.Errorf("unexpected MarcumQ(%v, %v, %v): got:%v want:%v", test.m, test.a, test.b, got, test.want)
+		}
+	}
+
+	// Q_1(0,b) = exp(-b²/2).
+	for _, b := range []float64{0, 0.5, 1, 3, 10} {
+		got := MarcumQ(1, 0, b)
+		want := math.Exp(-b * b / 2)
+		if !scalar.EqualWithinAbsOrRel(got, want, 1e-300, 1e-13) {
+			t.Errorf("unexpected MarcumQ(1, 0, %v): got:%v want:%v", b, got, want)
+		}
+	}
+}
+
+func TestNoncentralGammaIncReg(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		a, lambda, x, want float64
+	}{
+		// Results computed using the Poisson mixture series
+		// in arbitrary precision arithmetic.
+		{2, 3, 4, 0.41948425108770682},
+		{1, 0.5, 0.2, 0.11534729895370352},
+		{3, 10, 30, 0.99783537756507712},
+		{5, 1, 1, 0.0015809281437018572},
+	} {
+		got := NoncentralGammaIncReg(test.a, test.lambda, test.x)
+		if !scalar.EqualWithinAbsOrRel(got, test.want, 1e-300, 1e-12) {
+			t.Errorf("unexpected NoncentralGammaIncReg(%v, %v, %v): got:%v want:%v", test.a, test.lambda, test.x, got, test.want)
+		}
+		comp := NoncentralGammaIncRegComp(test.a, test.lambda, test.x)
+		if !scalar.EqualWithinAbsOrRel(comp, 1-test.want, 1e-15, 1e-12) {
+			t.Errorf("unexpected NoncentralGammaIncRegComp(%v, %v, %v): got:%v want:%v", test.a, test.lambda, test.x, comp, 1-test.want)
+		}
+	}
+	for _, x := range []float64{0.5, 2, 10} {
+		got := NoncentralGammaIncReg(2.5, 0, x)
+		want := GammaIncReg(2.5, x)
+		if got != want {
+			t.Errorf("unexpected central NoncentralGammaIncReg(2.5, 0, %v): got:%v want:%v", x, got, want)
+		}
+	}
+}
+
+func TestNoncentralRegIncBeta(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		a, b, lambda, x float64
+		want, wantComp  float64
+	}{
+		// Results computed using the Poisson mixture series
+		// in arbitrary precision arithmetic.
+		{2, 3, 1.5, 0.4, 0.28315462638702082, 0.71684537361297918},
+		{1, 1, 0.5, 0.5, 0.38940039153570244, 0.61059960846429762},
+		{3, 5, 10, 0.7, 0.41150169119742241, 0.58849830880257759},
+		{4, 2, 2, 0.1, 7.901286856991839e-05, 0.99992098713143007},
+	} {
+		got := NoncentralRegIncBeta(test.a, test.b, test.lambda, test.x)
+		if !scalar.EqualWithinAbsOrRel(got, test.want, 1e-300, 1e-12) {
+			t.Errorf("unexpected NoncentralRegIncBeta(%v, %v, %v, %v): got:%v want:%v", test.a, test.b, test.lambda, test.x, got, test.want)
+		}
+		comp := NoncentralRegIncBetaComp(test.a, test.b, test.lambda, test.x)
+		if !scalar.EqualWithinAbsOrRel(comp, test.wantComp, 1e-300, 1e-12) {
+			t.Errorf("unexpected NoncentralRegIncBetaComp(%v, %v, %v, %v): got:%v want:%v", test.a, test.b, test.lambda, test.x, comp, test.wantComp)
+		}
+	}
+}
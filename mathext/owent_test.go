@@ -0,0 +1,86 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestOwenT(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		h, a, want float64
+	}{
+		// Results computed using Owen's series in
+		// arbitrary precision arithmetic.
+		{0.0625, 0.25, 0.038911930234701367},
+		{6.5, 0.4375, 2.0005773048508314e-11},
+		{7, 0.96875, 6.3990627193898686e-13},
+		{4.78125, 0.0625, 1.0632974804687464e-07},
+		{2, 0.5, 0.0086250779855215065},
+		{1, 0.9999975, 0.066741808978228595},
+		{0.5, 1, 0.10667106296144852},
+		{0.1, 0.9, 0.1159172175253603},
+		{3, 0.1, 0.00017361822571328552},
+		{0, 0.7, 0.097200056107107385},
+		{1.5, 2, 0.033383245362167337},
+		{0.3, 5, 0.18887156345661174},
+		{2.5, 3, 0.0031048326628880457},
+		{5, 1.5, 1.433257859395941e-07},
+		{0.01, 100, 0.24767297891854384},
+		{8, 0.5, 3.1103239107887044e-16},
+		{1, 1, 0.066741882165700969},
+	} {
+		for _, sign := range []float64{1, -1} {
+			// T is even in h and odd in a.
+			got := OwenT(sign*test.h, sign*test.a)
+			want := sign * test.want
+			if !scalar.EqualWithinAbsOrRel(got, want, 1e-300, 1e-13) {
+				t.Errorf("unexpected OwenT(%v, %v): got:%v want:%v", sign*test.h, sign*test.a, got, want)
+			}
+		}
+	}
+
+	for _, test := range []struct {
+		h, a, want float64
+	}{
+		{h: 1, a: 0, want: 0},
+		{h: math.Inf(1), a: 1, want: 0},
+		{h: 0, a: math.Inf(1), want: 0.25},
+		{h: 1, a: math.Inf(1), want: 0.079327626965728578},
+		{h: 0, a: 1, want: 0.125},
+	} {
+		got := OwenT(test.h, test.a)
+		if !scalar.EqualWithinAbsOrRel(got, test.want, 1e-300, 1e-14) {
+			t.Errorf("unexpected OwenT(%v, %v): got:%v want:%v", test.h, test.a, got, test.want)
+		}
+	}
+	if got := OwenT(math.NaN(), 1); !math.IsNaN(got) {
+		t.Errorf("unexpected OwenT(NaN, 1): got:%v want:NaN", got)
+	}
+}
+
+func TestLegendre20(t *testing.T) {
+	t.Parallel()
+	x, w := legendre20()
+	// The 20-point rule is exact for polynomials
+	// of degree up to 39.
+	for d := 0; d < 40; d++ {
+		var got float64
+		for i, xi := range x {
+			got += w[i] * math.Pow(xi, float64(d))
+		}
+		var want float64
+		if d%2 == 0 {
+			want = 2 / float64(d+1)
+		}
+		if math.Abs(got-want) > 1e-14 {
+			t.Errorf("unexpected integral of x^%d: got:%v want:%v", d, got, want)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// Polylog returns the polylogarithm of integer order n, Li_n(z), on the
+// principal branch.
+//
+// For |z| < 1, Li_n(z) is defined by the power series
+//
+//	Li_n(z) = \sum_{k=1}^∞ z^k / k^n
+//
+// and is analytically continued to the rest of the complex plane. For n <= 0
+// Li_n is a rational function of z with a pole at z = 1. For n >= 1 Li_n has
+// a branch cut on the real axis for z in the interval (1, ∞), and for real
+// z > 1 the value with negative imaginary part is returned, in agreement with
+// Li2. Polylog(2, z) is equal to Li2(z).
+//
+// The implementation uses the power series for |z| <= 1/2, the expansion in
+// powers of log(z) for 1/2 < |z| < 2, and the inversion formula relating
+// Li_n(z) and Li_n(1/z) for |z| >= 2.
+//
+// See Wood, "The computation of polylogarithms", Technical Report 15-92,
+// University of Kent 1992 for details of the expansions.
+func Polylog(n int, z complex128) complex128 {
+	switch {
+	case cmplx.IsNaN(z):
+		return cmplx.NaN()
+	case z == 0:
+		return 0
+	case n == 0:
+		return z / (1 - z)
+	case n == 1:
+		return -cmplx.Log(1 - z)
+	case n < 0:
+		return polylogNeg(-n, z)
+	case z == 1:
+		return complex(Zeta(float64(n), 1), 0)
+	}
+	if imag(z) == 0 && real(z) > 1 {
+		// Take the value below the branch cut.
+		z = complex(real(z), math.Copysign(0, -1))
+	}
+	switch r := cmplx.Abs(z); {
+	case r <= 0.5:
+		return polylogSeries(n, z)
+	case r < 2:
+		return polylogLogSeries(n, z)
+	default:
+		return polylogInversion(n, z)
+	}
+}
+
+// polylogSeries returns Li_n(z) for |z| <= 1/2 by direct summation.
+func polylogSeries(n int, z complex128) complex128 {
+	var sum complex128
+	zk := z
+	for k := 1; ; k++ {
+		t := zk / complex(math.Pow(float64(k), float64(n)), 0)
+		sum += t
+		if cmplx.Abs(t) <= dlamchE*cmplx.Abs(sum) {
+			return sum
+		}
+		zk *= z
+	}
+}
+
+// polylogLogSeries returns Li_n(z) for n >= 2 and z != 1 using the
+// expansion in μ = log(z),
+//
+//	Li_n(e^μ) = μ^{n-1}/(n-1)! (H_{n-1} - log(-μ)) + \sum_{k≠n-1} ζ(n-k) μ^k/k!,
+//
+// which converges for |μ| < 2π.
+func polylogLogSeries(n int, z complex128) complex128 {
+	mu := cmplx.Log(z)
+
+	// Terms with k < n have ζ at arguments of at least 2.
+	var sum complex128
+	pk := complex(1, 0) // μ^k/k!
+	for k := 0; k < n; k++ {
+		if k == n-1 {
+			var h float64
+			for i := 1; i < n; i++ {
+				h += 1 / float64(i)
+			}
+			sum += pk * (complex(h, 0) - cmplx.Log(-mu))
+		} else {
+			sum += pk * complex(Zeta(float64(n-k), 1), 0)
+		}
+		pk *= mu / complex(float64(k+1), 0)
+	}
+
+	// Terms with k >= n have ζ at non-positive integers. ζ(0) = -1/2,
+	// ζ vanishes at negative even integers and at negative odd integers
+	//  ζ(1-2j) = (-1)^j 2 (2j-1)! ζ(2j) / (2π)^{2j}.
+	sum += pk * -0.5
+	c := 2 / (4 * math.Pi * math.Pi) // 2 (2j-1)! / (2π)^{2j} for j = 1.
+	for j := 1; ; j++ {
+		// Advance pk from k = n + 2j - 2 to n + 2j - 1.
+		k := n + 2*j - 2
+		pk *= mu / complex(float64(k+1), 0)
+		zeta := c * Zeta(float64(2*j), 1)
+		if j%2 == 1 {
+			zeta = -zeta
+		}
+		t := pk * complex(zeta, 0)
+		sum += t
+		if cmplx.Abs(t) <= dlamchE*cmplx.Abs(sum) {
+			return sum
+		}
+		pk *= mu / complex(float64(k+2), 0)
+		c *= float64(2*j) * float64(2*j+1) / (4 * math.Pi * math.Pi)
+	}
+}
+
+// polylogInversion returns Li_n(z) for n >= 2 and |z| >= 2 using the
+// inversion formula
+//
+//	Li_n(z) + (-1)^n Li_n(1/z) = -(2πi)^n/n! B_n(1/2 + log(-z)/(2πi)),
+//
+// where B_n is the Bernoulli polynomial, written in terms of u = πi + log(-z)
+// as
+//
+//	-(u^n/n! - πi u^{n-1}/(n-1)! - 2 \sum_{j=1}^{⌊n/2⌋} ζ(2j) u^{n-2j}/(n-2j)!).
+func polylogInversion(n int, z complex128) complex128 {
+	u := complex(0, math.Pi) + cmplx.Log(-z)
+
+	// uk[k] holds u^k/k!.
+	uk := make([]complex128, n+1)
+	uk[0] = 1
+	for k := 1; k <= n; k++ {
+		uk[k] = uk[k-1] * u / complex(float64(k), 0)
+	}
+	b := uk[n] - complex(0, math.Pi)*uk[n-1]
+	for j := 1; 2*j <= n; j++ {
+		b -= complex(2*Zeta(float64(2*j), 1), 0) * uk[n-2*j]
+	}
+
+	inv := polylogSeries(n, 1/z)
+	if n%2 == 0 {
+		return -b - inv
+	}
+	return -b + inv
+}
+
+// polylogNeg returns Li_{-m}(z) for m >= 1 using
+//
+//	Li_{-m}(z) = \sum_{k=0}^m k! S(m+1, k+1) (z/(1-z))^{k+1},
+//
+// where S is the Stirling number of the second kind.
+func polylogNeg(m int, z complex128) complex128 {
+	// s holds the row S(m+1, ·) of Stirling numbers,
+	// computed by S(i, j) = j S(i-1, j) + S(i-1, j-1).
+	s := make([]float64, m+2)
+	s[0] = 1
+	for i := 1; i <= m+1; i++ {
+		for j := i; j >= 1; j-- {
+			s[j] = float64(j)*s[j] + s[j-1]
+		}
+		s[0] = 0
+	}
+	w := z / (1 - z)
+	var sum complex128
+	wk := w
+	fact := 1.0
+	for k := 0; k <= m; k++ {
+		if k > 0 {
+			fact *= float64(k)
+		}
+		sum += complex(fact*s[k+1], 0) * wk
+		wk *= w
+	}
+	return sum
+}
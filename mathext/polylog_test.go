@@ -0,0 +1,109 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func cmplxEqualApprox(a, b complex128, tol float64) bool {
+	return cmplx.Abs(a-b) <= tol*math.Max(1, cmplx.Abs(b))
+}
+
+func TestPolylogValues(t *testing.T) {
+	t.Parallel()
+	zeta3 := Zeta(3, 1)
+	ln2 := math.Ln2
+	for _, test := range []struct {
+		n    int
+		z    complex128
+		want complex128
+	}{
+		{3, 0.5, complex(7.0/8*zeta3-math.Pi*math.Pi/12*ln2+ln2*ln2*ln2/6, 0)},
+		{3, 2, complex(7.0/8*zeta3+math.Pi*math.Pi/4*ln2, -math.Pi*ln2*ln2/2)},
+		{3, 1, complex(zeta3, 0)},
+		{3, -1, complex(-0.75*zeta3, 0)},
+		{4, -1, complex(-7.0/8*math.Pow(math.Pi, 4)/90, 0)},
+		{5, 1, complex(Zeta(5, 1), 0)},
+		{1, 0.5, complex(ln2, 0)},
+		{0, 0.5, 1},
+		{-1, 0.5, 2},
+		{-2, 0.5, 6},
+		{-3, -2, complex(-2*(1-8+4)/81.0, 0)},
+		{2, 0, 0},
+	} {
+		got := Polylog(test.n, test.z)
+		if !cmplxEqualApprox(got, test.want, 1e-14) {
+			t.Errorf("unexpected Polylog(%d, %v): got:%v want:%v", test.n, test.z, got, test.want)
+		}
+	}
+}
+
+var polylogZ = []complex128{
+	0.3, -0.4, complex(0.2, 0.3), 0.7, -0.9, complex(0.5, -0.6), complex(-1.2, 0.8),
+	complex(0, 1), 1.5, -1.9, complex(1.9, 0.1), 2.5, -3, complex(4, -5), 100, -1e4, complex(-2, 1e-3),
+}
+
+func TestPolylogLi2(t *testing.T) {
+	t.Parallel()
+	for _, z := range polylogZ {
+		got := Polylog(2, z)
+		want := Li2(z)
+		if !cmplxEqualApprox(got, want, 1e-13) {
+			t.Errorf("unexpected Polylog(2, %v): got:%v want:%v", z, got, want)
+		}
+	}
+}
+
+func TestPolylogRecurrence(t *testing.T) {
+	t.Parallel()
+	// z d/dz Li_n(z) = Li_{n-1}(z).
+	const h = 1e-5
+	for n := -3; n <= 8; n++ {
+		for _, z := range polylogZ {
+			if imag(z) == 0 && real(z) > 1 {
+				// Skip points on the branch cut.
+				continue
+			}
+			step := complex(h*math.Max(1, cmplx.Abs(z)), 0)
+			d := (Polylog(n, z+step) - Polylog(n, z-step)) / (2 * step)
+			got := z * d
+			want := Polylog(n-1, z)
+			if !cmplxEqualApprox(got, want, 1e-8) {
+				t.Errorf("unexpected derivative of Polylog(%d, %v): got:%v want:%v", n, z, got, want)
+			}
+		}
+	}
+}
+
+func TestPolylogExpansions(t *testing.T) {
+	t.Parallel()
+	// Check agreement between the expansions
+	// where their regions of validity overlap.
+	for n := 2; n <= 10; n++ {
+		for _, r := range []float64{0.45, 0.5, 0.55} {
+			for _, theta := range []float64{0, 0.5, 1.5, 3} {
+				z := cmplx.Rect(r, theta)
+				got := polylogLogSeries(n, z)
+				want := polylogSeries(n, z)
+				if !cmplxEqualApprox(got, want, 1e-14) {
+					t.Errorf("mismatch at |z|=%v for n=%d z=%v: got:%v want:%v", r, n, z, got, want)
+				}
+			}
+		}
+		for _, r := range []float64{1.9, 2, 2.5} {
+			for _, theta := range []float64{0.1, 1.5, 3, -2} {
+				z := cmplx.Rect(r, theta)
+				got := polylogLogSeries(n, z)
+				want := polylogInversion(n, z)
+				if !cmplxEqualApprox(got, want, 1e-13) {
+					t.Errorf("mismatch at |z|=%v for n=%d z=%v: got:%v want:%v", r, n, z, got, want)
+				}
+			}
+		}
+	}
+}
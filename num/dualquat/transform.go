@@ -0,0 +1,221 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dualquat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/num/quat"
+	"gonum.org/v1/gonum/spatial/r3"
+)
+
+// The functions in this file treat unit dual quaternions as rigid
+// transformations in ℝ³. Points, vectors and translations are represented
+// as pure quaternions, with the real part ignored on input and zero on
+// output.
+
+// NewTransform returns the unit dual quaternion for the rigid transformation
+// that rotates by the unit quaternion rot and then translates by trans,
+//
+//	rot + ½ trans rot ϵ.
+func NewTransform(rot, trans quat.Number) Number {
+	trans.Real = 0
+	return Number{Real: rot, Dual: quat.Scale(0.5, quat.Mul(trans, rot))}
+}
+
+// Rotation returns the unit quaternion representing the rotation of the
+// rigid transformation d.
+func Rotation(d Number) quat.Number {
+	return quat.Scale(1/quat.Abs(d.Real), d.Real)
+}
+
+// Translation returns the translation of the rigid transformation d,
+// 2 d₂ d̅₁ / |d₁|² for d = d₁+d₂ϵ.
+func Translation(d Number) quat.Number {
+	n := quat.Abs(d.Real)
+	t := quat.Scale(2/(n*n), quat.Mul(d.Dual, quat.Conj(d.Real)))
+	t.Real = 0
+	return t
+}
+
+// Normalize returns the unit dual quaternion nearest to d, removing the
+// drift that accumulates when composing many transformations. The real part
+// is scaled to unit length and the component of the dual part parallel to
+// the real part is removed.
+func Normalize(d Number) Number {
+	n := quat.Abs(d.Real)
+	r := quat.Scale(1/n, d.Real)
+	e := quat.Scale(1/n, d.Dual)
+	return Number{Real: r, Dual: quat.Sub(e, quat.Scale(dot4(r, e), r))}
+}
+
+// Compose returns the rigid transformation that applies the transformations
+// in d in order, d[0] first. Compose returns the identity transformation if
+// d is empty.
+func Compose(d ...Number) Number {
+	c := Number{Real: quat.Number{Real: 1}}
+	for _, t := range d {
+		c = Mul(t, c)
+	}
+	return c
+}
+
+// InvTransform returns the inverse of the rigid transformation d. For a unit
+// dual quaternion this is the quaternion conjugate, ConjQuat(d).
+func InvTransform(d Number) Number {
+	return ConjQuat(d)
+}
+
+// TransformPoint returns the point p transformed by the rigid
+// transformation d, d (1+pϵ) Conj(d).
+func TransformPoint(d Number, p quat.Number) quat.Number {
+	return quat.Add(TransformVector(d, p), Translation(d))
+}
+
+// TransformVector returns the vector v rotated by the rigid transformation
+// d. Vectors such as directions and velocities are unaffected by the
+// translation of d.
+func TransformVector(d Number, v quat.Number) quat.Number {
+	r := Rotation(d)
+	v.Real = 0
+	v = quat.Mul(quat.Mul(r, v), quat.Conj(r))
+	v.Real = 0
+	return v
+}
+
+// NewLine returns the line through the point p with direction dir in
+// Plücker coordinates, the dual quaternion u + (p×u)ϵ where u is the unit
+// vector in the direction of dir.
+func NewLine(p, dir quat.Number) Number {
+	dir.Real = 0
+	u := quat.Scale(1/quat.Abs(dir), dir)
+	return Number{Real: u, Dual: cross(p, u)}
+}
+
+// TransformLine returns the line l in Plücker coordinates, as returned by
+// NewLine, transformed by the rigid transformation d, d l d⁻¹.
+func TransformLine(d Number, l Number) Number {
+	l = Mul(Mul(d, l), ConjQuat(d))
+	l.Real.Real = 0
+	l.Dual.Real = 0
+	return l
+}
+
+// Homogeneous returns the 4×4 homogeneous transformation matrix
+//
+//	[R t]
+//	[0 1]
+//
+// of the rigid transformation d, where R is the rotation matrix and t the
+// translation of d.
+func Homogeneous(d Number) *mat.Dense {
+	r := r3.Rotation(Rotation(d)).Mat()
+	t := Translation(d)
+	m := mat.NewDense(4, 4, nil)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			m.Set(i, j, r.At(i, j))
+		}
+	}
+	m.Set(0, 3, t.Imag)
+	m.Set(1, 3, t.Jmag)
+	m.Set(2, 3, t.Kmag)
+	m.Set(3, 3, 1)
+	return m
+}
+
+// FromHomogeneous returns the unit dual quaternion for the rigid
+// transformation given by the 4×4 homogeneous transformation matrix m.
+// The upper left 3×3 block of m must be a rotation matrix and the last row
+// of m is ignored. FromHomogeneous panics with mat.ErrShape if m is not
+// 4×4.
+func FromHomogeneous(m mat.Matrix) Number {
+	r, c := m.Dims()
+	if r != 4 || c != 4 {
+		panic(mat.ErrShape)
+	}
+	rot := r3.NewMat(nil)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			rot.Set(i, j, m.At(i, j))
+		}
+	}
+	trans := quat.Number{Imag: m.At(0, 3), Jmag: m.At(1, 3), Kmag: m.At(2, 3)}
+	return NewTransform(quat.Number(r3.NewRotationFromMat(rot)), trans)
+}
+
+// Sclerp returns the screw linear interpolation between the rigid
+// transformations d0 and d1 at t,
+//
+//	Sclerp(d0, d1, t) = d0 (d0⁻¹ d1)^t,
+//
+// which moves with constant linear and angular velocity along the screw
+// motion taking d0 to d1. Sclerp(d0, d1, 0) returns d0 and Sclerp(d0, d1, 1)
+// returns d1 or -d1. As with quat.Slerp, the shorter path is taken.
+//
+// See Kavan, Collins, O'Sullivan and Žára, "Dual quaternions for rigid
+// transformation blending", Technical report TCD-CS-2006-46,
+// Trinity College Dublin, 2006 for details.
+func Sclerp(d0, d1 Number, t float64) Number {
+	delta := Mul(ConjQuat(d0), d1)
+	if delta.Real.Real < 0 {
+		delta = Scale(-1, delta)
+	}
+	return Mul(d0, screwPow(Normalize(delta), t))
+}
+
+// screwPow returns the unit dual quaternion d raised to the power t.
+//
+// The screw parameters of d are the rotation angle θ about the unit axis l
+// and the displacement s along l. With moment m of the axis, d^t is
+//
+//	(cos(tθ/2), sin(tθ/2) l) + ϵ(-ts/2 sin(tθ/2), sin(tθ/2) m + ts/2 cos(tθ/2) l).
+//
+// The moment term is expressed in terms of the translation to retain
+// accuracy for small angles.
+func screwPow(d Number, t float64) Number {
+	w := d.Real.Real
+	v := d.Real
+	v.Real = 0
+	sinHalf := quat.Abs(v)
+	if sinHalf == 0 {
+		// Pure translation.
+		return Number{Real: quat.Number{Real: 1}, Dual: quat.Scale(t, d.Dual)}
+	}
+	half := math.Atan2(sinHalf, w)
+	l := quat.Scale(1/sinHalf, v)
+	trans := Translation(d)
+	s := dot4(trans, l)
+
+	st, ct := math.Sincos(t * half)
+	// sin(tθ/2) m = sin(tθ/2)/2 (trans×l + cot(θ/2) (trans - s l)).
+	k := st / sinHalf * w
+	dv := quat.Add(
+		quat.Scale(0.5*st, cross(trans, l)),
+		quat.Scale(0.5*k, quat.Sub(trans, quat.Scale(s, l))),
+	)
+	dv = quat.Add(dv, quat.Scale(0.5*t*s*ct, l))
+	dv.Real = -0.5 * t * s * st
+
+	r := quat.Scale(st, l)
+	r.Real = ct
+	return Number{Real: r, Dual: dv}
+}
+
+// dot4 returns the dot product of p and q treated as 4-vectors.
+func dot4(p, q quat.Number) float64 {
+	return p.Real*q.Real + p.Imag*q.Imag + p.Jmag*q.Jmag + p.Kmag*q.Kmag
+}
+
+// cross returns the cross product of the vector parts of p and q as a pure
+// quaternion.
+func cross(p, q quat.Number) quat.Number {
+	return quat.Number{
+		Imag: p.Jmag*q.Kmag - p.Kmag*q.Jmag,
+		Jmag: p.Kmag*q.Imag - p.Imag*q.Kmag,
+		Kmag: p.Imag*q.Jmag - p.Jmag*q.Imag,
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dualquat
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/num/quat"
+)
+
+func randRotation(rnd *rand.Rand) quat.Number {
+	q := quat.Number{Real: rnd.NormFloat64(), Imag: rnd.NormFloat64(), Jmag: rnd.NormFloat64(), Kmag: rnd.NormFloat64()}
+	return quat.Scale(1/quat.Abs(q), q)
+}
+
+func randVec(rnd *rand.Rand) quat.Number {
+	return quat.Number{Imag: rnd.NormFloat64(), Jmag: rnd.NormFloat64(), Kmag: rnd.NormFloat64()}
+}
+
+func randTransform(rnd *rand.Rand) Number {
+	return NewTransform(randRotation(rnd), quat.Scale(3, randVec(rnd)))
+}
+
+// axisRotation returns the unit quaternion for a rotation by alpha around
+// the unit axis (x, y, z).
+func axisRotation(alpha, x, y, z float64) quat.Number {
+	s, c := math.Sincos(alpha / 2)
+	return quat.Number{Real: c, Imag: s * x, Jmag: s * y, Kmag: s * z}
+}
+
+func quatClose(a, b quat.Number, tol float64) bool {
+	return quat.Abs(quat.Sub(a, b)) <= tol
+}
+
+// sameTransform returns whether a and b represent the same rigid
+// transformation, allowing for the sign ambiguity of the representation.
+func sameTransform(a, b Number, tol float64) bool {
+	diff := Sub(a, b)
+	sum := Add(a, b)
+	return quat.Abs(diff.Real)+quat.Abs(diff.Dual) <= tol || quat.Abs(sum.Real)+quat.Abs(sum.Dual) <= tol
+}
+
+func TestTransformPoint(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 20; i++ {
+		rot := randRotation(rnd)
+		trans := randVec(rnd)
+		d := NewTransform(rot, trans)
+
+		if got := Rotation(d); !quatClose(got, rot, tol) {
+			t.Errorf("unexpected rotation: got:%v want:%v", got, rot)
+		}
+		if got := Translation(d); !quatClose(got, trans, tol) {
+			t.Errorf("unexpected translation: got:%v want:%v", got, trans)
+		}
+
+		p := randVec(rnd)
+		want := quat.Add(quat.Mul(quat.Mul(rot, p), quat.Conj(rot)), trans)
+		if got := TransformPoint(d, p); !quatClose(got, want, tol) {
+			t.Errorf("unexpected transformed point: got:%v want:%v", got, want)
+		}
+		// Check against the dual quaternion sandwich product.
+		pp := Mul(Mul(d, Number{Real: quat.Number{Real: 1}, Dual: p}), Conj(d))
+		if !quatClose(pp.Dual, want, tol) {
+			t.Errorf("unexpected sandwich product: got:%v want:%v", pp.Dual, want)
+		}
+		if got, want := TransformVector(d, p), quat.Sub(want, trans); !quatClose(got, want, tol) {
+			t.Errorf("unexpected transformed vector: got:%v want:%v", got, want)
+		}
+	}
+}
+
+func TestComposeInv(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 20; i++ {
+		a, b, c := randTransform(rnd), randTransform(rnd), randTransform(rnd)
+		p := randVec(rnd)
+
+		want := TransformPoint(c, TransformPoint(b, TransformPoint(a, p)))
+		if got := TransformPoint(Compose(a, b, c), p); !quatClose(got, want, tol) {
+			t.Errorf("unexpected composed transform: got:%v want:%v", got, want)
+		}
+		if got := TransformPoint(InvTransform(a), TransformPoint(a, p)); !quatClose(got, p, tol) {
+			t.Errorf("unexpected inverse transform: got:%v want:%v", got, p)
+		}
+	}
+	if got := Compose(); got != (Number{Real: quat.Number{Real: 1}}) {
+		t.Errorf("unexpected empty composition: got:%v", got)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 20; i++ {
+		d := randTransform(rnd)
+		// Perturb d away from the unit dual quaternions.
+		noisy := Add(Scale(1.1, d), Scale(1e-3, randTransform(rnd)))
+		got := Normalize(noisy)
+		if n := quat.Abs(got.Real); !scalar.EqualWithinAbs(n, 1, tol) {
+			t.Errorf("unexpected real modulus: got:%v want:1", n)
+		}
+		if p := dot4(got.Real, got.Dual); !scalar.EqualWithinAbs(p, 0, tol) {
+			t.Errorf("unexpected dot product of real and dual parts: got:%v want:0", p)
+		}
+		if got := Normalize(d); !sameTransform(got, d, tol) {
+			t.Errorf("unexpected normalization of unit dual quaternion: got:%v want:%v", got, d)
+		}
+	}
+}
+
+func TestHomogeneous(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 20; i++ {
+		d := randTransform(rnd)
+		m := Homogeneous(d)
+
+		p := randVec(rnd)
+		var hp mat.VecDense
+		hp.MulVec(m, mat.NewVecDense(4, []float64{p.Imag, p.Jmag, p.Kmag, 1}))
+		got := quat.Number{Imag: hp.AtVec(0), Jmag: hp.AtVec(1), Kmag: hp.AtVec(2)}
+		if want := TransformPoint(d, p); !quatClose(got, want, tol) || hp.AtVec(3) != 1 {
+			t.Errorf("unexpected homogeneous transform: got:%v want:%v", got, want)
+		}
+
+		if got := FromHomogeneous(m); !sameTransform(got, d, tol) {
+			t.Errorf("unexpected transform from homogeneous matrix: got:%v want:%v", got, d)
+		}
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r != mat.ErrShape {
+				t.Errorf("unexpected panic for 3×3 matrix: got:%v want:%v", r, mat.ErrShape)
+			}
+		}()
+		FromHomogeneous(mat.NewDense(3, 3, nil))
+	}()
+}
+
+func TestTransformLine(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 20; i++ {
+		d := randTransform(rnd)
+		p, dir := randVec(rnd), randVec(rnd)
+		want := NewLine(TransformPoint(d, p), TransformVector(d, dir))
+		got := TransformLine(d, NewLine(p, dir))
+		if !quatClose(got.Real, want.Real, tol) || !quatClose(got.Dual, want.Dual, tol) {
+			t.Errorf("unexpected transformed line: got:%v want:%v", got, want)
+		}
+
+		// The moment is independent of the choice of point on the line.
+		q := quat.Add(p, quat.Scale(rnd.NormFloat64(), dir))
+		if l := NewLine(q, dir); !quatClose(l.Dual, NewLine(p, dir).Dual, tol) {
+			t.Errorf("unexpected moment: got:%v want:%v", l.Dual, NewLine(p, dir).Dual)
+		}
+	}
+}
+
+func TestSclerp(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	for _, test := range []struct {
+		name   string
+		d0, d1 Number
+		t      float64
+		want   Number
+	}{
+		{
+			name: "translation",
+			d0:   NewTransform(quat.Number{Real: 1}, quat.Number{}),
+			d1:   NewTransform(quat.Number{Real: 1}, quat.Number{Imag: 2, Jmag: -4}),
+			t:    0.25,
+			want: NewTransform(quat.Number{Real: 1}, quat.Number{Imag: 0.5, Jmag: -1}),
+		},
+		{
+			name: "screw",
+			d0:   NewTransform(quat.Number{Real: 1}, quat.Number{}),
+			d1:   NewTransform(axisRotation(2, 0, 0, 1), quat.Number{Kmag: 3}),
+			t:    0.5,
+			want: NewTransform(axisRotation(1, 0, 0, 1), quat.Number{Kmag: 1.5}),
+		},
+		{
+			// Rotation by π/2 about the z axis through (1, 0, 0)
+			// moves the origin to (1, -1, 0). Half way it is rotated
+			// by π/4 about the same axis.
+			name: "offset axis",
+			d0:   NewTransform(quat.Number{Real: 1}, quat.Number{}),
+			d1:   NewTransform(axisRotation(math.Pi/2, 0, 0, 1), quat.Number{Imag: 1, Jmag: -1}),
+			t:    0.5,
+			want: NewTransform(axisRotation(math.Pi/4, 0, 0, 1), quat.Number{Imag: 1 - math.Sqrt2/2, Jmag: -math.Sqrt2 / 2}),
+		},
+		{
+			// The shorter path is taken for opposite signs.
+			name: "sign",
+			d0:   NewTransform(quat.Number{Real: 1}, quat.Number{}),
+			d1:   Scale(-1, NewTransform(axisRotation(1, 1, 0, 0), quat.Number{Jmag: 1})),
+			t:    1,
+			want: NewTransform(axisRotation(1, 1, 0, 0), quat.Number{Jmag: 1}),
+		},
+	} {
+		got := Sclerp(test.d0, test.d1, test.t)
+		if !sameTransform(got, test.want, tol) {
+			t.Errorf("unexpected Sclerp for %s: got:%v want:%v", test.name, got, test.want)
+		}
+	}
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 20; i++ {
+		d0, d1 := randTransform(rnd), randTransform(rnd)
+		if got := Sclerp(d0, d1, 0); !sameTransform(got, d0, tol) {
+			t.Errorf("unexpected Sclerp at 0: got:%v want:%v", got, d0)
+		}
+		if got := Sclerp(d0, d1, 1); !sameTransform(got, d1, tol) {
+			t.Errorf("unexpected Sclerp at 1: got:%v want:%v", got, d1)
+		}
+		// Motion along the screw has constant velocity.
+		delta := Normalize(Mul(ConjQuat(d0), d1))
+		a, b := rnd.Float64(), rnd.Float64()
+		got := Mul(screwPow(delta, a), screwPow(delta, b))
+		if want := screwPow(delta, a+b); !sameTransform(got, want, tol) {
+			t.Errorf("unexpected screw power: got:%v want:%v", got, want)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Slerp returns the spherical linear interpolation between the unit
+// quaternions q0 and q1 at t. Slerp(q0, q1, 0) returns q0 and
+// Slerp(q0, q1, 1) returns q1 or -q1.
+//
+// Since q and -q represent the same rotation, Slerp follows the shorter
+// great arc between q0 and q1 by negating q1 when the dot product of q0
+// and q1 is negative. This gives the interpolation with the smallest
+// rotation angle between the two orientations.
+func Slerp(q0, q1 Number, t float64) Number {
+	if dot(q0, q1) < 0 {
+		q1 = Scale(-1, q1)
+	}
+	return slerp(q0, q1, t)
+}
+
+// slerp returns the spherical linear interpolation between the unit
+// quaternions q0 and q1 at t along the great arc joining them.
+func slerp(q0, q1 Number, t float64) Number {
+	// The angle θ between q0 and q1 as 4-vectors is computed from the
+	// chord lengths to retain accuracy for nearly parallel quaternions.
+	theta := 2 * math.Atan2(Abs(Sub(q1, q0)), Abs(Add(q1, q0)))
+	s := math.Sin(theta)
+	if s == 0 {
+		return q0
+	}
+	return Add(Scale(math.Sin((1-t)*theta)/s, q0), Scale(math.Sin(t*theta)/s, q1))
+}
+
+// Squad returns the spherical quadrangle interpolation between the unit
+// quaternions q0 and q1 at t, using the control points s0 and s1,
+//
+//	Squad(q0, q1, s0, s1, t) = slerp(slerp(q0, q1, t), slerp(s0, s1, t), 2t(1-t)).
+//
+// When the control points are obtained from SquadControl, interpolation
+// over a sequence of quaternions is smooth at the keyframes. Unlike Slerp,
+// Squad does not negate its arguments, so the sequence of quaternions should
+// be chosen with non-negative dot products between neighbours.
+func Squad(q0, q1, s0, s1 Number, t float64) Number {
+	return slerp(slerp(q0, q1, t), slerp(s0, s1, t), 2*t*(1-t))
+}
+
+// SquadControl returns the Squad control point for the unit quaternion q
+// with neighbours prev and next in a sequence of quaternions,
+//
+//	s = q exp(-(log(q⁻¹ next) + log(q⁻¹ prev))/4).
+//
+// At the ends of a sequence, q may be passed as prev or next.
+func SquadControl(prev, q, next Number) Number {
+	if dot(q, prev) < 0 {
+		prev = Scale(-1, prev)
+	}
+	if dot(q, next) < 0 {
+		next = Scale(-1, next)
+	}
+	inv := Conj(q)
+	l := Add(Log(Mul(inv, next)), Log(Mul(inv, prev)))
+	return Mul(q, Exp(Scale(-0.25, l)))
+}
+
+// Average returns the weighted average of the rotations represented by the
+// unit quaternions in q. If weights is nil, all quaternions are weighted
+// equally, otherwise weights must have the same length as q.
+//
+// The average is the unit quaternion maximizing the weighted sum of squared
+// dot products with the elements of q, which is the eigenvector of the
+// largest eigenvalue of \sum_i w_i q_i q_iᵀ. It is insensitive to the signs
+// of the elements of q, and the sign of the result is chosen to have a
+// non-negative dot product with q[0].
+//
+// See Markley, Cheng, Crassidis and Oshman, "Averaging quaternions",
+// Journal of Guidance, Control, and Dynamics 30:1193-1197.
+// doi:10.2514/1.28949 for details.
+//
+// Average panics if q is empty or if the lengths of q and weights differ.
+// If the eigen decomposition fails, Average returns NaN.
+func Average(q []Number, weights []float64) Number {
+	if len(q) == 0 {
+		panic("quat: no quaternions to average")
+	}
+	if weights != nil && len(weights) != len(q) {
+		panic("quat: mismatched slice lengths")
+	}
+	m := mat.NewSymDense(4, nil)
+	for i, v := range q {
+		w := 1.0
+		if weights != nil {
+			w = weights[i]
+		}
+		e := [4]float64{v.Real, v.Imag, v.Jmag, v.Kmag}
+		for r := 0; r < 4; r++ {
+			for c := r; c < 4; c++ {
+				m.SetSym(r, c, m.At(r, c)+w*e[r]*e[c])
+			}
+		}
+	}
+	var eig mat.EigenSym
+	if !eig.Factorize(m, true) {
+		return NaN()
+	}
+	var vecs mat.Dense
+	eig.VectorsTo(&vecs)
+	// Eigenvalues are returned in ascending order.
+	avg := Number{Real: vecs.At(0, 3), Imag: vecs.At(1, 3), Jmag: vecs.At(2, 3), Kmag: vecs.At(3, 3)}
+	if dot(avg, q[0]) < 0 {
+		avg = Scale(-1, avg)
+	}
+	return unit(avg)
+}
+
+// dot returns the dot product of q and r treated as 4-vectors.
+func dot(q, r Number) float64 {
+	return q.Real*r.Real + q.Imag*r.Imag + q.Jmag*r.Jmag + q.Kmag*r.Kmag
+}
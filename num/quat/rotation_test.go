@@ -0,0 +1,158 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quat
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+// rotation returns the unit quaternion for a rotation by alpha around the
+// unit axis (x, y, z).
+func rotation(alpha, x, y, z float64) Number {
+	s, c := math.Sincos(alpha / 2)
+	return Number{Real: c, Imag: s * x, Jmag: s * y, Kmag: s * z}
+}
+
+func randUnit(rnd *rand.Rand) Number {
+	q := Number{Real: rnd.NormFloat64(), Imag: rnd.NormFloat64(), Jmag: rnd.NormFloat64(), Kmag: rnd.NormFloat64()}
+	return unit(q)
+}
+
+func TestSlerp(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	for _, test := range []struct {
+		q0, q1 Number
+		t      float64
+		want   Number
+	}{
+		{q0: rotation(0, 1, 0, 0), q1: rotation(1, 1, 0, 0), t: 0.25, want: rotation(0.25, 1, 0, 0)},
+		{q0: rotation(0.5, 0, 1, 0), q1: rotation(2.5, 0, 1, 0), t: 0.5, want: rotation(1.5, 0, 1, 0)},
+		// The shorter path from 0.1 to 2π-0.1 passes through zero.
+		{q0: rotation(0.1, 0, 0, 1), q1: rotation(2*math.Pi-0.1, 0, 0, 1), t: 0.5, want: rotation(0, 0, 0, 1)},
+		{q0: rotation(1, 0, 0, 1), q1: rotation(1, 0, 0, 1), t: 0.7, want: rotation(1, 0, 0, 1)},
+		{q0: rotation(1, 0, 0, 1), q1: rotation(1+1e-10, 0, 0, 1), t: 0.5, want: rotation(1+0.5e-10, 0, 0, 1)},
+	} {
+		got := Slerp(test.q0, test.q1, test.t)
+		if !equalApprox(got, test.want, tol) {
+			t.Errorf("unexpected Slerp(%v, %v, %v): got:%v want:%v", test.q0, test.q1, test.t, got, test.want)
+		}
+	}
+
+	// Slerp agrees with (q1 q0⁻¹)^t q0 for quaternions on the same
+	// hemisphere.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 20; i++ {
+		q0, q1 := randUnit(rnd), randUnit(rnd)
+		if dot(q0, q1) < 0 {
+			q1 = Scale(-1, q1)
+		}
+		for _, tt := range []float64{0, 0.3, 0.5, 1} {
+			want := Mul(PowReal(Mul(q1, Conj(q0)), tt), q0)
+			got := Slerp(q0, q1, tt)
+			if !equalApprox(got, want, 1e-13) {
+				t.Errorf("unexpected Slerp(%v, %v, %v): got:%v want:%v", q0, q1, tt, got, want)
+			}
+		}
+	}
+}
+
+func TestSquad(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 10; i++ {
+		q := make([]Number, 4)
+		for j := range q {
+			q[j] = randUnit(rnd)
+			if j > 0 && dot(q[j], q[j-1]) < 0 {
+				q[j] = Scale(-1, q[j])
+			}
+		}
+		s1 := SquadControl(q[0], q[1], q[2])
+		s2 := SquadControl(q[1], q[2], q[3])
+		if got := Squad(q[1], q[2], s1, s2, 0); !equalApprox(got, q[1], tol) {
+			t.Errorf("unexpected Squad at t=0: got:%v want:%v", got, q[1])
+		}
+		if got := Squad(q[1], q[2], s1, s2, 1); !equalApprox(got, q[2], tol) {
+			t.Errorf("unexpected Squad at t=1: got:%v want:%v", got, q[2])
+		}
+		if got := Abs(Squad(q[1], q[2], s1, s2, 0.4)); !scalar.EqualWithinAbs(got, 1, tol) {
+			t.Errorf("unexpected Squad modulus: got:%v want:1", got)
+		}
+	}
+
+	// For evenly spaced rotations around a single axis, the control
+	// points are the keyframes and Squad reduces to Slerp.
+	q0, q1, q2, q3 := rotation(0, 1, 0, 0), rotation(0.4, 1, 0, 0), rotation(0.8, 1, 0, 0), rotation(1.2, 1, 0, 0)
+	s1 := SquadControl(q0, q1, q2)
+	s2 := SquadControl(q1, q2, q3)
+	if !equalApprox(s1, q1, tol) || !equalApprox(s2, q2, tol) {
+		t.Errorf("unexpected control points: got:%v %v want:%v %v", s1, s2, q1, q2)
+	}
+	if got, want := Squad(q1, q2, s1, s2, 0.25), rotation(0.5, 1, 0, 0); !equalApprox(got, want, tol) {
+		t.Errorf("unexpected Squad: got:%v want:%v", got, want)
+	}
+}
+
+func TestAverage(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	for _, test := range []struct {
+		q       []Number
+		weights []float64
+		want    Number
+	}{
+		{
+			q:    []Number{rotation(0.7, 0, 1, 0)},
+			want: rotation(0.7, 0, 1, 0),
+		},
+		{
+			q:    []Number{rotation(0.3, 1, 0, 0), rotation(-0.3, 1, 0, 0)},
+			want: rotation(0, 1, 0, 0),
+		},
+		{
+			// The sign of the inputs does not affect the average.
+			q:    []Number{rotation(0.3, 0, 0, 1), Scale(-1, rotation(0.5, 0, 0, 1))},
+			want: rotation(0.4, 0, 0, 1),
+		},
+		{
+			q:       []Number{rotation(0, 0, 0, 1), rotation(1, 0, 0, 1), rotation(2, 0, 0, 1)},
+			weights: []float64{1, 0, 1},
+			want:    rotation(1, 0, 0, 1),
+		},
+		{
+			q:       []Number{rotation(1, 0, 1, 0), rotation(2, 0, 1, 0)},
+			weights: []float64{0, 2},
+			want:    rotation(2, 0, 1, 0),
+		},
+	} {
+		got := Average(test.q, test.weights)
+		if !equalApprox(got, test.want, tol) {
+			t.Errorf("unexpected Average(%v, %v): got:%v want:%v", test.q, test.weights, got, test.want)
+		}
+	}
+
+	for _, test := range []struct {
+		q       []Number
+		weights []float64
+	}{
+		{q: nil},
+		{q: []Number{rotation(0, 1, 0, 0)}, weights: []float64{1, 2}},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic for Average(%v, %v)", test.q, test.weights)
+				}
+			}()
+			Average(test.q, test.weights)
+		}()
+	}
+}
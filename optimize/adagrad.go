@@ -0,0 +1,97 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import "math"
+
+var (
+	_ Method      = (*AdaGrad)(nil)
+	_ localMethod = (*AdaGrad)(nil)
+)
+
+// AdaGrad implements the AdaGrad stochastic gradient method described in
+//
+//	Duchi, J., Hazan, E. and Singer, Y. "Adaptive subgradient methods for
+//	online learning and stochastic optimization", Journal of Machine
+//	Learning Research 12:2121-2159 (2011).
+//
+// AdaGrad scales the step in each coordinate by the inverse square root of
+// the sum of the squares of all of the gradient estimates so far, so that
+// rarely updated coordinates take larger steps. Like SGD, each major
+// iteration is a single step; see the documentation of SGD for the choice of
+// convergence settings.
+type AdaGrad struct {
+	// LearningRate is the schedule of the learning rate.
+	// If LearningRate is nil, a constant rate of 0.01
+	// is used.
+	LearningRate LearningRate
+	// Epsilon is added to the denominator of the step for
+	// numerical stability. If Epsilon is 0, a default of
+	// 1e-8 is used.
+	Epsilon float64
+
+	sum []float64
+	eps float64
+
+	driver stochasticDriver
+	status Status
+	err    error
+}
+
+func (a *AdaGrad) Status() (Status, error) {
+	return a.status, a.err
+}
+
+func (*AdaGrad) Uses(has Available) (uses Available, err error) {
+	return has.gradient()
+}
+
+func (a *AdaGrad) Init(dim, tasks int) int {
+	a.status = NotTerminated
+	a.err = nil
+	return 1
+}
+
+func (a *AdaGrad) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	a.status, a.err = localOptimizer{}.run(a, math.NaN(), operation, result, tasks)
+	close(operation)
+}
+
+func (a *AdaGrad) initLocal(loc *Location) (Operation, error) {
+	return a.driver.initLocal(a, loc)
+}
+
+func (a *AdaGrad) iterateLocal(loc *Location) (Operation, error) {
+	return a.driver.iterateLocal(a, loc)
+}
+
+func (a *AdaGrad) needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return a.driver.needs()
+}
+
+func (a *AdaGrad) initStep(dim int) {
+	if a.Epsilon < 0 {
+		panic("adagrad: negative epsilon")
+	}
+	a.eps = a.Epsilon
+	if a.eps == 0 {
+		a.eps = 1e-8
+	}
+	a.sum = resize(a.sum, dim)
+	for i := range a.sum {
+		a.sum[i] = 0
+	}
+}
+
+func (a *AdaGrad) step(x, grad []float64, t int) {
+	rate := learningRate(a.LearningRate, 0.01, t)
+	for i, g := range grad {
+		a.sum[i] += g * g
+		x[i] -= rate * g / (math.Sqrt(a.sum[i]) + a.eps)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import "math"
+
+var (
+	_ Method      = (*Adam)(nil)
+	_ localMethod = (*Adam)(nil)
+)
+
+// Adam implements the Adam stochastic gradient method described in
+//
+//	Kingma, D. P. and Ba, J. "Adam: A method for stochastic optimization",
+//	International Conference on Learning Representations (2015).
+//	https://arxiv.org/abs/1412.6980
+//
+// Adam scales the step in each coordinate using exponential moving averages
+// of the gradient estimates and of their squares. Like SGD, each major
+// iteration is a single step; see the documentation of SGD for the choice of
+// convergence settings.
+type Adam struct {
+	// LearningRate is the schedule of the learning rate.
+	// If LearningRate is nil, a constant rate of 0.001
+	// is used.
+	LearningRate LearningRate
+	// Beta1 and Beta2 are the decay factors of the moving
+	// averages of the gradient and of its square, and must
+	// be in [0, 1). If they are 0, the defaults of 0.9 and
+	// 0.999 are used.
+	Beta1, Beta2 float64
+	// Epsilon is added to the denominator of the step for
+	// numerical stability. If Epsilon is 0, a default of
+	// 1e-8 is used.
+	Epsilon float64
+
+	m, v []float64
+
+	beta1, beta2, eps float64
+
+	driver stochasticDriver
+	status Status
+	err    error
+}
+
+func (a *Adam) Status() (Status, error) {
+	return a.status, a.err
+}
+
+func (*Adam) Uses(has Available) (uses Available, err error) {
+	return has.gradient()
+}
+
+func (a *Adam) Init(dim, tasks int) int {
+	a.status = NotTerminated
+	a.err = nil
+	return 1
+}
+
+func (a *Adam) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	a.status, a.err = localOptimizer{}.run(a, math.NaN(), operation, result, tasks)
+	close(operation)
+}
+
+func (a *Adam) initLocal(loc *Location) (Operation, error) {
+	return a.driver.initLocal(a, loc)
+}
+
+func (a *Adam) iterateLocal(loc *Location) (Operation, error) {
+	return a.driver.iterateLocal(a, loc)
+}
+
+func (a *Adam) needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return a.driver.needs()
+}
+
+func (a *Adam) initStep(dim int) {
+	if a.Beta1 < 0 || 1 <= a.Beta1 || a.Beta2 < 0 || 1 <= a.Beta2 {
+		panic("adam: decay factor out of range")
+	}
+	if a.Epsilon < 0 {
+		panic("adam: negative epsilon")
+	}
+	a.beta1 = a.Beta1
+	if a.beta1 == 0 {
+		a.beta1 = 0.9
+	}
+	a.beta2 = a.Beta2
+	if a.beta2 == 0 {
+		a.beta2 = 0.999
+	}
+	a.eps = a.Epsilon
+	if a.eps == 0 {
+		a.eps = 1e-8
+	}
+	a.m = resize(a.m, dim)
+	a.v = resize(a.v, dim)
+	for i := range a.m {
+		a.m[i] = 0
+		a.v[i] = 0
+	}
+}
+
+func (a *Adam) step(x, grad []float64, t int) {
+	rate := learningRate(a.LearningRate, 0.001, t)
+	// Correct the bias of the moving averages
+	// towards their zero initial values.
+	c1 := 1 - math.Pow(a.beta1, float64(t+1))
+	c2 := 1 - math.Pow(a.beta2, float64(t+1))
+	for i, g := range grad {
+		a.m[i] = a.beta1*a.m[i] + (1-a.beta1)*g
+		a.v[i] = a.beta2*a.v[i] + (1-a.beta2)*g*g
+		x[i] -= rate * (a.m[i] / c1) / (math.Sqrt(a.v[i]/c2) + a.eps)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bayesopt
+
+import (
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+var (
+	_ Acquisition = ExpectedImprovement{}
+	_ Acquisition = UpperConfidenceBound{}
+	_ Acquisition = ProbabilityOfImprovement{}
+)
+
+// Acquisition is an acquisition function that scores candidate points for
+// minimization from the posterior distribution of the objective.
+type Acquisition interface {
+	// Value returns the score of a point where the posterior of the
+	// objective has the given mean and standard deviation, and best
+	// is the lowest observed value. Points with higher scores are
+	// preferred.
+	Value(mean, std, best float64) float64
+}
+
+// ExpectedImprovement is the expected improvement acquisition function
+//
+//	E[max(best - f - Xi, 0)],
+//
+// where Xi ≥ 0 encourages exploration.
+type ExpectedImprovement struct {
+	Xi float64
+}
+
+// Value returns the expected improvement over best.
+func (e ExpectedImprovement) Value(mean, std, best float64) float64 {
+	imp := best - mean - e.Xi
+	if std == 0 {
+		return max(imp, 0)
+	}
+	z := imp / std
+	return imp*distuv.UnitNormal.CDF(z) + std*distuv.UnitNormal.Prob(z)
+}
+
+// ProbabilityOfImprovement is the probability of improvement acquisition
+// function
+//
+//	P(f < best - Xi),
+//
+// where Xi ≥ 0 encourages exploration.
+type ProbabilityOfImprovement struct {
+	Xi float64
+}
+
+// Value returns the probability of improving on best by at least Xi.
+func (p ProbabilityOfImprovement) Value(mean, std, best float64) float64 {
+	imp := best - mean - p.Xi
+	if std == 0 {
+		if imp > 0 {
+			return 1
+		}
+		return 0
+	}
+	return distuv.UnitNormal.CDF(imp / std)
+}
+
+// UpperConfidenceBound is the upper confidence bound acquisition function
+// of the negated objective,
+//
+//	-mean + Kappa std,
+//
+// where Kappa ≥ 0 controls the trade-off between exploitation and
+// exploration.
+type UpperConfidenceBound struct {
+	Kappa float64
+}
+
+// Value returns the upper confidence bound of the negated objective.
+func (u UpperConfidenceBound) Value(mean, std, best float64) float64 {
+	return -mean + u.Kappa*std
+}
@@ -0,0 +1,375 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bayesopt
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+	"gonum.org/v1/gonum/stat"
+	"gonum.org/v1/gonum/stat/gp"
+)
+
+// Optimizer performs Bayesian optimization of a function within the box
+// given by Lower and Upper. The evaluations of the function are reported
+// with Observe, and new points to evaluate are obtained from Suggest, so the
+// function may be evaluated outside of the program, or several points may be
+// evaluated concurrently. Minimize runs the complete optimization for a
+// function that can be called directly.
+//
+// The objective is modeled by a Gaussian process over the box scaled to the
+// unit cube, fitted to the standardized observed values.
+type Optimizer struct {
+	// Lower and Upper are the bounds of the box
+	// in which the function is minimized. They
+	// must have the same length and Lower[i]
+	// must be less than Upper[i].
+	Lower, Upper []float64
+
+	// Kernel is the covariance function of the
+	// Gaussian process over the unit cube. If
+	// Kernel is nil, a Matérn 5/2 kernel with unit
+	// variance and length scale 0.2 is used.
+	Kernel gp.Kernel
+	// Noise is the variance of the observation
+	// noise relative to the variance of the observed
+	// values. If Noise is zero, a default of 1e-6
+	// is used for numerical stability.
+	Noise float64
+	// FixKernel specifies that the hyperparameters
+	// of the kernel and the noise are not fitted to
+	// the observations by maximum likelihood.
+	FixKernel bool
+
+	// Acquisition is the acquisition function that is
+	// maximized to choose the next points. If it is
+	// nil, ExpectedImprovement{Xi: 0.01} is used.
+	Acquisition Acquisition
+	// Candidates is the number of random candidate
+	// points from which the acquisition function is
+	// maximized. If Candidates is zero, a default of
+	// 1000 is used.
+	Candidates int
+
+	// InitialPoints is the number of points of the
+	// initial space-filling design used by Minimize.
+	// If InitialPoints is zero, a default of twice the
+	// dimension plus one is used.
+	InitialPoints int
+	// Batch is the number of points suggested and
+	// evaluated in each iteration of Minimize. If Batch
+	// is zero, a default of one is used.
+	Batch int
+
+	// Src is the source of randomness. If Src is nil,
+	// the global source is used.
+	Src rand.Source
+
+	x   [][]float64
+	y   []float64
+	rnd *rand.Rand
+	reg *gp.Regressor
+}
+
+// dim returns the dimension of the problem, and panics if the bounds are
+// invalid.
+func (o *Optimizer) dim() int {
+	n := len(o.Lower)
+	if n == 0 {
+		panic("bayesopt: zero dimension")
+	}
+	if len(o.Upper) != n {
+		panic("bayesopt: bounds length mismatch")
+	}
+	for i, l := range o.Lower {
+		if !(l < o.Upper[i]) {
+			panic("bayesopt: invalid bounds")
+		}
+	}
+	return n
+}
+
+// Observe records the value f of the function at x. Observe panics if x is
+// outside of the bounds, or if f is NaN or infinite.
+func (o *Optimizer) Observe(x []float64, f float64) {
+	n := o.dim()
+	if len(x) != n {
+		panic("bayesopt: dimension mismatch")
+	}
+	for i, v := range x {
+		if v < o.Lower[i] || o.Upper[i] < v {
+			panic("bayesopt: point out of bounds")
+		}
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		panic("bayesopt: invalid function value")
+	}
+	o.x = append(o.x, append([]float64(nil), x...))
+	o.y = append(o.y, f)
+}
+
+// Best returns the observed point with the lowest function value and its
+// value. Best panics if no values have been observed.
+func (o *Optimizer) Best() (x []float64, f float64) {
+	if len(o.y) == 0 {
+		panic("bayesopt: no observations")
+	}
+	i := floats.MinIdx(o.y)
+	return append([]float64(nil), o.x[i]...), o.y[i]
+}
+
+// Suggest returns n points at which to evaluate the function next. With
+// fewer than two observations the points are a space-filling random design.
+// Otherwise, a Gaussian process is fitted to the observations and the points
+// are chosen in turn by maximizing the acquisition function, each time
+// treating the previously chosen points as observed with their predicted
+// mean values. Suggest returns an error if the Gaussian process cannot be
+// fitted.
+func (o *Optimizer) Suggest(n int) ([][]float64, error) {
+	dim := o.dim()
+	if n <= 0 {
+		panic("bayesopt: non-positive number of points")
+	}
+	if o.rnd == nil {
+		src := o.Src
+		if src == nil {
+			src = rand.NewPCG(rand.Uint64(), rand.Uint64())
+		}
+		o.rnd = rand.New(src)
+	}
+	if len(o.y) < 2 {
+		return o.latinHypercube(n), nil
+	}
+
+	// Standardize the observations and scale
+	// the points to the unit cube.
+	mean, std := stat.MeanStdDev(o.y, nil)
+	if std == 0 || math.IsNaN(std) {
+		std = 1
+	}
+	m := len(o.y)
+	u := mat.NewDense(m, dim, nil)
+	for i, x := range o.x {
+		o.toUnit(u.RawRowView(i), x)
+	}
+	y := make([]float64, m)
+	for i, v := range o.y {
+		y[i] = (v - mean) / std
+	}
+	if err := o.fit(u, y); err != nil {
+		return nil, err
+	}
+
+	acq := o.Acquisition
+	if acq == nil {
+		acq = ExpectedImprovement{Xi: 0.01}
+	}
+	points := make([][]float64, n)
+	for k := range points {
+		best := floats.Min(y)
+		next := o.maximize(acq, u, y, best)
+		points[k] = o.fromUnit(make([]float64, dim), next)
+		if k == n-1 {
+			break
+		}
+		// Treat the chosen point as observed with its
+		// predicted mean, the kriging believer heuristic.
+		pred, _ := o.reg.Predict(next)
+		grown := mat.NewDense(m+k+1, dim, nil)
+		grown.Slice(0, m+k, 0, dim).(*mat.Dense).Copy(u)
+		copy(grown.RawRowView(m+k), next)
+		u = grown
+		y = append(y, pred)
+		if err := o.reg.Fit(u, y); err != nil {
+			return nil, err
+		}
+	}
+	return points, nil
+}
+
+// fit fits the Gaussian process to the standardized observations y at the
+// points in the rows of u.
+func (o *Optimizer) fit(u *mat.Dense, y []float64) error {
+	if o.reg == nil {
+		k := o.Kernel
+		if k == nil {
+			k = &gp.Matern{Variance: 1, LengthScale: 0.2, Nu: 2.5}
+		}
+		noise := o.Noise
+		if noise == 0 {
+			noise = 1e-6
+		}
+		o.reg = gp.NewRegressor(k, noise)
+	}
+	if !o.FixKernel && len(y) > 2 {
+		if o.reg.Optimize(u, y, nil, nil) == nil {
+			return nil
+		}
+		// Keep the previous hyperparameters if the
+		// likelihood could not be maximized.
+	}
+	return o.reg.Fit(u, y)
+}
+
+// maximize returns the point in the unit cube that approximately maximizes
+// the acquisition function. The acquisition function is evaluated at random
+// candidates and at perturbations of the best observed points, and the best
+// candidate is refined by local optimization.
+func (o *Optimizer) maximize(acq Acquisition, u *mat.Dense, y []float64, best float64) []float64 {
+	m, dim := u.Dims()
+	value := func(x []float64) float64 {
+		mean, variance := o.reg.Predict(x)
+		return acq.Value(mean, math.Sqrt(variance), best)
+	}
+	nc := o.Candidates
+	if nc == 0 {
+		nc = 1000
+	}
+
+	cand := make([]float64, dim)
+	bestCand := make([]float64, dim)
+	bestVal := math.Inf(-1)
+	try := func() {
+		if v := value(cand); v > bestVal {
+			bestVal = v
+			copy(bestCand, cand)
+		}
+	}
+	for i := 0; i < nc; i++ {
+		for j := range cand {
+			cand[j] = o.rnd.Float64()
+		}
+		try()
+	}
+	order := make([]int, m)
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool { return y[order[i]] < y[order[j]] })
+	const (
+		local  = 5
+		perLoc = 20
+		spread = 0.05
+	)
+	for _, i := range order[:min(local, m)] {
+		for k := 0; k < perLoc; k++ {
+			for j := range cand {
+				cand[j] = clamp(u.At(i, j) + spread*o.rnd.NormFloat64())
+			}
+			try()
+		}
+	}
+
+	// Refine the best candidate, evaluating the acquisition
+	// function at the nearest point of the unit cube.
+	x := make([]float64, dim)
+	p := optimize.Problem{
+		Func: func(v []float64) float64 {
+			for j, vj := range v {
+				x[j] = clamp(vj)
+			}
+			return -value(x)
+		},
+	}
+	settings := &optimize.Settings{FuncEvaluations: 50 * dim}
+	nm := &optimize.NelderMead{SimplexSize: 0.05}
+	res, err := optimize.Minimize(p, bestCand, settings, nm)
+	if err == nil && -res.F > bestVal {
+		for j, v := range res.X {
+			bestCand[j] = clamp(v)
+		}
+	}
+	return bestCand
+}
+
+// latinHypercube returns n points forming a Latin hypercube design in the
+// box.
+func (o *Optimizer) latinHypercube(n int) [][]float64 {
+	dim := len(o.Lower)
+	points := make([][]float64, n)
+	for i := range points {
+		points[i] = make([]float64, dim)
+	}
+	perm := make([]int, n)
+	for j := 0; j < dim; j++ {
+		for i := range perm {
+			perm[i] = i
+		}
+		o.rnd.Shuffle(n, func(a, b int) { perm[a], perm[b] = perm[b], perm[a] })
+		for i, p := range points {
+			v := (float64(perm[i]) + o.rnd.Float64()) / float64(n)
+			p[j] = o.Lower[j] + v*(o.Upper[j]-o.Lower[j])
+		}
+	}
+	return points
+}
+
+// toUnit stores the point x scaled to the unit cube in dst.
+func (o *Optimizer) toUnit(dst, x []float64) {
+	for i, v := range x {
+		dst[i] = (v - o.Lower[i]) / (o.Upper[i] - o.Lower[i])
+	}
+}
+
+// fromUnit stores the point u in the unit cube scaled to the box in dst and
+// returns dst.
+func (o *Optimizer) fromUnit(dst, u []float64) []float64 {
+	for i, v := range u {
+		dst[i] = o.Lower[i] + v*(o.Upper[i]-o.Lower[i])
+		// Guard against rounding outside of the box.
+		dst[i] = math.Min(math.Max(dst[i], o.Lower[i]), o.Upper[i])
+	}
+	return dst
+}
+
+func clamp(v float64) float64 {
+	return math.Min(math.Max(v, 0), 1)
+}
+
+// Minimize minimizes f within the bounds of the receiver using evals
+// evaluations of f, including any previously observed values. It starts with
+// a space-filling design of InitialPoints points if fewer values have been
+// observed, and then evaluates Batch suggested points in each iteration.
+// Minimize returns the best observed point and its value. It returns an
+// error if a suggestion fails, along with the best point found so far.
+func (o *Optimizer) Minimize(f func(x []float64) float64, evals int) (x []float64, fx float64, err error) {
+	dim := o.dim()
+	initial := o.InitialPoints
+	if initial == 0 {
+		initial = 2*dim + 1
+	}
+	batch := o.Batch
+	if batch == 0 {
+		batch = 1
+	}
+	for len(o.y) < evals {
+		var points [][]float64
+		if len(o.y) < initial {
+			if o.rnd == nil {
+				// Let Suggest initialize the
+				// random source.
+				_, _ = o.Suggest(1)
+			}
+			points = o.latinHypercube(min(initial, evals) - len(o.y))
+		} else {
+			points, err = o.Suggest(min(batch, evals-len(o.y)))
+			if err != nil {
+				break
+			}
+		}
+		for _, p := range points {
+			o.Observe(p, f(p))
+		}
+	}
+	if len(o.y) == 0 {
+		panic("bayesopt: no evaluations")
+	}
+	x, fx = o.Best()
+	return x, fx, err
+}
@@ -0,0 +1,69 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bayesopt_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/optimize/bayesopt"
+)
+
+func ExampleOptimizer_Minimize() {
+	// The function stands in for an expensive
+	// objective, such as the validation error
+	// of a model as a function of two of its
+	// hyperparameters.
+	f := func(x []float64) float64 {
+		return math.Pow(x[0]-1, 2) + math.Pow(x[1]+2, 2) + 0.5*math.Sin(3*x[0])
+	}
+
+	o := &bayesopt.Optimizer{
+		Lower: []float64{-5, -5},
+		Upper: []float64{5, 5},
+		Src:   rand.NewPCG(1, 1),
+	}
+	x, fx, err := o.Minimize(f, 30)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("x = %.1f\n", x)
+	fmt.Printf("f(x) = %.2f\n", fx)
+
+	// Output:
+	// x = [1.3 -2.0]
+	// f(x) = -0.25
+}
+
+func ExampleOptimizer_Suggest() {
+	// Suggest and Observe allow the objective to be
+	// evaluated elsewhere, here in batches of three
+	// points that could be evaluated concurrently.
+	f := func(x []float64) float64 {
+		return math.Pow(x[0]-0.3, 2)
+	}
+
+	o := &bayesopt.Optimizer{
+		Lower: []float64{-1},
+		Upper: []float64{1},
+		Src:   rand.NewPCG(1, 1),
+	}
+	for i := 0; i < 5; i++ {
+		points, err := o.Suggest(3)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, p := range points {
+			o.Observe(p, f(p))
+		}
+	}
+	x, _ := o.Best()
+	fmt.Printf("x = %.2f\n", x)
+
+	// Output:
+	// x = [0.30]
+}
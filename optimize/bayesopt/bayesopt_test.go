@@ -0,0 +1,209 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bayesopt
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+func panics(fun func()) (b bool) {
+	defer func() {
+		err := recover()
+		if err != nil {
+			b = true
+		}
+	}()
+	fun()
+	return
+}
+
+func TestAcquisition(t *testing.T) {
+	// Compare with numerical integration over the
+	// posterior distribution of the objective.
+	for _, test := range []struct {
+		mean, std, best, xi float64
+	}{
+		{mean: 0, std: 1, best: 0, xi: 0},
+		{mean: 1, std: 0.5, best: 0.2, xi: 0.1},
+		{mean: -1, std: 2, best: 0.5, xi: 0.01},
+	} {
+		n := distuv.Normal{Mu: test.mean, Sigma: test.std}
+		target := test.best - test.xi
+		const steps = 200000
+		lo, hi := test.mean-10*test.std, test.mean+10*test.std
+		h := (hi - lo) / steps
+		var ei float64
+		for i := 0; i < steps; i++ {
+			f := lo + (float64(i)+0.5)*h
+			ei += math.Max(target-f, 0) * n.Prob(f) * h
+		}
+		got := ExpectedImprovement{Xi: test.xi}.Value(test.mean, test.std, test.best)
+		if !scalar.EqualWithinAbsOrRel(got, ei, 1e-8, 1e-6) {
+			t.Errorf("unexpected expected improvement for %+v: got %v, want %v", test, got, ei)
+		}
+		got = ProbabilityOfImprovement{Xi: test.xi}.Value(test.mean, test.std, test.best)
+		if want := n.CDF(target); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+			t.Errorf("unexpected probability of improvement for %+v: got %v, want %v", test, got, want)
+		}
+	}
+	if got := (ExpectedImprovement{}).Value(-1, 0, 1); got != 2 {
+		t.Errorf("unexpected deterministic expected improvement: got %v, want 2", got)
+	}
+	if got := (UpperConfidenceBound{Kappa: 2}).Value(1, 0.5, 0); got != 0 {
+		t.Errorf("unexpected upper confidence bound: got %v, want 0", got)
+	}
+}
+
+// branin is the Branin–Hoo function on [-5, 10]×[0, 15] with global minimum
+// value 0.397887 at three points.
+func branin(x []float64) float64 {
+	const (
+		a = 1
+		b = 5.1 / (4 * math.Pi * math.Pi)
+		c = 5 / math.Pi
+		r = 6
+		s = 10
+		t = 1 / (8 * math.Pi)
+	)
+	v := x[1] - b*x[0]*x[0] + c*x[0] - r
+	return a*v*v + s*(1-t)*math.Cos(x[0]) + s
+}
+
+func TestMinimize(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		f      func([]float64) float64
+		lower  []float64
+		upper  []float64
+		acq    Acquisition
+		batch  int
+		evals  int
+		want   float64
+		within float64
+	}{
+		{
+			name:  "quadratic",
+			f:     func(x []float64) float64 { return (x[0]-0.3)*(x[0]-0.3) + 2*(x[1]+0.5)*(x[1]+0.5) },
+			lower: []float64{-2, -2}, upper: []float64{2, 2},
+			evals: 25, want: 0, within: 1e-2,
+		},
+		{
+			name:  "branin",
+			f:     branin,
+			lower: []float64{-5, 0}, upper: []float64{10, 15},
+			evals: 40, want: 0.397887, within: 0.1,
+		},
+		{
+			name:  "branin ucb",
+			f:     branin,
+			lower: []float64{-5, 0}, upper: []float64{10, 15},
+			acq:   UpperConfidenceBound{Kappa: 2},
+			evals: 40, want: 0.397887, within: 0.1,
+		},
+		{
+			name:  "branin batch",
+			f:     branin,
+			lower: []float64{-5, 0}, upper: []float64{10, 15},
+			batch: 4,
+			evals: 45, want: 0.397887, within: 0.1,
+		},
+		{
+			name:  "sine",
+			f:     func(x []float64) float64 { return math.Sin(3*x[0]) + 0.1*x[0]*x[0] },
+			lower: []float64{-4}, upper: []float64{4},
+			evals: 15, want: -0.97322, within: 2e-2,
+		},
+	} {
+		o := &Optimizer{
+			Lower:       test.lower,
+			Upper:       test.upper,
+			Acquisition: test.acq,
+			Batch:       test.batch,
+			Src:         rand.NewPCG(1, 1),
+		}
+		var evals int
+		f := func(x []float64) float64 {
+			evals++
+			for i, v := range x {
+				if v < test.lower[i] || test.upper[i] < v {
+					t.Errorf("%s: evaluation out of bounds: %v", test.name, x)
+				}
+			}
+			return test.f(x)
+		}
+		x, fx, err := o.Minimize(f, test.evals)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if evals != test.evals {
+			t.Errorf("%s: unexpected number of evaluations: got %d, want %d", test.name, evals, test.evals)
+		}
+		if fx != test.f(x) {
+			t.Errorf("%s: returned value does not match returned point", test.name)
+		}
+		if fx-test.want > test.within {
+			t.Errorf("%s: minimum not found: got f(%v) = %v, want %v", test.name, x, fx, test.want)
+		}
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	lower := []float64{-1, 2, 10}
+	upper := []float64{1, 3, 20}
+	o := &Optimizer{Lower: lower, Upper: upper, Src: rand.NewPCG(1, 2)}
+	f := func(x []float64) float64 { return x[0]*x[0] + (x[1]-2.5)*(x[1]-2.5) + 0.01*x[2] }
+
+	for _, n := range []int{5, 1, 4} {
+		points, err := o.Suggest(n)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(points) != n {
+			t.Fatalf("unexpected number of points: got %d, want %d", len(points), n)
+		}
+		for i, p := range points {
+			for j, v := range p {
+				if v < lower[j] || upper[j] < v {
+					t.Errorf("point out of bounds: %v", p)
+				}
+			}
+			// The points of a batch must be distinct.
+			for _, q := range points[:i] {
+				var dist float64
+				for j := range p {
+					d := (p[j] - q[j]) / (upper[j] - lower[j])
+					dist += d * d
+				}
+				if dist < 1e-8 {
+					t.Errorf("repeated point in batch: %v", p)
+				}
+			}
+			o.Observe(p, f(p))
+		}
+	}
+	x, fx := o.Best()
+	if fx != f(x) {
+		t.Errorf("best value does not match best point")
+	}
+
+	if !panics(func() { o.Observe([]float64{0, 0, 15}, 1) }) {
+		t.Errorf("expected panic for point out of bounds")
+	}
+	if !panics(func() { o.Observe([]float64{0, 2.5, 15}, math.NaN()) }) {
+		t.Errorf("expected panic for NaN value")
+	}
+	if !panics(func() { (&Optimizer{Lower: []float64{0}, Upper: []float64{0}}).Suggest(1) }) {
+		t.Errorf("expected panic for empty bounds")
+	}
+	if !panics(func() { (&Optimizer{Lower: []float64{0}, Upper: []float64{1}}).Best() }) {
+		t.Errorf("expected panic for no observations")
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package bayesopt provides Bayesian optimization of expensive black-box
+// functions within bound constraints.
+//
+// Bayesian optimization models the objective function with a Gaussian
+// process fitted to the evaluations so far, and chooses each new point to
+// evaluate by maximizing an acquisition function that trades off the
+// predicted value against its uncertainty. It is suited to objectives that
+// are costly to evaluate, where the budget is tens to hundreds of
+// evaluations.
+//
+// See Shahriari et al., "Taking the human out of the loop: A review of
+// Bayesian optimization", Proceedings of the IEEE 104:148-175 (2016).
+// doi:10.1109/JPROC.2015.2494218 for an overview of Bayesian optimization.
+package bayesopt // import "gonum.org/v1/gonum/optimize/bayesopt"
@@ -0,0 +1,601 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+var _ Method = (*DFTrustRegion)(nil)
+
+// dfIterType is a DFTrustRegion evaluation kind.
+type dfIterType int
+
+const (
+	dfInitialize dfIterType = iota
+	dfTrial
+	dfGeometry
+	dfMajor
+)
+
+// dfAction is the next action of a DFTrustRegion iteration.
+type dfAction int
+
+const (
+	// dfStep computes and evaluates a trust-region step.
+	dfStep dfAction = iota
+	// dfImprove improves the geometry of the interpolation
+	// set or reduces the lower bound on the trust region.
+	dfImprove
+)
+
+var errDFSingular = errors.New("dftrustregion: singular interpolation system")
+
+// DFTrustRegion implements a derivative-free trust-region method for smooth
+// unconstrained minimization in the style of Powell's NEWUOA, described in
+//
+//	Powell, M. J. D. "The NEWUOA software for unconstrained optimization
+//	without derivatives", Large-Scale Nonlinear Optimization, Springer
+//	(2006). doi:10.1007/0-387-30065-1_16
+//
+// DFTrustRegion maintains a set of interpolation points and a quadratic model
+// of the objective function that interpolates the function values at these
+// points. The model is minimized within a trust region around the best point
+// to obtain the next trial point. Since there are fewer points than the
+// number of coefficients of a quadratic, the freedom in the model is taken up
+// by choosing the Hessian closest in Frobenius norm to the Hessian of the
+// previous model. Each evaluated point replaces a point of the interpolation
+// set, chosen to keep the set well poised.
+//
+// The radius of the trust region is bounded below by a resolution ρ that is
+// reduced from InitialRadius to FinalRadius as the optimization progresses.
+// When ρ reaches FinalRadius and no further progress is made, DFTrustRegion
+// terminates with MethodConverge status. On smooth problems DFTrustRegion
+// typically needs far fewer function evaluations than NelderMead. The cost of
+// each iteration grows as the cube of the dimension, so it is best suited to
+// problems with up to about a hundred variables whose evaluation is costly.
+type DFTrustRegion struct {
+	// InitialRadius is the initial radius of the trust
+	// region and the spacing of the initial interpolation
+	// points. It should be about one tenth of the expected
+	// distance to the minimum. If InitialRadius is zero, a
+	// default of 0.5 is used.
+	InitialRadius float64
+	// FinalRadius is the final resolution of the trust
+	// region, which controls the accuracy of the location
+	// of the minimum. If FinalRadius is zero, a default of
+	// 1e-6 times the initial radius is used.
+	FinalRadius float64
+	// Points is the number of interpolation points, which
+	// must be between dim+2 and 2*dim+1. If Points is zero,
+	// the default of 2*dim+1 is used.
+	Points int
+
+	status Status
+	err    error
+
+	dim    int
+	npt    int
+	rho    float64
+	rhoEnd float64
+	delta  float64
+
+	pts  [][]float64 // interpolation points
+	fval []float64   // function values at the points
+	kopt int         // index of the best point
+
+	// The model is q(xc + s) = c + gᵀs + ½ sᵀ h s.
+	xc []float64
+	c  float64
+	g  []float64
+	h  *mat.SymDense
+
+	// inv is the inverse of the system determining the
+	// minimum Frobenius norm update, in coordinates scaled
+	// by rho and centered at the best point.
+	inv  *mat.Dense
+	wpts [][]float64 // scaled points relative to the best point
+
+	lastIter dfIterType
+	next     dfAction
+	fillIdx  int       // index for filling the initial interpolation set
+	replace  int       // index of the point replaced by the geometry step
+	step     []float64 // last trial step
+	predict  float64   // reduction in the model predicted for the step
+}
+
+func (d *DFTrustRegion) Status() (Status, error) {
+	return d.status, d.err
+}
+
+func (*DFTrustRegion) Uses(has Available) (uses Available, err error) {
+	return has.function()
+}
+
+func (d *DFTrustRegion) Init(dim, tasks int) int {
+	d.status = NotTerminated
+	d.err = nil
+	return 1
+}
+
+func (d *DFTrustRegion) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	d.status, d.err = localOptimizer{}.run(d, math.NaN(), operation, result, tasks)
+	close(operation)
+}
+
+func (d *DFTrustRegion) initLocal(loc *Location) (Operation, error) {
+	n := len(loc.X)
+	d.dim = n
+	d.npt = d.Points
+	if d.npt == 0 {
+		d.npt = 2*n + 1
+	}
+	if d.npt < n+2 || 2*n+1 < d.npt {
+		panic("dftrustregion: invalid number of interpolation points")
+	}
+	if d.InitialRadius < 0 || d.FinalRadius < 0 {
+		panic("dftrustregion: negative radius")
+	}
+	d.rho = d.InitialRadius
+	if d.rho == 0 {
+		d.rho = 0.5
+	}
+	d.rhoEnd = d.FinalRadius
+	if d.rhoEnd == 0 {
+		d.rhoEnd = 1e-6 * d.rho
+	}
+	if d.rhoEnd > d.rho {
+		panic("dftrustregion: final radius larger than initial radius")
+	}
+	d.delta = d.rho
+
+	d.pts = make([][]float64, d.npt)
+	d.wpts = make([][]float64, d.npt)
+	for i := range d.pts {
+		d.pts[i] = make([]float64, n)
+		d.wpts[i] = make([]float64, n)
+	}
+	d.fval = make([]float64, d.npt)
+	d.xc = make([]float64, n)
+	d.g = make([]float64, n)
+	d.h = mat.NewSymDense(n, nil)
+	d.step = make([]float64, n)
+	d.c = 0
+
+	// The initial points are the starting location and steps of
+	// InitialRadius along the coordinate directions, first positive
+	// and then negative.
+	copy(d.pts[0], loc.X)
+	d.fval[0] = loc.F
+	d.kopt = 0
+	d.fillIdx = 1
+	d.lastIter = dfInitialize
+	d.initialPoint(loc.X, d.fillIdx)
+	return FuncEvaluation, nil
+}
+
+// initialPoint stores the i-th initial interpolation point in x.
+func (d *DFTrustRegion) initialPoint(x []float64, i int) {
+	copy(x, d.pts[0])
+	if i <= d.dim {
+		x[i-1] += d.rho
+	} else {
+		x[i-d.dim-1] -= d.rho
+	}
+}
+
+func (d *DFTrustRegion) iterateLocal(loc *Location) (Operation, error) {
+	switch d.lastIter {
+	case dfInitialize:
+		copy(d.pts[d.fillIdx], loc.X)
+		d.fval[d.fillIdx] = loc.F
+		d.fillIdx++
+		if d.fillIdx < d.npt {
+			d.initialPoint(loc.X, d.fillIdx)
+			return FuncEvaluation, nil
+		}
+		kopt := floats.MinIdx(d.fval)
+		improved := kopt != 0
+		d.kopt = kopt
+		if err := d.updateModel(); err != nil {
+			return NoOperation, err
+		}
+		d.next = dfStep
+		if improved {
+			return d.major(loc)
+		}
+		return d.proceed(loc)
+	case dfTrial:
+		return d.trialResult(loc)
+	case dfGeometry:
+		improved := loc.F < d.fval[d.kopt]
+		if math.IsInf(loc.F, 0) || math.IsNaN(loc.F) {
+			// The geometry step cannot be used, so reduce
+			// the radius to move closer to the best point.
+			d.next = dfStep
+			d.delta = d.rho
+			return d.proceed(loc)
+		}
+		copy(d.pts[d.replace], loc.X)
+		d.fval[d.replace] = loc.F
+		if improved {
+			d.kopt = d.replace
+		}
+		if err := d.updateModel(); err != nil {
+			return NoOperation, err
+		}
+		d.next = dfStep
+		if improved {
+			return d.major(loc)
+		}
+		return d.proceed(loc)
+	case dfMajor:
+		return d.proceed(loc)
+	default:
+		panic("unreachable")
+	}
+}
+
+// trialResult updates the interpolation set, the model and the trust region
+// with the function value at the trial point in loc, and returns the next
+// operation.
+func (d *DFTrustRegion) trialResult(loc *Location) (Operation, error) {
+	fopt := d.fval[d.kopt]
+	snorm := floats.Norm(d.step, 2)
+	if math.IsInf(loc.F, 0) || math.IsNaN(loc.F) {
+		d.delta = math.Max(0.5*snorm, d.rho)
+		d.next = dfImprove
+		return d.proceed(loc)
+	}
+	ratio := (fopt - loc.F) / d.predict
+	switch {
+	case ratio <= 0.1:
+		d.delta = 0.5 * snorm
+	case ratio <= 0.7:
+		d.delta = math.Max(0.5*d.delta, snorm)
+	default:
+		d.delta = math.Max(0.5*d.delta, 2*snorm)
+	}
+	if d.delta <= 1.5*d.rho {
+		d.delta = d.rho
+	}
+
+	// Replace the point whose Lagrange function is largest at the new
+	// point, weighted towards points far from the best point. The best
+	// point is only replaced by a better one.
+	improved := loc.F < fopt
+	lag, beta := d.lagrangeValues(loc.X)
+	best, t := -1.0, -1
+	for i := 0; i < d.npt; i++ {
+		if i == d.kopt && !improved {
+			continue
+		}
+		// The ratio of the determinants of the new and the old
+		// interpolation systems is α β + τ², where α is the
+		// diagonal element of the inverse and τ the value of the
+		// Lagrange function of the point.
+		sigma := d.inv.At(i, i)*beta + lag[i]*lag[i]
+		dist := floats.Distance(d.pts[i], d.pts[d.kopt], 2)
+		w := math.Max(1, dist*dist/(d.delta*d.delta))
+		if score := math.Abs(sigma) * w * w; score > best {
+			best, t = score, i
+		}
+	}
+	copy(d.pts[t], loc.X)
+	d.fval[t] = loc.F
+	if improved {
+		d.kopt = t
+	}
+	if err := d.updateModel(); err != nil {
+		return NoOperation, err
+	}
+	if ratio >= 0.1 {
+		d.next = dfStep
+	} else {
+		d.next = dfImprove
+	}
+	if improved {
+		return d.major(loc)
+	}
+	return d.proceed(loc)
+}
+
+// major stores the best point in loc and commands a major iteration.
+func (d *DFTrustRegion) major(loc *Location) (Operation, error) {
+	copy(loc.X, d.pts[d.kopt])
+	loc.F = d.fval[d.kopt]
+	d.lastIter = dfMajor
+	return MajorIteration, nil
+}
+
+// proceed performs the pending actions until a function evaluation is
+// needed or the method has converged.
+func (d *DFTrustRegion) proceed(loc *Location) (Operation, error) {
+	xopt := d.pts[d.kopt]
+	for {
+		switch d.next {
+		case dfStep:
+			d.predict = -trustRegionCG(d.step, d.g, d.h, d.delta)
+			if floats.Norm(d.step, 2) >= 0.5*d.rho && d.predict > 0 {
+				floats.AddTo(loc.X, xopt, d.step)
+				d.lastIter = dfTrial
+				return FuncEvaluation, nil
+			}
+			// The step is too short to be worth evaluating.
+			d.delta = 0.1 * d.delta
+			if d.delta <= 1.5*d.rho {
+				d.delta = d.rho
+			}
+			d.next = dfImprove
+		case dfImprove:
+			// Replace the point farthest from the best point if it
+			// is too far for the model to be accurate in the trust
+			// region.
+			far, t := 0.0, -1
+			for i, p := range d.pts {
+				if dist := floats.Distance(p, xopt, 2); dist > far {
+					far, t = dist, i
+				}
+			}
+			if far > 2*d.delta {
+				radius := math.Max(math.Min(0.1*far, d.delta), d.rho)
+				d.geometryStep(loc.X, t, radius)
+				d.replace = t
+				d.lastIter = dfGeometry
+				return FuncEvaluation, nil
+			}
+			if d.delta > d.rho {
+				d.next = dfStep
+				continue
+			}
+			if d.rho <= d.rhoEnd {
+				copy(loc.X, xopt)
+				loc.F = d.fval[d.kopt]
+				return MethodDone, nil
+			}
+			// Reduce the resolution.
+			rhoOld := d.rho
+			switch ratio := d.rho / d.rhoEnd; {
+			case ratio <= 16:
+				d.rho = d.rhoEnd
+			case ratio <= 250:
+				d.rho = math.Sqrt(ratio) * d.rhoEnd
+			default:
+				d.rho *= 0.1
+			}
+			d.delta = math.Max(0.5*rhoOld, d.rho)
+			if err := d.updateModel(); err != nil {
+				return NoOperation, err
+			}
+			d.next = dfStep
+		}
+	}
+}
+
+// updateModel updates the quadratic model to interpolate the function values
+// at the current points with the least change in the Frobenius norm of its
+// Hessian, and recenters it at the best point.
+func (d *DFTrustRegion) updateModel() error {
+	n, m := d.dim, d.npt
+	xopt := d.pts[d.kopt]
+
+	// Compute the residuals of the current model at the points,
+	// and move its center to the best point.
+	rhs := make([]float64, m+n+1)
+	for i, p := range d.pts {
+		rhs[i] = d.fval[i] - d.modelValue(p)
+	}
+	d.c = d.modelValue(xopt)
+	diff := make([]float64, n)
+	floats.SubTo(diff, xopt, d.xc)
+	var hd mat.VecDense
+	hd.MulVec(d.h, mat.NewVecDense(n, diff))
+	floats.Add(d.g, hd.RawVector().Data)
+	copy(d.xc, xopt)
+
+	// Build and factorize the system
+	//  [ A  Xᵀ ] [ λ ]   [ r ]
+	//  [ X  0  ] [ μ ] = [ 0 ]
+	// with A_ij = ½ (w_iᵀ w_j)² and the columns of X being
+	// [1; w_i], where w_i are the points relative to the best
+	// point scaled by 1/rho.
+	for i, p := range d.pts {
+		floats.SubTo(d.wpts[i], p, xopt)
+		floats.Scale(1/d.rho, d.wpts[i])
+	}
+	w := mat.NewDense(m+n+1, m+n+1, nil)
+	for i := 0; i < m; i++ {
+		for j := i; j < m; j++ {
+			v := floats.Dot(d.wpts[i], d.wpts[j])
+			w.Set(i, j, 0.5*v*v)
+			w.Set(j, i, 0.5*v*v)
+		}
+		w.Set(i, m, 1)
+		w.Set(m, i, 1)
+		for k, v := range d.wpts[i] {
+			w.Set(i, m+1+k, v)
+			w.Set(m+1+k, i, v)
+		}
+	}
+	var lu mat.LU
+	lu.Factorize(w)
+	d.inv = mat.NewDense(m+n+1, m+n+1, nil)
+	err := lu.SolveTo(d.inv, false, eye(m+n+1))
+	if c, ok := err.(mat.Condition); ok && math.IsInf(float64(c), 1) {
+		return errDFSingular
+	}
+	var sol mat.VecDense
+	sol.MulVec(d.inv, mat.NewVecDense(m+n+1, rhs))
+	z := sol.RawVector().Data
+
+	// Add the correction c' + g'ᵀu + ½ uᵀ(Σ_i λ_i w_i w_iᵀ)u
+	// in the scaled coordinates u = s/rho.
+	d.c += z[m]
+	floats.AddScaled(d.g, 1/d.rho, z[m+1:])
+	for i := 0; i < m; i++ {
+		d.h.SymRankOne(d.h, z[i]/(d.rho*d.rho), mat.NewVecDense(n, d.wpts[i]))
+	}
+	return nil
+}
+
+// modelValue returns the value of the model at x.
+func (d *DFTrustRegion) modelValue(x []float64) float64 {
+	n := d.dim
+	s := make([]float64, n)
+	floats.SubTo(s, x, d.xc)
+	var hs mat.VecDense
+	hs.MulVec(d.h, mat.NewVecDense(n, s))
+	return d.c + floats.Dot(d.g, s) + 0.5*floats.Dot(s, hs.RawVector().Data)
+}
+
+// lagrangeValues returns the values at x of the Lagrange functions of the
+// interpolation points, the minimum Frobenius norm quadratics that are one at
+// their own point and zero at the others. It also returns the value β that
+// determines the change in the interpolation system when x replaces a point.
+func (d *DFTrustRegion) lagrangeValues(x []float64) (lag []float64, beta float64) {
+	n, m := d.dim, d.npt
+	u := make([]float64, n)
+	floats.SubTo(u, x, d.pts[d.kopt])
+	floats.Scale(1/d.rho, u)
+	v := make([]float64, m+n+1)
+	for i, w := range d.wpts {
+		dot := floats.Dot(w, u)
+		v[i] = 0.5 * dot * dot
+	}
+	v[m] = 1
+	copy(v[m+1:], u)
+	// The system is symmetric, so the product holds
+	// the values of all of the Lagrange functions.
+	var sol mat.VecDense
+	sol.MulVec(d.inv, mat.NewVecDense(m+n+1, v))
+	uu := floats.Dot(u, u)
+	beta = 0.5*uu*uu - floats.Dot(v, sol.RawVector().Data)
+	return sol.RawVector().Data[:m], beta
+}
+
+// geometryStep stores in x a point within radius of the best point at which
+// the absolute value of the Lagrange function of point t is large.
+func (d *DFTrustRegion) geometryStep(x []float64, t int, radius float64) {
+	n, m := d.dim, d.npt
+	z := mat.Col(nil, t, d.inv)
+
+	// The Lagrange function in the scaled coordinates
+	// is c + gᵀu + ½ uᵀ h u.
+	c := z[m]
+	g := z[m+1:]
+	h := mat.NewSymDense(n, nil)
+	for i := 0; i < m; i++ {
+		h.SymRankOne(h, z[i], mat.NewVecDense(n, d.wpts[i]))
+	}
+	value := func(u []float64) float64 {
+		var hu mat.VecDense
+		hu.MulVec(h, mat.NewVecDense(n, u))
+		return c + floats.Dot(g, u) + 0.5*floats.Dot(u, hu.RawVector().Data)
+	}
+
+	// Compare approximate maximizers and minimizers of the Lagrange
+	// function with steps towards and away from point t.
+	r := radius / d.rho
+	best := make([]float64, n)
+	floats.ScaleTo(best, r/floats.Norm(d.wpts[t], 2), d.wpts[t])
+	bestVal := math.Abs(value(best))
+	u := make([]float64, n)
+	floats.ScaleTo(u, -1, best)
+	if v := math.Abs(value(u)); v > bestVal {
+		copy(best, u)
+		bestVal = v
+	}
+	trustRegionCG(u, g, h, r)
+	if v := math.Abs(value(u)); v > bestVal {
+		copy(best, u)
+		bestVal = v
+	}
+	negG := make([]float64, n)
+	floats.ScaleTo(negG, -1, g)
+	negH := mat.NewSymDense(n, nil)
+	negH.ScaleSym(-1, h)
+	trustRegionCG(u, negG, negH, r)
+	if v := math.Abs(value(u)); v > bestVal {
+		copy(best, u)
+	}
+	floats.AddScaledTo(x, d.pts[d.kopt], d.rho, best)
+}
+
+// eye returns the n×n identity matrix.
+func eye(n int) *mat.Dense {
+	m := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		m.Set(i, i, 1)
+	}
+	return m
+}
+
+func (*DFTrustRegion) needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return struct {
+		Gradient bool
+		Hessian  bool
+	}{false, false}
+}
+
+// trustRegionCG approximately minimizes the quadratic gᵀs + ½ sᵀ h s subject
+// to |s| ≤ delta by the truncated conjugate gradient method of Steihaug and
+// Toint. It stores the step in s and returns the value of the quadratic.
+func trustRegionCG(s, g []float64, h mat.Symmetric, delta float64) float64 {
+	n := len(s)
+	return trustRegionCGFunc(s, g, func(dst, v []float64) {
+		mat.NewVecDense(n, dst).MulVec(h, mat.NewVecDense(n, v))
+	}, delta)
+}
+
+// trustRegionCGFunc is like trustRegionCG for a quadratic whose Hessian is
+// given by the function mul that stores the product of the Hessian with v
+// into dst.
+func trustRegionCGFunc(s, g []float64, mul func(dst, v []float64), delta float64) float64 {
+	n := len(s)
+	for i := range s {
+		s[i] = 0
+	}
+	r := make([]float64, n)
+	copy(r, g)
+	p := make([]float64, n)
+	floats.ScaleTo(p, -1, r)
+	gNorm := floats.Norm(g, 2)
+	rr := floats.Dot(r, r)
+	hp := make([]float64, n)
+	for k := 0; k < n && math.Sqrt(rr) > 1e-12*gNorm; k++ {
+		mul(hp, p)
+		curv := floats.Dot(p, hp)
+		alpha := rr / curv
+		if curv <= 0 || floats.Norm(s, 2)+alpha*floats.Norm(p, 2) >= delta {
+			// Move to the boundary along p if the curvature is not
+			// positive or the minimizer along p is outside the
+			// trust region.
+			sp := floats.Dot(s, p)
+			pp := floats.Dot(p, p)
+			ss := floats.Dot(s, s)
+			tau := (-sp + math.Sqrt(sp*sp+pp*(delta*delta-ss))) / pp
+			if curv > 0 && tau > alpha {
+				tau = alpha
+			}
+			floats.AddScaled(s, tau, p)
+			break
+		}
+		floats.AddScaled(s, alpha, p)
+		floats.AddScaled(r, alpha, hp)
+		rrNew := floats.Dot(r, r)
+		floats.Scale(rrNew/rr, p)
+		floats.Sub(p, r)
+		rr = rrNew
+	}
+	mul(hp, s)
+	return floats.Dot(g, s) + 0.5*floats.Dot(s, hp)
+}
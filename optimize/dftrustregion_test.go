@@ -0,0 +1,126 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+func TestDFTrustRegion(t *testing.T) {
+	t.Parallel()
+	quadratic := func(x []float64) float64 {
+		var f float64
+		for i, v := range x {
+			f += float64(i+1) * (v - 1) * (v - 1)
+		}
+		return f
+	}
+	for _, test := range []struct {
+		name   string
+		f      func([]float64) float64
+		x      []float64
+		method *DFTrustRegion
+		want   []float64
+		tol    float64
+	}{
+		{
+			name:   "Beale",
+			f:      functions.Beale{}.Func,
+			x:      []float64{1, 1},
+			method: &DFTrustRegion{},
+			want:   []float64{3, 0.5},
+			tol:    1e-6,
+		},
+		{
+			name:   "ExtendedRosenbrock",
+			f:      functions.ExtendedRosenbrock{}.Func,
+			x:      []float64{-1.2, 1},
+			method: &DFTrustRegion{},
+			want:   []float64{1, 1},
+			tol:    1e-6,
+		},
+		{
+			name:   "ExtendedRosenbrock",
+			f:      functions.ExtendedRosenbrock{}.Func,
+			x:      make([]float64, 10),
+			method: &DFTrustRegion{InitialRadius: 0.1, FinalRadius: 1e-8},
+			want:   []float64{1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+			tol:    1e-6,
+		},
+		{
+			name:   "Quadratic",
+			f:      quadratic,
+			x:      make([]float64, 20),
+			method: &DFTrustRegion{},
+			want:   []float64{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+			tol:    1e-6,
+		},
+		{
+			name:   "Quadratic",
+			f:      quadratic,
+			x:      make([]float64, 20),
+			method: &DFTrustRegion{Points: 22},
+			want:   []float64{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+			tol:    1e-6,
+		},
+	} {
+		p := Problem{Func: test.f}
+		result, err := Minimize(p, test.x, nil, test.method)
+		if err != nil {
+			t.Errorf("%s dim %d: unexpected error: %v", test.name, len(test.x), err)
+			continue
+		}
+		if result.Status != MethodConverge {
+			t.Errorf("%s dim %d: unexpected status: got %v, want %v", test.name, len(test.x), result.Status, MethodConverge)
+		}
+		if !floats.EqualApprox(result.X, test.want, test.tol) {
+			t.Errorf("%s dim %d: minimizer mismatch: got %v, want %v", test.name, len(test.x), result.X, test.want)
+		}
+		if f := test.f(result.X); f != result.F {
+			t.Errorf("%s dim %d: function value at the minimizer %v not equal to the returned value %v", test.name, len(test.x), f, result.F)
+		}
+
+		// Providing the initial function value must give the same
+		// answer with one fewer evaluation.
+		settings := &Settings{InitValues: &Location{F: test.f(test.x)}}
+		result2, err := Minimize(p, test.x, settings, test.method)
+		if err != nil {
+			t.Errorf("%s dim %d: unexpected error on second run: %v", test.name, len(test.x), err)
+			continue
+		}
+		if result.F != result2.F || !floats.Equal(result.X, result2.X) {
+			t.Errorf("%s dim %d: different minimum on second run", test.name, len(test.x))
+		}
+		if result.FuncEvaluations != result2.FuncEvaluations+1 {
+			t.Errorf("%s dim %d: providing initial data does not reduce the number of Func calls", test.name, len(test.x))
+		}
+	}
+}
+
+func TestDFTrustRegionEvaluations(t *testing.T) {
+	t.Parallel()
+	// On a smooth problem in 20 variables, the model-based
+	// method needs far fewer evaluations than NelderMead.
+	p := Problem{Func: functions.ExtendedRosenbrock{}.Func}
+	x := make([]float64, 20)
+	dft, err := Minimize(p, x, nil, &DFTrustRegion{InitialRadius: 0.1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	nm, err := Minimize(p, x, &Settings{FuncEvaluations: 100000}, &NelderMead{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if dft.F > 1e-8 {
+		t.Errorf("DFTrustRegion did not find the minimum: got %v", dft.F)
+	}
+	if dft.F > nm.F || dft.FuncEvaluations > nm.FuncEvaluations {
+		t.Errorf("DFTrustRegion not better than NelderMead: F %v and %d evaluations, NelderMead F %v and %d evaluations",
+			dft.F, dft.FuncEvaluations, nm.F, nm.FuncEvaluations)
+	}
+}
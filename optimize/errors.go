@@ -39,6 +39,10 @@ var (
 	// ErrMissingHess signifies that a Method requires a Hessian function that
 	// is not supplied by Problem.
 	ErrMissingHess = errors.New("optimize: problem does not provide needed Hess function")
+
+	// ErrMissingHessVec signifies that a Method requires a Hessian-vector
+	// product function that is not supplied by Problem.
+	ErrMissingHessVec = errors.New("optimize: problem does not provide needed HessVec function")
 )
 
 // ErrFunc is returned when an initial function value is invalid. The error
@@ -0,0 +1,289 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	// ErrEvaluationTimeout signifies that an evaluation of the Problem
+	// took longer than the EvaluationTimeout of the Settings.
+	ErrEvaluationTimeout = errors.New("optimize: evaluation timed out")
+
+	// ErrNonFiniteFunc signifies that an evaluation of the Problem
+	// returned a NaN or +Inf function value.
+	ErrNonFiniteFunc = errors.New("optimize: non-finite function value")
+)
+
+// EvaluationError is the error describing a failed evaluation of a Problem.
+type EvaluationError struct {
+	// Op is the evaluation that failed.
+	Op Operation
+	// X is the location of the failed evaluation.
+	X []float64
+	// Err is the cause of the failure. It is
+	// ErrEvaluationTimeout, ErrNonFiniteFunc
+	// or a *PanicError.
+	Err error
+}
+
+func (err *EvaluationError) Error() string {
+	return fmt.Sprintf("optimize: evaluation failed at %v: %v", err.X, err.Err)
+}
+
+// Unwrap returns the cause of the failure.
+func (err *EvaluationError) Unwrap() error { return err.Err }
+
+// PanicError holds a value recovered from a panic during an evaluation of a
+// Problem.
+type PanicError struct {
+	Value interface{}
+}
+
+func (err *PanicError) Error() string {
+	return fmt.Sprintf("optimize: panic during evaluation: %v", err.Value)
+}
+
+// Unwrap returns the panic value if it is an error.
+func (err *PanicError) Unwrap() error {
+	e, _ := err.Value.(error)
+	return e
+}
+
+// EvaluationPolicy specifies how Minimize handles failed evaluations of a
+// Problem. An evaluation fails if it returns a NaN or +Inf function value,
+// if it runs for longer than the EvaluationTimeout of the Settings, or if it
+// panics when RecoverPanics is set in the Settings. A function value of -Inf
+// is not a failure and terminates the optimization with the
+// FunctionNegativeInfinity status.
+type EvaluationPolicy int
+
+const (
+	// KeepNonFinite returns NaN and +Inf function values to the Method
+	// unaltered. Evaluations that time out or panic are rejected as for
+	// RejectEvaluation.
+	KeepNonFinite EvaluationPolicy = iota
+
+	// RejectEvaluation returns a failed evaluation to the Method with
+	// a function value of +Inf and with NaN gradient and Hessian
+	// elements, so that the Method rejects the step that led to the
+	// evaluation.
+	RejectEvaluation
+
+	// ShrinkEvaluation retries a failed evaluation at locations moved
+	// towards the location of the last major iteration, halving the
+	// distance between them at each retry. The location of the Task is
+	// updated to the location of the successful evaluation. If all of
+	// the retries fail, the evaluation is rejected as for
+	// RejectEvaluation. ShrinkEvaluation is intended for Methods that use
+	// the evaluated location as given, such as NelderMead and
+	// GuessAndCheck. Methods that control the evaluated location, such as
+	// line search based methods, should use RejectEvaluation.
+	ShrinkEvaluation
+
+	// TerminateEvaluation terminates the optimization with a Failure
+	// status and an *EvaluationError describing the failed evaluation.
+	TerminateEvaluation
+)
+
+// maxShrink is the maximum number of retries
+// of a failed evaluation by ShrinkEvaluation.
+const maxShrink = 10
+
+// evaluator evaluates a Problem, handling failed
+// evaluations according to the Settings.
+type evaluator struct {
+	prob     *Problem
+	settings *Settings
+
+	// ref is the reference location towards which
+	// failed evaluations are moved by ShrinkEvaluation.
+	ref *reference
+
+	x []float64
+}
+
+// reference is a location shared between
+// the workers of an optimization.
+type reference struct {
+	mu sync.Mutex
+	x  []float64
+}
+
+// set sets the reference location to x.
+func (r *reference) set(x []float64) {
+	r.mu.Lock()
+	copy(r.x, x)
+	r.mu.Unlock()
+}
+
+// get stores the reference location in dst.
+func (r *reference) get(dst []float64) {
+	r.mu.Lock()
+	copy(dst, r.x)
+	r.mu.Unlock()
+}
+
+// robust returns whether the settings require failed
+// evaluations to be handled.
+func robust(settings *Settings) bool {
+	return settings.EvaluationTimeout > 0 || settings.RecoverPanics || settings.FailedEvaluation != KeepNonFinite
+}
+
+// evaluate evaluates the routines specified by the Operation at loc.X,
+// storing the answer in loc. It returns the number of calls made to the
+// Problem and a non-nil *EvaluationError if the evaluation failed, after
+// handling the failure according to the FailedEvaluation policy of the
+// settings.
+func (e *evaluator) evaluate(loc *Location, op Operation) (calls int, err error) {
+	if !robust(e.settings) {
+		evaluate(e.prob, loc, op, e.x)
+		return 1, nil
+	}
+	if !op.isEvaluation() {
+		panic(fmt.Sprintf("optimize: invalid evaluation %v", op))
+	}
+	calls = 1
+	err = e.try(loc, op)
+	if err == nil {
+		return calls, nil
+	}
+	failure := &EvaluationError{Op: op, X: append([]float64(nil), loc.X...), Err: err}
+	if e.settings.FailedEvaluation == ShrinkEvaluation {
+		ref := make([]float64, len(loc.X))
+		e.ref.get(ref)
+		for range maxShrink {
+			floats.Add(loc.X, ref)
+			floats.Scale(0.5, loc.X)
+			if floats.Equal(loc.X, ref) {
+				break
+			}
+			calls++
+			if e.try(loc, op) == nil {
+				return calls, failure
+			}
+		}
+		copy(loc.X, failure.X)
+	}
+	reject(loc, op)
+	return calls, failure
+}
+
+// try performs a single evaluation at loc.X, returning
+// the cause of the failure if the evaluation failed.
+func (e *evaluator) try(loc *Location, op Operation) error {
+	err := e.call(loc, op)
+	if err != nil {
+		return err
+	}
+	if op&FuncEvaluation != 0 && e.settings.FailedEvaluation != KeepNonFinite {
+		if math.IsNaN(loc.F) || math.IsInf(loc.F, 1) {
+			return ErrNonFiniteFunc
+		}
+	}
+	return nil
+}
+
+// call evaluates the Problem at loc.X, applying the EvaluationTimeout and
+// RecoverPanics settings.
+func (e *evaluator) call(loc *Location, op Operation) error {
+	timeout := e.settings.EvaluationTimeout
+	if timeout <= 0 {
+		return e.protect(loc, op, e.x)
+	}
+
+	// Evaluate into a temporary location so that an abandoned
+	// evaluation does not modify loc after the timeout.
+	tmp := &Location{X: append([]float64(nil), loc.X...)}
+	ensureStorage(tmp, op, len(loc.X))
+	done := make(chan error, 1)
+	go func() {
+		done <- e.protect(tmp, op, make([]float64, len(loc.X)))
+	}()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case err := <-done:
+		if err != nil {
+			return err
+		}
+	case <-timer.C:
+		return ErrEvaluationTimeout
+	}
+	ensureStorage(loc, op, len(loc.X))
+	if op&FuncEvaluation != 0 {
+		loc.F = tmp.F
+	}
+	if op&GradEvaluation != 0 {
+		copy(loc.Gradient, tmp.Gradient)
+	}
+	if op&HessEvaluation != 0 {
+		loc.Hessian.CopySym(tmp.Hessian)
+	}
+	return nil
+}
+
+// protect evaluates the Problem at loc.X, recovering a panic
+// during the evaluation if the RecoverPanics setting is true.
+func (e *evaluator) protect(loc *Location, op Operation, x []float64) (err error) {
+	if e.settings.RecoverPanics {
+		defer func() {
+			if r := recover(); r != nil {
+				err = &PanicError{Value: r}
+			}
+		}()
+	}
+	evaluate(e.prob, loc, op, x)
+	return nil
+}
+
+// reject marks the values of a failed evaluation as invalid.
+func reject(loc *Location, op Operation) {
+	ensureStorage(loc, op, len(loc.X))
+	if op&FuncEvaluation != 0 {
+		loc.F = math.Inf(1)
+	}
+	if op&GradEvaluation != 0 {
+		for i := range loc.Gradient {
+			loc.Gradient[i] = math.NaN()
+		}
+	}
+	if op&HessEvaluation != 0 {
+		n := loc.Hessian.SymmetricDim()
+		for i := range n {
+			for j := i; j < n; j++ {
+				loc.Hessian.SetSym(i, j, math.NaN())
+			}
+		}
+	}
+}
+
+// ensureStorage makes sure that loc has a destination for
+// the gradient and Hessian evaluations specified by op.
+func ensureStorage(loc *Location, op Operation, dim int) {
+	if op&GradEvaluation != 0 && len(loc.Gradient) == 0 {
+		if cap(loc.Gradient) < dim {
+			loc.Gradient = make([]float64, dim)
+		} else {
+			loc.Gradient = loc.Gradient[:dim]
+		}
+	}
+	if op&HessEvaluation != 0 {
+		switch {
+		case loc.Hessian == nil:
+			loc.Hessian = mat.NewSymDense(dim, nil)
+		case loc.Hessian.IsEmpty():
+			loc.Hessian.ReuseAsSym(dim)
+		}
+	}
+}
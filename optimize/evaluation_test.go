@@ -0,0 +1,165 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"errors"
+	"math"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+// failingQuadratic returns a Problem with a minimum at (1, 1) that fails
+// for x[0] > 1.5 by calling fail.
+func failingQuadratic(fail func() float64) Problem {
+	return Problem{
+		Func: func(x []float64) float64 {
+			if x[0] > 1.5 {
+				return fail()
+			}
+			return (x[0]-1)*(x[0]-1) + (x[1]-1)*(x[1]-1)
+		},
+		Grad: func(grad, x []float64) {
+			if x[0] > 1.5 {
+				fail()
+			}
+			grad[0] = 2 * (x[0] - 1)
+			grad[1] = 2 * (x[1] - 1)
+		},
+	}
+}
+
+func TestFailedEvaluations(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name     string
+		fail     func() float64
+		settings Settings
+		method   Method
+	}{
+		{
+			name:     "NaN reject",
+			fail:     func() float64 { return math.NaN() },
+			settings: Settings{FailedEvaluation: RejectEvaluation},
+			method:   &NelderMead{},
+		},
+		{
+			name:     "Inf shrink",
+			fail:     func() float64 { return math.Inf(1) },
+			settings: Settings{FailedEvaluation: ShrinkEvaluation},
+			method:   &NelderMead{},
+		},
+		{
+			name:     "panic reject",
+			fail:     func() float64 { panic("bad location") },
+			settings: Settings{RecoverPanics: true},
+			method:   &GradientDescent{StepSizer: &ConstantStepSize{Size: 1}, Linesearcher: &Backtracking{}},
+		},
+		{
+			name:     "panic shrink",
+			fail:     func() float64 { panic("bad location") },
+			settings: Settings{RecoverPanics: true, FailedEvaluation: ShrinkEvaluation},
+			method:   &NelderMead{},
+		},
+		{
+			name:     "timeout",
+			fail:     func() float64 { time.Sleep(time.Second); return 0 },
+			settings: Settings{EvaluationTimeout: 20 * time.Millisecond},
+			method:   &NelderMead{},
+		},
+	} {
+		// Start with a step into the failing region.
+		initX := []float64{0, 1}
+		if _, ok := test.method.(*NelderMead); ok {
+			initX = []float64{1.4, 0}
+			test.method = &NelderMead{SimplexSize: 1}
+		}
+		prob := failingQuadratic(test.fail)
+		var calls atomic.Int64
+		f := prob.Func
+		prob.Func = func(x []float64) float64 {
+			calls.Add(1)
+			return f(x)
+		}
+		result, err := Minimize(prob, initX, &test.settings, test.method)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if result.Stats.FailedEvaluations == 0 {
+			t.Errorf("%s: no failed evaluations", test.name)
+		}
+		// Abandoned evaluations may not yet have
+		// started when Minimize returns.
+		if test.settings.EvaluationTimeout == 0 && result.Stats.FuncEvaluations != int(calls.Load()) {
+			t.Errorf("%s: unexpected number of function evaluations: got:%d want:%d",
+				test.name, result.Stats.FuncEvaluations, calls.Load())
+		}
+		if !floats.EqualApprox(result.X, []float64{1, 1}, 1e-4) {
+			t.Errorf("%s: unexpected minimum: got:%v want:[1 1]", test.name, result.X)
+		}
+	}
+}
+
+func TestTerminateEvaluation(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name     string
+		fail     func() float64
+		settings Settings
+		want     error
+	}{
+		{
+			name:     "NaN",
+			fail:     func() float64 { return math.NaN() },
+			settings: Settings{},
+			want:     ErrNonFiniteFunc,
+		},
+		{
+			name:     "timeout",
+			fail:     func() float64 { time.Sleep(time.Second); return 0 },
+			settings: Settings{EvaluationTimeout: 20 * time.Millisecond},
+			want:     ErrEvaluationTimeout,
+		},
+		{
+			name:     "panic",
+			fail:     func() float64 { panic("bad location") },
+			settings: Settings{RecoverPanics: true},
+		},
+		{
+			name:     "transformed",
+			fail:     func() float64 { return math.NaN() },
+			settings: Settings{Transform: NewScaling([]float64{2, 4})},
+			want:     ErrNonFiniteFunc,
+		},
+	} {
+		test.settings.FailedEvaluation = TerminateEvaluation
+		initX := []float64{2, 0}
+		result, err := Minimize(failingQuadratic(test.fail), initX, &test.settings, &NelderMead{})
+		if result.Status != Failure {
+			t.Errorf("%s: unexpected status: got:%v want:%v", test.name, result.Status, Failure)
+		}
+		var evalErr *EvaluationError
+		if !errors.As(err, &evalErr) {
+			t.Errorf("%s: unexpected error type: %T", test.name, err)
+			continue
+		}
+		if !floats.Equal(evalErr.X, initX) {
+			t.Errorf("%s: unexpected failed location: got:%v want:%v", test.name, evalErr.X, initX)
+		}
+		if test.want != nil && !errors.Is(err, test.want) {
+			t.Errorf("%s: unexpected cause: got:%v want:%v", test.name, evalErr.Err, test.want)
+		}
+		if test.want == nil {
+			var p *PanicError
+			if !errors.As(err, &p) || p.Value != "bad location" {
+				t.Errorf("%s: unexpected cause: got:%v", test.name, evalErr.Err)
+			}
+		}
+	}
+}
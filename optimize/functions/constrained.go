@@ -0,0 +1,456 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package functions
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Constrained is an objective function with constraints, defining the problem
+//
+//	minimize    f(x)
+//	subject to  c_i(x) = 0,  i = 0, ..., e-1,
+//	            c_i(x) ≥ 0,  i = e, ..., e+k-1,
+//	            lower ≤ x ≤ upper,
+//
+// where e and k are the numbers of equality and inequality constraints.
+// The minima of a Constrained function are the solutions of the
+// constrained problem, at which the gradient of f need not vanish.
+type Constrained interface {
+	// Func returns the value of the objective function at x.
+	Func(x []float64) float64
+
+	// Grad stores the gradient of the objective function at x in grad.
+	Grad(grad, x []float64)
+
+	// Bounds returns the lower and upper bounds on x. Unbounded
+	// elements of x have bounds of -Inf or +Inf. The length of the
+	// returned slices is the dimension of the problem.
+	Bounds() (lower, upper []float64)
+
+	// NumConstraints returns the number of equality
+	// and inequality constraints.
+	NumConstraints() (eq, ineq int)
+
+	// Constraints stores the values of the constraints at x in dst,
+	// with the equality constraints preceding the inequality
+	// constraints. The length of dst must equal eq+ineq.
+	Constraints(dst, x []float64)
+
+	// ConstraintJacobian stores the Jacobian of the constraints
+	// at x, J_{ij} = ∂c_i/∂x_j, in dst. The dimensions of dst
+	// must be (eq+ineq)×len(x).
+	ConstraintJacobian(dst *mat.Dense, x []float64)
+}
+
+// unbounded returns bounds of -Inf and +Inf for a problem of dimension n.
+func unbounded(n int) (lower, upper []float64) {
+	lower = make([]float64, n)
+	upper = make([]float64, n)
+	for i := range lower {
+		lower[i] = math.Inf(-1)
+		upper[i] = math.Inf(1)
+	}
+	return lower, upper
+}
+
+// checkConstraints panics if the lengths of the constraint
+// values and the input do not match the dimensions m×n.
+func checkConstraints(dst, x []float64, m, n int) {
+	if len(x) != n {
+		panic(badInputDim)
+	}
+	if len(dst) != m {
+		panic("incorrect number of constraints")
+	}
+}
+
+// HS6 implements problem 6 of the Hock-Schittkowski test set, a quadratic
+// function with a nonlinear equality constraint.
+//
+//	minimize    (1 - x_0)^2
+//	subject to  10 (x_1 - x_0^2) = 0
+//
+// Standard starting point:
+//
+//	[-1.2, 1]
+//
+// References:
+//   - Hock, W., Schittkowski, K.: Test Examples for Nonlinear Programming
+//     Codes. Lecture Notes in Economics and Mathematical Systems 187,
+//     Springer (1981)
+type HS6 struct{}
+
+func (HS6) Func(x []float64) float64 {
+	if len(x) != 2 {
+		panic("dimension of the problem must be 2")
+	}
+	return (1 - x[0]) * (1 - x[0])
+}
+
+func (HS6) Grad(grad, x []float64) {
+	if len(x) != 2 {
+		panic("dimension of the problem must be 2")
+	}
+	if len(x) != len(grad) {
+		panic("incorrect size of the gradient")
+	}
+	grad[0] = -2 * (1 - x[0])
+	grad[1] = 0
+}
+
+func (HS6) Bounds() (lower, upper []float64) { return unbounded(2) }
+
+func (HS6) NumConstraints() (eq, ineq int) { return 1, 0 }
+
+func (HS6) Constraints(dst, x []float64) {
+	checkConstraints(dst, x, 1, 2)
+	dst[0] = 10 * (x[1] - x[0]*x[0])
+}
+
+func (HS6) ConstraintJacobian(dst *mat.Dense, x []float64) {
+	checkJacobian(dst, x, 1, 2)
+	dst.Set(0, 0, -20*x[0])
+	dst.Set(0, 1, 10)
+}
+
+func (HS6) Minima() []Minimum {
+	return []Minimum{
+		{
+			X:      []float64{1, 1},
+			F:      0,
+			Global: true,
+		},
+	}
+}
+
+// HS7 implements problem 7 of the Hock-Schittkowski test set, a general
+// function with a nonlinear equality constraint.
+//
+//	minimize    log(1 + x_0^2) - x_1
+//	subject to  (1 + x_0^2)^2 + x_1^2 - 4 = 0
+//
+// Standard starting point:
+//
+//	[2, 2]
+//
+// References:
+//   - Hock, W., Schittkowski, K.: Test Examples for Nonlinear Programming
+//     Codes. Lecture Notes in Economics and Mathematical Systems 187,
+//     Springer (1981)
+type HS7 struct{}
+
+func (HS7) Func(x []float64) float64 {
+	if len(x) != 2 {
+		panic("dimension of the problem must be 2")
+	}
+	return math.Log1p(x[0]*x[0]) - x[1]
+}
+
+func (HS7) Grad(grad, x []float64) {
+	if len(x) != 2 {
+		panic("dimension of the problem must be 2")
+	}
+	if len(x) != len(grad) {
+		panic("incorrect size of the gradient")
+	}
+	grad[0] = 2 * x[0] / (1 + x[0]*x[0])
+	grad[1] = -1
+}
+
+func (HS7) Bounds() (lower, upper []float64) { return unbounded(2) }
+
+func (HS7) NumConstraints() (eq, ineq int) { return 1, 0 }
+
+func (HS7) Constraints(dst, x []float64) {
+	checkConstraints(dst, x, 1, 2)
+	t := 1 + x[0]*x[0]
+	dst[0] = t*t + x[1]*x[1] - 4
+}
+
+func (HS7) ConstraintJacobian(dst *mat.Dense, x []float64) {
+	checkJacobian(dst, x, 1, 2)
+	dst.Set(0, 0, 4*x[0]*(1+x[0]*x[0]))
+	dst.Set(0, 1, 2*x[1])
+}
+
+func (HS7) Minima() []Minimum {
+	return []Minimum{
+		{
+			X:      []float64{0, math.Sqrt(3)},
+			F:      -math.Sqrt(3),
+			Global: true,
+		},
+	}
+}
+
+// HS21 implements problem 21 of the Hock-Schittkowski test set, a quadratic
+// function with a linear inequality constraint and bounds.
+//
+//	minimize    0.01 x_0^2 + x_1^2 - 100
+//	subject to  10 x_0 - x_1 - 10 ≥ 0
+//	            2 ≤ x_0 ≤ 50
+//	            -50 ≤ x_1 ≤ 50
+//
+// Standard starting point:
+//
+//	[-1, -1]
+//
+// References:
+//   - Hock, W., Schittkowski, K.: Test Examples for Nonlinear Programming
+//     Codes. Lecture Notes in Economics and Mathematical Systems 187,
+//     Springer (1981)
+type HS21 struct{}
+
+func (HS21) Func(x []float64) float64 {
+	if len(x) != 2 {
+		panic("dimension of the problem must be 2")
+	}
+	return 0.01*x[0]*x[0] + x[1]*x[1] - 100
+}
+
+func (HS21) Grad(grad, x []float64) {
+	if len(x) != 2 {
+		panic("dimension of the problem must be 2")
+	}
+	if len(x) != len(grad) {
+		panic("incorrect size of the gradient")
+	}
+	grad[0] = 0.02 * x[0]
+	grad[1] = 2 * x[1]
+}
+
+func (HS21) Bounds() (lower, upper []float64) {
+	return []float64{2, -50}, []float64{50, 50}
+}
+
+func (HS21) NumConstraints() (eq, ineq int) { return 0, 1 }
+
+func (HS21) Constraints(dst, x []float64) {
+	checkConstraints(dst, x, 1, 2)
+	dst[0] = 10*x[0] - x[1] - 10
+}
+
+func (HS21) ConstraintJacobian(dst *mat.Dense, x []float64) {
+	checkJacobian(dst, x, 1, 2)
+	dst.Set(0, 0, 10)
+	dst.Set(0, 1, -1)
+}
+
+func (HS21) Minima() []Minimum {
+	return []Minimum{
+		{
+			X:      []float64{2, 0},
+			F:      -99.96,
+			Global: true,
+		},
+	}
+}
+
+// HS28 implements problem 28 of the Hock-Schittkowski test set, a quadratic
+// function with a linear equality constraint.
+//
+//	minimize    (x_0 + x_1)^2 + (x_1 + x_2)^2
+//	subject to  x_0 + 2 x_1 + 3 x_2 - 1 = 0
+//
+// Standard starting point:
+//
+//	[-4, 1, 1]
+//
+// References:
+//   - Hock, W., Schittkowski, K.: Test Examples for Nonlinear Programming
+//     Codes. Lecture Notes in Economics and Mathematical Systems 187,
+//     Springer (1981)
+type HS28 struct{}
+
+func (HS28) Func(x []float64) float64 {
+	if len(x) != 3 {
+		panic("dimension of the problem must be 3")
+	}
+	a := x[0] + x[1]
+	b := x[1] + x[2]
+	return a*a + b*b
+}
+
+func (HS28) Grad(grad, x []float64) {
+	if len(x) != 3 {
+		panic("dimension of the problem must be 3")
+	}
+	if len(x) != len(grad) {
+		panic("incorrect size of the gradient")
+	}
+	a := x[0] + x[1]
+	b := x[1] + x[2]
+	grad[0] = 2 * a
+	grad[1] = 2 * (a + b)
+	grad[2] = 2 * b
+}
+
+func (HS28) Bounds() (lower, upper []float64) { return unbounded(3) }
+
+func (HS28) NumConstraints() (eq, ineq int) { return 1, 0 }
+
+func (HS28) Constraints(dst, x []float64) {
+	checkConstraints(dst, x, 1, 3)
+	dst[0] = x[0] + 2*x[1] + 3*x[2] - 1
+}
+
+func (HS28) ConstraintJacobian(dst *mat.Dense, x []float64) {
+	checkJacobian(dst, x, 1, 3)
+	dst.Set(0, 0, 1)
+	dst.Set(0, 1, 2)
+	dst.Set(0, 2, 3)
+}
+
+func (HS28) Minima() []Minimum {
+	return []Minimum{
+		{
+			X:      []float64{0.5, -0.5, 0.5},
+			F:      0,
+			Global: true,
+		},
+	}
+}
+
+// HS35 implements problem 35 of the Hock-Schittkowski test set, a quadratic
+// function with a linear inequality constraint and bounds.
+//
+//	minimize    9 - 8 x_0 - 6 x_1 - 4 x_2 + 2 x_0^2 + 2 x_1^2 + x_2^2
+//	                + 2 x_0 x_1 + 2 x_0 x_2
+//	subject to  3 - x_0 - x_1 - 2 x_2 ≥ 0
+//	            0 ≤ x_i
+//
+// Standard starting point:
+//
+//	[0.5, 0.5, 0.5]
+//
+// References:
+//   - Hock, W., Schittkowski, K.: Test Examples for Nonlinear Programming
+//     Codes. Lecture Notes in Economics and Mathematical Systems 187,
+//     Springer (1981)
+type HS35 struct{}
+
+func (HS35) Func(x []float64) float64 {
+	if len(x) != 3 {
+		panic("dimension of the problem must be 3")
+	}
+	return 9 - 8*x[0] - 6*x[1] - 4*x[2] +
+		2*x[0]*x[0] + 2*x[1]*x[1] + x[2]*x[2] +
+		2*x[0]*x[1] + 2*x[0]*x[2]
+}
+
+func (HS35) Grad(grad, x []float64) {
+	if len(x) != 3 {
+		panic("dimension of the problem must be 3")
+	}
+	if len(x) != len(grad) {
+		panic("incorrect size of the gradient")
+	}
+	grad[0] = -8 + 4*x[0] + 2*x[1] + 2*x[2]
+	grad[1] = -6 + 4*x[1] + 2*x[0]
+	grad[2] = -4 + 2*x[2] + 2*x[0]
+}
+
+func (HS35) Bounds() (lower, upper []float64) {
+	inf := math.Inf(1)
+	return []float64{0, 0, 0}, []float64{inf, inf, inf}
+}
+
+func (HS35) NumConstraints() (eq, ineq int) { return 0, 1 }
+
+func (HS35) Constraints(dst, x []float64) {
+	checkConstraints(dst, x, 1, 3)
+	dst[0] = 3 - x[0] - x[1] - 2*x[2]
+}
+
+func (HS35) ConstraintJacobian(dst *mat.Dense, x []float64) {
+	checkJacobian(dst, x, 1, 3)
+	dst.Set(0, 0, -1)
+	dst.Set(0, 1, -1)
+	dst.Set(0, 2, -2)
+}
+
+func (HS35) Minima() []Minimum {
+	return []Minimum{
+		{
+			X:      []float64{4.0 / 3, 7.0 / 9, 4.0 / 9},
+			F:      1.0 / 9,
+			Global: true,
+		},
+	}
+}
+
+// HS71 implements problem 71 of the Hock-Schittkowski test set, a general
+// function with nonlinear equality and inequality constraints and bounds.
+//
+//	minimize    x_0 x_3 (x_0 + x_1 + x_2) + x_2
+//	subject to  x_0^2 + x_1^2 + x_2^2 + x_3^2 - 40 = 0
+//	            x_0 x_1 x_2 x_3 - 25 ≥ 0
+//	            1 ≤ x_i ≤ 5
+//
+// Standard starting point:
+//
+//	[1, 5, 5, 1]
+//
+// References:
+//   - Hock, W., Schittkowski, K.: Test Examples for Nonlinear Programming
+//     Codes. Lecture Notes in Economics and Mathematical Systems 187,
+//     Springer (1981)
+type HS71 struct{}
+
+func (HS71) Func(x []float64) float64 {
+	if len(x) != 4 {
+		panic("dimension of the problem must be 4")
+	}
+	return x[0]*x[3]*(x[0]+x[1]+x[2]) + x[2]
+}
+
+func (HS71) Grad(grad, x []float64) {
+	if len(x) != 4 {
+		panic("dimension of the problem must be 4")
+	}
+	if len(x) != len(grad) {
+		panic("incorrect size of the gradient")
+	}
+	grad[0] = x[3]*(x[0]+x[1]+x[2]) + x[0]*x[3]
+	grad[1] = x[0] * x[3]
+	grad[2] = x[0]*x[3] + 1
+	grad[3] = x[0] * (x[0] + x[1] + x[2])
+}
+
+func (HS71) Bounds() (lower, upper []float64) {
+	return []float64{1, 1, 1, 1}, []float64{5, 5, 5, 5}
+}
+
+func (HS71) NumConstraints() (eq, ineq int) { return 1, 1 }
+
+func (HS71) Constraints(dst, x []float64) {
+	checkConstraints(dst, x, 2, 4)
+	dst[0] = x[0]*x[0] + x[1]*x[1] + x[2]*x[2] + x[3]*x[3] - 40
+	dst[1] = x[0]*x[1]*x[2]*x[3] - 25
+}
+
+func (HS71) ConstraintJacobian(dst *mat.Dense, x []float64) {
+	checkJacobian(dst, x, 2, 4)
+	for j := range x {
+		dst.Set(0, j, 2*x[j])
+	}
+	dst.Set(1, 0, x[1]*x[2]*x[3])
+	dst.Set(1, 1, x[0]*x[2]*x[3])
+	dst.Set(1, 2, x[0]*x[1]*x[3])
+	dst.Set(1, 3, x[0]*x[1]*x[2])
+}
+
+func (HS71) Minima() []Minimum {
+	return []Minimum{
+		{
+			X:      []float64{1, 4.742999637264417, 3.821149984184874, 1.3794082931726723},
+			F:      17.014017289156303,
+			Global: true,
+		},
+	}
+}
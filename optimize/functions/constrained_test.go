@@ -0,0 +1,114 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package functions
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestConstrained(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		f Constrained
+		x []float64
+	}{
+		{f: HS6{}, x: []float64{-1.2, 1}},
+		{f: HS7{}, x: []float64{2, 2}},
+		{f: HS21{}, x: []float64{-1, -1}},
+		{f: HS28{}, x: []float64{-4, 1, 1}},
+		{f: HS35{}, x: []float64{0.5, 0.5, 0.5}},
+		{f: HS71{}, x: []float64{1, 5, 5, 1}},
+	} {
+		f := test.f
+		n := len(test.x)
+		eq, ineq := f.NumConstraints()
+		m := eq + ineq
+		lower, upper := f.Bounds()
+		if len(lower) != n || len(upper) != n {
+			t.Errorf("%T: unexpected length of bounds: got:%d,%d want:%d", f, len(lower), len(upper), n)
+			continue
+		}
+
+		grad := make([]float64, n)
+		f.Grad(grad, test.x)
+		fdGrad := fd.Gradient(nil, f.Func, test.x, &fd.Settings{Formula: fd.Central})
+		if !floats.EqualApprox(grad, fdGrad, 1e-6) {
+			t.Errorf("%T: gradient does not match finite difference approximation: got:%v want:%v", f, grad, fdGrad)
+		}
+
+		jac := mat.NewDense(m, n, nil)
+		f.ConstraintJacobian(jac, test.x)
+		fdJac := mat.NewDense(m, n, nil)
+		fd.Jacobian(fdJac, f.Constraints, test.x, &fd.JacobianSettings{
+			Formula: fd.Central,
+		})
+		if !mat.EqualApprox(jac, fdJac, 1e-6) {
+			t.Errorf("%T: constraint Jacobian does not match finite difference approximation:\ngot:\n%v\nwant:\n%v",
+				f, mat.Formatted(jac), mat.Formatted(fdJac))
+		}
+
+		const tol = 1e-10
+		c := make([]float64, m)
+		for _, minimum := range f.(minimumer).Minima() {
+			if got := f.Func(minimum.X); math.Abs(got-minimum.F) > tol*math.Max(1, math.Abs(minimum.F)) {
+				t.Errorf("%T: unexpected function value at minimum %v: got:%v want:%v", f, minimum.X, got, minimum.F)
+			}
+			for i, v := range minimum.X {
+				if v < lower[i] || upper[i] < v {
+					t.Errorf("%T: minimum %v violates bounds", f, minimum.X)
+					break
+				}
+			}
+			f.Constraints(c, minimum.X)
+			for i, v := range c {
+				if (i < eq && math.Abs(v) > tol) || (i >= eq && v < -tol) {
+					t.Errorf("%T: minimum %v violates constraint %d: %v", f, minimum.X, i, v)
+				}
+			}
+		}
+	}
+}
+
+func TestNoisy(t *testing.T) {
+	t.Parallel()
+	x := []float64{-1.2, 1}
+	want := ExtendedRosenbrock{}.Func(x)
+
+	f := Noisy{Function: ExtendedRosenbrock{}}
+	if got := f.Func(x); got != want {
+		t.Errorf("unexpected noise-free value: got:%v want:%v", got, want)
+	}
+
+	const sigma = 0.1
+	f = Noisy{Function: ExtendedRosenbrock{}, Sigma: sigma, Src: rand.NewPCG(1, 1)}
+	var differ bool
+	for range 100 {
+		got := f.Func(x)
+		if got < (1-sigma)*want || (1+sigma)*want < got {
+			t.Errorf("noisy value out of range: got:%v want in [%v, %v]", got, (1-sigma)*want, (1+sigma)*want)
+		}
+		differ = differ || got != want
+	}
+	if !differ {
+		t.Error("noisy values do not differ from the noise-free value")
+	}
+
+	grad := make([]float64, 2)
+	f.Grad(grad, x)
+	wantGrad := make([]float64, 2)
+	ExtendedRosenbrock{}.Grad(wantGrad, x)
+	if !floats.Equal(grad, wantGrad) {
+		t.Errorf("unexpected gradient: got:%v want:%v", grad, wantGrad)
+	}
+	if len(f.Minima()) != len(ExtendedRosenbrock{}.Minima()) {
+		t.Error("unexpected minima of noisy function")
+	}
+}
@@ -80,6 +80,25 @@ func (Beale) Hess(dst *mat.SymDense, x []float64) {
 	dst.SetSym(1, 1, h11)
 }
 
+func (Beale) NumResiduals(n int) int { return 3 }
+
+func (Beale) Residuals(dst, x []float64) {
+	checkResiduals(dst, x, 3, 2)
+	dst[0] = 1.5 - x[0]*(1-x[1])
+	dst[1] = 2.25 - x[0]*(1-x[1]*x[1])
+	dst[2] = 2.625 - x[0]*(1-x[1]*x[1]*x[1])
+}
+
+func (Beale) Jacobian(dst *mat.Dense, x []float64) {
+	checkJacobian(dst, x, 3, 2)
+	dst.Set(0, 0, -(1 - x[1]))
+	dst.Set(0, 1, x[0])
+	dst.Set(1, 0, -(1 - x[1]*x[1]))
+	dst.Set(1, 1, 2*x[0]*x[1])
+	dst.Set(2, 0, -(1 - x[1]*x[1]*x[1]))
+	dst.Set(2, 1, 3*x[0]*x[1]*x[1])
+}
+
 func (Beale) Minima() []Minimum {
 	return []Minimum{
 		{
@@ -472,6 +491,28 @@ func (Box3D) Grad(grad, x []float64) {
 	}
 }
 
+func (Box3D) NumResiduals(n int) int { return 10 }
+
+func (Box3D) Residuals(dst, x []float64) {
+	checkResiduals(dst, x, 10, 3)
+	for i := range dst {
+		c := -float64(i+1) / 10
+		y := math.Exp(c) - math.Exp(10*c)
+		dst[i] = math.Exp(c*x[0]) - math.Exp(c*x[1]) - x[2]*y
+	}
+}
+
+func (Box3D) Jacobian(dst *mat.Dense, x []float64) {
+	checkJacobian(dst, x, 10, 3)
+	for i := 0; i < 10; i++ {
+		c := -float64(i+1) / 10
+		y := math.Exp(c) - math.Exp(10*c)
+		dst.Set(i, 0, c*math.Exp(c*x[0]))
+		dst.Set(i, 1, -c*math.Exp(c*x[1]))
+		dst.Set(i, 2, -y)
+	}
+}
+
 func (Box3D) Minima() []Minimum {
 	return []Minimum{
 		{
@@ -589,6 +630,25 @@ func (BrownBadlyScaled) Hess(dst *mat.SymDense, x []float64) {
 	dst.SetSym(1, 1, h11)
 }
 
+func (BrownBadlyScaled) NumResiduals(n int) int { return 3 }
+
+func (BrownBadlyScaled) Residuals(dst, x []float64) {
+	checkResiduals(dst, x, 3, 2)
+	dst[0] = x[0] - 1e6
+	dst[1] = x[1] - 2e-6
+	dst[2] = float64(x[0]*x[1]) - 2 // Prevent fused multiply subtract.
+}
+
+func (BrownBadlyScaled) Jacobian(dst *mat.Dense, x []float64) {
+	checkJacobian(dst, x, 3, 2)
+	dst.Set(0, 0, 1)
+	dst.Set(0, 1, 0)
+	dst.Set(1, 0, 0)
+	dst.Set(1, 1, 1)
+	dst.Set(2, 0, x[1])
+	dst.Set(2, 1, x[0])
+}
+
 func (BrownBadlyScaled) Minima() []Minimum {
 	return []Minimum{
 		{
@@ -817,6 +877,28 @@ func (ExtendedRosenbrock) Grad(grad, x []float64) {
 	}
 }
 
+func (ExtendedRosenbrock) NumResiduals(n int) int { return 2 * (n - 1) }
+
+func (ExtendedRosenbrock) Residuals(dst, x []float64) {
+	n := len(x)
+	checkResiduals(dst, x, 2*(n-1), n)
+	for i := 0; i < n-1; i++ {
+		dst[2*i] = 10 * (x[i+1] - x[i]*x[i])
+		dst[2*i+1] = 1 - x[i]
+	}
+}
+
+func (ExtendedRosenbrock) Jacobian(dst *mat.Dense, x []float64) {
+	n := len(x)
+	checkJacobian(dst, x, 2*(n-1), n)
+	dst.Zero()
+	for i := 0; i < n-1; i++ {
+		dst.Set(2*i, i, -20*x[i])
+		dst.Set(2*i, i+1, 10)
+		dst.Set(2*i+1, i, -1)
+	}
+}
+
 func (ExtendedRosenbrock) Minima() []Minimum {
 	return []Minimum{
 		{
@@ -1089,6 +1171,42 @@ func (HelicalValley) Grad(grad, x []float64) {
 	grad[2] = 2 * (100*s + x[2])
 }
 
+func (HelicalValley) NumResiduals(n int) int { return 3 }
+
+func (HelicalValley) Residuals(dst, x []float64) {
+	checkResiduals(dst, x, 3, 3)
+	if x[0] == 0 {
+		panic("function not defined at x[0] = 0")
+	}
+
+	theta := 0.5 * math.Atan(x[1]/x[0]) / math.Pi
+	if x[0] < 0 {
+		theta += 0.5
+	}
+	dst[0] = 10 * (x[2] - 10*theta)
+	dst[1] = 10 * (math.Hypot(x[0], x[1]) - 1)
+	dst[2] = x[2]
+}
+
+func (HelicalValley) Jacobian(dst *mat.Dense, x []float64) {
+	checkJacobian(dst, x, 3, 3)
+	if x[0] == 0 {
+		panic("function not defined at x[0] = 0")
+	}
+
+	h := math.Hypot(x[0], x[1])
+	q := 50 / (math.Pi * h * h)
+	dst.Set(0, 0, q*x[1])
+	dst.Set(0, 1, -q*x[0])
+	dst.Set(0, 2, 10)
+	dst.Set(1, 0, 10*x[0]/h)
+	dst.Set(1, 1, 10*x[1]/h)
+	dst.Set(1, 2, 0)
+	dst.Set(2, 0, 0)
+	dst.Set(2, 1, 0)
+	dst.Set(2, 2, 1)
+}
+
 func (HelicalValley) Minima() []Minimum {
 	return []Minimum{
 		{
@@ -1316,6 +1434,22 @@ func (PowellBadlyScaled) Hess(dst *mat.SymDense, x []float64) {
 	dst.SetSym(1, 1, h11)
 }
 
+func (PowellBadlyScaled) NumResiduals(n int) int { return 2 }
+
+func (PowellBadlyScaled) Residuals(dst, x []float64) {
+	checkResiduals(dst, x, 2, 2)
+	dst[0] = 1e4*x[0]*x[1] - 1
+	dst[1] = math.Exp(-x[0]) + math.Exp(-x[1]) - 1.0001
+}
+
+func (PowellBadlyScaled) Jacobian(dst *mat.Dense, x []float64) {
+	checkJacobian(dst, x, 2, 2)
+	dst.Set(0, 0, 1e4*x[1])
+	dst.Set(0, 1, 1e4*x[0])
+	dst.Set(1, 0, -math.Exp(-x[0]))
+	dst.Set(1, 1, -math.Exp(-x[1]))
+}
+
 func (PowellBadlyScaled) Minima() []Minimum {
 	return []Minimum{
 		{
@@ -1690,6 +1824,33 @@ func (Wood) Hess(dst *mat.SymDense, x []float64) {
 	dst.SetSym(3, 3, 200.2)
 }
 
+func (Wood) NumResiduals(n int) int { return 6 }
+
+func (Wood) Residuals(dst, x []float64) {
+	checkResiduals(dst, x, 6, 4)
+	dst[0] = 10 * (x[1] - x[0]*x[0])
+	dst[1] = 1 - x[0]
+	dst[2] = math.Sqrt(90) * (x[3] - x[2]*x[2])
+	dst[3] = 1 - x[2]
+	dst[4] = math.Sqrt(10) * (x[1] + x[3] - 2)
+	dst[5] = (x[1] - x[3]) / math.Sqrt(10)
+}
+
+func (Wood) Jacobian(dst *mat.Dense, x []float64) {
+	checkJacobian(dst, x, 6, 4)
+	dst.Zero()
+	dst.Set(0, 0, -20*x[0])
+	dst.Set(0, 1, 10)
+	dst.Set(1, 0, -1)
+	dst.Set(2, 2, -2*math.Sqrt(90)*x[2])
+	dst.Set(2, 3, math.Sqrt(90))
+	dst.Set(3, 2, -1)
+	dst.Set(4, 1, math.Sqrt(10))
+	dst.Set(4, 3, math.Sqrt(10))
+	dst.Set(5, 1, 1/math.Sqrt(10))
+	dst.Set(5, 3, -1/math.Sqrt(10))
+}
+
 func (Wood) Minima() []Minimum {
 	return []Minimum{
 		{
@@ -0,0 +1,584 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package functions
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// LeastSquares is an objective function that is the sum of squares of
+// residuals,
+//
+//	f(x) = \sum_{i=1}^m r_i(x)^2.
+//
+// The least-squares functions of this package implement LeastSquares
+// and their Grad methods compute the gradient 2 Jᵀ r.
+type LeastSquares interface {
+	// Func returns the sum of squares of the residuals at x.
+	Func(x []float64) float64
+
+	// NumResiduals returns the number of residuals
+	// of the function for an input of dimension n.
+	NumResiduals(n int) int
+
+	// Residuals stores the residuals at x in dst.
+	// The length of dst must equal NumResiduals(len(x)).
+	Residuals(dst, x []float64)
+
+	// Jacobian stores the Jacobian of the residuals at
+	// x, J_{ij} = ∂r_i/∂x_j, in dst. The dimensions of
+	// dst must be NumResiduals(len(x))×len(x).
+	Jacobian(dst *mat.Dense, x []float64)
+}
+
+// sumSquares returns the sum of squares of the residuals of f at x.
+func sumSquares(f LeastSquares, x []float64) (sum float64) {
+	r := make([]float64, f.NumResiduals(len(x)))
+	f.Residuals(r, x)
+	for _, v := range r {
+		sum += v * v
+	}
+	return sum
+}
+
+// sumSquaresGrad stores the gradient of the sum of squares of the
+// residuals of f at x, 2 Jᵀ r, in grad.
+func sumSquaresGrad(f LeastSquares, grad, x []float64) {
+	if len(x) != len(grad) {
+		panic("incorrect size of the gradient")
+	}
+	m := f.NumResiduals(len(x))
+	r := make([]float64, m)
+	f.Residuals(r, x)
+	jac := mat.NewDense(m, len(x), nil)
+	f.Jacobian(jac, x)
+	g := mat.NewVecDense(len(grad), grad)
+	g.MulVec(jac.T(), mat.NewVecDense(m, r))
+	g.ScaleVec(2, g)
+}
+
+// checkResiduals panics if the lengths of the residuals
+// and the input do not match the dimensions m×n.
+func checkResiduals(dst, x []float64, m, n int) {
+	if len(x) != n {
+		panic(badInputDim)
+	}
+	if len(dst) != m {
+		panic("incorrect number of residuals")
+	}
+}
+
+// checkJacobian panics if the dimensions of the Jacobian
+// and the input do not match the dimensions m×n.
+func checkJacobian(dst *mat.Dense, x []float64, m, n int) {
+	if len(x) != n {
+		panic(badInputDim)
+	}
+	if r, c := dst.Dims(); r != m || c != n {
+		panic("incorrect size of the Jacobian")
+	}
+}
+
+// FreudensteinRoth implements the Freudenstein and Roth function.
+//
+// Standard starting point:
+//
+//	[0.5, -2]
+//
+// References:
+//   - Freudenstein, F., Roth, B.: Numerical solution of systems of nonlinear
+//     equations. J ACM 10 (1963), 550-556
+//   - More, J., Garbow, B.S., Hillstrom, K.E.: Testing unconstrained
+//     optimization software. ACM Trans Math Softw 7 (1981), 17-41
+type FreudensteinRoth struct{}
+
+func (f FreudensteinRoth) Func(x []float64) float64 { return sumSquares(f, x) }
+
+func (f FreudensteinRoth) Grad(grad, x []float64) { sumSquaresGrad(f, grad, x) }
+
+func (FreudensteinRoth) NumResiduals(n int) int { return 2 }
+
+func (FreudensteinRoth) Residuals(dst, x []float64) {
+	checkResiduals(dst, x, 2, 2)
+	dst[0] = -13 + x[0] + ((5-x[1])*x[1]-2)*x[1]
+	dst[1] = -29 + x[0] + ((x[1]+1)*x[1]-14)*x[1]
+}
+
+func (FreudensteinRoth) Jacobian(dst *mat.Dense, x []float64) {
+	checkJacobian(dst, x, 2, 2)
+	dst.Set(0, 0, 1)
+	dst.Set(0, 1, (10-3*x[1])*x[1]-2)
+	dst.Set(1, 0, 1)
+	dst.Set(1, 1, (3*x[1]+2)*x[1]-14)
+}
+
+func (FreudensteinRoth) Minima() []Minimum {
+	return []Minimum{
+		{
+			X:      []float64{5, 4},
+			F:      0,
+			Global: true,
+		},
+		{
+			X:      []float64{11.412778986902095, -0.8968052532744764},
+			F:      48.98425367924001,
+			Global: false,
+		},
+	}
+}
+
+// JennrichSampson implements the Jennrich and Sampson function with
+// 10 residuals.
+//
+// Standard starting point:
+//
+//	[0.3, 0.4]
+//
+// References:
+//   - Jennrich, R.I., Sampson, P.F.: Application of stepwise regression to
+//     nonlinear estimation. Technometrics 10 (1968), 63-72
+//   - More, J., Garbow, B.S., Hillstrom, K.E.: Testing unconstrained
+//     optimization software. ACM Trans Math Softw 7 (1981), 17-41
+type JennrichSampson struct{}
+
+func (f JennrichSampson) Func(x []float64) float64 { return sumSquares(f, x) }
+
+func (f JennrichSampson) Grad(grad, x []float64) { sumSquaresGrad(f, grad, x) }
+
+func (JennrichSampson) NumResiduals(n int) int { return 10 }
+
+func (JennrichSampson) Residuals(dst, x []float64) {
+	checkResiduals(dst, x, 10, 2)
+	for i := range dst {
+		c := float64(i + 1)
+		dst[i] = 2 + 2*c - (math.Exp(c*x[0]) + math.Exp(c*x[1]))
+	}
+}
+
+func (JennrichSampson) Jacobian(dst *mat.Dense, x []float64) {
+	checkJacobian(dst, x, 10, 2)
+	for i := 0; i < 10; i++ {
+		c := float64(i + 1)
+		dst.Set(i, 0, -c*math.Exp(c*x[0]))
+		dst.Set(i, 1, -c*math.Exp(c*x[1]))
+	}
+}
+
+func (JennrichSampson) Minima() []Minimum {
+	return []Minimum{
+		{
+			X:      []float64{0.2578252136703641, 0.2578252136703641},
+			F:      124.36218235561483,
+			Global: true,
+		},
+	}
+}
+
+// Bard implements the Bard function.
+//
+// Standard starting point:
+//
+//	[1, 1, 1]
+//
+// References:
+//   - Bard, Y.: Comparison of gradient methods for the solution of nonlinear
+//     parameter estimation problems. SIAM J Numer Anal 7 (1970), 157-186
+//   - More, J., Garbow, B.S., Hillstrom, K.E.: Testing unconstrained
+//     optimization software. ACM Trans Math Softw 7 (1981), 17-41
+type Bard struct{}
+
+var bardY = [15]float64{
+	0.14, 0.18, 0.22, 0.25, 0.29, 0.32, 0.35, 0.39,
+	0.37, 0.58, 0.73, 0.96, 1.34, 2.10, 4.39,
+}
+
+func (f Bard) Func(x []float64) float64 { return sumSquares(f, x) }
+
+func (f Bard) Grad(grad, x []float64) { sumSquaresGrad(f, grad, x) }
+
+func (Bard) NumResiduals(n int) int { return 15 }
+
+func (Bard) Residuals(dst, x []float64) {
+	checkResiduals(dst, x, 15, 3)
+	for i, y := range bardY {
+		u := float64(i + 1)
+		v := 15 - float64(i)
+		w := math.Min(u, v)
+		dst[i] = y - (x[0] + u/(v*x[1]+w*x[2]))
+	}
+}
+
+func (Bard) Jacobian(dst *mat.Dense, x []float64) {
+	checkJacobian(dst, x, 15, 3)
+	for i := range bardY {
+		u := float64(i + 1)
+		v := 15 - float64(i)
+		w := math.Min(u, v)
+		d := v*x[1] + w*x[2]
+		d2 := d * d
+		dst.Set(i, 0, -1)
+		dst.Set(i, 1, u*v/d2)
+		dst.Set(i, 2, u*w/d2)
+	}
+}
+
+func (Bard) Minima() []Minimum {
+	return []Minimum{
+		{
+			X:      []float64{0.08241055974978896, 1.1330360920297222, 2.3436951786425366},
+			F:      0.008214877306578982,
+			Global: true,
+		},
+	}
+}
+
+// KowalikOsborne implements the Kowalik and Osborne function.
+//
+// Standard starting point:
+//
+//	[0.25, 0.39, 0.415, 0.39]
+//
+// References:
+//   - Kowalik, J.S., Osborne, M.R.: Methods for Unconstrained Optimization
+//     Problems. Elsevier North-Holland, New York (1968)
+//   - More, J., Garbow, B.S., Hillstrom, K.E.: Testing unconstrained
+//     optimization software. ACM Trans Math Softw 7 (1981), 17-41
+type KowalikOsborne struct{}
+
+var (
+	kowalikOsborneY = [11]float64{
+		0.1957, 0.1947, 0.1735, 0.1600, 0.0844, 0.0627,
+		0.0456, 0.0342, 0.0323, 0.0235, 0.0246,
+	}
+	kowalikOsborneU = [11]float64{
+		4, 2, 1, 0.5, 0.25, 0.167,
+		0.125, 0.1, 0.0833, 0.0714, 0.0625,
+	}
+)
+
+func (f KowalikOsborne) Func(x []float64) float64 { return sumSquares(f, x) }
+
+func (f KowalikOsborne) Grad(grad, x []float64) { sumSquaresGrad(f, grad, x) }
+
+func (KowalikOsborne) NumResiduals(n int) int { return 11 }
+
+func (KowalikOsborne) Residuals(dst, x []float64) {
+	checkResiduals(dst, x, 11, 4)
+	for i, y := range kowalikOsborneY {
+		u := kowalikOsborneU[i]
+		dst[i] = y - x[0]*u*(u+x[1])/(u*(u+x[2])+x[3])
+	}
+}
+
+func (KowalikOsborne) Jacobian(dst *mat.Dense, x []float64) {
+	checkJacobian(dst, x, 11, 4)
+	for i, u := range kowalikOsborneU {
+		num := u * (u + x[1])
+		den := u*(u+x[2]) + x[3]
+		dst.Set(i, 0, -num/den)
+		dst.Set(i, 1, -x[0]*u/den)
+		dst.Set(i, 2, x[0]*num*u/(den*den))
+		dst.Set(i, 3, x[0]*num/(den*den))
+	}
+}
+
+func (KowalikOsborne) Minima() []Minimum {
+	return []Minimum{
+		{
+			X:      []float64{0.19280693457903791, 0.19128232873436637, 0.12305650692632081, 0.13606233068379456},
+			F:      0.000307505603849238,
+			Global: true,
+		},
+	}
+}
+
+// Osborne1 implements the Osborne 1 function.
+//
+// Standard starting point:
+//
+//	[0.5, 1.5, -1, 0.01, 0.02]
+//
+// References:
+//   - Osborne, M.R.: Some aspects of nonlinear least squares calculations.
+//     Numerical Methods for Nonlinear Optimization, F.A. Lootsma (ed.),
+//     Academic Press (1972), 171-189
+//   - More, J., Garbow, B.S., Hillstrom, K.E.: Testing unconstrained
+//     optimization software. ACM Trans Math Softw 7 (1981), 17-41
+type Osborne1 struct{}
+
+var osborne1Y = [33]float64{
+	0.844, 0.908, 0.932, 0.936, 0.925, 0.908, 0.881, 0.850, 0.818, 0.784, 0.751,
+	0.718, 0.685, 0.658, 0.628, 0.603, 0.580, 0.558, 0.538, 0.522, 0.506, 0.490,
+	0.478, 0.467, 0.457, 0.448, 0.438, 0.431, 0.424, 0.420, 0.414, 0.411, 0.406,
+}
+
+func (f Osborne1) Func(x []float64) float64 { return sumSquares(f, x) }
+
+func (f Osborne1) Grad(grad, x []float64) { sumSquaresGrad(f, grad, x) }
+
+func (Osborne1) NumResiduals(n int) int { return 33 }
+
+func (Osborne1) Residuals(dst, x []float64) {
+	checkResiduals(dst, x, 33, 5)
+	for i, y := range osborne1Y {
+		t := 10 * float64(i)
+		dst[i] = y - (x[0] + x[1]*math.Exp(-t*x[3]) + x[2]*math.Exp(-t*x[4]))
+	}
+}
+
+func (Osborne1) Jacobian(dst *mat.Dense, x []float64) {
+	checkJacobian(dst, x, 33, 5)
+	for i := range osborne1Y {
+		t := 10 * float64(i)
+		e4 := math.Exp(-t * x[3])
+		e5 := math.Exp(-t * x[4])
+		dst.Set(i, 0, -1)
+		dst.Set(i, 1, -e4)
+		dst.Set(i, 2, -e5)
+		dst.Set(i, 3, t*x[1]*e4)
+		dst.Set(i, 4, t*x[2]*e5)
+	}
+}
+
+func (Osborne1) Minima() []Minimum {
+	return []Minimum{
+		{
+			X:      []float64{0.3754100521069519, 1.9358469127123514, -1.464687136613407, 0.012867534640057257, 0.022122699661672678},
+			F:      5.4648946974830124e-05,
+			Global: true,
+		},
+	}
+}
+
+// BrownAlmostLinear implements the Brown almost-linear function. The number
+// of residuals is equal to the dimension of the problem.
+//
+// Standard starting point:
+//
+//	[0.5, ..., 0.5]
+//
+// References:
+//   - Brown, K.M.: A quadratically convergent Newton-like method based upon
+//     Gaussian elimination. SIAM J Numer Anal 6 (1969), 560-569
+//   - More, J., Garbow, B.S., Hillstrom, K.E.: Testing unconstrained
+//     optimization software. ACM Trans Math Softw 7 (1981), 17-41
+type BrownAlmostLinear struct{}
+
+func (f BrownAlmostLinear) Func(x []float64) float64 { return sumSquares(f, x) }
+
+func (f BrownAlmostLinear) Grad(grad, x []float64) { sumSquaresGrad(f, grad, x) }
+
+func (BrownAlmostLinear) NumResiduals(n int) int { return n }
+
+func (BrownAlmostLinear) Residuals(dst, x []float64) {
+	n := len(x)
+	checkResiduals(dst, x, n, n)
+	var sum float64
+	prod := 1.0
+	for _, v := range x {
+		sum += v
+		prod *= v
+	}
+	for i := 0; i < n-1; i++ {
+		dst[i] = x[i] + sum - float64(n+1)
+	}
+	dst[n-1] = prod - 1
+}
+
+func (BrownAlmostLinear) Jacobian(dst *mat.Dense, x []float64) {
+	n := len(x)
+	checkJacobian(dst, x, n, n)
+	for i := 0; i < n-1; i++ {
+		for j := 0; j < n; j++ {
+			dst.Set(i, j, 1)
+		}
+		dst.Set(i, i, 2)
+	}
+	for j := 0; j < n; j++ {
+		prod := 1.0
+		for k, v := range x {
+			if k != j {
+				prod *= v
+			}
+		}
+		dst.Set(n-1, j, prod)
+	}
+}
+
+func (BrownAlmostLinear) Minima() []Minimum {
+	return []Minimum{
+		{
+			X:      []float64{1, 1},
+			F:      0,
+			Global: true,
+		},
+		{
+			X:      []float64{1, 1, 1, 1, 1},
+			F:      0,
+			Global: true,
+		},
+		{
+			X:      []float64{1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+			F:      0,
+			Global: true,
+		},
+	}
+}
+
+// LinearFullRank implements the linear function with full rank. M is the
+// number of residuals, which must not be less than the dimension of the
+// problem. If M is zero, the number of residuals is equal to the dimension
+// of the problem.
+//
+// Standard starting point:
+//
+//	[1, ..., 1]
+//
+// References:
+//   - More, J., Garbow, B.S., Hillstrom, K.E.: Testing unconstrained
+//     optimization software. ACM Trans Math Softw 7 (1981), 17-41
+type LinearFullRank struct {
+	M int
+}
+
+func (f LinearFullRank) Func(x []float64) float64 { return sumSquares(f, x) }
+
+func (f LinearFullRank) Grad(grad, x []float64) { sumSquaresGrad(f, grad, x) }
+
+func (f LinearFullRank) NumResiduals(n int) int {
+	if f.M == 0 {
+		return n
+	}
+	if f.M < n {
+		panic("number of residuals less than dimension of the problem")
+	}
+	return f.M
+}
+
+func (f LinearFullRank) Residuals(dst, x []float64) {
+	n := len(x)
+	m := f.NumResiduals(n)
+	checkResiduals(dst, x, m, n)
+	var sum float64
+	for _, v := range x {
+		sum += v
+	}
+	t := 2*sum/float64(m) + 1
+	for i := range dst {
+		dst[i] = -t
+		if i < n {
+			dst[i] += x[i]
+		}
+	}
+}
+
+func (f LinearFullRank) Jacobian(dst *mat.Dense, x []float64) {
+	n := len(x)
+	m := f.NumResiduals(n)
+	checkJacobian(dst, x, m, n)
+	c := -2 / float64(m)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			if i == j {
+				dst.Set(i, j, 1+c)
+			} else {
+				dst.Set(i, j, c)
+			}
+		}
+	}
+}
+
+func (f LinearFullRank) Minima() []Minimum {
+	var minima []Minimum
+	for _, n := range []int{2, 5, 10} {
+		if f.M != 0 && f.M < n {
+			continue
+		}
+		x := make([]float64, n)
+		for i := range x {
+			x[i] = -1
+		}
+		minima = append(minima, Minimum{
+			X:      x,
+			F:      float64(f.NumResiduals(n) - n),
+			Global: true,
+		})
+	}
+	return minima
+}
+
+// LinearRank1 implements the linear function with rank 1. M is the number of
+// residuals, which must not be less than the dimension of the problem. If M is
+// zero, the number of residuals is equal to the dimension of the problem.
+//
+// Standard starting point:
+//
+//	[1, ..., 1]
+//
+// References:
+//   - More, J., Garbow, B.S., Hillstrom, K.E.: Testing unconstrained
+//     optimization software. ACM Trans Math Softw 7 (1981), 17-41
+type LinearRank1 struct {
+	M int
+}
+
+func (f LinearRank1) Func(x []float64) float64 { return sumSquares(f, x) }
+
+func (f LinearRank1) Grad(grad, x []float64) { sumSquaresGrad(f, grad, x) }
+
+func (f LinearRank1) NumResiduals(n int) int {
+	if f.M == 0 {
+		return n
+	}
+	if f.M < n {
+		panic("number of residuals less than dimension of the problem")
+	}
+	return f.M
+}
+
+func (f LinearRank1) Residuals(dst, x []float64) {
+	n := len(x)
+	checkResiduals(dst, x, f.NumResiduals(n), n)
+	var sum float64
+	for j, v := range x {
+		sum += float64(j+1) * v
+	}
+	for i := range dst {
+		dst[i] = float64(i+1)*sum - 1
+	}
+}
+
+func (f LinearRank1) Jacobian(dst *mat.Dense, x []float64) {
+	n := len(x)
+	m := f.NumResiduals(n)
+	checkJacobian(dst, x, m, n)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			dst.Set(i, j, float64((i+1)*(j+1)))
+		}
+	}
+}
+
+// Minima returns minima of the function. The minima are not isolated, every
+// x with \sum_j j x_j = 3/(2m+1) is a global minimum.
+func (f LinearRank1) Minima() []Minimum {
+	var minima []Minimum
+	for _, n := range []int{2, 5, 10} {
+		if f.M != 0 && f.M < n {
+			continue
+		}
+		m := float64(f.NumResiduals(n))
+		x := make([]float64, n)
+		x[0] = 3 / (2*m + 1)
+		minima = append(minima, Minimum{
+			X:      x,
+			F:      m * (m - 1) / (2 * (2*m + 1)),
+			Global: true,
+		})
+	}
+	return minima
+}
@@ -0,0 +1,131 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package functions
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+var leastSquaresTests = []struct {
+	f LeastSquares
+	x []float64
+}{
+	{f: Beale{}, x: []float64{1, 4}},
+	{f: Box3D{}, x: []float64{0, 10, 20}},
+	{f: BrownBadlyScaled{}, x: []float64{1, 1}},
+	{f: ExtendedRosenbrock{}, x: []float64{-1.2, 1, -1.2, 1}},
+	{f: HelicalValley{}, x: []float64{-1, 0, 0}},
+	{f: PowellBadlyScaled{}, x: []float64{0, 1}},
+	{f: Wood{}, x: []float64{-3, -1, -3, -1}},
+	{f: FreudensteinRoth{}, x: []float64{0.5, -2}},
+	{f: JennrichSampson{}, x: []float64{0.3, 0.4}},
+	{f: Bard{}, x: []float64{1, 1, 1}},
+	{f: KowalikOsborne{}, x: []float64{0.25, 0.39, 0.415, 0.39}},
+	{f: Osborne1{}, x: []float64{0.5, 1.5, -1, 0.01, 0.02}},
+	{f: BrownAlmostLinear{}, x: []float64{0.5, 0.5, 0.5, 0.5, 0.5}},
+	{f: LinearFullRank{}, x: []float64{1, 1, 1}},
+	{f: LinearFullRank{M: 7}, x: []float64{1, 1, 1}},
+	{f: LinearRank1{M: 5}, x: []float64{1, 1, 1}},
+}
+
+func TestLeastSquares(t *testing.T) {
+	t.Parallel()
+	for _, test := range leastSquaresTests {
+		f := test.f
+		n := len(test.x)
+		m := f.NumResiduals(n)
+		r := make([]float64, m)
+		f.Residuals(r, test.x)
+		want := floats.Dot(r, r)
+		if got := f.Func(test.x); math.Abs(got-want) > 1e-12*math.Max(1, math.Abs(want)) {
+			t.Errorf("%T: function value does not match residuals: got:%v want:%v", f, got, want)
+		}
+
+		jac := mat.NewDense(m, n, nil)
+		f.Jacobian(jac, test.x)
+		fdJac := mat.NewDense(m, n, nil)
+		fd.Jacobian(fdJac, f.Residuals, test.x, &fd.JacobianSettings{
+			Formula: fd.Central,
+		})
+		if !mat.EqualApprox(jac, fdJac, 1e-5*math.Max(1, mat.Norm(jac, math.Inf(1)))) {
+			t.Errorf("%T: Jacobian does not match finite difference approximation:\ngot:\n%v\nwant:\n%v",
+				f, mat.Formatted(jac), mat.Formatted(fdJac))
+		}
+
+		g, ok := f.(interface{ Grad(grad, x []float64) })
+		if !ok {
+			continue
+		}
+		grad := make([]float64, n)
+		g.Grad(grad, test.x)
+		var wantGrad mat.VecDense
+		wantGrad.MulVec(jac.T(), mat.NewVecDense(m, r))
+		wantGrad.ScaleVec(2, &wantGrad)
+		if !floats.EqualApprox(grad, wantGrad.RawVector().Data, 1e-9*math.Max(1, floats.Norm(grad, math.Inf(1)))) {
+			t.Errorf("%T: gradient does not match 2 Jᵀ r: got:%v want:%v", f, grad, wantGrad.RawVector().Data)
+		}
+	}
+}
+
+func TestFreudensteinRoth(t *testing.T) {
+	t.Parallel()
+	tests := []funcTest{
+		{
+			X:        []float64{0.5, -2},
+			F:        400.5,
+			Gradient: []float64{30, -1272},
+		},
+	}
+	testFunction(FreudensteinRoth{}, tests, t)
+}
+
+func TestJennrichSampson(t *testing.T) {
+	t.Parallel()
+	testFunction(JennrichSampson{}, nil, t)
+}
+
+func TestBard(t *testing.T) {
+	t.Parallel()
+	testFunction(Bard{}, nil, t)
+}
+
+func TestKowalikOsborne(t *testing.T) {
+	t.Parallel()
+	testFunction(KowalikOsborne{}, nil, t)
+}
+
+func TestOsborne1(t *testing.T) {
+	t.Parallel()
+	testFunction(Osborne1{}, nil, t)
+}
+
+func TestBrownAlmostLinear(t *testing.T) {
+	t.Parallel()
+	tests := []funcTest{
+		{
+			X:        []float64{0.5, 0.5},
+			F:        2.8125,
+			Gradient: []float64{-6.75, -3.75},
+		},
+	}
+	testFunction(BrownAlmostLinear{}, tests, t)
+}
+
+func TestLinearFullRank(t *testing.T) {
+	t.Parallel()
+	testFunction(LinearFullRank{}, nil, t)
+	testFunction(LinearFullRank{M: 10}, nil, t)
+}
+
+func TestLinearRank1(t *testing.T) {
+	t.Parallel()
+	testFunction(LinearRank1{}, nil, t)
+	testFunction(LinearRank1{M: 10}, nil, t)
+}
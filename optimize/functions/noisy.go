@@ -0,0 +1,66 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package functions
+
+import "math/rand/v2"
+
+// Noisy is a function with stochastic multiplicative noise,
+//
+//	f_N(x) = (1 + σ ε) f(x),
+//
+// where ε is drawn from the uniform distribution on [-1, 1] at each
+// evaluation. Noisy functions are used to benchmark derivative-free
+// optimization methods.
+//
+// References:
+//   - More, J.J., Wild, S.M.: Benchmarking derivative-free optimization
+//     algorithms. SIAM J Optim 20 (2009), 172-191
+type Noisy struct {
+	// Function is the noise-free function.
+	Function interface {
+		Func(x []float64) float64
+	}
+
+	// Sigma is the relative level of the noise.
+	Sigma float64
+
+	// Src is the source of random numbers. If
+	// Src is nil, the global source is used.
+	Src rand.Source
+}
+
+func (f Noisy) Func(x []float64) float64 {
+	var u float64
+	if f.Src == nil {
+		u = rand.Float64()
+	} else {
+		u = rand.New(f.Src).Float64()
+	}
+	return (1 + f.Sigma*(2*u-1)) * f.Function.Func(x)
+}
+
+// Grad stores the gradient of the noise-free function at x in grad. Grad
+// panics if the noise-free function does not have a Grad method.
+func (f Noisy) Grad(grad, x []float64) {
+	g, ok := f.Function.(interface {
+		Grad(grad, x []float64)
+	})
+	if !ok {
+		panic("functions: noise-free function has no gradient")
+	}
+	g.Grad(grad, x)
+}
+
+// Minima returns the minima of the noise-free function. Minima returns nil if
+// the noise-free function does not have a Minima method.
+func (f Noisy) Minima() []Minimum {
+	m, ok := f.Function.(interface {
+		Minima() []Minimum
+	})
+	if !ok {
+		return nil
+	}
+	return m.Minima()
+}
@@ -14,6 +14,8 @@ package optimize
 // like checking for (various types of) convergence and maintaining statistics.
 //
 // A Method can command an Evaluation, a MajorIteration or NoOperation operations.
+// It may also return MethodDone to signal that it has converged by its own
+// criteria, in which case the optimization terminates with MethodConverge status.
 //
 // An evaluation operation is one or more of the Evaluation operations
 // (FuncEvaluation, GradEvaluation, etc.) which can be combined with
@@ -58,6 +60,15 @@ type needser interface {
 	}
 }
 
+// hessVecUser is a Method that evaluates Hessian-vector products. Since the
+// product depends on a vector as well as the location, the evaluations are
+// performed directly by the Method rather than commanded by an Operation.
+type hessVecUser interface {
+	// setHessVec sets the function evaluating the Hessian-vector product.
+	// It is called after Init and before Run.
+	setHessVec(hessVec func(hv, x, v []float64))
+}
+
 // Statuser can report the status and any error. It is intended for methods as
 // an additional error reporting mechanism apart from the errors returned from
 // Init and Iterate.
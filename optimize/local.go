@@ -66,6 +66,11 @@ Loop:
 				l.finishMethodDone(operation, result, r)
 				return Failure, err
 			}
+			if op == MethodDone {
+				// The method has converged by its own criteria.
+				l.finishMethodDone(operation, result, r)
+				return MethodConverge, nil
+			}
 			r.Op = op
 			operation <- r
 		}
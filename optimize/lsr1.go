@@ -0,0 +1,251 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+var (
+	_ Method      = (*LSR1)(nil)
+	_ localMethod = (*LSR1)(nil)
+)
+
+// lsr1IterType is an LSR1 evaluation kind.
+type lsr1IterType int
+
+const (
+	lsr1Trial lsr1IterType = iota
+	lsr1Major
+)
+
+// LSR1 implements a limited-memory symmetric rank-one (L-SR1) quasi-Newton
+// trust-region method for gradient-based unconstrained minimization.
+//
+// LSR1 approximates the Hessian of the objective function by a matrix B
+// formed from a multiple of the identity by the symmetric rank-one updates
+// defined by the last Store pairs of steps s and gradient differences y.
+// Unlike the BFGS update, the SR1 update does not force B to be positive
+// definite and its approximations of the Hessian are often more accurate,
+// which makes it well suited to nonconvex problems. Since B may be
+// indefinite, the step is computed by approximately minimizing the
+// quadratic model within a trust region using the truncated conjugate
+// gradient method of Steihaug. B is applied to vectors in O(Store * dim)
+// operations and is never formed explicitly.
+//
+// The SR1 update and its use in trust-region methods are described in
+// Sections 4.1 and 6.2 of Nocedal, Wright (2006), 2nd edition.
+type LSR1 struct {
+	// Store is the size of the limited-memory storage.
+	// If Store is 0, it will be defaulted to 10.
+	Store int
+	// InitialRadius is the initial radius of the trust region.
+	// If InitialRadius is 0, it will be defaulted to 1.
+	InitialRadius float64
+	// GradStopThreshold sets the threshold for stopping if the gradient norm
+	// gets too small. If GradStopThreshold is 0 it is defaulted to 1e-12, and
+	// if it is NaN the setting is not used.
+	GradStopThreshold float64
+
+	status Status
+	err    error
+
+	x, g []float64 // Current location and gradient
+	f    float64   // Current function value
+
+	delta   float64   // Trust-region radius
+	step    []float64 // Last trial step
+	predict float64   // Reduction in the model predicted for the step
+
+	gamma float64     // Scale of the initial Hessian approximation
+	s, y  [][]float64 // Last Store values of s and y, oldest first
+	u     [][]float64 // Rank-one update vectors y - B s
+	us    []float64   // Values of uᵀs, or zero if the update is skipped
+
+	lastIter lsr1IterType
+}
+
+func (l *LSR1) Status() (Status, error) {
+	return l.status, l.err
+}
+
+func (*LSR1) Uses(has Available) (uses Available, err error) {
+	return has.gradient()
+}
+
+func (l *LSR1) Init(dim, tasks int) int {
+	l.status = NotTerminated
+	l.err = nil
+	return 1
+}
+
+func (l *LSR1) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	l.status, l.err = localOptimizer{}.run(l, l.GradStopThreshold, operation, result, tasks)
+	close(operation)
+}
+
+func (l *LSR1) initLocal(loc *Location) (Operation, error) {
+	if l.Store == 0 {
+		l.Store = 10
+	}
+	if l.Store < 0 {
+		panic("optimize: LSR1.Store is negative")
+	}
+	if l.InitialRadius < 0 {
+		panic("optimize: LSR1.InitialRadius is negative")
+	}
+	l.delta = l.InitialRadius
+	if l.delta == 0 {
+		l.delta = 1
+	}
+
+	dim := len(loc.X)
+	l.x = resize(l.x, dim)
+	l.g = resize(l.g, dim)
+	l.step = resize(l.step, dim)
+	copy(l.x, loc.X)
+	copy(l.g, loc.Gradient)
+	l.f = loc.F
+
+	l.gamma = 1
+	l.s = l.s[:0]
+	l.y = l.y[:0]
+	l.u = l.u[:0]
+	l.us = l.us[:0]
+	return l.trial(loc), nil
+}
+
+func (l *LSR1) iterateLocal(loc *Location) (Operation, error) {
+	if l.lastIter == lsr1Major {
+		return l.trial(loc), nil
+	}
+
+	snorm := floats.Norm(l.step, 2)
+	if math.IsInf(loc.F, 0) || math.IsNaN(loc.F) {
+		// The trial point is outside the domain of the function.
+		l.delta = 0.25 * snorm
+		return l.trial(loc), nil
+	}
+
+	// Update the Hessian approximation whether or not the step is
+	// accepted since the SR1 update does not require the curvature
+	// condition to hold.
+	y := make([]float64, len(l.g))
+	floats.SubTo(y, loc.Gradient, l.g)
+	l.update(l.step, y)
+
+	ratio := (l.f - loc.F) / l.predict
+	if l.predict <= 1e-15*math.Abs(l.f) && floats.Norm(loc.Gradient, 2) < floats.Norm(l.g, 2) {
+		// The reductions are at the level of rounding error in f
+		// so the ratio is unreliable. Accept the step if it reduces
+		// the gradient.
+		ratio = 1
+	}
+	switch {
+	case ratio < 0.1:
+		l.delta = 0.5 * snorm
+	case ratio > 0.75 && snorm > 0.8*l.delta:
+		l.delta *= 2
+	}
+	if ratio > 1e-4 {
+		// Accept the step. The evaluated location is the new
+		// major iterate.
+		copy(l.x, loc.X)
+		copy(l.g, loc.Gradient)
+		l.f = loc.F
+		l.lastIter = lsr1Major
+		return MajorIteration, nil
+	}
+	if l.delta <= 1e-15*math.Max(1, floats.Norm(l.x, 2)) {
+		// The trust region has collapsed, so no further
+		// progress is possible.
+		copy(loc.X, l.x)
+		copy(loc.Gradient, l.g)
+		loc.F = l.f
+		return MethodDone, nil
+	}
+	return l.trial(loc), nil
+}
+
+// trial computes the next trial step from the current location, stores the
+// trial point in loc and returns the evaluation operation.
+func (l *LSR1) trial(loc *Location) Operation {
+	l.predict = -trustRegionCGFunc(l.step, l.g, l.mulB, l.delta)
+	floats.AddTo(loc.X, l.x, l.step)
+	l.lastIter = lsr1Trial
+	return FuncEvaluation | GradEvaluation
+}
+
+// update adds the pair s and y to the limited memory and recomputes the
+// rank-one updates.
+func (l *LSR1) update(s, y []float64) {
+	// Scale the initial Hessian approximation by the Rayleigh quotient
+	// of the average Hessian along the latest step.
+	sy := floats.Dot(s, y)
+	if sy > 0 {
+		l.gamma = sy / floats.Dot(s, s)
+	}
+
+	if len(l.s) == l.Store {
+		// Recycle the storage of the oldest pair.
+		s0, y0, u0 := l.s[0], l.y[0], l.u[0]
+		copy(l.s, l.s[1:])
+		copy(l.y, l.y[1:])
+		copy(l.u, l.u[1:])
+		l.s[len(l.s)-1] = s0
+		l.y[len(l.y)-1] = y0
+		l.u[len(l.u)-1] = u0
+	} else {
+		dim := len(s)
+		l.s = append(l.s, make([]float64, dim))
+		l.y = append(l.y, make([]float64, dim))
+		l.u = append(l.u, make([]float64, dim))
+		l.us = append(l.us, 0)
+	}
+	copy(l.s[len(l.s)-1], s)
+	copy(l.y[len(l.y)-1], y)
+
+	// The update vectors depend on the scale of the initial Hessian
+	// approximation and on the preceding updates, so are recomputed
+	// from the oldest pair. Updates with a small denominator are
+	// skipped as described in Section 6.2 of Nocedal, Wright (2006).
+	const r = 1e-8
+	for i := range l.s {
+		l.us = l.us[:i]
+		ui := l.u[i]
+		l.mulB(ui, l.s[i])
+		floats.SubTo(ui, l.y[i], ui)
+		us := floats.Dot(ui, l.s[i])
+		if math.Abs(us) < r*floats.Norm(l.s[i], 2)*floats.Norm(ui, 2) {
+			us = 0
+		}
+		l.us = l.us[:i+1]
+		l.us[i] = us
+	}
+}
+
+// mulB stores the product of the Hessian approximation with v into dst.
+// Only the updates in l.us are applied.
+func (l *LSR1) mulB(dst, v []float64) {
+	floats.ScaleTo(dst, l.gamma, v)
+	for i, us := range l.us {
+		if us == 0 {
+			continue
+		}
+		floats.AddScaled(dst, floats.Dot(l.u[i], v)/us, l.u[i])
+	}
+}
+
+func (*LSR1) needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return struct {
+		Gradient bool
+		Hessian  bool
+	}{true, false}
+}
@@ -7,6 +7,7 @@ package optimize
 import (
 	"fmt"
 	"math"
+	"sync/atomic"
 	"time"
 
 	"gonum.org/v1/gonum/floats"
@@ -122,7 +123,9 @@ type Method interface {
 //
 // If p.Status is not nil, it is called before every evaluation. If the
 // returned Status is other than NotTerminated or if the error is not nil, the
-// optimization run is terminated.
+// optimization run is terminated. Evaluations of the Problem that time out,
+// panic or return non-finite function values are handled as specified by
+// the EvaluationTimeout, RecoverPanics and FailedEvaluation settings.
 //
 // The second argument specifies the initial location for the optimization.
 // Some Methods do not require an initial location, but initX must still be
@@ -151,6 +154,9 @@ type Method interface {
 // function evaluations. The Settings input struct can be used to limit this,
 // for example by modifying the maximum function evaluations or gradient tolerance.
 func Minimize(p Problem, initX []float64, settings *Settings, method Method) (*Result, error) {
+	if settings != nil && settings.Transform != nil {
+		return minimizeTransformed(p, initX, settings, method)
+	}
 	startTime := time.Now()
 	if method == nil {
 		method = getDefaultMethod(&p)
@@ -228,6 +234,23 @@ func minimize(prob *Problem, method Method, settings *Settings, converger Conver
 	}
 	nTasks = newNTasks
 
+	// Hessian-vector products are evaluated by the method so count them
+	// as they happen and update the statistics when tasks are received.
+	var hessVecEvals atomic.Int64
+	if m, ok := method.(hessVecUser); ok && prob.HessVec != nil {
+		m.setHessVec(func(hv, x, v []float64) {
+			hessVecEvals.Add(1)
+			prob.HessVec(hv, x, v)
+		})
+	}
+
+	// Take the reference location for shrinking failed evaluations
+	// before the method is able to modify the initial location.
+	var ref *reference
+	if settings.FailedEvaluation == ShrinkEvaluation {
+		ref = &reference{x: append([]float64(nil), initLoc.X...)}
+	}
+
 	// Launch the method. The method communicates tasks using the operations
 	// channel, and results is used to return the evaluated results.
 	operations := make(chan Task, nTasks)
@@ -270,9 +293,9 @@ func minimize(prob *Problem, method Method, settings *Settings, converger Conver
 	// closes statsChan, and with no more statistics to update the optimization
 	// concludes.
 
-	workerChan := make(chan Task) // Delegate tasks to the workers.
-	statsChan := make(chan Task)  // Send evaluation updates.
-	done := make(chan struct{})   // Communicate the optimization is done.
+	workerChan := make(chan Task)     // Delegate tasks to the workers.
+	statsChan := make(chan statsTask) // Send evaluation updates.
+	done := make(chan struct{})       // Communicate the optimization is done.
 
 	// Read tasks from the method and distribute as appropriate.
 	distributor := func() {
@@ -285,7 +308,7 @@ func minimize(prob *Problem, method Method, settings *Settings, converger Conver
 				case PostIteration:
 					panic("optimize: Method returned PostIteration")
 				case NoOperation, MajorIteration, MethodDone:
-					statsChan <- task
+					statsChan <- statsTask{Task: task}
 				default:
 					if !task.Op.isEvaluation() {
 						panic("optimize: expecting evaluation operation")
@@ -298,7 +321,7 @@ func minimize(prob *Problem, method Method, settings *Settings, converger Conver
 				close(workerChan)
 				for task := range operations {
 					if task.Op == MajorIteration {
-						statsChan <- task
+						statsChan <- statsTask{Task: task}
 					}
 				}
 				close(statsChan)
@@ -310,13 +333,13 @@ func minimize(prob *Problem, method Method, settings *Settings, converger Conver
 
 	// Evaluate the Problem concurrently.
 	worker := func() {
-		x := make([]float64, dim)
+		e := evaluator{prob: prob, settings: settings, ref: ref, x: make([]float64, dim)}
 		for task := range workerChan {
-			evaluate(prob, task.Location, task.Op, x)
-			statsChan <- task
+			calls, err := e.evaluate(task.Location, task.Op)
+			statsChan <- statsTask{Task: task, calls: calls, err: err}
 		}
 		// Signal successful worker completion.
-		statsChan <- Task{Op: signalDone}
+		statsChan <- statsTask{Task: Task{Op: signalDone}}
 	}
 	for i := 0; i < nTasks; i++ {
 		go worker()
@@ -332,14 +355,24 @@ func minimize(prob *Problem, method Method, settings *Settings, converger Conver
 
 	// Update optimization statistics and check convergence.
 	var methodDone bool
-	for task := range statsChan {
+	for st := range statsChan {
+		task := st.Task
+		stats.HessVecEvaluations = int(hessVecEvals.Load())
 		switch task.Op {
 		default:
 			if !task.Op.isEvaluation() {
 				panic("minimize: evaluation task expected")
 			}
-			updateEvaluationStats(stats, task.Op)
+			for range st.calls {
+				updateEvaluationStats(stats, task.Op)
+			}
+			if st.err != nil {
+				stats.FailedEvaluations++
+			}
 			status, err = checkEvaluationLimits(prob, stats, settings)
+			if st.err != nil && settings.FailedEvaluation == TerminateEvaluation && status == NotTerminated && err == nil {
+				status, err = Failure, st.err
+			}
 		case signalDone:
 			workersDone++
 			if workersDone == nTasks {
@@ -350,6 +383,9 @@ func minimize(prob *Problem, method Method, settings *Settings, converger Conver
 			// Just send the task back.
 		case MajorIteration:
 			status = performMajorIteration(optLoc, task.Location, stats, converger, startTime, settings)
+			if settings.FailedEvaluation == ShrinkEvaluation {
+				ref.set(optLoc.X)
+			}
 		case MethodDone:
 			methodDone = true
 			status = MethodConverge
@@ -382,6 +418,8 @@ func minimize(prob *Problem, method Method, settings *Settings, converger Conver
 			results <- task
 		}
 	}
+	stats.HessVecEvaluations = int(hessVecEvals.Load())
+
 	// This code block is here rather than above to ensure Status() is not called
 	// before Method.Run closes operations.
 	if methodDone {
@@ -397,6 +435,15 @@ func minimize(prob *Problem, method Method, settings *Settings, converger Conver
 	return finalStatus, finalError
 }
 
+// statsTask is a task sent to the stats combiner, holding the
+// number of calls made to the Problem to complete the evaluation
+// and the error describing a failed evaluation, if any.
+type statsTask struct {
+	Task
+	calls int
+	err   error
+}
+
 func defaultFunctionConverge() *FunctionConverge {
 	return &FunctionConverge{
 		Absolute:   1e-10,
@@ -479,28 +526,16 @@ func evaluate(p *Problem, loc *Location, op Operation, x []float64) {
 		panic(fmt.Sprintf("optimize: invalid evaluation %v", op))
 	}
 	copy(x, loc.X)
+	// Make sure we have destinations in which to place
+	// the gradient and Hessian.
+	ensureStorage(loc, op, len(x))
 	if op&FuncEvaluation != 0 {
 		loc.F = p.Func(x)
 	}
 	if op&GradEvaluation != 0 {
-		// Make sure we have a destination in which to place the gradient.
-		if len(loc.Gradient) == 0 {
-			if cap(loc.Gradient) < len(x) {
-				loc.Gradient = make([]float64, len(x))
-			} else {
-				loc.Gradient = loc.Gradient[:len(x)]
-			}
-		}
 		p.Grad(loc.Gradient, x)
 	}
 	if op&HessEvaluation != 0 {
-		// Make sure we have a destination in which to place the Hessian.
-		switch {
-		case loc.Hessian == nil:
-			loc.Hessian = mat.NewSymDense(len(x), nil)
-		case loc.Hessian.IsEmpty():
-			loc.Hessian.ReuseAsSym(len(x))
-		}
 		p.Hess(loc.Hessian, x)
 	}
 }
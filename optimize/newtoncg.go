@@ -0,0 +1,169 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+var (
+	_ Method          = (*NewtonCG)(nil)
+	_ localMethod     = (*NewtonCG)(nil)
+	_ NextDirectioner = (*NewtonCG)(nil)
+	_ hessVecUser     = (*NewtonCG)(nil)
+)
+
+// NewtonCG implements a truncated Newton method, also known as the
+// Newton-CG method, for unconstrained minimization using Hessian-vector
+// products. NewtonCG does not form or factorize the Hessian, and so is
+// suitable for large problems where the Hessian is unavailable or too
+// expensive to store, but its product with a vector can be computed
+// cheaply.
+//
+// At each iteration NewtonCG approximately solves the Newton equations
+//
+//	H_k d_k = -∇f_k
+//
+// by the conjugate gradient method, using Problem.HessVec to evaluate the
+// products with H_k. The conjugate gradient iteration is terminated when
+// the residual is smaller than min(0.5, sqrt(|∇f_k|)) * |∇f_k|, giving
+// superlinear convergence near a minimum, or when a direction of
+// non-positive curvature is encountered, ensuring that d_k is a descent
+// direction. The next location is found by a line search along d_k.
+//
+// NewtonCG is described in Algorithm 7.1 of Nocedal, Wright (2006), 2nd
+// edition.
+type NewtonCG struct {
+	// Linesearcher is used for selecting suitable steps along the descent
+	// direction d. Accepted steps should satisfy at least one of the Wolfe,
+	// Goldstein or Armijo conditions.
+	// If Linesearcher == nil, an appropriate default is chosen.
+	Linesearcher Linesearcher
+	// MaxCGIterations is the maximum number of conjugate gradient iterations
+	// and so Hessian-vector products in each major iteration. If
+	// MaxCGIterations is 0, it is defaulted to the problem dimension.
+	MaxCGIterations int
+	// GradStopThreshold sets the threshold for stopping if the gradient norm
+	// gets too small. If GradStopThreshold is 0 it is defaulted to 1e-12, and
+	// if it is NaN the setting is not used.
+	GradStopThreshold float64
+
+	status Status
+	err    error
+
+	ls *LinesearchMethod
+
+	hessVec func(hv, x, v []float64)
+
+	r, p, hp []float64 // Storage for the conjugate gradient iteration.
+}
+
+func (n *NewtonCG) Status() (Status, error) {
+	return n.status, n.err
+}
+
+func (*NewtonCG) Uses(has Available) (uses Available, err error) {
+	return has.hessVec()
+}
+
+func (n *NewtonCG) Init(dim, tasks int) int {
+	n.status = NotTerminated
+	n.err = nil
+	return 1
+}
+
+func (n *NewtonCG) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	n.status, n.err = localOptimizer{}.run(n, n.GradStopThreshold, operation, result, tasks)
+	close(operation)
+}
+
+func (n *NewtonCG) setHessVec(hessVec func(hv, x, v []float64)) {
+	n.hessVec = hessVec
+}
+
+func (n *NewtonCG) initLocal(loc *Location) (Operation, error) {
+	if n.MaxCGIterations < 0 {
+		panic("optimize: NewtonCG.MaxCGIterations is negative")
+	}
+	if n.hessVec == nil {
+		panic("optimize: NewtonCG has no Hessian-vector product")
+	}
+	if n.Linesearcher == nil {
+		n.Linesearcher = &MoreThuente{}
+	}
+	if n.ls == nil {
+		n.ls = &LinesearchMethod{}
+	}
+	n.ls.Linesearcher = n.Linesearcher
+	n.ls.NextDirectioner = n
+	return n.ls.Init(loc)
+}
+
+func (n *NewtonCG) iterateLocal(loc *Location) (Operation, error) {
+	return n.ls.Iterate(loc)
+}
+
+func (n *NewtonCG) InitDirection(loc *Location, dir []float64) (stepSize float64) {
+	dim := len(loc.X)
+	n.r = resize(n.r, dim)
+	n.p = resize(n.p, dim)
+	n.hp = resize(n.hp, dim)
+	return n.NextDirection(loc, dir)
+}
+
+func (n *NewtonCG) NextDirection(loc *Location, dir []float64) (stepSize float64) {
+	dim := len(loc.X)
+	maxIter := n.MaxCGIterations
+	if maxIter == 0 {
+		maxIter = dim
+	}
+
+	// Solve H d = -g starting from d = 0, so the residual H d + g is
+	// initially g.
+	for i := range dir {
+		dir[i] = 0
+	}
+	copy(n.r, loc.Gradient)
+	floats.ScaleTo(n.p, -1, n.r)
+	rr := floats.Dot(n.r, n.r)
+	gNorm := math.Sqrt(rr)
+	tol := math.Min(0.5, math.Sqrt(gNorm)) * gNorm
+	for k := 0; k < maxIter; k++ {
+		n.hessVec(n.hp, loc.X, n.p)
+		curv := floats.Dot(n.p, n.hp)
+		if curv <= 0 {
+			// H is not positive definite along p. Use the current
+			// direction, or the steepest descent direction if no
+			// progress has been made.
+			if k == 0 {
+				floats.ScaleTo(dir, -1, loc.Gradient)
+			}
+			break
+		}
+		alpha := rr / curv
+		floats.AddScaled(dir, alpha, n.p)
+		floats.AddScaled(n.r, alpha, n.hp)
+		rrNew := floats.Dot(n.r, n.r)
+		if math.Sqrt(rrNew) <= tol {
+			break
+		}
+		floats.Scale(rrNew/rr, n.p)
+		floats.Sub(n.p, n.r)
+		rr = rrNew
+	}
+	return 1
+}
+
+func (n *NewtonCG) needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return struct {
+		Gradient bool
+		Hessian  bool
+	}{true, false}
+}
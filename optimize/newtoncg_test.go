@@ -0,0 +1,92 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+// extendedRosenbrockHessVec computes the product of the tridiagonal Hessian
+// of the extended Rosenbrock function with v.
+func extendedRosenbrockHessVec(hv, x, v []float64) {
+	for i := range hv {
+		hv[i] = 0
+	}
+	for i := 0; i < len(x)-1; i++ {
+		hii := 2 + 1200*x[i]*x[i] - 400*x[i+1]
+		hij := -400 * x[i]
+		hv[i] += hii*v[i] + hij*v[i+1]
+		hv[i+1] += hij*v[i] + 200*v[i+1]
+	}
+}
+
+func TestNewtonCGLarge(t *testing.T) {
+	t.Parallel()
+	const dim = 1000
+	var calls int
+	p := Problem{
+		Func: functions.ExtendedRosenbrock{}.Func,
+		Grad: functions.ExtendedRosenbrock{}.Grad,
+		HessVec: func(hv, x, v []float64) {
+			calls++
+			extendedRosenbrockHessVec(hv, x, v)
+		},
+	}
+	x := make([]float64, dim)
+	for i := range x {
+		x[i] = 0.5
+	}
+	for _, method := range []Method{&NewtonCG{}, &NewtonCG{MaxCGIterations: 10}} {
+		calls = 0
+		settings := &Settings{GradientThreshold: 1e-8, Converger: NeverTerminate{}}
+		result, err := Minimize(p, x, settings, method)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if result.Status != GradientThreshold {
+			t.Errorf("unexpected status: got %v want %v", result.Status, GradientThreshold)
+		}
+		want := make([]float64, dim)
+		for i := range want {
+			want[i] = 1
+		}
+		if !floats.EqualApprox(result.X, want, 1e-8) {
+			t.Errorf("unexpected minimum location: max deviation %v", floats.Distance(result.X, want, math.Inf(1)))
+		}
+		if result.HessVecEvaluations != calls {
+			t.Errorf("unexpected number of HessVec evaluations: got %d want %d", result.HessVecEvaluations, calls)
+		}
+		if calls == 0 {
+			t.Error("HessVec was not called")
+		}
+		if result.HessEvaluations != 0 {
+			t.Errorf("unexpected Hess evaluations: %d", result.HessEvaluations)
+		}
+	}
+}
+
+func TestNewtonCGUses(t *testing.T) {
+	t.Parallel()
+	_, err := (&NewtonCG{}).Uses(Available{Grad: true, Hess: true})
+	if err != ErrMissingHessVec {
+		t.Errorf("unexpected error for missing HessVec: got %v want %v", err, ErrMissingHessVec)
+	}
+	_, err = (&NewtonCG{}).Uses(Available{HessVec: true})
+	if err != ErrMissingGrad {
+		t.Errorf("unexpected error for missing Grad: got %v want %v", err, ErrMissingGrad)
+	}
+	uses, err := (&NewtonCG{}).Uses(Available{Grad: true, Hess: true, HessVec: true})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if uses != (Available{Grad: true, HessVec: true}) {
+		t.Errorf("unexpected uses: %+v", uses)
+	}
+}
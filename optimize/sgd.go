@@ -0,0 +1,112 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+var (
+	_ Method      = (*SGD)(nil)
+	_ localMethod = (*SGD)(nil)
+)
+
+// SGD implements stochastic gradient descent with optional momentum. SGD is
+// intended for problems where the gradient is a noisy estimate, for example
+// computed on mini-batches as described by MiniBatch. At iteration t, SGD
+// updates the velocity v and the location x with the gradient estimate g as
+//
+//	v = Momentum v + g
+//	x = x - η_t v
+//
+// where η_t is the learning rate. With Nesterov momentum, the location is
+// instead updated with x = x - η_t (g + Momentum v).
+//
+// Each major iteration of SGD is a single step, after which the function and
+// the gradient are evaluated at the new location. Since the function values
+// are noisy, the default FunctionConverge converger may terminate the
+// optimization early; a NeverTerminate converger with a limit on the number
+// of major iterations is usually more appropriate. The gradient threshold of
+// the local methods is not checked.
+type SGD struct {
+	// LearningRate is the schedule of the learning rate.
+	// If LearningRate is nil, a constant rate of 0.01
+	// is used.
+	LearningRate LearningRate
+	// Momentum is the decay factor of the velocity and
+	// must be in [0, 1). If Momentum is 0, plain
+	// stochastic gradient descent is performed.
+	Momentum float64
+	// Nesterov specifies whether to use Nesterov momentum.
+	Nesterov bool
+
+	vel []float64
+
+	driver stochasticDriver
+	status Status
+	err    error
+}
+
+func (s *SGD) Status() (Status, error) {
+	return s.status, s.err
+}
+
+func (*SGD) Uses(has Available) (uses Available, err error) {
+	return has.gradient()
+}
+
+func (s *SGD) Init(dim, tasks int) int {
+	s.status = NotTerminated
+	s.err = nil
+	return 1
+}
+
+func (s *SGD) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	s.status, s.err = localOptimizer{}.run(s, math.NaN(), operation, result, tasks)
+	close(operation)
+}
+
+func (s *SGD) initLocal(loc *Location) (Operation, error) {
+	return s.driver.initLocal(s, loc)
+}
+
+func (s *SGD) iterateLocal(loc *Location) (Operation, error) {
+	return s.driver.iterateLocal(s, loc)
+}
+
+func (s *SGD) needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return s.driver.needs()
+}
+
+func (s *SGD) initStep(dim int) {
+	if s.Momentum < 0 || 1 <= s.Momentum {
+		panic("sgd: momentum out of range")
+	}
+	s.vel = resize(s.vel, dim)
+	for i := range s.vel {
+		s.vel[i] = 0
+	}
+}
+
+func (s *SGD) step(x, grad []float64, t int) {
+	rate := learningRate(s.LearningRate, 0.01, t)
+	if s.Momentum == 0 {
+		floats.AddScaled(x, -rate, grad)
+		return
+	}
+	floats.Scale(s.Momentum, s.vel)
+	floats.Add(s.vel, grad)
+	if s.Nesterov {
+		floats.AddScaled(x, -rate, grad)
+		floats.AddScaled(x, -rate*s.Momentum, s.vel)
+		return
+	}
+	floats.AddScaled(x, -rate, s.vel)
+}
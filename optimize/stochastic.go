@@ -0,0 +1,221 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+var (
+	_ LearningRate = ConstantRate{}
+	_ LearningRate = InverseTimeDecay{}
+	_ LearningRate = ExponentialDecay{}
+	_ LearningRate = StepDecay{}
+)
+
+// MiniBatch describes an objective function that is the mean of N terms,
+//
+//	f(x) = 1/N Σ_i f_i(x),
+//
+// for example the loss of a model over N data points. A MiniBatch is turned
+// into a Problem whose function and gradient are noisy estimates computed on
+// random subsets of the terms, for use with the stochastic gradient methods
+// SGD, Adam and AdaGrad.
+type MiniBatch struct {
+	// N is the number of terms of the objective.
+	N int
+
+	// Size is the number of terms in each mini-batch.
+	// Size must be between 1 and N.
+	Size int
+
+	// Func returns the mean of the terms with indices
+	// in batch at x. Func must not modify x or batch.
+	Func func(x []float64, batch []int) float64
+
+	// Grad stores the gradient of the mean of the terms
+	// with indices in batch at x in grad. Grad must not
+	// modify x or batch.
+	Grad func(grad, x []float64, batch []int)
+
+	// Src is the source of randomness used to draw the
+	// mini-batches. If Src is nil, the global source
+	// is used.
+	Src rand.Source
+}
+
+// Problem returns a Problem that evaluates the objective and its gradient on
+// mini-batches. The mini-batches are drawn without replacement from a random
+// permutation of the terms, which is renewed when fewer than Size terms
+// remain. Each call to the Func field of the returned Problem draws a new
+// mini-batch, and the Grad field evaluates on the mini-batch of the most
+// recent call to Func, or draws one if Func has not been called. This way a
+// function value and gradient requested in the same evaluation are
+// consistent.
+//
+// Problem panics if N is not positive or Size is not between 1 and N. The
+// returned Problem must not be evaluated concurrently.
+func (mb *MiniBatch) Problem() Problem {
+	if mb.N <= 0 {
+		panic("optimize: non-positive number of terms")
+	}
+	if mb.Size <= 0 || mb.N < mb.Size {
+		panic("optimize: invalid mini-batch size")
+	}
+	shuffle := rand.Shuffle
+	if mb.Src != nil {
+		shuffle = rand.New(mb.Src).Shuffle
+	}
+	perm := make([]int, mb.N)
+	for i := range perm {
+		perm[i] = i
+	}
+	pos := mb.N
+	var batch []int
+	next := func() {
+		if pos+mb.Size > mb.N {
+			shuffle(len(perm), func(i, j int) { perm[i], perm[j] = perm[j], perm[i] })
+			pos = 0
+		}
+		batch = append(batch[:0], perm[pos:pos+mb.Size]...)
+		pos += mb.Size
+	}
+
+	var p Problem
+	if mb.Func != nil {
+		p.Func = func(x []float64) float64 {
+			next()
+			return mb.Func(x, batch)
+		}
+	}
+	if mb.Grad != nil {
+		p.Grad = func(grad, x []float64) {
+			if batch == nil {
+				next()
+			}
+			mb.Grad(grad, x, batch)
+		}
+	}
+	return p
+}
+
+// LearningRate is a schedule of the learning rate of a stochastic gradient
+// method.
+type LearningRate interface {
+	// Rate returns the learning rate at iteration t,
+	// starting from zero. Rate must be positive.
+	Rate(t int) float64
+}
+
+// ConstantRate is a LearningRate that returns the same rate at every
+// iteration.
+type ConstantRate struct {
+	Rate0 float64
+}
+
+func (c ConstantRate) Rate(int) float64 {
+	return c.Rate0
+}
+
+// InverseTimeDecay is a LearningRate that decays as
+//
+//	Rate0 / (1 + Decay t).
+type InverseTimeDecay struct {
+	Rate0 float64
+	Decay float64
+}
+
+func (d InverseTimeDecay) Rate(t int) float64 {
+	return d.Rate0 / (1 + d.Decay*float64(t))
+}
+
+// ExponentialDecay is a LearningRate that decays as
+//
+//	Rate0 Factorᵗ,
+//
+// where Factor is in (0, 1].
+type ExponentialDecay struct {
+	Rate0  float64
+	Factor float64
+}
+
+func (d ExponentialDecay) Rate(t int) float64 {
+	return d.Rate0 * math.Pow(d.Factor, float64(t))
+}
+
+// StepDecay is a LearningRate that multiplies the rate by Factor every Steps
+// iterations,
+//
+//	Rate0 Factor^⌊t / Steps⌋.
+type StepDecay struct {
+	Rate0  float64
+	Factor float64
+	Steps  int
+}
+
+func (d StepDecay) Rate(t int) float64 {
+	return d.Rate0 * math.Pow(d.Factor, float64(t/d.Steps))
+}
+
+// stochasticStepper computes the steps of a stochastic gradient method.
+type stochasticStepper interface {
+	// initStep initializes the stepper for
+	// a problem of dimension dim.
+	initStep(dim int)
+	// step moves x in place using the gradient
+	// estimate grad at iteration t.
+	step(x, grad []float64, t int)
+}
+
+// stochasticDriver performs the reverse communication of a stochastic
+// gradient method. Each major iteration of the method takes a step from the
+// gradient estimate at the current location and evaluates the function and
+// the gradient at the new location.
+type stochasticDriver struct {
+	iter   int
+	lastOp Operation
+}
+
+func (s *stochasticDriver) initLocal(stepper stochasticStepper, loc *Location) (Operation, error) {
+	stepper.initStep(len(loc.X))
+	s.iter = 0
+	return s.next(stepper, loc), nil
+}
+
+func (s *stochasticDriver) iterateLocal(stepper stochasticStepper, loc *Location) (Operation, error) {
+	if s.lastOp == MajorIteration {
+		return s.next(stepper, loc), nil
+	}
+	// The evaluation at the new location is complete.
+	s.lastOp = MajorIteration
+	return s.lastOp, nil
+}
+
+func (s *stochasticDriver) next(stepper stochasticStepper, loc *Location) Operation {
+	stepper.step(loc.X, loc.Gradient, s.iter)
+	s.iter++
+	s.lastOp = FuncEvaluation | GradEvaluation
+	return s.lastOp
+}
+
+func (*stochasticDriver) needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return struct {
+		Gradient bool
+		Hessian  bool
+	}{true, false}
+}
+
+// learningRate returns the rate of the schedule at iteration t, or def if the
+// schedule is nil.
+func learningRate(lr LearningRate, def float64, t int) float64 {
+	if lr == nil {
+		return def
+	}
+	return lr.Rate(t)
+}
@@ -0,0 +1,64 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize_test
+
+import (
+	"fmt"
+	"log"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/optimize"
+)
+
+func ExampleMiniBatch() {
+	// Fit the line y = a + b t to noisy data by minimizing the mean
+	// squared error with Adam on mini-batches of 20 points.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 1000
+	t := make([]float64, n)
+	y := make([]float64, n)
+	for i := range t {
+		t[i] = rnd.Float64()
+		y[i] = 2 + 3*t[i] + 0.1*rnd.NormFloat64()
+	}
+	mb := &optimize.MiniBatch{
+		N:    n,
+		Size: 20,
+		Func: func(x []float64, batch []int) float64 {
+			var f float64
+			for _, i := range batch {
+				r := x[0] + x[1]*t[i] - y[i]
+				f += r * r
+			}
+			return f / float64(len(batch))
+		},
+		Grad: func(grad, x []float64, batch []int) {
+			grad[0], grad[1] = 0, 0
+			for _, i := range batch {
+				r := x[0] + x[1]*t[i] - y[i]
+				grad[0] += 2 * r / float64(len(batch))
+				grad[1] += 2 * r * t[i] / float64(len(batch))
+			}
+		},
+		Src: rand.NewPCG(2, 2),
+	}
+	settings := &optimize.Settings{
+		Converger:       optimize.NeverTerminate{},
+		MajorIterations: 5000,
+	}
+	method := &optimize.Adam{
+		LearningRate: optimize.StepDecay{Rate0: 0.1, Factor: 0.3, Steps: 1000},
+	}
+	result, err := optimize.Minimize(mb.Problem(), []float64{0, 0}, settings, method)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("status: %v\n", result.Status)
+	fmt.Printf("a = %.2f, b = %.2f\n", result.X[0], result.X[1])
+
+	// Output:
+	// status: IterationLimit
+	// a = 2.01, b = 2.99
+}
@@ -0,0 +1,226 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+// leastSquares returns a mini-batch least squares problem with n data points
+// and the exact minimizer of the mean of the squared residuals.
+func leastSquares(n int, src rand.Source) (*MiniBatch, []float64) {
+	rnd := rand.New(src)
+	want := []float64{1.5, -2, 0.5}
+	a := mat.NewDense(n, len(want), nil)
+	b := make([]float64, n)
+	for i := 0; i < n; i++ {
+		row := a.RawRowView(i)
+		for j := range row {
+			row[j] = rnd.NormFloat64()
+		}
+		b[i] = floats.Dot(row, want) + 0.1*rnd.NormFloat64()
+	}
+	var x mat.VecDense
+	err := x.SolveVec(a, mat.NewVecDense(n, b))
+	if err != nil {
+		panic(err)
+	}
+	mb := &MiniBatch{
+		N:    n,
+		Size: 10,
+		Func: func(x []float64, batch []int) float64 {
+			var f float64
+			for _, i := range batch {
+				r := floats.Dot(a.RawRowView(i), x) - b[i]
+				f += r * r
+			}
+			return f / float64(len(batch))
+		},
+		Grad: func(grad, x []float64, batch []int) {
+			for i := range grad {
+				grad[i] = 0
+			}
+			for _, i := range batch {
+				row := a.RawRowView(i)
+				r := floats.Dot(row, x) - b[i]
+				floats.AddScaled(grad, 2*r/float64(len(batch)), row)
+			}
+		},
+		Src: src,
+	}
+	return mb, x.RawVector().Data
+}
+
+func TestStochasticMethods(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		method Method
+		iters  int
+		tol    float64
+	}{
+		{
+			name:   "SGD",
+			method: &SGD{LearningRate: InverseTimeDecay{Rate0: 0.05, Decay: 0.01}},
+			iters:  5000,
+			tol:    0.02,
+		},
+		{
+			name:   "SGD momentum",
+			method: &SGD{LearningRate: InverseTimeDecay{Rate0: 0.01, Decay: 0.01}, Momentum: 0.9},
+			iters:  5000,
+			tol:    0.02,
+		},
+		{
+			name:   "SGD Nesterov",
+			method: &SGD{LearningRate: InverseTimeDecay{Rate0: 0.01, Decay: 0.01}, Momentum: 0.9, Nesterov: true},
+			iters:  5000,
+			tol:    0.02,
+		},
+		{
+			name:   "Adam",
+			method: &Adam{LearningRate: StepDecay{Rate0: 0.05, Factor: 0.5, Steps: 1000}},
+			iters:  5000,
+			tol:    0.02,
+		},
+		{
+			name:   "AdaGrad",
+			method: &AdaGrad{LearningRate: ConstantRate{Rate0: 0.5}},
+			iters:  5000,
+			tol:    0.02,
+		},
+	} {
+		mb, want := leastSquares(1000, rand.NewPCG(1, 1))
+		settings := &Settings{
+			Converger:       NeverTerminate{},
+			MajorIterations: test.iters,
+		}
+		result, err := Minimize(mb.Problem(), make([]float64, 3), settings, test.method)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if result.Status != IterationLimit {
+			t.Errorf("%s: unexpected status: got %v, want %v", test.name, result.Status, IterationLimit)
+		}
+		if result.MajorIterations != test.iters {
+			t.Errorf("%s: unexpected number of major iterations: got %d, want %d", test.name, result.MajorIterations, test.iters)
+		}
+		if !floats.EqualApprox(result.X, want, test.tol) {
+			t.Errorf("%s: minimizer mismatch: got %v, want %v", test.name, result.X, want)
+		}
+	}
+}
+
+func TestSGDDeterministic(t *testing.T) {
+	// With exact gradients and no momentum, SGD with a constant
+	// rate is gradient descent with a fixed step, so on
+	//  f(x) = ½ Σ_i c_i x_i²
+	// each coordinate contracts by 1 - η c_i per iteration.
+	c := []float64{1, 4}
+	p := Problem{
+		Func: func(x []float64) float64 {
+			return 0.5 * (c[0]*x[0]*x[0] + c[1]*x[1]*x[1])
+		},
+		Grad: func(grad, x []float64) {
+			grad[0] = c[0] * x[0]
+			grad[1] = c[1] * x[1]
+		},
+	}
+	const (
+		rate  = 0.1
+		iters = 20
+	)
+	settings := &Settings{
+		Converger:       NeverTerminate{},
+		MajorIterations: iters,
+	}
+	result, err := Minimize(p, []float64{1, 1}, settings, &SGD{LearningRate: ConstantRate{Rate0: rate}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The starting location is the first major iteration.
+	for i, ci := range c {
+		want := math.Pow(1-rate*ci, iters-1)
+		if !scalar.EqualWithinAbsOrRel(result.X[i], want, 1e-14, 1e-14) {
+			t.Errorf("unexpected location at %d: got %v, want %v", i, result.X[i], want)
+		}
+	}
+	if result.FuncEvaluations != iters || result.GradEvaluations != iters {
+		t.Errorf("unexpected number of evaluations: got %d and %d, want %d", result.FuncEvaluations, result.GradEvaluations, iters)
+	}
+}
+
+func TestMiniBatch(t *testing.T) {
+	const (
+		n    = 10
+		size = 3
+	)
+	var batches [][]int
+	mb := &MiniBatch{
+		N:    n,
+		Size: size,
+		Func: func(x []float64, batch []int) float64 {
+			batches = append(batches, append([]int(nil), batch...))
+			return 0
+		},
+		Grad: func(grad, x []float64, batch []int) {
+			last := batches[len(batches)-1]
+			for i, v := range batch {
+				if last[i] != v {
+					t.Errorf("gradient mini-batch differs from function mini-batch: %v != %v", batch, last)
+					break
+				}
+			}
+		},
+		Src: rand.NewPCG(1, 1),
+	}
+	p := mb.Problem()
+	const epochs = 4
+	for i := 0; i < epochs*(n/size); i++ {
+		p.Func(nil)
+		p.Grad(nil, nil)
+	}
+	// Each epoch uses distinct terms.
+	for e := 0; e < epochs; e++ {
+		var seen []int
+		for _, b := range batches[e*(n/size) : (e+1)*(n/size)] {
+			if len(b) != size {
+				t.Fatalf("unexpected mini-batch size: got %d, want %d", len(b), size)
+			}
+			seen = append(seen, b...)
+		}
+		sort.Ints(seen)
+		for i := 1; i < len(seen); i++ {
+			if seen[i] == seen[i-1] {
+				t.Errorf("term %d repeated in epoch %d", seen[i], e)
+			}
+		}
+	}
+}
+
+func TestLearningRate(t *testing.T) {
+	for _, test := range []struct {
+		lr   LearningRate
+		t    int
+		want float64
+	}{
+		{lr: ConstantRate{Rate0: 0.1}, t: 100, want: 0.1},
+		{lr: InverseTimeDecay{Rate0: 1, Decay: 0.5}, t: 4, want: 1. / 3},
+		{lr: ExponentialDecay{Rate0: 2, Factor: 0.5}, t: 3, want: 0.25},
+		{lr: StepDecay{Rate0: 1, Factor: 0.1, Steps: 10}, t: 9, want: 1},
+		{lr: StepDecay{Rate0: 1, Factor: 0.1, Steps: 10}, t: 25, want: 0.01},
+	} {
+		if got := test.lr.Rate(test.t); !scalar.EqualWithinAbsOrRel(got, test.want, 1e-15, 1e-15) {
+			t.Errorf("unexpected rate of %#v at %d: got %v, want %v", test.lr, test.t, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,344 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"errors"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Transform is an affine change of variables
+//
+//	x = M*z + Offset
+//
+// between the variables x of a Problem and the variables z in which a
+// Method performs the optimization. Choosing the transform so that the
+// variables z are of similar magnitude and the Hessian with respect to z is
+// well conditioned can greatly improve the convergence of badly scaled
+// problems.
+//
+// When Settings.Transform is set, Minimize minimizes f(M*z + Offset) with
+// respect to z. The gradient and Hessian of the transformed problem are
+//
+//	∇_z = Mᵀ ∇_x,
+//	H_z = Mᵀ H_x M,
+//
+// and are formed from the functions of the Problem. All Locations passed to
+// the Recorder and the Converger, and the Location of the Result, are in
+// the original variables x.
+type Transform struct {
+	// M is the square matrix of the transform, which must be invertible.
+	// If M is nil, the identity is used.
+	M mat.Matrix
+
+	// Offset is the offset of the transform. If Offset is nil,
+	// a zero offset is used.
+	Offset []float64
+}
+
+// NewScaling returns a diagonal Transform that scales the variables by
+//
+//	x_i = scale[i] * z_i.
+//
+// A good choice for scale[i] is the typical magnitude of x_i, so that the
+// transformed variables are all of order one.
+func NewScaling(scale []float64) *Transform {
+	for _, v := range scale {
+		if v == 0 {
+			panic("optimize: zero scale")
+		}
+	}
+	return &Transform{M: mat.NewDiagDense(len(scale), append([]float64(nil), scale...))}
+}
+
+// affine is a validated Transform that maps between the original and the
+// transformed variables.
+type affine struct {
+	dim    int
+	m      mat.Matrix
+	minv   *mat.Dense // Inverse of m, nil if m is diagonal.
+	diag   []float64  // Diagonal of m, nil if m is not diagonal.
+	offset []float64
+}
+
+func newAffine(t *Transform, dim int) *affine {
+	a := &affine{
+		dim:    dim,
+		offset: t.Offset,
+	}
+	if a.offset == nil {
+		a.offset = make([]float64, dim)
+	}
+	if len(a.offset) != dim {
+		panic("optimize: transform offset does not match problem dimension")
+	}
+	m := t.M
+	if m == nil {
+		m = mat.NewDiagDense(dim, nil)
+		for i := range dim {
+			m.(*mat.DiagDense).SetDiag(i, 1)
+		}
+	}
+	if r, c := m.Dims(); r != dim || c != dim {
+		panic("optimize: transform matrix does not match problem dimension")
+	}
+	a.m = m
+	if d, ok := m.(mat.Diagonal); ok {
+		a.diag = make([]float64, dim)
+		for i := range a.diag {
+			a.diag[i] = d.At(i, i)
+			if a.diag[i] == 0 {
+				panic("optimize: singular transform")
+			}
+		}
+		return a
+	}
+	a.minv = &mat.Dense{}
+	if err := a.minv.Inverse(m); err != nil {
+		panic("optimize: singular transform")
+	}
+	return a
+}
+
+// toX stores M*z + Offset into x.
+func (a *affine) toX(x, z []float64) {
+	if a.diag != nil {
+		floats.MulTo(x, a.diag, z)
+	} else {
+		mat.NewVecDense(a.dim, x).MulVec(a.m, mat.NewVecDense(a.dim, z))
+	}
+	floats.Add(x, a.offset)
+}
+
+// toZ stores M⁻¹*(x - Offset) into z.
+func (a *affine) toZ(z, x []float64) {
+	d := make([]float64, a.dim)
+	floats.SubTo(d, x, a.offset)
+	if a.diag != nil {
+		floats.DivTo(z, d, a.diag)
+		return
+	}
+	mat.NewVecDense(a.dim, z).MulVec(a.minv, mat.NewVecDense(a.dim, d))
+}
+
+// gradToZ stores Mᵀ*gx into gz.
+func (a *affine) gradToZ(gz, gx []float64) {
+	if a.diag != nil {
+		floats.MulTo(gz, a.diag, gx)
+		return
+	}
+	mat.NewVecDense(a.dim, gz).MulVec(a.m.T(), mat.NewVecDense(a.dim, gx))
+}
+
+// gradToX stores M⁻ᵀ*gz into gx.
+func (a *affine) gradToX(gx, gz []float64) {
+	if a.diag != nil {
+		floats.DivTo(gx, gz, a.diag)
+		return
+	}
+	mat.NewVecDense(a.dim, gx).MulVec(a.minv.T(), mat.NewVecDense(a.dim, gz))
+}
+
+// hessToZ stores Mᵀ*hx*M into hz.
+func (a *affine) hessToZ(hz *mat.SymDense, hx mat.Symmetric) {
+	a.congruence(hz, hx, a.m, a.diag, false)
+}
+
+// hessToX stores M⁻ᵀ*hz*M⁻¹ into hx.
+func (a *affine) hessToX(hx *mat.SymDense, hz mat.Symmetric) {
+	a.congruence(hx, hz, a.minv, a.diag, true)
+}
+
+// congruence stores wᵀ*h*w into dst, where w is the diagonal matrix with
+// elements diag, or their reciprocals if inv is true, when diag is not nil.
+func (a *affine) congruence(dst *mat.SymDense, h mat.Symmetric, w mat.Matrix, diag []float64, inv bool) {
+	if dst.IsEmpty() {
+		dst.ReuseAsSym(a.dim)
+	}
+	if diag != nil {
+		for i := range a.dim {
+			for j := i; j < a.dim; j++ {
+				if inv {
+					dst.SetSym(i, j, h.At(i, j)/(diag[i]*diag[j]))
+				} else {
+					dst.SetSym(i, j, diag[i]*h.At(i, j)*diag[j])
+				}
+			}
+		}
+		return
+	}
+	var hw, whw mat.Dense
+	hw.Mul(h, w)
+	whw.Mul(w.T(), &hw)
+	for i := range a.dim {
+		for j := i; j < a.dim; j++ {
+			dst.SetSym(i, j, 0.5*(whw.At(i, j)+whw.At(j, i)))
+		}
+	}
+}
+
+// problem returns the Problem in the transformed variables.
+func (a *affine) problem(p Problem) Problem {
+	// Each function has its own workspace since the functions
+	// may be called concurrently.
+	var pz Problem
+	pz.Func = func(z []float64) float64 {
+		x := make([]float64, a.dim)
+		a.toX(x, z)
+		return p.Func(x)
+	}
+	if p.Grad != nil {
+		pz.Grad = func(grad, z []float64) {
+			x := make([]float64, a.dim)
+			a.toX(x, z)
+			gx := make([]float64, a.dim)
+			p.Grad(gx, x)
+			a.gradToZ(grad, gx)
+		}
+	}
+	if p.Hess != nil {
+		pz.Hess = func(hess *mat.SymDense, z []float64) {
+			x := make([]float64, a.dim)
+			a.toX(x, z)
+			hx := mat.NewSymDense(a.dim, nil)
+			p.Hess(hx, x)
+			a.hessToZ(hess, hx)
+		}
+	}
+	if p.HessVec != nil {
+		pz.HessVec = func(hv, z, v []float64) {
+			x := make([]float64, a.dim)
+			a.toX(x, z)
+			mv := make([]float64, a.dim)
+			if a.diag != nil {
+				floats.MulTo(mv, a.diag, v)
+			} else {
+				mat.NewVecDense(a.dim, mv).MulVec(a.m, mat.NewVecDense(a.dim, v))
+			}
+			hmv := make([]float64, a.dim)
+			p.HessVec(hmv, x, mv)
+			a.gradToZ(hv, hmv)
+		}
+	}
+	pz.Status = p.Status
+	return pz
+}
+
+// locationToX stores the location z in the transformed variables into x.
+func (a *affine) locationToX(x, z *Location) {
+	x.X = resize(x.X, a.dim)
+	a.toX(x.X, z.X)
+	x.F = z.F
+	if z.Gradient == nil {
+		x.Gradient = nil
+	} else {
+		x.Gradient = resize(x.Gradient, a.dim)
+		a.gradToX(x.Gradient, z.Gradient)
+	}
+	if z.Hessian == nil {
+		x.Hessian = nil
+	} else {
+		if x.Hessian == nil {
+			x.Hessian = &mat.SymDense{}
+		}
+		a.hessToX(x.Hessian, z.Hessian)
+	}
+}
+
+// transformedRecorder passes Locations in the original variables to a
+// Recorder.
+type transformedRecorder struct {
+	a   *affine
+	r   Recorder
+	loc Location
+}
+
+func (t *transformedRecorder) Init() error {
+	return t.r.Init()
+}
+
+func (t *transformedRecorder) Record(loc *Location, op Operation, stats *Stats) error {
+	t.a.locationToX(&t.loc, loc)
+	return t.r.Record(&t.loc, op, stats)
+}
+
+// transformedConverger passes Locations in the original variables to a
+// Converger.
+type transformedConverger struct {
+	a   *affine
+	c   Converger
+	loc Location
+}
+
+func (t *transformedConverger) Init(dim int) {
+	t.c.Init(dim)
+}
+
+func (t *transformedConverger) Converged(loc *Location) Status {
+	t.a.locationToX(&t.loc, loc)
+	return t.c.Converged(&t.loc)
+}
+
+// minimizeTransformed performs Minimize with the change of variables in
+// settings.Transform.
+func minimizeTransformed(p Problem, initX []float64, settings *Settings, method Method) (*Result, error) {
+	if p.Func == nil {
+		panic(badProblem)
+	}
+	dim := len(initX)
+	if dim <= 0 {
+		panic("optimize: impossible problem dimension")
+	}
+	a := newAffine(settings.Transform, dim)
+
+	s := *settings
+	s.Transform = nil
+	if settings.InitValues != nil {
+		init := *settings.InitValues
+		if init.Gradient != nil {
+			if len(init.Gradient) != dim {
+				panic("optimize: initial gradient does not match problem dimension")
+			}
+			init.Gradient = make([]float64, dim)
+			a.gradToZ(init.Gradient, settings.InitValues.Gradient)
+		}
+		if init.Hessian != nil {
+			if init.Hessian.SymmetricDim() != dim {
+				panic("optimize: initial Hessian does not match problem dimension")
+			}
+			init.Hessian = &mat.SymDense{}
+			a.hessToZ(init.Hessian, settings.InitValues.Hessian)
+		}
+		s.InitValues = &init
+	}
+	if s.Recorder != nil {
+		s.Recorder = &transformedRecorder{a: a, r: s.Recorder}
+	}
+	if s.Converger == nil {
+		s.Converger = defaultFunctionConverge()
+	}
+	s.Converger = &transformedConverger{a: a, c: s.Converger}
+
+	z := make([]float64, dim)
+	a.toZ(z, initX)
+	if method == nil {
+		method = getDefaultMethod(&p)
+	}
+	result, err := Minimize(a.problem(p), z, &s, method)
+	var evalErr *EvaluationError
+	if errors.As(err, &evalErr) {
+		x := make([]float64, dim)
+		a.toX(x, evalErr.X)
+		evalErr.X = x
+	}
+	if result != nil {
+		var loc Location
+		a.locationToX(&loc, &result.Location)
+		result.Location = loc
+	}
+	return result, err
+}
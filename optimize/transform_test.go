@@ -0,0 +1,214 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+func TestTransformBadlyScaled(t *testing.T) {
+	t.Parallel()
+	// A quadratic whose Hessian has condition number 1e8, so that
+	// gradient descent makes almost no progress along the first
+	// variable.
+	scale := []float64{1e4, 1}
+	c := []float64{2e4, 3}
+	p := Problem{
+		Func: func(x []float64) float64 {
+			var f float64
+			for i, v := range x {
+				d := (v - c[i]) / scale[i]
+				f += d * d
+			}
+			return f
+		},
+		Grad: func(grad, x []float64) {
+			for i, v := range x {
+				grad[i] = 2 * (v - c[i]) / (scale[i] * scale[i])
+			}
+		},
+	}
+	x0 := []float64{0, 0}
+
+	settings := &Settings{
+		MajorIterations:   100,
+		GradientThreshold: 1e-10,
+		Converger:         NeverTerminate{},
+	}
+	result, err := Minimize(p, x0, settings, &GradientDescent{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Status != IterationLimit {
+		t.Errorf("unexpected convergence without scaling: status %v", result.Status)
+	}
+
+	settings.Transform = NewScaling(scale)
+	result, err = Minimize(p, x0, settings, &GradientDescent{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Status != GradientThreshold {
+		t.Errorf("unexpected status with scaling: got %v want %v", result.Status, GradientThreshold)
+	}
+	if !floats.EqualApprox(result.X, c, 1e-8) {
+		t.Errorf("unexpected minimum location: got %v want %v", result.X, c)
+	}
+}
+
+// locationRecorder checks that the recorded locations are consistent with
+// the problem in its original variables.
+type locationRecorder struct {
+	t     *testing.T
+	p     Problem
+	count int
+}
+
+func (*locationRecorder) Init() error { return nil }
+
+func (r *locationRecorder) Record(loc *Location, op Operation, _ *Stats) error {
+	if op != MajorIteration {
+		return nil
+	}
+	r.count++
+	if f := r.p.Func(loc.X); f != loc.F {
+		r.t.Errorf("recorded function value %v does not match value %v at recorded location", loc.F, f)
+	}
+	if loc.Gradient != nil {
+		g := make([]float64, len(loc.X))
+		r.p.Grad(g, loc.X)
+		if !floats.EqualApprox(g, loc.Gradient, 1e-8*math.Max(1, floats.Norm(g, math.Inf(1)))) {
+			r.t.Errorf("recorded gradient %v does not match gradient %v at recorded location", loc.Gradient, g)
+		}
+	}
+	return nil
+}
+
+func TestTransform(t *testing.T) {
+	t.Parallel()
+	wood := functions.Wood{}
+	p := Problem{
+		Func:    wood.Func,
+		Grad:    wood.Grad,
+		Hess:    wood.Hess,
+		HessVec: hessVecFromHess(wood.Hess),
+	}
+	x0 := []float64{-3, -1, -3, -1}
+	want := []float64{1, 1, 1, 1}
+	for _, transform := range []*Transform{
+		{},
+		{Offset: []float64{1, 2, 3, 4}},
+		NewScaling([]float64{2, -0.5, 3, 1}),
+		{
+			M: mat.NewDense(4, 4, []float64{
+				2, 0.5, 0, 0,
+				0, 1, 0.25, 0,
+				0, 0, 1.5, 0,
+				0.1, 0, 0, 1,
+			}),
+			Offset: []float64{0.5, -1, 0, 2},
+		},
+	} {
+		for _, method := range []Method{&BFGS{}, &LBFGS{}, &Newton{}, &NewtonCG{}, &LSR1{}} {
+			rec := &locationRecorder{t: t, p: p}
+			settings := &Settings{
+				GradientThreshold: 1e-10,
+				Converger:         NeverTerminate{},
+				Recorder:          rec,
+				Transform:         transform,
+			}
+			result, err := Minimize(p, x0, settings, method)
+			if err != nil {
+				t.Errorf("%T: unexpected error: %v", method, err)
+				continue
+			}
+			if rec.count == 0 {
+				t.Errorf("%T: no locations recorded", method)
+			}
+			if !floats.EqualApprox(result.X, want, 1e-8) {
+				t.Errorf("%T: unexpected minimum location: got %v want %v", method, result.X, want)
+			}
+			if f := p.Func(result.X); f != result.F {
+				t.Errorf("%T: function value %v does not match value %v at minimum location", method, result.F, f)
+			}
+			g := make([]float64, len(result.X))
+			p.Grad(g, result.X)
+			if !floats.EqualApprox(g, result.Gradient, 1e-10) {
+				t.Errorf("%T: returned gradient %v does not match gradient %v at minimum location", method, result.Gradient, g)
+			}
+
+			// Providing the initial values in the original variables
+			// saves one evaluation of each.
+			settings.Recorder = nil
+			settings.InitValues = &Location{F: p.Func(x0), Gradient: make([]float64, len(x0))}
+			p.Grad(settings.InitValues.Gradient, x0)
+			uses, _ := method.Uses(availFromProblem(p))
+			if uses.Hess {
+				settings.InitValues.Hessian = mat.NewSymDense(len(x0), nil)
+				p.Hess(settings.InitValues.Hessian, x0)
+			}
+			result2, err := Minimize(p, x0, settings, method)
+			if err != nil {
+				t.Errorf("%T: unexpected error with initial values: %v", method, err)
+				continue
+			}
+			if !floats.EqualApprox(result.X, result2.X, 1e-12) {
+				t.Errorf("%T: different minimum with initial values", method)
+			}
+			if result.FuncEvaluations != result2.FuncEvaluations+1 {
+				t.Errorf("%T: initial values do not reduce the number of Func calls", method)
+			}
+			if uses.Hess && result.HessEvaluations != result2.HessEvaluations+1 {
+				t.Errorf("%T: initial values do not reduce the number of Hess calls", method)
+			}
+		}
+	}
+}
+
+func TestTransformPanics(t *testing.T) {
+	t.Parallel()
+	p := Problem{Func: functions.ExtendedRosenbrock{}.Func}
+	x0 := []float64{1, 2}
+	for _, test := range []struct {
+		name      string
+		transform func() *Transform
+	}{
+		{
+			name:      "zero scale",
+			transform: func() *Transform { return NewScaling([]float64{1, 0}) },
+		},
+		{
+			name:      "singular matrix",
+			transform: func() *Transform { return &Transform{M: mat.NewDense(2, 2, []float64{1, 2, 2, 4})} },
+		},
+		{
+			name:      "matrix dimension mismatch",
+			transform: func() *Transform { return NewScaling([]float64{1, 2, 3}) },
+		},
+		{
+			name:      "offset dimension mismatch",
+			transform: func() *Transform { return &Transform{Offset: []float64{1}} },
+		},
+	} {
+		if !panics(func() {
+			Minimize(p, x0, &Settings{Transform: test.transform()}, &NelderMead{})
+		}) {
+			t.Errorf("%s: expected panic", test.name)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
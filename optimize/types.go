@@ -95,11 +95,13 @@ type Result struct {
 
 // Stats contains the statistics of the run.
 type Stats struct {
-	MajorIterations int           // Total number of major iterations
-	FuncEvaluations int           // Number of evaluations of Func
-	GradEvaluations int           // Number of evaluations of Grad
-	HessEvaluations int           // Number of evaluations of Hess
-	Runtime         time.Duration // Total runtime of the optimization
+	MajorIterations    int           // Total number of major iterations
+	FuncEvaluations    int           // Number of evaluations of Func
+	GradEvaluations    int           // Number of evaluations of Grad
+	HessEvaluations    int           // Number of evaluations of Hess
+	HessVecEvaluations int           // Number of evaluations of HessVec
+	FailedEvaluations  int           // Number of failed evaluations
+	Runtime            time.Duration // Total runtime of the optimization
 }
 
 // complementEval returns an evaluating operation that evaluates fields of loc
@@ -131,6 +133,12 @@ type Problem struct {
 	// will have dimensions matching the length of x. Hess must not modify x.
 	Hess func(hess *mat.SymDense, x []float64)
 
+	// HessVec evaluates the product of the Hessian at x with the vector v
+	// and stores the result in hv which will be the same length as x.
+	// HessVec must not modify x or v. HessVec allows Methods to use
+	// second-order information without forming the Hessian.
+	HessVec func(hv, x, v []float64)
+
 	// Status reports the status of the objective function being optimized and any
 	// error. This can be used to terminate early, for example when the function is
 	// not able to evaluate itself. The user can use one of the pre-provided Status
@@ -140,12 +148,13 @@ type Problem struct {
 
 // Available describes the functions available to call in Problem.
 type Available struct {
-	Grad bool
-	Hess bool
+	Grad    bool
+	Hess    bool
+	HessVec bool
 }
 
 func availFromProblem(prob Problem) Available {
-	return Available{Grad: prob.Grad != nil, Hess: prob.Hess != nil}
+	return Available{Grad: prob.Grad != nil, Hess: prob.Hess != nil, HessVec: prob.HessVec != nil}
 }
 
 // function tests if the Problem described by the receiver is suitable for an
@@ -181,6 +190,18 @@ func (has Available) hessian() (uses Available, err error) {
 	return Available{Grad: true, Hess: true}, nil
 }
 
+// hessVec tests if the Problem described by the receiver is suitable for an
+// unconstrained Method using Hessian-vector products, and returns the result.
+func (has Available) hessVec() (uses Available, err error) {
+	if !has.Grad {
+		return Available{}, ErrMissingGrad
+	}
+	if !has.HessVec {
+		return Available{}, ErrMissingHessVec
+	}
+	return Available{Grad: true, HessVec: true}, nil
+}
+
 // Settings represents settings of the optimization run. It contains initial
 // settings, convergence information, and Recorder information. Convergence
 // settings are only checked at MajorIterations, while Evaluation thresholds
@@ -252,8 +273,36 @@ type Settings struct {
 
 	Recorder Recorder
 
+	// Transform specifies an affine change of variables in which the
+	// optimization is performed. If Transform is nil, the problem is
+	// optimized in its original variables. The GradientThreshold is
+	// applied to the gradient with respect to the transformed variables.
+	// See the documentation of Transform for details.
+	Transform *Transform
+
 	// Concurrent represents how many concurrent evaluations are possible.
 	Concurrent int
+
+	// EvaluationTimeout is the maximum duration of a single evaluation of
+	// the Problem. An evaluation that runs for longer fails and is handled
+	// according to FailedEvaluation. A function can not be stopped once it
+	// has been called, so an evaluation that times out continues to run and
+	// its result is discarded, and the Problem functions must be safe for
+	// concurrent use.
+	// If it equals zero, this setting has no effect.
+	// The default value is 0.
+	EvaluationTimeout time.Duration
+
+	// RecoverPanics specifies that panics during evaluations of the Problem
+	// are recovered. An evaluation that panics fails and is handled
+	// according to FailedEvaluation.
+	// The default value is false.
+	RecoverPanics bool
+
+	// FailedEvaluation specifies how failed evaluations of the Problem are
+	// handled. See the documentation of EvaluationPolicy for details. The
+	// default value is KeepNonFinite.
+	FailedEvaluation EvaluationPolicy
 }
 
 // resize takes x and returns a slice of length dim. It returns a resliced x
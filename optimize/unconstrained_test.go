@@ -1368,3 +1368,43 @@ func TestNelderMeadOneD(t *testing.T) {
 		t.Errorf("Wrong value of shrink")
 	}
 }
+
+// hessVecFromHess returns a Hessian-vector product function that forms
+// the Hessian using hess.
+func hessVecFromHess(hess func(*mat.SymDense, []float64)) func(hv, x, v []float64) {
+	return func(hv, x, v []float64) {
+		h := mat.NewSymDense(len(x), nil)
+		hess(h, x)
+		mat.NewVecDense(len(hv), hv).MulVec(h, mat.NewVecDense(len(v), v))
+	}
+}
+
+func TestNewtonCG(t *testing.T) {
+	t.Parallel()
+	var tests []unconstrainedTest
+	for _, test := range newtonTests {
+		switch {
+		case test.name == "BrownAndDennis":
+			// The inexact Newton steps cannot reduce the gradient
+			// to the tolerance of the exact Newton method due to
+			// the large function value at the minimum.
+			test.gradTol = 1e-7
+		case test.name == "Watson" && len(test.x) == 10:
+			// The Hessian is too ill-conditioned for the truncated
+			// conjugate gradient iteration.
+			continue
+		}
+		test.p.HessVec = hessVecFromHess(test.p.Hess)
+		test.p.Hess = nil
+		tests = append(tests, test)
+	}
+	testLocal(t, tests, &NewtonCG{})
+}
+
+func TestLSR1(t *testing.T) {
+	t.Parallel()
+	var tests []unconstrainedTest
+	tests = append(tests, gradientDescentTests...)
+	tests = append(tests, quasiNewtonTests...)
+	testLocal(t, tests, &LSR1{})
+}
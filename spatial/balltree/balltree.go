@@ -0,0 +1,388 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package balltree
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"sort"
+)
+
+// Comparable is the element interface for values stored in a ball tree.
+type Comparable interface {
+	// Distance returns the distance between the receiver and the
+	// parameter. The returned distance must satisfy the properties
+	// of distances in a metric space.
+	//
+	// - a.Distance(a) == 0
+	// - a.Distance(b) >= 0
+	// - a.Distance(b) == b.Distance(a)
+	// - a.Distance(b) <= a.Distance(c)+c.Distance(b)
+	//
+	Distance(Comparable) float64
+}
+
+// Dimensional is a Comparable that is embedded in a space
+// with a known number of dimensions.
+type Dimensional interface {
+	Comparable
+
+	// Dims returns the number of dimensions
+	// of the space.
+	Dims() int
+}
+
+// Point represents a point in a Euclidean k-d space that satisfies the Comparable
+// and Dimensional interfaces.
+type Point []float64
+
+// Distance returns the Euclidean distance between c and the receiver. The concrete
+// type of c must be Point.
+func (p Point) Distance(c Comparable) float64 {
+	q := c.(Point)
+	var sum float64
+	for dim, c := range p {
+		d := c - q[dim]
+		sum += d * d
+	}
+	return math.Sqrt(sum)
+}
+
+// Dims returns the number of dimensions of the point.
+func (p Point) Dims() int { return len(p) }
+
+// Node holds a ball of point values in a ball tree. All the values
+// held below the node are within Radius of the Center. Leaf nodes
+// hold their values in Points and have nil Left and Right children.
+type Node struct {
+	Center Comparable
+	Radius float64
+
+	Points []Comparable
+
+	Left  *Node
+	Right *Node
+}
+
+// Tree implements a ball tree creation and nearest neighbor search.
+type Tree struct {
+	Root  *Node
+	Count int
+}
+
+// DefaultLeafSize is the maximum number of values held in a leaf
+// of a tree constructed by New when a leaf size of less than one
+// is specified and a tree search is expected to be more efficient
+// than a brute-force search.
+const DefaultLeafSize = 16
+
+// New returns a ball tree constructed from the values in p. The leafSize
+// parameter specifies the maximum number of values held in each leaf of the
+// tree. If leafSize is less than one, the leaf size is DefaultLeafSize unless
+// PreferBruteForce returns true for p, in which case all values are held in a
+// single leaf and searches are performed by brute force. The order of elements
+// in p will be altered after New returns. The src parameter provides the
+// source of randomness for the choice of the root ball center. If src is nil
+// global rand package functions are used. Points in p must not be infinitely
+// distant.
+func New(p []Comparable, leafSize int, src rand.Source) (t *Tree, err error) {
+	if leafSize < 1 {
+		leafSize = DefaultLeafSize
+		if PreferBruteForce(p) {
+			leafSize = max(len(p), 1)
+		}
+	}
+	if len(p) == 0 {
+		return &Tree{}, nil
+	}
+
+	var intn func(int) int
+	if src == nil {
+		intn = rand.IntN
+	} else {
+		intn = rand.New(src).IntN
+	}
+	b := builder{work: make([]float64, len(p)), leafSize: leafSize}
+
+	defer func() {
+		switch r := recover(); r {
+		case nil:
+		case pointAtInfinity:
+			t = nil
+			err = pointAtInfinity
+		default:
+			panic(r)
+		}
+	}()
+
+	t = &Tree{
+		Root:  b.build(p, p[intn(len(p))]),
+		Count: len(p),
+	}
+	return t, nil
+}
+
+var pointAtInfinity = errors.New("balltree: point at infinity")
+
+// PreferBruteForce returns whether a brute-force search of the values in p
+// is expected to be more efficient than a ball tree search. This is the case
+// when p is small, or when the values in p are Dimensional and the number of
+// dimensions is large enough relative to the number of values that a tree is
+// unlikely to prune a useful fraction of the search. The estimate assumes that
+// the intrinsic dimension of the data is the dimension of the space, so it is
+// conservative for data lying on a lower dimensional manifold.
+func PreferBruteForce(p []Comparable) bool {
+	if len(p) <= DefaultLeafSize {
+		return true
+	}
+	d, ok := p[0].(Dimensional)
+	if !ok {
+		return false
+	}
+	return float64(d.Dims()) >= math.Log2(float64(len(p)))
+}
+
+// builder performs ball tree construction using the pivot splitting
+// approach where each ball is divided by the two values that are
+// approximately furthest apart. Each child ball is centered on its
+// pivot.
+type builder struct {
+	work     []float64
+	leafSize int
+}
+
+func (b *builder) build(s []Comparable, center Comparable) *Node {
+	n := Node{Center: center}
+	b.work = b.work[:len(s)]
+	far := 0
+	for i, p := range s {
+		d := center.Distance(p)
+		if math.IsInf(d, 0) {
+			panic(pointAtInfinity)
+		}
+		b.work[i] = d
+		if d > b.work[far] {
+			far = i
+		}
+	}
+	n.Radius = b.work[far]
+	if len(s) <= b.leafSize || n.Radius == 0 {
+		n.Points = s
+		return &n
+	}
+
+	left := s[far]
+	far = 0
+	for i, p := range s {
+		b.work[i] = left.Distance(p)
+		if b.work[i] > b.work[far] {
+			far = i
+		}
+	}
+	right := s[far]
+
+	// Partition s into values closer to left, which
+	// includes left itself, and those closer to right,
+	// which includes right. Both halves are non-empty
+	// since left and right are distinct.
+	i, j := 0, len(s)-1
+	for i <= j {
+		if b.work[i] <= right.Distance(s[i]) {
+			i++
+			continue
+		}
+		s[i], s[j] = s[j], s[i]
+		b.work[i], b.work[j] = b.work[j], b.work[i]
+		j--
+	}
+	n.Left = b.build(s[:i], left)
+	n.Right = b.build(s[i:], right)
+	return &n
+}
+
+// Len returns the number of elements in the tree.
+func (t *Tree) Len() int { return t.Count }
+
+var inf = math.Inf(1)
+
+// Nearest returns the nearest value to the query and the distance between them.
+func (t *Tree) Nearest(q Comparable) (Comparable, float64) {
+	if t.Root == nil {
+		return nil, inf
+	}
+	return t.Root.search(q, q.Distance(t.Root.Center), nil, inf)
+}
+
+func (n *Node) search(q Comparable, d float64, best Comparable, dist float64) (Comparable, float64) {
+	if d-n.Radius >= dist {
+		return best, dist
+	}
+	if n.Points != nil {
+		for _, p := range n.Points {
+			if d := q.Distance(p); d < dist {
+				best, dist = p, d
+			}
+		}
+		return best, dist
+	}
+
+	dl := q.Distance(n.Left.Center)
+	dr := q.Distance(n.Right.Center)
+	if dl <= dr {
+		best, dist = n.Left.search(q, dl, best, dist)
+		return n.Right.search(q, dr, best, dist)
+	}
+	best, dist = n.Right.search(q, dr, best, dist)
+	return n.Left.search(q, dl, best, dist)
+}
+
+// ComparableDist holds a Comparable and a distance to a specific query. A nil Comparable
+// is used to mark the end of the heap, so clients should not store nil values except for
+// this purpose.
+type ComparableDist struct {
+	Comparable Comparable
+	Dist       float64
+}
+
+// Heap is a max heap sorted on Dist.
+type Heap []ComparableDist
+
+func (h *Heap) Max() ComparableDist  { return (*h)[0] }
+func (h *Heap) Len() int             { return len(*h) }
+func (h *Heap) Less(i, j int) bool   { return (*h)[i].Comparable == nil || (*h)[i].Dist > (*h)[j].Dist }
+func (h *Heap) Swap(i, j int)        { (*h)[i], (*h)[j] = (*h)[j], (*h)[i] }
+func (h *Heap) Push(x interface{})   { (*h) = append(*h, x.(ComparableDist)) }
+func (h *Heap) Pop() (i interface{}) { i, *h = (*h)[len(*h)-1], (*h)[:len(*h)-1]; return i }
+
+// NKeeper is a Keeper that retains the n best ComparableDists that have been passed to Keep.
+type NKeeper struct {
+	Heap
+}
+
+// NewNKeeper returns an NKeeper with the max value of the heap set to infinite distance. The
+// returned NKeeper is able to retain at most n values.
+func NewNKeeper(n int) *NKeeper {
+	k := NKeeper{make(Heap, 1, n)}
+	k.Heap[0].Dist = inf
+	return &k
+}
+
+// Keep adds c to the heap if its distance is less than the maximum value of the heap. If adding
+// c would increase the size of the heap beyond the initial maximum length, the maximum value of
+// the heap is dropped.
+func (k *NKeeper) Keep(c ComparableDist) {
+	if c.Dist <= k.Heap[0].Dist { // Favour later finds to displace sentinel.
+		if len(k.Heap) == cap(k.Heap) {
+			heap.Pop(k)
+		}
+		heap.Push(k, c)
+	}
+}
+
+// DistKeeper is a Keeper that retains the ComparableDists within the specified distance of the
+// query that it is called to Keep.
+type DistKeeper struct {
+	Heap
+}
+
+// NewDistKeeper returns an DistKeeper with the maximum value of the heap set to d.
+func NewDistKeeper(d float64) *DistKeeper { return &DistKeeper{Heap{{Dist: d}}} }
+
+// Keep adds c to the heap if its distance is less than or equal to the max value of the heap.
+func (k *DistKeeper) Keep(c ComparableDist) {
+	if c.Dist <= k.Heap[0].Dist {
+		heap.Push(k, c)
+	}
+}
+
+// Keeper implements a conditional max heap sorted on the Dist field of the ComparableDist type.
+// Ball tree search is guided by the distance stored in the max value of the heap.
+type Keeper interface {
+	Keep(ComparableDist) // Keep conditionally pushes the provided ComparableDist onto the heap.
+	Max() ComparableDist // Max returns the maximum element of the Keeper.
+	heap.Interface
+}
+
+// NearestSet finds the nearest values to the query accepted by the provided Keeper, k.
+// k must be able to return a ComparableDist specifying the maximum acceptable distance
+// when Max() is called, and retains the results of the search in min sorted order after
+// the call to NearestSet returns.
+// If a sentinel ComparableDist with a nil Comparable is used by the Keeper to mark the
+// maximum distance, NearestSet will remove it before returning.
+func (t *Tree) NearestSet(k Keeper, q Comparable) {
+	if t.Root == nil {
+		return
+	}
+	t.Root.searchSet(q, q.Distance(t.Root.Center), k)
+
+	// Check whether we have retained a sentinel
+	// and flag removal if we have.
+	removeSentinel := k.Len() != 0 && k.Max().Comparable == nil
+
+	sort.Sort(sort.Reverse(k))
+
+	// This abuses the interface to drop the max.
+	// It is reasonable to do this because we know
+	// that the maximum value will now be at element
+	// zero, which is removed by the Pop method.
+	if removeSentinel {
+		k.Pop()
+	}
+}
+
+func (n *Node) searchSet(q Comparable, d float64, k Keeper) {
+	if d-n.Radius > k.Max().Dist {
+		return
+	}
+	if n.Points != nil {
+		for _, p := range n.Points {
+			k.Keep(ComparableDist{Comparable: p, Dist: q.Distance(p)})
+		}
+		return
+	}
+
+	dl := q.Distance(n.Left.Center)
+	dr := q.Distance(n.Right.Center)
+	if dl <= dr {
+		n.Left.searchSet(q, dl, k)
+		n.Right.searchSet(q, dr, k)
+	} else {
+		n.Right.searchSet(q, dr, k)
+		n.Left.searchSet(q, dl, k)
+	}
+}
+
+// Operation is a function that operates on a Comparable. The tree depth of the point is
+// also provided. If done is returned true, the Operation is indicating that no further
+// work needs to be done and so the Do function should traverse no further.
+type Operation func(Comparable, int) (done bool)
+
+// Do performs fn on all values stored in the tree. A boolean is returned indicating whether the
+// Do traversal was interrupted by an Operation returning true. If fn alters stored values' distance
+// relationships, future tree operation behaviors are undefined.
+func (t *Tree) Do(fn Operation) bool {
+	if t.Root == nil {
+		return false
+	}
+	return t.Root.do(fn, 0)
+}
+
+func (n *Node) do(fn Operation, depth int) (done bool) {
+	if n.Points != nil {
+		for _, p := range n.Points {
+			if fn(p, depth) {
+				return true
+			}
+		}
+		return false
+	}
+	done = n.Left.do(fn, depth+1)
+	if done {
+		return
+	}
+	return n.Right.do(fn, depth+1)
+}
@@ -0,0 +1,34 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package balltree_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/spatial/balltree"
+)
+
+func ExampleTree() {
+	// Example data from https://en.wikipedia.org/wiki/K-d_tree
+	points := []balltree.Comparable{
+		balltree.Point{2, 3},
+		balltree.Point{5, 4},
+		balltree.Point{9, 6},
+		balltree.Point{4, 7},
+		balltree.Point{8, 1},
+		balltree.Point{7, 2},
+	}
+
+	t, err := balltree.New(points, 2, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	q := balltree.Point{8, 7}
+	p, d := t.Nearest(q)
+	fmt.Printf("%v is closest point to %v, d=%f\n", p, q, d)
+	// Output:
+	// [9 6] is closest point to [8 7], d=1.414214
+}
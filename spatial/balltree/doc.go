@@ -0,0 +1,11 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package balltree implements a ball tree. Ball trees provide an
+// efficient search for nearest neighbors in a metric space and remain
+// effective for data with higher dimension than is suitable for k-d trees.
+//
+// See "Five balltree construction algorithms", ICSI Technical Report
+// TR-89-063 for details of ball trees.
+package balltree // import "gonum.org/v1/gonum/spatial/balltree"
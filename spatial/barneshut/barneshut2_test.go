@@ -338,6 +338,16 @@ var planeTests = []struct {
 			},
 		},
 	},
+	{
+		name: "unequal masses",
+		particles: []particle2{
+			{x: 64.5, y: 81.5, m: 4, name: "A"},
+			{x: 242, y: 34, m: 2, name: "B"},
+			{x: 199, y: 69, m: 0.5, name: "C"},
+			{x: 285, y: 106.5, m: 3, name: "D"},
+			{x: 170, y: 194.5, m: 7, name: "E"},
+		},
+	},
 }
 
 func TestPlane(t *testing.T) {
@@ -333,6 +333,16 @@ var volumeTests = []struct {
 			},
 		},
 	},
+	{
+		name: "unequal masses",
+		particles: []particle3{
+			{x: 64.5, y: 81.5, z: 0, m: 4, name: "A"},
+			{x: 242, y: 34, z: 40, m: 2, name: "B"},
+			{x: 199, y: 69, z: 80, m: 0.5, name: "C"},
+			{x: 285, y: 106.5, z: 120, m: 3, name: "D"},
+			{x: 170, y: 194.5, z: 160, m: 7, name: "E"},
+		},
+	},
 }
 
 func TestVolume(t *testing.T) {
@@ -3,8 +3,14 @@
 // license that can be found in the LICENSE file.
 
 // Package barneshut provides routines for calculating n-body force approximations
-// using the Barnes-Hut algorithm.
+// using the Barnes-Hut algorithm and the fast multipole method.
 //
 // See https://en.wikipedia.org/wiki/Barnes–Hut_simulation, http://arborjs.org/docs/barnes-hut
-// and https://jheer.github.io/barnes-hut/ for details.
+// and https://jheer.github.io/barnes-hut/ for details of the Barnes-Hut algorithm, and
+// https://en.wikipedia.org/wiki/Fast_multipole_method for the fast multipole method.
+//
+// The Barnes-Hut types, Plane and Volume, calculate the force on a single particle
+// with an arbitrary force function. The fast multipole types, FMM2 and FMM3, calculate
+// the forces on and potentials at all particles at once for gravitational interactions,
+// with accuracy controlled by the order of the multipole expansions.
 package barneshut // import "gonum.org/v1/gonum/spatial/barneshut"
@@ -0,0 +1,393 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package barneshut
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+// FMM2 implements fast multipole method calculation of the potentials of
+// and forces between particles in a plane interacting through the
+// two-dimensional logarithmic potential. The potential at particle i is
+//
+//	u_i = Σ_{j≠i} m_j log ‖x_i - x_j‖
+//
+// and the force on particle i is -m_i ∇u_i, an attractive force on m_i by
+// each m_j equal to (m_i⋅m_j)/‖v‖ in the direction of v, the vector from
+// x_i to x_j. Repulsive forces are obtained by negating the returned
+// forces. Coincident particles do not interact.
+//
+// The expansions are calculated essentially as described in "A fast
+// algorithm for particle simulations", Journal of Computational Physics
+// 73(2):325-348. doi:10.1016/0021-9991(87)90140-9 using a dual tree
+// traversal to identify well separated cells, and the work done is
+// linear in the number of particles.
+type FMM2 struct {
+	// Particles is the set of particles
+	// being simulated.
+	Particles []Particle2
+
+	order int
+	binom [][]float64
+
+	pos  []complex128
+	mass []float64
+	perm []int
+	root *fmmCell2
+
+	pot  []float64
+	grad []complex128
+
+	// work is scratch space for
+	// expansion coefficients.
+	work []complex128
+}
+
+// fmmCell2 is a quad tree quadrant holding a contiguous
+// range of the permuted particles and their expansions.
+type fmmCell2 struct {
+	center complex128
+	radius float64
+
+	start, end int
+	children   []*fmmCell2
+
+	multipole []complex128
+	local     []complex128
+}
+
+const (
+	// fmmLeafSize is the maximum number of particles
+	// held in a leaf cell of a fast multipole tree.
+	fmmLeafSize = 16
+
+	// fmmMaxDepth is the maximum depth of a fast
+	// multipole tree. It bounds the subdivision of
+	// cells holding coincident particles.
+	fmmMaxDepth = 48
+
+	// fmmTheta is the separation criterion for
+	// interacting cells. Cells are well separated
+	// when the sum of their radii is less than
+	// fmmTheta times the distance between them.
+	fmmTheta = 0.5
+)
+
+// NewFMM2 returns a new FMM2 for the particles in p using expansions
+// with the given number of terms. The relative error of the calculated
+// forces is approximately 2^-order. NewFMM2 will panic if order is less
+// than one.
+func NewFMM2(p []Particle2, order int) *FMM2 {
+	if order < 1 {
+		panic("barneshut: invalid expansion order")
+	}
+	f := &FMM2{Particles: p, order: order, binom: binomials(2 * order)}
+	f.Reset()
+	return f
+}
+
+// binomials returns a table of binomial coefficients up to n.
+func binomials(n int) [][]float64 {
+	c := make([][]float64, n+1)
+	for i := range c {
+		c[i] = make([]float64, i+1)
+		c[i][0], c[i][i] = 1, 1
+		for j := 1; j < i; j++ {
+			c[i][j] = c[i-1][j-1] + c[i-1][j]
+		}
+	}
+	return c
+}
+
+// Reset reconstructs the fast multipole tree. Reset must be called if the
+// Particles field or elements of Particles have been altered.
+func (f *FMM2) Reset() {
+	n := len(f.Particles)
+	f.pos = make([]complex128, n)
+	f.mass = make([]float64, n)
+	f.perm = make([]int, n)
+	if n == 0 {
+		f.root = nil
+		return
+	}
+	var b r2.Box
+	for i, p := range f.Particles {
+		c := p.Coord2()
+		f.pos[i] = complex(c.X, c.Y)
+		f.mass[i] = p.Mass()
+		f.perm[i] = i
+		if i == 0 {
+			b = r2.Box{Min: c, Max: c}
+			continue
+		}
+		b.Min.X = math.Min(b.Min.X, c.X)
+		b.Min.Y = math.Min(b.Min.Y, c.Y)
+		b.Max.X = math.Max(b.Max.X, c.X)
+		b.Max.Y = math.Max(b.Max.Y, c.Y)
+	}
+	size := b.Size()
+	half := math.Max(size.X, size.Y) / 2
+	center := b.Center()
+	f.root = f.build(complex(center.X, center.Y), half, 0, n, 0)
+}
+
+// build returns the cell with the given center and half side length
+// holding the permuted particles in [start, end).
+func (f *FMM2) build(center complex128, half float64, start, end, depth int) *fmmCell2 {
+	c := &fmmCell2{center: center, start: start, end: end}
+	for _, i := range f.perm[start:end] {
+		c.radius = math.Max(c.radius, cmplx.Abs(f.pos[i]-center))
+	}
+	if end-start <= fmmLeafSize || depth == fmmMaxDepth || c.radius == 0 {
+		return c
+	}
+
+	// Partition the particles into quadrants.
+	quadrant := func(i int) int {
+		var q int
+		if real(f.pos[i]) >= real(center) {
+			q |= 1
+		}
+		if imag(f.pos[i]) >= imag(center) {
+			q |= 2
+		}
+		return q
+	}
+	var bounds [5]int
+	bounds[0] = start
+	for q := 0; q < 4; q++ {
+		k := bounds[q]
+		for j := k; j < end; j++ {
+			if quadrant(f.perm[j]) == q {
+				f.perm[j], f.perm[k] = f.perm[k], f.perm[j]
+				k++
+			}
+		}
+		bounds[q+1] = k
+	}
+	for q := 0; q < 4; q++ {
+		if bounds[q] == bounds[q+1] {
+			continue
+		}
+		off := complex(half/2, half/2)
+		if q&1 == 0 {
+			off = complex(-half/2, imag(off))
+		}
+		if q&2 == 0 {
+			off = complex(real(off), -half/2)
+		}
+		c.children = append(c.children, f.build(center+off, half/2, bounds[q], bounds[q+1], depth+1))
+	}
+	return c
+}
+
+// Evaluate calculates the forces on and potentials at each of the
+// particles. The results are placed in forces and potentials, which
+// must either be nil or have the same length as the Particles field.
+// If both are nil, Evaluate is a no-op.
+func (f *FMM2) Evaluate(forces []r2.Vec, potentials []float64) {
+	if forces == nil && potentials == nil {
+		return
+	}
+	n := len(f.Particles)
+	if (forces != nil && len(forces) != n) || (potentials != nil && len(potentials) != n) {
+		panic("barneshut: destination length mismatch")
+	}
+	if n == 0 {
+		return
+	}
+	f.pot = make([]float64, n)
+	f.grad = make([]complex128, n)
+	f.work = make([]complex128, f.order+1)
+
+	f.upward(f.root)
+	f.self(f.root)
+	f.downward(f.root)
+
+	for i, g := range f.grad {
+		if forces != nil {
+			// The gradient is the conjugate of
+			// the derivative of the complex potential.
+			forces[i] = r2.Vec{X: -f.mass[i] * real(g), Y: f.mass[i] * imag(g)}
+		}
+		if potentials != nil {
+			potentials[i] = f.pot[i]
+		}
+	}
+}
+
+// upward calculates the multipole expansions of c and its descendants.
+func (f *FMM2) upward(c *fmmCell2) {
+	p := f.order
+	c.multipole = make([]complex128, p+1)
+	c.local = make([]complex128, p+1)
+	if c.children == nil {
+		for _, i := range f.perm[c.start:c.end] {
+			q := complex(f.mass[i], 0)
+			u := f.pos[i] - c.center
+			c.multipole[0] += q
+			uk := complex(1, 0)
+			for k := 1; k <= p; k++ {
+				uk *= u
+				c.multipole[k] -= q * uk / complex(float64(k), 0)
+			}
+		}
+		return
+	}
+	for _, ch := range c.children {
+		f.upward(ch)
+		// Shift the child's multipole expansion to
+		// the center of c.
+		t := ch.center - c.center
+		a := ch.multipole
+		c.multipole[0] += a[0]
+		tl := complex(1, 0)
+		for l := 1; l <= p; l++ {
+			tl *= t
+			b := -a[0] * tl / complex(float64(l), 0)
+			tk := complex(1, 0)
+			for k := l; k >= 1; k-- {
+				b += a[k] * tk * complex(f.binom[l-1][k-1], 0)
+				tk *= t
+			}
+			c.multipole[l] += b
+		}
+	}
+}
+
+// self calculates the interactions between particles within c.
+func (f *FMM2) self(c *fmmCell2) {
+	if c.children == nil {
+		for j := c.start; j < c.end; j++ {
+			for k := j + 1; k < c.end; k++ {
+				f.direct(f.perm[j], f.perm[k])
+			}
+		}
+		return
+	}
+	for j, a := range c.children {
+		f.self(a)
+		for _, b := range c.children[j+1:] {
+			f.interact(a, b)
+		}
+	}
+}
+
+// interact calculates the interactions between particles in
+// the distinct cells a and b.
+func (f *FMM2) interact(a, b *fmmCell2) {
+	// Direct interaction is cheaper than translating
+	// expansions between sparsely populated cells.
+	direct := (a.end-a.start)*(b.end-b.start) <= f.order*f.order
+	if !direct && a.radius+b.radius < fmmTheta*cmplx.Abs(a.center-b.center) {
+		f.multipoleToLocal(a, b)
+		f.multipoleToLocal(b, a)
+		return
+	}
+	if direct || (a.children == nil && b.children == nil) {
+		for _, i := range f.perm[a.start:a.end] {
+			for _, j := range f.perm[b.start:b.end] {
+				f.direct(i, j)
+			}
+		}
+		return
+	}
+	if b.children == nil || (a.children != nil && a.radius >= b.radius) {
+		a, b = b, a
+	}
+	for _, c := range b.children {
+		f.interact(a, c)
+	}
+}
+
+// direct calculates the interaction between particles i and j.
+func (f *FMM2) direct(i, j int) {
+	d := f.pos[i] - f.pos[j]
+	r2 := real(d)*real(d) + imag(d)*imag(d)
+	if r2 == 0 {
+		return
+	}
+	logr := math.Log(r2) / 2
+	f.pot[i] += f.mass[j] * logr
+	f.pot[j] += f.mass[i] * logr
+	// The derivative of log(z) is 1/z.
+	g := 1 / d
+	f.grad[i] += complex(f.mass[j], 0) * g
+	f.grad[j] -= complex(f.mass[i], 0) * g
+}
+
+// multipoleToLocal adds the multipole expansion of src to the
+// local expansion of dst.
+func (f *FMM2) multipoleToLocal(src, dst *fmmCell2) {
+	p := f.order
+	a := src.multipole
+	d := dst.center - src.center
+	inv := 1 / d
+
+	// Precompute a_k/d^k.
+	ak := f.work
+	dk := complex(1, 0)
+	for k := 1; k <= p; k++ {
+		dk *= inv
+		ak[k] = a[k] * dk
+	}
+
+	b0 := a[0] * cmplx.Log(d)
+	for k := 1; k <= p; k++ {
+		b0 += ak[k]
+	}
+	dst.local[0] += b0
+
+	dl := complex(1, 0)
+	sign := 1.0
+	for l := 1; l <= p; l++ {
+		dl *= inv
+		sign = -sign
+		b := -a[0] / complex(float64(l), 0)
+		for k := 1; k <= p; k++ {
+			b += ak[k] * complex(f.binom[l+k-1][k-1], 0)
+		}
+		dst.local[l] += b * dl * complex(sign, 0)
+	}
+}
+
+// downward propagates local expansions from c to its descendants
+// and evaluates them at the particles in leaf cells.
+func (f *FMM2) downward(c *fmmCell2) {
+	p := f.order
+	if c.children == nil {
+		for _, i := range f.perm[c.start:c.end] {
+			w := f.pos[i] - c.center
+			var phi, dphi complex128
+			for l := p; l >= 0; l-- {
+				phi = phi*w + c.local[l]
+				if l > 0 {
+					dphi = dphi*w + complex(float64(l), 0)*c.local[l]
+				}
+			}
+			f.pot[i] += real(phi)
+			f.grad[i] += dphi
+		}
+		return
+	}
+	for _, ch := range c.children {
+		// Shift the local expansion of c to the
+		// center of the child.
+		s := ch.center - c.center
+		for l := 0; l <= p; l++ {
+			var b complex128
+			sk := complex(1, 0)
+			for k := l; k <= p; k++ {
+				b += complex(f.binom[k][l], 0) * c.local[k] * sk
+				sk *= s
+			}
+			ch.local[l] += b
+		}
+		f.downward(ch)
+	}
+}
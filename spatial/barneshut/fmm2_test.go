@@ -0,0 +1,118 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package barneshut
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+// directFMM2 returns the forces and potentials of the particles
+// calculated by direct summation.
+func directFMM2(particles []Particle2) ([]r2.Vec, []float64) {
+	forces := make([]r2.Vec, len(particles))
+	potentials := make([]float64, len(particles))
+	for i, p := range particles {
+		for _, e := range particles {
+			v := r2.Sub(e.Coord2(), p.Coord2())
+			d2 := r2.Norm2(v)
+			if d2 == 0 {
+				continue
+			}
+			potentials[i] += e.Mass() * math.Log(d2) / 2
+			forces[i] = r2.Add(forces[i], r2.Scale(p.Mass()*e.Mass()/d2, v))
+		}
+	}
+	return forces, potentials
+}
+
+func TestFMM2(t *testing.T) {
+	t.Parallel()
+	for _, n := range []int{0, 1, 2, 10, 1000, 5000} {
+		rnd := rand.New(rand.NewPCG(1, 1))
+		particles := make([]Particle2, n)
+		for i := range particles {
+			particles[i] = particle2{x: 1000 * rnd.Float64(), y: 1000 * rnd.Float64(), m: 1 + rnd.Float64()}
+		}
+		wantF, wantP := directFMM2(particles)
+		var forceNorm float64
+		for _, f := range wantF {
+			forceNorm += r2.Norm2(f)
+		}
+		forceNorm = math.Sqrt(forceNorm)
+
+		prev := math.Inf(1)
+		for _, order := range []int{4, 8, 16, 24} {
+			fmm := NewFMM2(particles, order)
+			gotF := make([]r2.Vec, n)
+			gotP := make([]float64, n)
+			fmm.Evaluate(gotF, gotP)
+
+			var ssd, maxPot float64
+			for i := range gotF {
+				ssd += r2.Norm2(r2.Sub(gotF[i], wantF[i]))
+				maxPot = math.Max(maxPot, math.Abs(gotP[i]-wantP[i])/math.Max(1, math.Abs(wantP[i])))
+			}
+			relErr := math.Sqrt(ssd) / math.Max(forceNorm, 1)
+			tol := math.Max(math.Pow(2, -float64(order)), 1e-12)
+			t.Logf("%d-body order=%d: force error=%.3g potential error=%.3g", n, order, relErr, maxPot)
+			if relErr > tol {
+				t.Errorf("unexpected force error for %d-body order=%d: got:%v want<=%v", n, order, relErr, tol)
+			}
+			if maxPot > tol {
+				t.Errorf("unexpected potential error for %d-body order=%d: got:%v want<=%v", n, order, maxPot, tol)
+			}
+			if n >= 1000 && relErr > prev {
+				t.Errorf("force error did not decrease with order for %d-body order=%d: %v > %v", n, order, relErr, prev)
+			}
+			prev = relErr
+		}
+	}
+}
+
+func TestFMM2Coincident(t *testing.T) {
+	t.Parallel()
+	particles := make([]Particle2, 100)
+	for i := range particles {
+		particles[i] = particle2{x: 1, y: 2, m: 1}
+	}
+	particles = append(particles, particle2{x: 3, y: 2, m: 2})
+	wantF, wantP := directFMM2(particles)
+	gotF := make([]r2.Vec, len(particles))
+	gotP := make([]float64, len(particles))
+	NewFMM2(particles, 8).Evaluate(gotF, gotP)
+	for i := range particles {
+		// Coincident particles may be approximated
+		// as a single well separated cell.
+		const tol = 1.0 / (1 << 8)
+		if r2.Norm(r2.Sub(gotF[i], wantF[i])) > tol*r2.Norm(wantF[i]) || math.Abs(gotP[i]-wantP[i]) > tol*math.Abs(wantP[i]) {
+			t.Errorf("unexpected result for particle %d: got:%v %v want:%v %v", i, gotF[i], gotP[i], wantF[i], wantP[i])
+		}
+	}
+}
+
+var fmm2Sink []r2.Vec
+
+func BenchmarkFMM2(b *testing.B) {
+	for _, n := range []int{1e3, 1e4, 1e5} {
+		rnd := rand.New(rand.NewPCG(1, 1))
+		particles := make([]Particle2, n)
+		for i := range particles {
+			particles[i] = particle2{x: rnd.Float64(), y: rnd.Float64(), m: 1}
+		}
+		fmm2Sink = make([]r2.Vec, n)
+		for _, order := range []int{4, 8, 16} {
+			b.Run(fmt.Sprintf("%d-body/order=%d", n, order), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					NewFMM2(particles, order).Evaluate(fmm2Sink, nil)
+				}
+			})
+		}
+	}
+}
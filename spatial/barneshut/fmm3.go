@@ -0,0 +1,503 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package barneshut
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/spatial/r3"
+)
+
+// FMM3 implements fast multipole method calculation of the potentials of
+// and forces between particles in a volume interacting through the
+// Newtonian potential. The potential at particle i is
+//
+//	φ_i = Σ_{j≠i} m_j/‖x_i - x_j‖
+//
+// and the force on particle i is m_i ∇φ_i, an attractive force on m_i by
+// each m_j equal to (m_i⋅m_j)/‖v‖² in the direction of v, the vector from
+// x_i to x_j, as calculated by Gravity3. Repulsive forces are obtained by
+// negating the returned forces. Coincident particles do not interact.
+//
+// The expansions are Cartesian Taylor expansions calculated using the
+// recurrence described in "A particle method and adaptive treecode for
+// vortex sheet motion in three-dimensions", Journal of Computational
+// Physics 172(2):879-907. doi:10.1006/jcph.2001.6862 using a dual tree
+// traversal to identify well separated cells, and the work done is
+// linear in the number of particles.
+type FMM3 struct {
+	// Particles is the set of particles
+	// being simulated.
+	Particles []Particle3
+
+	order int
+	terms taylor3
+
+	pos  []r3.Vec
+	mass []float64
+	perm []int
+	root *fmmCell3
+
+	pot  []float64
+	grad []r3.Vec
+
+	// work is scratch space for
+	// expansion coefficients.
+	work []float64
+}
+
+// fmmCell3 is an octree octant holding a contiguous
+// range of the permuted particles and their expansions.
+type fmmCell3 struct {
+	center r3.Vec
+	radius float64
+
+	start, end int
+	children   []*fmmCell3
+
+	multipole []float64
+	local     []float64
+}
+
+// NewFMM3 returns a new FMM3 for the particles in p using expansions
+// of the given order. The relative error of the calculated forces is
+// approximately 2^-order. NewFMM3 will panic if order is less than one.
+func NewFMM3(p []Particle3, order int) *FMM3 {
+	if order < 1 {
+		panic("barneshut: invalid expansion order")
+	}
+	f := &FMM3{Particles: p, order: order, terms: newTaylor3(order)}
+	f.Reset()
+	return f
+}
+
+// Reset reconstructs the fast multipole tree. Reset must be called if the
+// Particles field or elements of Particles have been altered.
+func (f *FMM3) Reset() {
+	n := len(f.Particles)
+	f.pos = make([]r3.Vec, n)
+	f.mass = make([]float64, n)
+	f.perm = make([]int, n)
+	if n == 0 {
+		f.root = nil
+		return
+	}
+	var b r3.Box
+	for i, p := range f.Particles {
+		c := p.Coord3()
+		f.pos[i] = c
+		f.mass[i] = p.Mass()
+		f.perm[i] = i
+		if i == 0 {
+			b = r3.Box{Min: c, Max: c}
+			continue
+		}
+		b.Min.X = math.Min(b.Min.X, c.X)
+		b.Min.Y = math.Min(b.Min.Y, c.Y)
+		b.Min.Z = math.Min(b.Min.Z, c.Z)
+		b.Max.X = math.Max(b.Max.X, c.X)
+		b.Max.Y = math.Max(b.Max.Y, c.Y)
+		b.Max.Z = math.Max(b.Max.Z, c.Z)
+	}
+	size := b.Size()
+	half := math.Max(size.X, math.Max(size.Y, size.Z)) / 2
+	f.root = f.build(b.Center(), half, 0, n, 0)
+}
+
+// build returns the cell with the given center and half side length
+// holding the permuted particles in [start, end).
+func (f *FMM3) build(center r3.Vec, half float64, start, end, depth int) *fmmCell3 {
+	c := &fmmCell3{center: center, start: start, end: end}
+	for _, i := range f.perm[start:end] {
+		c.radius = math.Max(c.radius, r3.Norm(r3.Sub(f.pos[i], center)))
+	}
+	if end-start <= fmmLeafSize || depth == fmmMaxDepth || c.radius == 0 {
+		return c
+	}
+
+	// Partition the particles into octants.
+	octant := func(i int) int {
+		var o int
+		if f.pos[i].X >= center.X {
+			o |= 1
+		}
+		if f.pos[i].Y >= center.Y {
+			o |= 2
+		}
+		if f.pos[i].Z >= center.Z {
+			o |= 4
+		}
+		return o
+	}
+	var bounds [9]int
+	bounds[0] = start
+	for o := 0; o < 8; o++ {
+		k := bounds[o]
+		for j := k; j < end; j++ {
+			if octant(f.perm[j]) == o {
+				f.perm[j], f.perm[k] = f.perm[k], f.perm[j]
+				k++
+			}
+		}
+		bounds[o+1] = k
+	}
+	for o := 0; o < 8; o++ {
+		if bounds[o] == bounds[o+1] {
+			continue
+		}
+		off := r3.Vec{X: -half / 2, Y: -half / 2, Z: -half / 2}
+		if o&1 != 0 {
+			off.X = half / 2
+		}
+		if o&2 != 0 {
+			off.Y = half / 2
+		}
+		if o&4 != 0 {
+			off.Z = half / 2
+		}
+		c.children = append(c.children, f.build(r3.Add(center, off), half/2, bounds[o], bounds[o+1], depth+1))
+	}
+	return c
+}
+
+// Evaluate calculates the forces on and potentials at each of the
+// particles. The results are placed in forces and potentials, which
+// must either be nil or have the same length as the Particles field.
+// If both are nil, Evaluate is a no-op.
+func (f *FMM3) Evaluate(forces []r3.Vec, potentials []float64) {
+	if forces == nil && potentials == nil {
+		return
+	}
+	n := len(f.Particles)
+	if (forces != nil && len(forces) != n) || (potentials != nil && len(potentials) != n) {
+		panic("barneshut: destination length mismatch")
+	}
+	if n == 0 {
+		return
+	}
+	f.pot = make([]float64, n)
+	f.grad = make([]r3.Vec, n)
+	f.work = make([]float64, len(f.terms.index))
+
+	f.upward(f.root)
+	f.self(f.root)
+	f.downward(f.root)
+
+	for i, g := range f.grad {
+		if forces != nil {
+			forces[i] = r3.Scale(f.mass[i], g)
+		}
+		if potentials != nil {
+			potentials[i] = f.pot[i]
+		}
+	}
+}
+
+// upward calculates the multipole expansions of c and its descendants.
+func (f *FMM3) upward(c *fmmCell3) {
+	t := &f.terms
+	c.multipole = make([]float64, len(t.index))
+	c.local = make([]float64, len(t.index))
+	if c.children == nil {
+		pow := make([]float64, len(t.index))
+		for _, i := range f.perm[c.start:c.end] {
+			t.monomials(pow, r3.Sub(f.pos[i], c.center))
+			for k, v := range pow {
+				c.multipole[k] += f.mass[i] * v
+			}
+		}
+		return
+	}
+	pow := make([]float64, len(t.index))
+	for _, ch := range c.children {
+		f.upward(ch)
+		// Shift the child's multipole expansion to
+		// the center of c.
+		t.monomials(pow, r3.Sub(ch.center, c.center))
+		for _, s := range t.shift {
+			c.multipole[s.k] += s.coef * ch.multipole[s.i] * pow[s.diff]
+		}
+	}
+}
+
+// self calculates the interactions between particles within c.
+func (f *FMM3) self(c *fmmCell3) {
+	if c.children == nil {
+		for j := c.start; j < c.end; j++ {
+			for k := j + 1; k < c.end; k++ {
+				f.direct(f.perm[j], f.perm[k])
+			}
+		}
+		return
+	}
+	for j, a := range c.children {
+		f.self(a)
+		for _, b := range c.children[j+1:] {
+			f.interact(a, b)
+		}
+	}
+}
+
+// interact calculates the interactions between particles in
+// the distinct cells a and b.
+func (f *FMM3) interact(a, b *fmmCell3) {
+	// Direct interaction is cheaper than translating
+	// expansions between sparsely populated cells.
+	direct := (a.end-a.start)*(b.end-b.start) <= len(f.terms.translate)
+	if !direct && a.radius+b.radius < fmmTheta*r3.Norm(r3.Sub(a.center, b.center)) {
+		f.multipoleToLocal(a, b)
+		return
+	}
+	if direct || (a.children == nil && b.children == nil) {
+		for _, i := range f.perm[a.start:a.end] {
+			for _, j := range f.perm[b.start:b.end] {
+				f.direct(i, j)
+			}
+		}
+		return
+	}
+	if b.children == nil || (a.children != nil && a.radius >= b.radius) {
+		a, b = b, a
+	}
+	for _, c := range b.children {
+		f.interact(a, c)
+	}
+}
+
+// direct calculates the interaction between particles i and j.
+func (f *FMM3) direct(i, j int) {
+	v := r3.Sub(f.pos[j], f.pos[i])
+	d2 := r3.Norm2(v)
+	if d2 == 0 {
+		return
+	}
+	d := math.Sqrt(d2)
+	f.pot[i] += f.mass[j] / d
+	f.pot[j] += f.mass[i] / d
+	v = r3.Scale(1/(d2*d), v)
+	f.grad[i] = r3.Add(f.grad[i], r3.Scale(f.mass[j], v))
+	f.grad[j] = r3.Sub(f.grad[j], r3.Scale(f.mass[i], v))
+}
+
+// multipoleToLocal adds the multipole expansion of each of a
+// and b to the local expansion of the other.
+func (f *FMM3) multipoleToLocal(a, b *fmmCell3) {
+	t := &f.terms
+	d := f.work
+	t.derivatives(d, r3.Sub(b.center, a.center))
+	for _, s := range t.translate {
+		// The derivatives for the reverse translation
+		// differ only in sign for odd orders.
+		v := s.coef * d[s.sum]
+		b.local[s.l] += v * a.multipole[s.k]
+		a.local[s.l] += s.sign * v * b.multipole[s.k]
+	}
+}
+
+// downward propagates local expansions from c to its descendants
+// and evaluates them at the particles in leaf cells.
+func (f *FMM3) downward(c *fmmCell3) {
+	t := &f.terms
+	pow := make([]float64, len(t.index))
+	if c.children == nil {
+		for _, i := range f.perm[c.start:c.end] {
+			t.monomials(pow, r3.Sub(f.pos[i], c.center))
+			for l, v := range c.local {
+				f.pot[i] += v * pow[l]
+			}
+			var g r3.Vec
+			for _, d := range t.gradient {
+				v := c.local[d.l] * d.coef * pow[d.lower]
+				switch d.dim {
+				case 0:
+					g.X += v
+				case 1:
+					g.Y += v
+				case 2:
+					g.Z += v
+				}
+			}
+			f.grad[i] = r3.Add(f.grad[i], g)
+		}
+		return
+	}
+	for _, ch := range c.children {
+		// Shift the local expansion of c to the
+		// center of the child.
+		t.monomials(pow, r3.Sub(ch.center, c.center))
+		for _, s := range t.shift {
+			ch.local[s.i] += s.coef * c.local[s.k] * pow[s.diff]
+		}
+		f.downward(ch)
+	}
+}
+
+// taylor3 holds the multi-index tables for Cartesian
+// Taylor expansions in three dimensions.
+type taylor3 struct {
+	// index is the set of multi-indices n
+	// with |n| ≤ order, sorted by |n|.
+	index [][3]int
+	// pos maps a multi-index to its position
+	// in index, or -1 if |n| > order.
+	pos [][][]int
+
+	// shift holds the terms of multipole
+	// and local expansion shifts.
+	shift []shiftTerm
+	// translate holds the terms of the
+	// multipole to local translation.
+	translate []translateTerm
+	// gradient holds the terms of the
+	// gradient of a local expansion.
+	gradient []gradientTerm
+	// lower holds, for each multi-index n
+	// with |n| > 0, the positions of n-e_i
+	// and n-2e_i, or -1 if they do not exist.
+	lower [][3][2]int
+}
+
+// shiftTerm is the contribution C(k, i) s^(k-i)
+// between multi-indices i ≤ k.
+type shiftTerm struct {
+	k, i, diff int
+	coef       float64
+}
+
+// translateTerm is the contribution (-1)^|l| C(k+l, k) b_(k+l)
+// of multipole term k to local term l. The sign field holds
+// (-1)^|k+l|.
+type translateTerm struct {
+	l, k, sum  int
+	coef, sign float64
+}
+
+// gradientTerm is the contribution n_dim w^(n-e_dim)
+// of local term l to the gradient in dimension dim.
+type gradientTerm struct {
+	l, lower, dim int
+	coef          float64
+}
+
+// newTaylor3 returns the tables for expansions of the given order.
+func newTaylor3(order int) taylor3 {
+	var t taylor3
+	t.pos = make([][][]int, order+1)
+	for a := range t.pos {
+		t.pos[a] = make([][]int, order+1)
+		for b := range t.pos[a] {
+			t.pos[a][b] = make([]int, order+1)
+			for c := range t.pos[a][b] {
+				t.pos[a][b][c] = -1
+			}
+		}
+	}
+	for n := 0; n <= order; n++ {
+		for a := n; a >= 0; a-- {
+			for b := n - a; b >= 0; b-- {
+				t.pos[a][b][n-a-b] = len(t.index)
+				t.index = append(t.index, [3]int{a, b, n - a - b})
+			}
+		}
+	}
+
+	t.lower = make([][3][2]int, len(t.index))
+	for i, n := range t.index {
+		for dim, e := range n {
+			t.lower[i][dim] = [2]int{-1, -1}
+			if e > 0 {
+				m := n
+				m[dim]--
+				t.lower[i][dim][0] = t.at(m)
+			}
+			if e > 1 {
+				m := n
+				m[dim] -= 2
+				t.lower[i][dim][1] = t.at(m)
+			}
+		}
+	}
+
+	binom := binomials(order)
+	choose := func(n, k [3]int) float64 {
+		return binom[n[0]][k[0]] * binom[n[1]][k[1]] * binom[n[2]][k[2]]
+	}
+	for k, nk := range t.index {
+		for i, ni := range t.index {
+			if ni[0] > nk[0] || ni[1] > nk[1] || ni[2] > nk[2] {
+				continue
+			}
+			diff := [3]int{nk[0] - ni[0], nk[1] - ni[1], nk[2] - ni[2]}
+			t.shift = append(t.shift, shiftTerm{k: k, i: i, diff: t.at(diff), coef: choose(nk, ni)})
+		}
+	}
+	for l, nl := range t.index {
+		sign := 1.0
+		if (nl[0]+nl[1]+nl[2])%2 != 0 {
+			sign = -1
+		}
+		for k, nk := range t.index {
+			sum := [3]int{nk[0] + nl[0], nk[1] + nl[1], nk[2] + nl[2]}
+			if sum[0]+sum[1]+sum[2] > order {
+				break
+			}
+			parity := 1.0
+			if (sum[0]+sum[1]+sum[2])%2 != 0 {
+				parity = -1
+			}
+			t.translate = append(t.translate, translateTerm{l: l, k: k, sum: t.at(sum), coef: sign * choose(sum, nk), sign: parity})
+		}
+		for dim, v := range nl {
+			if v == 0 {
+				continue
+			}
+			lower := nl
+			lower[dim]--
+			t.gradient = append(t.gradient, gradientTerm{l: l, lower: t.at(lower), dim: dim, coef: float64(v)})
+		}
+	}
+	return t
+}
+
+// at returns the position of the multi-index n.
+func (t *taylor3) at(n [3]int) int {
+	return t.pos[n[0]][n[1]][n[2]]
+}
+
+// monomials fills dst with the monomials v^n for each multi-index n.
+func (t *taylor3) monomials(dst []float64, v r3.Vec) {
+	dst[0] = 1
+	x := [3]float64{v.X, v.Y, v.Z}
+	for i := 1; i < len(t.index); i++ {
+		for dim, l := range t.lower[i] {
+			if l[0] >= 0 {
+				dst[i] = dst[l[0]] * x[dim]
+				break
+			}
+		}
+	}
+}
+
+// derivatives fills dst with the scaled derivatives b_n = (-1)^|n| D^n(1/‖v‖)/n!
+// for each multi-index n.
+func (t *taylor3) derivatives(dst []float64, v r3.Vec) {
+	x := [3]float64{v.X, v.Y, v.Z}
+	r2 := r3.Norm2(v)
+	dst[0] = 1 / math.Sqrt(r2)
+	for i := 1; i < len(t.index); i++ {
+		n := t.index[i]
+		m := float64(n[0] + n[1] + n[2])
+		var s1, s2 float64
+		for dim, l := range t.lower[i] {
+			if l[0] >= 0 {
+				s1 += x[dim] * dst[l[0]]
+			}
+			if l[1] >= 0 {
+				s2 += dst[l[1]]
+			}
+		}
+		dst[i] = ((2-1/m)*s1 - (1-1/m)*s2) / r2
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package barneshut
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/spatial/r3"
+)
+
+// directFMM3 returns the forces and potentials of the particles
+// calculated by direct summation.
+func directFMM3(particles []Particle3) ([]r3.Vec, []float64) {
+	forces := make([]r3.Vec, len(particles))
+	potentials := make([]float64, len(particles))
+	for i, p := range particles {
+		for _, e := range particles {
+			v := r3.Sub(e.Coord3(), p.Coord3())
+			d2 := r3.Norm2(v)
+			if d2 == 0 {
+				continue
+			}
+			d := math.Sqrt(d2)
+			potentials[i] += e.Mass() / d
+			forces[i] = r3.Add(forces[i], r3.Scale(p.Mass()*e.Mass()/(d2*d), v))
+		}
+	}
+	return forces, potentials
+}
+
+func TestFMM3(t *testing.T) {
+	t.Parallel()
+	for _, n := range []int{0, 1, 2, 10, 1000, 5000} {
+		rnd := rand.New(rand.NewPCG(1, 1))
+		particles := make([]Particle3, n)
+		for i := range particles {
+			particles[i] = particle3{x: 1000 * rnd.Float64(), y: 1000 * rnd.Float64(), z: 1000 * rnd.Float64(), m: 1 + rnd.Float64()}
+		}
+		wantF, wantP := directFMM3(particles)
+		var forceNorm float64
+		for _, f := range wantF {
+			forceNorm += r3.Norm2(f)
+		}
+		forceNorm = math.Sqrt(forceNorm)
+
+		prev := math.Inf(1)
+		for _, order := range []int{2, 4, 6, 8} {
+			fmm := NewFMM3(particles, order)
+			gotF := make([]r3.Vec, n)
+			gotP := make([]float64, n)
+			fmm.Evaluate(gotF, gotP)
+
+			var ssd, maxPot float64
+			for i := range gotF {
+				ssd += r3.Norm2(r3.Sub(gotF[i], wantF[i]))
+				maxPot = math.Max(maxPot, math.Abs(gotP[i]-wantP[i])/math.Max(1, math.Abs(wantP[i])))
+			}
+			relErr := math.Sqrt(ssd) / math.Max(forceNorm, 1)
+			tol := math.Max(math.Pow(2, -float64(order)), 1e-12)
+			t.Logf("%d-body order=%d: force error=%.3g potential error=%.3g", n, order, relErr, maxPot)
+			if relErr > tol {
+				t.Errorf("unexpected force error for %d-body order=%d: got:%v want<=%v", n, order, relErr, tol)
+			}
+			if maxPot > tol {
+				t.Errorf("unexpected potential error for %d-body order=%d: got:%v want<=%v", n, order, maxPot, tol)
+			}
+			if n >= 1000 && relErr > prev {
+				t.Errorf("force error did not decrease with order for %d-body order=%d: %v > %v", n, order, relErr, prev)
+			}
+			prev = relErr
+		}
+	}
+}
+
+func TestFMM3Coincident(t *testing.T) {
+	t.Parallel()
+	particles := make([]Particle3, 100)
+	for i := range particles {
+		particles[i] = particle3{x: 1, y: 2, z: 3, m: 1}
+	}
+	particles = append(particles, particle3{x: 3, y: 2, z: 3, m: 2})
+	wantF, wantP := directFMM3(particles)
+	gotF := make([]r3.Vec, len(particles))
+	gotP := make([]float64, len(particles))
+	NewFMM3(particles, 8).Evaluate(gotF, gotP)
+	for i := range particles {
+		// Coincident particles may be approximated
+		// as a single well separated cell.
+		const tol = 1.0 / (1 << 8)
+		if r3.Norm(r3.Sub(gotF[i], wantF[i])) > tol*r3.Norm(wantF[i]) || math.Abs(gotP[i]-wantP[i]) > tol*math.Abs(wantP[i]) {
+			t.Errorf("unexpected result for particle %d: got:%v %v want:%v %v", i, gotF[i], gotP[i], wantF[i], wantP[i])
+		}
+	}
+}
+
+var fmm3Sink []r3.Vec
+
+func BenchmarkFMM3(b *testing.B) {
+	for _, n := range []int{1e3, 1e4, 1e5} {
+		rnd := rand.New(rand.NewPCG(1, 1))
+		particles := make([]Particle3, n)
+		for i := range particles {
+			particles[i] = particle3{x: rnd.Float64(), y: rnd.Float64(), z: rnd.Float64(), m: 1}
+		}
+		fmm3Sink = make([]r3.Vec, n)
+		for _, order := range []int{2, 4, 8} {
+			b.Run(fmt.Sprintf("%d-body/order=%d", n, order), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					NewFMM3(particles, order).Evaluate(fmm3Sink, nil)
+				}
+			})
+		}
+	}
+}
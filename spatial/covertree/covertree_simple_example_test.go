@@ -0,0 +1,34 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package covertree_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/spatial/covertree"
+)
+
+func ExampleTree() {
+	// Example data from https://en.wikipedia.org/wiki/K-d_tree
+	points := []covertree.Comparable{
+		covertree.Point{2, 3},
+		covertree.Point{5, 4},
+		covertree.Point{9, 6},
+		covertree.Point{4, 7},
+		covertree.Point{8, 1},
+		covertree.Point{7, 2},
+	}
+
+	t, err := covertree.New(points)
+	if err != nil {
+		log.Fatal(err)
+	}
+	q := covertree.Point{8, 7}
+	p, d := t.Nearest(q)
+	fmt.Printf("%v is closest point to %v, d=%f\n", p, q, d)
+	// Output:
+	// [9 6] is closest point to [8 7], d=1.414214
+}
@@ -0,0 +1,327 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package covertree
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/internal/order"
+)
+
+var (
+	// Using example from WP article: https://en.wikipedia.org/w/index.php?title=K-d_tree&oldid=887573572.
+	wpData = []Comparable{
+		Point{2, 3},
+		Point{5, 4},
+		Point{9, 6},
+		Point{4, 7},
+		Point{8, 1},
+		Point{7, 2},
+	}
+)
+
+func randomPoints(n, dims int, rnd *rand.Rand) []Comparable {
+	p := make([]Comparable, n)
+	for i := range p {
+		v := make(Point, dims)
+		for j := range v {
+			v[j] = 1000 * rnd.Float64()
+		}
+		p[i] = v
+	}
+	return p
+}
+
+var newTests = []struct {
+	name string
+	data []Comparable
+}{
+	{name: "wp", data: wpData},
+	{name: "duplicates", data: []Comparable{Point{2, 3}, Point{5, 4}, Point{9, 6}, Point{5, 4}, Point{8, 1}, Point{7, 2}}},
+	{name: "coincident", data: []Comparable{Point{1, 1}, Point{1, 1}, Point{1, 1}}},
+	{name: "growing", data: []Comparable{Point{0}, Point{1}, Point{10}, Point{100}, Point{1e4}, Point{1e8}}},
+	{name: "random 3", data: randomPoints(1000, 3, rand.New(rand.NewPCG(1, 1)))},
+	{name: "random 30", data: randomPoints(1000, 30, rand.New(rand.NewPCG(1, 1)))},
+}
+
+func TestNew(t *testing.T) {
+	for _, test := range newTests {
+		tree, err := New(test.data)
+		if err != nil {
+			t.Errorf("unexpected error for test %s: %v", test.name, err)
+			continue
+		}
+		if tree.Len() != len(test.data) {
+			t.Errorf("unexpected tree size for test %s: got:%d want:%d", test.name, tree.Len(), len(test.data))
+		}
+		if n, ok := tree.Root.isCoverTree(); !ok {
+			t.Errorf("tree %s is not cover tree", test.name)
+		} else if n != len(test.data) {
+			t.Errorf("unexpected number of values in tree %s: got:%d want:%d", test.name, n, len(test.data))
+		}
+	}
+}
+
+// isCoverTree returns the number of values below n and whether
+// the leveling and covering invariants hold and MaxDist is an
+// upper bound on descendant distances.
+func (n *Node) isCoverTree() (int, bool) {
+	count := 1
+	for _, c := range n.Children {
+		if c.Level != n.Level-1 {
+			return 0, false
+		}
+		if n.Point.Distance(c.Point) > n.covDist() {
+			return 0, false
+		}
+		ok := !c.do(func(p Comparable, _ int) bool {
+			return n.Point.Distance(p) > n.MaxDist
+		}, 0)
+		if !ok {
+			return 0, false
+		}
+		m, ok := c.isCoverTree()
+		if !ok {
+			return 0, false
+		}
+		count += m
+	}
+	return count, true
+}
+
+func TestInsertPointAtInfinity(t *testing.T) {
+	data := []Comparable{Point{0, 0}, Point{1, 1}, Point{math.Inf(1), 0}}
+	tree, err := New(data)
+	if err != pointAtInfinity {
+		t.Errorf("unexpected error: got:%v want:%v", err, pointAtInfinity)
+	}
+	if tree != nil {
+		t.Error("unexpected non-nil tree")
+	}
+}
+
+func TestNewEmpty(t *testing.T) {
+	tree, err := New(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p, d := tree.Nearest(Point{0, 0})
+	if p != nil || !math.IsInf(d, 1) {
+		t.Errorf("unexpected result for empty tree: got:%v %v", p, d)
+	}
+	k := NewNKeeper(2)
+	tree.NearestSet(k, Point{0, 0})
+	if len(k.Heap) != 1 {
+		t.Errorf("unexpected result for empty tree: got:%v", k.Heap)
+	}
+}
+
+func nearest(q Comparable, p []Comparable) (Comparable, float64) {
+	min := q.Distance(p[0])
+	var r int
+	for i := 1; i < len(p); i++ {
+		d := q.Distance(p[i])
+		if d < min {
+			min = d
+			r = i
+		}
+	}
+	return p[r], min
+}
+
+func TestNearestRandom(t *testing.T) {
+	for _, dims := range []int{4, 32} {
+		rnd := rand.New(rand.NewPCG(1, 1))
+
+		const setSize = 5000
+		randData := randomPoints(setSize, dims, rnd)
+		tree, err := New(randData)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		for i := 0; i < 1000; i++ {
+			q := randomPoints(1, dims, rnd)[0]
+			got, gotD := tree.Nearest(q)
+			want, wantD := nearest(q, randData)
+			if !reflect.DeepEqual(got, want) || gotD != wantD {
+				t.Fatalf("unexpected result from query %d for dims=%d: got:%.3f d=%v want:%.3f d=%v", i, dims, got, gotD, want, wantD)
+			}
+		}
+	}
+}
+
+func TestNearest(t *testing.T) {
+	tree, err := New(wpData)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, q := range append([]Comparable{
+		Point{4, 6},
+		Point{8, 7},
+		Point{6, -5},
+		Point{1e5, 1e5},
+		Point{1e5, -1e5},
+		Point{-1e5, 1e5},
+		Point{-1e5, -1e5},
+		Point{1e5, 0},
+		Point{0, -1e5},
+		Point{0, 1e5},
+		Point{-1e5, 0},
+	}, wpData...) {
+		gotP, gotD := tree.Nearest(q)
+		wantP, wantD := nearest(q, wpData)
+		if !reflect.DeepEqual(gotP, wantP) {
+			t.Errorf("unexpected result for query %.3f: got:%.3f want:%.3f", q, gotP, wantP)
+		}
+		if gotD != wantD {
+			t.Errorf("unexpected distance for query %.3f: got:%v want:%v", q, gotD, wantD)
+		}
+	}
+}
+
+func nearestN(n int, q Comparable, p []Comparable) []ComparableDist {
+	nk := NewNKeeper(n)
+	for i := 0; i < len(p); i++ {
+		nk.Keep(ComparableDist{Comparable: p[i], Dist: q.Distance(p[i])})
+	}
+	if len(nk.Heap) == 1 {
+		return nk.Heap
+	}
+	sort.Sort(nk)
+	slices.Reverse(nk.Heap)
+	return nk.Heap
+}
+
+func TestNearestSetN(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const dims = 24
+	data := randomPoints(2000, dims, rnd)
+	tree, err := New(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, k := range []int{1, 2, 10, 50} {
+		for i := 0; i < 100; i++ {
+			q := randomPoints(1, dims, rnd)[0]
+			want := nearestN(min(k, len(data)), q, data)
+
+			nk := NewNKeeper(k)
+			tree.NearestSet(nk, q)
+			if len(nk.Heap) != len(want) {
+				t.Fatalf("unexpected number of results for k=%d query %d: got:%d want:%d", k, i, len(nk.Heap), len(want))
+			}
+			for j := range want {
+				if nk.Heap[j].Dist != want[j].Dist {
+					t.Errorf("unexpected distance for result %d for k=%d query %d: got:%v want:%v", j, k, i, nk.Heap[j].Dist, want[j].Dist)
+				}
+			}
+		}
+	}
+}
+
+var nearestSetDistTests = []Point{
+	{4, 6},
+	{7, 5},
+	{8, 7},
+	{6, -5},
+}
+
+func TestNearestSetDist(t *testing.T) {
+	tree, err := New(wpData)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, q := range nearestSetDistTests {
+		for d := 1.0; d < 100; d += 0.1 {
+			dk := NewDistKeeper(d)
+			tree.NearestSet(dk, q)
+
+			hits := make(map[string]float64)
+			for _, p := range wpData {
+				hits[fmt.Sprint(p)] = p.Distance(q)
+			}
+
+			for _, p := range dk.Heap {
+				if p.Comparable == nil {
+					t.Errorf("Test %d: unexpected sentinel in result", i)
+					continue
+				}
+				delete(hits, fmt.Sprint(p.Comparable))
+				dist := p.Comparable.Distance(q)
+				if dist > d {
+					t.Errorf("Test %d: query %v found %v expect %.3f <= %.3f", i, q, p, dist, d)
+				}
+			}
+
+			for p, dist := range hits {
+				if dist <= d {
+					t.Errorf("Test %d: query %v missed %v expect %.3f > %.3f", i, q, p, dist, d)
+				}
+			}
+		}
+	}
+}
+
+func TestDo(t *testing.T) {
+	tree, err := New(wpData)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var got []Point
+	fn := func(c Comparable, _ int) (done bool) {
+		got = append(got, c.(Point))
+		return
+	}
+	killed := tree.Do(fn)
+
+	want := make([]Point, len(wpData))
+	for i, p := range wpData {
+		want[i] = p.(Point)
+	}
+	order.BySliceValues(got)
+	order.BySliceValues(want)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected result from tree iteration: got:%v want:%v", got, want)
+	}
+	if killed {
+		t.Error("tree iteration unexpectedly killed")
+	}
+}
+
+func Benchmark(b *testing.B) {
+	for _, dims := range []int{3, 32} {
+		rnd := rand.New(rand.NewPCG(1, 1))
+		data := randomPoints(1e4, dims, rnd)
+		tree, err := New(data)
+		if err != nil {
+			b.Fatalf("unexpected error: %v", err)
+		}
+		var r Comparable
+		b.Run(fmt.Sprintf("NearestBrute:%d", dims), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				r, _ = nearest(randomPoints(1, dims, rnd)[0], data)
+			}
+			if r == nil {
+				b.Error("unexpected nil result")
+			}
+		})
+		b.Run(fmt.Sprintf("Nearest:%d", dims), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				r, _ = tree.Nearest(randomPoints(1, dims, rnd)[0])
+			}
+			if r == nil {
+				b.Error("unexpected nil result")
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package covertree implements a cover tree. Cover trees provide an
+// efficient search for nearest neighbors in a metric space with a
+// cost that depends on the intrinsic dimension of the data rather than
+// the dimension of the space it is embedded in.
+//
+// See "Cover trees for nearest neighbor", ICML'06 97-104
+// doi:10.1145/1143844.1143857 and "Faster cover trees", ICML'15
+// 1162-1170 for details of cover trees.
+package covertree // import "gonum.org/v1/gonum/spatial/covertree"
@@ -0,0 +1,12 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package hnsw implements a hierarchical navigable small world graph
+// index for approximate nearest neighbor search of float64 vectors.
+//
+// See "Efficient and robust approximate nearest neighbor search using
+// Hierarchical Navigable Small World graphs", IEEE Transactions on Pattern
+// Analysis and Machine Intelligence 42(4):824-836.
+// doi:10.1109/TPAMI.2018.2889473 for details of HNSW graphs.
+package hnsw // import "gonum.org/v1/gonum/spatial/hnsw"
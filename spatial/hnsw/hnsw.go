@@ -0,0 +1,365 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hnsw
+
+import (
+	"container/heap"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"sync"
+)
+
+// Index is a hierarchical navigable small world graph index over
+// float64 vectors. Vectors are identified by the order in which they
+// were inserted, starting from zero.
+//
+// Search may be called concurrently, but Insert must not be called
+// concurrently with any other method.
+type Index struct {
+	dims           int
+	m, m0          int
+	efConstruction int
+	levelMult      float64
+
+	dist func(a, b []float64) float64
+	sqrt bool
+
+	rnd func() float64
+
+	vecs  [][]float64
+	links [][][]int
+
+	entry    int
+	maxLevel int
+
+	visited sync.Pool
+}
+
+// New returns a new empty Index for vectors with the given number of
+// dimensions.
+//
+// The m parameter is the number of neighbors each vector is linked to
+// in each layer of the graph above the bottom layer, where 2×m neighbors
+// are linked. Larger values of m improve recall for high dimensional data
+// at the cost of memory and insertion time. Values between 8 and 48 are
+// typical.
+//
+// The efConstruction parameter is the size of the candidate list used
+// when linking inserted vectors. Larger values improve the quality of
+// the graph at the cost of insertion time. If efConstruction is less
+// than m, m is used.
+//
+// The dist parameter is the distance function used to compare vectors.
+// If dist is nil, the Euclidean distance is used. The src parameter
+// provides the source of randomness for level assignment. If src is nil
+// global rand package functions are used.
+//
+// New will panic if dims or m is less than one.
+func New(dims, m, efConstruction int, dist func(a, b []float64) float64, src rand.Source) *Index {
+	if dims < 1 {
+		panic("hnsw: invalid dimension")
+	}
+	if m < 1 {
+		panic("hnsw: invalid number of links")
+	}
+	var rnd func() float64
+	if src == nil {
+		rnd = rand.Float64
+	} else {
+		rnd = rand.New(src).Float64
+	}
+	idx := &Index{
+		dims:           dims,
+		m:              m,
+		m0:             2 * m,
+		efConstruction: max(efConstruction, m),
+		levelMult:      1 / math.Log(float64(max(m, 2))),
+		dist:           dist,
+		rnd:            rnd,
+		entry:          -1,
+	}
+	if idx.dist == nil {
+		// Squared Euclidean distance orders vectors in
+		// the same way as the Euclidean distance, so use
+		// it internally and correct returned distances.
+		idx.dist = sqDist
+		idx.sqrt = true
+	}
+	idx.visited.New = func() any { return &visitedSet{} }
+	return idx
+}
+
+func sqDist(a, b []float64) float64 {
+	var sum float64
+	for i, v := range a {
+		d := v - b[i]
+		sum += d * d
+	}
+	return sum
+}
+
+// Dims returns the number of dimensions of the vectors in the index.
+func (idx *Index) Dims() int { return idx.dims }
+
+// Len returns the number of vectors in the index.
+func (idx *Index) Len() int { return len(idx.vecs) }
+
+// Vector returns the vector with the given ID. The returned slice
+// must not be modified.
+func (idx *Index) Vector(id int) []float64 { return idx.vecs[id] }
+
+// Insert adds a copy of v to the index and returns its ID. Insert will
+// panic if the length of v does not match the dimensions of the index.
+func (idx *Index) Insert(v []float64) int {
+	if len(v) != idx.dims {
+		panic("hnsw: dimension mismatch")
+	}
+	id := len(idx.vecs)
+	idx.vecs = append(idx.vecs, slices.Clone(v))
+	level := int(-math.Log(1-idx.rnd()) * idx.levelMult)
+	idx.links = append(idx.links, make([][]int, level+1))
+	if idx.entry < 0 {
+		idx.entry = id
+		idx.maxLevel = level
+		return id
+	}
+	v = idx.vecs[id]
+
+	ep := candidate{id: idx.entry, dist: idx.dist(v, idx.vecs[idx.entry])}
+	for l := idx.maxLevel; l > level; l-- {
+		ep = idx.greedy(v, ep, l)
+	}
+	eps := []candidate{ep}
+	for l := min(level, idx.maxLevel); l >= 0; l-- {
+		w := idx.searchLayer(v, eps, idx.efConstruction, l)
+		neighbors := idx.selectNeighbors(w, idx.m)
+		idx.links[id][l] = ids(neighbors)
+
+		maxLinks := idx.maxLinks(l)
+		for _, n := range neighbors {
+			links := append(idx.links[n.id][l], id)
+			if len(links) > maxLinks {
+				// Shrink the neighbor's connections.
+				u := idx.vecs[n.id]
+				c := make([]candidate, len(links))
+				for i, e := range links {
+					c[i] = candidate{id: e, dist: idx.dist(u, idx.vecs[e])}
+				}
+				slices.SortFunc(c, byDist)
+				links = ids(idx.selectNeighbors(c, maxLinks))
+			}
+			idx.links[n.id][l] = links
+		}
+		eps = w
+	}
+	if level > idx.maxLevel {
+		idx.entry = id
+		idx.maxLevel = level
+	}
+	return id
+}
+
+// maxLinks returns the maximum number of links
+// for each vector in layer l.
+func (idx *Index) maxLinks(l int) int {
+	if l == 0 {
+		return idx.m0
+	}
+	return idx.m
+}
+
+// Neighbor is a vector ID and its distance from a query.
+type Neighbor struct {
+	ID   int
+	Dist float64
+}
+
+// Search returns the approximate k nearest neighbors of q in the index
+// sorted by ascending distance. The ef parameter is the size of the
+// candidate list used during the search; larger values improve recall
+// at the cost of search time. If ef is less than k, k is used. Search
+// will panic if the length of q does not match the dimensions of the
+// index.
+func (idx *Index) Search(q []float64, k, ef int) []Neighbor {
+	if len(q) != idx.dims {
+		panic("hnsw: dimension mismatch")
+	}
+	if idx.entry < 0 || k < 1 {
+		return nil
+	}
+	ep := candidate{id: idx.entry, dist: idx.dist(q, idx.vecs[idx.entry])}
+	for l := idx.maxLevel; l > 0; l-- {
+		ep = idx.greedy(q, ep, l)
+	}
+	w := idx.searchLayer(q, []candidate{ep}, max(ef, k), 0)
+	w = w[:min(k, len(w))]
+	res := make([]Neighbor, len(w))
+	for i, c := range w {
+		d := c.dist
+		if idx.sqrt {
+			d = math.Sqrt(d)
+		}
+		res[i] = Neighbor{ID: c.id, Dist: d}
+	}
+	return res
+}
+
+// greedy returns the closest vector to q found by greedy descent
+// from ep in layer l.
+func (idx *Index) greedy(q []float64, ep candidate, l int) candidate {
+	for changed := true; changed; {
+		changed = false
+		for _, e := range idx.links[ep.id][l] {
+			if d := idx.dist(q, idx.vecs[e]); d < ep.dist {
+				ep = candidate{id: e, dist: d}
+				changed = true
+			}
+		}
+	}
+	return ep
+}
+
+// searchLayer returns the ef closest vectors to q found by a best-first
+// search from eps in layer l, sorted by ascending distance.
+func (idx *Index) searchLayer(q []float64, eps []candidate, ef, l int) []candidate {
+	visited := idx.visited.Get().(*visitedSet)
+	defer idx.visited.Put(visited)
+	visited.reset(len(idx.vecs))
+
+	cand := make(minHeap, 0, ef)
+	res := make(maxHeap, 0, ef+1)
+	for _, e := range eps {
+		visited.add(e.id)
+		heap.Push(&cand, e)
+		heap.Push(&res, e)
+		if len(res) > ef {
+			heap.Pop(&res)
+		}
+	}
+	for len(cand) != 0 {
+		c := heap.Pop(&cand).(candidate)
+		if c.dist > res[0].dist && len(res) >= ef {
+			break
+		}
+		for _, e := range idx.links[c.id][l] {
+			if visited.has(e) {
+				continue
+			}
+			visited.add(e)
+			d := idx.dist(q, idx.vecs[e])
+			if len(res) < ef || d < res[0].dist {
+				heap.Push(&cand, candidate{id: e, dist: d})
+				heap.Push(&res, candidate{id: e, dist: d})
+				if len(res) > ef {
+					heap.Pop(&res)
+				}
+			}
+		}
+	}
+	w := []candidate(res)
+	slices.SortFunc(w, byDist)
+	return w
+}
+
+// selectNeighbors returns up to m candidates from c, which must be
+// sorted by ascending distance, using the neighbor selection heuristic
+// of the HNSW paper. A candidate is selected only if it is closer to
+// the base vector than to any already selected candidate, which keeps
+// links to distinct regions of the space.
+func (idx *Index) selectNeighbors(c []candidate, m int) []candidate {
+	if len(c) <= m {
+		return slices.Clone(c)
+	}
+	sel := make([]candidate, 0, m)
+	for _, e := range c {
+		if len(sel) == m {
+			break
+		}
+		ok := true
+		for _, s := range sel {
+			if idx.dist(idx.vecs[e.id], idx.vecs[s.id]) < e.dist {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			sel = append(sel, e)
+		}
+	}
+	return sel
+}
+
+type candidate struct {
+	id   int
+	dist float64
+}
+
+func byDist(a, b candidate) int {
+	switch {
+	case a.dist < b.dist:
+		return -1
+	case a.dist > b.dist:
+		return 1
+	}
+	return a.id - b.id
+}
+
+func ids(c []candidate) []int {
+	id := make([]int, len(c))
+	for i, e := range c {
+		id[i] = e.id
+	}
+	return id
+}
+
+type minHeap []candidate
+
+func (h minHeap) Len() int            { return len(h) }
+func (h minHeap) Less(i, j int) bool  { return h[i].dist < h[j].dist }
+func (h minHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *minHeap) Push(x interface{}) { *h = append(*h, x.(candidate)) }
+func (h *minHeap) Pop() interface{} {
+	old := *h
+	n := len(old) - 1
+	x := old[n]
+	*h = old[:n]
+	return x
+}
+
+type maxHeap []candidate
+
+func (h maxHeap) Len() int            { return len(h) }
+func (h maxHeap) Less(i, j int) bool  { return h[i].dist > h[j].dist }
+func (h maxHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *maxHeap) Push(x interface{}) { *h = append(*h, x.(candidate)) }
+func (h *maxHeap) Pop() interface{} {
+	old := *h
+	n := len(old) - 1
+	x := old[n]
+	*h = old[:n]
+	return x
+}
+
+// visitedSet is a set of vector IDs that can be reset
+// in constant time by advancing its epoch.
+type visitedSet struct {
+	epoch uint32
+	mark  []uint32
+}
+
+func (s *visitedSet) reset(n int) {
+	if len(s.mark) < n {
+		s.mark = append(s.mark, make([]uint32, n-len(s.mark))...)
+	}
+	s.epoch++
+	if s.epoch == 0 {
+		clear(s.mark)
+		s.epoch = 1
+	}
+}
+
+func (s *visitedSet) add(id int)      { s.mark[id] = s.epoch }
+func (s *visitedSet) has(id int) bool { return s.mark[id] == s.epoch }
@@ -0,0 +1,223 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hnsw
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func randomVectors(n, dims int, rnd *rand.Rand) [][]float64 {
+	v := make([][]float64, n)
+	for i := range v {
+		v[i] = make([]float64, dims)
+		for j := range v[i] {
+			v[i][j] = rnd.NormFloat64()
+		}
+	}
+	return v
+}
+
+func exactKNN(data [][]float64, q []float64, k int, dist func(a, b []float64) float64) []Neighbor {
+	all := make([]Neighbor, len(data))
+	for i, v := range data {
+		all[i] = Neighbor{ID: i, Dist: dist(q, v)}
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Dist < all[j].Dist })
+	return all[:min(k, len(all))]
+}
+
+func euclidean(a, b []float64) float64 { return floats.Distance(a, b, 2) }
+
+func recall(got, want []Neighbor) float64 {
+	ids := make(map[int]bool, len(want))
+	for _, n := range want {
+		ids[n.ID] = true
+	}
+	var hits int
+	for _, n := range got {
+		if ids[n.ID] {
+			hits++
+		}
+	}
+	return float64(hits) / float64(len(want))
+}
+
+func TestSearchSmall(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	data := randomVectors(50, 3, rnd)
+	idx := New(3, 8, 100, nil, rand.NewPCG(1, 1))
+	for i, v := range data {
+		id := idx.Insert(v)
+		if id != i {
+			t.Fatalf("unexpected ID: got:%d want:%d", id, i)
+		}
+	}
+	if idx.Len() != len(data) {
+		t.Errorf("unexpected length: got:%d want:%d", idx.Len(), len(data))
+	}
+	// The index is small enough relative to the
+	// search width that the search is exact.
+	for i := 0; i < 50; i++ {
+		q := randomVectors(1, 3, rnd)[0]
+		got := idx.Search(q, 5, 100)
+		want := exactKNN(data, q, 5, euclidean)
+		if len(got) != len(want) {
+			t.Fatalf("unexpected number of results: got:%d want:%d", len(got), len(want))
+		}
+		for j := range want {
+			if got[j].ID != want[j].ID || !scalar.EqualWithinAbsOrRel(got[j].Dist, want[j].Dist, 1e-12, 1e-12) {
+				t.Errorf("unexpected result %d for query %d: got:%v want:%v", j, i, got[j], want[j])
+			}
+		}
+	}
+}
+
+func TestSearchRecall(t *testing.T) {
+	const (
+		n    = 5000
+		dims = 16
+		k    = 10
+	)
+	rnd := rand.New(rand.NewPCG(1, 1))
+	data := randomVectors(n, dims, rnd)
+	for _, test := range []struct {
+		name string
+		dist func(a, b []float64) float64
+	}{
+		{name: "euclidean"},
+		{name: "manhattan", dist: func(a, b []float64) float64 { return floats.Distance(a, b, 1) }},
+	} {
+		idx := New(dims, 16, 200, test.dist, rand.NewPCG(1, 1))
+		for _, v := range data {
+			idx.Insert(v)
+		}
+		dist := test.dist
+		if dist == nil {
+			dist = euclidean
+		}
+
+		var lastRecall float64
+		for _, ef := range []int{10, 50, 200} {
+			var sum float64
+			const queries = 100
+			for i := 0; i < queries; i++ {
+				q := randomVectors(1, dims, rnd)[0]
+				got := idx.Search(q, k, ef)
+				if !slices.IsSortedFunc(got, func(a, b Neighbor) int {
+					return int(math.Copysign(1, a.Dist-b.Dist))
+				}) {
+					t.Errorf("results not sorted for %s ef=%d", test.name, ef)
+				}
+				sum += recall(got, exactKNN(data, q, k, dist))
+			}
+			r := sum / queries
+			if r < lastRecall-0.02 {
+				t.Errorf("unexpected decrease in recall for %s with ef=%d: %.3f < %.3f", test.name, ef, r, lastRecall)
+			}
+			lastRecall = r
+		}
+		if lastRecall < 0.95 {
+			t.Errorf("unexpectedly low recall for %s: got:%.3f want>=0.95", test.name, lastRecall)
+		}
+	}
+}
+
+func TestSearchEmpty(t *testing.T) {
+	idx := New(2, 4, 0, nil, nil)
+	if got := idx.Search([]float64{0, 0}, 3, 10); got != nil {
+		t.Errorf("unexpected result for empty index: %v", got)
+	}
+	idx.Insert([]float64{1, 1})
+	got := idx.Search([]float64{0, 0}, 3, 10)
+	want := []Neighbor{{ID: 0, Dist: math.Sqrt2}}
+	if !slices.Equal(got, want) {
+		t.Errorf("unexpected result for single vector index: got:%v want:%v", got, want)
+	}
+}
+
+func TestInsertCopies(t *testing.T) {
+	idx := New(2, 4, 0, nil, nil)
+	v := []float64{1, 2}
+	id := idx.Insert(v)
+	v[0] = 10
+	if got := idx.Vector(id); !slices.Equal(got, []float64{1, 2}) {
+		t.Errorf("unexpected stored vector: got:%v want:[1 2]", got)
+	}
+}
+
+func TestDimensionMismatch(t *testing.T) {
+	idx := New(2, 4, 0, nil, nil)
+	for _, fn := range []func(){
+		func() { idx.Insert([]float64{1}) },
+		func() { idx.Search([]float64{1, 2, 3}, 1, 1) },
+	} {
+		panicked := func() (panicked bool) {
+			defer func() { panicked = recover() != nil }()
+			fn()
+			return false
+		}()
+		if !panicked {
+			t.Error("expected panic for dimension mismatch")
+		}
+	}
+}
+
+func BenchmarkInsert(b *testing.B) {
+	for _, m := range []int{8, 16, 32} {
+		b.Run(fmt.Sprintf("M=%d", m), func(b *testing.B) {
+			data := randomVectors(b.N, 32, rand.New(rand.NewPCG(1, 1)))
+			idx := New(32, m, 100, nil, rand.NewPCG(1, 1))
+			b.ResetTimer()
+			for _, v := range data {
+				idx.Insert(v)
+			}
+		})
+	}
+}
+
+// BenchmarkSearch reports the search latency and the mean
+// recall of the 10 nearest neighbors for a range of ef values.
+func BenchmarkSearch(b *testing.B) {
+	const (
+		n    = 20000
+		dims = 32
+		k    = 10
+	)
+	rnd := rand.New(rand.NewPCG(1, 1))
+	data := randomVectors(n, dims, rnd)
+	idx := New(dims, 16, 200, nil, rand.NewPCG(1, 1))
+	for _, v := range data {
+		idx.Insert(v)
+	}
+	queries := randomVectors(100, dims, rnd)
+	want := make([][]Neighbor, len(queries))
+	for i, q := range queries {
+		want[i] = exactKNN(data, q, k, euclidean)
+	}
+
+	b.Run("Brute", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			exactKNN(data, queries[i%len(queries)], k, euclidean)
+		}
+	})
+	for _, ef := range []int{10, 50, 100, 400} {
+		b.Run(fmt.Sprintf("ef=%d", ef), func(b *testing.B) {
+			var sum float64
+			for i := 0; i < b.N; i++ {
+				j := i % len(queries)
+				sum += recall(idx.Search(queries[j], k, ef), want[j])
+			}
+			b.ReportMetric(sum/float64(b.N), "recall")
+		})
+	}
+}
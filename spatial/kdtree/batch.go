@@ -0,0 +1,102 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kdtree
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// KNearestBatch returns the k nearest values in the tree to each of the
+// queries in q. The returned slice holds the results for q[i] in its ith
+// element, each sorted by increasing distance from the query. If the tree
+// holds fewer than k values, all values are returned for each query.
+//
+// The queries are performed concurrently using up to workers goroutines.
+// If workers is less than one, runtime.GOMAXPROCS(0) is used. The tree
+// must not be modified during the call to KNearestBatch.
+func (t *Tree) KNearestBatch(q []Comparable, k, workers int) [][]ComparableDist {
+	return batchQuery(q, workers, func(q Comparable) []ComparableDist {
+		return comparableDists(kNearest(t.Root, t.Count, q, k))
+	})
+}
+
+// NearestWithin returns the values in the tree that are within the distance
+// d of the query, q, sorted by increasing distance. The distance is measured
+// using the Distance method of the stored values, so for Point values d is a
+// squared Euclidean distance.
+func (t *Tree) NearestWithin(q Comparable, d float64) []ComparableDist {
+	return comparableDists(nearestWithin(t.Root, q, d))
+}
+
+// NearestWithinBatch returns the values in the tree that are within the
+// distance d of each of the queries in q. The returned slice holds the results
+// for q[i] in its ith element, each sorted by increasing distance from the
+// query. The distance is measured as described for NearestWithin.
+//
+// The queries are performed concurrently using up to workers goroutines.
+// If workers is less than one, runtime.GOMAXPROCS(0) is used. The tree
+// must not be modified during the call to NearestWithinBatch.
+func (t *Tree) NearestWithinBatch(q []Comparable, d float64, workers int) [][]ComparableDist {
+	return batchQuery(q, workers, func(q Comparable) []ComparableDist {
+		return t.NearestWithin(q, d)
+	})
+}
+
+// comparableDists returns the values in p as ComparableDist values.
+func comparableDists(p []PointDist[Comparable]) []ComparableDist {
+	if p == nil {
+		return nil
+	}
+	c := make([]ComparableDist, len(p))
+	for i, v := range p {
+		c[i] = ComparableDist{Comparable: v.Point, Dist: v.Dist}
+	}
+	return c
+}
+
+// batchQuery returns the results of calling query on each of the queries
+// in q, using up to workers goroutines as described for batch.
+func batchQuery[Q, R any](q []Q, workers int, query func(Q) R) []R {
+	res := make([]R, len(q))
+	batch(len(q), workers, func(i int) {
+		res[i] = query(q[i])
+	})
+	return res
+}
+
+// batch calls fn for each index in [0, n) using up to workers goroutines.
+// If workers is less than one, runtime.GOMAXPROCS(0) is used.
+func batch(n, workers int, fn func(i int)) {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, n)
+	if workers <= 1 {
+		for i := 0; i < n; i++ {
+			fn(i)
+		}
+		return
+	}
+	var (
+		next atomic.Int64
+		wg   sync.WaitGroup
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= n {
+					return
+				}
+				fn(i)
+			}
+		}()
+	}
+	wg.Wait()
+}
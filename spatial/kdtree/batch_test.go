@@ -0,0 +1,156 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kdtree
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+func randomPoints(rnd *rand.Rand, n, dims int) Points {
+	p := make(Points, n)
+	for i := range p {
+		p[i] = make(Point, dims)
+		for j := range p[i] {
+			p[i][j] = rnd.Float64()
+		}
+	}
+	return p
+}
+
+func asComparables(p Points) []Comparable {
+	c := make([]Comparable, len(p))
+	for i, v := range p {
+		c[i] = v
+	}
+	return c
+}
+
+func TestKNearestBatch(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	data := randomPoints(rnd, 1000, 3)
+	queries := randomPoints(rnd, 200, 3)
+	tree := New(append(Points(nil), data...), false)
+	for _, k := range []int{0, 1, 5, 2000} {
+		var ref [][]ComparableDist
+		for _, workers := range []int{0, 1, 3} {
+			got := tree.KNearestBatch(asComparables(queries), k, workers)
+			if len(got) != len(queries) {
+				t.Fatalf("unexpected number of results: got:%d want:%d", len(got), len(queries))
+			}
+			for i, q := range queries {
+				want := min(k, len(data))
+				if len(got[i]) != want {
+					t.Errorf("unexpected number of neighbors for query %d k=%d: got:%d want:%d", i, k, len(got[i]), want)
+					continue
+				}
+				if !sort.SliceIsSorted(got[i], func(a, b int) bool { return got[i][a].Dist < got[i][b].Dist }) {
+					t.Errorf("neighbors not sorted by distance for query %d k=%d", i, k)
+				}
+				if k == 0 {
+					continue
+				}
+				wantD := nearestN(want, q, data)
+				if got[i][len(got[i])-1].Dist != wantD[len(wantD)-1].Dist {
+					t.Errorf("unexpected furthest neighbor distance for query %d k=%d: got:%v want:%v",
+						i, k, got[i][len(got[i])-1].Dist, wantD[len(wantD)-1].Dist)
+				}
+			}
+			if ref == nil {
+				ref = got
+			} else if !reflect.DeepEqual(got, ref) {
+				t.Errorf("result depends on number of workers: k=%d workers=%d", k, workers)
+			}
+		}
+	}
+
+	var empty Tree
+	for _, r := range empty.KNearestBatch(asComparables(queries[:2]), 3, 0) {
+		if len(r) != 0 {
+			t.Errorf("unexpected result for empty tree: %v", r)
+		}
+	}
+}
+
+func TestNearestWithinBatch(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	data := randomPoints(rnd, 1000, 3)
+	queries := randomPoints(rnd, 200, 3)
+	tree := New(append(Points(nil), data...), false)
+	for _, d := range []float64{0, 0.001, 0.01, 0.1} {
+		got := tree.NearestWithinBatch(asComparables(queries), d, 4)
+		for i, q := range queries {
+			want := make(map[string]bool)
+			for _, p := range data {
+				if q.Distance(p) <= d {
+					want[fmt.Sprint(p)] = true
+				}
+			}
+			if len(got[i]) != len(want) {
+				t.Errorf("unexpected number of neighbors for query %d d=%v: got:%d want:%d", i, d, len(got[i]), len(want))
+			}
+			for j, p := range got[i] {
+				if !want[fmt.Sprint(p.Comparable)] {
+					t.Errorf("unexpected neighbor for query %d d=%v: %v", i, d, p.Comparable)
+				}
+				if p.Dist != q.Distance(p.Comparable) {
+					t.Errorf("unexpected distance for query %d d=%v: got:%v want:%v", i, d, p.Dist, q.Distance(p.Comparable))
+				}
+				if j != 0 && got[i][j-1].Dist > p.Dist {
+					t.Errorf("neighbors not sorted by distance for query %d d=%v", i, d)
+				}
+			}
+		}
+	}
+}
+
+func TestPointsFromDense(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	data := randomPoints(rnd, 500, 4)
+	m := mat.NewDense(len(data), 4, nil)
+	for i, p := range data {
+		m.SetRow(i, p)
+	}
+	orig := mat.DenseCopyOf(m)
+
+	p := PointsFromDense(m)
+	for i := range p {
+		if &p[i][0] != &m.RawRowView(i)[0] {
+			t.Fatalf("point %d does not share data with matrix", i)
+		}
+	}
+	tree := New(p, true)
+	if !mat.Equal(m, orig) {
+		t.Error("unexpected modification of matrix by tree construction")
+	}
+	for _, q := range randomPoints(rnd, 100, 4) {
+		got, _ := tree.Nearest(q)
+		want, _ := nearest(q, data)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected result from query %.3f: got:%.3f want:%.3f", q, got, want)
+		}
+	}
+}
+
+func BenchmarkKNearestBatch(b *testing.B) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	tree := New(randomPoints(rnd, 100000, 3), false)
+	queries := asComparables(randomPoints(rnd, 10000, 3))
+	for _, workers := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				tree.KNearestBatch(queries, 10, workers)
+			}
+		})
+	}
+}
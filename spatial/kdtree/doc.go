@@ -4,5 +4,12 @@
 
 // Package kdtree implements a k-d tree.
 //
+// The Tree type holds values of the Comparable interface type. The
+// TreeOf type holds values of a type parameter satisfying the Locator
+// constraint, avoiding interface conversions and type assertions.
+// PointsFromDense and RowsFromDense provide points held in the rows
+// of a mat.Dense for Tree and TreeOf respectively without copying the
+// point data.
+//
 // See https://en.wikipedia.org/wiki/K-d_tree for more details of k-d tree functionality.
 package kdtree // import "gonum.org/v1/gonum/spatial/kdtree"
@@ -0,0 +1,280 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kdtree
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/internal/knn"
+)
+
+// Locator is the constraint satisfied by point types stored in a TreeOf. It
+// has the semantics of Comparable, but its methods take the point type itself
+// as a parameter, so points are stored and compared without conversion to an
+// interface value or type assertion.
+type Locator[P any] interface {
+	// Compare returns the signed distance of the receiver from the plane
+	// passing through p and perpendicular to the dimension d.
+	Compare(p P, d Dim) float64
+
+	// Dims returns the number of dimensions described by the receiver.
+	Dims() int
+
+	// Distance returns the squared Euclidean distance between the receiver
+	// and p.
+	Distance(p P) float64
+}
+
+// NodeOf holds a single point value in a TreeOf.
+type NodeOf[P Locator[P]] struct {
+	Point       P
+	Plane       Dim
+	Left, Right *NodeOf[P]
+}
+
+// TreeOf implements a k-d tree creation and nearest neighbor search for
+// points of type P.
+//
+// TreeOf does not support bounding volumes. Trees that require them should
+// use Tree.
+type TreeOf[P Locator[P]] struct {
+	Root  *NodeOf[P]
+	Count int
+}
+
+// NewOf returns a k-d tree constructed from the values in p. The ordering of
+// elements in p may be altered after NewOf returns. Pivots are chosen as
+// described for Plane.
+func NewOf[P Locator[P]](p []P) *TreeOf[P] {
+	return &TreeOf[P]{
+		Root:  buildOf(p, 0),
+		Count: len(p),
+	}
+}
+
+func buildOf[P Locator[P]](p []P, plane Dim) *NodeOf[P] {
+	if len(p) == 0 {
+		return nil
+	}
+
+	pl := planeOf[P]{points: p, dim: plane}
+	piv := Partition(pl, MedianOfRandoms(pl, randoms))
+	d := p[piv]
+	np := (plane + 1) % Dim(d.Dims())
+
+	return &NodeOf[P]{
+		Point: d,
+		Plane: plane,
+		Left:  buildOf(p[:piv], np),
+		Right: buildOf(p[piv+1:], np),
+	}
+}
+
+// planeOf is a SortSlicer that orders a slice of points on a dimension.
+type planeOf[P Locator[P]] struct {
+	points []P
+	dim    Dim
+}
+
+func (p planeOf[P]) Len() int           { return len(p.points) }
+func (p planeOf[P]) Less(i, j int) bool { return p.points[i].Compare(p.points[j], p.dim) < 0 }
+func (p planeOf[P]) Swap(i, j int)      { p.points[i], p.points[j] = p.points[j], p.points[i] }
+func (p planeOf[P]) Slice(start, end int) SortSlicer {
+	p.points = p.points[start:end]
+	return p
+}
+
+// Insert adds a point to the tree, updating the tree's Count.
+func (t *TreeOf[P]) Insert(c P) {
+	t.Count++
+	t.Root = t.Root.insert(c, 0)
+}
+
+func (n *NodeOf[P]) insert(c P, d Dim) *NodeOf[P] {
+	if n == nil {
+		return &NodeOf[P]{Point: c, Plane: d}
+	}
+
+	d = (n.Plane + 1) % Dim(c.Dims())
+	if c.Compare(n.Point, n.Plane) <= 0 {
+		n.Left = n.Left.insert(c, d)
+	} else {
+		n.Right = n.Right.insert(c, d)
+	}
+
+	return n
+}
+
+// Len returns the number of elements in the tree.
+func (t *TreeOf[P]) Len() int { return t.Count }
+
+// Nearest returns the nearest value to the query and the distance between
+// them. If the tree is empty, Nearest returns the zero value of P and an
+// infinite distance.
+func (t *TreeOf[P]) Nearest(q P) (P, float64) {
+	n, dist := t.Root.search(q, inf)
+	if n == nil {
+		var zero P
+		return zero, inf
+	}
+	return n.Point, dist
+}
+
+func (n *NodeOf[P]) search(q P, dist float64) (*NodeOf[P], float64) {
+	if n == nil {
+		return nil, inf
+	}
+
+	c := q.Compare(n.Point, n.Plane)
+	dist = math.Min(dist, q.Distance(n.Point))
+
+	bn := n
+	near, far := n.Left, n.Right
+	if c > 0 {
+		near, far = far, near
+	}
+	if nn, nd := near.search(q, dist); nd < dist {
+		bn, dist = nn, nd
+	}
+	if c*c < dist {
+		if fn, fd := far.search(q, dist); fd < dist {
+			bn, dist = fn, fd
+		}
+	}
+	return bn, dist
+}
+
+// PointDist holds a point and its distance to a specific query.
+type PointDist[P any] = knn.PointDist[P]
+
+// KNearest returns the k nearest values in the tree to the query, sorted by
+// increasing distance from the query. If the tree holds fewer than k values,
+// all values are returned.
+func (t *TreeOf[P]) KNearest(q P, k int) []PointDist[P] {
+	return kNearest(t.Root, t.Count, q, k)
+}
+
+// NearestWithin returns the values in the tree that are within the distance
+// d of the query, q, sorted by increasing distance. The distance is measured
+// using the Distance method of the stored values.
+func (t *TreeOf[P]) NearestWithin(q P, d float64) []PointDist[P] {
+	return nearestWithin(t.Root, q, d)
+}
+
+// KNearestBatch returns the k nearest values in the tree to each of the
+// queries in q. The returned slice holds the results for q[i] in its ith
+// element, each as described for KNearest.
+//
+// The queries are performed concurrently using up to workers goroutines.
+// If workers is less than one, runtime.GOMAXPROCS(0) is used. The tree
+// must not be modified during the call to KNearestBatch.
+func (t *TreeOf[P]) KNearestBatch(q []P, k, workers int) [][]PointDist[P] {
+	return batchQuery(q, workers, func(q P) []PointDist[P] {
+		return t.KNearest(q, k)
+	})
+}
+
+// NearestWithinBatch returns the values in the tree that are within the
+// distance d of each of the queries in q. The returned slice holds the results
+// for q[i] in its ith element, each as described for NearestWithin.
+//
+// The queries are performed concurrently using up to workers goroutines.
+// If workers is less than one, runtime.GOMAXPROCS(0) is used. The tree
+// must not be modified during the call to NearestWithinBatch.
+func (t *TreeOf[P]) NearestWithinBatch(q []P, d float64, workers int) [][]PointDist[P] {
+	return batchQuery(q, workers, func(q P) []PointDist[P] {
+		return t.NearestWithin(q, d)
+	})
+}
+
+// treeNode is the constraint satisfied by the nodes of Tree and TreeOf,
+// allowing their set searches to share an implementation.
+type treeNode[P any, N any] interface {
+	comparable
+
+	// split returns the point held by the node, the dimension
+	// it partitions and the node's children.
+	split() (p P, plane Dim, left, right N)
+}
+
+func (n *Node) split() (Comparable, Dim, *Node, *Node) {
+	return n.Point, n.Plane, n.Left, n.Right
+}
+
+func (n *NodeOf[P]) split() (P, Dim, *NodeOf[P], *NodeOf[P]) {
+	return n.Point, n.Plane, n.Left, n.Right
+}
+
+// kNearest returns the k nearest values to the query in the tree rooted at
+// root holding count values.
+func kNearest[P Locator[P], N treeNode[P, N]](root N, count int, q P, k int) []PointDist[P] {
+	var empty N
+	if k < 1 || root == empty {
+		return nil
+	}
+	s := knn.NewKNearest[P](k, count)
+	searchSet(root, q, &s)
+	return s.Sorted()
+}
+
+// nearestWithin returns the values within the distance d of the query in
+// the tree rooted at root.
+func nearestWithin[P Locator[P], N treeNode[P, N]](root N, q P, d float64) []PointDist[P] {
+	var empty N
+	if root == empty {
+		return nil
+	}
+	s := knn.NewWithin[P](d)
+	searchSet(root, q, &s)
+	return s.Sorted()
+}
+
+// searchSet adds the points in the subtree rooted at n that
+// may be retained by s for the query q.
+func searchSet[P Locator[P], N treeNode[P, N]](n N, q P, s *knn.Keeper[P]) {
+	var empty N
+	if n == empty {
+		return
+	}
+
+	p, plane, near, far := n.split()
+	c := q.Compare(p, plane)
+	s.Keep(p, q.Distance(p))
+	if c > 0 {
+		near, far = far, near
+	}
+	searchSet(near, q, s)
+	if c*c <= s.Max() {
+		searchSet(far, q, s)
+	}
+}
+
+// Do performs fn on all values stored in the tree in order, passing each
+// value and its depth in the tree. A boolean is returned indicating whether
+// the Do traversal was interrupted by fn returning true. If fn alters stored
+// values' sort relationships, future tree operation behaviors are undefined.
+func (t *TreeOf[P]) Do(fn func(p P, depth int) (done bool)) bool {
+	if t.Root == nil {
+		return false
+	}
+	return t.Root.do(fn, 0)
+}
+
+func (n *NodeOf[P]) do(fn func(P, int) bool, depth int) (done bool) {
+	if n.Left != nil {
+		done = n.Left.do(fn, depth+1)
+		if done {
+			return
+		}
+	}
+	done = fn(n.Point, depth)
+	if done {
+		return
+	}
+	if n.Right != nil {
+		done = n.Right.do(fn, depth+1)
+	}
+	return
+}
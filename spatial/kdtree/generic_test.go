@@ -0,0 +1,186 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kdtree
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// isKDTree returns whether the subtree rooted at n satisfies the k-d tree
+// partitioning property.
+func (n *NodeOf[P]) isKDTree() bool {
+	if n == nil {
+		return true
+	}
+	ok := true
+	n.Left.walk(func(p P) {
+		ok = ok && p.Compare(n.Point, n.Plane) <= 0
+	})
+	n.Right.walk(func(p P) {
+		ok = ok && p.Compare(n.Point, n.Plane) >= 0
+	})
+	return ok && n.Left.isKDTree() && n.Right.isKDTree()
+}
+
+// walk calls fn on each point in the subtree rooted at n.
+func (n *NodeOf[P]) walk(fn func(P)) {
+	if n == nil {
+		return
+	}
+	n.Left.walk(fn)
+	fn(n.Point)
+	n.Right.walk(fn)
+}
+
+// denseRows returns a matrix holding the points in p in its rows
+// and the DenseRow values for the rows.
+func denseRows(p Points) (*mat.Dense, []DenseRow) {
+	m := mat.NewDense(len(p), p[0].Dims(), nil)
+	for i, v := range p {
+		m.SetRow(i, v)
+	}
+	return m, RowsFromDense(m)
+}
+
+func TestTreeOf(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	data := randomPoints(rnd, 1000, 3)
+	m, points := denseRows(data)
+	tree := NewOf(append([]DenseRow(nil), points...))
+	if tree.Len() != 1000 {
+		t.Errorf("unexpected tree size: got:%d want:1000", tree.Len())
+	}
+	if !tree.Root.isKDTree() {
+		t.Error("tree is not k-d tree")
+	}
+
+	queries := randomPoints(rnd, 100, 3)
+	for i, q := range queries {
+		qr := DenseRow{Index: -1, Vec: q}
+		got, gotD := tree.Nearest(qr)
+		want, wantD := nearest(q, data)
+		if gotD != wantD || !reflect.DeepEqual(Point(got.Vec), want) {
+			t.Errorf("unexpected nearest point for query %d: got:%v %v want:%v %v", i, got.Vec, gotD, want, wantD)
+		}
+		if &got.Vec[0] != &m.RawRowView(got.Index)[0] {
+			t.Errorf("nearest point to query %d does not share the data of row %d", i, got.Index)
+		}
+
+		for _, k := range []int{1, 10, 2000} {
+			gotN := tree.KNearest(qr, k)
+			wantN := nearestN(min(k, len(data)), q, data)
+			if len(gotN) != len(wantN) {
+				t.Errorf("unexpected number of neighbors for query %d k=%d: got:%d want:%d", i, k, len(gotN), len(wantN))
+				continue
+			}
+			for j := range gotN {
+				if gotN[j].Dist != wantN[j].Dist {
+					t.Errorf("unexpected neighbor distance %d for query %d k=%d: got:%v want:%v", j, i, k, gotN[j].Dist, wantN[j].Dist)
+				}
+			}
+		}
+
+		const r = 0.05
+		gotW := tree.NearestWithin(qr, r)
+		var wantW int
+		for _, p := range data {
+			if q.Distance(p) <= r {
+				wantW++
+			}
+		}
+		if len(gotW) != wantW {
+			t.Errorf("unexpected number of points within %v of query %d: got:%d want:%d", r, i, len(gotW), wantW)
+		}
+		if !sort.SliceIsSorted(gotW, func(a, b int) bool { return gotW[a].Dist < gotW[b].Dist }) {
+			t.Errorf("points within distance not sorted for query %d", i)
+		}
+		for _, p := range gotW {
+			if p.Dist > r {
+				t.Errorf("point too distant for query %d: got:%v want:<=%v", i, p.Dist, r)
+			}
+		}
+	}
+
+	qr := make([]DenseRow, len(queries))
+	for i, q := range queries {
+		qr[i] = DenseRow{Index: -1, Vec: q}
+	}
+	for _, workers := range []int{0, 1, 3} {
+		got := tree.KNearestBatch(qr, 5, workers)
+		for i, q := range qr {
+			if !reflect.DeepEqual(got[i], tree.KNearest(q, 5)) {
+				t.Errorf("unexpected batch result for query %d with %d workers", i, workers)
+			}
+		}
+		gotW := tree.NearestWithinBatch(qr, 0.05, workers)
+		for i, q := range qr {
+			if !reflect.DeepEqual(gotW[i], tree.NearestWithin(q, 0.05)) {
+				t.Errorf("unexpected batch within result for query %d with %d workers", i, workers)
+			}
+		}
+	}
+
+	var n int
+	tree.Do(func(p DenseRow, _ int) bool {
+		n++
+		return false
+	})
+	if n != tree.Len() {
+		t.Errorf("unexpected number of points visited: got:%d want:%d", n, tree.Len())
+	}
+	if !tree.Do(func(DenseRow, int) bool { return true }) {
+		t.Error("expected interrupted traversal")
+	}
+}
+
+func TestTreeOfInsert(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 2))
+	_, points := denseRows(randomPoints(rnd, 200, 2))
+	var tree TreeOf[DenseRow]
+	for _, p := range points {
+		tree.Insert(p)
+	}
+	if tree.Len() != len(points) {
+		t.Errorf("unexpected tree size: got:%d want:%d", tree.Len(), len(points))
+	}
+	if !tree.Root.isKDTree() {
+		t.Error("tree is not k-d tree")
+	}
+	for i, p := range points {
+		got, d := tree.Nearest(p)
+		if d != 0 || got.Index != i {
+			t.Errorf("failed to find inserted point %d: got:%d with distance %v", i, got.Index, d)
+		}
+	}
+
+	var empty TreeOf[DenseRow]
+	if p, d := empty.Nearest(points[0]); p.Vec != nil || d != inf {
+		t.Errorf("unexpected nearest point in empty tree: got:%v %v", p, d)
+	}
+	if got := empty.KNearest(points[0], 3); got != nil {
+		t.Errorf("unexpected neighbors in empty tree: got:%v", got)
+	}
+	if got := empty.NearestWithin(points[0], 1); got != nil {
+		t.Errorf("unexpected points within distance in empty tree: got:%v", got)
+	}
+}
+
+func BenchmarkTreeOfKNearest(b *testing.B) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	_, points := denseRows(randomPoints(rnd, 10000, 3))
+	tree := NewOf(points)
+	q := DenseRow{Index: -1, Vec: []float64{0.5, 0.5, 0.5}}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree.KNearest(q, 10)
+	}
+}
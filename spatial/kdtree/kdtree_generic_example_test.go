@@ -0,0 +1,52 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kdtree_test
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/gonum/spatial/kdtree"
+)
+
+// landmark is a user point type with coordinates and a name.
+type landmark struct {
+	name string
+	x, y float64
+}
+
+func (p landmark) Compare(q landmark, d kdtree.Dim) float64 {
+	if d == 0 {
+		return p.x - q.x
+	}
+	return p.y - q.y
+}
+
+func (p landmark) Dims() int { return 2 }
+
+func (p landmark) Distance(q landmark) float64 {
+	dx := p.x - q.x
+	dy := p.y - q.y
+	return dx*dx + dy*dy
+}
+
+func ExampleTreeOf() {
+	landmarks := []landmark{
+		{name: "depot", x: 2, y: 3},
+		{name: "harbour", x: 5, y: 4},
+		{name: "mill", x: 9, y: 6},
+		{name: "market", x: 4, y: 7},
+		{name: "school", x: 8, y: 1},
+		{name: "station", x: 7, y: 2},
+	}
+
+	t := kdtree.NewOf(landmarks)
+	for _, n := range t.NearestWithin(landmark{x: 6, y: 3.2}, 2*2) {
+		fmt.Printf("%s is within 2 of (6, 3.2), d=%f\n", n.Point.name, math.Sqrt(n.Dist))
+	}
+	// Output:
+	// harbour is within 2 of (6, 3.2), d=1.280625
+	// station is within 2 of (6, 3.2), d=1.562050
+}
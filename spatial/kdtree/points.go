@@ -4,7 +4,11 @@
 
 package kdtree
 
-import "math"
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
 
 var (
 	_ Interface  = Points(nil)
@@ -86,3 +90,57 @@ func (p Plane) Less(i, j int) bool              { return p.Points[i][p.Dim] < p.
 func (p Plane) Pivot() int                      { return Partition(p, MedianOfRandoms(p, randoms)) }
 func (p Plane) Slice(start, end int) SortSlicer { p.Points = p.Points[start:end]; return p }
 func (p Plane) Swap(i, j int)                   { p.Points[i], p.Points[j] = p.Points[j], p.Points[i] }
+
+// PointsFromDense returns a Points collection with each element holding a
+// row of m. The returned Points share the backing data of m, so no point
+// data is copied. Constructing a tree from the returned Points reorders
+// the Points elements but does not alter m. Changes to the elements of m
+// after construction of a tree are reflected in the tree's points and may
+// invalidate the tree.
+func PointsFromDense(m *mat.Dense) Points {
+	r, _ := m.Dims()
+	p := make(Points, r)
+	for i := range p {
+		p[i] = m.RawRowView(i)
+	}
+	return p
+}
+
+// DenseRow is a point held in a row of a mat.Dense that satisfies the
+// Locator constraint. Index is the index of the row holding the point and
+// Vec is the row's data.
+type DenseRow struct {
+	Index int
+	Vec   []float64
+}
+
+var _ Locator[DenseRow] = DenseRow{}
+
+// Compare returns the signed distance of p from the plane passing through q
+// and perpendicular to the dimension d.
+func (p DenseRow) Compare(q DenseRow, d Dim) float64 { return p.Vec[d] - q.Vec[d] }
+
+// Dims returns the number of dimensions described by the receiver.
+func (p DenseRow) Dims() int { return len(p.Vec) }
+
+// Distance returns the squared Euclidean distance between q and the receiver.
+func (p DenseRow) Distance(q DenseRow) float64 {
+	var sum float64
+	for dim, c := range p.Vec {
+		d := c - q.Vec[dim]
+		sum += d * d
+	}
+	return sum
+}
+
+// RowsFromDense returns a DenseRow for each row of m, for use with TreeOf.
+// The returned points share the backing data of m as described for
+// PointsFromDense, and the Index of each point is the row of m holding it.
+func RowsFromDense(m *mat.Dense) []DenseRow {
+	r, _ := m.Dims()
+	p := make([]DenseRow, r)
+	for i := range p {
+		p[i] = DenseRow{Index: i, Vec: m.RawRowView(i)}
+	}
+	return p
+}
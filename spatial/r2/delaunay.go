@@ -0,0 +1,161 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r2
+
+import "math"
+
+// Delaunay returns the Delaunay triangulation of the points in p. Each
+// returned triangle holds the indices into p of its vertices in
+// counter-clockwise order. Coincident points are included in the
+// triangulation only once, using the first occurrence in p. If p has
+// fewer than three points or all the points in p are collinear, Delaunay
+// returns nil.
+//
+// The triangulation is calculated using the Bowyer-Watson algorithm and
+// takes O(n²) time in the worst case.
+func Delaunay(p []Vec) [][3]int {
+	if len(p) < 3 {
+		return nil
+	}
+
+	// Construct a super-triangle that contains all the
+	// points and place its vertices after the points in p.
+	min, max := p[0], p[0]
+	for _, v := range p[1:] {
+		min = minElem(min, v)
+		max = maxElem(max, v)
+	}
+	d := math.Max(max.X-min.X, max.Y-min.Y)
+	if d == 0 {
+		return nil
+	}
+	mid := Scale(0.5, Add(min, max))
+	const superScale = 1e3
+	v := append(p[:len(p):len(p)],
+		Vec{X: mid.X - superScale*d, Y: mid.Y - superScale*d},
+		Vec{X: mid.X + superScale*d, Y: mid.Y - superScale*d},
+		Vec{X: mid.X, Y: mid.Y + superScale*d},
+	)
+	n := len(p)
+	tris := [][3]int{{n, n + 1, n + 2}}
+
+	type edge struct{ u, v int }
+	for i := range p {
+		var bad [][3]int
+		var good [][3]int
+		for _, t := range tris {
+			if inCircle(v[t[0]], v[t[1]], v[t[2]], v[i]) > 0 {
+				bad = append(bad, t)
+			} else {
+				good = append(good, t)
+			}
+		}
+		if len(bad) == 0 {
+			// The point is coincident with a vertex.
+			continue
+		}
+
+		// Find the boundary of the cavity formed by the
+		// bad triangles and connect it to the new point.
+		edges := make(map[edge]bool, 3*len(bad))
+		for _, t := range bad {
+			for j := range t {
+				edges[edge{t[j], t[(j+1)%3]}] = true
+			}
+		}
+		for _, t := range bad {
+			for j := range t {
+				u, w := t[j], t[(j+1)%3]
+				if !edges[edge{w, u}] {
+					good = append(good, [3]int{u, w, i})
+				}
+			}
+		}
+		tris = good
+	}
+
+	var dst [][3]int
+	for _, t := range tris {
+		if t[0] < n && t[1] < n && t[2] < n {
+			dst = append(dst, t)
+		}
+	}
+	return dst
+}
+
+// inCircle returns a positive value if d lies inside the circle through
+// a, b and c, which must be in counter-clockwise order, a negative value
+// if it lies outside the circle and zero if it lies on the circle.
+func inCircle(a, b, c, d Vec) float64 {
+	ad := Sub(a, d)
+	bd := Sub(b, d)
+	cd := Sub(c, d)
+	return Norm2(ad)*Cross(bd, cd) - Norm2(bd)*Cross(ad, cd) + Norm2(cd)*Cross(ad, bd)
+}
+
+// Voronoi returns the Voronoi diagram of the points in p clipped to the
+// box b. The returned cells correspond to the elements of p and hold the
+// vertices of each cell in counter-clockwise order. Cells of points that
+// are coincident with another point in p are the same as the cell of the
+// other point, and cells that lie outside b are empty.
+//
+// The cells are calculated from the Delaunay triangulation of p.
+func Voronoi(p []Vec, b Box) []Polygon {
+	neighbors := make([][]int, len(p))
+	tris := Delaunay(p)
+	if tris == nil {
+		// Every point may neighbor every other
+		// when the triangulation is degenerate.
+		for i := range p {
+			for j := range p {
+				if i != j {
+					neighbors[i] = append(neighbors[i], j)
+				}
+			}
+		}
+	}
+	seen := make(map[[2]int]bool)
+	for _, t := range tris {
+		for j := range t {
+			u, v := t[j], t[(j+1)%3]
+			if seen[[2]int{v, u}] {
+				continue
+			}
+			seen[[2]int{u, v}] = true
+			neighbors[u] = append(neighbors[u], v)
+			neighbors[v] = append(neighbors[v], u)
+		}
+	}
+
+	// Coincident points are not included in the
+	// triangulation, so map them to the first
+	// coincident point.
+	first := make(map[Vec]int, len(p))
+	for i, v := range p {
+		if _, ok := first[v]; !ok {
+			first[v] = i
+		}
+	}
+
+	bounds := Polygon(b.Vertices())
+	cells := make([]Polygon, len(p))
+	for i, v := range p {
+		cell := bounds
+		for _, j := range neighbors[first[v]] {
+			w := p[j]
+			if w == v {
+				continue
+			}
+			// Retain the half-plane closer to v than w.
+			n := Sub(w, v)
+			cell = cell.clipHalfPlane(n, (Norm2(w)-Norm2(v))/2)
+			if len(cell) == 0 {
+				break
+			}
+		}
+		cells[i] = cell
+	}
+	return cells
+}
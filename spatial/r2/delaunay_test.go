@@ -0,0 +1,153 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r2
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestDelaunay(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{3, 4, 10, 100, 500} {
+		p := make([]Vec, n)
+		for i := range p {
+			p[i] = Vec{rnd.Float64(), rnd.Float64()}
+		}
+		tris := Delaunay(p)
+		if len(tris) == 0 {
+			t.Fatalf("no triangles for n=%d", n)
+		}
+
+		// The triangulation covers the convex hull.
+		var area float64
+		for _, tri := range tris {
+			a := Polygon{p[tri[0]], p[tri[1]], p[tri[2]]}.SignedArea()
+			if a <= 0 {
+				t.Errorf("triangle %v is not counter-clockwise for n=%d", tri, n)
+			}
+			area += a
+		}
+		if hull := ConvexHull(p).Area(); !scalar.EqualWithinAbsOrRel(area, hull, 1e-9, 1e-9) {
+			t.Errorf("unexpected triangulation area for n=%d: got:%v want:%v", n, area, hull)
+		}
+
+		// A planar triangulation of n points with h on
+		// the hull has 2n-2-h triangles.
+		if want := 2*n - 2 - len(ConvexHull(p)); len(tris) != want {
+			t.Errorf("unexpected number of triangles for n=%d: got:%d want:%d", n, len(tris), want)
+		}
+
+		// No point is within the circumcircle of any triangle.
+		for _, tri := range tris {
+			c := Triangle{p[tri[0]], p[tri[1]], p[tri[2]]}.Circumcenter()
+			r := Norm(Sub(p[tri[0]], c))
+			for i, v := range p {
+				if i == tri[0] || i == tri[1] || i == tri[2] {
+					continue
+				}
+				if Norm(Sub(v, c)) < r*(1-1e-9) {
+					t.Errorf("point %d within circumcircle of %v for n=%d", i, tri, n)
+				}
+			}
+		}
+	}
+}
+
+func TestDelaunayDegenerate(t *testing.T) {
+	for _, p := range [][]Vec{
+		nil,
+		{{0, 0}, {1, 1}},
+		{{0, 0}, {1, 1}, {2, 2}, {3, 3}},
+		{{1, 1}, {1, 1}, {1, 1}},
+	} {
+		if got := Delaunay(p); got != nil {
+			t.Errorf("unexpected triangulation for %v: %v", p, got)
+		}
+	}
+
+	// Coincident points are only included once.
+	p := []Vec{{0, 0}, {1, 0}, {0, 1}, {1, 0}, {1, 1}}
+	tris := Delaunay(p)
+	if len(tris) != 2 {
+		t.Fatalf("unexpected number of triangles: got:%d want:2", len(tris))
+	}
+	for _, tri := range tris {
+		for _, v := range tri {
+			if v == 3 {
+				t.Errorf("unexpected coincident point in triangle %v", tri)
+			}
+		}
+	}
+}
+
+func TestCircumcenter(t *testing.T) {
+	tri := Triangle{{0, 0}, {4, 0}, {0, 2}}
+	got := tri.Circumcenter()
+	want := Vec{2, 1}
+	if !vecApproxEqual(got, want, 1e-14) {
+		t.Errorf("unexpected circumcenter: got:%v want:%v", got, want)
+	}
+}
+
+func TestVoronoi(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	b := NewBox(0, 0, 1, 1)
+	for _, p := range [][]Vec{
+		{{0.25, 0.5}, {0.75, 0.5}},
+		{{0.1, 0.1}, {0.5, 0.5}, {0.9, 0.9}},
+		randomPoints(50, rnd),
+		append(randomPoints(20, rnd), Vec{0.5, 0.5}, Vec{0.5, 0.5}),
+	} {
+		cells := Voronoi(p, b)
+		if len(cells) != len(p) {
+			t.Fatalf("unexpected number of cells: got:%d want:%d", len(cells), len(p))
+		}
+		var area float64
+		seen := make(map[Vec]bool)
+		for i, c := range cells {
+			if c.SignedArea() < 0 {
+				t.Errorf("cell %d is not counter-clockwise", i)
+			}
+			if !c.IsConvex() {
+				t.Errorf("cell %d is not convex", i)
+			}
+			if !seen[p[i]] {
+				area += c.Area()
+				seen[p[i]] = true
+			}
+		}
+		if !scalar.EqualWithinAbs(area, 1, 1e-9) {
+			t.Errorf("unexpected total cell area: got:%v want:1", area)
+		}
+
+		// Every point in the box is in the
+		// cell of its nearest site.
+		for i := 0; i < 200; i++ {
+			q := Vec{rnd.Float64(), rnd.Float64()}
+			best := math.Inf(1)
+			var nearest int
+			for j, v := range p {
+				if d := Norm(Sub(q, v)); d < best {
+					best, nearest = d, j
+				}
+			}
+			if !cells[nearest].Contains(q) {
+				t.Errorf("cell %d does not contain %v", nearest, q)
+			}
+		}
+	}
+}
+
+func randomPoints(n int, rnd *rand.Rand) []Vec {
+	p := make([]Vec, n)
+	for i := range p {
+		p[i] = Vec{rnd.Float64(), rnd.Float64()}
+	}
+	return p
+}
@@ -2,5 +2,6 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// Package r2 provides 2D vectors and boxes and operations on them.
+// Package r2 provides 2D vectors, boxes and polygons and operations on
+// them, including convex hulls and Delaunay triangulations.
 package r2 // import "gonum.org/v1/gonum/spatial/r2"
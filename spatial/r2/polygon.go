@@ -0,0 +1,178 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r2
+
+import (
+	"math"
+	"slices"
+)
+
+// Polygon is a simple polygon described by its vertices in order.
+// The last vertex is joined to the first.
+type Polygon []Vec
+
+// SignedArea returns the signed area of the polygon. The area is
+// positive if the vertices are in counter-clockwise order and negative
+// if they are in clockwise order.
+func (p Polygon) SignedArea() float64 {
+	var a float64
+	for i, v := range p {
+		a += Cross(v, p[(i+1)%len(p)])
+	}
+	return a / 2
+}
+
+// Area returns the area of the polygon.
+func (p Polygon) Area() float64 {
+	return math.Abs(p.SignedArea())
+}
+
+// Centroid returns the centroid of the area of the polygon. If
+// the polygon has zero area, the mean of its vertices is returned.
+func (p Polygon) Centroid() Vec {
+	var c Vec
+	var a float64
+	for i, v := range p {
+		w := p[(i+1)%len(p)]
+		f := Cross(v, w)
+		c = Add(c, Scale(f, Add(v, w)))
+		a += f
+	}
+	if a == 0 {
+		var m Vec
+		for _, v := range p {
+			m = Add(m, v)
+		}
+		return Scale(1/float64(len(p)), m)
+	}
+	return Scale(1/(3*a), c)
+}
+
+// Edges returns the edges of the polygon.
+func (p Polygon) Edges() []Segment {
+	e := make([]Segment, len(p))
+	for i, v := range p {
+		e[i] = Segment{v, p[(i+1)%len(p)]}
+	}
+	return e
+}
+
+// Contains returns whether v is within the polygon. Points on the
+// boundary of the polygon are considered to be within the polygon.
+func (p Polygon) Contains(v Vec) bool {
+	var in bool
+	for i, a := range p {
+		b := p[(i+1)%len(p)]
+		if orient(a, b, v) == 0 && (Segment{a, b}).onSegment(v) {
+			return true
+		}
+		if (a.Y > v.Y) != (b.Y > v.Y) {
+			x := a.X + (v.Y-a.Y)*(b.X-a.X)/(b.Y-a.Y)
+			if v.X < x {
+				in = !in
+			}
+		}
+	}
+	return in
+}
+
+// Intersects returns whether the polygons p and q intersect. Polygons
+// that touch at their boundaries intersect, as do polygons where one
+// contains the other.
+func (p Polygon) Intersects(q Polygon) bool {
+	if len(p) == 0 || len(q) == 0 {
+		return false
+	}
+	for _, e := range p.Edges() {
+		for _, f := range q.Edges() {
+			if e.Intersects(f) {
+				return true
+			}
+		}
+	}
+	return p.Contains(q[0]) || q.Contains(p[0])
+}
+
+// IsConvex returns whether the polygon is convex. Collinear
+// consecutive vertices are allowed.
+func (p Polygon) IsConvex() bool {
+	var sign float64
+	for i, a := range p {
+		o := orient(a, p[(i+1)%len(p)], p[(i+2)%len(p)])
+		if o == 0 {
+			continue
+		}
+		if sign == 0 {
+			sign = o
+		} else if (o > 0) != (sign > 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// ConvexHull returns the convex hull of the points in p with the
+// vertices in counter-clockwise order starting from the vertex with
+// the lowest X coordinate, breaking ties by the lowest Y coordinate.
+// Points that lie on the edges of the hull are not included. If all
+// the points in p are collinear, the returned polygon holds the two
+// extreme points. The order of elements in p is not altered.
+//
+// The hull is calculated using Andrew's monotone chain algorithm.
+func ConvexHull(p []Vec) Polygon {
+	s := slices.Clone(p)
+	slices.SortFunc(s, func(a, b Vec) int {
+		switch {
+		case a.X < b.X:
+			return -1
+		case a.X > b.X:
+			return 1
+		case a.Y < b.Y:
+			return -1
+		case a.Y > b.Y:
+			return 1
+		}
+		return 0
+	})
+	s = slices.Compact(s)
+	if len(s) < 3 {
+		return s
+	}
+
+	h := make(Polygon, 0, 2*len(s))
+	for _, v := range s {
+		for len(h) >= 2 && orient(h[len(h)-2], h[len(h)-1], v) <= 0 {
+			h = h[:len(h)-1]
+		}
+		h = append(h, v)
+	}
+	lower := len(h) + 1
+	for i := len(s) - 2; i >= 0; i-- {
+		v := s[i]
+		for len(h) >= lower && orient(h[len(h)-2], h[len(h)-1], v) <= 0 {
+			h = h[:len(h)-1]
+		}
+		h = append(h, v)
+	}
+	return h[:len(h)-1]
+}
+
+// clipHalfPlane returns the part of the convex polygon p that satisfies
+// n·v <= c, using the Sutherland-Hodgman algorithm.
+func (p Polygon) clipHalfPlane(n Vec, c float64) Polygon {
+	var dst Polygon
+	for i, a := range p {
+		b := p[(i+1)%len(p)]
+		da := Dot(n, a) - c
+		db := Dot(n, b) - c
+		if da <= 0 {
+			dst = append(dst, a)
+		}
+		if (da < 0 && db > 0) || (da > 0 && db < 0) {
+			dst = append(dst, Add(a, Scale(da/(da-db), Sub(b, a))))
+		}
+	}
+	return dst
+}
@@ -0,0 +1,149 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r2
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+var (
+	unitSquare = Polygon{{0, 0}, {1, 0}, {1, 1}, {0, 1}}
+	// lShape is a non-convex polygon with area 3.
+	lShape = Polygon{{0, 0}, {2, 0}, {2, 1}, {1, 1}, {1, 2}, {0, 2}}
+)
+
+func TestPolygonArea(t *testing.T) {
+	for _, test := range []struct {
+		p          Polygon
+		signedArea float64
+		centroid   Vec
+	}{
+		{p: unitSquare, signedArea: 1, centroid: Vec{0.5, 0.5}},
+		{p: Polygon{{0, 1}, {1, 1}, {1, 0}, {0, 0}}, signedArea: -1, centroid: Vec{0.5, 0.5}},
+		{p: lShape, signedArea: 3, centroid: Vec{5.0 / 6, 5.0 / 6}},
+		{p: Polygon{{0, 0}, {4, 0}, {0, 3}}, signedArea: 6, centroid: Vec{4.0 / 3, 1}},
+		{p: Polygon{{0, 0}, {1, 1}, {2, 2}}, signedArea: 0, centroid: Vec{1, 1}},
+	} {
+		if got := test.p.SignedArea(); !scalar.EqualWithinAbs(got, test.signedArea, 1e-14) {
+			t.Errorf("unexpected signed area for %v: got:%v want:%v", test.p, got, test.signedArea)
+		}
+		if got := test.p.Area(); !scalar.EqualWithinAbs(got, math.Abs(test.signedArea), 1e-14) {
+			t.Errorf("unexpected area for %v: got:%v want:%v", test.p, got, math.Abs(test.signedArea))
+		}
+		got := test.p.Centroid()
+		if !vecApproxEqual(got, test.centroid, 1e-14) {
+			t.Errorf("unexpected centroid for %v: got:%v want:%v", test.p, got, test.centroid)
+		}
+	}
+}
+
+func TestPolygonContains(t *testing.T) {
+	for _, test := range []struct {
+		p    Polygon
+		v    Vec
+		want bool
+	}{
+		{p: unitSquare, v: Vec{0.5, 0.5}, want: true},
+		{p: unitSquare, v: Vec{0, 0}, want: true},
+		{p: unitSquare, v: Vec{0.5, 0}, want: true},
+		{p: unitSquare, v: Vec{1, 0.5}, want: true},
+		{p: unitSquare, v: Vec{1.5, 0.5}, want: false},
+		{p: unitSquare, v: Vec{-0.5, 0}, want: false},
+		{p: lShape, v: Vec{0.5, 1.5}, want: true},
+		{p: lShape, v: Vec{1.5, 0.5}, want: true},
+		{p: lShape, v: Vec{1.5, 1.5}, want: false},
+		{p: lShape, v: Vec{1, 1.5}, want: true},
+		{p: lShape, v: Vec{3, 1}, want: false},
+	} {
+		if got := test.p.Contains(test.v); got != test.want {
+			t.Errorf("unexpected containment of %v in %v: got:%t want:%t", test.v, test.p, got, test.want)
+		}
+	}
+}
+
+func TestPolygonIntersects(t *testing.T) {
+	translate := func(p Polygon, d Vec) Polygon {
+		q := make(Polygon, len(p))
+		for i, v := range p {
+			q[i] = Add(v, d)
+		}
+		return q
+	}
+	for _, test := range []struct {
+		p, q Polygon
+		want bool
+	}{
+		{p: unitSquare, q: translate(unitSquare, Vec{0.5, 0.5}), want: true},
+		{p: unitSquare, q: translate(unitSquare, Vec{1, 0}), want: true},
+		{p: unitSquare, q: translate(unitSquare, Vec{1.5, 0}), want: false},
+		{p: lShape, q: translate(unitSquare, Vec{1.25, 1.25}), want: false},
+		{p: lShape, q: Polygon{{0.25, 0.25}, {0.75, 0.25}, {0.5, 0.75}}, want: true},
+		{p: Polygon{{0.25, 0.25}, {0.75, 0.25}, {0.5, 0.75}}, q: lShape, want: true},
+	} {
+		if got := test.p.Intersects(test.q); got != test.want {
+			t.Errorf("unexpected intersection of %v and %v: got:%t want:%t", test.p, test.q, got, test.want)
+		}
+	}
+}
+
+func TestPolygonIsConvex(t *testing.T) {
+	for _, test := range []struct {
+		p    Polygon
+		want bool
+	}{
+		{p: unitSquare, want: true},
+		{p: Polygon{{0, 0}, {0.5, 0}, {1, 0}, {1, 1}, {0, 1}}, want: true},
+		{p: lShape, want: false},
+	} {
+		if got := test.p.IsConvex(); got != test.want {
+			t.Errorf("unexpected convexity of %v: got:%t want:%t", test.p, got, test.want)
+		}
+	}
+}
+
+func TestConvexHull(t *testing.T) {
+	for _, test := range []struct {
+		p    []Vec
+		want Polygon
+	}{
+		{p: nil, want: nil},
+		{p: []Vec{{1, 1}, {1, 1}}, want: Polygon{{1, 1}}},
+		{p: []Vec{{0, 0}, {2, 2}, {1, 1}}, want: Polygon{{0, 0}, {2, 2}}},
+		{
+			p:    []Vec{{0.5, 0.5}, {1, 1}, {0, 1}, {0, 0}, {1, 0}, {0.5, 0}, {0.25, 0.75}},
+			want: Polygon{{0, 0}, {1, 0}, {1, 1}, {0, 1}},
+		},
+	} {
+		got := ConvexHull(test.p)
+		if !slices.Equal(got, test.want) {
+			t.Errorf("unexpected hull of %v: got:%v want:%v", test.p, got, test.want)
+		}
+	}
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 20; i++ {
+		p := make([]Vec, 100)
+		for j := range p {
+			p[j] = Vec{rnd.NormFloat64(), rnd.NormFloat64()}
+		}
+		h := ConvexHull(p)
+		if !h.IsConvex() {
+			t.Errorf("hull %d is not convex", i)
+		}
+		if h.SignedArea() <= 0 {
+			t.Errorf("hull %d is not counter-clockwise", i)
+		}
+		for _, v := range p {
+			if !h.Contains(v) {
+				t.Errorf("hull %d does not contain %v", i, v)
+			}
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r2
+
+import "math"
+
+// Segment is a line segment between two points.
+type Segment [2]Vec
+
+// Intersect returns the point of intersection of s and t and whether
+// the segments intersect. If the segments are collinear and overlap,
+// the returned point is an end point of one of the segments that lies
+// within the overlap.
+func (s Segment) Intersect(t Segment) (Vec, bool) {
+	d1 := orient(t[0], t[1], s[0])
+	d2 := orient(t[0], t[1], s[1])
+	d3 := orient(s[0], s[1], t[0])
+	d4 := orient(s[0], s[1], t[1])
+	if ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) && ((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0)) {
+		return Add(s[0], Scale(d1/(d1-d2), Sub(s[1], s[0]))), true
+	}
+	switch {
+	case d1 == 0 && t.onSegment(s[0]):
+		return s[0], true
+	case d2 == 0 && t.onSegment(s[1]):
+		return s[1], true
+	case d3 == 0 && s.onSegment(t[0]):
+		return t[0], true
+	case d4 == 0 && s.onSegment(t[1]):
+		return t[1], true
+	}
+	return Vec{}, false
+}
+
+// Intersects returns whether s and t intersect.
+func (s Segment) Intersects(t Segment) bool {
+	_, ok := s.Intersect(t)
+	return ok
+}
+
+// Distance returns the Euclidean distance from p to the closest
+// point on s.
+func (s Segment) Distance(p Vec) float64 {
+	d := Sub(s[1], s[0])
+	l2 := Norm2(d)
+	if l2 == 0 {
+		return Norm(Sub(p, s[0]))
+	}
+	u := math.Max(0, math.Min(1, Dot(Sub(p, s[0]), d)/l2))
+	return Norm(Sub(p, Add(s[0], Scale(u, d))))
+}
+
+// onSegment returns whether p, which must be collinear with s,
+// lies within the bounding box of s.
+func (s Segment) onSegment(p Vec) bool {
+	return math.Min(s[0].X, s[1].X) <= p.X && p.X <= math.Max(s[0].X, s[1].X) &&
+		math.Min(s[0].Y, s[1].Y) <= p.Y && p.Y <= math.Max(s[0].Y, s[1].Y)
+}
+
+// orient returns twice the signed area of the triangle abc. The
+// returned value is positive if abc are in counter-clockwise order,
+// negative if they are in clockwise order and zero if they are
+// collinear.
+func orient(a, b, c Vec) float64 {
+	return Cross(Sub(b, a), Sub(c, a))
+}
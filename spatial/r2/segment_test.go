@@ -0,0 +1,67 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r2
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestSegmentIntersect(t *testing.T) {
+	for _, test := range []struct {
+		s, t    Segment
+		want    Vec
+		overlap bool
+		wantOK  bool
+	}{
+		{s: Segment{{0, 0}, {2, 2}}, t: Segment{{0, 2}, {2, 0}}, want: Vec{1, 1}, wantOK: true},
+		{s: Segment{{0, 0}, {4, 0}}, t: Segment{{1, -1}, {1, 3}}, want: Vec{1, 0}, wantOK: true},
+		{s: Segment{{0, 0}, {1, 1}}, t: Segment{{1, 1}, {2, 0}}, want: Vec{1, 1}, wantOK: true},
+		{s: Segment{{0, 0}, {2, 0}}, t: Segment{{1, 0}, {1, 1}}, want: Vec{1, 0}, wantOK: true},
+		{s: Segment{{0, 0}, {2, 0}}, t: Segment{{1, 0}, {3, 0}}, overlap: true, wantOK: true},
+		{s: Segment{{0, 0}, {1, 0}}, t: Segment{{2, 0}, {3, 0}}, wantOK: false},
+		{s: Segment{{0, 0}, {1, 0}}, t: Segment{{0, 1}, {1, 1}}, wantOK: false},
+		{s: Segment{{0, 0}, {1, 1}}, t: Segment{{2, 0}, {1.5, 1}}, wantOK: false},
+	} {
+		for _, swap := range []bool{false, true} {
+			s, u := test.s, test.t
+			if swap {
+				s, u = u, s
+			}
+			got, ok := s.Intersect(u)
+			if ok != test.wantOK {
+				t.Errorf("unexpected intersection result for %v and %v: got:%t want:%t", s, u, ok, test.wantOK)
+				continue
+			}
+			if ok && (s.Distance(got) > 1e-14 || u.Distance(got) > 1e-14) {
+				t.Errorf("intersection of %v and %v not on both segments: got:%v", s, u, got)
+			}
+			if ok && !test.overlap && !vecApproxEqual(got, test.want, 1e-14) {
+				t.Errorf("unexpected intersection for %v and %v: got:%v want:%v", s, u, got, test.want)
+			}
+			if s.Intersects(u) != ok {
+				t.Errorf("mismatched Intersects result for %v and %v", s, u)
+			}
+		}
+	}
+}
+
+func TestSegmentDistance(t *testing.T) {
+	for _, test := range []struct {
+		s    Segment
+		p    Vec
+		want float64
+	}{
+		{s: Segment{{0, 0}, {2, 0}}, p: Vec{1, 1}, want: 1},
+		{s: Segment{{0, 0}, {2, 0}}, p: Vec{3, 0}, want: 1},
+		{s: Segment{{0, 0}, {2, 0}}, p: Vec{-3, 4}, want: 5},
+		{s: Segment{{1, 1}, {1, 1}}, p: Vec{4, 5}, want: 5},
+	} {
+		if got := test.s.Distance(test.p); !scalar.EqualWithinAbs(got, test.want, 1e-14) {
+			t.Errorf("unexpected distance from %v to %v: got:%v want:%v", test.p, test.s, got, test.want)
+		}
+	}
+}
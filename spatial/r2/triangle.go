@@ -95,3 +95,18 @@ func (l line) distance(p Vec) float64 {
 	num := math.Abs((p2.X-p1.X)*(p1.Y-p.Y) - (p1.X-p.X)*(p2.Y-p1.Y))
 	return num / math.Hypot(p2.X-p1.X, p2.Y-p1.Y)
 }
+
+// Circumcenter returns the center of the circle passing through
+// the vertices of the triangle. If the triangle is degenerate, the
+// returned vector has infinite or NaN components.
+func (t Triangle) Circumcenter() Vec {
+	b := Sub(t[1], t[0])
+	c := Sub(t[2], t[0])
+	d := 2 * Cross(b, c)
+	b2 := Norm2(b)
+	c2 := Norm2(c)
+	return Add(t[0], Vec{
+		X: (c.Y*b2 - b.Y*c2) / d,
+		Y: (b.X*c2 - c.X*b2) / d,
+	})
+}
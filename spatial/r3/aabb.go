@@ -0,0 +1,217 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// AABBTree is a bounding volume hierarchy of axis-aligned bounding boxes
+// holding a set of triangles. It provides ray intersection queries that
+// take time logarithmic in the number of triangles for well distributed
+// triangles.
+type AABBTree struct {
+	tris []Triangle
+
+	// index holds the indices of the triangles
+	// in tris, ordered so that the triangles of
+	// each leaf are contiguous.
+	index []int
+
+	// nodes holds the nodes of the tree with
+	// the root at index zero.
+	nodes []aabbNode
+}
+
+// aabbNode is a node of an AABBTree.
+type aabbNode struct {
+	box Box
+
+	// left and right are the indices of the
+	// children of the node in the nodes of
+	// the tree, or -1 if the node is a leaf.
+	left, right int
+
+	// start and end delimit the triangles of
+	// a leaf in the index of the tree.
+	start, end int
+}
+
+// aabbLeafSize is the maximum number of triangles held in a leaf.
+const aabbLeafSize = 4
+
+// NewAABBTree returns an AABBTree holding the triangles in t. The tree
+// is constructed by recursively splitting the triangles at the median of
+// their centroids along the longest extent of the centroids.
+func NewAABBTree(t []Triangle) *AABBTree {
+	tree := &AABBTree{
+		tris:  t,
+		index: make([]int, len(t)),
+	}
+	for i := range tree.index {
+		tree.index[i] = i
+	}
+	if len(t) != 0 {
+		tree.build(0, len(t))
+	}
+	return tree
+}
+
+// build adds the node holding the triangles in index[start:end] and its
+// descendants to the tree and returns its index in nodes.
+func (t *AABBTree) build(start, end int) int {
+	n := len(t.nodes)
+	t.nodes = append(t.nodes, aabbNode{left: -1, right: -1, start: start, end: end})
+
+	first := t.tris[t.index[start]]
+	box := Box{Min: first[0], Max: first[0]}
+	centroids := Box{Min: first.Centroid(), Max: first.Centroid()}
+	for _, i := range t.index[start:end] {
+		tri := t.tris[i]
+		for _, v := range tri {
+			box.Min = minElem(box.Min, v)
+			box.Max = maxElem(box.Max, v)
+		}
+		c := tri.Centroid()
+		centroids.Min = minElem(centroids.Min, c)
+		centroids.Max = maxElem(centroids.Max, c)
+	}
+	t.nodes[n].box = box
+	if end-start <= aabbLeafSize {
+		return n
+	}
+
+	ext := centroids.Size()
+	coord := func(v Vec) float64 { return v.X }
+	switch {
+	case ext.Y >= ext.X && ext.Y >= ext.Z:
+		coord = func(v Vec) float64 { return v.Y }
+	case ext.Z >= ext.X && ext.Z >= ext.Y:
+		coord = func(v Vec) float64 { return v.Z }
+	}
+	if coord(ext) == 0 {
+		// The centroids are coincident, so
+		// the triangles cannot be separated.
+		return n
+	}
+	slices.SortFunc(t.index[start:end], func(a, b int) int {
+		return cmp.Compare(coord(t.tris[a].Centroid()), coord(t.tris[b].Centroid()))
+	})
+	mid := start + (end-start)/2
+	left := t.build(start, mid)
+	right := t.build(mid, end)
+	t.nodes[n].left = left
+	t.nodes[n].right = right
+	return n
+}
+
+// Len returns the number of triangles held by the tree.
+func (t *AABBTree) Len() int { return len(t.tris) }
+
+// Bounds returns the bounding box of the triangles held by the tree. The
+// returned box may have zero extent in some dimensions. If the tree holds
+// no triangles, Bounds returns the zero Box.
+func (t *AABBTree) Bounds() Box {
+	if len(t.nodes) == 0 {
+		return Box{}
+	}
+	return t.nodes[0].box
+}
+
+// RayHit is the intersection of a ray with a triangle held by an AABBTree.
+type RayHit struct {
+	// Index is the index of the
+	// intersected triangle in the
+	// triangles held by the tree.
+	Index int
+
+	// T is the parameter of the
+	// point of intersection on the
+	// ray and Point is the point.
+	T     float64
+	Point Vec
+}
+
+// IntersectRay returns the nearest intersection of the ray r with the
+// triangles held by the tree and whether there is any intersection. If
+// more than one triangle is intersected at the nearest point, the hit
+// with the lowest triangle index is returned.
+func (t *AABBTree) IntersectRay(r Ray) (RayHit, bool) {
+	best := RayHit{Index: -1, T: math.Inf(1)}
+	if len(t.nodes) == 0 {
+		return best, false
+	}
+	stack := []int{0}
+	for len(stack) != 0 {
+		n := t.nodes[stack[len(stack)-1]]
+		stack = stack[:len(stack)-1]
+		if tmin, _, ok := n.box.IntersectRay(r); !ok || tmin > best.T {
+			continue
+		}
+		if n.left < 0 {
+			for _, i := range t.index[n.start:n.end] {
+				w, ok := t.tris[i].IntersectRay(r)
+				if ok && (w < best.T || (w == best.T && i < best.Index)) {
+					best = RayHit{Index: i, T: w}
+				}
+			}
+			continue
+		}
+		// Visit the nearer child first so that its hits
+		// can prune the search of the further child.
+		near, far := n.left, n.right
+		tl, _, okl := t.nodes[near].box.IntersectRay(r)
+		tr, _, okr := t.nodes[far].box.IntersectRay(r)
+		if okl && okr && tr < tl {
+			near, far = far, near
+		}
+		stack = append(stack, far, near)
+	}
+	if best.Index < 0 {
+		return RayHit{}, false
+	}
+	best.Point = r.At(best.T)
+	return best, true
+}
+
+// Intersections returns all the intersections of the ray r with the
+// triangles held by the tree, sorted by increasing ray parameter and then
+// by triangle index.
+//
+// For a closed mesh, the parity of the number of intersections of a ray
+// starting at a point indicates whether the point is inside the mesh if
+// the ray does not pass through an edge or vertex of the mesh.
+func (t *AABBTree) Intersections(r Ray) []RayHit {
+	if len(t.nodes) == 0 {
+		return nil
+	}
+	var hits []RayHit
+	stack := []int{0}
+	for len(stack) != 0 {
+		n := t.nodes[stack[len(stack)-1]]
+		stack = stack[:len(stack)-1]
+		if _, _, ok := n.box.IntersectRay(r); !ok {
+			continue
+		}
+		if n.left < 0 {
+			for _, i := range t.index[n.start:n.end] {
+				if w, ok := t.tris[i].IntersectRay(r); ok {
+					hits = append(hits, RayHit{Index: i, T: w, Point: r.At(w)})
+				}
+			}
+			continue
+		}
+		stack = append(stack, n.right, n.left)
+	}
+	slices.SortFunc(hits, func(a, b RayHit) int {
+		if c := cmp.Compare(a.T, b.T); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Index, b.Index)
+	})
+	return hits
+}
@@ -0,0 +1,120 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func TestAABBTree(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	tris := make([]Triangle, 500)
+	for i := range tris {
+		// Small triangles scattered in a box.
+		c := randomVec(rnd)
+		for j := range tris[i] {
+			tris[i][j] = Add(c, Scale(0.1, randomVec(rnd)))
+		}
+	}
+	tree := NewAABBTree(tris)
+	if tree.Len() != len(tris) {
+		t.Errorf("unexpected number of triangles: got:%d want:%d", tree.Len(), len(tris))
+	}
+	b := tree.Bounds()
+	for i, tri := range tris {
+		for _, v := range tri {
+			if !b.Contains(v) {
+				t.Errorf("vertex of triangle %d not within bounds: %v not in %v", i, v, b)
+			}
+		}
+	}
+
+	var hits int
+	for i := 0; i < 2000; i++ {
+		r := Ray{Origin: randomVec(rnd), Dir: randomVec(rnd)}
+
+		var want []RayHit
+		best := RayHit{Index: -1}
+		for j, tri := range tris {
+			w, ok := tri.IntersectRay(r)
+			if !ok {
+				continue
+			}
+			want = append(want, RayHit{Index: j, T: w})
+			if best.Index < 0 || w < best.T {
+				best = RayHit{Index: j, T: w}
+			}
+		}
+
+		got, ok := tree.IntersectRay(r)
+		if ok != (best.Index >= 0) {
+			t.Errorf("unexpected intersection result for ray %d: got:%t want:%t", i, ok, best.Index >= 0)
+			continue
+		}
+		if ok {
+			hits++
+			if got.Index != best.Index || got.T != best.T || got.Point != r.At(best.T) {
+				t.Errorf("unexpected nearest intersection for ray %d: got:%+v want:%+v", i, got, best)
+			}
+		}
+
+		all := tree.Intersections(r)
+		if len(all) != len(want) {
+			t.Errorf("unexpected number of intersections for ray %d: got:%d want:%d", i, len(all), len(want))
+			continue
+		}
+		for j := 1; j < len(all); j++ {
+			if all[j].T < all[j-1].T {
+				t.Errorf("intersections not sorted for ray %d", i)
+				break
+			}
+		}
+		if len(all) != 0 && all[0].Index != got.Index {
+			t.Errorf("first intersection does not match nearest for ray %d: got:%d want:%d", i, all[0].Index, got.Index)
+		}
+	}
+	if hits == 0 {
+		t.Error("no rays intersected triangles")
+	}
+
+	var empty AABBTree
+	if _, ok := empty.IntersectRay(Ray{Dir: Vec{X: 1}}); ok {
+		t.Error("unexpected intersection with empty tree")
+	}
+	if got := NewAABBTree(nil).Intersections(Ray{Dir: Vec{X: 1}}); got != nil {
+		t.Errorf("unexpected intersections with empty tree: %v", got)
+	}
+}
+
+func TestAABBTreeInside(t *testing.T) {
+	// Points inside a closed mesh have rays with an odd
+	// number of intersections.
+	rnd := rand.New(rand.NewPCG(1, 2))
+	p := randomSphere(rnd, 500)
+	sphere := Mesh{Vertices: p, Faces: ConvexHull(p)}
+	tree := NewAABBTree(sphere.Triangles())
+	for i := 0; i < 200; i++ {
+		q := Scale(0.12, randomVec(rnd))
+		dir := Unit(Vec{X: rnd.NormFloat64(), Y: rnd.NormFloat64(), Z: rnd.NormFloat64()})
+		n := len(tree.Intersections(Ray{Origin: q, Dir: dir}))
+		inside := Norm(q) < 0.9
+		outside := Norm(q) > 1
+		if (inside && n%2 != 1) || (outside && n%2 != 0) {
+			t.Errorf("unexpected number of intersections for point %v at distance %v: %d", q, Norm(q), n)
+		}
+	}
+}
+
+func BenchmarkAABBTreeIntersectRay(b *testing.B) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	p := randomSphere(rnd, 2000)
+	tree := NewAABBTree(Mesh{Vertices: p, Faces: ConvexHull(p)}.Triangles())
+	r := Ray{Dir: Vec{X: 1, Y: 0.3, Z: 0.1}}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree.IntersectRay(r)
+	}
+}
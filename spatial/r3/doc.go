@@ -2,5 +2,6 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// Package r3 provides 3D vectors and boxes and operations on them.
+// Package r3 provides 3D vectors and boxes and operations on them,
+// including convex hulls.
 package r3 // import "gonum.org/v1/gonum/spatial/r3"
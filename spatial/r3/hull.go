@@ -0,0 +1,137 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import "math"
+
+// ConvexHull returns the triangular faces of the convex hull of the
+// points in p. Each returned face holds the indices into p of its
+// vertices in counter-clockwise order when viewed from outside the
+// hull, so the normal of the corresponding Triangle points outward.
+// Points that lie on the faces of the hull are not included as
+// vertices. If p has fewer than four points or all the points in p
+// are coplanar, ConvexHull returns nil.
+//
+// The hull is calculated using an incremental algorithm and takes
+// O(n²) time in the worst case.
+func ConvexHull(p []Vec) [][3]int {
+	if len(p) < 4 {
+		return nil
+	}
+
+	// Scale the tolerance for visibility
+	// tests by the extent of the points.
+	var scale float64
+	for _, v := range p {
+		scale = math.Max(scale, math.Max(math.Abs(v.X), math.Max(math.Abs(v.Y), math.Abs(v.Z))))
+	}
+	tol := 1e-12 * scale
+
+	// Find an initial non-degenerate tetrahedron.
+	a := 0
+	for i, v := range p {
+		if v.X < p[a].X {
+			a = i
+		}
+	}
+	b := a
+	for i, v := range p {
+		if Norm2(Sub(v, p[a])) > Norm2(Sub(p[b], p[a])) {
+			b = i
+		}
+	}
+	c := -1
+	var best float64
+	for i, v := range p {
+		if d := Norm(Cross(Sub(p[b], p[a]), Sub(v, p[a]))); d > best {
+			c, best = i, d
+		}
+	}
+	if c < 0 || best <= tol*Norm(Sub(p[b], p[a])) {
+		return nil
+	}
+	n := Cross(Sub(p[b], p[a]), Sub(p[c], p[a]))
+	d := -1
+	best = 0
+	for i, v := range p {
+		if h := math.Abs(Dot(n, Sub(v, p[a]))); h > best {
+			d, best = i, h
+		}
+	}
+	if d < 0 || best <= tol*Norm(n) {
+		return nil
+	}
+	if Dot(n, Sub(p[d], p[a])) > 0 {
+		b, c = c, b
+	}
+
+	h := hull{p: p, tol: tol}
+	h.add(a, b, c)
+	h.add(a, c, d)
+	h.add(a, d, b)
+	h.add(b, d, c)
+
+	for i, v := range p {
+		if i == a || i == b || i == c || i == d {
+			continue
+		}
+		h.insert(i, v)
+	}
+
+	var faces [][3]int
+	for _, f := range h.faces {
+		if !f.dead {
+			faces = append(faces, f.v)
+		}
+	}
+	return faces
+}
+
+// hull is a convex hull under incremental construction.
+type hull struct {
+	p   []Vec
+	tol float64
+
+	faces []hullFace
+}
+
+// hullFace is a face of a convex hull with its vertices in
+// counter-clockwise order when viewed from outside.
+type hullFace struct {
+	v    [3]int
+	n    Vec
+	off  float64
+	dead bool
+}
+
+func (h *hull) add(a, b, c int) {
+	n := Unit(Cross(Sub(h.p[b], h.p[a]), Sub(h.p[c], h.p[a])))
+	h.faces = append(h.faces, hullFace{v: [3]int{a, b, c}, n: n, off: Dot(n, h.p[a])})
+}
+
+// insert adds the point v with index i to the hull if it lies
+// outside the hull.
+func (h *hull) insert(i int, v Vec) {
+	type edge struct{ u, v int }
+	var edges []edge
+	visible := make(map[edge]bool)
+	for j, f := range h.faces {
+		if f.dead || Dot(f.n, v)-f.off <= h.tol {
+			continue
+		}
+		h.faces[j].dead = true
+		for k := range f.v {
+			e := edge{f.v[k], f.v[(k+1)%3]}
+			edges = append(edges, e)
+			visible[e] = true
+		}
+	}
+	// Connect the horizon of the visible region to v.
+	for _, e := range edges {
+		if !visible[edge{e.v, e.u}] {
+			h.add(e.u, e.v, i)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func TestConvexHull(t *testing.T) {
+	cube := []Vec{
+		{0, 0, 0}, {1, 0, 0}, {0, 1, 0}, {1, 1, 0},
+		{0, 0, 1}, {1, 0, 1}, {0, 1, 1}, {1, 1, 1},
+		{0.5, 0.5, 0.5}, {0.25, 0.5, 0.75},
+	}
+	faces := ConvexHull(cube)
+	if len(faces) != 12 {
+		t.Errorf("unexpected number of faces for cube: got:%d want:12", len(faces))
+	}
+	checkHull(t, "cube", cube, faces)
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 10; i++ {
+		p := make([]Vec, 200)
+		for j := range p {
+			p[j] = Vec{rnd.NormFloat64(), rnd.NormFloat64(), rnd.NormFloat64()}
+		}
+		checkHull(t, "random", p, ConvexHull(p))
+	}
+
+	// Points on a sphere are all hull vertices.
+	p := make([]Vec, 100)
+	for j := range p {
+		p[j] = Unit(Vec{rnd.NormFloat64(), rnd.NormFloat64(), rnd.NormFloat64()})
+	}
+	faces = ConvexHull(p)
+	checkHull(t, "sphere", p, faces)
+	if want := 2*len(p) - 4; len(faces) != want {
+		t.Errorf("unexpected number of faces for sphere: got:%d want:%d", len(faces), want)
+	}
+}
+
+func checkHull(t *testing.T, name string, p []Vec, faces [][3]int) {
+	t.Helper()
+	if len(faces) == 0 {
+		t.Errorf("no faces for %s", name)
+		return
+	}
+
+	// The hull is a closed manifold: each directed
+	// edge is matched by its reverse exactly once
+	// and the Euler characteristic is two.
+	edges := make(map[[2]int]int)
+	verts := make(map[int]bool)
+	for _, f := range faces {
+		for k := range f {
+			edges[[2]int{f[k], f[(k+1)%3]}]++
+			verts[f[k]] = true
+		}
+	}
+	for e, n := range edges {
+		if n != 1 || edges[[2]int{e[1], e[0]}] != 1 {
+			t.Errorf("hull for %s is not a closed manifold at edge %v", name, e)
+		}
+	}
+	if chi := len(verts) - len(edges)/2 + len(faces); chi != 2 {
+		t.Errorf("unexpected Euler characteristic for %s: got:%d want:2", name, chi)
+	}
+
+	// All points lie on the inner side of every face.
+	for _, f := range faces {
+		n := Triangle{p[f[0]], p[f[1]], p[f[2]]}.Normal()
+		for i, v := range p {
+			if Dot(n, Sub(v, p[f[0]])) > 1e-12 {
+				t.Errorf("point %d outside face %v for %s", i, f, name)
+			}
+		}
+	}
+}
+
+func TestConvexHullDegenerate(t *testing.T) {
+	for _, p := range [][]Vec{
+		nil,
+		{{0, 0, 0}, {1, 0, 0}, {0, 1, 0}},
+		{{0, 0, 0}, {1, 0, 0}, {0, 1, 0}, {1, 1, 0}, {0.5, 0.5, 0}},
+		{{0, 0, 0}, {1, 1, 1}, {2, 2, 2}, {3, 3, 3}},
+	} {
+		if got := ConvexHull(p); got != nil {
+			t.Errorf("unexpected hull for %v: %v", p, got)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+// Mesh is a triangle mesh in 3D space. Each face holds the indices into
+// Vertices of the vertices of a triangle. The ordering of the vertices of
+// each face decides the direction of its normal as described for Triangle.
+// The faces returned by ConvexHull may be used with their points to form
+// a Mesh.
+type Mesh struct {
+	Vertices []Vec
+	Faces    [][3]int
+}
+
+// Triangle returns the triangle of face i of the mesh.
+func (m Mesh) Triangle(i int) Triangle {
+	f := m.Faces[i]
+	return Triangle{m.Vertices[f[0]], m.Vertices[f[1]], m.Vertices[f[2]]}
+}
+
+// Triangles returns the triangles of the faces of the mesh. The triangles
+// may be used to construct an AABBTree for ray queries on the mesh.
+func (m Mesh) Triangles() []Triangle {
+	t := make([]Triangle, len(m.Faces))
+	for i := range t {
+		t[i] = m.Triangle(i)
+	}
+	return t
+}
+
+// Bounds returns the bounding box of the vertices of the mesh. The
+// returned box may have zero extent in some dimensions. If the mesh has
+// no vertices, Bounds returns the zero Box.
+func (m Mesh) Bounds() Box {
+	if len(m.Vertices) == 0 {
+		return Box{}
+	}
+	b := Box{Min: m.Vertices[0], Max: m.Vertices[0]}
+	for _, v := range m.Vertices[1:] {
+		b.Min = minElem(b.Min, v)
+		b.Max = maxElem(b.Max, v)
+	}
+	return b
+}
+
+// Area returns the surface area of the mesh, the sum of the areas of its
+// faces.
+func (m Mesh) Area() float64 {
+	var area float64
+	for i := range m.Faces {
+		area += m.Triangle(i).Area()
+	}
+	return area
+}
+
+// Volume returns the signed volume enclosed by the mesh. The mesh must be
+// closed for the volume to be meaningful. The volume is positive if the
+// normals of the faces point out of the enclosed region and negative if
+// they point into it.
+//
+// The volume is calculated as the sum of the signed volumes of the
+// tetrahedra formed by the origin and each face, so it is exact for any
+// closed mesh whether or not it is convex.
+func (m Mesh) Volume() float64 {
+	var vol float64
+	for i := range m.Faces {
+		t := m.Triangle(i)
+		vol += Dot(t[0], Cross(t[1], t[2]))
+	}
+	return vol / 6
+}
+
+// FaceNormals returns the unit normal of each face of the mesh. The
+// normals of degenerate faces are NaN.
+func (m Mesh) FaceNormals() []Vec {
+	n := make([]Vec, len(m.Faces))
+	for i := range m.Faces {
+		n[i] = Unit(m.Triangle(i).Normal())
+	}
+	return n
+}
+
+// VertexNormals returns the unit normal of each vertex of the mesh. The
+// normal of a vertex is the sum of the normals of the faces holding it,
+// each weighted by the area of the face. The normals of vertices that are
+// not held by any face, or at which the weighted face normals cancel, are
+// the zero vector.
+func (m Mesh) VertexNormals() []Vec {
+	n := make([]Vec, len(m.Vertices))
+	for i, f := range m.Faces {
+		// The magnitude of the face normal is
+		// twice the area of the face.
+		fn := m.Triangle(i).Normal()
+		for _, v := range f {
+			n[v] = Add(n[v], fn)
+		}
+	}
+	for i, v := range n {
+		if l := Norm(v); l != 0 {
+			n[i] = Scale(1/l, v)
+		}
+	}
+	return n
+}
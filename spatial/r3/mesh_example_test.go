@@ -0,0 +1,36 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3_test
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/spatial/r3"
+)
+
+func ExampleAABBTree() {
+	// A regular tetrahedron with outward facing normals.
+	m := r3.Mesh{
+		Vertices: []r3.Vec{
+			{X: 1, Y: 1, Z: 1},
+			{X: 1, Y: -1, Z: -1},
+			{X: -1, Y: 1, Z: -1},
+			{X: -1, Y: -1, Z: 1},
+		},
+		Faces: [][3]int{{0, 1, 2}, {0, 3, 1}, {0, 2, 3}, {1, 3, 2}},
+	}
+	fmt.Printf("volume=%.4f area=%.4f\n", m.Volume(), m.Area())
+
+	// Cast a ray from the centroid of the tetrahedron.
+	tree := r3.NewAABBTree(m.Triangles())
+	hit, ok := tree.IntersectRay(r3.Ray{Dir: r3.Vec{X: 1, Y: 1, Z: 0}})
+	if ok {
+		fmt.Printf("ray hits face %d at %.4v\n", hit.Index, hit.Point)
+	}
+
+	// Output:
+	// volume=2.6667 area=13.8564
+	// ray hits face 0 at {0.5 0.5 0}
+}
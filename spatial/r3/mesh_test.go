@@ -0,0 +1,116 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+// unitCube returns a mesh of the unit cube with outward normals. The
+// vertex with index i is at {i&1, i>>1&1, i>>2&1}.
+func unitCube() Mesh {
+	m := Mesh{Faces: [][3]int{
+		{0, 2, 3}, {0, 3, 1}, // z=0
+		{4, 5, 7}, {4, 7, 6}, // z=1
+		{0, 1, 5}, {0, 5, 4}, // y=0
+		{2, 6, 7}, {2, 7, 3}, // y=1
+		{0, 4, 6}, {0, 6, 2}, // x=0
+		{1, 3, 7}, {1, 7, 5}, // x=1
+	}}
+	for i := 0; i < 8; i++ {
+		m.Vertices = append(m.Vertices, Vec{X: float64(i & 1), Y: float64(i >> 1 & 1), Z: float64(i >> 2 & 1)})
+	}
+	return m
+}
+
+// randomSphere returns n random points on the unit sphere.
+func randomSphere(rnd *rand.Rand, n int) []Vec {
+	p := make([]Vec, n)
+	for i := range p {
+		p[i] = Unit(Vec{X: rnd.NormFloat64(), Y: rnd.NormFloat64(), Z: rnd.NormFloat64()})
+	}
+	return p
+}
+
+func TestMesh(t *testing.T) {
+	const tol = 1e-14
+
+	cube := unitCube()
+	if got := cube.Area(); !scalar.EqualWithinAbs(got, 6, tol) {
+		t.Errorf("unexpected cube area: got:%v want:6", got)
+	}
+	if got := cube.Volume(); !scalar.EqualWithinAbs(got, 1, tol) {
+		t.Errorf("unexpected cube volume: got:%v want:1", got)
+	}
+	if got := cube.Bounds(); got != NewBox(0, 0, 0, 1, 1, 1) {
+		t.Errorf("unexpected cube bounds: got:%v want:%v", got, NewBox(0, 0, 0, 1, 1, 1))
+	}
+
+	shifted := Mesh{Faces: cube.Faces}
+	for _, v := range cube.Vertices {
+		shifted.Vertices = append(shifted.Vertices, Add(v, Vec{X: 3, Y: -2, Z: 5}))
+	}
+	if got := shifted.Volume(); !scalar.EqualWithinAbs(got, 1, tol) {
+		t.Errorf("unexpected shifted cube volume: got:%v want:1", got)
+	}
+
+	inverted := Mesh{Vertices: cube.Vertices}
+	for _, f := range cube.Faces {
+		inverted.Faces = append(inverted.Faces, [3]int{f[0], f[2], f[1]})
+	}
+	if got := inverted.Volume(); !scalar.EqualWithinAbs(got, -1, tol) {
+		t.Errorf("unexpected inverted cube volume: got:%v want:-1", got)
+	}
+
+	center := Vec{X: 0.5, Y: 0.5, Z: 0.5}
+	for i, n := range cube.FaceNormals() {
+		f := cube.Triangle(i)
+		if !scalar.EqualWithinAbs(Norm(n), 1, tol) {
+			t.Errorf("face normal %d is not a unit vector: %v", i, n)
+		}
+		if Dot(n, Sub(f.Centroid(), center)) <= 0 {
+			t.Errorf("face normal %d does not point outward: %v", i, n)
+		}
+	}
+	normals := cube.VertexNormals()
+	for i, n := range normals {
+		if !scalar.EqualWithinAbs(Norm(n), 1, tol) {
+			t.Errorf("vertex normal %d is not a unit vector: %v", i, n)
+		}
+		if Dot(n, Sub(cube.Vertices[i], center)) <= 0 {
+			t.Errorf("vertex normal %d does not point outward: %v", i, n)
+		}
+	}
+	for _, i := range []int{0, 7} {
+		want := Unit(Sub(cube.Vertices[i], center))
+		if Norm(Sub(normals[i], want)) > tol {
+			t.Errorf("unexpected vertex normal %d: got:%v want:%v", i, normals[i], want)
+		}
+	}
+
+	unused := Mesh{Vertices: append(cube.Vertices, Vec{X: 5}), Faces: cube.Faces}
+	if got := unused.VertexNormals()[8]; got != (Vec{}) {
+		t.Errorf("unexpected normal for unused vertex: got:%v want:%v", got, Vec{})
+	}
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	p := randomSphere(rnd, 5000)
+	sphere := Mesh{Vertices: p, Faces: ConvexHull(p)}
+	if got, want := sphere.Volume(), 4*math.Pi/3; !scalar.EqualWithinRel(got, want, 1e-2) {
+		t.Errorf("unexpected sphere volume: got:%v want:%v", got, want)
+	}
+	if got, want := sphere.Area(), 4*math.Pi; !scalar.EqualWithinRel(got, want, 1e-2) {
+		t.Errorf("unexpected sphere area: got:%v want:%v", got, want)
+	}
+	for i, n := range sphere.VertexNormals() {
+		if Dot(n, p[i]) < 0.99 {
+			t.Errorf("unexpected sphere vertex normal %d: got:%v want:%v", i, n, p[i])
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import "math"
+
+// Ray is a half-line in 3D space starting at Origin and
+// extending in the direction of Dir. Dir need not be a
+// unit vector; distances along the ray are measured in
+// multiples of Dir.
+type Ray struct {
+	Origin, Dir Vec
+}
+
+// At returns the point on the ray at the parameter t,
+// Origin+t*Dir.
+func (r Ray) At(t float64) Vec {
+	return Add(r.Origin, Scale(t, r.Dir))
+}
+
+// IntersectRay returns the parameter t of the point of intersection of
+// the ray r with the triangle and whether they intersect. The point of
+// intersection is r.At(t). Rays that lie in the plane of the triangle
+// are not considered to intersect it.
+func (t Triangle) IntersectRay(r Ray) (float64, bool) {
+	w, ok := t.intersect(r.Origin, r.Dir)
+	if !ok || w < 0 {
+		return 0, false
+	}
+	return w, true
+}
+
+// IntersectRay returns the parameters of the points where the ray r
+// enters and leaves the box and whether they intersect. If the origin
+// of r is within the box, tmin is zero. The box is treated as closed,
+// so boxes with zero extent in some dimension may be intersected.
+func (a Box) IntersectRay(r Ray) (tmin, tmax float64, ok bool) {
+	tmin, tmax = 0, math.Inf(1)
+	for _, s := range [3][4]float64{
+		{r.Origin.X, r.Dir.X, a.Min.X, a.Max.X},
+		{r.Origin.Y, r.Dir.Y, a.Min.Y, a.Max.Y},
+		{r.Origin.Z, r.Dir.Z, a.Min.Z, a.Max.Z},
+	} {
+		o, d, lo, hi := s[0], s[1], s[2], s[3]
+		if d == 0 {
+			if o < lo || hi < o {
+				return 0, 0, false
+			}
+			continue
+		}
+		t0 := (lo - o) / d
+		t1 := (hi - o) / d
+		if t0 > t1 {
+			t0, t1 = t1, t0
+		}
+		tmin = math.Max(tmin, t0)
+		tmax = math.Min(tmax, t1)
+		if tmin > tmax {
+			return 0, 0, false
+		}
+	}
+	return tmin, tmax, true
+}
@@ -0,0 +1,83 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func TestTriangleIntersectRay(t *testing.T) {
+	tri := Triangle{{0, 0, 0}, {1, 0, 0}, {0, 1, 0}}
+	for _, test := range []struct {
+		ray   Ray
+		want  float64
+		found bool
+	}{
+		{ray: Ray{Origin: Vec{0.25, 0.25, 1}, Dir: Vec{0, 0, -1}}, want: 1, found: true},
+		{ray: Ray{Origin: Vec{0.25, 0.25, 1}, Dir: Vec{0, 0, -2}}, want: 0.5, found: true},
+		{ray: Ray{Origin: Vec{0.25, 0.25, -3}, Dir: Vec{0, 0, 1}}, want: 3, found: true},
+		{ray: Ray{Origin: Vec{0.25, 0.25, 1}, Dir: Vec{0, 0, 1}}, found: false},
+		{ray: Ray{Origin: Vec{1, 1, 1}, Dir: Vec{0, 0, -1}}, found: false},
+		{ray: Ray{Origin: Vec{-1, 0.25, 0}, Dir: Vec{1, 0, 0}}, found: false},
+	} {
+		got, ok := tri.IntersectRay(test.ray)
+		if ok != test.found || got != test.want {
+			t.Errorf("unexpected intersection of %v: got:%v %t want:%v %t", test.ray, got, ok, test.want, test.found)
+		}
+	}
+
+	// Rays agree with segments.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 1000; i++ {
+		tri := randomTriangle(rnd)
+		s := Segment{randomVec(rnd), randomVec(rnd)}
+		r := Ray{Origin: s[0], Dir: Sub(s[1], s[0])}
+		p, okSeg := tri.IntersectSegment(s)
+		w, okRay := tri.IntersectRay(r)
+		if okSeg && (!okRay || Norm(Sub(r.At(w), p)) > 1e-12) {
+			t.Errorf("ray does not agree with segment intersection for test %d: got:%v %t want:%v", i, r.At(w), okRay, p)
+		}
+		if okRay && w <= 1 && !okSeg {
+			t.Errorf("ray intersection %v within segment without segment intersection for test %d", w, i)
+		}
+	}
+}
+
+func TestBoxIntersectRay(t *testing.T) {
+	box := NewBox(0, 0, 0, 1, 2, 3)
+	for _, test := range []struct {
+		ray        Ray
+		tmin, tmax float64
+		found      bool
+	}{
+		{ray: Ray{Origin: Vec{-1, 1, 1}, Dir: Vec{1, 0, 0}}, tmin: 1, tmax: 2, found: true},
+		{ray: Ray{Origin: Vec{0.5, 1, 1}, Dir: Vec{0, 0, 1}}, tmin: 0, tmax: 2, found: true},
+		{ray: Ray{Origin: Vec{0.5, 1, 5}, Dir: Vec{0, 0, -2}}, tmin: 1, tmax: 2.5, found: true},
+		{ray: Ray{Origin: Vec{-1, -1, -1}, Dir: Vec{1, 1, 1}}, tmin: 1, tmax: 2, found: true},
+		{ray: Ray{Origin: Vec{-1, 1, 1}, Dir: Vec{-1, 0, 0}}, found: false},
+		{ray: Ray{Origin: Vec{-1, 3, 1}, Dir: Vec{1, 0, 0}}, found: false},
+		{ray: Ray{Origin: Vec{-1, 0, 0}, Dir: Vec{1, 0, 0}}, tmin: 1, tmax: 2, found: true},
+	} {
+		tmin, tmax, ok := box.IntersectRay(test.ray)
+		if ok != test.found || tmin != test.tmin || tmax != test.tmax {
+			t.Errorf("unexpected intersection of %v: got:%v %v %t want:%v %v %t",
+				test.ray, tmin, tmax, ok, test.tmin, test.tmax, test.found)
+		}
+	}
+
+	// A flat box is intersected.
+	flat := Box{Min: Vec{0, 0, 0}, Max: Vec{1, 1, 0}}
+	tmin, tmax, ok := flat.IntersectRay(Ray{Origin: Vec{0.5, 0.5, 1}, Dir: Vec{0, 0, -1}})
+	if !ok || tmin != 1 || tmax != 1 {
+		t.Errorf("unexpected intersection of flat box: got:%v %v %t want:1 1 true", tmin, tmax, ok)
+	}
+	if _, _, ok := flat.IntersectRay(Ray{Origin: Vec{2, 0.5, 1}, Dir: Vec{0, 0, -1}}); ok {
+		t.Error("unexpected intersection of flat box")
+	}
+	if got := (Ray{Origin: Vec{1, 2, 3}, Dir: Vec{1, 0, -1}}).At(2); got != (Vec{3, 2, 1}) {
+		t.Errorf("unexpected point on ray: got:%v want:%v", got, Vec{3, 2, 1})
+	}
+}
@@ -7,18 +7,10 @@ package r3
 import (
 	"math"
 
+	"gonum.org/v1/gonum/mat"
 	"gonum.org/v1/gonum/num/quat"
 )
 
-// TODO: possibly useful additions to the current rotation API:
-//  - create rotations from Euler angles (NewRotationFromEuler?)
-//  - create rotations from rotation matrices (NewRotationFromMatrix?)
-//  - return the equivalent Euler angles from a Rotation
-//
-// Euler angles have issues (see [1] for a discussion).
-// We should think carefully before adding them in.
-// [1]: http://www.euclideanspace.com/maths/geometry/rotations/conversions/quaternionToEuler/
-
 // Rotation describes a rotation in space.
 type Rotation quat.Number
 
@@ -38,6 +30,95 @@ func NewRotation(alpha float64, axis Vec) Rotation {
 	return Rotation(q)
 }
 
+// NewRotationFromEuler creates a rotation from the Euler angles alpha, beta
+// and gamma, which are rotations around the x, y and z axes respectively.
+// The rotations are applied in the order x, y, z around the fixed axes,
+// so the rotation matrix is R_z(gamma) R_y(beta) R_x(alpha). This is
+// equivalent to intrinsic rotations around the z, y and x axes (yaw, pitch
+// and roll).
+//
+// Euler angles have a singularity when beta is ±π/2, where rotations around
+// the x and z axes are about the same axis and a degree of freedom is lost.
+// See https://en.wikipedia.org/wiki/Euler_angles for more details.
+func NewRotationFromEuler(alpha, beta, gamma float64) Rotation {
+	var x, y, z quat.Number
+	x.Imag, x.Real = math.Sincos(alpha / 2)
+	y.Jmag, y.Real = math.Sincos(beta / 2)
+	z.Kmag, z.Real = math.Sincos(gamma / 2)
+	return Rotation(quat.Mul(z, quat.Mul(y, x)))
+}
+
+// NewRotationFromMat creates a rotation from the 3×3 rotation matrix m.
+// The matrix m should be orthogonal with a determinant of 1; the result
+// is normalized, but no other correction is made for matrices that are not
+// rotations. NewRotationFromMat panics with mat.ErrShape if m is not 3×3.
+//
+// The conversion follows Shepperd, "Quaternion from rotation matrix",
+// Journal of Guidance and Control 1:223-224. doi:10.2514/3.55767.
+func NewRotationFromMat(m mat.Matrix) Rotation {
+	r, c := m.Dims()
+	if r != 3 || c != 3 {
+		panic(mat.ErrShape)
+	}
+	m00, m01, m02 := m.At(0, 0), m.At(0, 1), m.At(0, 2)
+	m10, m11, m12 := m.At(1, 0), m.At(1, 1), m.At(1, 2)
+	m20, m21, m22 := m.At(2, 0), m.At(2, 1), m.At(2, 2)
+
+	// Compute the largest component of the quaternion from the
+	// diagonal and the remaining components relative to it.
+	var q quat.Number
+	switch tr := m00 + m11 + m22; {
+	case tr > 0:
+		s := 2 * math.Sqrt(1+tr)
+		q = quat.Number{Real: s / 4, Imag: (m21 - m12) / s, Jmag: (m02 - m20) / s, Kmag: (m10 - m01) / s}
+	case m00 > m11 && m00 > m22:
+		s := 2 * math.Sqrt(1+m00-m11-m22)
+		q = quat.Number{Real: (m21 - m12) / s, Imag: s / 4, Jmag: (m01 + m10) / s, Kmag: (m02 + m20) / s}
+	case m11 > m22:
+		s := 2 * math.Sqrt(1+m11-m00-m22)
+		q = quat.Number{Real: (m02 - m20) / s, Imag: (m01 + m10) / s, Jmag: s / 4, Kmag: (m12 + m21) / s}
+	default:
+		s := 2 * math.Sqrt(1+m22-m00-m11)
+		q = quat.Number{Real: (m10 - m01) / s, Imag: (m02 + m20) / s, Jmag: (m12 + m21) / s, Kmag: s / 4}
+	}
+	if q.Real < 0 {
+		q = quat.Scale(-1, q)
+	}
+	return Rotation(quat.Scale(1/quat.Abs(q), q))
+}
+
+// AxisAngle returns the rotation angle in [0, π] and the unit axis of the
+// rotation. For the identity rotation, AxisAngle returns a zero angle and
+// the zero vector.
+func (r Rotation) AxisAngle() (alpha float64, axis Vec) {
+	q := quat.Number(r)
+	v := Vec{X: q.Imag, Y: q.Jmag, Z: q.Kmag}
+	n := Norm(v)
+	if n == 0 {
+		return 0, Vec{}
+	}
+	if q.Real < 0 {
+		v = Scale(-1, v)
+	}
+	return 2 * math.Atan2(n, math.Abs(q.Real)), Scale(1/n, v)
+}
+
+// Euler returns the Euler angles alpha, beta and gamma of the rotation
+// around the x, y and z axes, using the convention of NewRotationFromEuler.
+// The returned beta is in [-π/2, π/2] and alpha and gamma are in [-π, π].
+// At the singularity where beta is ±π/2, alpha is returned as zero and the
+// combined rotation is returned in gamma.
+func (r Rotation) Euler() (alpha, beta, gamma float64) {
+	m := r.Mat()
+	r20 := m.At(2, 0)
+	cb := math.Hypot(m.At(0, 0), m.At(1, 0))
+	beta = math.Atan2(-r20, cb)
+	if cb < 1e-12 {
+		return 0, beta, math.Atan2(-m.At(0, 1), m.At(1, 1))
+	}
+	return math.Atan2(m.At(2, 1), m.At(2, 2)), beta, math.Atan2(m.At(1, 0), m.At(0, 0))
+}
+
 // Rotate returns p rotated according to the parameters used to construct
 // the receiver.
 func (r Rotation) Rotate(p Vec) Vec {
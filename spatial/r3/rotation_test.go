@@ -0,0 +1,139 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/num/quat"
+)
+
+// sameRotation returns whether a and b represent the same rotation,
+// allowing for the sign ambiguity of the quaternion representation.
+func sameRotation(a, b Rotation, tol float64) bool {
+	qa, qb := quat.Number(a), quat.Number(b)
+	return quat.Abs(quat.Sub(qa, qb)) < tol || quat.Abs(quat.Add(qa, qb)) < tol
+}
+
+func TestNewRotationFromMat(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	rnd := rand.New(rand.NewPCG(1, 1))
+
+	for tc := 0; tc < 50; tc++ {
+		q := quat.Number{Real: rnd.NormFloat64(), Imag: rnd.NormFloat64(), Jmag: rnd.NormFloat64(), Kmag: rnd.NormFloat64()}
+		r := Rotation(quat.Scale(1/quat.Abs(q), q))
+		got := NewRotationFromMat(r.Mat())
+		if !sameRotation(got, r, tol) {
+			t.Errorf("case %d: unexpected rotation from matrix: got:%v want:%v", tc, got, r)
+		}
+	}
+
+	// Rotations near π around each axis exercise each branch of the
+	// conversion.
+	for _, axis := range []Vec{{X: 1}, {Y: 1}, {Z: 1}, {X: 1, Y: -1, Z: 0.5}} {
+		for _, alpha := range []float64{0, 0.5, math.Pi - 1e-3, math.Pi} {
+			r := NewRotation(alpha, axis)
+			got := NewRotationFromMat(r.Mat())
+			if !sameRotation(got, r, tol) {
+				t.Errorf("unexpected rotation from matrix for %v around %v: got:%v want:%v", alpha, axis, got, r)
+			}
+		}
+	}
+
+	// Any mat.Matrix may be used.
+	m := mat.NewDense(3, 3, []float64{
+		0, -1, 0,
+		1, 0, 0,
+		0, 0, 1,
+	})
+	want := NewRotation(math.Pi/2, Vec{Z: 1})
+	if got := NewRotationFromMat(m); !sameRotation(got, want, tol) {
+		t.Errorf("unexpected rotation from dense matrix: got:%v want:%v", got, want)
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r != mat.ErrShape {
+				t.Errorf("unexpected panic for 3×2 matrix: got:%v want:%v", r, mat.ErrShape)
+			}
+		}()
+		NewRotationFromMat(mat.NewDense(3, 2, nil))
+	}()
+}
+
+func TestRotationEuler(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+
+	for tc := 0; tc < 50; tc++ {
+		alpha := math.Pi * (2*rnd.Float64() - 1)
+		beta := math.Pi / 2 * (2*rnd.Float64() - 1)
+		gamma := math.Pi * (2*rnd.Float64() - 1)
+		r := NewRotationFromEuler(alpha, beta, gamma)
+
+		// Check against composition of single axis rotations.
+		want := NewRotation(gamma, Vec{Z: 1}).Rotate(NewRotation(beta, Vec{Y: 1}).Rotate(NewRotation(alpha, Vec{X: 1}).Rotate(Vec{X: 1, Y: 2, Z: 3})))
+		if got := r.Rotate(Vec{X: 1, Y: 2, Z: 3}); Norm(Sub(got, want)) > tol {
+			t.Errorf("case %d: unexpected rotation: got:%v want:%v", tc, got, want)
+		}
+
+		a, b, g := r.Euler()
+		if !scalar.EqualWithinAbs(a, alpha, tol) || !scalar.EqualWithinAbs(b, beta, tol) || !scalar.EqualWithinAbs(g, gamma, tol) {
+			t.Errorf("case %d: unexpected Euler angles: got:(%v, %v, %v) want:(%v, %v, %v)", tc, a, b, g, alpha, beta, gamma)
+		}
+	}
+
+	// At the singularity the angles are not unique, but must
+	// reproduce the rotation.
+	for _, beta := range []float64{math.Pi / 2, -math.Pi / 2} {
+		r := NewRotationFromEuler(0.3, beta, -1.1)
+		a, b, g := r.Euler()
+		if a != 0 {
+			t.Errorf("unexpected alpha at singularity: got:%v want:0", a)
+		}
+		if got := NewRotationFromEuler(a, b, g); !sameRotation(got, r, tol) {
+			t.Errorf("unexpected rotation from Euler angles at singularity: got:%v want:%v", got, r)
+		}
+	}
+}
+
+func TestRotationAxisAngle(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	for _, test := range []struct {
+		alpha float64
+		axis  Vec
+	}{
+		{alpha: 0.5, axis: Vec{X: 1}},
+		{alpha: 2, axis: Vec{X: 1, Y: 1, Z: 1}},
+		{alpha: math.Pi, axis: Vec{Z: 1}},
+		{alpha: -1, axis: Vec{Y: 2}},
+		{alpha: 5, axis: Vec{X: -1, Z: 3}},
+	} {
+		alpha, axis := test.alpha, Unit(test.axis)
+		// Normalize the expected angle to [0, π].
+		alpha = math.Remainder(alpha, 2*math.Pi)
+		if alpha < 0 {
+			alpha, axis = -alpha, Scale(-1, axis)
+		}
+		r := NewRotation(test.alpha, test.axis)
+		gotAlpha, gotAxis := r.AxisAngle()
+		if !scalar.EqualWithinAbs(gotAlpha, alpha, tol) || Norm(Sub(gotAxis, axis)) > tol {
+			t.Errorf("unexpected axis-angle for %v around %v: got:(%v, %v) want:(%v, %v)",
+				test.alpha, test.axis, gotAlpha, gotAxis, alpha, axis)
+		}
+	}
+
+	alpha, axis := Rotation{Real: 1}.AxisAngle()
+	if alpha != 0 || axis != (Vec{}) {
+		t.Errorf("unexpected axis-angle for identity: got:(%v, %v)", alpha, axis)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import "math"
+
+// Segment is a line segment between two points.
+type Segment [2]Vec
+
+// ClosestPoints returns the closest points on s and t, a on s and b on t.
+// If the closest points are not unique, one pair of closest points is
+// returned.
+func (s Segment) ClosestPoints(t Segment) (a, b Vec) {
+	// See Ericson, Real-Time Collision Detection, section 5.1.9.
+	d1 := Sub(s[1], s[0])
+	d2 := Sub(t[1], t[0])
+	r := Sub(s[0], t[0])
+	l1 := Norm2(d1)
+	l2 := Norm2(d2)
+	f := Dot(d2, r)
+
+	var u, v float64
+	switch {
+	case l1 == 0 && l2 == 0:
+	case l1 == 0:
+		v = clamp01(f / l2)
+	default:
+		c := Dot(d1, r)
+		if l2 == 0 {
+			u = clamp01(-c / l1)
+			break
+		}
+		b := Dot(d1, d2)
+		denom := l1*l2 - b*b
+		if denom != 0 {
+			u = clamp01((b*f - c*l2) / denom)
+		}
+		v = (b*u + f) / l2
+		switch {
+		case v < 0:
+			v = 0
+			u = clamp01(-c / l1)
+		case v > 1:
+			v = 1
+			u = clamp01((b - c) / l1)
+		}
+	}
+	return Add(s[0], Scale(u, d1)), Add(t[0], Scale(v, d2))
+}
+
+// Intersect returns the point of intersection of s and t and whether the
+// segments intersect. The segments are considered to intersect if their
+// closest points are within tol of each other, in which case the returned
+// point is the midpoint of the closest points.
+func (s Segment) Intersect(t Segment, tol float64) (Vec, bool) {
+	a, b := s.ClosestPoints(t)
+	if Norm(Sub(a, b)) > tol {
+		return Vec{}, false
+	}
+	return Scale(0.5, Add(a, b)), true
+}
+
+// Distance returns the Euclidean distance from p to the closest
+// point on s.
+func (s Segment) Distance(p Vec) float64 {
+	d := Sub(s[1], s[0])
+	l2 := Norm2(d)
+	if l2 == 0 {
+		return Norm(Sub(p, s[0]))
+	}
+	u := clamp01(Dot(Sub(p, s[0]), d) / l2)
+	return Norm(Sub(p, Add(s[0], Scale(u, d))))
+}
+
+func clamp01(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
@@ -0,0 +1,94 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestSegmentClosestPoints(t *testing.T) {
+	for _, test := range []struct {
+		s, t   Segment
+		a, b   Vec
+		dist   float64
+		wantOK bool
+	}{
+		{
+			s: Segment{{0, 0, 0}, {2, 0, 0}}, t: Segment{{1, -1, 1}, {1, 1, 1}},
+			a: Vec{1, 0, 0}, b: Vec{1, 0, 1}, dist: 1,
+		},
+		{
+			s: Segment{{0, 0, 0}, {2, 0, 0}}, t: Segment{{1, -1, 0}, {1, 1, 0}},
+			a: Vec{1, 0, 0}, b: Vec{1, 0, 0}, dist: 0, wantOK: true,
+		},
+		{
+			s: Segment{{0, 0, 0}, {1, 0, 0}}, t: Segment{{3, 0, 0}, {3, 1, 0}},
+			a: Vec{1, 0, 0}, b: Vec{3, 0, 0}, dist: 2,
+		},
+		{
+			s: Segment{{0, 0, 0}, {0, 0, 0}}, t: Segment{{1, -1, 0}, {1, 1, 0}},
+			a: Vec{0, 0, 0}, b: Vec{1, 0, 0}, dist: 1,
+		},
+		{
+			s: Segment{{0, 0, 0}, {1, 1, 1}}, t: Segment{{2, 2, 2}, {2, 2, 2}},
+			a: Vec{1, 1, 1}, b: Vec{2, 2, 2}, dist: Norm(Vec{1, 1, 1}),
+		},
+	} {
+		a, b := test.s.ClosestPoints(test.t)
+		if !vecApproxEqual(a, test.a, 1e-14) || !vecApproxEqual(b, test.b, 1e-14) {
+			t.Errorf("unexpected closest points for %v and %v: got:%v %v want:%v %v", test.s, test.t, a, b, test.a, test.b)
+		}
+		if d := Norm(Sub(a, b)); !scalar.EqualWithinAbs(d, test.dist, 1e-14) {
+			t.Errorf("unexpected distance between %v and %v: got:%v want:%v", test.s, test.t, d, test.dist)
+		}
+		_, ok := test.s.Intersect(test.t, 1e-12)
+		if ok != test.wantOK {
+			t.Errorf("unexpected intersection result for %v and %v: got:%t want:%t", test.s, test.t, ok, test.wantOK)
+		}
+	}
+}
+
+func TestSegmentDistance(t *testing.T) {
+	s := Segment{{0, 0, 0}, {2, 0, 0}}
+	for _, test := range []struct {
+		p    Vec
+		want float64
+	}{
+		{p: Vec{1, 3, 4}, want: 5},
+		{p: Vec{4, 0, 0}, want: 2},
+		{p: Vec{0, 0, 0}, want: 0},
+	} {
+		if got := s.Distance(test.p); !scalar.EqualWithinAbs(got, test.want, 1e-14) {
+			t.Errorf("unexpected distance from %v: got:%v want:%v", test.p, got, test.want)
+		}
+	}
+}
+
+func TestTriangleIntersectSegment(t *testing.T) {
+	tri := Triangle{{0, 0, 0}, {2, 0, 0}, {0, 2, 0}}
+	for _, test := range []struct {
+		s      Segment
+		want   Vec
+		wantOK bool
+	}{
+		{s: Segment{{0.5, 0.5, -1}, {0.5, 0.5, 1}}, want: Vec{0.5, 0.5, 0}, wantOK: true},
+		{s: Segment{{0.5, 0.5, 1}, {0.5, 0.5, -3}}, want: Vec{0.5, 0.5, 0}, wantOK: true},
+		{s: Segment{{0.5, 0.5, 1}, {0.5, 0.5, 2}}, wantOK: false},
+		{s: Segment{{1.5, 1.5, -1}, {1.5, 1.5, 1}}, wantOK: false},
+		{s: Segment{{0, 0, -1}, {0, 0, 1}}, want: Vec{0, 0, 0}, wantOK: true},
+		{s: Segment{{-1, 0.5, 0}, {3, 0.5, 0}}, wantOK: false},
+	} {
+		got, ok := tri.IntersectSegment(test.s)
+		if ok != test.wantOK {
+			t.Errorf("unexpected intersection result for %v: got:%t want:%t", test.s, ok, test.wantOK)
+			continue
+		}
+		if ok && !vecApproxEqual(got, test.want, 1e-14) {
+			t.Errorf("unexpected intersection for %v: got:%v want:%v", test.s, got, test.want)
+		}
+	}
+}
@@ -12,19 +12,6 @@ import (
 	"gonum.org/v1/gonum/spatial/r3"
 )
 
-// slerp returns the spherical interpolation between q0 and q1
-// for t in [0,1]; 0 corresponds to q0 and 1 corresponds to q1.
-func slerp(r0, r1 r3.Rotation, t float64) r3.Rotation {
-	q0 := quat.Number(r0)
-	q1 := quat.Number(r1)
-	// Based on Simo Särkkä "Notes on Quaternions" Eq. 35
-	//  p(t) = (q1 ∗ q0^−1) ^ t ∗ q0
-	// https://users.aalto.fi/~ssarkka/pub/quat.pdf
-	q1 = quat.Mul(q1, quat.Inv(q0))
-	q1 = quat.PowReal(q1, t)
-	return r3.Rotation(quat.Mul(q1, q0))
-}
-
 // Spherically interpolate between two quaternions to obtain a rotation.
 func Example_slerp() {
 	const steps = 10
@@ -37,7 +24,7 @@ func Example_slerp() {
 	v := r3.Vec{X: 1, Y: 1, Z: 1}
 	for i := 0.0; i <= steps; i++ {
 		t := i / steps
-		rotated := slerp(initialRot, finalRot, t).Rotate(v)
+		rotated := r3.Rotation(quat.Slerp(quat.Number(initialRot), quat.Number(finalRot), t)).Rotate(v)
 		fmt.Printf("%.2f %+.2f\n", t, rotated)
 	}
 
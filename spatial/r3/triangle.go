@@ -116,3 +116,43 @@ func (l line) distance(p Vec) float64 {
 	num := Norm(Cross(Sub(p, l[0]), Sub(p, l[1])))
 	return num / Norm(Sub(l[1], l[0]))
 }
+
+// IntersectSegment returns the point of intersection of the segment s
+// with the triangle and whether they intersect. Segments that lie in the
+// plane of the triangle are not considered to intersect it.
+func (t Triangle) IntersectSegment(s Segment) (Vec, bool) {
+	dir := Sub(s[1], s[0])
+	w, ok := t.intersect(s[0], dir)
+	if !ok || w < 0 || w > 1 {
+		return Vec{}, false
+	}
+	return Add(s[0], Scale(w, dir)), true
+}
+
+// intersect returns the parameter w such that orig+w*dir lies in the
+// triangle and whether such a parameter exists. Lines that lie in the
+// plane of the triangle are not considered to intersect it.
+func (t Triangle) intersect(orig, dir Vec) (w float64, ok bool) {
+	// See Möller and Trumbore, "Fast, minimum storage ray-triangle
+	// intersection", Journal of Graphics Tools 2(1):21-28.
+	// doi:10.1080/10867651.1997.10487468
+	e1 := Sub(t[1], t[0])
+	e2 := Sub(t[2], t[0])
+	p := Cross(dir, e2)
+	det := Dot(e1, p)
+	if det == 0 {
+		return 0, false
+	}
+	inv := 1 / det
+	r := Sub(orig, t[0])
+	u := Dot(r, p) * inv
+	if u < 0 || u > 1 {
+		return 0, false
+	}
+	q := Cross(r, e1)
+	v := Dot(dir, q) * inv
+	if v < 0 || u+v > 1 {
+		return 0, false
+	}
+	return Dot(e2, q) * inv, true
+}
@@ -0,0 +1,149 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rtree
+
+import (
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/spatial/r2"
+	"gonum.org/v1/gonum/spatial/r3"
+)
+
+// Box is an axis-aligned box in a k-dimensional space. Well formed
+// Boxes have Min components no greater than Max components and Min
+// and Max of equal length. A Box with equal Min and Max is a point.
+type Box struct {
+	Min, Max []float64
+}
+
+// FromR2 returns the Box corresponding to b.
+func FromR2(b r2.Box) Box {
+	b = b.Canon()
+	return Box{Min: []float64{b.Min.X, b.Min.Y}, Max: []float64{b.Max.X, b.Max.Y}}
+}
+
+// FromR3 returns the Box corresponding to b.
+func FromR3(b r3.Box) Box {
+	b = b.Canon()
+	return Box{Min: []float64{b.Min.X, b.Min.Y, b.Min.Z}, Max: []float64{b.Max.X, b.Max.Y, b.Max.Z}}
+}
+
+// PointR2 returns the Box holding only v.
+func PointR2(v r2.Vec) Box {
+	return FromR2(r2.Box{Min: v, Max: v})
+}
+
+// PointR3 returns the Box holding only v.
+func PointR3(v r3.Vec) Box {
+	return FromR3(r3.Box{Min: v, Max: v})
+}
+
+// Dims returns the number of dimensions of the box.
+func (b Box) Dims() int { return len(b.Min) }
+
+// Volume returns the volume of the box.
+func (b Box) Volume() float64 {
+	v := 1.0
+	for i, min := range b.Min {
+		v *= b.Max[i] - min
+	}
+	return v
+}
+
+// Margin returns the sum of the edge lengths of the box along
+// each dimension.
+func (b Box) Margin() float64 {
+	var m float64
+	for i, min := range b.Min {
+		m += b.Max[i] - min
+	}
+	return m
+}
+
+// Intersects returns whether b and c share any points, including
+// points on their boundaries.
+func (b Box) Intersects(c Box) bool {
+	for i, min := range b.Min {
+		if min > c.Max[i] || c.Min[i] > b.Max[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Contains returns whether c is within b.
+func (b Box) Contains(c Box) bool {
+	for i, min := range b.Min {
+		if c.Min[i] < min || c.Max[i] > b.Max[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Union returns the smallest box enclosing both b and c.
+func (b Box) Union(c Box) Box {
+	u := Box{Min: make([]float64, len(b.Min)), Max: make([]float64, len(b.Max))}
+	for i := range b.Min {
+		u.Min[i] = math.Min(b.Min[i], c.Min[i])
+		u.Max[i] = math.Max(b.Max[i], c.Max[i])
+	}
+	return u
+}
+
+// Distance returns the minimum Euclidean distance between points
+// in b and c. If b and c intersect, Distance returns zero.
+func (b Box) Distance(c Box) float64 {
+	var sum float64
+	for i, min := range b.Min {
+		var d float64
+		switch {
+		case c.Max[i] < min:
+			d = min - c.Max[i]
+		case b.Max[i] < c.Min[i]:
+			d = c.Min[i] - b.Max[i]
+		}
+		sum += d * d
+	}
+	return math.Sqrt(sum)
+}
+
+// Equal returns whether b and c are the same box.
+func (b Box) Equal(c Box) bool {
+	return slices.Equal(b.Min, c.Min) && slices.Equal(b.Max, c.Max)
+}
+
+// clone returns a copy of b that does not share storage.
+func (b Box) clone() Box {
+	return Box{Min: slices.Clone(b.Min), Max: slices.Clone(b.Max)}
+}
+
+// overlap returns the volume of the intersection of b and c.
+func (b Box) overlap(c Box) float64 {
+	v := 1.0
+	for i := range b.Min {
+		d := math.Min(b.Max[i], c.Max[i]) - math.Max(b.Min[i], c.Min[i])
+		if d <= 0 {
+			return 0
+		}
+		v *= d
+	}
+	return v
+}
+
+// enlargement returns the increase in volume of b needed to include c.
+func (b Box) enlargement(c Box) float64 {
+	v := 1.0
+	for i := range b.Min {
+		v *= math.Max(b.Max[i], c.Max[i]) - math.Min(b.Min[i], c.Min[i])
+	}
+	return v - b.Volume()
+}
+
+// center returns the center of b along dimension i.
+func (b Box) center(i int) float64 {
+	return (b.Min[i] + b.Max[i]) / 2
+}
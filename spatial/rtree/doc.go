@@ -0,0 +1,12 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package rtree implements an R*-tree. R*-trees provide an efficient
+// index of axis-aligned boxes supporting window queries and nearest
+// neighbor search.
+//
+// See "The R*-tree: an efficient and robust access method for points
+// and rectangles", SIGMOD'90 322-331. doi:10.1145/93597.98741 for
+// details of R*-trees.
+package rtree // import "gonum.org/v1/gonum/spatial/rtree"
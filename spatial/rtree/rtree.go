@@ -0,0 +1,479 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rtree
+
+import (
+	"container/heap"
+	"math"
+	"slices"
+)
+
+// Tree is an R*-tree index of boxes and their associated values.
+type Tree struct {
+	dims     int
+	min, max int
+
+	root  *node
+	count int
+
+	// reinserted records the levels at which
+	// forced reinsertion has been performed
+	// during the current insertion.
+	reinserted []bool
+}
+
+// node is an R*-tree node. Leaf nodes have level zero and
+// hold entries with values. Internal nodes hold entries
+// with children one level below them.
+type node struct {
+	level   int
+	entries []entry
+}
+
+// entry is a node entry holding either a child node
+// or a stored value.
+type entry struct {
+	box   Box
+	child *node
+	value any
+}
+
+// bounds returns the bounding box of the entries of n.
+func (n *node) bounds() Box {
+	return boundsOf(n.entries)
+}
+
+func boundsOf(e []entry) Box {
+	b := e[0].box.clone()
+	for _, c := range e[1:] {
+		for i := range b.Min {
+			b.Min[i] = math.Min(b.Min[i], c.box.Min[i])
+			b.Max[i] = math.Max(b.Max[i], c.box.Max[i])
+		}
+	}
+	return b
+}
+
+// New returns a new empty R*-tree for boxes with the given number of
+// dimensions. The maxEntries parameter is the maximum number of entries
+// held by each node; if it is less than 4, a value of 16 is used. The
+// minimum number of entries in a node other than the root is 40% of
+// maxEntries.
+//
+// New will panic if dims is less than one.
+func New(dims, maxEntries int) *Tree {
+	if dims < 1 {
+		panic("rtree: invalid dimension")
+	}
+	if maxEntries < 4 {
+		maxEntries = 16
+	}
+	return &Tree{
+		dims: dims,
+		min:  max(2, maxEntries*2/5),
+		max:  maxEntries,
+		root: &node{},
+	}
+}
+
+// Dims returns the number of dimensions of the boxes in the tree.
+func (t *Tree) Dims() int { return t.dims }
+
+// Len returns the number of values in the tree.
+func (t *Tree) Len() int { return t.count }
+
+// Insert adds the value v with the bounding box b to the tree. Insert
+// will panic if b does not have the dimensions of the tree or is not well
+// formed.
+func (t *Tree) Insert(b Box, v any) {
+	t.checkBox(b)
+	t.resetReinserted()
+	t.insert(entry{box: b.clone(), value: v}, 0)
+	t.count++
+}
+
+// resetReinserted marks all levels as not having had
+// forced reinsertion.
+func (t *Tree) resetReinserted() {
+	if len(t.reinserted) <= t.root.level {
+		t.reinserted = make([]bool, t.root.level+1)
+	}
+	clear(t.reinserted)
+}
+
+func (t *Tree) checkBox(b Box) {
+	if len(b.Min) != t.dims || len(b.Max) != t.dims {
+		panic("rtree: dimension mismatch")
+	}
+	for i, min := range b.Min {
+		if !(min <= b.Max[i]) {
+			panic("rtree: malformed box")
+		}
+	}
+}
+
+// insert adds e to a node at the given level of the tree.
+func (t *Tree) insert(e entry, level int) {
+	path := t.chooseSubtree(e.box, level)
+	n := path[len(path)-1]
+	n.entries = append(n.entries, e)
+
+	for i := len(path) - 1; i >= 0; i-- {
+		n := path[i]
+		if len(n.entries) <= t.max {
+			if i > 0 {
+				t.updateBox(path[i-1], n)
+			}
+			continue
+		}
+		if n.level >= len(t.reinserted) {
+			t.reinserted = append(t.reinserted, make([]bool, n.level+1-len(t.reinserted))...)
+		}
+		if i > 0 && !t.reinserted[n.level] {
+			t.reinserted[n.level] = true
+			removed := t.pickReinsert(n)
+			for j := i; j > 0; j-- {
+				t.updateBox(path[j-1], path[j])
+			}
+			for _, r := range removed {
+				t.insert(r, n.level)
+			}
+			return
+		}
+
+		a, b := t.split(n.entries)
+		n.entries = a
+		sibling := &node{level: n.level, entries: b}
+		if i == 0 {
+			t.root = &node{
+				level: n.level + 1,
+				entries: []entry{
+					{box: n.bounds(), child: n},
+					{box: sibling.bounds(), child: sibling},
+				},
+			}
+			return
+		}
+		parent := path[i-1]
+		t.updateBox(parent, n)
+		parent.entries = append(parent.entries, entry{box: sibling.bounds(), child: sibling})
+	}
+}
+
+// updateBox sets the box of the entry for child in parent to the
+// bounds of child.
+func (t *Tree) updateBox(parent, child *node) {
+	for i, e := range parent.entries {
+		if e.child == child {
+			parent.entries[i].box = child.bounds()
+			return
+		}
+	}
+	panic("rtree: child not found")
+}
+
+// chooseSubtree returns the path from the root to the node at the
+// given level best suited to hold b.
+func (t *Tree) chooseSubtree(b Box, level int) []*node {
+	n := t.root
+	path := []*node{n}
+	for n.level > level {
+		best := -1
+		var bestOverlap, bestEnlarge, bestVolume float64
+		for i, e := range n.entries {
+			var overlap float64
+			if n.level == 1 {
+				// Children are leaves, so minimize the
+				// increase in overlap with siblings.
+				u := e.box.Union(b)
+				for j, f := range n.entries {
+					if j != i {
+						overlap += u.overlap(f.box) - e.box.overlap(f.box)
+					}
+				}
+			}
+			enlarge := e.box.enlargement(b)
+			volume := e.box.Volume()
+			if best < 0 ||
+				overlap < bestOverlap ||
+				(overlap == bestOverlap && enlarge < bestEnlarge) ||
+				(overlap == bestOverlap && enlarge == bestEnlarge && volume < bestVolume) {
+				best = i
+				bestOverlap, bestEnlarge, bestVolume = overlap, enlarge, volume
+			}
+		}
+		n = n.entries[best].child
+		path = append(path, n)
+	}
+	return path
+}
+
+// pickReinsert removes and returns the 30% of entries of n whose
+// centers are furthest from the center of n, ordered by increasing
+// distance for close reinsertion.
+func (t *Tree) pickReinsert(n *node) []entry {
+	b := n.bounds()
+	dist := func(e entry) float64 {
+		var sum float64
+		for i := range b.Min {
+			d := e.box.center(i) - b.center(i)
+			sum += d * d
+		}
+		return sum
+	}
+	slices.SortStableFunc(n.entries, func(a, b entry) int {
+		da, db := dist(a), dist(b)
+		switch {
+		case da < db:
+			return -1
+		case da > db:
+			return 1
+		}
+		return 0
+	})
+	p := max(1, len(n.entries)*3/10)
+	k := len(n.entries) - p
+	removed := slices.Clone(n.entries[k:])
+	n.entries = slices.Clip(n.entries[:k])
+	return removed
+}
+
+// split divides the entries e into two groups using the R*-tree
+// topological split.
+func (t *Tree) split(e []entry) (a, b []entry) {
+	sorted := func(axis int, byMax bool) []entry {
+		s := slices.Clone(e)
+		slices.SortStableFunc(s, func(a, b entry) int {
+			x, y := a.box.Min[axis], b.box.Min[axis]
+			if byMax {
+				x, y = a.box.Max[axis], b.box.Max[axis]
+			}
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			}
+			return 0
+		})
+		return s
+	}
+
+	// Choose the split axis with the minimum
+	// total margin over all distributions.
+	bestAxis := 0
+	bestMargin := math.Inf(1)
+	for axis := 0; axis < t.dims; axis++ {
+		var margin float64
+		for _, byMax := range []bool{false, true} {
+			s := sorted(axis, byMax)
+			for k := t.min; k <= len(s)-t.min; k++ {
+				margin += boundsOf(s[:k]).Margin() + boundsOf(s[k:]).Margin()
+			}
+		}
+		if margin < bestMargin {
+			bestAxis, bestMargin = axis, margin
+		}
+	}
+
+	// Choose the distribution along the axis
+	// with the minimum overlap, then volume.
+	bestOverlap := math.Inf(1)
+	bestVolume := math.Inf(1)
+	for _, byMax := range []bool{false, true} {
+		s := sorted(bestAxis, byMax)
+		for k := t.min; k <= len(s)-t.min; k++ {
+			b1 := boundsOf(s[:k])
+			b2 := boundsOf(s[k:])
+			overlap := b1.overlap(b2)
+			volume := b1.Volume() + b2.Volume()
+			if overlap < bestOverlap || (overlap == bestOverlap && volume < bestVolume) {
+				bestOverlap, bestVolume = overlap, volume
+				a, b = s[:k:k], s[k:]
+			}
+		}
+	}
+	return a, b
+}
+
+// Delete removes the value v with the bounding box b from the tree
+// and returns whether it was found. Values are compared using ==, so
+// v must be comparable. If more than one matching value is held in the
+// tree, only one is removed.
+func (t *Tree) Delete(b Box, v any) bool {
+	if len(b.Min) != t.dims || len(b.Max) != t.dims {
+		panic("rtree: dimension mismatch")
+	}
+	path, idx := t.findLeaf(t.root, b, v, nil)
+	if path == nil {
+		return false
+	}
+	leaf := path[len(path)-1]
+	leaf.entries = slices.Delete(leaf.entries, idx, idx+1)
+	t.count--
+
+	// Condense the tree, collecting the entries of
+	// underfull nodes for reinsertion.
+	var orphans []entry
+	for i := len(path) - 1; i > 0; i-- {
+		n := path[i]
+		parent := path[i-1]
+		if len(n.entries) >= t.min {
+			t.updateBox(parent, n)
+			continue
+		}
+		for j, e := range parent.entries {
+			if e.child == n {
+				parent.entries = slices.Delete(parent.entries, j, j+1)
+				break
+			}
+		}
+		orphans = append(orphans, n.entries...)
+	}
+	for len(t.root.entries) == 1 && t.root.level > 0 {
+		t.root = t.root.entries[0].child
+	}
+	if len(t.root.entries) == 0 {
+		t.root = &node{}
+	}
+	for _, e := range orphans {
+		level := 0
+		if e.child != nil {
+			level = e.child.level + 1
+		}
+		t.resetReinserted()
+		t.reinsertOrphan(e, level)
+	}
+	return true
+}
+
+// reinsertOrphan inserts an entry orphaned by condensing the tree,
+// decomposing subtrees that are taller than the current tree.
+func (t *Tree) reinsertOrphan(e entry, level int) {
+	if level > t.root.level {
+		for _, c := range e.child.entries {
+			t.reinsertOrphan(c, level-1)
+		}
+		return
+	}
+	t.insert(e, level)
+}
+
+// findLeaf returns the path to the leaf holding the value v with box b
+// and the index of the entry in the leaf.
+func (t *Tree) findLeaf(n *node, b Box, v any, path []*node) ([]*node, int) {
+	path = append(path, n)
+	for i, e := range n.entries {
+		if !e.box.Contains(b) {
+			continue
+		}
+		if n.level == 0 {
+			if e.value == v && e.box.Equal(b) {
+				return path, i
+			}
+			continue
+		}
+		if p, idx := t.findLeaf(e.child, b, v, path); p != nil {
+			return p, idx
+		}
+	}
+	return nil, -1
+}
+
+// Search calls fn for each value in the tree with a bounding box that
+// intersects q. If fn returns true, the search is terminated. Search
+// returns whether the search was terminated by fn. The box passed to fn
+// must not be modified.
+func (t *Tree) Search(q Box, fn func(b Box, v any) (done bool)) bool {
+	if len(q.Min) != t.dims || len(q.Max) != t.dims {
+		panic("rtree: dimension mismatch")
+	}
+	return t.root.search(q, fn)
+}
+
+func (n *node) search(q Box, fn func(b Box, v any) (done bool)) bool {
+	for _, e := range n.entries {
+		if !e.box.Intersects(q) {
+			continue
+		}
+		if n.level == 0 {
+			if fn(e.box, e.value) {
+				return true
+			}
+			continue
+		}
+		if e.child.search(q, fn) {
+			return true
+		}
+	}
+	return false
+}
+
+// Neighbor is a value held in the tree, its bounding box and the
+// distance between the box and a query.
+type Neighbor struct {
+	Box   Box
+	Value any
+	Dist  float64
+}
+
+// Nearest returns up to k values in the tree with bounding boxes closest
+// to q, sorted by increasing distance. The distance between boxes is the
+// minimum Euclidean distance between their points, so all values with
+// boxes intersecting q are at distance zero.
+func (t *Tree) Nearest(q Box, k int) []Neighbor {
+	if len(q.Min) != t.dims || len(q.Max) != t.dims {
+		panic("rtree: dimension mismatch")
+	}
+	if k < 1 || t.count == 0 {
+		return nil
+	}
+	var res []Neighbor
+	h := queue{{dist: 0, node: t.root}}
+	for len(h) != 0 && len(res) < k {
+		c := heap.Pop(&h).(queued)
+		if c.node == nil {
+			res = append(res, Neighbor{Box: c.entry.box, Value: c.entry.value, Dist: c.dist})
+			continue
+		}
+		for _, e := range c.node.entries {
+			d := q.Distance(e.box)
+			if c.node.level == 0 {
+				heap.Push(&h, queued{dist: d, entry: e})
+			} else {
+				heap.Push(&h, queued{dist: d, node: e.child})
+			}
+		}
+	}
+	return res
+}
+
+// queued is a node or value in the nearest neighbor search queue.
+type queued struct {
+	dist  float64
+	node  *node
+	entry entry
+}
+
+type queue []queued
+
+func (q queue) Len() int { return len(q) }
+func (q queue) Less(i, j int) bool {
+	// Prefer values over nodes at equal distance so
+	// that results are returned as early as possible.
+	if q[i].dist == q[j].dist {
+		return q[i].node == nil && q[j].node != nil
+	}
+	return q[i].dist < q[j].dist
+}
+func (q queue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *queue) Push(x interface{}) { *q = append(*q, x.(queued)) }
+func (q *queue) Pop() interface{} {
+	old := *q
+	n := len(old) - 1
+	x := old[n]
+	*q = old[:n]
+	return x
+}
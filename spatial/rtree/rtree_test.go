@@ -0,0 +1,276 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rtree
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/spatial/r2"
+	"gonum.org/v1/gonum/spatial/r3"
+)
+
+func randomBox(dims int, size float64, rnd *rand.Rand) Box {
+	b := Box{Min: make([]float64, dims), Max: make([]float64, dims)}
+	for i := range b.Min {
+		b.Min[i] = 100 * rnd.Float64()
+		b.Max[i] = b.Min[i] + size*rnd.Float64()
+	}
+	return b
+}
+
+// isRTree returns the number of values below n and whether the
+// node sizes are within bounds, all leaves are at level zero and
+// entry boxes enclose their children.
+func (t *Tree) isRTree(n *node, root bool) (int, bool) {
+	if !root && (len(n.entries) < t.min || len(n.entries) > t.max) {
+		return 0, false
+	}
+	if n.level == 0 {
+		for _, e := range n.entries {
+			if e.child != nil {
+				return 0, false
+			}
+		}
+		return len(n.entries), true
+	}
+	var count int
+	for _, e := range n.entries {
+		if e.child == nil || e.child.level != n.level-1 {
+			return 0, false
+		}
+		if !e.box.Equal(e.child.bounds()) {
+			return 0, false
+		}
+		c, ok := t.isRTree(e.child, false)
+		if !ok {
+			return 0, false
+		}
+		count += c
+	}
+	return count, true
+}
+
+func TestInsertSearch(t *testing.T) {
+	for _, dims := range []int{1, 2, 3} {
+		for _, maxEntries := range []int{4, 8, 16} {
+			rnd := rand.New(rand.NewPCG(1, 1))
+			tree := New(dims, maxEntries)
+			boxes := make([]Box, 2000)
+			for i := range boxes {
+				boxes[i] = randomBox(dims, 5, rnd)
+				tree.Insert(boxes[i], i)
+			}
+			if tree.Len() != len(boxes) {
+				t.Errorf("unexpected length: got:%d want:%d", tree.Len(), len(boxes))
+			}
+			if n, ok := tree.isRTree(tree.root, true); !ok {
+				t.Errorf("tree is not R-tree for dims=%d maxEntries=%d", dims, maxEntries)
+			} else if n != len(boxes) {
+				t.Errorf("unexpected number of values in tree for dims=%d maxEntries=%d: got:%d want:%d", dims, maxEntries, n, len(boxes))
+			}
+
+			for i := 0; i < 50; i++ {
+				q := randomBox(dims, 20, rnd)
+				checkSearch(t, tree, boxes, nil, q)
+			}
+		}
+	}
+}
+
+func checkSearch(t *testing.T, tree *Tree, boxes []Box, deleted map[int]bool, q Box) {
+	t.Helper()
+	var got []int
+	tree.Search(q, func(b Box, v any) bool {
+		got = append(got, v.(int))
+		if !b.Equal(boxes[v.(int)]) {
+			t.Errorf("unexpected box for %d: got:%v want:%v", v, b, boxes[v.(int)])
+		}
+		return false
+	})
+	sort.Ints(got)
+	var want []int
+	for i, b := range boxes {
+		if !deleted[i] && b.Intersects(q) {
+			want = append(want, i)
+		}
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("unexpected search result for %v: got:%v want:%v", q, got, want)
+	}
+}
+
+func TestSearchDone(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	tree := New(2, 4)
+	for i := 0; i < 100; i++ {
+		tree.Insert(randomBox(2, 5, rnd), i)
+	}
+	var n int
+	done := tree.Search(Box{Min: []float64{0, 0}, Max: []float64{100, 100}}, func(Box, any) bool {
+		n++
+		return n == 10
+	})
+	if !done {
+		t.Error("search unexpectedly not terminated")
+	}
+	if n != 10 {
+		t.Errorf("unexpected number of calls: got:%d want:10", n)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	tree := New(2, 6)
+	boxes := make([]Box, 1000)
+	for i := range boxes {
+		boxes[i] = randomBox(2, 5, rnd)
+		tree.Insert(boxes[i], i)
+	}
+	if tree.Delete(boxes[0], -1) {
+		t.Error("unexpected deletion of missing value")
+	}
+	deleted := make(map[int]bool)
+	for _, i := range rnd.Perm(len(boxes))[:900] {
+		if !tree.Delete(boxes[i], i) {
+			t.Fatalf("failed to delete %d", i)
+		}
+		deleted[i] = true
+		if tree.Delete(boxes[i], i) {
+			t.Fatalf("unexpected second deletion of %d", i)
+		}
+	}
+	if tree.Len() != len(boxes)-len(deleted) {
+		t.Errorf("unexpected length: got:%d want:%d", tree.Len(), len(boxes)-len(deleted))
+	}
+	if n, ok := tree.isRTree(tree.root, true); !ok {
+		t.Error("tree is not R-tree after deletion")
+	} else if n != tree.Len() {
+		t.Errorf("unexpected number of values in tree: got:%d want:%d", n, tree.Len())
+	}
+	for i := 0; i < 50; i++ {
+		checkSearch(t, tree, boxes, deleted, randomBox(2, 20, rnd))
+	}
+
+	for i := range boxes {
+		if !deleted[i] {
+			tree.Delete(boxes[i], i)
+		}
+	}
+	if tree.Len() != 0 || len(tree.root.entries) != 0 {
+		t.Errorf("unexpected non-empty tree: len=%d", tree.Len())
+	}
+	tree.Insert(boxes[0], 0)
+	checkSearch(t, tree, boxes[:1], nil, boxes[0])
+}
+
+func TestNearest(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range []int{2, 3} {
+		tree := New(dims, 8)
+		boxes := make([]Box, 1000)
+		for i := range boxes {
+			boxes[i] = randomBox(dims, 2, rnd)
+			tree.Insert(boxes[i], i)
+		}
+		for i := 0; i < 100; i++ {
+			q := randomBox(dims, 0, rnd)
+			for _, k := range []int{1, 5, 20} {
+				got := tree.Nearest(q, k)
+				want := make([]float64, len(boxes))
+				for j, b := range boxes {
+					want[j] = q.Distance(b)
+				}
+				sort.Float64s(want)
+				if len(got) != k {
+					t.Fatalf("unexpected number of results: got:%d want:%d", len(got), k)
+				}
+				for j, n := range got {
+					if n.Dist != want[j] {
+						t.Errorf("unexpected distance for result %d of query %v: got:%v want:%v", j, q, n.Dist, want[j])
+					}
+					if d := q.Distance(boxes[n.Value.(int)]); d != n.Dist {
+						t.Errorf("mismatched distance for result %d: got:%v want:%v", j, n.Dist, d)
+					}
+				}
+			}
+		}
+	}
+
+	if got := New(2, 0).Nearest(Box{Min: []float64{0, 0}, Max: []float64{0, 0}}, 3); got != nil {
+		t.Errorf("unexpected result for empty tree: %v", got)
+	}
+}
+
+func TestFromR2R3(t *testing.T) {
+	tree := New(2, 0)
+	tree.Insert(FromR2(r2.NewBox(0, 0, 1, 1)), "a")
+	tree.Insert(FromR2(r2.NewBox(2, 2, 3, 3)), "b")
+	got := tree.Nearest(PointR2(r2.Vec{X: 2.5, Y: 4}), 1)
+	if len(got) != 1 || got[0].Value != "b" || got[0].Dist != 1 {
+		t.Errorf("unexpected nearest value: got:%v", got)
+	}
+
+	tree = New(3, 0)
+	tree.Insert(FromR3(r3.NewBox(0, 0, 0, 1, 1, 1)), "a")
+	tree.Insert(FromR3(r3.NewBox(2, 2, 2, 3, 3, 3)), "b")
+	var found []string
+	tree.Search(PointR3(r3.Vec{X: 0.5, Y: 0.5, Z: 0.5}), func(_ Box, v any) bool {
+		found = append(found, v.(string))
+		return false
+	})
+	if !slices.Equal(found, []string{"a"}) {
+		t.Errorf("unexpected search result: got:%v want:[a]", found)
+	}
+}
+
+func TestInsertPanics(t *testing.T) {
+	tree := New(2, 0)
+	for _, b := range []Box{
+		{Min: []float64{0}, Max: []float64{1}},
+		{Min: []float64{1, 1}, Max: []float64{0, 2}},
+	} {
+		panicked := func() (panicked bool) {
+			defer func() { panicked = recover() != nil }()
+			tree.Insert(b, nil)
+			return false
+		}()
+		if !panicked {
+			t.Errorf("expected panic for box %v", b)
+		}
+	}
+}
+
+func BenchmarkInsert(b *testing.B) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	boxes := make([]Box, b.N)
+	for i := range boxes {
+		boxes[i] = randomBox(2, 1, rnd)
+	}
+	tree := New(2, 16)
+	b.ResetTimer()
+	for i, box := range boxes {
+		tree.Insert(box, i)
+	}
+}
+
+func BenchmarkSearch(b *testing.B) {
+	for _, n := range []int{1e3, 1e5} {
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			rnd := rand.New(rand.NewPCG(1, 1))
+			tree := New(2, 16)
+			for i := 0; i < n; i++ {
+				tree.Insert(randomBox(2, 1, rnd), i)
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				tree.Search(randomBox(2, 5, rnd), func(Box, any) bool { return false })
+			}
+		})
+	}
+}
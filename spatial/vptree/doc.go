@@ -6,5 +6,11 @@
 // trees provide an efficient search for nearest neighbors in a
 // metric space.
 //
+// The Tree type holds values of the Comparable interface type. The
+// TreeOf type holds values of a type parameter satisfying the Metric
+// constraint, avoiding interface conversions and type assertions.
+// RowsFromDense provides points held in the rows of a mat.Dense for
+// TreeOf without copying the point data.
+//
 // See http://pnylab.com/papers/vptree/vptree.pdf for details of vp-trees.
 package vptree // import "gonum.org/v1/gonum/spatial/vptree"
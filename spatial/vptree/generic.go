@@ -0,0 +1,236 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vptree
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/internal/knn"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Metric is the constraint satisfied by point types stored in a TreeOf. It
+// has the semantics of Comparable, but the Distance method takes the point
+// type itself as a parameter, so points are stored and compared without
+// conversion to an interface value or type assertion.
+type Metric[P any] interface {
+	// Distance returns the distance between the receiver and p. The
+	// returned distance must satisfy the properties of distances in a
+	// metric space as described for Comparable.
+	Distance(p P) float64
+}
+
+// DenseRow is a point held in a row of a mat.Dense that satisfies the
+// Metric constraint. Index is the index of the row holding the point and
+// Vec is the row's data.
+type DenseRow struct {
+	Index int
+	Vec   []float64
+}
+
+var _ Metric[DenseRow] = DenseRow{}
+
+// Distance returns the Euclidean distance between q and the receiver.
+func (p DenseRow) Distance(q DenseRow) float64 {
+	var sum float64
+	for dim, c := range p.Vec {
+		d := c - q.Vec[dim]
+		sum += d * d
+	}
+	return math.Sqrt(sum)
+}
+
+// RowsFromDense returns a DenseRow for each row of m, for use with TreeOf.
+// The returned points share the backing data of m, so no point data is
+// copied, and the Index of each point is the row of m holding it.
+// Constructing a tree from the returned points reorders the slice but does
+// not alter m. Changes to the elements of m after construction of a tree
+// are reflected in the tree's points and may invalidate the tree.
+func RowsFromDense(m *mat.Dense) []DenseRow {
+	r, _ := m.Dims()
+	p := make([]DenseRow, r)
+	for i := range p {
+		p[i] = DenseRow{Index: i, Vec: m.RawRowView(i)}
+	}
+	return p
+}
+
+// NodeOf holds a single point value in a TreeOf.
+type NodeOf[P Metric[P]] struct {
+	Point   P
+	Radius  float64
+	Closer  *NodeOf[P]
+	Further *NodeOf[P]
+}
+
+// TreeOf implements a vantage point tree creation and nearest neighbor search
+// for points of type P.
+type TreeOf[P Metric[P]] struct {
+	Root  *NodeOf[P]
+	Count int
+}
+
+// NewOf returns a vantage point tree constructed from the values in p. The
+// effort and src parameters and the conditions on the values of p are as
+// described for New. The order of elements in p will be altered after NewOf
+// returns.
+func NewOf[P Metric[P]](p []P, effort int, src rand.Source) (t *TreeOf[P], err error) {
+	b := newBuilder(p, src)
+
+	defer func() {
+		switch r := recover(); r {
+		case nil:
+		case pointAtInfinity:
+			t = nil
+			err = pointAtInfinity
+		default:
+			panic(r)
+		}
+	}()
+
+	t = &TreeOf[P]{
+		Root:  buildOf(b, p, effort),
+		Count: len(p),
+	}
+	return t, nil
+}
+
+func buildOf[P Metric[P]](b *builder[P], s []P, effort int) *NodeOf[P] {
+	if len(s) <= 1 {
+		if len(s) == 0 {
+			return nil
+		}
+		return &NodeOf[P]{Point: s[0]}
+	}
+	n := NodeOf[P]{Point: b.selectVantage(s, effort)}
+	radius, closer, further := b.partition(n.Point, s)
+	n.Radius = radius
+	n.Closer = buildOf(b, closer, effort)
+	n.Further = buildOf(b, further, effort)
+	return &n
+}
+
+// Len returns the number of elements in the tree.
+func (t *TreeOf[P]) Len() int { return t.Count }
+
+// Nearest returns the nearest value to the query and the distance between
+// them. If the tree is empty, Nearest returns the zero value of P and an
+// infinite distance.
+func (t *TreeOf[P]) Nearest(q P) (P, float64) {
+	n, dist := t.Root.search(q, inf)
+	if n == nil {
+		var zero P
+		return zero, inf
+	}
+	return n.Point, dist
+}
+
+func (n *NodeOf[P]) search(q P, dist float64) (*NodeOf[P], float64) {
+	if n == nil {
+		return nil, inf
+	}
+
+	d := q.Distance(n.Point)
+	dist = math.Min(dist, d)
+
+	bn := n
+	if d < n.Radius {
+		if cn, cd := n.Closer.search(q, dist); cd < dist {
+			bn, dist = cn, cd
+		}
+		if d+dist >= n.Radius {
+			if fn, fd := n.Further.search(q, dist); fd < dist {
+				bn, dist = fn, fd
+			}
+		}
+	} else {
+		if fn, fd := n.Further.search(q, dist); fd < dist {
+			bn, dist = fn, fd
+		}
+		if d-dist <= n.Radius {
+			if cn, cd := n.Closer.search(q, dist); cd < dist {
+				bn, dist = cn, cd
+			}
+		}
+	}
+
+	return bn, dist
+}
+
+// PointDist holds a point and its distance to a specific query.
+type PointDist[P any] = knn.PointDist[P]
+
+// KNearest returns the k nearest values in the tree to the query, sorted by
+// increasing distance from the query. If the tree holds fewer than k values,
+// all values are returned.
+func (t *TreeOf[P]) KNearest(q P, k int) []PointDist[P] {
+	if k < 1 || t.Root == nil {
+		return nil
+	}
+	s := knn.NewKNearest[P](k, t.Count)
+	t.Root.searchSet(q, &s)
+	return s.Sorted()
+}
+
+// NearestWithin returns the values in the tree that are within the distance
+// d of the query, q, sorted by increasing distance.
+func (t *TreeOf[P]) NearestWithin(q P, d float64) []PointDist[P] {
+	if t.Root == nil {
+		return nil
+	}
+	s := knn.NewWithin[P](d)
+	t.Root.searchSet(q, &s)
+	return s.Sorted()
+}
+
+func (n *NodeOf[P]) searchSet(q P, s *knn.Keeper[P]) {
+	if n == nil {
+		return
+	}
+
+	d := q.Distance(n.Point)
+	s.Keep(n.Point, d)
+	if d < n.Radius {
+		n.Closer.searchSet(q, s)
+		if d+s.Max() >= n.Radius {
+			n.Further.searchSet(q, s)
+		}
+	} else {
+		n.Further.searchSet(q, s)
+		if d-s.Max() <= n.Radius {
+			n.Closer.searchSet(q, s)
+		}
+	}
+}
+
+// Do performs fn on all values stored in the tree, passing each value and
+// its depth in the tree. A boolean is returned indicating whether the Do
+// traversal was interrupted by fn returning true. If fn alters stored
+// values' distance relationships, future tree operation behaviors are
+// undefined.
+func (t *TreeOf[P]) Do(fn func(p P, depth int) (done bool)) bool {
+	if t.Root == nil {
+		return false
+	}
+	return t.Root.do(fn, 0)
+}
+
+func (n *NodeOf[P]) do(fn func(P, int) bool, depth int) (done bool) {
+	if n.Closer != nil {
+		done = n.Closer.do(fn, depth+1)
+		if done {
+			return
+		}
+	}
+	done = fn(n.Point, depth)
+	if done {
+		return
+	}
+	if n.Further != nil {
+		done = n.Further.do(fn, depth+1)
+	}
+	return
+}
@@ -0,0 +1,197 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vptree
+
+import (
+	"cmp"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// cityBlock is a point in the plane with the Manhattan metric.
+type cityBlock [2]float64
+
+func (p cityBlock) Distance(q cityBlock) float64 {
+	return math.Abs(p[0]-q[0]) + math.Abs(p[1]-q[1])
+}
+
+// isVPTree returns whether the subtree rooted at n satisfies the vp-tree
+// partitioning property.
+func (n *NodeOf[P]) isVPTree() bool {
+	if n == nil {
+		return true
+	}
+	ok := true
+	n.Closer.walk(func(p P) {
+		ok = ok && n.Point.Distance(p) <= n.Radius
+	})
+	n.Further.walk(func(p P) {
+		ok = ok && n.Point.Distance(p) >= n.Radius
+	})
+	return ok && n.Closer.isVPTree() && n.Further.isVPTree()
+}
+
+// walk calls fn on each point in the subtree rooted at n.
+func (n *NodeOf[P]) walk(fn func(P)) {
+	if n == nil {
+		return
+	}
+	n.Closer.walk(fn)
+	fn(n.Point)
+	n.Further.walk(fn)
+}
+
+func randomCityBlocks(rnd *rand.Rand, n int) []cityBlock {
+	p := make([]cityBlock, n)
+	for i := range p {
+		p[i] = cityBlock{rnd.Float64(), rnd.Float64()}
+	}
+	return p
+}
+
+func TestTreeOf(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	data := randomCityBlocks(rnd, 1000)
+	for _, effort := range []int{0, 10} {
+		tree, err := NewOf(slices.Clone(data), effort, rand.NewPCG(1, 1))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if tree.Len() != len(data) {
+			t.Errorf("unexpected tree size: got:%d want:%d", tree.Len(), len(data))
+		}
+		if !tree.Root.isVPTree() {
+			t.Errorf("tree is not vp-tree for effort=%d", effort)
+		}
+		var n int
+		tree.Do(func(cityBlock, int) bool {
+			n++
+			return false
+		})
+		if n != len(data) {
+			t.Errorf("unexpected number of points visited: got:%d want:%d", n, len(data))
+		}
+
+		for i, q := range randomCityBlocks(rnd, 100) {
+			dists := make([]float64, len(data))
+			for j, p := range data {
+				dists[j] = q.Distance(p)
+			}
+			slices.Sort(dists)
+
+			_, d := tree.Nearest(q)
+			if d != dists[0] {
+				t.Errorf("unexpected nearest distance for query %d effort=%d: got:%v want:%v", i, effort, d, dists[0])
+			}
+
+			for _, k := range []int{1, 10, 2000} {
+				got := tree.KNearest(q, k)
+				if len(got) != min(k, len(data)) {
+					t.Errorf("unexpected number of neighbors for query %d k=%d: got:%d want:%d", i, k, len(got), min(k, len(data)))
+					continue
+				}
+				for j, p := range got {
+					if p.Dist != dists[j] {
+						t.Errorf("unexpected neighbor distance %d for query %d k=%d: got:%v want:%v", j, i, k, p.Dist, dists[j])
+					}
+				}
+			}
+
+			const r = 0.1
+			got := tree.NearestWithin(q, r)
+			want, _ := slices.BinarySearchFunc(dists, r, func(a, b float64) int {
+				if a <= b {
+					return -1
+				}
+				return 1
+			})
+			if len(got) != want {
+				t.Errorf("unexpected number of points within %v of query %d: got:%d want:%d", r, i, len(got), want)
+			}
+			if !slices.IsSortedFunc(got, func(a, b PointDist[cityBlock]) int { return cmp.Compare(a.Dist, b.Dist) }) {
+				t.Errorf("points within distance not sorted for query %d", i)
+			}
+		}
+	}
+
+	var empty TreeOf[cityBlock]
+	if p, d := empty.Nearest(cityBlock{}); p != (cityBlock{}) || d != inf {
+		t.Errorf("unexpected nearest point in empty tree: got:%v %v", p, d)
+	}
+	if got := empty.KNearest(cityBlock{}, 3); got != nil {
+		t.Errorf("unexpected neighbors in empty tree: got:%v", got)
+	}
+}
+
+func TestNewOfInfinity(t *testing.T) {
+	t.Parallel()
+	data := []cityBlock{{0, 0}, {1, 1}, {math.Inf(1), 0}, {2, 3}}
+	tree, err := NewOf(data, 4, rand.NewPCG(1, 1))
+	if err != pointAtInfinity {
+		t.Errorf("unexpected error: got:%v want:%v", err, pointAtInfinity)
+	}
+	if tree != nil {
+		t.Error("unexpected non-nil tree")
+	}
+}
+
+func TestTreeOfDenseRow(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n, dims = 500, 3
+	m := mat.NewDense(n, dims, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < dims; j++ {
+			m.Set(i, j, rnd.Float64())
+		}
+	}
+	points := RowsFromDense(m)
+	for i, p := range points {
+		if p.Index != i || &p.Vec[0] != &m.RawRowView(i)[0] {
+			t.Fatalf("point %d does not refer to row %d", p.Index, i)
+		}
+	}
+	tree, err := NewOf(slices.Clone(points), 10, rand.NewPCG(1, 1))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !tree.Root.isVPTree() {
+		t.Error("tree is not vp-tree")
+	}
+
+	for i := 0; i < 100; i++ {
+		q := DenseRow{Index: -1, Vec: []float64{rnd.Float64(), rnd.Float64(), rnd.Float64()}}
+		want := slices.Clone(points)
+		slices.SortStableFunc(want, func(a, b DenseRow) int {
+			return cmp.Compare(q.Distance(a), q.Distance(b))
+		})
+
+		got, d := tree.Nearest(q)
+		if got.Index != want[0].Index || d != q.Distance(want[0]) {
+			t.Errorf("unexpected nearest point for query %d: got:%d %v want:%d %v", i, got.Index, d, want[0].Index, q.Distance(want[0]))
+		}
+		const k = 10
+		gotN := tree.KNearest(q, k)
+		if len(gotN) != k {
+			t.Errorf("unexpected number of neighbors for query %d: got:%d want:%d", i, len(gotN), k)
+			continue
+		}
+		for j, p := range gotN {
+			if p.Dist != q.Distance(want[j]) {
+				t.Errorf("unexpected neighbor distance %d for query %d: got:%v want:%v", j, i, p.Dist, q.Distance(want[j]))
+			}
+			var diff mat.VecDense
+			diff.SubVec(mat.NewVecDense(dims, q.Vec), m.RowView(p.Point.Index))
+			if want := mat.Norm(&diff, 2); math.Abs(p.Dist-want) > 1e-14 {
+				t.Errorf("unexpected distance to row %d for query %d: got:%v want:%v", p.Point.Index, i, p.Dist, want)
+			}
+		}
+	}
+}
@@ -66,17 +66,7 @@ type Tree struct {
 // global rand package functions are used. Points in p must not be infinitely
 // distant.
 func New(p []Comparable, effort int, src rand.Source) (t *Tree, err error) {
-	var intn func(int) int
-	var shuf func(n int, swap func(i, j int))
-	if src == nil {
-		intn = rand.IntN
-		shuf = rand.Shuffle
-	} else {
-		rnd := rand.New(src)
-		intn = rnd.IntN
-		shuf = rnd.Shuffle
-	}
-	b := builder{work: make([]float64, len(p)), intn: intn, shuf: shuf}
+	b := newBuilder(p, src)
 
 	defer func() {
 		switch r := recover(); r {
@@ -90,7 +80,7 @@ func New(p []Comparable, effort int, src rand.Source) (t *Tree, err error) {
 	}()
 
 	t = &Tree{
-		Root:  b.build(p, effort),
+		Root:  build(b, p, effort),
 		Count: len(p),
 	}
 	return t, nil
@@ -100,13 +90,29 @@ var pointAtInfinity = errors.New("vptree: point at infinity")
 
 // builder performs vp-tree construction as described for the simple vp-tree
 // algorithm in http://pnylab.com/papers/vptree/vptree.pdf.
-type builder struct {
+type builder[P Metric[P]] struct {
 	work []float64
 	intn func(n int) int
 	shuf func(n int, swap func(i, j int))
 }
 
-func (b *builder) build(s []Comparable, effort int) *Node {
+// newBuilder returns a builder for the points in p using src as the source
+// of randomness. If src is nil global rand package functions are used.
+func newBuilder[P Metric[P]](p []P, src rand.Source) *builder[P] {
+	var intn func(int) int
+	var shuf func(n int, swap func(i, j int))
+	if src == nil {
+		intn = rand.IntN
+		shuf = rand.Shuffle
+	} else {
+		rnd := rand.New(src)
+		intn = rnd.IntN
+		shuf = rnd.Shuffle
+	}
+	return &builder[P]{work: make([]float64, len(p)), intn: intn, shuf: shuf}
+}
+
+func build(b *builder[Comparable], s []Comparable, effort int) *Node {
 	if len(s) <= 1 {
 		if len(s) == 0 {
 			return nil
@@ -116,19 +122,22 @@ func (b *builder) build(s []Comparable, effort int) *Node {
 	n := Node{Point: b.selectVantage(s, effort)}
 	radius, closer, further := b.partition(n.Point, s)
 	n.Radius = radius
-	n.Closer = b.build(closer, effort)
-	n.Further = b.build(further, effort)
+	n.Closer = build(b, closer, effort)
+	n.Further = build(b, further, effort)
 	return &n
 }
 
-func (b *builder) selectVantage(s []Comparable, effort int) Comparable {
+func (b *builder[P]) selectVantage(s []P, effort int) P {
 	if effort <= 1 {
 		return s[b.intn(len(s))]
 	}
 	if effort > len(s) {
 		effort = len(s)
 	}
-	var best Comparable
+	var (
+		best  P
+		found bool
+	)
 	bestVar := -1.0
 	b.work = b.work[:effort]
 	choices := b.random(effort, s)
@@ -143,16 +152,17 @@ func (b *builder) selectVantage(s []Comparable, effort int) Comparable {
 		variance := stat.Variance(b.work, nil)
 		if variance > bestVar {
 			best, bestVar = p, variance
+			found = true
 		}
 	}
-	if best == nil {
+	if !found {
 		// This should never be reached.
 		panic("vptree: could not find vantage point")
 	}
 	return best
 }
 
-func (b *builder) random(n int, s []Comparable) []Comparable {
+func (b *builder[P]) random(n int, s []P) []P {
 	if n >= len(s) {
 		n = len(s)
 	}
@@ -160,7 +170,7 @@ func (b *builder) random(n int, s []Comparable) []Comparable {
 	return s[:n]
 }
 
-func (b *builder) partition(v Comparable, s []Comparable) (radius float64, closer, further []Comparable) {
+func (b *builder[P]) partition(v P, s []P) (radius float64, closer, further []P) {
 	b.work = b.work[:len(s)]
 	for i, p := range s {
 		d := v.Distance(p)
@@ -169,7 +179,7 @@ func (b *builder) partition(v Comparable, s []Comparable) (radius float64, close
 		}
 		b.work[i] = d
 	}
-	sort.Sort(byDist{dists: b.work, points: s})
+	sort.Sort(byDist[P]{dists: b.work, points: s})
 
 	// Note that this does not conform exactly to the description
 	// in the paper which specifies d(p, s) < mu for L; in cases
@@ -189,14 +199,14 @@ func (b *builder) partition(v Comparable, s []Comparable) (radius float64, close
 	return radius, closer, further
 }
 
-type byDist struct {
+type byDist[P any] struct {
 	dists  []float64
-	points []Comparable
+	points []P
 }
 
-func (c byDist) Len() int           { return len(c.dists) }
-func (c byDist) Less(i, j int) bool { return c.dists[i] < c.dists[j] }
-func (c byDist) Swap(i, j int) {
+func (c byDist[P]) Len() int           { return len(c.dists) }
+func (c byDist[P]) Less(i, j int) bool { return c.dists[i] < c.dists[j] }
+func (c byDist[P]) Swap(i, j int) {
 	c.dists[i], c.dists[j] = c.dists[j], c.dists[i]
 	c.points[i], c.points[j] = c.points[j], c.points[i]
 }
@@ -0,0 +1,164 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// CohensKappa returns Cohen's kappa, the chance-corrected agreement between
+// two raters classifying the same subjects into k categories,
+//
+//	κ = 1 - \sum_{i,j} w_ij O_ij / \sum_{i,j} w_ij E_ij
+//
+// where O is the k×k confusion matrix of the counts of subjects placed in
+// category i by the first rater and category j by the second, E is the
+// matrix of counts expected under independent ratings with the observed
+// marginal frequencies, and w_ij is the disagreement weight of categories
+// i and j.
+//
+// If disagreement is nil, the weights are zero on the diagonal and one
+// elsewhere, giving the unweighted kappa. For ordered categories, weights
+// w_ij = |i-j| or w_ij = (i-j)^2 give the linearly and quadratically
+// weighted kappa. CohensKappa panics if confusion is not square or if
+// disagreement is not nil and has different dimensions.
+func CohensKappa(confusion, disagreement mat.Matrix) float64 {
+	r, c := confusion.Dims()
+	if r != c {
+		panic(mat.ErrSquare)
+	}
+	if disagreement != nil {
+		if dr, dc := disagreement.Dims(); dr != r || dc != c {
+			panic(mat.ErrShape)
+		}
+	}
+	rowSums := make([]float64, r)
+	colSums := make([]float64, c)
+	var total float64
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			v := confusion.At(i, j)
+			rowSums[i] += v
+			colSums[j] += v
+			total += v
+		}
+	}
+	var obs, exp float64
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			w := 1.0
+			switch {
+			case disagreement != nil:
+				w = disagreement.At(i, j)
+			case i == j:
+				w = 0
+			}
+			obs += w * confusion.At(i, j)
+			exp += w * rowSums[i] * colSums[j] / total
+		}
+	}
+	return 1 - obs/exp
+}
+
+// FleissKappa returns Fleiss' kappa, the chance-corrected agreement between
+// a fixed number of raters classifying subjects into categories. The
+// element in row i and column j of ratings is the number of raters who
+// placed subject i in category j, and every row must have the same sum,
+// the number of raters per subject, which must be at least two. The raters
+// need not be the same for every subject. FleissKappa panics if the row
+// sums differ or are less than two.
+func FleissKappa(ratings mat.Matrix) float64 {
+	r, c := ratings.Dims()
+	p := make([]float64, c)
+	var n, pBar float64
+	for i := 0; i < r; i++ {
+		var sum, sumSq float64
+		for j := 0; j < c; j++ {
+			v := ratings.At(i, j)
+			sum += v
+			sumSq += v * v
+			p[j] += v
+		}
+		if i == 0 {
+			n = sum
+			if n < 2 {
+				panic("stat: fewer than two raters")
+			}
+		} else if sum != n {
+			panic("stat: number of ratings differs between subjects")
+		}
+		// The proportion of agreeing pairs of raters.
+		pBar += (sumSq - n) / (n * (n - 1))
+	}
+	pBar /= float64(r)
+	var pe float64
+	for _, v := range p {
+		v /= float64(r) * n
+		pe += v * v
+	}
+	return (pBar - pe) / (1 - pe)
+}
+
+// KrippendorffAlpha returns Krippendorff's alpha, the chance-corrected
+// agreement between raters who each rate some of a set of units,
+//
+//	α = 1 - D_o / D_e
+//
+// where D_o is the observed disagreement between the values assigned to the
+// same unit and D_e is the disagreement expected between values assigned
+// to any units. The element in row i and column u of data is the value
+// assigned by rater i to unit u, or NaN if the rater did not rate the unit.
+// Units rated by fewer than two raters are ignored.
+//
+// The disagreement between two values is given by metric. If metric is
+// nil, the nominal metric, zero for equal values and one otherwise, is
+// used. The metric (a-b)^2 gives the interval alpha.
+func KrippendorffAlpha(data mat.Matrix, metric func(a, b float64) float64) float64 {
+	if metric == nil {
+		metric = func(a, b float64) float64 {
+			if a == b {
+				return 0
+			}
+			return 1
+		}
+	}
+	r, c := data.Dims()
+	var (
+		values []float64
+		obs    float64
+	)
+	unit := make([]float64, 0, r)
+	for u := 0; u < c; u++ {
+		unit = unit[:0]
+		for i := 0; i < r; i++ {
+			if v := data.At(i, u); !math.IsNaN(v) {
+				unit = append(unit, v)
+			}
+		}
+		m := len(unit)
+		if m < 2 {
+			continue
+		}
+		var d float64
+		for i, a := range unit {
+			for _, b := range unit[i+1:] {
+				d += metric(a, b) + metric(b, a)
+			}
+		}
+		obs += d / float64(m-1)
+		values = append(values, unit...)
+	}
+	n := float64(len(values))
+	var exp float64
+	for i, a := range values {
+		for _, b := range values[i+1:] {
+			exp += metric(a, b) + metric(b, a)
+		}
+	}
+	// D_o = obs / n and D_e = exp / (n (n-1)).
+	return 1 - (n-1)*obs/exp
+}
@@ -0,0 +1,79 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestCohensKappa(t *testing.T) {
+	t.Parallel()
+	confusion := mat.NewDense(2, 2, []float64{20, 5, 10, 15})
+	if got := CohensKappa(confusion, nil); !scalar.EqualWithinAbs(got, 0.4, 1e-14) {
+		t.Errorf("unexpected kappa: got:%v want:0.4", got)
+	}
+
+	// With two categories all weightings are equivalent.
+	linear := mat.NewDense(2, 2, []float64{0, 1, 1, 0})
+	if got := CohensKappa(confusion, linear); !scalar.EqualWithinAbs(got, 0.4, 1e-14) {
+		t.Errorf("unexpected weighted kappa: got:%v want:0.4", got)
+	}
+
+	perfect := mat.NewDense(3, 3, []float64{5, 0, 0, 0, 7, 0, 0, 0, 2})
+	if got := CohensKappa(perfect, nil); got != 1 {
+		t.Errorf("unexpected kappa for perfect agreement: got:%v want:1", got)
+	}
+	if !panics(func() { CohensKappa(mat.NewDense(2, 3, nil), nil) }) {
+		t.Error("expected panic for non-square confusion matrix")
+	}
+}
+
+func TestFleissKappa(t *testing.T) {
+	t.Parallel()
+	// The example from https://en.wikipedia.org/wiki/Fleiss%27_kappa.
+	ratings := mat.NewDense(10, 5, []float64{
+		0, 0, 0, 0, 14,
+		0, 2, 6, 4, 2,
+		0, 0, 3, 5, 6,
+		0, 3, 9, 2, 0,
+		2, 2, 8, 1, 1,
+		7, 7, 0, 0, 0,
+		3, 2, 6, 3, 0,
+		2, 5, 3, 2, 2,
+		6, 5, 2, 1, 0,
+		0, 2, 2, 3, 7,
+	})
+	if got := FleissKappa(ratings); !scalar.EqualWithinAbs(got, 0.20993, 1e-5) {
+		t.Errorf("unexpected kappa: got:%v want:0.20993", got)
+	}
+	ratings.Set(0, 0, 1)
+	if !panics(func() { FleissKappa(ratings) }) {
+		t.Error("expected panic for differing numbers of ratings")
+	}
+}
+
+func TestKrippendorffAlpha(t *testing.T) {
+	t.Parallel()
+	// The reliability data of Krippendorff, Computing
+	// Krippendorff's alpha-reliability, 2011.
+	nan := math.NaN()
+	data := mat.NewDense(4, 12, []float64{
+		1, 2, 3, 3, 2, 1, 4, 1, 2, nan, nan, nan,
+		1, 2, 3, 3, 2, 2, 4, 1, 2, 5, nan, 3,
+		nan, 3, 3, 3, 2, 3, 4, 2, 2, 5, 1, nan,
+		1, 2, 3, 3, 2, 4, 4, 1, 2, 5, 1, nan,
+	})
+	if got := KrippendorffAlpha(data, nil); !scalar.EqualWithinAbs(got, 0.743, 1e-3) {
+		t.Errorf("unexpected nominal alpha: got:%v want:0.743", got)
+	}
+	interval := func(a, b float64) float64 { return (a - b) * (a - b) }
+	if got := KrippendorffAlpha(data, interval); !scalar.EqualWithinAbs(got, 0.849, 1e-3) {
+		t.Errorf("unexpected interval alpha: got:%v want:0.849", got)
+	}
+}
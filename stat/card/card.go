@@ -36,6 +36,62 @@ func linearCounting(m, v float64) float64 {
 	return m * (math.Log(m) - math.Log(v))
 }
 
+// tick is an observation held in a register of a sliding HyperLogLog
+// sketch. The fields are exported for gob encoding.
+type tick struct {
+	T int64 // T is the time of the observation.
+	R uint8 // R is the register value of the observation.
+}
+
+// addTick adds t to the list of future possible maxima of a register,
+// held in increasing order of time and decreasing order of value, and
+// removes the observations made before the time expire.
+func addTick(ticks []tick, t tick, expire int64) []tick {
+	// Observations with values no larger than t can
+	// never again be the maximum of the register.
+	for len(ticks) != 0 && ticks[len(ticks)-1].R <= t.R {
+		ticks = ticks[:len(ticks)-1]
+	}
+	ticks = append(ticks, t)
+	var i int
+	for i < len(ticks) && ticks[i].T < expire {
+		i++
+	}
+	if i != 0 {
+		ticks = append(ticks[:0], ticks[i:]...)
+	}
+	return ticks
+}
+
+// mergeTicks returns the list of future possible maxima of the union
+// of the observations in the lists a and b made at or after expire.
+func mergeTicks(a, b []tick, expire int64) []tick {
+	var merged []tick
+	for len(a) != 0 || len(b) != 0 {
+		var t tick
+		if len(b) == 0 || (len(a) != 0 && a[0].T <= b[0].T) {
+			t, a = a[0], a[1:]
+		} else {
+			t, b = b[0], b[1:]
+		}
+		merged = addTick(merged, t, expire)
+	}
+	return merged
+}
+
+// maxTickSince returns the largest value of the observations in
+// the list made at or after since.
+func maxTickSince(ticks []tick, since int64) uint8 {
+	// Values decrease with time so the first observation
+	// at or after since holds the maximum.
+	for _, t := range ticks {
+		if t.T >= since {
+			return t.R
+		}
+	}
+	return 0
+}
+
 func typeNameOf(v interface{}) string {
 	t := reflect.TypeOf(v)
 	var prefix string
@@ -77,7 +77,7 @@ func (h *HyperLogLog32) Union(a, b *HyperLogLog32) error {
 	if a.p != b.p {
 		return errors.New("card: mismatched precision")
 	}
-	ta := reflect.TypeOf(b.hash)
+	ta := reflect.TypeOf(a.hash)
 	if reflect.TypeOf(b.hash) != ta {
 		return errors.New("card: mismatched hash function")
 	}
@@ -98,13 +98,36 @@ func (h *HyperLogLog32) Union(a, b *HyperLogLog32) error {
 // will return an error if it is called on a receiver with a non-nil
 // hash function.
 func (h *HyperLogLog32) SetHash(fn hash.Hash32) error {
-	if h.hash == nil {
+	if h.hash != nil {
 		return errors.New("card: hash function already set")
 	}
 	h.hash = fn
 	return nil
 }
 
+// Intersection returns an estimate of the cardinality of the intersection of
+// the sets of items written to the receiver and to other, computed by
+// inclusion–exclusion from the estimated cardinalities of the sets and of
+// their union, and an estimate of its standard error. The standard error
+// is derived from the relative standard error 1.04/sqrt(2^prec) of each
+// estimate and is large relative to the intersection when it is small
+// compared with the sets. The returned estimate is clamped to the range
+// [0, min(|A|, |B|)]. Intersection returns an error if the precisions or
+// hash functions of the sketches do not match.
+func (h *HyperLogLog32) Intersection(other *HyperLogLog32) (n, stderr float64, err error) {
+	var u HyperLogLog32
+	err = u.Union(h, other)
+	if err != nil {
+		return 0, 0, err
+	}
+	a := h.Count()
+	b := other.Count()
+	c := u.Count()
+	rse := 1.04 / math.Sqrt(float64(h.m))
+	stderr = rse * math.Sqrt(a*a+b*b+c*c)
+	return min(max(a+b-c, 0), a, b), stderr, nil
+}
+
 // Count returns an estimate of the cardinality of the set of items written
 // the receiver.
 func (h *HyperLogLog32) Count() float64 {
@@ -225,3 +248,194 @@ func hash32For(name string) hash.Hash32 {
 	h, _ := fn.(userType).fn.Call(nil)[0].Interface().(hash.Hash32)
 	return h
 }
+
+// SlidingHyperLogLog32 implements cardinality estimation over a sliding
+// window of time according to the sliding HyperLogLog algorithm described
+// in Chabchoub and Hébrail, "Sliding HyperLogLog: Estimating cardinality in
+// a data stream over a sliding window", ICDM Workshops, 2010. Each register
+// holds the times and values of the observations that may become the
+// register maximum as older observations leave the window.
+type SlidingHyperLogLog32 struct {
+	p uint8
+	m uint32
+
+	window int64
+	last   int64
+
+	hash hash.Hash32
+
+	register [][]tick
+}
+
+// NewSlidingHyperLogLog32 returns a new SlidingHyperLogLog32 sketch that
+// retains observations made within window of the time of the most recent
+// observation. The value of prec must be in the range [4, 32] and window
+// must be positive.
+func NewSlidingHyperLogLog32(prec int, window int64, h hash.Hash32) (*SlidingHyperLogLog32, error) {
+	if prec < 4 || w32 < prec {
+		return nil, errors.New("card: precision out of range")
+	}
+	if window <= 0 {
+		return nil, errors.New("card: non-positive window")
+	}
+	p := uint8(prec)
+	m := uint32(1) << p
+	return &SlidingHyperLogLog32{
+		p: p, m: m,
+		window:   window,
+		last:     math.MinInt64,
+		hash:     h,
+		register: make([][]tick, m),
+	}, nil
+}
+
+// WriteTime notes the data in b as a single observation made at time t
+// into the sketch held by the receiver. Observations must be written in
+// non-decreasing order of time, otherwise WriteTime will return an error
+// and not alter the sketch.
+func (h *SlidingHyperLogLog32) WriteTime(b []byte, t int64) (int, error) {
+	if t < h.last {
+		return 0, errors.New("card: observation time out of order")
+	}
+	h.last = t
+	n, err := h.hash.Write(b)
+	x := h.hash.Sum32()
+	h.hash.Reset()
+	q := w32 - h.p
+	idx := x >> q
+	h.register[idx] = addTick(h.register[idx], tick{T: t, R: rho32q(x, q)}, t-h.window)
+	return n, err
+}
+
+// Union places the union of the sketches in a and b into the receiver. The
+// window of the receiver is the larger of the windows of a and b. Union will
+// return an error if the precisions or hash functions of a and b do not
+// match or if the receiver has a hash function that is set and does not
+// match those of a and b.
+//
+// If the receiver does not have a set hash function, it can be set after
+// a call to Union with the SetHash method.
+func (h *SlidingHyperLogLog32) Union(a, b *SlidingHyperLogLog32) error {
+	if a.p != b.p {
+		return errors.New("card: mismatched precision")
+	}
+	ta := reflect.TypeOf(a.hash)
+	if reflect.TypeOf(b.hash) != ta {
+		return errors.New("card: mismatched hash function")
+	}
+	if h.hash != nil && reflect.TypeOf(h.hash) != ta {
+		return errors.New("card: mismatched hash function")
+	}
+
+	register := make([][]tick, a.m)
+	window := max(a.window, b.window)
+	last := max(a.last, b.last)
+	for i := range register {
+		register[i] = mergeTicks(a.register[i], b.register[i], last-window)
+	}
+	*h = SlidingHyperLogLog32{p: a.p, m: a.m, window: window, last: last, hash: h.hash, register: register}
+	return nil
+}
+
+// SetHash sets the hash function of the receiver if it is nil. SetHash
+// will return an error if it is called on a receiver with a non-nil
+// hash function.
+func (h *SlidingHyperLogLog32) SetHash(fn hash.Hash32) error {
+	if h.hash != nil {
+		return errors.New("card: hash function already set")
+	}
+	h.hash = fn
+	return nil
+}
+
+// Count returns an estimate of the cardinality of the set of items written
+// to the receiver at or after the time since. Observations older than the
+// window of the receiver before the most recent observation are not
+// retained, so since values earlier than that give the count over the
+// whole window.
+func (h *SlidingHyperLogLog32) Count(since int64) float64 {
+	if h.last-h.window > since {
+		since = h.last - h.window
+	}
+	c := HyperLogLog32{p: h.p, m: h.m, register: make([]uint8, h.m)}
+	for i, ticks := range h.register {
+		c.register[i] = maxTickSince(ticks, since)
+	}
+	return c.Count()
+}
+
+// Reset clears the receiver's registers allowing it to be reused.
+// Reset does not alter the precision, window or hash function of
+// the receiver.
+func (h *SlidingHyperLogLog32) Reset() {
+	for i := range h.register {
+		h.register[i] = h.register[i][:0]
+	}
+	h.last = math.MinInt64
+}
+
+// MarshalBinary marshals the sketch in the receiver. It encodes the
+// name of the hash function, the precision and window of the sketch
+// and the sketch data. The receiver must have a non-nil hash function.
+func (h *SlidingHyperLogLog32) MarshalBinary() ([]byte, error) {
+	if h.hash == nil {
+		return nil, errors.New("card: hash function not set")
+	}
+	var buf bytes.Buffer
+	enc := gob.NewEncoder(&buf)
+	for _, v := range []interface{}{uint8(w32), typeNameOf(h.hash), h.p, h.window, h.last, h.register} {
+		err := enc.Encode(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals the binary representation of a sketch
+// into the receiver. The precision and window of the receiver will be
+// set after return. The receiver must have a non-nil hash function value
+// that is the same type as the one that was stored in the binary data.
+func (h *SlidingHyperLogLog32) UnmarshalBinary(b []byte) error {
+	dec := gob.NewDecoder(bytes.NewReader(b))
+	var size uint8
+	err := dec.Decode(&size)
+	if err != nil {
+		return err
+	}
+	if size != w32 {
+		return fmt.Errorf("card: mismatched hash function size: dst=%d src=%d", w32, size)
+	}
+	var srcHash string
+	err = dec.Decode(&srcHash)
+	if err != nil {
+		return err
+	}
+	if h.hash == nil {
+		h.hash = hash32For(srcHash)
+		if h.hash == nil {
+			return fmt.Errorf("card: hash function not set and no hash registered for %q", srcHash)
+		}
+	} else {
+		dstHash := typeNameOf(h.hash)
+		if dstHash != srcHash {
+			return fmt.Errorf("card: mismatched hash function: dst=%s src=%s", dstHash, srcHash)
+		}
+	}
+	for _, v := range []interface{}{&h.p, &h.window, &h.last} {
+		err = dec.Decode(v)
+		if err != nil {
+			return err
+		}
+	}
+	h.m = uint32(1) << h.p
+	h.register = nil
+	err = dec.Decode(&h.register)
+	if err != nil {
+		return err
+	}
+	if uint32(len(h.register)) != h.m {
+		return errors.New("card: mismatched register count")
+	}
+	return nil
+}
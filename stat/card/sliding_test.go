@@ -0,0 +1,191 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package card
+
+import (
+	"hash/fnv"
+	"math"
+	"math/rand/v2"
+	"strconv"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestSlidingHyperLogLog(t *testing.T) {
+	t.Parallel()
+	const (
+		n      = 100000
+		window = 50000
+	)
+	s32, err := NewSlidingHyperLogLog32(12, window, fnv.New32a())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	s64, err := NewSlidingHyperLogLog64(12, window, fnv.New64a())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Each item is written twice so times are not unique.
+	var dst []byte
+	for i := 0; i < n; i++ {
+		dst = item(dst, int64(i))
+		for range 2 {
+			if _, err := s32.WriteTime(dst, int64(i)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if _, err := s64.WriteTime(dst, int64(i)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+	}
+
+	for _, since := range []int64{n - 10, n - 1000, n - 20000, n - window, math.MinInt64} {
+		// The sliding count over the observations since a time
+		// is the count of a plain sketch of those observations.
+		h32 := mustCounter(NewHyperLogLog32(12, fnv.New32a()))
+		h64 := mustCounter(NewHyperLogLog64(12, fnv.New64a()))
+		first := max(since, n-1-window)
+		for i := first; i < n; i++ {
+			dst = item(dst, i)
+			h32.Write(dst)
+			h64.Write(dst)
+		}
+		if got, want := s32.Count(since), h32.Count(); got != want {
+			t.Errorf("unexpected 32 bit count since %d: got:%v want:%v", since, got, want)
+		}
+		if got, want := s64.Count(since), h64.Count(); got != want {
+			t.Errorf("unexpected 64 bit count since %d: got:%v want:%v", since, got, want)
+		}
+		if got, want := s64.Count(since), float64(n-first); !scalar.EqualWithinRel(got, want, 0.05) {
+			t.Errorf("inaccurate count since %d: got:%.0f want:%.0f", since, got, want)
+		}
+	}
+
+	if _, err := s64.WriteTime([]byte("late"), 0); err == nil {
+		t.Error("expected error for out of order observation")
+	}
+
+	b, err := s64.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error marshaling: %v", err)
+	}
+	var u SlidingHyperLogLog64
+	err = u.UnmarshalBinary(b)
+	if err == nil {
+		t.Error("expected error unmarshaling with unregistered hash")
+	}
+	u = SlidingHyperLogLog64{hash: fnv.New64a()}
+	err = u.UnmarshalBinary(b)
+	if err != nil {
+		t.Fatalf("unexpected error unmarshaling: %v", err)
+	}
+	for _, since := range []int64{n - 10, n - 1000, 0} {
+		if got, want := u.Count(since), s64.Count(since); got != want {
+			t.Errorf("unexpected count after round trip since %d: got:%v want:%v", since, got, want)
+		}
+	}
+
+	s64.Reset()
+	if got := s64.Count(math.MinInt64); got != 0 {
+		t.Errorf("unexpected count after reset: got:%v want:0", got)
+	}
+}
+
+func TestSlidingHyperLogLogUnion(t *testing.T) {
+	t.Parallel()
+	const n = 20000
+	var parts [2]*SlidingHyperLogLog64
+	for i := range parts {
+		var err error
+		parts[i], err = NewSlidingHyperLogLog64(10, n, fnv.New64a())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	whole, err := NewSlidingHyperLogLog64(10, n, fnv.New64a())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var dst []byte
+	for i := 0; i < n; i++ {
+		dst = item(dst, int64(i))
+		whole.WriteTime(dst, int64(i))
+		parts[i%3/2].WriteTime(dst, int64(i))
+	}
+
+	var u SlidingHyperLogLog64
+	err = u.Union(parts[0], parts[1])
+	if err != nil {
+		t.Fatalf("unexpected error from Union: %v", err)
+	}
+	err = u.SetHash(fnv.New64a())
+	if err != nil {
+		t.Fatalf("unexpected error from SetHash: %v", err)
+	}
+	if u.SetHash(fnv.New64a()) == nil {
+		t.Error("expected error setting hash twice")
+	}
+	for _, since := range []int64{n - 10, n - 500, n / 2, 0} {
+		if got, want := u.Count(since), whole.Count(since); got != want {
+			t.Errorf("unexpected union count since %d: got:%v want:%v", since, got, want)
+		}
+	}
+}
+
+func TestIntersection(t *testing.T) {
+	t.Parallel()
+	const n = 20000
+	a := mustCounter(NewHyperLogLog64(14, fnv.New64a())).(*HyperLogLog64)
+	b := mustCounter(NewHyperLogLog64(14, fnv.New64a())).(*HyperLogLog64)
+	var dst []byte
+	for i := 0; i < 3*n; i++ {
+		dst = item(dst, int64(i))
+		if i < 2*n {
+			a.Write(dst)
+		}
+		if i >= n {
+			b.Write(dst)
+		}
+	}
+	got, stderr, err := a.Intersection(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stderr <= 0 || 3*stderr > n {
+		t.Errorf("unexpected standard error: got:%v", stderr)
+	}
+	if !scalar.EqualWithinAbs(got, n, 3*stderr) {
+		t.Errorf("unexpected intersection: got:%.0f±%.0f want:%d", got, stderr, n)
+	}
+
+	// Disjoint sets have an intersection near zero.
+	c := mustCounter(NewHyperLogLog64(14, fnv.New64a())).(*HyperLogLog64)
+	for i := 0; i < n; i++ {
+		dst = item(dst, int64(-i-1))
+		c.Write(dst)
+	}
+	got, stderr, err = a.Intersection(c)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got > 3*stderr {
+		t.Errorf("unexpected intersection of disjoint sets: got:%.0f±%.0f want:0", got, stderr)
+	}
+
+	d := mustCounter(NewHyperLogLog64(14, fnv.New64())).(*HyperLogLog64)
+	if _, _, err := a.Intersection(d); err == nil {
+		t.Error("expected error for mismatched hash functions")
+	}
+}
+
+// item returns the data for the item i appended to dst[:0]. The data
+// has a pseudo-random prefix to give good hash dispersion.
+func item(dst []byte, i int64) []byte {
+	rnd := rand.New(rand.NewPCG(uint64(i), 1))
+	dst = strconv.AppendUint(dst[:0], rnd.Uint64(), 16)
+	dst = append(dst, '-')
+	return strconv.AppendInt(dst, i, 10)
+}
@@ -0,0 +1,60 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cluster_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/cluster"
+)
+
+func ExampleKMeans() {
+	x := mat.NewDense(6, 2, []float64{
+		1.0, 1.1,
+		0.9, 1.0,
+		1.1, 0.9,
+		5.0, 5.2,
+		5.1, 4.9,
+		4.9, 5.0,
+	})
+	res := cluster.KMeans(x, 2, &cluster.KMeansSettings{
+		Restarts: 5,
+		Src:      rand.NewPCG(1, 1),
+	})
+	fmt.Println("same cluster:", res.Labels[0] == res.Labels[1], res.Labels[3] == res.Labels[5])
+	fmt.Println("separated:", res.Labels[0] != res.Labels[3])
+	fmt.Printf("inertia: %.3f\n", res.Inertia)
+
+	// Output:
+	// same cluster: true true
+	// separated: true
+	// inertia: 0.107
+}
+
+func ExampleAgglomerative() {
+	pts := []float64{0, 1, 3, 10, 11, 20}
+	dis := mat.NewSymDense(len(pts), nil)
+	for i := range pts {
+		for j := i + 1; j < len(pts); j++ {
+			dis.SetSym(i, j, floats.Distance(pts[i:i+1], pts[j:j+1], 2))
+		}
+	}
+	den := cluster.Agglomerative(dis, cluster.Single)
+	for _, m := range den.Merges {
+		fmt.Printf("merge %d and %d at %v (size %d)\n", m.A, m.B, m.Height, m.Size)
+	}
+	fmt.Println("3 clusters:", den.Cut(3))
+
+	// Output:
+	// merge 0 and 1 at 1 (size 2)
+	// merge 3 and 4 at 1 (size 2)
+	// merge 2 and 6 at 2 (size 3)
+	// merge 7 and 8 at 7 (size 5)
+	// merge 5 and 9 at 9 (size 6)
+	// 3 clusters: [0 0 0 1 1 2]
+}
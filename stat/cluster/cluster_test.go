@@ -0,0 +1,65 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// blobs returns n observations in each of the given centers with
+// isotropic Gaussian noise of the given standard deviation, and
+// the index of the center of each observation.
+func blobs(centers [][]float64, n int, sd float64, rnd *rand.Rand) (*mat.Dense, []int) {
+	d := len(centers[0])
+	x := mat.NewDense(n*len(centers), d, nil)
+	truth := make([]int, n*len(centers))
+	for c, center := range centers {
+		for i := 0; i < n; i++ {
+			row := c*n + i
+			for j, v := range center {
+				x.Set(row, j, v+sd*rnd.NormFloat64())
+			}
+			truth[row] = c
+		}
+	}
+	return x, truth
+}
+
+// dissimilarity returns the Euclidean distance matrix between
+// the rows of x.
+func dissimilarity(x mat.Matrix) *mat.SymDense {
+	n, _ := x.Dims()
+	d := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			d.SetSym(i, j, floats.Distance(mat.Row(nil, i, x), mat.Row(nil, j, x), 2))
+		}
+	}
+	return d
+}
+
+// samePartition returns whether the labelings a and b describe the
+// same partition of the observations up to relabeling.
+func samePartition(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	ab := make(map[int]int)
+	ba := make(map[int]int)
+	for i := range a {
+		if l, ok := ab[a[i]]; ok && l != b[i] {
+			return false
+		}
+		if l, ok := ba[b[i]]; ok && l != a[i] {
+			return false
+		}
+		ab[a[i]] = b[i]
+		ba[b[i]] = a[i]
+	}
+	return true
+}
@@ -0,0 +1,140 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/spatial/kdtree"
+)
+
+// Noise is the label given to observations that are not
+// assigned to any cluster by density-based clustering.
+const Noise = -1
+
+// DBSCAN clusters the rows of x using the DBSCAN algorithm. Observations
+// with at least minPts observations, including themselves, within the
+// Euclidean distance eps are core observations. Clusters are the connected
+// components of core observations linked when within eps of each other,
+// together with the non-core observations within eps of a core observation.
+// DBSCAN returns the cluster label of each observation, with clusters
+// labeled from zero in order of discovery and observations not in any
+// cluster labeled Noise. Neighborhoods are found using a k-d tree.
+//
+// See "A density-based algorithm for discovering clusters in large spatial
+// databases with noise", Proceedings of the Second International Conference
+// on Knowledge Discovery and Data Mining 226-231 for details.
+//
+// DBSCAN will panic if eps is negative or minPts is less than one.
+func DBSCAN(x mat.Matrix, eps float64, minPts int) []int {
+	if eps < 0 {
+		panic("cluster: negative neighborhood radius")
+	}
+	if minPts < 1 {
+		panic("cluster: invalid minimum number of points")
+	}
+	n, _ := x.Dims()
+	labels := make([]int, n)
+	if n == 0 {
+		return labels
+	}
+	xd := mat.DenseCopyOf(x)
+	pts := make(indexedPoints, n)
+	for i := range pts {
+		pts[i] = indexedPoint{Point: xd.RawRowView(i), index: i}
+	}
+	query := make([]indexedPoint, n)
+	copy(query, pts)
+	tree := kdtree.New(pts, false)
+
+	const unvisited = -2
+	for i := range labels {
+		labels[i] = unvisited
+	}
+	neighbors := func(i int) []int {
+		found := tree.NearestWithin(query[i], eps*eps)
+		idx := make([]int, len(found))
+		for j, c := range found {
+			idx[j] = c.Comparable.(indexedPoint).index
+		}
+		return idx
+	}
+
+	var cluster int
+	for i := range labels {
+		if labels[i] != unvisited {
+			continue
+		}
+		nb := neighbors(i)
+		if len(nb) < minPts {
+			labels[i] = Noise
+			continue
+		}
+		labels[i] = cluster
+		queue := nb
+		for len(queue) != 0 {
+			j := queue[0]
+			queue = queue[1:]
+			switch labels[j] {
+			case Noise:
+				// A border observation.
+				labels[j] = cluster
+				continue
+			case unvisited:
+				labels[j] = cluster
+			default:
+				continue
+			}
+			if nb := neighbors(j); len(nb) >= minPts {
+				queue = append(queue, nb...)
+			}
+		}
+		cluster++
+	}
+	return labels
+}
+
+// indexedPoint is a kdtree.Point that retains its
+// row index in the clustered matrix.
+type indexedPoint struct {
+	kdtree.Point
+	index int
+}
+
+func (p indexedPoint) Compare(c kdtree.Comparable, d kdtree.Dim) float64 {
+	return p.Point[d] - c.(indexedPoint).Point[d]
+}
+
+func (p indexedPoint) Distance(c kdtree.Comparable) float64 {
+	return p.Point.Distance(c.(indexedPoint).Point)
+}
+
+// indexedPoints is a collection of indexedPoint
+// satisfying the kdtree.Interface.
+type indexedPoints []indexedPoint
+
+func (p indexedPoints) Index(i int) kdtree.Comparable         { return p[i] }
+func (p indexedPoints) Len() int                              { return len(p) }
+func (p indexedPoints) Slice(start, end int) kdtree.Interface { return p[start:end] }
+func (p indexedPoints) Pivot(d kdtree.Dim) int {
+	pl := indexedPlane{dim: d, points: p}
+	return kdtree.Partition(pl, kdtree.MedianOfRandoms(pl, 100))
+}
+
+// indexedPlane allows indexedPoints to be
+// pivoted on a dimension.
+type indexedPlane struct {
+	dim    kdtree.Dim
+	points indexedPoints
+}
+
+func (p indexedPlane) Len() int { return len(p.points) }
+func (p indexedPlane) Less(i, j int) bool {
+	return p.points[i].Point[p.dim] < p.points[j].Point[p.dim]
+}
+func (p indexedPlane) Swap(i, j int) { p.points[i], p.points[j] = p.points[j], p.points[i] }
+func (p indexedPlane) Slice(start, end int) kdtree.SortSlicer {
+	p.points = p.points[start:end]
+	return p
+}
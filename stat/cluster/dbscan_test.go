@@ -0,0 +1,114 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// naiveDBSCAN returns the DBSCAN core observations of the rows
+// of x and the connected components of the core observations.
+func naiveDBSCAN(x *mat.Dense, eps float64, minPts int) (core []bool, component []int) {
+	n, _ := x.Dims()
+	near := func(i, j int) bool { return floats.Distance(x.RawRowView(i), x.RawRowView(j), 2) <= eps }
+	core = make([]bool, n)
+	for i := 0; i < n; i++ {
+		var cnt int
+		for j := 0; j < n; j++ {
+			if near(i, j) {
+				cnt++
+			}
+		}
+		core[i] = cnt >= minPts
+	}
+	component = make([]int, n)
+	for i := range component {
+		component[i] = -1
+	}
+	var c int
+	for i := 0; i < n; i++ {
+		if !core[i] || component[i] >= 0 {
+			continue
+		}
+		stack := []int{i}
+		component[i] = c
+		for len(stack) != 0 {
+			j := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for k := 0; k < n; k++ {
+				if core[k] && component[k] < 0 && near(j, k) {
+					component[k] = c
+					stack = append(stack, k)
+				}
+			}
+		}
+		c++
+	}
+	return core, component
+}
+
+func TestDBSCAN(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	x, _ := blobs([][]float64{{0, 0}, {10, 0}, {5, 8}}, 40, 1, rnd)
+	n, _ := x.Dims()
+
+	// Add uniform background noise.
+	noisy := mat.NewDense(n+20, 2, nil)
+	noisy.Slice(0, n, 0, 2).(*mat.Dense).Copy(x)
+	for i := n; i < n+20; i++ {
+		noisy.Set(i, 0, 30*rnd.Float64()-10)
+		noisy.Set(i, 1, 30*rnd.Float64()-10)
+	}
+
+	for _, test := range []struct {
+		eps    float64
+		minPts int
+	}{
+		{eps: 1, minPts: 5},
+		{eps: 1.5, minPts: 4},
+		{eps: 0.5, minPts: 3},
+		{eps: 3, minPts: 1},
+	} {
+		labels := DBSCAN(noisy, test.eps, test.minPts)
+		core, component := naiveDBSCAN(noisy, test.eps, test.minPts)
+		n, _ := noisy.Dims()
+		var coreLabels, coreComponents []int
+		for i := 0; i < n; i++ {
+			if core[i] {
+				coreLabels = append(coreLabels, labels[i])
+				coreComponents = append(coreComponents, component[i])
+				continue
+			}
+			// A non-core observation is either noise or
+			// in the cluster of a neighboring core.
+			var ok bool
+			for j := 0; j < n; j++ {
+				if core[j] && labels[j] == labels[i] && floats.Distance(noisy.RawRowView(i), noisy.RawRowView(j), 2) <= test.eps {
+					ok = true
+					break
+				}
+			}
+			if labels[i] != Noise && !ok {
+				t.Errorf("border observation %d not adjacent to a core in its cluster for eps=%v minPts=%d", i, test.eps, test.minPts)
+			}
+			if labels[i] == Noise {
+				for j := 0; j < n; j++ {
+					if core[j] && floats.Distance(noisy.RawRowView(i), noisy.RawRowView(j), 2) <= test.eps {
+						t.Errorf("observation %d labeled noise but adjacent to core %d for eps=%v minPts=%d", i, j, test.eps, test.minPts)
+						break
+					}
+				}
+			}
+		}
+		if !samePartition(coreLabels, coreComponents) {
+			t.Errorf("unexpected core partition for eps=%v minPts=%d", test.eps, test.minPts)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cluster provides partitional, density-based and hierarchical
+// clustering of observations.
+//
+// Observations are represented as the rows of a matrix for the routines that
+// work with coordinates, and as a symmetric dissimilarity matrix for the
+// routines that work only with the dissimilarities between observations.
+package cluster // import "gonum.org/v1/gonum/stat/cluster"
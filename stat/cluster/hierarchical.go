@@ -0,0 +1,273 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Linkage specifies the dissimilarity between clusters used in
+// agglomerative clustering.
+type Linkage int
+
+const (
+	// Single linkage uses the minimum dissimilarity
+	// between observations in the two clusters.
+	Single Linkage = iota
+
+	// Complete linkage uses the maximum dissimilarity
+	// between observations in the two clusters.
+	Complete
+
+	// Average linkage uses the mean dissimilarity
+	// between observations in the two clusters.
+	Average
+
+	// Ward linkage merges the clusters that give the
+	// minimum increase in the total within-cluster
+	// variance. The dissimilarities must be Euclidean
+	// distances.
+	Ward
+)
+
+// Merge is a merge of two clusters in a Dendrogram. Clusters are identified
+// by integers: the values 0 to n-1 are the singleton clusters holding each
+// of the n observations and the value n+i is the cluster created by the ith
+// merge.
+type Merge struct {
+	// A and B are the merged clusters.
+	A, B int
+
+	// Height is the linkage dissimilarity
+	// between A and B.
+	Height float64
+
+	// Size is the number of observations
+	// in the merged cluster.
+	Size int
+}
+
+// Dendrogram is the result of agglomerative hierarchical clustering.
+type Dendrogram struct {
+	// Merges holds the n-1 merges of the
+	// clustering in order of increasing
+	// height.
+	Merges []Merge
+
+	n int
+}
+
+// Len returns the number of observations in the dendrogram.
+func (d *Dendrogram) Len() int { return d.n }
+
+// Agglomerative performs agglomerative hierarchical clustering of n
+// observations given the n×n dissimilarity matrix between them using the
+// specified linkage. The returned Dendrogram holds the n-1 merges sorted by
+// increasing height.
+//
+// The clustering is performed with the nearest-neighbor chain algorithm in
+// O(n²) time and space, with cluster dissimilarities updated using the
+// Lance-Williams formula.
+//
+// See Müllner, "Modern hierarchical, agglomerative clustering algorithms",
+// arXiv:1109.2378 for details of the algorithm.
+//
+// Agglomerative will panic if linkage is not a valid Linkage.
+func Agglomerative(dis mat.Symmetric, linkage Linkage) *Dendrogram {
+	if linkage < Single || Ward < linkage {
+		panic("cluster: invalid linkage")
+	}
+	n := dis.SymmetricDim()
+	den := &Dendrogram{n: n}
+	if n < 2 {
+		return den
+	}
+
+	// d holds the current dissimilarities between
+	// active clusters, represented by the index of
+	// one of their observations.
+	d := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			v := dis.At(i, j)
+			if linkage == Ward {
+				v *= v
+			}
+			d.SetSym(i, j, v)
+		}
+	}
+	size := make([]int, n)
+	active := make([]bool, n)
+	for i := range size {
+		size[i] = 1
+		active[i] = true
+	}
+
+	type merge struct {
+		a, b   int
+		height float64
+	}
+	merges := make([]merge, 0, n-1)
+	chain := make([]int, 0, n)
+	for len(merges) < n-1 {
+		if len(chain) == 0 {
+			for i, ok := range active {
+				if ok {
+					chain = append(chain, i)
+					break
+				}
+			}
+		}
+		for {
+			a := chain[len(chain)-1]
+			prev := -1
+			if len(chain) > 1 {
+				prev = chain[len(chain)-2]
+			}
+			// Find the nearest neighbor of a, preferring
+			// the previous element of the chain on ties.
+			b := prev
+			best := math.Inf(1)
+			if prev >= 0 {
+				best = d.At(a, prev)
+			}
+			for i, ok := range active {
+				if !ok || i == a {
+					continue
+				}
+				if v := d.At(a, i); v < best {
+					b, best = i, v
+				}
+			}
+			if b == prev {
+				// a and b are reciprocal nearest
+				// neighbors, so merge them.
+				chain = chain[:len(chain)-2]
+				if linkage == Ward {
+					best = math.Sqrt(best)
+				}
+				merges = append(merges, merge{a: a, b: b, height: best})
+				lanceWilliams(d, active, size, a, b, linkage)
+				break
+			}
+			chain = append(chain, b)
+		}
+	}
+
+	// Order the merges by height and relabel the
+	// clusters using a union-find structure.
+	sort.SliceStable(merges, func(i, j int) bool { return merges[i].height < merges[j].height })
+	parent := make([]int, n)
+	label := make([]int, n)
+	for i := range parent {
+		parent[i] = i
+		label[i] = i
+		size[i] = 1
+	}
+	find := func(i int) int {
+		for parent[i] != i {
+			parent[i] = parent[parent[i]]
+			i = parent[i]
+		}
+		return i
+	}
+	den.Merges = make([]Merge, len(merges))
+	for i, m := range merges {
+		ra, rb := find(m.a), find(m.b)
+		la, lb := label[ra], label[rb]
+		if la > lb {
+			la, lb = lb, la
+		}
+		den.Merges[i] = Merge{A: la, B: lb, Height: m.height, Size: size[ra] + size[rb]}
+		parent[rb] = ra
+		size[ra] += size[rb]
+		label[ra] = n + i
+	}
+	return den
+}
+
+// lanceWilliams merges cluster b into cluster a, updating the
+// dissimilarities between a and the other active clusters.
+func lanceWilliams(d *mat.SymDense, active []bool, size []int, a, b int, linkage Linkage) {
+	dab := d.At(a, b)
+	na, nb := float64(size[a]), float64(size[b])
+	for k, ok := range active {
+		if !ok || k == a || k == b {
+			continue
+		}
+		dak, dbk := d.At(a, k), d.At(b, k)
+		var v float64
+		switch linkage {
+		case Single:
+			v = math.Min(dak, dbk)
+		case Complete:
+			v = math.Max(dak, dbk)
+		case Average:
+			v = (na*dak + nb*dbk) / (na + nb)
+		case Ward:
+			nk := float64(size[k])
+			v = ((na+nk)*dak + (nb+nk)*dbk - nk*dab) / (na + nb + nk)
+		}
+		d.SetSym(a, k, v)
+	}
+	active[b] = false
+	size[a] += size[b]
+}
+
+// Cut returns the cluster labels of the observations when the dendrogram
+// is cut to give k clusters. Clusters are labeled from zero in order of
+// their first observation. Cut will panic if k is less than one or greater
+// than the number of observations.
+func (d *Dendrogram) Cut(k int) []int {
+	if k < 1 || k > d.n {
+		panic("cluster: invalid number of clusters")
+	}
+	return d.cut(d.n - k)
+}
+
+// CutHeight returns the cluster labels of the observations when the
+// dendrogram is cut at the height h, so that clusters merged at a height
+// greater than h are separated. Clusters are labeled from zero in order of
+// their first observation.
+func (d *Dendrogram) CutHeight(h float64) []int {
+	m := sort.Search(len(d.Merges), func(i int) bool { return d.Merges[i].Height > h })
+	return d.cut(m)
+}
+
+// cut returns the cluster labels after applying the first m merges.
+func (d *Dendrogram) cut(m int) []int {
+	if d.n == 0 {
+		return nil
+	}
+	parent := make([]int, 2*d.n-1)
+	for i := range parent {
+		parent[i] = i
+	}
+	for i, mg := range d.Merges[:m] {
+		parent[mg.A] = d.n + i
+		parent[mg.B] = d.n + i
+	}
+	root := func(i int) int {
+		for parent[i] != i {
+			i = parent[i]
+		}
+		return i
+	}
+	labels := make([]int, d.n)
+	ids := make(map[int]int)
+	for i := range labels {
+		r := root(i)
+		id, ok := ids[r]
+		if !ok {
+			id = len(ids)
+			ids[r] = id
+		}
+		labels[i] = id
+	}
+	return labels
+}
@@ -0,0 +1,122 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+// naiveAgglomerative returns the merge heights of agglomerative
+// clustering of the rows of x calculated directly from the
+// definitions of the linkages.
+func naiveAgglomerative(x *mat.Dense, linkage Linkage) []float64 {
+	n, _ := x.Dims()
+	clusters := make([][]int, n)
+	for i := range clusters {
+		clusters[i] = []int{i}
+	}
+	centroid := func(c []int) []float64 {
+		_, d := x.Dims()
+		m := make([]float64, d)
+		for _, i := range c {
+			floats.Add(m, x.RawRowView(i))
+		}
+		floats.Scale(1/float64(len(c)), m)
+		return m
+	}
+	link := func(a, b []int) float64 {
+		switch linkage {
+		case Ward:
+			na, nb := float64(len(a)), float64(len(b))
+			return math.Sqrt(2 * na * nb / (na + nb) * sqDist(centroid(a), centroid(b)))
+		}
+		var v float64
+		switch linkage {
+		case Single:
+			v = math.Inf(1)
+		case Complete:
+			v = math.Inf(-1)
+		}
+		for _, i := range a {
+			for _, j := range b {
+				d := floats.Distance(x.RawRowView(i), x.RawRowView(j), 2)
+				switch linkage {
+				case Single:
+					v = math.Min(v, d)
+				case Complete:
+					v = math.Max(v, d)
+				case Average:
+					v += d / float64(len(a)*len(b))
+				}
+			}
+		}
+		return v
+	}
+	var heights []float64
+	for len(clusters) > 1 {
+		bi, bj := -1, -1
+		best := math.Inf(1)
+		for i := range clusters {
+			for j := i + 1; j < len(clusters); j++ {
+				if v := link(clusters[i], clusters[j]); v < best {
+					bi, bj, best = i, j, v
+				}
+			}
+		}
+		heights = append(heights, best)
+		clusters[bi] = append(clusters[bi], clusters[bj]...)
+		clusters = slices.Delete(clusters, bj, bj+1)
+	}
+	slices.Sort(heights)
+	return heights
+}
+
+func TestAgglomerative(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	x, truth := blobs([][]float64{{0, 0}, {8, 0}, {4, 8}}, 12, 1, rnd)
+	dis := dissimilarity(x)
+	n := dis.SymmetricDim()
+	for _, linkage := range []Linkage{Single, Complete, Average, Ward} {
+		den := Agglomerative(dis, linkage)
+		if len(den.Merges) != n-1 {
+			t.Errorf("unexpected number of merges for linkage %d: got:%d want:%d", linkage, len(den.Merges), n-1)
+			continue
+		}
+		want := naiveAgglomerative(x, linkage)
+		for i, m := range den.Merges {
+			if !scalar.EqualWithinAbsOrRel(m.Height, want[i], 1e-10, 1e-10) {
+				t.Errorf("unexpected height for merge %d with linkage %d: got:%v want:%v", i, linkage, m.Height, want[i])
+			}
+			if m.A >= m.B || m.B >= n+i {
+				t.Errorf("invalid merge %d with linkage %d: %+v", i, linkage, m)
+			}
+		}
+		if last := den.Merges[n-2]; last.Size != n {
+			t.Errorf("unexpected final cluster size with linkage %d: got:%d want:%d", linkage, last.Size, n)
+		}
+
+		if got := den.Cut(3); !samePartition(got, truth) {
+			t.Errorf("unexpected partition for linkage %d: got:%v want:%v", linkage, got, truth)
+		}
+		if got := den.Cut(n); slices.Max(got) != n-1 {
+			t.Errorf("unexpected singleton partition for linkage %d: %v", linkage, got)
+		}
+		if got := den.Cut(1); slices.Max(got) != 0 {
+			t.Errorf("unexpected single cluster partition for linkage %d: %v", linkage, got)
+		}
+		h := (den.Merges[n-3].Height + den.Merges[n-4].Height) / 2
+		if got := den.CutHeight(h); slices.Max(got) != 2 {
+			t.Errorf("unexpected number of clusters for cut at %v with linkage %d: got:%d want:3", h, linkage, slices.Max(got)+1)
+		}
+	}
+}
@@ -0,0 +1,273 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"math"
+	"math/rand/v2"
+	"runtime"
+	"sync"
+
+	"gonum.org/v1/gonum/blas/gonum"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// KMeansSettings holds the settings for KMeans.
+type KMeansSettings struct {
+	// MaxIterations is the maximum number of
+	// Lloyd iterations for each restart. If
+	// MaxIterations is zero, 300 is used.
+	MaxIterations int
+
+	// Tolerance is the threshold on the sum of
+	// squared movements of the centers below which
+	// the iteration is considered converged.
+	Tolerance float64
+
+	// Restarts is the number of k-means++
+	// initializations to run. The clustering
+	// with the lowest inertia is returned. If
+	// Restarts is zero, one is used.
+	Restarts int
+
+	// Concurrent is the number of goroutines to
+	// use for the assignment step. If Concurrent
+	// is zero, the limit set by gonum.SetMaxProcs
+	// is used, or runtime.GOMAXPROCS(0) if no
+	// limit is set.
+	Concurrent int
+
+	// Src is the source of randomness for
+	// initialization. If Src is nil, the global
+	// rand package functions are used.
+	Src rand.Source
+}
+
+// KMeansResult is the result of a k-means clustering.
+type KMeansResult struct {
+	// Labels holds the cluster label of
+	// each observation.
+	Labels []int
+
+	// Centers holds the center of each
+	// cluster in its rows.
+	Centers *mat.Dense
+
+	// Inertia is the sum of squared
+	// distances from each observation to
+	// its cluster center.
+	Inertia float64
+
+	// Iterations is the number of Lloyd
+	// iterations of the returned clustering.
+	Iterations int
+
+	// Converged indicates whether the
+	// returned clustering converged
+	// before the iteration limit.
+	Converged bool
+}
+
+// KMeans clusters the rows of x into k clusters using Lloyd's algorithm with
+// k-means++ seeding. The assignment step of each iteration is performed
+// concurrently. If settings is nil, default settings are used.
+//
+// See "k-means++: The advantages of careful seeding", Proceedings of the
+// eighteenth annual ACM-SIAM symposium on Discrete algorithms 1027-1035
+// for details of the seeding.
+//
+// KMeans will panic if k is less than one or greater than the number of
+// rows of x.
+func KMeans(x mat.Matrix, k int, settings *KMeansSettings) *KMeansResult {
+	n, _ := x.Dims()
+	if k < 1 || k > n {
+		panic("cluster: invalid number of clusters")
+	}
+	var s KMeansSettings
+	if settings != nil {
+		s = *settings
+	}
+	if s.MaxIterations == 0 {
+		s.MaxIterations = 300
+	}
+	if s.Restarts == 0 {
+		s.Restarts = 1
+	}
+	if s.Concurrent == 0 {
+		s.Concurrent = maxWorkers()
+	}
+	var rnd *rand.Rand
+	if s.Src != nil {
+		rnd = rand.New(s.Src)
+	}
+	xd := mat.DenseCopyOf(x)
+
+	var best *KMeansResult
+	for r := 0; r < s.Restarts; r++ {
+		centers := seedKMeans(xd, k, rnd)
+		res := lloyd(xd, centers, &s)
+		if best == nil || res.Inertia < best.Inertia {
+			best = res
+		}
+	}
+	return best
+}
+
+// seedKMeans returns k initial centers chosen from the rows of x
+// using k-means++ seeding.
+func seedKMeans(x *mat.Dense, k int, rnd *rand.Rand) *mat.Dense {
+	n, d := x.Dims()
+	float64n := rand.Float64
+	intn := rand.IntN
+	if rnd != nil {
+		float64n = rnd.Float64
+		intn = rnd.IntN
+	}
+
+	centers := mat.NewDense(k, d, nil)
+	centers.SetRow(0, x.RawRowView(intn(n)))
+	dist := make([]float64, n)
+	for i := range dist {
+		dist[i] = sqDist(x.RawRowView(i), centers.RawRowView(0))
+	}
+	for c := 1; c < k; c++ {
+		// Sample the next center with probability
+		// proportional to the squared distance to
+		// the nearest existing center.
+		sum := floats.Sum(dist)
+		next := n - 1
+		if sum > 0 {
+			u := float64n() * sum
+			for i, v := range dist {
+				u -= v
+				if u < 0 {
+					next = i
+					break
+				}
+			}
+		} else {
+			next = intn(n)
+		}
+		centers.SetRow(c, x.RawRowView(next))
+		for i := range dist {
+			dist[i] = math.Min(dist[i], sqDist(x.RawRowView(i), centers.RawRowView(c)))
+		}
+	}
+	return centers
+}
+
+// lloyd runs Lloyd's algorithm from the given initial centers.
+func lloyd(x, centers *mat.Dense, s *KMeansSettings) *KMeansResult {
+	n, d := x.Dims()
+	k, _ := centers.Dims()
+	labels := make([]int, n)
+	for i := range labels {
+		labels[i] = -1
+	}
+	dist := make([]float64, n)
+	counts := make([]int, k)
+	next := mat.NewDense(k, d, nil)
+
+	res := &KMeansResult{Labels: labels, Centers: centers}
+	for res.Iterations < s.MaxIterations {
+		res.Iterations++
+		changed := assign(x, centers, labels, dist, s.Concurrent)
+
+		// Update the centers.
+		next.Zero()
+		clear(counts)
+		for i, l := range labels {
+			floats.Add(next.RawRowView(l), x.RawRowView(i))
+			counts[l]++
+		}
+		for c, cnt := range counts {
+			if cnt == 0 {
+				// Move the center of an empty cluster to
+				// the observation furthest from its center.
+				far := floats.MaxIdx(dist)
+				next.SetRow(c, x.RawRowView(far))
+				dist[far] = 0
+				continue
+			}
+			floats.Scale(1/float64(cnt), next.RawRowView(c))
+		}
+		var shift float64
+		for c := 0; c < k; c++ {
+			shift += sqDist(centers.RawRowView(c), next.RawRowView(c))
+		}
+		centers.Copy(next)
+		if !changed || shift <= s.Tolerance {
+			res.Converged = true
+			break
+		}
+	}
+	assign(x, centers, labels, dist, s.Concurrent)
+	res.Inertia = floats.Sum(dist)
+	return res
+}
+
+// assign sets each element of labels to the index of the center nearest
+// to the corresponding row of x and dist to the squared distance to that
+// center, using up to workers goroutines. It returns whether any label
+// was changed.
+func assign(x, centers *mat.Dense, labels []int, dist []float64, workers int) bool {
+	n, _ := x.Dims()
+	k, _ := centers.Dims()
+	workers = max(1, min(workers, n))
+	chunk := (n + workers - 1) / workers
+	changed := make([]bool, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		start := w * chunk
+		end := min(start+chunk, n)
+		wg.Add(1)
+		go func(w, start, end int) {
+			defer wg.Done()
+			for i := start; i < end; i++ {
+				xi := x.RawRowView(i)
+				best := 0
+				bestDist := math.Inf(1)
+				for c := 0; c < k; c++ {
+					d := sqDist(xi, centers.RawRowView(c))
+					if d < bestDist {
+						best, bestDist = c, d
+					}
+				}
+				if labels[i] != best {
+					labels[i] = best
+					changed[w] = true
+				}
+				dist[i] = bestDist
+			}
+		}(w, start, end)
+	}
+	wg.Wait()
+	for _, c := range changed {
+		if c {
+			return true
+		}
+	}
+	return false
+}
+
+func sqDist(a, b []float64) float64 {
+	var sum float64
+	for i, v := range a {
+		d := v - b[i]
+		sum += d * d
+	}
+	return sum
+}
+
+// maxWorkers returns the maximum number of goroutines to use
+// in a parallel operation, honouring the limit set by
+// gonum.SetMaxProcs.
+func maxWorkers() int {
+	if n := gonum.MaxProcs(); n > 0 {
+		return n
+	}
+	return runtime.GOMAXPROCS(0)
+}
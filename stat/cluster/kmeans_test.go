@@ -0,0 +1,69 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestKMeans(t *testing.T) {
+	t.Parallel()
+	centers := [][]float64{{0, 0}, {10, 0}, {0, 10}, {10, 10}}
+	for _, concurrent := range []int{1, 4} {
+		rnd := rand.New(rand.NewPCG(1, 1))
+		x, truth := blobs(centers, 50, 1, rnd)
+		res := KMeans(x, 4, &KMeansSettings{Restarts: 5, Concurrent: concurrent, Src: rand.NewPCG(2, 2)})
+		if !res.Converged {
+			t.Errorf("k-means did not converge with %d workers", concurrent)
+		}
+		if !samePartition(res.Labels, truth) {
+			t.Errorf("unexpected partition with %d workers", concurrent)
+		}
+
+		// Each center must be the mean of its cluster.
+		var inertia float64
+		for c := 0; c < 4; c++ {
+			mean := make([]float64, 2)
+			var n int
+			for i, l := range res.Labels {
+				if l == c {
+					floats.Add(mean, x.RawRowView(i))
+					n++
+				}
+			}
+			floats.Scale(1/float64(n), mean)
+			if !floats.EqualApprox(mean, res.Centers.RawRowView(c), 1e-12) {
+				t.Errorf("unexpected center %d with %d workers: got:%v want:%v", c, concurrent, res.Centers.RawRowView(c), mean)
+			}
+			for i, l := range res.Labels {
+				if l == c {
+					inertia += sqDist(x.RawRowView(i), mean)
+				}
+			}
+		}
+		if !scalar.EqualWithinAbsOrRel(res.Inertia, inertia, 1e-10, 1e-10) {
+			t.Errorf("unexpected inertia with %d workers: got:%v want:%v", concurrent, res.Inertia, inertia)
+		}
+	}
+}
+
+func TestKMeansDuplicates(t *testing.T) {
+	t.Parallel()
+	// More clusters than distinct observations
+	// must not leave clusters without centers.
+	x := mat.NewDense(6, 1, []float64{1, 1, 1, 5, 5, 5})
+	res := KMeans(x, 3, &KMeansSettings{Src: rand.NewPCG(1, 1)})
+	if res.Inertia != 0 {
+		t.Errorf("unexpected inertia: got:%v want:0", res.Inertia)
+	}
+	if !samePartition(res.Labels[:3], []int{0, 0, 0}) || res.Labels[0] == res.Labels[3] {
+		t.Errorf("unexpected labels: %v", res.Labels)
+	}
+}
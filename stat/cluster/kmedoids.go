@@ -0,0 +1,200 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// KMedoidsResult is the result of a k-medoids clustering.
+type KMedoidsResult struct {
+	// Medoids holds the index of the
+	// medoid observation of each cluster.
+	Medoids []int
+
+	// Labels holds the cluster label of
+	// each observation.
+	Labels []int
+
+	// Cost is the sum of dissimilarities
+	// from each observation to its medoid.
+	Cost float64
+
+	// Swaps is the number of medoid swaps
+	// made after initialization.
+	Swaps int
+}
+
+// KMedoids clusters n observations into k clusters using the Partitioning
+// Around Medoids algorithm given the n×n dissimilarity matrix between the
+// observations. The initial medoids are chosen by the greedy BUILD phase,
+// and are then improved by the SWAP phase until no swap reduces the cost or
+// maxSwaps swaps have been made. If maxSwaps is zero, no limit is placed on
+// the number of swaps. Each pass of the SWAP phase takes O(k n²) time.
+//
+// See Kaufman and Rousseeuw, "Finding Groups in Data: An Introduction to
+// Cluster Analysis", Wiley 1990. doi:10.1002/9780470316801 for details of
+// the algorithm.
+//
+// KMedoids will panic if k is less than one or greater than n.
+func KMedoids(dis mat.Symmetric, k, maxSwaps int) *KMedoidsResult {
+	n := dis.SymmetricDim()
+	if k < 1 || k > n {
+		panic("cluster: invalid number of clusters")
+	}
+
+	// BUILD: greedily add the medoid that
+	// most reduces the cost.
+	nearest := make([]float64, n)
+	for i := range nearest {
+		nearest[i] = math.Inf(1)
+	}
+	isMedoid := make([]bool, n)
+	medoids := make([]int, 0, k)
+	for len(medoids) < k {
+		best := -1
+		bestCost := math.Inf(1)
+		for c := 0; c < n; c++ {
+			if isMedoid[c] {
+				continue
+			}
+			var cost float64
+			for i := 0; i < n; i++ {
+				cost += math.Min(nearest[i], dis.At(i, c))
+			}
+			if cost < bestCost {
+				best, bestCost = c, cost
+			}
+		}
+		medoids = append(medoids, best)
+		isMedoid[best] = true
+		for i := range nearest {
+			nearest[i] = math.Min(nearest[i], dis.At(i, best))
+		}
+	}
+
+	// SWAP: repeatedly make the swap of a medoid
+	// and a non-medoid that most reduces the cost.
+	res := &KMedoidsResult{Medoids: medoids, Labels: make([]int, n)}
+	first := make([]float64, n)
+	second := make([]float64, n)
+	for maxSwaps == 0 || res.Swaps < maxSwaps {
+		res.Cost = nearestMedoids(dis, medoids, res.Labels, first, second)
+
+		bestDelta := 0.0
+		bestM, bestO := -1, -1
+		for m := range medoids {
+			for o := 0; o < n; o++ {
+				if isMedoid[o] {
+					continue
+				}
+				var delta float64
+				for i := 0; i < n; i++ {
+					d := dis.At(i, o)
+					if res.Labels[i] == m {
+						// The observation moves to o or
+						// to its second nearest medoid.
+						delta += math.Min(d, second[i]) - first[i]
+					} else if d < first[i] {
+						delta += d - first[i]
+					}
+				}
+				if delta < bestDelta {
+					bestDelta, bestM, bestO = delta, m, o
+				}
+			}
+		}
+		// Guard against swaps made only due to
+		// floating point error.
+		if bestM < 0 || bestDelta > -1e-12*math.Max(res.Cost, 1) {
+			break
+		}
+		isMedoid[medoids[bestM]] = false
+		isMedoid[bestO] = true
+		medoids[bestM] = bestO
+		res.Swaps++
+	}
+	res.Cost = nearestMedoids(dis, medoids, res.Labels, first, second)
+	return res
+}
+
+// nearestMedoids sets labels to the index of the nearest medoid to each
+// observation, and first and second to the dissimilarities to the nearest
+// and second nearest medoids. It returns the sum of the elements of first.
+func nearestMedoids(dis mat.Symmetric, medoids, labels []int, first, second []float64) float64 {
+	var cost float64
+	for i := range labels {
+		first[i], second[i] = math.Inf(1), math.Inf(1)
+		for m, c := range medoids {
+			d := dis.At(i, c)
+			switch {
+			case d < first[i]:
+				second[i] = first[i]
+				first[i] = d
+				labels[i] = m
+			case d < second[i]:
+				second[i] = d
+			}
+		}
+		cost += first[i]
+	}
+	return cost
+}
+
+// Silhouette returns the mean silhouette width of the clustering of n
+// observations with the given labels and n×n dissimilarity matrix, and
+// places the silhouette width of each observation into dst if it is not
+// nil. The silhouette width of an observation in a singleton cluster is
+// zero. Silhouette will panic if the length of labels is not n or if dst
+// is not nil and does not have length n.
+//
+// See Rousseeuw, "Silhouettes: a graphical aid to the interpretation and
+// validation of cluster analysis", Journal of Computational and Applied
+// Mathematics 20:53-65. doi:10.1016/0377-0427(87)90125-7.
+func Silhouette(dst []float64, dis mat.Symmetric, labels []int) float64 {
+	n := dis.SymmetricDim()
+	if len(labels) != n {
+		panic("cluster: label length mismatch")
+	}
+	if dst != nil && len(dst) != n {
+		panic("cluster: destination length mismatch")
+	}
+	k := slices.Max(labels) + 1
+	sum := make([]float64, k)
+	size := make([]int, k)
+	for _, l := range labels {
+		size[l]++
+	}
+	var total float64
+	for i, li := range labels {
+		clear(sum)
+		for j, lj := range labels {
+			if i != j {
+				sum[lj] += dis.At(i, j)
+			}
+		}
+		var s float64
+		if size[li] > 1 {
+			a := sum[li] / float64(size[li]-1)
+			b := math.Inf(1)
+			for l, v := range sum {
+				if l != li && size[l] > 0 {
+					b = math.Min(b, v/float64(size[l]))
+				}
+			}
+			if !math.IsInf(b, 1) {
+				s = (b - a) / math.Max(a, b)
+			}
+		}
+		if dst != nil {
+			dst[i] = s
+		}
+		total += s
+	}
+	return total / float64(n)
+}
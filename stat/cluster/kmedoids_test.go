@@ -0,0 +1,93 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/combin"
+)
+
+func TestKMedoids(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	x, truth := blobs([][]float64{{0, 0}, {6, 0}, {3, 6}}, 6, 0.5, rnd)
+	dis := dissimilarity(x)
+	n := dis.SymmetricDim()
+
+	res := KMedoids(dis, 3, 0)
+	if !samePartition(res.Labels, truth) {
+		t.Errorf("unexpected partition: got:%v want:%v", res.Labels, truth)
+	}
+
+	// Compare with the exhaustive optimum.
+	best := math.Inf(1)
+	gen := combin.NewCombinationGenerator(n, 3)
+	medoids := make([]int, 3)
+	for gen.Next() {
+		gen.Combination(medoids)
+		var cost float64
+		for i := 0; i < n; i++ {
+			d := math.Inf(1)
+			for _, m := range medoids {
+				d = math.Min(d, dis.At(i, m))
+			}
+			cost += d
+		}
+		best = math.Min(best, cost)
+	}
+	if !scalar.EqualWithinAbsOrRel(res.Cost, best, 1e-12, 1e-12) {
+		t.Errorf("unexpected cost: got:%v want:%v", res.Cost, best)
+	}
+	for i, l := range res.Labels {
+		if d := dis.At(i, res.Medoids[l]); d != minMedoidDist(dis, i, res.Medoids) {
+			t.Errorf("observation %d not assigned to nearest medoid", i)
+		}
+	}
+
+	limited := KMedoids(dis, 3, 1)
+	if limited.Swaps > 1 {
+		t.Errorf("unexpected number of swaps: got:%d want:<=1", limited.Swaps)
+	}
+}
+
+func minMedoidDist(dis mat.Symmetric, i int, medoids []int) float64 {
+	d := math.Inf(1)
+	for _, m := range medoids {
+		d = math.Min(d, dis.At(i, m))
+	}
+	return d
+}
+
+func TestSilhouette(t *testing.T) {
+	t.Parallel()
+	dis := mat.NewSymDense(4, []float64{
+		0, 1, 4, 5,
+		1, 0, 3, 4,
+		4, 3, 0, 1,
+		5, 4, 1, 0,
+	})
+	labels := []int{0, 0, 1, 1}
+	got := make([]float64, 4)
+	mean := Silhouette(got, dis, labels)
+	want := []float64{
+		1 - 1/4.5,
+		1 - 1/3.5,
+		1 - 1/3.5,
+		1 - 1/4.5,
+	}
+	for i := range want {
+		if !scalar.EqualWithinAbsOrRel(got[i], want[i], 1e-14, 1e-14) {
+			t.Errorf("unexpected silhouette for %d: got:%v want:%v", i, got[i], want[i])
+		}
+	}
+	if wantMean := (want[0] + want[1] + want[2] + want[3]) / 4; !scalar.EqualWithinAbsOrRel(mean, wantMean, 1e-14, 1e-14) {
+		t.Errorf("unexpected mean silhouette: got:%v want:%v", mean, wantMean)
+	}
+}
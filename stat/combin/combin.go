@@ -144,6 +144,28 @@ func (c *CombinationGenerator) Combination(dst []int) []int {
 	return dst
 }
 
+// Seek positions the generator so that the following call to Next makes the
+// combination with index idx, as defined by CombinationIndex, current. Seek
+// allows the combinations to be partitioned into contiguous ranges that are
+// iterated over independently.
+//
+// Seek panics if idx is not in [0, Binomial(n,k)].
+func (c *CombinationGenerator) Seek(idx int) {
+	total := Binomial(c.n, c.k)
+	if idx < 0 || total < idx {
+		panic("combin: invalid index")
+	}
+	c.remaining = total - idx
+	if idx == 0 {
+		c.previous = nil
+		return
+	}
+	if c.previous == nil {
+		c.previous = make([]int, c.k)
+	}
+	IndexToCombination(c.previous, idx-1, c.n, c.k)
+}
+
 // Combinations generates all of the combinations of k elements from a
 // set of size n. The returned slice has length Binomial(n,k) and each inner slice
 // has length k.
@@ -469,6 +491,10 @@ type PermutationGenerator struct {
 	nPerm       int
 	idx         int
 	permutation []int
+
+	// comb and order are work space
+	// for unranking permutations.
+	comb, order []int
 }
 
 // NewPermutationGenerator returns a PermutationGenerator for generating the
@@ -483,6 +509,8 @@ func NewPermutationGenerator(n, k int) *PermutationGenerator {
 		nPerm:       NumPermutations(n, k),
 		idx:         -1,
 		permutation: make([]int, k),
+		comb:        make([]int, k),
+		order:       make([]int, k),
 	}
 }
 
@@ -496,7 +524,7 @@ func (p *PermutationGenerator) Next() bool {
 		return false
 	}
 	p.idx++
-	IndexToPermutation(p.permutation, p.idx, p.n, p.k)
+	indexToPermutation(p.permutation, p.comb, p.order, p.idx, p.n, p.k)
 	return true
 }
 
@@ -524,6 +552,22 @@ func (p *PermutationGenerator) Permutation(dst []int) []int {
 	return dst
 }
 
+// Seek positions the generator so that the following call to Next makes the
+// permutation with index idx, as defined by PermutationIndex, current. Seek
+// allows the permutations to be partitioned into contiguous ranges that are
+// iterated over independently.
+//
+// Seek panics if idx is not in [0, NumPermutations(n,k)].
+func (p *PermutationGenerator) Seek(idx int) {
+	if idx < 0 || p.nPerm < idx {
+		panic("combin: invalid index")
+	}
+	p.idx = idx - 1
+	if idx > 0 {
+		indexToPermutation(p.permutation, p.comb, p.order, p.idx, p.n, p.k)
+	}
+}
+
 // PermutationIndex returns the index of the given permutation.
 //
 // The functions PermutationIndex and IndexToPermutation define a bijection
@@ -622,6 +666,16 @@ func IndexToPermutation(dst []int, idx, n, k int) []int {
 		indexToEqualPermutation(dst, idx)
 		return dst
 	}
+	return indexToPermutation(dst, make([]int, k), make([]int, k), idx, n, k)
+}
+
+// indexToPermutation stores the permutation corresponding to the given index
+// into dst using comb and order as work space. All slices must have length k.
+func indexToPermutation(dst, comb, order []int, idx, n, k int) []int {
+	if n == k {
+		indexToEqualPermutation(dst, idx)
+		return dst
+	}
 
 	// First, we index into the combination (which of the k items to choose)
 	// and then we index into the n == k permutation of those k items. The
@@ -629,10 +683,9 @@ func IndexToPermutation(dst []int, idx, n, k int) []int {
 	kPerm := NumPermutations(k, k)
 	combIdx := idx / kPerm
 	permIdx := idx % kPerm
-	comb := IndexToCombination(nil, combIdx, n, k) // Gives us the set of integers.
-	perm := make([]int, len(dst))
-	indexToEqualPermutation(perm, permIdx) // Gives their order.
-	for i, v := range perm {
+	IndexToCombination(comb, combIdx, n, k) // Gives us the set of integers.
+	indexToEqualPermutation(order, permIdx) // Gives their order.
+	for i, v := range order {
 		dst[i] = comb[v]
 	}
 	return dst
@@ -377,3 +377,82 @@ func TestPermutationGenerator(t *testing.T) {
 		}
 	}
 }
+
+func TestGeneratorSeek(t *testing.T) {
+	for n := 0; n <= 6; n++ {
+		for k := 1; k <= n; k++ {
+			combinations := Combinations(n, k)
+			for start := 0; start <= len(combinations); start++ {
+				cg := NewCombinationGenerator(n, k)
+				cg.Seek(start)
+				var got [][]int
+				for cg.Next() {
+					got = append(got, cg.Combination(nil))
+				}
+				if !intSosMatch(got, combinations[start:]) {
+					t.Errorf("unexpected combinations after seek to %d for n = %v, k = %v", start, n, k)
+				}
+			}
+
+			permutations := Permutations(n, k)
+			for start := 0; start <= len(permutations); start += max(1, len(permutations)/10) {
+				pg := NewPermutationGenerator(n, k)
+				pg.Seek(start)
+				var got [][]int
+				for pg.Next() {
+					got = append(got, pg.Permutation(nil))
+				}
+				if !intSosMatch(got, permutations[start:]) {
+					t.Errorf("unexpected permutations after seek to %d for n = %v, k = %v", start, n, k)
+				}
+			}
+		}
+	}
+}
+
+func TestGeneratorAllocs(t *testing.T) {
+	// The generators must not be exhausted
+	// by the runs of AllocsPerRun.
+	const n, k = 10, 3
+	cg := NewCombinationGenerator(n, k)
+	cg.Next()
+	comb := make([]int, k)
+	if allocs := testing.AllocsPerRun(100, func() {
+		cg.Next()
+		cg.Combination(comb)
+	}); allocs != 0 {
+		t.Errorf("unexpected allocations by CombinationGenerator: %v", allocs)
+	}
+	pg := NewPermutationGenerator(n, k)
+	perm := make([]int, k)
+	if allocs := testing.AllocsPerRun(100, func() {
+		pg.Next()
+		pg.Permutation(perm)
+	}); allocs != 0 {
+		t.Errorf("unexpected allocations by PermutationGenerator: %v", allocs)
+	}
+	gg := NewGraySubsetGenerator(n)
+	subset := make([]bool, n)
+	if allocs := testing.AllocsPerRun(100, func() {
+		gg.Next()
+		gg.Subset(subset)
+	}); allocs != 0 {
+		t.Errorf("unexpected allocations by GraySubsetGenerator: %v", allocs)
+	}
+	sg := NewSetPartitionGenerator(n)
+	rgs := make([]int, n)
+	if allocs := testing.AllocsPerRun(100, func() {
+		sg.Next()
+		sg.Partition(rgs)
+	}); allocs != 0 {
+		t.Errorf("unexpected allocations by SetPartitionGenerator: %v", allocs)
+	}
+	cpg := NewCompositionGenerator(2*n, k)
+	comp := make([]int, k)
+	if allocs := testing.AllocsPerRun(100, func() {
+		cpg.Next()
+		cpg.Composition(comp)
+	}); allocs != 0 {
+		t.Errorf("unexpected allocations by CompositionGenerator: %v", allocs)
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package combin
+
+// NumCompositions returns the number of compositions of n into k parts, that
+// is the number of ordered sequences of k positive integers that sum to n.
+// No check is made for overflow.
+//
+// NumCompositions panics if n or k is negative.
+func NumCompositions(n, k int) int {
+	if n < 0 || k < 0 {
+		panic(errNegInput)
+	}
+	if k == 0 {
+		if n == 0 {
+			return 1
+		}
+		return 0
+	}
+	if k > n {
+		return 0
+	}
+	return Binomial(n-1, k-1)
+}
+
+// CompositionGenerator generates the compositions of an integer iteratively.
+//
+// A composition of n into k parts is identified with the k-1 partial sums of
+// its first k-1 parts, which form a combination of k-1 elements of [1, n).
+// Compositions are generated in lexicographic order of the partial sums.
+type CompositionGenerator struct {
+	n     int
+	k     int
+	total int
+	idx   int
+
+	// cuts holds the partial sums of the
+	// current composition less one.
+	cuts []int
+}
+
+// NewCompositionGenerator returns a CompositionGenerator for generating the
+// compositions of n into k parts.
+//
+// NewCompositionGenerator panics if n or k is negative.
+func NewCompositionGenerator(n, k int) *CompositionGenerator {
+	return &CompositionGenerator{
+		n:     n,
+		k:     k,
+		total: NumCompositions(n, k),
+		idx:   -1,
+		cuts:  make([]int, max(k-1, 0)),
+	}
+}
+
+// Next advances the iterator if there are compositions remaining to be
+// generated, and returns false if all compositions have been generated.
+// Next must be called to initialize the first value before calling
+// Composition or Composition will panic. The value returned by Composition
+// is only changed during calls to Next.
+func (g *CompositionGenerator) Next() bool {
+	if g.idx >= g.total-1 {
+		g.idx = g.total // so Composition can panic.
+		return false
+	}
+	g.idx++
+	if g.idx == 0 {
+		for i := range g.cuts {
+			g.cuts[i] = i
+		}
+		return true
+	}
+	nextCombination(g.cuts, g.n-1, g.k-1)
+	return true
+}
+
+// Composition returns the current composition. If dst is non-nil, it must have
+// length k and the result will be stored in-place into dst. If dst is nil a new
+// slice will be allocated and returned. If all of the compositions have already
+// been constructed (Next() returns false), Composition will panic.
+//
+// Next must be called to initialize the first value before calling Composition
+// or Composition will panic. The value returned by Composition is only changed
+// during calls to Next.
+func (g *CompositionGenerator) Composition(dst []int) []int {
+	if g.idx == g.total {
+		panic("combin: all compositions have been generated")
+	}
+	if g.idx == -1 {
+		panic("combin: Composition called before Next")
+	}
+	if dst == nil {
+		dst = make([]int, g.k)
+	} else if len(dst) != g.k {
+		panic(badInput)
+	}
+	copy(dst, g.cuts)
+	cutsToComposition(dst, g.n)
+	return dst
+}
+
+// Seek positions the generator so that the following call to Next makes the
+// composition with index idx, as defined by CompositionIndex, current. Seek
+// allows the compositions to be partitioned into contiguous ranges that are
+// iterated over independently.
+//
+// Seek panics if idx is not in [0, NumCompositions(n,k)].
+func (g *CompositionGenerator) Seek(idx int) {
+	if idx < 0 || g.total < idx {
+		panic("combin: invalid index")
+	}
+	g.idx = idx - 1
+	if idx > 0 && g.k > 0 {
+		IndexToCombination(g.cuts, g.idx, g.n-1, g.k-1)
+	}
+}
+
+// CompositionIndex returns the index of the given composition of n in the
+// order generated by CompositionGenerator.
+//
+// The functions CompositionIndex and IndexToComposition define a bijection
+// between the integers and the NumCompositions(n, k) compositions of n into
+// k parts. CompositionIndex returns the inverse of IndexToComposition.
+//
+// CompositionIndex panics if comp is not a composition of n.
+func CompositionIndex(comp []int, n int) int {
+	if n < 0 {
+		panic(errNegInput)
+	}
+	k := len(comp)
+	var sum int
+	for _, v := range comp {
+		if v < 1 {
+			panic("combin: non-positive part")
+		}
+		sum += v
+	}
+	if sum != n {
+		panic("combin: composition does not sum to n")
+	}
+	if k <= 1 {
+		return 0
+	}
+	cuts := make([]int, k-1)
+	sum = 0
+	for i, v := range comp[:k-1] {
+		sum += v
+		cuts[i] = sum - 1
+	}
+	return CombinationIndex(cuts, n-1, k-1)
+}
+
+// IndexToComposition returns the composition of n into k parts corresponding
+// to the given index in the order generated by CompositionGenerator.
+//
+// The functions CompositionIndex and IndexToComposition define a bijection
+// between the integers and the NumCompositions(n, k) compositions of n into
+// k parts. IndexToComposition returns the inverse of CompositionIndex.
+//
+// The composition is stored in-place into dst if dst is non-nil, otherwise
+// a new slice is allocated and returned.
+//
+// IndexToComposition panics if n or k are negative or if idx is not in
+// [0, NumCompositions(n,k)-1]. IndexToComposition will also panic if dst
+// is non-nil and len(dst) is not k.
+func IndexToComposition(dst []int, idx, n, k int) []int {
+	if idx < 0 || idx >= NumCompositions(n, k) {
+		panic("combin: invalid index")
+	}
+	if dst == nil {
+		dst = make([]int, k)
+	} else if len(dst) != k {
+		panic(badInput)
+	}
+	if k == 0 {
+		return dst
+	}
+	IndexToCombination(dst[:k-1], idx, n-1, k-1)
+	cutsToComposition(dst, n)
+	return dst
+}
+
+// cutsToComposition converts the partial sums less one held in dst[:len(dst)-1]
+// into the parts of a composition of n in place.
+func cutsToComposition(dst []int, n int) {
+	k := len(dst)
+	if k == 0 {
+		return
+	}
+	prev := n
+	for i := k - 2; i >= 0; i-- {
+		s := dst[i] + 1
+		dst[i+1] = prev - s
+		prev = s
+	}
+	dst[0] = prev
+}
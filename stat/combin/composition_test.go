@@ -0,0 +1,71 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package combin
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCompositionGenerator(t *testing.T) {
+	for n := 0; n <= 8; n++ {
+		total := 0
+		for k := 0; k <= n+1; k++ {
+			var all [][]int
+			seen := make(map[string]bool)
+			g := NewCompositionGenerator(n, k)
+			for g.Next() {
+				c := g.Composition(nil)
+				idx := len(all)
+				var sum int
+				for _, v := range c {
+					if v < 1 {
+						t.Errorf("non-positive part in composition %v", c)
+					}
+					sum += v
+				}
+				if sum != n || len(c) != k {
+					t.Errorf("invalid composition of %d into %d parts: %v", n, k, c)
+				}
+				key := intSliceToKey(c)
+				if seen[key] {
+					t.Errorf("repeated composition %v", c)
+				}
+				seen[key] = true
+				if got := CompositionIndex(c, n); got != idx {
+					t.Errorf("unexpected index for %v: got:%d want:%d", c, got, idx)
+				}
+				if got := IndexToComposition(nil, idx, n, k); !slices.Equal(got, c) {
+					t.Errorf("unexpected composition for index %d: got:%v want:%v", idx, got, c)
+				}
+				all = append(all, c)
+			}
+			if len(all) != NumCompositions(n, k) {
+				t.Errorf("unexpected number of compositions of %d into %d parts: got:%d want:%d", n, k, len(all), NumCompositions(n, k))
+			}
+			total += len(all)
+
+			for start := 0; start <= len(all); start++ {
+				g := NewCompositionGenerator(n, k)
+				g.Seek(start)
+				var got [][]int
+				for g.Next() {
+					got = append(got, g.Composition(nil))
+				}
+				if !intSosMatch(got, all[start:]) {
+					t.Errorf("unexpected compositions after seek to %d for n = %d, k = %d", start, n, k)
+				}
+			}
+		}
+		// There are 2^(n-1) compositions of a positive integer n.
+		want := 1
+		if n > 0 {
+			want = 1 << uint(n-1)
+		}
+		if total != want {
+			t.Errorf("unexpected total number of compositions of %d: got:%d want:%d", n, total, want)
+		}
+	}
+}
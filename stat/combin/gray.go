@@ -0,0 +1,199 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package combin
+
+import "math/bits"
+
+// maxSubsetSize is the largest set size for which the number
+// of subsets can be represented by an int.
+const maxSubsetSize = bits.UintSize - 2
+
+// GrayCode returns the i-th binary reflected Gray code. Consecutive
+// Gray codes differ in exactly one bit.
+//
+// GrayCode panics if i is negative.
+func GrayCode(i int) int {
+	if i < 0 {
+		panic(errNegInput)
+	}
+	return i ^ (i >> 1)
+}
+
+// GrayCodeIndex returns the index of the binary reflected Gray code g.
+// GrayCodeIndex is the inverse of GrayCode.
+//
+// GrayCodeIndex panics if g is negative.
+func GrayCodeIndex(g int) int {
+	if g < 0 {
+		panic(errNegInput)
+	}
+	for shift := 1; shift < bits.UintSize; shift <<= 1 {
+		g ^= g >> shift
+	}
+	return g
+}
+
+// GraySubsetGenerator generates the subsets of a set in Gray code order, so
+// that consecutive subsets differ by the addition or removal of a single
+// element.
+type GraySubsetGenerator struct {
+	n       int
+	total   int
+	idx     int
+	flipped int
+	subset  []bool
+}
+
+// NewGraySubsetGenerator returns a GraySubsetGenerator for generating the
+// 2^n subsets of a set of size n. The first subset generated is the empty set.
+//
+// NewGraySubsetGenerator panics if n is negative or if the number of subsets
+// overflows int.
+func NewGraySubsetGenerator(n int) *GraySubsetGenerator {
+	if n < 0 {
+		panic(errNegInput)
+	}
+	if n > maxSubsetSize {
+		panic("combin: set too large")
+	}
+	return &GraySubsetGenerator{
+		n:       n,
+		total:   1 << uint(n),
+		idx:     -1,
+		flipped: -1,
+		subset:  make([]bool, n),
+	}
+}
+
+// Next advances the iterator if there are subsets remaining to be generated,
+// and returns false if all subsets have been generated. Next must be called
+// to initialize the first value before calling Subset or Subset will panic.
+// The value returned by Subset is only changed during calls to Next.
+func (g *GraySubsetGenerator) Next() bool {
+	if g.idx >= g.total-1 {
+		g.idx = g.total // so Subset can panic.
+		return false
+	}
+	g.idx++
+	if g.idx == 0 {
+		for i := range g.subset {
+			g.subset[i] = false
+		}
+		g.flipped = -1
+		return true
+	}
+	g.flipped = bits.TrailingZeros(uint(g.idx))
+	g.subset[g.flipped] = !g.subset[g.flipped]
+	return true
+}
+
+// Subset returns the current subset as an indicator of set membership for
+// each element. If dst is non-nil, it must have length n and the result will
+// be stored in-place into dst. If dst is nil a new slice will be allocated and
+// returned. If all of the subsets have already been constructed (Next() returns
+// false), Subset will panic.
+//
+// Next must be called to initialize the first value before calling Subset
+// or Subset will panic. The value returned by Subset is only changed
+// during calls to Next.
+func (g *GraySubsetGenerator) Subset(dst []bool) []bool {
+	if g.idx == g.total {
+		panic("combin: all subsets have been generated")
+	}
+	if g.idx == -1 {
+		panic("combin: Subset called before Next")
+	}
+	if dst == nil {
+		dst = make([]bool, g.n)
+	} else if len(dst) != g.n {
+		panic(badInput)
+	}
+	copy(dst, g.subset)
+	return dst
+}
+
+// Flipped returns the element that was added to or removed from the previous
+// subset by the last call to Next. Flipped returns -1 for the first subset.
+func (g *GraySubsetGenerator) Flipped() int {
+	return g.flipped
+}
+
+// Seek positions the generator so that the following call to Next makes the
+// subset with index idx, as defined by GraySubsetIndex, current. Seek allows
+// the subsets to be partitioned into contiguous ranges that are iterated over
+// independently.
+//
+// Seek panics if idx is not in [0, 2^n].
+func (g *GraySubsetGenerator) Seek(idx int) {
+	if idx < 0 || g.total < idx {
+		panic("combin: invalid index")
+	}
+	g.idx = idx - 1
+	g.flipped = -1
+	if idx > 0 {
+		grayToSubset(g.subset, GrayCode(g.idx))
+	}
+}
+
+// GraySubsetIndex returns the index of the given subset in the Gray code order
+// generated by GraySubsetGenerator. The subset is given as an indicator of set
+// membership for each element.
+//
+// The functions GraySubsetIndex and IndexToGraySubset define a bijection
+// between the integers and the 2^n subsets of a set of size n.
+// GraySubsetIndex returns the inverse of IndexToGraySubset.
+//
+// GraySubsetIndex panics if the number of subsets overflows int.
+func GraySubsetIndex(subset []bool) int {
+	if len(subset) > maxSubsetSize {
+		panic("combin: set too large")
+	}
+	var g int
+	for i, in := range subset {
+		if in {
+			g |= 1 << uint(i)
+		}
+	}
+	return GrayCodeIndex(g)
+}
+
+// IndexToGraySubset returns the subset of a set of size n corresponding to
+// the given index in the Gray code order generated by GraySubsetGenerator.
+//
+// The functions GraySubsetIndex and IndexToGraySubset define a bijection
+// between the integers and the 2^n subsets of a set of size n.
+// IndexToGraySubset returns the inverse of GraySubsetIndex.
+//
+// The subset is stored in-place into dst if dst is non-nil, otherwise
+// a new slice is allocated and returned.
+//
+// IndexToGraySubset panics if n is negative, if the number of subsets
+// overflows int, or if idx is not in [0, 2^n-1]. IndexToGraySubset will
+// also panic if dst is non-nil and len(dst) is not n.
+func IndexToGraySubset(dst []bool, idx, n int) []bool {
+	if n < 0 {
+		panic(errNegInput)
+	}
+	if n > maxSubsetSize {
+		panic("combin: set too large")
+	}
+	if idx < 0 || idx >= 1<<uint(n) {
+		panic("combin: invalid index")
+	}
+	if dst == nil {
+		dst = make([]bool, n)
+	} else if len(dst) != n {
+		panic(badInput)
+	}
+	grayToSubset(dst, GrayCode(idx))
+	return dst
+}
+
+// grayToSubset stores the set membership of the bits of g into dst.
+func grayToSubset(dst []bool, g int) {
+	for i := range dst {
+		dst[i] = g&(1<<uint(i)) != 0
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package combin
+
+import (
+	"math/bits"
+	"reflect"
+	"testing"
+)
+
+func TestGrayCode(t *testing.T) {
+	for i := 0; i < 1<<12; i++ {
+		g := GrayCode(i)
+		if got := GrayCodeIndex(g); got != i {
+			t.Errorf("unexpected Gray code index for %d: got:%d want:%d", g, got, i)
+		}
+		if i > 0 {
+			if d := bits.OnesCount(uint(g ^ GrayCode(i-1))); d != 1 {
+				t.Errorf("Gray codes %d and %d differ in %d bits", i-1, i, d)
+			}
+		}
+	}
+	// The large index depends on the width of int so that
+	// it is representable on all architectures.
+	for _, i := range []int{1<<(maxSubsetSize-20) + 12345, 1<<maxSubsetSize - 1} {
+		if got := GrayCodeIndex(GrayCode(i)); got != i {
+			t.Errorf("unexpected Gray code index: got:%d want:%d", got, i)
+		}
+	}
+}
+
+func TestGraySubsetGenerator(t *testing.T) {
+	for n := 0; n <= 8; n++ {
+		var all [][]bool
+		seen := make(map[int]bool)
+		g := NewGraySubsetGenerator(n)
+		for g.Next() {
+			s := g.Subset(nil)
+			idx := len(all)
+			if got := GraySubsetIndex(s); got != idx {
+				t.Errorf("unexpected index for n = %d: got:%d want:%d", n, got, idx)
+			}
+			if got := IndexToGraySubset(nil, idx, n); !reflect.DeepEqual(got, s) {
+				t.Errorf("unexpected subset for index %d with n = %d: got:%v want:%v", idx, n, got, s)
+			}
+			var mask int
+			for i, in := range s {
+				if in {
+					mask |= 1 << uint(i)
+				}
+			}
+			if seen[mask] {
+				t.Errorf("repeated subset %v for n = %d", s, n)
+			}
+			seen[mask] = true
+			if idx == 0 {
+				if g.Flipped() != -1 {
+					t.Errorf("unexpected flipped element for first subset: %d", g.Flipped())
+				}
+			} else {
+				prev := all[idx-1]
+				var diff []int
+				for i := range s {
+					if s[i] != prev[i] {
+						diff = append(diff, i)
+					}
+				}
+				if len(diff) != 1 || diff[0] != g.Flipped() {
+					t.Errorf("unexpected change from %v to %v with flipped %d", prev, s, g.Flipped())
+				}
+			}
+			all = append(all, s)
+		}
+		if len(all) != 1<<uint(n) {
+			t.Errorf("unexpected number of subsets for n = %d: got:%d want:%d", n, len(all), 1<<uint(n))
+		}
+		if !panics(func() { g.Subset(nil) }) {
+			t.Errorf("expected panic after all subsets generated")
+		}
+
+		for start := 0; start <= len(all); start++ {
+			g := NewGraySubsetGenerator(n)
+			g.Seek(start)
+			var got [][]bool
+			for g.Next() {
+				got = append(got, g.Subset(nil))
+			}
+			if !reflect.DeepEqual(got, all[start:]) && (len(got) != 0 || start != len(all)) {
+				t.Errorf("unexpected subsets after seek to %d for n = %d", start, n)
+			}
+		}
+	}
+}
+
+// panics returns true if the called function panics during evaluation.
+func panics(fun func()) (b bool) {
+	defer func() {
+		err := recover()
+		if err != nil {
+			b = true
+		}
+	}()
+	fun()
+	return
+}
@@ -0,0 +1,238 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package combin
+
+// NumSetPartitions returns the number of partitions of a set of n elements
+// into non-empty blocks, the Bell number B(n).
+// No check is made for overflow.
+//
+// NumSetPartitions panics if n is negative.
+func NumSetPartitions(n int) int {
+	if n < 0 {
+		panic(errNegInput)
+	}
+	if n == 0 {
+		return 1
+	}
+	// Compute the Bell triangle.
+	row := make([]int, n)
+	row[0] = 1
+	for i := 1; i < n; i++ {
+		prev := row[i-1]
+		for j := i; j > 0; j-- {
+			row[j] = row[j-1]
+		}
+		row[0] = prev
+		for j := 1; j <= i; j++ {
+			row[j] += row[j-1]
+		}
+	}
+	return row[n-1]
+}
+
+// SetPartitionGenerator generates the partitions of a set iteratively.
+//
+// Partitions are represented as restricted growth strings: element i of the
+// set is assigned to block p[i], where p[0] = 0 and p[i] is at most one more
+// than the largest of p[0], ..., p[i-1]. Partitions are generated in
+// lexicographic order of their restricted growth strings.
+type SetPartitionGenerator struct {
+	n     int
+	total int
+	idx   int
+
+	// rgs is the restricted growth string of
+	// the current partition and blocks[i] is the
+	// number of blocks used by rgs[:i].
+	rgs    []int
+	blocks []int
+}
+
+// NewSetPartitionGenerator returns a SetPartitionGenerator for generating the
+// partitions of a set of size n.
+//
+// NewSetPartitionGenerator panics if n is negative.
+func NewSetPartitionGenerator(n int) *SetPartitionGenerator {
+	return &SetPartitionGenerator{
+		n:      n,
+		total:  NumSetPartitions(n),
+		idx:    -1,
+		rgs:    make([]int, n),
+		blocks: make([]int, n),
+	}
+}
+
+// Next advances the iterator if there are partitions remaining to be generated,
+// and returns false if all partitions have been generated. Next must be called
+// to initialize the first value before calling Partition or Partition will
+// panic. The value returned by Partition is only changed during calls to Next.
+func (g *SetPartitionGenerator) Next() bool {
+	if g.idx >= g.total-1 {
+		g.idx = g.total // so Partition can panic.
+		return false
+	}
+	g.idx++
+	if g.idx == 0 {
+		for i := range g.rgs {
+			g.rgs[i] = 0
+			g.blocks[i] = min(i, 1)
+		}
+		return true
+	}
+	// Find the last element that can be moved to a later block,
+	// move it and place all following elements in the first block.
+	i := g.n - 1
+	for g.rgs[i] == g.blocks[i] {
+		i--
+	}
+	g.rgs[i]++
+	b := max(g.blocks[i], g.rgs[i]+1)
+	for j := i + 1; j < g.n; j++ {
+		g.rgs[j] = 0
+		g.blocks[j] = b
+	}
+	return true
+}
+
+// Partition returns the restricted growth string of the current partition.
+// If dst is non-nil, it must have length n and the result will be stored
+// in-place into dst. If dst is nil a new slice will be allocated and returned.
+// If all of the partitions have already been constructed (Next() returns
+// false), Partition will panic.
+//
+// Next must be called to initialize the first value before calling Partition
+// or Partition will panic. The value returned by Partition is only changed
+// during calls to Next.
+func (g *SetPartitionGenerator) Partition(dst []int) []int {
+	if g.idx == g.total {
+		panic("combin: all partitions have been generated")
+	}
+	if g.idx == -1 {
+		panic("combin: Partition called before Next")
+	}
+	if dst == nil {
+		dst = make([]int, g.n)
+	} else if len(dst) != g.n {
+		panic(badInput)
+	}
+	copy(dst, g.rgs)
+	return dst
+}
+
+// Seek positions the generator so that the following call to Next makes the
+// partition with index idx, as defined by SetPartitionIndex, current. Seek
+// allows the partitions to be partitioned into contiguous ranges that are
+// iterated over independently.
+//
+// Seek panics if idx is not in [0, NumSetPartitions(n)].
+func (g *SetPartitionGenerator) Seek(idx int) {
+	if idx < 0 || g.total < idx {
+		panic("combin: invalid index")
+	}
+	g.idx = idx - 1
+	if idx == 0 {
+		return
+	}
+	IndexToSetPartition(g.rgs, g.idx, g.n)
+	b := 0
+	for i, v := range g.rgs {
+		g.blocks[i] = b
+		b = max(b, v+1)
+	}
+}
+
+// SetPartitionIndex returns the index of the partition with the given
+// restricted growth string in the order generated by SetPartitionGenerator.
+//
+// The functions SetPartitionIndex and IndexToSetPartition define a bijection
+// between the integers and the NumSetPartitions(n) partitions of a set of
+// size n. SetPartitionIndex returns the inverse of IndexToSetPartition.
+//
+// SetPartitionIndex panics if rgs is not a restricted growth string.
+func SetPartitionIndex(rgs []int) int {
+	n := len(rgs)
+	if n == 0 {
+		return 0
+	}
+	if rgs[0] != 0 {
+		panic("combin: invalid restricted growth string")
+	}
+	counts := growthCounts(n)
+	var idx int
+	b := 1
+	for i := 1; i < n; i++ {
+		v := rgs[i]
+		if v < 0 || v > b {
+			panic("combin: invalid restricted growth string")
+		}
+		rem := n - i - 1
+		idx += v * counts[rem][b]
+		if v == b {
+			b++
+		}
+	}
+	return idx
+}
+
+// IndexToSetPartition returns the restricted growth string of the partition
+// of a set of size n corresponding to the given index in the order generated
+// by SetPartitionGenerator.
+//
+// The functions SetPartitionIndex and IndexToSetPartition define a bijection
+// between the integers and the NumSetPartitions(n) partitions of a set of
+// size n. IndexToSetPartition returns the inverse of SetPartitionIndex.
+//
+// The partition is stored in-place into dst if dst is non-nil, otherwise
+// a new slice is allocated and returned.
+//
+// IndexToSetPartition panics if n is negative or if idx is not in
+// [0, NumSetPartitions(n)-1]. IndexToSetPartition will also panic if dst is
+// non-nil and len(dst) is not n.
+func IndexToSetPartition(dst []int, idx, n int) []int {
+	if idx < 0 || idx >= NumSetPartitions(n) {
+		panic("combin: invalid index")
+	}
+	if dst == nil {
+		dst = make([]int, n)
+	} else if len(dst) != n {
+		panic(badInput)
+	}
+	if n == 0 {
+		return dst
+	}
+	counts := growthCounts(n)
+	dst[0] = 0
+	b := 1
+	for i := 1; i < n; i++ {
+		c := counts[n-i-1][b]
+		if idx < b*c {
+			dst[i] = idx / c
+			idx %= c
+			continue
+		}
+		dst[i] = b
+		idx -= b * c
+		b++
+	}
+	return dst
+}
+
+// growthCounts returns a table where counts[m][b] is the number of ways
+// to complete a restricted growth string with m remaining elements when
+// b blocks are already in use, for all m and b with m+b <= n.
+func growthCounts(n int) [][]int {
+	counts := make([][]int, n)
+	counts[0] = make([]int, n+1)
+	for b := range counts[0] {
+		counts[0][b] = 1
+	}
+	for m := 1; m < n; m++ {
+		counts[m] = make([]int, n-m+1)
+		for b := range counts[m] {
+			counts[m][b] = b*counts[m-1][b] + counts[m-1][b+1]
+		}
+	}
+	return counts
+}
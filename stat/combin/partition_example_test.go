@@ -0,0 +1,62 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package combin_test
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/stat/combin"
+)
+
+func ExampleSetPartitionGenerator() {
+	// Print the partitions of {a, b, c} as blocks.
+	elems := []string{"a", "b", "c"}
+	gen := combin.NewSetPartitionGenerator(len(elems))
+	rgs := make([]int, len(elems))
+	for gen.Next() {
+		gen.Partition(rgs)
+		var blocks [][]string
+		for i, b := range rgs {
+			if b == len(blocks) {
+				blocks = append(blocks, nil)
+			}
+			blocks[b] = append(blocks[b], elems[i])
+		}
+		fmt.Println(rgs, blocks)
+	}
+
+	// Output:
+	// [0 0 0] [[a b c]]
+	// [0 0 1] [[a b] [c]]
+	// [0 1 0] [[a c] [b]]
+	// [0 1 1] [[a] [b c]]
+	// [0 1 2] [[a] [b] [c]]
+}
+
+func ExampleSetPartitionGenerator_Seek() {
+	// The partitions of a set may be split into contiguous
+	// ranges of indices that are iterated over independently,
+	// for example by separate goroutines.
+	const n = 6
+	total := combin.NumSetPartitions(n)
+	const workers = 4
+	for w := range workers {
+		start := w * total / workers
+		end := (w + 1) * total / workers
+		gen := combin.NewSetPartitionGenerator(n)
+		gen.Seek(start)
+		var count int
+		for i := start; i < end && gen.Next(); i++ {
+			count++
+		}
+		fmt.Printf("worker %d: partitions [%d, %d) count %d\n", w, start, end, count)
+	}
+
+	// Output:
+	// worker 0: partitions [0, 50) count 50
+	// worker 1: partitions [50, 101) count 51
+	// worker 2: partitions [101, 152) count 51
+	// worker 3: partitions [152, 203) count 51
+}
@@ -0,0 +1,69 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package combin
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNumSetPartitions(t *testing.T) {
+	// Bell numbers from OEIS A000110.
+	want := []int{1, 1, 2, 5, 15, 52, 203, 877, 4140, 21147, 115975, 678570, 4213597}
+	for n, w := range want {
+		if got := NumSetPartitions(n); got != w {
+			t.Errorf("unexpected number of partitions for n = %d: got:%d want:%d", n, got, w)
+		}
+	}
+}
+
+func TestSetPartitionGenerator(t *testing.T) {
+	for n := 0; n <= 8; n++ {
+		var all [][]int
+		g := NewSetPartitionGenerator(n)
+		for g.Next() {
+			p := g.Partition(nil)
+			idx := len(all)
+			b := 0
+			for i, v := range p {
+				if v < 0 || v > b || (i == 0 && v != 0) {
+					t.Fatalf("invalid restricted growth string for n = %d: %v", n, p)
+				}
+				b = max(b, v+1)
+			}
+			if idx > 0 && slices.Compare(all[idx-1], p) >= 0 {
+				t.Errorf("partitions not in lexicographic order: %v then %v", all[idx-1], p)
+			}
+			if got := SetPartitionIndex(p); got != idx {
+				t.Errorf("unexpected index for %v: got:%d want:%d", p, got, idx)
+			}
+			if got := IndexToSetPartition(nil, idx, n); !slices.Equal(got, p) {
+				t.Errorf("unexpected partition for index %d with n = %d: got:%v want:%v", idx, n, got, p)
+			}
+			all = append(all, p)
+		}
+		if len(all) != NumSetPartitions(n) {
+			t.Errorf("unexpected number of partitions for n = %d: got:%d want:%d", n, len(all), NumSetPartitions(n))
+		}
+		if !panics(func() { g.Partition(nil) }) {
+			t.Errorf("expected panic after all partitions generated")
+		}
+
+		for start := 0; start <= len(all); start += max(1, len(all)/20) {
+			g := NewSetPartitionGenerator(n)
+			g.Seek(start)
+			var got [][]int
+			for g.Next() {
+				got = append(got, g.Partition(nil))
+			}
+			if !intSosMatch(got, all[start:]) {
+				t.Errorf("unexpected partitions after seek to %d for n = %d", start, n)
+			}
+		}
+	}
+	if !panics(func() { SetPartitionIndex([]int{0, 2}) }) {
+		t.Errorf("expected panic for invalid restricted growth string")
+	}
+}
@@ -0,0 +1,389 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/mathext"
+)
+
+// ContingencyTable is a two-way table of the counts of observations
+// classified by two categorical variables, the rows and the columns.
+type ContingencyTable struct {
+	counts  *mat.Dense
+	rowSums []float64
+	colSums []float64
+	total   float64
+}
+
+// NewContingencyTable returns a contingency table holding a copy of counts.
+// NewContingencyTable panics if counts has fewer than two rows or columns,
+// if a count is negative, or if a row or column sums to zero.
+func NewContingencyTable(counts mat.Matrix) *ContingencyTable {
+	r, c := counts.Dims()
+	if r < 2 || c < 2 {
+		panic("stat: contingency table smaller than 2×2")
+	}
+	t := &ContingencyTable{
+		counts:  mat.DenseCopyOf(counts),
+		rowSums: make([]float64, r),
+		colSums: make([]float64, c),
+	}
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			v := t.counts.At(i, j)
+			if v < 0 {
+				panic("stat: negative count")
+			}
+			t.rowSums[i] += v
+			t.colSums[j] += v
+			t.total += v
+		}
+	}
+	for _, s := range t.rowSums {
+		if s == 0 {
+			panic("stat: empty row in contingency table")
+		}
+	}
+	for _, s := range t.colSums {
+		if s == 0 {
+			panic("stat: empty column in contingency table")
+		}
+	}
+	return t
+}
+
+// Dims returns the number of rows and columns of the table.
+func (t *ContingencyTable) Dims() (r, c int) {
+	return t.counts.Dims()
+}
+
+// Expected returns the expected counts of the table under independence of
+// the rows and columns, the products of the row and column sums divided by
+// the total count.
+func (t *ContingencyTable) Expected() *mat.Dense {
+	r, c := t.Dims()
+	e := mat.NewDense(r, c, nil)
+	for i, ri := range t.rowSums {
+		for j, cj := range t.colSums {
+			e.Set(i, j, ri*cj/t.total)
+		}
+	}
+	return e
+}
+
+// df returns the degrees of freedom of the tests of independence.
+func (t *ContingencyTable) df() int {
+	r, c := t.Dims()
+	return (r - 1) * (c - 1)
+}
+
+// ChiSquare returns Pearson's chi-squared statistic for the test of
+// independence of the rows and columns,
+//
+//	\sum_{i,j} (O_ij - E_ij)^2 / E_ij
+//
+// where O and E are the observed and expected counts, its p-value from the
+// asymptotic chi-squared distribution and its degrees of freedom. If
+// correct is true and the table is 2×2, Yates's continuity correction is
+// applied by reducing each |O_ij - E_ij| by 0.5, but not below zero.
+func (t *ContingencyTable) ChiSquare(correct bool) (stat, p float64, df int) {
+	r, c := t.Dims()
+	correct = correct && r == 2 && c == 2
+	for i, ri := range t.rowSums {
+		for j, cj := range t.colSums {
+			e := ri * cj / t.total
+			d := math.Abs(t.counts.At(i, j) - e)
+			if correct {
+				d = math.Max(d-0.5, 0)
+			}
+			stat += d * d / e
+		}
+	}
+	df = t.df()
+	return stat, chiSquareSurvival(stat, df), df
+}
+
+// GTest returns the likelihood-ratio G statistic for the test of
+// independence of the rows and columns,
+//
+//	2 \sum_{i,j} O_ij log(O_ij / E_ij)
+//
+// where O and E are the observed and expected counts, its p-value from the
+// asymptotic chi-squared distribution and its degrees of freedom.
+func (t *ContingencyTable) GTest() (stat, p float64, df int) {
+	for i, ri := range t.rowSums {
+		for j, cj := range t.colSums {
+			o := t.counts.At(i, j)
+			if o == 0 {
+				continue
+			}
+			stat += o * math.Log(o*t.total/(ri*cj))
+		}
+	}
+	stat *= 2
+	df = t.df()
+	return stat, chiSquareSurvival(stat, df), df
+}
+
+// chiSquareSurvival returns the probability that a chi-squared
+// random variable with df degrees of freedom exceeds x.
+func chiSquareSurvival(x float64, df int) float64 {
+	return mathext.GammaIncRegComp(float64(df)/2, x/2)
+}
+
+// CramersV returns Cramér's V, the measure of association between the rows
+// and columns
+//
+//	sqrt(χ^2 / (n * (min(r, c) - 1)))
+//
+// where χ^2 is the uncorrected chi-squared statistic and n is the total
+// count. Cramér's V is in [0, 1], and is zero when the observed and
+// expected counts are equal.
+func (t *ContingencyTable) CramersV() float64 {
+	chi2, _, _ := t.ChiSquare(false)
+	r, c := t.Dims()
+	return math.Sqrt(chi2 / (t.total * float64(min(r, c)-1)))
+}
+
+// OddsRatio returns the odds ratio of a 2×2 table and its Woolf confidence
+// interval with the given confidence level as described for the function
+// OddsRatio. OddsRatio panics if the table is not 2×2.
+func (t *ContingencyTable) OddsRatio(level float64) (or, lower, upper float64) {
+	a, b, c, d := t.cells2x2()
+	return OddsRatio(a, b, c, d, level)
+}
+
+// RelativeRisk returns the relative risk of a 2×2 table in which the rows
+// are the exposed and unexposed groups and the first column counts the
+// events, that is the ratio of the proportions of events in the first and
+// second rows
+//
+//	(a / (a + b)) / (c / (c + d))
+//
+// and the lower and upper bounds of its confidence interval with the given
+// confidence level, computed from the normal approximation to the log
+// relative risk with standard error
+//
+//	sqrt(1/a - 1/(a + b) + 1/c - 1/(c + d))
+//
+// RelativeRisk panics if the table is not 2×2 or if level is not in (0, 1).
+func (t *ContingencyTable) RelativeRisk(level float64) (rr, lower, upper float64) {
+	if level <= 0 || 1 <= level {
+		panic("stat: confidence level out of range")
+	}
+	a, b, c, d := t.cells2x2()
+	rr = (a / (a + b)) / (c / (c + d))
+	se := math.Sqrt(1/a - 1/(a+b) + 1/c - 1/(c+d))
+	z := mathext.NormalQuantile(1 - (1-level)/2)
+	lrr := math.Log(rr)
+	return rr, math.Exp(lrr - z*se), math.Exp(lrr + z*se)
+}
+
+// cells2x2 returns the counts of a 2×2 table in row-major order.
+func (t *ContingencyTable) cells2x2() (a, b, c, d float64) {
+	if r, c := t.Dims(); r != 2 || c != 2 {
+		panic("stat: contingency table not 2×2")
+	}
+	return t.counts.At(0, 0), t.counts.At(0, 1), t.counts.At(1, 0), t.counts.At(1, 1)
+}
+
+// FisherExact returns the p-value of Fisher's exact test of independence
+// of the rows and columns, the probability under independence, conditional
+// on the row and column sums, of a table that is no more probable than the
+// observed table. The p-value is computed with the network algorithm of
+// Mehta and Patel, which avoids enumerating all the tables with the
+// observed sums, but the computational cost may still be prohibitive for
+// large tables with large counts.
+//
+// FisherExact panics if a count is not an integer.
+func (t *ContingencyTable) FisherExact() float64 {
+	r, c := t.Dims()
+	rows := make([]int, r)
+	for i, v := range t.rowSums {
+		rows[i] = int(v)
+	}
+	cols := make([]int, c)
+	for j, v := range t.colSums {
+		cols[j] = int(v)
+	}
+	// The probability of a table with counts n_ij is
+	//  K / \prod_{i,j} n_ij!
+	// with K = \prod_i R_i! \prod_j C_j! / N!, where R and C
+	// are the row and column sums and N is the total count.
+	var obs float64
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			v := t.counts.At(i, j)
+			if v != math.Trunc(v) {
+				panic("stat: non-integer count")
+			}
+			obs -= lgammaInt(int(v))
+		}
+	}
+	logK := -lgammaInt(int(t.total))
+	for _, v := range rows {
+		logK += lgammaInt(v)
+	}
+	for _, v := range cols {
+		logK += lgammaInt(v)
+	}
+
+	// Process the larger dimension as the stages of
+	// the network so that the nodes are smaller.
+	if r > c {
+		rows, cols = cols, rows
+	}
+	net := fisherNetwork{
+		cols:   cols,
+		bounds: make([]map[string][2]float64, len(cols)+1),
+		// Tables with probability within a relative tolerance of
+		// the observed probability are counted as no more probable.
+		thresh: logK + obs + 1e-7,
+	}
+	slices.Sort(rows)
+	return math.Min(net.sum(0, rows, logK), 1)
+}
+
+// lgammaInt returns log(n!).
+func lgammaInt(n int) float64 {
+	v, _ := math.Lgamma(float64(n) + 1)
+	return v
+}
+
+// fisherNetwork is the network of Fisher's exact test. The node at stage k
+// is the sorted row sums remaining after filling the first k columns, and
+// the arcs from a node are the ways to fill column k. The length of a path
+// is the sum of -log(n_ij!) over the cells filled along it.
+type fisherNetwork struct {
+	cols   []int
+	thresh float64
+
+	// bounds holds the longest and shortest path
+	// lengths from the nodes at each stage to the end.
+	bounds []map[string][2]float64
+}
+
+// sum returns the sum of the exponentials of the lengths of the paths
+// through the node rows at stage k with total length at most the
+// threshold. past is the length of the path to the node, including
+// the log of the normalizing constant of the table probabilities.
+func (net *fisherNetwork) sum(k int, rows []int, past float64) float64 {
+	if k == len(net.cols) {
+		if past <= net.thresh {
+			return math.Exp(past)
+		}
+		return 0
+	}
+	longest, shortest := net.pathBounds(k, rows)
+	if past+longest <= net.thresh {
+		// All completions are no more probable than the observed
+		// table. The sum of their lengths' exponentials is
+		//  M! / (\prod_i r_i! \prod_{j≥k} C_j!)
+		// where M is the remaining total count.
+		total := 0
+		lt := 0.0
+		for _, v := range rows {
+			total += v
+			lt -= lgammaInt(v)
+		}
+		for _, v := range net.cols[k:] {
+			lt -= lgammaInt(v)
+		}
+		lt += lgammaInt(total)
+		return math.Exp(past + lt)
+	}
+	if past+shortest > net.thresh {
+		return 0
+	}
+	var s float64
+	eachColumn(rows, net.cols[k], func(x []int, length float64) {
+		next := make([]int, len(rows))
+		for i, v := range rows {
+			next[i] = v - x[i]
+		}
+		slices.Sort(next)
+		s += net.sum(k+1, next, past+length)
+	})
+	return s
+}
+
+// pathBounds returns the lengths of the longest and shortest paths from
+// the node rows at stage k to the end of the network.
+func (net *fisherNetwork) pathBounds(k int, rows []int) (longest, shortest float64) {
+	if k == len(net.cols) {
+		return 0, 0
+	}
+	if k == len(net.cols)-1 {
+		// The last column is determined by the row sums.
+		for _, v := range rows {
+			longest -= lgammaInt(v)
+		}
+		return longest, longest
+	}
+	key := nodeKey(rows)
+	if net.bounds[k] == nil {
+		net.bounds[k] = make(map[string][2]float64)
+	}
+	if b, ok := net.bounds[k][key]; ok {
+		return b[0], b[1]
+	}
+	longest, shortest = math.Inf(-1), math.Inf(1)
+	eachColumn(rows, net.cols[k], func(x []int, length float64) {
+		next := make([]int, len(rows))
+		for i, v := range rows {
+			next[i] = v - x[i]
+		}
+		slices.Sort(next)
+		l, s := net.pathBounds(k+1, next)
+		longest = math.Max(longest, length+l)
+		shortest = math.Min(shortest, length+s)
+	})
+	net.bounds[k][key] = [2]float64{longest, shortest}
+	return longest, shortest
+}
+
+// nodeKey returns a map key for the node with the given row sums.
+func nodeKey(rows []int) string {
+	var b strings.Builder
+	for _, v := range rows {
+		b.WriteString(strconv.Itoa(v))
+		b.WriteByte(',')
+	}
+	return b.String()
+}
+
+// eachColumn calls fn with each vector x with 0 ≤ x_i ≤ rows[i] and sum
+// total, and the sum of -log(x_i!). The slice x is reused between calls.
+func eachColumn(rows []int, total int, fn func(x []int, length float64)) {
+	// rest[i] is the sum of rows[i:].
+	rest := make([]int, len(rows)+1)
+	for i := len(rows) - 1; i >= 0; i-- {
+		rest[i] = rest[i+1] + rows[i]
+	}
+	x := make([]int, len(rows))
+	var fill func(i, remaining int, length float64)
+	fill = func(i, remaining int, length float64) {
+		if i == len(rows)-1 {
+			x[i] = remaining
+			fn(x, length-lgammaInt(remaining))
+			return
+		}
+		lo := max(0, remaining-rest[i+1])
+		hi := min(rows[i], remaining)
+		for v := lo; v <= hi; v++ {
+			x[i] = v
+			fill(i+1, remaining-v, length-lgammaInt(v))
+		}
+	}
+	if total <= rest[0] {
+		fill(0, total, 0)
+	}
+}
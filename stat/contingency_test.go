@@ -0,0 +1,240 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestContingencyTableTests(t *testing.T) {
+	t.Parallel()
+	ct := NewContingencyTable(mat.NewDense(2, 3, []float64{
+		10, 20, 30,
+		25, 15, 20,
+	}))
+	e := ct.Expected()
+	want := mat.NewDense(2, 3, []float64{
+		35 * 60 / 120.0, 35 * 60 / 120.0, 50 * 60 / 120.0,
+		35 * 60 / 120.0, 35 * 60 / 120.0, 50 * 60 / 120.0,
+	})
+	if !mat.EqualApprox(e, want, 1e-12) {
+		t.Errorf("unexpected expected counts:\ngot: %v\nwant:%v", mat.Formatted(e), mat.Formatted(want))
+	}
+
+	var chi2, g float64
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 3; j++ {
+			o, e := ct.counts.At(i, j), e.At(i, j)
+			chi2 += (o - e) * (o - e) / e
+			g += 2 * o * math.Log(o/e)
+		}
+	}
+	stat, p, df := ct.ChiSquare(true)
+	if df != 2 {
+		t.Errorf("unexpected degrees of freedom: got:%d want:2", df)
+	}
+	if !scalar.EqualWithinAbsOrRel(stat, chi2, 1e-12, 1e-12) {
+		t.Errorf("unexpected chi-squared statistic: got:%v want:%v", stat, chi2)
+	}
+	// With two degrees of freedom the survival function is exp(-x/2).
+	if !scalar.EqualWithinAbsOrRel(p, math.Exp(-chi2/2), 1e-12, 1e-12) {
+		t.Errorf("unexpected chi-squared p-value: got:%v want:%v", p, math.Exp(-chi2/2))
+	}
+	stat, p, _ = ct.GTest()
+	if !scalar.EqualWithinAbsOrRel(stat, g, 1e-12, 1e-12) {
+		t.Errorf("unexpected G statistic: got:%v want:%v", stat, g)
+	}
+	if !scalar.EqualWithinAbsOrRel(p, math.Exp(-g/2), 1e-12, 1e-12) {
+		t.Errorf("unexpected G-test p-value: got:%v want:%v", p, math.Exp(-g/2))
+	}
+	if v, want := ct.CramersV(), math.Sqrt(chi2/120); !scalar.EqualWithinAbsOrRel(v, want, 1e-12, 1e-12) {
+		t.Errorf("unexpected Cramér's V: got:%v want:%v", v, want)
+	}
+
+	// Yates's correction for a 2×2 table.
+	ct = NewContingencyTable(mat.NewDense(2, 2, []float64{12, 5, 7, 7}))
+	stat, p, df = ct.ChiSquare(true)
+	var yates float64
+	e = ct.Expected()
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			d := math.Abs(ct.counts.At(i, j)-e.At(i, j)) - 0.5
+			yates += d * d / e.At(i, j)
+		}
+	}
+	if df != 1 || !scalar.EqualWithinAbsOrRel(stat, yates, 1e-12, 1e-12) {
+		t.Errorf("unexpected corrected statistic: got:%v (df=%d) want:%v (df=1)", stat, df, yates)
+	}
+	if want := math.Erfc(math.Sqrt(yates / 2)); !scalar.EqualWithinAbsOrRel(p, want, 1e-12, 1e-12) {
+		t.Errorf("unexpected corrected p-value: got:%v want:%v", p, want)
+	}
+
+	perfect := NewContingencyTable(mat.NewDense(2, 2, []float64{5, 0, 0, 5}))
+	if v := perfect.CramersV(); !scalar.EqualWithinAbs(v, 1, 1e-14) {
+		t.Errorf("unexpected Cramér's V for perfect association: got:%v want:1", v)
+	}
+}
+
+func TestContingencyTableRisk(t *testing.T) {
+	t.Parallel()
+	ct := NewContingencyTable(mat.NewDense(2, 2, []float64{20, 80, 10, 90}))
+	rr, lower, upper := ct.RelativeRisk(0.95)
+	if !scalar.EqualWithinAbsOrRel(rr, 2, 1e-14, 1e-14) {
+		t.Errorf("unexpected relative risk: got:%v want:2", rr)
+	}
+	se := math.Sqrt(1.0/20 - 1.0/100 + 1.0/10 - 1.0/100)
+	const z = 1.959963984540054
+	if want := 2 * math.Exp(-z*se); !scalar.EqualWithinAbsOrRel(lower, want, 1e-12, 1e-12) {
+		t.Errorf("unexpected lower bound: got:%v want:%v", lower, want)
+	}
+	if want := 2 * math.Exp(z*se); !scalar.EqualWithinAbsOrRel(upper, want, 1e-12, 1e-12) {
+		t.Errorf("unexpected upper bound: got:%v want:%v", upper, want)
+	}
+	or, _, _ := ct.OddsRatio(0.95)
+	if want := 20.0 * 90 / (80 * 10); !scalar.EqualWithinAbsOrRel(or, want, 1e-14, 1e-14) {
+		t.Errorf("unexpected odds ratio: got:%v want:%v", or, want)
+	}
+
+	big := NewContingencyTable(mat.NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6}))
+	if !panics(func() { big.RelativeRisk(0.95) }) {
+		t.Error("expected panic for relative risk of table larger than 2×2")
+	}
+}
+
+func TestFisherExact(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name   string
+		counts *mat.Dense
+		want   float64
+		tol    float64
+	}{
+		{
+			// Fisher's lady tasting tea.
+			name:   "tea",
+			counts: mat.NewDense(2, 2, []float64{3, 1, 1, 3}),
+			want:   34.0 / 70,
+			tol:    1e-12,
+		},
+		{
+			// The job satisfaction example of R's fisher.test.
+			name: "job",
+			counts: mat.NewDense(4, 4, []float64{
+				1, 3, 10, 6,
+				2, 3, 10, 7,
+				1, 6, 14, 12,
+				0, 1, 9, 11,
+			}),
+			want: 0.7827,
+			tol:  1e-4,
+		},
+	} {
+		got := NewContingencyTable(test.counts).FisherExact()
+		if !scalar.EqualWithinAbs(got, test.want, test.tol) {
+			t.Errorf("unexpected p-value for %s: got:%v want:%v", test.name, got, test.want)
+		}
+	}
+
+	// Compare with complete enumeration of the tables
+	// with the observed margins.
+	for _, counts := range []*mat.Dense{
+		mat.NewDense(3, 3, []float64{2, 0, 3, 1, 4, 0, 0, 2, 3}),
+		mat.NewDense(2, 4, []float64{3, 0, 2, 1, 0, 4, 1, 2}),
+		mat.NewDense(3, 2, []float64{5, 1, 0, 3, 2, 2}),
+	} {
+		got := NewContingencyTable(counts).FisherExact()
+		want := fisherEnumerate(counts)
+		if !scalar.EqualWithinAbsOrRel(got, want, 1e-10, 1e-10) {
+			t.Errorf("unexpected p-value for\n%v\ngot:%v want:%v", mat.Formatted(counts), got, want)
+		}
+	}
+}
+
+// fisherEnumerate returns the p-value of Fisher's exact test by
+// enumerating every table with the margins of counts.
+func fisherEnumerate(counts *mat.Dense) float64 {
+	r, c := counts.Dims()
+	rows := make([]int, r)
+	cols := make([]int, c)
+	var n int
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			v := int(counts.At(i, j))
+			rows[i] += v
+			cols[j] += v
+			n += v
+		}
+	}
+	logK := -lgammaInt(n)
+	for _, v := range rows {
+		logK += lgammaInt(v)
+	}
+	for _, v := range cols {
+		logK += lgammaInt(v)
+	}
+	logProb := func(x [][]int) float64 {
+		p := logK
+		for _, row := range x {
+			for _, v := range row {
+				p -= lgammaInt(v)
+			}
+		}
+		return p
+	}
+	obs := make([][]int, r)
+	for i := range obs {
+		obs[i] = make([]int, c)
+		for j := range obs[i] {
+			obs[i][j] = int(counts.At(i, j))
+		}
+	}
+	thresh := logProb(obs) + 1e-7
+
+	x := make([][]int, r)
+	for i := range x {
+		x[i] = make([]int, c)
+	}
+	var p float64
+	var fill func(cell int, rowRem, colRem []int)
+	fill = func(cell int, rowRem, colRem []int) {
+		i, j := cell/c, cell%c
+		if i == r {
+			if lp := logProb(x); lp <= thresh {
+				p += math.Exp(lp)
+			}
+			return
+		}
+		lo, hi := 0, min(rowRem[i], colRem[j])
+		if j == c-1 {
+			// The last cell of a row is determined.
+			lo = rowRem[i]
+			if lo > hi {
+				return
+			}
+			hi = lo
+		}
+		if i == r-1 {
+			// The last row is determined.
+			if colRem[j] > rowRem[i] {
+				return
+			}
+			lo, hi = colRem[j], colRem[j]
+		}
+		for v := lo; v <= hi; v++ {
+			x[i][j] = v
+			rowRem[i] -= v
+			colRem[j] -= v
+			fill(cell+1, rowRem, colRem)
+			rowRem[i] += v
+			colRem[j] += v
+		}
+	}
+	fill(0, rows, cols)
+	return p
+}
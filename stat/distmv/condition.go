@@ -0,0 +1,261 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distmv
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// PreparedConditioner conditions a Normal or StudentsT distribution on
+// observations of a fixed set of its variables. Conditioning requires the
+// factorization of the covariance of the observed variables and of its
+// Schur complement, which depend only on the set of observed variables and
+// not on their values. PreparedConditioner computes these factorizations
+// once so that each subsequent conditioning on new values costs O(n²)
+// rather than O(n³).
+//
+// A PreparedConditioner is created by the PrepareCondition method of Normal
+// or StudentsT, and holds a copy of the parameters it needs, so later changes
+// to the original distribution do not affect it.
+type PreparedConditioner struct {
+	// nu is the degrees of freedom of the original
+	// distribution, or +Inf if it is a Normal.
+	nu float64
+
+	observed []int
+	muOb     []float64
+	muUn     []float64
+
+	// cholOb is the factorization of the covariance of the
+	// observed variables, sigma_{ob,ob}.
+	cholOb mat.Cholesky
+	// gain is sigma_{ob,ob}^-1 * sigma_{ob,un}.
+	gain mat.Dense
+
+	// schur is the Schur complement
+	//  sigma_{un,un} - sigma_{ob,un}ᵀ * sigma_{ob,ob}^-1 * sigma_{ob,un}
+	// and schurChol is its factorization.
+	schur      mat.SymDense
+	schurChol  mat.Cholesky
+	logSqrtDet float64
+}
+
+// PrepareCondition returns a PreparedConditioner for conditioning the receiver
+// on observations of the variables with the given indices. See ConditionNormal
+// for the definition of the conditional distribution.
+//
+// PrepareCondition returns {nil, false} if there is a failure during the
+// factorization. Mathematically this is impossible, but can occur with finite
+// precision arithmetic.
+func (n *Normal) PrepareCondition(observed []int) (*PreparedConditioner, bool) {
+	if len(observed) == 0 {
+		panic("normal: no observed value")
+	}
+	for _, v := range observed {
+		if v < 0 || v >= n.dim {
+			panic("normal: observed value out of bounds")
+		}
+	}
+	return newPreparedConditioner(observed, math.Inf(1), n.mu, &n.sigma)
+}
+
+// PrepareCondition returns a PreparedConditioner for conditioning the receiver
+// on observations of the variables with the given indices. See
+// ConditionStudentsT for the definition of the conditional distribution.
+//
+// PrepareCondition returns {nil, false} if there is a failure during the
+// factorization. Mathematically this is impossible, but can occur with finite
+// precision arithmetic.
+func (s *StudentsT) PrepareCondition(observed []int) (*PreparedConditioner, bool) {
+	if len(observed) == 0 {
+		panic("studentst: no observed value")
+	}
+	for _, v := range observed {
+		if v < 0 || v >= s.dim {
+			panic("studentst: observed value out of bounds")
+		}
+	}
+	return newPreparedConditioner(observed, s.nu, s.mu, &s.sigma)
+}
+
+// newPreparedConditioner computes the factorizations needed to condition a
+// distribution with the given mean and scale matrix on the observed variables.
+func newPreparedConditioner(observed []int, nu float64, mu []float64, sigma mat.Symmetric) (*PreparedConditioner, bool) {
+	unobserved := findUnob(observed, len(mu))
+	if len(unobserved) == 0 {
+		panic("distmv: all dimensions observed")
+	}
+	ob := len(observed)
+	un := len(unobserved)
+
+	p := &PreparedConditioner{
+		nu:       nu,
+		observed: make([]int, ob),
+		muOb:     make([]float64, ob),
+		muUn:     make([]float64, un),
+	}
+	copy(p.observed, observed)
+	for i, v := range observed {
+		p.muOb[i] = mu[v]
+	}
+	for i, v := range unobserved {
+		p.muUn[i] = mu[v]
+	}
+
+	var sigma22 mat.SymDense
+	sigma22.SubsetSym(sigma, observed)
+	if !p.cholOb.Factorize(&sigma22) {
+		return nil, false
+	}
+
+	sigma21 := mat.NewDense(ob, un, nil)
+	for i, r := range observed {
+		for j, c := range unobserved {
+			sigma21.Set(i, j, sigma.At(r, c))
+		}
+	}
+	err := p.cholOb.SolveTo(&p.gain, sigma21)
+	if err != nil {
+		return nil, false
+	}
+
+	// Compute sigma_{1,1} - sigma_{2,1}ᵀ * sigma_{2,2}^-1 * sigma_{2,1}.
+	var tmp mat.Dense
+	tmp.Mul(sigma21.T(), &p.gain)
+	p.schur.SubsetSym(sigma, unobserved)
+	for i := 0; i < un; i++ {
+		for j := i; j < un; j++ {
+			p.schur.SetSym(i, j, p.schur.At(i, j)-tmp.At(i, j))
+		}
+	}
+	if !p.schurChol.Factorize(&p.schur) {
+		return nil, false
+	}
+	p.logSqrtDet = 0.5 * p.schurChol.LogDet()
+	return p, true
+}
+
+// ConditionNormal returns the Normal distribution that is the original Normal
+// conditioned on the prepared variables having the given values. The returned
+// Normal represents the unobserved variables in their original order.
+//
+// If dst is not nil, the result is stored in-place into dst, which may be the
+// original Normal, and returned, otherwise a new Normal is allocated. The input
+// src is used as the source of random numbers of the returned Normal.
+//
+// ConditionNormal panics if the receiver was not prepared from a Normal or if
+// len(values) is not equal to the number of observed variables.
+func (p *PreparedConditioner) ConditionNormal(dst *Normal, values []float64, src rand.Source) *Normal {
+	if !math.IsInf(p.nu, 1) {
+		panic("distmv: conditioner not prepared from Normal")
+	}
+	if len(values) != len(p.observed) {
+		panic(badInputLength)
+	}
+	if dst == nil {
+		dst = &Normal{}
+	}
+	dim := len(p.muUn)
+	if len(dst.mu) != dim {
+		dst.mu = make([]float64, dim)
+	}
+	p.conditionalMean(dst.mu, values)
+	dst.dim = dim
+	resetSym(&dst.sigma, dim)
+	dst.sigma.CopySym(&p.schur)
+	dst.chol.Clone(&p.schurChol)
+	dst.logSqrtDet = p.logSqrtDet
+	dst.src = src
+	dst.rnd = rand.New(src)
+	return dst
+}
+
+// ConditionStudentsT returns the Student's T distribution that is the original
+// Student's T conditioned on the prepared variables having the given values.
+// The returned Student's T represents the unobserved variables in their
+// original order.
+//
+// If dst is not nil, the result is stored in-place into dst, which may be the
+// original Student's T, and returned, otherwise a new StudentsT is allocated.
+// The input src is used as the source of random numbers of the returned
+// Student's T.
+//
+// ConditionStudentsT panics if the receiver was not prepared from a StudentsT
+// or if len(values) is not equal to the number of observed variables.
+func (p *PreparedConditioner) ConditionStudentsT(dst *StudentsT, values []float64, src rand.Source) *StudentsT {
+	if math.IsInf(p.nu, 1) {
+		panic("distmv: conditioner not prepared from StudentsT")
+	}
+	if len(values) != len(p.observed) {
+		panic(badInputLength)
+	}
+	if dst == nil {
+		dst = &StudentsT{}
+	}
+	dim := len(p.muUn)
+	if len(dst.mu) != dim {
+		dst.mu = make([]float64, dim)
+	}
+	beta := p.conditionalMean(dst.mu, values)
+	ob := float64(len(p.observed))
+	dst.nu = p.nu + ob
+	dst.dim = dim
+
+	// Scale the Schur complement by (nu + beta)/(nu + ob).
+	f := (p.nu + beta) / (p.nu + ob)
+	resetSym(&dst.sigma, dim)
+	dst.sigma.ScaleSym(f, &p.schur)
+	dst.chol.Clone(&p.schurChol)
+	dst.chol.Scale(f, &dst.chol)
+	if r, _ := dst.lower.Dims(); r != dim {
+		dst.lower.Reset()
+	}
+	dst.chol.LTo(&dst.lower)
+	dst.logSqrtDet = p.logSqrtDet + 0.5*float64(dim)*math.Log(f)
+
+	dst.src = src
+	dst.rnd = nil
+	if src != nil {
+		dst.rnd = rand.New(src)
+	}
+	return dst
+}
+
+// conditionalMean stores the mean of the unobserved variables conditioned on
+// the observed values into dst and returns the squared Mahalanobis distance
+// of the values from the mean of the observed variables.
+func (p *PreparedConditioner) conditionalMean(dst, values []float64) (beta float64) {
+	// Compute mu_1 + sigma_{2,1}ᵀ * sigma_{2,2}^-1 (v - mu_2).
+	shift := make([]float64, len(values))
+	floats.SubTo(shift, values, p.muOb)
+	dstVec := mat.NewVecDense(len(dst), dst)
+	dstVec.MulVec(p.gain.T(), mat.NewVecDense(len(shift), shift))
+	floats.Add(dst, p.muUn)
+
+	if math.IsInf(p.nu, 1) {
+		return 0
+	}
+	// Compute beta = (v - mu_2)ᵀ * sigma_{2,2}^-1 * (v - mu_2).
+	var tmp mat.VecDense
+	shiftVec := mat.NewVecDense(len(shift), shift)
+	err := p.cholOb.SolveVecTo(&tmp, shiftVec)
+	if err != nil {
+		panic(err)
+	}
+	return mat.Dot(shiftVec, &tmp)
+}
+
+// resetSym prepares s to receive an n×n symmetric matrix.
+func resetSym(s *mat.SymDense, n int) {
+	if !s.IsEmpty() && s.SymmetricDim() == n {
+		return
+	}
+	s.Reset()
+	s.ReuseAsSym(n)
+}
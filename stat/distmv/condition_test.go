@@ -0,0 +1,153 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distmv
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+// conditionDirect returns the conditional mean, the Schur complement and the
+// squared Mahalanobis distance of the observed values computed using an
+// explicit inverse.
+func conditionDirect(mu []float64, sigma mat.Symmetric, observed []int, values []float64) (newMu []float64, schur *mat.Dense, beta float64) {
+	unob := findUnob(observed, len(mu))
+	var s11, s22 mat.SymDense
+	s11.SubsetSym(sigma, unob)
+	s22.SubsetSym(sigma, observed)
+	s12 := mat.NewDense(len(unob), len(observed), nil)
+	for i, u := range unob {
+		for j, o := range observed {
+			s12.Set(i, j, sigma.At(u, o))
+		}
+	}
+	var inv mat.Dense
+	err := inv.Inverse(&s22)
+	if err != nil {
+		panic(err)
+	}
+	shift := make([]float64, len(observed))
+	for i, o := range observed {
+		shift[i] = values[i] - mu[o]
+	}
+	shiftVec := mat.NewVecDense(len(shift), shift)
+	var tmp mat.VecDense
+	tmp.MulVec(&inv, shiftVec)
+	beta = mat.Dot(shiftVec, &tmp)
+	var mean mat.VecDense
+	mean.MulVec(s12, &tmp)
+	newMu = make([]float64, len(unob))
+	for i, u := range unob {
+		newMu[i] = mu[u] + mean.AtVec(i)
+	}
+	var gain mat.Dense
+	gain.Mul(s12, &inv)
+	schur = &mat.Dense{}
+	schur.Mul(&gain, s12.T())
+	schur.Sub(&s11, schur)
+	return newMu, schur, beta
+}
+
+func TestPreparedConditioner(t *testing.T) {
+	mu := []float64{2, 3, 4, 5}
+	sigma := mat.NewSymDense(4, []float64{
+		2, 0.5, 3, 0.1,
+		0.5, 1, 0.6, 0.2,
+		3, 0.6, 10, 0.3,
+		0.1, 0.2, 0.3, 3,
+	})
+	const nu = 5
+	for _, observed := range [][]int{{0}, {2}, {3, 1}, {0, 1, 3}} {
+		normal, ok := NewNormal(mu, sigma, nil)
+		if !ok {
+			t.Fatalf("Bad test, covariance matrix not positive definite")
+		}
+		var chol mat.Cholesky
+		chol.Factorize(sigma)
+		normalChol := NewNormalChol(mu, &chol, nil)
+		st, ok := NewStudentsT(mu, sigma, nu, nil)
+		if !ok {
+			t.Fatalf("Bad test, covariance matrix not positive definite")
+		}
+		pn, ok := normal.PrepareCondition(observed)
+		if !ok {
+			t.Fatalf("unexpected failure preparing Normal conditioner")
+		}
+		pc, ok := normalChol.PrepareCondition(observed)
+		if !ok {
+			t.Fatalf("unexpected failure preparing Normal conditioner from Cholesky")
+		}
+		ps, ok := st.PrepareCondition(observed)
+		if !ok {
+			t.Fatalf("unexpected failure preparing StudentsT conditioner")
+		}
+
+		var dstNormal *Normal
+		var dstStudentsT *StudentsT
+		rnd := rand.New(rand.NewPCG(1, 1))
+		for trial := 0; trial < 5; trial++ {
+			values := make([]float64, len(observed))
+			for i := range values {
+				values[i] = 10 * rnd.NormFloat64()
+			}
+			wantMu, wantSchur, beta := conditionDirect(mu, sigma, observed, values)
+
+			dstNormal = pn.ConditionNormal(dstNormal, values, nil)
+			for _, n := range []*Normal{dstNormal, pc.ConditionNormal(nil, values, nil)} {
+				if !floats.EqualApprox(n.mu, wantMu, 1e-12) {
+					t.Errorf("observed %v: Normal mean mismatch: got %v, want %v", observed, n.mu, wantMu)
+				}
+				var cov mat.SymDense
+				n.CovarianceMatrix(&cov)
+				if !mat.EqualApprox(&cov, wantSchur, 1e-12) {
+					t.Errorf("observed %v: Normal covariance mismatch: got %v, want %v", observed, mat.Formatted(&cov), mat.Formatted(wantSchur))
+				}
+				want, ok := NewNormal(wantMu, &cov, nil)
+				if !ok {
+					t.Fatalf("unexpected failure creating conditional Normal")
+				}
+				x := make([]float64, len(wantMu))
+				if got := n.LogProb(x); !scalar.EqualWithinAbsOrRel(got, want.LogProb(x), 1e-12, 1e-12) {
+					t.Errorf("observed %v: Normal LogProb mismatch: got %v, want %v", observed, got, want.LogProb(x))
+				}
+			}
+
+			dstStudentsT = ps.ConditionStudentsT(dstStudentsT, values, nil)
+			if dstStudentsT.nu != nu+float64(len(observed)) {
+				t.Errorf("observed %v: StudentsT nu mismatch: got %v, want %v", observed, dstStudentsT.nu, nu+float64(len(observed)))
+			}
+			if !floats.EqualApprox(dstStudentsT.mu, wantMu, 1e-12) {
+				t.Errorf("observed %v: StudentsT mean mismatch: got %v, want %v", observed, dstStudentsT.mu, wantMu)
+			}
+			var wantSigma mat.Dense
+			wantSigma.Scale((nu+beta)/(nu+float64(len(observed))), wantSchur)
+			if !mat.EqualApprox(&dstStudentsT.sigma, &wantSigma, 1e-10) {
+				t.Errorf("observed %v: StudentsT scale mismatch: got %v, want %v", observed, mat.Formatted(&dstStudentsT.sigma), mat.Formatted(&wantSigma))
+			}
+			want, ok := st.ConditionStudentsT(observed, values, nil)
+			if !ok {
+				t.Fatalf("unexpected failure of ConditionStudentsT")
+			}
+			x := make([]float64, len(wantMu))
+			if got := dstStudentsT.LogProb(x); !scalar.EqualWithinAbsOrRel(got, want.LogProb(x), 1e-12, 1e-12) {
+				t.Errorf("observed %v: StudentsT LogProb mismatch: got %v, want %v", observed, got, want.LogProb(x))
+			}
+		}
+
+		// Conditioning in-place into the original distribution.
+		values := make([]float64, len(observed))
+		wantMu, _, _ := conditionDirect(mu, sigma, observed, values)
+		if got := pn.ConditionNormal(normal, values, nil); got != normal || !floats.EqualApprox(normal.mu, wantMu, 1e-12) {
+			t.Errorf("observed %v: in-place Normal mean mismatch: got %v, want %v", observed, normal.mu, wantMu)
+		}
+		if got := ps.ConditionStudentsT(st, values, nil); got != st || !floats.EqualApprox(st.mu, wantMu, 1e-12) {
+			t.Errorf("observed %v: in-place StudentsT mean mismatch: got %v, want %v", observed, st.mu, wantMu)
+		}
+	}
+}
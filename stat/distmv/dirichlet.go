@@ -147,3 +147,33 @@ func (d *Dirichlet) Rand(dst []float64) []float64 {
 	floats.Scale(1/sum, dst)
 	return dst
 }
+
+// NumParameters returns the number of parameters of the distribution.
+func (d *Dirichlet) NumParameters() int {
+	return d.dim
+}
+
+// Parameters returns the parameters of the distribution, the elements of α
+// named "Alpha[i]". If p is not nil, the parameters are stored in p, which
+// must have length NumParameters.
+func (d *Dirichlet) Parameters(p []distuv.Parameter) []distuv.Parameter {
+	return parametersOf(p, d.parameterNames(), d.alpha)
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+// SetParameters will panic if any element of α is not positive.
+func (d *Dirichlet) SetParameters(p []distuv.Parameter) {
+	*d = *NewDirichlet(parameterValues(p, d.parameterNames()), d.src)
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (d *Dirichlet) ParameterBounds(b []distuv.ParameterBounds) []distuv.ParameterBounds {
+	return boundsOf(b, d.parameterNames(), func(int) distuv.ParameterBounds { return positive })
+}
+
+func (d *Dirichlet) parameterNames() []string {
+	return vectorNames(nil, "Alpha", d.dim)
+}
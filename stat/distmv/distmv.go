@@ -4,6 +4,13 @@
 
 package distmv
 
+import (
+	"math"
+	"strconv"
+
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
 const (
 	badQuantile      = "distmv: quantile not between 0 and 1"
 	badOutputLen     = "distmv: output slice is not nil or the correct length"
@@ -11,6 +18,8 @@ const (
 	badSizeMismatch  = "distmv: size mismatch"
 	badZeroDimension = "distmv: zero dimensional input"
 	nonPosDimension  = "distmv: non-positive dimension input"
+	badParameterName = "distmv: parameter name mismatch"
+	badNonInteger    = "distmv: non-integer parameter value"
 )
 
 const logTwoPi = 1.8378770664093454835606594728112352797227949472755668
@@ -26,3 +35,89 @@ func reuseAs(dst []float64, n int) []float64 {
 	}
 	return dst
 }
+
+// Commonly used parameter bounds.
+var (
+	realLine = distuv.ParameterBounds{Min: math.Inf(-1), Max: math.Inf(1), OpenMin: true, OpenMax: true}
+	positive = distuv.ParameterBounds{Min: 0, Max: math.Inf(1), OpenMin: true, OpenMax: true}
+	nonNeg   = distuv.ParameterBounds{Min: 0, Max: math.Inf(1), OpenMax: true}
+	count    = distuv.ParameterBounds{Min: 0, Max: math.Inf(1), OpenMax: true, Integer: true}
+	unit     = distuv.ParameterBounds{Min: 0, Max: 1}
+)
+
+// vectorNames appends to dst the names of the n elements of the vector
+// parameter with the given name, name[i], and returns the result.
+func vectorNames(dst []string, name string, n int) []string {
+	for i := range n {
+		dst = append(dst, name+"["+strconv.Itoa(i)+"]")
+	}
+	return dst
+}
+
+// symmetricNames appends to dst the names of the elements of the lower
+// triangle of the n×n symmetric matrix parameter with the given name,
+// name[i,j] with j ≤ i in row-major order, and returns the result.
+func symmetricNames(dst []string, name string, n int) []string {
+	for i := range n {
+		for j := 0; j <= i; j++ {
+			dst = append(dst, name+"["+strconv.Itoa(i)+","+strconv.Itoa(j)+"]")
+		}
+	}
+	return dst
+}
+
+// parametersOf returns the named parameter values, storing them in p if it
+// is not nil. It panics if p is not nil and does not have the same length
+// as names.
+func parametersOf(p []distuv.Parameter, names []string, values []float64) []distuv.Parameter {
+	if p == nil {
+		p = make([]distuv.Parameter, len(names))
+	} else if len(p) != len(names) {
+		panic(badOutputLen)
+	}
+	for i, name := range names {
+		p[i] = distuv.Parameter{Name: name, Value: values[i]}
+	}
+	return p
+}
+
+// parameterValues returns the values of the parameters in p after checking
+// that they have the given names. It panics if the names do not match.
+func parameterValues(p []distuv.Parameter, names []string) []float64 {
+	if len(p) != len(names) {
+		panic(badInputLength)
+	}
+	v := make([]float64, len(p))
+	for i, name := range names {
+		if p[i].Name != name {
+			panic(badParameterName)
+		}
+		v[i] = p[i].Value
+	}
+	return v
+}
+
+// boundsOf returns the bounds of the named parameters, where the bounds of
+// the parameter with name names[i] are given by bounds(i), storing them in
+// b if it is not nil. It panics if b is not nil and does not have the same
+// length as names.
+func boundsOf(b []distuv.ParameterBounds, names []string, bounds func(i int) distuv.ParameterBounds) []distuv.ParameterBounds {
+	if b == nil {
+		b = make([]distuv.ParameterBounds, len(names))
+	} else if len(b) != len(names) {
+		panic(badOutputLen)
+	}
+	for i, name := range names {
+		b[i] = bounds(i)
+		b[i].Name = name
+	}
+	return b
+}
+
+// integerValue returns v as an int. It panics if v is not an integer.
+func integerValue(v float64) int {
+	if v != math.Trunc(v) {
+		panic(badNonInteger)
+	}
+	return int(v)
+}
@@ -0,0 +1,257 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distmv
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/combin"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// MultivariateHypergeometric implements the multivariate hypergeometric
+// distribution, the distribution of the counts of each of k kinds of item
+// in n draws without replacement from a population of N items, K_i of which
+// are of kind i. The probability of a count vector x with \sum_i x_i = n is
+//
+//	\prod_i (K_i choose x_i) / (N choose n)
+//
+// For more information see https://en.wikipedia.org/wiki/Hypergeometric_distribution#Multivariate_hypergeometric_distribution
+type MultivariateHypergeometric struct {
+	n     int
+	k     []int
+	total int
+	dim   int
+	src   rand.Source
+
+	lchoose float64
+}
+
+// NewMultivariateHypergeometric creates a new multivariate hypergeometric
+// distribution of n draws from a population with k[i] items of kind i.
+//
+// NewMultivariateHypergeometric panics if len(k) == 0, if any k is negative,
+// or if n is negative or greater than the population size.
+func NewMultivariateHypergeometric(n int, k []int, src rand.Source) *MultivariateHypergeometric {
+	if len(k) == 0 {
+		panic(badZeroDimension)
+	}
+	var total int
+	for _, v := range k {
+		if v < 0 {
+			panic("hypergeometric: negative population count")
+		}
+		total += v
+	}
+	if n < 0 || n > total {
+		panic("hypergeometric: number of draws out of range")
+	}
+	h := &MultivariateHypergeometric{
+		n:     n,
+		k:     make([]int, len(k)),
+		total: total,
+		dim:   len(k),
+		src:   src,
+	}
+	copy(h.k, k)
+	h.lchoose = combin.LogGeneralizedBinomial(float64(total), float64(n))
+	return h
+}
+
+// CovarianceMatrix calculates the covariance matrix of the distribution,
+// storing the result in dst. Upon return, the value at element {i, j} of the
+// covariance matrix is equal to the covariance of the i^th and j^th variables.
+//
+//	covariance(i, j) = n (N-n)/(N-1) (δ_ij K_i/N - K_i K_j/N²)
+//
+// If the dst matrix is empty it will be resized to the correct dimensions,
+// otherwise dst must match the dimension of the receiver or CovarianceMatrix
+// will panic.
+func (h *MultivariateHypergeometric) CovarianceMatrix(dst *mat.SymDense) {
+	if dst.IsEmpty() {
+		*dst = *(dst.GrowSym(h.dim).(*mat.SymDense))
+	} else if dst.SymmetricDim() != h.dim {
+		panic("hypergeometric: input matrix size mismatch")
+	}
+	n := float64(h.n)
+	total := float64(h.total)
+	var scale float64
+	if h.total > 1 {
+		scale = n * (total - n) / (total - 1)
+	}
+	for i, ki := range h.k {
+		pi := float64(ki) / total
+		dst.SetSym(i, i, scale*pi*(1-pi))
+		for j := i + 1; j < h.dim; j++ {
+			dst.SetSym(i, j, -scale*pi*float64(h.k[j])/total)
+		}
+	}
+}
+
+// Dim returns the dimension of the distribution.
+func (h *MultivariateHypergeometric) Dim() int {
+	return h.dim
+}
+
+// LogProb computes the log of the probability of the count vector x.
+// LogProb returns -Inf if any element of x is not an integer in [0, K_i]
+// or if the elements of x do not sum to the number of draws.
+func (h *MultivariateHypergeometric) LogProb(x []float64) float64 {
+	if len(x) != h.dim {
+		panic(badSizeMismatch)
+	}
+	lprob := -h.lchoose
+	var sum float64
+	for i, v := range x {
+		if v < 0 || v > float64(h.k[i]) || v != math.Floor(v) {
+			return math.Inf(-1)
+		}
+		sum += v
+		lprob += combin.LogGeneralizedBinomial(float64(h.k[i]), v)
+	}
+	if sum != float64(h.n) {
+		return math.Inf(-1)
+	}
+	return lprob
+}
+
+// Mean returns the mean of the probability distribution, n K_i / N.
+//
+// If dst is not nil, the mean will be stored in-place into dst and returned,
+// otherwise a new slice will be allocated first. If dst is not nil, it must
+// have length equal to the dimension of the distribution.
+func (h *MultivariateHypergeometric) Mean(dst []float64) []float64 {
+	dst = reuseAs(dst, h.dim)
+	for i, k := range h.k {
+		dst[i] = float64(h.n) * float64(k) / float64(h.total)
+	}
+	return dst
+}
+
+// Prob computes the probability of the count vector x.
+func (h *MultivariateHypergeometric) Prob(x []float64) float64 {
+	return math.Exp(h.LogProb(x))
+}
+
+// Rand generates a random count vector according to the distribution.
+//
+// If dst is not nil, the sample will be stored in-place into dst and returned,
+// otherwise a new slice will be allocated first. If dst is not nil, it must
+// have length equal to the dimension of the distribution.
+func (h *MultivariateHypergeometric) Rand(dst []float64) []float64 {
+	dst = reuseAs(dst, h.dim)
+	rnd := rand.Float64
+	if h.src != nil {
+		rnd = rand.New(h.src).Float64
+	}
+	// Draw the count of each kind conditional on the counts of the
+	// preceding kinds, which is univariate hypergeometric in the
+	// remaining draws and population.
+	draws := h.n
+	population := h.total
+	for i, k := range h.k {
+		var x int
+		switch {
+		case draws == 0:
+		case k == population:
+			x = draws
+		default:
+			x = hypergeometricRand(draws, k, population, rnd)
+		}
+		dst[i] = float64(x)
+		draws -= x
+		population -= k
+	}
+	return dst
+}
+
+// hypergeometricRand returns a sample of the number of successes in n draws
+// without replacement from a population of size total containing k successes.
+// The sample is drawn by inversion, searching outwards from the mode.
+func hypergeometricRand(n, k, total int, rnd func() float64) int {
+	lo := max(0, n-(total-k))
+	hi := min(n, k)
+	mode := min(hi, max(lo, (n+1)*(k+1)/(total+2)))
+
+	fn, fk, ft := float64(n), float64(k), float64(total)
+	logProb := func(x float64) float64 {
+		return combin.LogGeneralizedBinomial(fk, x) +
+			combin.LogGeneralizedBinomial(ft-fk, fn-x) -
+			combin.LogGeneralizedBinomial(ft, fn)
+	}
+	pMode := math.Exp(logProb(float64(mode)))
+	u := rnd() - pMode
+	if u <= 0 {
+		return mode
+	}
+	down, up := mode, mode
+	pDown, pUp := pMode, pMode
+	for down > lo || up < hi {
+		if up < hi {
+			// P(x+1)/P(x) = (k-x)(n-x) / ((x+1)(total-k-n+x+1)).
+			x := float64(up)
+			pUp *= (fk - x) * (fn - x) / ((x + 1) * (ft - fk - fn + x + 1))
+			up++
+			if u -= pUp; u <= 0 {
+				return up
+			}
+		}
+		if down > lo {
+			x := float64(down)
+			pDown *= x * (ft - fk - fn + x) / ((fk - x + 1) * (fn - x + 1))
+			down--
+			if u -= pDown; u <= 0 {
+				return down
+			}
+		}
+	}
+	// The remaining probability is due to rounding.
+	return mode
+}
+
+// NumParameters returns the number of parameters of the distribution.
+func (h *MultivariateHypergeometric) NumParameters() int {
+	return h.dim + 1
+}
+
+// Parameters returns the parameters of the distribution, the number of draws
+// named "N" followed by the number of items of each kind in the population
+// named "K[i]". If p is not nil, the parameters are stored in p, which must
+// have length NumParameters.
+func (h *MultivariateHypergeometric) Parameters(p []distuv.Parameter) []distuv.Parameter {
+	v := make([]float64, 0, h.dim+1)
+	v = append(v, float64(h.n))
+	for _, k := range h.k {
+		v = append(v, float64(k))
+	}
+	return parametersOf(p, h.parameterNames(), v)
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+// SetParameters will panic if any parameter is not a non-negative integer or
+// the number of draws is greater than the population size.
+func (h *MultivariateHypergeometric) SetParameters(p []distuv.Parameter) {
+	v := parameterValues(p, h.parameterNames())
+	k := make([]int, h.dim)
+	for i := range k {
+		k[i] = integerValue(v[i+1])
+	}
+	*h = *NewMultivariateHypergeometric(integerValue(v[0]), k, h.src)
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// The bounds do not describe the requirement that the number of draws is
+// not greater than the population size. If b is not nil, the bounds are
+// stored in b, which must have length NumParameters.
+func (h *MultivariateHypergeometric) ParameterBounds(b []distuv.ParameterBounds) []distuv.ParameterBounds {
+	return boundsOf(b, h.parameterNames(), func(int) distuv.ParameterBounds { return count })
+}
+
+func (h *MultivariateHypergeometric) parameterNames() []string {
+	return vectorNames([]string{"N"}, "K", h.dim)
+}
@@ -0,0 +1,52 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distmv
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestMultivariateHypergeometric(t *testing.T) {
+	t.Parallel()
+	src := rand.NewPCG(1, 1)
+	for cas, test := range []struct {
+		n int
+		k []int
+	}{
+		{n: 5, k: []int{3, 4, 5}},
+		{n: 30, k: []int{10, 0, 20, 15, 5}},
+		{n: 4, k: []int{4}},
+		{n: 200, k: []int{1000, 3000, 500}},
+		{n: 45, k: []int{10, 20, 20}},
+	} {
+		h := NewMultivariateHypergeometric(test.n, test.k, src)
+		checkCountMoments(t, cas, h, float64(test.n), 0.1)
+	}
+
+	// The probabilities over the support must sum to one.
+	h := NewMultivariateHypergeometric(6, []int{3, 4, 5}, nil)
+	var sum float64
+	for a := 0.0; a <= 3; a++ {
+		for b := 0.0; b <= 4; b++ {
+			sum += h.Prob([]float64{a, b, 6 - a - b})
+		}
+	}
+	if !scalar.EqualWithinAbs(sum, 1, 1e-14) {
+		t.Errorf("probabilities sum to %v", sum)
+	}
+	want := math.Log(3.0 * 6 * 10 / 924)
+	if got := h.LogProb([]float64{1, 2, 3}); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+		t.Errorf("unexpected LogProb: got %v, want %v", got, want)
+	}
+	for _, x := range [][]float64{{4, 1, 1}, {1, 2, 2}, {0.5, 2.5, 3}} {
+		if got := h.LogProb(x); !math.IsInf(got, -1) {
+			t.Errorf("unexpected LogProb for %v: got %v, want -Inf", x, got)
+		}
+	}
+}
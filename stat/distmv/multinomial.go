@@ -0,0 +1,210 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distmv
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// Multinomial implements the multinomial distribution, the distribution of
+// the counts of each of k categories in n independent trials where each
+// trial results in category i with probability p_i. The probability of a
+// count vector x with \sum_i x_i = n is
+//
+//	n! \prod_i p_i^x_i / x_i!
+//
+// For more information see https://en.wikipedia.org/wiki/Multinomial_distribution
+type Multinomial struct {
+	n   int
+	p   []float64
+	dim int
+	src rand.Source
+
+	lgn float64
+	// tail holds the sums of the trailing probabilities,
+	// tail[i] = \sum_{j≥i} p_j.
+	tail []float64
+}
+
+// NewMultinomial creates a new multinomial distribution with n trials and
+// category probabilities proportional to p. The probabilities are normalized
+// to sum to one.
+//
+// NewMultinomial panics if len(p) == 0, if n is negative, if any p is negative,
+// or if the sum of p is not positive.
+func NewMultinomial(n int, p []float64, src rand.Source) *Multinomial {
+	if len(p) == 0 {
+		panic(badZeroDimension)
+	}
+	if n < 0 {
+		panic("multinomial: negative number of trials")
+	}
+	var sum float64
+	for _, v := range p {
+		if v < 0 {
+			panic("multinomial: negative probability")
+		}
+		sum += v
+	}
+	if !(sum > 0) {
+		panic("multinomial: sum of probabilities not positive")
+	}
+	m := &Multinomial{
+		n:   n,
+		p:   make([]float64, len(p)),
+		dim: len(p),
+		src: src,
+	}
+	floats.ScaleTo(m.p, 1/sum, p)
+	m.lgn, _ = math.Lgamma(float64(n) + 1)
+	m.tail = make([]float64, len(p))
+	var tail float64
+	for i := len(p) - 1; i >= 0; i-- {
+		tail += m.p[i]
+		m.tail[i] = tail
+	}
+	return m
+}
+
+// CovarianceMatrix calculates the covariance matrix of the distribution,
+// storing the result in dst. Upon return, the value at element {i, j} of the
+// covariance matrix is equal to the covariance of the i^th and j^th variables.
+//
+//	covariance(i, j) = n (δ_ij p_i - p_i p_j)
+//
+// If the dst matrix is empty it will be resized to the correct dimensions,
+// otherwise dst must match the dimension of the receiver or CovarianceMatrix
+// will panic.
+func (m *Multinomial) CovarianceMatrix(dst *mat.SymDense) {
+	if dst.IsEmpty() {
+		*dst = *(dst.GrowSym(m.dim).(*mat.SymDense))
+	} else if dst.SymmetricDim() != m.dim {
+		panic("multinomial: input matrix size mismatch")
+	}
+	n := float64(m.n)
+	for i, pi := range m.p {
+		dst.SetSym(i, i, n*pi*(1-pi))
+		for j := i + 1; j < m.dim; j++ {
+			dst.SetSym(i, j, -n*pi*m.p[j])
+		}
+	}
+}
+
+// Dim returns the dimension of the distribution.
+func (m *Multinomial) Dim() int {
+	return m.dim
+}
+
+// LogProb computes the log of the probability of the count vector x.
+// LogProb returns -Inf if any element of x is not a non-negative integer
+// or if the elements of x do not sum to the number of trials.
+func (m *Multinomial) LogProb(x []float64) float64 {
+	if len(x) != m.dim {
+		panic(badSizeMismatch)
+	}
+	lprob := m.lgn
+	var sum float64
+	for i, v := range x {
+		if v < 0 || v != math.Floor(v) {
+			return math.Inf(-1)
+		}
+		sum += v
+		if v == 0 {
+			continue
+		}
+		lg, _ := math.Lgamma(v + 1)
+		lprob += v*math.Log(m.p[i]) - lg
+	}
+	if sum != float64(m.n) {
+		return math.Inf(-1)
+	}
+	return lprob
+}
+
+// Mean returns the mean of the probability distribution, n p.
+//
+// If dst is not nil, the mean will be stored in-place into dst and returned,
+// otherwise a new slice will be allocated first. If dst is not nil, it must
+// have length equal to the dimension of the distribution.
+func (m *Multinomial) Mean(dst []float64) []float64 {
+	dst = reuseAs(dst, m.dim)
+	floats.ScaleTo(dst, float64(m.n), m.p)
+	return dst
+}
+
+// Prob computes the probability of the count vector x.
+func (m *Multinomial) Prob(x []float64) float64 {
+	return math.Exp(m.LogProb(x))
+}
+
+// Rand generates a random count vector according to the distribution.
+//
+// If dst is not nil, the sample will be stored in-place into dst and returned,
+// otherwise a new slice will be allocated first. If dst is not nil, it must
+// have length equal to the dimension of the distribution.
+func (m *Multinomial) Rand(dst []float64) []float64 {
+	dst = reuseAs(dst, m.dim)
+	// Use the conditional binomial method: the count of each category
+	// given the counts of the preceding categories is binomial in the
+	// remaining trials with the probability of the category relative
+	// to the remaining probability mass.
+	remaining := float64(m.n)
+	for i, p := range m.p {
+		switch {
+		case remaining == 0 || p == 0:
+			dst[i] = 0
+		case p >= m.tail[i]:
+			dst[i] = remaining
+		default:
+			dst[i] = distuv.Binomial{N: remaining, P: p / m.tail[i], Src: m.src}.Rand()
+		}
+		remaining -= dst[i]
+	}
+	return dst
+}
+
+// NumParameters returns the number of parameters of the distribution.
+func (m *Multinomial) NumParameters() int {
+	return m.dim + 1
+}
+
+// Parameters returns the parameters of the distribution, the number of
+// trials named "N" followed by the category probabilities named "P[i]". If p
+// is not nil, the parameters are stored in p, which must have length
+// NumParameters.
+func (m *Multinomial) Parameters(p []distuv.Parameter) []distuv.Parameter {
+	return parametersOf(p, m.parameterNames(), append([]float64{float64(m.n)}, m.p...))
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order. The
+// probabilities are normalized to sum to one. SetParameters will panic if
+// the number of trials is not a non-negative integer, if any probability is
+// negative, or if the sum of the probabilities is not positive.
+func (m *Multinomial) SetParameters(p []distuv.Parameter) {
+	v := parameterValues(p, m.parameterNames())
+	*m = *NewMultinomial(integerValue(v[0]), v[1:], m.src)
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (m *Multinomial) ParameterBounds(b []distuv.ParameterBounds) []distuv.ParameterBounds {
+	return boundsOf(b, m.parameterNames(), func(i int) distuv.ParameterBounds {
+		if i == 0 {
+			return count
+		}
+		return unit
+	})
+}
+
+func (m *Multinomial) parameterNames() []string {
+	return vectorNames([]string{"N"}, "P", m.dim)
+}
@@ -0,0 +1,94 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distmv
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// countDist is a distribution over count vectors.
+type countDist interface {
+	RandLogProber
+	Dim() int
+	Mean([]float64) []float64
+	CovarianceMatrix(*mat.SymDense)
+}
+
+// checkCountMoments checks that the sample mean and covariance of count
+// vectors drawn from d match the distribution and that the samples sum
+// to n.
+func checkCountMoments(t *testing.T, cas int, d countDist, n float64, tol float64) {
+	t.Helper()
+	const samples = 20000
+	x := mat.NewDense(samples, d.Dim(), nil)
+	for i := 0; i < samples; i++ {
+		row := x.RawRowView(i)
+		d.Rand(row)
+		if floats.Sum(row) != n {
+			t.Errorf("sample does not sum to %v for case %d: %v", n, cas, row)
+		}
+		if math.IsInf(d.LogProb(row), -1) {
+			t.Errorf("zero probability sample for case %d: %v", cas, row)
+		}
+	}
+	mean := make([]float64, d.Dim())
+	for j := range mean {
+		mean[j] = stat.Mean(mat.Col(nil, j, x), nil)
+	}
+	if !floats.EqualApprox(mean, d.Mean(nil), tol) {
+		t.Errorf("mean mismatch for case %d: got %v, want %v", cas, mean, d.Mean(nil))
+	}
+	var got, want mat.SymDense
+	stat.CovarianceMatrix(&got, x, nil)
+	d.CovarianceMatrix(&want)
+	if !mat.EqualApprox(&got, &want, tol) {
+		t.Errorf("covariance mismatch for case %d:\ngot:\n%v\nwant:\n%v", cas, mat.Formatted(&got), mat.Formatted(&want))
+	}
+}
+
+func TestMultinomial(t *testing.T) {
+	t.Parallel()
+	src := rand.NewPCG(1, 1)
+	for cas, test := range []struct {
+		n int
+		p []float64
+	}{
+		{n: 10, p: []float64{0.2, 0.3, 0.5}},
+		{n: 40, p: []float64{1, 1, 2, 0, 4}},
+		{n: 3, p: []float64{0, 1}},
+		{n: 0, p: []float64{1, 2}},
+	} {
+		m := NewMultinomial(test.n, test.p, src)
+		checkCountMoments(t, cas, m, float64(test.n), 0.1)
+	}
+
+	// With two categories the distribution is binomial.
+	m := NewMultinomial(12, []float64{0.3, 0.7}, nil)
+	b := distuv.Binomial{N: 12, P: 0.3}
+	for k := 0.0; k <= 12; k++ {
+		if got, want := m.LogProb([]float64{k, 12 - k}), b.LogProb(k); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+			t.Errorf("mismatch with binomial at %v: got %v, want %v", k, got, want)
+		}
+	}
+
+	m = NewMultinomial(6, []float64{1, 2, 3}, nil)
+	want := math.Log(60 * (1.0 / 6) * (2.0 / 6) * (2.0 / 6) * (3.0 / 6) * (3.0 / 6) * (3.0 / 6))
+	if got := m.LogProb([]float64{1, 2, 3}); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+		t.Errorf("unexpected LogProb: got %v, want %v", got, want)
+	}
+	for _, x := range [][]float64{{1, 2, 2}, {1, 2.5, 2.5}, {-1, 4, 3}} {
+		if got := m.LogProb(x); !math.IsInf(got, -1) {
+			t.Errorf("unexpected LogProb for %v: got %v, want -Inf", x, got)
+		}
+	}
+}
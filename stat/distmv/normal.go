@@ -8,6 +8,8 @@ import (
 	"math"
 	"math/rand/v2"
 
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
 	"gonum.org/v1/gonum/floats"
 	"gonum.org/v1/gonum/mat"
 	"gonum.org/v1/gonum/stat"
@@ -78,6 +80,7 @@ func NewNormalChol(mu []float64, chol *mat.Cholesky, src rand.Source) *Normal {
 		mu:  make([]float64, dim),
 	}
 	n.chol.Clone(chol)
+	n.chol.ToSym(&n.sigma)
 	copy(n.mu, mu)
 	n.logSqrtDet = 0.5 * n.chol.LogDet()
 	return n
@@ -132,24 +135,19 @@ func NewNormalPrecision(mu []float64, prec *mat.SymDense, src rand.Source) (norm
 //
 // ConditionNormal returns {nil, false} if there is a failure during the update.
 // Mathematically this is impossible, but can occur with finite precision arithmetic.
+//
+// When conditioning repeatedly on the same set of observed variables, the
+// PreparedConditioner returned by PrepareCondition avoids recomputing the
+// factorizations of the covariance matrix.
 func (n *Normal) ConditionNormal(observed []int, values []float64, src rand.Source) (*Normal, bool) {
-	if len(observed) == 0 {
-		panic("normal: no observed value")
-	}
 	if len(observed) != len(values) {
 		panic(badInputLength)
 	}
-	for _, v := range observed {
-		if v < 0 || v >= n.Dim() {
-			panic("normal: observed value out of bounds")
-		}
-	}
-
-	_, mu1, sigma11 := studentsTConditional(observed, values, math.Inf(1), n.mu, &n.sigma)
-	if mu1 == nil {
+	p, ok := n.PrepareCondition(observed)
+	if !ok {
 		return nil, false
 	}
-	return NewNormal(mu1, sigma11, src)
+	return p.ConditionNormal(nil, values, src), true
 }
 
 // CovarianceMatrix stores the covariance matrix of the distribution in dst.
@@ -216,6 +214,34 @@ func normalLogProb(x, mu []float64, chol *mat.Cholesky, logSqrtDet float64) floa
 	return c - 0.5*dst*dst
 }
 
+// MahalanobisBatch computes the Mahalanobis distance of each row of x from the
+// mean of the distribution,
+//
+//	sqrt((x_i-μ)' Σ^-1 (x_i-μ))
+//
+// and stores the result in dst. The squared distances of samples drawn from
+// the distribution follow a χ² distribution with Dim degrees of freedom, so
+// they may be used to identify outliers.
+//
+// If dst is not nil, the distances will be stored in-place into dst and
+// returned, otherwise a new slice will be allocated first. If dst is not nil,
+// it must have length equal to the number of rows of x. MahalanobisBatch will
+// also panic if the number of columns of x is not equal to the dimension of
+// the receiver.
+func (n *Normal) MahalanobisBatch(dst []float64, x mat.Matrix) []float64 {
+	r, c := x.Dims()
+	if c != n.dim {
+		panic(badInputLength)
+	}
+	dst = reuseAs(dst, r)
+	var z mat.Dense
+	n.WhitenTo(&z, x)
+	for i := range dst {
+		dst[i] = floats.Norm(z.RawRowView(i), 2)
+	}
+	return dst
+}
+
 // MarginalNormal returns the marginal distribution of the given input variables.
 // That is, MarginalNormal returns
 //
@@ -522,3 +548,152 @@ func transformNormal(dst, normal, mu []float64, chol *mat.Cholesky) []float64 {
 	floats.Add(dst, mu)
 	return dst
 }
+
+// UnwhitenTo transforms each row of z, assumed to be a vector of independent
+// standard normal variables, into a sample from the receiver and stores the
+// result into the corresponding row of dst. For a sample z, the result is
+//
+//	μ + Uᵀ z
+//
+// where Σ = Uᵀ U is the Cholesky decomposition of the covariance matrix.
+// UnwhitenTo is the inverse of WhitenTo and is equivalent to applying
+// TransformNormal to each row of z.
+//
+// If dst is empty it is resized to the dimensions of z, otherwise it must have
+// the same dimensions as z. UnwhitenTo panics if the number of columns of z is
+// not equal to the dimension of the receiver.
+func (n *Normal) UnwhitenTo(dst *mat.Dense, z mat.Matrix) {
+	n.copyRows(dst, z)
+	raw := dst.RawMatrix()
+	u := n.chol.RawU().(mat.RawTriangular).RawTriangular()
+	blas64.Trmm(blas.Right, blas.NoTrans, 1, u, raw)
+	for i := 0; i < raw.Rows; i++ {
+		floats.Add(raw.Data[i*raw.Stride:i*raw.Stride+raw.Cols], n.mu)
+	}
+}
+
+// WhitenTo transforms each row of x into a vector of independent standard
+// normal variables under the receiver and stores the result into the
+// corresponding row of dst. For a sample x, the result is the vector of
+// standardized residuals
+//
+//	U^-T (x - μ)
+//
+// where Σ = Uᵀ U is the Cholesky decomposition of the covariance matrix.
+// WhitenTo is the inverse of UnwhitenTo.
+//
+// If dst is empty it is resized to the dimensions of x, otherwise it must have
+// the same dimensions as x. WhitenTo panics if the number of columns of x is
+// not equal to the dimension of the receiver.
+func (n *Normal) WhitenTo(dst *mat.Dense, x mat.Matrix) {
+	n.copyRows(dst, x)
+	raw := dst.RawMatrix()
+	for i := 0; i < raw.Rows; i++ {
+		floats.Sub(raw.Data[i*raw.Stride:i*raw.Stride+raw.Cols], n.mu)
+	}
+	u := n.chol.RawU().(mat.RawTriangular).RawTriangular()
+	blas64.Trsm(blas.Right, blas.NoTrans, 1, u, raw)
+}
+
+// copyRows copies the samples in the rows of x into dst after checking
+// that they have the dimension of the receiver.
+func (n *Normal) copyRows(dst *mat.Dense, x mat.Matrix) {
+	r, c := x.Dims()
+	if c != n.dim {
+		panic(badInputLength)
+	}
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, c)
+	} else if rd, cd := dst.Dims(); rd != r || cd != c {
+		panic(badSizeMismatch)
+	}
+	dst.Copy(x)
+}
+
+// NumParameters returns the number of parameters of the distribution.
+func (n *Normal) NumParameters() int {
+	return n.dim + n.dim*(n.dim+1)/2
+}
+
+// Parameters returns the parameters of the distribution, the elements of the
+// mean named "Mu[i]" followed by the elements of the lower triangle of the
+// covariance matrix named "Sigma[i,j]", with j ≤ i, in row-major order. If p
+// is not nil, the parameters are stored in p, which must have length
+// NumParameters.
+func (n *Normal) Parameters(p []distuv.Parameter) []distuv.Parameter {
+	return parametersOf(p, n.parameterNames(), meanAndLower(n.mu, &n.sigma))
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+// SetParameters will panic if the covariance matrix is not positive definite.
+func (n *Normal) SetParameters(p []distuv.Parameter) {
+	mu, sigma := splitMeanAndLower(parameterValues(p, n.parameterNames()), n.dim)
+	norm, ok := NewNormal(mu, sigma, n.src)
+	if !ok {
+		panic("normal: covariance matrix not positive definite")
+	}
+	*n = *norm
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// The bounds do not describe the requirement that the covariance matrix is
+// positive definite. If b is not nil, the bounds are stored in b, which must
+// have length NumParameters.
+func (n *Normal) ParameterBounds(b []distuv.ParameterBounds) []distuv.ParameterBounds {
+	return boundsOf(b, n.parameterNames(), func(i int) distuv.ParameterBounds {
+		return meanAndLowerBounds(i, n.dim)
+	})
+}
+
+func (n *Normal) parameterNames() []string {
+	return symmetricNames(vectorNames(nil, "Mu", n.dim), "Sigma", n.dim)
+}
+
+// meanAndLower returns the elements of mu followed by the elements of the
+// lower triangle of sigma in row-major order.
+func meanAndLower(mu []float64, sigma mat.Symmetric) []float64 {
+	dim := len(mu)
+	v := append(make([]float64, 0, dim+dim*(dim+1)/2), mu...)
+	for i := range dim {
+		for j := 0; j <= i; j++ {
+			v = append(v, sigma.At(i, j))
+		}
+	}
+	return v
+}
+
+// splitMeanAndLower returns the mean vector and symmetric matrix held in v
+// as described for meanAndLower.
+func splitMeanAndLower(v []float64, dim int) ([]float64, *mat.SymDense) {
+	sigma := mat.NewSymDense(dim, nil)
+	k := dim
+	for i := range dim {
+		for j := 0; j <= i; j++ {
+			sigma.SetSym(i, j, v[k])
+			k++
+		}
+	}
+	return v[:dim], sigma
+}
+
+// meanAndLowerBounds returns the bounds of the parameter with index i of
+// the parameters described for meanAndLower, where the symmetric matrix is
+// a covariance or scale matrix with positive diagonal elements.
+func meanAndLowerBounds(i, dim int) distuv.ParameterBounds {
+	i -= dim
+	if i < 0 {
+		return realLine
+	}
+	// Find the row of the element in
+	// the lower triangle.
+	r := 0
+	for i > r {
+		i -= r + 1
+		r++
+	}
+	if i == r {
+		return positive
+	}
+	return realLine
+}
@@ -64,6 +64,12 @@ func TestNewNormalChol(t *testing.T) {
 		// have been set properly. See issue #426.
 		x := n.Rand(nil)
 		_ = n.Prob(x)
+
+		var cov mat.SymDense
+		n.CovarianceMatrix(&cov)
+		if !mat.EqualApprox(&cov, test.cov, 1e-14) {
+			t.Errorf("Covariance mismatch: got %v, want %v", mat.Formatted(&cov), mat.Formatted(test.cov))
+		}
 	}
 }
 
@@ -573,6 +579,81 @@ func TestNormalScoreInput(t *testing.T) {
 	}
 }
 
+func TestNormalWhiten(t *testing.T) {
+	for cas, test := range []struct {
+		mu    []float64
+		sigma *mat.SymDense
+		x     *mat.Dense
+	}{
+		{
+			mu:    []float64{2, 3, 4},
+			sigma: mat.NewSymDense(3, []float64{2, 0.5, 3, 0.5, 1, 0.6, 3, 0.6, 10}),
+			x: mat.NewDense(2, 3, []float64{
+				1, 3.1, -2,
+				2, 3, 4,
+			}),
+		},
+		{
+			mu:    []float64{2, 3, 4, 5},
+			sigma: mat.NewSymDense(4, []float64{2, 0.5, 3, 0.1, 0.5, 1, 0.6, 0.2, 3, 0.6, 10, 0.3, 0.1, 0.2, 0.3, 3}),
+			x: mat.NewDense(3, 4, []float64{
+				1, 3.1, -2, 5,
+				-1, 0, 7, 2,
+				4, 4, 4, 4,
+			}),
+		},
+	} {
+		normal, ok := NewNormal(test.mu, test.sigma, nil)
+		if !ok {
+			t.Fatalf("Bad test, covariance matrix not positive definite")
+		}
+		var z mat.Dense
+		normal.WhitenTo(&z, test.x)
+		dist := normal.MahalanobisBatch(nil, test.x)
+		r, c := test.x.Dims()
+		for i := 0; i < r; i++ {
+			x := test.x.RawRowView(i)
+			row := normal.TransformNormal(nil, z.RawRowView(i))
+			if !floats.EqualApprox(row, x, 1e-12) {
+				t.Errorf("Case %d, row %d: TransformNormal of whitened sample mismatch. Got %v, want %v", cas, i, row, x)
+			}
+			want := stat.Mahalanobis(mat.NewVecDense(c, x), mat.NewVecDense(c, test.mu), &normal.chol)
+			if math.Abs(dist[i]-want) > 1e-12 {
+				t.Errorf("Case %d, row %d: Mahalanobis distance mismatch. Got %v, want %v", cas, i, dist[i], want)
+			}
+		}
+
+		var x mat.Dense
+		normal.UnwhitenTo(&x, &z)
+		if !mat.EqualApprox(&x, test.x, 1e-12) {
+			t.Errorf("Case %d: unwhitened samples mismatch. Got %v, want %v", cas, mat.Formatted(&x), mat.Formatted(test.x))
+		}
+
+		// Check that in-place transforms are supported.
+		x.CloneFrom(test.x)
+		normal.WhitenTo(&x, &x)
+		if !mat.EqualApprox(&x, &z, 1e-14) {
+			t.Errorf("Case %d: in-place whitening mismatch", cas)
+		}
+	}
+
+	// Whitened samples from the distribution have identity covariance.
+	const n = 100000
+	sigma := mat.NewSymDense(3, []float64{2, 0.5, 3, 0.5, 1, 0.6, 3, 0.6, 10})
+	normal, _ := NewNormal([]float64{1, -2, 3}, sigma, rand.NewPCG(1, 1))
+	x := mat.NewDense(n, 3, nil)
+	for i := 0; i < n; i++ {
+		normal.Rand(x.RawRowView(i))
+	}
+	var z mat.Dense
+	normal.WhitenTo(&z, x)
+	var cov mat.SymDense
+	stat.CovarianceMatrix(&cov, &z, nil)
+	if !mat.EqualApprox(&cov, mat.NewDiagDense(3, []float64{1, 1, 1}), 2e-2) {
+		t.Errorf("Whitened covariance mismatch. Got %v, want identity", mat.Formatted(&cov))
+	}
+}
+
 func TestNormalRandCov(t *testing.T) {
 	const numSamples = 1_000_000
 	const tol = 1e-2
@@ -0,0 +1,190 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distmv
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/spatial/r1"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+func TestParameterized(t *testing.T) {
+	t.Parallel()
+	sigma := mat.NewSymDense(3, []float64{
+		4, 1, 0.5,
+		1, 3, 0.2,
+		0.5, 0.2, 2,
+	})
+	normal, ok := NewNormal([]float64{1, -2, 3}, sigma, nil)
+	if !ok {
+		t.Fatal("unexpected failure creating normal distribution")
+	}
+	studentsT, ok := NewStudentsT([]float64{1, -2, 3}, sigma, 5, nil)
+	if !ok {
+		t.Fatal("unexpected failure creating Student's t distribution")
+	}
+
+	for _, test := range []struct {
+		dist distuv.Parameterized
+		n    int
+		// modify returns a modified valid
+		// value of the parameter i.
+		modify func(i int, v float64) float64
+	}{
+		{
+			dist:   NewDirichlet([]float64{1, 2, 3}, nil),
+			n:      3,
+			modify: func(_ int, v float64) float64 { return 2 * v },
+		},
+		{
+			dist: normal,
+			n:    9,
+			modify: func(i int, v float64) float64 {
+				if i < 3 {
+					return v + 1
+				}
+				return 2 * v
+			},
+		},
+		{
+			dist: studentsT,
+			n:    10,
+			modify: func(i int, v float64) float64 {
+				if i < 3 {
+					return v + 1
+				}
+				return 2 * v
+			},
+		},
+		{
+			dist:   NewUniform([]r1.Interval{{Min: 0, Max: 1}, {Min: -2, Max: 3}}, nil),
+			n:      4,
+			modify: func(_ int, v float64) float64 { return 2 * v },
+		},
+		{
+			dist: NewVonMisesFisher([]float64{0.6, 0, 0.8}, 2, nil),
+			n:    4,
+			modify: func(i int, v float64) float64 {
+				if i < 3 {
+					// Keep the direction normalized.
+					return []float64{0, 0.8, 0.6}[i]
+				}
+				return v + 1
+			},
+		},
+		{
+			dist: NewMultinomial(10, []float64{0.2, 0.3, 0.5}, nil),
+			n:    4,
+			modify: func(i int, v float64) float64 {
+				if i == 0 {
+					return v + 5
+				}
+				return []float64{0.5, 0.25, 0.25}[i-1]
+			},
+		},
+		{
+			dist:   NewMultivariateHypergeometric(4, []int{3, 5, 2}, nil),
+			n:      4,
+			modify: func(_ int, v float64) float64 { return v + 1 },
+		},
+	} {
+		d := test.dist
+		name := fmt.Sprintf("%T", d)
+		if got := d.NumParameters(); got != test.n {
+			t.Errorf("unexpected number of parameters for %s: got %d, want %d", name, got, test.n)
+		}
+		params := d.Parameters(nil)
+		bounds := d.ParameterBounds(nil)
+		if len(params) != test.n || len(bounds) != test.n {
+			t.Errorf("unexpected lengths for %s: got %d parameters and %d bounds, want %d", name, len(params), len(bounds), test.n)
+			continue
+		}
+		seen := make(map[string]bool)
+		for i, p := range params {
+			if seen[p.Name] {
+				t.Errorf("repeated parameter name %q for %s", p.Name, name)
+			}
+			seen[p.Name] = true
+			if bounds[i].Name != p.Name {
+				t.Errorf("mismatched parameter bounds name for %s: got %q, want %q", name, bounds[i].Name, p.Name)
+			}
+			if !bounds[i].Contains(p.Value) {
+				t.Errorf("valid parameter %s of %s out of bounds: %v not in %+v", p.Name, name, p.Value, bounds[i])
+			}
+		}
+
+		mod := d.Parameters(nil)
+		for i := range mod {
+			mod[i].Value = test.modify(i, mod[i].Value)
+		}
+		d.SetParameters(mod)
+		got := d.Parameters(nil)
+		for i := range got {
+			if got[i].Name != mod[i].Name || math.Abs(got[i].Value-mod[i].Value) > 1e-14 {
+				t.Errorf("unexpected parameter after SetParameters for %s: got %+v, want %+v", name, got[i], mod[i])
+			}
+		}
+		d.SetParameters(params)
+
+		if !panics(func() { d.Parameters(make([]distuv.Parameter, test.n+1)) }) {
+			t.Errorf("expected panic for wrong parameter slice length for %s", name)
+		}
+		if !panics(func() { d.SetParameters(params[:test.n-1]) }) {
+			t.Errorf("expected panic for short parameters for %s", name)
+		}
+		bad := d.Parameters(nil)
+		bad[0].Name = "__badName__"
+		if !panics(func() { d.SetParameters(bad) }) {
+			t.Errorf("expected panic for wrong parameter name for %s", name)
+		}
+	}
+
+	// The covariance matrix of a normal distribution is
+	// positive definite.
+	p := normal.Parameters(nil)
+	p[3].Value = -1
+	if !panics(func() { normal.SetParameters(p) }) {
+		t.Errorf("expected panic for covariance matrix that is not positive definite")
+	}
+	// The number of trials of a multinomial
+	// distribution is an integer.
+	m := NewMultinomial(10, []float64{0.5, 0.5}, nil)
+	p = m.Parameters(nil)
+	p[0].Value = 2.5
+	if !panics(func() { m.SetParameters(p) }) {
+		t.Errorf("expected panic for non-integer number of trials")
+	}
+}
+
+func TestMeanAndLowerBounds(t *testing.T) {
+	t.Parallel()
+	const dim = 4
+	names := symmetricNames(vectorNames(nil, "Mu", dim), "Sigma", dim)
+	for i, name := range names {
+		got := meanAndLowerBounds(i, dim)
+		var want distuv.ParameterBounds
+		switch name {
+		case "Sigma[0,0]", "Sigma[1,1]", "Sigma[2,2]", "Sigma[3,3]":
+			want = positive
+		default:
+			want = realLine
+		}
+		if got != want {
+			t.Errorf("unexpected bounds for %s: got %+v, want %+v", name, got, want)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
@@ -13,6 +13,7 @@ import (
 
 	"gonum.org/v1/gonum/floats"
 	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/mathext"
 	"gonum.org/v1/gonum/stat"
 	"gonum.org/v1/gonum/stat/distuv"
 )
@@ -23,13 +24,15 @@ import (
 //	p(y) = (Γ((ν+n)/2) / Γ(ν/2)) * (νπ)^(-n/2) * |Ʃ|^(-1/2) *
 //	           (1 + 1/ν * (y-μ)ᵀ * Ʃ^-1 * (y-μ))^(-(ν+n)/2)
 //
-// where ν is a scalar greater than 2, μ is a vector in ℝ^n, and Ʃ is an n×n
+// where ν is a positive scalar, μ is a vector in ℝ^n, and Ʃ is an n×n
 // symmetric positive definite matrix.
 //
 // In this distribution, ν sets the spread of the distribution, similar to
 // the degrees of freedom in a univariate Student's T distribution. As ν → ∞,
 // the distribution approaches a multi-variate normal distribution.
-// μ is the mean of the distribution, and the covariance is  ν/(ν-2)*Ʃ.
+// μ is the mean of the distribution when ν > 1, and the covariance is
+// ν/(ν-2)*Ʃ when ν > 2. The moments of order k are only finite when ν > k;
+// methods returning moments that are not finite report math.NaN().
 //
 // See https://en.wikipedia.org/wiki/Student%27s_t-distribution and
 // http://users.isy.liu.se/en/rt/roth/student.pdf for more information.
@@ -51,12 +54,16 @@ type StudentsT struct {
 // NewStudentsT creates a new StudentsT with the given nu, mu, and sigma
 // parameters.
 //
-// NewStudentsT panics if len(mu) == 0, or if len(mu) != sigma.SymmetricDim(). If
-// the covariance matrix is not positive-definite, nil is returned and ok is false.
+// NewStudentsT panics if len(mu) == 0, if len(mu) != sigma.SymmetricDim() or
+// if nu is not positive. If the covariance matrix is not positive-definite,
+// nil is returned and ok is false.
 func NewStudentsT(mu []float64, sigma mat.Symmetric, nu float64, src rand.Source) (dist *StudentsT, ok bool) {
 	if len(mu) == 0 {
 		panic(badZeroDimension)
 	}
+	if !(nu > 0) {
+		panic("studentst: non-positive nu")
+	}
 	dim := sigma.SymmetricDim()
 	if dim != len(mu) {
 		panic(badSizeMismatch)
@@ -95,114 +102,19 @@ func NewStudentsT(mu []float64, sigma mat.Symmetric, nu float64, src rand.Source
 // ok indicates whether there was a failure during the update. If ok is false
 // the operation failed and dist is not usable.
 // Mathematically this is impossible, but can occur with finite precision arithmetic.
+//
+// When conditioning repeatedly on the same set of observed variables, the
+// PreparedConditioner returned by PrepareCondition avoids recomputing the
+// factorizations of the scale matrix.
 func (s *StudentsT) ConditionStudentsT(observed []int, values []float64, src rand.Source) (dist *StudentsT, ok bool) {
-	if len(observed) == 0 {
-		panic("studentst: no observed value")
-	}
 	if len(observed) != len(values) {
 		panic(badInputLength)
 	}
-
-	for _, v := range observed {
-		if v < 0 || v >= s.dim {
-			panic("studentst: observed value out of bounds")
-		}
-	}
-
-	newNu, newMean, newSigma := studentsTConditional(observed, values, s.nu, s.mu, &s.sigma)
-	if newMean == nil {
+	p, ok := s.PrepareCondition(observed)
+	if !ok {
 		return nil, false
 	}
-
-	return NewStudentsT(newMean, newSigma, newNu, src)
-
-}
-
-// studentsTConditional updates a Student's T distribution based on the observed samples
-// (see documentation for the public function). The Gaussian conditional update
-// is treated as a special case when  nu == math.Inf(1).
-func studentsTConditional(observed []int, values []float64, nu float64, mu []float64, sigma mat.Symmetric) (newNu float64, newMean []float64, newSigma *mat.SymDense) {
-	dim := len(mu)
-	ob := len(observed)
-
-	unobserved := findUnob(observed, dim)
-
-	unob := len(unobserved)
-	if unob == 0 {
-		panic("stat: all dimensions observed")
-	}
-
-	mu1 := make([]float64, unob)
-	for i, v := range unobserved {
-		mu1[i] = mu[v]
-	}
-	mu2 := make([]float64, ob) // really v - mu2
-	for i, v := range observed {
-		mu2[i] = values[i] - mu[v]
-	}
-
-	var sigma11, sigma22 mat.SymDense
-	sigma11.SubsetSym(sigma, unobserved)
-	sigma22.SubsetSym(sigma, observed)
-
-	sigma21 := mat.NewDense(ob, unob, nil)
-	for i, r := range observed {
-		for j, c := range unobserved {
-			v := sigma.At(r, c)
-			sigma21.Set(i, j, v)
-		}
-	}
-
-	var chol mat.Cholesky
-	ok := chol.Factorize(&sigma22)
-	if !ok {
-		return math.NaN(), nil, nil
-	}
-
-	// Compute mu_1 + sigma_{2,1}ᵀ * sigma_{2,2}^-1 (v - mu_2).
-	v := mat.NewVecDense(ob, mu2)
-	var tmp, tmp2 mat.VecDense
-	err := chol.SolveVecTo(&tmp, v)
-	if err != nil {
-		return math.NaN(), nil, nil
-	}
-	tmp2.MulVec(sigma21.T(), &tmp)
-
-	for i := range mu1 {
-		mu1[i] += tmp2.At(i, 0)
-	}
-
-	// Compute tmp4 = sigma_{2,1}ᵀ * sigma_{2,2}^-1 * sigma_{2,1}.
-	// TODO(btracey): Should this be a method of SymDense?
-	var tmp3, tmp4 mat.Dense
-	err = chol.SolveTo(&tmp3, sigma21)
-	if err != nil {
-		return math.NaN(), nil, nil
-	}
-	tmp4.Mul(sigma21.T(), &tmp3)
-
-	// Compute sigma_{1,1} - tmp4
-	// TODO(btracey): If tmp4 can constructed with a method, then this can be
-	// replaced with SubSym.
-	for i := 0; i < len(unobserved); i++ {
-		for j := i; j < len(unobserved); j++ {
-			v := sigma11.At(i, j)
-			sigma11.SetSym(i, j, v-tmp4.At(i, j))
-		}
-	}
-
-	// The computed variables are accurate for a Normal.
-	if math.IsInf(nu, 1) {
-		return nu, mu1, &sigma11
-	}
-
-	// Compute beta = (v - mu_2)ᵀ * sigma_{2,2}^-1 * (v - mu_2)ᵀ
-	beta := mat.Dot(v, &tmp)
-
-	// Scale the covariance matrix
-	sigma11.ScaleSym((nu+beta)/(nu+float64(ob)), &sigma11)
-
-	return nu + float64(ob), mu1, &sigma11
+	return p.ConditionStudentsT(nil, values, src), true
 }
 
 // findUnob returns the unobserved variables (the complementary set to observed).
@@ -232,6 +144,9 @@ func findUnob(observed []int, dim int) (unobserved []int) {
 // If the dst matrix is empty it will be resized to the correct dimensions,
 // otherwise dst must match the dimension of the receiver or CovarianceMatrix
 // will panic.
+//
+// The covariance is not finite for ν <= 2, and all elements of dst are set
+// to math.NaN().
 func (st *StudentsT) CovarianceMatrix(dst *mat.SymDense) {
 	if dst.IsEmpty() {
 		*dst = *(dst.GrowSym(st.dim).(*mat.SymDense))
@@ -239,7 +154,16 @@ func (st *StudentsT) CovarianceMatrix(dst *mat.SymDense) {
 		panic("studentst: input matrix size mismatch")
 	}
 	dst.CopySym(&st.sigma)
-	dst.ScaleSym(st.nu/(st.nu-2), dst)
+	dst.ScaleSym(st.varianceScale(), dst)
+}
+
+// varianceScale returns the ratio of the covariance to the scale matrix,
+// ν/(ν-2), or NaN if the covariance is not finite.
+func (s *StudentsT) varianceScale() float64 {
+	if s.nu <= 2 {
+		return math.NaN()
+	}
+	return s.nu / (s.nu - 2)
 }
 
 // Dim returns the dimension of the distribution.
@@ -247,6 +171,31 @@ func (s *StudentsT) Dim() int {
 	return s.dim
 }
 
+// Entropy returns the differential entropy of the distribution.
+func (s *StudentsT) Entropy() float64 {
+	// The entropy is
+	//  -log(Γ((ν+n)/2)/Γ(ν/2)) + n/2 log(νπ) + 1/2 log|Ʃ|
+	//      + (ν+n)/2 (ψ((ν+n)/2) - ψ(ν/2))
+	// where ψ is the digamma function.
+	nu := s.nu
+	n := float64(s.dim)
+	lg1, _ := math.Lgamma((nu + n) / 2)
+	lg2, _ := math.Lgamma(nu / 2)
+	return lg2 - lg1 + n/2*math.Log(nu*math.Pi) + s.logSqrtDet +
+		(nu+n)/2*(mathext.Digamma((nu+n)/2)-mathext.Digamma(nu/2))
+}
+
+// ExKurtosis returns the excess kurtosis of each of the marginal
+// distributions, 6/(ν-4).
+//
+// The excess kurtosis is not finite for ν <= 4, and this returns math.NaN().
+func (s *StudentsT) ExKurtosis() float64 {
+	if s.nu <= 4 {
+		return math.NaN()
+	}
+	return 6 / (s.nu - 4)
+}
+
 // LogProb computes the log of the pdf of the point x.
 func (s *StudentsT) LogProb(y []float64) float64 {
 	if len(y) != s.dim {
@@ -315,7 +264,27 @@ func (s *StudentsT) MarginalStudentsTSingle(i int, src rand.Source) distuv.Stude
 // If dst is not nil, the mean will be stored in-place into dst and returned,
 // otherwise a new slice will be allocated first. If dst is not nil, it must
 // have length equal to the dimension of the distribution.
+//
+// The mean is undefined for ν <= 1, and all elements of the result are set
+// to math.NaN().
 func (s *StudentsT) Mean(dst []float64) []float64 {
+	dst = reuseAs(dst, s.dim)
+	if s.nu <= 1 {
+		for i := range dst {
+			dst[i] = math.NaN()
+		}
+		return dst
+	}
+	copy(dst, s.mu)
+	return dst
+}
+
+// Mode returns the mode of the probability distribution.
+//
+// If dst is not nil, the mode will be stored in-place into dst and returned,
+// otherwise a new slice will be allocated first. If dst is not nil, it must
+// have length equal to the dimension of the distribution.
+func (s *StudentsT) Mode(dst []float64) []float64 {
 	dst = reuseAs(dst, s.dim)
 	copy(dst, s.mu)
 	return dst
@@ -331,6 +300,51 @@ func (s *StudentsT) Prob(y []float64) float64 {
 	return math.Exp(s.LogProb(y))
 }
 
+// Skewness returns the skewness of each of the marginal distributions,
+// which is zero by symmetry.
+//
+// The skewness is undefined for ν <= 3, and this returns math.NaN().
+func (s *StudentsT) Skewness() float64 {
+	if s.nu <= 3 {
+		return math.NaN()
+	}
+	return 0
+}
+
+// StdDev returns the standard deviations of the marginal distributions.
+//
+// If dst is not nil, the standard deviations will be stored in-place into dst
+// and returned, otherwise a new slice will be allocated first. If dst is not
+// nil, it must have length equal to the dimension of the distribution.
+//
+// The standard deviation is not finite for ν <= 2, and all elements of the
+// result are set to math.NaN().
+func (s *StudentsT) StdDev(dst []float64) []float64 {
+	dst = s.Variance(dst)
+	for i, v := range dst {
+		dst[i] = math.Sqrt(v)
+	}
+	return dst
+}
+
+// Variance returns the variances of the marginal distributions, the
+// diagonal of the covariance matrix.
+//
+// If dst is not nil, the variances will be stored in-place into dst and
+// returned, otherwise a new slice will be allocated first. If dst is not nil,
+// it must have length equal to the dimension of the distribution.
+//
+// The variance is not finite for ν <= 2, and all elements of the result are
+// set to math.NaN().
+func (s *StudentsT) Variance(dst []float64) []float64 {
+	dst = reuseAs(dst, s.dim)
+	scale := s.varianceScale()
+	for i := range dst {
+		dst[i] = scale * s.sigma.At(i, i)
+	}
+	return dst
+}
+
 // Rand generates a random sample according to the distribution.
 //
 // If dst is not nil, the sample will be stored in-place into dst and returned,
@@ -360,3 +374,333 @@ func (s *StudentsT) Rand(dst []float64) []float64 {
 	floats.AddScaledTo(dst, s.mu, math.Sqrt(s.nu/u), dst)
 	return dst
 }
+
+const (
+	// studentsTFitTol is the relative tolerance on the change in the
+	// log-likelihood used to detect convergence in FitStudentsT.
+	studentsTFitTol = 1e-10
+	// studentsTFitMaxIter is the maximum number of iterations
+	// used by FitStudentsT.
+	studentsTFitMaxIter = 10000
+
+	// minNu and maxNu bound the estimated degrees of freedom.
+	minNu = 1e-2
+	maxNu = 1e6
+)
+
+// FitStudentsT returns the Student's T distribution fitted by maximum
+// likelihood to the samples in the rows of x with relative weights, and the
+// success of the operation. Missing entries in x are represented by NaN and
+// are accounted for by their conditional expectations given the observed
+// entries of the sample. Rows with no observed entries are ignored.
+//
+// The parameters are estimated by the ECME algorithm described in
+//
+//	Liu, C. and Rubin, D. B. "ML estimation of the t distribution using EM
+//	and its extensions, ECM and ECME", Statistica Sinica 5:19-39 (1995).
+//
+// in which μ and Ʃ are updated by conditional maximization of the expected
+// complete-data log-likelihood and ν is updated by maximizing the observed-data
+// log-likelihood directly.
+//
+// If nu is positive the degrees of freedom are held fixed at nu, otherwise if
+// nu is zero ν is estimated along with μ and Ʃ. Estimates of ν are restricted
+// to the interval [0.01, 1e6]. The input src is passed to the created
+// StudentsT.
+//
+// If weights is nil, then all the weights are 1. If weights is not nil, then
+// len(weights) must equal the number of rows of x. FitStudentsT panics if nu
+// is negative, NaN or infinite.
+//
+// ok indicates whether the fit was successful. It is false if any variable
+// has fewer than two distinct observed values, if the scale matrix becomes
+// singular, or if the algorithm fails to converge.
+func FitStudentsT(x mat.Matrix, weights []float64, nu float64, src rand.Source) (dist *StudentsT, ok bool) {
+	r, dim := x.Dims()
+	if weights != nil && len(weights) != r {
+		panic(badInputLength)
+	}
+	if !(nu >= 0) || math.IsInf(nu, 1) {
+		panic("studentst: invalid nu")
+	}
+	fixNu := nu > 0
+	if !fixNu {
+		nu = 10
+	}
+	weight := func(i int) float64 {
+		if weights == nil {
+			return 1
+		}
+		return weights[i]
+	}
+
+	// Initialize with the means and variances of the observed entries.
+	mu := make([]float64, dim)
+	sigma := mat.NewSymDense(dim, nil)
+	for j := 0; j < dim; j++ {
+		var sumWeights, mean, ss float64
+		for i := 0; i < r; i++ {
+			v := x.At(i, j)
+			if math.IsNaN(v) {
+				continue
+			}
+			w := weight(i)
+			sumWeights += w
+			d := v - mean
+			mean += w * d / sumWeights
+			ss += w * d * (v - mean)
+		}
+		if !(ss > 0) {
+			return nil, false
+		}
+		mu[j] = mean
+		sigma.SetSym(j, j, ss/sumWeights)
+	}
+
+	// Group the samples by their pattern of missing entries so that
+	// the factorizations can be shared.
+	type pattern struct {
+		obs, mis []int
+		rows     []int // indices into the observed samples below
+		weight   float64
+	}
+	var patterns []*pattern
+	byMask := make(map[string]*pattern)
+	var rows []int
+	mask := make([]byte, dim)
+	for i := 0; i < r; i++ {
+		var nObs int
+		for j := range mask {
+			mask[j] = 1
+			if math.IsNaN(x.At(i, j)) {
+				mask[j] = 0
+				continue
+			}
+			nObs++
+		}
+		if nObs == 0 {
+			continue
+		}
+		p, found := byMask[string(mask)]
+		if !found {
+			p = &pattern{}
+			for j, m := range mask {
+				if m == 1 {
+					p.obs = append(p.obs, j)
+				} else {
+					p.mis = append(p.mis, j)
+				}
+			}
+			byMask[string(mask)] = p
+			patterns = append(patterns, p)
+		}
+		p.rows = append(p.rows, len(rows))
+		p.weight += weight(i)
+		rows = append(rows, i)
+	}
+
+	n := len(rows)
+	c := make([]float64, n)
+	var sumC float64
+	for k, i := range rows {
+		c[k] = weight(i)
+		sumC += c[k]
+	}
+	xhat := mat.NewDense(n, dim, nil)
+	delta := make([]float64, n)
+	obsDim := make([]float64, n)
+	logDet := make([]float64, n)
+	cond := make([]*mat.Dense, len(patterns))
+
+	llOld := math.Inf(-1)
+	for iter := 0; ; iter++ {
+		if iter == studentsTFitMaxIter {
+			return nil, false
+		}
+
+		// Compute the Mahalanobis distances of the observed entries and
+		// the conditional expectations of the missing entries.
+		for pi, p := range patterns {
+			var sigmaOO mat.SymDense
+			sigmaOO.SubsetSym(sigma, p.obs)
+			var chol mat.Cholesky
+			if !chol.Factorize(&sigmaOO) {
+				return nil, false
+			}
+			ld := chol.LogDet()
+
+			// Compute the regression of the missing entries on the
+			// observed entries, Ʃ_mo Ʃ_oo^-1, and the conditional scale
+			// matrix of the missing entries, Ʃ_mm - Ʃ_mo Ʃ_oo^-1 Ʃ_om.
+			var sigmaMO *mat.Dense
+			if len(p.mis) != 0 {
+				sigmaMO = mat.NewDense(len(p.mis), len(p.obs), nil)
+				for a, m := range p.mis {
+					for b, o := range p.obs {
+						sigmaMO.Set(a, b, sigma.At(m, o))
+					}
+				}
+				var tmp mat.Dense
+				err := chol.SolveTo(&tmp, sigmaMO.T())
+				if err != nil {
+					return nil, false
+				}
+				var sigmaMM mat.SymDense
+				sigmaMM.SubsetSym(sigma, p.mis)
+				cond[pi] = mat.NewDense(len(p.mis), len(p.mis), nil)
+				cond[pi].Mul(sigmaMO, &tmp)
+				cond[pi].Sub(&sigmaMM, cond[pi])
+			}
+
+			d := mat.NewVecDense(len(p.obs), nil)
+			var sol, tmp mat.VecDense
+			for _, k := range p.rows {
+				row := xhat.RawRowView(k)
+				for b, o := range p.obs {
+					row[o] = x.At(rows[k], o)
+					d.SetVec(b, row[o]-mu[o])
+				}
+				err := chol.SolveVecTo(&sol, d)
+				if err != nil {
+					return nil, false
+				}
+				delta[k] = mat.Dot(d, &sol)
+				obsDim[k] = float64(len(p.obs))
+				logDet[k] = ld
+				if sigmaMO != nil {
+					tmp.MulVec(sigmaMO, &sol)
+					for a, m := range p.mis {
+						row[m] = mu[m] + tmp.AtVec(a)
+					}
+				}
+			}
+		}
+
+		if !fixNu {
+			nu = studentsTDoF(delta, obsDim, c)
+		}
+
+		// Compute the observed-data log-likelihood.
+		var ll float64
+		lgNu, _ := math.Lgamma(nu / 2)
+		for k, p := range obsDim {
+			lg, _ := math.Lgamma((nu + p) / 2)
+			ll += c[k] * (lg - lgNu - p/2*math.Log(nu*math.Pi) - logDet[k]/2 - (nu+p)/2*math.Log1p(delta[k]/nu))
+		}
+		if math.Abs(ll-llOld) <= studentsTFitTol*(1+math.Abs(ll)) {
+			break
+		}
+		llOld = ll
+
+		// Update μ and Ʃ using the expected values of the latent scale
+		// of each sample, (ν+p)/(ν+δ).
+		tau := make([]float64, n)
+		var sumTau float64
+		for k := range tau {
+			tau[k] = c[k] * (nu + obsDim[k]) / (nu + delta[k])
+			sumTau += tau[k]
+		}
+		for j := range mu {
+			mu[j] = 0
+		}
+		for k, w := range tau {
+			floats.AddScaled(mu, w/sumTau, xhat.RawRowView(k))
+		}
+		sigma.Zero()
+		resid := make([]float64, dim)
+		for k, w := range tau {
+			floats.SubTo(resid, xhat.RawRowView(k), mu)
+			sigma.SymRankOne(sigma, w/sumC, mat.NewVecDense(dim, resid))
+		}
+		for pi, p := range patterns {
+			if cond[pi] == nil {
+				continue
+			}
+			f := p.weight / sumC
+			for a, m := range p.mis {
+				for b := a; b < len(p.mis); b++ {
+					l := p.mis[b]
+					sigma.SetSym(m, l, sigma.At(m, l)+f*cond[pi].At(a, b))
+				}
+			}
+		}
+	}
+	return NewStudentsT(mu, sigma, nu, src)
+}
+
+// studentsTDoF returns the degrees of freedom that maximize the weighted
+// log-likelihood of samples with squared Mahalanobis distances delta in p
+// dimensions, by bisection on the derivative of the log-likelihood.
+func studentsTDoF(delta, p, weights []float64) float64 {
+	deriv := func(nu float64) float64 {
+		var g float64
+		dg := mathext.Digamma(nu / 2)
+		for k, d := range delta {
+			g += weights[k] * (mathext.Digamma((nu+p[k])/2) - dg - p[k]/nu -
+				math.Log1p(d/nu) + (nu+p[k])*d/(nu*(nu+d)))
+		}
+		return g / 2
+	}
+	lo, hi := minNu, maxNu
+	if deriv(hi) >= 0 {
+		return hi
+	}
+	if deriv(lo) <= 0 {
+		return lo
+	}
+	for hi/lo > 1+1e-12 {
+		mid := math.Sqrt(lo * hi)
+		if deriv(mid) > 0 {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return math.Sqrt(lo * hi)
+}
+
+// NumParameters returns the number of parameters of the distribution.
+func (s *StudentsT) NumParameters() int {
+	return s.dim + s.dim*(s.dim+1)/2 + 1
+}
+
+// Parameters returns the parameters of the distribution, the elements of the
+// location named "Mu[i]" followed by the elements of the lower triangle of
+// the scale matrix named "Sigma[i,j]", with j ≤ i, in row-major order and
+// the degrees of freedom named "Nu". If p is not nil, the parameters are
+// stored in p, which must have length NumParameters.
+func (s *StudentsT) Parameters(p []distuv.Parameter) []distuv.Parameter {
+	return parametersOf(p, s.parameterNames(), append(meanAndLower(s.mu, &s.sigma), s.nu))
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+// SetParameters will panic if the scale matrix is not positive definite or
+// ν is not positive.
+func (s *StudentsT) SetParameters(p []distuv.Parameter) {
+	v := parameterValues(p, s.parameterNames())
+	mu, sigma := splitMeanAndLower(v[:len(v)-1], s.dim)
+	dist, ok := NewStudentsT(mu, sigma, v[len(v)-1], s.src)
+	if !ok {
+		panic("studentst: scale matrix not positive definite")
+	}
+	*s = *dist
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// The bounds do not describe the requirement that the scale matrix is
+// positive definite. If b is not nil, the bounds are stored in b, which must
+// have length NumParameters.
+func (s *StudentsT) ParameterBounds(b []distuv.ParameterBounds) []distuv.ParameterBounds {
+	names := s.parameterNames()
+	return boundsOf(b, names, func(i int) distuv.ParameterBounds {
+		if i == len(names)-1 {
+			return positive
+		}
+		return meanAndLowerBounds(i, s.dim)
+	})
+}
+
+func (s *StudentsT) parameterNames() []string {
+	return append(symmetricNames(vectorNames(nil, "Mu", s.dim), "Sigma", s.dim), "Nu")
+}
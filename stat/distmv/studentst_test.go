@@ -271,3 +271,169 @@ func TestStudentsTMarginalSingle(t *testing.T) {
 		}
 	}
 }
+
+func TestStudentsTMoments(t *testing.T) {
+	const n = 200000
+	mu := []float64{2, 3, 4}
+	sigma := mat.NewSymDense(3, []float64{2, 0.5, 3, 0.5, 1, 0.6, 3, 0.6, 10})
+	for _, nu := range []float64{0.5, 1, 1.5, 2, 3, 4.5, 10} {
+		s, ok := NewStudentsT(mu, sigma, nu, rand.NewPCG(1, 1))
+		if !ok {
+			t.Fatalf("Bad test, covariance matrix not positive definite")
+		}
+
+		if !floats.Equal(s.Mode(nil), mu) {
+			t.Errorf("Mode mismatch for nu=%v: got %v, want %v", nu, s.Mode(nil), mu)
+		}
+		mean := s.Mean(nil)
+		for i, v := range mean {
+			if nu <= 1 && !math.IsNaN(v) || nu > 1 && v != mu[i] {
+				t.Errorf("Mean mismatch for nu=%v: got %v", nu, mean)
+				break
+			}
+		}
+
+		var cov mat.SymDense
+		s.CovarianceMatrix(&cov)
+		variance := s.Variance(nil)
+		std := s.StdDev(nil)
+		for i := range mu {
+			marg := s.MarginalStudentsTSingle(i, nil)
+			if nu <= 2 {
+				if !math.IsNaN(variance[i]) || !math.IsNaN(std[i]) || !math.IsNaN(cov.At(i, i)) {
+					t.Errorf("Variance not NaN for nu=%v: got %v, %v, %v", nu, variance[i], std[i], cov.At(i, i))
+				}
+				continue
+			}
+			if !scalar.EqualWithinAbsOrRel(variance[i], marg.Variance(), 1e-14, 1e-14) {
+				t.Errorf("Variance mismatch for nu=%v, idx %d: got %v, want %v", nu, i, variance[i], marg.Variance())
+			}
+			if !scalar.EqualWithinAbsOrRel(std[i], marg.StdDev(), 1e-14, 1e-14) {
+				t.Errorf("StdDev mismatch for nu=%v, idx %d: got %v, want %v", nu, i, std[i], marg.StdDev())
+			}
+			if variance[i] != cov.At(i, i) {
+				t.Errorf("Variance does not match covariance diagonal for nu=%v, idx %d: got %v, want %v", nu, i, variance[i], cov.At(i, i))
+			}
+		}
+
+		if skew := s.Skewness(); nu <= 3 && !math.IsNaN(skew) || nu > 3 && skew != 0 {
+			t.Errorf("Skewness mismatch for nu=%v: got %v", nu, skew)
+		}
+		kurt := s.ExKurtosis()
+		if nu <= 4 && !math.IsNaN(kurt) || nu > 4 && !scalar.EqualWithinAbsOrRel(kurt, 6/(nu-4), 1e-14, 1e-14) {
+			t.Errorf("ExKurtosis mismatch for nu=%v: got %v", nu, kurt)
+		}
+
+		// The entropy is the expected negative log probability.
+		x := make([]float64, len(mu))
+		var entropy float64
+		for i := 0; i < n; i++ {
+			entropy -= s.LogProb(s.Rand(x))
+		}
+		entropy /= n
+		if !scalar.EqualWithinAbsOrRel(s.Entropy(), entropy, 2e-2, 2e-2) {
+			t.Errorf("Entropy mismatch for nu=%v: got %v, want %v", nu, s.Entropy(), entropy)
+		}
+	}
+}
+
+func TestFitStudentsT(t *testing.T) {
+	mu := []float64{2, 3, 4}
+	sigma := mat.NewSymDense(3, []float64{2, 0.5, 1.5, 0.5, 1, 0.6, 1.5, 0.6, 3})
+	const nu = 5.0
+	s, ok := NewStudentsT(mu, sigma, nu, rand.NewPCG(1, 1))
+	if !ok {
+		t.Fatalf("Bad test, covariance matrix not positive definite")
+	}
+	const n = 20000
+	x := mat.NewDense(n, 3, nil)
+	for i := 0; i < n; i++ {
+		s.Rand(x.RawRowView(i))
+	}
+	var missing mat.Dense
+	missing.CloneFrom(x)
+	rnd := rand.New(rand.NewPCG(2, 2))
+	for i := 0; i < n; i++ {
+		for j := 0; j < 3; j++ {
+			if rnd.Float64() < 0.2 {
+				missing.Set(i, j, math.NaN())
+			}
+		}
+	}
+
+	for _, test := range []struct {
+		name   string
+		x      mat.Matrix
+		nu     float64
+		tolNu  float64
+		tolMu  float64
+		tolSig float64
+	}{
+		{name: "complete", x: x, nu: 0, tolNu: 0.5, tolMu: 0.03, tolSig: 0.1},
+		{name: "complete fixed nu", x: x, nu: nu, tolMu: 0.03, tolSig: 0.1},
+		{name: "missing", x: &missing, nu: 0, tolNu: 0.6, tolMu: 0.03, tolSig: 0.1},
+	} {
+		fit, ok := FitStudentsT(test.x, nil, test.nu, nil)
+		if !ok {
+			t.Errorf("%s: unexpected fit failure", test.name)
+			continue
+		}
+		if math.Abs(fit.Nu()-nu) > test.tolNu {
+			t.Errorf("%s: nu mismatch: got %v, want %v", test.name, fit.Nu(), nu)
+		}
+		if !floats.EqualApprox(fit.Mean(nil), mu, test.tolMu) {
+			t.Errorf("%s: mu mismatch: got %v, want %v", test.name, fit.Mean(nil), mu)
+		}
+		if !mat.EqualApprox(&fit.sigma, sigma, test.tolSig) {
+			t.Errorf("%s: sigma mismatch: got %v, want %v", test.name, mat.Formatted(&fit.sigma), mat.Formatted(sigma))
+		}
+	}
+
+	// The fitted parameters of complete weighted data maximize the
+	// weighted log-likelihood.
+	const m = 200
+	weights := make([]float64, m)
+	for i := range weights {
+		weights[i] = rnd.Float64() + 0.5
+	}
+	sub := x.Slice(0, m, 0, 3).(*mat.Dense)
+	fit, ok := FitStudentsT(sub, weights, 0, nil)
+	if !ok {
+		t.Fatalf("unexpected fit failure")
+	}
+	logLike := func(mu []float64, sigma *mat.SymDense, nu float64) float64 {
+		s, ok := NewStudentsT(mu, sigma, nu, nil)
+		if !ok {
+			return math.Inf(-1)
+		}
+		var ll float64
+		for i, w := range weights {
+			ll += w * s.LogProb(sub.RawRowView(i))
+		}
+		return ll
+	}
+	best := logLike(fit.mu, &fit.sigma, fit.nu)
+	const h = 1e-3
+	for _, dir := range []int{-1, 1} {
+		d := float64(dir) * h
+		if ll := logLike(fit.mu, &fit.sigma, fit.nu+d); ll > best {
+			t.Errorf("log-likelihood increased with nu perturbed by %v: %v > %v", d, ll, best)
+		}
+		for j := range fit.mu {
+			perturbed := make([]float64, len(fit.mu))
+			copy(perturbed, fit.mu)
+			perturbed[j] += d
+			if ll := logLike(perturbed, &fit.sigma, fit.nu); ll > best {
+				t.Errorf("log-likelihood increased with mu[%d] perturbed by %v: %v > %v", j, d, ll, best)
+			}
+			for k := j; k < len(fit.mu); k++ {
+				sig := mat.NewSymDense(len(fit.mu), nil)
+				sig.CopySym(&fit.sigma)
+				sig.SetSym(j, k, sig.At(j, k)+d)
+				if ll := logLike(fit.mu, sig, fit.nu); ll > best {
+					t.Errorf("log-likelihood increased with sigma[%d,%d] perturbed by %v: %v > %v", j, k, d, ll, best)
+				}
+			}
+		}
+	}
+}
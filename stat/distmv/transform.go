@@ -0,0 +1,366 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distmv
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Transform is a smooth invertible transformation of points in an n
+// dimensional space, such as the support of a distribution, onto a possibly
+// constrained space of dimension m. Transformations are used to construct
+// distributions on constrained spaces from distributions on unconstrained
+// spaces, and conversely to sample in an unconstrained space.
+//
+// For transformations onto a space of dimension m greater than n, the
+// transformed points lie on an n dimensional manifold, such as the simplex,
+// and the Jacobian is that of the map to the first n coordinates.
+type Transform interface {
+	// OutputDim returns the dimension of the result of Forward
+	// for an input of dimension n, or -1 if n is not a valid
+	// input dimension.
+	OutputDim(n int) int
+
+	// InputDim returns the dimension of the result of Inverse
+	// for an input of dimension m, or -1 if m is not a valid
+	// output dimension.
+	InputDim(m int) int
+
+	// Forward stores in dst the transformation of x and returns
+	// the result. If dst is nil, a new slice is allocated.
+	Forward(dst, x []float64) []float64
+
+	// Inverse stores in dst the inverse transformation of y and
+	// returns the result. If dst is nil, a new slice is
+	// allocated.
+	Inverse(dst, y []float64) []float64
+
+	// LogDetJacobian returns the log of the absolute value of
+	// the determinant of the Jacobian of Forward at x.
+	LogDetJacobian(x []float64) float64
+}
+
+// Transformed is the distribution of the transformation by a Transform of
+// random variables with a base distribution. The density of Transformed at
+// y = T(x) is given by the change of variables formula
+//
+//	log p_Y(y) = log p_X(x) - log |det J_T(x)|.
+type Transformed struct {
+	base RandLogProber
+	t    Transform
+
+	// dim and baseDim are the dimensions of
+	// the transformed and base distributions.
+	dim, baseDim int
+}
+
+// NewTransformed returns the distribution of the transformation by t of
+// random variables with the dim-dimensional distribution base.
+//
+// NewTransformed will panic if dim is not positive or is not a valid input
+// dimension of t.
+func NewTransformed(base RandLogProber, dim int, t Transform) *Transformed {
+	if dim <= 0 {
+		panic(nonPosDimension)
+	}
+	m := t.OutputDim(dim)
+	if m < 0 {
+		panic(badSizeMismatch)
+	}
+	return &Transformed{base: base, t: t, dim: m, baseDim: dim}
+}
+
+// Dim returns the dimension of the distribution.
+func (d *Transformed) Dim() int {
+	return d.dim
+}
+
+// LogProb computes the log of the pdf of the point y.
+//
+// LogProb will panic if the length of y is not equal to the dimension of
+// the distribution.
+func (d *Transformed) LogProb(y []float64) float64 {
+	if len(y) != d.dim {
+		panic(badSizeMismatch)
+	}
+	x := d.t.Inverse(make([]float64, d.baseDim), y)
+	return d.base.LogProb(x) - d.t.LogDetJacobian(x)
+}
+
+// Prob computes the value of the probability density function at y.
+func (d *Transformed) Prob(y []float64) float64 {
+	return math.Exp(d.LogProb(y))
+}
+
+// Rand generates a random sample according to the distribution by
+// transforming a sample from the base distribution.
+//
+// If dst is not nil, the sample will be stored in-place into dst and returned,
+// otherwise a new slice will be allocated first. If dst is not nil, it must
+// have length equal to the dimension of the distribution.
+func (d *Transformed) Rand(dst []float64) []float64 {
+	dst = reuseAs(dst, d.dim)
+	x := d.base.Rand(make([]float64, d.baseDim))
+	return d.t.Forward(dst, x)
+}
+
+// AffineTransform is the transformation y = A x + b for an invertible square
+// matrix A.
+type AffineTransform struct {
+	a    *mat.Dense
+	b    []float64
+	lu   mat.LU
+	ldet float64
+}
+
+// NewAffineTransform returns the affine transformation y = A x + b. If b is
+// nil, the shift is zero.
+//
+// NewAffineTransform will panic if a is not square, b is not nil and its
+// length is not the dimension of a, or a is singular.
+func NewAffineTransform(a mat.Matrix, b []float64) *AffineTransform {
+	r, c := a.Dims()
+	if r != c {
+		panic(mat.ErrSquare)
+	}
+	if b == nil {
+		b = make([]float64, r)
+	} else if len(b) != r {
+		panic(badInputLength)
+	}
+	t := &AffineTransform{a: mat.DenseCopyOf(a), b: append([]float64(nil), b...)}
+	t.lu.Factorize(t.a)
+	if t.lu.Det() == 0 {
+		panic("distmv: singular affine transformation")
+	}
+	t.ldet, _ = t.lu.LogDet()
+	return t
+}
+
+// OutputDim returns n if n is the dimension of the transformation and -1
+// otherwise.
+func (t *AffineTransform) OutputDim(n int) int {
+	if n != len(t.b) {
+		return -1
+	}
+	return n
+}
+
+// InputDim returns m if m is the dimension of the transformation and -1
+// otherwise.
+func (t *AffineTransform) InputDim(m int) int {
+	return t.OutputDim(m)
+}
+
+// Forward stores A x + b in dst and returns the result.
+func (t *AffineTransform) Forward(dst, x []float64) []float64 {
+	n := len(t.b)
+	if len(x) != n {
+		panic(badInputLength)
+	}
+	dst = reuseAs(dst, n)
+	v := mat.NewVecDense(n, dst)
+	v.MulVec(t.a, mat.NewVecDense(n, x))
+	floats.Add(dst, t.b)
+	return dst
+}
+
+// Inverse stores A⁻¹ (y - b) in dst and returns the result.
+func (t *AffineTransform) Inverse(dst, y []float64) []float64 {
+	n := len(t.b)
+	if len(y) != n {
+		panic(badInputLength)
+	}
+	dst = reuseAs(dst, n)
+	floats.SubTo(dst, y, t.b)
+	v := mat.NewVecDense(n, dst)
+	err := t.lu.SolveVecTo(v, false, v)
+	if err != nil {
+		// The condition of A was checked on
+		// construction, so only warn of
+		// ill-conditioning here.
+		if _, ok := err.(mat.Condition); !ok {
+			panic(err)
+		}
+	}
+	return dst
+}
+
+// LogDetJacobian returns log |det A|.
+func (t *AffineTransform) LogDetJacobian(x []float64) float64 {
+	if len(x) != len(t.b) {
+		panic(badInputLength)
+	}
+	return t.ldet
+}
+
+// ExpTransform is the element-wise transformation y_i = exp(x_i) onto the
+// positive orthant.
+type ExpTransform struct{}
+
+// OutputDim returns n.
+func (ExpTransform) OutputDim(n int) int { return n }
+
+// InputDim returns m.
+func (ExpTransform) InputDim(m int) int { return m }
+
+// Forward stores the element-wise exponential of x in dst and returns the
+// result.
+func (ExpTransform) Forward(dst, x []float64) []float64 {
+	dst = reuseAs(dst, len(x))
+	for i, v := range x {
+		dst[i] = math.Exp(v)
+	}
+	return dst
+}
+
+// Inverse stores the element-wise logarithm of y in dst and returns the
+// result.
+func (ExpTransform) Inverse(dst, y []float64) []float64 {
+	dst = reuseAs(dst, len(y))
+	for i, v := range y {
+		dst[i] = math.Log(v)
+	}
+	return dst
+}
+
+// LogDetJacobian returns \sum_i x_i.
+func (ExpTransform) LogDetJacobian(x []float64) float64 {
+	return floats.Sum(x)
+}
+
+// SoftplusTransform is the element-wise transformation
+//
+//	y_i = log(1 + exp(x_i))
+//
+// onto the positive orthant. Unlike ExpTransform, it is approximately the
+// identity for large x_i.
+type SoftplusTransform struct{}
+
+// OutputDim returns n.
+func (SoftplusTransform) OutputDim(n int) int { return n }
+
+// InputDim returns m.
+func (SoftplusTransform) InputDim(m int) int { return m }
+
+// Forward stores the element-wise softplus of x in dst and returns the
+// result.
+func (SoftplusTransform) Forward(dst, x []float64) []float64 {
+	dst = reuseAs(dst, len(x))
+	for i, v := range x {
+		dst[i] = softplus(v)
+	}
+	return dst
+}
+
+// Inverse stores the element-wise inverse softplus of y, log(exp(y_i) - 1),
+// in dst and returns the result.
+func (SoftplusTransform) Inverse(dst, y []float64) []float64 {
+	dst = reuseAs(dst, len(y))
+	for i, v := range y {
+		// log(exp(y) - 1) = y + log(1 - exp(-y)).
+		dst[i] = v + math.Log(-math.Expm1(-v))
+	}
+	return dst
+}
+
+// LogDetJacobian returns \sum_i log σ(x_i), where σ is the logistic
+// function, the derivative of the softplus function.
+func (SoftplusTransform) LogDetJacobian(x []float64) float64 {
+	var ldet float64
+	for _, v := range x {
+		ldet -= softplus(-v)
+	}
+	return ldet
+}
+
+// softplus returns log(1 + exp(x)) avoiding overflow.
+func softplus(x float64) float64 {
+	if x > 0 {
+		return x + math.Log1p(math.Exp(-x))
+	}
+	return math.Log1p(math.Exp(x))
+}
+
+// StickBreakingTransform is the transformation of a point x in n dimensions
+// onto the interior of the n-simplex in n+1 dimensions, the points with
+// positive elements summing to one, by stick breaking. The elements of the
+// result are
+//
+//	y_k = (1 - \sum_{j<k} y_j) z_k,  k < n,
+//	y_n = 1 - \sum_{j<n} y_j,
+//
+// for z_k = σ(x_k - log(n-k)) with σ the logistic function, so the zero
+// vector is transformed to the centroid of the simplex. Transformed
+// distributions on the simplex have densities with respect to the first n
+// elements, as for the Dirichlet distribution.
+type StickBreakingTransform struct{}
+
+// OutputDim returns n+1.
+func (StickBreakingTransform) OutputDim(n int) int { return n + 1 }
+
+// InputDim returns m-1 if m is greater than one and -1 otherwise.
+func (StickBreakingTransform) InputDim(m int) int {
+	if m < 2 {
+		return -1
+	}
+	return m - 1
+}
+
+// Forward stores the transformation of x onto the simplex in dst and returns
+// the result.
+func (StickBreakingTransform) Forward(dst, x []float64) []float64 {
+	n := len(x)
+	dst = reuseAs(dst, n+1)
+	rest := 1.0
+	for k, v := range x {
+		z := logistic(v - math.Log(float64(n-k)))
+		dst[k] = rest * z
+		rest -= dst[k]
+	}
+	dst[n] = rest
+	return dst
+}
+
+// Inverse stores the inverse transformation of the point y in the simplex
+// in dst and returns the result.
+func (StickBreakingTransform) Inverse(dst, y []float64) []float64 {
+	n := len(y) - 1
+	if n < 1 {
+		panic(badInputLength)
+	}
+	dst = reuseAs(dst, n)
+	rest := 1.0
+	for k := range n {
+		z := y[k] / rest
+		dst[k] = math.Log(z) - math.Log1p(-z) + math.Log(float64(n-k))
+		rest -= y[k]
+	}
+	return dst
+}
+
+// LogDetJacobian returns the log of the determinant of the Jacobian of the
+// first n elements of the transformation, which is triangular with diagonal
+// elements (1 - \sum_{j<k} y_j) z_k (1 - z_k).
+func (StickBreakingTransform) LogDetJacobian(x []float64) float64 {
+	n := len(x)
+	var ldet float64
+	rest := 1.0
+	for k, v := range x {
+		u := v - math.Log(float64(n-k))
+		// log z = -softplus(-u), log(1-z) = -softplus(u).
+		ldet += math.Log(rest) - softplus(-u) - softplus(u)
+		rest -= rest * logistic(u)
+	}
+	return ldet
+}
+
+// logistic returns the logistic function 1/(1+exp(-x)).
+func logistic(x float64) float64 {
+	return 1 / (1 + math.Exp(-x))
+}
@@ -0,0 +1,228 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distmv
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+func TestTransforms(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	a := mat.NewDense(3, 3, []float64{
+		2, 1, 0,
+		-1, 3, 0.5,
+		0, 0.2, 1,
+	})
+	for _, test := range []struct {
+		name string
+		t    Transform
+		n    int
+	}{
+		{name: "affine", t: NewAffineTransform(a, []float64{1, -2, 0.5}), n: 3},
+		{name: "affine no shift", t: NewAffineTransform(a, nil), n: 3},
+		{name: "exp", t: ExpTransform{}, n: 4},
+		{name: "softplus", t: SoftplusTransform{}, n: 4},
+		{name: "stick-breaking", t: StickBreakingTransform{}, n: 1},
+		{name: "stick-breaking", t: StickBreakingTransform{}, n: 4},
+	} {
+		m := test.t.OutputDim(test.n)
+		if got := test.t.InputDim(m); got != test.n {
+			t.Errorf("unexpected input dimension for %s: got:%d want:%d", test.name, got, test.n)
+		}
+		for range 5 {
+			x := make([]float64, test.n)
+			for i := range x {
+				x[i] = 2 * rnd.NormFloat64()
+			}
+			y := test.t.Forward(nil, x)
+			if len(y) != m {
+				t.Fatalf("unexpected output length for %s: got:%d want:%d", test.name, len(y), m)
+			}
+			got := test.t.Inverse(nil, y)
+			if !floats.EqualApprox(got, x, 1e-10) {
+				t.Errorf("inverse does not invert forward for %s: got:%v want:%v", test.name, got, x)
+			}
+
+			// The Jacobian of the first n
+			// elements of the transformation.
+			jac := mat.NewDense(test.n, test.n, nil)
+			fd.Jacobian(jac, func(dst, x []float64) {
+				copy(dst, test.t.Forward(nil, x))
+			}, x, &fd.JacobianSettings{Formula: fd.Central})
+			var lu mat.LU
+			lu.Factorize(jac)
+			want, _ := lu.LogDet()
+			if got := test.t.LogDetJacobian(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-6, 1e-6) {
+				t.Errorf("unexpected log determinant of Jacobian for %s: got:%v want:%v", test.name, got, want)
+			}
+		}
+	}
+
+	// Points are transformed onto the simplex and
+	// the zero vector onto its centroid.
+	y := StickBreakingTransform{}.Forward(nil, make([]float64, 4))
+	if !floats.EqualApprox(y, []float64{0.2, 0.2, 0.2, 0.2, 0.2}, 1e-14) {
+		t.Errorf("unexpected transformation of zero: got:%v want:centroid", y)
+	}
+	y = StickBreakingTransform{}.Forward(nil, []float64{30, -30, 2})
+	if !scalar.EqualWithinAbs(floats.Sum(y), 1, 1e-14) || floats.Min(y) < 0 {
+		t.Errorf("transformation not in simplex: %v", y)
+	}
+
+	// Softplus is accurate for large arguments.
+	if got := (SoftplusTransform{}).Forward(nil, []float64{800, -800}); got[0] != 800 || got[1] != math.Exp(-800) {
+		t.Errorf("unexpected softplus of large arguments: %v", got)
+	}
+
+	if !panics(func() { NewAffineTransform(mat.NewDense(2, 3, nil), nil) }) {
+		t.Errorf("expected panic for non-square matrix")
+	}
+	if !panics(func() { NewAffineTransform(mat.NewDense(2, 2, nil), nil) }) {
+		t.Errorf("expected panic for singular matrix")
+	}
+	if !panics(func() { NewAffineTransform(a, []float64{1}) }) {
+		t.Errorf("expected panic for shift length mismatch")
+	}
+}
+
+func TestTransformedAffine(t *testing.T) {
+	t.Parallel()
+	// An affine transformation of a normal
+	// distribution is normal.
+	mu := []float64{1, -1}
+	sigma := mat.NewSymDense(2, []float64{2, 0.5, 0.5, 1})
+	base, ok := NewNormal(mu, sigma, rand.NewPCG(1, 1))
+	if !ok {
+		t.Fatal("bad test")
+	}
+	a := mat.NewDense(2, 2, []float64{1, 2, -0.5, 3})
+	b := []float64{3, 4}
+	d := NewTransformed(base, 2, NewAffineTransform(a, b))
+
+	var muY mat.VecDense
+	muY.MulVec(a, mat.NewVecDense(2, mu))
+	muY.AddVec(&muY, mat.NewVecDense(2, b))
+	var tmp mat.Dense
+	tmp.Mul(a, sigma)
+	var sigmaY mat.Dense
+	sigmaY.Mul(&tmp, a.T())
+	want, ok := NewNormal(muY.RawVector().Data, mat.NewSymDense(2, sigmaY.RawMatrix().Data), nil)
+	if !ok {
+		t.Fatal("bad test")
+	}
+	if d.Dim() != 2 {
+		t.Errorf("unexpected dimension: got:%d want:2", d.Dim())
+	}
+	for _, y := range [][]float64{{0, 0}, {3, 4}, {-2, 7}} {
+		if got, want := d.LogProb(y), want.LogProb(y); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+			t.Errorf("unexpected log probability at %v: got:%v want:%v", y, got, want)
+		}
+	}
+}
+
+func TestTransformedExp(t *testing.T) {
+	t.Parallel()
+	// The exponential of independent normal
+	// variables are independent log-normal.
+	mu := []float64{0.5, -1}
+	sd := []float64{0.3, 0.8}
+	sigma := mat.NewSymDense(2, []float64{sd[0] * sd[0], 0, 0, sd[1] * sd[1]})
+	base, ok := NewNormal(mu, sigma, rand.NewPCG(2, 2))
+	if !ok {
+		t.Fatal("bad test")
+	}
+	d := NewTransformed(base, 2, ExpTransform{})
+	for _, y := range [][]float64{{1, 1}, {0.5, 2}, {3, 0.1}} {
+		var want float64
+		for i, v := range y {
+			want += distuv.LogNormal{Mu: mu[i], Sigma: sd[i]}.LogProb(v)
+		}
+		if got := d.LogProb(y); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+			t.Errorf("unexpected log probability at %v: got:%v want:%v", y, got, want)
+		}
+	}
+
+	const n = 20000
+	mean := make([]float64, 2)
+	y := make([]float64, 2)
+	for range n {
+		d.Rand(y)
+		if y[0] <= 0 || y[1] <= 0 {
+			t.Fatalf("sample not in positive orthant: %v", y)
+		}
+		floats.AddScaled(mean, 1.0/n, y)
+	}
+	for i := range mean {
+		want := distuv.LogNormal{Mu: mu[i], Sigma: sd[i]}.Mean()
+		if !scalar.EqualWithinRel(mean[i], want, 0.02) {
+			t.Errorf("unexpected sample mean %d: got:%v want:%v", i, mean[i], want)
+		}
+	}
+}
+
+func TestTransformedStickBreaking(t *testing.T) {
+	t.Parallel()
+	// For one dimension the stick-breaking
+	// transformation of a normal variable has
+	// a logit-normal first element.
+	base, ok := NewNormal([]float64{0.3}, mat.NewSymDense(1, []float64{0.7}), rand.NewPCG(3, 3))
+	if !ok {
+		t.Fatal("bad test")
+	}
+	d := NewTransformed(base, 1, StickBreakingTransform{})
+	if d.Dim() != 2 {
+		t.Errorf("unexpected dimension: got:%d want:2", d.Dim())
+	}
+	norm := distuv.Normal{Mu: 0.3, Sigma: math.Sqrt(0.7)}
+	for _, p := range []float64{0.1, 0.5, 0.8} {
+		want := norm.LogProb(math.Log(p/(1-p))) - math.Log(p*(1-p))
+		if got := d.LogProb([]float64{p, 1 - p}); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+			t.Errorf("unexpected log probability at %v: got:%v want:%v", p, got, want)
+		}
+	}
+
+	// Samples in higher dimensions lie in the simplex.
+	base, ok = NewNormal(make([]float64, 3), eye(3), rand.NewPCG(4, 4))
+	if !ok {
+		t.Fatal("bad test")
+	}
+	d = NewTransformed(base, 3, StickBreakingTransform{})
+	for range 100 {
+		y := d.Rand(nil)
+		if len(y) != 4 || floats.Min(y) <= 0 || !scalar.EqualWithinAbs(floats.Sum(y), 1, 1e-14) {
+			t.Fatalf("sample not in simplex: %v", y)
+		}
+		if lp := d.LogProb(y); math.IsNaN(lp) || math.IsInf(lp, 0) {
+			t.Errorf("unexpected log probability at %v: %v", y, lp)
+		}
+	}
+
+	if !panics(func() { NewTransformed(base, 3, NewAffineTransform(eye(2), nil)) }) {
+		t.Errorf("expected panic for dimension mismatch")
+	}
+	if !panics(func() { NewTransformed(base, 0, ExpTransform{}) }) {
+		t.Errorf("expected panic for non-positive dimension")
+	}
+	if !panics(func() { d.LogProb(make([]float64, 3)) }) {
+		t.Errorf("expected panic for length mismatch")
+	}
+}
+
+func eye(n int) *mat.SymDense {
+	m := mat.NewSymDense(n, nil)
+	for i := range n {
+		m.SetSym(i, i, 1)
+	}
+	return m
+}
@@ -7,8 +7,10 @@ package distmv
 import (
 	"math"
 	"math/rand/v2"
+	"strconv"
 
 	"gonum.org/v1/gonum/spatial/r1"
+	"gonum.org/v1/gonum/stat/distuv"
 )
 
 // Uniform represents a multivariate uniform distribution.
@@ -198,3 +200,52 @@ func (u *Uniform) Quantile(dst, p []float64) []float64 {
 	}
 	return dst
 }
+
+// NumParameters returns the number of parameters of the distribution.
+func (u *Uniform) NumParameters() int {
+	return 2 * u.dim
+}
+
+// Parameters returns the parameters of the distribution, the lower and upper
+// bounds of each dimension named "Min[i]" and "Max[i]", with the bounds of
+// each dimension adjacent. If p is not nil, the parameters are stored in p,
+// which must have length NumParameters.
+func (u *Uniform) Parameters(p []distuv.Parameter) []distuv.Parameter {
+	v := make([]float64, 0, 2*u.dim)
+	for _, b := range u.bounds {
+		v = append(v, b.Min, b.Max)
+	}
+	return parametersOf(p, u.parameterNames(), v)
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+// SetParameters will panic if the upper bound of any dimension is less than
+// the lower bound.
+func (u *Uniform) SetParameters(p []distuv.Parameter) {
+	v := parameterValues(p, u.parameterNames())
+	bnds := make([]r1.Interval, u.dim)
+	for i := range bnds {
+		bnds[i] = r1.Interval{Min: v[2*i], Max: v[2*i+1]}
+	}
+	rnd := u.rnd
+	*u = *NewUniform(bnds, nil)
+	u.rnd = rnd
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// The bounds do not describe the requirement that the upper bound of each
+// dimension is not less than the lower bound. If b is not nil, the bounds
+// are stored in b, which must have length NumParameters.
+func (u *Uniform) ParameterBounds(b []distuv.ParameterBounds) []distuv.ParameterBounds {
+	return boundsOf(b, u.parameterNames(), func(int) distuv.ParameterBounds { return realLine })
+}
+
+func (u *Uniform) parameterNames() []string {
+	names := make([]string, 0, 2*u.dim)
+	for i := range u.dim {
+		k := strconv.Itoa(i)
+		names = append(names, "Min["+k+"]", "Max["+k+"]")
+	}
+	return names
+}
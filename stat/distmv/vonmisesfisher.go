@@ -0,0 +1,359 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distmv
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/mathext"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// VonMisesFisher implements the von Mises–Fisher distribution, a distribution
+// over the unit sphere S^(d-1) in ℝ^d with the probability density
+//
+//	p(x) = C_d(κ) exp(κ μᵀx)
+//	C_d(κ) = κ^(d/2-1) / ((2π)^(d/2) I_(d/2-1)(κ))
+//
+// with respect to the surface measure of the sphere, where μ is a unit vector,
+// κ ≥ 0 is the concentration and I_ν is the modified Bessel function of the
+// first kind. If κ is zero the distribution is uniform on the sphere.
+//
+// For more information see https://en.wikipedia.org/wiki/Von_Mises%E2%80%93Fisher_distribution.
+type VonMisesFisher struct {
+	mu    []float64
+	kappa float64
+	dim   int
+	src   rand.Source
+
+	logNorm float64
+}
+
+// NewVonMisesFisher creates a new von Mises–Fisher distribution with the
+// mean direction mu and concentration kappa. The mean direction is
+// normalized to unit length.
+//
+// NewVonMisesFisher panics if len(mu) < 2, if mu is the zero vector, or if
+// kappa is negative.
+func NewVonMisesFisher(mu []float64, kappa float64, src rand.Source) *VonMisesFisher {
+	if len(mu) < 2 {
+		panic("vonmisesfisher: dimension less than two")
+	}
+	if kappa < 0 {
+		panic("vonmisesfisher: negative concentration")
+	}
+	v := &VonMisesFisher{
+		mu:  make([]float64, len(mu)),
+		dim: len(mu),
+		src: src,
+	}
+	v.setMu(mu)
+	v.setKappa(kappa)
+	return v
+}
+
+func (v *VonMisesFisher) setMu(mu []float64) {
+	norm := floats.Norm(mu, 2)
+	if norm == 0 {
+		panic("vonmisesfisher: zero mean direction")
+	}
+	floats.ScaleTo(v.mu, 1/norm, mu)
+}
+
+func (v *VonMisesFisher) setKappa(kappa float64) {
+	v.kappa = kappa
+	d := float64(v.dim)
+	if kappa == 0 {
+		// The reciprocal of the surface area of the sphere,
+		// 2π^(d/2) / Γ(d/2).
+		lg, _ := math.Lgamma(d / 2)
+		v.logNorm = lg - math.Ln2 - d/2*math.Log(math.Pi)
+		return
+	}
+	nu := d/2 - 1
+	v.logNorm = nu*math.Log(kappa) - d/2*logTwoPi - logBesselI(nu, kappa)
+}
+
+// Concentration returns the concentration parameter κ of the distribution.
+func (v *VonMisesFisher) Concentration() float64 {
+	return v.kappa
+}
+
+// Dim returns the dimension of the distribution.
+func (v *VonMisesFisher) Dim() int {
+	return v.dim
+}
+
+// Entropy returns the differential entropy of the distribution
+// with respect to the surface measure of the sphere.
+func (v *VonMisesFisher) Entropy() float64 {
+	return -v.logNorm - v.kappa*v.meanResultantLength()
+}
+
+// Fit sets the parameters of the distribution to the maximum likelihood
+// estimates from the unit vectors in the rows of x with relative weights.
+// The mean direction is the direction of the weighted resultant vector, and
+// the concentration solves A_d(κ) = R where R is the mean resultant length
+// and A_d(κ) = I_(d/2)(κ) / I_(d/2-1)(κ).
+//
+// If weights is nil, then all the weights are 1. If weights is not nil, then
+// len(weights) must equal the number of rows of x. Fit panics if the number
+// of columns of x is not equal to the dimension of the distribution or if the
+// resultant vector is zero. If all of the samples are equal the concentration
+// is set to +Inf.
+func (v *VonMisesFisher) Fit(x mat.Matrix, weights []float64) {
+	r, c := x.Dims()
+	if c != v.dim {
+		panic(badSizeMismatch)
+	}
+	if weights != nil && len(weights) != r {
+		panic(badInputLength)
+	}
+	sum := make([]float64, v.dim)
+	var sumWeights float64
+	for i := 0; i < r; i++ {
+		w := 1.0
+		if weights != nil {
+			w = weights[i]
+		}
+		for j := range sum {
+			sum[j] += w * x.At(i, j)
+		}
+		sumWeights += w
+	}
+	norm := floats.Norm(sum, 2)
+	v.setMu(sum)
+	v.setKappa(vonMisesFisherConcentration(norm/sumWeights, v.dim))
+}
+
+// LogProb computes the log of the pdf of the point x.
+//
+// It does not check that ||x||_2 = 1.
+func (v *VonMisesFisher) LogProb(x []float64) float64 {
+	if len(x) != v.dim {
+		panic(badSizeMismatch)
+	}
+	return v.logNorm + v.kappa*floats.Dot(v.mu, x)
+}
+
+// Mean returns the mean of the probability distribution, A_d(κ) μ, which lies
+// inside the unit ball.
+//
+// If dst is not nil, the mean will be stored in-place into dst and returned,
+// otherwise a new slice will be allocated first. If dst is not nil, it must
+// have length equal to the dimension of the distribution.
+func (v *VonMisesFisher) Mean(dst []float64) []float64 {
+	dst = reuseAs(dst, v.dim)
+	floats.ScaleTo(dst, v.meanResultantLength(), v.mu)
+	return dst
+}
+
+// MeanDirection returns the mean direction μ of the distribution.
+//
+// If dst is not nil, the mean direction will be stored in-place into dst and
+// returned, otherwise a new slice will be allocated first. If dst is not nil,
+// it must have length equal to the dimension of the distribution.
+func (v *VonMisesFisher) MeanDirection(dst []float64) []float64 {
+	dst = reuseAs(dst, v.dim)
+	copy(dst, v.mu)
+	return dst
+}
+
+// meanResultantLength returns A_d(κ) = I_(d/2)(κ) / I_(d/2-1)(κ).
+func (v *VonMisesFisher) meanResultantLength() float64 {
+	return besselIRatio(float64(v.dim)/2-1, v.kappa)
+}
+
+// Prob computes the value of the probability density function at x.
+func (v *VonMisesFisher) Prob(x []float64) float64 {
+	return math.Exp(v.LogProb(x))
+}
+
+// Rand generates a random sample according to the distribution.
+//
+// If dst is not nil, the sample will be stored in-place into dst and returned,
+// otherwise a new slice will be allocated first. If dst is not nil, it must
+// have length equal to the dimension of the distribution.
+func (v *VonMisesFisher) Rand(dst []float64) []float64 {
+	dst = reuseAs(dst, v.dim)
+	rnd := rand.Float64
+	nrm := rand.NormFloat64
+	if v.src != nil {
+		rng := rand.New(v.src)
+		rnd = rng.Float64
+		nrm = rng.NormFloat64
+	}
+
+	// Sample the component along e_1 using the rejection sampler in
+	//  Wood, A. T. A. "Simulation of the von Mises Fisher distribution",
+	//  Communications in Statistics - Simulation and Computation
+	//  23(1):157-164 (1994). doi:10.1080/03610919408813161
+	// and the remaining components uniformly on the orthogonal sphere.
+	m := float64(v.dim - 1)
+	var w float64
+	if v.kappa == 0 {
+		w = 2*distuv.Beta{Alpha: m / 2, Beta: m / 2, Src: v.src}.Rand() - 1
+	} else {
+		b := m / (2*v.kappa + math.Sqrt(4*v.kappa*v.kappa+m*m))
+		x0 := (1 - b) / (1 + b)
+		c := v.kappa*x0 + m*math.Log(1-x0*x0)
+		beta := distuv.Beta{Alpha: m / 2, Beta: m / 2, Src: v.src}
+		for {
+			z := beta.Rand()
+			w = (1 - (1+b)*z) / (1 - (1-b)*z)
+			if v.kappa*w+m*math.Log(1-x0*w)-c >= math.Log(rnd()) {
+				break
+			}
+		}
+	}
+	tail := dst[1:]
+	for i := range tail {
+		tail[i] = nrm()
+	}
+	floats.Scale(math.Sqrt(math.Max(0, 1-w*w))/floats.Norm(tail, 2), tail)
+	dst[0] = w
+
+	// Reflect e_1 onto μ with the Householder reflection
+	// H = I - 2uuᵀ/uᵀu with u = e_1 - μ.
+	u0 := 1 - v.mu[0]
+	uu := u0*u0 + floats.Dot(v.mu[1:], v.mu[1:])
+	if uu == 0 {
+		return dst
+	}
+	s := 2 * (u0*dst[0] - floats.Dot(v.mu[1:], tail)) / uu
+	dst[0] -= s * u0
+	floats.AddScaled(tail, s, v.mu[1:])
+	return dst
+}
+
+// vonMisesFisherConcentration returns the concentration of the von
+// Mises–Fisher distribution on S^(d-1) with mean resultant length r,
+// the solution of A_d(κ) = r. The initial approximation from
+//
+//	Banerjee, A. et al. "Clustering on the unit hypersphere using von
+//	Mises-Fisher distributions", Journal of Machine Learning Research
+//	6:1345-1382 (2005).
+//
+// is refined using Newton's method.
+func vonMisesFisherConcentration(r float64, dim int) float64 {
+	switch {
+	case r <= 0:
+		return 0
+	case r >= 1:
+		return math.Inf(1)
+	}
+	d := float64(dim)
+	nu := d/2 - 1
+	kappa := r * (d - r*r) / (1 - r*r)
+	for i := 0; i < 100; i++ {
+		a := besselIRatio(nu, kappa)
+		// The derivative of A_d(κ) is 1 - A_d(κ)² - (d-1)/κ A_d(κ).
+		step := (a - r) / (1 - a*a - (d-1)/kappa*a)
+		kappa = math.Max(kappa/2, kappa-step)
+		if math.Abs(step) <= 1e-14*kappa {
+			break
+		}
+	}
+	return kappa
+}
+
+// besselIRatio returns I_(ν+1)(x) / I_ν(x).
+func besselIRatio(nu, x float64) float64 {
+	if x == 0 {
+		return 0
+	}
+	return math.Exp(logBesselI(nu+1, x) - logBesselI(nu, x))
+}
+
+// logBesselI returns log(I_ν(x)) for ν ≥ 0 and x > 0, avoiding the overflow
+// and underflow of I_ν(x) when x or ν is large.
+func logBesselI(nu, x float64) float64 {
+	switch {
+	case x >= 500 && nu < 5:
+		// Use the large argument expansion
+		//  I_ν(x) ~ e^x / sqrt(2πx) Σ_k (-1)^k a_k(ν) / x^k
+		// with a_k(ν) = Π_{j=1}^{k} (4ν² - (2j-1)²) / (k! 8^k).
+		mu := 4 * nu * nu
+		var sum, term float64 = 1, 1
+		for k := 1.0; k <= 10; k++ {
+			term *= -(mu - (2*k-1)*(2*k-1)) / (8 * k * x)
+			sum += term
+		}
+		return x - 0.5*math.Log(2*math.Pi*x) + math.Log(sum)
+	case x >= 500 || nu >= 50:
+		// Use the uniform asymptotic expansion for large order
+		//  I_ν(νz) ~ e^(νη) / (sqrt(2πν) (1+z²)^(1/4)) Σ_k u_k(t) / ν^k
+		// with t = 1/sqrt(1+z²) and η = sqrt(1+z²) + log(z/(1+sqrt(1+z²))).
+		// See Abramowitz and Stegun, 9.7.7.
+		z := x / nu
+		s := math.Hypot(1, z)
+		t := 1 / s
+		eta := s + math.Log(z/(1+s))
+		t2 := t * t
+		u1 := t * (3 - 5*t2) / 24
+		u2 := t2 * (81 + t2*(-462+t2*385)) / 1152
+		u3 := t * t2 * (30375 + t2*(-369603+t2*(765765-t2*425425))) / 414720
+		u4 := t2 * t2 * (4465125 + t2*(-94121676+t2*(349922430+t2*(-446185740+t2*185910725)))) / 39813120
+		u5 := t * t2 * t2 * (1519035525 + t2*(-49286948607+t2*(284499769554+t2*(-614135872350+t2*(566098157625-t2*188699385875))))) / 6688604160
+		sum := 1 + (u1+(u2+(u3+(u4+u5/nu)/nu)/nu)/nu)/nu
+		return nu*eta - 0.5*math.Log(2*math.Pi*nu) - 0.5*math.Log(s) + math.Log(sum)
+	}
+	i := mathext.BesselI(nu, x)
+	if i < 0x1p-1022 {
+		// I_ν(x) has underflowed, so x is small and the series
+		//  I_ν(x) = (x/2)^ν Σ_k (x²/4)^k / (k! Γ(k+ν+1))
+		// converges rapidly.
+		q := x * x / 4
+		var sum, term float64 = 1, 1
+		for k := 1.0; term > 1e-17*sum; k++ {
+			term *= q / (k * (k + nu))
+			sum += term
+		}
+		lg, _ := math.Lgamma(nu + 1)
+		return nu*math.Log(x/2) - lg + math.Log(sum)
+	}
+	return math.Log(i)
+}
+
+// NumParameters returns the number of parameters of the distribution.
+func (v *VonMisesFisher) NumParameters() int {
+	return v.dim + 1
+}
+
+// Parameters returns the parameters of the distribution, the elements of the
+// mean direction named "Mu[i]" followed by the concentration named "Kappa".
+// If p is not nil, the parameters are stored in p, which must have length
+// NumParameters.
+func (v *VonMisesFisher) Parameters(p []distuv.Parameter) []distuv.Parameter {
+	return parametersOf(p, v.parameterNames(), append(append(make([]float64, 0, v.dim+1), v.mu...), v.kappa))
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order. The
+// mean direction is normalized to unit length. SetParameters will panic if
+// the mean direction is the zero vector or the concentration is negative.
+func (v *VonMisesFisher) SetParameters(p []distuv.Parameter) {
+	vals := parameterValues(p, v.parameterNames())
+	*v = *NewVonMisesFisher(vals[:v.dim], vals[v.dim], v.src)
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (v *VonMisesFisher) ParameterBounds(b []distuv.ParameterBounds) []distuv.ParameterBounds {
+	return boundsOf(b, v.parameterNames(), func(i int) distuv.ParameterBounds {
+		if i == v.dim {
+			return nonNeg
+		}
+		return realLine
+	})
+}
+
+func (v *VonMisesFisher) parameterNames() []string {
+	return append(vectorNames(nil, "Mu", v.dim), "Kappa")
+}
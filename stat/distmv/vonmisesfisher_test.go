@@ -0,0 +1,129 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distmv
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/mathext"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+func TestLogBesselI(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct{ nu, x float64 }{
+		{0, 500}, {0, 650}, {0.5, 600}, {1.5, 500}, {4, 690},
+		{5, 500}, {10, 600}, {30, 700},
+		{50, 1}, {60, 50}, {60, 300}, {100, 200}, {150, 20},
+	} {
+		if test.nu < 50 && test.x < 500 {
+			t.Fatalf("test case %v does not use an expansion", test)
+		}
+		want := math.Log(mathext.BesselI(test.nu, test.x))
+		got := logBesselI(test.nu, test.x)
+		if !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+			t.Errorf("unexpected log I_%v(%v): got %v, want %v", test.nu, test.x, got, want)
+		}
+	}
+
+	// Values where I_ν(x) underflows, computed with 50 digit arithmetic.
+	for _, test := range []struct{ nu, x, want float64 }{
+		{nu: 40, x: 1e-8, want: -874.8737566952498},
+		{nu: 49, x: 1e-5, want: -742.6633035773229},
+	} {
+		got := logBesselI(test.nu, test.x)
+		if !scalar.EqualWithinRel(got, test.want, 1e-14) {
+			t.Errorf("unexpected log I_%v(%v): got %v, want %v", test.nu, test.x, got, test.want)
+		}
+	}
+}
+
+func TestVonMisesFisherProb(t *testing.T) {
+	t.Parallel()
+	// On the circle the distribution is the von Mises distribution.
+	for _, kappa := range []float64{0, 0.5, 3, 40, 1000} {
+		v := NewVonMisesFisher([]float64{math.Cos(1), math.Sin(1)}, kappa, nil)
+		want := distuv.VonMises{Mu: 1, Kappa: kappa}
+		for _, theta := range []float64{-2, 0, 1, 2.5} {
+			got := v.LogProb([]float64{math.Cos(theta), math.Sin(theta)})
+			if !scalar.EqualWithinAbsOrRel(got, want.LogProb(theta), 1e-12, 1e-12) {
+				t.Errorf("unexpected LogProb for κ=%v at %v: got %v, want %v", kappa, theta, got, want.LogProb(theta))
+			}
+		}
+	}
+
+	// On the 2-sphere the normalizing constant is κ/(4π sinh κ).
+	mu := []float64{1, 2, 2}
+	for _, kappa := range []float64{0, 1e-3, 0.5, 3, 40, 600} {
+		v := NewVonMisesFisher(mu, kappa, nil)
+		x := []float64{0, 0.6, 0.8}
+		dot := (2*0.6 + 2*0.8) / 3
+		want := -math.Log(4 * math.Pi)
+		if kappa != 0 {
+			want = math.Log(kappa/(2*math.Pi)) - kappa - math.Log1p(-math.Exp(-2*kappa)) + kappa*dot
+		}
+		if got := v.LogProb(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+			t.Errorf("unexpected LogProb for κ=%v: got %v, want %v", kappa, got, want)
+		}
+	}
+}
+
+func TestVonMisesFisher(t *testing.T) {
+	t.Parallel()
+	src := rand.NewPCG(1, 1)
+	for cas, test := range []struct {
+		mu    []float64
+		kappa float64
+	}{
+		{mu: []float64{0, 1}, kappa: 2},
+		{mu: []float64{1, 0, 0}, kappa: 0},
+		{mu: []float64{1, -2, 2}, kappa: 5},
+		{mu: []float64{1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, kappa: 30},
+		{mu: []float64{-1, 0.5, 0, 0, 2}, kappa: 500},
+	} {
+		v := NewVonMisesFisher(test.mu, test.kappa, src)
+		const n = 20000
+		x := mat.NewDense(n, v.Dim(), nil)
+		mean := make([]float64, v.Dim())
+		for i := 0; i < n; i++ {
+			row := x.RawRowView(i)
+			v.Rand(row)
+			if norm := floats.Norm(row, 2); math.Abs(norm-1) > 1e-12 {
+				t.Errorf("sample not on the sphere for case %d: norm %v", cas, norm)
+			}
+			floats.AddScaled(mean, 1.0/n, row)
+		}
+		if !floats.EqualApprox(mean, v.Mean(nil), 2e-2) {
+			t.Errorf("mean mismatch for case %d: got %v, want %v", cas, mean, v.Mean(nil))
+		}
+
+		start := make([]float64, v.Dim())
+		start[0] = 1
+		fit := NewVonMisesFisher(start, 1, nil)
+		fit.Fit(x, nil)
+		if !scalar.EqualWithinAbsOrRel(fit.Concentration(), test.kappa, 0.1, 3e-2) {
+			t.Errorf("unexpected concentration fit for case %d: got %v, want %v", cas, fit.Concentration(), test.kappa)
+		}
+		if test.kappa != 0 && !floats.EqualApprox(fit.MeanDirection(nil), v.MeanDirection(nil), 2e-2) {
+			t.Errorf("unexpected mean direction fit for case %d: got %v, want %v", cas, fit.MeanDirection(nil), v.MeanDirection(nil))
+		}
+	}
+
+	// Weights must be equivalent to repeated samples.
+	x := mat.NewDense(3, 3, []float64{1, 0, 0, 0, 1, 0, 0, 0.6, 0.8})
+	rep := mat.NewDense(6, 3, []float64{1, 0, 0, 0, 1, 0, 0, 1, 0, 0, 0.6, 0.8, 0, 0.6, 0.8, 0, 0.6, 0.8})
+	a := NewVonMisesFisher([]float64{1, 0, 0}, 1, nil)
+	a.Fit(x, []float64{1, 2, 3})
+	b := NewVonMisesFisher([]float64{1, 0, 0}, 1, nil)
+	b.Fit(rep, nil)
+	if !scalar.EqualWithinRel(a.Concentration(), b.Concentration(), 1e-12) || !floats.EqualApprox(a.MeanDirection(nil), b.MeanDirection(nil), 1e-14) {
+		t.Errorf("mismatch between weighted and repeated fit")
+	}
+}
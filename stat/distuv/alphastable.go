@@ -69,6 +69,29 @@ func (a AlphaStable) NumParameters() int {
 	return 4
 }
 
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (a AlphaStable) Parameters(p []Parameter) []Parameter {
+	return parametersOf(p, "alphastable", []string{"Alpha", "Beta", "C", "Mu"}, a.Alpha, a.Beta, a.C, a.Mu)
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (a *AlphaStable) SetParameters(p []Parameter) {
+	checkParameterNames(p, "alphastable", "Alpha", "Beta", "C", "Mu")
+	a.Alpha = p[0].Value
+	a.Beta = p[1].Value
+	a.C = p[2].Value
+	a.Mu = p[3].Value
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (AlphaStable) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "alphastable", []string{"Alpha", "Beta", "C", "Mu"}, ParameterBounds{Min: 0, Max: 2, OpenMin: true}, ParameterBounds{Min: -1, Max: 1}, positive, realLine)
+}
+
 // Rand returns a random sample drawn from the distribution.
 func (a AlphaStable) Rand() float64 {
 	// From https://en.wikipedia.org/wiki/Stable_distribution#Simulation_of_stable_variables
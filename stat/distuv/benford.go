@@ -0,0 +1,144 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+// Benford implements Benford's distribution, the distribution of the
+// leading digit of numbers spread uniformly over many orders of magnitude,
+// such as the values of many naturally occurring data sets. The support of
+// the distribution is the non-zero digits 1, ..., b-1 of the base b.
+// Benford's distribution has density function:
+//
+//	f(d) = log_b(1 + 1/d)
+//
+// For more information, see https://en.wikipedia.org/wiki/Benford%27s_law.
+type Benford struct {
+	// Base is the base of the number system.
+	// Base must be an integer greater than 1.
+	Base float64
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (b Benford) CDF(x float64) float64 {
+	switch {
+	case x < 1:
+		return 0
+	case x >= b.Base-1:
+		return 1
+	}
+	return math.Log(math.Floor(x)+1) / math.Log(b.Base)
+}
+
+// Entropy returns the entropy of the distribution.
+func (b Benford) Entropy() float64 {
+	var e float64
+	for d := 1.0; d < b.Base; d++ {
+		p := b.Prob(d)
+		e -= p * math.Log(p)
+	}
+	return e
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (b Benford) LogProb(x float64) float64 {
+	if x < 1 || b.Base-1 < x || math.Floor(x) != x {
+		return math.Inf(-1)
+	}
+	return math.Log(math.Log1p(1/x) / math.Log(b.Base))
+}
+
+// Mean returns the mean of the probability distribution.
+func (b Benford) Mean() float64 {
+	var m float64
+	for d := 1.0; d < b.Base; d++ {
+		m += d * b.Prob(d)
+	}
+	return m
+}
+
+// Mode returns the mode of the distribution.
+func (Benford) Mode() float64 {
+	return 1
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (Benford) NumParameters() int {
+	return 1
+}
+
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (b Benford) Parameters(p []Parameter) []Parameter {
+	return parametersOf(p, "benford", []string{"Base"}, b.Base)
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (b *Benford) SetParameters(p []Parameter) {
+	checkParameterNames(p, "benford", "Base")
+	b.Base = p[0].Value
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If bnds is not nil, the bounds are stored in bnds, which must have length
+// NumParameters.
+func (Benford) ParameterBounds(bnds []ParameterBounds) []ParameterBounds {
+	return boundsOf(bnds, "benford", []string{"Base"}, ParameterBounds{Min: 2, Max: math.Inf(1), OpenMax: true, Integer: true})
+}
+
+// Prob computes the value of the probability density function at x.
+func (b Benford) Prob(x float64) float64 {
+	return math.Exp(b.LogProb(x))
+}
+
+// Quantile returns the minimum value of x from amongst all those values whose CDF value exceeds or equals p.
+func (b Benford) Quantile(p float64) float64 {
+	if p < 0 || 1 < p {
+		panic(badPercentile)
+	}
+	d := math.Ceil(math.Pow(b.Base, p) - 1)
+	// Correct for rounding in the power.
+	for d > 1 && b.CDF(d-1) >= p {
+		d--
+	}
+	for d < b.Base-1 && b.CDF(d) < p {
+		d++
+	}
+	return math.Max(d, 1)
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (b Benford) Rand() float64 {
+	d := math.Floor(math.Pow(b.Base, uniform(b.Src)))
+	return math.Min(d, b.Base-1)
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (b Benford) StdDev() float64 {
+	return math.Sqrt(b.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (b Benford) Survival(x float64) float64 {
+	return 1 - b.CDF(x)
+}
+
+// Variance returns the variance of the probability distribution.
+func (b Benford) Variance() float64 {
+	var m, m2 float64
+	for d := 1.0; d < b.Base; d++ {
+		p := b.Prob(d)
+		m += d * p
+		m2 += d * d * p
+	}
+	return m2 - m*m
+}
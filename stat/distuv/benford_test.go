@@ -0,0 +1,48 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestBenford(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewPCG(1, 1))
+	for i, d := range []Benford{
+		{Base: 10, Src: src},
+		{Base: 2, Src: src},
+		{Base: 16, Src: src},
+	} {
+		testCountDist(t, i, d)
+		if d.Mode() != 1 {
+			t.Errorf("unexpected mode for case %d: %v", i, d.Mode())
+		}
+		for k := 1.0; k < d.Base; k++ {
+			if q := d.Quantile(d.CDF(k)); q != k {
+				t.Errorf("unexpected quantile of CDF for case %d at %v: got %v", i, k, q)
+			}
+		}
+		if d.Quantile(0) != 1 || d.Quantile(1) != d.Base-1 {
+			t.Errorf("unexpected extreme quantiles for case %d", i)
+		}
+	}
+
+	// The probability of a leading digit of one in base 10.
+	d := Benford{Base: 10}
+	if !scalar.EqualWithinAbsOrRel(d.Prob(1), math.Log10(2), 1e-15, 1e-15) {
+		t.Errorf("unexpected probability of 1: got %v, want %v", d.Prob(1), math.Log10(2))
+	}
+	if !scalar.EqualWithinAbsOrRel(d.Mean(), 3.4402369671232065, 1e-14, 1e-14) {
+		t.Errorf("unexpected mean: got %v", d.Mean())
+	}
+	if !panics(func() { d.Quantile(1.5) }) {
+		t.Error("expected panic for percentile out of bounds")
+	}
+}
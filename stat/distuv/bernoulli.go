@@ -77,6 +77,26 @@ func (Bernoulli) NumParameters() int {
 	return 1
 }
 
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (b Bernoulli) Parameters(p []Parameter) []Parameter {
+	return parametersOf(p, "bernoulli", []string{"P"}, b.P)
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (b *Bernoulli) SetParameters(p []Parameter) {
+	checkParameterNames(p, "bernoulli", "P")
+	b.P = p[0].Value
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (Bernoulli) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "bernoulli", []string{"P"}, unit)
+}
+
 // Prob computes the value of the probability distribution at x.
 func (b Bernoulli) Prob(x float64) float64 {
 	if x == 0 {
@@ -9,6 +9,7 @@ import (
 	"math/rand/v2"
 
 	"gonum.org/v1/gonum/mathext"
+	"gonum.org/v1/gonum/mathext/bigmath"
 )
 
 // Beta implements the Beta distribution, a two-parameter continuous distribution
@@ -57,6 +58,24 @@ func (b Beta) ExKurtosis() float64 {
 	return num / den
 }
 
+// LogCDF returns the log of the cumulative distribution function at x.
+func (b Beta) LogCDF(x float64) float64 {
+	switch {
+	case x <= 0:
+		return math.Inf(-1)
+	case x >= 1:
+		return 0
+	}
+	p := mathext.RegIncBeta(b.Alpha, b.Beta, x)
+	switch {
+	case p > 0.5:
+		return math.Log1p(-mathext.RegIncBeta(b.Beta, b.Alpha, 1-x))
+	case p >= minTail:
+		return math.Log(p)
+	}
+	return bigmath.LogRegIncBeta(b.Alpha, b.Beta, x)
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (b Beta) LogProb(x float64) float64 {
@@ -82,6 +101,24 @@ func (b Beta) LogProb(x float64) float64 {
 	return lab - la - lb + lx + l1mx
 }
 
+// LogSurvival returns the log of the survival function (complementary CDF) at x.
+func (b Beta) LogSurvival(x float64) float64 {
+	switch {
+	case x <= 0:
+		return 0
+	case x >= 1:
+		return math.Inf(-1)
+	}
+	q := mathext.RegIncBeta(b.Beta, b.Alpha, 1-x)
+	switch {
+	case q > 0.5:
+		return math.Log1p(-mathext.RegIncBeta(b.Alpha, b.Beta, x))
+	case q >= minTail:
+		return math.Log(q)
+	}
+	return bigmath.LogRegIncBetaComp(b.Alpha, b.Beta, x)
+}
+
 // Mean returns the mean of the probability distribution.
 func (b Beta) Mean() float64 {
 	return b.Alpha / (b.Alpha + b.Beta)
@@ -109,6 +146,27 @@ func (b Beta) NumParameters() int {
 	return 2
 }
 
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (b Beta) Parameters(p []Parameter) []Parameter {
+	return parametersOf(p, "beta", []string{"Alpha", "Beta"}, b.Alpha, b.Beta)
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (b *Beta) SetParameters(p []Parameter) {
+	checkParameterNames(p, "beta", "Alpha", "Beta")
+	b.Alpha = p[0].Value
+	b.Beta = p[1].Value
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (Beta) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "beta", []string{"Alpha", "Beta"}, positive, positive)
+}
+
 // Prob computes the value of the probability density function at x.
 func (b Beta) Prob(x float64) float64 {
 	return math.Exp(b.LogProb(x))
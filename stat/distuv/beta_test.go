@@ -171,3 +171,29 @@ func TestBetaIssue1377(t *testing.T) {
 		t.Errorf("NaN PDF at x == 1 for Alpha > 1 and Beta == 1")
 	}
 }
+
+func TestBetaLogCDFSurvival(t *testing.T) {
+	t.Parallel()
+	xs := []float64{0.01, 0.1, 0.3, 0.5, 0.8, 0.99}
+	for i, b := range []Beta{
+		{Alpha: 0.5, Beta: 0.5},
+		{Alpha: 2, Beta: 5},
+		{Alpha: 10, Beta: 3},
+	} {
+		checkLogCDFSurvival(t, i, xs, b, 1e-12)
+	}
+
+	for _, test := range []struct {
+		name      string
+		got, want float64
+	}{
+		// The CDF with β = 1 is x^α.
+		{name: "LogCDF", got: Beta{Alpha: 100, Beta: 1}.LogCDF(1e-5), want: 100 * math.Log(1e-5)},
+		// The survival function with α = 1 is (1-x)^β.
+		{name: "LogSurvival", got: Beta{Alpha: 1, Beta: 50}.LogSurvival(1 - 1e-8), want: 50 * math.Log1p(-(1 - 1e-8))},
+	} {
+		if !scalar.EqualWithinRel(test.got, test.want, 1e-12) {
+			t.Errorf("unexpected %s: got:%v want:%v", test.name, test.got, test.want)
+		}
+	}
+}
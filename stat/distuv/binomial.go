@@ -67,6 +67,27 @@ func (Binomial) NumParameters() int {
 	return 2
 }
 
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (b Binomial) Parameters(p []Parameter) []Parameter {
+	return parametersOf(p, "binomial", []string{"N", "P"}, b.N, b.P)
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (b *Binomial) SetParameters(p []Parameter) {
+	checkParameterNames(p, "binomial", "N", "P")
+	b.N = p[0].Value
+	b.P = p[1].Value
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (Binomial) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "binomial", []string{"N", "P"}, ParameterBounds{Min: 0, Max: math.Inf(1), OpenMin: true, OpenMax: true, Integer: true}, unit)
+}
+
 // Prob computes the value of the probability density function at x.
 func (b Binomial) Prob(x float64) float64 {
 	return math.Exp(b.LogProb(x))
@@ -79,6 +79,26 @@ func (c Chi) NumParameters() int {
 	return 1
 }
 
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (c Chi) Parameters(p []Parameter) []Parameter {
+	return parametersOf(p, "chi", []string{"K"}, c.K)
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (c *Chi) SetParameters(p []Parameter) {
+	checkParameterNames(p, "chi", "K")
+	c.K = p[0].Value
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (Chi) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "chi", []string{"K"}, positive)
+}
+
 // Prob computes the value of the probability density function at x.
 func (c Chi) Prob(x float64) float64 {
 	return math.Exp(c.LogProb(x))
@@ -39,6 +39,14 @@ func (c ChiSquared) ExKurtosis() float64 {
 	return 12 / c.K
 }
 
+// LogCDF returns the log of the cumulative distribution function at x.
+func (c ChiSquared) LogCDF(x float64) float64 {
+	if x <= 0 {
+		return math.Inf(-1)
+	}
+	return logGammaIncReg(0.5*c.K, 0.5*x)
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (c ChiSquared) LogProb(x float64) float64 {
@@ -49,6 +57,14 @@ func (c ChiSquared) LogProb(x float64) float64 {
 	return (c.K/2-1)*math.Log(x) - x/2 - (c.K/2)*math.Ln2 - lg
 }
 
+// LogSurvival returns the log of the survival function (complementary CDF) at x.
+func (c ChiSquared) LogSurvival(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return logGammaIncRegComp(0.5*c.K, 0.5*x)
+}
+
 // Mean returns the mean of the probability distribution.
 func (c ChiSquared) Mean() float64 {
 	return c.K
@@ -64,6 +80,26 @@ func (c ChiSquared) NumParameters() int {
 	return 1
 }
 
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (c ChiSquared) Parameters(p []Parameter) []Parameter {
+	return parametersOf(p, "chisquared", []string{"K"}, c.K)
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (c *ChiSquared) SetParameters(p []Parameter) {
+	checkParameterNames(p, "chisquared", "K")
+	c.K = p[0].Value
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (ChiSquared) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "chisquared", []string{"K"}, positive)
+}
+
 // Prob computes the value of the probability density function at x.
 func (c ChiSquared) Prob(x float64) float64 {
 	return math.Exp(c.LogProb(x))
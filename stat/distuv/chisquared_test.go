@@ -94,3 +94,21 @@ func testChiSquared(t *testing.T, c ChiSquared, i int) {
 		t.Errorf("Survival is not 1 for negative argument. Got %v", survival)
 	}
 }
+
+func TestChiSquaredLogCDFSurvival(t *testing.T) {
+	t.Parallel()
+	xs := []float64{0.05, 0.5, 1, 2, 5, 12}
+	for i, c := range []ChiSquared{
+		{K: 1},
+		{K: 2},
+		{K: 7.5},
+	} {
+		checkLogCDFSurvival(t, i, xs, c, 1e-12)
+	}
+
+	// The survival function with two degrees of freedom is e^{-x/2}.
+	const x = 3000
+	if got, want := (ChiSquared{K: 2}).LogSurvival(x), -x/2.0; !scalar.EqualWithinRel(got, want, 1e-12) {
+		t.Errorf("unexpected LogSurvival(%v): got:%v want:%v", x, got, want)
+	}
+}
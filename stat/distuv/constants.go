@@ -26,3 +26,8 @@ const (
 const (
 	panicNameMismatch = "parameter name mismatch"
 )
+
+// minTail is the smallest tail probability for which the log-probability
+// methods use the float64 special functions. Smaller probabilities are
+// computed in arbitrary precision so that far tails do not underflow.
+const minTail = 1e-280
@@ -165,6 +165,31 @@ func checkQuantileCDFSurvival(t *testing.T, cas int, xs []float64, c cumulanter,
 	}
 }
 
+type logCumulanter interface {
+	CDF(x float64) float64
+	Survival(x float64) float64
+	LogCDF(x float64) float64
+	LogSurvival(x float64) float64
+}
+
+// checkLogCDFSurvival checks that LogCDF and LogSurvival agree with CDF and
+// Survival at points where the latter are not small.
+func checkLogCDFSurvival(t *testing.T, cas int, xs []float64, c logCumulanter, tol float64) {
+	t.Helper()
+	for _, x := range xs {
+		if p := c.CDF(x); p > 1e-3 {
+			if got, want := c.LogCDF(x), math.Log(p); !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+				t.Errorf("LogCDF mismatch case %v at %v: want: %v, got: %v", cas, x, want, got)
+			}
+		}
+		if s := c.Survival(x); s > 1e-3 {
+			if got, want := c.LogSurvival(x), math.Log(s); !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+				t.Errorf("LogSurvival mismatch case %v at %v: want: %v, got: %v", cas, x, want, got)
+			}
+		}
+	}
+}
+
 // checkProbContinuous checks that the PDF is consistent with LogPDF
 // and integrates to 1 from the lower to upper bound.
 func checkProbContinuous(t *testing.T, cas int, x []float64, lower float64, upper float64, p probLogprober, tol float64) {
@@ -105,6 +105,13 @@ func (Exponential) NumParameters() int {
 	return 1
 }
 
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (Exponential) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "exponential", []string{"Rate"}, positive)
+}
+
 // NumSuffStat returns the number of sufficient statistics for the distribution.
 func (Exponential) NumSuffStat() int {
 	return 1
@@ -236,8 +243,9 @@ func (e Exponential) Survival(x float64) float64 {
 	return math.Exp(-e.Rate * x)
 }
 
-// setParameters modifies the parameters of the distribution.
-func (e *Exponential) setParameters(p []Parameter) {
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (e *Exponential) SetParameters(p []Parameter) {
 	if len(p) != e.NumParameters() {
 		panic("exponential: incorrect number of parameters to set")
 	}
@@ -252,8 +260,9 @@ func (e Exponential) Variance() float64 {
 	return 1 / (e.Rate * e.Rate)
 }
 
-// parameters returns the parameters of the distribution.
-func (e Exponential) parameters(p []Parameter) []Parameter {
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (e Exponential) Parameters(p []Parameter) []Parameter {
 	nParam := e.NumParameters()
 	if p == nil {
 		p = make([]Parameter, nParam)
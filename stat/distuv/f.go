@@ -73,6 +73,27 @@ func (f F) NumParameters() int {
 	return 2
 }
 
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (f F) Parameters(p []Parameter) []Parameter {
+	return parametersOf(p, "f", []string{"D1", "D2"}, f.D1, f.D2)
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (f *F) SetParameters(p []Parameter) {
+	checkParameterNames(p, "f", "D1", "D2")
+	f.D1 = p[0].Value
+	f.D2 = p[1].Value
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (F) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "f", []string{"D1", "D2"}, positive, positive)
+}
+
 // Prob computes the value of the probability density function at x.
 func (f F) Prob(x float64) float64 {
 	return math.Exp(f.LogProb(x))
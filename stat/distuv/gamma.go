@@ -9,6 +9,7 @@ import (
 	"math/rand/v2"
 
 	"gonum.org/v1/gonum/mathext"
+	"gonum.org/v1/gonum/mathext/bigmath"
 )
 
 // Gamma implements the Gamma distribution, a two-parameter continuous distribution
@@ -43,6 +44,14 @@ func (g Gamma) ExKurtosis() float64 {
 	return 6 / g.Alpha
 }
 
+// LogCDF returns the log of the cumulative distribution function at x.
+func (g Gamma) LogCDF(x float64) float64 {
+	if x <= 0 {
+		return math.Inf(-1)
+	}
+	return logGammaIncReg(g.Alpha, g.Beta*x)
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (g Gamma) LogProb(x float64) float64 {
@@ -58,6 +67,14 @@ func (g Gamma) LogProb(x float64) float64 {
 	return a*math.Log(b) - lg + (a-1)*math.Log(x) - b*x
 }
 
+// LogSurvival returns the log of the survival function (complementary CDF) at x.
+func (g Gamma) LogSurvival(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return logGammaIncRegComp(g.Alpha, g.Beta*x)
+}
+
 // Mean returns the mean of the probability distribution.
 func (g Gamma) Mean() float64 {
 	return g.Alpha / g.Beta
@@ -79,6 +96,27 @@ func (Gamma) NumParameters() int {
 	return 2
 }
 
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (g Gamma) Parameters(p []Parameter) []Parameter {
+	return parametersOf(p, "gamma", []string{"Alpha", "Beta"}, g.Alpha, g.Beta)
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (g *Gamma) SetParameters(p []Parameter) {
+	checkParameterNames(p, "gamma", "Alpha", "Beta")
+	g.Alpha = p[0].Value
+	g.Beta = p[1].Value
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (Gamma) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "gamma", []string{"Alpha", "Beta"}, positive, positive)
+}
+
 // Prob computes the value of the probability density function at x.
 func (g Gamma) Prob(x float64) float64 {
 	return math.Exp(g.LogProb(x))
@@ -201,3 +239,30 @@ func (g Gamma) StdDev() float64 {
 func (g Gamma) Variance() float64 {
 	return g.Alpha / g.Beta / g.Beta
 }
+
+// logGammaIncReg returns the log of the regularized lower incomplete Gamma
+// integral, using arbitrary precision when the value is below minTail.
+func logGammaIncReg(a, x float64) float64 {
+	p := mathext.GammaIncReg(a, x)
+	switch {
+	case p > 0.5:
+		return math.Log1p(-mathext.GammaIncRegComp(a, x))
+	case p >= minTail:
+		return math.Log(p)
+	}
+	return bigmath.LogGammaIncReg(a, x)
+}
+
+// logGammaIncRegComp returns the log of the complement of the regularized
+// lower incomplete Gamma integral, using arbitrary precision when the value
+// is below minTail.
+func logGammaIncRegComp(a, x float64) float64 {
+	q := mathext.GammaIncRegComp(a, x)
+	switch {
+	case q > 0.5:
+		return math.Log1p(-mathext.GammaIncReg(a, x))
+	case q >= minTail:
+		return math.Log(q)
+	}
+	return bigmath.LogGammaIncRegComp(a, x)
+}
@@ -141,3 +141,29 @@ func BenchmarkGammaRand(b *testing.B) {
 		})
 	}
 }
+
+func TestGammaLogCDFSurvival(t *testing.T) {
+	t.Parallel()
+	xs := []float64{0.01, 0.1, 0.5, 1, 2, 5, 10}
+	for i, g := range []Gamma{
+		{Alpha: 1, Beta: 1},
+		{Alpha: 0.5, Beta: 2},
+		{Alpha: 3, Beta: 0.8},
+	} {
+		checkLogCDFSurvival(t, i, xs, g, 1e-12)
+	}
+
+	for _, test := range []struct {
+		name      string
+		got, want float64
+	}{
+		// The survival function of the exponential distribution is e^{-βx}.
+		{name: "LogSurvival", got: Gamma{Alpha: 1, Beta: 2}.LogSurvival(500), want: -1000},
+		// The CDF is x^α β^α/Γ(α+1) (1 + O(x)) for small x.
+		{name: "LogCDF", got: Gamma{Alpha: 3, Beta: 1}.LogCDF(1e-120), want: 3*math.Log(1e-120) - math.Log(6)},
+	} {
+		if !scalar.EqualWithinRel(test.got, test.want, 1e-12) {
+			t.Errorf("unexpected %s: got:%v want:%v", test.name, test.got, test.want)
+		}
+	}
+}
@@ -4,12 +4,99 @@
 
 package distuv
 
+import "math"
+
 // Parameter represents a parameter of a probability distribution
 type Parameter struct {
 	Name  string
 	Value float64
 }
 
+// ParameterBounds describes the valid values of a parameter of a probability
+// distribution. Constraints that relate the values of several parameters,
+// such as the ordering of the bounds of a Uniform distribution, are not
+// described by the ParameterBounds of the individual parameters.
+type ParameterBounds struct {
+	// Name is the name of the parameter.
+	Name string
+
+	// Min and Max are the lower and upper bounds
+	// of the parameter. OpenMin and OpenMax specify
+	// whether the bounds are excluded.
+	Min, Max         float64
+	OpenMin, OpenMax bool
+
+	// Integer specifies whether the parameter
+	// only takes integer values.
+	Integer bool
+}
+
+// Contains returns whether v is a valid value of the parameter.
+func (b ParameterBounds) Contains(v float64) bool {
+	if math.IsNaN(v) {
+		return false
+	}
+	if v < b.Min || (b.OpenMin && v == b.Min) {
+		return false
+	}
+	if v > b.Max || (b.OpenMax && v == b.Max) {
+		return false
+	}
+	return !b.Integer || v == math.Trunc(v)
+}
+
+// Commonly used parameter bounds.
+var (
+	realLine = ParameterBounds{Min: math.Inf(-1), Max: math.Inf(1), OpenMin: true, OpenMax: true}
+	positive = ParameterBounds{Min: 0, Max: math.Inf(1), OpenMin: true, OpenMax: true}
+	nonNeg   = ParameterBounds{Min: 0, Max: math.Inf(1), OpenMax: true}
+	unit     = ParameterBounds{Min: 0, Max: 1}
+)
+
+// parametersOf returns the named parameter values, storing them in p if it
+// is not nil. It panics with a message prefixed by prefix if p is not nil
+// and does not have the same length as names.
+func parametersOf(p []Parameter, prefix string, names []string, values ...float64) []Parameter {
+	if p == nil {
+		p = make([]Parameter, len(names))
+	} else if len(p) != len(names) {
+		panic(prefix + ": improper parameter length")
+	}
+	for i, name := range names {
+		p[i] = Parameter{Name: name, Value: values[i]}
+	}
+	return p
+}
+
+// checkParameterNames panics with a message prefixed by prefix if p does
+// not hold parameters with the given names.
+func checkParameterNames(p []Parameter, prefix string, names ...string) {
+	if len(p) != len(names) {
+		panic(prefix + ": incorrect number of parameters to set")
+	}
+	for i, name := range names {
+		if p[i].Name != name {
+			panic(prefix + ": " + panicNameMismatch)
+		}
+	}
+}
+
+// boundsOf returns the parameter bounds in bnds with the given names,
+// storing them in b if it is not nil. It panics with a message prefixed by
+// prefix if b is not nil and does not have the same length as names.
+func boundsOf(b []ParameterBounds, prefix string, names []string, bnds ...ParameterBounds) []ParameterBounds {
+	if b == nil {
+		b = make([]ParameterBounds, len(names))
+	} else if len(b) != len(names) {
+		panic(prefix + ": improper parameter bounds length")
+	}
+	for i, name := range names {
+		b[i] = bnds[i]
+		b[i].Name = name
+	}
+	return b
+}
+
 const (
 	badPercentile = "distuv: percentile out of bounds"
 	badLength     = "distuv: slice length mismatch"
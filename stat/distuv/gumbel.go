@@ -73,6 +73,27 @@ func (GumbelRight) NumParameters() int {
 	return 2
 }
 
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (g GumbelRight) Parameters(p []Parameter) []Parameter {
+	return parametersOf(p, "gumbel", []string{"Mu", "Beta"}, g.Mu, g.Beta)
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (g *GumbelRight) SetParameters(p []Parameter) {
+	checkParameterNames(p, "gumbel", "Mu", "Beta")
+	g.Mu = p[0].Value
+	g.Beta = p[1].Value
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (GumbelRight) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "gumbel", []string{"Mu", "Beta"}, realLine, positive)
+}
+
 // Prob computes the value of the probability density function at x.
 func (g GumbelRight) Prob(x float64) float64 {
 	return math.Exp(g.LogProb(x))
@@ -30,3 +30,30 @@ type Quantiler interface {
 	// all those values whose CDF value exceeds or equals p.
 	Quantile(p float64) float64
 }
+
+// Parameterized is the interface implemented by distributions with a fixed
+// number of real parameters that can be retrieved and set as a vector, such
+// as for generic fitting, sampling of parameters and serialization.
+type Parameterized interface {
+	// NumParameters returns the number of parameters
+	// of the distribution.
+	NumParameters() int
+
+	// Parameters returns the names and values of the
+	// parameters of the distribution. If p is not nil,
+	// the parameters are stored in p, which must have
+	// length NumParameters.
+	Parameters(p []Parameter) []Parameter
+
+	// SetParameters sets the parameters of the
+	// distribution to the values in p, which must
+	// have the names returned by Parameters in the
+	// same order.
+	SetParameters(p []Parameter)
+
+	// ParameterBounds returns the bounds of the valid
+	// values of each parameter. If b is not nil, the
+	// bounds are stored in b, which must have length
+	// NumParameters.
+	ParameterBounds(b []ParameterBounds) []ParameterBounds
+}
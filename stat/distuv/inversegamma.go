@@ -79,6 +79,27 @@ func (InverseGamma) NumParameters() int {
 	return 2
 }
 
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (g InverseGamma) Parameters(p []Parameter) []Parameter {
+	return parametersOf(p, "inversegamma", []string{"Alpha", "Beta"}, g.Alpha, g.Beta)
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (g *InverseGamma) SetParameters(p []Parameter) {
+	checkParameterNames(p, "inversegamma", "Alpha", "Beta")
+	g.Alpha = p[0].Value
+	g.Beta = p[1].Value
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (InverseGamma) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "inversegamma", []string{"Alpha", "Beta"}, positive, positive)
+}
+
 // Prob computes the value of the probability density function at x.
 func (g InverseGamma) Prob(x float64) float64 {
 	return math.Exp(g.LogProb(x))
@@ -0,0 +1,119 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import "math/rand/v2"
+
+// Inversion generates random variates from a distribution by inverse
+// transform sampling, returning the quantile of the distribution at a
+// uniform random variate drawn from Src.
+//
+// Each variate consumes exactly one value from Src, unlike the Rand methods
+// of the distributions, which consume their source in ways that depend on
+// the generation algorithm and the parameters of the distribution. Variates
+// generated by Inversion using sources with the same seed are therefore
+// synchronized across distributions and parameter values, as required for
+// the use of common random numbers to reduce the variance of comparisons
+// between simulated scenarios. The variates are monotonic functions of the
+// uniform variates, so they are positively correlated between scenarios.
+//
+// If Antithetic is true, variates are generated in antithetic pairs; the
+// uniform variate u drawn for the first of each pair is used to generate
+// the second as the quantile at 1-u, so the pair is negatively correlated.
+// The variance of the mean of an even number of antithetic variates of a
+// monotonic function of the variates is no greater than that of independent
+// variates. Only the first variate of each pair consumes a value from Src.
+type Inversion struct {
+	// Dist is the distribution from which
+	// variates are generated.
+	Dist Quantiler
+
+	// Src is the source of uniform random
+	// variates. If Src is nil, the global
+	// source of math/rand/v2 is used.
+	Src rand.Source
+
+	// Antithetic specifies whether variates
+	// are generated in antithetic pairs.
+	Antithetic bool
+
+	// u holds the uniform variate of the first
+	// variate of an incomplete antithetic pair.
+	u       float64
+	pending bool
+}
+
+// Rand returns a random variate drawn from the distribution.
+func (inv *Inversion) Rand() float64 {
+	var u float64
+	switch {
+	case inv.Antithetic && inv.pending:
+		u = 1 - inv.u
+		inv.pending = false
+	default:
+		u = Uniform01(inv.Src)
+		if inv.Antithetic {
+			inv.u = u
+			inv.pending = true
+		}
+	}
+	return inv.Dist.Quantile(u)
+}
+
+// Reset discards the first variate of an incomplete antithetic pair, so the
+// next variate consumes a value from Src.
+func (inv *Inversion) Reset() {
+	inv.pending = false
+}
+
+// Uniform01 returns a uniform random variate in the open interval (0, 1)
+// using a single value from src. The value is an odd multiple of 2^-53, so
+// both it and its complement 1-u are exactly representable and neither is
+// zero or one, and the quantiles at u and 1-u are finite for distributions
+// with unbounded support. If src is nil, the global source of math/rand/v2 is
+// used.
+func Uniform01(src rand.Source) float64 {
+	var v uint64
+	if src == nil {
+		v = rand.Uint64()
+	} else {
+		v = src.Uint64()
+	}
+	return float64(v>>11|1) / (1 << 53)
+}
+
+// InverseTransform stores in dst the quantiles of the distribution q at the
+// probabilities in u and returns the result. If dst is nil, a new slice is
+// allocated. InverseTransform may be used to generate variates from several
+// distributions using a shared slice of uniform variates, such as one filled
+// by UniformVariates.
+//
+// InverseTransform will panic if dst is not nil and its length is not equal
+// to the length of u.
+func InverseTransform(dst []float64, q Quantiler, u []float64) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(u))
+	} else if len(dst) != len(u) {
+		panic(badLength)
+	}
+	for i, p := range u {
+		dst[i] = q.Quantile(p)
+	}
+	return dst
+}
+
+// UniformVariates fills dst with uniform random variates in the open interval
+// (0, 1) generated as described for Uniform01 using src. If antithetic is
+// true, the variates are generated in pairs u, 1-u, with the last element
+// of an odd length dst being the first of an incomplete pair.
+func UniformVariates(dst []float64, src rand.Source, antithetic bool) {
+	for i := range dst {
+		if antithetic && i%2 == 1 {
+			dst[i] = 1 - dst[i-1]
+			continue
+		}
+		dst[i] = Uniform01(src)
+	}
+}
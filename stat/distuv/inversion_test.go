@@ -0,0 +1,155 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+// countingSource is a rand.Source that counts
+// the number of values it has generated.
+type countingSource struct {
+	rand.Source
+	n int
+}
+
+func (s *countingSource) Uint64() uint64 {
+	s.n++
+	return s.Source.Uint64()
+}
+
+func TestUniform01(t *testing.T) {
+	t.Parallel()
+	for _, v := range []uint64{0, 1, 1 << 11, math.MaxUint64, math.MaxUint64 >> 1} {
+		u := Uniform01(constSource(v))
+		if !(0 < u && u < 1) {
+			t.Errorf("variate out of range for %#x: %v", v, u)
+		}
+		c := 1 - u
+		if !(0 < c && c < 1) || 1-c != u {
+			t.Errorf("complement of variate not exact for %#x: %v", v, u)
+		}
+	}
+}
+
+// constSource is a rand.Source that
+// always returns the same value.
+type constSource uint64
+
+func (s constSource) Uint64() uint64 { return uint64(s) }
+
+func TestInversionCommonRandomNumbers(t *testing.T) {
+	t.Parallel()
+	const n = 1000
+	// Variates of distributions with different
+	// parameters generated from sources with the
+	// same seed use the same uniform variates.
+	src1 := &countingSource{Source: rand.NewPCG(1, 1)}
+	src2 := &countingSource{Source: rand.NewPCG(1, 1)}
+	a := Inversion{Dist: Normal{Mu: 0, Sigma: 1}, Src: src1}
+	b := Inversion{Dist: Normal{Mu: 3, Sigma: 2}, Src: src2}
+	for i := range n {
+		x, y := a.Rand(), b.Rand()
+		if !scalar.EqualWithinAbsOrRel(y, 3+2*x, 1e-12, 1e-12) {
+			t.Fatalf("unsynchronized variates at %d: got %v and %v", i, x, y)
+		}
+	}
+	if src1.n != n || src2.n != n {
+		t.Errorf("unexpected number of source values consumed: got %d and %d, want %d", src1.n, src2.n, n)
+	}
+
+	// The same holds for distributions whose Rand
+	// method consumes a variable number of values.
+	src1 = &countingSource{Source: rand.NewPCG(1, 1)}
+	g := Inversion{Dist: Gamma{Alpha: 0.5, Beta: 1}, Src: src1}
+	for range n {
+		g.Rand()
+	}
+	if src1.n != n {
+		t.Errorf("unexpected number of source values consumed: got %d, want %d", src1.n, n)
+	}
+}
+
+func TestInversionAntithetic(t *testing.T) {
+	t.Parallel()
+	const n = 1000
+	src := &countingSource{Source: rand.NewPCG(1, 1)}
+	inv := Inversion{Dist: Uniform{Min: 0, Max: 1}, Src: src, Antithetic: true}
+	for i := 0; i < n; i += 2 {
+		u := inv.Rand()
+		v := inv.Rand()
+		if u+v != 1 {
+			t.Fatalf("variates at %d not antithetic: got %v and %v", i, u, v)
+		}
+	}
+	if src.n != n/2 {
+		t.Errorf("unexpected number of source values consumed: got %d, want %d", src.n, n/2)
+	}
+
+	// Reset discards the pending variate.
+	inv.Rand()
+	inv.Reset()
+	inv.Rand()
+	if src.n != n/2+2 {
+		t.Errorf("unexpected number of source values consumed after reset: got %d, want %d", src.n, n/2+2)
+	}
+
+	// The mean of antithetic pairs of a linear function of the
+	// uniform variates is exact.
+	e := Inversion{Dist: Uniform{Min: 2, Max: 5}, Src: rand.NewPCG(2, 2), Antithetic: true}
+	var sum float64
+	for range 10 {
+		sum += e.Rand()
+	}
+	if !scalar.EqualWithinAbs(sum/10, 3.5, 1e-14) {
+		t.Errorf("unexpected mean of antithetic variates: got %v, want 3.5", sum/10)
+	}
+}
+
+func TestInversionDistribution(t *testing.T) {
+	t.Parallel()
+	const n = 100000
+	inv := Inversion{Dist: Exponential{Rate: 2}, Src: rand.NewPCG(1, 1)}
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = inv.Rand()
+	}
+	sort.Float64s(x)
+	testRandLogProbContinuous(t, 0, 0, x, Exponential{Rate: 2}, 1e-2, 20)
+}
+
+func TestUniformVariates(t *testing.T) {
+	t.Parallel()
+	u := make([]float64, 7)
+	UniformVariates(u, rand.NewPCG(1, 1), true)
+	for i := 0; i+1 < len(u); i += 2 {
+		if u[i]+u[i+1] != 1 {
+			t.Errorf("variates %d and %d not antithetic: got %v and %v", i, i+1, u[i], u[i+1])
+		}
+	}
+
+	// InverseTransform of shared uniform
+	// variates uses them in order.
+	src := rand.NewPCG(3, 3)
+	var want []float64
+	for range u {
+		want = append(want, Uniform01(src))
+	}
+	UniformVariates(u, rand.NewPCG(3, 3), false)
+	got := InverseTransform(nil, Uniform{Min: 0, Max: 1}, u)
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("unexpected variate %d: got %v, want %v", i, got[i], want[i])
+		}
+	}
+	if !panics(func() { InverseTransform(make([]float64, 3), Uniform{Min: 0, Max: 1}, u) }) {
+		t.Errorf("expected panic for destination length mismatch")
+	}
+}
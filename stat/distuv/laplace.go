@@ -104,8 +104,9 @@ func (l Laplace) LogProb(x float64) float64 {
 	return -math.Ln2 - math.Log(l.Scale) - math.Abs(x-l.Mu)/l.Scale
 }
 
-// parameters returns the parameters of the distribution.
-func (l Laplace) parameters(p []Parameter) []Parameter {
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (l Laplace) Parameters(p []Parameter) []Parameter {
 	nParam := l.NumParameters()
 	if p == nil {
 		p = make([]Parameter, nParam)
@@ -139,6 +140,13 @@ func (l Laplace) NumParameters() int {
 	return 2
 }
 
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (Laplace) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "laplace", []string{"Mu", "Scale"}, realLine, positive)
+}
+
 // Quantile returns the inverse of the cumulative probability distribution.
 func (l Laplace) Quantile(p float64) float64 {
 	if p < 0 || p > 1 {
@@ -246,8 +254,9 @@ func (l Laplace) Survival(x float64) float64 {
 	return 0.5 * math.Exp(-(x-l.Mu)/l.Scale)
 }
 
-// setParameters modifies the parameters of the distribution.
-func (l *Laplace) setParameters(p []Parameter) {
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (l *Laplace) SetParameters(p []Parameter) {
 	if len(p) != l.NumParameters() {
 		panic(badLength)
 	}
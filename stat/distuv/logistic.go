@@ -64,6 +64,27 @@ func (l Logistic) NumParameters() int {
 	return 2
 }
 
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (l Logistic) Parameters(p []Parameter) []Parameter {
+	return parametersOf(p, "logistic", []string{"Mu", "S"}, l.Mu, l.S)
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (l *Logistic) SetParameters(p []Parameter) {
+	checkParameterNames(p, "logistic", "Mu", "S")
+	l.Mu = p[0].Value
+	l.S = p[1].Value
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (Logistic) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "logistic", []string{"Mu", "S"}, realLine, positive)
+}
+
 // Prob computes the value of the probability density function at x.
 func (l Logistic) Prob(x float64) float64 {
 	E := math.Exp(-(x - l.Mu) / l.S)
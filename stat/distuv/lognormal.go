@@ -65,6 +65,27 @@ func (LogNormal) NumParameters() int {
 	return 2
 }
 
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (l LogNormal) Parameters(p []Parameter) []Parameter {
+	return parametersOf(p, "lognormal", []string{"Mu", "Sigma"}, l.Mu, l.Sigma)
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (l *LogNormal) SetParameters(p []Parameter) {
+	checkParameterNames(p, "lognormal", "Mu", "Sigma")
+	l.Mu = p[0].Value
+	l.Sigma = p[1].Value
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (LogNormal) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "lognormal", []string{"Mu", "Sigma"}, realLine, positive)
+}
+
 // Prob computes the value of the probability density function at x.
 func (l LogNormal) Prob(x float64) float64 {
 	return math.Exp(l.LogProb(x))
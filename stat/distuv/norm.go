@@ -10,6 +10,7 @@ import (
 
 	"gonum.org/v1/gonum/floats"
 	"gonum.org/v1/gonum/mathext"
+	"gonum.org/v1/gonum/mathext/bigmath"
 	"gonum.org/v1/gonum/stat"
 )
 
@@ -91,11 +92,21 @@ func (n *Normal) Fit(samples, weights []float64) {
 	n.ConjugateUpdate(suffStat, nSamples, make([]float64, n.NumSuffStat()))
 }
 
+// LogCDF returns the log of the cumulative distribution function at x.
+func (n Normal) LogCDF(x float64) float64 {
+	return logHalfErfc(-(x - n.Mu) / (n.Sigma * math.Sqrt2))
+}
+
 // LogProb computes the natural logarithm of the value of the probability density function at x.
 func (n Normal) LogProb(x float64) float64 {
 	return negLogRoot2Pi - math.Log(n.Sigma) - (x-n.Mu)*(x-n.Mu)/(2*n.Sigma*n.Sigma)
 }
 
+// LogSurvival returns the log of the survival function (complementary CDF) at x.
+func (n Normal) LogSurvival(x float64) float64 {
+	return logHalfErfc((x - n.Mu) / (n.Sigma * math.Sqrt2))
+}
+
 // Mean returns the mean of the probability distribution.
 func (n Normal) Mean() float64 {
 	return n.Mu
@@ -116,6 +127,13 @@ func (Normal) NumParameters() int {
 	return 2
 }
 
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (Normal) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "normal", []string{"Mu", "Sigma"}, realLine, positive)
+}
+
 // NumSuffStat returns the number of sufficient statistics for the distribution.
 func (Normal) NumSuffStat() int {
 	return 2
@@ -227,8 +245,9 @@ func (n Normal) Survival(x float64) float64 {
 	return 0.5 * (1 - math.Erf((x-n.Mu)/(n.Sigma*math.Sqrt2)))
 }
 
-// setParameters modifies the parameters of the distribution.
-func (n *Normal) setParameters(p []Parameter) {
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (n *Normal) SetParameters(p []Parameter) {
 	if len(p) != n.NumParameters() {
 		panic("normal: incorrect number of parameters to set")
 	}
@@ -247,8 +266,9 @@ func (n Normal) Variance() float64 {
 	return n.Sigma * n.Sigma
 }
 
-// parameters returns the parameters of the distribution.
-func (n Normal) parameters(p []Parameter) []Parameter {
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (n Normal) Parameters(p []Parameter) []Parameter {
 	nParam := n.NumParameters()
 	if p == nil {
 		p = make([]Parameter, nParam)
@@ -261,3 +281,14 @@ func (n Normal) parameters(p []Parameter) []Parameter {
 	p[1].Value = n.Sigma
 	return p
 }
+
+// logHalfErfc returns log(erfc(x)/2).
+func logHalfErfc(x float64) float64 {
+	if x < 0 {
+		return math.Log1p(-0.5 * math.Erfc(-x))
+	}
+	if v := math.Erfc(x); v >= minTail {
+		return math.Log(0.5 * v)
+	}
+	return bigmath.LogErfc(x) - math.Ln2
+}
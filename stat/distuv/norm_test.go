@@ -228,3 +228,25 @@ func TestNormalIssue577(t *testing.T) {
 		t.Errorf("Normal{0,1}.CDF(%e) is greater than %e. got: %e", x, max, cdf)
 	}
 }
+
+func TestNormalLogCDFSurvival(t *testing.T) {
+	t.Parallel()
+	xs := []float64{-5, -2, -0.5, 0, 0.3, 1, 3, 7}
+	for i, n := range []Normal{
+		{Mu: 0, Sigma: 1},
+		{Mu: 3, Sigma: 0.5},
+		{Mu: -2, Sigma: 4},
+	} {
+		checkLogCDFSurvival(t, i, xs, n, 1e-12)
+	}
+
+	// Q(x) ~ φ(x)/x (1 - 1/x² + 3/x⁴ - 15/x⁶) far in the tail.
+	const x = 40.0
+	want := -x*x/2 - math.Log(x*math.Sqrt(2*math.Pi)) + math.Log1p(-1/(x*x)+3/math.Pow(x, 4)-15/math.Pow(x, 6))
+	if got := UnitNormal.LogSurvival(x); !scalar.EqualWithinRel(got, want, 1e-12) {
+		t.Errorf("unexpected LogSurvival(%v): got:%v want:%v", x, got, want)
+	}
+	if got := UnitNormal.LogCDF(-x); !scalar.EqualWithinRel(got, want, 1e-12) {
+		t.Errorf("unexpected LogCDF(%v): got:%v want:%v", -x, got, want)
+	}
+}
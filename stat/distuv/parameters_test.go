@@ -0,0 +1,147 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestParameterized(t *testing.T) {
+	t.Parallel()
+	triangle := NewTriangle(0, 3, 1, nil)
+	for _, d := range []Parameterized{
+		&AlphaStable{Alpha: 1.5, Beta: -0.5, C: 2, Mu: 1},
+		&Bernoulli{P: 0.3},
+		&Benford{Base: 10},
+		&Beta{Alpha: 2, Beta: 3},
+		&Binomial{N: 10, P: 0.4},
+		&Chi{K: 3},
+		&ChiSquared{K: 4},
+		&DiscretePowerLaw{Alpha: 2.5, Xmin: 3},
+		&Exponential{Rate: 2},
+		&F{D1: 3, D2: 7},
+		&Gamma{Alpha: 2, Beta: 0.5},
+		&GumbelRight{Mu: 1, Beta: 2},
+		&HurdlePoisson{Pi: 0.2, Lambda: 3},
+		&InverseGamma{Alpha: 3, Beta: 2},
+		&Laplace{Mu: -1, Scale: 2},
+		&Logistic{Mu: 1, S: 0.5},
+		&LogNormal{Mu: 0.5, Sigma: 1.5},
+		&Normal{Mu: 1, Sigma: 2},
+		&Pareto{Xm: 1, Alpha: 3},
+		&Poisson{Lambda: 4},
+		&StudentsT{Mu: 1, Sigma: 2, Nu: 5},
+		&triangle,
+		&Tweedie{Mu: 2, Phi: 1, P: 1.5},
+		&Uniform{Min: -1, Max: 2},
+		&VonMises{Mu: 1, Kappa: 2},
+		&Weibull{K: 2, Lambda: 3},
+		&WrappedCauchy{Mu: 1, Rho: 0.5},
+		&ZipfMandelbrot{N: 100, Q: 2, S: 1.2},
+		&ZeroInflatedNegativeBinomial{Pi: 0.2, R: 3, P: 0.4},
+		&ZeroInflatedPoisson{Pi: 0.2, Lambda: 3},
+	} {
+		name := fmt.Sprintf("%T", d)
+		n := d.NumParameters()
+		params := d.Parameters(nil)
+		if len(params) != n {
+			t.Errorf("unexpected number of parameters for %s: got %d, want %d", name, len(params), n)
+			continue
+		}
+		bounds := d.ParameterBounds(nil)
+		if len(bounds) != n {
+			t.Errorf("unexpected number of parameter bounds for %s: got %d, want %d", name, len(bounds), n)
+			continue
+		}
+		for i, p := range params {
+			if bounds[i].Name != p.Name {
+				t.Errorf("mismatched parameter bounds name for %s: got %q, want %q", name, bounds[i].Name, p.Name)
+			}
+			if !bounds[i].Contains(p.Value) {
+				t.Errorf("valid parameter %s of %s out of bounds: %v not in %+v", p.Name, name, p.Value, bounds[i])
+			}
+		}
+
+		// Parameters and ParameterBounds use
+		// the provided slices.
+		dst := make([]Parameter, n)
+		if got := d.Parameters(dst); &got[0] != &dst[0] {
+			t.Errorf("Parameters did not use the provided slice for %s", name)
+		}
+		dstBounds := make([]ParameterBounds, n)
+		if got := d.ParameterBounds(dstBounds); &got[0] != &dstBounds[0] {
+			t.Errorf("ParameterBounds did not use the provided slice for %s", name)
+		}
+		if !panics(func() { d.Parameters(make([]Parameter, n+1)) }) {
+			t.Errorf("expected panic for wrong parameter slice length for %s", name)
+		}
+		if !panics(func() { d.ParameterBounds(make([]ParameterBounds, n+1)) }) {
+			t.Errorf("expected panic for wrong parameter bounds slice length for %s", name)
+		}
+
+		// SetParameters round trips.
+		mod := d.Parameters(nil)
+		for i := range mod {
+			v := mod[i].Value
+			if bounds[i].Integer {
+				v++
+			} else {
+				v = bounds[i].Min + (v-bounds[i].Min)*0.75
+				if math.IsInf(bounds[i].Min, -1) {
+					v = mod[i].Value + 0.25
+				}
+			}
+			mod[i].Value = v
+		}
+		d.SetParameters(mod)
+		got := d.Parameters(nil)
+		for i := range got {
+			if got[i] != mod[i] {
+				t.Errorf("unexpected parameter after SetParameters for %s: got %+v, want %+v", name, got[i], mod[i])
+			}
+		}
+		d.SetParameters(params)
+
+		if !panics(func() { d.SetParameters(params[:n-1]) }) {
+			t.Errorf("expected panic for short parameters for %s", name)
+		}
+		bad := d.Parameters(nil)
+		for i := range bad {
+			bad[i].Name = "__badName__"
+			if !panics(func() { d.SetParameters(bad) }) {
+				t.Errorf("expected panic for wrong name of parameter %d for %s", i, name)
+			}
+			bad[i].Name = params[i].Name
+		}
+	}
+}
+
+func TestParameterBoundsContains(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		b    ParameterBounds
+		v    float64
+		want bool
+	}{
+		{b: positive, v: 0, want: false},
+		{b: positive, v: 1e-300, want: true},
+		{b: positive, v: math.Inf(1), want: false},
+		{b: nonNeg, v: 0, want: true},
+		{b: nonNeg, v: -1e-300, want: false},
+		{b: unit, v: 0, want: true},
+		{b: unit, v: 1, want: true},
+		{b: unit, v: 1.5, want: false},
+		{b: realLine, v: -1e300, want: true},
+		{b: realLine, v: math.NaN(), want: false},
+		{b: ParameterBounds{Min: 0, Max: 10, Integer: true}, v: 3, want: true},
+		{b: ParameterBounds{Min: 0, Max: 10, Integer: true}, v: 3.5, want: false},
+	} {
+		if got := test.b.Contains(test.v); got != test.want {
+			t.Errorf("unexpected result for %v in %+v: got %t, want %t", test.v, test.b, got, test.want)
+		}
+	}
+}
@@ -83,6 +83,28 @@ func (p Pareto) NumParameters() int {
 	return 2
 }
 
+// Parameters returns the parameters of the distribution. If params is not
+// nil, the parameters are stored in params, which must have length
+// NumParameters.
+func (p Pareto) Parameters(params []Parameter) []Parameter {
+	return parametersOf(params, "pareto", []string{"Xm", "Alpha"}, p.Xm, p.Alpha)
+}
+
+// SetParameters sets the parameters of the distribution to the values in
+// params, which must have the names returned by Parameters in the same order.
+func (p *Pareto) SetParameters(params []Parameter) {
+	checkParameterNames(params, "pareto", "Xm", "Alpha")
+	p.Xm = params[0].Value
+	p.Alpha = params[1].Value
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (Pareto) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "pareto", []string{"Xm", "Alpha"}, positive, positive)
+}
+
 // Prob computes the value of the probability density function at x.
 func (p Pareto) Prob(x float64) float64 {
 	return math.Exp(p.LogProb(x))
@@ -60,6 +60,27 @@ func (Poisson) NumParameters() int {
 	return 1
 }
 
+// Parameters returns the parameters of the distribution. If params is not
+// nil, the parameters are stored in params, which must have length
+// NumParameters.
+func (p Poisson) Parameters(params []Parameter) []Parameter {
+	return parametersOf(params, "poisson", []string{"Lambda"}, p.Lambda)
+}
+
+// SetParameters sets the parameters of the distribution to the values in
+// params, which must have the names returned by Parameters in the same order.
+func (p *Poisson) SetParameters(params []Parameter) {
+	checkParameterNames(params, "poisson", "Lambda")
+	p.Lambda = params[0].Value
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (Poisson) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "poisson", []string{"Lambda"}, positive)
+}
+
 // Prob computes the value of the probability density function at x.
 func (p Poisson) Prob(x float64) float64 {
 	return math.Exp(p.LogProb(x))
@@ -0,0 +1,329 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+
+	"gonum.org/v1/gonum/mathext"
+)
+
+// DiscretePowerLaw implements the discrete power-law distribution, also
+// known as the zeta distribution when Xmin is one, over the integers
+// greater than or equal to Xmin. It models heavy-tailed count data such as
+// the degrees of the nodes of many networks, often only above a lower
+// bound Xmin of the power-law behavior.
+// The discrete power-law distribution has density function:
+//
+//	f(x) = x^{-α} / ζ(α, x_min), x ≥ x_min
+//
+// where ζ is the Hurwitz zeta function.
+//
+// See Clauset, A., Shalizi, C. R. and Newman, M. E. J. (2009) Power-law
+// distributions in empirical data. SIAM Review 51(4) for details of the
+// fitting and testing of power-law distributions.
+type DiscretePowerLaw struct {
+	// Alpha is the exponent.
+	// Alpha must be greater than 1.
+	Alpha float64
+	// Xmin is the lower bound of the support.
+	// Xmin must be a positive integer.
+	Xmin float64
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (p DiscretePowerLaw) CDF(x float64) float64 {
+	return 1 - p.Survival(x)
+}
+
+// Fit sets the exponent Alpha of the distribution to its maximum likelihood
+// estimate from the samples with relative weights, holding Xmin fixed.
+// Samples less than Xmin are ignored.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+// Fit panics if any sample is not a positive integer or no sample is at
+// least Xmin.
+func (p *DiscretePowerLaw) Fit(samples, weights []float64) {
+	if weights != nil && len(samples) != len(weights) {
+		panic(badLength)
+	}
+	var sum, total float64
+	for i, x := range samples {
+		if x < 1 || math.Floor(x) != x || math.IsInf(x, 1) {
+			panic(badSupport)
+		}
+		if x < p.Xmin {
+			continue
+		}
+		w := 1.0
+		if weights != nil {
+			w = weights[i]
+		}
+		sum += w * math.Log(x)
+		total += w
+	}
+	if total == 0 {
+		panic(errNoSamples)
+	}
+	p.Alpha = powerLawAlpha(sum/total, p.Xmin)
+}
+
+// powerLawAlpha returns the maximum likelihood estimate of the exponent of
+// a discrete power law with lower bound xmin for samples with mean
+// logarithm meanLog.
+func powerLawAlpha(meanLog, xmin float64) float64 {
+	// The negative log likelihood per sample is convex in the
+	// exponent, so its minimum is found by golden section search.
+	// The interval is bracketed using the continuous approximation
+	// of the estimate.
+	nll := func(alpha float64) float64 {
+		return math.Log(mathext.Zeta(alpha, xmin)) + alpha*meanLog
+	}
+	lo := 1 + 1e-8
+	approx := 1 + 1/(meanLog-math.Log(xmin-0.5))
+	hi := math.Max(2*approx, 4)
+	// Limit the exponent to avoid underflow of the zeta function.
+	hiMax := 1e3
+	if xmin > 1 {
+		hiMax = math.Min(hiMax, 700/math.Log(xmin))
+	}
+	hi = math.Min(hi, hiMax)
+	for hi < hiMax && nll(hi) < nll(hi/2) {
+		hi = math.Min(2*hi, hiMax)
+	}
+	invPhi := (math.Sqrt(5) - 1) / 2
+	a := hi - invPhi*(hi-lo)
+	b := lo + invPhi*(hi-lo)
+	fa, fb := nll(a), nll(b)
+	for hi-lo > 1e-10*hi {
+		if fa < fb {
+			hi, b, fb = b, a, fa
+			a = hi - invPhi*(hi-lo)
+			fa = nll(a)
+		} else {
+			lo, a, fa = a, b, fb
+			b = lo + invPhi*(hi-lo)
+			fb = nll(b)
+		}
+	}
+	return lo + (hi-lo)/2
+}
+
+// FitXmin sets the lower bound Xmin and the exponent Alpha of the
+// distribution to the values that best describe the tail of the samples
+// using the method of Clauset, Shalizi and Newman. For each distinct value
+// of the samples other than the largest as a candidate lower bound, the
+// exponent is estimated by maximum likelihood from the samples at or above
+// the candidate, and the candidate minimizing the Kolmogorov-Smirnov
+// distance between the empirical distribution of those samples and the
+// fitted power law is chosen. FitXmin returns the Kolmogorov-Smirnov
+// distance of the chosen fit.
+//
+// FitXmin panics if any sample is not a positive integer or there are
+// fewer than two distinct sample values.
+func (p *DiscretePowerLaw) FitXmin(samples []float64) (ks float64) {
+	x := slices.Clone(samples)
+	slices.Sort(x)
+	for _, v := range x {
+		if v < 1 || math.Floor(v) != v || math.IsInf(v, 1) {
+			panic(badSupport)
+		}
+	}
+	if len(x) == 0 || x[0] == x[len(x)-1] {
+		panic("distuv: too few distinct samples")
+	}
+
+	// Compute the suffix sums of the logarithms of
+	// the sorted samples for the candidate fits.
+	suffix := make([]float64, len(x)+1)
+	for i := len(x) - 1; i >= 0; i-- {
+		suffix[i] = suffix[i+1] + math.Log(x[i])
+	}
+	ks = math.Inf(1)
+	for i := 0; i < len(x) && x[i] < x[len(x)-1]; i++ {
+		if i > 0 && x[i] == x[i-1] {
+			continue
+		}
+		cand := DiscretePowerLaw{Xmin: x[i]}
+		cand.Alpha = powerLawAlpha(suffix[i]/float64(len(x)-i), x[i])
+		d := cand.ksDistance(x[i:])
+		if d < ks {
+			ks = d
+			p.Alpha = cand.Alpha
+			p.Xmin = cand.Xmin
+		}
+	}
+	return ks
+}
+
+// ksDistance returns the Kolmogorov-Smirnov distance between the empirical
+// distribution of the sorted samples x, all at least Xmin, and p.
+func (p DiscretePowerLaw) ksDistance(x []float64) float64 {
+	n := float64(len(x))
+	var d float64
+	for i := 0; i < len(x); {
+		v := x[i]
+		for i < len(x) && x[i] == v {
+			i++
+		}
+		// Both distribution functions are step functions, so
+		// the distance is largest at a sample value or just
+		// before the next sample value.
+		emp := float64(i) / n
+		d = math.Max(d, math.Abs(emp-p.CDF(v)))
+		if i < len(x) && x[i]-1 > v {
+			d = math.Max(d, math.Abs(emp-p.CDF(x[i]-1)))
+		}
+	}
+	return d
+}
+
+// GoodnessOfFit fits the distribution to the samples using FitXmin and
+// returns the p-value of the semi-parametric bootstrap goodness-of-fit test
+// of Clauset, Shalizi and Newman for the hypothesis that the tail of the
+// samples follows the fitted power law. Each of the given number of
+// replicate data sets draws each sample from the fitted power law with
+// probability equal to the fraction of the samples at or above Xmin, and
+// otherwise uniformly from the samples below Xmin. The p-value is the
+// fraction of the replicates whose Kolmogorov-Smirnov distance, after
+// fitting with FitXmin, is at least that of the samples. Small p-values,
+// conventionally less than 0.1, indicate that the power-law hypothesis is
+// implausible. The replicates are drawn using Src.
+//
+// GoodnessOfFit panics if replicates is not positive, and under the same
+// conditions as FitXmin.
+func (p *DiscretePowerLaw) GoodnessOfFit(samples []float64, replicates int) (pValue float64) {
+	if replicates <= 0 {
+		panic("distuv: non-positive number of replicates")
+	}
+	ks := p.FitXmin(samples)
+	var body []float64
+	for _, x := range samples {
+		if x < p.Xmin {
+			body = append(body, x)
+		}
+	}
+	var rnd *rand.Rand
+	if p.Src == nil {
+		rnd = rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	} else {
+		rnd = rand.New(p.Src)
+	}
+	tail := 1 - float64(len(body))/float64(len(samples))
+	fitted := DiscretePowerLaw{Alpha: p.Alpha, Xmin: p.Xmin, Src: rnd}
+	replicate := make([]float64, len(samples))
+	var count int
+	for range replicates {
+		for i := range replicate {
+			if len(body) == 0 || rnd.Float64() < tail {
+				replicate[i] = fitted.Rand()
+			} else {
+				replicate[i] = body[rnd.IntN(len(body))]
+			}
+		}
+		// A replicate with a single distinct value cannot be
+		// fitted and is counted as fitting no better than the
+		// samples.
+		var rep DiscretePowerLaw
+		if slices.Min(replicate) == slices.Max(replicate) || rep.FitXmin(replicate) >= ks {
+			count++
+		}
+	}
+	return float64(count) / float64(replicates)
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (p DiscretePowerLaw) LogProb(x float64) float64 {
+	if x < p.Xmin || math.Floor(x) != x || math.IsInf(x, 1) {
+		return math.Inf(-1)
+	}
+	return -p.Alpha*math.Log(x) - math.Log(mathext.Zeta(p.Alpha, p.Xmin))
+}
+
+// Mean returns the mean of the probability distribution. The mean is +Inf
+// if Alpha is not greater than 2.
+func (p DiscretePowerLaw) Mean() float64 {
+	if p.Alpha <= 2 {
+		return math.Inf(1)
+	}
+	return mathext.Zeta(p.Alpha-1, p.Xmin) / mathext.Zeta(p.Alpha, p.Xmin)
+}
+
+// Mode returns the mode of the distribution.
+func (p DiscretePowerLaw) Mode() float64 {
+	return p.Xmin
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (DiscretePowerLaw) NumParameters() int {
+	return 2
+}
+
+// Parameters returns the parameters of the distribution. If params is not
+// nil, the parameters are stored in params, which must have length
+// NumParameters.
+func (p DiscretePowerLaw) Parameters(params []Parameter) []Parameter {
+	return parametersOf(params, "powerlaw", []string{"Alpha", "Xmin"}, p.Alpha, p.Xmin)
+}
+
+// SetParameters sets the parameters of the distribution to the values in
+// params, which must have the names returned by Parameters in the same order.
+func (p *DiscretePowerLaw) SetParameters(params []Parameter) {
+	checkParameterNames(params, "powerlaw", "Alpha", "Xmin")
+	p.Alpha = params[0].Value
+	p.Xmin = params[1].Value
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (DiscretePowerLaw) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "powerlaw", []string{"Alpha", "Xmin"},
+		ParameterBounds{Min: 1, Max: math.Inf(1), OpenMin: true, OpenMax: true},
+		ParameterBounds{Min: 1, Max: math.Inf(1), OpenMax: true, Integer: true},
+	)
+}
+
+// Prob computes the value of the probability density function at x.
+func (p DiscretePowerLaw) Prob(x float64) float64 {
+	return math.Exp(p.LogProb(x))
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (p DiscretePowerLaw) Rand() float64 {
+	return p.Xmin - 1 + zipfRand(p.Alpha, p.Xmin-1, math.Inf(1), p.Src)
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (p DiscretePowerLaw) StdDev() float64 {
+	return math.Sqrt(p.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (p DiscretePowerLaw) Survival(x float64) float64 {
+	switch {
+	case x < p.Xmin:
+		return 1
+	case math.IsInf(x, 1):
+		return 0
+	}
+	return mathext.Zeta(p.Alpha, math.Floor(x)+1) / mathext.Zeta(p.Alpha, p.Xmin)
+}
+
+// Variance returns the variance of the probability distribution. The
+// variance is +Inf if Alpha is not greater than 3.
+func (p DiscretePowerLaw) Variance() float64 {
+	if p.Alpha <= 3 {
+		return math.Inf(1)
+	}
+	z := mathext.Zeta(p.Alpha, p.Xmin)
+	m := mathext.Zeta(p.Alpha-1, p.Xmin) / z
+	return mathext.Zeta(p.Alpha-2, p.Xmin)/z - m*m
+}
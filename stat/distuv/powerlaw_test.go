@@ -0,0 +1,154 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestDiscretePowerLaw(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewPCG(1, 1))
+	for i, d := range []DiscretePowerLaw{
+		{Alpha: 4.5, Xmin: 1, Src: src},
+		{Alpha: 5, Xmin: 3, Src: src},
+		{Alpha: 6, Xmin: 10, Src: src},
+	} {
+		testCountDist(t, i, d)
+		if d.Mode() != d.Xmin {
+			t.Errorf("unexpected mode for case %d: %v", i, d.Mode())
+		}
+	}
+
+	d := DiscretePowerLaw{Alpha: 2, Xmin: 1}
+	if !scalar.EqualWithinRel(d.Prob(1), 6/(math.Pi*math.Pi), 1e-14) {
+		t.Errorf("unexpected probability at 1: got %v, want %v", d.Prob(1), 6/(math.Pi*math.Pi))
+	}
+	if !math.IsInf(d.Mean(), 1) || !math.IsInf(d.Variance(), 1) {
+		t.Error("expected infinite moments for Alpha=2")
+	}
+	if d.Survival(math.Inf(1)) != 0 {
+		t.Error("unexpected survival at infinity")
+	}
+
+	// Samples from a heavy tail must follow the distribution.
+	d = DiscretePowerLaw{Alpha: 2.2, Xmin: 2, Src: src}
+	x := make([]float64, 1e6)
+	generateSamples(x, d)
+	for k := 2.0; k < 10; k++ {
+		var count float64
+		for _, v := range x {
+			if v == k {
+				count++
+			}
+		}
+		if got, want := count/float64(len(x)), d.Prob(k); !scalar.EqualWithinAbs(got, want, 2e-3) {
+			t.Errorf("unexpected frequency of %v: got %v, want %v", k, got, want)
+		}
+	}
+}
+
+func TestDiscretePowerLawFit(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewPCG(1, 1))
+	for i, want := range []DiscretePowerLaw{
+		{Alpha: 2.5, Xmin: 1},
+		{Alpha: 2, Xmin: 5},
+		{Alpha: 3.2, Xmin: 2},
+	} {
+		d := want
+		d.Src = src
+		x := make([]float64, 1e5)
+		generateSamples(x, d)
+		d.Alpha = 0
+		d.Fit(x, nil)
+		if !scalar.EqualWithinAbs(d.Alpha, want.Alpha, 0.03) {
+			t.Errorf("unexpected fitted exponent for case %d: got %v, want %v", i, d.Alpha, want.Alpha)
+		}
+	}
+
+	// Samples below Xmin are ignored.
+	a := DiscretePowerLaw{Xmin: 3}
+	a.Fit([]float64{1, 2, 3, 4, 10}, nil)
+	b := DiscretePowerLaw{Xmin: 3}
+	b.Fit([]float64{3, 4, 10}, nil)
+	if a.Alpha != b.Alpha {
+		t.Errorf("samples below Xmin changed the fit: %v != %v", a.Alpha, b.Alpha)
+	}
+	if !panics(func() { a.Fit([]float64{1, 2}, nil) }) {
+		t.Error("expected panic for no samples at least Xmin")
+	}
+	if !panics(func() { a.Fit([]float64{3.5}, nil) }) {
+		t.Error("expected panic for non-integer sample")
+	}
+}
+
+func TestDiscretePowerLawFitXmin(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewPCG(1, 1))
+
+	// A power-law tail above 20 with geometrically distributed
+	// small values below it.
+	tail := DiscretePowerLaw{Alpha: 2.5, Xmin: 20, Src: src}
+	x := make([]float64, 5000)
+	for i := range x {
+		if i%2 == 0 {
+			x[i] = tail.Rand()
+		} else {
+			x[i] = 1 + math.Floor(19*src.Float64()*src.Float64())
+		}
+	}
+	var d DiscretePowerLaw
+	ks := d.FitXmin(x)
+	if math.Abs(d.Xmin-20) > 5 {
+		t.Errorf("unexpected fitted Xmin: got %v, want about 20", d.Xmin)
+	}
+	if math.Abs(d.Alpha-2.5) > 0.15 {
+		t.Errorf("unexpected fitted Alpha: got %v, want about 2.5", d.Alpha)
+	}
+	if !(ks > 0 && ks < 0.05) {
+		t.Errorf("unexpected Kolmogorov-Smirnov distance: %v", ks)
+	}
+
+	if !panics(func() { d.FitXmin([]float64{2, 2, 2}) }) {
+		t.Error("expected panic for a single distinct value")
+	}
+	if !panics(func() { d.FitXmin([]float64{0, 2}) }) {
+		t.Error("expected panic for sample out of support")
+	}
+}
+
+func TestDiscretePowerLawGoodnessOfFit(t *testing.T) {
+	t.Parallel()
+	src := rand.NewPCG(1, 1)
+
+	d := DiscretePowerLaw{Alpha: 2.5, Xmin: 1, Src: src}
+	x := make([]float64, 300)
+	generateSamples(x, d)
+	fit := DiscretePowerLaw{Src: src}
+	if p := fit.GoodnessOfFit(x, 100); p < 0.1 {
+		t.Errorf("unexpected rejection of power-law samples: p=%v", p)
+	}
+
+	// Uniformly distributed samples are not power-law distributed.
+	src = rand.NewPCG(2, 2)
+	fit.Src = src
+	x = make([]float64, 2000)
+	rnd := rand.New(src)
+	for i := range x {
+		x[i] = float64(1 + rnd.IntN(100))
+	}
+	if p := fit.GoodnessOfFit(x, 100); p > 0.1 {
+		t.Errorf("unexpected acceptance of uniform samples: p=%v", p)
+	}
+
+	if !panics(func() { fit.GoodnessOfFit(x, 0) }) {
+		t.Error("expected panic for non-positive replicates")
+	}
+}
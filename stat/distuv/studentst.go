@@ -85,6 +85,28 @@ func (StudentsT) NumParameters() int {
 	return 3
 }
 
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (s StudentsT) Parameters(p []Parameter) []Parameter {
+	return parametersOf(p, "studentst", []string{"Mu", "Sigma", "Nu"}, s.Mu, s.Sigma, s.Nu)
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (s *StudentsT) SetParameters(p []Parameter) {
+	checkParameterNames(p, "studentst", "Mu", "Sigma", "Nu")
+	s.Mu = p[0].Value
+	s.Sigma = p[1].Value
+	s.Nu = p[2].Value
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (StudentsT) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "studentst", []string{"Mu", "Sigma", "Nu"}, realLine, positive, positive)
+}
+
 // Prob computes the value of the probability density function at x.
 func (s StudentsT) Prob(x float64) float64 {
 	return math.Exp(s.LogProb(x))
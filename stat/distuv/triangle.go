@@ -91,6 +91,13 @@ func (Triangle) NumParameters() int {
 	return 3
 }
 
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (Triangle) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "triangle", []string{"A", "B", "C"}, realLine, realLine, realLine)
+}
+
 // Prob computes the value of the probability density function at x.
 func (t Triangle) Prob(x float64) float64 {
 	switch {
@@ -233,8 +240,9 @@ func (t Triangle) Survival(x float64) float64 {
 	return 1 - t.CDF(x)
 }
 
-// parameters returns the parameters of the distribution.
-func (t Triangle) parameters(p []Parameter) []Parameter {
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (t Triangle) Parameters(p []Parameter) []Parameter {
 	nParam := t.NumParameters()
 	if p == nil {
 		p = make([]Parameter, nParam)
@@ -250,8 +258,9 @@ func (t Triangle) parameters(p []Parameter) []Parameter {
 	return p
 }
 
-// setParameters modifies the parameters of the distribution.
-func (t *Triangle) setParameters(p []Parameter) {
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (t *Triangle) SetParameters(p []Parameter) {
 	if len(p) != t.NumParameters() {
 		panic("triangle: incorrect number of parameters to set")
 	}
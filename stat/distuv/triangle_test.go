@@ -194,16 +194,16 @@ func TestTriangleScore(t *testing.T) {
 }
 
 func logProbDerivative(t Triangle, x float64, i int, h float64) float64 {
-	origParams := t.parameters(nil)
+	origParams := t.Parameters(nil)
 	params := make([]Parameter, len(origParams))
 	copy(params, origParams)
 	params[i].Value = origParams[i].Value + h
-	t.setParameters(params)
+	t.SetParameters(params)
 	lpUp := t.LogProb(x)
 	params[i].Value = origParams[i].Value - h
-	t.setParameters(params)
+	t.SetParameters(params)
 	lpDown := t.LogProb(x)
-	t.setParameters(origParams)
+	t.SetParameters(origParams)
 	return (lpUp - lpDown) / (2 * h)
 }
 
@@ -0,0 +1,223 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/mathext"
+	"gonum.org/v1/gonum/stat"
+)
+
+// Tweedie implements the Tweedie distribution with power variance parameter
+// in (1, 2), also known as the compound Poisson-gamma distribution. A Tweedie
+// random variable is the sum of N independent gamma random variables where N
+// is Poisson distributed, so it has a point mass at zero and is continuous on
+// the positive real numbers. It is commonly used to model insurance claim
+// amounts and rainfall totals.
+//
+// The distribution has mean μ and variance φ μ^p. The number of terms is
+// Poisson distributed with rate λ = μ^(2-p) / (φ (2-p)) and each term is gamma
+// distributed with shape α = (2-p) / (p-1) and rate β = 1 / (φ (p-1) μ^(p-1)).
+//
+// The density is evaluated by summing the series over the number of terms
+// as described in
+//
+//	Dunn, P. K. and Smyth, G. K. "Series evaluation of Tweedie exponential
+//	dispersion model densities", Statistics and Computing 15:267-280 (2005).
+//	doi:10.1007/s11222-005-4070-y
+//
+// For more information, see https://en.wikipedia.org/wiki/Tweedie_distribution.
+type Tweedie struct {
+	// Mu is the mean of the distribution. Mu must be greater than 0.
+	Mu float64
+	// Phi is the dispersion parameter of the distribution.
+	// Phi must be greater than 0.
+	Phi float64
+	// P is the power variance parameter of the
+	// distribution. P must be in (1, 2).
+	P float64
+
+	Src rand.Source
+}
+
+// params returns the Poisson rate, and the gamma shape and rate
+// of the compound Poisson representation of the distribution.
+func (t Tweedie) params() (lambda, alpha, beta float64) {
+	lambda = math.Pow(t.Mu, 2-t.P) / (t.Phi * (2 - t.P))
+	alpha = (2 - t.P) / (t.P - 1)
+	beta = 1 / (t.Phi * (t.P - 1) * math.Pow(t.Mu, t.P-1))
+	return lambda, alpha, beta
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (t Tweedie) CDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	lambda, alpha, beta := t.params()
+	return math.Exp(-lambda) + poissonMixture(lambda, func(n float64) float64 {
+		return mathext.GammaIncReg(n*alpha, beta*x)
+	})
+}
+
+// Fit sets the mean and dispersion parameters of the distribution from the
+// data samples x with relative weights w by the method of quasi-likelihood.
+// The power parameter P is not altered and must be set before calling Fit.
+// Mu is set to the weighted mean and Phi to the Pearson estimate of the
+// dispersion, the bias-corrected weighted variance divided by Mu^P.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+func (t *Tweedie) Fit(samples, weights []float64) {
+	if weights != nil && len(samples) != len(weights) {
+		panic(badLength)
+	}
+	if len(samples) == 0 {
+		panic(errNoSamples)
+	}
+	if !(1 < t.P && t.P < 2) {
+		panic("distuv: Tweedie power parameter out of range")
+	}
+	for _, x := range samples {
+		if x < 0 {
+			panic("distuv: negative Tweedie sample")
+		}
+	}
+	mean, variance := stat.MeanVariance(samples, weights)
+	t.Mu = mean
+	t.Phi = variance / math.Pow(mean, t.P)
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x. At zero, where the distribution has a point mass,
+// LogProb returns the log of the probability of zero.
+func (t Tweedie) LogProb(x float64) float64 {
+	if x < 0 {
+		return math.Inf(-1)
+	}
+	lambda, alpha, beta := t.params()
+	if x == 0 {
+		return -lambda
+	}
+
+	// The log of the n-th term of the series,
+	//  Poisson(n; λ) Gamma(x; nα, β),
+	// excluding the factors independent of n.
+	logLambda := math.Log(lambda)
+	logBetaX := math.Log(beta * x)
+	term := func(n float64) float64 {
+		lgn, _ := math.Lgamma(n + 1)
+		lgna, _ := math.Lgamma(n * alpha)
+		return n*logLambda - lgn + n*alpha*logBetaX - lgna
+	}
+
+	// The terms are log-concave in n, so sum outwards from
+	// the largest term until the terms are negligible.
+	const negligible = -37 // log(1e-16)
+	nMax := math.Max(1, math.Round(math.Pow(x, 2-t.P)/(t.Phi*(2-t.P))))
+	lmax := term(nMax)
+	sum := 1.0
+	for n := nMax + 1; ; n++ {
+		d := term(n) - lmax
+		if d < negligible {
+			break
+		}
+		sum += math.Exp(d)
+	}
+	for n := nMax - 1; n >= 1; n-- {
+		d := term(n) - lmax
+		if d < negligible {
+			break
+		}
+		sum += math.Exp(d)
+	}
+	return lmax + math.Log(sum) - lambda - beta*x - math.Log(x)
+}
+
+// Mean returns the mean of the probability distribution.
+func (t Tweedie) Mean() float64 {
+	return t.Mu
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (Tweedie) NumParameters() int {
+	return 3
+}
+
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (t Tweedie) Parameters(p []Parameter) []Parameter {
+	return parametersOf(p, "tweedie", []string{"Mu", "Phi", "P"}, t.Mu, t.Phi, t.P)
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (t *Tweedie) SetParameters(p []Parameter) {
+	checkParameterNames(p, "tweedie", "Mu", "Phi", "P")
+	t.Mu = p[0].Value
+	t.Phi = p[1].Value
+	t.P = p[2].Value
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (Tweedie) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "tweedie", []string{"Mu", "Phi", "P"}, positive, positive, ParameterBounds{Min: 1, Max: 2, OpenMin: true, OpenMax: true})
+}
+
+// Prob computes the value of the probability density function at x.
+// At zero, where the distribution has a point mass, Prob returns the
+// probability of zero.
+func (t Tweedie) Prob(x float64) float64 {
+	return math.Exp(t.LogProb(x))
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (t Tweedie) Rand() float64 {
+	lambda, alpha, beta := t.params()
+	n := Poisson{Lambda: lambda, Src: t.Src}.Rand()
+	if n == 0 {
+		return 0
+	}
+	return Gamma{Alpha: n * alpha, Beta: beta, Src: t.Src}.Rand()
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (t Tweedie) StdDev() float64 {
+	return math.Sqrt(t.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (t Tweedie) Survival(x float64) float64 {
+	if x < 0 {
+		return 1
+	}
+	lambda, alpha, beta := t.params()
+	return poissonMixture(lambda, func(n float64) float64 {
+		return mathext.GammaIncRegComp(n*alpha, beta*x)
+	})
+}
+
+// Variance returns the variance of the probability distribution.
+func (t Tweedie) Variance() float64 {
+	return t.Phi * math.Pow(t.Mu, t.P)
+}
+
+// poissonMixture returns the sum over n > 0 of the Poisson(n; λ)
+// probabilities weighted by f(n), truncated where the Poisson
+// probabilities are negligible.
+func poissonMixture(lambda float64, f func(n float64) float64) float64 {
+	width := 10*math.Sqrt(lambda) + 10
+	lo := math.Max(1, math.Floor(lambda-width))
+	hi := math.Ceil(lambda + width)
+	p := Poisson{Lambda: lambda}
+	var sum float64
+	for n := lo; n <= hi; n++ {
+		sum += p.Prob(n) * f(n)
+	}
+	return sum
+}
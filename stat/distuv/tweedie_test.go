@@ -0,0 +1,100 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/integrate/quad"
+)
+
+func TestTweedieProb(t *testing.T) {
+	t.Parallel()
+	// Values computed by direct summation of the compound
+	// Poisson-gamma series.
+	for _, test := range []struct {
+		x    float64
+		dist Tweedie
+		want float64
+	}{
+		{x: 1, dist: Tweedie{Mu: 1, Phi: 1, P: 1.5}, want: 0.3575016790048707},
+		{x: 0.1, dist: Tweedie{Mu: 2, Phi: 0.5, P: 1.2}, want: 0.022489654429004007},
+		{x: 10, dist: Tweedie{Mu: 3, Phi: 2, P: 1.8}, want: 0.014746819548349475},
+		{x: 50, dist: Tweedie{Mu: 5, Phi: 0.3, P: 1.6}, want: 3.339345195112224e-26},
+		{x: 0, dist: Tweedie{Mu: 1, Phi: 1, P: 1.5}, want: math.Exp(-2)},
+		{x: -1, dist: Tweedie{Mu: 1, Phi: 1, P: 1.5}, want: 0},
+	} {
+		got := test.dist.Prob(test.x)
+		if !scalar.EqualWithinRel(got, test.want, 1e-12) {
+			t.Errorf("unexpected Prob for %+v at %v: got %v, want %v", test.dist, test.x, got, test.want)
+		}
+	}
+}
+
+func TestTweedie(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewPCG(1, 1))
+	for i, d := range []Tweedie{
+		{Mu: 1, Phi: 1, P: 1.5, Src: src},
+		{Mu: 2, Phi: 0.5, P: 1.2, Src: src},
+		{Mu: 3, Phi: 2, P: 1.8, Src: src},
+		{Mu: 20, Phi: 0.1, P: 1.3, Src: src},
+	} {
+		const n = 1e5
+		x := make([]float64, n)
+		generateSamples(x, d)
+		sort.Float64s(x)
+
+		checkMean(t, i, x, d, 2e-2)
+		checkVarAndStd(t, i, x, d, 5e-2)
+
+		var zeros float64
+		for _, v := range x {
+			if v == 0 {
+				zeros++
+			}
+		}
+		if !scalar.EqualWithinAbs(zeros/n, d.Prob(0), 5e-3) {
+			t.Errorf("mismatch in probability of zero for case %d: got %v, want %v", i, zeros/n, d.Prob(0))
+		}
+
+		// The CDF must be the point mass at zero plus the integral
+		// of the density. The density is singular at zero when the
+		// gamma shape is less than one, so integrate in u = x^(1/4).
+		density := func(u float64) float64 {
+			return 4 * u * u * u * d.Prob(u*u*u*u)
+		}
+		for _, q := range []float64{0.25, 0.5, 0.75, 0.95} {
+			xq := x[int(q*n)]
+			if xq == 0 {
+				continue
+			}
+			want := d.Prob(0) + quad.Fixed(density, 0, math.Sqrt(math.Sqrt(xq)), 1000, nil, 0)
+			if got := d.CDF(xq); !scalar.EqualWithinAbs(got, want, 1e-6) {
+				t.Errorf("CDF mismatch for case %d at %v: got %v, want %v", i, xq, got, want)
+			}
+			if got := d.CDF(xq) + d.Survival(xq); !scalar.EqualWithinAbs(got, 1, 1e-12) {
+				t.Errorf("CDF and Survival mismatch for case %d at %v: sum %v", i, xq, got)
+			}
+			if !scalar.EqualWithinAbs(d.CDF(xq), q, 1e-2) {
+				t.Errorf("CDF mismatch with samples for case %d at %v: got %v, want %v", i, xq, d.CDF(xq), q)
+			}
+		}
+
+		fit := Tweedie{P: d.P}
+		fit.Fit(x, nil)
+		if !scalar.EqualWithinRel(fit.Mu, d.Mu, 2e-2) || !scalar.EqualWithinRel(fit.Phi, d.Phi, 5e-2) {
+			t.Errorf("unexpected fit for case %d: got %+v, want %+v", i, fit, d)
+		}
+	}
+
+	if !panics(func() { (&Tweedie{P: 2.5}).Fit([]float64{1, 2}, nil) }) {
+		t.Errorf("expected panic for power parameter out of range")
+	}
+}
@@ -57,8 +57,9 @@ func (u Uniform) LogProb(x float64) float64 {
 	return -math.Log(u.Max - u.Min)
 }
 
-// parameters returns the parameters of the distribution.
-func (u Uniform) parameters(p []Parameter) []Parameter {
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (u Uniform) Parameters(p []Parameter) []Parameter {
 	nParam := u.NumParameters()
 	if p == nil {
 		p = make([]Parameter, nParam)
@@ -89,6 +90,13 @@ func (Uniform) NumParameters() int {
 	return 2
 }
 
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (Uniform) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "uniform", []string{"Min", "Max"}, realLine, realLine)
+}
+
 // Prob computes the value of the probability density function at x.
 func (u Uniform) Prob(x float64) float64 {
 	if x < u.Min {
@@ -188,8 +196,9 @@ func (u Uniform) Survival(x float64) float64 {
 	return (u.Max - x) / (u.Max - u.Min)
 }
 
-// setParameters modifies the parameters of the distribution.
-func (u *Uniform) setParameters(p []Parameter) {
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (u *Uniform) SetParameters(p []Parameter) {
 	if len(p) != u.NumParameters() {
 		panic("uniform: incorrect number of parameters to set")
 	}
@@ -0,0 +1,278 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/mathext"
+	"gonum.org/v1/gonum/stat"
+)
+
+// VonMises implements the von Mises distribution, the circular analogue of
+// the normal distribution, for angles measured in radians.
+// The von Mises distribution has density function:
+//
+//	f(x) = exp(κ cos(x-μ)) / (2π I_0(κ))
+//
+// where I_0 is the modified Bessel function of the first kind of order zero.
+// The density is periodic with period 2π. The cumulative distribution
+// function is taken over the interval [μ-π, μ+π), and Rand returns
+// samples in that interval.
+//
+// For more information, see https://en.wikipedia.org/wiki/Von_Mises_distribution.
+type VonMises struct {
+	// Mu is the location, or circular mean, of the distribution.
+	Mu float64
+	// Kappa is the concentration of the distribution.
+	// Kappa must be non-negative. If Kappa is zero the
+	// distribution is uniform on the circle.
+	Kappa float64
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (v VonMises) CDF(x float64) float64 {
+	theta := x - v.Mu
+	switch {
+	case theta < -math.Pi:
+		return 0
+	case theta >= math.Pi:
+		return 1
+	}
+	// Use the series
+	//  F(θ) = (θ+π)/2π + 1/π Σ_{j≥1} I_j(κ)/I_0(κ) sin(jθ)/j.
+	sum := (theta + math.Pi) / (2 * math.Pi)
+	if v.Kappa == 0 {
+		return sum
+	}
+	ratios := besselIRatios(v.Kappa)
+	prod := 1.0
+	for j, r := range ratios {
+		prod *= r
+		n := float64(j + 1)
+		sum += prod * math.Sin(n*theta) / (n * math.Pi)
+	}
+	return math.Max(0, math.Min(1, sum))
+}
+
+// CircularVariance returns the circular variance of the distribution,
+// one minus the mean resultant length.
+func (v VonMises) CircularVariance() float64 {
+	return 1 - besselIRatio(v.Kappa)
+}
+
+// Entropy returns the differential entropy of the distribution.
+func (v VonMises) Entropy() float64 {
+	return math.Log(2*math.Pi) + logBesselI0(v.Kappa) - v.Kappa*besselIRatio(v.Kappa)
+}
+
+// Fit sets the parameters of the probability distribution from the
+// angles x with relative weights w by maximum likelihood. Mu is set to
+// the circular mean and Kappa to the concentration whose mean resultant
+// length matches that of the samples.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+func (v *VonMises) Fit(samples, weights []float64) {
+	if weights != nil && len(samples) != len(weights) {
+		panic(badLength)
+	}
+	if len(samples) == 0 {
+		panic(errNoSamples)
+	}
+	v.Mu = stat.CircularMean(samples, weights)
+	v.Kappa = vonMisesConcentration(stat.MeanResultantLength(samples, weights))
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (v VonMises) LogProb(x float64) float64 {
+	return v.Kappa*math.Cos(x-v.Mu) - math.Log(2*math.Pi) - logBesselI0(v.Kappa)
+}
+
+// Mean returns the circular mean of the probability distribution.
+func (v VonMises) Mean() float64 {
+	return v.Mu
+}
+
+// Median returns the median of the probability distribution.
+func (v VonMises) Median() float64 {
+	return v.Mu
+}
+
+// Mode returns the mode of the probability distribution.
+func (v VonMises) Mode() float64 {
+	return v.Mu
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (VonMises) NumParameters() int {
+	return 2
+}
+
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (v VonMises) Parameters(p []Parameter) []Parameter {
+	return parametersOf(p, "vonmises", []string{"Mu", "Kappa"}, v.Mu, v.Kappa)
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (v *VonMises) SetParameters(p []Parameter) {
+	checkParameterNames(p, "vonmises", "Mu", "Kappa")
+	v.Mu = p[0].Value
+	v.Kappa = p[1].Value
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (VonMises) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "vonmises", []string{"Mu", "Kappa"}, realLine, nonNeg)
+}
+
+// Prob computes the value of the probability density function at x.
+func (v VonMises) Prob(x float64) float64 {
+	return math.Exp(v.LogProb(x))
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (v VonMises) Rand() float64 {
+	// Use the algorithm in
+	//  Best, D. J. and Fisher, N. I. "Efficient simulation of the von Mises
+	//  distribution", Applied Statistics 28:152-157 (1979).
+	//  doi:10.2307/2346732
+	rnd := rand.Float64
+	nrm := rand.NormFloat64
+	if v.Src != nil {
+		rng := rand.New(v.Src)
+		rnd = rng.Float64
+		nrm = rng.NormFloat64
+	}
+	switch {
+	case v.Kappa < 1e-8:
+		return v.Mu + math.Pi*(2*rnd()-1)
+	case v.Kappa > 1e6:
+		// The distribution is indistinguishable from a normal
+		// distribution with variance 1/κ.
+		return wrapAngle(nrm()/math.Sqrt(v.Kappa), v.Mu)
+	}
+	tau := 1 + math.Sqrt(1+4*v.Kappa*v.Kappa)
+	rho := (tau - math.Sqrt(2*tau)) / (2 * v.Kappa)
+	s := (1 + rho*rho) / (2 * rho)
+	var w float64
+	for {
+		z := math.Cos(math.Pi * rnd())
+		w = (1 + s*z) / (s + z)
+		y := v.Kappa * (s - w)
+		u := rnd()
+		if y*(2-y)-u >= 0 || math.Log(y/u)+1-y >= 0 {
+			break
+		}
+	}
+	theta := math.Acos(math.Max(-1, math.Min(1, w)))
+	if rnd() < 0.5 {
+		theta = -theta
+	}
+	return wrapAngle(theta, v.Mu)
+}
+
+// wrapAngle returns theta+mu wrapped into the interval [mu-π, mu+π).
+func wrapAngle(theta, mu float64) float64 {
+	theta = math.Mod(theta+math.Pi, 2*math.Pi)
+	if theta < 0 {
+		theta += 2 * math.Pi
+	}
+	return mu + theta - math.Pi
+}
+
+// logBesselI0 returns log(I_0(x)) for x ≥ 0 without overflow.
+func logBesselI0(x float64) float64 {
+	if x < 500 {
+		return math.Log(mathext.BesselI(0, x))
+	}
+	// Use the asymptotic expansion
+	//  I_0(x) ~ e^x / sqrt(2πx) Σ_k ((2k-1)!!)² / (k! (8x)^k).
+	var sum, term float64 = 1, 1
+	for k := 1.0; k <= 8; k++ {
+		term *= (2*k - 1) * (2*k - 1) / (k * 8 * x)
+		sum += term
+	}
+	return x - 0.5*math.Log(2*math.Pi*x) + math.Log(sum)
+}
+
+// besselIRatio returns I_1(x)/I_0(x) for x ≥ 0, the mean resultant
+// length of the von Mises distribution with concentration x.
+func besselIRatio(x float64) float64 {
+	if x < 500 {
+		return mathext.BesselI(1, x) / mathext.BesselI(0, x)
+	}
+	// Use the asymptotic expansion in 1/x.
+	t := 1 / x
+	return 1 - t*(0.5+t*(0.125+t*(0.125+t*(25.0/128+t*(13.0/32)))))
+}
+
+// besselIRatios returns the successive ratios I_j(x)/I_{j-1}(x) for
+// j = 1, 2, ... until their product is negligible, computed by backward
+// recurrence.
+func besselIRatios(x float64) []float64 {
+	// The ratios decay like exp(-j²/2x) for large x, so about
+	// sqrt(74x) terms are needed. Starting the recurrence further
+	// out allows the error in the starting value to decay.
+	n := int(math.Ceil(11*math.Sqrt(x) + 30))
+	ratios := make([]float64, n)
+	var r float64
+	for j := n; j >= 1; j-- {
+		r = 1 / (2*float64(j)/x + r)
+		ratios[j-1] = r
+	}
+	prod := 1.0
+	for j, r := range ratios {
+		prod *= r
+		if prod < 1e-17 {
+			return ratios[:j+1]
+		}
+	}
+	return ratios
+}
+
+// vonMisesConcentration returns the concentration of the von Mises
+// distribution with mean resultant length r, solving
+//
+//	I_1(κ)/I_0(κ) = r
+//
+// by Newton's method.
+func vonMisesConcentration(r float64) float64 {
+	switch {
+	case r <= 0:
+		return 0
+	case r >= 1:
+		return math.Inf(1)
+	}
+	// Initial approximation from
+	//  Fisher, N. I. "Statistical Analysis of Circular Data",
+	//  Cambridge University Press (1993), section 4.5.5.
+	var kappa float64
+	switch {
+	case r < 0.53:
+		kappa = 2*r + r*r*r + 5*r*r*r*r*r/6
+	case r < 0.85:
+		kappa = -0.4 + 1.39*r + 0.43/(1-r)
+	default:
+		kappa = 1 / (r*r*r - 4*r*r + 3*r)
+	}
+	for i := 0; i < 100; i++ {
+		a := besselIRatio(kappa)
+		// The derivative of I_1(κ)/I_0(κ) is 1 - a/κ - a².
+		step := (a - r) / (1 - a/kappa - a*a)
+		kappa = math.Max(kappa/2, kappa-step)
+		if math.Abs(step) <= 1e-14*kappa {
+			break
+		}
+	}
+	return kappa
+}
@@ -0,0 +1,123 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/integrate/quad"
+	"gonum.org/v1/gonum/mathext"
+	"gonum.org/v1/gonum/stat"
+)
+
+type circularDist interface {
+	entropyer
+	Rander
+	CDF(x float64) float64
+	Prob(x float64) float64
+	Median() float64
+	CircularVariance() float64
+}
+
+func testCircularDist(t *testing.T, cas int, d circularDist, mu float64) {
+	t.Helper()
+	const n = 1e5
+	x := make([]float64, n)
+	generateSamples(x, d)
+	sort.Float64s(x)
+
+	if x[0] < mu-math.Pi || x[len(x)-1] >= mu+math.Pi {
+		t.Errorf("samples out of range for case %d: [%v, %v]", cas, x[0], x[len(x)-1])
+	}
+	// The circular mean is not defined for the uniform distribution.
+	if got := stat.CircularMean(x, nil); d.CircularVariance() < 1 && !scalar.EqualWithinAbs(math.Remainder(got-mu, 2*math.Pi), 0, 2e-2) {
+		t.Errorf("circular mean mismatch for case %d: got %v, want %v", cas, got, mu)
+	}
+	if got := stat.CircularVariance(x, nil); !scalar.EqualWithinAbs(got, d.CircularVariance(), 1e-2) {
+		t.Errorf("circular variance mismatch for case %d: got %v, want %v", cas, got, d.CircularVariance())
+	}
+	if got := stat.Quantile(0.5, stat.Empirical, x, nil); !scalar.EqualWithinAbs(got, d.Median(), 2e-2) {
+		t.Errorf("median mismatch for case %d: got %v, want %v", cas, got, d.Median())
+	}
+	checkEntropy(t, cas, x, d, 1e-2)
+
+	if d.CDF(mu-math.Pi-1e-10) != 0 || d.CDF(mu+math.Pi) != 1 {
+		t.Errorf("unexpected CDF outside range for case %d", cas)
+	}
+	total := quad.Fixed(d.Prob, mu-math.Pi, mu+math.Pi, 1000, nil, 0)
+	if !scalar.EqualWithinAbs(total, 1, 1e-10) {
+		t.Errorf("density does not integrate to one for case %d: %v", cas, total)
+	}
+	for _, q := range []float64{0.01, 0.1, 0.3, 0.5, 0.7, 0.9, 0.99} {
+		xq := x[int(q*n)]
+		if got := d.CDF(xq); !scalar.EqualWithinAbs(got, q, 1e-2) {
+			t.Errorf("CDF mismatch with samples for case %d at %v: got %v, want %v", cas, xq, got, q)
+		}
+		want := quad.Fixed(d.Prob, mu-math.Pi, xq, 1000, nil, 0)
+		if got := d.CDF(xq); !scalar.EqualWithinAbs(got, want, 1e-10) {
+			t.Errorf("CDF mismatch with density for case %d at %v: got %v, want %v", cas, xq, got, want)
+		}
+	}
+}
+
+func TestVonMises(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewPCG(1, 1))
+	for i, d := range []VonMises{
+		{Mu: 0, Kappa: 0, Src: src},
+		{Mu: 1, Kappa: 0.5, Src: src},
+		{Mu: -2, Kappa: 4, Src: src},
+		{Mu: 3, Kappa: 50, Src: src},
+	} {
+		testCircularDist(t, i, d, d.Mu)
+
+		x := make([]float64, 1e5)
+		generateSamples(x, d)
+		fit := VonMises{Mu: 10, Kappa: 10}
+		fit.Fit(x, nil)
+		if !scalar.EqualWithinAbsOrRel(fit.Kappa, d.Kappa, 2e-2, 2e-2) {
+			t.Errorf("unexpected concentration fit for case %d: got %v, want %v", i, fit.Kappa, d.Kappa)
+		}
+	}
+
+	// The expansions for large concentrations must agree
+	// with the direct evaluations where they meet.
+	for _, x := range []float64{500, 600, 700} {
+		wantLog := math.Log(mathext.BesselI(0, x))
+		wantRatio := mathext.BesselI(1, x) / mathext.BesselI(0, x)
+		if got := logBesselI0(x); !scalar.EqualWithinRel(got, wantLog, 1e-14) {
+			t.Errorf("unexpected log I_0(%v): got %v, want %v", x, got, wantLog)
+		}
+		if got := besselIRatio(x); !scalar.EqualWithinRel(got, wantRatio, 1e-14) {
+			t.Errorf("unexpected I_1/I_0(%v): got %v, want %v", x, got, wantRatio)
+		}
+	}
+	for _, kappa := range []float64{1e-3, 0.3, 1, 3, 30, 300, 3000} {
+		if got := vonMisesConcentration(besselIRatio(kappa)); !scalar.EqualWithinRel(got, kappa, 1e-10) {
+			t.Errorf("unexpected concentration: got %v, want %v", got, kappa)
+		}
+	}
+}
+
+func TestWrappedCauchy(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewPCG(1, 1))
+	for i, d := range []WrappedCauchy{
+		{Mu: 0, Rho: 0, Src: src},
+		{Mu: 1, Rho: 0.3, Src: src},
+		{Mu: -2, Rho: 0.8, Src: src},
+	} {
+		testCircularDist(t, i, d, d.Mu)
+		for _, p := range []float64{0, 0.1, 0.5, 0.9} {
+			if got := d.CDF(d.Quantile(p)); !scalar.EqualWithinAbs(got, p, 1e-14) {
+				t.Errorf("Quantile and CDF mismatch for case %d at %v: got %v", i, p, got)
+			}
+		}
+	}
+}
@@ -97,6 +97,13 @@ func (Weibull) NumParameters() int {
 	return 2
 }
 
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (Weibull) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "weibull", []string{"K", "λ"}, positive, positive)
+}
+
 // Prob computes the value of the probability density function at x.
 func (w Weibull) Prob(x float64) float64 {
 	if x < 0 {
@@ -194,8 +201,9 @@ func (w Weibull) Survival(x float64) float64 {
 	return math.Exp(w.LogSurvival(x))
 }
 
-// setParameters modifies the parameters of the distribution.
-func (w *Weibull) setParameters(p []Parameter) {
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (w *Weibull) SetParameters(p []Parameter) {
 	if len(p) != w.NumParameters() {
 		panic("weibull: incorrect number of parameters to set")
 	}
@@ -214,8 +222,9 @@ func (w Weibull) Variance() float64 {
 	return math.Pow(w.Lambda, 2) * (math.Gamma(1+2/w.K) - w.gammaIPow(1, 2))
 }
 
-// parameters returns the parameters of the distribution.
-func (w Weibull) parameters(p []Parameter) []Parameter {
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (w Weibull) Parameters(p []Parameter) []Parameter {
 	nParam := w.NumParameters()
 	if p == nil {
 		p = make([]Parameter, nParam)
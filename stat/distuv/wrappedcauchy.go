@@ -0,0 +1,127 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+// WrappedCauchy implements the wrapped Cauchy distribution, the result of
+// wrapping the Cauchy distribution around the unit circle, for angles
+// measured in radians.
+// The wrapped Cauchy distribution has density function:
+//
+//	f(x) = (1-ρ²) / (2π (1 + ρ² - 2ρ cos(x-μ)))
+//
+// The density is periodic with period 2π. The cumulative distribution
+// function is taken over the interval [μ-π, μ+π), and Rand returns
+// samples in that interval.
+//
+// For more information, see https://en.wikipedia.org/wiki/Wrapped_Cauchy_distribution.
+type WrappedCauchy struct {
+	// Mu is the location, or circular mean, of the distribution.
+	Mu float64
+	// Rho is the mean resultant length of the distribution. Rho
+	// must be in [0, 1). If Rho is zero the distribution is uniform
+	// on the circle.
+	Rho float64
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (w WrappedCauchy) CDF(x float64) float64 {
+	theta := x - w.Mu
+	switch {
+	case theta < -math.Pi:
+		return 0
+	case theta >= math.Pi:
+		return 1
+	}
+	return 0.5 + math.Atan((1+w.Rho)/(1-w.Rho)*math.Tan(theta/2))/math.Pi
+}
+
+// CircularVariance returns the circular variance of the distribution,
+// one minus the mean resultant length.
+func (w WrappedCauchy) CircularVariance() float64 {
+	return 1 - w.Rho
+}
+
+// Entropy returns the differential entropy of the distribution.
+func (w WrappedCauchy) Entropy() float64 {
+	return math.Log(2 * math.Pi * (1 - w.Rho*w.Rho))
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (w WrappedCauchy) LogProb(x float64) float64 {
+	return math.Log1p(-w.Rho*w.Rho) - math.Log(2*math.Pi*(1+w.Rho*w.Rho-2*w.Rho*math.Cos(x-w.Mu)))
+}
+
+// Mean returns the circular mean of the probability distribution.
+func (w WrappedCauchy) Mean() float64 {
+	return w.Mu
+}
+
+// Median returns the median of the probability distribution.
+func (w WrappedCauchy) Median() float64 {
+	return w.Mu
+}
+
+// Mode returns the mode of the probability distribution.
+func (w WrappedCauchy) Mode() float64 {
+	return w.Mu
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (WrappedCauchy) NumParameters() int {
+	return 2
+}
+
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (w WrappedCauchy) Parameters(p []Parameter) []Parameter {
+	return parametersOf(p, "wrappedcauchy", []string{"Mu", "Rho"}, w.Mu, w.Rho)
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (w *WrappedCauchy) SetParameters(p []Parameter) {
+	checkParameterNames(p, "wrappedcauchy", "Mu", "Rho")
+	w.Mu = p[0].Value
+	w.Rho = p[1].Value
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (WrappedCauchy) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "wrappedcauchy", []string{"Mu", "Rho"}, realLine, ParameterBounds{Min: 0, Max: 1, OpenMax: true})
+}
+
+// Prob computes the value of the probability density function at x.
+func (w WrappedCauchy) Prob(x float64) float64 {
+	return math.Exp(w.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative distribution function.
+func (w WrappedCauchy) Quantile(p float64) float64 {
+	if p < 0 || p > 1 {
+		panic(badPercentile)
+	}
+	return w.Mu + 2*math.Atan((1-w.Rho)/(1+w.Rho)*math.Tan(math.Pi*(p-0.5)))
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (w WrappedCauchy) Rand() float64 {
+	var p float64
+	if w.Src == nil {
+		p = rand.Float64()
+	} else {
+		p = rand.New(w.Src).Float64()
+	}
+	return w.Quantile(p)
+}
@@ -0,0 +1,611 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+
+	"gonum.org/v1/gonum/mathext"
+)
+
+const (
+	badCount = "distuv: count sample must be a non-negative integer"
+
+	// emTol and emMaxIter control the convergence
+	// of the expectation-maximisation fits.
+	emTol     = 1e-10
+	emMaxIter = 10000
+)
+
+// ZeroInflatedPoisson implements the zero-inflated Poisson distribution,
+// a mixture of a point mass at zero and a Poisson distribution. It models
+// count data with more zeros than a Poisson distribution allows.
+// The zero-inflated Poisson distribution has density function:
+//
+//	f(0) = π + (1-π) e^(-λ)
+//	f(k) = (1-π) λ^k / k! e^(-λ), k > 0
+//
+// For more information, see https://en.wikipedia.org/wiki/Zero-inflated_model.
+type ZeroInflatedPoisson struct {
+	// Pi is the probability of an excess zero.
+	// Pi must be in [0, 1].
+	Pi float64
+	// Lambda is the mean of the Poisson component.
+	// Lambda must be greater than 0.
+	Lambda float64
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (z ZeroInflatedPoisson) CDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return z.Pi + (1-z.Pi)*Poisson{Lambda: z.Lambda}.CDF(x)
+}
+
+// Fit sets the parameters of the probability distribution from the
+// count data samples x with relative weights w using the
+// expectation-maximisation algorithm.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+// Fit panics if any sample is not a non-negative integer. If all the
+// samples are zero, Pi is set to 1 and Lambda is left unchanged.
+func (z *ZeroInflatedPoisson) Fit(samples, weights []float64) {
+	c := newCounts(samples, weights)
+	if c.sum == 0 {
+		z.Pi = 1
+		return
+	}
+
+	// Start from a Poisson component fitted
+	// to half of the observed zeros.
+	pi := c.zeros / c.total / 2
+	lambda := c.sum / (c.total * (1 - pi))
+	for i := 0; i < emMaxIter; i++ {
+		// E-step: the expected weight of the excess zeros.
+		excess := 0.0
+		if c.zeros > 0 {
+			excess = c.zeros * pi / (pi + (1-pi)*math.Exp(-lambda))
+		}
+
+		// M-step.
+		newPi := excess / c.total
+		newLambda := c.sum / (c.total - excess)
+		done := math.Abs(newPi-pi) <= emTol && math.Abs(newLambda-lambda) <= emTol*newLambda
+		pi, lambda = newPi, newLambda
+		if done {
+			break
+		}
+	}
+	z.Pi = pi
+	z.Lambda = lambda
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (z ZeroInflatedPoisson) LogProb(x float64) float64 {
+	if x < 0 || math.Floor(x) != x {
+		return math.Inf(-1)
+	}
+	if x == 0 {
+		return math.Log(z.Pi + (1-z.Pi)*math.Exp(-z.Lambda))
+	}
+	return math.Log1p(-z.Pi) + Poisson{Lambda: z.Lambda}.LogProb(x)
+}
+
+// Mean returns the mean of the probability distribution.
+func (z ZeroInflatedPoisson) Mean() float64 {
+	return (1 - z.Pi) * z.Lambda
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (ZeroInflatedPoisson) NumParameters() int {
+	return 2
+}
+
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (z ZeroInflatedPoisson) Parameters(p []Parameter) []Parameter {
+	return parametersOf(p, "zeroinflatedpoisson", []string{"Pi", "Lambda"}, z.Pi, z.Lambda)
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (z *ZeroInflatedPoisson) SetParameters(p []Parameter) {
+	checkParameterNames(p, "zeroinflatedpoisson", "Pi", "Lambda")
+	z.Pi = p[0].Value
+	z.Lambda = p[1].Value
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (ZeroInflatedPoisson) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "zeroinflatedpoisson", []string{"Pi", "Lambda"}, unit, positive)
+}
+
+// Prob computes the value of the probability density function at x.
+func (z ZeroInflatedPoisson) Prob(x float64) float64 {
+	return math.Exp(z.LogProb(x))
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (z ZeroInflatedPoisson) Rand() float64 {
+	if uniform(z.Src) < z.Pi {
+		return 0
+	}
+	return Poisson{Lambda: z.Lambda, Src: z.Src}.Rand()
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (z ZeroInflatedPoisson) StdDev() float64 {
+	return math.Sqrt(z.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (z ZeroInflatedPoisson) Survival(x float64) float64 {
+	if x < 0 {
+		return 1
+	}
+	return (1 - z.Pi) * mathext.GammaIncReg(math.Floor(x)+1, z.Lambda)
+}
+
+// Variance returns the variance of the probability distribution.
+func (z ZeroInflatedPoisson) Variance() float64 {
+	return (1 - z.Pi) * z.Lambda * (1 + z.Pi*z.Lambda)
+}
+
+// ZeroInflatedNegativeBinomial implements the zero-inflated negative binomial
+// distribution, a mixture of a point mass at zero and a negative binomial
+// distribution. It models overdispersed count data with more zeros than a
+// negative binomial distribution allows.
+// The zero-inflated negative binomial distribution has density function:
+//
+//	f(0) = π + (1-π) p^r
+//	f(k) = (1-π) Γ(k+r) / (k! Γ(r)) p^r (1-p)^k, k > 0
+//
+// For more information, see https://en.wikipedia.org/wiki/Zero-inflated_model
+// and https://en.wikipedia.org/wiki/Negative_binomial_distribution.
+type ZeroInflatedNegativeBinomial struct {
+	// Pi is the probability of an excess zero.
+	// Pi must be in [0, 1].
+	Pi float64
+	// R is the shape, or number of successes, parameter of
+	// the negative binomial component. R must be greater than 0.
+	R float64
+	// P is the success probability of the negative binomial
+	// component. P must be in (0, 1].
+	P float64
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (z ZeroInflatedNegativeBinomial) CDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	if z.P == 1 {
+		return 1
+	}
+	return z.Pi + (1-z.Pi)*mathext.RegIncBeta(z.R, math.Floor(x)+1, z.P)
+}
+
+// Fit sets the parameters of the probability distribution from the
+// count data samples x with relative weights w using the
+// expectation-maximisation algorithm.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+// Fit panics if any sample is not a non-negative integer. If all the
+// samples are zero, Pi is set to 1 and R and P are left unchanged.
+// If the non-zero counts are underdispersed, the fitted R is large,
+// approaching the zero-inflated Poisson limit.
+func (z *ZeroInflatedNegativeBinomial) Fit(samples, weights []float64) {
+	c := newCounts(samples, weights)
+	if c.sum == 0 {
+		z.Pi = 1
+		return
+	}
+
+	pi := c.zeros / c.total / 2
+	mean := c.sum / (c.total * (1 - pi))
+	r := 1.0
+	p := r / (r + mean)
+	for i := 0; i < emMaxIter; i++ {
+		// E-step: the expected weight of the excess zeros.
+		excess := 0.0
+		if c.zeros > 0 {
+			excess = c.zeros * pi / (pi + (1-pi)*math.Pow(p, r))
+		}
+
+		// M-step. For a given shape the maximum likelihood success
+		// probability is p = r n / (r n + s) where n is the weight
+		// assigned to the negative binomial component and s is the
+		// sum of the counts, so only the shape needs to be searched.
+		n := c.total - excess
+		newPi := excess / c.total
+		newR := c.nbShape(n, r)
+		newP := newR * n / (newR*n + c.sum)
+		done := math.Abs(newPi-pi) <= emTol && math.Abs(newR-r) <= emTol*newR
+		pi, r, p = newPi, newR, newP
+		if done {
+			break
+		}
+	}
+	z.Pi = pi
+	z.R = r
+	z.P = p
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (z ZeroInflatedNegativeBinomial) LogProb(x float64) float64 {
+	if x < 0 || math.Floor(x) != x {
+		return math.Inf(-1)
+	}
+	if x == 0 {
+		return math.Log(z.Pi + (1-z.Pi)*math.Pow(z.P, z.R))
+	}
+	return math.Log1p(-z.Pi) + negBinomialLogProb(x, z.R, z.P)
+}
+
+// Mean returns the mean of the probability distribution.
+func (z ZeroInflatedNegativeBinomial) Mean() float64 {
+	return (1 - z.Pi) * z.R * (1 - z.P) / z.P
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (ZeroInflatedNegativeBinomial) NumParameters() int {
+	return 3
+}
+
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (z ZeroInflatedNegativeBinomial) Parameters(p []Parameter) []Parameter {
+	return parametersOf(p, "zeroinflatednegativebinomial", []string{"Pi", "R", "P"}, z.Pi, z.R, z.P)
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (z *ZeroInflatedNegativeBinomial) SetParameters(p []Parameter) {
+	checkParameterNames(p, "zeroinflatednegativebinomial", "Pi", "R", "P")
+	z.Pi = p[0].Value
+	z.R = p[1].Value
+	z.P = p[2].Value
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (ZeroInflatedNegativeBinomial) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "zeroinflatednegativebinomial", []string{"Pi", "R", "P"}, unit, positive, ParameterBounds{Min: 0, Max: 1, OpenMin: true})
+}
+
+// Prob computes the value of the probability density function at x.
+func (z ZeroInflatedNegativeBinomial) Prob(x float64) float64 {
+	return math.Exp(z.LogProb(x))
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (z ZeroInflatedNegativeBinomial) Rand() float64 {
+	if z.P == 1 || uniform(z.Src) < z.Pi {
+		return 0
+	}
+	// The negative binomial distribution is a gamma mixture
+	// of Poisson distributions.
+	lambda := Gamma{Alpha: z.R, Beta: z.P / (1 - z.P), Src: z.Src}.Rand()
+	return Poisson{Lambda: lambda, Src: z.Src}.Rand()
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (z ZeroInflatedNegativeBinomial) StdDev() float64 {
+	return math.Sqrt(z.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (z ZeroInflatedNegativeBinomial) Survival(x float64) float64 {
+	if x < 0 {
+		return 1
+	}
+	if z.P == 1 {
+		return 0
+	}
+	return (1 - z.Pi) * mathext.RegIncBeta(math.Floor(x)+1, z.R, 1-z.P)
+}
+
+// Variance returns the variance of the probability distribution.
+func (z ZeroInflatedNegativeBinomial) Variance() float64 {
+	mu := z.R * (1 - z.P) / z.P
+	return (1 - z.Pi) * mu * (1/z.P + z.Pi*mu)
+}
+
+// HurdlePoisson implements the Poisson hurdle distribution, in which zeros
+// occur with probability π and positive counts follow a zero-truncated
+// Poisson distribution. Unlike the zero-inflated Poisson distribution,
+// all zeros arise from the hurdle, so the model also allows fewer zeros
+// than a Poisson distribution.
+// The Poisson hurdle distribution has density function:
+//
+//	f(0) = π
+//	f(k) = (1-π) λ^k / (k! (e^λ - 1)), k > 0
+//
+// For more information, see https://en.wikipedia.org/wiki/Hurdle_model.
+type HurdlePoisson struct {
+	// Pi is the probability of a zero. Pi must be in [0, 1].
+	Pi float64
+	// Lambda is the rate of the truncated Poisson component.
+	// Lambda must be non-negative. In the limit of Lambda
+	// equal to zero, all positive counts are one.
+	Lambda float64
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (h HurdlePoisson) CDF(x float64) float64 {
+	return 1 - h.Survival(x)
+}
+
+// Fit sets the parameters of the probability distribution from the
+// count data samples x with relative weights w by maximum likelihood.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+// Fit panics if any sample is not a non-negative integer. If all the
+// samples are zero, Pi is set to 1 and Lambda is left unchanged.
+func (h *HurdlePoisson) Fit(samples, weights []float64) {
+	c := newCounts(samples, weights)
+	h.Pi = c.zeros / c.total
+	if c.sum == 0 {
+		return
+	}
+
+	// The rate solves λ / (1 - e^-λ) = m where m is the mean
+	// of the positive counts. The left hand side is convex and
+	// increasing, so Newton's method from above converges.
+	m := c.sum / (c.total - c.zeros)
+	if m == 1 {
+		h.Lambda = 0
+		return
+	}
+	lambda := m
+	for i := 0; i < emMaxIter; i++ {
+		e := -math.Expm1(-lambda)
+		f := lambda/e - m
+		df := (e - lambda*math.Exp(-lambda)) / (e * e)
+		step := f / df
+		lambda -= step
+		if math.Abs(step) <= emTol*lambda {
+			break
+		}
+	}
+	h.Lambda = lambda
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (h HurdlePoisson) LogProb(x float64) float64 {
+	if x < 0 || math.Floor(x) != x {
+		return math.Inf(-1)
+	}
+	if x == 0 {
+		return math.Log(h.Pi)
+	}
+	if h.Lambda == 0 {
+		if x == 1 {
+			return math.Log1p(-h.Pi)
+		}
+		return math.Inf(-1)
+	}
+	lg, _ := math.Lgamma(x + 1)
+	return math.Log1p(-h.Pi) + x*math.Log(h.Lambda) - lg - math.Log(math.Expm1(h.Lambda))
+}
+
+// Mean returns the mean of the probability distribution.
+func (h HurdlePoisson) Mean() float64 {
+	return (1 - h.Pi) * h.truncatedMean()
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (HurdlePoisson) NumParameters() int {
+	return 2
+}
+
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (h HurdlePoisson) Parameters(p []Parameter) []Parameter {
+	return parametersOf(p, "hurdlepoisson", []string{"Pi", "Lambda"}, h.Pi, h.Lambda)
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (h *HurdlePoisson) SetParameters(p []Parameter) {
+	checkParameterNames(p, "hurdlepoisson", "Pi", "Lambda")
+	h.Pi = p[0].Value
+	h.Lambda = p[1].Value
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (HurdlePoisson) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	return boundsOf(b, "hurdlepoisson", []string{"Pi", "Lambda"}, unit, nonNeg)
+}
+
+// Prob computes the value of the probability density function at x.
+func (h HurdlePoisson) Prob(x float64) float64 {
+	return math.Exp(h.LogProb(x))
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (h HurdlePoisson) Rand() float64 {
+	if uniform(h.Src) < h.Pi {
+		return 0
+	}
+	// Invert the truncated distribution function so that
+	// no samples are rejected for small rates.
+	u := uniform(h.Src)
+	if u == 0 || h.Lambda == 0 {
+		return 1
+	}
+	p := h.Lambda / math.Expm1(h.Lambda)
+	k := 1.0
+	for u > p {
+		u -= p
+		k++
+		p *= h.Lambda / k
+		if p == 0 {
+			break
+		}
+	}
+	return k
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (h HurdlePoisson) StdDev() float64 {
+	return math.Sqrt(h.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (h HurdlePoisson) Survival(x float64) float64 {
+	if x < 0 {
+		return 1
+	}
+	if x < 1 {
+		return 1 - h.Pi
+	}
+	if h.Lambda == 0 {
+		return 0
+	}
+	// P(X > k | X > 0) = P(Y > k) / P(Y > 0) for Y ~ Poisson(λ).
+	return (1 - h.Pi) * mathext.GammaIncReg(math.Floor(x)+1, h.Lambda) / -math.Expm1(-h.Lambda)
+}
+
+// Variance returns the variance of the probability distribution.
+func (h HurdlePoisson) Variance() float64 {
+	// The second moment of the truncated Poisson
+	// distribution is λ + 1 times its mean.
+	mu := h.truncatedMean()
+	return (1-h.Pi)*(1+h.Lambda)*mu - (1-h.Pi)*(1-h.Pi)*mu*mu
+}
+
+// truncatedMean returns the mean of the zero-truncated
+// Poisson component, λ / (1 - e^-λ).
+func (h HurdlePoisson) truncatedMean() float64 {
+	if h.Lambda == 0 {
+		return 1
+	}
+	return h.Lambda / -math.Expm1(-h.Lambda)
+}
+
+// negBinomialLogProb returns the log of the negative binomial
+// probability of k failures before r successes with success
+// probability p.
+func negBinomialLogProb(k, r, p float64) float64 {
+	lgkr, _ := math.Lgamma(k + r)
+	lgk, _ := math.Lgamma(k + 1)
+	lgr, _ := math.Lgamma(r)
+	return lgkr - lgk - lgr + r*math.Log(p) + k*math.Log1p(-p)
+}
+
+// uniform returns a uniform random variate in [0, 1) from src, or
+// from the global source if src is nil.
+func uniform(src rand.Source) float64 {
+	if src == nil {
+		return rand.Float64()
+	}
+	return rand.New(src).Float64()
+}
+
+// counts holds the weighted summary of count data used by the
+// fits of the zero-inflated and hurdle distributions.
+type counts struct {
+	// total, zeros and sum are the total weight, the
+	// weight of zero samples and the weighted sum of
+	// the samples.
+	total, zeros, sum float64
+
+	// values and weights are the distinct positive
+	// counts and their total weights.
+	values, weights []float64
+}
+
+func newCounts(samples, weights []float64) counts {
+	if weights != nil && len(samples) != len(weights) {
+		panic(badLength)
+	}
+	if len(samples) == 0 {
+		panic(errNoSamples)
+	}
+	w := make(map[float64]float64)
+	var c counts
+	for i, x := range samples {
+		if x < 0 || math.Floor(x) != x || math.IsInf(x, 1) {
+			panic(badCount)
+		}
+		wi := 1.0
+		if weights != nil {
+			wi = weights[i]
+		}
+		c.total += wi
+		c.sum += wi * x
+		if x == 0 {
+			c.zeros += wi
+		} else {
+			w[x] += wi
+		}
+	}
+	for x := range w {
+		c.values = append(c.values, x)
+	}
+	slices.Sort(c.values)
+	c.weights = make([]float64, len(c.values))
+	for i, x := range c.values {
+		c.weights[i] = w[x]
+	}
+	return c
+}
+
+// nbShape returns the maximum likelihood negative binomial shape
+// for the counts when the component has total weight n, starting
+// the search from r.
+func (c counts) nbShape(n, r float64) float64 {
+	// The profile score in the shape,
+	//  g(r) = Σ w_k (ψ(k+r) - ψ(r)) + n log(r n / (r n + s)),
+	// is positive for small r and decreases through its root.
+	// It remains positive for all r when the counts are
+	// underdispersed, in which case the shape is capped.
+	const maxShape = 1e10
+	g := func(r float64) float64 {
+		var sum float64
+		dr := mathext.Digamma(r)
+		for i, k := range c.values {
+			sum += c.weights[i] * (mathext.Digamma(k+r) - dr)
+		}
+		return sum - n*math.Log1p(c.sum/(r*n))
+	}
+	lo, hi := r, r
+	for g(lo) < 0 {
+		lo /= 2
+	}
+	for g(hi) > 0 {
+		if hi >= maxShape {
+			return maxShape
+		}
+		hi *= 2
+	}
+	for hi-lo > emTol*1e-3*hi {
+		mid := math.Sqrt(lo * hi)
+		if g(mid) > 0 {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return math.Sqrt(lo * hi)
+}
@@ -0,0 +1,213 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+type countDist interface {
+	probLogprober
+	Rander
+	CDF(x float64) float64
+	Survival(x float64) float64
+	Mean() float64
+	StdDev() float64
+	Variance() float64
+}
+
+func testCountDist(t *testing.T, i int, d countDist) {
+	t.Helper()
+	const n = 1e6
+	x := make([]float64, n)
+	generateSamples(x, d)
+	sort.Float64s(x)
+
+	checkProbDiscrete(t, i, x, d, 2e-3)
+	checkMean(t, i, x, d, 1e-2)
+	checkVarAndStd(t, i, x, d, 2e-2)
+
+	if d.CDF(-0.5) != 0 || d.Survival(-0.5) != 1 {
+		t.Errorf("unexpected CDF or Survival below support for case %d", i)
+	}
+	if !math.IsInf(d.LogProb(1.5), -1) {
+		t.Errorf("unexpected LogProb for non-integer x for case %d", i)
+	}
+	var cdf float64
+	for k := 0.0; k <= x[len(x)-1]; k++ {
+		cdf += d.Prob(k)
+		if !scalar.EqualWithinAbsOrRel(d.CDF(k), cdf, 1e-12, 1e-12) {
+			t.Errorf("CDF mismatch for case %d at %v: got %v, want %v", i, k, d.CDF(k), cdf)
+		}
+		if !scalar.EqualWithinAbs(d.CDF(k+0.5)+d.Survival(k+0.5), 1, 1e-14) {
+			t.Errorf("CDF and Survival mismatch for case %d at %v", i, k+0.5)
+		}
+	}
+}
+
+func TestZeroInflatedPoisson(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewPCG(1, 1))
+	for i, d := range []ZeroInflatedPoisson{
+		{Pi: 0, Lambda: 3, Src: src},
+		{Pi: 0.3, Lambda: 0.5, Src: src},
+		{Pi: 0.6, Lambda: 12, Src: src},
+	} {
+		testCountDist(t, i, d)
+		if d.Pi == 0 {
+			p := Poisson{Lambda: d.Lambda}
+			for k := 0.0; k < 10; k++ {
+				if !scalar.EqualWithinRel(d.Prob(k), p.Prob(k), 1e-14) {
+					t.Errorf("mismatch with Poisson for case %d at %v", i, k)
+				}
+			}
+		}
+	}
+}
+
+func TestZeroInflatedNegativeBinomial(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewPCG(1, 1))
+	for i, d := range []ZeroInflatedNegativeBinomial{
+		{Pi: 0, R: 2, P: 0.4, Src: src},
+		{Pi: 0.25, R: 0.7, P: 0.2, Src: src},
+		{Pi: 0.5, R: 10, P: 0.7, Src: src},
+	} {
+		testCountDist(t, i, d)
+	}
+
+	// Negative binomial probabilities C(k+2, k) 0.25^3 0.75^k.
+	d := ZeroInflatedNegativeBinomial{Pi: 0, R: 3, P: 0.25}
+	for _, test := range []struct{ k, want float64 }{
+		{0, 0.015625},
+		{1, 0.03515625},
+		{5, 0.0778656005859375},
+		{20, 0.011446093092089882},
+	} {
+		if got := d.Prob(test.k); !scalar.EqualWithinRel(got, test.want, 1e-13) {
+			t.Errorf("unexpected Prob at %v: got %v, want %v", test.k, got, test.want)
+		}
+	}
+}
+
+func TestHurdlePoisson(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewPCG(1, 1))
+	for i, d := range []HurdlePoisson{
+		{Pi: 0.1, Lambda: 0.2, Src: src},
+		{Pi: 0.5, Lambda: 3, Src: src},
+		{Pi: 0.8, Lambda: 15, Src: src},
+		{Pi: 0.4, Lambda: 0, Src: src},
+	} {
+		testCountDist(t, i, d)
+	}
+}
+
+func TestCountDistFit(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewPCG(1, 1))
+	const n = 1e5
+	x := make([]float64, n)
+
+	zip := ZeroInflatedPoisson{Pi: 0.35, Lambda: 2.5, Src: src}
+	generateSamples(x, zip)
+	var zipFit ZeroInflatedPoisson
+	zipFit.Fit(x, nil)
+	if !scalar.EqualWithinAbs(zipFit.Pi, zip.Pi, 1e-2) || !scalar.EqualWithinRel(zipFit.Lambda, zip.Lambda, 1e-2) {
+		t.Errorf("unexpected zero-inflated Poisson fit: got %+v, want %+v", zipFit, zip)
+	}
+	checkCountFitOptimal(t, "zero-inflated Poisson", x, &zipFit, []func(float64) ZeroInflatedPoisson{
+		func(h float64) ZeroInflatedPoisson {
+			return ZeroInflatedPoisson{Pi: zipFit.Pi + h, Lambda: zipFit.Lambda}
+		},
+		func(h float64) ZeroInflatedPoisson {
+			return ZeroInflatedPoisson{Pi: zipFit.Pi, Lambda: zipFit.Lambda + h}
+		},
+	})
+
+	zinb := ZeroInflatedNegativeBinomial{Pi: 0.3, R: 1.5, P: 0.3, Src: src}
+	generateSamples(x, zinb)
+	var zinbFit ZeroInflatedNegativeBinomial
+	zinbFit.Fit(x, nil)
+	if !scalar.EqualWithinAbs(zinbFit.Pi, zinb.Pi, 2e-2) || !scalar.EqualWithinRel(zinbFit.R, zinb.R, 5e-2) || !scalar.EqualWithinRel(zinbFit.P, zinb.P, 5e-2) {
+		t.Errorf("unexpected zero-inflated negative binomial fit: got %+v, want %+v", zinbFit, zinb)
+	}
+	checkCountFitOptimal(t, "zero-inflated negative binomial", x, &zinbFit, []func(float64) ZeroInflatedNegativeBinomial{
+		func(h float64) ZeroInflatedNegativeBinomial {
+			return ZeroInflatedNegativeBinomial{Pi: zinbFit.Pi + h, R: zinbFit.R, P: zinbFit.P}
+		},
+		func(h float64) ZeroInflatedNegativeBinomial {
+			return ZeroInflatedNegativeBinomial{Pi: zinbFit.Pi, R: zinbFit.R + h, P: zinbFit.P}
+		},
+		func(h float64) ZeroInflatedNegativeBinomial {
+			return ZeroInflatedNegativeBinomial{Pi: zinbFit.Pi, R: zinbFit.R, P: zinbFit.P + h}
+		},
+	})
+
+	hurdle := HurdlePoisson{Pi: 0.6, Lambda: 1.2, Src: src}
+	generateSamples(x, hurdle)
+	var hurdleFit HurdlePoisson
+	hurdleFit.Fit(x, nil)
+	if !scalar.EqualWithinAbs(hurdleFit.Pi, hurdle.Pi, 1e-2) || !scalar.EqualWithinRel(hurdleFit.Lambda, hurdle.Lambda, 2e-2) {
+		t.Errorf("unexpected Poisson hurdle fit: got %+v, want %+v", hurdleFit, hurdle)
+	}
+	checkCountFitOptimal(t, "Poisson hurdle", x, &hurdleFit, []func(float64) HurdlePoisson{
+		func(h float64) HurdlePoisson { return HurdlePoisson{Pi: hurdleFit.Pi + h, Lambda: hurdleFit.Lambda} },
+		func(h float64) HurdlePoisson { return HurdlePoisson{Pi: hurdleFit.Pi, Lambda: hurdleFit.Lambda + h} },
+	})
+
+	// Weights must be equivalent to repeated samples.
+	xs := []float64{0, 0, 0, 1, 2, 2, 3, 5, 0, 7}
+	ws := []float64{1, 2, 1, 1, 3, 1, 2, 1, 1, 1}
+	var rep []float64
+	for i, v := range xs {
+		for j := 0; j < int(ws[i]); j++ {
+			rep = append(rep, v)
+		}
+	}
+	var wFit, rFit ZeroInflatedNegativeBinomial
+	wFit.Fit(xs, ws)
+	rFit.Fit(rep, nil)
+	if !scalar.EqualWithinRel(wFit.Pi, rFit.Pi, 1e-8) || !scalar.EqualWithinRel(wFit.R, rFit.R, 1e-8) || !scalar.EqualWithinRel(wFit.P, rFit.P, 1e-8) {
+		t.Errorf("mismatch between weighted and repeated fit: got %+v, want %+v", wFit, rFit)
+	}
+
+	if !panics(func() { zipFit.Fit([]float64{0, 1.5}, nil) }) {
+		t.Errorf("expected panic for non-integer sample")
+	}
+	if !panics(func() { zipFit.Fit([]float64{0, 1}, []float64{1}) }) {
+		t.Errorf("expected panic for mismatched weights")
+	}
+	zipFit.Fit([]float64{0, 0, 0}, nil)
+	if zipFit.Pi != 1 {
+		t.Errorf("unexpected Pi for all zero samples: got %v, want 1", zipFit.Pi)
+	}
+}
+
+// checkCountFitOptimal checks that perturbing each fitted parameter
+// does not increase the log-likelihood of the samples.
+func checkCountFitOptimal[D LogProber](t *testing.T, name string, x []float64, fit LogProber, perturb []func(float64) D) {
+	t.Helper()
+	ll := func(d LogProber) float64 {
+		var sum float64
+		for _, v := range x {
+			sum += d.LogProb(v)
+		}
+		return sum
+	}
+	best := ll(fit)
+	for i, p := range perturb {
+		for _, h := range []float64{-1e-3, 1e-3} {
+			if got := ll(p(h)); got > best+1e-8*math.Abs(best) {
+				t.Errorf("%s fit is not a maximum in parameter %d: perturbed log-likelihood %v > %v", name, i, got, best)
+			}
+		}
+	}
+}
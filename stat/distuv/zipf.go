@@ -0,0 +1,298 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/mathext"
+)
+
+const badSupport = "distuv: sample out of support"
+
+// ZipfMandelbrot implements the Zipf–Mandelbrot distribution, a discrete
+// power-law distribution over the ranks 1, ..., N that models the
+// frequencies of words and other ranked data. With Q equal to zero it is
+// Zipf's distribution.
+// The Zipf–Mandelbrot distribution has density function:
+//
+//	f(k) = (k+q)^{-s} / \sum_{i=1}^N (i+q)^{-s}
+//
+// For more information, see https://en.wikipedia.org/wiki/Zipf%E2%80%93Mandelbrot_law.
+type ZipfMandelbrot struct {
+	// N is the number of ranks.
+	// N must be a positive integer.
+	N float64
+	// Q is the shift of the ranks.
+	// Q must be non-negative.
+	Q float64
+	// S is the exponent.
+	// S must be non-negative.
+	S float64
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (z ZipfMandelbrot) CDF(x float64) float64 {
+	switch {
+	case x < 1:
+		return 0
+	case x >= z.N:
+		return 1
+	}
+	return zipfSum(z.S, z.Q, math.Floor(x)) / zipfSum(z.S, z.Q, z.N)
+}
+
+// Fit sets the exponent S of the distribution to its maximum likelihood
+// estimate from the samples with relative weights, holding N and Q fixed.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+// Fit panics if any sample is not an integer in [1, N]. The estimate is
+// found by bisection, and each iteration takes time proportional to N.
+func (z *ZipfMandelbrot) Fit(samples, weights []float64) {
+	if weights != nil && len(samples) != len(weights) {
+		panic(badLength)
+	}
+	if len(samples) == 0 {
+		panic(errNoSamples)
+	}
+	var sum, total float64
+	for i, x := range samples {
+		if x < 1 || z.N < x || math.Floor(x) != x {
+			panic(badSupport)
+		}
+		w := 1.0
+		if weights != nil {
+			w = weights[i]
+		}
+		sum += w * math.Log(x+z.Q)
+		total += w
+	}
+	target := sum / total
+
+	// The expected value of log(k+q) decreases with the exponent,
+	// so the score equation E_s[log(k+q)] = target has a unique
+	// solution found by bisection.
+	meanLog := func(s float64) float64 {
+		// Scale the terms by (1+q)^s to avoid underflow.
+		l1 := math.Log(1 + z.Q)
+		var num, den float64
+		for k := z.N; k >= 1; k-- {
+			l := math.Log(k + z.Q)
+			p := math.Exp(-s * (l - l1))
+			num += p * l
+			den += p
+		}
+		return num / den
+	}
+	if target >= meanLog(0) {
+		z.S = 0
+		return
+	}
+	lo, hi := 0.0, 1.0
+	for meanLog(hi) > target {
+		lo = hi
+		hi *= 2
+		if math.IsInf(hi, 1) {
+			z.S = hi
+			return
+		}
+	}
+	for hi-lo > 1e-12*hi {
+		mid := lo + (hi-lo)/2
+		if mid == lo || mid == hi {
+			break
+		}
+		if meanLog(mid) > target {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	z.S = lo + (hi-lo)/2
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (z ZipfMandelbrot) LogProb(x float64) float64 {
+	if x < 1 || z.N < x || math.Floor(x) != x {
+		return math.Inf(-1)
+	}
+	return -z.S*math.Log(x+z.Q) - math.Log(zipfSum(z.S, z.Q, z.N))
+}
+
+// Mean returns the mean of the probability distribution.
+func (z ZipfMandelbrot) Mean() float64 {
+	return zipfSum(z.S-1, z.Q, z.N)/zipfSum(z.S, z.Q, z.N) - z.Q
+}
+
+// Mode returns the mode of the distribution.
+func (ZipfMandelbrot) Mode() float64 {
+	return 1
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (ZipfMandelbrot) NumParameters() int {
+	return 3
+}
+
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in p, which must have length NumParameters.
+func (z ZipfMandelbrot) Parameters(p []Parameter) []Parameter {
+	return parametersOf(p, "zipf", []string{"N", "Q", "S"}, z.N, z.Q, z.S)
+}
+
+// SetParameters sets the parameters of the distribution to the values in p,
+// which must have the names returned by Parameters in the same order.
+func (z *ZipfMandelbrot) SetParameters(p []Parameter) {
+	checkParameterNames(p, "zipf", "N", "Q", "S")
+	z.N = p[0].Value
+	z.Q = p[1].Value
+	z.S = p[2].Value
+}
+
+// ParameterBounds returns the bounds of the valid values of each parameter.
+// If b is not nil, the bounds are stored in b, which must have length
+// NumParameters.
+func (ZipfMandelbrot) ParameterBounds(b []ParameterBounds) []ParameterBounds {
+	nonNegative := ParameterBounds{Min: 0, Max: math.Inf(1), OpenMax: true}
+	return boundsOf(b, "zipf", []string{"N", "Q", "S"},
+		ParameterBounds{Min: 1, Max: math.Inf(1), OpenMax: true, Integer: true},
+		nonNegative,
+		nonNegative,
+	)
+}
+
+// Prob computes the value of the probability density function at x.
+func (z ZipfMandelbrot) Prob(x float64) float64 {
+	return math.Exp(z.LogProb(x))
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (z ZipfMandelbrot) Rand() float64 {
+	return zipfRand(z.S, z.Q, z.N, z.Src)
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (z ZipfMandelbrot) StdDev() float64 {
+	return math.Sqrt(z.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (z ZipfMandelbrot) Survival(x float64) float64 {
+	switch {
+	case x < 1:
+		return 1
+	case x >= z.N:
+		return 0
+	}
+	k := math.Floor(x)
+	h := zipfSum(z.S, z.Q, z.N)
+	return (h - zipfSum(z.S, z.Q, k)) / h
+}
+
+// Variance returns the variance of the probability distribution.
+func (z ZipfMandelbrot) Variance() float64 {
+	h := zipfSum(z.S, z.Q, z.N)
+	m := zipfSum(z.S-1, z.Q, z.N) / h
+	return zipfSum(z.S-2, z.Q, z.N)/h - m*m
+}
+
+// zipfDirect is the number of terms below which zipfSum
+// computes the sum directly.
+const zipfDirect = 1 << 12
+
+// zipfSum returns the generalized harmonic number
+//
+//	\sum_{k=1}^n (k+q)^{-s}.
+//
+// The sum is +Inf if n is +Inf and s is not greater than one.
+func zipfSum(s, q, n float64) float64 {
+	if n > zipfDirect && s > 1 {
+		z := mathext.Zeta(s, 1+q)
+		if math.IsInf(n, 1) {
+			return z
+		}
+		return z - mathext.Zeta(s, n+1+q)
+	}
+	if math.IsInf(n, 1) {
+		return math.Inf(1)
+	}
+	// Sum the smallest terms first.
+	var sum float64
+	for k := n; k >= 1; k-- {
+		sum += math.Exp(-s * math.Log(k+q))
+	}
+	return sum
+}
+
+// zipfRand returns a random integer in [1, n] drawn with probability
+// proportional to (k+q)^{-s}, using the rejection-inversion method for
+// monotone discrete distributions. n may be +Inf if s is greater than one.
+// q must be non-negative.
+//
+// See Hörmann, W. and Derflinger, G. (1996) Rejection-inversion to generate
+// variates from monotone discrete distributions. ACM Transactions on
+// Modeling and Computer Simulation 6(3) for details.
+func zipfRand(s, q, n float64, src rand.Source) float64 {
+	var rnd func() float64
+	if src == nil {
+		rnd = rand.Float64
+	} else {
+		rnd = rand.New(src).Float64
+	}
+
+	// The hat function is h(x) = ((x+q)/(1+q))^{-s}, scaled so that
+	// h(1) = 1, with integral H(x) = (1+q) (y^{1-s} - 1) / (1-s)
+	// where y = (x+q)/(1+q). Since h is convex, the integral of h
+	// over [k-1/2, k+1/2] is at least h(k).
+	c := 1 + q
+	h := func(x float64) float64 {
+		return math.Exp(-s * math.Log((x+q)/c))
+	}
+	hInt := func(x float64) float64 {
+		if math.IsInf(x, 1) {
+			return c / (s - 1)
+		}
+		l := math.Log((x + q) / c)
+		return c * expm1Ratio((1-s)*l) * l
+	}
+	hIntInv := func(u float64) float64 {
+		t := max(u*(1-s)/c, -1)
+		return c*math.Exp(log1pRatio(t)*u/c) - q
+	}
+
+	lo := hInt(1.5) - h(1)
+	hi := hInt(n + 0.5)
+	for {
+		u := lo + rnd()*(hi-lo)
+		k := math.Floor(hIntInv(u) + 0.5)
+		if math.IsInf(k, 0) || math.IsNaN(k) {
+			continue
+		}
+		k = math.Max(1, math.Min(k, n))
+		if k == 1 || u >= hInt(k+0.5)-h(k) {
+			return k
+		}
+	}
+}
+
+// expm1Ratio returns expm1(x)/x, with the limit 1 at zero.
+func expm1Ratio(x float64) float64 {
+	if math.Abs(x) < 1e-8 {
+		return 1 + x/2
+	}
+	return math.Expm1(x) / x
+}
+
+// log1pRatio returns log1p(x)/x, with the limit 1 at zero.
+func log1pRatio(x float64) float64 {
+	if math.Abs(x) < 1e-8 {
+		return 1 - x/2
+	}
+	return math.Log1p(x) / x
+}
@@ -0,0 +1,81 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestZipfMandelbrot(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewPCG(1, 1))
+	for i, d := range []ZipfMandelbrot{
+		{N: 10, Q: 0, S: 1, Src: src},
+		{N: 50, Q: 2.5, S: 1.5, Src: src},
+		{N: 20, Q: 0, S: 0, Src: src},
+		{N: 100, Q: 0.5, S: 0.7, Src: src},
+		{N: 1e4, Q: 1, S: 4.5, Src: src},
+	} {
+		testCountDist(t, i, d)
+		if !scalar.EqualWithinAbs(d.CDF(d.N), 1, 1e-14) || d.Survival(d.N) != 0 {
+			t.Errorf("unexpected CDF or Survival at N for case %d", i)
+		}
+	}
+
+	// The sums used for large N must agree with direct summation.
+	for _, s := range []float64{1.2, 2, 3.5} {
+		var want float64
+		for k := 2e4; k >= 1; k-- {
+			want += math.Pow(k+0.5, -s)
+		}
+		if got := zipfSum(s, 0.5, 2e4); !scalar.EqualWithinRel(got, want, 1e-12) {
+			t.Errorf("unexpected sum for s=%v: got %v, want %v", s, got, want)
+		}
+	}
+}
+
+func TestZipfMandelbrotFit(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewPCG(1, 1))
+	for i, want := range []ZipfMandelbrot{
+		{N: 100, Q: 0, S: 1.1},
+		{N: 1000, Q: 3, S: 2},
+		{N: 30, Q: 0, S: 0.5},
+	} {
+		d := want
+		d.Src = src
+		x := make([]float64, 1e5)
+		generateSamples(x, d)
+		d.S = 0
+		d.Fit(x, nil)
+		if !scalar.EqualWithinAbs(d.S, want.S, 0.02) {
+			t.Errorf("unexpected fitted exponent for case %d: got %v, want %v", i, d.S, want.S)
+		}
+	}
+
+	// Weighted samples are equivalent to repeated samples.
+	var a, b ZipfMandelbrot
+	a.N, b.N = 10, 10
+	a.Fit([]float64{1, 1, 1, 2, 3}, nil)
+	b.Fit([]float64{1, 2, 3}, []float64{3, 1, 1})
+	if !scalar.EqualWithinRel(a.S, b.S, 1e-10) {
+		t.Errorf("mismatch between weighted and repeated samples: %v != %v", a.S, b.S)
+	}
+
+	d := ZipfMandelbrot{N: 5}
+	if !panics(func() { d.Fit([]float64{6}, nil) }) {
+		t.Error("expected panic for sample out of support")
+	}
+	if !panics(func() { d.Fit([]float64{1}, []float64{1, 2}) }) {
+		t.Error("expected panic for length mismatch")
+	}
+	if !panics(func() { d.Fit(nil, nil) }) {
+		t.Error("expected panic for no samples")
+	}
+}
@@ -0,0 +1,11 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package dp provides Dirichlet process utilities for nonparametric Bayesian
+// modeling, including stick-breaking weights, Chinese restaurant process
+// sampling and variational Dirichlet process mixture models.
+//
+// See Teh, Y. W. (2010) Dirichlet Process. Encyclopedia of Machine Learning,
+// Springer, for an introduction to Dirichlet processes.
+package dp // import "gonum.org/v1/gonum/stat/dp"
@@ -0,0 +1,180 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dp
+
+import (
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// StickBreaking stores in dst the weights of a Dirichlet process with
+// concentration alpha truncated to len(dst) atoms, generated by the
+// stick-breaking construction
+//
+//	v_k ~ Beta(1, α),  w_k = v_k \prod_{j<k} (1 - v_j).
+//
+// The last weight is the remainder of the stick, so the weights sum to one.
+// If src is nil, the global source is used. StickBreaking returns dst.
+//
+// StickBreaking will panic if dst is empty or alpha is not positive.
+func StickBreaking(dst []float64, alpha float64, src rand.Source) []float64 {
+	if len(dst) == 0 {
+		panic("dp: empty weights")
+	}
+	if !(alpha > 0) {
+		panic("dp: non-positive concentration")
+	}
+	beta := distuv.Beta{Alpha: 1, Beta: alpha, Src: src}
+	rest := 1.0
+	for k := range dst[:len(dst)-1] {
+		v := beta.Rand()
+		dst[k] = rest * v
+		rest *= 1 - v
+	}
+	dst[len(dst)-1] = rest
+	return dst
+}
+
+// ChineseRestaurant samples a partition of len(dst) customers from the
+// Chinese restaurant process with concentration alpha, the partition
+// induced on samples from a random measure drawn from a Dirichlet process.
+// Customer i joins an occupied table with probability proportional to the
+// number of customers already seated there, or a new table with
+// probability proportional to alpha. The table of each customer is stored
+// in dst, with tables numbered from zero in the order they are first
+// occupied, and the number of occupied tables is returned. If src is nil,
+// the global source is used.
+//
+// ChineseRestaurant will panic if alpha is not positive.
+func ChineseRestaurant(dst []int, alpha float64, src rand.Source) (tables int) {
+	if !(alpha > 0) {
+		panic("dp: non-positive concentration")
+	}
+	float64n := rand.Float64
+	if src != nil {
+		float64n = rand.New(src).Float64
+	}
+	var counts []int
+	for i := range dst {
+		u := float64n() * (float64(i) + alpha)
+		table := len(counts)
+		for j, c := range counts {
+			u -= float64(c)
+			if u < 0 {
+				table = j
+				break
+			}
+		}
+		if table == len(counts) {
+			counts = append(counts, 0)
+		}
+		counts[table]++
+		dst[i] = table
+	}
+	return len(counts)
+}
+
+// Base is a base measure of a Dirichlet process.
+type Base interface {
+	// Dim returns the dimension of the atoms.
+	Dim() int
+
+	// Rand returns a random atom drawn from the base measure.
+	// If x is nil, a new slice is allocated, otherwise the atom
+	// is stored in x, which must have length Dim.
+	Rand(x []float64) []float64
+}
+
+// Univariate is a Base for the base measure of a univariate distribution.
+type Univariate struct {
+	distuv.Rander
+}
+
+// Dim returns 1.
+func (Univariate) Dim() int { return 1 }
+
+// Rand returns a random atom drawn from the distribution.
+func (u Univariate) Rand(x []float64) []float64 {
+	if x == nil {
+		x = make([]float64, 1)
+	} else if len(x) != 1 {
+		panic("dp: length mismatch")
+	}
+	x[0] = u.Rander.Rand()
+	return x
+}
+
+// Process is a Dirichlet process DP(α, G₀) with concentration α and base
+// measure G₀. Random measures drawn from the process are discrete, placing
+// the weights of the stick-breaking construction on atoms drawn
+// independently from the base measure.
+//
+// Base measures of multivariate distributions, such as *distmv.Normal, may
+// be used directly, and those of univariate distributions through
+// Univariate.
+type Process struct {
+	// Alpha is the concentration
+	// parameter of the process.
+	Alpha float64
+
+	// Base is the base measure.
+	Base Base
+
+	// Src is the source of randomness for
+	// the weights and partitions. The atoms
+	// are drawn using the source of Base. If
+	// Src is nil, the global source is used.
+	Src rand.Source
+}
+
+// Truncated returns a random measure drawn from the process truncated to k
+// atoms. The weights of the atoms are returned in weights and the atoms in
+// the rows of atoms.
+//
+// Truncated will panic if k is not positive.
+func (p Process) Truncated(k int) (weights []float64, atoms *mat.Dense) {
+	if k <= 0 {
+		panic("dp: non-positive truncation")
+	}
+	weights = StickBreaking(make([]float64, k), p.Alpha, p.Src)
+	atoms = mat.NewDense(k, p.Base.Dim(), nil)
+	for i := range k {
+		p.Base.Rand(atoms.RawRowView(i))
+	}
+	return weights, atoms
+}
+
+// Rand stores in the rows of dst samples from a random measure drawn from
+// the process, integrating out the random measure using the Blackwell-
+// MacQueen urn scheme. The samples are partitioned by ChineseRestaurant and
+// the samples of each block of the partition share a single atom drawn from
+// the base measure. The block of each sample is returned in labels. If
+// labels is not nil, the blocks are stored in labels, which must have
+// length equal to the number of rows of dst.
+//
+// Rand will panic if the number of columns of dst is not the dimension of
+// the base measure or labels has the wrong length.
+func (p Process) Rand(dst *mat.Dense, labels []int) []int {
+	r, c := dst.Dims()
+	if c != p.Base.Dim() {
+		panic("dp: dimension mismatch")
+	}
+	if labels == nil {
+		labels = make([]int, r)
+	} else if len(labels) != r {
+		panic("dp: length mismatch")
+	}
+	tables := ChineseRestaurant(labels, p.Alpha, p.Src)
+	atoms := mat.NewDense(tables, c, nil)
+	for i := range tables {
+		p.Base.Rand(atoms.RawRowView(i))
+	}
+	for i, l := range labels {
+		dst.SetRow(i, atoms.RawRowView(l))
+	}
+	return labels
+}
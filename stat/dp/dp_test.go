@@ -0,0 +1,233 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dp
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/distmv"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return false
+}
+
+func TestStickBreaking(t *testing.T) {
+	t.Parallel()
+	src := rand.NewPCG(1, 1)
+	for _, alpha := range []float64{0.5, 1, 5} {
+		const draws = 20000
+		w := make([]float64, 8)
+		var mean float64
+		for range draws {
+			StickBreaking(w, alpha, src)
+			for _, v := range w {
+				if v < 0 {
+					t.Fatalf("negative weight for alpha=%v: %v", alpha, w)
+				}
+			}
+			if sum := floats.Sum(w); math.Abs(sum-1) > 1e-14 {
+				t.Fatalf("weights do not sum to one for alpha=%v: %v", alpha, sum)
+			}
+			mean += w[0] / draws
+		}
+		want := 1 / (1 + alpha)
+		if math.Abs(mean-want) > 0.01 {
+			t.Errorf("unexpected mean of first weight for alpha=%v: got:%v want:%v", alpha, mean, want)
+		}
+	}
+	if !panics(func() { StickBreaking(nil, 1, nil) }) {
+		t.Error("expected panic for empty weights")
+	}
+	if !panics(func() { StickBreaking(make([]float64, 2), 0, nil) }) {
+		t.Error("expected panic for non-positive concentration")
+	}
+}
+
+func TestChineseRestaurant(t *testing.T) {
+	t.Parallel()
+	src := rand.NewPCG(1, 1)
+	for _, test := range []struct {
+		n     int
+		alpha float64
+	}{
+		{n: 1, alpha: 1},
+		{n: 50, alpha: 0.5},
+		{n: 100, alpha: 2},
+		{n: 200, alpha: 10},
+	} {
+		// The expected number of tables is \sum_i α/(α+i).
+		var want float64
+		for i := range test.n {
+			want += test.alpha / (test.alpha + float64(i))
+		}
+		const draws = 2000
+		labels := make([]int, test.n)
+		var mean float64
+		for range draws {
+			tables := ChineseRestaurant(labels, test.alpha, src)
+			next := 0
+			for _, l := range labels {
+				if l > next {
+					t.Fatalf("tables not numbered in order of occupation: %v", labels)
+				}
+				if l == next {
+					next++
+				}
+			}
+			if next != tables {
+				t.Fatalf("unexpected number of tables: got:%d want:%d", tables, next)
+			}
+			mean += float64(tables) / draws
+		}
+		if math.Abs(mean-want) > 0.05*want {
+			t.Errorf("unexpected mean number of tables for n=%d alpha=%v: got:%v want:%v", test.n, test.alpha, mean, want)
+		}
+	}
+	if !panics(func() { ChineseRestaurant(make([]int, 2), -1, nil) }) {
+		t.Error("expected panic for non-positive concentration")
+	}
+}
+
+func TestProcess(t *testing.T) {
+	t.Parallel()
+	src := rand.NewPCG(1, 1)
+	norm, ok := distmv.NewNormal([]float64{0, 0}, mat.NewSymDense(2, []float64{1, 0, 0, 1}), src)
+	if !ok {
+		t.Fatal("bad test: covariance not positive definite")
+	}
+	for _, base := range []Base{
+		norm,
+		Univariate{distuv.Normal{Mu: 5, Sigma: 1, Src: src}},
+	} {
+		p := Process{Alpha: 2, Base: base, Src: src}
+		weights, atoms := p.Truncated(20)
+		if r, c := atoms.Dims(); r != 20 || c != base.Dim() {
+			t.Errorf("unexpected atom dimensions: got:%d×%d want:20×%d", r, c, base.Dim())
+		}
+		if sum := floats.Sum(weights); math.Abs(sum-1) > 1e-14 {
+			t.Errorf("weights do not sum to one: %v", sum)
+		}
+
+		x := mat.NewDense(100, base.Dim(), nil)
+		labels := p.Rand(x, nil)
+		atom := make(map[int][]float64)
+		for i, l := range labels {
+			row := x.RawRowView(i)
+			if a, ok := atom[l]; ok {
+				if !floats.Equal(a, row) {
+					t.Errorf("samples with label %d do not share an atom: %v %v", l, a, row)
+				}
+				continue
+			}
+			for _, a := range atom {
+				if floats.Equal(a, row) {
+					t.Errorf("samples with different labels share an atom: %v", row)
+				}
+			}
+			atom[l] = row
+		}
+	}
+	p := Process{Alpha: 1, Base: norm}
+	if !panics(func() { p.Truncated(0) }) {
+		t.Error("expected panic for non-positive truncation")
+	}
+	if !panics(func() { p.Rand(mat.NewDense(3, 1, nil), nil) }) {
+		t.Error("expected panic for dimension mismatch")
+	}
+	if !panics(func() { p.Rand(mat.NewDense(3, 2, nil), make([]int, 2)) }) {
+		t.Error("expected panic for labels length mismatch")
+	}
+}
+
+func TestFitMixture(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	centers := [][]float64{{0, 0}, {8, 0}, {0, 8}}
+	const perCluster = 100
+	x := mat.NewDense(len(centers)*perCluster, 2, nil)
+	truth := make([]int, len(centers)*perCluster)
+	for c, center := range centers {
+		for i := range perCluster {
+			row := c*perCluster + i
+			x.Set(row, 0, center[0]+rnd.NormFloat64())
+			x.Set(row, 1, center[1]+0.5*rnd.NormFloat64())
+			truth[row] = c
+		}
+	}
+
+	mix, err := FitMixture(x, &MixtureSettings{Truncation: 8, Src: rand.NewPCG(2, 2)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !mix.Converged {
+		t.Errorf("mixture did not converge in %d iterations", mix.Iterations)
+	}
+	if sum := floats.Sum(mix.Weights); math.Abs(sum-1) > 1e-12 {
+		t.Errorf("weights do not sum to one: %v", sum)
+	}
+	var used int
+	for _, w := range mix.Weights {
+		if w > 0.05 {
+			used++
+		}
+	}
+	if used != len(centers) {
+		t.Errorf("unexpected number of components used: got:%d want:%d weights:%v", used, len(centers), mix.Weights)
+	}
+
+	// The labels must match the true clusters up to relabeling.
+	label := make(map[int]int)
+	for i, l := range mix.Labels {
+		c := truth[i]
+		if want, ok := label[c]; ok && want != l {
+			t.Errorf("observation %d of cluster %d labeled %d, want %d", i, c, l, want)
+			continue
+		}
+		label[c] = l
+	}
+	for c, center := range centers {
+		post := make([]float64, len(mix.Weights))
+		if got := mix.Predict(post, center); got != label[c] {
+			t.Errorf("unexpected prediction for center of cluster %d: got:%d want:%d", c, got, label[c])
+		}
+		if sum := floats.Sum(post); math.Abs(sum-1) > 1e-12 {
+			t.Errorf("posterior probabilities do not sum to one: %v", sum)
+		}
+		mean := mix.Components[label[c]].Mean(nil)
+		if !floats.EqualApprox(mean, center, 0.3) {
+			t.Errorf("unexpected mean of cluster %d: got:%v want:%v", c, mean, center)
+		}
+	}
+	for i := range mix.Labels {
+		if sum := floats.Sum(mix.Responsibilities.RawRowView(i)); math.Abs(sum-1) > 1e-12 {
+			t.Errorf("responsibilities of observation %d do not sum to one: %v", i, sum)
+			break
+		}
+	}
+
+	if !panics(func() { FitMixture(x, &MixtureSettings{Alpha: -1}) }) {
+		t.Error("expected panic for non-positive concentration")
+	}
+	if !panics(func() { FitMixture(x, &MixtureSettings{PriorDegrees: 0.5}) }) {
+		t.Error("expected panic for too few degrees of freedom")
+	}
+	if !panics(func() { mix.Predict(nil, []float64{1}) }) {
+		t.Error("expected panic for dimension mismatch")
+	}
+	degenerate := mat.NewDense(4, 2, []float64{1, 1, 2, 2, 3, 3, 4, 4})
+	if _, err := FitMixture(degenerate, nil); err == nil {
+		t.Error("expected error for degenerate data")
+	}
+}
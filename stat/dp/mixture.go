@@ -0,0 +1,393 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dp
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/mathext"
+	"gonum.org/v1/gonum/stat"
+	"gonum.org/v1/gonum/stat/cluster"
+	"gonum.org/v1/gonum/stat/distmv"
+)
+
+var errNotPositiveDefinite = errors.New("dp: scale matrix not positive definite")
+
+// MixtureSettings holds the settings for FitMixture.
+type MixtureSettings struct {
+	// Alpha is the concentration parameter
+	// of the Dirichlet process prior on the
+	// mixing weights. Smaller values favor
+	// fewer components. If Alpha is zero,
+	// one is used.
+	Alpha float64
+
+	// Truncation is the number of components
+	// of the truncated variational posterior,
+	// an upper bound on the number of
+	// components used by the fit. If
+	// Truncation is zero, 10 is used.
+	Truncation int
+
+	// MaxIterations is the maximum number of
+	// variational iterations. If MaxIterations
+	// is zero, 100 is used.
+	MaxIterations int
+
+	// Tolerance is the threshold on the largest
+	// change of the responsibilities below which
+	// the iteration is considered converged. If
+	// Tolerance is zero, 1e-6 is used.
+	Tolerance float64
+
+	// PriorMean is the mean of the normal-
+	// Wishart prior on the component means. If
+	// PriorMean is nil, the mean of the data is
+	// used.
+	PriorMean []float64
+
+	// PriorPrecision is the scaling of the
+	// precision of the prior on the component
+	// means. If PriorPrecision is zero, one is
+	// used.
+	PriorPrecision float64
+
+	// PriorDegrees is the number of degrees of
+	// freedom of the Wishart prior on the
+	// component precisions, which must be
+	// greater than the dimension minus one. If
+	// PriorDegrees is zero, the dimension is
+	// used.
+	PriorDegrees float64
+
+	// PriorScale is the inverse of the scale
+	// matrix of the Wishart prior on the
+	// component precisions. If PriorScale is
+	// nil, the covariance of the data is used.
+	PriorScale mat.Symmetric
+
+	// Src is the source of randomness for the
+	// k-means initialization. If Src is nil,
+	// the global source is used.
+	Src rand.Source
+}
+
+// Mixture is a Dirichlet process mixture of multivariate normal
+// distributions fitted by truncated variational inference.
+type Mixture struct {
+	// Weights holds the expected mixing
+	// weight of each component.
+	Weights []float64
+
+	// Components holds the normal distribution
+	// of each component, with the posterior
+	// mean of the component and the inverse of
+	// its expected precision as covariance.
+	Components []*distmv.Normal
+
+	// Responsibilities holds the posterior
+	// probability of each component for each
+	// observation, with observations in rows.
+	Responsibilities *mat.Dense
+
+	// Labels holds the most probable
+	// component of each observation.
+	Labels []int
+
+	// Iterations is the number of
+	// variational iterations.
+	Iterations int
+
+	// Converged indicates whether the
+	// iteration converged before the
+	// iteration limit.
+	Converged bool
+
+	// elnpi holds the expected logarithm
+	// of the mixing weights and comps the
+	// posterior of each component.
+	elnpi []float64
+	comps []component
+}
+
+// component is the normal-Wishart posterior of a mixture component.
+type component struct {
+	beta, nu float64
+	mean     []float64
+
+	// chol is the Cholesky factorization of
+	// the inverse of the Wishart scale matrix.
+	chol mat.Cholesky
+
+	// elnlam is the expected logarithm of the
+	// determinant of the component precision.
+	elnlam float64
+}
+
+// FitMixture fits a Dirichlet process mixture of multivariate normal
+// distributions to the observations in the rows of x. The mixing weights
+// have the stick-breaking prior of a Dirichlet process truncated to
+// Truncation components, and the component means and precisions have a
+// conjugate normal-Wishart prior. The posterior is approximated by mean-
+// field variational inference initialized by k-means clustering, so the
+// number of components with non-negligible weight is inferred from the
+// data. If settings is nil, default settings are used.
+//
+// See Blei, D. M. and Jordan, M. I. (2006) Variational inference for
+// Dirichlet process mixtures. Bayesian Analysis 1(1) for details.
+//
+// FitMixture returns an error if PriorScale or the posterior scale of a
+// component is not positive definite, which may happen if the data lie in
+// a lower dimensional subspace and PriorScale is nil. FitMixture will
+// panic if the settings are invalid.
+func FitMixture(x mat.Matrix, settings *MixtureSettings) (*Mixture, error) {
+	n, d := x.Dims()
+	var s MixtureSettings
+	if settings != nil {
+		s = *settings
+	}
+	if s.Alpha == 0 {
+		s.Alpha = 1
+	}
+	if s.Truncation == 0 {
+		s.Truncation = 10
+	}
+	if s.MaxIterations == 0 {
+		s.MaxIterations = 100
+	}
+	if s.Tolerance == 0 {
+		s.Tolerance = 1e-6
+	}
+	if s.PriorPrecision == 0 {
+		s.PriorPrecision = 1
+	}
+	if s.PriorDegrees == 0 {
+		s.PriorDegrees = float64(d)
+	}
+	switch {
+	case !(s.Alpha > 0):
+		panic("dp: non-positive concentration")
+	case s.Truncation < 0:
+		panic("dp: negative truncation")
+	case s.MaxIterations < 0:
+		panic("dp: negative iteration limit")
+	case !(s.PriorPrecision > 0):
+		panic("dp: non-positive prior precision")
+	case !(s.PriorDegrees > float64(d-1)):
+		panic("dp: too few prior degrees of freedom")
+	case s.PriorMean != nil && len(s.PriorMean) != d:
+		panic("dp: prior mean dimension mismatch")
+	case s.PriorScale != nil && s.PriorScale.SymmetricDim() != d:
+		panic("dp: prior scale dimension mismatch")
+	}
+
+	xd := mat.DenseCopyOf(x)
+	m0 := s.PriorMean
+	if m0 == nil {
+		m0 = make([]float64, d)
+		for j := range m0 {
+			m0[j] = stat.Mean(mat.Col(nil, j, xd), nil)
+		}
+	}
+	var s0 mat.SymDense
+	if s.PriorScale == nil {
+		stat.CovarianceMatrix(&s0, xd, nil)
+	} else {
+		s0.CopySym(s.PriorScale)
+	}
+	var chol0 mat.Cholesky
+	if !chol0.Factorize(&s0) {
+		return nil, errNotPositiveDefinite
+	}
+
+	k := s.Truncation
+	resp := mat.NewDense(n, k, nil)
+	init := cluster.KMeans(xd, min(k, n), &cluster.KMeansSettings{Src: s.Src})
+	for i, l := range init.Labels {
+		resp.Set(i, l, 1)
+	}
+
+	mix := &Mixture{
+		Weights:          make([]float64, k),
+		Responsibilities: resp,
+		elnpi:            make([]float64, k),
+		comps:            make([]component, k),
+	}
+	p := prior{mean: m0, scale: &s0, beta: s.PriorPrecision, nu: s.PriorDegrees, alpha: s.Alpha}
+	err := mix.update(xd, &p)
+	if err != nil {
+		return nil, err
+	}
+	prev := mat.NewDense(n, k, nil)
+	for mix.Iterations < s.MaxIterations {
+		prev.Copy(resp)
+		for i := range n {
+			mix.posterior(resp.RawRowView(i), xd.RawRowView(i))
+		}
+		mix.Iterations++
+		err = mix.update(xd, &p)
+		if err != nil {
+			return nil, err
+		}
+		prev.Sub(prev, resp)
+		var delta float64
+		for i := range n {
+			for _, v := range prev.RawRowView(i) {
+				delta = max(delta, math.Abs(v))
+			}
+		}
+		if delta < s.Tolerance {
+			mix.Converged = true
+			break
+		}
+	}
+
+	mix.Components = make([]*distmv.Normal, k)
+	for j := range mix.comps {
+		c := &mix.comps[j]
+		var cov mat.SymDense
+		c.chol.ToSym(&cov)
+		cov.ScaleSym(1/c.nu, &cov)
+		norm, ok := distmv.NewNormal(c.mean, &cov, nil)
+		if !ok {
+			return nil, errNotPositiveDefinite
+		}
+		mix.Components[j] = norm
+	}
+	mix.Labels = make([]int, n)
+	for i := range n {
+		mix.Labels[i] = floats.MaxIdx(resp.RawRowView(i))
+	}
+	return mix, nil
+}
+
+// prior is the prior of a Dirichlet process mixture.
+type prior struct {
+	mean  []float64
+	scale *mat.SymDense
+	beta  float64
+	nu    float64
+	alpha float64
+}
+
+// update sets the variational posterior of the mixing weights and
+// components given the responsibilities of the mixture.
+func (mix *Mixture) update(x *mat.Dense, p *prior) error {
+	n, d := x.Dims()
+	resp := mix.Responsibilities
+	k := len(mix.comps)
+
+	// Compute the sufficient statistics of the components.
+	nk := make([]float64, k)
+	for i := range n {
+		floats.Add(nk, resp.RawRowView(i))
+	}
+	for j := range nk {
+		// Avoid division by zero for empty components.
+		nk[j] += 10 * math.SmallestNonzeroFloat64
+	}
+	var xbar mat.Dense
+	xbar.Mul(resp.T(), x)
+
+	var winv mat.SymDense
+	diff := mat.NewDense(n, d, nil)
+	dm := mat.NewVecDense(d, nil)
+	for j := range mix.comps {
+		c := &mix.comps[j]
+		mean := xbar.RawRowView(j)
+		floats.Scale(1/nk[j], mean)
+		for i := range n {
+			r := math.Sqrt(resp.At(i, j))
+			floats.SubTo(diff.RawRowView(i), x.RawRowView(i), mean)
+			floats.Scale(r, diff.RawRowView(i))
+		}
+		winv.SymRankK(p.scale, 1, diff.T())
+		floats.SubTo(dm.RawVector().Data, mean, p.mean)
+		winv.SymRankOne(&winv, p.beta*nk[j]/(p.beta+nk[j]), dm)
+		if !c.chol.Factorize(&winv) {
+			return errNotPositiveDefinite
+		}
+
+		c.beta = p.beta + nk[j]
+		c.nu = p.nu + nk[j]
+		if c.mean == nil {
+			c.mean = make([]float64, d)
+		}
+		floats.AddScaledTo(c.mean, floats.ScaleTo(c.mean, p.beta, p.mean), nk[j], mean)
+		floats.Scale(1/c.beta, c.mean)
+		c.elnlam = float64(d)*math.Ln2 - c.chol.LogDet()
+		for i := 1; i <= d; i++ {
+			c.elnlam += mathext.Digamma((c.nu + 1 - float64(i)) / 2)
+		}
+	}
+
+	// Compute the stick-breaking posterior of the weights, with
+	// the last stick proportion fixed at one by the truncation.
+	var tail float64
+	for j := range nk[1:] {
+		tail += nk[j+1]
+	}
+	var elnrest float64
+	rest := 1.0
+	for j := range k - 1 {
+		g1 := 1 + nk[j]
+		g2 := p.alpha + tail
+		dsum := mathext.Digamma(g1 + g2)
+		mix.elnpi[j] = elnrest + mathext.Digamma(g1) - dsum
+		elnrest += mathext.Digamma(g2) - dsum
+		v := g1 / (g1 + g2)
+		mix.Weights[j] = rest * v
+		rest *= 1 - v
+		tail -= nk[j+1]
+	}
+	mix.elnpi[k-1] = elnrest
+	mix.Weights[k-1] = rest
+	return nil
+}
+
+// posterior stores in dst the posterior probabilities
+// of the components of the mixture for the point x.
+func (mix *Mixture) posterior(dst, x []float64) {
+	d := len(x)
+	diff := mat.NewVecDense(d, nil)
+	var z mat.VecDense
+	for j := range mix.comps {
+		c := &mix.comps[j]
+		floats.SubTo(diff.RawVector().Data, x, c.mean)
+		// The factor is non-singular since its factorization
+		// succeeded, so a Condition error is only advisory.
+		_ = z.SolveVec(c.chol.RawU().T(), diff)
+		quad := mat.Dot(&z, &z)
+		dst[j] = mix.elnpi[j] + 0.5*c.elnlam - 0.5*float64(d)*math.Log(2*math.Pi) - 0.5*(float64(d)/c.beta+c.nu*quad)
+	}
+	lse := floats.LogSumExp(dst)
+	for j, v := range dst {
+		dst[j] = math.Exp(v - lse)
+	}
+}
+
+// Predict returns the most probable component of the mixture for the
+// point x. If dst is not nil, the posterior probabilities of the
+// components are stored in dst, which must have length equal to the number
+// of components.
+//
+// Predict will panic if the length of x is not the dimension of the
+// mixture or dst has the wrong length.
+func (mix *Mixture) Predict(dst, x []float64) int {
+	if len(x) != len(mix.comps[0].mean) {
+		panic("dp: dimension mismatch")
+	}
+	if dst == nil {
+		dst = make([]float64, len(mix.comps))
+	} else if len(dst) != len(mix.comps) {
+		panic("dp: length mismatch")
+	}
+	mix.posterior(dst, x)
+	return floats.MaxIdx(dst)
+}
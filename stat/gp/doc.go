@@ -0,0 +1,9 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package gp provides Gaussian process regression.
+//
+// See Rasmussen and Williams, "Gaussian Processes for Machine Learning",
+// MIT Press 2006. ISBN 0-262-18253-X for details of Gaussian processes.
+package gp // import "gonum.org/v1/gonum/stat/gp"
@@ -0,0 +1,279 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gp
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+// Regressor is a Gaussian process regression model with a zero mean prior
+// and independent Gaussian observation noise. Data with a non-zero mean
+// should be centered before fitting.
+type Regressor struct {
+	// Kernel is the covariance function
+	// of the prior.
+	Kernel Kernel
+
+	// Noise is the variance of the
+	// observation noise.
+	Noise float64
+
+	x *mat.Dense
+	y *mat.VecDense
+
+	chol  mat.Cholesky
+	alpha mat.VecDense
+}
+
+// NewRegressor returns a new Regressor with the given kernel and
+// observation noise variance.
+func NewRegressor(k Kernel, noise float64) *Regressor {
+	return &Regressor{Kernel: k, Noise: noise}
+}
+
+var errNotPositiveDefinite = errors.New("gp: covariance matrix not positive definite")
+
+// Fit conditions the Gaussian process on the observations y at the
+// locations in the rows of x using exact inference. The values in x and
+// y are copied. Fit returns an error if the covariance matrix of the
+// observations is not positive definite, which may happen if Noise is
+// zero and locations are duplicated. Fit will panic if the number of
+// rows of x is not equal to the length of y.
+func (r *Regressor) Fit(x mat.Matrix, y []float64) error {
+	n, _ := x.Dims()
+	if n != len(y) {
+		panic("gp: data length mismatch")
+	}
+	r.x = mat.DenseCopyOf(x)
+	r.y = mat.NewVecDense(n, append([]float64(nil), y...))
+	return r.factorize()
+}
+
+// factorize computes the Cholesky factorization of the covariance
+// matrix of the observations and the weights of the predictive mean.
+func (r *Regressor) factorize() error {
+	n, _ := r.x.Dims()
+	k := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		xi := r.x.RawRowView(i)
+		for j := i; j < n; j++ {
+			k.SetSym(i, j, r.Kernel.Covariance(xi, r.x.RawRowView(j)))
+		}
+		k.SetSym(i, i, k.At(i, i)+r.Noise)
+	}
+	if !r.chol.Factorize(k) {
+		r.chol.Reset()
+		return errNotPositiveDefinite
+	}
+	// The number of observations may differ from a previous fit.
+	r.alpha.Reset()
+	err := r.chol.SolveVecTo(&r.alpha, r.y)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// LogMarginalLikelihood returns the log marginal likelihood of the
+// observations passed to Fit,
+//
+//	log p(y|X) = -1/2 yᵀ K⁻¹ y - 1/2 log|K| - n/2 log(2π)
+//
+// where K is the covariance matrix of the observations including noise.
+// LogMarginalLikelihood will panic if the receiver has not been
+// successfully fitted.
+func (r *Regressor) LogMarginalLikelihood() float64 {
+	if r.chol.IsEmpty() {
+		panic("gp: regressor not fitted")
+	}
+	n := float64(r.y.Len())
+	return -0.5*mat.Dot(r.y, &r.alpha) - 0.5*r.chol.LogDet() - 0.5*n*math.Log(2*math.Pi)
+}
+
+// Predict returns the mean and variance of the posterior distribution of
+// the latent function at x. The returned variance does not include the
+// observation noise. Predict will panic if the receiver has not been
+// successfully fitted or if the length of x does not match the number of
+// columns of the fitted locations.
+func (r *Regressor) Predict(x []float64) (mean, variance float64) {
+	if r.chol.IsEmpty() {
+		panic("gp: regressor not fitted")
+	}
+	n, c := r.x.Dims()
+	if len(x) != c {
+		panic("gp: dimension mismatch")
+	}
+	ks := mat.NewVecDense(n, nil)
+	for i := 0; i < n; i++ {
+		ks.SetVec(i, r.Kernel.Covariance(x, r.x.RawRowView(i)))
+	}
+	mean = mat.Dot(ks, &r.alpha)
+
+	var v mat.VecDense
+	_ = r.chol.SolveVecTo(&v, ks)
+	variance = r.Kernel.Covariance(x, x) - mat.Dot(ks, &v)
+	return mean, math.Max(variance, 0)
+}
+
+// PredictCov returns the mean of the posterior distribution of the latent
+// function at the locations in the rows of x and places the posterior
+// covariance between the locations into dst. If dst is empty it is resized
+// to the number of rows in x. PredictCov will panic if the receiver has not
+// been successfully fitted, if the number of columns of x does not match
+// the number of columns of the fitted locations or if dst is not empty and
+// does not have the same number of rows as x.
+func (r *Regressor) PredictCov(dst *mat.SymDense, x mat.Matrix) []float64 {
+	if r.chol.IsEmpty() {
+		panic("gp: regressor not fitted")
+	}
+	n, c := r.x.Dims()
+	m, cx := x.Dims()
+	if cx != c {
+		panic("gp: dimension mismatch")
+	}
+	if dst.IsEmpty() {
+		dst.ReuseAsSym(m)
+	} else if dst.SymmetricDim() != m {
+		panic(mat.ErrShape)
+	}
+	xs := mat.DenseCopyOf(x)
+
+	ks := mat.NewDense(n, m, nil)
+	for i := 0; i < n; i++ {
+		xi := r.x.RawRowView(i)
+		for j := 0; j < m; j++ {
+			ks.Set(i, j, r.Kernel.Covariance(xi, xs.RawRowView(j)))
+		}
+	}
+	var mean mat.VecDense
+	mean.MulVec(ks.T(), &r.alpha)
+
+	var v mat.Dense
+	_ = r.chol.SolveTo(&v, ks)
+	var q mat.Dense
+	q.Mul(ks.T(), &v)
+	for i := 0; i < m; i++ {
+		xi := xs.RawRowView(i)
+		for j := i; j < m; j++ {
+			dst.SetSym(i, j, r.Kernel.Covariance(xi, xs.RawRowView(j))-q.At(i, j))
+		}
+	}
+	return mean.RawVector().Data
+}
+
+// Optimize fits the hyperparameters of the kernel and, if Noise is non-zero,
+// the observation noise variance by maximizing the log marginal likelihood
+// of the observations y at the locations in the rows of x. The current
+// hyperparameters are used as the starting location of the optimization.
+// After a successful optimization, the receiver is fitted to the data as if
+// Fit had been called.
+//
+// If method is nil, BFGS is used. If settings is nil, default settings are
+// used with a gradient threshold of 1e-4.
+//
+// Optimize returns an error if the optimization or the final fit fails.
+// If the optimization fails the hyperparameters are not altered.
+func (r *Regressor) Optimize(x mat.Matrix, y []float64, settings *optimize.Settings, method optimize.Method) error {
+	n, _ := x.Dims()
+	if n != len(y) {
+		panic("gp: data length mismatch")
+	}
+	r.x = mat.DenseCopyOf(x)
+	r.y = mat.NewVecDense(n, append([]float64(nil), y...))
+
+	np := r.Kernel.NumParameters()
+	fitNoise := r.Noise != 0
+	init := make([]float64, np, np+1)
+	r.Kernel.Parameters(init)
+	if fitNoise {
+		init = append(init, math.Log(r.Noise))
+	}
+	orig := append([]float64(nil), init...)
+	origNoise := r.Noise
+
+	set := func(p []float64) {
+		r.Kernel.SetParameters(p[:np])
+		if fitNoise {
+			r.Noise = math.Exp(p[np])
+		}
+	}
+	problem := optimize.Problem{
+		Func: func(p []float64) float64 {
+			set(p)
+			if r.factorize() != nil {
+				return math.Inf(1)
+			}
+			return -r.LogMarginalLikelihood()
+		},
+		Grad: func(grad, p []float64) {
+			set(p)
+			if r.factorize() != nil {
+				for i := range grad {
+					grad[i] = math.NaN()
+				}
+				return
+			}
+			r.negLogLikelihoodGrad(grad, np, fitNoise)
+		},
+	}
+	if method == nil {
+		method = &optimize.BFGS{}
+	}
+	if settings == nil {
+		// The log marginal likelihood is computed with
+		// limited precision, so use a less strict gradient
+		// threshold than the optimize default.
+		settings = &optimize.Settings{GradientThreshold: 1e-4}
+	}
+	res, err := optimize.Minimize(problem, init, settings, method)
+	if err != nil {
+		r.Kernel.SetParameters(orig[:np])
+		r.Noise = origNoise
+		_ = r.factorize()
+		return err
+	}
+	set(res.X)
+	return r.factorize()
+}
+
+// negLogLikelihoodGrad places the gradient of the negative log marginal
+// likelihood with respect to the log hyperparameters into grad using
+//
+//	∂/∂θ log p(y|X) = 1/2 tr((α αᵀ - K⁻¹) ∂K/∂θ)
+//
+// where α = K⁻¹ y. The receiver must have been factorized.
+func (r *Regressor) negLogLikelihoodGrad(grad []float64, np int, fitNoise bool) {
+	n, _ := r.x.Dims()
+	var kinv mat.SymDense
+	_ = r.chol.InverseTo(&kinv)
+
+	for i := range grad {
+		grad[i] = 0
+	}
+	dk := make([]float64, np)
+	alpha := r.alpha.RawVector().Data
+	for i := 0; i < n; i++ {
+		xi := r.x.RawRowView(i)
+		for j := i; j < n; j++ {
+			r.Kernel.Gradient(dk, xi, r.x.RawRowView(j))
+			w := alpha[i]*alpha[j] - kinv.At(i, j)
+			if i != j {
+				// Account for the symmetric element.
+				w *= 2
+			}
+			for p, d := range dk {
+				grad[p] -= 0.5 * w * d
+			}
+		}
+		if fitNoise {
+			// ∂K/∂log(σ²) = σ² I.
+			grad[np] -= 0.5 * (alpha[i]*alpha[i] - kinv.At(i, i)) * r.Noise
+		}
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gp_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/gp"
+)
+
+func ExampleRegressor() {
+	// Noisy observations of sin(x).
+	x := mat.NewDense(8, 1, []float64{0, 1, 2, 3, 4, 5, 6, 7})
+	y := []float64{0.05, 0.81, 0.93, 0.12, -0.78, -0.93, -0.31, 0.68}
+
+	// Fit a Gaussian process with a Matérn 5/2 kernel
+	// and optimize its hyperparameters.
+	r := gp.NewRegressor(&gp.Matern{Variance: 1, LengthScale: 1, Nu: 2.5}, 0.01)
+	err := r.Optimize(x, y, nil, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, v := range []float64{1.5, 4.5} {
+		mean, variance := r.Predict([]float64{v})
+		fmt.Printf("f(%v)=%.1f±%.1f sin(%[1]v)=%.1f\n", v, mean, 2*math.Sqrt(variance), math.Sin(v))
+	}
+
+	// Output:
+	//
+	// f(1.5)=1.0±0.1 sin(1.5)=1.0
+	// f(4.5)=-1.0±0.1 sin(4.5)=-1.0
+}
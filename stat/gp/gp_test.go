@@ -0,0 +1,165 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gp
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func sinData(n int, noise float64, rnd *rand.Rand) (*mat.Dense, []float64) {
+	x := mat.NewDense(n, 1, nil)
+	y := make([]float64, n)
+	for i := range y {
+		v := 10 * rnd.Float64()
+		x.Set(i, 0, v)
+		y[i] = math.Sin(v) + noise*rnd.NormFloat64()
+	}
+	return x, y
+}
+
+func TestRegressorInterpolation(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	x, y := sinData(20, 0, rnd)
+	r := NewRegressor(&RBF{Variance: 1, LengthScale: 1}, 1e-10)
+	err := r.Fit(x, y)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, want := range y {
+		mean, variance := r.Predict(x.RawRowView(i))
+		if !scalar.EqualWithinAbs(mean, want, 1e-6) {
+			t.Errorf("unexpected mean at observation %d: got:%v want:%v", i, mean, want)
+		}
+		if variance > 1e-6 {
+			t.Errorf("unexpected variance at observation %d: got:%v want:0", i, variance)
+		}
+	}
+
+	// Far from the data the posterior reverts to the prior.
+	mean, variance := r.Predict([]float64{100})
+	if !scalar.EqualWithinAbs(mean, 0, 1e-10) || !scalar.EqualWithinAbs(variance, 1, 1e-10) {
+		t.Errorf("unexpected prediction far from data: got:%v±%v want:0±1", mean, variance)
+	}
+
+	// Between observations the posterior should be
+	// close to the interpolated function.
+	for _, v := range []float64{2.5, 5.5, 7.5} {
+		mean, _ := r.Predict([]float64{v})
+		if !scalar.EqualWithinAbs(mean, math.Sin(v), 0.05) {
+			t.Errorf("unexpected mean at %v: got:%v want:%v", v, mean, math.Sin(v))
+		}
+	}
+
+	// Refitting with a different number of observations must succeed.
+	x, y = sinData(25, 0, rnd)
+	err = r.Fit(x, y)
+	if err != nil {
+		t.Fatalf("unexpected error refitting: %v", err)
+	}
+	mean, _ = r.Predict(x.RawRowView(0))
+	if !scalar.EqualWithinAbs(mean, y[0], 1e-6) {
+		t.Errorf("unexpected mean after refitting: got:%v want:%v", mean, y[0])
+	}
+}
+
+func TestRegressorPredictCov(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	x, y := sinData(15, 0.1, rnd)
+	r := NewRegressor(&Matern{Variance: 1, LengthScale: 2, Nu: 2.5}, 0.01)
+	err := r.Fit(x, y)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	xs := mat.NewDense(4, 1, []float64{-1, 3.3, 6.1, 11})
+	var cov mat.SymDense
+	mean := r.PredictCov(&cov, xs)
+	for i := range mean {
+		m, v := r.Predict(xs.RawRowView(i))
+		if !scalar.EqualWithinAbsOrRel(mean[i], m, 1e-12, 1e-12) {
+			t.Errorf("unexpected mean %d: got:%v want:%v", i, mean[i], m)
+		}
+		if !scalar.EqualWithinAbsOrRel(cov.At(i, i), v, 1e-12, 1e-12) {
+			t.Errorf("unexpected variance %d: got:%v want:%v", i, cov.At(i, i), v)
+		}
+	}
+	var chol mat.Cholesky
+	if !chol.Factorize(&cov) {
+		t.Error("posterior covariance not positive definite")
+	}
+}
+
+func TestLogMarginalLikelihoodGradient(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	x, y := sinData(25, 0.1, rnd)
+	k := &Sum{A: &RBF{Variance: 1, LengthScale: 1.2}, B: &Periodic{Variance: 0.5, LengthScale: 1, Period: 6}}
+	r := NewRegressor(k, 0.05)
+	err := r.Fit(x, y)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	np := k.NumParameters()
+	p := make([]float64, np+1)
+	k.Parameters(p[:np])
+	p[np] = math.Log(r.Noise)
+
+	got := make([]float64, np+1)
+	r.negLogLikelihoodGrad(got, np, true)
+
+	want := fd.Gradient(nil, func(q []float64) float64 {
+		k.SetParameters(q[:np])
+		r.Noise = math.Exp(q[np])
+		if err := r.factorize(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return -r.LogMarginalLikelihood()
+	}, p, &fd.Settings{Formula: fd.Central})
+	if !floats.EqualApprox(got, want, 1e-5) {
+		t.Errorf("unexpected gradient: got:%v want:%v", got, want)
+	}
+}
+
+func TestRegressorOptimize(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const noise = 0.1
+	x, y := sinData(60, noise, rnd)
+	k := &RBF{Variance: 0.1, LengthScale: 0.2}
+	r := NewRegressor(k, 1)
+	err := r.Fit(x, y)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	before := r.LogMarginalLikelihood()
+	err = r.Optimize(x, y, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	after := r.LogMarginalLikelihood()
+	if after <= before {
+		t.Errorf("log marginal likelihood did not increase: before=%v after=%v", before, after)
+	}
+	if !scalar.EqualWithinRel(r.Noise, noise*noise, 0.5) {
+		t.Errorf("unexpected noise variance: got:%v want:%v", r.Noise, noise*noise)
+	}
+	if k.LengthScale < 0.5 || k.LengthScale > 2 {
+		t.Errorf("unexpected length scale: got:%v", k.LengthScale)
+	}
+	for _, v := range []float64{2.5, 5.5, 7.5} {
+		mean, _ := r.Predict([]float64{v})
+		if !scalar.EqualWithinAbs(mean, math.Sin(v), 0.1) {
+			t.Errorf("unexpected mean at %v: got:%v want:%v", v, mean, math.Sin(v))
+		}
+	}
+}
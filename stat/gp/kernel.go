@@ -0,0 +1,283 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gp
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+// Kernel is a positive definite covariance function with positive
+// hyperparameters. The Parameters, SetParameters and Gradient methods
+// work with the logarithms of the hyperparameters.
+type Kernel interface {
+	// Covariance returns the covariance between
+	// the process at x and at y.
+	Covariance(x, y []float64) float64
+
+	// NumParameters returns the number of
+	// hyperparameters of the kernel.
+	NumParameters() int
+
+	// Parameters places the logarithms of the
+	// hyperparameters of the kernel into dst.
+	Parameters(dst []float64)
+
+	// SetParameters sets the hyperparameters of
+	// the kernel from their logarithms in p.
+	SetParameters(p []float64)
+
+	// Gradient places the gradient of the
+	// covariance between x and y with respect
+	// to the logarithms of the hyperparameters
+	// into dst.
+	Gradient(dst, x, y []float64)
+}
+
+// RBF is the radial basis function, or squared exponential, kernel
+//
+//	k(x, y) = Variance exp(-‖x-y‖²/(2 LengthScale²))
+type RBF struct {
+	Variance    float64
+	LengthScale float64
+}
+
+// Covariance returns the covariance between the process at x and at y.
+func (k *RBF) Covariance(x, y []float64) float64 {
+	r2 := sqDist(x, y)
+	return k.Variance * math.Exp(-r2/(2*k.LengthScale*k.LengthScale))
+}
+
+// NumParameters returns 2.
+func (k *RBF) NumParameters() int { return 2 }
+
+// Parameters places the logarithms of the variance and length scale into dst.
+func (k *RBF) Parameters(dst []float64) {
+	dst[0] = math.Log(k.Variance)
+	dst[1] = math.Log(k.LengthScale)
+}
+
+// SetParameters sets the variance and length scale from their logarithms in p.
+func (k *RBF) SetParameters(p []float64) {
+	k.Variance = math.Exp(p[0])
+	k.LengthScale = math.Exp(p[1])
+}
+
+// Gradient places the gradient of the covariance with respect to the
+// logarithms of the variance and length scale into dst.
+func (k *RBF) Gradient(dst, x, y []float64) {
+	r2 := sqDist(x, y)
+	l2 := k.LengthScale * k.LengthScale
+	c := k.Variance * math.Exp(-r2/(2*l2))
+	dst[0] = c
+	dst[1] = c * r2 / l2
+}
+
+// Matern is the Matérn kernel with smoothness ν
+//
+//	k(x, y) = Variance 2^(1-ν)/Γ(ν) (√(2ν) r/LengthScale)^ν K_ν(√(2ν) r/LengthScale)
+//
+// where r = ‖x-y‖ and K_ν is the modified Bessel function of the second
+// kind. The smoothness is given by Nu, which must be one of 0.5, 1.5 or
+// 2.5. The smoothness is not a hyperparameter of the kernel.
+type Matern struct {
+	Variance    float64
+	LengthScale float64
+	Nu          float64
+}
+
+// Covariance returns the covariance between the process at x and at y.
+// Covariance will panic if k.Nu is not 0.5, 1.5 or 2.5.
+func (k *Matern) Covariance(x, y []float64) float64 {
+	a := k.scaled(x, y)
+	e := k.Variance * math.Exp(-a)
+	switch k.Nu {
+	case 0.5:
+		return e
+	case 1.5:
+		return e * (1 + a)
+	default:
+		return e * (1 + a + a*a/3)
+	}
+}
+
+// scaled returns √(2ν) ‖x-y‖/LengthScale.
+func (k *Matern) scaled(x, y []float64) float64 {
+	switch k.Nu {
+	case 0.5, 1.5, 2.5:
+	default:
+		panic("gp: unsupported Matérn smoothness")
+	}
+	return math.Sqrt(2*k.Nu) * floats.Distance(x, y, 2) / k.LengthScale
+}
+
+// NumParameters returns 2.
+func (k *Matern) NumParameters() int { return 2 }
+
+// Parameters places the logarithms of the variance and length scale into dst.
+func (k *Matern) Parameters(dst []float64) {
+	dst[0] = math.Log(k.Variance)
+	dst[1] = math.Log(k.LengthScale)
+}
+
+// SetParameters sets the variance and length scale from their logarithms in p.
+func (k *Matern) SetParameters(p []float64) {
+	k.Variance = math.Exp(p[0])
+	k.LengthScale = math.Exp(p[1])
+}
+
+// Gradient places the gradient of the covariance with respect to the
+// logarithms of the variance and length scale into dst.
+func (k *Matern) Gradient(dst, x, y []float64) {
+	a := k.scaled(x, y)
+	e := k.Variance * math.Exp(-a)
+	switch k.Nu {
+	case 0.5:
+		dst[0] = e
+		dst[1] = e * a
+	case 1.5:
+		dst[0] = e * (1 + a)
+		dst[1] = e * a * a
+	default:
+		dst[0] = e * (1 + a + a*a/3)
+		dst[1] = e * a * a * (1 + a) / 3
+	}
+}
+
+// Periodic is the periodic kernel
+//
+//	k(x, y) = Variance exp(-2 sin²(π‖x-y‖/Period)/LengthScale²)
+type Periodic struct {
+	Variance    float64
+	LengthScale float64
+	Period      float64
+}
+
+// Covariance returns the covariance between the process at x and at y.
+func (k *Periodic) Covariance(x, y []float64) float64 {
+	s := math.Sin(math.Pi * floats.Distance(x, y, 2) / k.Period)
+	return k.Variance * math.Exp(-2*s*s/(k.LengthScale*k.LengthScale))
+}
+
+// NumParameters returns 3.
+func (k *Periodic) NumParameters() int { return 3 }
+
+// Parameters places the logarithms of the variance, length scale and
+// period into dst.
+func (k *Periodic) Parameters(dst []float64) {
+	dst[0] = math.Log(k.Variance)
+	dst[1] = math.Log(k.LengthScale)
+	dst[2] = math.Log(k.Period)
+}
+
+// SetParameters sets the variance, length scale and period from their
+// logarithms in p.
+func (k *Periodic) SetParameters(p []float64) {
+	k.Variance = math.Exp(p[0])
+	k.LengthScale = math.Exp(p[1])
+	k.Period = math.Exp(p[2])
+}
+
+// Gradient places the gradient of the covariance with respect to the
+// logarithms of the variance, length scale and period into dst.
+func (k *Periodic) Gradient(dst, x, y []float64) {
+	t := math.Pi * floats.Distance(x, y, 2) / k.Period
+	s := math.Sin(t)
+	l2 := k.LengthScale * k.LengthScale
+	c := k.Variance * math.Exp(-2*s*s/l2)
+	dst[0] = c
+	dst[1] = c * 4 * s * s / l2
+	dst[2] = c * 2 * t * math.Sin(2*t) / l2
+}
+
+// Sum is the sum of two kernels. The hyperparameters of a Sum are those
+// of A followed by those of B.
+type Sum struct {
+	A, B Kernel
+}
+
+// Covariance returns the covariance between the process at x and at y.
+func (k *Sum) Covariance(x, y []float64) float64 {
+	return k.A.Covariance(x, y) + k.B.Covariance(x, y)
+}
+
+// NumParameters returns the total number of hyperparameters of A and B.
+func (k *Sum) NumParameters() int { return k.A.NumParameters() + k.B.NumParameters() }
+
+// Parameters places the logarithms of the hyperparameters of A and B
+// into dst.
+func (k *Sum) Parameters(dst []float64) {
+	n := k.A.NumParameters()
+	k.A.Parameters(dst[:n])
+	k.B.Parameters(dst[n:])
+}
+
+// SetParameters sets the hyperparameters of A and B from their
+// logarithms in p.
+func (k *Sum) SetParameters(p []float64) {
+	n := k.A.NumParameters()
+	k.A.SetParameters(p[:n])
+	k.B.SetParameters(p[n:])
+}
+
+// Gradient places the gradient of the covariance with respect to the
+// logarithms of the hyperparameters of A and B into dst.
+func (k *Sum) Gradient(dst, x, y []float64) {
+	n := k.A.NumParameters()
+	k.A.Gradient(dst[:n], x, y)
+	k.B.Gradient(dst[n:], x, y)
+}
+
+// Product is the product of two kernels. The hyperparameters of a Product
+// are those of A followed by those of B.
+type Product struct {
+	A, B Kernel
+}
+
+// Covariance returns the covariance between the process at x and at y.
+func (k *Product) Covariance(x, y []float64) float64 {
+	return k.A.Covariance(x, y) * k.B.Covariance(x, y)
+}
+
+// NumParameters returns the total number of hyperparameters of A and B.
+func (k *Product) NumParameters() int { return k.A.NumParameters() + k.B.NumParameters() }
+
+// Parameters places the logarithms of the hyperparameters of A and B
+// into dst.
+func (k *Product) Parameters(dst []float64) {
+	n := k.A.NumParameters()
+	k.A.Parameters(dst[:n])
+	k.B.Parameters(dst[n:])
+}
+
+// SetParameters sets the hyperparameters of A and B from their
+// logarithms in p.
+func (k *Product) SetParameters(p []float64) {
+	n := k.A.NumParameters()
+	k.A.SetParameters(p[:n])
+	k.B.SetParameters(p[n:])
+}
+
+// Gradient places the gradient of the covariance with respect to the
+// logarithms of the hyperparameters of A and B into dst.
+func (k *Product) Gradient(dst, x, y []float64) {
+	n := k.A.NumParameters()
+	k.A.Gradient(dst[:n], x, y)
+	k.B.Gradient(dst[n:], x, y)
+	a := k.A.Covariance(x, y)
+	b := k.B.Covariance(x, y)
+	floats.Scale(b, dst[:n])
+	floats.Scale(a, dst[n:])
+}
+
+func sqDist(x, y []float64) float64 {
+	var sum float64
+	for i, v := range x {
+		d := v - y[i]
+		sum += d * d
+	}
+	return sum
+}
@@ -0,0 +1,76 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gp
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestKernelGradient(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		k    Kernel
+	}{
+		{name: "rbf", k: &RBF{Variance: 1.5, LengthScale: 0.7}},
+		{name: "matern 1/2", k: &Matern{Variance: 2, LengthScale: 1.3, Nu: 0.5}},
+		{name: "matern 3/2", k: &Matern{Variance: 2, LengthScale: 1.3, Nu: 1.5}},
+		{name: "matern 5/2", k: &Matern{Variance: 2, LengthScale: 1.3, Nu: 2.5}},
+		{name: "periodic", k: &Periodic{Variance: 0.8, LengthScale: 1.1, Period: 2.3}},
+		{
+			name: "sum",
+			k:    &Sum{A: &RBF{Variance: 1.5, LengthScale: 0.7}, B: &Periodic{Variance: 0.8, LengthScale: 1.1, Period: 2.3}},
+		},
+		{
+			name: "product",
+			k:    &Product{A: &Matern{Variance: 2, LengthScale: 1.3, Nu: 1.5}, B: &Periodic{Variance: 0.8, LengthScale: 1.1, Period: 2.3}},
+		},
+	} {
+		for _, pair := range [][2][]float64{
+			{{0, 0}, {0.3, -0.4}},
+			{{1, 2}, {2.5, 0.5}},
+			{{-1, 1}, {-1, 1}},
+		} {
+			x, y := pair[0], pair[1]
+			n := test.k.NumParameters()
+			p := make([]float64, n)
+			test.k.Parameters(p)
+
+			got := make([]float64, n)
+			test.k.Gradient(got, x, y)
+			want := fd.Gradient(nil, func(q []float64) float64 {
+				test.k.SetParameters(q)
+				return test.k.Covariance(x, y)
+			}, p, &fd.Settings{Formula: fd.Central})
+			test.k.SetParameters(p)
+			if !floats.EqualApprox(got, want, 1e-6) {
+				t.Errorf("unexpected gradient for %s at %v %v: got:%v want:%v", test.name, x, y, got, want)
+			}
+
+			if c, d := test.k.Covariance(x, y), test.k.Covariance(y, x); c != d {
+				t.Errorf("asymmetric covariance for %s: %v != %v", test.name, c, d)
+			}
+		}
+	}
+}
+
+func TestMaternLimits(t *testing.T) {
+	t.Parallel()
+	x := []float64{0.2, 0.1}
+	y := []float64{1.1, -0.3}
+	r := floats.Distance(x, y, 2)
+	got := (&Matern{Variance: 2, LengthScale: 1.5, Nu: 0.5}).Covariance(x, y)
+	want := 2 * math.Exp(-r/1.5)
+	if math.Abs(got-want) > 1e-14 {
+		t.Errorf("unexpected Matérn ν=1/2 covariance: got:%v want:%v", got, want)
+	}
+	if got := (&Matern{Variance: 2, LengthScale: 1.5, Nu: 2.5}).Covariance(x, x); got != 2 {
+		t.Errorf("unexpected Matérn variance: got:%v want:2", got)
+	}
+}
@@ -0,0 +1,16 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package hmm provides hidden Markov models with pluggable emission
+// distributions, including evaluation of the likelihood and posterior
+// state probabilities by the forward-backward algorithm, decoding of the
+// most probable state sequence by the Viterbi algorithm, and maximum
+// likelihood estimation of the parameters by the Baum–Welch algorithm.
+// All computations are performed in log-space to avoid underflow on long
+// sequences.
+//
+// See Rabiner, L. R. "A tutorial on hidden Markov models and selected
+// applications in speech recognition", Proceedings of the IEEE 77:257-286
+// (1989). doi:10.1109/5.18626 for details of hidden Markov models.
+package hmm // import "gonum.org/v1/gonum/stat/hmm"
@@ -0,0 +1,150 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hmm
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/distmv"
+)
+
+// Emission is the distribution of the observations emitted in a hidden
+// state. Each observation is a vector, held in a row of the matrix of
+// observations passed to the methods of Model. Multivariate distributions
+// such as *distmv.Normal and *distmv.StudentsT satisfy Emission, and
+// univariate distributions can be used via Univariate.
+type Emission interface {
+	// LogProb returns the log of the probability or probability
+	// density of the observation x.
+	LogProb(x []float64) float64
+}
+
+// Fitter is an Emission whose parameters can be estimated from weighted
+// observations. Model.Fit re-estimates the emissions that implement
+// Fitter and leaves the others unchanged.
+type Fitter interface {
+	Emission
+
+	// Fit sets the parameters of the distribution to the maximum
+	// likelihood estimates from the observations in the rows of x
+	// with the given weights. If Fit returns an error the parameters
+	// must be left unchanged.
+	Fit(x mat.Matrix, weights []float64) error
+}
+
+var errNoWeight = errors.New("hmm: no weight on observations")
+
+// Discrete is an emission distribution over the symbols 0, 1, ..., n-1.
+// The observations are vectors of length one holding the symbol.
+type Discrete struct {
+	// Prob holds the probability of each symbol.
+	Prob []float64
+}
+
+// LogProb returns the log of the probability of the symbol x[0].
+// LogProb returns -Inf if x[0] is not a valid symbol.
+func (d Discrete) LogProb(x []float64) float64 {
+	s := x[0]
+	if s != math.Trunc(s) || s < 0 || int(s) >= len(d.Prob) {
+		return math.Inf(-1)
+	}
+	return math.Log(d.Prob[int(s)])
+}
+
+// Fit sets the probability of each symbol to its weighted frequency in the
+// first column of x. Fit returns an error if the sum of the weights is
+// zero. Fit panics if a symbol is not valid.
+func (d *Discrete) Fit(x mat.Matrix, weights []float64) error {
+	r, _ := x.Dims()
+	p := make([]float64, len(d.Prob))
+	var sum float64
+	for i := 0; i < r; i++ {
+		s := x.At(i, 0)
+		if s != math.Trunc(s) || s < 0 || int(s) >= len(p) {
+			panic("hmm: invalid symbol")
+		}
+		p[int(s)] += weights[i]
+		sum += weights[i]
+	}
+	if sum == 0 {
+		return errNoWeight
+	}
+	floats.Scale(1/sum, p)
+	d.Prob = p
+	return nil
+}
+
+// Gaussian is a multivariate normal emission distribution.
+type Gaussian struct {
+	*distmv.Normal
+}
+
+// Fit sets the mean and covariance of the distribution to the weighted
+// mean and the maximum likelihood estimate of the covariance of the rows
+// of x. Fit returns an error if the sum of the weights is zero or if the
+// estimated covariance is not positive definite.
+func (g *Gaussian) Fit(x mat.Matrix, weights []float64) error {
+	r, c := x.Dims()
+	mean := make([]float64, c)
+	var sum float64
+	row := make([]float64, c)
+	for i := 0; i < r; i++ {
+		floats.AddScaled(mean, weights[i], mat.Row(row, i, x))
+		sum += weights[i]
+	}
+	if sum == 0 {
+		return errNoWeight
+	}
+	floats.Scale(1/sum, mean)
+	cov := mat.NewSymDense(c, nil)
+	for i := 0; i < r; i++ {
+		floats.Sub(mat.Row(row, i, x), mean)
+		cov.SymRankOne(cov, weights[i]/sum, mat.NewVecDense(c, row))
+	}
+	n, ok := distmv.NewNormal(mean, cov, nil)
+	if !ok {
+		return errors.New("hmm: covariance not positive definite")
+	}
+	g.Normal = n
+	return nil
+}
+
+// Univariate is an emission distribution over scalar observations. The
+// observations are vectors of length one.
+type Univariate struct {
+	// Dist is the distribution of the observations, for example
+	// a distuv.Normal. If Dist also implements
+	//  Fit(samples, weights []float64)
+	// as the pointer types of several distuv distributions do,
+	// the distribution is re-estimated by Model.Fit.
+	Dist interface {
+		LogProb(float64) float64
+	}
+}
+
+// LogProb returns the log of the probability or probability density of x[0].
+func (u Univariate) LogProb(x []float64) float64 {
+	return u.Dist.LogProb(x[0])
+}
+
+// Fit fits the distribution to the weighted samples in the first column of
+// x if it implements a Fit method, and otherwise does nothing.
+func (u Univariate) Fit(x mat.Matrix, weights []float64) error {
+	f, ok := u.Dist.(interface {
+		Fit(samples, weights []float64)
+	})
+	if !ok {
+		return nil
+	}
+	if floats.Sum(weights) == 0 {
+		return errNoWeight
+	}
+	r, _ := x.Dims()
+	f.Fit(mat.Col(make([]float64, r), 0, x), weights)
+	return nil
+}
@@ -0,0 +1,278 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hmm
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Model is a hidden Markov model with n hidden states. The hidden state
+// s_t evolves as a Markov chain, and in each state an observation x_t is
+// emitted independently according to the emission distribution of the
+// state.
+type Model struct {
+	// Initial holds the probabilities of
+	// the first hidden state.
+	Initial []float64
+	// Transition is the n×n matrix of the
+	// probabilities P(s_{t+1} = j | s_t = i)
+	// in row i and column j.
+	Transition mat.Matrix
+	// Emissions holds the emission
+	// distribution of each state.
+	Emissions []Emission
+}
+
+// numStates returns the number of hidden states of the model, and panics
+// if the dimensions of the parameters are inconsistent.
+func (m *Model) numStates() int {
+	n := len(m.Initial)
+	if n == 0 {
+		panic("hmm: no states")
+	}
+	if r, c := m.Transition.Dims(); r != n || c != n {
+		panic("hmm: transition matrix dimension mismatch")
+	}
+	if len(m.Emissions) != n {
+		panic("hmm: emission count mismatch")
+	}
+	return n
+}
+
+// logParams returns the logs of the initial and transition probabilities,
+// and of the emission probabilities of each observation in the rows of x.
+func (m *Model) logParams(x mat.Matrix) (logInit []float64, logTrans, logEmit *mat.Dense) {
+	n := m.numStates()
+	logInit = make([]float64, n)
+	for i, p := range m.Initial {
+		logInit[i] = math.Log(p)
+	}
+	logTrans = mat.NewDense(n, n, nil)
+	logTrans.Apply(func(i, j int, v float64) float64 { return math.Log(v) }, m.Transition)
+	r, c := x.Dims()
+	logEmit = mat.NewDense(r, n, nil)
+	obs := make([]float64, c)
+	for t := 0; t < r; t++ {
+		mat.Row(obs, t, x)
+		for j, e := range m.Emissions {
+			logEmit.Set(t, j, e.LogProb(obs))
+		}
+	}
+	return logInit, logTrans, logEmit
+}
+
+// forward returns the log of the forward variables
+//
+//	α_t(j) = P(x_0, ..., x_t, s_t = j)
+//
+// in row t, and the log-likelihood of the observations.
+func forward(logInit []float64, logTrans, logEmit *mat.Dense) (logAlpha *mat.Dense, logLike float64) {
+	r, n := logEmit.Dims()
+	logAlpha = mat.NewDense(r, n, nil)
+	floats.AddTo(logAlpha.RawRowView(0), logInit, logEmit.RawRowView(0))
+	tmp := make([]float64, n)
+	for t := 1; t < r; t++ {
+		prev := logAlpha.RawRowView(t - 1)
+		cur := logAlpha.RawRowView(t)
+		for j := range cur {
+			for i, a := range prev {
+				tmp[i] = a + logTrans.At(i, j)
+			}
+			cur[j] = floats.LogSumExp(tmp) + logEmit.At(t, j)
+		}
+	}
+	return logAlpha, floats.LogSumExp(logAlpha.RawRowView(r - 1))
+}
+
+// backward returns the log of the backward variables
+//
+//	β_t(i) = P(x_{t+1}, ..., x_{T-1} | s_t = i)
+//
+// in row t.
+func backward(logTrans, logEmit *mat.Dense) *mat.Dense {
+	r, n := logEmit.Dims()
+	logBeta := mat.NewDense(r, n, nil)
+	tmp := make([]float64, n)
+	for t := r - 2; t >= 0; t-- {
+		next := logBeta.RawRowView(t + 1)
+		cur := logBeta.RawRowView(t)
+		for i := range cur {
+			for j, b := range next {
+				tmp[j] = logTrans.At(i, j) + logEmit.At(t+1, j) + b
+			}
+			cur[i] = floats.LogSumExp(tmp)
+		}
+	}
+	return logBeta
+}
+
+// LogLikelihood returns the log-likelihood of the sequence of observations
+// in the rows of x, computed by the forward algorithm. LogLikelihood panics
+// if x has no rows.
+func (m *Model) LogLikelihood(x mat.Matrix) float64 {
+	logInit, logTrans, logEmit := m.logParams(x)
+	_, logLike := forward(logInit, logTrans, logEmit)
+	return logLike
+}
+
+// Posterior returns the posterior probabilities of the hidden states given
+// the sequence of observations in the rows of x, computed by the
+// forward-backward algorithm. The element in row t and column j of the
+// returned matrix is P(s_t = j | x). Posterior also returns the
+// log-likelihood of the observations. Posterior panics if x has no rows.
+func (m *Model) Posterior(x mat.Matrix) (post *mat.Dense, logLike float64) {
+	logInit, logTrans, logEmit := m.logParams(x)
+	logAlpha, logLike := forward(logInit, logTrans, logEmit)
+	logBeta := backward(logTrans, logEmit)
+	post = logAlpha
+	post.Apply(func(i, j int, v float64) float64 {
+		return math.Exp(v + logBeta.At(i, j) - logLike)
+	}, post)
+	return post, logLike
+}
+
+// Viterbi returns the most probable sequence of hidden states given the
+// sequence of observations in the rows of x, and the log of the joint
+// probability of the observations and that sequence of states. Viterbi
+// panics if x has no rows.
+func (m *Model) Viterbi(x mat.Matrix) (states []int, logProb float64) {
+	logInit, logTrans, logEmit := m.logParams(x)
+	r, n := logEmit.Dims()
+	delta := make([]float64, n)
+	floats.AddTo(delta, logInit, logEmit.RawRowView(0))
+	next := make([]float64, n)
+	back := make([][]int, r)
+	for t := 1; t < r; t++ {
+		back[t] = make([]int, n)
+		for j := range next {
+			best, arg := math.Inf(-1), 0
+			for i, d := range delta {
+				if v := d + logTrans.At(i, j); v > best {
+					best, arg = v, i
+				}
+			}
+			next[j] = best + logEmit.At(t, j)
+			back[t][j] = arg
+		}
+		delta, next = next, delta
+	}
+	states = make([]int, r)
+	states[r-1] = floats.MaxIdx(delta)
+	logProb = delta[states[r-1]]
+	for t := r - 1; t > 0; t-- {
+		states[t-1] = back[t][states[t]]
+	}
+	return states, logProb
+}
+
+// Fit estimates the parameters of the receiver from the sequences of
+// observations in seqs by maximum likelihood using the Baum–Welch
+// algorithm, starting from the current parameters. The initial and
+// transition probabilities are re-estimated, as are the emission
+// distributions that implement Fitter.
+//
+// Fit stops when the increase in the total log-likelihood of the sequences
+// in an iteration is less than tol times its magnitude, or after maxIter
+// iterations, and returns the log-likelihood under the updated parameters.
+//
+// Fit returns an error if re-estimating an emission distribution fails, in
+// which case the initial and transition probabilities of the receiver are
+// those of the last successful iteration, but emissions earlier in
+// m.Emissions than the failing one may have been updated. Fit panics if
+// seqs is empty or any sequence has no rows.
+func (m *Model) Fit(seqs []mat.Matrix, maxIter int, tol float64) (logLike float64, err error) {
+	if len(seqs) == 0 {
+		panic("hmm: no sequences")
+	}
+	n := m.numStates()
+
+	// Collect all observations for re-estimating the emissions.
+	var total int
+	for _, x := range seqs {
+		r, _ := x.Dims()
+		total += r
+	}
+	_, c := seqs[0].Dims()
+	all := mat.NewDense(total, c, nil)
+	var off int
+	for _, x := range seqs {
+		r, _ := x.Dims()
+		all.Slice(off, off+r, 0, c).(*mat.Dense).Copy(x)
+		off += r
+	}
+
+	llOld := math.Inf(-1)
+	weights := mat.NewDense(total, n, nil)
+	for iter := 0; ; iter++ {
+		initial := make([]float64, n)
+		trans := mat.NewDense(n, n, nil)
+		logLike = 0
+		off = 0
+		for _, x := range seqs {
+			logInit, logTrans, logEmit := m.logParams(x)
+			logAlpha, ll := forward(logInit, logTrans, logEmit)
+			logBeta := backward(logTrans, logEmit)
+			logLike += ll
+
+			r, _ := logEmit.Dims()
+			for t := 0; t < r; t++ {
+				row := weights.RawRowView(off + t)
+				for j := range row {
+					row[j] = math.Exp(logAlpha.At(t, j) + logBeta.At(t, j) - ll)
+				}
+			}
+			floats.Add(initial, weights.RawRowView(off))
+
+			// Accumulate the expected number of transitions
+			//  ξ_t(i, j) = α_t(i) a_ij b_j(x_{t+1}) β_{t+1}(j) / P(x).
+			for t := 0; t < r-1; t++ {
+				for i := 0; i < n; i++ {
+					for j := 0; j < n; j++ {
+						v := logAlpha.At(t, i) + logTrans.At(i, j) + logEmit.At(t+1, j) + logBeta.At(t+1, j) - ll
+						trans.Set(i, j, trans.At(i, j)+math.Exp(v))
+					}
+				}
+			}
+			off += r
+		}
+		if iter == maxIter || logLike-llOld <= tol*math.Abs(logLike) {
+			return logLike, nil
+		}
+		llOld = logLike
+
+		col := make([]float64, total)
+		for j, e := range m.Emissions {
+			f, ok := e.(Fitter)
+			if !ok {
+				continue
+			}
+			err := f.Fit(all, mat.Col(col, j, weights))
+			if err == errNoWeight {
+				// The state is never visited so its
+				// emission cannot be estimated.
+				continue
+			}
+			if err != nil {
+				return 0, err
+			}
+		}
+		floats.Scale(1/floats.Sum(initial), initial)
+		for i := 0; i < n; i++ {
+			row := trans.RawRowView(i)
+			if sum := floats.Sum(row); sum > 0 {
+				floats.Scale(1/sum, row)
+			} else {
+				// Keep the transition probabilities of a
+				// state that is never left.
+				mat.Row(row, i, m.Transition)
+			}
+		}
+		m.Initial = initial
+		m.Transition = trans
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hmm_test
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/hmm"
+)
+
+func ExampleModel_Viterbi() {
+	// A casino switches between a fair die and a loaded die that
+	// rolls a six half of the time. The faces are the symbols 0 to 5.
+	m := &hmm.Model{
+		Initial:    []float64{0.5, 0.5},
+		Transition: mat.NewDense(2, 2, []float64{0.95, 0.05, 0.1, 0.9}),
+		Emissions: []hmm.Emission{
+			hmm.Discrete{Prob: []float64{1. / 6, 1. / 6, 1. / 6, 1. / 6, 1. / 6, 1. / 6}},
+			hmm.Discrete{Prob: []float64{0.1, 0.1, 0.1, 0.1, 0.1, 0.5}},
+		},
+	}
+	rolls := []float64{2, 0, 4, 3, 1, 2, 0, 3, 5, 5, 1, 5, 5, 5, 5, 2, 5, 0, 3, 1, 2, 4, 0, 3}
+	x := mat.NewDense(len(rolls), 1, rolls)
+
+	states, _ := m.Viterbi(x)
+	fmt.Println("loaded:", states)
+
+	post, logLike := m.Posterior(x)
+	fmt.Printf("P(loaded) at roll 12: %.3f\n", post.At(12, 1))
+	fmt.Printf("log-likelihood: %.3f\n", logLike)
+
+	// Output:
+	// loaded: [0 0 0 0 0 0 0 0 1 1 1 1 1 1 1 1 1 0 0 0 0 0 0 0]
+	// P(loaded) at roll 12: 0.941
+	// log-likelihood: -41.006
+}
@@ -0,0 +1,318 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hmm
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/distmv"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+func discreteModel() *Model {
+	return &Model{
+		Initial: []float64{0.6, 0.3, 0.1},
+		Transition: mat.NewDense(3, 3, []float64{
+			0.7, 0.2, 0.1,
+			0.1, 0.8, 0.1,
+			0.3, 0.3, 0.4,
+		}),
+		Emissions: []Emission{
+			&Discrete{Prob: []float64{0.5, 0.4, 0.1}},
+			&Discrete{Prob: []float64{0.1, 0.3, 0.6}},
+			&Discrete{Prob: []float64{0.3, 0.3, 0.4}},
+		},
+	}
+}
+
+// enumerate computes the log-likelihood, the posterior probabilities of the
+// states and the most probable sequence of states by enumerating all paths.
+func enumerate(m *Model, x mat.Matrix) (logLike float64, post *mat.Dense, best []int, bestLogProb float64) {
+	r, c := x.Dims()
+	n := len(m.Initial)
+	post = mat.NewDense(r, n, nil)
+	path := make([]int, r)
+	obs := make([]float64, c)
+	var like float64
+	bestLogProb = math.Inf(-1)
+	for {
+		lp := math.Log(m.Initial[path[0]])
+		for t, s := range path {
+			if t > 0 {
+				lp += math.Log(m.Transition.At(path[t-1], s))
+			}
+			lp += m.Emissions[s].LogProb(mat.Row(obs, t, x))
+		}
+		p := math.Exp(lp)
+		like += p
+		for t, s := range path {
+			post.Set(t, s, post.At(t, s)+p)
+		}
+		if lp > bestLogProb {
+			bestLogProb = lp
+			best = append(best[:0], path...)
+		}
+
+		// Advance to the next path.
+		t := 0
+		for ; t < r; t++ {
+			path[t]++
+			if path[t] < n {
+				break
+			}
+			path[t] = 0
+		}
+		if t == r {
+			break
+		}
+	}
+	post.Scale(1/like, post)
+	return math.Log(like), post, best, bestLogProb
+}
+
+func TestInference(t *testing.T) {
+	const tol = 1e-12
+	for i, test := range []struct {
+		m *Model
+		x *mat.Dense
+	}{
+		{
+			m: discreteModel(),
+			x: mat.NewDense(1, 1, []float64{2}),
+		},
+		{
+			m: discreteModel(),
+			x: mat.NewDense(6, 1, []float64{0, 0, 2, 1, 2, 2}),
+		},
+		{
+			m: &Model{
+				Initial:    []float64{0.5, 0.5},
+				Transition: mat.NewDense(2, 2, []float64{0.9, 0.1, 0.2, 0.8}),
+				Emissions: []Emission{
+					Gaussian{mustNormal([]float64{0, 0}, []float64{1, 0.3, 0.3, 1}, nil)},
+					Gaussian{mustNormal([]float64{2, 1}, []float64{2, 0, 0, 0.5}, nil)},
+				},
+			},
+			x: mat.NewDense(5, 2, []float64{
+				0.1, -0.3,
+				1.5, 0.8,
+				2.2, 1.1,
+				-0.4, 0.2,
+				0.9, 0.4,
+			}),
+		},
+		{
+			m: &Model{
+				Initial:    []float64{1, 0},
+				Transition: mat.NewDense(2, 2, []float64{0.5, 0.5, 0, 1}),
+				Emissions: []Emission{
+					Univariate{distuv.Normal{Mu: 0, Sigma: 1}},
+					Univariate{distuv.Normal{Mu: 3, Sigma: 0.5}},
+				},
+			},
+			x: mat.NewDense(4, 1, []float64{0.2, 2.5, 3.1, 2.9}),
+		},
+	} {
+		wantLogLike, wantPost, wantStates, wantLogProb := enumerate(test.m, test.x)
+
+		logLike := test.m.LogLikelihood(test.x)
+		if !scalar.EqualWithinAbsOrRel(logLike, wantLogLike, tol, tol) {
+			t.Errorf("test %d: log-likelihood mismatch: got %v, want %v", i, logLike, wantLogLike)
+		}
+		post, logLike := test.m.Posterior(test.x)
+		if !mat.EqualApprox(post, wantPost, tol) {
+			t.Errorf("test %d: posterior mismatch:\ngot:\n%v\nwant:\n%v", i, mat.Formatted(post), mat.Formatted(wantPost))
+		}
+		if !scalar.EqualWithinAbsOrRel(logLike, wantLogLike, tol, tol) {
+			t.Errorf("test %d: posterior log-likelihood mismatch: got %v, want %v", i, logLike, wantLogLike)
+		}
+		states, logProb := test.m.Viterbi(test.x)
+		if !equalInts(states, wantStates) {
+			t.Errorf("test %d: Viterbi path mismatch: got %v, want %v", i, states, wantStates)
+		}
+		if !scalar.EqualWithinAbsOrRel(logProb, wantLogProb, tol, tol) {
+			t.Errorf("test %d: Viterbi log-probability mismatch: got %v, want %v", i, logProb, wantLogProb)
+		}
+	}
+}
+
+func TestInferenceLong(t *testing.T) {
+	// A long sequence underflows unless the
+	// computations are done in log-space.
+	m := discreteModel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	x := sample(m, 5000, rnd)
+	logLike := m.LogLikelihood(x)
+	if math.IsInf(logLike, 0) || math.IsNaN(logLike) {
+		t.Fatalf("unexpected log-likelihood: %v", logLike)
+	}
+	post, _ := m.Posterior(x)
+	r, _ := post.Dims()
+	for i := 0; i < r; i++ {
+		if sum := floats.Sum(post.RawRowView(i)); math.Abs(sum-1) > 1e-8 {
+			t.Fatalf("posterior at %d does not sum to one: %v", i, sum)
+		}
+	}
+	_, logProb := m.Viterbi(x)
+	if logProb > logLike {
+		t.Errorf("Viterbi log-probability greater than log-likelihood: %v > %v", logProb, logLike)
+	}
+}
+
+func TestFit(t *testing.T) {
+	src := rand.NewPCG(1, 1)
+	truth := &Model{
+		Initial:    []float64{0.5, 0.5},
+		Transition: mat.NewDense(2, 2, []float64{0.95, 0.05, 0.1, 0.9}),
+		Emissions: []Emission{
+			Gaussian{mustNormal([]float64{-2, 0}, []float64{1, 0.5, 0.5, 1}, src)},
+			Gaussian{mustNormal([]float64{2, 1}, []float64{0.5, 0, 0, 2}, src)},
+		},
+	}
+	rnd := rand.New(rand.NewPCG(2, 2))
+	seqs := make([]mat.Matrix, 4)
+	for i := range seqs {
+		seqs[i] = sample(truth, 500, rnd)
+	}
+
+	m := &Model{
+		Initial:    []float64{0.5, 0.5},
+		Transition: mat.NewDense(2, 2, []float64{0.5, 0.5, 0.5, 0.5}),
+		Emissions: []Emission{
+			&Gaussian{mustNormal([]float64{-1, 0}, []float64{1, 0, 0, 1}, nil)},
+			&Gaussian{mustNormal([]float64{1, 0}, []float64{1, 0, 0, 1}, nil)},
+		},
+	}
+	// The log-likelihood must not decrease with each iteration.
+	last := math.Inf(-1)
+	for i := 0; i < 10; i++ {
+		ll, err := m.Fit(seqs, 1, 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if ll < last-1e-8 {
+			t.Errorf("log-likelihood decreased at iteration %d: %v < %v", i, ll, last)
+		}
+		last = ll
+	}
+	_, err := m.Fit(seqs, 100, 1e-10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !mat.EqualApprox(m.Transition, truth.Transition, 0.03) {
+		t.Errorf("transition mismatch:\ngot:\n%v\nwant:\n%v", mat.Formatted(m.Transition), mat.Formatted(truth.Transition))
+	}
+	for i, e := range m.Emissions {
+		got := e.(*Gaussian)
+		want := truth.Emissions[i].(Gaussian)
+		if !floats.EqualApprox(got.Mean(nil), want.Mean(nil), 0.15) {
+			t.Errorf("state %d: mean mismatch: got %v, want %v", i, got.Mean(nil), want.Mean(nil))
+		}
+		var gotCov, wantCov mat.SymDense
+		got.CovarianceMatrix(&gotCov)
+		want.CovarianceMatrix(&wantCov)
+		if !mat.EqualApprox(&gotCov, &wantCov, 0.2) {
+			t.Errorf("state %d: covariance mismatch:\ngot:\n%v\nwant:\n%v", i, mat.Formatted(&gotCov), mat.Formatted(&wantCov))
+		}
+	}
+}
+
+func TestFitDiscrete(t *testing.T) {
+	truth := discreteModel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	seqs := []mat.Matrix{sample(truth, 200, rnd), sample(truth, 300, rnd)}
+
+	m := &Model{
+		Initial:    []float64{0.4, 0.3, 0.3},
+		Transition: mat.NewDense(3, 3, []float64{0.5, 0.3, 0.2, 0.2, 0.5, 0.3, 0.3, 0.2, 0.5}),
+		Emissions: []Emission{
+			&Discrete{Prob: []float64{0.4, 0.4, 0.2}},
+			&Discrete{Prob: []float64{0.2, 0.3, 0.5}},
+			// The emission of the last state is fixed.
+			Discrete{Prob: []float64{0.3, 0.3, 0.4}},
+		},
+	}
+	last := math.Inf(-1)
+	for i := 0; i < 20; i++ {
+		ll, err := m.Fit(seqs, 1, 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if ll < last-1e-8 {
+			t.Errorf("log-likelihood decreased at iteration %d: %v < %v", i, ll, last)
+		}
+		last = ll
+	}
+	if sum := floats.Sum(m.Initial); math.Abs(sum-1) > 1e-12 {
+		t.Errorf("initial probabilities do not sum to one: %v", sum)
+	}
+	for i := 0; i < 3; i++ {
+		if sum := floats.Sum(mat.Row(nil, i, m.Transition)); math.Abs(sum-1) > 1e-12 {
+			t.Errorf("transition probabilities of state %d do not sum to one: %v", i, sum)
+		}
+	}
+	if got := m.Emissions[2].(Discrete).Prob; !floats.Equal(got, []float64{0.3, 0.3, 0.4}) {
+		t.Errorf("fixed emission changed: %v", got)
+	}
+}
+
+// sample returns a sequence of r observations from the model. The emissions
+// must be Discrete or Gaussian.
+func sample(m *Model, r int, rnd *rand.Rand) *mat.Dense {
+	var c int
+	switch e := m.Emissions[0].(type) {
+	case Discrete, *Discrete:
+		c = 1
+	case Gaussian:
+		c = e.Dim()
+	case *Gaussian:
+		c = e.Dim()
+	}
+	x := mat.NewDense(r, c, nil)
+	n := len(m.Initial)
+	trans := make([]float64, n)
+	s := distuv.NewCategorical(m.Initial, rnd).Rand()
+	for t := 0; t < r; t++ {
+		if t > 0 {
+			s = distuv.NewCategorical(mat.Row(trans, int(s), m.Transition), rnd).Rand()
+		}
+		switch e := m.Emissions[int(s)].(type) {
+		case Discrete:
+			x.Set(t, 0, distuv.NewCategorical(e.Prob, rnd).Rand())
+		case *Discrete:
+			x.Set(t, 0, distuv.NewCategorical(e.Prob, rnd).Rand())
+		case Gaussian:
+			e.Rand(x.RawRowView(t))
+		case *Gaussian:
+			e.Rand(x.RawRowView(t))
+		}
+	}
+	return x
+}
+
+func mustNormal(mu, sigma []float64, src rand.Source) *distmv.Normal {
+	n, ok := distmv.NewNormal(mu, mat.NewSymDense(len(mu), sigma), src)
+	if !ok {
+		panic("bad test: covariance not positive definite")
+	}
+	return n
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, v := range a {
+		if b[i] != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,203 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"slices"
+	"sort"
+)
+
+// Isotonic is the isotonic regression of a response y on a single
+// predictor x, the monotone step function f minimizing the weighted sum of
+// squares
+//
+//	\sum_i w_i (y_i - f(x_i))²
+//
+// subject to f being non-decreasing, or non-increasing if Decreasing is
+// true. Observations with equal values of x are pooled before the
+// regression, so the fitted values at tied observations are equal. The
+// regression is computed by the pool adjacent violators algorithm in
+// linear time after sorting.
+//
+// Isotonic regression is commonly used to calibrate the scores of a
+// classifier to probabilities by regressing the class labels, coded as
+// zero and one, on the scores.
+//
+// See Barlow, R. E., Bartholomew, D. J., Bremner, J. M. and Brunk, H. D.
+// (1972) Statistical Inference under Order Restrictions. Wiley, for
+// details.
+type Isotonic struct {
+	// Decreasing specifies that the
+	// regression is non-increasing.
+	Decreasing bool
+
+	// knots holds the distinct values of x in
+	// increasing order, and values the fitted
+	// values at the knots.
+	knots, values []float64
+
+	// index holds the index of the knot of
+	// each observation in the order they were
+	// passed to Fit.
+	index []int
+
+	ok bool
+}
+
+// Fit fits the isotonic regression to the observations x and y with the
+// given weights, returning whether the fit was successful. If weights is
+// nil, all the weights are one. The fit fails if there are no
+// observations.
+//
+// Fit will panic if the lengths of x, y and weights, when not nil, are not
+// equal, or if any weight is not positive.
+func (iso *Isotonic) Fit(x, y, weights []float64) (ok bool) {
+	if len(x) != len(y) {
+		panic("stat: slice length mismatch")
+	}
+	if weights != nil && len(weights) != len(x) {
+		panic("stat: slice length mismatch")
+	}
+	for _, w := range weights {
+		if !(w > 0) {
+			panic("stat: non-positive weight")
+		}
+	}
+	iso.ok = false
+	n := len(x)
+	if n == 0 {
+		return false
+	}
+
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return x[order[i]] < x[order[j]] })
+
+	// Pool tied observations into knots holding the
+	// weighted mean of their responses, and merge
+	// adjacent blocks of knots while they violate the
+	// ordering. Each block is represented by its
+	// weighted mean, total weight and number of knots.
+	type block struct {
+		mean, weight float64
+		knots        int
+	}
+	sign := 1.0
+	if iso.Decreasing {
+		sign = -1
+	}
+	iso.knots = iso.knots[:0]
+	iso.index = slices.Grow(iso.index[:0], n)[:n]
+	var stack []block
+	for i := 0; i < n; {
+		xi := x[order[i]]
+		var sum, wsum float64
+		for ; i < n && x[order[i]] == xi; i++ {
+			w := 1.0
+			if weights != nil {
+				w = weights[order[i]]
+			}
+			sum += w * sign * y[order[i]]
+			wsum += w
+			iso.index[order[i]] = len(iso.knots)
+		}
+		iso.knots = append(iso.knots, xi)
+
+		b := block{mean: sum / wsum, weight: wsum, knots: 1}
+		for len(stack) != 0 && stack[len(stack)-1].mean > b.mean {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			w := top.weight + b.weight
+			b = block{
+				mean:   top.mean + (b.mean-top.mean)*(b.weight/w),
+				weight: w,
+				knots:  top.knots + b.knots,
+			}
+		}
+		stack = append(stack, b)
+	}
+
+	iso.values = slices.Grow(iso.values[:0], len(iso.knots))[:0]
+	for _, b := range stack {
+		for range b.knots {
+			iso.values = append(iso.values, sign*b.mean)
+		}
+	}
+	iso.ok = true
+	return true
+}
+
+// Fitted returns the fitted values of the regression at the observations in
+// the order they were passed to Fit. If dst is not nil, the values are
+// stored in dst, which must have length equal to the number of
+// observations.
+//
+// Fitted will panic if the receiver does not contain a successful fit or
+// dst has the wrong length.
+func (iso *Isotonic) Fitted(dst []float64) []float64 {
+	if !iso.ok {
+		panic("stat: use of unsuccessful Isotonic fit")
+	}
+	if dst == nil {
+		dst = make([]float64, len(iso.index))
+	} else if len(dst) != len(iso.index) {
+		panic("stat: slice length mismatch")
+	}
+	for i, k := range iso.index {
+		dst[i] = iso.values[k]
+	}
+	return dst
+}
+
+// Predict returns the value of the regression at x, interpolating linearly
+// between the fitted values at the nearest distinct observed values of x on
+// either side. Outside the range of the observed values of x, Predict
+// returns the fitted value at the nearest observed value. The interpolated
+// function is monotone and continuous.
+//
+// Predict will panic if the receiver does not contain a successful fit.
+func (iso *Isotonic) Predict(x float64) float64 {
+	if !iso.ok {
+		panic("stat: use of unsuccessful Isotonic fit")
+	}
+	k := sort.SearchFloat64s(iso.knots, x)
+	switch {
+	case k == len(iso.knots):
+		return iso.values[k-1]
+	case k == 0 || iso.knots[k] == x:
+		return iso.values[k]
+	}
+	x0, x1 := iso.knots[k-1], iso.knots[k]
+	y0, y1 := iso.values[k-1], iso.values[k]
+	return y0 + (y1-y0)*((x-x0)/(x1-x0))
+}
+
+// Knots returns the distinct observed values of x in increasing order and
+// the fitted values of the regression at them. If knots and values are not
+// nil, they are stored in knots and values, which must have length equal to
+// the number of distinct observed values of x.
+//
+// Knots will panic if the receiver does not contain a successful fit or
+// knots or values has the wrong length.
+func (iso *Isotonic) Knots(knots, values []float64) ([]float64, []float64) {
+	if !iso.ok {
+		panic("stat: use of unsuccessful Isotonic fit")
+	}
+	if knots == nil {
+		knots = make([]float64, len(iso.knots))
+	} else if len(knots) != len(iso.knots) {
+		panic("stat: slice length mismatch")
+	}
+	if values == nil {
+		values = make([]float64, len(iso.values))
+	} else if len(values) != len(iso.values) {
+		panic("stat: slice length mismatch")
+	}
+	copy(knots, iso.knots)
+	copy(values, iso.values)
+	return knots, values
+}
@@ -0,0 +1,216 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math/rand/v2"
+	"slices"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+// isotonicNaive returns the non-decreasing isotonic regression of y on x
+// at the observations by repeatedly pooling the first pair of adjacent
+// blocks violating the ordering.
+func isotonicNaive(x, y, weights []float64) []float64 {
+	n := len(x)
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return x[order[i]] < x[order[j]] })
+	type block struct {
+		sum, weight float64
+		members     []int
+	}
+	var blocks []block
+	for _, i := range order {
+		w := 1.0
+		if weights != nil {
+			w = weights[i]
+		}
+		if len(blocks) != 0 && x[blocks[len(blocks)-1].members[0]] == x[i] {
+			b := &blocks[len(blocks)-1]
+			b.sum += w * y[i]
+			b.weight += w
+			b.members = append(b.members, i)
+			continue
+		}
+		blocks = append(blocks, block{sum: w * y[i], weight: w, members: []int{i}})
+	}
+	for {
+		merged := false
+		for i := 0; i < len(blocks)-1; i++ {
+			a, b := blocks[i], blocks[i+1]
+			if a.sum/a.weight > b.sum/b.weight {
+				blocks[i] = block{
+					sum:     a.sum + b.sum,
+					weight:  a.weight + b.weight,
+					members: append(a.members, b.members...),
+				}
+				blocks = slices.Delete(blocks, i+1, i+2)
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			break
+		}
+	}
+	fitted := make([]float64, n)
+	for _, b := range blocks {
+		for _, i := range b.members {
+			fitted[i] = b.sum / b.weight
+		}
+	}
+	return fitted
+}
+
+func TestIsotonic(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		x, y, weights []float64
+		decreasing    bool
+		want          []float64
+	}{
+		{
+			x:    []float64{1, 2, 3, 4, 5, 6},
+			y:    []float64{1, 3, 2, 4, 3, 5},
+			want: []float64{1, 2.5, 2.5, 3.5, 3.5, 5},
+		},
+		{
+			x:    []float64{6, 5, 4, 3, 2, 1},
+			y:    []float64{5, 3, 4, 2, 3, 1},
+			want: []float64{5, 3.5, 3.5, 2.5, 2.5, 1},
+		},
+		{
+			x:       []float64{1, 2, 3},
+			y:       []float64{3, 1, 2},
+			weights: []float64{1, 3, 1},
+			want:    []float64{1.5, 1.5, 2},
+		},
+		{
+			x:    []float64{1, 2, 2, 3},
+			y:    []float64{2, 4, 0, 3},
+			want: []float64{2, 2, 2, 3},
+		},
+		{
+			x:          []float64{1, 2, 3, 4},
+			y:          []float64{4, 2, 3, 1},
+			decreasing: true,
+			want:       []float64{4, 2.5, 2.5, 1},
+		},
+		{
+			x:    []float64{1},
+			y:    []float64{7},
+			want: []float64{7},
+		},
+	} {
+		iso := Isotonic{Decreasing: test.decreasing}
+		if !iso.Fit(test.x, test.y, test.weights) {
+			t.Errorf("unexpected fit failure for x=%v y=%v", test.x, test.y)
+			continue
+		}
+		got := iso.Fitted(nil)
+		if !floats.EqualApprox(got, test.want, 1e-14) {
+			t.Errorf("unexpected fitted values for x=%v y=%v: got:%v want:%v", test.x, test.y, got, test.want)
+		}
+	}
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{2, 10, 50, 200} {
+		for _, weighted := range []bool{false, true} {
+			x := make([]float64, n)
+			y := make([]float64, n)
+			var weights []float64
+			if weighted {
+				weights = make([]float64, n)
+			}
+			for i := range x {
+				x[i] = float64(rnd.IntN(n))
+				y[i] = x[i]/float64(n) + rnd.NormFloat64()
+				if weighted {
+					weights[i] = 0.1 + rnd.Float64()
+				}
+			}
+
+			var iso Isotonic
+			if !iso.Fit(x, y, weights) {
+				t.Fatalf("unexpected fit failure for n=%d", n)
+			}
+			got := iso.Fitted(nil)
+			want := isotonicNaive(x, y, weights)
+			if !floats.EqualApprox(got, want, 1e-12) {
+				t.Errorf("unexpected fitted values for n=%d weighted=%t:\ngot: %v\nwant:%v", n, weighted, got, want)
+			}
+
+			iso.Decreasing = true
+			if !iso.Fit(x, y, weights) {
+				t.Fatalf("unexpected fit failure for n=%d", n)
+			}
+			got = iso.Fitted(nil)
+			neg := make([]float64, n)
+			floats.ScaleTo(neg, -1, y)
+			want = isotonicNaive(x, neg, weights)
+			floats.Scale(-1, want)
+			if !floats.EqualApprox(got, want, 1e-12) {
+				t.Errorf("unexpected decreasing fitted values for n=%d weighted=%t:\ngot: %v\nwant:%v", n, weighted, got, want)
+			}
+		}
+	}
+}
+
+func TestIsotonicPredict(t *testing.T) {
+	t.Parallel()
+	var iso Isotonic
+	x := []float64{3, 1, 2, 4, 4}
+	y := []float64{2, 0, 3, 5, 7}
+	if !iso.Fit(x, y, nil) {
+		t.Fatal("unexpected fit failure")
+	}
+	knots, values := iso.Knots(nil, nil)
+	wantKnots := []float64{1, 2, 3, 4}
+	wantValues := []float64{0, 2.5, 2.5, 6}
+	if !floats.Equal(knots, wantKnots) || !floats.EqualApprox(values, wantValues, 1e-14) {
+		t.Errorf("unexpected knots: got:%v %v want:%v %v", knots, values, wantKnots, wantValues)
+	}
+	for _, test := range []struct {
+		x, want float64
+	}{
+		{x: -1, want: 0},
+		{x: 1, want: 0},
+		{x: 1.5, want: 1.25},
+		{x: 2, want: 2.5},
+		{x: 2.7, want: 2.5},
+		{x: 3.25, want: 3.375},
+		{x: 4, want: 6},
+		{x: 10, want: 6},
+	} {
+		got := iso.Predict(test.x)
+		if !scalar.EqualWithinAbsOrRel(got, test.want, 1e-14, 1e-14) {
+			t.Errorf("unexpected prediction at %v: got:%v want:%v", test.x, got, test.want)
+		}
+	}
+
+	var empty Isotonic
+	if empty.Fit(nil, nil, nil) {
+		t.Error("expected failure for no observations")
+	}
+	if !panics(func() { empty.Predict(1) }) {
+		t.Error("expected panic for prediction after failure")
+	}
+	if !panics(func() { iso.Fit(x, y[:4], nil) }) {
+		t.Error("expected panic for length mismatch")
+	}
+	if !panics(func() { iso.Fit(x, y, []float64{1, 1, 0, 1, 1}) }) {
+		t.Error("expected panic for non-positive weight")
+	}
+	if !panics(func() { iso.Fitted(make([]float64, 2)) }) {
+		t.Error("expected panic for dst length mismatch")
+	}
+}
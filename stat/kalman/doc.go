@@ -0,0 +1,16 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package kalman provides Kalman filtering, Rauch–Tung–Striebel smoothing
+// and maximum likelihood parameter estimation for linear-Gaussian
+// state-space models.
+//
+// The filter propagates the Cholesky factor of the state covariance
+// rather than the covariance itself, which guarantees that the covariance
+// remains symmetric and positive definite in finite precision arithmetic.
+//
+// See Durbin, J. and Koopman, S. J. "Time Series Analysis by State Space
+// Methods", Oxford University Press (2012). ISBN 978-0-19-964117-8 for
+// details of state-space models.
+package kalman // import "gonum.org/v1/gonum/stat/kalman"
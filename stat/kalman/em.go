@@ -0,0 +1,153 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kalman
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Fit estimates the parameters of the receiver from the observations in the
+// rows of y by maximum likelihood using the expectation-maximization
+// algorithm described in
+//
+//	Shumway, R. H. and Stoffer, D. S. "An approach to time series smoothing
+//	and forecasting using the EM algorithm", Journal of Time Series Analysis
+//	3:253-264 (1982). doi:10.1111/j.1467-9892.1982.tb00349.x
+//
+// The current parameters of the receiver are used as the starting point.
+// Fit updates the transition and observation matrices, the noise covariance
+// matrices and the initial mean. The initial covariance is not altered since
+// it cannot be estimated from a single sequence of observations.
+//
+// Fit stops when the increase in the log-likelihood of an iteration is less
+// than tol times its magnitude, or after maxIter iterations, and returns the
+// log-likelihood of the observations under the updated parameters.
+//
+// Fit returns an error if any of the covariance matrices is not positive
+// definite, in which case the receiver holds the parameters of the last
+// successful iteration. Fit panics if y has fewer than two rows, if any
+// element of y is NaN, or if the number of columns of y is not equal to the
+// dimension of the observations of the model.
+func (m *Model) Fit(y mat.Matrix, maxIter int, tol float64) (logLike float64, err error) {
+	n, k := m.dims()
+	r, c := y.Dims()
+	if c != k {
+		panic("kalman: observation length mismatch")
+	}
+	if r < 2 {
+		panic("kalman: too few observations")
+	}
+	for i := 0; i < r; i++ {
+		for j := 0; j < k; j++ {
+			if math.IsNaN(y.At(i, j)) {
+				panic("kalman: missing observation")
+			}
+		}
+	}
+
+	llOld := math.Inf(-1)
+	for iter := 0; ; iter++ {
+		s, err := m.smooth(y)
+		if err != nil {
+			return 0, err
+		}
+		if iter == maxIter || s.logLike-llOld <= tol*math.Abs(s.logLike) {
+			return s.logLike, nil
+		}
+		llOld = s.logLike
+
+		// Compute the expected sufficient statistics
+		//  s00 = Σ_{t<T-1} E[x_t x_tᵀ]
+		//  s11 = Σ_{t>0} E[x_t x_tᵀ]
+		//  s10 = Σ_{t>0} E[x_t x_{t-1}ᵀ]
+		//  sxx = Σ_t E[x_t x_tᵀ]
+		//  syx = Σ_t y_t E[x_t]ᵀ
+		//  syy = Σ_t y_t y_tᵀ
+		s00 := mat.NewSymDense(n, nil)
+		s11 := mat.NewSymDense(n, nil)
+		s10 := mat.NewDense(n, n, nil)
+		sxx := mat.NewSymDense(n, nil)
+		syx := mat.NewDense(k, n, nil)
+		syy := mat.NewSymDense(k, nil)
+		var outer mat.Dense
+		yt := make([]float64, k)
+		for t, mean := range s.mean {
+			x := mat.NewVecDense(n, mean)
+			second := mat.NewSymDense(n, nil)
+			second.CopySym(s.cov[t])
+			second.SymRankOne(second, 1, x)
+			sxx.AddSym(sxx, second)
+			if t < r-1 {
+				s00.AddSym(s00, second)
+			}
+			if t > 0 {
+				s11.AddSym(s11, second)
+				outer.Outer(1, x, mat.NewVecDense(n, s.mean[t-1]))
+				s10.Add(s10, &outer)
+				s10.Add(s10, s.lag[t-1])
+			}
+			yv := mat.NewVecDense(k, mat.Row(yt, t, y))
+			outer.Outer(1, yv, x)
+			syx.Add(syx, &outer)
+			syy.SymRankOne(syy, 1, yv)
+		}
+
+		// The transition matrix is F = s10 s00^-1 and the transition
+		// noise is Q = (s11 - F s10ᵀ) / (T-1).
+		f, err := solveRight(s10, s00)
+		if err != nil {
+			return 0, err
+		}
+		q := residualCov(s11, f, s10, float64(r-1))
+
+		// The observation matrix is H = syx sxx^-1 and the observation
+		// noise is R = (syy - H syxᵀ) / T.
+		h, err := solveRight(syx, sxx)
+		if err != nil {
+			return 0, err
+		}
+		rCov := residualCov(syy, h, syx, float64(r))
+
+		var chol mat.Cholesky
+		if !chol.Factorize(q) || !chol.Factorize(rCov) {
+			return 0, errNotPositiveDefinite
+		}
+		m.Transition = f
+		m.TransitionNoise = q
+		m.Observation = h
+		m.ObservationNoise = rCov
+		m.InitialMean = s.mean[0]
+	}
+}
+
+// solveRight returns b a^-1 for the symmetric positive definite matrix a.
+func solveRight(b mat.Matrix, a mat.Symmetric) (*mat.Dense, error) {
+	var chol mat.Cholesky
+	if !chol.Factorize(a) {
+		return nil, errNotPositiveDefinite
+	}
+	var xt mat.Dense
+	err := chol.SolveTo(&xt, b.T())
+	if err != nil {
+		return nil, err
+	}
+	return mat.DenseCopyOf(xt.T()), nil
+}
+
+// residualCov returns the symmetric part of (s - b cᵀ) / n.
+func residualCov(s mat.Symmetric, b, c mat.Matrix, n float64) *mat.SymDense {
+	var bc mat.Dense
+	bc.Mul(b, c.T())
+	dim := s.SymmetricDim()
+	dst := mat.NewSymDense(dim, nil)
+	for i := 0; i < dim; i++ {
+		for j := i; j < dim; j++ {
+			dst.SetSym(i, j, (s.At(i, j)-0.5*(bc.At(i, j)+bc.At(j, i)))/n)
+		}
+	}
+	return dst
+}
@@ -0,0 +1,270 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kalman
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/distmv"
+)
+
+const logTwoPi = 1.8378770664093454835606594728112352797227949472755668
+
+var errNotPositiveDefinite = errors.New("kalman: covariance matrix not positive definite")
+
+// Model is a linear-Gaussian state-space model. The n-dimensional state
+// x_t and the k-dimensional observation y_t evolve according to
+//
+//	x_{t+1} = F x_t + w_t,  w_t ~ N(0, Q)
+//	y_t     = H x_t + v_t,  v_t ~ N(0, R)
+//
+// with the first state distributed as x_0 ~ N(μ_0, P_0). The first
+// observation y_0 is made of the first state, before any transition.
+type Model struct {
+	// Transition is the n×n state
+	// transition matrix F.
+	Transition mat.Matrix
+	// TransitionNoise is the n×n covariance
+	// matrix Q of the state noise.
+	TransitionNoise mat.Symmetric
+
+	// Observation is the k×n observation
+	// matrix H.
+	Observation mat.Matrix
+	// ObservationNoise is the k×k covariance
+	// matrix R of the observation noise.
+	ObservationNoise mat.Symmetric
+
+	// InitialMean is the mean μ_0
+	// of the first state.
+	InitialMean []float64
+	// InitialCov is the n×n covariance
+	// matrix P_0 of the first state.
+	InitialCov mat.Symmetric
+}
+
+// dims returns the dimensions of the state and observations of the
+// model, and panics if the dimensions of the parameters are inconsistent.
+func (m *Model) dims() (n, k int) {
+	n = len(m.InitialMean)
+	if n == 0 {
+		panic("kalman: zero dimensional state")
+	}
+	if r, c := m.Transition.Dims(); r != n || c != n {
+		panic("kalman: transition matrix dimension mismatch")
+	}
+	if m.TransitionNoise.SymmetricDim() != n {
+		panic("kalman: transition noise dimension mismatch")
+	}
+	if m.InitialCov.SymmetricDim() != n {
+		panic("kalman: initial covariance dimension mismatch")
+	}
+	k, c := m.Observation.Dims()
+	if c != n {
+		panic("kalman: observation matrix dimension mismatch")
+	}
+	if m.ObservationNoise.SymmetricDim() != k {
+		panic("kalman: observation noise dimension mismatch")
+	}
+	return n, k
+}
+
+// Filter is a Kalman filter in square-root form. It holds the distribution
+// of the current state given the observations so far, represented by its
+// mean and the Cholesky factorization of its covariance.
+//
+// A Filter is advanced through time by alternating calls to Update, which
+// incorporates an observation of the current state, and Predict, which
+// moves the state forward by one time step.
+type Filter struct {
+	model *Model
+	n, k  int
+
+	// uQ is the upper triangular Cholesky
+	// factor of the transition noise.
+	uQ mat.TriDense
+	// cholR is the Cholesky factorization
+	// of the observation noise.
+	cholR mat.Cholesky
+
+	mean []float64
+	chol mat.Cholesky
+
+	logLike float64
+}
+
+// NewFilter returns a new Filter for the model with the state distribution
+// set to the distribution of the first state. The model must not be
+// modified while the Filter is in use.
+//
+// NewFilter returns an error if any of the covariance matrices of the
+// model is not positive definite. NewFilter panics if the dimensions of
+// the model parameters are inconsistent.
+func NewFilter(m *Model) (*Filter, error) {
+	n, k := m.dims()
+	f := &Filter{
+		model: m,
+		n:     n,
+		k:     k,
+		mean:  make([]float64, n),
+	}
+	var cholQ mat.Cholesky
+	if !cholQ.Factorize(m.TransitionNoise) {
+		return nil, errNotPositiveDefinite
+	}
+	cholQ.UTo(&f.uQ)
+	if !f.cholR.Factorize(m.ObservationNoise) {
+		return nil, errNotPositiveDefinite
+	}
+	if !f.chol.Factorize(m.InitialCov) {
+		return nil, errNotPositiveDefinite
+	}
+	copy(f.mean, m.InitialMean)
+	return f, nil
+}
+
+// LogLikelihood returns the log-likelihood of all the observations passed
+// to Update, that is the log of their joint probability density under the
+// model.
+func (f *Filter) LogLikelihood() float64 {
+	return f.logLike
+}
+
+// Predict advances the state distribution by one time step, replacing it
+// with the distribution of the next state given the same observations.
+func (f *Filter) Predict() {
+	// The predicted covariance F P Fᵀ + Q is AᵀA where
+	//  A = [U Fᵀ]
+	//      [U_Q ]
+	// and P = UᵀU and Q = U_QᵀU_Q, so its Cholesky factor
+	// is the triangular factor of the QR decomposition of A.
+	n := f.n
+	a := mat.NewDense(2*n, n, nil)
+	a.Slice(0, n, 0, n).(*mat.Dense).Mul(f.chol.RawU(), f.model.Transition.T())
+	a.Slice(n, 2*n, 0, n).(*mat.Dense).Copy(&f.uQ)
+	setFromU(&f.chol, upperFactor(a, n))
+
+	var mean mat.VecDense
+	mean.MulVec(f.model.Transition, mat.NewVecDense(n, f.mean))
+	copy(f.mean, mean.RawVector().Data)
+}
+
+// Update incorporates the observation y of the current state into the state
+// distribution, and adds the log probability density of y given the previous
+// observations to the log-likelihood. Elements of y that are NaN are treated
+// as missing and are ignored.
+//
+// Update panics if the length of y is not equal to the dimension of the
+// observations of the model.
+func (f *Filter) Update(y []float64) {
+	if len(y) != f.k {
+		panic("kalman: observation length mismatch")
+	}
+	var obs []int
+	for i, v := range y {
+		if !math.IsNaN(v) {
+			obs = append(obs, i)
+		}
+	}
+	p := len(obs)
+	if p == 0 {
+		return
+	}
+
+	n := f.n
+	h := f.model.Observation
+	uR := f.cholR.RawU()
+	if p < f.k {
+		// Use the model of the observed elements of y.
+		hObs := mat.NewDense(p, n, nil)
+		for i, o := range obs {
+			for j := 0; j < n; j++ {
+				hObs.Set(i, j, h.At(o, j))
+			}
+		}
+		h = hObs
+		var rObs mat.SymDense
+		rObs.SubsetSym(f.model.ObservationNoise, obs)
+		var cholR mat.Cholesky
+		cholR.Factorize(&rObs)
+		uR = cholR.RawU()
+	}
+
+	// The QR decomposition of the pre-array
+	//  A = [U_R    0]
+	//      [U Hᵀ   U]
+	// with P = UᵀU and R = U_RᵀU_R has the triangular factor
+	//  T = [U_S  B ]
+	//      [0    U⁺]
+	// where S = H P Hᵀ + R = U_SᵀU_S is the covariance of the
+	// innovation, B = U_S^-ᵀ H P and U⁺ is the Cholesky factor
+	// of the updated covariance.
+	a := mat.NewDense(p+n, p+n, nil)
+	a.Slice(0, p, 0, p).(*mat.Dense).Copy(uR)
+	u := f.chol.RawU()
+	a.Slice(p, p+n, 0, p).(*mat.Dense).Mul(u, h.T())
+	a.Slice(p, p+n, p, p+n).(*mat.Dense).Copy(u)
+	t := upperFactor(a, p+n)
+
+	// Compute the innovation e = y - H x and z = U_S^-ᵀ e, so that
+	// the updated mean is x + Bᵀ z.
+	e := make([]float64, p)
+	for i, o := range obs {
+		e[i] = y[o] - floats.Dot(mat.Row(nil, i, h), f.mean)
+	}
+	var logDet float64
+	for i := range e {
+		// Solve U_Sᵀ z = e by forward substitution.
+		for j := 0; j < i; j++ {
+			e[i] -= t.At(j, i) * e[j]
+		}
+		e[i] /= t.At(i, i)
+		logDet += 2 * math.Log(t.At(i, i))
+	}
+	f.logLike -= 0.5 * (float64(p)*logTwoPi + logDet + floats.Dot(e, e))
+
+	var delta mat.VecDense
+	delta.MulVec(t.Slice(0, p, p, p+n).T(), mat.NewVecDense(p, e))
+	floats.Add(f.mean, delta.RawVector().Data)
+	setFromU(&f.chol, t.Slice(p, p+n, p, p+n).(*mat.Dense))
+}
+
+// State returns the current distribution of the state. The input src is
+// passed to the constructed Normal.
+func (f *Filter) State(src rand.Source) *distmv.Normal {
+	return distmv.NewNormalChol(f.mean, &f.chol, src)
+}
+
+// upperFactor returns the leading n×n block of the triangular factor R of
+// the QR decomposition of a, with the signs of the rows chosen so that the
+// diagonal of R is non-negative. The product RᵀR is equal to aᵀa.
+func upperFactor(a *mat.Dense, n int) *mat.Dense {
+	var qr mat.QR
+	qr.Factorize(a)
+	var r mat.Dense
+	qr.RTo(&r)
+	t := mat.DenseCopyOf(r.Slice(0, n, 0, n))
+	for i := 0; i < n; i++ {
+		if t.At(i, i) < 0 {
+			row := t.RawRowView(i)
+			floats.Scale(-1, row)
+		}
+	}
+	return t
+}
+
+// setFromU sets the Cholesky factorization chol to have the upper
+// triangular factor in the upper triangle of u.
+func setFromU(chol *mat.Cholesky, u *mat.Dense) {
+	n, _ := u.Dims()
+	t := mat.NewTriDense(n, mat.Upper, nil)
+	t.Copy(u)
+	chol.Reset()
+	chol.SetFromU(t)
+}
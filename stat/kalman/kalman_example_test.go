@@ -0,0 +1,62 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kalman_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/kalman"
+)
+
+func ExampleFilter() {
+	// Track an object moving with nearly constant velocity from noisy
+	// measurements of its position. The state is the position and the
+	// velocity of the object.
+	const dt = 1.0
+	model := &kalman.Model{
+		Transition: mat.NewDense(2, 2, []float64{
+			1, dt,
+			0, 1,
+		}),
+		TransitionNoise: mat.NewSymDense(2, []float64{
+			dt * dt * dt / 3, dt * dt / 2,
+			dt * dt / 2, dt,
+		}),
+		Observation:      mat.NewDense(1, 2, []float64{1, 0}),
+		ObservationNoise: mat.NewSymDense(1, []float64{4}),
+		InitialMean:      []float64{0, 0},
+		InitialCov:       mat.NewSymDense(2, []float64{100, 0, 0, 100}),
+	}
+	f, err := kalman.NewFilter(model)
+	if err != nil {
+		log.Fatal(err)
+	}
+	positions := []float64{1.1, 3.4, 4.6, 7.9, 10.2, 11.8, 14.1, 16.3}
+	for i, y := range positions {
+		if i != 0 {
+			f.Predict()
+		}
+		f.Update([]float64{y})
+	}
+	state := f.State(nil)
+	var cov mat.SymDense
+	state.CovarianceMatrix(&cov)
+	mean := state.Mean(nil)
+	fmt.Printf("position = %.2f ± %.2f\n", mean[0], math.Sqrt(cov.At(0, 0)))
+	fmt.Printf("velocity = %.2f ± %.2f\n", mean[1], math.Sqrt(cov.At(1, 1)))
+
+	// Predict the position two steps ahead.
+	f.Predict()
+	f.Predict()
+	fmt.Printf("predicted position = %.2f\n", f.State(nil).Mean(nil)[0])
+
+	// Output:
+	// position = 16.30 ± 1.59
+	// velocity = 2.16 ± 1.26
+	// predicted position = 20.61
+}
@@ -0,0 +1,266 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kalman
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/distmv"
+)
+
+func testModel() *Model {
+	return &Model{
+		Transition:       mat.NewDense(2, 2, []float64{1, 0.5, -0.2, 0.9}),
+		TransitionNoise:  mat.NewSymDense(2, []float64{0.3, 0.1, 0.1, 0.2}),
+		Observation:      mat.NewDense(2, 2, []float64{1, 0, 0.5, 1}),
+		ObservationNoise: mat.NewSymDense(2, []float64{0.5, 0.2, 0.2, 0.4}),
+		InitialMean:      []float64{1, -1},
+		InitialCov:       mat.NewSymDense(2, []float64{2, 0.3, 0.3, 1}),
+	}
+}
+
+// jointNormal returns the joint distribution of the states and the
+// observations of the model over T time steps. The states are ordered
+// first, followed by the observations.
+func jointNormal(m *Model, T int) *distmv.Normal {
+	n, k := m.dims()
+	lower := func(s mat.Symmetric) *mat.TriDense {
+		var chol mat.Cholesky
+		chol.Factorize(s)
+		var l mat.TriDense
+		chol.LTo(&l)
+		return &l
+	}
+	l0 := lower(m.InitialCov)
+	lq := lower(m.TransitionNoise)
+	lr := lower(m.ObservationNoise)
+
+	// Express the variables as affine functions of independent standard
+	// normal variables, one block of n for each state followed by one
+	// block of k for each observation, so the covariance is A Aᵀ.
+	dim := T * (n + k)
+	mu := make([]float64, dim)
+	a := mat.NewDense(dim, dim, nil)
+	mean := mat.NewVecDense(n, append([]float64(nil), m.InitialMean...))
+	for t := 0; t < T; t++ {
+		xt := a.Slice(t*n, (t+1)*n, 0, dim).(*mat.Dense)
+		if t == 0 {
+			xt.Slice(0, n, 0, n).(*mat.Dense).Copy(l0)
+		} else {
+			mean.MulVec(m.Transition, mean)
+			xt.Mul(m.Transition, a.Slice((t-1)*n, t*n, 0, dim))
+			xt.Slice(0, n, t*n, (t+1)*n).(*mat.Dense).Copy(lq)
+		}
+		copy(mu[t*n:], mean.RawVector().Data)
+
+		yt := a.Slice(T*n+t*k, T*n+(t+1)*k, 0, dim).(*mat.Dense)
+		yt.Mul(m.Observation, xt)
+		yt.Slice(0, k, T*n+t*k, T*n+(t+1)*k).(*mat.Dense).Copy(lr)
+		var hm mat.VecDense
+		hm.MulVec(m.Observation, mean)
+		copy(mu[T*n+t*k:], hm.RawVector().Data)
+	}
+	sigma := mat.NewSymDense(dim, nil)
+	sigma.SymOuterK(1, a)
+	joint, ok := distmv.NewNormal(mu, sigma, nil)
+	if !ok {
+		panic("bad test: joint covariance not positive definite")
+	}
+	return joint
+}
+
+// reference returns the distribution of state i given the observations of
+// the first upTo time steps, and the log-likelihood of those observations,
+// computed from the joint distribution.
+func reference(joint *distmv.Normal, y *mat.Dense, n, i, upTo int) (mean []float64, cov *mat.SymDense, logLike float64) {
+	T, k := y.Dims()
+	var observed []int
+	var values []float64
+	for t := 0; t < upTo; t++ {
+		for j := 0; j < k; j++ {
+			if v := y.At(t, j); !math.IsNaN(v) {
+				observed = append(observed, T*n+t*k+j)
+				values = append(values, v)
+			}
+		}
+	}
+	var state []int
+	for j := 0; j < n; j++ {
+		state = append(state, i*n+j)
+	}
+	if len(observed) == 0 {
+		marg, _ := joint.MarginalNormal(state, nil)
+		cov = &mat.SymDense{}
+		marg.CovarianceMatrix(cov)
+		return marg.Mean(nil), cov, 0
+	}
+	margObs, _ := joint.MarginalNormal(observed, nil)
+	logLike = margObs.LogProb(values)
+
+	cond, ok := joint.ConditionNormal(observed, values, nil)
+	if !ok {
+		panic("bad test: conditioning failed")
+	}
+	// The conditioned distribution holds the states followed by the
+	// unobserved observations, so state i is at the same position.
+	marg, _ := cond.MarginalNormal(state, nil)
+	cov = &mat.SymDense{}
+	marg.CovarianceMatrix(cov)
+	return marg.Mean(nil), cov, logLike
+}
+
+func TestFilterSmooth(t *testing.T) {
+	const T = 6
+	m := testModel()
+	n, _ := m.dims()
+	nan := math.NaN()
+	y := mat.NewDense(T, 2, []float64{
+		1.2, 0.3,
+		0.8, nan,
+		nan, nan,
+		-0.4, 1.1,
+		nan, 2,
+		0.1, -0.5,
+	})
+	joint := jointNormal(m, T)
+
+	filtered, logLike, err := m.Filter(y)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	smoothed, smoothLogLike, err := m.Smooth(y)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	const tol = 1e-10
+	for i := 0; i < T; i++ {
+		for _, test := range []struct {
+			name string
+			dist *distmv.Normal
+			upTo int
+		}{
+			{name: "filtered", dist: filtered[i], upTo: i + 1},
+			{name: "smoothed", dist: smoothed[i], upTo: T},
+		} {
+			wantMean, wantCov, _ := reference(joint, y, n, i, test.upTo)
+			if !floats.EqualApprox(test.dist.Mean(nil), wantMean, tol) {
+				t.Errorf("%s state %d: mean mismatch: got %v, want %v", test.name, i, test.dist.Mean(nil), wantMean)
+			}
+			var cov mat.SymDense
+			test.dist.CovarianceMatrix(&cov)
+			if !mat.EqualApprox(&cov, wantCov, tol) {
+				t.Errorf("%s state %d: covariance mismatch: got %v, want %v", test.name, i, mat.Formatted(&cov), mat.Formatted(wantCov))
+			}
+		}
+	}
+	_, _, wantLogLike := reference(joint, y, n, 0, T)
+	if !scalar.EqualWithinAbsOrRel(logLike, wantLogLike, tol, tol) {
+		t.Errorf("filter log-likelihood mismatch: got %v, want %v", logLike, wantLogLike)
+	}
+	if !scalar.EqualWithinAbsOrRel(smoothLogLike, wantLogLike, tol, tol) {
+		t.Errorf("smoother log-likelihood mismatch: got %v, want %v", smoothLogLike, wantLogLike)
+	}
+
+	// Check the cross-covariances of consecutive states used by Fit.
+	s, err := m.smooth(y)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var observed []int
+	var values []float64
+	for i := 0; i < T; i++ {
+		for j := 0; j < 2; j++ {
+			if v := y.At(i, j); !math.IsNaN(v) {
+				observed = append(observed, T*n+i*2+j)
+				values = append(values, v)
+			}
+		}
+	}
+	cond, _ := joint.ConditionNormal(observed, values, nil)
+	var condCov mat.SymDense
+	cond.CovarianceMatrix(&condCov)
+	for i := 0; i < T-1; i++ {
+		got := s.lag[i]
+		for a := 0; a < n; a++ {
+			for b := 0; b < n; b++ {
+				w := condCov.At((i+1)*n+a, i*n+b)
+				if !scalar.EqualWithinAbsOrRel(got.At(a, b), w, tol, tol) {
+					t.Errorf("lag covariance %d mismatch at (%d,%d): got %v, want %v", i, a, b, got.At(a, b), w)
+				}
+			}
+		}
+	}
+}
+
+func TestFit(t *testing.T) {
+	// Simulate an AR(1) process observed with noise.
+	truth := &Model{
+		Transition:       mat.NewDense(1, 1, []float64{0.8}),
+		TransitionNoise:  mat.NewSymDense(1, []float64{1}),
+		Observation:      mat.NewDense(1, 1, []float64{1}),
+		ObservationNoise: mat.NewSymDense(1, []float64{0.5}),
+		InitialMean:      []float64{0},
+		InitialCov:       mat.NewSymDense(1, []float64{1}),
+	}
+	const T = 1000
+	rnd := rand.New(rand.NewPCG(1, 1))
+	y := mat.NewDense(T, 1, nil)
+	x := rnd.NormFloat64()
+	for i := 0; i < T; i++ {
+		if i > 0 {
+			x = 0.8*x + rnd.NormFloat64()
+		}
+		y.Set(i, 0, x+math.Sqrt(0.5)*rnd.NormFloat64())
+	}
+	_, trueLogLike, err := truth.Filter(y)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	m := &Model{
+		Transition:       mat.NewDense(1, 1, []float64{0.3}),
+		TransitionNoise:  mat.NewSymDense(1, []float64{0.5}),
+		Observation:      mat.NewDense(1, 1, []float64{1}),
+		ObservationNoise: mat.NewSymDense(1, []float64{2}),
+		InitialMean:      []float64{0},
+		InitialCov:       mat.NewSymDense(1, []float64{1}),
+	}
+	// The log-likelihood must not decrease with each iteration.
+	last := math.Inf(-1)
+	for i := 0; i < 10; i++ {
+		ll, err := m.Fit(y, 1, 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if ll < last-1e-8 {
+			t.Errorf("log-likelihood decreased at iteration %d: %v < %v", i, ll, last)
+		}
+		last = ll
+	}
+	ll, err := m.Fit(y, 200, 1e-8)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ll < trueLogLike {
+		t.Errorf("fitted log-likelihood less than that of the true parameters: %v < %v", ll, trueLogLike)
+	}
+	if got := m.Transition.At(0, 0); math.Abs(got-0.8) > 0.1 {
+		t.Errorf("transition mismatch: got %v, want 0.8", got)
+	}
+	// Only the ratio of the noise of the state to the square
+	// of the observation matrix is identifiable.
+	h := m.Observation.At(0, 0)
+	if got := h * h * m.TransitionNoise.At(0, 0); math.Abs(got-1) > 0.15 {
+		t.Errorf("scaled transition noise mismatch: got %v, want 1", got)
+	}
+	if got := m.ObservationNoise.At(0, 0); math.Abs(got-0.5) > 0.15 {
+		t.Errorf("observation noise mismatch: got %v, want 0.5", got)
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kalman
+
+import (
+	"errors"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/distmv"
+)
+
+var errSmoothedCov = errors.New("kalman: smoothed covariance not positive definite")
+
+// Filter returns the filtered distributions of the states given the
+// observations in the rows of y. The i-th returned distribution is the
+// distribution of the i-th state given the first i+1 observations. Elements
+// of y that are NaN are treated as missing. Filter also returns the
+// log-likelihood of the observations.
+//
+// Filter returns an error if any of the covariance matrices of the model is
+// not positive definite. Filter panics if the number of columns of y is not
+// equal to the dimension of the observations of the model.
+func (m *Model) Filter(y mat.Matrix) (states []*distmv.Normal, logLike float64, err error) {
+	f, err := NewFilter(m)
+	if err != nil {
+		return nil, 0, err
+	}
+	r, _ := y.Dims()
+	states = make([]*distmv.Normal, r)
+	obs := make([]float64, f.k)
+	for i := 0; i < r; i++ {
+		if i != 0 {
+			f.Predict()
+		}
+		f.Update(mat.Row(obs, i, y))
+		states[i] = f.State(nil)
+	}
+	return states, f.LogLikelihood(), nil
+}
+
+// Smooth returns the smoothed distributions of the states given all of the
+// observations in the rows of y, computed by the Rauch–Tung–Striebel
+// smoother. The i-th returned distribution is the distribution of the i-th
+// state. Elements of y that are NaN are treated as missing. Smooth also
+// returns the log-likelihood of the observations.
+//
+// Smooth returns an error if any of the covariance matrices of the model is
+// not positive definite, or if a smoothed covariance matrix is not positive
+// definite due to loss of precision. Smooth panics if the number of columns
+// of y is not equal to the dimension of the observations of the model.
+func (m *Model) Smooth(y mat.Matrix) (states []*distmv.Normal, logLike float64, err error) {
+	s, err := m.smooth(y)
+	if err != nil {
+		return nil, 0, err
+	}
+	states = make([]*distmv.Normal, len(s.mean))
+	for i, mean := range s.mean {
+		var ok bool
+		states[i], ok = distmv.NewNormal(mean, s.cov[i], nil)
+		if !ok {
+			return nil, 0, errSmoothedCov
+		}
+	}
+	return states, s.logLike, nil
+}
+
+// smoothed holds the moments of the smoothed distributions of the states.
+type smoothed struct {
+	mean [][]float64
+	cov  []*mat.SymDense
+	// lag holds the cross-covariances of consecutive states,
+	// Cov(x_{t+1}, x_t), given all of the observations.
+	lag     []*mat.Dense
+	logLike float64
+}
+
+// smooth runs the filter forward over the observations in the rows of y and
+// then the Rauch–Tung–Striebel smoother backward.
+func (m *Model) smooth(y mat.Matrix) (*smoothed, error) {
+	f, err := NewFilter(m)
+	if err != nil {
+		return nil, err
+	}
+	n := f.n
+	r, _ := y.Dims()
+	s := &smoothed{
+		mean: make([][]float64, r),
+		cov:  make([]*mat.SymDense, r),
+		lag:  make([]*mat.Dense, max(r-1, 0)),
+	}
+	// predMean and predChol hold the predicted
+	// distribution of each state but the first.
+	predMean := make([][]float64, r)
+	predChol := make([]mat.Cholesky, r)
+	obs := make([]float64, f.k)
+	for i := 0; i < r; i++ {
+		if i != 0 {
+			f.Predict()
+			predMean[i] = append([]float64(nil), f.mean...)
+			predChol[i].Clone(&f.chol)
+		}
+		f.Update(mat.Row(obs, i, y))
+		s.mean[i] = append([]float64(nil), f.mean...)
+		s.cov[i] = &mat.SymDense{}
+		f.chol.ToSym(s.cov[i])
+	}
+	s.logLike = f.LogLikelihood()
+
+	var fp, gt, diff, tmp mat.Dense
+	shift := make([]float64, n)
+	for i := r - 2; i >= 0; i-- {
+		// Compute the smoother gain G = P_i F ᵀ P_{i+1|i}^-1
+		// from Gᵀ = P_{i+1|i}^-1 F P_i.
+		filtCov := s.cov[i]
+		fp.Mul(m.Transition, filtCov)
+		err := predChol[i+1].SolveTo(&gt, &fp)
+		if err != nil {
+			return nil, err
+		}
+
+		// Update the mean with G (x_{i+1} - x_{i+1|i}).
+		floats.SubTo(shift, s.mean[i+1], predMean[i+1])
+		var delta mat.VecDense
+		delta.MulVec(gt.T(), mat.NewVecDense(n, shift))
+		floats.Add(s.mean[i], delta.RawVector().Data)
+
+		// Update the covariance with G (P_{i+1} - P_{i+1|i}) Gᵀ.
+		var predCov mat.SymDense
+		predChol[i+1].ToSym(&predCov)
+		diff.Sub(s.cov[i+1], &predCov)
+		tmp.Mul(&diff, &gt)
+		s.lag[i] = mat.NewDense(n, n, nil)
+		s.lag[i].Mul(s.cov[i+1], &gt)
+		cov := mat.NewSymDense(n, nil)
+		for j := 0; j < n; j++ {
+			for k := j; k < n; k++ {
+				v := filtCov.At(j, k) + 0.5*(mat.Dot(gt.ColView(j), tmp.ColView(k))+mat.Dot(gt.ColView(k), tmp.ColView(j)))
+				cov.SetSym(j, k, v)
+			}
+		}
+		s.cov[i] = cov
+	}
+	return s, nil
+}
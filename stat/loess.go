@@ -0,0 +1,443 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math"
+	"slices"
+	"sort"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// LOESS is a locally weighted regression smoother of a response y on a
+// single predictor x, also known as LOWESS. The fitted value at a point x₀
+// is the value at x₀ of a polynomial fitted by weighted least squares to
+// the observations in the neighborhood of x₀ formed by the fraction Span of
+// the observations closest to it. The observations are weighted by the
+// tricube function of their distance from x₀ scaled by the largest distance
+// in the neighborhood,
+//
+//	w(u) = (1 - |u|³)³, |u| < 1.
+//
+// If Iterations is positive, the fit is made robust to outliers by
+// iteratively refitting with the weights multiplied by the bisquare function
+// of the residuals of the previous fit scaled by six times their median
+// absolute value.
+//
+// See Cleveland, W. S. (1979) Robust locally weighted regression and
+// smoothing scatterplots. Journal of the American Statistical Association
+// 74(368) and Cleveland, W. S. and Devlin, S. J. (1988) Locally weighted
+// regression: an approach to regression analysis by local fitting. Journal
+// of the American Statistical Association 83(403) for details.
+type LOESS struct {
+	// Span is the fraction of the observations
+	// in each neighborhood. Larger values give
+	// smoother fits. If Span is greater than one,
+	// all the observations are used and the
+	// largest distance is scaled by Span. If Span
+	// is zero, a span of 0.75 is used.
+	Span float64
+
+	// Degree is the degree of the local
+	// polynomials, zero, one or two. Local
+	// linear fitting is the original LOWESS.
+	Degree int
+
+	// Iterations is the number of robustness
+	// iterations. Cleveland recommends two.
+	Iterations int
+
+	// x and y hold the observations sorted by x,
+	// and fitted the fitted values at x. order
+	// holds the original indices of the sorted
+	// observations.
+	x, y, fitted []float64
+	order        []int
+
+	// robust holds the robustness weights.
+	robust []float64
+
+	// sigma is the residual standard error and
+	// nu the equivalent degrees of freedom
+	// of the residuals for the approximate
+	// confidence intervals. They are computed
+	// on demand and are valid if haveVar is true.
+	sigma, nu float64
+	haveVar   bool
+
+	// k is the number of observations in each
+	// neighborhood and scale the factor by which
+	// the largest distance is scaled.
+	k     int
+	scale float64
+
+	// Workspace for the local fits.
+	dist, w []float64
+
+	ok bool
+}
+
+// Fit fits the smoother to the observations x and y, returning whether the
+// fit was successful. The fit fails if the smoother is misconfigured or
+// there are too few distinct values of x for the degree of the local
+// polynomials.
+//
+// Fit will panic if the lengths of x and y are not equal.
+func (l *LOESS) Fit(x, y []float64) (ok bool) {
+	if len(x) != len(y) {
+		panic("stat: slice length mismatch")
+	}
+	l.ok = false
+	l.haveVar = false
+	span := l.Span
+	if span == 0 {
+		span = 0.75
+	}
+	n := len(x)
+	if !(span > 0) || l.Degree < 0 || 2 < l.Degree || l.Iterations < 0 || n == 0 {
+		return false
+	}
+	l.k = min(n, int(math.Floor(span*float64(n)+1e-5)))
+	l.scale = max(span, 1)
+	if l.k < l.Degree+1 {
+		return false
+	}
+
+	l.order = slices.Grow(l.order[:0], n)[:n]
+	for i := range l.order {
+		l.order[i] = i
+	}
+	sort.SliceStable(l.order, func(i, j int) bool { return x[l.order[i]] < x[l.order[j]] })
+	l.x = slices.Grow(l.x[:0], n)[:n]
+	l.y = slices.Grow(l.y[:0], n)[:n]
+	for i, j := range l.order {
+		l.x[i] = x[j]
+		l.y[i] = y[j]
+	}
+	if distinct(l.x) < l.Degree+1 {
+		return false
+	}
+	l.fitted = slices.Grow(l.fitted[:0], n)[:n]
+	l.robust = slices.Grow(l.robust[:0], n)[:n]
+	for i := range l.robust {
+		l.robust[i] = 1
+	}
+
+	var scale float64
+	for _, v := range l.y {
+		scale += math.Abs(v)
+	}
+	scale /= float64(n)
+
+	resid := make([]float64, n)
+	for iter := 0; ; iter++ {
+		for i, v := range l.x {
+			l.fitted[i] = l.local(nil, v)
+		}
+		if iter == l.Iterations {
+			break
+		}
+
+		// Update the robustness weights from the
+		// bisquare function of the residuals,
+		// stopping if the fit is exact to within
+		// rounding error.
+		for i, v := range l.y {
+			resid[i] = math.Abs(v - l.fitted[i])
+		}
+		slices.Sort(resid)
+		s := 3 * (resid[(n-1)/2] + resid[n/2])
+		if s <= 1e-7*scale {
+			break
+		}
+		for i, v := range l.y {
+			u := math.Abs(v-l.fitted[i]) / s
+			if u < 1 {
+				u = 1 - u*u
+				l.robust[i] = u * u
+			} else {
+				l.robust[i] = 0
+			}
+		}
+	}
+	l.ok = true
+	return true
+}
+
+// distinct returns the number of distinct values in the sorted slice x.
+func distinct(x []float64) int {
+	var n int
+	for i, v := range x {
+		if i == 0 || v != x[i-1] {
+			n++
+		}
+	}
+	return n
+}
+
+// Fitted returns the fitted values of the smoother at the observations in
+// the order they were passed to Fit. If dst is not nil, the values are
+// stored in dst, which must have length equal to the number of
+// observations.
+//
+// Fitted will panic if the receiver does not contain a successful fit or
+// dst has the wrong length.
+func (l *LOESS) Fitted(dst []float64) []float64 {
+	if !l.ok {
+		panic("stat: use of unsuccessful LOESS fit")
+	}
+	if dst == nil {
+		dst = make([]float64, len(l.x))
+	} else if len(dst) != len(l.x) {
+		panic("stat: slice length mismatch")
+	}
+	for i, j := range l.order {
+		dst[j] = l.fitted[i]
+	}
+	return dst
+}
+
+// RobustnessWeights returns the robustness weights of the observations from
+// the last robustness iteration in the order they were passed to Fit. The
+// weights are all one if Iterations is zero. If dst is not nil, the weights
+// are stored in dst, which must have length equal to the number of
+// observations.
+//
+// RobustnessWeights will panic if the receiver does not contain a successful
+// fit or dst has the wrong length.
+func (l *LOESS) RobustnessWeights(dst []float64) []float64 {
+	if !l.ok {
+		panic("stat: use of unsuccessful LOESS fit")
+	}
+	if dst == nil {
+		dst = make([]float64, len(l.x))
+	} else if len(dst) != len(l.x) {
+		panic("stat: slice length mismatch")
+	}
+	for i, j := range l.order {
+		dst[j] = l.robust[i]
+	}
+	return dst
+}
+
+// Predict returns the value of the smoother at x, fitting the local
+// polynomial at x using the final robustness weights.
+//
+// Predict will panic if the receiver does not contain a successful fit.
+func (l *LOESS) Predict(x float64) float64 {
+	if !l.ok {
+		panic("stat: use of unsuccessful LOESS fit")
+	}
+	return l.local(nil, x)
+}
+
+// ConfidenceInterval returns the value of the smoother at x and the lower
+// and upper bounds of its approximate confidence interval with the given
+// confidence level. The fitted value is a linear function of the responses,
+//
+//	ŷ(x) = \sum_i l_i(x) y_i,
+//
+// treating the robustness weights as fixed, so its standard error is
+// σ‖l(x)‖, where σ is estimated from the residual sum of squares divided by
+// δ₁ = tr((I-L)ᵀ(I-L)) for the operator matrix L mapping the responses to
+// the fitted values. The interval is computed from a Student's t
+// distribution with δ₁²/δ₂ degrees of freedom, where δ₂ = tr(((I-L)ᵀ(I-L))²),
+// as described by Cleveland and Devlin. The interval covers the expected
+// value of the smoother rather than the true regression function, so it
+// does not account for the bias of the smoother.
+//
+// The first call to ConfidenceInterval after Fit computes the operator
+// matrix, which requires O(n²) memory and O(n³) time for n observations.
+//
+// ConfidenceInterval will panic if the receiver does not contain a
+// successful fit or level is not in (0, 1).
+func (l *LOESS) ConfidenceInterval(x, level float64) (fit, lower, upper float64) {
+	if !l.ok {
+		panic("stat: use of unsuccessful LOESS fit")
+	}
+	if level <= 0 || 1 <= level {
+		panic("stat: confidence level out of range")
+	}
+	if !l.haveVar {
+		l.variance()
+	}
+	row := make([]float64, len(l.x))
+	fit = l.local(row, x)
+	var ss float64
+	for _, v := range row {
+		ss += v * v
+	}
+	se := l.sigma * math.Sqrt(ss)
+	if se == 0 || math.IsInf(l.nu, 1) {
+		return fit, fit, fit
+	}
+	t := tUpperQuantile((1-level)/2, l.nu)
+	return fit, fit - t*se, fit + t*se
+}
+
+// variance computes the residual standard error and the equivalent degrees
+// of freedom of the residuals of the fit.
+func (l *LOESS) variance() {
+	n := len(l.x)
+	// Form I-L, the operator matrix
+	// mapping the responses to the
+	// residuals.
+	m := mat.NewDense(n, n, nil)
+	row := make([]float64, n)
+	var rss float64
+	for i, v := range l.x {
+		l.local(row, v)
+		for j := range row {
+			row[j] = -row[j]
+		}
+		row[i]++
+		m.SetRow(i, row)
+		r := l.y[i] - l.fitted[i]
+		rss += r * r
+	}
+	var c mat.SymDense
+	c.SymOuterK(1, m.T())
+	var d1, d2 float64
+	for i := range n {
+		d1 += c.At(i, i)
+		for j := range n {
+			v := c.At(i, j)
+			d2 += v * v
+		}
+	}
+	l.sigma = 0
+	l.nu = math.Inf(1)
+	if d1 > 0 {
+		l.sigma = math.Sqrt(rss / d1)
+		l.nu = d1 * d1 / d2
+	}
+	l.haveVar = true
+}
+
+// local returns the value at x of the local polynomial fitted to the
+// neighborhood of x. If row is not nil, the weights l_i of the responses
+// in the fitted value are stored in row.
+func (l *LOESS) local(row []float64, x float64) float64 {
+	n := len(l.x)
+	k := l.k
+
+	// Find the k nearest neighbors of x by
+	// expanding outward from its position in
+	// the sorted observations.
+	lo := sort.SearchFloat64s(l.x, x)
+	hi := lo
+	for hi-lo < k {
+		switch {
+		case lo == 0:
+			hi++
+		case hi == n:
+			lo--
+		case x-l.x[lo-1] <= l.x[hi]-x:
+			lo--
+		default:
+			hi++
+		}
+	}
+	// Include observations tied at the
+	// boundary of the neighborhood.
+	d := max(x-l.x[lo], l.x[hi-1]-x)
+	for lo > 0 && x-l.x[lo-1] == d {
+		lo--
+	}
+	for hi < n && l.x[hi]-x == d {
+		hi++
+	}
+	d *= l.scale
+
+	// The distances are scaled by d to
+	// improve the conditioning of the
+	// local fit.
+	l.w = slices.Grow(l.w[:0], hi-lo)[:hi-lo]
+	l.dist = slices.Grow(l.dist[:0], hi-lo)[:hi-lo]
+	for i := range l.w {
+		j := lo + i
+		w := 1.0
+		l.dist[i] = 0
+		if d > 0 {
+			l.dist[i] = (l.x[j] - x) / d
+			u := math.Abs(l.dist[i])
+			w = 0
+			if u < 1 {
+				u = 1 - u*u*u
+				w = u * u * u
+			}
+		}
+		l.w[i] = w * l.robust[j]
+	}
+
+	if row != nil {
+		clear(row)
+	}
+	// Fit the local polynomial, reducing its
+	// degree if the neighborhood has too few
+	// distinct weighted observations.
+	for deg := l.Degree; deg >= 0; deg-- {
+		g, ok := l.localCoef(deg)
+		if !ok {
+			continue
+		}
+		var fit float64
+		for i, w := range l.w {
+			if w == 0 {
+				continue
+			}
+			v := g[0]
+			p := 1.0
+			for _, c := range g[1:] {
+				p *= l.dist[i]
+				v += c * p
+			}
+			v *= w
+			fit += v * l.y[lo+i]
+			if row != nil {
+				row[lo+i] = v
+			}
+		}
+		return fit
+	}
+	return math.NaN()
+}
+
+// localCoef returns the first column g of the inverse of the weighted normal
+// matrix of the local polynomial of degree deg, so that the weight of the
+// ith response in the fitted value is w_i \sum_j g_j d_i^j for the scaled
+// distance d_i of the ith observation from the point of the fit.
+func (l *LOESS) localCoef(deg int) ([]float64, bool) {
+	p := deg + 1
+	a := mat.NewSymDense(p, nil)
+	for i, w := range l.w {
+		if w == 0 {
+			continue
+		}
+		pr := 1.0
+		for r := range p {
+			// pc holds d_i^(r+c).
+			pc := pr * pr
+			for c := r; c < p; c++ {
+				a.SetSym(r, c, a.At(r, c)+w*pc)
+				pc *= l.dist[i]
+			}
+			pr *= l.dist[i]
+		}
+	}
+	var chol mat.Cholesky
+	if !chol.Factorize(a) || chol.Cond() > 1e12 {
+		return nil, false
+	}
+	e := mat.NewVecDense(p, nil)
+	e.SetVec(0, 1)
+	var g mat.VecDense
+	err := chol.SolveVecTo(&g, e)
+	if err != nil {
+		return nil, false
+	}
+	return g.RawVector().Data, true
+}
@@ -0,0 +1,286 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+// loessLocal returns the fitted value at x0 of the local polynomial
+// regression of y on x by direct weighted least squares.
+func loessLocal(x, y, robust []float64, span float64, degree int, x0 float64) float64 {
+	n := len(x)
+	k := min(n, int(math.Floor(span*float64(n)+1e-5)))
+	dist := make([]float64, n)
+	for i, v := range x {
+		dist[i] = math.Abs(v - x0)
+	}
+	sorted := slices.Clone(dist)
+	slices.Sort(sorted)
+	d := sorted[k-1] * max(span, 1)
+
+	var rows []int
+	var w []float64
+	for i, v := range dist {
+		if v > d || robust[i] == 0 {
+			continue
+		}
+		u := 1 - math.Pow(v/d, 3)
+		if d == 0 {
+			u = 1
+		}
+		if u <= 0 {
+			continue
+		}
+		rows = append(rows, i)
+		w = append(w, math.Sqrt(u*u*u*robust[i]))
+	}
+	a := mat.NewDense(len(rows), degree+1, nil)
+	b := mat.NewVecDense(len(rows), nil)
+	for r, i := range rows {
+		for c := 0; c <= degree; c++ {
+			a.Set(r, c, w[r]*math.Pow(x[i]-x0, float64(c)))
+		}
+		b.SetVec(r, w[r]*y[i])
+	}
+	var beta mat.VecDense
+	err := beta.SolveVec(a, b)
+	if err != nil {
+		return math.NaN()
+	}
+	return beta.AtVec(0)
+}
+
+func loessData(rnd *rand.Rand, n int) (x, y []float64) {
+	x = make([]float64, n)
+	y = make([]float64, n)
+	for i := range x {
+		x[i] = 10 * rnd.Float64()
+		y[i] = math.Sin(x[i]) + 0.3*rnd.NormFloat64()
+	}
+	return x, y
+}
+
+func TestLOESS(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		n          int
+		span       float64
+		degree     int
+		iterations int
+	}{
+		{n: 50, span: 0.3, degree: 0},
+		{n: 50, span: 0.3, degree: 1},
+		{n: 50, span: 0.5, degree: 2},
+		{n: 100, span: 0, degree: 1, iterations: 2},
+		{n: 80, span: 0.2, degree: 2, iterations: 3},
+		{n: 30, span: 1.5, degree: 1},
+	} {
+		x, y := loessData(rnd, test.n)
+		l := LOESS{Span: test.span, Degree: test.degree, Iterations: test.iterations}
+		if !l.Fit(x, y) {
+			t.Errorf("unexpected failure for %+v", test)
+			continue
+		}
+		span := test.span
+		if span == 0 {
+			span = 0.75
+		}
+		robust := l.RobustnessWeights(nil)
+		if test.iterations == 0 {
+			for _, w := range robust {
+				if w != 1 {
+					t.Errorf("unexpected robustness weight for %+v: %v", test, w)
+					break
+				}
+			}
+		}
+		fitted := l.Fitted(nil)
+		for i, v := range x {
+			want := loessLocal(x, y, robust, span, test.degree, v)
+			if !scalar.EqualWithinAbsOrRel(fitted[i], want, 1e-10, 1e-10) {
+				t.Errorf("unexpected fitted value %d for %+v: got:%v want:%v", i, test, fitted[i], want)
+				break
+			}
+		}
+		for _, v := range []float64{-1, 0.5, 3.3, 9.99, 12} {
+			got := l.Predict(v)
+			want := loessLocal(x, y, robust, span, test.degree, v)
+			if !scalar.EqualWithinAbsOrRel(got, want, 1e-10, 1e-10) {
+				t.Errorf("unexpected prediction at %v for %+v: got:%v want:%v", v, test, got, want)
+			}
+		}
+	}
+}
+
+func TestLOESSExact(t *testing.T) {
+	t.Parallel()
+	// Local polynomials of degree d reproduce
+	// polynomials of degree at most d.
+	x := []float64{3, 1, 4, 1.5, 9, 2.6, 5, 3.5, 8, 9.7, 0.2, 6}
+	for degree, f := range []func(float64) float64{
+		func(float64) float64 { return 2 },
+		func(x float64) float64 { return 1 - 2*x },
+		func(x float64) float64 { return 1 - 2*x + 0.5*x*x },
+	} {
+		y := make([]float64, len(x))
+		for i, v := range x {
+			y[i] = f(v)
+		}
+		l := LOESS{Span: 0.5, Degree: degree, Iterations: 2}
+		if !l.Fit(x, y) {
+			t.Fatalf("unexpected failure for degree %d", degree)
+		}
+		fitted := l.Fitted(nil)
+		for i, v := range x {
+			if !scalar.EqualWithinAbsOrRel(fitted[i], y[i], 1e-10, 1e-10) {
+				t.Errorf("unexpected fitted value for degree %d at %v: got:%v want:%v", degree, v, fitted[i], y[i])
+			}
+		}
+		if got := l.Predict(7); !scalar.EqualWithinAbsOrRel(got, f(7), 1e-10, 1e-10) {
+			t.Errorf("unexpected prediction for degree %d: got:%v want:%v", degree, got, f(7))
+		}
+	}
+}
+
+func TestLOESSRobust(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(2, 2))
+	const n = 60
+	x := make([]float64, n)
+	y := make([]float64, n)
+	for i := range x {
+		x[i] = float64(i) / 6
+		y[i] = 1 + x[i] + 0.1*rnd.NormFloat64()
+	}
+	const outlier = 30
+	y[outlier] += 20
+
+	plain := LOESS{Span: 0.3, Degree: 1}
+	robust := LOESS{Span: 0.3, Degree: 1, Iterations: 2}
+	if !plain.Fit(x, y) || !robust.Fit(x, y) {
+		t.Fatal("unexpected fit failure")
+	}
+	want := 1 + x[outlier]
+	if got := plain.Fitted(nil)[outlier]; math.Abs(got-want) < 1 {
+		t.Errorf("expected outlier to affect non-robust fit: got:%v want far from:%v", got, want)
+	}
+	if got := robust.Fitted(nil)[outlier]; math.Abs(got-want) > 0.2 {
+		t.Errorf("unexpected robust fit at outlier: got:%v want:%v", got, want)
+	}
+	if w := robust.RobustnessWeights(nil)[outlier]; w != 0 {
+		t.Errorf("unexpected robustness weight of outlier: got:%v want:0", w)
+	}
+}
+
+func TestLOESSConfidenceInterval(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(3, 3))
+	const n = 40
+	x, y := loessData(rnd, n)
+	l := LOESS{Span: 0.4, Degree: 2}
+	if !l.Fit(x, y) {
+		t.Fatal("unexpected fit failure")
+	}
+
+	// Compute the operator matrix from the fits
+	// to the unit vectors.
+	robust := l.RobustnessWeights(nil)
+	op := mat.NewDense(n, n, nil)
+	e := make([]float64, n)
+	for j := range n {
+		e[j] = 1
+		for i, v := range x {
+			op.Set(i, j, loessLocal(x, e, robust, 0.4, 2, v))
+		}
+		e[j] = 0
+	}
+	var m mat.Dense
+	m.Sub(eye(n), op)
+	var c mat.Dense
+	c.Mul(m.T(), &m)
+	d1 := mat.Trace(&c)
+	var c2 mat.Dense
+	c2.Mul(&c, &c)
+	d2 := mat.Trace(&c2)
+	var resid mat.VecDense
+	resid.MulVec(&m, mat.NewVecDense(n, y))
+	sigma := mat.Norm(&resid, 2) / math.Sqrt(d1)
+	nu := d1 * d1 / d2
+
+	for _, v := range []float64{0.5, 5, 9} {
+		for _, level := range []float64{0.9, 0.95} {
+			fit, lower, upper := l.ConfidenceInterval(v, level)
+			if !scalar.EqualWithinAbsOrRel(fit, l.Predict(v), 1e-14, 1e-14) {
+				t.Errorf("unexpected fit at %v: got:%v want:%v", v, fit, l.Predict(v))
+			}
+			var ss float64
+			for j := range n {
+				e[j] = 1
+				lj := loessLocal(x, e, robust, 0.4, 2, v)
+				ss += lj * lj
+				e[j] = 0
+			}
+			half := tUpperQuantile((1-level)/2, nu) * sigma * math.Sqrt(ss)
+			if !scalar.EqualWithinAbsOrRel(fit-lower, half, 1e-8, 1e-8) || !scalar.EqualWithinAbsOrRel(upper-fit, half, 1e-8, 1e-8) {
+				t.Errorf("unexpected interval at %v with level %v: got:[%v, %v] want:[%v, %v]", v, level, lower, upper, fit-half, fit+half)
+			}
+		}
+	}
+}
+
+func eye(n int) *mat.Dense {
+	m := mat.NewDense(n, n, nil)
+	for i := range n {
+		m.Set(i, i, 1)
+	}
+	return m
+}
+
+func TestLOESSFailure(t *testing.T) {
+	t.Parallel()
+	x := []float64{1, 1, 2, 2, 3}
+	y := []float64{1, 2, 3, 4, 5}
+	for _, l := range []LOESS{
+		{Span: -1},
+		{Degree: 3},
+		{Degree: -1},
+		{Iterations: -1},
+		{Span: 0.2, Degree: 1},
+	} {
+		if l.Fit(x, y) {
+			t.Errorf("expected failure for %+v", l)
+		}
+		if !panics(func() { l.Predict(1) }) {
+			t.Errorf("expected panic for prediction after failure for %+v", l)
+		}
+	}
+	l := LOESS{Degree: 2}
+	if l.Fit([]float64{1, 1, 2, 2}, y[:4]) {
+		t.Errorf("expected failure for too few distinct values")
+	}
+	if l.Fit(nil, nil) {
+		t.Errorf("expected failure for no observations")
+	}
+	if !panics(func() { l.Fit(x, y[:4]) }) {
+		t.Errorf("expected panic for length mismatch")
+	}
+	if !l.Fit(x, y) {
+		t.Fatal("unexpected fit failure")
+	}
+	if !panics(func() { l.Fitted(make([]float64, 2)) }) {
+		t.Errorf("expected panic for dst length mismatch")
+	}
+	if !panics(func() { l.ConfidenceInterval(1, 1) }) {
+		t.Errorf("expected panic for confidence level out of range")
+	}
+}
@@ -0,0 +1,12 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package markov provides routines for discrete-time Markov chains on a
+// finite state space.
+//
+// A chain with n states is described by its n×n transition matrix P, where
+// P[i][j] is the probability of moving from state i to state j in one step.
+// The routines accept the transition matrix as a mat.Matrix and access it
+// only through At, so any matrix representation can be used.
+package markov // import "gonum.org/v1/gonum/stat/markov"
@@ -0,0 +1,326 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package markov
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"sort"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// rowSumTol is the tolerance on the deviation of the
+// sum of a row of a transition matrix from one.
+const rowSumTol = 1e-10
+
+var (
+	errNoStationary = errors.New("markov: no unique stationary distribution")
+	errNoAbsorbing  = errors.New("markov: no absorbing states")
+	errNotAbsorbed  = errors.New("markov: absorption not certain from all transient states")
+	errNotMixed     = errors.New("markov: chain not mixed within maximum steps")
+)
+
+// checkTransition returns the number of states of the chain with transition
+// matrix p, and panics if p is not square or a row of p is not a probability
+// distribution.
+func checkTransition(p mat.Matrix) int {
+	r, c := p.Dims()
+	if r != c {
+		panic(mat.ErrSquare)
+	}
+	for i := 0; i < r; i++ {
+		var sum float64
+		for j := 0; j < c; j++ {
+			v := p.At(i, j)
+			if v < 0 || math.IsNaN(v) {
+				panic("markov: negative transition probability")
+			}
+			sum += v
+		}
+		if math.Abs(sum-1) > rowSumTol {
+			panic("markov: transition probabilities do not sum to one")
+		}
+	}
+	return r
+}
+
+// Stationary computes the stationary distribution π of the chain with
+// transition matrix p, the probability distribution satisfying πᵀ P = πᵀ,
+// and stores it in dst. If dst is nil a new slice is allocated. The
+// stationary distribution is unique if the chain has a single closed
+// communicating class; otherwise Stationary returns an error.
+//
+// Stationary panics if p is not a valid transition matrix or if dst is not
+// nil and its length is not the number of states.
+func Stationary(dst []float64, p mat.Matrix) ([]float64, error) {
+	n := checkTransition(p)
+	if dst == nil {
+		dst = make([]float64, n)
+	} else if len(dst) != n {
+		panic("markov: destination length mismatch")
+	}
+
+	// Solve (I - Pᵀ) π = 0 with the last equation
+	// replaced by the normalization Σ_i π_i = 1.
+	a := mat.NewDense(n, n, nil)
+	for i := 0; i < n-1; i++ {
+		for j := 0; j < n; j++ {
+			v := -p.At(j, i)
+			if i == j {
+				v++
+			}
+			a.Set(i, j, v)
+		}
+	}
+	for j := 0; j < n; j++ {
+		a.Set(n-1, j, 1)
+	}
+	b := mat.NewVecDense(n, nil)
+	b.SetVec(n-1, 1)
+
+	var lu mat.LU
+	lu.Factorize(a)
+	x := mat.NewVecDense(n, dst)
+	if err := lu.SolveVecTo(x, false, b); err != nil {
+		return nil, errNoStationary
+	}
+	// Remove the rounding errors that
+	// make probabilities negative.
+	for i, v := range dst {
+		dst[i] = math.Max(v, 0)
+	}
+	floats.Scale(1/floats.Sum(dst), dst)
+	return dst, nil
+}
+
+// SpectralGap returns the absolute spectral gap of the chain with transition
+// matrix p, 1 - |λ₂|, where |λ₂| is the second largest modulus of the
+// eigenvalues of p. The gap is zero if the chain is periodic or has more
+// than one closed communicating class. For a reversible chain the inverse
+// of the gap, the relaxation time, bounds the number of steps needed for
+// the chain to mix.
+//
+// SpectralGap panics if p is not a valid transition matrix.
+func SpectralGap(p mat.Matrix) float64 {
+	n := checkTransition(p)
+	if n == 1 {
+		return 1
+	}
+	var eig mat.Eigen
+	if !eig.Factorize(p, mat.EigenNone) {
+		panic("markov: eigendecomposition failed")
+	}
+	values := eig.Values(nil)
+	mod := make([]float64, n)
+	for i, v := range values {
+		mod[i] = cmplx.Abs(v)
+	}
+	sort.Float64s(mod)
+	return math.Max(1-mod[n-2], 0)
+}
+
+// MixingTime returns the mixing time of the chain with transition matrix p,
+// the smallest number of steps t such that the distribution of the chain
+// after t steps is within total variation distance eps of the stationary
+// distribution for every starting state,
+//
+//	max_i ½ Σ_j |P^t[i][j] - π_j| ≤ eps.
+//
+// MixingTime returns an error if the chain has no unique stationary
+// distribution or if it has not mixed after maxSteps steps.
+//
+// MixingTime panics if p is not a valid transition matrix or if eps is not
+// positive.
+func MixingTime(p mat.Matrix, eps float64, maxSteps int) (int, error) {
+	if !(eps > 0) {
+		panic("markov: non-positive tolerance")
+	}
+	pi, err := Stationary(nil, p)
+	if err != nil {
+		return 0, err
+	}
+	n := len(pi)
+	pt := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		pt.Set(i, i, 1)
+	}
+	next := mat.NewDense(n, n, nil)
+	for t := 0; t <= maxSteps; t++ {
+		if t > 0 {
+			next.Mul(pt, p)
+			pt, next = next, pt
+		}
+		var dist float64
+		for i := 0; i < n; i++ {
+			dist = math.Max(dist, 0.5*floats.Distance(pt.RawRowView(i), pi, 1))
+		}
+		if dist <= eps {
+			return t, nil
+		}
+	}
+	return 0, errNotMixed
+}
+
+// Absorption holds the absorption properties of an absorbing Markov chain.
+type Absorption struct {
+	// Absorbing and Transient hold the indices of
+	// the absorbing and transient states in
+	// increasing order.
+	Absorbing []int
+	Transient []int
+
+	// Prob holds the probability of absorption
+	// into state Absorbing[j] starting from state
+	// Transient[i] in row i and column j.
+	Prob *mat.Dense
+
+	// Steps holds the expected number of steps
+	// before absorption starting from each of the
+	// transient states.
+	Steps []float64
+}
+
+// NewAbsorption returns the absorption properties of the chain with
+// transition matrix p. A state i is absorbing if p[i][i] is one, and all
+// other states are transient. NewAbsorption returns an error if the chain
+// has no absorbing states, or if absorption is not certain from all of the
+// transient states.
+//
+// NewAbsorption panics if p is not a valid transition matrix.
+func NewAbsorption(p mat.Matrix) (*Absorption, error) {
+	n := checkTransition(p)
+	var abs, trans []int
+	for i := 0; i < n; i++ {
+		if p.At(i, i) == 1 {
+			abs = append(abs, i)
+		} else {
+			trans = append(trans, i)
+		}
+	}
+	if len(abs) == 0 {
+		return nil, errNoAbsorbing
+	}
+	a := &Absorption{
+		Absorbing: abs,
+		Transient: trans,
+		Prob:      &mat.Dense{},
+	}
+	if len(trans) == 0 {
+		return a, nil
+	}
+
+	// With Q the transitions among the transient states and R the
+	// transitions from the transient to the absorbing states, the
+	// absorption probabilities B and the expected numbers of steps t
+	// solve (I - Q) B = R and (I - Q) t = 1.
+	nt := len(trans)
+	iq := mat.NewDense(nt, nt, nil)
+	rhs := mat.NewDense(nt, len(abs)+1, nil)
+	for i, ti := range trans {
+		for j, tj := range trans {
+			v := -p.At(ti, tj)
+			if i == j {
+				v++
+			}
+			iq.Set(i, j, v)
+		}
+		for j, aj := range abs {
+			rhs.Set(i, j, p.At(ti, aj))
+		}
+		rhs.Set(i, len(abs), 1)
+	}
+	var lu mat.LU
+	lu.Factorize(iq)
+	var sol mat.Dense
+	if err := lu.SolveTo(&sol, false, rhs); err != nil {
+		return nil, errNotAbsorbed
+	}
+	a.Prob = mat.DenseCopyOf(sol.Slice(0, nt, 0, len(abs)))
+	a.Steps = mat.Col(nil, len(abs), &sol)
+	return a, nil
+}
+
+// Simulate simulates the chain with transition matrix p starting from the
+// state start, and stores the visited states in dst, starting with start.
+// If src is nil, the global random source is used.
+//
+// Simulate panics if p is not a valid transition matrix, if start is not a
+// state of the chain, or if dst is empty.
+func Simulate(dst []int, p mat.Matrix, start int, src rand.Source) []int {
+	n := checkTransition(p)
+	if start < 0 || n <= start {
+		panic("markov: invalid start state")
+	}
+	if len(dst) == 0 {
+		panic("markov: zero length destination")
+	}
+	rnd := rand.Float64
+	if src != nil {
+		rnd = rand.New(src).Float64
+	}
+	// cum holds the cumulative transition
+	// probabilities of each state.
+	cum := make([][]float64, n)
+	dst[0] = start
+	for t := 1; t < len(dst); t++ {
+		s := dst[t-1]
+		if cum[s] == nil {
+			cum[s] = make([]float64, n)
+			floats.CumSum(cum[s], mat.Row(nil, s, p))
+		}
+		u := rnd() * cum[s][n-1]
+		next := sort.SearchFloat64s(cum[s], u)
+		// Skip states with zero probability that
+		// share the cumulative probability.
+		for next < n-1 && cum[s][next] <= u {
+			next++
+		}
+		dst[t] = next
+	}
+	return dst
+}
+
+// FitTransition returns the maximum likelihood estimate of the transition
+// matrix of a chain with n states from the observed sequences of states in
+// seqs. The probability of the transition from state i to state j is the
+// number of such transitions divided by the number of transitions from i.
+// The rows of states that are never left in seqs are set to the uniform
+// distribution.
+//
+// FitTransition panics if n is not positive or if a state in seqs is not
+// in [0, n).
+func FitTransition(n int, seqs [][]int) *mat.Dense {
+	if n <= 0 {
+		panic("markov: non-positive number of states")
+	}
+	counts := mat.NewDense(n, n, nil)
+	for _, seq := range seqs {
+		for t, s := range seq {
+			if s < 0 || n <= s {
+				panic("markov: invalid state")
+			}
+			if t > 0 {
+				prev := seq[t-1]
+				counts.Set(prev, s, counts.At(prev, s)+1)
+			}
+		}
+	}
+	for i := 0; i < n; i++ {
+		row := counts.RawRowView(i)
+		sum := floats.Sum(row)
+		if sum == 0 {
+			for j := range row {
+				row[j] = 1 / float64(n)
+			}
+			continue
+		}
+		floats.Scale(1/sum, row)
+	}
+	return counts
+}
@@ -0,0 +1,59 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package markov_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/markov"
+)
+
+func ExampleStationary() {
+	// A weather model with the states sunny, cloudy and rainy.
+	p := mat.NewDense(3, 3, []float64{
+		0.7, 0.2, 0.1,
+		0.3, 0.4, 0.3,
+		0.2, 0.3, 0.5,
+	})
+	pi, err := markov.Stationary(nil, p)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("stationary distribution: %.3f\n", pi)
+	fmt.Printf("spectral gap: %.3f\n", markov.SpectralGap(p))
+	t, err := markov.MixingTime(p, 0.01, 100)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("mixing time:", t)
+
+	// Output:
+	// stationary distribution: [0.457 0.283 0.261]
+	// spectral gap: 0.527
+	// mixing time: 6
+}
+
+func ExampleNewAbsorption() {
+	// A random walk on 0, 1, 2, 3 that stops at either end.
+	p := mat.NewDense(4, 4, []float64{
+		1, 0, 0, 0,
+		0.5, 0, 0.5, 0,
+		0, 0.5, 0, 0.5,
+		0, 0, 0, 1,
+	})
+	a, err := markov.NewAbsorption(p)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for i, s := range a.Transient {
+		fmt.Printf("from %d: P(end at 3) = %.3f, expected steps = %.1f\n", s, a.Prob.At(i, 1), a.Steps[i])
+	}
+
+	// Output:
+	// from 1: P(end at 3) = 0.333, expected steps = 2.0
+	// from 2: P(end at 3) = 0.667, expected steps = 2.0
+}
@@ -0,0 +1,211 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package markov
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestTwoState(t *testing.T) {
+	const tol = 1e-12
+	for _, test := range []struct{ a, b float64 }{
+		{a: 0.3, b: 0.1},
+		{a: 0.9, b: 0.6},
+		{a: 0.5, b: 0.5},
+	} {
+		a, b := test.a, test.b
+		p := mat.NewDense(2, 2, []float64{1 - a, a, b, 1 - b})
+
+		pi, err := Stationary(nil, p)
+		if err != nil {
+			t.Fatalf("a=%v b=%v: unexpected error: %v", a, b, err)
+		}
+		want := []float64{b / (a + b), a / (a + b)}
+		if !floats.EqualApprox(pi, want, tol) {
+			t.Errorf("a=%v b=%v: stationary mismatch: got %v, want %v", a, b, pi, want)
+		}
+
+		// The eigenvalues are 1 and 1-a-b.
+		gap := SpectralGap(p)
+		if wantGap := 1 - math.Abs(1-a-b); !scalar.EqualWithinAbsOrRel(gap, wantGap, tol, tol) {
+			t.Errorf("a=%v b=%v: spectral gap mismatch: got %v, want %v", a, b, gap, wantGap)
+		}
+
+		// The total variation distance after
+		// t steps is max(π) |1-a-b|^t.
+		const eps = 1e-3
+		mix, err := MixingTime(p, eps, 1000)
+		if err != nil {
+			t.Fatalf("a=%v b=%v: unexpected error: %v", a, b, err)
+		}
+		wantMix := 0
+		for d := math.Max(want[0], want[1]); d > eps; d *= math.Abs(1 - a - b) {
+			wantMix++
+		}
+		if mix != wantMix {
+			t.Errorf("a=%v b=%v: mixing time mismatch: got %d, want %d", a, b, mix, wantMix)
+		}
+	}
+}
+
+func TestStationaryReducible(t *testing.T) {
+	// Two closed classes.
+	p := mat.NewDense(3, 3, []float64{
+		1, 0, 0,
+		0.5, 0, 0.5,
+		0, 0, 1,
+	})
+	_, err := Stationary(nil, p)
+	if err == nil {
+		t.Error("expected error for chain with two closed classes")
+	}
+	if gap := SpectralGap(p); gap != 0 {
+		t.Errorf("unexpected spectral gap: got %v, want 0", gap)
+	}
+}
+
+func TestMixingTimePeriodic(t *testing.T) {
+	p := mat.NewDense(2, 2, []float64{0, 1, 1, 0})
+	_, err := MixingTime(p, 0.1, 100)
+	if err == nil {
+		t.Error("expected error for periodic chain")
+	}
+}
+
+func TestAbsorption(t *testing.T) {
+	// The gambler's ruin with a fortune of 0 to n and a probability q of
+	// winning each bet. The absorbing states are 0 and n.
+	const (
+		n   = 6
+		q   = 0.4
+		tol = 1e-12
+	)
+	p := mat.NewDense(n+1, n+1, nil)
+	p.Set(0, 0, 1)
+	p.Set(n, n, 1)
+	for i := 1; i < n; i++ {
+		p.Set(i, i-1, 1-q)
+		p.Set(i, i+1, q)
+	}
+	a, err := NewAbsorption(p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(a.Absorbing) != 2 || a.Absorbing[0] != 0 || a.Absorbing[1] != n {
+		t.Errorf("unexpected absorbing states: %v", a.Absorbing)
+	}
+	r := (1 - q) / q
+	for i, s := range a.Transient {
+		if s != i+1 {
+			t.Fatalf("unexpected transient states: %v", a.Transient)
+		}
+		win := (1 - math.Pow(r, float64(s))) / (1 - math.Pow(r, n))
+		if got := a.Prob.At(i, 1); !scalar.EqualWithinAbsOrRel(got, win, tol, tol) {
+			t.Errorf("state %d: probability of reaching %d mismatch: got %v, want %v", s, n, got, win)
+		}
+		if got := a.Prob.At(i, 0); !scalar.EqualWithinAbsOrRel(got, 1-win, tol, tol) {
+			t.Errorf("state %d: probability of ruin mismatch: got %v, want %v", s, got, 1-win)
+		}
+		steps := float64(s)/(1-2*q) - n/(1-2*q)*win
+		if got := a.Steps[i]; !scalar.EqualWithinAbsOrRel(got, steps, tol, tol) {
+			t.Errorf("state %d: expected steps mismatch: got %v, want %v", s, got, steps)
+		}
+	}
+
+	// State 1 and 2 form a closed class
+	// from which 0 cannot be reached.
+	p = mat.NewDense(3, 3, []float64{
+		1, 0, 0,
+		0, 0.5, 0.5,
+		0, 0.5, 0.5,
+	})
+	_, err = NewAbsorption(p)
+	if err == nil {
+		t.Error("expected error for uncertain absorption")
+	}
+}
+
+func TestSimulateFit(t *testing.T) {
+	p := mat.NewDense(3, 3, []float64{
+		0.5, 0.5, 0,
+		0.2, 0.3, 0.5,
+		0.6, 0, 0.4,
+	})
+	pi, err := Stationary(nil, p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	src := rand.NewPCG(1, 1)
+	seqs := make([][]int, 4)
+	freq := make([]float64, 3)
+	var total float64
+	for i := range seqs {
+		seqs[i] = Simulate(make([]int, 10000), p, i%3, src)
+		if seqs[i][0] != i%3 {
+			t.Errorf("sequence %d does not start with start state", i)
+		}
+		for j, s := range seqs[i] {
+			if j > 0 && p.At(seqs[i][j-1], s) == 0 {
+				t.Fatalf("impossible transition from %d to %d", seqs[i][j-1], s)
+			}
+			freq[s]++
+			total++
+		}
+	}
+	floats.Scale(1/total, freq)
+	if !floats.EqualApprox(freq, pi, 0.01) {
+		t.Errorf("state frequencies mismatch: got %v, want %v", freq, pi)
+	}
+
+	got := FitTransition(3, seqs)
+	if !mat.EqualApprox(got, p, 0.02) {
+		t.Errorf("fitted transition mismatch:\ngot:\n%v\nwant:\n%v", mat.Formatted(got), mat.Formatted(p))
+	}
+	for i := 0; i < 3; i++ {
+		if p.At(i, (i+2)%3) == 0 && got.At(i, (i+2)%3) != 0 {
+			t.Errorf("fitted impossible transition from %d", i)
+		}
+	}
+
+	// A state that is never left has a uniform row.
+	got = FitTransition(3, [][]int{{0, 1, 0, 2}})
+	want := mat.NewDense(3, 3, []float64{
+		0, 0.5, 0.5,
+		1, 0, 0,
+		1. / 3, 1. / 3, 1. / 3,
+	})
+	if !mat.EqualApprox(got, want, 1e-15) {
+		t.Errorf("fitted transition mismatch:\ngot:\n%v\nwant:\n%v", mat.Formatted(got), mat.Formatted(want))
+	}
+}
+
+func TestInvalidTransition(t *testing.T) {
+	for _, p := range []mat.Matrix{
+		mat.NewDense(2, 3, nil),
+		mat.NewDense(2, 2, []float64{0.5, 0.4, 0, 1}),
+		mat.NewDense(2, 2, []float64{1.5, -0.5, 0, 1}),
+	} {
+		if !panics(func() { Stationary(nil, p) }) {
+			t.Errorf("expected panic for invalid transition matrix %v", mat.Formatted(p))
+		}
+	}
+}
+
+func panics(fun func()) (b bool) {
+	defer func() {
+		err := recover()
+		if err != nil {
+			b = true
+		}
+	}()
+	fun()
+	return
+}
@@ -0,0 +1,332 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/dsp/fourier"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/mathext"
+)
+
+// RHat returns the rank-normalized split-R̂ convergence diagnostic of
+// Markov chain Monte Carlo samples of a scalar quantity. The columns of
+// chains hold the draws of the chains, in the order in which they were
+// generated, and each chain has the same number of draws.
+//
+// The potential scale reduction factor R̂ compares the variance of the
+// draws within the chains to the variance between them, and approaches one
+// from above as the chains converge to the same stationary distribution.
+// Each chain is split into halves to detect non-stationarity within chains.
+// R̂ is computed for the rank-normalized draws and for the rank-normalized
+// absolute deviations of the draws from their median, so it is robust to
+// heavy tails and detects chains that differ in scale as well as in location,
+// and RHat returns the larger of the two. Vehtari et al. recommend that
+// samples are used only if R̂ is less than 1.01.
+//
+// RHat returns NaN if the chains have fewer than four draws, any draw is
+// not finite or all the draws are equal.
+//
+// See Vehtari, A., Gelman, A., Simpson, D., Carpenter, B. and Bürkner, P.-C.
+// (2021) Rank-normalization, folding, and localization: An improved R̂ for
+// assessing convergence of MCMC. Bayesian Analysis 16(2) for details.
+func RHat(chains mat.Matrix) float64 {
+	s := splitChains(chains)
+	if s == nil {
+		return math.NaN()
+	}
+	bulk := rhatBasic(zScale(s))
+	tail := rhatBasic(zScale(fold(s)))
+	return max(bulk, tail)
+}
+
+// ESSBulk returns the bulk effective sample size of Markov chain Monte
+// Carlo samples of a scalar quantity, the effective sample size of the
+// rank-normalized draws of the split chains. The bulk effective sample size
+// measures the efficiency of estimates of the location of the distribution
+// such as the mean and median. The columns of chains hold the draws of the
+// chains as for RHat.
+//
+// The effective sample size is computed from the autocorrelations of the
+// chains, estimated with the variance between the chains included, and
+// truncated using Geyer's initial monotone sequence. It may exceed the total
+// number of draws for antithetic chains.
+//
+// ESSBulk returns NaN if the chains have fewer than eight draws, any draw is
+// not finite or all the draws are equal.
+func ESSBulk(chains mat.Matrix) float64 {
+	s := splitChains(chains)
+	if s == nil {
+		return math.NaN()
+	}
+	return essBasic(zScale(s))
+}
+
+// ESSTail returns the tail effective sample size of Markov chain Monte
+// Carlo samples of a scalar quantity, the smaller of the effective sample
+// sizes of the estimates of the 5% and 95% quantiles of the distribution,
+// computed from the split chains of indicators of the draws not exceeding
+// the empirical quantiles. The tail effective sample size measures the
+// efficiency of estimates of intervals. The columns of chains hold the
+// draws of the chains as for RHat.
+//
+// ESSTail returns NaN if the chains have fewer than eight draws, any draw is
+// not finite or all the draws are equal.
+func ESSTail(chains mat.Matrix) float64 {
+	s := splitChains(chains)
+	if s == nil {
+		return math.NaN()
+	}
+	sorted := slices.Concat(s...)
+	slices.Sort(sorted)
+	ess := math.Inf(1)
+	for _, p := range []float64{0.05, 0.95} {
+		q := Quantile(p, Empirical, sorted, nil)
+		ind := make([][]float64, len(s))
+		for j, c := range s {
+			ind[j] = make([]float64, len(c))
+			for i, v := range c {
+				if v <= q {
+					ind[j][i] = 1
+				}
+			}
+		}
+		ess = min(ess, essBasic(ind))
+	}
+	return ess
+}
+
+// AutocorrelationTime returns the integrated autocorrelation time
+//
+//	τ = 1 + 2 \sum_{t=1}^∞ ρ_t
+//
+// of the Markov chain draws in x, where ρ_t is the autocorrelation at lag t.
+// The effective sample size of the chain is len(x)/τ, and the variance of
+// the mean of x is τ times the variance of the mean of independent draws.
+// The sum is truncated using Geyer's initial monotone sequence estimator,
+// the autocorrelations being estimated using the fast Fourier transform.
+//
+// AutocorrelationTime returns NaN if x has fewer than four elements, any
+// element is not finite or all the elements are equal.
+func AutocorrelationTime(x []float64) float64 {
+	for _, v := range x {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return math.NaN()
+		}
+	}
+	return autocorrTime([][]float64{x})
+}
+
+// Geweke returns Geweke's convergence diagnostic for the Markov chain draws
+// in x, the z-score
+//
+//	z = (mean(a) - mean(b)) / sqrt(var(mean(a)) + var(mean(b)))
+//
+// comparing the means of the first fraction, a, and the last fraction, b,
+// of the draws, and the two-sided p-value of the hypothesis that the means
+// are equal. The variances of the means are estimated from the
+// autocorrelation times of the parts, as returned by AutocorrelationTime.
+// If the chain is stationary, z is asymptotically standard normal.
+// Geweke recommends comparing the first 10% and the last 50% of the draws.
+//
+// Geweke returns NaN if either part has fewer than four draws, any draw is
+// not finite or all the draws in either part are equal.
+//
+// Geweke will panic if first or last is not in (0, 1) or their sum exceeds
+// one.
+func Geweke(x []float64, first, last float64) (z, p float64) {
+	if !(0 < first && first < 1) || !(0 < last && last < 1) || first+last > 1 {
+		panic("stat: invalid Geweke fractions")
+	}
+	n := len(x)
+	a := x[:int(first*float64(n))]
+	b := x[n-int(last*float64(n)):]
+	varMean := func(x []float64) (mean, v float64) {
+		mean, v = PopMeanVariance(x, nil)
+		return mean, v * AutocorrelationTime(x) / float64(len(x))
+	}
+	ma, va := varMean(a)
+	mb, vb := varMean(b)
+	z = (ma - mb) / math.Sqrt(va+vb)
+	return z, 2 * normalCDF(-math.Abs(z))
+}
+
+// splitChains returns the halves of the columns of chains, dropping the
+// middle draw of chains with an odd number of draws. splitChains returns
+// nil if the chains have fewer than four draws or any draw is not finite.
+func splitChains(chains mat.Matrix) [][]float64 {
+	r, c := chains.Dims()
+	if r < 4 {
+		return nil
+	}
+	half := r / 2
+	s := make([][]float64, 2*c)
+	for j := range c {
+		first := make([]float64, half)
+		second := make([]float64, half)
+		for i := range half {
+			first[i] = chains.At(i, j)
+			second[i] = chains.At(r-half+i, j)
+		}
+		s[2*j] = first
+		s[2*j+1] = second
+	}
+	for _, c := range s {
+		for _, v := range c {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				return nil
+			}
+		}
+	}
+	return s
+}
+
+// zScale returns the normal scores of the ranks of the draws over all the
+// chains, with ties given their average rank.
+func zScale(chains [][]float64) [][]float64 {
+	ranks := weightedRanks(slices.Concat(chains...), nil)
+	total := float64(len(ranks))
+	z := make([][]float64, len(chains))
+	var k int
+	for j, c := range chains {
+		z[j] = make([]float64, len(c))
+		for i := range c {
+			z[j][i] = mathext.NormalQuantile((ranks[k] - 3.0/8) / (total + 1.0/4))
+			k++
+		}
+	}
+	return z
+}
+
+// fold returns the absolute deviations of the draws from their median over
+// all the chains.
+func fold(chains [][]float64) [][]float64 {
+	sorted := slices.Concat(chains...)
+	slices.Sort(sorted)
+	n := len(sorted)
+	median := sorted[n/2]
+	if n%2 == 0 {
+		median = (sorted[n/2-1] + median) / 2
+	}
+	f := make([][]float64, len(chains))
+	for j, c := range chains {
+		f[j] = make([]float64, len(c))
+		for i, v := range c {
+			f[j][i] = math.Abs(v - median)
+		}
+	}
+	return f
+}
+
+// rhatBasic returns the potential scale reduction factor of chains with
+// equal numbers of draws.
+func rhatBasic(chains [][]float64) float64 {
+	n := float64(len(chains[0]))
+	means := make([]float64, len(chains))
+	var within float64
+	for j, c := range chains {
+		var v float64
+		means[j], v = MeanVariance(c, nil)
+		within += v
+	}
+	within /= float64(len(chains))
+	between := n * Variance(means, nil)
+	varPlus := (n-1)/n*within + between/n
+	return math.Sqrt(varPlus / within)
+}
+
+// essBasic returns the effective sample size of chains with equal numbers
+// of draws.
+func essBasic(chains [][]float64) float64 {
+	total := float64(len(chains) * len(chains[0]))
+	tau := autocorrTime(chains)
+	return total / max(tau, 1/math.Log10(total))
+}
+
+// autocorrTime returns the integrated autocorrelation time of chains with
+// equal numbers of draws, with the autocorrelations estimated using the
+// variance within and between the chains and truncated by Geyer's initial
+// monotone sequence estimator.
+func autocorrTime(chains [][]float64) float64 {
+	m := len(chains)
+	n := len(chains[0])
+	if n < 4 {
+		return math.NaN()
+	}
+	acov := make([]float64, n)
+	means := make([]float64, m)
+	for j, c := range chains {
+		floats.AddScaled(acov, 1/float64(m), autocovariance(c))
+		means[j] = Mean(c, nil)
+	}
+	meanVar := acov[0] * float64(n) / float64(n-1)
+	if meanVar == 0 {
+		return math.NaN()
+	}
+	varPlus := meanVar * float64(n-1) / float64(n)
+	if m > 1 {
+		varPlus += Variance(means, nil)
+	}
+
+	rho := make([]float64, n)
+	rho[0] = 1
+	rhoEven := 1.0
+	rhoOdd := 1 - (meanVar-acov[1])/varPlus
+	rho[1] = rhoOdd
+	var t int
+	for t < n-5 && rhoEven+rhoOdd > 0 {
+		t += 2
+		rhoEven = 1 - (meanVar-acov[t])/varPlus
+		rhoOdd = 1 - (meanVar-acov[t+1])/varPlus
+		if rhoEven+rhoOdd >= 0 {
+			rho[t] = rhoEven
+			rho[t+1] = rhoOdd
+		}
+	}
+	maxT := t
+	if rhoEven > 0 {
+		rho[maxT] = rhoEven
+	}
+
+	// Enforce that the sums of pairs of
+	// autocorrelations are non-increasing.
+	for t = 2; t <= maxT-2; t += 2 {
+		if rho[t]+rho[t+1] > rho[t-2]+rho[t-1] {
+			rho[t] = (rho[t-2] + rho[t-1]) / 2
+			rho[t+1] = rho[t]
+		}
+	}
+
+	tau := -1 + rho[maxT]
+	for _, r := range rho[:maxT] {
+		tau += 2 * r
+	}
+	return tau
+}
+
+// autocovariance returns the autocovariances of x at lags zero to len(x)-1,
+// with denominator len(x), computed using the fast Fourier transform.
+func autocovariance(x []float64) []float64 {
+	n := len(x)
+	mean := Mean(x, nil)
+	seq := make([]float64, 2*n)
+	for i, v := range x {
+		seq[i] = v - mean
+	}
+	fft := fourier.NewFFT(2 * n)
+	coef := fft.Coefficients(nil, seq)
+	for i, c := range coef {
+		coef[i] = complex(real(c)*real(c)+imag(c)*imag(c), 0)
+	}
+	fft.Sequence(seq, coef)
+	acov := seq[:n]
+	for i := range acov {
+		acov[i] /= float64(2 * n * n)
+	}
+	return acov
+}
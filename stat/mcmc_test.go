@@ -0,0 +1,178 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+// ar1Chains returns m chains of n draws of a stationary
+// first-order autoregressive process with coefficient phi
+// and unit marginal variance, which has integrated
+// autocorrelation time (1+phi)/(1-phi).
+func ar1Chains(rnd *rand.Rand, n, m int, phi float64) *mat.Dense {
+	chains := mat.NewDense(n, m, nil)
+	s := math.Sqrt(1 - phi*phi)
+	for j := range m {
+		x := rnd.NormFloat64()
+		for i := range n {
+			chains.Set(i, j, x)
+			x = phi*x + s*rnd.NormFloat64()
+		}
+	}
+	return chains
+}
+
+func TestAutocovariance(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 7, 64, 101} {
+		x := make([]float64, n)
+		for i := range x {
+			x[i] = rnd.NormFloat64()
+		}
+		mean := Mean(x, nil)
+		want := make([]float64, n)
+		for k := range want {
+			for i := 0; i+k < n; i++ {
+				want[k] += (x[i] - mean) * (x[i+k] - mean)
+			}
+			want[k] /= float64(n)
+		}
+		got := autocovariance(x)
+		if !floats.EqualApprox(got, want, 1e-12) {
+			t.Errorf("unexpected autocovariance for n=%d: got:%v want:%v", n, got, want)
+		}
+	}
+}
+
+func TestRHatBasic(t *testing.T) {
+	t.Parallel()
+	// Chain means 2 and 4 and variances 1,
+	// so W = 1, B = 3*2 and
+	// R̂² = (2/3*1 + 6/3) / 1.
+	got := rhatBasic([][]float64{{1, 2, 3}, {3, 4, 5}})
+	want := math.Sqrt(8.0 / 3)
+	if !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+		t.Errorf("unexpected R̂: got:%v want:%v", got, want)
+	}
+}
+
+func TestMCMCDiagnostics(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+
+	// Independent draws.
+	const n, m = 1000, 4
+	chains := ar1Chains(rnd, n, m, 0)
+	if got := RHat(chains); got > 1.01 || got < 0.99 {
+		t.Errorf("unexpected R̂ for independent draws: %v", got)
+	}
+	if got := ESSBulk(chains); math.Abs(got-n*m) > 0.15*n*m {
+		t.Errorf("unexpected bulk effective sample size for independent draws: got:%v want:%v", got, n*m)
+	}
+	if got := ESSTail(chains); math.Abs(got-n*m) > 0.25*n*m {
+		t.Errorf("unexpected tail effective sample size for independent draws: got:%v want:%v", got, n*m)
+	}
+
+	// Autocorrelated draws.
+	const phi = 0.9
+	tau := (1 + phi) / (1 - phi)
+	chains = ar1Chains(rnd, 25000, m, phi)
+	if got := RHat(chains); got > 1.01 {
+		t.Errorf("unexpected R̂ for autocorrelated draws: %v", got)
+	}
+	if got, want := ESSBulk(chains), 25000*m/tau; !scalar.EqualWithinRel(got, want, 0.15) {
+		t.Errorf("unexpected bulk effective sample size for autocorrelated draws: got:%v want:%v", got, want)
+	}
+	// The indicators of the tails are less
+	// autocorrelated than the draws.
+	if got := ESSTail(chains); got < 25000*m/tau || 25000*m < got {
+		t.Errorf("unexpected tail effective sample size for autocorrelated draws: %v", got)
+	}
+	x := mat.Col(nil, 0, ar1Chains(rnd, 100000, 1, phi))
+	if got := AutocorrelationTime(x); !scalar.EqualWithinRel(got, tau, 0.15) {
+		t.Errorf("unexpected autocorrelation time: got:%v want:%v", got, tau)
+	}
+	if z, p := Geweke(x, 0.1, 0.5); math.Abs(z) > 3 || p < 0.003 {
+		t.Errorf("unexpected Geweke diagnostic for stationary chain: z=%v p=%v", z, p)
+	}
+
+	// Chains with different locations.
+	chains = ar1Chains(rnd, n, m, 0)
+	for i := range n {
+		chains.Set(i, 0, chains.At(i, 0)+2)
+	}
+	if got := RHat(chains); got < 1.1 {
+		t.Errorf("unexpected R̂ for chains with different locations: %v", got)
+	}
+
+	// Chains with different scales are detected
+	// by the tail R̂ only.
+	chains = ar1Chains(rnd, n, m, 0)
+	for i := range n {
+		chains.Set(i, 0, 3*chains.At(i, 0))
+	}
+	if got := RHat(chains); got < 1.05 {
+		t.Errorf("unexpected R̂ for chains with different scales: %v", got)
+	}
+	if got := rhatBasic(zScale(splitChains(chains))); got > 1.02 {
+		t.Errorf("unexpected bulk R̂ for chains with different scales: %v", got)
+	}
+
+	// A chain with a trend is non-stationary.
+	x = mat.Col(nil, 0, ar1Chains(rnd, n, 1, 0))
+	for i := range x {
+		x[i] += 2 * float64(i) / n
+	}
+	if _, p := Geweke(x, 0.1, 0.5); p > 1e-6 {
+		t.Errorf("unexpected Geweke p-value for chain with trend: %v", p)
+	}
+	// Splitting chains detects the trend
+	// in a single chain.
+	if got := RHat(mat.NewDense(n, 1, x)); got < 1.1 {
+		t.Errorf("unexpected R̂ for chain with trend: %v", got)
+	}
+
+	// Degenerate cases.
+	constant := mat.NewDense(10, 2, nil)
+	short := mat.NewDense(3, 2, []float64{1, 2, 3, 4, 5, 6})
+	inf := ar1Chains(rnd, 10, 2, 0)
+	inf.Set(3, 1, math.Inf(1))
+	for _, test := range []struct {
+		name   string
+		chains *mat.Dense
+	}{
+		{name: "constant", chains: constant},
+		{name: "short", chains: short},
+		{name: "infinite", chains: inf},
+	} {
+		if got := RHat(test.chains); !math.IsNaN(got) {
+			t.Errorf("unexpected R̂ for %s chains: got:%v want:NaN", test.name, got)
+		}
+		if got := ESSBulk(test.chains); !math.IsNaN(got) {
+			t.Errorf("unexpected bulk effective sample size for %s chains: got:%v want:NaN", test.name, got)
+		}
+		if got := ESSTail(test.chains); !math.IsNaN(got) {
+			t.Errorf("unexpected tail effective sample size for %s chains: got:%v want:NaN", test.name, got)
+		}
+		if got := AutocorrelationTime(mat.Col(nil, 1, test.chains)); !math.IsNaN(got) {
+			t.Errorf("unexpected autocorrelation time for %s chain: got:%v want:NaN", test.name, got)
+		}
+	}
+
+	if !panics(func() { Geweke(x, 0.6, 0.5) }) {
+		t.Errorf("expected panic for overlapping Geweke fractions")
+	}
+	if !panics(func() { Geweke(x, 0, 0.5) }) {
+		t.Errorf("expected panic for zero Geweke fraction")
+	}
+}
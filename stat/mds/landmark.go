@@ -0,0 +1,133 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mds
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// LandmarkScaling performs landmark multidimensional scaling, embedding n
+// observations into at most k dimensions using only their dissimilarities to
+// a set of m landmark observations. The landmarks are embedded by classical
+// scaling of the m×m dissimilarity matrix between them, and the observations
+// are then placed by distance-based triangulation from the m×n matrix dis
+// holding the dissimilarities from each landmark to each observation. This
+// requires O(m n) rather than O(n²) storage and time, and is equivalent to a
+// Nyström approximation of classical scaling. The observations may include
+// the landmarks themselves, in which case their embedding agrees with the
+// classical scaling of the landmarks.
+//
+// LandmarkScaling places the coordinates of the observations into the rows
+// of dst, resizing it to n×kk, and returns kk, the number of dimensions used.
+// kk is the smaller of k and the number of positive eigenvalues of the
+// landmark classical scaling. If the classical scaling of the landmarks
+// fails, dst is empty on return and kk is zero.
+//
+// See de Silva and Tenenbaum, "Sparse multidimensional scaling using
+// landmark points", Technical report, Stanford University 2004 for details
+// of the algorithm.
+//
+// LandmarkScaling will panic if dst is not empty, if k is less than one or if
+// the number of rows of dis does not match the dimension of landmarks.
+func LandmarkScaling(dst *mat.Dense, landmarks mat.Symmetric, dis mat.Matrix, k int) (kk int) {
+	if !dst.IsEmpty() {
+		panic("mds: receiver matrix not empty")
+	}
+	if k < 1 {
+		panic("mds: invalid number of dimensions")
+	}
+	m := landmarks.SymmetricDim()
+	r, n := dis.Dims()
+	if r != m {
+		panic(mat.ErrShape)
+	}
+
+	var l mat.Dense
+	eig := make([]float64, m)
+	kk, _ = TorgersonScaling(&l, eig, landmarks)
+	if kk == 0 {
+		return 0
+	}
+	kk = min(kk, k)
+
+	// The pseudo-inverse transpose of the landmark coordinates
+	// has columns v_c/sqrt(λ_c) = l_c/λ_c.
+	pinv := mat.NewDense(m, kk, nil)
+	for i := 0; i < m; i++ {
+		for c := 0; c < kk; c++ {
+			pinv.Set(i, c, l.At(i, c)/eig[c])
+		}
+	}
+
+	// mean holds the mean squared dissimilarity
+	// from each landmark to the other landmarks.
+	mean := make([]float64, m)
+	for i := 0; i < m; i++ {
+		for j := 0; j < m; j++ {
+			v := landmarks.At(i, j)
+			mean[i] += v * v
+		}
+		mean[i] /= float64(m)
+	}
+	centered := mat.NewDense(m, n, nil)
+	for i := 0; i < m; i++ {
+		row := centered.RawRowView(i)
+		for j := range row {
+			v := dis.At(i, j)
+			row[j] = v*v - mean[i]
+		}
+	}
+	dst.ReuseAs(n, kk)
+	dst.Mul(centered.T(), pinv)
+	dst.Scale(-0.5, dst)
+	return kk
+}
+
+// MaxMinLandmarks returns the indices of m landmarks chosen from n
+// observations by greedy farthest point sampling, where dist returns the
+// dissimilarity between observations i and j. The first landmark is
+// chosen at random using src, and each subsequent landmark is the
+// observation with the largest dissimilarity to its nearest landmark.
+// Choosing m landmarks requires m n calls to dist. If src is nil, the
+// global rand package functions are used.
+//
+// MaxMinLandmarks will panic if m is less than one or greater than n.
+func MaxMinLandmarks(n, m int, dist func(i, j int) float64, src rand.Source) []int {
+	if m < 1 || m > n {
+		panic("mds: invalid number of landmarks")
+	}
+	intn := rand.IntN
+	if src != nil {
+		intn = rand.New(src).IntN
+	}
+	landmarks := make([]int, 0, m)
+	nearest := make([]float64, n)
+	for i := range nearest {
+		nearest[i] = math.Inf(1)
+	}
+	next := intn(n)
+	for {
+		landmarks = append(landmarks, next)
+		if len(landmarks) == m {
+			return landmarks
+		}
+		nearest[next] = math.Inf(-1)
+		far := -1
+		for i, v := range nearest {
+			if math.IsInf(v, -1) {
+				continue
+			}
+			v = math.Min(v, dist(next, i))
+			nearest[i] = v
+			if far < 0 || v > nearest[far] {
+				far = i
+			}
+		}
+		next = far
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mds
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestLandmarkScaling(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		n, m, d int
+	}{
+		{n: 50, m: 4, d: 2},
+		{n: 100, m: 10, d: 3},
+		{n: 30, m: 30, d: 2},
+	} {
+		x := randomPoints(test.n, test.d, rnd)
+		dist := func(i, j int) float64 {
+			return floats.Distance(x.RawRowView(i), x.RawRowView(j), 2)
+		}
+		idx := MaxMinLandmarks(test.n, test.m, dist, rand.NewPCG(1, 1))
+		landmarks := mat.NewSymDense(test.m, nil)
+		for i, a := range idx {
+			for j, b := range idx[i+1:] {
+				landmarks.SetSym(i, i+1+j, dist(a, b))
+			}
+		}
+		dis := mat.NewDense(test.m, test.n, nil)
+		for i, a := range idx {
+			for j := 0; j < test.n; j++ {
+				dis.Set(i, j, dist(a, j))
+			}
+		}
+
+		var got mat.Dense
+		k := LandmarkScaling(&got, landmarks, dis, test.d)
+		if k != test.d {
+			t.Errorf("unexpected number of dimensions for n=%d m=%d: got:%d want:%d", test.n, test.m, k, test.d)
+			continue
+		}
+		// Euclidean data is embedded exactly.
+		want := euclideanDistances(x)
+		if gotDis := euclideanDistances(&got); !mat.EqualApprox(gotDis, want, 1e-8) {
+			t.Errorf("unexpected distances for n=%d m=%d", test.n, test.m)
+		}
+	}
+}
+
+func TestMaxMinLandmarks(t *testing.T) {
+	t.Parallel()
+	pts := []float64{0, 1, 2, 10, 11, 20, 4}
+	dist := func(i, j int) float64 { return abs(pts[i] - pts[j]) }
+	for seed := uint64(0); seed < 10; seed++ {
+		got := MaxMinLandmarks(len(pts), len(pts), dist, rand.NewPCG(seed, seed))
+		sorted := slices.Clone(got)
+		slices.Sort(sorted)
+		if !slices.Equal(sorted, []int{0, 1, 2, 3, 4, 5, 6}) {
+			t.Errorf("landmarks not a permutation: %v", got)
+		}
+		// The second landmark is the farthest from the first.
+		first := got[0]
+		for i := range pts {
+			if dist(first, i) > dist(first, got[1]) {
+				t.Errorf("second landmark %d not farthest from %d", got[1], first)
+			}
+		}
+	}
+}
+
+func abs(x float64) float64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,279 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mds
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// SMACOFSettings holds the settings for SMACOF.
+type SMACOFSettings struct {
+	// NonMetric specifies that only the rank
+	// order of the dissimilarities is to be
+	// preserved. The disparities are then found
+	// by isotonic regression of the distances on
+	// the dissimilarities at each iteration.
+	NonMetric bool
+
+	// MaxIterations is the maximum number of
+	// iterations. If MaxIterations is zero,
+	// 300 is used.
+	MaxIterations int
+
+	// Tolerance is the threshold on the decrease
+	// of the normalized stress, the sum of squared
+	// differences between the disparities and the
+	// distances divided by the sum of squared
+	// disparities, below which the iteration is
+	// considered converged. If Tolerance is zero,
+	// 1e-6 is used.
+	Tolerance float64
+
+	// Init is the initial configuration. If Init
+	// is nil, the classical scaling returned by
+	// TorgersonScaling is used.
+	Init mat.Matrix
+}
+
+var (
+	errNotConverged  = errors.New("mds: iteration limit reached")
+	errInitFailed    = errors.New("mds: initial scaling failed")
+	errDegenerateDis = errors.New("mds: all dissimilarities are zero")
+)
+
+// SMACOF performs metric or non-metric multidimensional scaling of the
+// dissimilarity matrix dis into k dimensions by minimizing the stress
+// using the SMACOF majorization algorithm. SMACOF places the coordinates
+// of the configuration into the rows of dst, resizing it to n×k, and
+// returns the Kruskal stress-1 of the configuration,
+//
+//	sqrt(Σ_{i<j} (d̂_ij - d_ij)² / Σ_{i<j} d_ij²)
+//
+// where d_ij are the distances in the configuration and d̂_ij are the
+// disparities: the dissimilarities for metric scaling, or the monotone
+// regression of the distances on the dissimilarities for non-metric
+// scaling. Tied dissimilarities are given equal disparities. If settings
+// is nil, default settings are used.
+//
+// SMACOF returns an error if the initial classical scaling fails or if the
+// iteration limit is reached before convergence. In the latter case dst
+// holds the final configuration.
+//
+// See de Leeuw, "Applications of convex analysis to multidimensional
+// scaling", Recent Developments in Statistics 133-145 and Borg and Groenen,
+// "Modern Multidimensional Scaling", Springer 2005.
+// doi:10.1007/0-387-28981-X for details of the algorithm.
+//
+// SMACOF will panic if dst is not empty, if k is less than one, or if
+// settings.Init is not nil and is not n×k.
+func SMACOF(dst *mat.Dense, dis mat.Symmetric, k int, settings *SMACOFSettings) (stress float64, err error) {
+	if !dst.IsEmpty() {
+		panic("mds: receiver matrix not empty")
+	}
+	if k < 1 {
+		panic("mds: invalid number of dimensions")
+	}
+	var s SMACOFSettings
+	if settings != nil {
+		s = *settings
+	}
+	if s.MaxIterations == 0 {
+		s.MaxIterations = 300
+	}
+	if s.Tolerance == 0 {
+		s.Tolerance = 1e-6
+	}
+
+	n := dis.SymmetricDim()
+	dst.ReuseAs(n, k)
+	if s.Init != nil {
+		if r, c := s.Init.Dims(); r != n || c != k {
+			panic(mat.ErrShape)
+		}
+		dst.Copy(s.Init)
+	} else {
+		var tc mat.Dense
+		kt, _ := TorgersonScaling(&tc, nil, dis)
+		if kt == 0 {
+			return math.NaN(), errInitFailed
+		}
+		dst.Copy(&tc)
+	}
+	if n < 2 {
+		return 0, nil
+	}
+
+	// Work with the dissimilarities and
+	// distances in condensed form.
+	m := n * (n - 1) / 2
+	delta := make([]float64, m)
+	var sumSq float64
+	for i, p := 0, 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			delta[p] = dis.At(i, j)
+			sumSq += delta[p] * delta[p]
+			p++
+		}
+	}
+	if sumSq == 0 {
+		return math.NaN(), errDegenerateDis
+	}
+	hat := delta
+	var order []int
+	if s.NonMetric {
+		hat = make([]float64, m)
+		order = make([]int, m)
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(i, j int) bool { return delta[order[i]] < delta[order[j]] })
+	}
+
+	// The raw stress is monotonically non-increasing
+	// over iterations, so it is used to determine
+	// convergence after normalization by the sum of
+	// squared disparities.
+	norm := sumSq
+	if s.NonMetric {
+		norm = float64(m)
+	}
+	d := make([]float64, m)
+	next := mat.NewDense(n, k, nil)
+	distances(d, dst)
+	raw := disparities(hat, d, delta, order, m)
+	for it := 0; it < s.MaxIterations; it++ {
+		guttman(next, dst, hat, d)
+		dst.Copy(next)
+		distances(d, dst)
+		prev := raw
+		raw = disparities(hat, d, delta, order, m)
+		if prev-raw <= s.Tolerance*norm {
+			return kruskal(hat, d), nil
+		}
+	}
+	return kruskal(hat, d), errNotConverged
+}
+
+// kruskal returns the Kruskal stress-1 of the distances d
+// for the disparities hat.
+func kruskal(hat, d []float64) float64 {
+	var num, den float64
+	for i, v := range d {
+		r := hat[i] - v
+		num += r * r
+		den += v * v
+	}
+	if den == 0 {
+		return math.Inf(1)
+	}
+	return math.Sqrt(num / den)
+}
+
+// distances places the Euclidean distances between the rows of x into
+// d in condensed form.
+func distances(d []float64, x *mat.Dense) {
+	n, _ := x.Dims()
+	p := 0
+	for i := 0; i < n; i++ {
+		xi := x.RawRowView(i)
+		for j := i + 1; j < n; j++ {
+			xj := x.RawRowView(j)
+			var sum float64
+			for c, v := range xi {
+				v -= xj[c]
+				sum += v * v
+			}
+			d[p] = math.Sqrt(sum)
+			p++
+		}
+	}
+}
+
+// disparities updates the disparities in hat for the distances d and
+// returns the raw stress, the sum of squared differences between the
+// disparities and the distances. If order is nil the disparities are the
+// dissimilarities delta, otherwise they are the isotonic regression of
+// d on the dissimilarities in the order given, normalized so that their
+// sum of squares is m.
+func disparities(hat, d, delta []float64, order []int, m int) float64 {
+	if order != nil {
+		monotone(hat, d, delta, order)
+		var ss float64
+		for _, v := range hat {
+			ss += v * v
+		}
+		if ss > 0 {
+			f := math.Sqrt(float64(m) / ss)
+			for i := range hat {
+				hat[i] *= f
+			}
+		}
+	}
+	var raw float64
+	for i, v := range d {
+		r := hat[i] - v
+		raw += r * r
+	}
+	return raw
+}
+
+// monotone places the least squares monotone regression of d on the
+// dissimilarities delta, visited in the given increasing order, into hat
+// using the pool adjacent violators algorithm. Tied dissimilarities are
+// pooled before the regression.
+func monotone(hat, d, delta []float64, order []int) {
+	type block struct {
+		start, end int
+		sum        float64
+	}
+	mean := func(b block) float64 { return b.sum / float64(b.end-b.start) }
+	var stack []block
+	for i := 0; i < len(order); {
+		// Collect tied dissimilarities into an initial block.
+		b := block{start: i}
+		for i < len(order) && delta[order[i]] == delta[order[b.start]] {
+			b.sum += d[order[i]]
+			i++
+		}
+		b.end = i
+		for len(stack) != 0 && mean(stack[len(stack)-1]) >= mean(b) {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			b = block{start: top.start, end: b.end, sum: top.sum + b.sum}
+		}
+		stack = append(stack, b)
+	}
+	for _, b := range stack {
+		v := mean(b)
+		for _, p := range order[b.start:b.end] {
+			hat[p] = v
+		}
+	}
+}
+
+// guttman places the Guttman transform of x for the disparities hat and
+// the distances d, both in condensed form, into dst.
+func guttman(dst, x *mat.Dense, hat, d []float64) {
+	n, _ := x.Dims()
+	b := mat.NewSymDense(n, nil)
+	p := 0
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if d[p] > 0 {
+				v := -hat[p] / d[p]
+				b.SetSym(i, j, v)
+				b.SetSym(i, i, b.At(i, i)-v)
+				b.SetSym(j, j, b.At(j, j)-v)
+			}
+			p++
+		}
+	}
+	dst.Mul(b, x)
+	dst.Scale(1/float64(n), dst)
+}
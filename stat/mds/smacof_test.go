@@ -0,0 +1,196 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mds
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// randomPoints returns n random points in d dimensions.
+func randomPoints(n, d int, rnd *rand.Rand) *mat.Dense {
+	x := mat.NewDense(n, d, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < d; j++ {
+			x.Set(i, j, 10*rnd.Float64())
+		}
+	}
+	return x
+}
+
+// euclideanDistances returns the Euclidean distance matrix
+// between the rows of x.
+func euclideanDistances(x mat.Matrix) *mat.SymDense {
+	n, _ := x.Dims()
+	d := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			d.SetSym(i, j, floats.Distance(mat.Row(nil, i, x), mat.Row(nil, j, x), 2))
+		}
+	}
+	return d
+}
+
+func TestSMACOFMetric(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, d := range []int{1, 2, 3} {
+		x := randomPoints(20, d, rnd)
+		dis := euclideanDistances(x)
+
+		// Start from a random configuration so that the
+		// iteration does not begin at the solution.
+		init := randomPoints(20, d, rnd)
+		var got mat.Dense
+		stress, err := SMACOF(&got, dis, d, &SMACOFSettings{Init: init, MaxIterations: 5000, Tolerance: 1e-12})
+		if err != nil {
+			t.Errorf("unexpected error for d=%d: %v", d, err)
+		}
+		// One-dimensional scaling may have local minima.
+		if d > 1 && stress > 1e-4 {
+			t.Errorf("unexpected stress for d=%d: got:%v want:0", d, stress)
+		}
+		if d == 1 {
+			continue
+		}
+		gotDis := euclideanDistances(&got)
+		if !mat.EqualApprox(gotDis, dis, 1e-2) {
+			t.Errorf("unexpected distances for d=%d", d)
+		}
+	}
+}
+
+func TestSMACOFTorgersonInit(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	x := randomPoints(15, 2, rnd)
+	dis := euclideanDistances(x)
+	var got mat.Dense
+	stress, err := SMACOF(&got, dis, 2, nil)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if stress > 1e-6 {
+		t.Errorf("unexpected stress: got:%v want:0", stress)
+	}
+	if r, c := got.Dims(); r != 15 || c != 2 {
+		t.Errorf("unexpected dimensions: got:%d×%d want:15×2", r, c)
+	}
+}
+
+func TestSMACOFNonMetric(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 20
+	x := randomPoints(n, 2, rnd)
+	dis := euclideanDistances(x)
+
+	// A monotone transformation of the distances
+	// only preserves their rank order.
+	transformed := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			transformed.SetSym(i, j, math.Exp(dis.At(i, j)/3))
+		}
+	}
+
+	var metric mat.Dense
+	metricStress, _ := SMACOF(&metric, transformed, 2, nil)
+	var got mat.Dense
+	stress, err := SMACOF(&got, transformed, 2, &SMACOFSettings{NonMetric: true, MaxIterations: 2000})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if stress > 0.05 {
+		t.Errorf("unexpected non-metric stress: got:%v want:<0.05", stress)
+	}
+	if stress >= metricStress {
+		t.Errorf("non-metric stress not less than metric stress: %v >= %v", stress, metricStress)
+	}
+
+	// Check the rank correlation between the
+	// recovered and the true distances.
+	gotDis := euclideanDistances(&got)
+	var a, b []float64
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			a = append(a, dis.At(i, j))
+			b = append(b, gotDis.At(i, j))
+		}
+	}
+	if rho := spearman(a, b); rho < 0.99 {
+		t.Errorf("unexpected rank correlation: got:%v want:>0.99", rho)
+	}
+}
+
+func spearman(a, b []float64) float64 {
+	rank := func(x []float64) []float64 {
+		idx := make([]int, len(x))
+		for i := range idx {
+			idx[i] = i
+		}
+		sort.Slice(idx, func(i, j int) bool { return x[idx[i]] < x[idx[j]] })
+		r := make([]float64, len(x))
+		for i, j := range idx {
+			r[j] = float64(i)
+		}
+		return r
+	}
+	ra, rb := rank(a), rank(b)
+	n := float64(len(a))
+	var sum float64
+	for i := range ra {
+		d := ra[i] - rb[i]
+		sum += d * d
+	}
+	return 1 - 6*sum/(n*(n*n-1))
+}
+
+func TestMonotone(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		d, delta []float64
+		want     []float64
+	}{
+		{
+			d:     []float64{1, 3, 2, 4, 3.5, 5},
+			delta: []float64{1, 2, 3, 4, 5, 6},
+			want:  []float64{1, 2.5, 2.5, 3.75, 3.75, 5},
+		},
+		{
+			// Reversed input order.
+			d:     []float64{5, 3.5, 4, 2, 3, 1},
+			delta: []float64{6, 5, 4, 3, 2, 1},
+			want:  []float64{5, 3.75, 3.75, 2.5, 2.5, 1},
+		},
+		{
+			// Tied dissimilarities are pooled.
+			d:     []float64{1, 3, 2},
+			delta: []float64{1, 2, 2},
+			want:  []float64{1, 2.5, 2.5},
+		},
+		{
+			d:     []float64{3, 2, 1},
+			delta: []float64{1, 2, 3},
+			want:  []float64{2, 2, 2},
+		},
+	} {
+		order := make([]int, len(test.delta))
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(i, j int) bool { return test.delta[order[i]] < test.delta[order[j]] })
+		got := make([]float64, len(test.d))
+		monotone(got, test.d, test.delta, order)
+		if !floats.EqualApprox(got, test.want, 1e-14) {
+			t.Errorf("unexpected monotone regression: got:%v want:%v", got, test.want)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math"
+	"sort"
+)
+
+// Adjustment specifies a procedure for adjusting p-values for multiple
+// comparisons.
+type Adjustment int
+
+const (
+	// Bonferroni is the Bonferroni correction controlling
+	// the family-wise error rate, multiplying each p-value
+	// by the number of tests.
+	Bonferroni Adjustment = iota
+	// Holm is Holm's step-down procedure controlling the
+	// family-wise error rate. It is uniformly more powerful
+	// than the Bonferroni correction.
+	Holm
+	// Hochberg is Hochberg's step-up procedure controlling
+	// the family-wise error rate for independent or
+	// positively dependent tests.
+	Hochberg
+	// BenjaminiHochberg is the Benjamini-Hochberg
+	// procedure controlling the false discovery rate for
+	// independent or positively dependent tests.
+	BenjaminiHochberg
+	// BenjaminiYekutieli is the Benjamini-Yekutieli
+	// procedure controlling the false discovery rate
+	// under arbitrary dependence between the tests.
+	BenjaminiYekutieli
+)
+
+// AdjustPValues stores in dst the p-values in p adjusted for multiple
+// comparisons using the given procedure and returns the result. A hypothesis
+// is rejected at level α by the procedure if its adjusted p-value is not
+// greater than α. The adjusted p-values are those returned by the p.adjust
+// function of R. If dst is nil, a new slice is allocated. dst and p may be
+// the same slice.
+//
+// For the m p-values sorted in increasing order, p₍₁₎ ≤ … ≤ p₍ₘ₎, the
+// adjusted p-values are
+//
+//	Bonferroni:          min(1, m p₍ᵢ₎)
+//	Holm:                max_{j ≤ i} min(1, (m - j + 1) p₍ⱼ₎)
+//	Hochberg:            min_{j ≥ i} min(1, (m - j + 1) p₍ⱼ₎)
+//	BenjaminiHochberg:   min_{j ≥ i} min(1, m p₍ⱼ₎ / j)
+//	BenjaminiYekutieli:  min_{j ≥ i} min(1, c(m) m p₍ⱼ₎ / j)
+//
+// where c(m) = \sum_{k=1}^m 1/k.
+//
+// AdjustPValues will panic if dst is not nil and its length is not equal to
+// the length of p, any p-value is not in [0, 1], or method is not a known
+// Adjustment.
+func AdjustPValues(dst, p []float64, method Adjustment) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(p))
+	} else if len(dst) != len(p) {
+		panic("stat: slice length mismatch")
+	}
+	checkPValues(p)
+	m := len(p)
+	idx := sortedPValues(p)
+	adj := make([]float64, m)
+	fm := float64(m)
+	switch method {
+	case Bonferroni:
+		for i, v := range p {
+			adj[i] = min(1, fm*v)
+		}
+		copy(dst, adj)
+		return dst
+	case Holm:
+		var prev float64
+		for i, j := range idx {
+			prev = max(prev, min(1, (fm-float64(i))*p[j]))
+			adj[j] = prev
+		}
+	case Hochberg:
+		prev := 1.0
+		for i := m - 1; i >= 0; i-- {
+			j := idx[i]
+			prev = min(prev, (fm-float64(i))*p[j])
+			adj[j] = prev
+		}
+	case BenjaminiHochberg, BenjaminiYekutieli:
+		c := 1.0
+		if method == BenjaminiYekutieli {
+			c = 0
+			for k := 1; k <= m; k++ {
+				c += 1 / float64(k)
+			}
+		}
+		prev := 1.0
+		for i := m - 1; i >= 0; i-- {
+			j := idx[i]
+			prev = min(prev, c*fm*p[j]/float64(i+1))
+			adj[j] = prev
+		}
+	default:
+		panic("stat: unknown p-value adjustment")
+	}
+	copy(dst, adj)
+	return dst
+}
+
+// QValues stores in dst the q-values of the p-values in p and returns the
+// result with the estimate of the proportion of true null hypotheses used
+// to compute them. The q-value of a test is the minimum false discovery
+// rate at which it is declared significant, estimated by
+//
+//	q₍ᵢ₎ = min_{j ≥ i} min(1, π₀ m p₍ⱼ₎ / j)
+//
+// for the m p-values sorted in increasing order, where π₀ is the estimate
+// returned by NullProportion for the tuning parameter lambda. With lambda
+// equal to zero, π₀ is one and the q-values are the p-values adjusted by the
+// Benjamini-Hochberg procedure. If dst is nil, a new slice is allocated. dst
+// and p may be the same slice.
+//
+// See Storey, J. D. (2002) A direct approach to false discovery rates.
+// Journal of the Royal Statistical Society: Series B 64(3) and Storey, J. D.
+// and Tibshirani, R. (2003) Statistical significance for genomewide
+// studies. PNAS 100(16) for details.
+//
+// QValues will panic if dst is not nil and its length is not equal to the
+// length of p, any p-value is not in [0, 1], or lambda is not in [0, 1).
+func QValues(dst, p []float64, lambda float64) (q []float64, pi0 float64) {
+	if dst == nil {
+		dst = make([]float64, len(p))
+	} else if len(dst) != len(p) {
+		panic("stat: slice length mismatch")
+	}
+	pi0 = NullProportion(p, lambda)
+	m := len(p)
+	idx := sortedPValues(p)
+	adj := make([]float64, m)
+	prev := 1.0
+	for i := m - 1; i >= 0; i-- {
+		j := idx[i]
+		prev = min(prev, pi0*float64(m)*p[j]/float64(i+1))
+		adj[j] = prev
+	}
+	copy(dst, adj)
+	return dst, pi0
+}
+
+// NullProportion returns Storey's estimate of the proportion of true null
+// hypotheses among the tests with the p-values in p,
+//
+//	π₀ = #{p_i > λ} / (m (1 - λ)),
+//
+// truncated to be at most one, based on the uniform distribution of the
+// p-values of true null hypotheses. Larger values of lambda reduce the bias
+// of the estimate and increase its variance. If p is empty, NullProportion
+// returns one.
+//
+// NullProportion will panic if any p-value is not in [0, 1] or lambda is not
+// in [0, 1).
+func NullProportion(p []float64, lambda float64) float64 {
+	if !(0 <= lambda && lambda < 1) {
+		panic("stat: lambda out of range")
+	}
+	checkPValues(p)
+	if len(p) == 0 {
+		return 1
+	}
+	var n int
+	for _, v := range p {
+		if v > lambda {
+			n++
+		}
+	}
+	return math.Min(1, float64(n)/(float64(len(p))*(1-lambda)))
+}
+
+// checkPValues panics if any element of p is not a valid p-value.
+func checkPValues(p []float64) {
+	for _, v := range p {
+		if !(0 <= v && v <= 1) {
+			panic("stat: p-value out of range")
+		}
+	}
+}
+
+// sortedPValues returns the indices of the p-values in p in order of
+// increasing p-value.
+func sortedPValues(p []float64) []int {
+	idx := make([]int, len(p))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(a, b int) bool { return p[idx[a]] < p[idx[b]] })
+	return idx
+}
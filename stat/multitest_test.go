@@ -0,0 +1,150 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestAdjustPValues(t *testing.T) {
+	t.Parallel()
+	p := []float64{0.01, 0.04, 0.03, 0.005, 0.2, 0.04, 0.5}
+	for _, test := range []struct {
+		method Adjustment
+		want   []float64
+	}{
+		{method: Bonferroni, want: []float64{0.07, 0.28, 0.21, 0.035, 1, 0.28, 1}},
+		{method: Holm, want: []float64{0.06, 0.16, 0.15, 0.035, 0.4, 0.16, 0.5}},
+		{method: Hochberg, want: []float64{0.06, 0.12, 0.12, 0.035, 0.4, 0.12, 0.5}},
+		{method: BenjaminiHochberg, want: []float64{0.035, 0.056, 0.056, 0.035, 0.7 / 3, 0.056, 0.5}},
+		{method: BenjaminiYekutieli, want: []float64{0.09075, 0.1452, 0.1452, 0.09075, 0.605, 0.1452, 1}},
+	} {
+		got := AdjustPValues(nil, p, test.method)
+		if !floats.EqualApprox(got, test.want, 1e-12) {
+			t.Errorf("unexpected adjusted p-values for method %d: got:%v want:%v", test.method, got, test.want)
+		}
+
+		// Check that dst may alias p.
+		dst := slices.Clone(p)
+		AdjustPValues(dst, dst, test.method)
+		if !floats.EqualApprox(dst, test.want, 1e-12) {
+			t.Errorf("unexpected adjusted p-values in place for method %d: got:%v want:%v", test.method, dst, test.want)
+		}
+
+		if got := AdjustPValues(nil, nil, test.method); len(got) != 0 {
+			t.Errorf("unexpected adjusted p-values for no tests: %v", got)
+		}
+		if got := AdjustPValues(nil, []float64{0.3}, test.method); got[0] != 0.3 {
+			t.Errorf("unexpected adjusted p-value for a single test for method %d: got:%v want:0.3", test.method, got[0])
+		}
+	}
+
+	// The adjusted p-values are ordered as the
+	// p-values and not less than them, and the
+	// procedures are ordered by power.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	p = make([]float64, 50)
+	for i := range p {
+		p[i] = rnd.Float64() * rnd.Float64()
+	}
+	adj := make(map[Adjustment][]float64)
+	for _, method := range []Adjustment{Bonferroni, Holm, Hochberg, BenjaminiHochberg, BenjaminiYekutieli} {
+		a := AdjustPValues(nil, p, method)
+		for i := range p {
+			if a[i] < p[i] || 1 < a[i] {
+				t.Errorf("adjusted p-value out of range for method %d: p=%v adjusted=%v", method, p[i], a[i])
+			}
+			for j := range p {
+				if p[i] < p[j] && a[i] > a[j] {
+					t.Errorf("adjusted p-values not monotonic for method %d", method)
+				}
+			}
+		}
+		adj[method] = a
+	}
+	for i := range p {
+		if !(adj[Bonferroni][i] >= adj[Holm][i] && adj[Holm][i] >= adj[Hochberg][i] && adj[Hochberg][i] >= adj[BenjaminiHochberg][i]) {
+			t.Errorf("adjusted p-values not ordered by procedure power at %d", i)
+		}
+		if adj[BenjaminiYekutieli][i] < adj[BenjaminiHochberg][i] {
+			t.Errorf("Benjamini-Yekutieli adjusted p-value less than Benjamini-Hochberg at %d", i)
+		}
+	}
+
+	if !panics(func() { AdjustPValues(make([]float64, 2), p, Holm) }) {
+		t.Errorf("expected panic for dst length mismatch")
+	}
+	if !panics(func() { AdjustPValues(nil, []float64{0.5, 1.5}, Holm) }) {
+		t.Errorf("expected panic for p-value out of range")
+	}
+	if !panics(func() { AdjustPValues(nil, []float64{0.5, -0.1}, Bonferroni) }) {
+		t.Errorf("expected panic for negative p-value")
+	}
+	if !panics(func() { AdjustPValues(nil, p, Adjustment(-1)) }) {
+		t.Errorf("expected panic for unknown method")
+	}
+}
+
+func TestQValues(t *testing.T) {
+	t.Parallel()
+	p := []float64{0.01, 0.04, 0.03, 0.005, 0.2, 0.04, 0.5, 0.8, 0.9, 0.7}
+
+	// With lambda zero, the q-values are the
+	// Benjamini-Hochberg adjusted p-values.
+	q, pi0 := QValues(nil, p, 0)
+	if pi0 != 1 {
+		t.Errorf("unexpected null proportion for lambda zero: got:%v want:1", pi0)
+	}
+	want := AdjustPValues(nil, p, BenjaminiHochberg)
+	if !floats.EqualApprox(q, want, 1e-14) {
+		t.Errorf("unexpected q-values for lambda zero: got:%v want:%v", q, want)
+	}
+
+	// Three p-values exceed 0.5.
+	q, pi0 = QValues(nil, p, 0.5)
+	if wantPi0 := 3 / (10 * 0.5); pi0 != wantPi0 {
+		t.Errorf("unexpected null proportion: got:%v want:%v", pi0, wantPi0)
+	}
+	for i := range want {
+		want[i] = pi0 * AdjustPValues(nil, p, BenjaminiHochberg)[i]
+	}
+	if !floats.EqualApprox(q, want, 1e-14) {
+		t.Errorf("unexpected q-values: got:%v want:%v", q, want)
+	}
+
+	// The estimate of the null proportion
+	// is truncated at one.
+	if got := NullProportion([]float64{0.9, 0.95, 0.1}, 0.5); got != 1 {
+		t.Errorf("unexpected truncated null proportion: got:%v want:1", got)
+	}
+	if got := NullProportion(nil, 0.5); got != 1 {
+		t.Errorf("unexpected null proportion for no tests: got:%v want:1", got)
+	}
+
+	// The null proportion of a mixture of uniform
+	// p-values and p-values near zero is estimated.
+	rnd := rand.New(rand.NewPCG(2, 2))
+	p = make([]float64, 10000)
+	for i := range p {
+		p[i] = rnd.Float64()
+		if i%5 == 0 {
+			p[i] *= 1e-3
+		}
+	}
+	if got := NullProportion(p, 0.5); got < 0.77 || 0.83 < got {
+		t.Errorf("unexpected null proportion estimate: got:%v want:0.8", got)
+	}
+
+	if !panics(func() { NullProportion(p, 1) }) {
+		t.Errorf("expected panic for lambda out of range")
+	}
+	if !panics(func() { QValues(make([]float64, 2), p, 0.5) }) {
+		t.Errorf("expected panic for dst length mismatch")
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pairwise
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Metric is a distance between observations.
+type Metric interface {
+	// Distance returns the distance between a and b.
+	// Distance will panic if the lengths of a and b
+	// differ.
+	Distance(a, b []float64) float64
+}
+
+// Euclidean is the Euclidean distance.
+//
+// Distance matrices for Euclidean are computed using
+//
+//	‖a - b‖² = ‖a‖² + ‖b‖² - 2 a·b
+//
+// so that the bulk of the work is a matrix multiplication. Distances
+// between nearly identical observations computed this way have an
+// absolute error on the order of the square root of machine epsilon
+// times the norm of the observations.
+type Euclidean struct{}
+
+// Distance returns the Euclidean distance between a and b.
+func (Euclidean) Distance(a, b []float64) float64 {
+	if len(a) != len(b) {
+		panic(errLength)
+	}
+	return floats.Distance(a, b, 2)
+}
+
+// Manhattan is the Manhattan, or L1, distance.
+type Manhattan struct{}
+
+// Distance returns the Manhattan distance between a and b.
+func (Manhattan) Distance(a, b []float64) float64 {
+	if len(a) != len(b) {
+		panic(errLength)
+	}
+	return floats.Distance(a, b, 1)
+}
+
+// Cosine is the cosine distance, one minus the cosine of the angle
+// between the observations. The distance between a zero vector and
+// any other vector is one.
+//
+// Distance matrices for Cosine are computed using a matrix
+// multiplication of the normalized observations.
+type Cosine struct{}
+
+// Distance returns the cosine distance between a and b.
+func (Cosine) Distance(a, b []float64) float64 {
+	if len(a) != len(b) {
+		panic(errLength)
+	}
+	na := floats.Norm(a, 2)
+	nb := floats.Norm(b, 2)
+	if na == 0 || nb == 0 {
+		return 1
+	}
+	return clampCosine(1 - floats.Dot(a, b)/(na*nb))
+}
+
+// clampCosine clamps a cosine distance to its valid range.
+func clampCosine(d float64) float64 {
+	return math.Min(math.Max(d, 0), 2)
+}
+
+// Hamming is the Hamming distance, the proportion of elements that
+// differ between the observations.
+type Hamming struct{}
+
+// Distance returns the Hamming distance between a and b. The distance
+// between two empty observations is zero.
+func (Hamming) Distance(a, b []float64) float64 {
+	if len(a) != len(b) {
+		panic(errLength)
+	}
+	if len(a) == 0 {
+		return 0
+	}
+	var n int
+	for i, v := range a {
+		if v != b[i] {
+			n++
+		}
+	}
+	return float64(n) / float64(len(a))
+}
+
+// Mahalanobis is the Mahalanobis distance
+//
+//	sqrt((a - b)ᵀ Σ⁻¹ (a - b))
+//
+// where Σ is the covariance matrix represented by its Cholesky
+// decomposition.
+//
+// Distance matrices for Mahalanobis are computed by whitening the
+// observations and computing Euclidean distances, and have the same
+// accuracy properties as Euclidean.
+type Mahalanobis struct {
+	Chol *mat.Cholesky
+}
+
+// Distance returns the Mahalanobis distance between a and b. Distance
+// will panic if the length of a does not match the dimension of the
+// covariance matrix.
+func (m Mahalanobis) Distance(a, b []float64) float64 {
+	if len(a) != len(b) {
+		panic(errLength)
+	}
+	if len(a) != m.Chol.SymmetricDim() {
+		panic(errDimension)
+	}
+	diff := make([]float64, len(a))
+	floats.SubTo(diff, a, b)
+	var u mat.TriDense
+	m.Chol.UTo(&u)
+	// Solve Uᵀ z = a - b so that ‖z‖² = (a - b)ᵀ Σ⁻¹ (a - b).
+	var z mat.VecDense
+	err := z.SolveVec(u.T(), mat.NewVecDense(len(diff), diff))
+	if err != nil {
+		return math.NaN()
+	}
+	return mat.Norm(&z, 2)
+}
@@ -0,0 +1,283 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pairwise
+
+import (
+	"math"
+	"runtime"
+	"sync"
+
+	"gonum.org/v1/gonum/blas/gonum"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	errLength    = "pairwise: length mismatch"
+	errDimension = "pairwise: dimension mismatch"
+)
+
+// blockSize is the side length of the square blocks of the
+// distance matrix computed by each unit of work.
+const blockSize = 64
+
+// Distances places the distances between the rows of x and the rows of y
+// under the metric m into dst, so that dst[i, j] holds the distance between
+// row i of x and row j of y. If y is nil, the distances between the rows of
+// x are computed, the diagonal is set to zero and only the upper triangle is
+// computed and then reflected. If dst is empty it is resized to the number
+// of rows of x by the number of rows of y.
+//
+// The distance matrix is computed in blocks concurrently. The Euclidean,
+// Cosine and Mahalanobis metrics are computed using matrix multiplication;
+// other metrics call the Distance method for each pair of observations.
+//
+// Distances will panic if x and y do not have the same number of columns
+// or if dst is not empty and has the wrong shape.
+func Distances(dst *mat.Dense, x, y mat.Matrix, m Metric) {
+	r, c := x.Dims()
+	sym := y == nil
+	if sym {
+		y = x
+	}
+	ry, cy := y.Dims()
+	if c != cy {
+		panic(errDimension)
+	}
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, ry)
+	} else if dr, dc := dst.Dims(); dr != r || dc != ry {
+		panic(mat.ErrShape)
+	}
+	if r == 0 || ry == 0 {
+		return
+	}
+
+	set := func(i, j int, v float64) { dst.Set(i, j, v) }
+	compute(set, x, y, sym, m)
+	if sym {
+		for i := 0; i < r; i++ {
+			dst.Set(i, i, 0)
+			for j := i + 1; j < r; j++ {
+				dst.Set(j, i, dst.At(i, j))
+			}
+		}
+	}
+}
+
+// Condensed returns the distances between the rows of x under the metric m
+// in condensed form, the upper triangle of the distance matrix excluding the
+// diagonal in row-major order. The distance between rows i and j, with i < j,
+// is stored at CondensedIndex(n, i, j) where n is the number of rows of x.
+// If dst is not nil, the result is stored in dst and returned, otherwise a
+// new slice is allocated.
+//
+// The condensed distances are computed as described for Distances.
+//
+// Condensed will panic if dst is not nil and its length is not n(n-1)/2.
+func Condensed(dst []float64, x mat.Matrix, m Metric) []float64 {
+	n, _ := x.Dims()
+	l := n * (n - 1) / 2
+	if dst == nil {
+		dst = make([]float64, l)
+	} else if len(dst) != l {
+		panic(errLength)
+	}
+	if n < 2 {
+		return dst
+	}
+	set := func(i, j int, v float64) {
+		if i < j {
+			dst[CondensedIndex(n, i, j)] = v
+		}
+	}
+	compute(set, x, x, true, m)
+	return dst
+}
+
+// CondensedIndex returns the index into a condensed distance matrix of n
+// observations holding the distance between observations i and j. The
+// order of i and j is not significant. CondensedIndex will panic if i
+// equals j or if either is out of range.
+func CondensedIndex(n, i, j int) int {
+	if i < 0 || n <= i || j < 0 || n <= j {
+		panic("pairwise: index out of range")
+	}
+	if i == j {
+		panic("pairwise: diagonal index")
+	}
+	if i > j {
+		i, j = j, i
+	}
+	return i*(2*n-i-1)/2 + j - i - 1
+}
+
+// compute calls set with the distance between each pair of rows of x and
+// y. If sym is true, x and y are the same and set is only called for pairs
+// with i <= j. Calls to set are made concurrently, but never concurrently
+// for the same pair.
+func compute(set func(i, j int, v float64), x, y mat.Matrix, sym bool, m Metric) {
+	switch m := m.(type) {
+	case Euclidean:
+		gram(set, mat.DenseCopyOf(x), mat.DenseCopyOf(y), sym, euclidean)
+	case Cosine:
+		gram(set, normalized(x), normalized(y), sym, cosine)
+	case Mahalanobis:
+		_, c := x.Dims()
+		if c != m.Chol.SymmetricDim() {
+			panic(errDimension)
+		}
+		xw := whiten(x, m.Chol)
+		yw := xw
+		if !sym {
+			yw = whiten(y, m.Chol)
+		}
+		gram(set, xw, yw, sym, euclidean)
+	default:
+		xd := mat.DenseCopyOf(x)
+		yd := xd
+		if !sym {
+			yd = mat.DenseCopyOf(y)
+		}
+		blocks(xd.RawMatrix().Rows, yd.RawMatrix().Rows, sym, func(i0, i1, j0, j1 int) {
+			for i := i0; i < i1; i++ {
+				xi := xd.RawRowView(i)
+				for j := start(i, j0, sym); j < j1; j++ {
+					set(i, j, m.Distance(xi, yd.RawRowView(j)))
+				}
+			}
+		})
+	}
+}
+
+// start returns the first column index to compute in row i of
+// a block starting at column j0.
+func start(i, j0 int, sym bool) int {
+	if sym {
+		return max(j0, i)
+	}
+	return j0
+}
+
+type gramKind int
+
+const (
+	euclidean gramKind = iota
+	cosine
+)
+
+// gram calls set with the distances between the rows of x and y computed
+// from their inner products.
+func gram(set func(i, j int, v float64), x, y *mat.Dense, sym bool, kind gramKind) {
+	r, _ := x.Dims()
+	ry, _ := y.Dims()
+	var g mat.Dense
+	g.Mul(x, y.T())
+	var nx, ny []float64
+	if kind == euclidean {
+		nx = sqNorms(x)
+		ny = nx
+		if !sym {
+			ny = sqNorms(y)
+		}
+	}
+	blocks(r, ry, sym, func(i0, i1, j0, j1 int) {
+		for i := i0; i < i1; i++ {
+			gi := g.RawRowView(i)
+			for j := start(i, j0, sym); j < j1; j++ {
+				var v float64
+				switch kind {
+				case euclidean:
+					v = math.Sqrt(math.Max(nx[i]+ny[j]-2*gi[j], 0))
+				case cosine:
+					v = clampCosine(1 - gi[j])
+				}
+				set(i, j, v)
+			}
+		}
+	})
+}
+
+// sqNorms returns the squared Euclidean norms of the rows of x.
+func sqNorms(x *mat.Dense) []float64 {
+	r, _ := x.Dims()
+	n := make([]float64, r)
+	for i := range n {
+		row := x.RawRowView(i)
+		n[i] = floats.Dot(row, row)
+	}
+	return n
+}
+
+// normalized returns a copy of x with rows scaled to unit Euclidean
+// norm. Zero rows are left unaltered.
+func normalized(x mat.Matrix) *mat.Dense {
+	xd := mat.DenseCopyOf(x)
+	r, _ := xd.Dims()
+	for i := 0; i < r; i++ {
+		row := xd.RawRowView(i)
+		if n := floats.Norm(row, 2); n != 0 {
+			floats.Scale(1/n, row)
+		}
+	}
+	return xd
+}
+
+// whiten returns x U⁻¹ where Σ = Uᵀ U is the Cholesky decomposition held
+// by chol, so that the Euclidean distances between the rows of the result
+// are the Mahalanobis distances between the rows of x.
+func whiten(x mat.Matrix, chol *mat.Cholesky) *mat.Dense {
+	var u mat.TriDense
+	chol.UTo(&u)
+	var w mat.Dense
+	// Solve Z U = X as Uᵀ Zᵀ = Xᵀ.
+	err := w.Solve(u.T(), mat.DenseCopyOf(x).T())
+	if err != nil {
+		if _, ok := err.(mat.Condition); !ok {
+			panic(err)
+		}
+	}
+	return mat.DenseCopyOf(w.T())
+}
+
+// blocks calls fn concurrently for each block of the r×c index space
+// covering [i0, i1)×[j0, j1). If sym is true, blocks strictly below the
+// diagonal are skipped.
+func blocks(r, c int, sym bool, fn func(i0, i1, j0, j1 int)) {
+	type block struct{ i, j int }
+	work := make(chan block)
+	workers := maxWorkers()
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for b := range work {
+				fn(b.i, min(b.i+blockSize, r), b.j, min(b.j+blockSize, c))
+			}
+		}()
+	}
+	for i := 0; i < r; i += blockSize {
+		j := 0
+		if sym {
+			j = i
+		}
+		for ; j < c; j += blockSize {
+			work <- block{i: i, j: j}
+		}
+	}
+	close(work)
+	wg.Wait()
+}
+
+// maxWorkers returns the maximum number of goroutines to use
+// in a parallel operation, honouring the limit set by
+// gonum.SetMaxProcs.
+func maxWorkers() int {
+	if n := gonum.MaxProcs(); n > 0 {
+		return n
+	}
+	return runtime.GOMAXPROCS(0)
+}
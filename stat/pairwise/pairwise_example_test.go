@@ -0,0 +1,36 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pairwise_test
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/pairwise"
+)
+
+func ExampleCondensed() {
+	x := mat.NewDense(4, 2, []float64{
+		0, 0,
+		3, 4,
+		6, 8,
+		0, 1,
+	})
+	d := pairwise.Condensed(nil, x, pairwise.Euclidean{})
+	const n = 4
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			fmt.Printf("d(%d,%d) = %.4g\n", i, j, d[pairwise.CondensedIndex(n, i, j)])
+		}
+	}
+
+	// Output:
+	// d(0,1) = 5
+	// d(0,2) = 10
+	// d(0,3) = 1
+	// d(1,2) = 5
+	// d(1,3) = 4.243
+	// d(2,3) = 9.22
+}
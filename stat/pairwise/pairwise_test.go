@@ -0,0 +1,162 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pairwise
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+)
+
+func randomMatrix(r, c int, discrete bool, rnd *rand.Rand) *mat.Dense {
+	m := mat.NewDense(r, c, nil)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			v := rnd.NormFloat64()
+			if discrete {
+				v = float64(rnd.IntN(3))
+			}
+			m.Set(i, j, v)
+		}
+	}
+	return m
+}
+
+func testMetrics(t *testing.T, c int, rnd *rand.Rand) []Metric {
+	a := randomMatrix(c, c, false, rnd)
+	var cov mat.SymDense
+	cov.SymOuterK(1, a)
+	for i := 0; i < c; i++ {
+		cov.SetSym(i, i, cov.At(i, i)+1)
+	}
+	var chol mat.Cholesky
+	if !chol.Factorize(&cov) {
+		t.Fatal("unexpected Cholesky factorization failure")
+	}
+	return []Metric{Euclidean{}, Manhattan{}, Cosine{}, Hamming{}, Mahalanobis{Chol: &chol}}
+}
+
+func TestMahalanobis(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const c = 4
+	m := testMetrics(t, c, rnd)[4].(Mahalanobis)
+	for i := 0; i < 10; i++ {
+		a := randomMatrix(1, c, false, rnd).RawRowView(0)
+		b := randomMatrix(1, c, false, rnd).RawRowView(0)
+		got := m.Distance(a, b)
+		want := stat.Mahalanobis(mat.NewVecDense(c, a), mat.NewVecDense(c, b), m.Chol)
+		if !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+			t.Errorf("unexpected Mahalanobis distance: got:%v want:%v", got, want)
+		}
+	}
+}
+
+func TestDistances(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		r, ry, c int
+	}{
+		{r: 1, ry: 1, c: 1},
+		{r: 3, ry: 5, c: 2},
+		{r: 70, ry: 130, c: 5},
+		{r: 200, ry: 65, c: 3},
+	} {
+		for _, m := range testMetrics(t, test.c, rnd) {
+			_, discrete := m.(Hamming)
+			x := randomMatrix(test.r, test.c, discrete, rnd)
+			y := randomMatrix(test.ry, test.c, discrete, rnd)
+			name := fmt.Sprintf("%T r=%d ry=%d c=%d", m, test.r, test.ry, test.c)
+
+			var got mat.Dense
+			Distances(&got, x, y, m)
+			for i := 0; i < test.r; i++ {
+				for j := 0; j < test.ry; j++ {
+					want := m.Distance(x.RawRowView(i), y.RawRowView(j))
+					if !scalar.EqualWithinAbsOrRel(got.At(i, j), want, 1e-10, 1e-10) {
+						t.Errorf("unexpected distance for %s at (%d,%d): got:%v want:%v", name, i, j, got.At(i, j), want)
+					}
+				}
+			}
+
+			var self mat.Dense
+			Distances(&self, x, nil, m)
+			cond := Condensed(nil, x, m)
+			if len(cond) != test.r*(test.r-1)/2 {
+				t.Errorf("unexpected condensed length for %s: got:%d want:%d", name, len(cond), test.r*(test.r-1)/2)
+				continue
+			}
+			for i := 0; i < test.r; i++ {
+				if self.At(i, i) != 0 {
+					t.Errorf("unexpected non-zero diagonal for %s at %d: %v", name, i, self.At(i, i))
+				}
+				for j := i + 1; j < test.r; j++ {
+					want := m.Distance(x.RawRowView(i), x.RawRowView(j))
+					if !scalar.EqualWithinAbsOrRel(self.At(i, j), want, 1e-10, 1e-10) {
+						t.Errorf("unexpected self distance for %s at (%d,%d): got:%v want:%v", name, i, j, self.At(i, j), want)
+					}
+					if self.At(i, j) != self.At(j, i) {
+						t.Errorf("asymmetric self distance for %s at (%d,%d)", name, i, j)
+					}
+					if v := cond[CondensedIndex(test.r, i, j)]; v != self.At(i, j) {
+						t.Errorf("unexpected condensed distance for %s at (%d,%d): got:%v want:%v", name, i, j, v, self.At(i, j))
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestCondensedIndex(t *testing.T) {
+	t.Parallel()
+	const n = 7
+	var want int
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if got := CondensedIndex(n, i, j); got != want {
+				t.Errorf("unexpected index for (%d,%d): got:%d want:%d", i, j, got, want)
+			}
+			if got := CondensedIndex(n, j, i); got != want {
+				t.Errorf("unexpected index for (%d,%d): got:%d want:%d", j, i, got, want)
+			}
+			want++
+		}
+	}
+}
+
+func TestCosineZero(t *testing.T) {
+	t.Parallel()
+	x := mat.NewDense(3, 2, []float64{
+		0, 0,
+		1, 0,
+		0, 2,
+	})
+	got := Condensed(nil, x, Cosine{})
+	want := []float64{1, 1, 1}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-15 {
+			t.Errorf("unexpected cosine distance at %d: got:%v want:%v", i, got[i], want[i])
+		}
+	}
+}
+
+func BenchmarkCondensed(b *testing.B) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	x := randomMatrix(1000, 20, false, rnd)
+	dst := make([]float64, 1000*999/2)
+	for _, m := range []Metric{Euclidean{}, Manhattan{}} {
+		b.Run(fmt.Sprintf("%T", m), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				Condensed(dst, x, m)
+			}
+		})
+	}
+}
@@ -0,0 +1,293 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mathext"
+)
+
+// Alternative specifies the alternative hypothesis of a test.
+type Alternative int
+
+const (
+	// TwoSided is the alternative that the effect is non-zero.
+	TwoSided Alternative = iota
+	// Greater is the alternative that the effect is positive.
+	Greater
+	// Less is the alternative that the effect is negative.
+	Less
+)
+
+// CohensD returns Cohen's d, the standardized difference between the
+// weighted means of the samples x and y,
+//
+//	d = (mean(x) - mean(y)) / s
+//
+// where s^2 is the pooled unbiased variance of the samples
+//
+//	s^2 = ((n_x - 1) * s_x^2 + (n_y - 1) * s_y^2) / (n_x + n_y - 2)
+//
+// and n_x and n_y are the sums of the weights of the samples. If a weights
+// slice is nil then all of the weights of the corresponding sample are 1.
+func CohensD(x, xWeights, y, yWeights []float64) float64 {
+	mx, vx := MeanVariance(x, xWeights)
+	my, vy := MeanVariance(y, yWeights)
+	nx := sumWeights(x, xWeights)
+	ny := sumWeights(y, yWeights)
+	pooled := ((nx-1)*vx + (ny-1)*vy) / (nx + ny - 2)
+	return (mx - my) / math.Sqrt(pooled)
+}
+
+// sumWeights returns the sum of the weights of the observations in x.
+func sumWeights(x, weights []float64) float64 {
+	if weights == nil {
+		return float64(len(x))
+	}
+	return floats.Sum(weights)
+}
+
+// CohensH returns Cohen's h, the difference between the proportions p1
+// and p2 after the variance-stabilizing arcsine transformation,
+//
+//	h = 2 * asin(sqrt(p1)) - 2 * asin(sqrt(p2))
+func CohensH(p1, p2 float64) float64 {
+	return 2*math.Asin(math.Sqrt(p1)) - 2*math.Asin(math.Sqrt(p2))
+}
+
+// OddsRatio returns the odds ratio of the 2×2 contingency table
+//
+//	a b
+//	c d
+//
+// that is ad/bc, and the lower and upper bounds of its Woolf confidence
+// interval with the given confidence level, computed from the normal
+// approximation to the log odds ratio with standard error
+//
+//	sqrt(1/a + 1/b + 1/c + 1/d)
+//
+// If any count is zero, the interval is unbounded; a common remedy is to
+// add 0.5 to every count. OddsRatio panics if level is not in (0, 1).
+func OddsRatio(a, b, c, d, level float64) (or, lower, upper float64) {
+	if level <= 0 || 1 <= level {
+		panic("stat: confidence level out of range")
+	}
+	or = a * d / (b * c)
+	se := math.Sqrt(1/a + 1/b + 1/c + 1/d)
+	z := mathext.NormalQuantile(1 - (1-level)/2)
+	lor := math.Log(or)
+	return or, math.Exp(lor - z*se), math.Exp(lor + z*se)
+}
+
+// TTestPower returns the power of a t-test at significance level alpha to
+// detect a standardized effect d, Cohen's d, for the alternative alt. If
+// n2 is zero, the test is the one-sample or paired t-test with n1
+// observations or pairs; otherwise it is the two-sample t-test with equal
+// variances and n1 and n2 observations in the samples. The power is
+// computed exactly from the noncentral t distribution.
+func TTestPower(d, n1, n2, alpha float64, alt Alternative) float64 {
+	checkAlpha(alpha)
+	var nu, delta float64
+	if n2 == 0 {
+		nu = n1 - 1
+		delta = d * math.Sqrt(n1)
+	} else {
+		nu = n1 + n2 - 2
+		delta = d * math.Sqrt(n1*n2/(n1+n2))
+	}
+	switch alt {
+	case TwoSided:
+		c := tUpperQuantile(alpha/2, nu)
+		return 1 - noncentralTCDF(c, nu, delta) + noncentralTCDF(-c, nu, delta)
+	case Greater:
+		return 1 - noncentralTCDF(tUpperQuantile(alpha, nu), nu, delta)
+	case Less:
+		return noncentralTCDF(-tUpperQuantile(alpha, nu), nu, delta)
+	default:
+		panic("stat: invalid alternative")
+	}
+}
+
+// ProportionTestPower returns the power of a z-test for proportions at
+// significance level alpha to detect an effect h, Cohen's h, for the
+// alternative alt. If n2 is zero, the test compares the proportion in a
+// sample of n1 observations with a fixed proportion; otherwise it compares
+// the proportions in samples of n1 and n2 observations. The power is
+// computed from the normal approximation to the arcsine transformed
+// proportions.
+func ProportionTestPower(h, n1, n2, alpha float64, alt Alternative) float64 {
+	checkAlpha(alpha)
+	delta := h * math.Sqrt(n1)
+	if n2 != 0 {
+		delta = h * math.Sqrt(n1*n2/(n1+n2))
+	}
+	return normalTestPower(delta, alpha, alt)
+}
+
+// CorrelationTestPower returns the power of a test of zero correlation at
+// significance level alpha to detect a correlation r in a sample of n
+// observations for the alternative alt. The power is computed from the
+// normal approximation to the Fisher z-transformation of the correlation,
+// which has standard error 1/sqrt(n-3).
+func CorrelationTestPower(r, n, alpha float64, alt Alternative) float64 {
+	checkAlpha(alpha)
+	return normalTestPower(math.Atanh(r)*math.Sqrt(n-3), alpha, alt)
+}
+
+// normalTestPower returns the power of a z-test at significance level
+// alpha when the test statistic has unit normal distribution shifted
+// by delta.
+func normalTestPower(delta, alpha float64, alt Alternative) float64 {
+	switch alt {
+	case TwoSided:
+		z := mathext.NormalQuantile(1 - alpha/2)
+		return normalCDF(delta-z) + normalCDF(-delta-z)
+	case Greater:
+		return normalCDF(delta - mathext.NormalQuantile(1-alpha))
+	case Less:
+		return normalCDF(-delta - mathext.NormalQuantile(1-alpha))
+	default:
+		panic("stat: invalid alternative")
+	}
+}
+
+// AnovaPower returns the power of the F-test of a one-way analysis of
+// variance of k groups of n observations at significance level alpha to
+// detect an effect f, Cohen's f, the ratio of the standard deviation of
+// the group means to the common within-group standard deviation. The
+// power is computed exactly from the noncentral F distribution with
+// noncentrality parameter f^2 * k * n.
+func AnovaPower(f float64, k int, n, alpha float64) float64 {
+	checkAlpha(alpha)
+	if k < 2 {
+		panic("stat: fewer than two groups")
+	}
+	d1 := float64(k - 1)
+	d2 := float64(k) * (n - 1)
+	lambda := f * f * float64(k) * n
+
+	// The critical value of the F statistic F_c
+	// corresponds to the beta variate
+	//  y = d1 F_c / (d1 F_c + d2)
+	// with P(F > F_c) = 1 - I_y(d1/2, d2/2) = alpha.
+	y := mathext.InvRegIncBeta(d1/2, d2/2, 1-alpha)
+	return mathext.NoncentralRegIncBetaComp(d1/2, d2/2, lambda/2, y)
+}
+
+// SampleSize returns the smallest integer sample size n, no less than
+// minN, for which power(n) is at least target, where power is the power
+// of a test as a function of the sample size and is non-decreasing, for
+// example
+//
+//	n := stat.SampleSize(0.8, 2, func(n float64) float64 {
+//		return stat.TTestPower(0.5, n, n, 0.05, stat.TwoSided)
+//	})
+//
+// for the number of observations in each sample of a two-sample t-test.
+// SampleSize returns -1 if the target is not reached for any sample size
+// that can be represented by an int. SampleSize panics if target is not
+// in (0, 1).
+func SampleSize(target float64, minN int, power func(n float64) float64) int {
+	if target <= 0 || 1 <= target {
+		panic("stat: target power out of range")
+	}
+	if power(float64(minN)) >= target {
+		return minN
+	}
+	// Find an upper bound by doubling and then bisect.
+	lo, hi := minN, max(2*minN, 1)
+	for power(float64(hi)) < target {
+		if hi > math.MaxInt/2 {
+			return -1
+		}
+		lo, hi = hi, 2*hi
+	}
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		if power(float64(mid)) >= target {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return hi
+}
+
+func checkAlpha(alpha float64) {
+	if alpha <= 0 || 1 <= alpha {
+		panic("stat: significance level out of range")
+	}
+}
+
+// normalCDF returns the cumulative distribution function
+// of the unit normal distribution.
+func normalCDF(x float64) float64 {
+	return 0.5 * math.Erfc(-x/math.Sqrt2)
+}
+
+// tUpperQuantile returns the value c such that P(T > c) = p for
+// T with Student's t distribution with nu degrees of freedom,
+// for p ≤ 0.5.
+func tUpperQuantile(p, nu float64) float64 {
+	// P(|T| > c) = I_x(nu/2, 1/2) with x = nu/(nu + c^2).
+	x := mathext.InvRegIncBeta(nu/2, 0.5, 2*p)
+	return math.Sqrt(nu * (1 - x) / x)
+}
+
+// noncentralTCDF returns the cumulative distribution function at t of the
+// noncentral t distribution with nu degrees of freedom and noncentrality
+// parameter delta.
+func noncentralTCDF(t, nu, delta float64) float64 {
+	if t < 0 {
+		return 1 - noncentralTCDF(-t, nu, -delta)
+	}
+	// For t ≥ 0 the distribution function is the series
+	//  Φ(-δ) + 1/2 \sum_j p_j I_x(j+1/2, ν/2) + q_j I_x(j+1, ν/2)
+	// with x = t^2/(t^2+ν), the Poisson weights
+	//  p_j = e^{-δ^2/2} (δ^2/2)^j / j!
+	// and
+	//  q_j = δ/sqrt(2) e^{-δ^2/2} (δ^2/2)^j / Γ(j+3/2),
+	// as in Lenth, Algorithm AS 243, Appl. Statist. 38(1), 1989.
+	// The terms are summed outward from the mode of the weights.
+	base := normalCDF(-delta)
+	x := t * t / (t*t + nu)
+	if x == 0 {
+		return base
+	}
+	if delta == 0 {
+		return base + 0.5*mathext.RegIncBeta(0.5, nu/2, x)
+	}
+	lambda := delta * delta / 2
+	logLambda := math.Log(lambda)
+	term := func(j int) float64 {
+		fj := float64(j)
+		lp, _ := math.Lgamma(fj + 1)
+		lq, _ := math.Lgamma(fj + 1.5)
+		logW := -lambda + fj*logLambda
+		p := math.Exp(logW - lp)
+		q := math.Copysign(math.Exp(logW-lq+math.Log(math.Abs(delta))-0.5*math.Ln2), delta)
+		return p*mathext.RegIncBeta(fj+0.5, nu/2, x) + q*mathext.RegIncBeta(fj+1, nu/2, x)
+	}
+	const tol = 1e-16
+	mode := int(lambda)
+	sum := term(mode)
+	for j := mode + 1; ; j++ {
+		v := term(j)
+		sum += v
+		if math.Abs(v) <= tol*math.Abs(sum) || j > mode+10000 {
+			break
+		}
+	}
+	for j := mode - 1; j >= 0; j-- {
+		v := term(j)
+		sum += v
+		if math.Abs(v) <= tol*math.Abs(sum) {
+			break
+		}
+	}
+	return base + 0.5*sum
+}
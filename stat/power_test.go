@@ -0,0 +1,163 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/integrate/quad"
+)
+
+func TestNoncentralTCDF(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct{ t, nu, delta float64 }{
+		{0, 5, 1},
+		{1, 5, 0},
+		{-1, 5, 0},
+		{2, 10, 1},
+		{-2, 10, 1},
+		{1, 3, -2},
+		{5, 20, 4},
+		{10, 50, 12},
+		{-0.5, 2.5, 0.3},
+		{30, 100, 25},
+	} {
+		// P(T ≤ t) = \int_0^∞ Φ(t sqrt(v/ν) - δ) f_{χ^2_ν}(v) dv.
+		lg, _ := math.Lgamma(test.nu / 2)
+		want := quad.Fixed(func(v float64) float64 {
+			if v == 0 {
+				return 0
+			}
+			logDens := (test.nu/2-1)*math.Log(v) - v/2 - test.nu/2*math.Ln2 - lg
+			return normalCDF(test.t*math.Sqrt(v/test.nu)-test.delta) * math.Exp(logDens)
+		}, 0, math.Inf(1), 2000, nil, 0)
+		got := noncentralTCDF(test.t, test.nu, test.delta)
+		if !scalar.EqualWithinAbsOrRel(got, want, 1e-9, 1e-9) {
+			t.Errorf("unexpected CDF for t=%v ν=%v δ=%v: got:%v want:%v", test.t, test.nu, test.delta, got, want)
+		}
+	}
+}
+
+func TestEffectSizes(t *testing.T) {
+	t.Parallel()
+	x := []float64{5, 6, 7, 8, 9}
+	y := []float64{1, 2, 3, 4, 5, 6, 7}
+	// The variances are 2.5 and 14/3, so the
+	// pooled variance is (4*2.5 + 6*14/3)/10 = 3.8.
+	want := (7 - 4) / math.Sqrt(3.8)
+	if got := CohensD(x, nil, y, nil); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+		t.Errorf("unexpected Cohen's d: got:%v want:%v", got, want)
+	}
+	w := []float64{1, 1, 2, 1, 1, 1, 1}
+	yw := []float64{1, 2, 3, 3, 4, 5, 6, 7}
+	want = CohensD(x, nil, yw, nil)
+	if got := CohensD(x, nil, y[:7], w); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+		t.Errorf("unexpected weighted Cohen's d: got:%v want:%v", got, want)
+	}
+
+	if got := CohensH(0.5, 0.5); got != 0 {
+		t.Errorf("unexpected Cohen's h for equal proportions: got:%v", got)
+	}
+	if got, want := CohensH(1, 0), math.Pi; !scalar.EqualWithinAbs(got, want, 1e-15) {
+		t.Errorf("unexpected Cohen's h: got:%v want:%v", got, want)
+	}
+
+	or, lower, upper := OddsRatio(20, 10, 5, 15, 0.95)
+	if or != 6 {
+		t.Errorf("unexpected odds ratio: got:%v want:6", or)
+	}
+	se := math.Sqrt(1.0/20 + 1.0/10 + 1.0/5 + 1.0/15)
+	const z = 1.959963984540054
+	if want := math.Exp(math.Log(6) - z*se); !scalar.EqualWithinAbsOrRel(lower, want, 1e-12, 1e-12) {
+		t.Errorf("unexpected lower bound: got:%v want:%v", lower, want)
+	}
+	if want := math.Exp(math.Log(6) + z*se); !scalar.EqualWithinAbsOrRel(upper, want, 1e-12, 1e-12) {
+		t.Errorf("unexpected upper bound: got:%v want:%v", upper, want)
+	}
+}
+
+func TestPower(t *testing.T) {
+	t.Parallel()
+	const alpha = 0.05
+
+	// Reference values from R's power.t.test and the pwr package.
+	for _, test := range []struct {
+		name string
+		got  float64
+		want float64
+		tol  float64
+	}{
+		{name: "two-sample t", got: TTestPower(0.5, 64, 64, alpha, TwoSided), want: 0.8014596, tol: 1e-6},
+		{name: "one-sample t", got: TTestPower(0.5, 34, 0, alpha, TwoSided), want: 0.8077775, tol: 1e-6},
+		{name: "two-sample proportion", got: ProportionTestPower(0.3, 175, 175, alpha, TwoSided), want: 0.8013, tol: 1e-3},
+		{name: "correlation", got: CorrelationTestPower(0.3, 85, alpha, TwoSided), want: 0.80, tol: 1e-2},
+		{name: "anova", got: AnovaPower(0.25, 4, 45, alpha), want: 0.8044, tol: 1e-3},
+		{name: "null t", got: TTestPower(0, 20, 20, alpha, TwoSided), want: alpha, tol: 1e-12},
+		{name: "null anova", got: AnovaPower(0, 3, 10, alpha), want: alpha, tol: 1e-12},
+	} {
+		if !scalar.EqualWithinAbs(test.got, test.want, test.tol) {
+			t.Errorf("unexpected %s power: got:%v want:%v", test.name, test.got, test.want)
+		}
+	}
+
+	// A one-sided test is more powerful than a two-sided
+	// test for an effect in the direction of the alternative.
+	if one, two := TTestPower(0.5, 50, 50, alpha, Greater), TTestPower(0.5, 50, 50, alpha, TwoSided); one <= two {
+		t.Errorf("one-sided power not greater than two-sided: %v <= %v", one, two)
+	}
+
+	// The power for one alternative is that for the other
+	// with the sign of the effect reversed.
+	if g, l := TTestPower(0.3, 20, 25, alpha, Greater), TTestPower(-0.3, 20, 25, alpha, Less); !scalar.EqualWithinAbs(g, l, 1e-12) {
+		t.Errorf("one-sided t powers differ: %v != %v", g, l)
+	}
+
+	for _, test := range []struct {
+		name  string
+		power func(n float64) float64
+		minN  int
+		want  int
+	}{
+		{
+			name:  "two-sample t",
+			power: func(n float64) float64 { return TTestPower(0.5, n, n, alpha, TwoSided) },
+			minN:  2,
+			want:  64,
+		},
+		{
+			name:  "two-sample proportion",
+			power: func(n float64) float64 { return ProportionTestPower(0.3, n, n, alpha, TwoSided) },
+			minN:  1,
+			want:  175,
+		},
+		{
+			name:  "correlation",
+			power: func(n float64) float64 { return CorrelationTestPower(0.3, n, alpha, TwoSided) },
+			minN:  4,
+			want:  85,
+		},
+		{
+			name:  "anova",
+			power: func(n float64) float64 { return AnovaPower(0.25, 4, n, alpha) },
+			minN:  2,
+			want:  45,
+		},
+		{
+			name:  "large effect",
+			power: func(n float64) float64 { return TTestPower(3, n, n, alpha, TwoSided) },
+			minN:  2,
+			want:  4,
+		},
+	} {
+		if got := SampleSize(0.8, test.minN, test.power); got != test.want {
+			t.Errorf("unexpected %s sample size: got:%d want:%d", test.name, got, test.want)
+		}
+	}
+	if got := SampleSize(0.8, 2, func(float64) float64 { return alpha }); got != -1 {
+		t.Errorf("unexpected sample size for unreachable power: got:%d want:-1", got)
+	}
+}
@@ -0,0 +1,369 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// ErrQuantRegNoConvergence is returned by QuantileRegression when the
+// interior point iteration fails to converge.
+var ErrQuantRegNoConvergence = errors.New("stat: quantile regression did not converge")
+
+// PinballLoss returns the weighted sum of the pinball, or check, loss of the
+// residuals y[i]-yHat[i] for the quantile tau,
+//
+//	\sum_i w_i ρ_τ(y_i - ŷ_i),  ρ_τ(r) = r (τ - [r < 0]),
+//
+// which is minimized by the τ quantile. If weights is nil, all the weights
+// are one.
+//
+// PinballLoss will panic if tau is not in [0, 1] or the lengths of y, yHat
+// and weights, when not nil, are not equal.
+func PinballLoss(tau float64, y, yHat, weights []float64) float64 {
+	if !(0 <= tau && tau <= 1) {
+		panic("stat: quantile out of range")
+	}
+	if len(y) != len(yHat) {
+		panic("stat: slice length mismatch")
+	}
+	if weights != nil && len(weights) != len(y) {
+		panic("stat: slice length mismatch")
+	}
+	var loss float64
+	for i, v := range y {
+		r := v - yHat[i]
+		l := r * tau
+		if r < 0 {
+			l = r * (tau - 1)
+		}
+		if weights != nil {
+			l *= weights[i]
+		}
+		loss += l
+	}
+	return loss
+}
+
+// QuantileRegression returns the coefficients β of the linear quantile
+// regression of y on the columns of x for the quantile tau, minimizing the
+// weighted pinball loss
+//
+//	\sum_i w_i ρ_τ(y_i - x_iᵀβ)
+//
+// where x_i is the ith row of x. The regression fits the τ conditional
+// quantile of y as a linear function of the predictors; with tau equal to
+// 0.5 it is median regression, a robust alternative to least squares. An
+// intercept is included in the model only if x has a column of ones. If
+// weights is nil, all the weights are one. If dst is not nil, the
+// coefficients are stored in dst, which must have length equal to the
+// number of columns of x.
+//
+// The coefficients are the solution of a linear program, which is solved by
+// the Frisch-Newton primal-dual interior point method of Portnoy and Koenker
+// applied to its dual. QuantileRegression returns ErrQuantRegNoConvergence
+// if the iteration fails to converge, and an error if the design matrix is
+// rank deficient.
+//
+// QuantileRegression will panic if tau is not in (0, 1), x has fewer rows
+// than columns, the number of rows of x is not len(y), weights is not nil
+// and does not have length len(y), any weight is not positive, or dst is not
+// nil and has the wrong length.
+//
+// See Koenker, R. and Portnoy, S. (1997) The Gaussian hare and the Laplacian
+// tortoise: computability of squared-error versus absolute-error
+// estimators. Statistical Science 12(4) for details of the method.
+func QuantileRegression(dst []float64, x mat.Matrix, y []float64, tau float64, weights []float64) ([]float64, error) {
+	n, p := x.Dims()
+	if !(0 < tau && tau < 1) {
+		panic("stat: quantile out of range")
+	}
+	if n != len(y) {
+		panic("stat: slice length mismatch")
+	}
+	if weights != nil && len(weights) != n {
+		panic("stat: slice length mismatch")
+	}
+	if n < p {
+		panic("stat: fewer observations than predictors")
+	}
+	if dst == nil {
+		dst = make([]float64, p)
+	} else if len(dst) != p {
+		panic("stat: slice length mismatch")
+	}
+
+	// The weighted problem is equivalent to the
+	// unweighted problem with the rows of x and
+	// the elements of y scaled by the weights.
+	a := mat.DenseCopyOf(x.T())
+	c := make([]float64, n)
+	for i, v := range y {
+		w := 1.0
+		if weights != nil {
+			w = weights[i]
+			if !(w > 0) {
+				panic("stat: non-positive weight")
+			}
+			for j := range p {
+				a.Set(j, i, w*a.At(j, i))
+			}
+		}
+		c[i] = -w * v
+	}
+	beta, err := frischNewton(a, c, tau)
+	if err != nil {
+		return nil, err
+	}
+	for i, v := range beta {
+		dst[i] = -v
+	}
+	return dst, nil
+}
+
+// frischNewton solves the linear program
+//
+//	minimize cᵀx subject to Ax = (1-τ)A1, 0 ≤ x ≤ 1,
+//
+// the dual of the quantile regression problem where A is the transpose of
+// the design matrix and c is the negated response, returning the solution y
+// of its dual, which is the negated vector of regression coefficients.
+func frischNewton(a *mat.Dense, c []float64, tau float64) ([]float64, error) {
+	const (
+		maxIter = 100
+		step    = 0.99995
+		tol     = 1e-10
+	)
+	p, n := a.Dims()
+
+	// The primal variables x and s = 1-x start at
+	// the feasible interior point x = 1-τ.
+	x := make([]float64, n)
+	s := make([]float64, n)
+	for i := range x {
+		x[i] = 1 - tau
+		s[i] = tau
+	}
+	b := mat.NewVecDense(p, nil)
+	b.MulVec(a, mat.NewVecDense(n, x))
+
+	// The dual variables start at the least squares
+	// solution of Aᵀy = c with the dual slacks z and
+	// w chosen so that z - w = c - Aᵀy.
+	var y mat.VecDense
+	err := y.SolveVec(a.T(), mat.NewVecDense(n, c))
+	if err != nil {
+		return nil, err
+	}
+	r := mat.NewVecDense(n, nil)
+	r.MulVec(a.T(), &y)
+	z := make([]float64, n)
+	w := make([]float64, n)
+	var scale float64
+	for i := range c {
+		r.SetVec(i, c[i]-r.AtVec(i))
+		scale += math.Abs(r.AtVec(i))
+	}
+	scale = scale/float64(n) + 1
+	for i := range z {
+		v := r.AtVec(i)
+		z[i] = max(v, 0) + 1e-2*scale
+		w[i] = max(-v, 0) + 1e-2*scale
+	}
+
+	var (
+		q    = make([]float64, n)
+		rq   = mat.NewVecDense(n, nil)
+		dx   = make([]float64, n)
+		ds   = make([]float64, n)
+		dz   = make([]float64, n)
+		dw   = make([]float64, n)
+		dy   mat.VecDense
+		rhs  mat.VecDense
+		aty  mat.VecDense
+		aqa  = mat.NewSymDense(p, nil)
+		aq   = mat.NewDense(p, n, nil)
+		chol mat.Cholesky
+	)
+	cScale := 1.0
+	for _, v := range c {
+		cScale = max(cScale, math.Abs(v))
+	}
+	for range maxIter {
+		var gap float64
+		for i := range x {
+			gap += x[i]*z[i] + s[i]*w[i]
+		}
+		if gap <= tol*cScale*float64(n) {
+			return y.RawVector().Data, nil
+		}
+
+		// Affine scaling predictor step.
+		for i := range q {
+			q[i] = 1 / (z[i]/x[i] + w[i]/s[i])
+			rq.SetVec(i, q[i]*(z[i]-w[i]))
+		}
+		aq.Copy(a)
+		for j := range n {
+			for k := range p {
+				aq.Set(k, j, aq.At(k, j)*q[j])
+			}
+		}
+		for k := range p {
+			for l := k; l < p; l++ {
+				aqa.SetSym(k, l, mat.Dot(aq.RowView(k), a.RowView(l)))
+			}
+		}
+		if !chol.Factorize(aqa) {
+			return nil, ErrQuantRegNoConvergence
+		}
+		rhs.MulVec(a, rq)
+		err := chol.SolveVecTo(&dy, &rhs)
+		if err != nil {
+			return nil, err
+		}
+		aty.MulVec(a.T(), &dy)
+		for i := range dx {
+			dx[i] = q[i] * (aty.AtVec(i) - (z[i] - w[i]))
+			ds[i] = -dx[i]
+			dz[i] = -z[i] * (dx[i]/x[i] + 1)
+			dw[i] = -w[i] * (ds[i]/s[i] + 1)
+		}
+		fp := min(step*min(maxStep(x, dx), maxStep(s, ds)), 1)
+		fd := min(step*min(maxStep(z, dz), maxStep(w, dw)), 1)
+
+		if min(fp, fd) < 1 {
+			// Mehrotra predictor-corrector step with
+			// the centering parameter chosen from the
+			// reduction of the gap by the predictor.
+			var g float64
+			for i := range x {
+				g += (z[i]+fd*dz[i])*(x[i]+fp*dx[i]) + (w[i]+fd*dw[i])*(s[i]+fp*ds[i])
+			}
+			mu := gap * math.Pow(g/gap, 3) / float64(2*n)
+			for i := range q {
+				dxdz := dx[i] * dz[i] / x[i]
+				dsdw := ds[i] * dw[i] / s[i]
+				xi := mu * (1/x[i] - 1/s[i])
+				rq.SetVec(i, q[i]*(z[i]-w[i]-xi+dxdz-dsdw))
+			}
+			rhs.MulVec(a, rq)
+			err := chol.SolveVecTo(&dy, &rhs)
+			if err != nil {
+				return nil, err
+			}
+			aty.MulVec(a.T(), &dy)
+			for i := range dx {
+				dxdz := dx[i] * dz[i]
+				dsdw := ds[i] * dw[i]
+				xi := mu * (1/x[i] - 1/s[i])
+				dx[i] = q[i] * (aty.AtVec(i) + xi - (z[i] - w[i]) - dxdz/x[i] + dsdw/s[i])
+				ds[i] = -dx[i]
+				dz[i] = (mu-dxdz)/x[i] - z[i] - z[i]/x[i]*dx[i]
+				dw[i] = (mu-dsdw)/s[i] - w[i] - w[i]/s[i]*ds[i]
+			}
+			fp = min(step*min(maxStep(x, dx), maxStep(s, ds)), 1)
+			fd = min(step*min(maxStep(z, dz), maxStep(w, dw)), 1)
+		}
+
+		for i := range x {
+			x[i] += fp * dx[i]
+			s[i] += fp * ds[i]
+			z[i] += fd * dz[i]
+			w[i] += fd * dw[i]
+		}
+		y.AddScaledVec(&y, fd, &dy)
+	}
+	return nil, ErrQuantRegNoConvergence
+}
+
+// maxStep returns the largest step length α such that v + α·dv is
+// non-negative, or +Inf if there is no such bound.
+func maxStep(v, dv []float64) float64 {
+	alpha := math.Inf(1)
+	for i, d := range dv {
+		if d < 0 {
+			alpha = min(alpha, -v[i]/d)
+		}
+	}
+	return alpha
+}
+
+// QuantileRegressionBootstrap returns bootstrap estimates of the standard
+// errors of the coefficients returned by QuantileRegression for x, y, tau and
+// weights. The estimates are the standard deviations of the coefficients
+// fitted to samples observations drawn with replacement from the rows of x
+// and the corresponding elements of y and weights, the pairs bootstrap. If src
+// is nil, the global source of math/rand/v2 is used. If dst is not nil, the
+// standard errors are stored in dst, which must have length equal to the
+// number of columns of x.
+//
+// Resamples that are rank deficient or for which the fit fails to converge
+// are redrawn. QuantileRegressionBootstrap returns an error if the fit to
+// the original data fails.
+//
+// QuantileRegressionBootstrap will panic under the conditions described for
+// QuantileRegression or if samples is less than two.
+func QuantileRegressionBootstrap(dst []float64, x mat.Matrix, y []float64, tau float64, weights []float64, samples int, src rand.Source) ([]float64, error) {
+	if samples < 2 {
+		panic("stat: too few bootstrap samples")
+	}
+	n, p := x.Dims()
+	_, err := QuantileRegression(dst, x, y, tau, weights)
+	if err != nil {
+		return nil, err
+	}
+	if dst == nil {
+		dst = make([]float64, p)
+	}
+
+	var intn func(int) int
+	if src == nil {
+		intn = rand.IntN
+	} else {
+		intn = rand.New(src).IntN
+	}
+	xb := mat.NewDense(n, p, nil)
+	yb := make([]float64, n)
+	var wb []float64
+	if weights != nil {
+		wb = make([]float64, n)
+	}
+	beta := make([]float64, p)
+	mean := make([]float64, p)
+	m2 := make([]float64, p)
+	for k := 0; k < samples; {
+		for i := range n {
+			j := intn(n)
+			for c := range p {
+				xb.Set(i, c, x.At(j, c))
+			}
+			yb[i] = y[j]
+			if weights != nil {
+				wb[i] = weights[j]
+			}
+		}
+		_, err := QuantileRegression(beta, xb, yb, tau, wb)
+		if err != nil {
+			continue
+		}
+		// Accumulate the moments using
+		// Welford's method.
+		k++
+		for c, v := range beta {
+			d := v - mean[c]
+			mean[c] += d / float64(k)
+			m2[c] += d * (v - mean[c])
+		}
+	}
+	for c := range dst {
+		dst[c] = math.Sqrt(m2[c] / float64(samples-1))
+	}
+	return dst, nil
+}
@@ -0,0 +1,260 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestPinballLoss(t *testing.T) {
+	t.Parallel()
+	y := []float64{1, 2, 3, 4}
+	yHat := []float64{2, 2, 1, 5}
+	for _, test := range []struct {
+		tau     float64
+		weights []float64
+		want    float64
+	}{
+		{tau: 0.5, want: 0.5*1 + 0 + 0.5*2 + 0.5*1},
+		{tau: 0.25, want: 0.75*1 + 0 + 0.25*2 + 0.75*1},
+		{tau: 0.25, weights: []float64{1, 1, 2, 0}, want: 0.75*1 + 0 + 2*0.25*2},
+	} {
+		got := PinballLoss(test.tau, y, yHat, test.weights)
+		if !scalar.EqualWithinAbsOrRel(got, test.want, 1e-14, 1e-14) {
+			t.Errorf("unexpected loss for tau=%v weights=%v: got:%v want:%v", test.tau, test.weights, got, test.want)
+		}
+	}
+	if !panics(func() { PinballLoss(1.5, y, yHat, nil) }) {
+		t.Errorf("expected panic for tau out of range")
+	}
+	if !panics(func() { PinballLoss(0.5, y, yHat[:3], nil) }) {
+		t.Errorf("expected panic for length mismatch")
+	}
+	if !panics(func() { PinballLoss(0.5, y, yHat, []float64{1}) }) {
+		t.Errorf("expected panic for weights length mismatch")
+	}
+}
+
+// quantRegData returns a design matrix with an intercept column and n-1
+// further uniform predictors and a heteroscedastic response.
+func quantRegData(rnd *rand.Rand, n, p int) (*mat.Dense, []float64) {
+	x := mat.NewDense(n, p, nil)
+	y := make([]float64, n)
+	for i := range n {
+		x.Set(i, 0, 1)
+		v := 1.0
+		for j := 1; j < p; j++ {
+			x.Set(i, j, 10*rnd.Float64())
+			v += float64(j) * x.At(i, j)
+		}
+		y[i] = v + (1+x.At(i, min(1, p-1)))*rnd.NormFloat64()
+	}
+	return x, y
+}
+
+// quantRegExhaustive returns the minimum pinball loss of the linear
+// quantile regression of y on x by evaluating the fits through all subsets
+// of p observations, one of which is optimal.
+func quantRegExhaustive(x mat.Matrix, y []float64, tau float64, weights []float64) float64 {
+	n, p := x.Dims()
+	best := math.Inf(1)
+	idx := make([]int, p)
+	a := mat.NewDense(p, p, nil)
+	b := mat.NewVecDense(p, nil)
+	var beta, yHat mat.VecDense
+	var visit func(k, start int)
+	visit = func(k, start int) {
+		if k == p {
+			for i, r := range idx {
+				for j := range p {
+					a.Set(i, j, x.At(r, j))
+				}
+				b.SetVec(i, y[r])
+			}
+			err := beta.SolveVec(a, b)
+			if err != nil {
+				return
+			}
+			yHat.MulVec(x, &beta)
+			best = min(best, PinballLoss(tau, y, yHat.RawVector().Data, weights))
+			return
+		}
+		for i := start; i < n; i++ {
+			idx[k] = i
+			visit(k+1, i+1)
+		}
+	}
+	visit(0, 0)
+	return best
+}
+
+func TestQuantileRegression(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		n, p     int
+		tau      float64
+		weighted bool
+	}{
+		{n: 10, p: 1, tau: 0.5},
+		{n: 20, p: 2, tau: 0.5},
+		{n: 20, p: 2, tau: 0.1},
+		{n: 30, p: 3, tau: 0.9},
+		{n: 25, p: 2, tau: 0.3, weighted: true},
+		{n: 30, p: 4, tau: 0.75, weighted: true},
+	} {
+		x, y := quantRegData(rnd, test.n, test.p)
+		var weights []float64
+		if test.weighted {
+			weights = make([]float64, test.n)
+			for i := range weights {
+				weights[i] = 0.5 + rnd.Float64()
+			}
+		}
+		beta, err := QuantileRegression(nil, x, y, test.tau, weights)
+		if err != nil {
+			t.Errorf("unexpected error for n=%d p=%d tau=%v: %v", test.n, test.p, test.tau, err)
+			continue
+		}
+		var yHat mat.VecDense
+		yHat.MulVec(x, mat.NewVecDense(test.p, beta))
+		got := PinballLoss(test.tau, y, yHat.RawVector().Data, weights)
+
+		want := quantRegExhaustive(x, y, test.tau, weights)
+		if !scalar.EqualWithinAbsOrRel(got, want, 1e-8, 1e-8) {
+			t.Errorf("unexpected loss for n=%d p=%d tau=%v: got:%v want:%v", test.n, test.p, test.tau, got, want)
+		}
+
+		// Check that dst is used.
+		dst := make([]float64, test.p)
+		res, err := QuantileRegression(dst, x, y, test.tau, weights)
+		if err != nil {
+			t.Errorf("unexpected error with dst: %v", err)
+			continue
+		}
+		if &res[0] != &dst[0] {
+			t.Errorf("result not stored in dst")
+		}
+	}
+}
+
+func TestQuantileRegressionIntercept(t *testing.T) {
+	t.Parallel()
+	// With only an intercept, the fit is a sample quantile.
+	y := []float64{5, 1, 9, 3, 7, 2, 8}
+	x := mat.NewDense(len(y), 1, nil)
+	for i := range y {
+		x.Set(i, 0, 1)
+	}
+	for _, test := range []struct {
+		tau  float64
+		want float64
+	}{
+		{tau: 0.5, want: 5},
+		{tau: 0.2, want: 2},
+		{tau: 0.8, want: 8},
+	} {
+		beta, err := QuantileRegression(nil, x, y, test.tau, nil)
+		if err != nil {
+			t.Errorf("unexpected error for tau=%v: %v", test.tau, err)
+			continue
+		}
+		if !scalar.EqualWithinAbs(beta[0], test.want, 1e-8) {
+			t.Errorf("unexpected quantile for tau=%v: got:%v want:%v", test.tau, beta[0], test.want)
+		}
+	}
+}
+
+func TestQuantileRegressionProportion(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(2, 2))
+	const n = 500
+	x, y := quantRegData(rnd, n, 2)
+	for _, tau := range []float64{0.1, 0.5, 0.9} {
+		beta, err := QuantileRegression(nil, x, y, tau, nil)
+		if err != nil {
+			t.Errorf("unexpected error for tau=%v: %v", tau, err)
+			continue
+		}
+		// The fit interpolates at least p points and the
+		// proportion of points below it is within p/n of tau.
+		var below int
+		for i := range n {
+			if y[i] < beta[0]+beta[1]*x.At(i, 1)-1e-8 {
+				below++
+			}
+		}
+		got := float64(below) / n
+		if math.Abs(got-tau) > 2.0/n {
+			t.Errorf("unexpected proportion below fit for tau=%v: got:%v", tau, got)
+		}
+	}
+}
+
+func TestQuantileRegressionBootstrap(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(3, 3))
+	const n = 200
+	x, y := quantRegData(rnd, n, 2)
+	se, err := QuantileRegressionBootstrap(nil, x, y, 0.5, nil, 200, rand.NewPCG(4, 4))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The noise has standard deviation 1+x for x uniform in
+	// [0, 10], so the asymptotic standard errors of the median
+	// regression coefficients are of order 0.3 and 0.06.
+	for i, want := range []float64{0.3, 0.06} {
+		if !(want/3 < se[i] && se[i] < 3*want) {
+			t.Errorf("unexpected standard error for coefficient %d: got:%v want approximately:%v", i, se[i], want)
+		}
+	}
+
+	// The standard errors are reproducible for a given source.
+	dst := make([]float64, 2)
+	again, err := QuantileRegressionBootstrap(dst, x, y, 0.5, nil, 200, rand.NewPCG(4, 4))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if &again[0] != &dst[0] {
+		t.Errorf("result not stored in dst")
+	}
+	for i := range se {
+		if se[i] != again[i] {
+			t.Errorf("standard errors not reproducible: %v != %v", se, again)
+			break
+		}
+	}
+
+	if !panics(func() { QuantileRegressionBootstrap(nil, x, y, 0.5, nil, 1, nil) }) {
+		t.Errorf("expected panic for too few samples")
+	}
+}
+
+func TestQuantileRegressionPanics(t *testing.T) {
+	t.Parallel()
+	x := mat.NewDense(3, 2, []float64{1, 1, 1, 2, 1, 3})
+	y := []float64{1, 2, 3}
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{"tau zero", func() { QuantileRegression(nil, x, y, 0, nil) }},
+		{"tau one", func() { QuantileRegression(nil, x, y, 1, nil) }},
+		{"length mismatch", func() { QuantileRegression(nil, x, y[:2], 0.5, nil) }},
+		{"weights mismatch", func() { QuantileRegression(nil, x, y, 0.5, []float64{1}) }},
+		{"negative weight", func() { QuantileRegression(nil, x, y, 0.5, []float64{1, -1, 1}) }},
+		{"dst mismatch", func() { QuantileRegression(make([]float64, 3), x, y, 0.5, nil) }},
+		{"too few rows", func() { QuantileRegression(nil, x.Slice(0, 1, 0, 2), y[:1], 0.5, nil) }},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math"
+	"sort"
+)
+
+// KendallTauB returns Kendall's tau-b rank correlation between the samples
+// x and y, which adjusts for ties in either sample,
+//
+//	τ_b = (n_c - n_d) / sqrt((n_0 - n_x) (n_0 - n_y))
+//
+// where n_c and n_d are the numbers of concordant and discordant pairs,
+// n_0 = n(n-1)/2 is the number of pairs, and n_x and n_y are the numbers of
+// pairs tied in x and in y. KendallTauB also returns the two-sided p-value
+// of the test of independence from the normal approximation to n_c - n_d
+// with its variance corrected for ties.
+//
+// KendallTauB panics if the lengths of x and y differ.
+func KendallTauB(x, y []float64) (tau, p float64) {
+	s, tx, ty, variance := kendallS(x, y)
+	n0 := pairs(len(x))
+	tau = s / math.Sqrt((n0-tx)*(n0-ty))
+	return tau, math.Erfc(math.Abs(s) / math.Sqrt(2*variance))
+}
+
+// KendallTauC returns Stuart's tau-c rank correlation between the samples x
+// and y, a variant of Kendall's tau for rectangular contingency tables,
+//
+//	τ_c = 2 m (n_c - n_d) / (n^2 (m - 1))
+//
+// where n_c and n_d are the numbers of concordant and discordant pairs and
+// m is the smaller of the numbers of distinct values in x and in y.
+// KendallTauC also returns the two-sided p-value of the test of
+// independence, which is the same as that of KendallTauB.
+//
+// KendallTauC panics if the lengths of x and y differ.
+func KendallTauC(x, y []float64) (tau, p float64) {
+	s, _, _, variance := kendallS(x, y)
+	n := float64(len(x))
+	m := float64(min(len(tieCounts(x)), len(tieCounts(y))))
+	tau = 2 * m * s / (n * n * (m - 1))
+	return tau, math.Erfc(math.Abs(s) / math.Sqrt(2*variance))
+}
+
+// kendallS returns the difference between the numbers of concordant and
+// discordant pairs of x and y, the numbers of pairs tied in x and in y,
+// and the variance of the difference under independence.
+func kendallS(x, y []float64) (s, tx, ty, variance float64) {
+	if len(x) != len(y) {
+		panic("stat: slice length mismatch")
+	}
+	n := len(x)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			s += sign(x[j]-x[i]) * sign(y[j]-y[i])
+		}
+	}
+	// The variance of S corrected for ties, from
+	// Kendall, Rank Correlation Methods, 1970.
+	fn := float64(n)
+	v0 := fn * (fn - 1) * (2*fn + 5)
+	var vx, vy, x1, y1, x2, y2 float64
+	for _, t := range tieCounts(x) {
+		ft := float64(t)
+		tx += pairs(t)
+		vx += ft * (ft - 1) * (2*ft + 5)
+		x1 += ft * (ft - 1)
+		x2 += ft * (ft - 1) * (ft - 2)
+	}
+	for _, t := range tieCounts(y) {
+		ft := float64(t)
+		ty += pairs(t)
+		vy += ft * (ft - 1) * (2*ft + 5)
+		y1 += ft * (ft - 1)
+		y2 += ft * (ft - 1) * (ft - 2)
+	}
+	variance = (v0-vx-vy)/18 + x1*y1/(2*fn*(fn-1)) + x2*y2/(9*fn*(fn-1)*(fn-2))
+	return s, tx, ty, variance
+}
+
+// pairs returns the number of unordered pairs of n items.
+func pairs(n int) float64 {
+	return float64(n) * float64(n-1) / 2
+}
+
+// sign returns the sign of v, or zero if v is zero.
+func sign(v float64) float64 {
+	switch {
+	case v > 0:
+		return 1
+	case v < 0:
+		return -1
+	}
+	return 0
+}
+
+// tieCounts returns the number of occurrences of each distinct value of x.
+func tieCounts(x []float64) []int {
+	s := append([]float64(nil), x...)
+	sort.Float64s(s)
+	var counts []int
+	for i, v := range s {
+		if i == 0 || v != s[i-1] {
+			counts = append(counts, 0)
+		}
+		counts[len(counts)-1]++
+	}
+	return counts
+}
+
+// Spearman returns the weighted Spearman rank correlation between the
+// samples of x and y, the weighted Pearson correlation of their ranks.
+// Tied values are given the mean of the ranks they span. With weights,
+// the rank of a value is the midpoint of the cumulative weight of the
+// values it spans, so an observation with weight w is ranked as if it
+// were repeated w times.
+//
+// The lengths of x and y must be equal. If weights is nil then all of the
+// weights are 1. If weights is not nil, then len(x) must equal len(weights).
+func Spearman(x, y, weights []float64) float64 {
+	if len(x) != len(y) {
+		panic("stat: slice length mismatch")
+	}
+	if weights != nil && len(weights) != len(x) {
+		panic("stat: slice length mismatch")
+	}
+	return Correlation(weightedRanks(x, weights), weightedRanks(y, weights), weights)
+}
+
+// weightedRanks returns the mid-ranks of x with the given weights.
+func weightedRanks(x, weights []float64) []float64 {
+	idx := make([]int, len(x))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return x[idx[i]] < x[idx[j]] })
+	ranks := make([]float64, len(x))
+	var before float64
+	for i := 0; i < len(idx); {
+		j := i
+		var w float64
+		for ; j < len(idx) && x[idx[j]] == x[idx[i]]; j++ {
+			w += weightAt(weights, idx[j])
+		}
+		for _, k := range idx[i:j] {
+			ranks[k] = before + (w+1)/2
+		}
+		before += w
+		i = j
+	}
+	return ranks
+}
@@ -0,0 +1,65 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestKendallTau(t *testing.T) {
+	t.Parallel()
+	// Reference values from scipy.stats.kendalltau.
+	x := []float64{12, 2, 1, 12, 2}
+	y := []float64{1, 4, 7, 1, 0}
+	tau, p := KendallTauB(x, y)
+	if !scalar.EqualWithinAbsOrRel(tau, -0.47140452079103173, 1e-14, 1e-14) {
+		t.Errorf("unexpected tau-b: got:%v want:-0.47140452079103173", tau)
+	}
+	if !scalar.EqualWithinAbsOrRel(p, 0.2827454599327748, 1e-12, 1e-12) {
+		t.Errorf("unexpected tau-b p-value: got:%v want:0.2827454599327748", p)
+	}
+	tau, pc := KendallTauC(x, y)
+	if !scalar.EqualWithinAbsOrRel(tau, -0.48, 1e-14, 1e-14) {
+		t.Errorf("unexpected tau-c: got:%v want:-0.48", tau)
+	}
+	if pc != p {
+		t.Errorf("unexpected tau-c p-value: got:%v want:%v", pc, p)
+	}
+
+	// Without ties tau-b is tau-a.
+	x = []float64{8, -3, 7, 9, -4}
+	y = []float64{10, 15, 4, 5, -1}
+	tau, _ = KendallTauB(x, y)
+	if want := Kendall(x, y, nil); !scalar.EqualWithinAbs(tau, want, 1e-14) {
+		t.Errorf("unexpected tau-b without ties: got:%v want:%v", tau, want)
+	}
+}
+
+func TestSpearman(t *testing.T) {
+	t.Parallel()
+	x := []float64{1, 2, 3, 4, 5}
+	y := []float64{5, 6, 7, 8, 7}
+	// The ranks of y are 1, 2, 3.5, 5, 3.5.
+	want := Correlation([]float64{1, 2, 3, 4, 5}, []float64{1, 2, 3.5, 5, 3.5}, nil)
+	if got := Spearman(x, y, nil); !scalar.EqualWithinAbs(got, want, 1e-14) {
+		t.Errorf("unexpected Spearman correlation: got:%v want:%v", got, want)
+	}
+
+	// Monotone transformations do not change the correlation.
+	if got := Spearman(x, []float64{1, 8, 27, 64, 125}, nil); !scalar.EqualWithinAbs(got, 1, 1e-14) {
+		t.Errorf("unexpected Spearman correlation for monotone data: got:%v want:1", got)
+	}
+
+	// Integer weights are equivalent to repeated observations.
+	weights := []float64{1, 3, 1, 2, 1}
+	xr := []float64{1, 2, 2, 2, 3, 4, 4, 5}
+	yr := []float64{5, 6, 6, 6, 7, 8, 8, 7}
+	want = Spearman(xr, yr, nil)
+	if got := Spearman(x, y, weights); !scalar.EqualWithinAbs(got, want, 1e-14) {
+		t.Errorf("unexpected weighted Spearman correlation: got:%v want:%v", got, want)
+	}
+}
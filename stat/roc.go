@@ -196,3 +196,278 @@ func TOC(classes []bool, weights []float64) (min, ntp, max []float64) {
 	}
 	return min, ntp, max
 }
+
+// PrecisionRecall returns paired precision and recall values corresponding
+// to cutoff points on the precision–recall curve obtained when y is treated
+// as a binary classifier for classes with weights. The cutoff thresholds are
+// the unique values of y in descending order and are returned in thresh
+// such that precision[i] and recall[i] are the precision and recall when
+// observations with y >= thresh[i] are classified as true. The precision is
+// the weighted fraction of the observations classified as true that are
+// true, and the recall is the weighted fraction of the true observations
+// that are classified as true.
+//
+// The input y must be sorted ascending, and values in y must correspond to
+// values in classes and weights. SortWeightedLabeled can be used to sort y
+// together with classes and weights.
+//
+// If weights is nil, all weights are treated as 1. If weights is not nil
+// it must have the same length as y and classes, otherwise PrecisionRecall
+// will panic.
+func PrecisionRecall(y []float64, classes []bool, weights []float64) (precision, recall, thresh []float64) {
+	if len(y) != len(classes) {
+		panic("stat: slice length mismatch")
+	}
+	if weights != nil && len(y) != len(weights) {
+		panic("stat: slice length mismatch")
+	}
+	if !sort.Float64sAreSorted(y) {
+		panic("stat: input must be sorted ascending")
+	}
+	if len(y) == 0 {
+		return nil, nil, nil
+	}
+
+	var tp, fp, nPos float64
+	for i, c := range classes {
+		if c {
+			nPos += weightAt(weights, i)
+		}
+	}
+	for i := len(y) - 1; i >= 0; i-- {
+		w := weightAt(weights, i)
+		if classes[i] {
+			tp += w
+		} else {
+			fp += w
+		}
+		if i > 0 && y[i-1] == y[i] {
+			continue
+		}
+		precision = append(precision, tp/(tp+fp))
+		recall = append(recall, tp/nPos)
+		thresh = append(thresh, y[i])
+	}
+	return precision, recall, thresh
+}
+
+// weightAt returns the weight of the observation i,
+// which is 1 if weights is nil.
+func weightAt(weights []float64, i int) float64 {
+	if weights == nil {
+		return 1
+	}
+	return weights[i]
+}
+
+// AveragePrecision returns the average precision of the classifier y for
+// classes with weights, the mean of the precisions at each threshold
+// weighted by the increase in recall from the previous threshold,
+//
+//	\sum_k (recall_k - recall_{k-1}) * precision_k
+//
+// with the precisions and recalls at descending thresholds computed by
+// PrecisionRecall. The average precision summarizes the precision–recall
+// curve without the optimistic bias of interpolation.
+//
+// The inputs are as for PrecisionRecall.
+func AveragePrecision(y []float64, classes []bool, weights []float64) float64 {
+	precision, recall, _ := PrecisionRecall(y, classes, weights)
+	var ap, prev float64
+	for i, p := range precision {
+		ap += (recall[i] - prev) * p
+		prev = recall[i]
+	}
+	return ap
+}
+
+// BrierScore returns the weighted mean squared difference between the
+// predicted probabilities p of the class being true and the observed
+// classes,
+//
+//	\sum_i w_i (p_i - [classes_i])^2 / \sum_i w_i
+//
+// where [x] is 1 if x is true and 0 otherwise. If weights is nil, all
+// weights are treated as 1. BrierScore panics if the lengths of the
+// inputs differ.
+func BrierScore(p []float64, classes []bool, weights []float64) float64 {
+	if len(p) != len(classes) {
+		panic("stat: slice length mismatch")
+	}
+	if weights != nil && len(p) != len(weights) {
+		panic("stat: slice length mismatch")
+	}
+	var sum, sumWeights float64
+	for i, v := range p {
+		if classes[i] {
+			v--
+		}
+		w := weightAt(weights, i)
+		sum += w * v * v
+		sumWeights += w
+	}
+	return sum / sumWeights
+}
+
+// Calibration returns the calibration curve, or reliability diagram, of the
+// predicted probabilities p of the class being true for the observed
+// classes with weights. The predictions are divided into bins of equal
+// width in [0, 1], and for each bin the weighted mean of the predictions,
+// the weighted fraction of true observations and the sum of the weights of
+// the observations in the bin are returned. A prediction of one is placed
+// in the last bin. For a well calibrated classifier the mean predictions
+// and fractions of true observations are close. The mean prediction and
+// fraction of true observations of an empty bin are NaN.
+//
+// If weights is nil, all weights are treated as 1. Calibration panics if
+// bins is less than one, if a prediction is outside [0, 1] or if the
+// lengths of the inputs differ.
+func Calibration(bins int, p []float64, classes []bool, weights []float64) (meanPred, fracTrue, count []float64) {
+	if bins < 1 {
+		panic("stat: invalid number of bins")
+	}
+	if len(p) != len(classes) {
+		panic("stat: slice length mismatch")
+	}
+	if weights != nil && len(p) != len(weights) {
+		panic("stat: slice length mismatch")
+	}
+	meanPred = make([]float64, bins)
+	fracTrue = make([]float64, bins)
+	count = make([]float64, bins)
+	for i, v := range p {
+		if v < 0 || 1 < v {
+			panic("stat: probability out of range")
+		}
+		b := min(int(v*float64(bins)), bins-1)
+		w := weightAt(weights, i)
+		meanPred[b] += w * v
+		if classes[i] {
+			fracTrue[b] += w
+		}
+		count[b] += w
+	}
+	for b, n := range count {
+		meanPred[b] /= n
+		fracTrue[b] /= n
+	}
+	return meanPred, fracTrue, count
+}
+
+// YoudenThreshold returns the threshold of the ROC curve with true and false
+// positive rates tpr and fpr at thresholds thresh, as returned by ROC, that
+// maximizes Youden's J statistic, tpr - fpr, and the value of J at that
+// threshold. If several thresholds attain the maximum, the first is
+// returned. YoudenThreshold panics if the lengths of the inputs differ or
+// are zero.
+func YoudenThreshold(tpr, fpr, thresh []float64) (t, j float64) {
+	if len(tpr) != len(fpr) || len(tpr) != len(thresh) {
+		panic("stat: slice length mismatch")
+	}
+	if len(tpr) == 0 {
+		panic("stat: zero length slice")
+	}
+	best := 0
+	for i := range tpr {
+		if tpr[i]-fpr[i] > tpr[best]-fpr[best] {
+			best = i
+		}
+	}
+	return thresh[best], tpr[best] - fpr[best]
+}
+
+// F1Threshold returns the threshold of the precision–recall curve with
+// precision and recall values at thresholds thresh, as returned by
+// PrecisionRecall, that maximizes the F1 score, the harmonic mean of the
+// precision and the recall, and the F1 score at that threshold. If several
+// thresholds attain the maximum, the first is returned. F1Threshold panics
+// if the lengths of the inputs differ or are zero.
+func F1Threshold(precision, recall, thresh []float64) (t, f1 float64) {
+	if len(precision) != len(recall) || len(precision) != len(thresh) {
+		panic("stat: slice length mismatch")
+	}
+	if len(precision) == 0 {
+		panic("stat: zero length slice")
+	}
+	best, f1 := 0, -1.0
+	for i, p := range precision {
+		var f float64
+		if p+recall[i] > 0 {
+			f = 2 * p * recall[i] / (p + recall[i])
+		}
+		if f > f1 {
+			best, f1 = i, f
+		}
+	}
+	return thresh[best], f1
+}
+
+// DeLong compares the areas under the ROC curves of two classifiers y1 and
+// y2 evaluated on the same observations with classes, using the test of
+// DeLong, DeLong and Clarke-Pearson for correlated ROC curves. It returns
+// the areas under the curves of the classifiers, the z statistic for the
+// difference between the areas, and the two-sided p-value of the test of
+// equal areas from the normal approximation.
+//
+// The area under the curve is the Mann–Whitney estimate of the probability
+// that a classifier scores a true observation above a false one, counting
+// ties as one half. The inputs need not be sorted. DeLong panics if the
+// lengths of the inputs differ, or if there are fewer than two true or
+// two false observations.
+func DeLong(y1, y2 []float64, classes []bool) (auc1, auc2, z, p float64) {
+	if len(y1) != len(classes) || len(y2) != len(classes) {
+		panic("stat: slice length mismatch")
+	}
+	var pos, neg []int
+	for i, c := range classes {
+		if c {
+			pos = append(pos, i)
+		} else {
+			neg = append(neg, i)
+		}
+	}
+	m, n := len(pos), len(neg)
+	if m < 2 || n < 2 {
+		panic("stat: too few observations of a class")
+	}
+
+	// The structural components of the area of each classifier
+	// are the mean placement of each true observation above
+	// the false observations and of each false observation
+	// below the true observations.
+	v10 := [2][]float64{make([]float64, m), make([]float64, m)}
+	v01 := [2][]float64{make([]float64, n), make([]float64, n)}
+	for k, y := range [2][]float64{y1, y2} {
+		for i, pi := range pos {
+			for j, nj := range neg {
+				var psi float64
+				switch {
+				case y[pi] > y[nj]:
+					psi = 1
+				case y[pi] == y[nj]:
+					psi = 0.5
+				}
+				v10[k][i] += psi
+				v01[k][j] += psi
+			}
+		}
+		for i := range v10[k] {
+			v10[k][i] /= float64(n)
+		}
+		for j := range v01[k] {
+			v01[k][j] /= float64(m)
+		}
+	}
+	auc1 = Mean(v10[0], nil)
+	auc2 = Mean(v10[1], nil)
+
+	// The variance of the difference of the areas from the
+	// covariances of the structural components.
+	varDiff := func(v [2][]float64) float64 {
+		return Variance(v[0], nil) + Variance(v[1], nil) - 2*Covariance(v[0], v[1], nil)
+	}
+	variance := varDiff(v10)/float64(m) + varDiff(v01)/float64(n)
+	z = (auc1 - auc2) / math.Sqrt(variance)
+	p = math.Erfc(math.Abs(z) / math.Sqrt2)
+	return auc1, auc2, z, p
+}
@@ -385,3 +385,160 @@ func randomBools(l int, src rand.Source) []bool {
 	}
 	return s
 }
+
+func TestPrecisionRecall(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	y := []float64{0.1, 0.35, 0.4, 0.8}
+	classes := []bool{false, true, false, true}
+
+	precision, recall, thresh := PrecisionRecall(y, classes, nil)
+	wantPrecision := []float64{1, 0.5, 2.0 / 3, 0.5}
+	wantRecall := []float64{0.5, 0.5, 1, 1}
+	wantThresh := []float64{0.8, 0.4, 0.35, 0.1}
+	if !floats.EqualApprox(precision, wantPrecision, tol) {
+		t.Errorf("unexpected precision: got:%v want:%v", precision, wantPrecision)
+	}
+	if !floats.EqualApprox(recall, wantRecall, tol) {
+		t.Errorf("unexpected recall: got:%v want:%v", recall, wantRecall)
+	}
+	if !floats.Equal(thresh, wantThresh) {
+		t.Errorf("unexpected thresholds: got:%v want:%v", thresh, wantThresh)
+	}
+	// The average precision of sklearn's average_precision_score example.
+	if got, want := AveragePrecision(y, classes, nil), 0.5+0.5*2.0/3; math.Abs(got-want) > tol {
+		t.Errorf("unexpected average precision: got:%v want:%v", got, want)
+	}
+	th, f1 := F1Threshold(precision, recall, thresh)
+	if th != 0.35 || math.Abs(f1-0.8) > tol {
+		t.Errorf("unexpected F1 threshold: got:%v (F1=%v) want:0.35 (F1=0.8)", th, f1)
+	}
+
+	// Tied values share a threshold, and weights
+	// are equivalent to repeated observations.
+	y = []float64{1, 2, 2, 3}
+	classes = []bool{false, true, false, true}
+	weights := []float64{1, 2, 1, 1}
+	precision, recall, thresh = PrecisionRecall(y, classes, weights)
+	wantPrecision = []float64{1, 0.75, 0.6}
+	wantRecall = []float64{1.0 / 3, 1, 1}
+	wantThresh = []float64{3, 2, 1}
+	if !floats.EqualApprox(precision, wantPrecision, tol) || !floats.EqualApprox(recall, wantRecall, tol) || !floats.Equal(thresh, wantThresh) {
+		t.Errorf("unexpected weighted curve: got:%v %v %v want:%v %v %v",
+			precision, recall, thresh, wantPrecision, wantRecall, wantThresh)
+	}
+}
+
+func TestBrierScoreCalibration(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	p := []float64{0.1, 0.9, 0.8, 0.3, 1, 0.35}
+	classes := []bool{false, true, true, false, true, true}
+	if got, want := BrierScore(p[:4], classes[:4], nil), (0.01+0.01+0.04+0.09)/4; math.Abs(got-want) > tol {
+		t.Errorf("unexpected Brier score: got:%v want:%v", got, want)
+	}
+	if got, want := BrierScore(p[:2], classes[:2], []float64{3, 1}), 0.01; math.Abs(got-want) > tol {
+		t.Errorf("unexpected weighted Brier score: got:%v want:%v", got, want)
+	}
+
+	meanPred, fracTrue, count := Calibration(4, p, classes, nil)
+	wantMean := []float64{0.1, 0.325, math.NaN(), 0.9}
+	wantFrac := []float64{0, 0.5, math.NaN(), 1}
+	wantCount := []float64{1, 2, 0, 3}
+	if !floats.EqualApprox(count, wantCount, tol) {
+		t.Errorf("unexpected counts: got:%v want:%v", count, wantCount)
+	}
+	if !sameApprox(meanPred, wantMean, tol) {
+		t.Errorf("unexpected mean predictions: got:%v want:%v", meanPred, wantMean)
+	}
+	if !sameApprox(fracTrue, wantFrac, tol) {
+		t.Errorf("unexpected fractions: got:%v want:%v", fracTrue, wantFrac)
+	}
+}
+
+// sameApprox returns whether a and b are equal within tol,
+// treating NaN values as equal.
+func sameApprox(a, b []float64, tol float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, v := range a {
+		if math.IsNaN(v) != math.IsNaN(b[i]) || math.Abs(v-b[i]) > tol {
+			return false
+		}
+	}
+	return true
+}
+
+func TestYoudenThreshold(t *testing.T) {
+	t.Parallel()
+	y := []float64{0, 3, 5, 6, 7.5, 8}
+	classes := []bool{false, true, false, true, true, true}
+	tpr, fpr, thresh := ROC(nil, y, classes, nil)
+	th, j := YoudenThreshold(tpr, fpr, thresh)
+	if th != 6 || math.Abs(j-0.75) > 1e-14 {
+		t.Errorf("unexpected Youden threshold: got:%v (J=%v) want:6 (J=0.75)", th, j)
+	}
+}
+
+func TestDeLong(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 200
+	classes := make([]bool, n)
+	good := make([]float64, n)
+	poor := make([]float64, n)
+	for i := range classes {
+		classes[i] = i%2 == 0
+		var shift float64
+		if classes[i] {
+			shift = 1
+		}
+		good[i] = 2*shift + rnd.NormFloat64()
+		poor[i] = 0.3*shift + rnd.NormFloat64()
+	}
+
+	auc1, auc2, z, p := DeLong(good, poor, classes)
+	for k, y := range [][]float64{good, poor} {
+		want := rocAUC(y, classes)
+		got := []float64{auc1, auc2}[k]
+		if math.Abs(got-want) > 1e-12 {
+			t.Errorf("unexpected AUC for classifier %d: got:%v want:%v", k, got, want)
+		}
+	}
+	if z <= 0 || p > 1e-6 {
+		t.Errorf("expected significant positive difference: z=%v p=%v", z, p)
+	}
+	_, _, zr, pr := DeLong(poor, good, classes)
+	if math.Abs(zr+z) > 1e-12 || math.Abs(pr-p) > 1e-12 {
+		t.Errorf("test not antisymmetric: z=%v p=%v reversed z=%v p=%v", z, p, zr, pr)
+	}
+
+	// Two classifiers of similar quality.
+	other := make([]float64, n)
+	for i := range other {
+		var shift float64
+		if classes[i] {
+			shift = 2
+		}
+		other[i] = shift + rnd.NormFloat64()
+	}
+	_, _, _, p = DeLong(good, other, classes)
+	if p < 0.01 {
+		t.Errorf("unexpected significant difference between similar classifiers: p=%v", p)
+	}
+}
+
+// rocAUC returns the area under the ROC curve
+// computed by the trapezoidal rule.
+func rocAUC(y []float64, classes []bool) float64 {
+	y = slices.Clone(y)
+	classes = slices.Clone(classes)
+	SortWeightedLabeled(y, classes, nil)
+	tpr, fpr, _ := ROC(nil, y, classes, nil)
+	var auc float64
+	for i := 1; i < len(tpr); i++ {
+		auc += (fpr[i] - fpr[i-1]) * (tpr[i] + tpr[i-1]) / 2
+	}
+	return auc
+}
@@ -0,0 +1,232 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package samplemv
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// SampleLogWeighted generates rows(batch) samples using the Importance
+// sampling generation procedure, storing the logs of the importance weights
+// in logWeights. The log weights do not overflow for target and proposal
+// distributions with very different densities, and are the input to PSIS.
+//
+// The length of logWeights must equal the length of batch, otherwise
+// SampleLogWeighted will panic.
+func (l Importance) SampleLogWeighted(batch *mat.Dense, logWeights []float64) {
+	r, _ := batch.Dims()
+	if r != len(logWeights) {
+		panic(errLengthMismatch)
+	}
+	for i := 0; i < r; i++ {
+		v := batch.RawRowView(i)
+		l.Proposal.Rand(v)
+		logWeights[i] = l.Target.LogProb(v) - l.Proposal.LogProb(v)
+	}
+}
+
+// EffectiveSampleSize returns Kish's effective sample size of a sample with
+// the given importance weights,
+//
+//	(\sum_i w_i)² / \sum_i w_i²,
+//
+// the number of independent samples from the target distribution that would
+// give an estimate of a mean with the same variance as the weighted sample,
+// under the approximation that the weights are independent of the function
+// being averaged. The effective sample size is between one and the number of
+// weights, and is equal to the number of weights only if they are all
+// equal. EffectiveSampleSize returns zero if all the weights are zero.
+//
+// EffectiveSampleSize will panic if any weight is negative.
+func EffectiveSampleSize(weights []float64) float64 {
+	var sum, sum2 float64
+	m := maxWeight(weights)
+	if m == 0 {
+		return 0
+	}
+	for _, w := range weights {
+		// Scale by the largest weight
+		// to avoid overflow.
+		w /= m
+		sum += w
+		sum2 += w * w
+	}
+	return sum * sum / sum2
+}
+
+// WeightCV returns the coefficient of variation of the importance weights,
+// the ratio of their standard deviation to their mean, with the standard
+// deviation computed with denominator n for n weights. It is related to the
+// effective sample size by
+//
+//	ESS = n / (1 + CV²).
+//
+// A large coefficient of variation indicates that the estimates are
+// dominated by a small number of samples. WeightCV returns NaN if all the
+// weights are zero.
+//
+// WeightCV will panic if any weight is negative.
+func WeightCV(weights []float64) float64 {
+	m := maxWeight(weights)
+	if m == 0 {
+		return math.NaN()
+	}
+	n := float64(len(weights))
+	var sum, sum2 float64
+	for _, w := range weights {
+		w /= m
+		sum += w
+		sum2 += w * w
+	}
+	return math.Sqrt(max(n*sum2/(sum*sum)-1, 0))
+}
+
+// maxWeight returns the largest weight, panicking if any weight is negative.
+func maxWeight(weights []float64) float64 {
+	var m float64
+	for _, w := range weights {
+		if w < 0 {
+			panic("samplemv: negative weight")
+		}
+		m = max(m, w)
+	}
+	return m
+}
+
+// PSIS performs Pareto-smoothed importance sampling, storing in dst the
+// smoothed logs of the importance weights with the logs of the raw weights
+// in logWeights and returning the result and the estimated shape parameter
+// k̂ of the generalized Pareto distribution fitted to the upper tail of the
+// weights. If dst is nil, a new slice is allocated. dst and logWeights may
+// be the same slice.
+//
+// The M = ⌈min(n/5, 3√n)⌉ largest of the n weights are replaced by the
+// expected order statistics of a generalized Pareto distribution fitted to
+// them by the method of Zhang and Stephens, truncated at the largest raw
+// weight, and the other weights are unchanged. Smoothing reduces the
+// variance of importance sampling estimates at the cost of a small bias.
+//
+// The estimate k̂ is a diagnostic of the reliability of the estimates; the
+// importance weights have finite variance if k < 1/2 and finite mean if
+// k < 1. Vehtari et al. recommend that estimates are trusted only if k̂ is
+// less than 0.7. If the tail has fewer than five samples or all the tail
+// weights are equal, the weights are unchanged and k̂ is +Inf.
+//
+// See Vehtari, A., Simpson, D., Gelman, A., Yao, Y. and Gabry, J. (2024)
+// Pareto smoothed importance sampling. Journal of Machine Learning Research
+// 25(72) for details.
+//
+// PSIS will panic if dst is not nil and its length is not equal to the
+// length of logWeights.
+func PSIS(dst, logWeights []float64) (smoothed []float64, khat float64) {
+	n := len(logWeights)
+	if dst == nil {
+		dst = make([]float64, n)
+	} else if len(dst) != n {
+		panic(errLengthMismatch)
+	}
+	if n == 0 {
+		return dst, math.Inf(1)
+	}
+
+	// Shift the log weights so the largest
+	// is zero for safe exponentiation.
+	shift := floats.Max(logWeights)
+	lw := make([]float64, n)
+	for i, v := range logWeights {
+		lw[i] = v - shift
+	}
+
+	copy(dst, logWeights)
+	khat = math.Inf(1)
+	m := int(math.Ceil(min(0.2*float64(n), 3*math.Sqrt(float64(n)))))
+	if m >= 5 && m < n {
+		idx := make([]int, n)
+		for i := range idx {
+			idx[i] = i
+		}
+		sort.SliceStable(idx, func(a, b int) bool { return lw[idx[a]] < lw[idx[b]] })
+		tail := idx[n-m:]
+		if lw[tail[m-1]]-lw[tail[0]] > 0 {
+			cutoff := math.Exp(lw[idx[n-m-1]])
+			x := make([]float64, m)
+			for i, j := range tail {
+				x[i] = math.Exp(lw[j]) - cutoff
+			}
+			k, sigma := gpdFit(x)
+			if !math.IsInf(k, 0) && !math.IsNaN(k) {
+				khat = k
+				// Truncate the smoothed weights at the
+				// largest raw weight and undo the shift.
+				for i, j := range tail {
+					p := (float64(i) + 0.5) / float64(m)
+					dst[j] = min(math.Log(gpdQuantile(p, k, sigma)+cutoff), 0) + shift
+				}
+			}
+		}
+	}
+	return dst, khat
+}
+
+// gpdFit returns the estimates of the shape k and scale σ of a generalized
+// Pareto distribution with location zero fitted to the sorted positive
+// values in x by the empirical Bayes method of Zhang and Stephens (2009),
+// with the estimate of k shrunk towards 1/2 by a weakly informative prior
+// as described by Vehtari et al.
+//
+// The distribution function of the generalized Pareto distribution is
+//
+//	F(x) = 1 - (1 + k x / σ)^(-1/k).
+func gpdFit(x []float64) (k, sigma float64) {
+	const prior = 3
+	n := len(x)
+	m := 30 + int(math.Sqrt(float64(n)))
+
+	// The grid of values of θ = -k/σ weighted by
+	// their profile likelihood.
+	quartile := x[int(float64(n)/4+0.5)-1]
+	theta := make([]float64, m)
+	logLik := make([]float64, m)
+	for j := range theta {
+		theta[j] = 1/x[n-1] + (1-math.Sqrt(float64(m)/(float64(j)+0.5)))/prior/quartile
+		kj := gpdMeanLog1p(-theta[j], x)
+		logLik[j] = float64(n) * (math.Log(-theta[j]/kj) - kj - 1)
+	}
+	lse := floats.LogSumExp(logLik)
+	var thetaHat float64
+	for j, t := range theta {
+		thetaHat += t * math.Exp(logLik[j]-lse)
+	}
+
+	k = gpdMeanLog1p(-thetaHat, x)
+	sigma = -k / thetaHat
+
+	// Shrink the estimate of k towards 1/2.
+	const a = 10
+	k = (k*float64(n) + 0.5*a) / (float64(n) + a)
+	return k, sigma
+}
+
+// gpdMeanLog1p returns the mean of log(1 + a x_i).
+func gpdMeanLog1p(a float64, x []float64) float64 {
+	var sum float64
+	for _, v := range x {
+		sum += math.Log1p(a * v)
+	}
+	return sum / float64(len(x))
+}
+
+// gpdQuantile returns the p quantile of the generalized Pareto distribution
+// with location zero, shape k and scale sigma.
+func gpdQuantile(p, k, sigma float64) float64 {
+	if k == 0 {
+		return -sigma * math.Log1p(-p)
+	}
+	return sigma * math.Expm1(-k*math.Log1p(-p)) / k
+}
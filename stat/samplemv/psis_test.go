@@ -0,0 +1,198 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package samplemv
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/distmv"
+)
+
+func TestEffectiveSampleSize(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		weights []float64
+		ess, cv float64
+	}{
+		{weights: []float64{1, 1, 1, 1}, ess: 4, cv: 0},
+		{weights: []float64{2, 2, 2}, ess: 3, cv: 0},
+		{weights: []float64{0, 0, 5, 0}, ess: 1, cv: math.Sqrt(3)},
+		{weights: []float64{1, 3}, ess: 16.0 / 10, cv: 0.5},
+		{weights: []float64{1e300, 3e300}, ess: 16.0 / 10, cv: 0.5},
+		{weights: []float64{0, 0}, ess: 0, cv: math.NaN()},
+	} {
+		ess := EffectiveSampleSize(test.weights)
+		if !scalar.EqualWithinAbsOrRel(ess, test.ess, 1e-14, 1e-14) {
+			t.Errorf("unexpected effective sample size for %v: got:%v want:%v", test.weights, ess, test.ess)
+		}
+		cv := WeightCV(test.weights)
+		if !scalar.Same(cv, test.cv) && !scalar.EqualWithinAbsOrRel(cv, test.cv, 1e-14, 1e-14) {
+			t.Errorf("unexpected coefficient of variation for %v: got:%v want:%v", test.weights, cv, test.cv)
+		}
+		if ess != 0 {
+			n := float64(len(test.weights))
+			if !scalar.EqualWithinAbsOrRel(ess, n/(1+cv*cv), 1e-14, 1e-14) {
+				t.Errorf("effective sample size and coefficient of variation inconsistent for %v", test.weights)
+			}
+		}
+	}
+	if !panics(func() { EffectiveSampleSize([]float64{1, -1}) }) {
+		t.Errorf("expected panic for negative weight")
+	}
+	if !panics(func() { WeightCV([]float64{1, -1}) }) {
+		t.Errorf("expected panic for negative weight")
+	}
+}
+
+func TestGPDFit(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		k, sigma float64
+	}{
+		{k: 0.2, sigma: 1},
+		{k: 0.5, sigma: 2},
+		{k: 0.9, sigma: 0.5},
+		{k: -0.3, sigma: 1},
+	} {
+		const n = 10000
+		x := make([]float64, n)
+		for i := range x {
+			x[i] = gpdQuantile(rnd.Float64(), test.k, test.sigma)
+		}
+		slices.Sort(x)
+		k, sigma := gpdFit(x)
+		if math.Abs(k-test.k) > 0.05 {
+			t.Errorf("unexpected shape estimate: got:%v want:%v", k, test.k)
+		}
+		if !scalar.EqualWithinRel(sigma, test.sigma, 0.05) {
+			t.Errorf("unexpected scale estimate for k=%v: got:%v want:%v", test.k, sigma, test.sigma)
+		}
+	}
+}
+
+func TestPSIS(t *testing.T) {
+	t.Parallel()
+	target, ok := distmv.NewNormal([]float64{0, 0}, mat.NewSymDense(2, []float64{1, 0, 0, 1}), nil)
+	if !ok {
+		t.Fatal("bad test")
+	}
+	for _, test := range []struct {
+		name      string
+		scale     float64
+		reliable  bool
+		seed      uint64
+		nSamples  int
+		wantTrunc bool
+	}{
+		// A proposal wider than the target gives
+		// bounded weights.
+		{name: "wide", scale: 2, reliable: true, seed: 1, nSamples: 4000},
+		// A proposal narrower than the target gives
+		// weights with infinite variance.
+		{name: "narrow", scale: 0.4, reliable: false, seed: 2, nSamples: 4000},
+	} {
+		sigma := mat.NewSymDense(2, []float64{test.scale * test.scale, 0, 0, test.scale * test.scale})
+		proposal, ok := distmv.NewNormal([]float64{0, 0}, sigma, rand.NewPCG(test.seed, test.seed))
+		if !ok {
+			t.Fatal("bad test")
+		}
+		batch := mat.NewDense(test.nSamples, 2, nil)
+		logWeights := make([]float64, test.nSamples)
+		Importance{Target: target, Proposal: proposal}.SampleLogWeighted(batch, logWeights)
+
+		smoothed, khat := PSIS(nil, logWeights)
+		if test.reliable && !(khat < 0.5) {
+			t.Errorf("unexpected k-hat for %s proposal: got:%v want:<0.5", test.name, khat)
+		}
+		if !test.reliable && !(khat > 0.7) {
+			t.Errorf("unexpected k-hat for %s proposal: got:%v want:>0.7", test.name, khat)
+		}
+
+		// Smoothing preserves the order of the weights,
+		// leaves the weights below the tail unchanged
+		// and does not exceed the largest raw weight.
+		maxRaw := floats.Max(logWeights)
+		m := int(math.Ceil(min(0.2*float64(test.nSamples), 3*math.Sqrt(float64(test.nSamples)))))
+		sorted := slices.Clone(logWeights)
+		slices.Sort(sorted)
+		cutoff := sorted[test.nSamples-m-1]
+		var changed int
+		for i, lw := range logWeights {
+			if smoothed[i] > maxRaw {
+				t.Errorf("smoothed weight exceeds largest raw weight for %s proposal", test.name)
+				break
+			}
+			if lw <= cutoff && smoothed[i] != lw {
+				t.Errorf("weight below tail changed for %s proposal: got:%v want:%v", test.name, smoothed[i], lw)
+				break
+			}
+			if smoothed[i] != lw {
+				changed++
+			}
+			for j := range logWeights {
+				if logWeights[i] < logWeights[j] && smoothed[i] > smoothed[j] {
+					t.Fatalf("smoothing does not preserve order for %s proposal", test.name)
+				}
+			}
+		}
+		if changed > m {
+			t.Errorf("too many weights changed for %s proposal: got:%d want:<=%d", test.name, changed, m)
+		}
+
+		// The smoothed weights give an estimate of the
+		// second moment of the target close to its value.
+		w := make([]float64, test.nSamples)
+		for i, v := range smoothed {
+			w[i] = math.Exp(v - maxRaw)
+		}
+		var num, den float64
+		for i := range w {
+			x := batch.RawRowView(i)
+			num += w[i] * floats.Dot(x, x)
+			den += w[i]
+		}
+		if test.reliable && !scalar.EqualWithinRel(num/den, 2, 0.1) {
+			t.Errorf("unexpected estimate of second moment for %s proposal: got:%v want:2", test.name, num/den)
+		}
+		if ess := EffectiveSampleSize(w); ess <= 1 || float64(test.nSamples) < ess {
+			t.Errorf("unexpected effective sample size for %s proposal: %v", test.name, ess)
+		}
+	}
+
+	// Too few samples for tail fitting.
+	lw := []float64{-1, 0, 2, 1}
+	got, khat := PSIS(nil, lw)
+	if !math.IsInf(khat, 1) || !floats.Equal(got, lw) {
+		t.Errorf("unexpected result for small sample: got:%v %v", got, khat)
+	}
+	// Smoothing in place.
+	lw = make([]float64, 100)
+	for i := range lw {
+		lw[i] = float64(i) / 10
+	}
+	want, _ := PSIS(nil, lw)
+	PSIS(lw, lw)
+	if !floats.Equal(lw, want) {
+		t.Errorf("unexpected result for smoothing in place")
+	}
+	if !panics(func() { PSIS(make([]float64, 2), lw) }) {
+		t.Errorf("expected panic for length mismatch")
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
@@ -0,0 +1,198 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package samplemv
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/distmv"
+)
+
+var _ Sampler = (*ParallelTempering)(nil)
+
+// ParallelTempering is a type for generating samples using parallel tempering,
+// also known as replica exchange Markov chain Monte Carlo. Parallel tempering
+// runs a Metropolis-Hastings chain for each of the temperatures T_i in
+// Temperatures, with the chain at temperature T targeting the tempered
+// distribution
+//
+//	p_T(x) ∝ target(x)^(1/T),
+//
+// which is flatter than the target for T > 1. Every SwapInterval iterations,
+// exchanges of the locations of chains at adjacent temperatures are proposed
+// and accepted with probability
+//
+//	p = min(1, (target(x_j) / target(x_i))^(1/T_i - 1/T_j)),
+//
+// which leaves the joint distribution of the chains invariant. Hot chains
+// move easily between the modes of a multimodal target and the exchanges
+// allow the cold chain, the chain at temperature one, to do the same. The
+// samples stored in batch are those of the cold chain.
+//
+// Temperatures must be at least one and the first must be one. The
+// temperatures are typically increasing and geometrically spaced, chosen so
+// that the swap acceptance rates reported by SwapAcceptance are not small.
+// Exchanges are proposed alternately between the even and odd pairs of
+// adjacent chains. If SwapInterval is 0 it is defaulted to 1.
+//
+// Proposals holds the proposal distribution for each chain. Hotter chains
+// typically need wider proposals for reasonable acceptance rates. If
+// Proposals has length one, the same proposal is used for every chain.
+//
+// BurnIn and Rate are as for MetropolisHastingser and count iterations of
+// all of the chains. All the chains start at Initial, and the initial value
+// is NOT changed during calls to Sample. If Src != nil, it will be used to
+// generate random numbers for acceptance and exchange, otherwise
+// rand.Float64 will be used.
+type ParallelTempering struct {
+	Initial      []float64
+	Target       distmv.LogProber
+	Proposals    []MHProposal
+	Temperatures []float64
+	Src          rand.Source
+
+	SwapInterval int
+	BurnIn       int
+	Rate         int
+
+	swapped, proposed []int
+}
+
+// SwapAcceptance stores in dst the fraction of proposed exchanges that were
+// accepted between each pair of chains at adjacent temperatures during the
+// most recent call to Sample and returns the result. If dst is nil, a new
+// slice is allocated. Element i of the result is the acceptance rate of
+// exchanges between chains i and i+1, and is NaN if no exchanges between
+// them were proposed.
+//
+// SwapAcceptance will panic if dst is not nil and its length is not one
+// less than the number of temperatures during the most recent call to
+// Sample.
+func (p *ParallelTempering) SwapAcceptance(dst []float64) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(p.proposed))
+	} else if len(dst) != len(p.proposed) {
+		panic(errLengthMismatch)
+	}
+	for i, n := range p.proposed {
+		if n == 0 {
+			dst[i] = math.NaN()
+			continue
+		}
+		dst[i] = float64(p.swapped[i]) / float64(n)
+	}
+	return dst
+}
+
+// Sample generates rows(batch) samples from the cold chain using parallel
+// tempering. The initial location is NOT updated during the call to Sample.
+//
+// Sample will panic if the number of columns in batch is not equal to
+// len(p.Initial), there are no temperatures, the first temperature is not
+// one or any temperature is less than one, or the number of proposals is
+// neither one nor the number of temperatures.
+func (p *ParallelTempering) Sample(batch *mat.Dense) {
+	r, c := batch.Dims()
+	if len(p.Initial) != c {
+		panic("paralleltempering: length mismatch")
+	}
+	if c == 0 {
+		panic("paralleltempering: zero length initial")
+	}
+	n := len(p.Temperatures)
+	if n == 0 {
+		panic("paralleltempering: no temperatures")
+	}
+	if p.Temperatures[0] != 1 {
+		panic("paralleltempering: first temperature not one")
+	}
+	beta := make([]float64, n)
+	for i, t := range p.Temperatures {
+		if !(t >= 1) {
+			panic("paralleltempering: temperature less than one")
+		}
+		beta[i] = 1 / t
+	}
+	proposals := p.Proposals
+	switch len(proposals) {
+	case n:
+	case 1:
+		proposals = make([]MHProposal, n)
+		for i := range proposals {
+			proposals[i] = p.Proposals[0]
+		}
+	default:
+		panic("paralleltempering: proposal count mismatch")
+	}
+	interval := p.SwapInterval
+	if interval == 0 {
+		interval = 1
+	}
+	rate := p.Rate
+	if rate == 0 {
+		rate = 1
+	}
+	f64 := rand.Float64
+	if p.Src != nil {
+		f64 = rand.New(p.Src).Float64
+	}
+
+	p.swapped = make([]int, n-1)
+	p.proposed = make([]int, n-1)
+
+	// The locations of the chains and the untempered
+	// log probabilities of the target at them.
+	x := make([][]float64, n)
+	logProb := make([]float64, n)
+	initialLogProb := p.Target.LogProb(p.Initial)
+	for i := range x {
+		x[i] = make([]float64, c)
+		copy(x[i], p.Initial)
+		logProb[i] = initialLogProb
+	}
+	proposed := make([]float64, c)
+
+	var iter, odd int
+	step := func() {
+		for i := range x {
+			proposals[i].ConditionalRand(proposed, x[i])
+			proposedLogProb := p.Target.LogProb(proposed)
+			probTo := proposals[i].ConditionalLogProb(proposed, x[i])
+			probBack := proposals[i].ConditionalLogProb(x[i], proposed)
+
+			accept := math.Exp(beta[i]*(proposedLogProb-logProb[i]) + probBack - probTo)
+			if accept > f64() {
+				copy(x[i], proposed)
+				logProb[i] = proposedLogProb
+			}
+		}
+		iter++
+		if iter%interval != 0 {
+			return
+		}
+		for i := odd; i < n-1; i += 2 {
+			p.proposed[i]++
+			accept := math.Exp((beta[i] - beta[i+1]) * (logProb[i+1] - logProb[i]))
+			if accept > f64() {
+				x[i], x[i+1] = x[i+1], x[i]
+				logProb[i], logProb[i+1] = logProb[i+1], logProb[i]
+				p.swapped[i]++
+			}
+		}
+		odd = 1 - odd
+	}
+
+	for range p.BurnIn {
+		step()
+	}
+	for i := range r {
+		for range rate {
+			step()
+		}
+		batch.SetRow(i, x[0])
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package samplemv
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// bimodal is a mixture of two well-separated normal
+// distributions in one dimension with weights w and 1-w.
+type bimodal struct {
+	w     float64
+	a, b  distuv.Normal
+	count int
+}
+
+func (d *bimodal) LogProb(x []float64) float64 {
+	d.count++
+	return floats.LogSumExp([]float64{
+		math.Log(d.w) + d.a.LogProb(x[0]),
+		math.Log(1-d.w) + d.b.LogProb(x[0]),
+	})
+}
+
+func TestParallelTempering(t *testing.T) {
+	t.Parallel()
+	target := &bimodal{
+		w: 0.3,
+		a: distuv.Normal{Mu: -4, Sigma: 0.5},
+		b: distuv.Normal{Mu: 4, Sigma: 0.5},
+	}
+	wantMean := 0.3*-4 + 0.7*4
+	wantLower := 0.3
+
+	temps := []float64{1, 2.5, 6, 15, 40}
+	proposals := make([]MHProposal, len(temps))
+	for i, temp := range temps {
+		var ok bool
+		proposals[i], ok = NewProposalNormal(mat.NewSymDense(1, []float64{0.5 * temp}), rand.NewPCG(uint64(i), 1))
+		if !ok {
+			t.Fatal("bad test")
+		}
+	}
+
+	const n = 20000
+	batch := mat.NewDense(n, 1, nil)
+	pt := &ParallelTempering{
+		Initial:      []float64{4},
+		Target:       target,
+		Proposals:    proposals,
+		Temperatures: temps,
+		Src:          rand.NewPCG(1, 2),
+		BurnIn:       1000,
+		Rate:         2,
+	}
+	pt.Sample(batch)
+	if want := len(temps) * (pt.BurnIn + n*pt.Rate); target.count != want+1 {
+		t.Errorf("unexpected number of target evaluations: got:%d want:%d", target.count, want+1)
+	}
+
+	x := mat.Col(nil, 0, batch)
+	var lower int
+	for _, v := range x {
+		if v < 0 {
+			lower++
+		}
+	}
+	if frac := float64(lower) / n; math.Abs(frac-wantLower) > 0.05 {
+		t.Errorf("unexpected fraction of samples in lower mode: got:%v want:%v", frac, wantLower)
+	}
+	if mean := stat.Mean(x, nil); math.Abs(mean-wantMean) > 0.4 {
+		t.Errorf("unexpected sample mean: got:%v want:%v", mean, wantMean)
+	}
+	rates := pt.SwapAcceptance(nil)
+	if len(rates) != len(temps)-1 {
+		t.Fatalf("unexpected number of swap acceptance rates: got:%d want:%d", len(rates), len(temps)-1)
+	}
+	for i, r := range rates {
+		if r < 0.2 || 1 < r {
+			t.Errorf("unexpected swap acceptance rate between chains %d and %d: %v", i, i+1, r)
+		}
+	}
+	if pt.Initial[0] != 4 {
+		t.Errorf("initial location modified")
+	}
+
+	// A single chain with the same proposal
+	// does not leave the initial mode.
+	single := &ParallelTempering{
+		Initial:      []float64{4},
+		Target:       target,
+		Proposals:    proposals[:1],
+		Temperatures: []float64{1},
+		Src:          rand.NewPCG(1, 2),
+		BurnIn:       1000,
+	}
+	single.Sample(batch)
+	if lo := floats.Min(mat.Col(nil, 0, batch)); lo < 0 {
+		t.Errorf("unexpected sample in lower mode for single chain: %v", lo)
+	}
+	if got := single.SwapAcceptance(nil); len(got) != 0 {
+		t.Errorf("unexpected swap acceptance rates for single chain: %v", got)
+	}
+
+	for _, test := range []struct {
+		name string
+		pt   ParallelTempering
+	}{
+		{name: "length mismatch", pt: ParallelTempering{Initial: []float64{0, 0}, Target: target, Proposals: proposals, Temperatures: temps}},
+		{name: "no temperatures", pt: ParallelTempering{Initial: []float64{0}, Target: target, Proposals: proposals}},
+		{name: "first temperature", pt: ParallelTempering{Initial: []float64{0}, Target: target, Proposals: proposals[:1], Temperatures: []float64{2, 4}}},
+		{name: "low temperature", pt: ParallelTempering{Initial: []float64{0}, Target: target, Proposals: proposals[:1], Temperatures: []float64{1, 0.5}}},
+		{name: "proposal count", pt: ParallelTempering{Initial: []float64{0}, Target: target, Proposals: proposals[:2], Temperatures: temps}},
+	} {
+		if !panics(func() { test.pt.Sample(batch) }) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sampleuv
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+// Systematic samples len(idxs) integers from [0, n) by systematic sampling.
+// With the sampling interval k = n/len(idxs), a start u is drawn uniformly
+// from [0, k) and idxs[i] is set to floor(u + i*k), so every integer is
+// included with probability len(idxs)/n and the returned integers are
+// unique and increasing. If src is non-nil it will be used to generate
+// random numbers, otherwise the default source from the math/rand/v2
+// package will be used.
+//
+// Systematic will panic if len(idxs) is zero or greater than n.
+func Systematic(idxs []int, n int, src rand.Source) {
+	if len(idxs) == 0 {
+		panic("systematic: zero length input")
+	}
+	if len(idxs) > n {
+		panic("systematic: impossible size inputs")
+	}
+	k := float64(n) / float64(len(idxs))
+	var u float64
+	if src != nil {
+		u = k * rand.New(src).Float64()
+	} else {
+		u = k * rand.Float64()
+	}
+	for i := range idxs {
+		idxs[i] = min(int(math.Floor(u+float64(i)*k)), n-1)
+	}
+}
+
+// Stratified draws a stratified simple random sample without replacement
+// from a population whose unit i is in stratum strata[i]. It returns the
+// indices of the sampled units, with sizes[h] units drawn from stratum h,
+// grouped by stratum in increasing stratum order. If src is non-nil it
+// will be used to generate random numbers, otherwise the default source
+// from the math/rand/v2 package will be used.
+//
+// Stratified will panic if a stratum is out of the range of sizes, or if
+// sizes[h] is negative or greater than the number of units in stratum h.
+func Stratified(strata, sizes []int, src rand.Source) []int {
+	units := make([][]int, len(sizes))
+	var total int
+	for i, h := range strata {
+		if h < 0 || len(sizes) <= h {
+			panic("stratified: stratum out of range")
+		}
+		units[h] = append(units[h], i)
+	}
+	for h, n := range sizes {
+		if n < 0 || len(units[h]) < n {
+			panic("stratified: impossible size inputs")
+		}
+		total += n
+	}
+	idxs := make([]int, 0, total)
+	for h, n := range sizes {
+		if n == 0 {
+			continue
+		}
+		sample := make([]int, n)
+		WithoutReplacement(sample, len(units[h]), src)
+		for _, j := range sample {
+			idxs = append(idxs, units[h][j])
+		}
+	}
+	return idxs
+}
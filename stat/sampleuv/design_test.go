@@ -0,0 +1,82 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sampleuv
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestSystematic(t *testing.T) {
+	t.Parallel()
+	src := rand.NewPCG(1, 1)
+	for _, test := range []struct{ n, k int }{
+		{n: 10, k: 10}, {n: 10, k: 3}, {n: 17, k: 5}, {n: 100, k: 1},
+	} {
+		const trials = 100000
+		dist := make([]float64, test.n)
+		idxs := make([]int, test.k)
+		for range trials {
+			Systematic(idxs, test.n, src)
+			if !slices.IsSorted(idxs) || len(slices.Compact(slices.Clone(idxs))) != test.k {
+				t.Fatalf("n=%d k=%d: indices not unique and increasing: %v", test.n, test.k, idxs)
+			}
+			for _, v := range idxs {
+				dist[v]++
+			}
+		}
+		floats.Scale(1/float64(trials), dist)
+		want := make([]float64, test.n)
+		for i := range want {
+			want[i] = float64(test.k) / float64(test.n)
+		}
+		if !floats.EqualApprox(dist, want, 1e-2) {
+			t.Errorf("n=%d k=%d: unexpected inclusion probabilities: got:%v want:%v", test.n, test.k, dist, want)
+		}
+	}
+}
+
+func TestStratified(t *testing.T) {
+	t.Parallel()
+	src := rand.NewPCG(1, 1)
+	strata := []int{0, 1, 0, 2, 1, 1, 0, 2, 1, 0, 1}
+	sizes := []int{2, 3, 0}
+	for range 100 {
+		idxs := Stratified(strata, sizes, src)
+		if len(idxs) != 5 {
+			t.Fatalf("unexpected sample size: got:%d want:5", len(idxs))
+		}
+		counts := make([]int, len(sizes))
+		seen := make(map[int]bool)
+		for i, v := range idxs {
+			if seen[v] {
+				t.Fatalf("repeated index in sample: %v", idxs)
+			}
+			seen[v] = true
+			h := strata[v]
+			if i > 0 && strata[idxs[i-1]] > h {
+				t.Fatalf("sample not grouped by stratum: %v", idxs)
+			}
+			counts[h]++
+		}
+		if !slices.Equal(counts, sizes) {
+			t.Fatalf("unexpected stratum sizes: got:%v want:%v", counts, sizes)
+		}
+	}
+
+	for _, sizes := range [][]int{{5, 0, 0}, {1, 1}, {-1, 0, 0}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic for sizes %v", sizes)
+				}
+			}()
+			Stratified(strata, sizes, src)
+		}()
+	}
+}
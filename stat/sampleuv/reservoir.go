@@ -0,0 +1,105 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sampleuv
+
+import (
+	"container/heap"
+	"math"
+	"math/rand/v2"
+	"sort"
+)
+
+// Reservoir maintains a weighted random sample without replacement of k
+// items from a stream of items of unknown length using the A-Res algorithm
+// of Efraimidis and Spirakis, "Weighted random sampling with a reservoir",
+// Inf. Process. Lett. 97(5), 2006. Each item is assigned the key u^(1/w)
+// for its weight w and a uniform random u, and the sample holds the items
+// with the k largest keys. With equal weights the sample is a simple
+// random sample of the items.
+type Reservoir struct {
+	n    int
+	keys reservoirHeap
+	rnd  *rand.Rand
+}
+
+// NewReservoir returns a Reservoir holding a sample of at most k items. If
+// src is nil, the default source from the math/rand/v2 package is used.
+// NewReservoir panics if k is less than one.
+func NewReservoir(k int, src rand.Source) *Reservoir {
+	if k < 1 {
+		panic("reservoir: non-positive sample size")
+	}
+	r := &Reservoir{keys: make(reservoirHeap, 0, k)}
+	if src != nil {
+		r.rnd = rand.New(src)
+	}
+	return r
+}
+
+// Add offers the next item of the stream, which has weight w, to the
+// sample. The items are identified by the order in which they are
+// offered, starting from zero. Add panics if w is negative; items with
+// zero weight are never sampled.
+func (r *Reservoir) Add(w float64) {
+	if w < 0 {
+		panic("reservoir: negative weight")
+	}
+	idx := r.n
+	r.n++
+	if w == 0 {
+		return
+	}
+	var u float64
+	if r.rnd == nil {
+		u = rand.Float64()
+	} else {
+		u = r.rnd.Float64()
+	}
+	// Compare keys in log space to avoid underflow
+	// of u^(1/w) for small weights.
+	key := math.Log(u) / w
+	if len(r.keys) < cap(r.keys) {
+		heap.Push(&r.keys, reservoirItem{key: key, idx: idx})
+		return
+	}
+	if key > r.keys[0].key {
+		r.keys[0] = reservoirItem{key: key, idx: idx}
+		heap.Fix(&r.keys, 0)
+	}
+}
+
+// Len returns the number of items offered to the sample.
+func (r *Reservoir) Len() int {
+	return r.n
+}
+
+// Sample returns the indices of the sampled items in increasing order.
+func (r *Reservoir) Sample() []int {
+	idx := make([]int, len(r.keys))
+	for i, it := range r.keys {
+		idx[i] = it.idx
+	}
+	sort.Ints(idx)
+	return idx
+}
+
+type reservoirItem struct {
+	key float64
+	idx int
+}
+
+// reservoirHeap is a min-heap of items ordered by key.
+type reservoirHeap []reservoirItem
+
+func (h reservoirHeap) Len() int            { return len(h) }
+func (h reservoirHeap) Less(i, j int) bool  { return h[i].key < h[j].key }
+func (h reservoirHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *reservoirHeap) Push(x interface{}) { *h = append(*h, x.(reservoirItem)) }
+func (h *reservoirHeap) Pop() interface{} {
+	old := *h
+	it := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return it
+}
@@ -0,0 +1,69 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sampleuv
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestReservoir(t *testing.T) {
+	t.Parallel()
+	src := rand.NewPCG(1, 1)
+	const trials = 100000
+
+	// A sample of one item is drawn with
+	// probability proportional to the weights.
+	weights := []float64{1, 2, 0, 3, 4}
+	dist := make([]float64, len(weights))
+	for range trials {
+		r := NewReservoir(1, src)
+		for _, w := range weights {
+			r.Add(w)
+		}
+		s := r.Sample()
+		if len(s) != 1 {
+			t.Fatalf("unexpected sample size: got:%d want:1", len(s))
+		}
+		dist[s[0]]++
+	}
+	floats.Scale(1/float64(trials), dist)
+	want := []float64{0.1, 0.2, 0, 0.3, 0.4}
+	if !floats.EqualApprox(dist, want, 1e-2) {
+		t.Errorf("unexpected selection probabilities: got:%v want:%v", dist, want)
+	}
+
+	// With equal weights every item is included
+	// with probability k/n.
+	const n, k = 20, 5
+	dist = make([]float64, n)
+	for range trials {
+		r := NewReservoir(k, src)
+		for range n {
+			r.Add(1)
+		}
+		if r.Len() != n {
+			t.Fatalf("unexpected length: got:%d want:%d", r.Len(), n)
+		}
+		s := r.Sample()
+		if len(s) != k {
+			t.Fatalf("unexpected sample size: got:%d want:%d", len(s), k)
+		}
+		for i, v := range s {
+			if i > 0 && s[i-1] >= v {
+				t.Fatalf("sample not unique and increasing: %v", s)
+			}
+			dist[v]++
+		}
+	}
+	floats.Scale(1/float64(trials), dist)
+	for i, p := range dist {
+		if d := p - float64(k)/n; d < -1e-2 || 1e-2 < d {
+			t.Errorf("unexpected inclusion probability for item %d: got:%v want:%v", i, p, float64(k)/n)
+		}
+	}
+}
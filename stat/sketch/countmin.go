@@ -0,0 +1,164 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sketch
+
+import (
+	"errors"
+	"hash"
+	"math"
+	"reflect"
+)
+
+// CountMin is a count-min sketch estimating the frequencies of the items in
+// a stream as described in Cormode and Muthukrishnan, "An improved data
+// stream summary: the count-min sketch and its applications", J. Algorithms
+// 55(1), 2005. The estimated count of an item is never less than its true
+// count, and with a sketch of width ⌈e/ε⌉ and depth ⌈ln(1/δ)⌉ it exceeds
+// the true count by at most ε times the total count with probability at
+// least 1-δ.
+type CountMin struct {
+	width, depth int
+
+	// conservative specifies whether to use the
+	// conservative update of Estan and Varghese.
+	conservative bool
+
+	total  uint64
+	counts []uint64
+
+	hash hash.Hash64
+	idx  []int
+}
+
+// CountMinDims returns the width and depth of a count-min sketch whose
+// estimates exceed the true counts by at most eps times the total count
+// with probability at least 1-delta. CountMinDims panics if eps or delta
+// is not in (0, 1).
+func CountMinDims(eps, delta float64) (width, depth int) {
+	if eps <= 0 || 1 <= eps || delta <= 0 || 1 <= delta {
+		panic("sketch: parameter out of range")
+	}
+	return int(math.Ceil(math.E / eps)), int(math.Ceil(math.Log(1 / delta)))
+}
+
+// NewCountMin returns a new count-min sketch with the given width and depth
+// using the hash function h. If conservative is true, an update increases
+// only the counters that are smaller than the new estimate of the item,
+// which reduces the overestimation of counts but prevents the counts being
+// decremented and the sketch being combined by Union.
+func NewCountMin(width, depth int, conservative bool, h hash.Hash64) (*CountMin, error) {
+	if width < 1 || depth < 1 {
+		return nil, errors.New("sketch: non-positive dimension")
+	}
+	return &CountMin{
+		width:        width,
+		depth:        depth,
+		conservative: conservative,
+		counts:       make([]uint64, width*depth),
+		hash:         h,
+		idx:          make([]int, depth),
+	}, nil
+}
+
+// indices places the index of the counter of the item b in each row of
+// the sketch into c.idx, deriving the row hashes from a single 64-bit
+// hash as described in Kirsch and Mitzenmacher, "Less hashing, same
+// performance: Building a better Bloom filter", 2006.
+func (c *CountMin) indices(b []byte) {
+	c.hash.Write(b)
+	x := c.hash.Sum64()
+	c.hash.Reset()
+	h1, h2 := x&math.MaxUint32, x>>32
+	for i := range c.idx {
+		c.idx[i] = i*c.width + int((h1+uint64(i)*h2)%uint64(c.width))
+	}
+}
+
+// Write notes the data in b as a single observation of an item into the
+// sketch. Write satisfies the io.Writer interface and always returns a nil
+// error.
+func (c *CountMin) Write(b []byte) (int, error) {
+	c.Add(b, 1)
+	return len(b), nil
+}
+
+// Add adds n observations of the item b into the sketch.
+func (c *CountMin) Add(b []byte, n uint64) {
+	c.indices(b)
+	c.total += n
+	if !c.conservative {
+		for _, i := range c.idx {
+			c.counts[i] += n
+		}
+		return
+	}
+	est := c.min() + n
+	for _, i := range c.idx {
+		c.counts[i] = max(c.counts[i], est)
+	}
+}
+
+// Count returns the estimated number of observations of the item b.
+func (c *CountMin) Count(b []byte) uint64 {
+	c.indices(b)
+	return c.min()
+}
+
+// min returns the smallest counter at the indices in c.idx.
+func (c *CountMin) min() uint64 {
+	m := uint64(math.MaxUint64)
+	for _, i := range c.idx {
+		m = min(m, c.counts[i])
+	}
+	return m
+}
+
+// Total returns the total number of observations in the sketch.
+func (c *CountMin) Total() uint64 {
+	return c.total
+}
+
+// Union places the union of the sketches a and b, the sketch of the
+// concatenation of their streams, into the receiver. Union will return an
+// error if the dimensions or hash functions of a and b do not match, if
+// the receiver has a hash function that is set and does not match those
+// of a and b, or if a or b uses conservative update.
+func (c *CountMin) Union(a, b *CountMin) error {
+	if a.width != b.width || a.depth != b.depth {
+		return errors.New("sketch: mismatched dimensions")
+	}
+	ta := reflect.TypeOf(a.hash)
+	if reflect.TypeOf(b.hash) != ta || (c.hash != nil && reflect.TypeOf(c.hash) != ta) {
+		return errors.New("sketch: mismatched hash function")
+	}
+	if a.conservative || b.conservative {
+		return errors.New("sketch: union of conservative sketches")
+	}
+	counts := make([]uint64, len(a.counts))
+	for i, v := range a.counts {
+		counts[i] = v + b.counts[i]
+	}
+	h := c.hash
+	if h == nil {
+		h = a.hash
+	}
+	*c = CountMin{
+		width:  a.width,
+		depth:  a.depth,
+		total:  a.total + b.total,
+		counts: counts,
+		hash:   h,
+		idx:    make([]int, a.depth),
+	}
+	return nil
+}
+
+// Reset clears the counts of the receiver allowing it to be reused.
+func (c *CountMin) Reset() {
+	for i := range c.counts {
+		c.counts[i] = 0
+	}
+	c.total = 0
+}
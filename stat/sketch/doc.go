@@ -0,0 +1,7 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package sketch provides frequency estimation for data streams using
+// bounded memory.
+package sketch // import "gonum.org/v1/gonum/stat/sketch"
@@ -0,0 +1,143 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sketch
+
+import (
+	"hash/fnv"
+	"math/rand/v2"
+	"strconv"
+	"testing"
+)
+
+// zipfStream returns a stream of n items with Zipf-distributed
+// frequencies and the true count of each item.
+func zipfStream(n int, rnd *rand.Rand) ([]string, map[string]uint64) {
+	z := rand.NewZipf(rnd, 1.2, 1, 1e5)
+	stream := make([]string, n)
+	counts := make(map[string]uint64)
+	for i := range stream {
+		v := z.Uint64()
+		stream[i] = "item-" + strconv.FormatUint(v, 10)
+		counts[stream[i]]++
+	}
+	return stream, counts
+}
+
+func TestCountMin(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 100000
+	stream, counts := zipfStream(n, rnd)
+
+	const eps, delta = 0.001, 0.01
+	width, depth := CountMinDims(eps, delta)
+	if width != 2719 || depth != 5 {
+		t.Errorf("unexpected dimensions: got:%d×%d want:2719×5", width, depth)
+	}
+	var sketches [2]*CountMin
+	for i, conservative := range []bool{false, true} {
+		c, err := NewCountMin(width, depth, conservative, fnv.New64a())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, s := range stream {
+			c.Write([]byte(s))
+		}
+		if c.Total() != n {
+			t.Errorf("unexpected total: got:%d want:%d", c.Total(), n)
+		}
+		var bad int
+		for s, want := range counts {
+			got := c.Count([]byte(s))
+			if got < want {
+				t.Errorf("count underestimated for %s: got:%d want:%d", s, got, want)
+			}
+			if float64(got-want) > eps*n {
+				bad++
+			}
+		}
+		if float64(bad) > 2*delta*float64(len(counts)) {
+			t.Errorf("conservative=%t: too many estimates outside error bound: %d of %d", conservative, bad, len(counts))
+		}
+		sketches[i] = c
+	}
+	for s := range counts {
+		if sketches[1].Count([]byte(s)) > sketches[0].Count([]byte(s)) {
+			t.Errorf("conservative estimate exceeds standard estimate for %s", s)
+		}
+	}
+
+	// The union of sketches of two halves of the stream
+	// is the sketch of the whole stream.
+	var halves [2]*CountMin
+	for i := range halves {
+		halves[i], _ = NewCountMin(width, depth, false, fnv.New64a())
+		for _, s := range stream[i*n/2 : (i+1)*n/2] {
+			halves[i].Write([]byte(s))
+		}
+	}
+	var u CountMin
+	err := u.Union(halves[0], halves[1])
+	if err != nil {
+		t.Fatalf("unexpected error from Union: %v", err)
+	}
+	for s := range counts {
+		if got, want := u.Count([]byte(s)), sketches[0].Count([]byte(s)); got != want {
+			t.Errorf("unexpected union count for %s: got:%d want:%d", s, got, want)
+		}
+	}
+	if err := u.Union(sketches[0], sketches[1]); err == nil {
+		t.Error("expected error for union of conservative sketch")
+	}
+	other, _ := NewCountMin(width, depth, false, fnv.New64())
+	if err := u.Union(sketches[0], other); err == nil {
+		t.Error("expected error for mismatched hash functions")
+	}
+}
+
+func TestSpaceSaving(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const (
+		n = 100000
+		k = 100
+	)
+	stream, counts := zipfStream(n, rnd)
+	s := NewSpaceSaving(k)
+	for _, item := range stream {
+		s.Write([]byte(item))
+	}
+	if s.Total() != n {
+		t.Errorf("unexpected total: got:%d want:%d", s.Total(), n)
+	}
+	top := s.Top(-1)
+	if len(top) != k {
+		t.Fatalf("unexpected number of monitored items: got:%d want:%d", len(top), k)
+	}
+	for i, h := range top {
+		if i > 0 && top[i-1].Count < h.Count {
+			t.Errorf("heavy hitters not in decreasing order at %d", i)
+		}
+		want := counts[h.Item]
+		if want > h.Count || want < h.Count-h.Error {
+			t.Errorf("true count of %s outside bounds: got:[%d, %d] want:%d", h.Item, h.Count-h.Error, h.Count, want)
+		}
+	}
+	// Every item with count above n/k is monitored.
+	for item, c := range counts {
+		if c > n/k {
+			if _, ok := s.items[item]; !ok {
+				t.Errorf("frequent item %s with count %d not monitored", item, c)
+			}
+		}
+	}
+	if got := s.Top(3); len(got) != 3 || got[0] != top[0] {
+		t.Errorf("unexpected top 3: %v", got)
+	}
+	count, err := s.Count("absent")
+	if count != 0 || err != top[k-1].Count {
+		t.Errorf("unexpected count of unmonitored item: got:%d±%d want:0±%d", count, err, top[k-1].Count)
+	}
+}
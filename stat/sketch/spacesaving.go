@@ -0,0 +1,138 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sketch
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// SpaceSaving finds the most frequent items in a stream using a fixed number
+// of counters with the space-saving algorithm described in Metwally, Agrawal
+// and El Abbadi, "Efficient computation of frequent and top-k elements in
+// data streams", ICDT 2005. Every item whose count exceeds the total count
+// divided by the number of counters is guaranteed to be monitored.
+type SpaceSaving struct {
+	total uint64
+	items map[string]*counter
+	heap  counterHeap
+}
+
+// HeavyHitter is an item monitored by a SpaceSaving. The true count of the
+// item is in the range [Count-Error, Count].
+type HeavyHitter struct {
+	Item  string
+	Count uint64
+	Error uint64
+}
+
+type counter struct {
+	HeavyHitter
+	index int
+}
+
+// NewSpaceSaving returns a new SpaceSaving monitoring at most k items.
+// NewSpaceSaving panics if k is less than one.
+func NewSpaceSaving(k int) *SpaceSaving {
+	if k < 1 {
+		panic("sketch: non-positive number of counters")
+	}
+	return &SpaceSaving{
+		items: make(map[string]*counter, k),
+		heap:  make(counterHeap, 0, k),
+	}
+}
+
+// Write notes the data in b as a single observation of an item. Write
+// satisfies the io.Writer interface and always returns a nil error.
+func (s *SpaceSaving) Write(b []byte) (int, error) {
+	s.Add(string(b), 1)
+	return len(b), nil
+}
+
+// Add adds n observations of item.
+func (s *SpaceSaving) Add(item string, n uint64) {
+	s.total += n
+	if c, ok := s.items[item]; ok {
+		c.Count += n
+		heap.Fix(&s.heap, c.index)
+		return
+	}
+	if len(s.heap) < cap(s.heap) {
+		c := &counter{HeavyHitter: HeavyHitter{Item: item, Count: n}}
+		s.items[item] = c
+		heap.Push(&s.heap, c)
+		return
+	}
+	// Replace the item with the smallest count, which
+	// bounds the count of the new item before monitoring.
+	c := s.heap[0]
+	delete(s.items, c.Item)
+	c.Item = item
+	c.Error = c.Count
+	c.Count += n
+	s.items[item] = c
+	heap.Fix(&s.heap, 0)
+}
+
+// Count returns the estimated count of item and the maximum overestimation
+// of the count. If item is not monitored, Count returns the count of the
+// least frequent monitored item as its error bound with a zero count, or
+// zeros if fewer than k items have been observed.
+func (s *SpaceSaving) Count(item string) (count, err uint64) {
+	if c, ok := s.items[item]; ok {
+		return c.Count, c.Error
+	}
+	if len(s.heap) < cap(s.heap) {
+		return 0, 0
+	}
+	return 0, s.heap[0].Count
+}
+
+// Top returns up to n monitored items in decreasing order of their
+// estimated counts. If n is negative, all monitored items are returned.
+func (s *SpaceSaving) Top(n int) []HeavyHitter {
+	top := make([]HeavyHitter, len(s.heap))
+	for i, c := range s.heap {
+		top[i] = c.HeavyHitter
+	}
+	sort.Slice(top, func(i, j int) bool {
+		if top[i].Count != top[j].Count {
+			return top[i].Count > top[j].Count
+		}
+		return top[i].Item < top[j].Item
+	})
+	if 0 <= n && n < len(top) {
+		top = top[:n]
+	}
+	return top
+}
+
+// Total returns the total number of observations.
+func (s *SpaceSaving) Total() uint64 {
+	return s.total
+}
+
+// counterHeap is a min-heap of counters ordered by count.
+type counterHeap []*counter
+
+func (h counterHeap) Len() int           { return len(h) }
+func (h counterHeap) Less(i, j int) bool { return h[i].Count < h[j].Count }
+func (h counterHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+func (h *counterHeap) Push(x interface{}) {
+	c := x.(*counter)
+	c.index = len(*h)
+	*h = append(*h, c)
+}
+func (h *counterHeap) Pop() interface{} {
+	old := *h
+	c := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return c
+}
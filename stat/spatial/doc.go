@@ -2,5 +2,6 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// Package spatial provides spatial statistical functions.
+// Package spatial provides spatial statistical functions, including measures
+// of spatial autocorrelation, variogram estimation and kriging.
 package spatial // import "gonum.org/v1/gonum/stat/spatial"
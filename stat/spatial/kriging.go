@@ -0,0 +1,143 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package spatial
+
+import (
+	"errors"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Drift is a set of basis functions describing the mean of a spatial
+// process for universal kriging.
+type Drift interface {
+	// Len returns the number of basis functions
+	// for locations with the given dimensions.
+	Len(dims int) int
+
+	// Basis places the values of the basis
+	// functions at x into dst.
+	Basis(dst, x []float64)
+}
+
+// ConstantDrift is a Drift with an unknown constant mean. Kriging with
+// a ConstantDrift is ordinary kriging.
+type ConstantDrift struct{}
+
+// Len returns 1.
+func (ConstantDrift) Len(int) int { return 1 }
+
+// Basis sets dst[0] to 1.
+func (ConstantDrift) Basis(dst, _ []float64) { dst[0] = 1 }
+
+// LinearDrift is a Drift with a mean that is an unknown linear function
+// of the location.
+type LinearDrift struct{}
+
+// Len returns dims+1.
+func (LinearDrift) Len(dims int) int { return dims + 1 }
+
+// Basis sets dst[0] to 1 and dst[1:] to the elements of x.
+func (LinearDrift) Basis(dst, x []float64) {
+	dst[0] = 1
+	copy(dst[1:], x)
+}
+
+// Kriging is a kriging interpolator for spatial data. Kriging predicts the
+// value of a spatial process at unobserved locations as the best linear
+// unbiased predictor given observations of the process, a variogram model
+// describing its spatial dependence and a drift describing its mean.
+type Kriging struct {
+	x     *mat.Dense
+	y     []float64
+	v     Variogram
+	drift Drift
+
+	lu mat.LU
+}
+
+// NewKriging returns a Kriging for the data observed at the locations in
+// the rows of x. Predictions are made using the variogram model v and the
+// provided drift. If drift is nil, ConstantDrift is used, giving ordinary
+// kriging. The values in x and y are copied.
+//
+// NewKriging returns an error if the kriging system is singular, which may
+// happen when locations are duplicated or there are fewer locations than
+// drift basis functions. NewKriging will panic if the number of rows of x
+// is not equal to the length of y.
+func NewKriging(x mat.Matrix, y []float64, v Variogram, drift Drift) (*Kriging, error) {
+	r, c := x.Dims()
+	if r != len(y) {
+		panic("spatial: data length mismatch")
+	}
+	if drift == nil {
+		drift = ConstantDrift{}
+	}
+	k := &Kriging{
+		x:     mat.DenseCopyOf(x),
+		y:     append([]float64(nil), y...),
+		v:     v,
+		drift: drift,
+	}
+
+	// Construct the kriging system
+	//  [Γ F; Fᵀ 0]
+	// where Γ holds the semivariances between the
+	// locations and F holds the drift basis values.
+	p := drift.Len(c)
+	n := r + p
+	a := mat.NewDense(n, n, nil)
+	f := make([]float64, p)
+	for i := 0; i < r; i++ {
+		xi := k.x.RawRowView(i)
+		for j := i + 1; j < r; j++ {
+			g := v.Semivariance(floats.Distance(xi, k.x.RawRowView(j), 2))
+			a.Set(i, j, g)
+			a.Set(j, i, g)
+		}
+		drift.Basis(f, xi)
+		for j, fj := range f {
+			a.Set(i, r+j, fj)
+			a.Set(r+j, i, fj)
+		}
+	}
+	k.lu.Factorize(a)
+	if k.lu.Cond() > mat.ConditionTolerance {
+		return nil, errors.New("spatial: singular kriging system")
+	}
+	return k, nil
+}
+
+// Predict returns the kriging prediction of the process at x and the kriging
+// variance of the prediction. Predict will panic if the length of x does not
+// match the dimensions of the observed locations.
+func (k *Kriging) Predict(x []float64) (mean, variance float64) {
+	r, c := k.x.Dims()
+	if len(x) != c {
+		panic("spatial: dimension mismatch")
+	}
+	p := k.drift.Len(c)
+	b := mat.NewVecDense(r+p, nil)
+	for i := 0; i < r; i++ {
+		b.SetVec(i, k.v.Semivariance(floats.Distance(x, k.x.RawRowView(i), 2)))
+	}
+	f := make([]float64, p)
+	k.drift.Basis(f, x)
+	for j, fj := range f {
+		b.SetVec(r+j, fj)
+	}
+
+	var w mat.VecDense
+	// The condition of the system was checked
+	// during construction, so the error can
+	// be ignored.
+	_ = k.lu.SolveVecTo(&w, false, b)
+	for i, yi := range k.y {
+		mean += w.AtVec(i) * yi
+	}
+	variance = mat.Dot(&w, b)
+	return mean, variance
+}
@@ -0,0 +1,45 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package spatial_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/spatial"
+)
+
+func ExampleKriging() {
+	// Observations of a process along a transect.
+	x := mat.NewDense(8, 1, []float64{0, 1, 2, 3, 5, 6, 8, 9})
+	y := []float64{1.2, 1.9, 2.4, 2.2, 1.1, 0.7, 1.3, 1.8}
+
+	// Estimate the empirical variogram and fit
+	// an exponential model to it.
+	lags := spatial.EmpiricalVariogram(x, y, []float64{0, 1.5, 3, 4.5, 6})
+	var v spatial.Exponential
+	err := spatial.FitVariogram(&v, lags, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Predict the process at unobserved locations
+	// using ordinary kriging.
+	k, err := spatial.NewKriging(x, y, v, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, p := range []float64{4, 7} {
+		mean, variance := k.Predict([]float64{p})
+		fmt.Printf("x=%v y=%.2f±%.2f\n", p, mean, math.Sqrt(variance))
+	}
+
+	// Output:
+	//
+	// x=4 y=1.64±0.53
+	// x=7 y=1.05±0.53
+}
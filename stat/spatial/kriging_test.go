@@ -0,0 +1,80 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package spatial
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestKriging(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 30
+	x := mat.NewDense(n, 2, nil)
+	for i := 0; i < n; i++ {
+		x.Set(i, 0, 10*rnd.Float64())
+		x.Set(i, 1, 10*rnd.Float64())
+	}
+	linear := func(p []float64) float64 { return 3 + 2*p[0] - p[1] }
+
+	for _, test := range []struct {
+		name  string
+		drift Drift
+		fn    func([]float64) float64
+
+		// exact indicates that the drift can
+		// reproduce fn at all locations.
+		exact bool
+	}{
+		{name: "ordinary constant", drift: nil, fn: func([]float64) float64 { return 5 }, exact: true},
+		{name: "ordinary linear", drift: ConstantDrift{}, fn: linear},
+		{name: "universal linear", drift: LinearDrift{}, fn: linear, exact: true},
+	} {
+		y := make([]float64, n)
+		for i := range y {
+			y[i] = test.fn(x.RawRowView(i))
+		}
+		k, err := NewKriging(x, y, Exponential{PartialSill: 1, Range: 3}, test.drift)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", test.name, err)
+			continue
+		}
+
+		// Kriging is an exact interpolator.
+		for i := 0; i < n; i++ {
+			mean, variance := k.Predict(x.RawRowView(i))
+			if !scalar.EqualWithinAbsOrRel(mean, y[i], 1e-10, 1e-10) {
+				t.Errorf("unexpected prediction at observation %d for %s: got:%v want:%v", i, test.name, mean, y[i])
+			}
+			if math.Abs(variance) > 1e-10 {
+				t.Errorf("unexpected variance at observation %d for %s: got:%v want:0", i, test.name, variance)
+			}
+		}
+
+		for _, p := range [][]float64{{5, 5}, {1, 9}, {12, -1}} {
+			mean, variance := k.Predict(p)
+			if variance <= 0 {
+				t.Errorf("unexpected non-positive variance at %v for %s: %v", p, test.name, variance)
+			}
+			if test.exact && !scalar.EqualWithinAbsOrRel(mean, test.fn(p), 1e-10, 1e-10) {
+				t.Errorf("unexpected prediction at %v for %s: got:%v want:%v", p, test.name, mean, test.fn(p))
+			}
+		}
+	}
+}
+
+func TestKrigingSingular(t *testing.T) {
+	t.Parallel()
+	x := mat.NewDense(3, 1, []float64{0, 1, 1})
+	_, err := NewKriging(x, []float64{0, 1, 1}, Spherical{PartialSill: 1, Range: 2}, nil)
+	if err == nil {
+		t.Error("expected error for duplicated locations")
+	}
+}
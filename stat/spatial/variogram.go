@@ -0,0 +1,307 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package spatial
+
+import (
+	"errors"
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+// Lag is a bin of an empirical variogram.
+type Lag struct {
+	// Distance is the mean separation
+	// distance of the pairs in the bin.
+	Distance float64
+
+	// Semivariance is the estimated
+	// semivariance of the bin.
+	Semivariance float64
+
+	// Pairs is the number of pairs
+	// of observations in the bin.
+	Pairs int
+}
+
+// EmpiricalVariogram returns the Matheron estimate of the semivariogram of
+// the data observed at the locations in the rows of x,
+//
+//	γ(h) = 1/(2 N(h)) \sum_{(i,j) ∈ N(h)} (y_i - y_j)^2
+//
+// where N(h) is the set of pairs of locations with Euclidean separation in
+// the half open interval [edges[k], edges[k+1]). The returned slice has
+// length len(edges)-1 and its kth element holds the estimate for the kth
+// bin. Bins without any pairs have a Pairs value of zero and NaN Distance
+// and Semivariance values.
+//
+// EmpiricalVariogram will panic if the number of rows of x is not equal to
+// the length of y, or if edges has fewer than two elements or is not sorted
+// in increasing order.
+func EmpiricalVariogram(x mat.Matrix, y []float64, edges []float64) []Lag {
+	r, c := x.Dims()
+	if r != len(y) {
+		panic("spatial: data length mismatch")
+	}
+	if len(edges) < 2 {
+		panic("spatial: too few bin edges")
+	}
+	if !slices.IsSorted(edges) {
+		panic("spatial: bin edges not sorted")
+	}
+
+	lags := make([]Lag, len(edges)-1)
+	xi := make([]float64, c)
+	xj := make([]float64, c)
+	for i := 0; i < r; i++ {
+		mat.Row(xi, i, x)
+		for j := i + 1; j < r; j++ {
+			mat.Row(xj, j, x)
+			h := floats.Distance(xi, xj, 2)
+			k, found := slices.BinarySearch(edges, h)
+			if !found {
+				k--
+			}
+			if k < 0 || k >= len(lags) {
+				continue
+			}
+			d := y[i] - y[j]
+			lags[k].Distance += h
+			lags[k].Semivariance += d * d
+			lags[k].Pairs++
+		}
+	}
+	for k := range lags {
+		n := float64(lags[k].Pairs)
+		if n == 0 {
+			lags[k].Distance = math.NaN()
+			lags[k].Semivariance = math.NaN()
+			continue
+		}
+		lags[k].Distance /= n
+		lags[k].Semivariance /= 2 * n
+	}
+	return lags
+}
+
+// Variogram is a semivariogram model of a second order stationary or
+// intrinsically stationary spatial process.
+type Variogram interface {
+	// Semivariance returns the semivariance at the
+	// separation distance h. Semivariance must
+	// return zero when h is zero.
+	Semivariance(h float64) float64
+}
+
+// FittableVariogram is a Variogram with parameters that can be fitted to an
+// empirical variogram by FitVariogram.
+type FittableVariogram interface {
+	Variogram
+
+	// Parameters returns the current non-negative
+	// parameters of the model.
+	Parameters() []float64
+
+	// SetParameters sets the parameters of the
+	// model. The length of p must match the length
+	// of the slice returned by Parameters.
+	SetParameters(p []float64)
+}
+
+// Spherical is the spherical variogram model
+//
+//	γ(h) = Nugget + PartialSill (3/2 h/Range - 1/2 (h/Range)^3)  for 0 < h ≤ Range
+//	γ(h) = Nugget + PartialSill                                  for h > Range
+//
+// and γ(0) = 0.
+type Spherical struct {
+	Nugget      float64
+	PartialSill float64
+	Range       float64
+}
+
+// Semivariance returns the semivariance at the separation distance h.
+func (v Spherical) Semivariance(h float64) float64 {
+	if h == 0 {
+		return 0
+	}
+	if h >= v.Range {
+		return v.Nugget + v.PartialSill
+	}
+	r := h / v.Range
+	return v.Nugget + v.PartialSill*(1.5*r-0.5*r*r*r)
+}
+
+// Parameters returns the nugget, partial sill and range of the model.
+func (v *Spherical) Parameters() []float64 {
+	return []float64{v.Nugget, v.PartialSill, v.Range}
+}
+
+// SetParameters sets the nugget, partial sill and range of the model.
+func (v *Spherical) SetParameters(p []float64) {
+	v.Nugget, v.PartialSill, v.Range = p[0], p[1], p[2]
+}
+
+// Exponential is the exponential variogram model
+//
+//	γ(h) = Nugget + PartialSill (1 - exp(-h/Range))  for h > 0
+//
+// and γ(0) = 0. The practical range of the model, where the semivariance
+// reaches 95% of the sill, is approximately 3×Range.
+type Exponential struct {
+	Nugget      float64
+	PartialSill float64
+	Range       float64
+}
+
+// Semivariance returns the semivariance at the separation distance h.
+func (v Exponential) Semivariance(h float64) float64 {
+	if h == 0 {
+		return 0
+	}
+	return v.Nugget + v.PartialSill*-math.Expm1(-h/v.Range)
+}
+
+// Parameters returns the nugget, partial sill and range of the model.
+func (v *Exponential) Parameters() []float64 {
+	return []float64{v.Nugget, v.PartialSill, v.Range}
+}
+
+// SetParameters sets the nugget, partial sill and range of the model.
+func (v *Exponential) SetParameters(p []float64) {
+	v.Nugget, v.PartialSill, v.Range = p[0], p[1], p[2]
+}
+
+// Matern is the Matérn variogram model
+//
+//	γ(h) = Nugget + PartialSill (1 - 2^(1-ν)/Γ(ν) (√(2ν) h/Range)^ν K_ν(√(2ν) h/Range))  for h > 0
+//
+// and γ(0) = 0, where K_ν is the modified Bessel function of the second kind.
+// The smoothness parameter, ν, is given by Nu and must be a positive half
+// integer, 0.5, 1.5, 2.5 and so on, for which the model has a closed form.
+// A Nu of 0.5 gives the exponential model, and the model approaches the
+// Gaussian model as Nu increases. Nu is not altered by FitVariogram.
+type Matern struct {
+	Nugget      float64
+	PartialSill float64
+	Range       float64
+	Nu          float64
+}
+
+// Semivariance returns the semivariance at the separation distance h.
+// Semivariance will panic if v.Nu is not a positive half integer.
+func (v Matern) Semivariance(h float64) float64 {
+	if h == 0 {
+		return 0
+	}
+	return v.Nugget + v.PartialSill*(1-maternCorrelation(h/v.Range, v.Nu))
+}
+
+// maternCorrelation returns the Matérn correlation at the scaled
+// distance r for the half integer smoothness nu.
+func maternCorrelation(r, nu float64) float64 {
+	p := nu - 0.5
+	if p < 0 || p != math.Trunc(p) {
+		panic("spatial: Matérn smoothness not a positive half integer")
+	}
+	n := int(p)
+	s := math.Sqrt(2*nu) * r
+
+	// For ν = n+1/2 the correlation is
+	//  exp(-s) n!/(2n)! \sum_{i=0}^n (n+i)!/(i!(n-i)!) (2s)^(n-i).
+	a := 1.0
+	for j := n + 1; j <= 2*n; j++ {
+		a /= float64(j)
+	}
+	var sum float64
+	for i := 0; i <= n; i++ {
+		sum = sum*2*s + a
+		a *= float64(n+i+1) * float64(n-i) / float64(i+1)
+	}
+	return math.Exp(-s) * sum
+}
+
+// Parameters returns the nugget, partial sill and range of the model.
+func (v *Matern) Parameters() []float64 {
+	return []float64{v.Nugget, v.PartialSill, v.Range}
+}
+
+// SetParameters sets the nugget, partial sill and range of the model.
+func (v *Matern) SetParameters(p []float64) {
+	v.Nugget, v.PartialSill, v.Range = p[0], p[1], p[2]
+}
+
+// FitVariogram fits the parameters of the variogram model v to the lags of
+// an empirical variogram by least squares weighted by the number of pairs
+// in each lag. Lags with no pairs are ignored. The parameters of v are used
+// as the starting location of the fit if any are non-zero; otherwise a
+// starting location is estimated from lags, assuming the parameters are
+// those of a nugget, partial sill and range model such as Spherical. The
+// fitted parameters are constrained to be non-negative.
+//
+// If settings is nil, default settings are used. FitVariogram returns an
+// error if the optimization fails, in which case v is not altered.
+func FitVariogram(v FittableVariogram, lags []Lag, settings *optimize.Settings) error {
+	var (
+		h, g, w []float64
+		maxH    float64
+		maxG    float64
+	)
+	for _, l := range lags {
+		if l.Pairs == 0 {
+			continue
+		}
+		h = append(h, l.Distance)
+		g = append(g, l.Semivariance)
+		w = append(w, float64(l.Pairs))
+		maxH = math.Max(maxH, l.Distance)
+		maxG = math.Max(maxG, l.Semivariance)
+	}
+	if len(h) == 0 {
+		return errors.New("spatial: no lags to fit")
+	}
+
+	orig := v.Parameters()
+	init := slices.Clone(orig)
+	if floats.Max(init) == 0 && floats.Min(init) == 0 && len(init) == 3 {
+		init[0] = 0.1 * maxG
+		init[1] = 0.9 * maxG
+		init[2] = maxH / 2
+	}
+	// Optimize over the square roots of the
+	// parameters to keep them non-negative.
+	for i, p := range init {
+		init[i] = math.Sqrt(math.Max(p, 0))
+	}
+
+	params := make([]float64, len(init))
+	problem := optimize.Problem{
+		Func: func(x []float64) float64 {
+			for i, v := range x {
+				params[i] = v * v
+			}
+			v.SetParameters(params)
+			var sum float64
+			for i, hi := range h {
+				d := g[i] - v.Semivariance(hi)
+				sum += w[i] * d * d
+			}
+			return sum
+		},
+	}
+	res, err := optimize.Minimize(problem, init, settings, &optimize.NelderMead{})
+	if err != nil {
+		v.SetParameters(orig)
+		return err
+	}
+	for i, x := range res.X {
+		params[i] = x * x
+	}
+	v.SetParameters(params)
+	return nil
+}
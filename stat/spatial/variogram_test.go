@@ -0,0 +1,116 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package spatial
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestEmpiricalVariogram(t *testing.T) {
+	t.Parallel()
+	x := mat.NewDense(4, 1, []float64{0, 1, 2, 4})
+	y := []float64{1, 3, 2, 6}
+	got := EmpiricalVariogram(x, y, []float64{0, 1.5, 3, 10, 20})
+
+	// Pairs by separation:
+	//  h=1: (0,1) d=2, (1,2) d=1
+	//  h=2: (0,2) d=1, (2,4) d=4
+	//  h=3: (1,4) d=3
+	//  h=4: (0,4) d=5
+	want := []Lag{
+		{Distance: 1, Semivariance: (4 + 1) / 4.0, Pairs: 2},
+		{Distance: 2, Semivariance: (1 + 16) / 4.0, Pairs: 2},
+		{Distance: 3.5, Semivariance: (9 + 25) / 4.0, Pairs: 2},
+		{Distance: math.NaN(), Semivariance: math.NaN(), Pairs: 0},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("unexpected number of lags: got:%d want:%d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i].Pairs != want[i].Pairs ||
+			!scalar.Same(got[i].Distance, want[i].Distance) ||
+			!scalar.Same(got[i].Semivariance, want[i].Semivariance) {
+			t.Errorf("unexpected lag %d: got:%+v want:%+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestMatern(t *testing.T) {
+	t.Parallel()
+	for _, h := range []float64{0.01, 0.3, 1, 2.5, 7} {
+		const rng = 1.3
+		s := h / rng
+
+		got := Matern{PartialSill: 2, Range: rng, Nu: 0.5}.Semivariance(h)
+		want := Exponential{PartialSill: 2, Range: rng}.Semivariance(h)
+		if !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+			t.Errorf("unexpected Matérn ν=1/2 semivariance at %v: got:%v want:%v", h, got, want)
+		}
+
+		s3 := math.Sqrt(3) * s
+		got = Matern{PartialSill: 2, Range: rng, Nu: 1.5}.Semivariance(h)
+		want = 2 * (1 - (1+s3)*math.Exp(-s3))
+		if !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+			t.Errorf("unexpected Matérn ν=3/2 semivariance at %v: got:%v want:%v", h, got, want)
+		}
+
+		s5 := math.Sqrt(5) * s
+		got = Matern{PartialSill: 2, Range: rng, Nu: 2.5}.Semivariance(h)
+		want = 2 * (1 - (1+s5+s5*s5/3)*math.Exp(-s5))
+		if !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+			t.Errorf("unexpected Matérn ν=5/2 semivariance at %v: got:%v want:%v", h, got, want)
+		}
+	}
+	if got := (Matern{Nugget: 1, PartialSill: 2, Range: 1, Nu: 1.5}).Semivariance(0); got != 0 {
+		t.Errorf("unexpected semivariance at zero: got:%v want:0", got)
+	}
+}
+
+func TestFitVariogram(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name  string
+		truth FittableVariogram
+		fit   FittableVariogram
+	}{
+		{
+			name:  "spherical",
+			truth: &Spherical{Nugget: 0.2, PartialSill: 1.5, Range: 4},
+			fit:   &Spherical{},
+		},
+		{
+			name:  "exponential",
+			truth: &Exponential{Nugget: 0.1, PartialSill: 2, Range: 1.5},
+			fit:   &Exponential{},
+		},
+		{
+			name:  "matern",
+			truth: &Matern{Nugget: 0.3, PartialSill: 1, Range: 2, Nu: 2.5},
+			fit:   &Matern{Nu: 2.5},
+		},
+	} {
+		var lags []Lag
+		for h := 0.25; h < 10; h += 0.5 {
+			lags = append(lags, Lag{Distance: h, Semivariance: test.truth.Semivariance(h), Pairs: 10})
+		}
+		err := FitVariogram(test.fit, lags, nil)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", test.name, err)
+			continue
+		}
+		got := test.fit.Parameters()
+		want := test.truth.Parameters()
+		for i := range want {
+			if !scalar.EqualWithinAbsOrRel(got[i], want[i], 1e-3, 1e-3) {
+				t.Errorf("unexpected fitted parameters for %s: got:%v want:%v", test.name, got, want)
+				break
+			}
+		}
+	}
+}
@@ -432,6 +432,30 @@ func CircularMean(x, weights []float64) float64 {
 	return math.Atan2(aY, aX)
 }
 
+// CircularStdDev returns the circular standard deviation of the dataset.
+//
+//	sqrt(-2 * log(R))
+//
+// where R is the mean resultant length of x. See MeanResultantLength.
+// If weights is nil then all of the weights are 1. If weights is not nil, then
+// len(x) must equal len(weights).
+func CircularStdDev(x, weights []float64) float64 {
+	return math.Sqrt(-2 * math.Log(MeanResultantLength(x, weights)))
+}
+
+// CircularVariance returns the circular variance of the dataset.
+//
+//	1 - R
+//
+// where R is the mean resultant length of x. See MeanResultantLength.
+// The circular variance is in [0, 1], and is zero when all of the angles
+// are equal.
+// If weights is nil then all of the weights are 1. If weights is not nil, then
+// len(x) must equal len(weights).
+func CircularVariance(x, weights []float64) float64 {
+	return 1 - MeanResultantLength(x, weights)
+}
+
 // Correlation returns the weighted correlation between the samples of x and y
 // with the given means.
 //
@@ -507,6 +531,9 @@ func Correlation(x, y, weights []float64) float64 {
 // concordant and discordant pairs of numbers. If weights are specified then
 // each pair is weighted by weights[i] * weights[j] and the final sum is
 // normalized to stay between -1 and 1.
+// Pairs tied in x or y are not treated specially, so the Tau-a correlation
+// of samples with ties is biased; KendallTauB and KendallTauC account for
+// ties.
 // The lengths of x and y must be equal. If weights is nil then all of the
 // weights are 1. If weights is not nil, then len(x) must equal len(weights).
 func Kendall(x, y, weights []float64) float64 {
@@ -1205,6 +1232,39 @@ func Mean(x, weights []float64) float64 {
 	return sumValues / sumWeights
 }
 
+// MeanResultantLength returns the length of the weighted mean of the unit
+// vectors with angles x.
+//
+//	sqrt((\sum_i w_i * cos(alpha_i))^2 + (\sum_i w_i * sin(alpha_i))^2) / \sum_i w_i
+//
+// The mean resultant length is in [0, 1] and measures the concentration of
+// the angles about their circular mean. It is one when all of the angles are
+// equal.
+// If weights is nil then all of the weights are 1. If weights is not nil, then
+// len(x) must equal len(weights).
+func MeanResultantLength(x, weights []float64) float64 {
+	if weights != nil && len(x) != len(weights) {
+		panic("stat: slice length mismatch")
+	}
+
+	var aX, aY, sumWeights float64
+	if weights != nil {
+		for i, v := range x {
+			aX += weights[i] * math.Cos(v)
+			aY += weights[i] * math.Sin(v)
+			sumWeights += weights[i]
+		}
+	} else {
+		for _, v := range x {
+			aX += math.Cos(v)
+			aY += math.Sin(v)
+		}
+		sumWeights = float64(len(x))
+	}
+
+	return math.Min(1, math.Hypot(aX, aY)/sumWeights)
+}
+
 // Mode returns the most common value in the dataset specified by x and the
 // given weights. Strict float64 equality is used when comparing values, so users
 // should take caution. If several values are the mode, any of them may be returned.
@@ -63,6 +63,59 @@ func TestCircularMean(t *testing.T) {
 	}
 }
 
+func TestCircularDispersion(t *testing.T) {
+	for i, test := range []struct {
+		x   []float64
+		wts []float64
+		r   float64
+	}{
+		{
+			x: []float64{0, 2 * math.Pi},
+			r: 1,
+		},
+		{
+			x: []float64{0, 0.5 * math.Pi},
+			r: math.Sqrt2 / 2,
+		},
+		{
+			x:   []float64{-1.5 * math.Pi, 0.5 * math.Pi, 2.5 * math.Pi},
+			wts: []float64{1, 2, 3},
+			r:   1,
+		},
+		{
+			x:   []float64{0, 0.5 * math.Pi},
+			wts: []float64{1, 2},
+			r:   math.Sqrt(5) / 3,
+		},
+		{
+			x: []float64{0, 0.5 * math.Pi, math.Pi, 1.5 * math.Pi},
+			r: 0,
+		},
+	} {
+		r := MeanResultantLength(test.x, test.wts)
+		if math.Abs(r-test.r) > 1e-14 {
+			t.Errorf("Mean resultant length mismatch case %d: Expected %v, Found %v", i, test.r, r)
+		}
+		v := CircularVariance(test.x, test.wts)
+		if math.Abs(v-(1-test.r)) > 1e-14 {
+			t.Errorf("Circular variance mismatch case %d: Expected %v, Found %v", i, 1-test.r, v)
+		}
+		if test.r == 0 {
+			continue
+		}
+		// The standard deviation is sensitive to rounding
+		// in the mean resultant length when it is near one.
+		sd := CircularStdDev(test.x, test.wts)
+		want := math.Sqrt(-2 * math.Log(test.r))
+		if math.Abs(sd-want) > 1e-7 {
+			t.Errorf("Circular standard deviation mismatch case %d: Expected %v, Found %v", i, want, sd)
+		}
+	}
+	if !panics(func() { MeanResultantLength(make([]float64, 3), make([]float64, 2)) }) {
+		t.Errorf("MeanResultantLength did not panic with x, wts length mismatch")
+	}
+}
+
 func ExampleCorrelation() {
 	x := []float64{8, -3, 7, 8, -4}
 	y := []float64{10, 5, 6, 3, -1}
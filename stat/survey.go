@@ -0,0 +1,152 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// StratifiedMean returns the stratified estimate of the population mean
+// from a stratified simple random sample without replacement, and the
+// estimated variance of the estimate,
+//
+//	mean = \sum_h W_h * ybar_h
+//	variance = \sum_h W_h^2 * (1 - n_h/N_h) * s_h^2 / n_h
+//
+// where W_h = N_h / \sum_h N_h is the weight of stratum h, and ybar_h, s_h^2
+// and n_h are the mean, unbiased variance and number of the observations in
+// stratum h. The observation x[i] is in stratum strata[i], and popSizes[h]
+// is the population size N_h of stratum h.
+//
+// The variance is NaN if a stratum with a single observation is not
+// completely enumerated. StratifiedMean panics if len(x) != len(strata),
+// if a stratum is out of the range of popSizes, or if a stratum has no
+// observations.
+func StratifiedMean(x []float64, strata []int, popSizes []float64) (mean, variance float64) {
+	total, variance, n := stratifiedTotal(x, strata, popSizes)
+	return total / n, variance / (n * n)
+}
+
+// StratifiedTotal returns the stratified estimate of the population total
+// from a stratified simple random sample without replacement, and the
+// estimated variance of the estimate,
+//
+//	total = \sum_h N_h * ybar_h
+//	variance = \sum_h N_h^2 * (1 - n_h/N_h) * s_h^2 / n_h
+//
+// The parameters and panics are as for StratifiedMean.
+func StratifiedTotal(x []float64, strata []int, popSizes []float64) (total, variance float64) {
+	total, variance, _ = stratifiedTotal(x, strata, popSizes)
+	return total, variance
+}
+
+// stratifiedTotal returns the stratified estimate of the population total
+// and its variance, and the total population size.
+func stratifiedTotal(x []float64, strata []int, popSizes []float64) (total, variance, popSize float64) {
+	if len(x) != len(strata) {
+		panic("stat: slice length mismatch")
+	}
+	groups := make([][]float64, len(popSizes))
+	for i, h := range strata {
+		if h < 0 || len(popSizes) <= h {
+			panic("stat: stratum out of range")
+		}
+		groups[h] = append(groups[h], x[i])
+	}
+	for h, g := range groups {
+		if len(g) == 0 {
+			panic("stat: empty stratum")
+		}
+		nh := float64(len(g))
+		bigN := popSizes[h]
+		popSize += bigN
+		if nh == bigN {
+			// A completely enumerated stratum does not
+			// contribute to the sampling variance.
+			total += bigN * Mean(g, nil)
+			continue
+		}
+		m, v := MeanVariance(g, nil)
+		total += bigN * m
+		variance += bigN * bigN * (1 - nh/bigN) * v / nh
+	}
+	return total, variance, popSize
+}
+
+// HorvitzThompson returns the Horvitz–Thompson estimate of the population
+// total from a sample drawn without replacement with unequal probabilities,
+// and the estimated variance of the estimate. The observation x[i] is
+// included in the sample with probability prob[i], and the estimate is
+//
+//	total = \sum_i x_i / π_i
+//
+// If joint is not nil, the off-diagonal element i, j of joint must be the
+// probability π_ij that observations i and j are both included in the
+// sample, and the variance is the Horvitz–Thompson estimate
+//
+//	variance = \sum_i (1 - π_i) (x_i / π_i)^2
+//	         + \sum_{i≠j} (π_ij - π_i π_j) / π_ij * x_i / π_i * x_j / π_j
+//
+// The diagonal of joint is not used. If joint is nil, the variance is the
+// with-replacement approximation
+//
+//	variance = n / (n-1) * \sum_i (x_i / π_i - total / n)^2
+//
+// which is conservative for most designs.
+//
+// HorvitzThompson panics if the lengths of x and prob differ, or if
+// joint is not nil and its size is not len(x).
+func HorvitzThompson(x, prob []float64, joint mat.Symmetric) (total, variance float64) {
+	if len(x) != len(prob) {
+		panic("stat: slice length mismatch")
+	}
+	n := len(x)
+	z := make([]float64, n)
+	for i, v := range x {
+		z[i] = v / prob[i]
+		total += z[i]
+	}
+	if joint == nil {
+		mean := total / float64(n)
+		for _, v := range z {
+			d := v - mean
+			variance += d * d
+		}
+		return total, variance * float64(n) / float64(n-1)
+	}
+	if joint.SymmetricDim() != n {
+		panic("stat: joint inclusion probability dimension mismatch")
+	}
+	for i, zi := range z {
+		variance += (1 - prob[i]) * zi * zi
+		for j := i + 1; j < n; j++ {
+			pij := joint.At(i, j)
+			variance += 2 * (pij - prob[i]*prob[j]) / pij * zi * z[j]
+		}
+	}
+	return total, variance
+}
+
+// DesignEffect returns Kish's design effect due to unequal weighting,
+//
+//	n * \sum_i w_i^2 / (\sum_i w_i)^2
+//
+// the factor by which the variance of a weighted mean exceeds that of
+// the mean of a simple random sample of the same size n when the
+// observations have equal variance. The effective sample size of the
+// weighted sample is n divided by the design effect.
+func DesignEffect(weights []float64) float64 {
+	var sum, sum2 float64
+	for _, w := range weights {
+		sum += w
+		sum2 += w * w
+	}
+	if sum == 0 {
+		return math.NaN()
+	}
+	return float64(len(weights)) * sum2 / (sum * sum)
+}
@@ -0,0 +1,119 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestStratified(t *testing.T) {
+	t.Parallel()
+	x := []float64{3, 5, 4, 10, 12, 11, 13, 20}
+	strata := []int{0, 0, 0, 1, 1, 1, 1, 2}
+	popSizes := []float64{30, 40, 1}
+
+	// Stratum means are 4, 11.5 and 20, and
+	// stratum variances are 1 and 5/3.
+	const (
+		wantTotal = 30*4 + 40*11.5 + 20
+		wantVar   = 30*30*(1-3.0/30)*1/3 + 40*40*(1-4.0/40)*(5.0/3)/4
+	)
+	total, variance := StratifiedTotal(x, strata, popSizes)
+	if !scalar.EqualWithinAbsOrRel(total, wantTotal, 1e-12, 1e-12) {
+		t.Errorf("unexpected total: got:%v want:%v", total, wantTotal)
+	}
+	if !scalar.EqualWithinAbsOrRel(variance, wantVar, 1e-12, 1e-12) {
+		t.Errorf("unexpected total variance: got:%v want:%v", variance, wantVar)
+	}
+	mean, variance := StratifiedMean(x, strata, popSizes)
+	if !scalar.EqualWithinAbsOrRel(mean, wantTotal/71, 1e-12, 1e-12) {
+		t.Errorf("unexpected mean: got:%v want:%v", mean, wantTotal/71)
+	}
+	if !scalar.EqualWithinAbsOrRel(variance, wantVar/(71*71), 1e-12, 1e-12) {
+		t.Errorf("unexpected mean variance: got:%v want:%v", variance, wantVar/(71*71))
+	}
+
+	// A stratum with a single observation that is
+	// not completely enumerated has undefined variance.
+	popSizes[2] = 5
+	_, variance = StratifiedMean(x, strata, popSizes)
+	if !math.IsNaN(variance) {
+		t.Errorf("expected NaN variance, got:%v", variance)
+	}
+
+	for _, test := range []struct {
+		name     string
+		strata   []int
+		popSizes []float64
+	}{
+		{name: "length", strata: []int{0}, popSizes: []float64{10}},
+		{name: "range", strata: []int{0, 0, 0, 1, 1, 1, 1, 3}, popSizes: popSizes},
+		{name: "empty", strata: strata, popSizes: []float64{30, 40, 1, 2}},
+	} {
+		if !panics(func() { StratifiedMean(x, test.strata, test.popSizes) }) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
+
+func TestHorvitzThompson(t *testing.T) {
+	t.Parallel()
+	// For a simple random sample without replacement the
+	// Horvitz–Thompson estimates are the usual estimates.
+	x := []float64{2, 7, 1, 8, 2, 8}
+	const bigN = 20
+	n := float64(len(x))
+	prob := make([]float64, len(x))
+	for i := range prob {
+		prob[i] = n / bigN
+	}
+	joint := mat.NewSymDense(len(x), nil)
+	for i := range x {
+		for j := i; j < len(x); j++ {
+			joint.SetSym(i, j, n*(n-1)/(bigN*(bigN-1)))
+		}
+	}
+	m, v := MeanVariance(x, nil)
+	wantTotal := bigN * m
+	wantVar := bigN * bigN * (1 - n/bigN) * v / n
+
+	total, variance := HorvitzThompson(x, prob, joint)
+	if !scalar.EqualWithinAbsOrRel(total, wantTotal, 1e-12, 1e-12) {
+		t.Errorf("unexpected total: got:%v want:%v", total, wantTotal)
+	}
+	if !scalar.EqualWithinAbsOrRel(variance, wantVar, 1e-12, 1e-12) {
+		t.Errorf("unexpected variance: got:%v want:%v", variance, wantVar)
+	}
+
+	// The with-replacement approximation omits the
+	// finite population correction.
+	total, variance = HorvitzThompson(x, prob, nil)
+	if !scalar.EqualWithinAbsOrRel(total, wantTotal, 1e-12, 1e-12) {
+		t.Errorf("unexpected total: got:%v want:%v", total, wantTotal)
+	}
+	if want := bigN * bigN * v / n; !scalar.EqualWithinAbsOrRel(variance, want, 1e-12, 1e-12) {
+		t.Errorf("unexpected approximate variance: got:%v want:%v", variance, want)
+	}
+}
+
+func TestDesignEffect(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		weights []float64
+		want    float64
+	}{
+		{weights: []float64{2, 2, 2, 2}, want: 1},
+		{weights: []float64{1, 3}, want: 2 * 10.0 / 16},
+		{weights: []float64{1, 0, 0, 0}, want: 4},
+	} {
+		if got := DesignEffect(test.weights); !scalar.EqualWithinAbsOrRel(got, test.want, 1e-14, 1e-14) {
+			t.Errorf("unexpected design effect for %v: got:%v want:%v", test.weights, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,463 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package survival
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/mathext"
+	"gonum.org/v1/gonum/optimize"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// Ties specifies the approximation to the partial likelihood used by Cox
+// for observations with tied event times.
+type Ties int
+
+const (
+	// Efron is Efron's approximation, which is
+	// more accurate than Breslow's when there
+	// are many ties.
+	Efron Ties = iota
+
+	// Breslow is Breslow's approximation,
+	// treating the tied events as if each
+	// occurred with the whole risk set.
+	Breslow
+)
+
+// Cox is a Cox proportional hazards regression model. The hazard of the
+// event for an observation with covariates x is
+//
+//	h(t | x) = h₀(t) exp(xᵀβ),
+//
+// where h₀ is an unspecified baseline hazard function, so the ratio of the
+// hazards of two observations is constant in time. The coefficients β are
+// estimated by maximizing the partial likelihood, which does not depend on
+// h₀.
+type Cox struct {
+	// Ties is the approximation used for
+	// tied event times.
+	Ties Ties
+
+	coef []float64
+	cov  *mat.SymDense
+
+	logLik, nullLogLik float64
+
+	// mean holds the weighted means of the
+	// covariates, which are subtracted from
+	// the covariates to avoid overflow.
+	mean []float64
+
+	// times holds the distinct event times in
+	// increasing order and cumHaz the baseline
+	// cumulative hazard for the centered
+	// covariates at each event time.
+	times, cumHaz []float64
+
+	ok bool
+}
+
+// coxData holds the centered covariates and the observations sorted by
+// decreasing time.
+type coxData struct {
+	x       *mat.Dense
+	times   []float64
+	events  []bool
+	weights []float64
+}
+
+// Fit fits the model to the observations with covariates in the rows of x
+// at the given times, maximizing the partial likelihood by Newton's method
+// using optimize.Newton starting from zero coefficients. If events[i] is
+// true, the event was observed at times[i], otherwise the observation was
+// censored. If weights is not nil, it holds the number of observations with
+// each time, event status and covariates. If settings is nil, the default
+// settings of optimize.Minimize are used.
+//
+// Fit returns an error if there are no events or the optimization fails,
+// which can happen if the coefficients are not identifiable, for example if
+// a covariate separates the observations with events from those at risk at
+// each event time, in which case the partial likelihood is maximized by an
+// infinite coefficient.
+//
+// Fit will panic if x has no columns, the lengths of times, events and
+// weights, when not nil, are not equal to the number of rows of x, or any
+// weight is negative.
+func (c *Cox) Fit(x mat.Matrix, times []float64, events []bool, weights []float64, settings *optimize.Settings) error {
+	checkData(times, events, weights)
+	n, p := x.Dims()
+	if n != len(times) {
+		panic("survival: slice length mismatch")
+	}
+	if p == 0 {
+		panic("survival: no covariates")
+	}
+	c.ok = false
+
+	var total, nEvents float64
+	mean := make([]float64, p)
+	for i := range n {
+		w := weight(weights, i)
+		total += w
+		if events[i] {
+			nEvents += w
+		}
+		for j := range p {
+			mean[j] += w * x.At(i, j)
+		}
+	}
+	if nEvents == 0 {
+		return errors.New("survival: no events")
+	}
+	floats.Scale(1/total, mean)
+
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(a, b int) bool { return times[idx[a]] > times[idx[b]] })
+	d := coxData{
+		x:       mat.NewDense(n, p, nil),
+		times:   make([]float64, n),
+		events:  make([]bool, n),
+		weights: make([]float64, n),
+	}
+	for r, i := range idx {
+		for j := range p {
+			d.x.Set(r, j, x.At(i, j)-mean[j])
+		}
+		d.times[r] = times[i]
+		d.events[r] = events[i]
+		d.weights[r] = weight(weights, i)
+	}
+
+	beta := make([]float64, p)
+	nullLogLik := c.partial(d, beta, nil, nil)
+	problem := optimize.Problem{
+		Func: func(beta []float64) float64 {
+			return -c.partial(d, beta, nil, nil)
+		},
+		Grad: func(grad, beta []float64) {
+			c.partial(d, beta, grad, nil)
+			floats.Scale(-1, grad)
+		},
+		Hess: func(hess *mat.SymDense, beta []float64) {
+			c.partial(d, beta, nil, hess)
+			hess.ScaleSym(-1, hess)
+		},
+	}
+	res, err := optimize.Minimize(problem, beta, settings, &optimize.Newton{})
+	if err != nil {
+		return err
+	}
+	copy(beta, res.X)
+
+	// The covariance of the coefficients is the
+	// inverse of the observed information.
+	info := mat.NewSymDense(p, nil)
+	logLik := c.partial(d, beta, nil, info)
+	info.ScaleSym(-1, info)
+	var chol mat.Cholesky
+	if !chol.Factorize(info) {
+		return errors.New("survival: singular information matrix")
+	}
+	cov := mat.NewSymDense(p, nil)
+	err = chol.InverseTo(cov)
+	if err != nil {
+		return err
+	}
+
+	c.coef = beta
+	c.cov = cov
+	c.logLik = logLik
+	c.nullLogLik = nullLogLik
+	c.mean = mean
+	c.baseline(d, beta)
+	c.ok = true
+	return nil
+}
+
+// partial returns the log partial likelihood of the coefficients beta for
+// the data d. If grad is not nil, the gradient is stored in grad. If hess
+// is not nil, the Hessian is stored in hess.
+func (c *Cox) partial(d coxData, beta, grad []float64, hess *mat.SymDense) float64 {
+	n, p := d.x.Dims()
+	if grad != nil {
+		clear(grad)
+	}
+	if hess != nil {
+		hess.Zero()
+	}
+
+	// The sums over the risk set and over the
+	// events at the current time of the risk
+	// scores and their products with the
+	// covariates and the outer products of the
+	// covariates.
+	var risk, tied float64
+	risk1 := make([]float64, p)
+	tied1 := make([]float64, p)
+	a1 := make([]float64, p)
+	var risk2, tied2 *mat.SymDense
+	if hess != nil {
+		risk2 = mat.NewSymDense(p, nil)
+		tied2 = mat.NewSymDense(p, nil)
+	}
+
+	var ll float64
+	for start := 0; start < n; {
+		t := d.times[start]
+		end := start
+		tied = 0
+		clear(tied1)
+		if tied2 != nil {
+			tied2.Zero()
+		}
+		var m int
+		var dw float64
+		for ; end < n && d.times[end] == t; end++ {
+			w := d.weights[end]
+			if w == 0 {
+				continue
+			}
+			xi := d.x.RawRowView(end)
+			eta := floats.Dot(xi, beta)
+			r := w * math.Exp(eta)
+			risk += r
+			floats.AddScaled(risk1, r, xi)
+			if risk2 != nil {
+				risk2.SymRankOne(risk2, r, mat.NewVecDense(p, xi))
+			}
+			if !d.events[end] {
+				continue
+			}
+			m++
+			dw += w
+			ll += w * eta
+			if grad != nil {
+				floats.AddScaled(grad, w, xi)
+			}
+			tied += r
+			floats.AddScaled(tied1, r, xi)
+			if tied2 != nil {
+				tied2.SymRankOne(tied2, r, mat.NewVecDense(p, xi))
+			}
+		}
+		start = end
+		if m == 0 {
+			continue
+		}
+		if c.Ties == Breslow {
+			m = 1
+		}
+
+		// For Efron's approximation, each of the m tied
+		// events is taken to remove on average the
+		// fraction l/m of the tied risk from the risk set.
+		// Breslow's approximation is the case m = 1.
+		for l := range m {
+			f := float64(l) / float64(m)
+			a := risk - f*tied
+			ll -= dw / float64(m) * math.Log(a)
+			if grad == nil && hess == nil {
+				continue
+			}
+			for j := range p {
+				a1[j] = risk1[j] - f*tied1[j]
+			}
+			s := dw / float64(m)
+			if grad != nil {
+				floats.AddScaled(grad, -s/a, a1)
+			}
+			if hess != nil {
+				for j := range p {
+					for k := j; k < p; k++ {
+						b := risk2.At(j, k) - f*tied2.At(j, k)
+						hess.SetSym(j, k, hess.At(j, k)-s*(b/a-a1[j]*a1[k]/(a*a)))
+					}
+				}
+			}
+		}
+	}
+	return ll
+}
+
+// baseline computes the estimate of the baseline cumulative hazard
+// corresponding to the approximation of the partial likelihood.
+func (c *Cox) baseline(d coxData, beta []float64) {
+	n, _ := d.x.Dims()
+	var times, hazard []float64
+	var risk float64
+	for start := 0; start < n; {
+		t := d.times[start]
+		end := start
+		var tied, dw float64
+		var m int
+		for ; end < n && d.times[end] == t; end++ {
+			w := d.weights[end]
+			if w == 0 {
+				continue
+			}
+			r := w * math.Exp(floats.Dot(d.x.RawRowView(end), beta))
+			risk += r
+			if d.events[end] {
+				m++
+				dw += w
+				tied += r
+			}
+		}
+		start = end
+		if m == 0 {
+			continue
+		}
+		var h float64
+		if c.Ties == Breslow {
+			h = dw / risk
+		} else {
+			for l := range m {
+				h += dw / float64(m) / (risk - float64(l)/float64(m)*tied)
+			}
+		}
+		times = append(times, t)
+		hazard = append(hazard, h)
+	}
+
+	// The hazard increments were accumulated
+	// in order of decreasing time.
+	c.times = c.times[:0]
+	c.cumHaz = c.cumHaz[:0]
+	var sum float64
+	for i := len(times) - 1; i >= 0; i-- {
+		sum += hazard[i]
+		c.times = append(c.times, times[i])
+		c.cumHaz = append(c.cumHaz, sum)
+	}
+}
+
+// Coefficients returns the estimated coefficients of the model. If dst is
+// not nil, the coefficients are stored in dst, which must have length equal
+// to the number of covariates.
+//
+// Coefficients will panic if the receiver does not contain a successful fit
+// or dst has the wrong length.
+func (c *Cox) Coefficients(dst []float64) []float64 {
+	c.checkFit()
+	if dst == nil {
+		dst = make([]float64, len(c.coef))
+	} else if len(dst) != len(c.coef) {
+		panic("survival: slice length mismatch")
+	}
+	copy(dst, c.coef)
+	return dst
+}
+
+// CovarianceMatrix returns the estimated covariance matrix of the
+// coefficients, the inverse of the observed information matrix at the
+// estimate. If dst is not empty, the covariance is stored in dst, which
+// must have dimension equal to the number of covariates.
+//
+// CovarianceMatrix will panic if the receiver does not contain a successful
+// fit or dst has the wrong dimension.
+func (c *Cox) CovarianceMatrix(dst *mat.SymDense) *mat.SymDense {
+	c.checkFit()
+	p := len(c.coef)
+	if dst == nil {
+		dst = mat.NewSymDense(p, nil)
+	} else if dst.IsEmpty() {
+		dst.ReuseAsSym(p)
+	} else if dst.SymmetricDim() != p {
+		panic("survival: dimension mismatch")
+	}
+	dst.CopySym(c.cov)
+	return dst
+}
+
+// HazardRatio returns the estimated hazard ratio exp(β_i) for a unit
+// increase of the ith covariate and the lower and upper bounds of its Wald
+// confidence interval with the given confidence level.
+//
+// HazardRatio will panic if the receiver does not contain a successful fit,
+// i is out of range or level is not in (0, 1).
+func (c *Cox) HazardRatio(i int, level float64) (hr, lower, upper float64) {
+	c.checkFit()
+	if i < 0 || len(c.coef) <= i {
+		panic("survival: covariate index out of range")
+	}
+	if level <= 0 || 1 <= level {
+		panic("survival: confidence level out of range")
+	}
+	z := mathext.NormalQuantile(1 - (1-level)/2)
+	b := c.coef[i]
+	se := math.Sqrt(c.cov.At(i, i))
+	return math.Exp(b), math.Exp(b - z*se), math.Exp(b + z*se)
+}
+
+// LogLikelihood returns the maximized log partial likelihood.
+//
+// LogLikelihood will panic if the receiver does not contain a successful
+// fit.
+func (c *Cox) LogLikelihood() float64 {
+	c.checkFit()
+	return c.logLik
+}
+
+// LikelihoodRatio returns the statistic, p-value and degrees of freedom of
+// the likelihood ratio test of the null hypothesis that all the
+// coefficients are zero, twice the difference between the maximized log
+// partial likelihood and its value at zero, which is approximately χ²
+// distributed with degrees of freedom equal to the number of covariates.
+//
+// LikelihoodRatio will panic if the receiver does not contain a successful
+// fit.
+func (c *Cox) LikelihoodRatio() (stat, p float64, df int) {
+	c.checkFit()
+	df = len(c.coef)
+	stat = max(2*(c.logLik-c.nullLogLik), 0)
+	return stat, distuv.ChiSquared{K: float64(df)}.Survival(stat), df
+}
+
+// CumulativeHazard returns the estimated cumulative hazard at t for an
+// observation with covariates x, the baseline cumulative hazard estimated
+// with the approximation of the partial likelihood scaled by exp(xᵀβ).
+//
+// CumulativeHazard will panic if the receiver does not contain a successful
+// fit or the length of x is not the number of covariates.
+func (c *Cox) CumulativeHazard(x []float64, t float64) float64 {
+	c.checkFit()
+	if len(x) != len(c.coef) {
+		panic("survival: slice length mismatch")
+	}
+	i := sort.Search(len(c.times), func(i int) bool { return c.times[i] > t }) - 1
+	if i < 0 {
+		return 0
+	}
+	var eta float64
+	for j, b := range c.coef {
+		eta += (x[j] - c.mean[j]) * b
+	}
+	return c.cumHaz[i] * math.Exp(eta)
+}
+
+// Survival returns the estimated survival function at t for an observation
+// with covariates x, exp(-H(t | x)) for the cumulative hazard returned by
+// CumulativeHazard.
+//
+// Survival will panic if the receiver does not contain a successful fit or
+// the length of x is not the number of covariates.
+func (c *Cox) Survival(x []float64, t float64) float64 {
+	return math.Exp(-c.CumulativeHazard(x, t))
+}
+
+func (c *Cox) checkFit() {
+	if !c.ok {
+		panic("survival: use of unfitted Cox model")
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package survival
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestCoxGehan(t *testing.T) {
+	t.Parallel()
+	n := len(gehan.times)
+	x := mat.NewDense(n, 1, nil)
+	for i, g := range gehan.placebo {
+		x.Set(i, 0, float64(g))
+	}
+	// Values from R coxph(Surv(time, cens) ~ treat, data=gehan)
+	// and the corresponding Breslow fit.
+	for _, test := range []struct {
+		ties     Ties
+		coef, se float64
+		lr       float64
+	}{
+		{ties: Efron, coef: 1.5721, se: 0.4124, lr: 16.35},
+		{ties: Breslow, coef: 1.5092, se: 0.4096, lr: 15.21},
+	} {
+		c := Cox{Ties: test.ties}
+		err := c.Fit(x, gehan.times, gehan.events, nil, nil)
+		if err != nil {
+			t.Fatalf("unexpected error for ties=%v: %v", test.ties, err)
+		}
+		coef := c.Coefficients(nil)
+		if !scalar.EqualWithinAbs(coef[0], test.coef, 1e-4) {
+			t.Errorf("unexpected coefficient for ties=%v: got:%v want:%v", test.ties, coef[0], test.coef)
+		}
+		cov := c.CovarianceMatrix(nil)
+		if se := math.Sqrt(cov.At(0, 0)); !scalar.EqualWithinAbs(se, test.se, 1e-4) {
+			t.Errorf("unexpected standard error for ties=%v: got:%v want:%v", test.ties, se, test.se)
+		}
+		stat, p, df := c.LikelihoodRatio()
+		if !scalar.EqualWithinAbs(stat, test.lr, 5e-3) || df != 1 {
+			t.Errorf("unexpected likelihood ratio test for ties=%v: got:(%v, %d) want:(%v, 1)", test.ties, stat, df, test.lr)
+		}
+		if !(p < 1e-4) {
+			t.Errorf("unexpected likelihood ratio p-value for ties=%v: %v", test.ties, p)
+		}
+		hr, lower, upper := c.HazardRatio(0, 0.95)
+		if !scalar.EqualWithinAbsOrRel(hr, math.Exp(coef[0]), 1e-14, 1e-14) || !(lower < hr && hr < upper) {
+			t.Errorf("unexpected hazard ratio for ties=%v: got:%v [%v, %v]", test.ties, hr, lower, upper)
+		}
+		if !scalar.EqualWithinAbsOrRel(math.Log(upper)-math.Log(hr), 1.959964*test.se, 1e-3, 1e-3) {
+			t.Errorf("unexpected hazard ratio interval for ties=%v: [%v, %v]", test.ties, lower, upper)
+		}
+
+		// The survival function is decreasing in
+		// time and lower for the placebo group.
+		for _, tm := range []float64{0, 5, 10, 20} {
+			s0 := c.Survival([]float64{0}, tm)
+			s1 := c.Survival([]float64{1}, tm)
+			if tm == 0 && (s0 != 1 || s1 != 1) {
+				t.Errorf("unexpected survival at zero for ties=%v: %v %v", test.ties, s0, s1)
+			}
+			if !scalar.EqualWithinAbsOrRel(math.Log(s1), math.Log(s0)*hr, 1e-12, 1e-12) {
+				t.Errorf("survival not proportional hazards for ties=%v at %v: %v %v", test.ties, tm, s0, s1)
+			}
+		}
+	}
+}
+
+func TestCoxMartingale(t *testing.T) {
+	t.Parallel()
+	// The martingale residuals of a Breslow fit
+	// sum to zero at the maximum partial likelihood.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 100
+	x := mat.NewDense(n, 2, nil)
+	times := make([]float64, n)
+	events := make([]bool, n)
+	weights := make([]float64, n)
+	for i := range n {
+		x1, x2 := rnd.NormFloat64(), float64(rnd.IntN(2))
+		x.SetRow(i, []float64{x1, x2})
+		rate := math.Exp(0.5*x1 - x2)
+		// Round the times to produce ties.
+		tm := math.Ceil(10 * rnd.ExpFloat64() / rate)
+		c := math.Ceil(20 * rnd.Float64())
+		times[i] = min(tm, c)
+		events[i] = tm <= c
+		weights[i] = float64(1 + rnd.IntN(3))
+	}
+	c := Cox{Ties: Breslow}
+	err := c.Fit(x, times, events, weights, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var sum float64
+	for i := range n {
+		r := -c.CumulativeHazard(x.RawRowView(i), times[i])
+		if events[i] {
+			r++
+		}
+		sum += weights[i] * r
+	}
+	if !scalar.EqualWithinAbs(sum, 0, 1e-8) {
+		t.Errorf("martingale residuals do not sum to zero: %v", sum)
+	}
+	coef := c.Coefficients(nil)
+	if !(0.2 < coef[0] && coef[0] < 0.8 && -1.5 < coef[1] && coef[1] < -0.5) {
+		t.Errorf("unexpected coefficients: %v", coef)
+	}
+}
+
+func TestCoxDerivatives(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(2, 2))
+	const n, p = 30, 3
+	d := coxData{
+		x:       mat.NewDense(n, p, nil),
+		times:   make([]float64, n),
+		events:  make([]bool, n),
+		weights: make([]float64, n),
+	}
+	for i := range n {
+		for j := range p {
+			d.x.Set(i, j, rnd.NormFloat64())
+		}
+		// Times in decreasing order with ties.
+		d.times[i] = float64(10 - i/3)
+		d.events[i] = rnd.Float64() < 0.7
+		d.weights[i] = 0.5 + rnd.Float64()
+	}
+	beta := []float64{0.3, -0.2, 0.5}
+	for _, ties := range []Ties{Efron, Breslow} {
+		c := Cox{Ties: ties}
+		f := func(b []float64) float64 { return c.partial(d, b, nil, nil) }
+		grad := make([]float64, p)
+		c.partial(d, beta, grad, nil)
+		want := fd.Gradient(nil, f, beta, &fd.Settings{Formula: fd.Central})
+		if !floats.EqualApprox(grad, want, 1e-6) {
+			t.Errorf("unexpected gradient for ties=%v: got:%v want:%v", ties, grad, want)
+		}
+		hess := mat.NewSymDense(p, nil)
+		c.partial(d, beta, nil, hess)
+		var wantHess mat.SymDense
+		fd.Hessian(&wantHess, f, beta, &fd.Settings{Formula: fd.Central})
+		if !mat.EqualApprox(hess, &wantHess, 1e-4) {
+			t.Errorf("unexpected Hessian for ties=%v:\ngot:\n%v\nwant:\n%v", ties, mat.Formatted(hess), mat.Formatted(&wantHess))
+		}
+	}
+}
+
+func TestCoxErrors(t *testing.T) {
+	t.Parallel()
+	x := mat.NewDense(3, 1, []float64{1, 2, 3})
+	times := []float64{1, 2, 3}
+	var c Cox
+	err := c.Fit(x, times, []bool{false, false, false}, nil, nil)
+	if err == nil {
+		t.Errorf("expected error for no events")
+	}
+	if !panics(func() { c.Coefficients(nil) }) {
+		t.Errorf("expected panic for use of unfitted model")
+	}
+	if !panics(func() { c.Fit(x, times[:2], []bool{true, true}, nil, nil) }) {
+		t.Errorf("expected panic for length mismatch")
+	}
+	if !panics(func() { c.Fit(x, times, []bool{true}, nil, nil) }) {
+		t.Errorf("expected panic for events length mismatch")
+	}
+
+	err = c.Fit(x, times, []bool{true, false, true}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !panics(func() { c.HazardRatio(1, 0.95) }) {
+		t.Errorf("expected panic for covariate index out of range")
+	}
+	if !panics(func() { c.HazardRatio(0, 0) }) {
+		t.Errorf("expected panic for confidence level out of range")
+	}
+	if !panics(func() { c.Survival([]float64{1, 2}, 1) }) {
+		t.Errorf("expected panic for covariate length mismatch")
+	}
+	if !panics(func() { c.Coefficients(make([]float64, 2)) }) {
+		t.Errorf("expected panic for dst length mismatch")
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package survival provides methods for the analysis of time-to-event data
+// subject to right censoring, including the Kaplan-Meier estimator of the
+// survival function, the log-rank test for the comparison of survival
+// between groups and Cox proportional hazards regression.
+//
+// The data are described by the time of each observation and whether the
+// event of interest was observed at that time or the observation was
+// censored, meaning that the event had not occurred by that time.
+//
+// See Klein, J. P. and Moeschberger, M. L. "Survival Analysis: Techniques
+// for Censored and Truncated Data", Springer 2003. ISBN 0-387-95399-X for an
+// introduction to the methods.
+package survival // import "gonum.org/v1/gonum/stat/survival"
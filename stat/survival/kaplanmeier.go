@@ -0,0 +1,226 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package survival
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/mathext"
+)
+
+// KaplanMeier is the Kaplan-Meier product-limit estimate of a survival
+// function. The estimate is a right-continuous step function that is one
+// before the first event time and is multiplied at each event time t_j by
+// 1 - d_j/n_j, where d_j is the number of events at t_j and n_j is the
+// number of observations at risk just before t_j, those with times not less
+// than t_j.
+type KaplanMeier struct {
+	// Times holds the distinct event times
+	// in increasing order.
+	Times []float64
+
+	// AtRisk and Events hold the number at
+	// risk and the number of events at each
+	// event time.
+	AtRisk, Events []float64
+
+	// Survival holds the estimate of the
+	// survival function at each event time.
+	Survival []float64
+
+	// Variance holds Greenwood's estimate of the
+	// variance of the survival estimate at each
+	// event time,
+	//  S(t)² \sum_{t_j ≤ t} d_j / (n_j (n_j - d_j)).
+	Variance []float64
+}
+
+// NewKaplanMeier returns the Kaplan-Meier estimate of the survival function
+// for observations at the given times. If events[i] is true, the event was
+// observed at times[i], otherwise the observation was censored. If weights
+// is not nil, it holds the number of observations with each time and
+// event status. Censored observations tied with events are taken to be at
+// risk at the time of the events.
+//
+// NewKaplanMeier will panic if the lengths of times, events and weights,
+// when not nil, are not equal, or any weight is negative.
+func NewKaplanMeier(times []float64, events []bool, weights []float64) *KaplanMeier {
+	checkData(times, events, weights)
+	km := &KaplanMeier{}
+	s := 1.0
+	var sum float64
+	for _, g := range groupTimes(times, events, weights, nil) {
+		if g.events == 0 {
+			continue
+		}
+		km.Times = append(km.Times, g.time)
+		km.AtRisk = append(km.AtRisk, g.atRisk)
+		km.Events = append(km.Events, g.events)
+		s *= 1 - g.events/g.atRisk
+		if g.events < g.atRisk {
+			sum += g.events / (g.atRisk * (g.atRisk - g.events))
+		} else {
+			sum = math.Inf(1)
+		}
+		km.Survival = append(km.Survival, s)
+		v := s * s * sum
+		if s == 0 {
+			v = 0
+		}
+		km.Variance = append(km.Variance, v)
+	}
+	return km
+}
+
+// index returns the index of the last event time not greater than t, or
+// -1 if t is before the first event time.
+func (km *KaplanMeier) index(t float64) int {
+	return sort.Search(len(km.Times), func(i int) bool { return km.Times[i] > t }) - 1
+}
+
+// At returns the estimate of the survival function at t.
+func (km *KaplanMeier) At(t float64) float64 {
+	i := km.index(t)
+	if i < 0 {
+		return 1
+	}
+	return km.Survival[i]
+}
+
+// StdErr returns Greenwood's estimate of the standard error of the estimate
+// of the survival function at t.
+func (km *KaplanMeier) StdErr(t float64) float64 {
+	i := km.index(t)
+	if i < 0 {
+		return 0
+	}
+	return math.Sqrt(km.Variance[i])
+}
+
+// ConfidenceInterval returns the lower and upper bounds of the pointwise
+// confidence interval of the survival function at t with the given
+// confidence level. The interval is computed from the normal approximation
+// to the distribution of log(-log S(t)) with its standard error obtained from
+// Greenwood's variance by the delta method, so it lies within [0, 1]. If the
+// estimate is zero or one, the interval is degenerate.
+//
+// ConfidenceInterval will panic if level is not in (0, 1).
+func (km *KaplanMeier) ConfidenceInterval(t, level float64) (lower, upper float64) {
+	if level <= 0 || 1 <= level {
+		panic("survival: confidence level out of range")
+	}
+	i := km.index(t)
+	if i < 0 {
+		return 1, 1
+	}
+	s := km.Survival[i]
+	if s == 0 || s == 1 {
+		return s, s
+	}
+	z := mathext.NormalQuantile(1 - (1-level)/2)
+	ls := math.Log(s)
+	se := math.Sqrt(km.Variance[i]) / (s * math.Abs(ls))
+	return math.Pow(s, math.Exp(z*se)), math.Pow(s, math.Exp(-z*se))
+}
+
+// Quantile returns the estimate of the p quantile of the survival time, the
+// smallest event time at which the estimate of the survival function is not
+// greater than 1-p. If the survival function does not fall to 1-p, Quantile
+// returns +Inf.
+//
+// Quantile will panic if p is not in [0, 1].
+func (km *KaplanMeier) Quantile(p float64) float64 {
+	if p < 0 || 1 < p {
+		panic("survival: quantile out of range")
+	}
+	if p == 0 {
+		return math.Inf(-1)
+	}
+	// Use a small tolerance so that survival
+	// estimates equal to 1-p within rounding
+	// are taken as reaching it.
+	for i, s := range km.Survival {
+		if s <= 1-p+1e-12 {
+			return km.Times[i]
+		}
+	}
+	return math.Inf(1)
+}
+
+// Median returns the estimate of the median survival time. It is equivalent
+// to km.Quantile(0.5).
+func (km *KaplanMeier) Median() float64 {
+	return km.Quantile(0.5)
+}
+
+// timeGroup holds the number of observations at risk and the number of
+// events and censored observations at a distinct observation time.
+type timeGroup struct {
+	time     float64
+	atRisk   float64
+	events   float64
+	censored float64
+}
+
+// groupTimes returns the distinct times of the observations in increasing
+// order with the numbers at risk, events and censorings at each time. If
+// include is not nil, only the observations for which include returns true
+// are counted.
+func groupTimes(times []float64, events []bool, weights []float64, include func(i int) bool) []timeGroup {
+	idx := make([]int, 0, len(times))
+	var total float64
+	for i := range times {
+		if include != nil && !include(i) {
+			continue
+		}
+		idx = append(idx, i)
+		total += weight(weights, i)
+	}
+	sort.Slice(idx, func(a, b int) bool { return times[idx[a]] < times[idx[b]] })
+
+	var groups []timeGroup
+	for _, i := range idx {
+		w := weight(weights, i)
+		n := len(groups)
+		if n == 0 || groups[n-1].time != times[i] {
+			groups = append(groups, timeGroup{time: times[i], atRisk: total})
+			n++
+		}
+		if events[i] {
+			groups[n-1].events += w
+		} else {
+			groups[n-1].censored += w
+		}
+		total -= w
+	}
+	return groups
+}
+
+// weight returns the weight of the ith observation.
+func weight(weights []float64, i int) float64 {
+	if weights == nil {
+		return 1
+	}
+	return weights[i]
+}
+
+// checkData panics if the survival data are not valid.
+func checkData(times []float64, events []bool, weights []float64) {
+	if len(times) != len(events) {
+		panic("survival: slice length mismatch")
+	}
+	if weights == nil {
+		return
+	}
+	if len(weights) != len(times) {
+		panic("survival: slice length mismatch")
+	}
+	for _, w := range weights {
+		if w < 0 {
+			panic("survival: negative weight")
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package survival
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// LogRank returns the statistic, p-value and degrees of freedom of the
+// log-rank test of the null hypothesis that the survival functions of the
+// groups of observations are equal. The observation at times[i] belongs to
+// group groups[i] and, if events[i] is true, the event was observed at that
+// time, otherwise the observation was censored. If weights is not nil, it
+// holds the number of observations with each time, event status and group.
+//
+// At each event time, the number of events in each group is compared with
+// the number expected under the null hypothesis given the numbers at risk
+// in the groups. The statistic is the quadratic form of the differences
+// between the observed and expected numbers of events summed over the
+// event times, with the inverse of their hypergeometric covariance,
+//
+//	(O - E)ᵀ V⁻¹ (O - E),
+//
+// omitting one group since the differences sum to zero. It is approximately
+// χ² distributed with k-1 degrees of freedom for the k groups with
+// observations. If the covariance matrix is singular, for example if there
+// are no events, the statistic and p-value are NaN.
+//
+// LogRank will panic if the lengths of times, events, groups and weights,
+// when not nil, are not equal, any group is negative, any weight is
+// negative, or there are observations in fewer than two groups.
+func LogRank(times []float64, events []bool, groups []int, weights []float64) (stat, p float64, df int) {
+	checkData(times, events, weights)
+	if len(groups) != len(times) {
+		panic("survival: slice length mismatch")
+	}
+
+	// Label the groups with observations
+	// by consecutive integers.
+	label := make(map[int]int)
+	for i, g := range groups {
+		if g < 0 {
+			panic("survival: negative group")
+		}
+		if weight(weights, i) == 0 {
+			continue
+		}
+		if _, ok := label[g]; !ok {
+			label[g] = len(label)
+		}
+	}
+	k := len(label)
+	if k < 2 {
+		panic("survival: fewer than two groups")
+	}
+	df = k - 1
+
+	idx := make([]int, 0, len(times))
+	atRisk := make([]float64, k)
+	for i, g := range groups {
+		if w := weight(weights, i); w != 0 {
+			idx = append(idx, i)
+			atRisk[label[g]] += w
+		}
+	}
+	sort.Slice(idx, func(a, b int) bool { return times[idx[a]] < times[idx[b]] })
+
+	diff := make([]float64, k)
+	v := mat.NewSymDense(df, nil)
+	d := make([]float64, k)
+	leaving := make([]float64, k)
+	for start := 0; start < len(idx); {
+		t := times[idx[start]]
+		end := start
+		clear(d)
+		clear(leaving)
+		for ; end < len(idx) && times[idx[end]] == t; end++ {
+			i := idx[end]
+			g := label[groups[i]]
+			w := weight(weights, i)
+			if events[i] {
+				d[g] += w
+			}
+			leaving[g] += w
+		}
+		start = end
+
+		var n, dt float64
+		for g := range k {
+			n += atRisk[g]
+			dt += d[g]
+		}
+		if dt > 0 {
+			for g := range k {
+				diff[g] += d[g] - dt*atRisk[g]/n
+			}
+			if n > 1 {
+				c := dt * (n - dt) / (n - 1)
+				for g := range df {
+					pg := atRisk[g] / n
+					v.SetSym(g, g, v.At(g, g)+c*pg*(1-pg))
+					for h := g + 1; h < df; h++ {
+						v.SetSym(g, h, v.At(g, h)-c*pg*atRisk[h]/n)
+					}
+				}
+			}
+		}
+		for g := range k {
+			atRisk[g] -= leaving[g]
+		}
+	}
+
+	var chol mat.Cholesky
+	if !chol.Factorize(v) {
+		return math.NaN(), math.NaN(), df
+	}
+	u := mat.NewVecDense(df, diff[:df])
+	var x mat.VecDense
+	err := chol.SolveVecTo(&x, u)
+	if err != nil {
+		return math.NaN(), math.NaN(), df
+	}
+	stat = mat.Dot(u, &x)
+	return stat, distuv.ChiSquared{K: float64(df)}.Survival(stat), df
+}
@@ -0,0 +1,236 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package survival
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+// gehan is the remission times in weeks of leukemia patients treated with
+// 6-mercaptopurine or a placebo from Freireich et al. (1963) as analyzed by
+// Gehan (1965).
+var gehan = struct {
+	times  []float64
+	events []bool
+	// placebo is 1 for the placebo group.
+	placebo []int
+}{
+	times: []float64{
+		// 6-MP.
+		6, 6, 6, 7, 10, 13, 16, 22, 23,
+		6, 9, 10, 11, 17, 19, 20, 25, 32, 32, 34, 35,
+		// Placebo.
+		1, 1, 2, 2, 3, 4, 4, 5, 5, 8, 8, 8, 8, 11, 11, 12, 12, 15, 17, 22, 23,
+	},
+	events: []bool{
+		true, true, true, true, true, true, true, true, true,
+		false, false, false, false, false, false, false, false, false, false, false, false,
+		true, true, true, true, true, true, true, true, true, true, true,
+		true, true, true, true, true, true, true, true, true, true,
+	},
+	placebo: []int{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	},
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
+
+func TestKaplanMeier(t *testing.T) {
+	t.Parallel()
+	// The 6-MP group.
+	km := NewKaplanMeier(gehan.times[:21], gehan.events[:21], nil)
+
+	wantTimes := []float64{6, 7, 10, 13, 16, 22, 23}
+	wantAtRisk := []float64{21, 17, 15, 12, 11, 7, 6}
+	wantEvents := []float64{3, 1, 1, 1, 1, 1, 1}
+	if len(km.Times) != len(wantTimes) {
+		t.Fatalf("unexpected number of event times: got:%d want:%d", len(km.Times), len(wantTimes))
+	}
+	s := 1.0
+	var sum float64
+	for i, tm := range wantTimes {
+		if km.Times[i] != tm || km.AtRisk[i] != wantAtRisk[i] || km.Events[i] != wantEvents[i] {
+			t.Errorf("unexpected counts at %d: got:(%v, %v, %v) want:(%v, %v, %v)",
+				i, km.Times[i], km.AtRisk[i], km.Events[i], tm, wantAtRisk[i], wantEvents[i])
+		}
+		n, d := wantAtRisk[i], wantEvents[i]
+		s *= 1 - d/n
+		sum += d / (n * (n - d))
+		if !scalar.EqualWithinAbsOrRel(km.Survival[i], s, 1e-14, 1e-14) {
+			t.Errorf("unexpected survival at %v: got:%v want:%v", tm, km.Survival[i], s)
+		}
+		if !scalar.EqualWithinAbsOrRel(km.Variance[i], s*s*sum, 1e-14, 1e-14) {
+			t.Errorf("unexpected variance at %v: got:%v want:%v", tm, km.Variance[i], s*s*sum)
+		}
+	}
+
+	for _, test := range []struct {
+		t, s, se float64
+	}{
+		{t: 0, s: 1, se: 0},
+		{t: 5.9, s: 1, se: 0},
+		{t: 6, s: 0.857142857142857, se: 0.0763603548321},
+		{t: 12, s: 0.752941176470588, se: 0.0963496529943},
+		{t: 23, s: 0.448179271708683, se: 0.1345914567558},
+		{t: 100, s: 0.448179271708683, se: 0.1345914567558},
+	} {
+		if got := km.At(test.t); !scalar.EqualWithinAbsOrRel(got, test.s, 1e-12, 1e-12) {
+			t.Errorf("unexpected survival at %v: got:%v want:%v", test.t, got, test.s)
+		}
+		if got := km.StdErr(test.t); !scalar.EqualWithinAbsOrRel(got, test.se, 1e-10, 1e-10) {
+			t.Errorf("unexpected standard error at %v: got:%v want:%v", test.t, got, test.se)
+		}
+	}
+
+	lower, upper := km.ConfidenceInterval(13, 0.95)
+	if !scalar.EqualWithinAbs(lower, 0.4316, 1e-4) || !scalar.EqualWithinAbs(upper, 0.8491, 1e-4) {
+		t.Errorf("unexpected confidence interval at 13: got:[%v, %v] want:[0.4316, 0.8491]", lower, upper)
+	}
+	if lower, upper := km.ConfidenceInterval(1, 0.95); lower != 1 || upper != 1 {
+		t.Errorf("unexpected confidence interval before first event: got:[%v, %v]", lower, upper)
+	}
+
+	if got := km.Median(); got != 23 {
+		t.Errorf("unexpected median: got:%v want:23", got)
+	}
+	if got := km.Quantile(0.25); got != 13 {
+		t.Errorf("unexpected lower quartile: got:%v want:13", got)
+	}
+	if got := km.Quantile(0.75); !math.IsInf(got, 1) {
+		t.Errorf("unexpected upper quartile: got:%v want:+Inf", got)
+	}
+
+	// The placebo group has no censoring so
+	// the estimate is the empirical survival
+	// function reaching zero.
+	km = NewKaplanMeier(gehan.times[21:], gehan.events[21:], nil)
+	if got := km.At(23); got != 0 {
+		t.Errorf("unexpected survival after last event: got:%v want:0", got)
+	}
+	if got := km.At(8); !scalar.EqualWithinAbsOrRel(got, 8.0/21, 1e-14, 1e-14) {
+		t.Errorf("unexpected survival at 8: got:%v want:%v", got, 8.0/21)
+	}
+	if got := km.Median(); got != 8 {
+		t.Errorf("unexpected median: got:%v want:8", got)
+	}
+}
+
+func TestKaplanMeierWeights(t *testing.T) {
+	t.Parallel()
+	times := []float64{1, 2, 2, 3, 5, 5, 8}
+	events := []bool{true, false, true, true, true, false, true}
+	weights := []float64{2, 1, 3, 1, 2, 4, 1}
+	var expTimes []float64
+	var expEvents []bool
+	for i, w := range weights {
+		for range int(w) {
+			expTimes = append(expTimes, times[i])
+			expEvents = append(expEvents, events[i])
+		}
+	}
+	got := NewKaplanMeier(times, events, weights)
+	want := NewKaplanMeier(expTimes, expEvents, nil)
+	for i := range want.Times {
+		if got.Times[i] != want.Times[i] || got.AtRisk[i] != want.AtRisk[i] || got.Events[i] != want.Events[i] ||
+			!scalar.EqualWithinAbsOrRel(got.Survival[i], want.Survival[i], 1e-14, 1e-14) ||
+			!scalar.EqualWithinAbsOrRel(got.Variance[i], want.Variance[i], 1e-14, 1e-14) {
+			t.Errorf("weighted estimate differs from expanded data at %d", i)
+		}
+	}
+
+	if !panics(func() { NewKaplanMeier(times, events[:2], nil) }) {
+		t.Errorf("expected panic for length mismatch")
+	}
+	if !panics(func() { NewKaplanMeier(times, events, []float64{1}) }) {
+		t.Errorf("expected panic for weights length mismatch")
+	}
+	if !panics(func() { NewKaplanMeier(times, events, []float64{1, 1, 1, 1, 1, 1, -1}) }) {
+		t.Errorf("expected panic for negative weight")
+	}
+}
+
+func TestLogRank(t *testing.T) {
+	t.Parallel()
+	// Value from R survdiff(Surv(time, cens) ~ treat, data=gehan).
+	stat, p, df := LogRank(gehan.times, gehan.events, gehan.placebo, nil)
+	if !scalar.EqualWithinAbs(stat, 16.79, 5e-3) {
+		t.Errorf("unexpected statistic: got:%v want:16.79", stat)
+	}
+	if df != 1 {
+		t.Errorf("unexpected degrees of freedom: got:%d want:1", df)
+	}
+	if !scalar.EqualWithinAbsOrRel(p, 4.169e-5, 1e-3, 1e-2) {
+		t.Errorf("unexpected p-value: got:%v want:4.169e-5", p)
+	}
+
+	// The test is invariant to the group labels.
+	groups := make([]int, len(gehan.placebo))
+	for i, g := range gehan.placebo {
+		groups[i] = 7 - 4*g
+	}
+	got, _, _ := LogRank(gehan.times, gehan.events, groups, nil)
+	if !scalar.EqualWithinAbsOrRel(got, stat, 1e-12, 1e-12) {
+		t.Errorf("statistic depends on group labels: got:%v want:%v", got, stat)
+	}
+
+	// Split the placebo group into two groups
+	// with the same survival distribution.
+	for i := range groups {
+		groups[i] = gehan.placebo[i] + gehan.placebo[i]*(i%2)
+	}
+	stat3, p3, df3 := LogRank(gehan.times, gehan.events, groups, nil)
+	if df3 != 2 {
+		t.Errorf("unexpected degrees of freedom: got:%d want:2", df3)
+	}
+	if !(stat3 > 10 && p3 < 1e-2) {
+		t.Errorf("unexpected result for three groups: stat=%v p=%v", stat3, p3)
+	}
+	// Comparing the two halves of the placebo
+	// group alone should not be significant.
+	_, p2, _ := LogRank(gehan.times[21:], gehan.events[21:], groups[21:], nil)
+	if p2 < 0.05 {
+		t.Errorf("unexpected significant difference between placebo halves: p=%v", p2)
+	}
+
+	// Weights are equivalent to repetition.
+	weights := make([]float64, len(gehan.times))
+	var expTimes []float64
+	var expEvents []bool
+	var expGroups []int
+	for i := range weights {
+		weights[i] = float64(1 + i%3)
+		for range 1 + i%3 {
+			expTimes = append(expTimes, gehan.times[i])
+			expEvents = append(expEvents, gehan.events[i])
+			expGroups = append(expGroups, gehan.placebo[i])
+		}
+	}
+	got, _, _ = LogRank(gehan.times, gehan.events, gehan.placebo, weights)
+	want, _, _ := LogRank(expTimes, expEvents, expGroups, nil)
+	if !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+		t.Errorf("weighted statistic differs from expanded data: got:%v want:%v", got, want)
+	}
+
+	if !panics(func() { LogRank(gehan.times, gehan.events, gehan.placebo[:3], nil) }) {
+		t.Errorf("expected panic for length mismatch")
+	}
+	if !panics(func() { LogRank(gehan.times[:21], gehan.events[:21], gehan.placebo[:21], nil) }) {
+		t.Errorf("expected panic for single group")
+	}
+	groups[0] = -1
+	if !panics(func() { LogRank(gehan.times, gehan.events, groups, nil) }) {
+		t.Errorf("expected panic for negative group")
+	}
+}
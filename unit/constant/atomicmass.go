@@ -11,3 +11,6 @@ import "gonum.org/v1/gonum/unit"
 // AtomicMass is the atomic mass constant (mᵤ), one twelfth of the mass of an unbound atom of carbon-12 at rest and in its ground state.
 // The dimension of AtomicMass is kg. The standard uncertainty of the constant is 5e-37 kg.
 const AtomicMass = unit.Mass(1.6605390666e-27)
+
+// AtomicMassUncertainty is the standard uncertainty of AtomicMass.
+const AtomicMassUncertainty = unit.Mass(5e-37)
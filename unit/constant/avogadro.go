@@ -16,6 +16,9 @@ import (
 // The dimension of Avogadro is mol^-1. The constant is exact.
 const Avogadro = avogadroUnits(6.02214076e+23)
 
+// AvogadroUncertainty is the standard uncertainty of Avogadro. It is zero since Avogadro is exact.
+const AvogadroUncertainty = avogadroUnits(0)
+
 type avogadroUnits float64
 
 // Unit converts the avogadroUnits to a *unit.Unit
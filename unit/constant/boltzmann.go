@@ -16,6 +16,9 @@ import (
 // The dimensions of Boltzmann are kg m^2 K^-1 s^-2. The constant is exact.
 const Boltzmann = boltzmannUnits(1.380649e-23)
 
+// BoltzmannUncertainty is the standard uncertainty of Boltzmann. It is zero since Boltzmann is exact.
+const BoltzmannUncertainty = boltzmannUnits(0)
+
 type boltzmannUnits float64
 
 // Unit converts the boltzmannUnits to a *unit.Unit
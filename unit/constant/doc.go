@@ -9,4 +9,11 @@
 //
 // Constant values reflect the values published at https://physics.nist.gov/cuu/index.html
 // and are subject to change when the published values are updated.
+// The standard uncertainty of each constant is provided by a constant
+// with the Uncertainty suffix, and the Measurement type propagates
+// uncertainties through calculations.
+//
+// The constants may be regenerated from a newer CODATA table of constants
+// by running generate_constants.go with the -codata flag naming the table
+// in the format of https://physics.nist.gov/cuu/Constants/Table/allascii.txt.
 package constant
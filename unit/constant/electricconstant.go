@@ -16,6 +16,9 @@ import (
 // The dimensions of ElectricConstant are A^2 s^4 kg^-1 m^-3. The standard uncertainty of the constant is 1.3e-21 A^2 s^4 kg^-1 m^-3.
 const ElectricConstant = electricConstantUnits(8.854187817620389e-12)
 
+// ElectricConstantUncertainty is the standard uncertainty of ElectricConstant.
+const ElectricConstantUncertainty = electricConstantUnits(1.3e-21)
+
 type electricConstantUnits float64
 
 // Unit converts the electricConstantUnits to a *unit.Unit
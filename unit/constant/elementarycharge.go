@@ -11,3 +11,6 @@ import "gonum.org/v1/gonum/unit"
 // ElementaryCharge, is the elementary charge constant (e), the magnitude of electric charge carried by a single proton or electron.
 // The dimensions of ElementaryCharge are A s. The constant is exact.
 const ElementaryCharge = unit.Charge(1.602176634e-19)
+
+// ElementaryChargeUncertainty is the standard uncertainty of ElementaryCharge. It is zero since ElementaryCharge is exact.
+const ElementaryChargeUncertainty = unit.Charge(0)
@@ -16,6 +16,9 @@ import (
 // The dimensions of Faraday are A s mol^-1. The constant is exact.
 const Faraday = faradayUnits(96485.33212)
 
+// FaradayUncertainty is the standard uncertainty of Faraday. It is zero since Faraday is exact.
+const FaradayUncertainty = faradayUnits(0)
+
 type faradayUnits float64
 
 // Unit converts the faradayUnits to a *unit.Unit
@@ -10,3 +10,6 @@ import "gonum.org/v1/gonum/unit"
 
 // FineStructure is the fine structure constant (α), it describes the strength of the electromagnetic interaction between elementary charged particles. The standard uncertainty of the constant is 1.1e-12 .
 const FineStructure = unit.Dimless(0.0072973525693)
+
+// FineStructureUncertainty is the standard uncertainty of FineStructure.
+const FineStructureUncertainty = unit.Dimless(1.1e-12)
@@ -8,11 +8,15 @@
 package main
 
 import (
+	"bufio"
 	"bytes"
+	"flag"
+	"fmt"
 	"go/format"
 	"log"
 	"math"
 	"os"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -28,66 +32,66 @@ const (
 
 var constants = []Constant{
 	{
-		Name: "AtomicMass", Value: 1.66053906660e-27,
+		Name: "AtomicMass", CODATA: "atomic mass constant", Value: 1.66053906660e-27,
 		Dimensions:  []Dimension{{massName, 1}},
 		Comment:     "AtomicMass is the atomic mass constant (mᵤ), one twelfth of the mass of an unbound atom of carbon-12 at rest and in its ground state.",
 		Uncertainty: 0.00000000050e-27,
 	},
 	{
-		Name: "Avogadro", Value: 6.02214076e23,
+		Name: "Avogadro", CODATA: "Avogadro constant", Value: 6.02214076e23,
 		Dimensions: []Dimension{{moleName, -1}},
 		Comment:    "Avogadro is the Avogadro constant (A), the number of constituent particles contained in one mole of a substance.",
 	},
 	{
-		Name: "Boltzmann", Value: 1.380649e-23,
+		Name: "Boltzmann", CODATA: "Boltzmann constant", Value: 1.380649e-23,
 		Dimensions: []Dimension{{massName, 1}, {lengthName, 2}, {timeName, -2}, {temperatureName, -1}},
 		Comment:    "Boltzmann is the Boltzmann constant (k), it relates the average relative kinetic energy of particles in a gas with the temperature of the gas.",
 	},
 	{
-		Name: "ElectricConstant", Value: 1 / (4 * math.Pi * 1e-7 * lightSpeed * lightSpeed),
+		Name: "ElectricConstant", CODATA: "vacuum electric permittivity", Value: 1 / (4 * math.Pi * 1e-7 * lightSpeed * lightSpeed),
 		Dimensions:  []Dimension{{currentName, 2}, {timeName, 4}, {massName, -1}, {lengthName, -3}},
 		Comment:     "ElectricConstant is the electric constant (ε₀), the value of the absolute dielectric permittivity of classical vacuum.",
 		Uncertainty: 0.0000000013e-12,
 	},
 	{
-		Name: "ElementaryCharge", Value: elementaryCharge,
+		Name: "ElementaryCharge", CODATA: "elementary charge", Value: elementaryCharge,
 		Dimensions: []Dimension{{currentName, 1}, {timeName, 1}},
 		Comment:    "ElementaryCharge, is the elementary charge constant (e), the magnitude of electric charge carried by a single proton or electron.",
 	},
 	{
-		Name: "Faraday", Value: 96485.33212,
+		Name: "Faraday", CODATA: "Faraday constant", Value: 96485.33212,
 		Dimensions: []Dimension{{currentName, 1}, {timeName, 1}, {moleName, -1}},
 		Comment:    "Faraday is the Faraday constant, the magnitude of electric charge per mole of electrons.",
 	},
 	{
-		Name: "FineStructure", Value: fineStructure,
+		Name: "FineStructure", CODATA: "fine-structure constant", Value: fineStructure,
 		Comment:     "FineStructure is the fine structure constant (α), it describes the strength of the electromagnetic interaction between elementary charged particles.",
 		Uncertainty: 0.0000000011e-3,
 	},
 	{
-		Name: "Gravitational", Value: 6.67430e-11,
+		Name: "Gravitational", CODATA: "Newtonian constant of gravitation", Value: 6.67430e-11,
 		Dimensions:  []Dimension{{massName, -1}, {lengthName, 3}, {timeName, -2}},
 		Comment:     "Gravitational is the universal gravitational constant (G), the proportionality constant connecting the gravitational force between two bodies.",
 		Uncertainty: 0.00015e-11,
 	},
 	{
-		Name: "LightSpeedInVacuum", Value: lightSpeed,
+		Name: "LightSpeedInVacuum", CODATA: "speed of light in vacuum", Value: lightSpeed,
 		Dimensions: []Dimension{{lengthName, 1}, {timeName, -1}},
 		Comment:    "LightSpeedInVacuum is the c constant, the speed of light in a vacuum.",
 	},
 	{
-		Name: "MagneticConstant", Value: 2 * fineStructure * planck / (elementaryCharge * elementaryCharge * lightSpeed),
+		Name: "MagneticConstant", CODATA: "vacuum mag. permeability", Value: 2 * fineStructure * planck / (elementaryCharge * elementaryCharge * lightSpeed),
 		Dimensions:  []Dimension{{currentName, 2}, {timeName, 4}, {massName, -1}, {lengthName, -3}},
 		Comment:     "MagneticConstant is the magnetic constant (μ₀), the magnetic permeability in a classical vacuum.",
 		Uncertainty: 0.00000000019e-6,
 	},
 	{
-		Name: "Planck", Value: planck,
+		Name: "Planck", CODATA: "Planck constant", Value: planck,
 		Dimensions: []Dimension{{massName, 1}, {lengthName, 2}, {timeName, -1}},
 		Comment:    "Planck is the Planck constant (h), it relates the energy carried by a photon to its frequency.",
 	},
 	{
-		Name: "StandardGravity", Value: 9.80665,
+		Name: "StandardGravity", CODATA: "standard acceleration of gravity", Value: 9.80665,
 		Dimensions: []Dimension{{lengthName, 1}, {timeName, -2}},
 		Comment:    "StandardGravity is the standard gravity constant (g₀), the nominal gravitational acceleration of an object in a vacuum near the surface of the Earth",
 	},
@@ -121,6 +125,10 @@ type Constant struct {
 	Dimensions  []Dimension
 	Comment     string
 	Uncertainty float64
+
+	// CODATA is the name of the constant in the
+	// NIST CODATA table of constants.
+	CODATA string
 }
 
 type Dimension struct {
@@ -153,7 +161,21 @@ func (c Constant) dimensions() unit.Dimensions {
 }
 
 // Generate a file for each of the constants.
+//
+// If the -codata flag is set, the values and uncertainties of the
+// constants are read from the named file, which must be in the format
+// of the NIST CODATA table of constants available from
+// https://physics.nist.gov/cuu/Constants/Table/allascii.txt.
 func main() {
+	codata := flag.String("codata", "", "specify a NIST CODATA table to update the constants from")
+	flag.Parse()
+	if *codata != "" {
+		err := updateFromCODATA(*codata, constants)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
 	for _, c := range constants {
 		generate(c)
 		generateTest(c)
@@ -173,6 +195,9 @@ import "gonum.org/v1/gonum/unit"
 // {{.Comment}}{{if .Dimensions}}
 // {{$n := len .Dimensions}}The dimension{{if gt $n 1}}s{{end}} of {{.Name}} {{if eq $n 1}}is{{else}}are{{end}} {{.Units}}.{{end}} {{if not .Uncertainty}}The constant is exact.{{else}}The standard uncertainty of the constant is {{.Uncertainty}} {{.Units}}.{{end}}
 const {{.Name}} = {{.Type}}({{.Value}})
+
+// {{.Name}}Uncertainty is the standard uncertainty of {{.Name}}.{{if not .Uncertainty}} It is zero since {{.Name}} is exact.{{end}}
+const {{.Name}}Uncertainty = {{.Type}}({{.Uncertainty}})
 `
 
 var baseUnit = template.Must(template.New("base").Parse(baseUnitTemplate))
@@ -195,6 +220,9 @@ import (
 // {{$n := len .Dimensions}}The dimension{{if gt $n 1}}s{{end}} of {{.Name}} {{if eq $n 1}}is{{else}}are{{end}} {{.Units}}. {{if not .Uncertainty}}The constant is exact.{{else}}The standard uncertainty of the constant is {{.Uncertainty}} {{.Units}}.{{end}}
 const {{.Name}} = {{.Type}}({{.Value}})
 
+// {{.Name}}Uncertainty is the standard uncertainty of {{.Name}}.{{if not .Uncertainty}} It is zero since {{.Name}} is exact.{{end}}
+const {{.Name}}Uncertainty = {{.Type}}({{.Uncertainty}})
+
 type {{.Type}} float64
 
 // Unit converts the {{.Type}} to a *unit.Unit
@@ -331,3 +359,81 @@ func generateTest(c Constant) {
 
 	f.Write(b)
 }
+
+// updateFromCODATA updates the values and uncertainties of the constants
+// in c from the NIST CODATA table of constants in the named file.
+//
+// The table holds a header ending with a line of dashes followed by a line
+// for each constant with fixed width columns for the name, value,
+// uncertainty and units of the constant. Digits of values and
+// uncertainties are grouped with spaces, values that are not exact
+// decimals end with an ellipsis and the uncertainty of exact values is
+// given as "(exact)".
+func updateFromCODATA(path string, c []Constant) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	const (
+		nameEnd  = 60
+		valueEnd = 85
+		uncerEnd = 110
+	)
+	table := make(map[string][2]float64)
+	sc := bufio.NewScanner(f)
+	var inTable bool
+	for sc.Scan() {
+		line := sc.Text()
+		if !inTable {
+			inTable = strings.HasPrefix(line, "-----")
+			continue
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if len(line) < valueEnd {
+			return fmt.Errorf("short CODATA line: %q", line)
+		}
+		name := strings.TrimSpace(line[:nameEnd])
+		value, err := parseCODATA(line[nameEnd:valueEnd])
+		if err != nil {
+			return fmt.Errorf("invalid value for %q: %w", name, err)
+		}
+		var uncertainty float64
+		if u := strings.TrimSpace(line[valueEnd:min(uncerEnd, len(line))]); u != "(exact)" {
+			uncertainty, err = parseCODATA(u)
+			if err != nil {
+				return fmt.Errorf("invalid uncertainty for %q: %w", name, err)
+			}
+		}
+		table[name] = [2]float64{value, uncertainty}
+	}
+	err = sc.Err()
+	if err != nil {
+		return err
+	}
+	if !inTable {
+		return fmt.Errorf("no CODATA table found in %s", path)
+	}
+
+	for i, k := range c {
+		if k.CODATA == "" {
+			continue
+		}
+		v, ok := table[k.CODATA]
+		if !ok {
+			return fmt.Errorf("no value for %s (%q) in %s", k.Name, k.CODATA, path)
+		}
+		c[i].Value, c[i].Uncertainty = v[0], v[1]
+	}
+	return nil
+}
+
+// parseCODATA parses a number in the format of the CODATA table.
+func parseCODATA(s string) (float64, error) {
+	s = strings.ReplaceAll(s, " ", "")
+	s = strings.TrimSuffix(s, "...")
+	return strconv.ParseFloat(s, 64)
+}
@@ -16,6 +16,9 @@ import (
 // The dimensions of Gravitational are m^3 kg^-1 s^-2. The standard uncertainty of the constant is 1.5e-15 m^3 kg^-1 s^-2.
 const Gravitational = gravitationalUnits(6.6743e-11)
 
+// GravitationalUncertainty is the standard uncertainty of Gravitational.
+const GravitationalUncertainty = gravitationalUnits(1.5e-15)
+
 type gravitationalUnits float64
 
 // Unit converts the gravitationalUnits to a *unit.Unit
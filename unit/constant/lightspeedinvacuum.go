@@ -11,3 +11,6 @@ import "gonum.org/v1/gonum/unit"
 // LightSpeedInVacuum is the c constant, the speed of light in a vacuum.
 // The dimensions of LightSpeedInVacuum are m s^-1. The constant is exact.
 const LightSpeedInVacuum = unit.Velocity(2.99792458e+08)
+
+// LightSpeedInVacuumUncertainty is the standard uncertainty of LightSpeedInVacuum. It is zero since LightSpeedInVacuum is exact.
+const LightSpeedInVacuumUncertainty = unit.Velocity(0)
@@ -16,6 +16,9 @@ import (
 // The dimensions of MagneticConstant are A^2 s^4 kg^-1 m^-3. The standard uncertainty of the constant is 1.9e-16 A^2 s^4 kg^-1 m^-3.
 const MagneticConstant = magneticConstantUnits(1.2566370621238374e-06)
 
+// MagneticConstantUncertainty is the standard uncertainty of MagneticConstant.
+const MagneticConstantUncertainty = magneticConstantUnits(1.9e-16)
+
 type magneticConstantUnits float64
 
 // Unit converts the magneticConstantUnits to a *unit.Unit
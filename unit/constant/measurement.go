@@ -0,0 +1,117 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package constant
+
+import (
+	"fmt"
+	"math"
+)
+
+// Measurement is a value with a standard uncertainty, Value ± Sigma.
+//
+// The arithmetic methods of Measurement propagate uncertainties to first
+// order under the assumption that the uncertainties of the operands are
+// independent, so the results are not correct for operands that are
+// correlated. In particular, combining a Measurement with itself, such as
+// in m.Mul(m), treats the two operands as independent; m.Pow(2) should be
+// used instead.
+type Measurement struct {
+	Value float64
+	Sigma float64
+}
+
+// Measure returns a Measurement holding the value and standard uncertainty
+// of a constant. For example
+//
+//	g := constant.Measure(constant.Gravitational, constant.GravitationalUncertainty)
+func Measure[T ~float64](value, sigma T) Measurement {
+	return Measurement{Value: float64(value), Sigma: math.Abs(float64(sigma))}
+}
+
+// Add returns the sum of m and n.
+func (m Measurement) Add(n Measurement) Measurement {
+	return Measurement{Value: m.Value + n.Value, Sigma: math.Hypot(m.Sigma, n.Sigma)}
+}
+
+// Sub returns the difference of m and n.
+func (m Measurement) Sub(n Measurement) Measurement {
+	return Measurement{Value: m.Value - n.Value, Sigma: math.Hypot(m.Sigma, n.Sigma)}
+}
+
+// Mul returns the product of m and n.
+func (m Measurement) Mul(n Measurement) Measurement {
+	return Measurement{
+		Value: m.Value * n.Value,
+		Sigma: math.Hypot(n.Value*m.Sigma, m.Value*n.Sigma),
+	}
+}
+
+// Div returns the quotient of m and n.
+func (m Measurement) Div(n Measurement) Measurement {
+	v := m.Value / n.Value
+	return Measurement{
+		Value: v,
+		Sigma: math.Hypot(m.Sigma/n.Value, v*n.Sigma/n.Value),
+	}
+}
+
+// Scale returns m multiplied by the exact value f.
+func (m Measurement) Scale(f float64) Measurement {
+	return Measurement{Value: f * m.Value, Sigma: math.Abs(f) * m.Sigma}
+}
+
+// Pow returns m raised to the exact power p.
+func (m Measurement) Pow(p float64) Measurement {
+	return Measurement{
+		Value: math.Pow(m.Value, p),
+		Sigma: math.Abs(p*math.Pow(m.Value, p-1)) * m.Sigma,
+	}
+}
+
+// Relative returns the relative standard uncertainty of m, Sigma/|Value|.
+func (m Measurement) Relative() float64 {
+	return m.Sigma / math.Abs(m.Value)
+}
+
+// String returns a string representation of m in the form "Value ± Sigma".
+func (m Measurement) String() string {
+	return fmt.Sprintf("%v ± %v", m.Value, m.Sigma)
+}
+
+// Propagate returns the value of fn evaluated at the values of x and its
+// standard uncertainty propagated to first order from the uncertainties of
+// x, which are assumed to be independent. The partial derivatives of fn
+// are approximated using central differences with steps scaled by the
+// magnitude of each value. fn must not retain or modify its argument.
+func Propagate(fn func(x []float64) float64, x ...Measurement) Measurement {
+	v := make([]float64, len(x))
+	for i, m := range x {
+		v[i] = m.Value
+	}
+	f := fn(v)
+
+	// step is the relative step size for central
+	// differences, the cube root of machine epsilon.
+	const step = 6.0554544523933395e-06
+
+	var sigma2 float64
+	for i, m := range x {
+		if m.Sigma == 0 {
+			continue
+		}
+		h := step * math.Abs(m.Value)
+		if h == 0 {
+			h = step * m.Sigma
+		}
+		v[i] = m.Value + h
+		hi := fn(v)
+		v[i] = m.Value - h
+		lo := fn(v)
+		v[i] = m.Value
+		d := (hi - lo) / (2 * h) * m.Sigma
+		sigma2 += d * d
+	}
+	return Measurement{Value: f, Sigma: math.Sqrt(sigma2)}
+}
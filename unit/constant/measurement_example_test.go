@@ -0,0 +1,35 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package constant_test
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/gonum/unit/constant"
+)
+
+func ExampleMeasurement() {
+	// Calculate the mass of the Earth from the standard
+	// gravity and a mean radius of 6371.0±0.5 km using
+	// g = GM/r², propagating the uncertainty of the
+	// gravitational constant and of the radius.
+	g := constant.Measure(constant.StandardGravity, constant.StandardGravityUncertainty)
+	G := constant.Measure(constant.Gravitational, constant.GravitationalUncertainty)
+	r := constant.Measurement{Value: 6371.0e3, Sigma: 0.5e3}
+
+	m := g.Mul(r.Pow(2)).Div(G)
+	fmt.Printf("M = %.4g ± %.1g kg (relative uncertainty %.1g)\n", m.Value, m.Sigma, m.Relative())
+
+	// The same calculation using Propagate.
+	m = constant.Propagate(func(x []float64) float64 {
+		return x[0] * math.Pow(x[1], 2) / x[2]
+	}, g, r, G)
+	fmt.Printf("M = %.4g ± %.1g kg\n", m.Value, m.Sigma)
+
+	// Output:
+	// M = 5.964e+24 ± 9e+20 kg (relative uncertainty 0.0002)
+	// M = 5.964e+24 ± 9e+20 kg
+}
@@ -0,0 +1,109 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package constant
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestMeasurement(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+
+	a := Measurement{Value: 3, Sigma: 0.3}
+	b := Measurement{Value: 4, Sigma: 0.4}
+	for _, test := range []struct {
+		name string
+		got  Measurement
+		want Measurement
+	}{
+		{name: "add", got: a.Add(b), want: Measurement{Value: 7, Sigma: 0.5}},
+		{name: "sub", got: a.Sub(b), want: Measurement{Value: -1, Sigma: 0.5}},
+		{name: "mul", got: a.Mul(b), want: Measurement{Value: 12, Sigma: 12 * math.Sqrt2 * 0.1}},
+		{name: "div", got: a.Div(b), want: Measurement{Value: 0.75, Sigma: 0.75 * math.Sqrt2 * 0.1}},
+		{name: "scale", got: a.Scale(-2), want: Measurement{Value: -6, Sigma: 0.6}},
+		{name: "pow", got: b.Pow(2), want: Measurement{Value: 16, Sigma: 3.2}},
+		{name: "sqrt", got: b.Pow(0.5), want: Measurement{Value: 2, Sigma: 0.1}},
+		{name: "measure", got: Measure(StandardGravity, -StandardGravityUncertainty), want: Measurement{Value: 9.80665}},
+		{name: "measure uncertain", got: Measure(Gravitational, GravitationalUncertainty), want: Measurement{Value: 6.6743e-11, Sigma: 1.5e-15}},
+	} {
+		if !scalar.EqualWithinAbsOrRel(test.got.Value, test.want.Value, tol, tol) ||
+			!scalar.EqualWithinAbsOrRel(test.got.Sigma, test.want.Sigma, tol, tol) {
+			t.Errorf("unexpected result for %s: got:%v want:%v", test.name, test.got, test.want)
+		}
+	}
+
+	if got := b.Relative(); got != 0.1 {
+		t.Errorf("unexpected relative uncertainty: got:%v want:0.1", got)
+	}
+	if got := a.String(); got != "3 ± 0.3" {
+		t.Errorf("unexpected string: got:%q want:%q", got, "3 ± 0.3")
+	}
+}
+
+func TestPropagate(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-8
+
+	a := Measurement{Value: 3, Sigma: 0.3}
+	b := Measurement{Value: 4, Sigma: 0.4}
+	c := Measurement{Value: 0, Sigma: 0.1}
+	for _, test := range []struct {
+		name string
+		fn   func([]float64) float64
+		x    []Measurement
+		want Measurement
+	}{
+		{
+			name: "mul",
+			fn:   func(x []float64) float64 { return x[0] * x[1] },
+			x:    []Measurement{a, b},
+			want: a.Mul(b),
+		},
+		{
+			name: "div",
+			fn:   func(x []float64) float64 { return x[0] / x[1] },
+			x:    []Measurement{a, b},
+			want: a.Div(b),
+		},
+		{
+			name: "square",
+			fn:   func(x []float64) float64 { return x[0] * x[0] },
+			x:    []Measurement{b},
+			want: b.Pow(2),
+		},
+		{
+			name: "exp at zero",
+			fn:   func(x []float64) float64 { return math.Exp(x[0]) },
+			x:    []Measurement{c},
+			want: Measurement{Value: 1, Sigma: 0.1},
+		},
+		{
+			name: "exact",
+			fn:   func(x []float64) float64 { return x[0] + x[1] },
+			x:    []Measurement{{Value: 1}, {Value: 2}},
+			want: Measurement{Value: 3},
+		},
+		{
+			// The energy of a photon with a wavelength of
+			// 500±1 nm, E = hc/λ, where h and c are exact.
+			name: "photon energy",
+			fn:   func(x []float64) float64 { return float64(Planck) * float64(LightSpeedInVacuum) / x[0] },
+			x:    []Measurement{{Value: 500e-9, Sigma: 1e-9}},
+			want: Measure(Planck, PlanckUncertainty).
+				Mul(Measure(LightSpeedInVacuum, LightSpeedInVacuumUncertainty)).
+				Div(Measurement{Value: 500e-9, Sigma: 1e-9}),
+		},
+	} {
+		got := Propagate(test.fn, test.x...)
+		if !scalar.EqualWithinAbsOrRel(got.Value, test.want.Value, tol, tol) ||
+			!scalar.EqualWithinAbsOrRel(got.Sigma, test.want.Sigma, tol, tol) {
+			t.Errorf("unexpected result for %s: got:%v want:%v", test.name, got, test.want)
+		}
+	}
+}
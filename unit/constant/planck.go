@@ -16,6 +16,9 @@ import (
 // The dimensions of Planck are kg m^2 s^-1. The constant is exact.
 const Planck = planckUnits(6.62607015e-34)
 
+// PlanckUncertainty is the standard uncertainty of Planck. It is zero since Planck is exact.
+const PlanckUncertainty = planckUnits(0)
+
 type planckUnits float64
 
 // Unit converts the planckUnits to a *unit.Unit
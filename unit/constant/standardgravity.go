@@ -11,3 +11,6 @@ import "gonum.org/v1/gonum/unit"
 // StandardGravity is the standard gravity constant (g₀), the nominal gravitational acceleration of an object in a vacuum near the surface of the Earth
 // The dimensions of StandardGravity are m s^-2. The constant is exact.
 const StandardGravity = unit.Acceleration(9.80665)
+
+// StandardGravityUncertainty is the standard uncertainty of StandardGravity. It is zero since StandardGravity is exact.
+const StandardGravityUncertainty = unit.Acceleration(0)
@@ -0,0 +1,417 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unit
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// named is a named unit of measurement recognised by Parse.
+type named struct {
+	scale float64
+	dims  Dimensions
+
+	// prefixable indicates whether the unit
+	// may be combined with an SI prefix.
+	prefixable bool
+}
+
+var (
+	force       = Dimensions{MassDim: 1, LengthDim: 1, TimeDim: -2}
+	pressure    = Dimensions{MassDim: 1, LengthDim: -1, TimeDim: -2}
+	energy      = Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -2}
+	power       = Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -3}
+	frequency   = Dimensions{TimeDim: -1}
+	absorbed    = Dimensions{LengthDim: 2, TimeDim: -2}
+	resistance  = Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -3, CurrentDim: -2}
+	conductance = Dimensions{MassDim: -1, LengthDim: -2, TimeDim: 3, CurrentDim: 2}
+
+	// units is the table of unit symbols recognised by Parse.
+	units = map[string]named{
+		// SI base units. The kilogram is
+		// handled as a prefixed gram.
+		"A":   {1, Dimensions{CurrentDim: 1}, true},
+		"m":   {1, Dimensions{LengthDim: 1}, true},
+		"cd":  {1, Dimensions{LuminousIntensityDim: 1}, true},
+		"g":   {1e-3, Dimensions{MassDim: 1}, true},
+		"mol": {1, Dimensions{MoleDim: 1}, true},
+		"K":   {1, Dimensions{TemperatureDim: 1}, true},
+		"s":   {1, Dimensions{TimeDim: 1}, true},
+		"rad": {1, Dimensions{AngleDim: 1}, true},
+
+		// SI derived units with special symbols.
+		"sr":  {1, Dimensions{AngleDim: 2}, false},
+		"Hz":  {1, frequency, true},
+		"N":   {1, force, true},
+		"Pa":  {1, pressure, true},
+		"J":   {1, energy, true},
+		"W":   {1, power, true},
+		"C":   {1, Dimensions{CurrentDim: 1, TimeDim: 1}, true},
+		"V":   {1, Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -3, CurrentDim: -1}, true},
+		"F":   {1, Dimensions{MassDim: -1, LengthDim: -2, TimeDim: 4, CurrentDim: 2}, true},
+		"Ω":   {1, resistance, true},
+		"ohm": {1, resistance, true},
+		"S":   {1, conductance, true},
+		"Wb":  {1, Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -2, CurrentDim: -1}, true},
+		"T":   {1, Dimensions{MassDim: 1, TimeDim: -2, CurrentDim: -1}, true},
+		"H":   {1, Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -2, CurrentDim: -2}, true},
+		"Bq":  {1, frequency, true},
+		"Gy":  {1, absorbed, true},
+		"Sv":  {1, absorbed, true},
+		"kat": {1, Dimensions{MoleDim: 1, TimeDim: -1}, true},
+
+		// Units in use with SI.
+		"min": {60, Dimensions{TimeDim: 1}, false},
+		"h":   {3600, Dimensions{TimeDim: 1}, false},
+		"d":   {86400, Dimensions{TimeDim: 1}, false},
+		"deg": {math.Pi / 180, Dimensions{AngleDim: 1}, false},
+		"°":   {math.Pi / 180, Dimensions{AngleDim: 1}, false},
+		"ha":  {1e4, Dimensions{LengthDim: 2}, false},
+		"L":   {1e-3, Dimensions{LengthDim: 3}, true},
+		"l":   {1e-3, Dimensions{LengthDim: 3}, true},
+		"t":   {1e3, Dimensions{MassDim: 1}, false},
+		"eV":  {1.602176634e-19, energy, true},
+		"bar": {1e5, pressure, true},
+	}
+
+	// prefixes is the table of SI prefixes recognised by Parse.
+	prefixes = map[string]float64{
+		"Y":  Yotta,
+		"Z":  Zetta,
+		"E":  Exa,
+		"P":  Peta,
+		"T":  Tera,
+		"G":  Giga,
+		"M":  Mega,
+		"k":  Kilo,
+		"h":  Hecto,
+		"da": Deca,
+		"d":  Deci,
+		"c":  Centi,
+		"m":  Milli,
+		"μ":  Micro,
+		"µ":  Micro, // U+00B5 MICRO SIGN.
+		"u":  Micro,
+		"n":  Nano,
+		"p":  Pico,
+		"f":  Femto,
+		"a":  Atto,
+		"z":  Zepto,
+		"y":  Yocto,
+	}
+)
+
+// Parse parses a quantity written as an optional number followed by a unit
+// expression, for example "3.2 km/h", "9.81 m s^-2", "1.5e3 kg·m²/s²" or
+// "J/(mol K)". If the number is omitted, the quantity has a value of one.
+//
+// A unit expression is a sequence of unit symbols, optionally with an SI
+// prefix and an integer power written with "^", "**" or superscript digits.
+// Symbols are combined with "*", "·", "/" or white space for multiplication.
+// Multiplication and division have equal precedence and associate to the
+// left, so compound denominators must be parenthesised. The SI base and
+// derived units, and the common units accepted for use with SI, are
+// recognised, as are the symbols of dimensions created by NewDimension.
+// Units with an offset, such as the degree Celsius, are not supported.
+func Parse(s string) (*Unit, error) {
+	p := parser{s: s}
+	p.next()
+	if p.tok.kind == tokEOF {
+		return nil, errors.New("unit: empty quantity")
+	}
+	var neg bool
+	if p.tok.kind == tokOp && (p.tok.text == "-" || p.tok.text == "+") {
+		neg = p.tok.text == "-"
+		p.next()
+	}
+	u, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokEOF {
+		return nil, p.errorf("unexpected %q", p.tok.text)
+	}
+	if neg {
+		u.value = -u.value
+	}
+	return u, nil
+}
+
+// In returns the value of the receiver expressed in the units given by
+// the unit expression s, which is parsed as for Parse. In returns an error
+// if s cannot be parsed or does not have the dimensions of the receiver.
+func (u *Unit) In(s string) (float64, error) {
+	to, err := Parse(s)
+	if err != nil {
+		return 0, err
+	}
+	if !u.dimensions.matches(to.dimensions) {
+		return 0, errors.New("unit: dimension mismatch")
+	}
+	return u.value / to.value, nil
+}
+
+// FormatIn returns the receiver expressed in the units given by the unit
+// expression s, formatted according to the format and precision arguments
+// of strconv.FormatFloat, followed by s. FormatIn returns an error if s
+// cannot be parsed or does not have the dimensions of the receiver.
+func (u *Unit) FormatIn(s string, fmt byte, prec int) (string, error) {
+	v, err := u.In(s)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatFloat(v, fmt, prec, 64) + " " + strings.TrimSpace(s), nil
+}
+
+// lookup returns the unit corresponding to the possibly
+// prefixed symbol sym.
+func lookup(sym string) (*Unit, bool) {
+	if n, ok := units[sym]; ok {
+		return New(n.scale, n.dims), true
+	}
+	for p, f := range prefixes {
+		n, ok := units[strings.TrimPrefix(sym, p)]
+		if !ok || !n.prefixable || len(p) == len(sym) || !strings.HasPrefix(sym, p) {
+			continue
+		}
+		return New(f*n.scale, n.dims), true
+	}
+	mu.RLock()
+	d, ok := dimensions[sym]
+	mu.RUnlock()
+	if !ok || d <= AngleDim {
+		return nil, false
+	}
+	return New(1, Dimensions{d: 1}), true
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNumber
+	tokSymbol
+	tokPower
+	tokOp
+	tokSpace
+	tokLParen
+	tokRParen
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// parser is a recursive descent parser for quantity expressions.
+type parser struct {
+	s   string
+	off int
+	tok token
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("unit: parsing %q at offset %d: %s", p.s, p.tok.pos, fmt.Sprintf(format, args...))
+}
+
+// expr parses a product or quotient of factors.
+func (p *parser) expr() (*Unit, error) {
+	u, err := p.factor()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		div := false
+		switch p.tok.kind {
+		case tokSpace:
+			p.next()
+			if p.tok.kind != tokNumber && p.tok.kind != tokSymbol && p.tok.kind != tokLParen {
+				continue
+			}
+		case tokSymbol:
+			// A number immediately followed by a unit, as in "3km".
+		case tokOp:
+			div = p.tok.text == "/"
+			if !div && p.tok.text != "*" && p.tok.text != "·" && p.tok.text != "⋅" {
+				return u, nil
+			}
+			p.next()
+		default:
+			return u, nil
+		}
+		if p.tok.kind == tokSpace {
+			p.next()
+		}
+		f, err := p.factor()
+		if err != nil {
+			return nil, err
+		}
+		if div {
+			u.Div(f)
+		} else {
+			u.Mul(f)
+		}
+	}
+}
+
+// factor parses a number, a unit symbol or a parenthesised
+// expression, followed by an optional power.
+func (p *parser) factor() (*Unit, error) {
+	var u *Unit
+	switch p.tok.kind {
+	case tokNumber:
+		v, err := strconv.ParseFloat(p.tok.text, 64)
+		if err != nil {
+			return nil, p.errorf("invalid number %q", p.tok.text)
+		}
+		u = New(v, Dimensions{})
+	case tokSymbol:
+		var ok bool
+		u, ok = lookup(p.tok.text)
+		if !ok {
+			return nil, p.errorf("unknown unit %q", p.tok.text)
+		}
+	case tokLParen:
+		p.next()
+		if p.tok.kind == tokSpace {
+			p.next()
+		}
+		var err error
+		u, err = p.expr()
+		if err != nil {
+			return nil, err
+		}
+		if p.tok.kind == tokSpace {
+			p.next()
+		}
+		if p.tok.kind != tokRParen {
+			return nil, p.errorf("missing closing parenthesis")
+		}
+	case tokEOF:
+		return nil, p.errorf("unexpected end of expression")
+	default:
+		return nil, p.errorf("unexpected %q", p.tok.text)
+	}
+	p.next()
+	if p.tok.kind == tokPower {
+		n, err := strconv.Atoi(p.tok.text)
+		if err != nil {
+			return nil, p.errorf("invalid power %q", p.tok.text)
+		}
+		u.Pow(n)
+		p.next()
+	}
+	return u, nil
+}
+
+// next advances the parser to the next token.
+func (p *parser) next() {
+	start := p.off
+	if p.off == len(p.s) {
+		p.tok = token{kind: tokEOF, pos: start}
+		return
+	}
+	r, w := utf8.DecodeRuneInString(p.s[p.off:])
+	switch {
+	case unicode.IsSpace(r):
+		for p.off < len(p.s) {
+			r, w := utf8.DecodeRuneInString(p.s[p.off:])
+			if !unicode.IsSpace(r) {
+				break
+			}
+			p.off += w
+		}
+		p.tok = token{kind: tokSpace, text: " ", pos: start}
+	case '0' <= r && r <= '9' || r == '.':
+		p.off = start + numberLen(p.s[start:])
+		p.tok = token{kind: tokNumber, text: p.s[start:p.off], pos: start}
+	case r == '^' || strings.HasPrefix(p.s[p.off:], "**"):
+		p.off += w
+		if r == '*' {
+			p.off++
+		}
+		for p.off < len(p.s) && p.s[p.off] == ' ' {
+			p.off++
+		}
+		n := p.off
+		if n < len(p.s) && (p.s[n] == '-' || p.s[n] == '+') {
+			n++
+		}
+		for n < len(p.s) && '0' <= p.s[n] && p.s[n] <= '9' {
+			n++
+		}
+		p.tok = token{kind: tokPower, text: p.s[p.off:n], pos: start}
+		p.off = n
+	case strings.ContainsRune(superscripts, r):
+		var buf strings.Builder
+		for p.off < len(p.s) {
+			r, w := utf8.DecodeRuneInString(p.s[p.off:])
+			i := strings.IndexRune(superscripts, r)
+			if i < 0 {
+				break
+			}
+			buf.WriteByte("0123456789-+"[utf8.RuneCountInString(superscripts[:i])])
+			p.off += w
+		}
+		p.tok = token{kind: tokPower, text: buf.String(), pos: start}
+	case r == '(':
+		p.off += w
+		p.tok = token{kind: tokLParen, text: "(", pos: start}
+	case r == ')':
+		p.off += w
+		p.tok = token{kind: tokRParen, text: ")", pos: start}
+	case strings.ContainsRune("*·⋅/-+", r):
+		p.off += w
+		p.tok = token{kind: tokOp, text: string(r), pos: start}
+	case unicode.IsLetter(r) || r == '°':
+		for p.off < len(p.s) {
+			r, w := utf8.DecodeRuneInString(p.s[p.off:])
+			if !unicode.IsLetter(r) && r != '°' {
+				break
+			}
+			p.off += w
+		}
+		p.tok = token{kind: tokSymbol, text: p.s[start:p.off], pos: start}
+	default:
+		p.off += w
+		p.tok = token{kind: tokOp, text: string(r), pos: start}
+	}
+}
+
+// superscripts holds the superscript forms of
+// the characters in "0123456789-+".
+const superscripts = "⁰¹²³⁴⁵⁶⁷⁸⁹⁻⁺"
+
+// numberLen returns the length of the decimal floating point
+// number at the start of s.
+func numberLen(s string) int {
+	digits := func(i int) int {
+		for i < len(s) && '0' <= s[i] && s[i] <= '9' {
+			i++
+		}
+		return i
+	}
+	n := digits(0)
+	if n < len(s) && s[n] == '.' {
+		n = digits(n + 1)
+	}
+	if n < len(s) && (s[n] == 'e' || s[n] == 'E') {
+		i := n + 1
+		if i < len(s) && (s[i] == '-' || s[i] == '+') {
+			i++
+		}
+		if j := digits(i); j > i {
+			n = j
+		}
+	}
+	return n
+}
@@ -0,0 +1,135 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unit
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		in   string
+		want Uniter
+	}{
+		{in: "3.2 km/h", want: Velocity(3.2 * 1000 / 3600)},
+		{in: "3.2km/h", want: Velocity(3.2 * 1000 / 3600)},
+		{in: "9.81 m s^-2", want: Acceleration(9.81)},
+		{in: "9.81 m/s**2", want: Acceleration(9.81)},
+		{in: "9.81 m·s⁻²", want: Acceleration(9.81)},
+		{in: "-4 m / s / s", want: Acceleration(-4)},
+		{in: "1.5e3 kg m²/s²", want: Energy(1500)},
+		{in: "2 kWh", want: nil},
+		{in: "2 kW h", want: Energy(2 * 1000 * 3600)},
+		{in: "1 J/(mol K)", want: New(1, Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -2, MoleDim: -1, TemperatureDim: -1})},
+		{in: "kg", want: Mass(1)},
+		{in: "mg", want: Mass(1e-6)},
+		{in: "5 dam", want: Length(50)},
+		{in: "1 hPa", want: Pressure(100)},
+		{in: "1 mbar", want: Pressure(100)},
+		{in: "3 μs", want: Time(3e-6)},
+		{in: "3 µs", want: Time(3e-6)},
+		{in: "3 us", want: Time(3e-6)},
+		{in: "2 min", want: Time(120)},
+		{in: "1 d", want: Time(86400)},
+		{in: "1 cd", want: LuminousIntensity(1)},
+		{in: "2 mL", want: Volume(2e-6)},
+		{in: "180 deg", want: Angle(math.Pi)},
+		{in: "1 kΩ", want: Resistance(1000)},
+		{in: "1/s", want: Frequency(1)},
+		{in: "10^3 m", want: Length(1000)},
+		{in: "4", want: Dimless(4)},
+		{in: "m^0", want: Dimless(1)},
+		{in: "", want: nil},
+		{in: "3 furlong", want: nil},
+		{in: "3 m^", want: nil},
+		{in: "3 (m", want: nil},
+		{in: "3 m -1", want: nil},
+		{in: "3 m)", want: nil},
+		{in: "3 kmin", want: nil},
+	} {
+		got, err := Parse(test.in)
+		if test.want == nil {
+			if err == nil {
+				t.Errorf("expected error for %q, got %v", test.in, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", test.in, err)
+			continue
+		}
+		if !DimensionsMatch(got, test.want) {
+			t.Errorf("dimension mismatch for %q: got=%v want=%v", test.in, got, test.want.Unit())
+		}
+		if !scalar.EqualWithinRel(got.Value(), test.want.Unit().Value(), 1e-14) {
+			t.Errorf("value mismatch for %q: got=%v want=%v", test.in, got.Value(), test.want.Unit().Value())
+		}
+	}
+}
+
+func TestParseNewDimension(t *testing.T) {
+	t.Parallel()
+	d := NewDimension("parsetestwidget")
+	got, err := Parse("12 parsetestwidget/h")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := New(12.0/3600, Dimensions{d: 1, TimeDim: -1})
+	if !DimensionsMatch(got, want) || !scalar.EqualWithinRel(got.Value(), want.Value(), 1e-14) {
+		t.Errorf("unexpected result: got=%v want=%v", got, want)
+	}
+}
+
+func TestIn(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		u    Uniter
+		to   string
+		want float64
+		err  bool
+	}{
+		{u: Velocity(10), to: "km/h", want: 36},
+		{u: Length(1609.344), to: "km", want: 1.609344},
+		{u: Energy(3.6e6), to: "kW h", want: 1},
+		{u: Pressure(101325), to: "bar", want: 1.01325},
+		{u: Length(1), to: "s", err: true},
+		{u: Length(1), to: "m/", err: true},
+	} {
+		got, err := test.u.Unit().In(test.to)
+		if test.err {
+			if err == nil {
+				t.Errorf("expected error converting %v to %q", test.u.Unit(), test.to)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error converting %v to %q: %v", test.u.Unit(), test.to, err)
+			continue
+		}
+		if !scalar.EqualWithinRel(got, test.want, 1e-14) {
+			t.Errorf("unexpected value converting %v to %q: got=%v want=%v", test.u.Unit(), test.to, got, test.want)
+		}
+	}
+}
+
+func TestPow(t *testing.T) {
+	t.Parallel()
+	got := Length(3).Unit().Pow(2)
+	if !DimensionsMatch(got, Area(9)) || got.Value() != 9 {
+		t.Errorf("unexpected result for squared length: got=%v", got)
+	}
+	got = Time(2).Unit().Pow(-1)
+	if !DimensionsMatch(got, Frequency(0.5)) || got.Value() != 0.5 {
+		t.Errorf("unexpected result for inverse time: got=%v", got)
+	}
+	got = New(2, nil).Mul(Length(3))
+	if !DimensionsMatch(got, Length(6)) || got.Value() != 6 {
+		t.Errorf("unexpected result for product with nil dimensions: got=%v", got)
+	}
+}
@@ -6,6 +6,7 @@ package unit_test
 
 import (
 	"fmt"
+	"log"
 
 	"gonum.org/v1/gonum/unit"
 )
@@ -47,3 +48,26 @@ func Example_horsepower() {
 	// 1 hp = 745.6998715822701 kg m^2 s^-3
 	// W is equivalent to hp? true
 }
+
+func ExampleParse() {
+	speed, err := unit.Parse("3.2 km/h")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(speed)
+
+	s, err := speed.FormatIn("m/min", 'f', 2)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(s)
+
+	_, err = speed.In("kg")
+	fmt.Println(err)
+
+	// Output:
+	//
+	// 0.8888888888888888 m s^-1
+	// 53.33 m/min
+	// unit: dimension mismatch
+}
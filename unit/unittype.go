@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"cmp"
 	"fmt"
+	"math"
 	"slices"
 	"sync"
 	"unicode/utf8"
@@ -291,6 +292,9 @@ func (u *Unit) Copy() *Unit {
 // of the receiver as appropriate. The input is not changed.
 func (u *Unit) Mul(uniter Uniter) *Unit {
 	a := uniter.Unit()
+	if u.dimensions == nil {
+		u.dimensions = make(Dimensions)
+	}
 	for key, val := range a.dimensions {
 		if d := u.dimensions[key]; d == -val {
 			delete(u.dimensions, key)
@@ -306,6 +310,9 @@ func (u *Unit) Mul(uniter Uniter) *Unit {
 // dimensions of the receiver as appropriate.
 func (u *Unit) Div(uniter Uniter) *Unit {
 	a := uniter.Unit()
+	if u.dimensions == nil {
+		u.dimensions = make(Dimensions)
+	}
 	u.value /= a.value
 	for key, val := range a.dimensions {
 		if d := u.dimensions[key]; d == val {
@@ -317,6 +324,20 @@ func (u *Unit) Div(uniter Uniter) *Unit {
 	return u
 }
 
+// Pow raises the receiver to the integer power n changing
+// the dimensions of the receiver as appropriate.
+func (u *Unit) Pow(n int) *Unit {
+	for key := range u.dimensions {
+		if n == 0 {
+			delete(u.dimensions, key)
+		} else {
+			u.dimensions[key] *= n
+		}
+	}
+	u.value = math.Pow(u.value, float64(n))
+	return u
+}
+
 // Value return the raw value of the unit as a float64. Use of this
 // method is, in general, not recommended, though it can be useful
 // for printing. Instead, the From method of a specific dimension